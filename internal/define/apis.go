@@ -4,10 +4,12 @@
 //go:generate go run ../tools/gen-api-envelope/main.go
 //go:generate go run ../tools/gen-api-op/main.go
 //go:generate go run ../tools/gen-api-tracer/main.go
+//go:generate go run ../tools/gen-api-metrics/main.go
 //go:generate go run ../tools/gen-api-stub/main.go
 //go:generate go run ../tools/gen-api-meta/main.go
 //go:generate go run ../tools/gen-api-fake-store/main.go
 //go:generate go run ../tools/gen-api-fake-op/main.go
+//go:generate go run ../tools/gen-api-fake-server/main.go
 package define
 
 import "github.com/sacloud/libsacloud-v2/internal/schema"
@@ -16,20 +18,46 @@ import "github.com/sacloud/libsacloud-v2/internal/schema"
 var Resources schema.Resources
 
 func init() {
-	Resources.Def(archiveAPI)      // アーカイブ
-	Resources.Def(bridgeAPI)       // ブリッジ
-	Resources.Def(cdromAPI)        // ISOイメージ(CD-ROM)
-	Resources.Def(diskAPI)         // ディスク
-	Resources.Def(gslbAPI)         // GSLB
-	Resources.Def(interfaceAPI)    // インターフェース(NIC)
-	Resources.Def(internetAPI)     // スイッチ+ルータ
-	Resources.Def(loadBalancerAPI) // ロードバランサ
-	Resources.Def(nfsAPI)          // NFS
-	Resources.Def(noteAPI)         // スタートアップスクリプト
-	Resources.Def(packetFilterAPI) // パケットフィルタ
-	Resources.Def(serverAPI)       // サーバ
-	Resources.Def(simAPI)          // SIM
-	Resources.Def(switchAPI)       // スイッチ
-	Resources.Def(vpcRouterAPI)    // VPCルータ
-	Resources.Def(zoneAPI)         // ゾーン
+	Resources.Def(archiveAPI)         // アーカイブ
+	Resources.Def(authStatusAPI)      // 認証情報
+	Resources.Def(autoBackupAPI)      // 自動バックアップ
+	Resources.Def(billAPI)            // 請求情報
+	Resources.Def(bridgeAPI)          // ブリッジ
+	Resources.Def(cdromAPI)           // ISOイメージ(CD-ROM)
+	Resources.Def(couponAPI)          // クーポン
+	Resources.Def(databaseAPI)        // データベース
+	Resources.Def(diskAPI)            // ディスク
+	Resources.Def(diskPlanAPI)        // ディスクプラン
+	Resources.Def(dnsAPI)             // DNS
+	Resources.Def(esUsageAPI)         // ES利用実績
+	Resources.Def(gslbAPI)            // GSLB
+	Resources.Def(iconAPI)            // アイコン
+	Resources.Def(interfaceAPI)       // インターフェース(NIC)
+	Resources.Def(internetAPI)        // スイッチ+ルータ
+	Resources.Def(internetPlanAPI)    // スイッチ+ルータ プラン
+	Resources.Def(ipAddressAPI)       // IPアドレス
+	Resources.Def(ipv6AddrAPI)        // IPv6アドレス
+	Resources.Def(ipv6NetAPI)         // IPv6ネットワーク
+	Resources.Def(licenseAPI)         // ライセンス
+	Resources.Def(licensePlanAPI)     // ライセンスプラン
+	Resources.Def(loadBalancerAPI)    // ロードバランサ
+	Resources.Def(mobileGatewayAPI)   // モバイルゲートウェイ
+	Resources.Def(nfsAPI)             // NFS
+	Resources.Def(noteAPI)            // スタートアップスクリプト
+	Resources.Def(packetFilterAPI)    // パケットフィルタ
+	Resources.Def(privateHostAPI)     // 専有ホスト
+	Resources.Def(privateHostPlanAPI) // 専有ホストプラン
+	Resources.Def(proxyLBAPI)         // エンハンスドロードバランサ
+	Resources.Def(regionAPI)          // リージョン
+	Resources.Def(serverAPI)          // サーバ
+	Resources.Def(serverPlanAPI)      // サーバプラン
+	Resources.Def(serviceClassAPI)    // 価格
+	Resources.Def(simAPI)             // SIM
+	Resources.Def(simpleMonitorAPI)   // シンプル監視
+	Resources.Def(sshKeyAPI)          // 公開鍵
+	Resources.Def(storageAPI)         // ストレージ
+	Resources.Def(subnetAPI)          // サブネット
+	Resources.Def(switchAPI)          // スイッチ
+	Resources.Def(vpcRouterAPI)       // VPCルータ
+	Resources.Def(zoneAPI)            // ゾーン
 }
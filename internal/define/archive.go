@@ -1,6 +1,8 @@
 package define
 
 import (
+	"net/http"
+
 	"github.com/sacloud/libsacloud-v2/internal/schema"
 	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
 	"github.com/sacloud/libsacloud-v2/sacloud/naked"
@@ -24,7 +26,46 @@ var archiveAPI = &schema.Resource{
 					PayloadName: models.ftpServer().Name,
 					PayloadType: meta.Static(naked.OpeningFTPServer{}),
 				}).Name("CreateBlank"),
-			// TODO 他ゾーンからの転送コピー作成
+
+			// transfer
+			r.DefineOperation("Transfer").
+				Method(http.MethodPost).
+				PathFormat(schema.IDAndSuffixPathFormat("to/zone/{{.destZoneID}}")).
+				RequestEnvelope(&schema.EnvelopePayloadDesc{
+					PayloadType: archiveNakedType,
+					PayloadName: "Archive",
+				}).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				Argument(&schema.Argument{
+					Name: "destZoneID",
+					Type: meta.TypeID,
+				}).
+				MappableArgument("param", archiveTransferParam).
+				ResultFromEnvelope(archiveView, &schema.EnvelopePayloadDesc{
+					PayloadType: archiveNakedType,
+					PayloadName: "Archive",
+				}),
+
+			// create from shared
+			r.DefineOperation("CreateFromShared").
+				Method(http.MethodPost).
+				PathFormat(schema.IDAndSuffixPathFormat("to/zone/{{.destZoneID}}")).
+				RequestEnvelope(&schema.EnvelopePayloadDesc{
+					PayloadType: archiveNakedType,
+					PayloadName: "Archive",
+				}).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				Argument(&schema.Argument{
+					Name: "destZoneID",
+					Type: meta.TypeID,
+				}).
+				MappableArgument("param", archiveCreateFromSharedParam).
+				ResultFromEnvelope(archiveView, &schema.EnvelopePayloadDesc{
+					PayloadType: archiveNakedType,
+					PayloadName: "Archive",
+				}),
 
 			// read
 			r.DefineOperationRead(archiveNakedType, archiveView),
@@ -40,6 +81,18 @@ var archiveAPI = &schema.Resource{
 
 			// closeFTP
 			r.DefineOperationCloseFTP(),
+
+			// share
+			r.DefineOperation("Share").
+				Method(http.MethodPut).
+				PathFormat(schema.IDAndSuffixPathFormat("ftp")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				PassthroughModelArgumentWithEnvelope("param", archiveShareParam).
+				ResultFromEnvelope(archiveShareInfoView, &schema.EnvelopePayloadDesc{
+					PayloadType: meta.Static(naked.ArchiveShareInfo{}),
+					PayloadName: "ArchiveShareInfo",
+				}),
 		}
 	},
 }
@@ -105,4 +158,49 @@ var (
 			fields.IconID(),
 		},
 	}
+
+	archiveTransferParam = &schema.Model{
+		Name: "ArchiveTransferRequest",
+		Fields: []*schema.FieldDesc{
+			fields.Name(),
+			fields.Description(),
+			fields.Tags(),
+			fields.IconID(),
+		},
+		NakedType: archiveNakedType,
+	}
+
+	archiveCreateFromSharedParam = &schema.Model{
+		Name: "ArchiveCreateFromSharedRequest",
+		Fields: []*schema.FieldDesc{
+			fields.Name(),
+			fields.Description(),
+			fields.Tags(),
+			fields.IconID(),
+			fields.New("SourceSharedKey", meta.TypeArchiveShareKey),
+		},
+		NakedType: archiveNakedType,
+	}
+
+	archiveShareParam = &schema.Model{
+		Name: "ArchiveShareRequest",
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "Shared",
+				Type: meta.TypeFlag,
+				Tags: &schema.FieldTags{
+					// 共有解除(false)の場合も送信する
+					JSON: "Shared",
+				},
+			},
+		},
+	}
+
+	archiveShareInfoView = &schema.Model{
+		Name: "ArchiveShareInfo",
+		Fields: []*schema.FieldDesc{
+			fields.New("SharedKey", meta.TypeArchiveShareKey),
+		},
+		NakedType: meta.Static(naked.ArchiveShareInfo{}),
+	}
 )
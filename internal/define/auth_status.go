@@ -0,0 +1,87 @@
+package define
+
+import (
+	"net/http"
+
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var authStatusAPI = &schema.Resource{
+	Name:       "AuthStatus",
+	PathName:   "auth-status",
+	PathSuffix: schema.CloudAPISuffix,
+	IsGlobal:   true,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			// read
+			r.DefineOperation("Read").
+				Method(http.MethodGet).
+				PathFormat(schema.DefaultPathFormat).
+				Argument(schema.ArgumentZone).
+				ResultFromEnvelope(authStatusView, &schema.EnvelopePayloadDesc{
+					PayloadName: "AuthStatus",
+					PayloadType: meta.Static(naked.AuthStatus{}),
+					IsEmbedded:  true,
+				}),
+		}
+	},
+}
+
+var (
+	authStatusView = &schema.Model{
+		Name: "AuthStatus",
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "AccountID",
+				Type: meta.TypeID,
+				Tags: &schema.FieldTags{
+					MapConv: "Account.ID",
+				},
+			},
+			{
+				Name: "AccountName",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv: "Account.Name",
+				},
+			},
+			{
+				Name: "AccountCode",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv: "Account.Code",
+				},
+			},
+			{
+				Name: "AccountClass",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv: "Account.Class",
+				},
+			},
+			{
+				Name: "MemberCode",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv: "Member.Code",
+				},
+			},
+			{
+				Name: "MemberClass",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv: "Member.Class",
+				},
+			},
+			fields.New("AuthClass", meta.TypeString),
+			fields.New("AuthMethod", meta.TypeString),
+			fields.New("IsAPIKey", meta.TypeFlag),
+			fields.New("ExternalPermission", meta.TypeExternalPermission),
+			fields.New("OperationPenalty", meta.TypeString),
+			fields.New("Permission", meta.TypePermission),
+		},
+		NakedType: meta.Static(naked.AuthStatus{}),
+	}
+)
@@ -0,0 +1,88 @@
+package define
+
+import (
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var autoBackupAPI = &schema.Resource{
+	Name:       "AutoBackup",
+	PathName:   "commonserviceitem",
+	PathSuffix: schema.CloudAPISuffix,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			// find
+			r.DefineOperationCommonServiceItemFind(autoBackupNakedType, findParameter, autoBackupView),
+
+			// create
+			r.DefineOperationCommonServiceItemCreate(autoBackupNakedType, autoBackupCreateParam, autoBackupView),
+
+			// read
+			r.DefineOperationCommonServiceItemRead(autoBackupNakedType, autoBackupView),
+
+			// update
+			r.DefineOperationCommonServiceItemUpdate(autoBackupNakedType, autoBackupUpdateParam, autoBackupView),
+
+			// delete
+			r.DefineOperationDelete(),
+		}
+	},
+}
+
+var (
+	autoBackupNakedType = meta.Static(naked.AutoBackup{})
+
+	autoBackupView = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			fields.Name(),
+			fields.Description(),
+			fields.Tags(),
+			fields.Availability(),
+			fields.IconID(),
+			fields.CreatedAt(),
+			fields.ModifiedAt(),
+			fields.AutoBackupProviderClass(),
+			// settings
+			fields.AutoBackupBackupSpanType(),
+			fields.AutoBackupBackupSpanWeekdays(),
+			fields.AutoBackupMaximumNumberOfArchives(),
+			fields.SettingsHash(),
+			// status
+			fields.AutoBackupDiskID(),
+			fields.AutoBackupAccountID(),
+			fields.AutoBackupZoneID(),
+			fields.AutoBackupZoneName(),
+		},
+	}
+
+	autoBackupCreateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.AutoBackupProviderClass(),
+			fields.AutoBackupDiskID(),
+
+			fields.AutoBackupBackupSpanType(),
+			fields.AutoBackupBackupSpanWeekdays(),
+			fields.AutoBackupMaximumNumberOfArchives(),
+
+			fields.Name(),
+			fields.Description(),
+			fields.Tags(),
+			fields.IconID(),
+		},
+	}
+
+	autoBackupUpdateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.AutoBackupBackupSpanType(),
+			fields.AutoBackupBackupSpanWeekdays(),
+			fields.AutoBackupMaximumNumberOfArchives(),
+
+			fields.Name(),
+			fields.Description(),
+			fields.Tags(),
+			fields.IconID(),
+		},
+	}
+)
@@ -0,0 +1,179 @@
+package define
+
+import (
+	"net/http"
+
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+const (
+	billPathByContract = "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/by-contract/{{.accountID}}"
+	billDetailPath     = "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/billdetail/{{.memberCode}}/{{.id}}"
+)
+
+var billAPI = &schema.Resource{
+	Name:       "Bill",
+	PathName:   "bill",
+	PathSuffix: schema.BillingAPISuffix,
+	IsGlobal:   true,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			// by contract
+			r.DefineOperation("ByContract").
+				Method(http.MethodGet).
+				PathFormat(billPathByContract).
+				Argument(schema.ArgumentZone).
+				Argument(billArgAccountID).
+				ResultPluralFromEnvelope(billView, &schema.EnvelopePayloadDesc{
+					PayloadName: "Bills",
+					PayloadType: billNakedType,
+				}),
+
+			// by contract and year
+			r.DefineOperation("ByContractYear").
+				Method(http.MethodGet).
+				PathFormat(billPathByContract+"/{{.year}}").
+				Argument(schema.ArgumentZone).
+				Argument(billArgAccountID).
+				Argument(billArgYear).
+				ResultPluralFromEnvelope(billView, &schema.EnvelopePayloadDesc{
+					PayloadName: "Bills",
+					PayloadType: billNakedType,
+				}),
+
+			// by contract, year and month
+			r.DefineOperation("ByContractYearMonth").
+				Method(http.MethodGet).
+				PathFormat(billPathByContract+"/{{.year}}/{{.month}}").
+				Argument(schema.ArgumentZone).
+				Argument(billArgAccountID).
+				Argument(billArgYear).
+				Argument(billArgMonth).
+				ResultPluralFromEnvelope(billView, &schema.EnvelopePayloadDesc{
+					PayloadName: "Bills",
+					PayloadType: billNakedType,
+				}),
+
+			// read(APIの戻り値は配列となる)
+			r.DefineOperation("Read").
+				Method(http.MethodGet).
+				PathFormat(schema.DefaultPathFormat+"/id/{{.id}}").
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				ResultPluralFromEnvelope(billView, &schema.EnvelopePayloadDesc{
+					PayloadName: "Bills",
+					PayloadType: billNakedType,
+				}),
+
+			// details
+			r.DefineOperation("Details").
+				Method(http.MethodGet).
+				PathFormat(billDetailPath).
+				Argument(schema.ArgumentZone).
+				Argument(billArgMemberCode).
+				Argument(schema.ArgumentID).
+				ResultPluralFromEnvelope(billDetailView, &schema.EnvelopePayloadDesc{
+					PayloadName: "BillDetails",
+					PayloadType: billDetailNakedType,
+				}),
+
+			// details csv
+			r.DefineOperation("DetailsCSV").
+				Method(http.MethodGet).
+				PathFormat(billDetailPath+"/csv").
+				Argument(schema.ArgumentZone).
+				Argument(billArgMemberCode).
+				Argument(schema.ArgumentID).
+				ResultFromEnvelope(billDetailCSVView, &schema.EnvelopePayloadDesc{
+					PayloadName: "BillDetailCSV",
+					PayloadType: billDetailCSVNakedType,
+					IsEmbedded:  true,
+				}),
+		}
+	},
+}
+
+var (
+	billNakedType          = meta.Static(naked.Bill{})
+	billDetailNakedType    = meta.Static(naked.BillDetail{})
+	billDetailCSVNakedType = meta.Static(naked.BillDetailCSV{})
+
+	billArgAccountID = &schema.Argument{
+		Name: "accountID",
+		Type: meta.TypeID,
+	}
+	billArgYear = &schema.Argument{
+		Name: "year",
+		Type: meta.TypeInt,
+	}
+	billArgMonth = &schema.Argument{
+		Name: "month",
+		Type: meta.TypeInt,
+	}
+	billArgMemberCode = &schema.Argument{
+		Name: "memberCode",
+		Type: meta.TypeString,
+	}
+
+	billView = &schema.Model{
+		Name: "Bill",
+		Fields: []*schema.FieldDesc{
+			billIDField("BillID"),
+			fields.New("Amount", meta.TypeInt64),
+			{
+				Name: "Date",
+				Type: meta.TypeTime,
+				Tags: &schema.FieldTags{
+					MapConv: "BillingDate",
+				},
+			},
+			fields.New("MemberID", meta.TypeString),
+			fields.New("Paid", meta.TypeFlag),
+			fields.New("PayLimit", meta.TypeTime),
+			fields.New("PaymentClassID", meta.TypeID),
+		},
+		NakedType: billNakedType,
+	}
+
+	billDetailView = &schema.Model{
+		Name: "BillDetail",
+		Fields: []*schema.FieldDesc{
+			billIDField("ContractID"),
+			fields.New("Amount", meta.TypeInt64),
+			fields.Description(),
+			fields.New("ServiceClassID", meta.TypeID),
+			fields.New("Usage", meta.TypeInt64),
+			fields.New("Zone", meta.TypeString),
+			fields.New("ContractEndAt", meta.TypeTime),
+		},
+		NakedType: billDetailNakedType,
+	}
+
+	billDetailCSVView = &schema.Model{
+		Name: "BillDetailCSV",
+		Fields: []*schema.FieldDesc{
+			fields.New("Count", meta.TypeInt),
+			fields.New("ResponsedAt", meta.TypeTime),
+			fields.New("Filename", meta.TypeString),
+			{
+				Name: "RawBody",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv: "Body",
+				},
+			},
+		},
+		NakedType: billDetailCSVNakedType,
+	}
+)
+
+// billIDField 請求関連APIではIDの項目名がリソースごとに異なるため、mapconvタグを指定したIDフィールドを返す
+func billIDField(sourceName string) *schema.FieldDesc {
+	f := fields.ID()
+	f.Tags = &schema.FieldTags{
+		MapConv: sourceName,
+	}
+	return f
+}
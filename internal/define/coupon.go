@@ -0,0 +1,51 @@
+package define
+
+import (
+	"net/http"
+
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var couponAPI = &schema.Resource{
+	Name:       "Coupon",
+	PathName:   "coupon",
+	PathSuffix: schema.BillingAPISuffix,
+	IsGlobal:   true,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			// find
+			r.DefineOperation("Find").
+				Method(http.MethodGet).
+				PathFormat(schema.DefaultPathFormat+"/{{.accountID}}").
+				Argument(schema.ArgumentZone).
+				Argument(&schema.Argument{
+					Name: "accountID",
+					Type: meta.TypeID,
+				}).
+				ResultPluralFromEnvelope(couponView, &schema.EnvelopePayloadDesc{
+					PayloadName: "Coupons",
+					PayloadType: couponNakedType,
+				}),
+		}
+	},
+}
+
+var (
+	couponNakedType = meta.Static(naked.Coupon{})
+
+	couponView = &schema.Model{
+		Name: "Coupon",
+		Fields: []*schema.FieldDesc{
+			billIDField("CouponID"),
+			fields.New("MemberID", meta.TypeString),
+			fields.New("ContractID", meta.TypeID),
+			fields.New("ServiceClassID", meta.TypeID),
+			fields.New("Discount", meta.TypeInt64),
+			fields.New("AppliedAt", meta.TypeTime),
+			fields.New("UntilAt", meta.TypeTime),
+		},
+		NakedType: couponNakedType,
+	}
+)
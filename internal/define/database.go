@@ -0,0 +1,261 @@
+package define
+
+import (
+	"net/http"
+
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var databaseAPI = &schema.Resource{
+	Name:       "Database",
+	PathName:   "appliance",
+	PathSuffix: schema.CloudAPISuffix,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			// find
+			r.DefineOperationApplianceFind(databaseNakedType, findParameter, databaseView),
+
+			// create
+			r.DefineOperationApplianceCreate(databaseNakedType, databaseCreateParam, databaseView),
+
+			// read
+			r.DefineOperationApplianceRead(databaseNakedType, databaseView),
+
+			// update
+			r.DefineOperationApplianceUpdate(databaseNakedType, databaseUpdateParam, databaseView),
+
+			// delete
+			r.DefineOperationDelete(),
+
+			// config
+			r.DefineOperationConfig(),
+
+			// power management(boot/shutdown/reset)
+			r.DefineOperationBoot(),
+			r.DefineOperationShutdown(),
+			r.DefineOperationReset(),
+
+			// monitor
+			r.DefineOperationMonitorChild("CPU", "cpu",
+				monitorParameter, monitors.cpuTimeModel()),
+			r.DefineOperationMonitorChild("Disk", "disk/0",
+				monitorParameter, monitors.diskModel()),
+			r.DefineOperationMonitorChild("Interface", "interface",
+				monitorParameter, monitors.interfaceModel()),
+			r.DefineOperationMonitorChild("Database", "database",
+				monitorParameter, monitors.databaseModel()),
+
+			// status
+			r.DefineOperation("Status").
+				Method(http.MethodGet).
+				PathFormat(schema.IDAndSuffixPathFormat("status")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				ResultFromEnvelope(databaseStatusView, &schema.EnvelopePayloadDesc{
+					PayloadName: "Appliance",
+					PayloadType: meta.Static(naked.DatabaseStatusResponse{}),
+				}),
+
+			// parameter
+			r.DefineOperation("GetParameter").
+				Method(http.MethodGet).
+				PathFormat(schema.IDAndSuffixPathFormat("database/parameter")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				ResultFromEnvelope(databaseParameterView, &schema.EnvelopePayloadDesc{
+					PayloadName: "Database",
+					PayloadType: meta.Static(naked.DatabaseParameter{}),
+				}),
+			r.DefineOperation("SetParameter").
+				Method(http.MethodPut).
+				PathFormat(schema.IDAndSuffixPathFormat("database/parameter")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				RequestEnvelope(&schema.EnvelopePayloadDesc{
+					PayloadName: "Parameter",
+					PayloadType: meta.Static(naked.DatabaseParameterSetting{}),
+				}).
+				Argument(&schema.Argument{
+					Name:       "param",
+					Type:       meta.Static(map[string]interface{}{}),
+					MapConvTag: "Parameter.Attr",
+				}),
+		}
+	},
+}
+
+var (
+	databaseNakedType = meta.Static(naked.Database{})
+
+	databaseView = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			fields.Class(),
+			fields.Name(),
+			fields.Description(),
+			fields.Tags(),
+			fields.Availability(),
+			fields.IconID(),
+			fields.CreatedAt(),
+			fields.ModifiedAt(),
+			// instance
+			fields.InstanceHostName(),
+			fields.InstanceHostInfoURL(),
+			fields.InstanceStatus(),
+			fields.InstanceStatusChangedAt(),
+			// plan
+			fields.AppliancePlanID(),
+			// switch
+			fields.ApplianceSwitchID(),
+			// remark
+			fields.DatabaseConf(),
+			fields.RemarkDefaultRoute(),
+			fields.RemarkNetworkMaskLen(),
+			fields.RemarkServerIPAddress(),
+			fields.RemarkZoneID(),
+			// settings
+			fields.DatabaseSettingsCommon(),
+			fields.DatabaseSettingsBackup(),
+			fields.DatabaseSettingsReplication(),
+			fields.SettingsHash(),
+		},
+	}
+
+	databaseCreateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.DatabaseClass(),
+			fields.AppliancePlanID(),
+			fields.ApplianceSwitchID(),
+			fields.ApplianceIPAddresses(),
+			fields.RemarkNetworkMaskLen(),
+			fields.RemarkDefaultRoute(),
+			fields.DatabaseConf(),
+			fields.Name(),
+			fields.Description(),
+			fields.Tags(),
+			fields.IconID(),
+			fields.DatabaseSettingsCommon(),
+			fields.DatabaseSettingsBackup(),
+			fields.DatabaseSettingsReplication(),
+		},
+	}
+
+	databaseUpdateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.Name(),
+			fields.Description(),
+			fields.Tags(),
+			fields.IconID(),
+			fields.DatabaseSettingsCommon(),
+			fields.DatabaseSettingsBackup(),
+			fields.DatabaseSettingsReplication(),
+		},
+	}
+
+	databaseStatusView = &schema.Model{
+		Name:      "DatabaseStatus",
+		NakedType: meta.Static(naked.DatabaseStatusResponse{}),
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "Status",
+				Type: meta.TypeInstanceStatus,
+				Tags: &schema.FieldTags{
+					MapConv: "SettingsResponse.status",
+				},
+			},
+			{
+				Name: "IsFatal",
+				Type: meta.TypeFlag,
+				Tags: &schema.FieldTags{
+					MapConv: "SettingsResponse.is_fatal",
+				},
+			},
+			{
+				Name: "Version",
+				Type: &schema.Model{
+					Name:      "DatabaseVersionInfo",
+					NakedType: meta.Static(naked.DatabaseStatusVersion{}),
+					Fields: []*schema.FieldDesc{
+						{Name: "LastModified", Type: meta.TypeString, Tags: &schema.FieldTags{MapConv: "lastmodified"}},
+						{Name: "CommitHash", Type: meta.TypeString, Tags: &schema.FieldTags{MapConv: "commithash"}},
+						{Name: "Status", Type: meta.TypeString, Tags: &schema.FieldTags{MapConv: "status"}},
+						{Name: "Tag", Type: meta.TypeString, Tags: &schema.FieldTags{MapConv: "tag"}},
+						{Name: "Expire", Type: meta.TypeString, Tags: &schema.FieldTags{MapConv: "expire"}},
+					},
+				},
+				Tags: &schema.FieldTags{
+					MapConv: "SettingsResponse.DBConf.version,recursive",
+				},
+			},
+			{
+				Name: "Logs",
+				Type: &schema.Model{
+					Name:      "DatabaseLog",
+					NakedType: meta.Static(naked.DatabaseLog{}),
+					IsArray:   true,
+					Fields: []*schema.FieldDesc{
+						{Name: "Name", Type: meta.TypeString, Tags: &schema.FieldTags{MapConv: "name"}},
+						{Name: "Data", Type: meta.TypeString, Tags: &schema.FieldTags{MapConv: "data"}},
+						{Name: "Size", Type: meta.TypeStringNumber, Tags: &schema.FieldTags{MapConv: "size"}},
+					},
+				},
+				Tags: &schema.FieldTags{
+					MapConv: "SettingsResponse.DBConf.[]log,recursive",
+				},
+			},
+			{
+				Name: "Backups",
+				Type: &schema.Model{
+					Name:      "DatabaseBackupHistory",
+					NakedType: meta.Static(naked.DatabaseBackupHistory{}),
+					IsArray:   true,
+					Fields: []*schema.FieldDesc{
+						{Name: "CreatedAt", Type: meta.TypeTime, Tags: &schema.FieldTags{MapConv: "createdat"}},
+						{Name: "Availability", Type: meta.TypeString, Tags: &schema.FieldTags{MapConv: "availability"}},
+						{Name: "RecoveredAt", Type: meta.TypeTime, Tags: &schema.FieldTags{MapConv: "recoveredat"}},
+						{Name: "Size", Type: meta.TypeStringNumber, Tags: &schema.FieldTags{MapConv: "size"}},
+					},
+				},
+				Tags: &schema.FieldTags{
+					MapConv: "SettingsResponse.DBConf.backup.[]history,recursive",
+				},
+			},
+		},
+	}
+
+	databaseParameterView = &schema.Model{
+		Name:      "DatabaseParameter",
+		NakedType: meta.Static(naked.DatabaseParameter{}),
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "Settings",
+				Type: meta.Static(map[string]interface{}{}),
+				Tags: &schema.FieldTags{
+					MapConv: "Parameter.Attr",
+				},
+			},
+			{
+				Name: "MetaInfo",
+				Type: &schema.Model{
+					Name:      "DatabaseParameterMeta",
+					NakedType: meta.Static(naked.DatabaseParameterForm{}),
+					IsArray:   true,
+					Fields: []*schema.FieldDesc{
+						{Name: "Type", Type: meta.TypeString, Tags: &schema.FieldTags{MapConv: "type"}},
+						{Name: "Name", Type: meta.TypeString, Tags: &schema.FieldTags{MapConv: "name"}},
+						{Name: "Label", Type: meta.TypeString, Tags: &schema.FieldTags{MapConv: "label"}},
+						{Name: "Text", Type: meta.TypeString, Tags: &schema.FieldTags{MapConv: "options.text"}},
+						{Name: "Example", Type: meta.TypeString, Tags: &schema.FieldTags{MapConv: "options.ex"}},
+						{Name: "Min", Type: meta.TypeFloat64, Tags: &schema.FieldTags{MapConv: "options.min"}},
+						{Name: "Max", Type: meta.TypeFloat64, Tags: &schema.FieldTags{MapConv: "options.max"}},
+					},
+				},
+				Tags: &schema.FieldTags{
+					MapConv: "Remark.[]Form,recursive",
+				},
+			},
+		},
+	}
+)
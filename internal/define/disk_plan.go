@@ -0,0 +1,58 @@
+package define
+
+import (
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var diskPlanAPI = &schema.Resource{
+	Name:       "DiskPlan",
+	PathName:   "product/disk",
+	PathSuffix: schema.CloudAPISuffix,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			r.DefineOperationFind(diskPlanNakedType, findParameter, diskPlanView),
+			r.DefineOperationRead(diskPlanNakedType, diskPlanView),
+		}
+	},
+}
+
+var (
+	diskPlanNakedType = meta.Static(naked.DiskPlan{})
+
+	diskPlanView = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			fields.Name(),
+			{
+				Name: "StorageClass",
+				Type: meta.TypeString,
+			},
+			fields.Availability(),
+			{
+				Name: "Size",
+				Type: &schema.Model{
+					Name:      "DiskPlanSizeInfo",
+					NakedType: meta.Static(naked.DiskPlanSizeInfo{}),
+					IsArray:   true,
+					Fields: []*schema.FieldDesc{
+						fields.Availability(),
+						{
+							Name: "DisplaySize",
+							Type: meta.TypeInt,
+						},
+						{
+							Name: "DisplaySuffix",
+							Type: meta.TypeString,
+						},
+						fields.SizeMB(),
+					},
+				},
+				Tags: &schema.FieldTags{
+					MapConv: "[]Size,recursive",
+				},
+			},
+		},
+	}
+)
@@ -0,0 +1,75 @@
+package define
+
+import (
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var dnsAPI = &schema.Resource{
+	Name:       "DNS",
+	PathName:   "commonserviceitem",
+	PathSuffix: schema.CloudAPISuffix,
+	IsGlobal:   true,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			// find
+			r.DefineOperationCommonServiceItemFind(dnsNakedType, findParameter, dnsView),
+
+			// create
+			r.DefineOperationCommonServiceItemCreate(dnsNakedType, dnsCreateParam, dnsView),
+
+			// read
+			r.DefineOperationCommonServiceItemRead(dnsNakedType, dnsView),
+
+			// update
+			r.DefineOperationCommonServiceItemUpdate(dnsNakedType, dnsUpdateParam, dnsView),
+
+			// delete
+			r.DefineOperationDelete(),
+		}
+	},
+}
+
+var (
+	dnsNakedType = meta.Static(naked.DNS{})
+
+	dnsView = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			fields.Name(),
+			fields.Description(),
+			fields.Tags(),
+			fields.Availability(),
+			fields.IconID(),
+			fields.CreatedAt(),
+			fields.ModifiedAt(),
+			fields.DNSProviderClass(),
+			fields.DNSRecords(),
+			fields.SettingsHash(),
+			fields.DNSNameServers(),
+		},
+	}
+
+	dnsCreateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.DNSProviderClass(),
+			fields.DNSZoneName(),
+			fields.DNSRecords(),
+
+			fields.Description(),
+			fields.Tags(),
+			fields.IconID(),
+		},
+	}
+
+	dnsUpdateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.DNSRecords(),
+
+			fields.Description(),
+			fields.Tags(),
+			fields.IconID(),
+		},
+	}
+)
@@ -0,0 +1,51 @@
+package define
+
+import (
+	"net/http"
+
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var esUsageAPI = &schema.Resource{
+	Name:       "ESUsage",
+	PathName:   "esusage",
+	PathSuffix: schema.BillingAPISuffix,
+	IsGlobal:   true,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			// find(契約、年月ごと)
+			r.DefineOperation("Find").
+				Method(http.MethodGet).
+				PathFormat(schema.DefaultPathFormat+"/{{.accountID}}/{{.year}}/{{.month}}").
+				Argument(schema.ArgumentZone).
+				Argument(billArgAccountID).
+				Argument(billArgYear).
+				Argument(billArgMonth).
+				ResultPluralFromEnvelope(esUsageView, &schema.EnvelopePayloadDesc{
+					PayloadName: "ESUsages",
+					PayloadType: esUsageNakedType,
+				}),
+		}
+	},
+}
+
+var (
+	esUsageNakedType = meta.Static(naked.ESUsage{})
+
+	esUsageView = &schema.Model{
+		Name: "ESUsage",
+		Fields: []*schema.FieldDesc{
+			billIDField("ESUsageID"),
+			fields.New("MemberID", meta.TypeString),
+			fields.New("ContractID", meta.TypeID),
+			fields.New("ServiceClassID", meta.TypeID),
+			fields.Description(),
+			fields.New("Usage", meta.TypeInt64),
+			fields.New("Amount", meta.TypeInt64),
+			fields.New("UsageDate", meta.TypeTime),
+		},
+		NakedType: esUsageNakedType,
+	}
+)
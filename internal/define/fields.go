@@ -138,6 +138,9 @@ func (f *fieldsDef) Generation() *schema.FieldDesc {
 	return &schema.FieldDesc{
 		Name: "ServerPlanGeneration",
 		Type: meta.TypePlanGeneration,
+		Tags: &schema.FieldTags{
+			MapConv: "Generation",
+		},
 	}
 }
 
@@ -337,6 +340,23 @@ func (f *fieldsDef) IconID() *schema.FieldDesc {
 	}
 }
 
+func (f *fieldsDef) IconURL() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "URL",
+		Type: meta.TypeString,
+	}
+}
+
+func (f *fieldsDef) IconImage() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "Image",
+		Type: meta.TypeString,
+		Tags: &schema.FieldTags{
+			Validate: "required,base64",
+		},
+	}
+}
+
 func (f *fieldsDef) ZoneID() *schema.FieldDesc {
 	return &schema.FieldDesc{
 		Name: "ZoneID",
@@ -514,6 +534,105 @@ func (f *fieldsDef) LoadBalancerVIP() *schema.FieldDesc {
 	}
 }
 
+func (f *fieldsDef) DatabaseConf() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "Conf",
+		Type: &schema.Model{
+			Name: "DatabaseRemarkDBConfCommon",
+			Fields: []*schema.FieldDesc{
+				{Name: "DatabaseName", Type: meta.TypeString},
+				{Name: "DatabaseVersion", Type: meta.TypeString},
+				{Name: "DatabaseRevision", Type: meta.TypeString},
+			},
+		},
+		Tags: &schema.FieldTags{
+			MapConv: "Remark.DBConf.Common,recursive",
+		},
+	}
+}
+
+func (f *fieldsDef) DatabaseSettingsCommon() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "CommonSetting",
+		Type: &schema.Model{
+			Name: "DatabaseSettingCommon",
+			Fields: []*schema.FieldDesc{
+				{Name: "WebUI", Type: meta.TypeWebUI},
+				{
+					Name: "ServicePort",
+					Type: meta.TypeInt,
+					Tags: &schema.FieldTags{
+						Validate: "min=0,max=65535",
+					},
+				},
+				{Name: "SourceNetwork", Type: meta.TypeStringSlice},
+				{Name: "DefaultUser", Type: meta.TypeString},
+				{Name: "UserPassword", Type: meta.TypeString},
+				{Name: "ReplicaUser", Type: meta.TypeString},
+				{Name: "ReplicaPassword", Type: meta.TypeString},
+			},
+		},
+		Tags: &schema.FieldTags{
+			MapConv: "Settings.DBConf.Common,recursive",
+		},
+	}
+}
+
+func (f *fieldsDef) DatabaseSettingsBackup() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "BackupSetting",
+		Type: &schema.Model{
+			Name: "DatabaseSettingBackup",
+			Fields: []*schema.FieldDesc{
+				{
+					Name: "Rotate",
+					Type: meta.TypeInt,
+					Tags: &schema.FieldTags{
+						Validate: "min=0,max=8",
+					},
+				},
+				{Name: "Time", Type: meta.TypeString},
+				{Name: "DayOfWeek", Type: meta.TypeStringSlice},
+			},
+		},
+		Tags: &schema.FieldTags{
+			MapConv: "Settings.DBConf.Backup,recursive",
+		},
+	}
+}
+
+func (f *fieldsDef) DatabaseSettingsReplication() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "ReplicationSetting",
+		Type: &schema.Model{
+			Name: "DatabaseReplicationSetting",
+			Fields: []*schema.FieldDesc{
+				{Name: "Model", Type: meta.TypeDatabaseReplicationModel},
+				{
+					Name: "IPAddress",
+					Type: meta.TypeString,
+					Tags: &schema.FieldTags{
+						Validate: "omitempty,ipv4",
+					},
+				},
+				{Name: "Port", Type: meta.TypeInt},
+				{Name: "User", Type: meta.TypeString},
+				{Name: "Password", Type: meta.TypeString},
+				{
+					Name: "ApplianceID",
+					Type: meta.TypeID,
+					Tags: &schema.FieldTags{
+						MapConv: "Appliance.ID",
+					},
+				},
+			},
+		},
+		Tags: &schema.FieldTags{
+			MapConv: "Settings.DBConf.Replication,recursive",
+		},
+	}
+}
+
 func (f *fieldsDef) Tags() *schema.FieldDesc {
 	return &schema.FieldDesc{
 		Name: "Tags",
@@ -538,6 +657,16 @@ func (f *fieldsDef) NFSClass() *schema.FieldDesc {
 	}
 }
 
+func (f *fieldsDef) DatabaseClass() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "Class",
+		Type: meta.TypeString,
+		Tags: &schema.FieldTags{
+			MapConv: ",default=database",
+		},
+	}
+}
+
 func (f *fieldsDef) LoadBalancerClass() *schema.FieldDesc {
 	return &schema.FieldDesc{
 		Name: "Class",
@@ -558,6 +687,16 @@ func (f *fieldsDef) VPCRouterClass() *schema.FieldDesc {
 	}
 }
 
+func (f *fieldsDef) MobileGatewayClass() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "Class",
+		Type: meta.TypeString,
+		Tags: &schema.FieldTags{
+			MapConv: ",default=mobilegateway",
+		},
+	}
+}
+
 func (f *fieldsDef) SIMProviderClass() *schema.FieldDesc {
 	return &schema.FieldDesc{
 		Name: "Class",
@@ -725,227 +864,884 @@ func (f *fieldsDef) GSLBSorryServer() *schema.FieldDesc {
 	}
 }
 
-func (f *fieldsDef) SettingsHash() *schema.FieldDesc {
+func (f *fieldsDef) DNSProviderClass() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "SettingsHash",
+		Name: "Class",
 		Type: meta.TypeString,
+		Tags: &schema.FieldTags{
+			MapConv: "Provider.Class,default=dns",
+		},
 	}
 }
 
-func (f *fieldsDef) InstanceHostName() *schema.FieldDesc {
+func (f *fieldsDef) DNSZoneName() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "InstanceHostName",
+		Name: "Name",
 		Type: meta.TypeString,
 		Tags: &schema.FieldTags{
-			MapConv: "Instance.Host.Name",
+			MapConv:  "Name/Status.Zone",
+			Validate: "required",
 		},
 	}
 }
 
-func (f *fieldsDef) InstanceHostInfoURL() *schema.FieldDesc {
+func (f *fieldsDef) DNSNameServers() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "InstanceHostInfoURL",
-		Type: meta.TypeString,
+		Name: "DNSNameServers",
+		Type: meta.TypeStringSlice,
 		Tags: &schema.FieldTags{
-			MapConv: "Instance.Host.InfoURL",
+			MapConv: "Status.NS",
 		},
 	}
 }
 
-func (f *fieldsDef) InstanceStatus() *schema.FieldDesc {
+func (f *fieldsDef) DNSRecords() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "InstanceStatus",
-		Type: meta.TypeInstanceStatus,
+		Name: "Records",
+		Type: &schema.Model{
+			Name:    "DNSRecord",
+			IsArray: true,
+			Fields: []*schema.FieldDesc{
+				{
+					Name: "Name",
+					Type: meta.TypeString,
+					Tags: &schema.FieldTags{
+						Validate: "required",
+					},
+				},
+				{
+					Name: "Type",
+					Type: meta.TypeDNSRecordType,
+					Tags: &schema.FieldTags{
+						Validate: "required,oneof=A AAAA ALIAS CNAME NS MX TXT SRV CAA",
+					},
+				},
+				{
+					Name: "RData",
+					Type: meta.TypeString,
+					Tags: &schema.FieldTags{
+						Validate: "required",
+					},
+				},
+				{
+					Name: "TTL",
+					Type: meta.TypeInt,
+					Tags: &schema.FieldTags{
+						Validate: "omitempty,min=10,max=3600000",
+					},
+				},
+			},
+		},
 		Tags: &schema.FieldTags{
-			MapConv: "Instance.Status",
+			MapConv:  "Settings.DNS.[]ResourceRecordSets,recursive",
+			Validate: "min=0,max=1000",
 		},
 	}
 }
 
-func (f *fieldsDef) InstanceBeforeStatus() *schema.FieldDesc {
+func (f *fieldsDef) SimpleMonitorProviderClass() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "InstanceBeforeStatus",
-		Type: meta.TypeInstanceStatus,
+		Name: "Class",
+		Type: meta.TypeString,
 		Tags: &schema.FieldTags{
-			MapConv: "Instance.BeforeStatus",
+			MapConv: "Provider.Class,default=simplemon",
 		},
 	}
 }
 
-func (f *fieldsDef) InstanceStatusChangedAt() *schema.FieldDesc {
+func (f *fieldsDef) SimpleMonitorTarget() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "InstanceStatusChangedAt",
-		Type: meta.TypeTime,
+		Name: "Target",
+		Type: meta.TypeString,
 		Tags: &schema.FieldTags{
-			MapConv: "Instance.StatusChangedAt",
+			MapConv:  "Name/Status.Target",
+			Validate: "required",
 		},
 	}
 }
 
-func (f *fieldsDef) InstanceWarnings() *schema.FieldDesc {
+func (f *fieldsDef) SimpleMonitorDelayLoop() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "InstanceWarnings",
-		Type: meta.TypeString,
+		Name: "DelayLoop",
+		Type: meta.TypeInt,
 		Tags: &schema.FieldTags{
-			MapConv: "Instance.Warnings",
+			MapConv:  "Settings.SimpleMonitor.DelayLoop",
+			Validate: "omitempty,min=60,max=3600",
 		},
 	}
 }
 
-func (f *fieldsDef) InstanceWarningsValue() *schema.FieldDesc {
+func (f *fieldsDef) SimpleMonitorEnabled() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "InstanceWarningsValue",
-		Type: meta.TypeInt,
+		Name: "Enabled",
+		Type: meta.TypeStringFlag,
 		Tags: &schema.FieldTags{
-			MapConv: "Instance.WarningsValue",
+			MapConv: "Settings.SimpleMonitor.Enabled",
 		},
 	}
 }
 
-func (f *fieldsDef) Interfaces() *schema.FieldDesc {
+func (f *fieldsDef) SimpleMonitorHealthCheck() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "Interfaces",
-		Type: models.interfaceModel(),
+		Name: "HealthCheck",
+		Type: &schema.Model{
+			Name: "SimpleMonitorHealthCheck",
+			Fields: []*schema.FieldDesc{
+				{
+					Name: "Protocol",
+					Type: meta.TypeSimpleMonitorProtocol,
+					Tags: &schema.FieldTags{
+						Validate: "required,oneof=http https ping tcp dns ssh smtp pop3 snmp sslcertificate",
+					},
+				},
+				{Name: "Port", Type: meta.TypeStringNumber},
+				{Name: "Path", Type: meta.TypeString},
+				{Name: "Status", Type: meta.TypeStringNumber},
+				{Name: "SNI", Type: meta.TypeStringFlag},
+				{Name: "Host", Type: meta.TypeString},
+				{Name: "BasicAuthUsername", Type: meta.TypeString},
+				{Name: "BasicAuthPassword", Type: meta.TypeString},
+				{Name: "QName", Type: meta.TypeString},
+				{Name: "ExpectedData", Type: meta.TypeString},
+				{Name: "Community", Type: meta.TypeString},
+				{Name: "SNMPVersion", Type: meta.TypeString},
+				{Name: "OID", Type: meta.TypeString},
+				{Name: "RemainingDays", Type: meta.TypeInt},
+			},
+		},
 		Tags: &schema.FieldTags{
-			JSON:    ",omitempty",
-			MapConv: "[]Interfaces,recursive,omitempty",
+			MapConv:  "Settings.SimpleMonitor.HealthCheck,recursive",
+			Validate: "required",
 		},
 	}
 }
 
-func (f *fieldsDef) VPCRouterInterfaces() *schema.FieldDesc {
+func (f *fieldsDef) SimpleMonitorNotifyEmailEnabled() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "Interfaces",
-		Type: models.vpcRouterInterfaceModel(),
+		Name: "NotifyEmailEnabled",
+		Type: meta.TypeStringFlag,
 		Tags: &schema.FieldTags{
-			JSON:    ",omitempty",
-			MapConv: "[]Interfaces,recursive,omitempty",
+			MapConv: "Settings.SimpleMonitor.NotifyEmail.Enabled",
 		},
 	}
 }
 
-func (f *fieldsDef) NoteClass() *schema.FieldDesc {
+func (f *fieldsDef) SimpleMonitorNotifyEmailHTML() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "Class",
-		Type: meta.TypeString,
+		Name: "NotifyEmailHTML",
+		Type: meta.TypeStringFlag,
+		Tags: &schema.FieldTags{
+			MapConv: "Settings.SimpleMonitor.NotifyEmail.HTML",
+		},
 	}
 }
 
-func (f *fieldsDef) NoteContent() *schema.FieldDesc {
+func (f *fieldsDef) SimpleMonitorNotifySlackEnabled() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "Content",
-		Type: meta.TypeString,
+		Name: "NotifySlackEnabled",
+		Type: meta.TypeStringFlag,
+		Tags: &schema.FieldTags{
+			MapConv: "Settings.SimpleMonitor.NotifySlack.Enabled",
+		},
 	}
 }
 
-func (f *fieldsDef) Description() *schema.FieldDesc {
+func (f *fieldsDef) SimpleMonitorSlackWebhooksURL() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "Description",
+		Name: "SlackWebhooksURL",
 		Type: meta.TypeString,
 		Tags: &schema.FieldTags{
-			Validate: "min=0,max=512",
+			MapConv:  "Settings.SimpleMonitor.NotifySlack.IncomingWebhooksURL",
+			Validate: "omitempty,url",
 		},
 	}
 }
 
-func (f *fieldsDef) Availability() *schema.FieldDesc {
+func (f *fieldsDef) SimpleMonitorNotifyInterval() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "Availability",
-		Type: meta.TypeAvailability,
+		Name: "NotifyInterval",
+		Type: meta.TypeInt,
+		Tags: &schema.FieldTags{
+			MapConv:  "Settings.SimpleMonitor.NotifyInterval",
+			Validate: "omitempty,min=3600,max=259200",
+		},
 	}
 }
 
-func (f *fieldsDef) Scope() *schema.FieldDesc {
+func (f *fieldsDef) AutoBackupProviderClass() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "Scope",
-		Type: meta.TypeScope,
+		Name: "Class",
+		Type: meta.TypeString,
+		Tags: &schema.FieldTags{
+			MapConv: "Provider.Class,default=autobackup",
+		},
 	}
 }
 
-func (f *fieldsDef) BandWidthMbps() *schema.FieldDesc {
+func (f *fieldsDef) AutoBackupDiskID() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "BandWidthMbps",
-		Type: meta.TypeInt,
+		Name: "DiskID",
+		Type: meta.TypeID,
+		Tags: &schema.FieldTags{
+			MapConv:  "Status.DiskId",
+			Validate: "required",
+		},
 	}
 }
 
-func (f *fieldsDef) DiskConnection() *schema.FieldDesc {
+func (f *fieldsDef) AutoBackupBackupSpanType() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "Connection",
-		Type: meta.TypeDiskConnection,
+		Name: "BackupSpanType",
+		Type: meta.TypeBackupSpanType,
 		Tags: &schema.FieldTags{
-			JSON:    ",omitempty",
-			MapConv: ",omitempty",
+			MapConv: "Settings.Autobackup.BackupSpanType,default=weekdays",
 		},
 	}
 }
 
-func (f *fieldsDef) DiskConnectionOrder() *schema.FieldDesc {
+func (f *fieldsDef) AutoBackupBackupSpanWeekdays() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "ConnectionOrder",
-		Type: meta.TypeInt,
+		Name: "BackupSpanWeekdays",
+		Type: meta.TypeBackupSpanWeekdays,
+		Tags: &schema.FieldTags{
+			MapConv:  "Settings.Autobackup.BackupSpanWeekdays",
+			Validate: "required,min=1,max=7,dive,oneof=sun mon tue wed thu fri sat",
+		},
 	}
 }
 
-func (f *fieldsDef) DiskReinstallCount() *schema.FieldDesc {
+func (f *fieldsDef) AutoBackupMaximumNumberOfArchives() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "ReinstallCount",
+		Name: "MaximumNumberOfArchives",
 		Type: meta.TypeInt,
+		Tags: &schema.FieldTags{
+			MapConv:  "Settings.Autobackup.MaximumNumberOfArchives",
+			Validate: "min=1,max=10",
+		},
 	}
 }
 
-func (f *fieldsDef) SizeMB() *schema.FieldDesc {
+func (f *fieldsDef) AutoBackupAccountID() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "SizeMB",
-		Type: meta.TypeInt,
-		ExtendAccessors: []*schema.ExtendAccessor{
-			{Name: "SizeGB"},
+		Name: "AccountID",
+		Type: meta.TypeID,
+		Tags: &schema.FieldTags{
+			MapConv: "Status.AccountId",
 		},
 	}
 }
 
-func (f *fieldsDef) MigratedMB() *schema.FieldDesc {
+func (f *fieldsDef) AutoBackupZoneID() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "MigratedMB",
-		Type: meta.TypeInt,
-		ExtendAccessors: []*schema.ExtendAccessor{
-			{Name: "MigratedGB"},
+		Name: "ZoneID",
+		Type: meta.TypeID,
+		Tags: &schema.FieldTags{
+			MapConv: "Status.ZoneId",
 		},
 	}
 }
 
-func (f *fieldsDef) DefaultRoute() *schema.FieldDesc {
+func (f *fieldsDef) AutoBackupZoneName() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "DefaultRoute",
+		Name: "ZoneName",
 		Type: meta.TypeString,
 		Tags: &schema.FieldTags{
-			Validate: "ipv4",
+			MapConv: "Status.ZoneName",
 		},
 	}
 }
 
-func (f *fieldsDef) NextHop() *schema.FieldDesc {
+func (f *fieldsDef) ProxyLBProviderClass() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "NextHop",
+		Name: "Class",
 		Type: meta.TypeString,
-		Description: `
-			スイッチ+ルータでの追加IPアドレスブロックを示すSubnetの中でのみ設定される項目。
-			この場合DefaultRouteの値は設定されないためNextHopを代用する。
-			StaticRouteと同じ値が設定される。`,
 		Tags: &schema.FieldTags{
-			Validate: "ipv4",
+			MapConv: "Provider.Class,default=proxylb",
 		},
 	}
 }
 
-func (f *fieldsDef) StaticRoute() *schema.FieldDesc {
+func (f *fieldsDef) ProxyLBHealthCheck() *schema.FieldDesc {
 	return &schema.FieldDesc{
-		Name: "StaticRoute",
-		Type: meta.TypeString,
-		Description: `
-			スイッチ+ルータでの追加IPアドレスブロックを示すSubnetの中でのみ設定される項目。
-			この場合DefaultRouteの値は設定されないためNextHopを代用する。
-			NextHopと同じ値が設定される。`,
+		Name: "HealthCheck",
+		Type: &schema.Model{
+			Name: "ProxyLBHealthCheck",
+			Fields: []*schema.FieldDesc{
+				{
+					Name: "Protocol",
+					Type: meta.TypeProxyLBHealthCheckProtocol,
+					Tags: &schema.FieldTags{
+						Validate: "oneof=http tcp",
+					},
+				},
+				{Name: "Path", Type: meta.TypeString},
+				{Name: "Host", Type: meta.TypeString},
+				{
+					Name: "DelayLoop",
+					Type: meta.TypeInt,
+					Tags: &schema.FieldTags{
+						Validate: "omitempty,min=10,max=60",
+					},
+				},
+			},
+		},
+		Tags: &schema.FieldTags{
+			MapConv:  "Settings.ProxyLB.HealthCheck,recursive",
+			Validate: "required",
+		},
+	}
+}
+
+func (f *fieldsDef) ProxyLBSorryServer() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "SorryServer",
+		Type: &schema.Model{
+			Name: "ProxyLBSorryServer",
+			Fields: []*schema.FieldDesc{
+				{
+					Name: "IPAddress",
+					Type: meta.TypeString,
+					Tags: &schema.FieldTags{
+						Validate: "omitempty,ipv4",
+					},
+				},
+				{
+					Name: "Port",
+					Type: meta.TypeInt,
+					Tags: &schema.FieldTags{
+						Validate: "omitempty,min=0,max=65535",
+					},
+				},
+			},
+		},
+		Tags: &schema.FieldTags{
+			MapConv: "Settings.ProxyLB.SorryServer,recursive",
+		},
+	}
+}
+
+func (f *fieldsDef) ProxyLBBindPorts() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "BindPorts",
+		Type: &schema.Model{
+			Name:    "ProxyLBBindPort",
+			IsArray: true,
+			Fields: []*schema.FieldDesc{
+				{
+					Name: "ProxyMode",
+					Type: meta.TypeProxyLBProxyMode,
+					Tags: &schema.FieldTags{
+						Validate: "required,oneof=http https tcp",
+					},
+				},
+				{
+					Name: "Port",
+					Type: meta.TypeInt,
+					Tags: &schema.FieldTags{
+						Validate: "min=0,max=65535",
+					},
+				},
+				{Name: "RedirectToHTTPS", Type: meta.TypeFlag},
+				{Name: "SupportHTTP2", Type: meta.TypeFlag},
+			},
+		},
+		Tags: &schema.FieldTags{
+			MapConv:  "Settings.ProxyLB.[]BindPorts,recursive",
+			Validate: "min=0,max=2",
+		},
+	}
+}
+
+func (f *fieldsDef) ProxyLBServers() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "Servers",
+		Type: &schema.Model{
+			Name:    "ProxyLBServer",
+			IsArray: true,
+			Fields: []*schema.FieldDesc{
+				{
+					Name: "IPAddress",
+					Type: meta.TypeString,
+					Tags: &schema.FieldTags{
+						Validate: "required,ipv4",
+					},
+				},
+				{
+					Name: "Port",
+					Type: meta.TypeInt,
+					Tags: &schema.FieldTags{
+						Validate: "min=0,max=65535",
+					},
+				},
+				{Name: "Enabled", Type: meta.TypeFlag},
+			},
+		},
+		Tags: &schema.FieldTags{
+			MapConv:  "Settings.ProxyLB.[]Servers,recursive",
+			Validate: "min=0,max=40",
+		},
+	}
+}
+
+func (f *fieldsDef) ProxyLBLetsEncrypt() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "LetsEncrypt",
+		Type: &schema.Model{
+			Name: "ProxyLBLetsEncrypt",
+			Fields: []*schema.FieldDesc{
+				{Name: "CommonName", Type: meta.TypeString},
+				{Name: "Enabled", Type: meta.TypeFlag},
+			},
+		},
+		Tags: &schema.FieldTags{
+			MapConv: "Settings.ProxyLB.LetsEncrypt,recursive",
+		},
+	}
+}
+
+func (f *fieldsDef) ProxyLBStickySession() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "StickySession",
+		Type: &schema.Model{
+			Name: "ProxyLBStickySession",
+			Fields: []*schema.FieldDesc{
+				{Name: "Enabled", Type: meta.TypeFlag},
+				{
+					Name: "Method",
+					Type: meta.TypeString,
+					Tags: &schema.FieldTags{
+						Validate: "omitempty,oneof=cookie",
+					},
+				},
+			},
+		},
+		Tags: &schema.FieldTags{
+			MapConv: "Settings.ProxyLB.StickySession,recursive",
+		},
+	}
+}
+
+func (f *fieldsDef) ProxyLBTimeout() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "Timeout",
+		Type: &schema.Model{
+			Name: "ProxyLBTimeout",
+			Fields: []*schema.FieldDesc{
+				{
+					Name: "InactiveSec",
+					Type: meta.TypeInt,
+					Tags: &schema.FieldTags{
+						Validate: "omitempty,min=10,max=600",
+					},
+				},
+			},
+		},
+		Tags: &schema.FieldTags{
+			MapConv: "Settings.ProxyLB.Timeout,recursive",
+		},
+	}
+}
+
+func (f *fieldsDef) ProxyLBUseVIPFailover() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "UseVIPFailover",
+		Type: meta.TypeFlag,
+		Tags: &schema.FieldTags{
+			MapConv: "Status.UseVIPFailover",
+		},
+	}
+}
+
+func (f *fieldsDef) ProxyLBRegion() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "Region",
+		Type: meta.TypeProxyLBRegion,
+		Tags: &schema.FieldTags{
+			MapConv:  "Status.Region",
+			Validate: "omitempty,oneof=tk1 is1 anycast",
+		},
+	}
+}
+
+func (f *fieldsDef) ProxyLBFQDN() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "FQDN",
+		Type: meta.TypeString,
+		Tags: &schema.FieldTags{
+			MapConv: "Status.FQDN",
+		},
+	}
+}
+
+func (f *fieldsDef) ProxyLBVirtualIPAddress() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "VirtualIPAddress",
+		Type: meta.TypeString,
+		Tags: &schema.FieldTags{
+			MapConv: "Status.VirtualIPAddress",
+		},
+	}
+}
+
+func (f *fieldsDef) ProxyLBProxyNetworks() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "ProxyNetworks",
+		Type: meta.TypeStringSlice,
+		Tags: &schema.FieldTags{
+			MapConv: "Status.ProxyNetworks",
+		},
+	}
+}
+
+func (f *fieldsDef) PlanName() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "PlanName",
+		Type: meta.TypeString,
+		Tags: &schema.FieldTags{
+			MapConv: "Plan.Name",
+		},
+	}
+}
+
+func (f *fieldsDef) PrivateHostPlanClass() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "PlanClass",
+		Type: meta.TypeString,
+		Tags: &schema.FieldTags{
+			MapConv: "Plan.Class",
+		},
+	}
+}
+
+func (f *fieldsDef) PrivateHostPlanCPU() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "CPU",
+		Type: meta.TypeInt,
+		Tags: &schema.FieldTags{
+			MapConv: "Plan.CPU",
+		},
+	}
+}
+
+func (f *fieldsDef) PrivateHostPlanMemoryMB() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "MemoryMB",
+		Type: meta.TypeInt,
+		Tags: &schema.FieldTags{
+			MapConv: "Plan.MemoryMB",
+		},
+		ExtendAccessors: []*schema.ExtendAccessor{
+			{
+				Name: "MemoryGB",
+				Type: meta.TypeInt,
+			},
+		},
+	}
+}
+
+func (f *fieldsDef) PrivateHostAssignedCPU() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "AssignedCPU",
+		Type: meta.TypeInt,
+	}
+}
+
+func (f *fieldsDef) PrivateHostAssignedMemoryMB() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "AssignedMemoryMB",
+		Type: meta.TypeInt,
+		ExtendAccessors: []*schema.ExtendAccessor{
+			{
+				Name: "AssignedMemoryGB",
+				Type: meta.TypeInt,
+			},
+		},
+	}
+}
+
+func (f *fieldsDef) PrivateHostHostName() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "HostName",
+		Type: meta.TypeString,
+		Tags: &schema.FieldTags{
+			MapConv: "Host.Name",
+		},
+	}
+}
+
+func (f *fieldsDef) LicenseInfoID() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "LicenseInfoID",
+		Type: meta.TypeID,
+		Tags: &schema.FieldTags{
+			MapConv: "LicenseInfo.ID",
+		},
+	}
+}
+
+func (f *fieldsDef) LicenseInfoName() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "LicenseInfoName",
+		Type: meta.TypeString,
+		Tags: &schema.FieldTags{
+			MapConv: "LicenseInfo.Name",
+		},
+	}
+}
+
+func (f *fieldsDef) SettingsHash() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "SettingsHash",
+		Type: meta.TypeString,
+	}
+}
+
+func (f *fieldsDef) InstanceHostName() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "InstanceHostName",
+		Type: meta.TypeString,
+		Tags: &schema.FieldTags{
+			MapConv: "Instance.Host.Name",
+		},
+	}
+}
+
+func (f *fieldsDef) InstanceHostInfoURL() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "InstanceHostInfoURL",
+		Type: meta.TypeString,
+		Tags: &schema.FieldTags{
+			MapConv: "Instance.Host.InfoURL",
+		},
+	}
+}
+
+func (f *fieldsDef) InstanceStatus() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "InstanceStatus",
+		Type: meta.TypeInstanceStatus,
+		Tags: &schema.FieldTags{
+			MapConv: "Instance.Status",
+		},
+	}
+}
+
+func (f *fieldsDef) InstanceBeforeStatus() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "InstanceBeforeStatus",
+		Type: meta.TypeInstanceStatus,
+		Tags: &schema.FieldTags{
+			MapConv: "Instance.BeforeStatus",
+		},
+	}
+}
+
+func (f *fieldsDef) InstanceStatusChangedAt() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "InstanceStatusChangedAt",
+		Type: meta.TypeTime,
+		Tags: &schema.FieldTags{
+			MapConv: "Instance.StatusChangedAt",
+		},
+	}
+}
+
+func (f *fieldsDef) InstanceWarnings() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "InstanceWarnings",
+		Type: meta.TypeString,
+		Tags: &schema.FieldTags{
+			MapConv: "Instance.Warnings",
+		},
+	}
+}
+
+func (f *fieldsDef) InstanceWarningsValue() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "InstanceWarningsValue",
+		Type: meta.TypeInt,
+		Tags: &schema.FieldTags{
+			MapConv: "Instance.WarningsValue",
+		},
+	}
+}
+
+func (f *fieldsDef) Interfaces() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "Interfaces",
+		Type: models.interfaceModel(),
+		Tags: &schema.FieldTags{
+			JSON:    ",omitempty",
+			MapConv: "[]Interfaces,recursive,omitempty",
+		},
+	}
+}
+
+func (f *fieldsDef) VPCRouterInterfaces() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "Interfaces",
+		Type: models.vpcRouterInterfaceModel(),
+		Tags: &schema.FieldTags{
+			JSON:    ",omitempty",
+			MapConv: "[]Interfaces,recursive,omitempty",
+		},
+	}
+}
+
+func (f *fieldsDef) MobileGatewayInterfaces() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "Interfaces",
+		Type: models.mobileGatewayInterfaceModel(),
+		Tags: &schema.FieldTags{
+			JSON:    ",omitempty",
+			MapConv: "[]Interfaces,recursive,omitempty",
+		},
+	}
+}
+
+func (f *fieldsDef) NoteClass() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "Class",
+		Type: meta.TypeString,
+	}
+}
+
+func (f *fieldsDef) NoteContent() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "Content",
+		Type: meta.TypeString,
+	}
+}
+
+func (f *fieldsDef) SSHKeyPublicKey() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "PublicKey",
+		Type: meta.TypeString,
+	}
+}
+
+func (f *fieldsDef) SSHKeyPrivateKey() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "PrivateKey",
+		Type: meta.TypeString,
+	}
+}
+
+func (f *fieldsDef) SSHKeyFingerprint() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "Fingerprint",
+		Type: meta.TypeString,
+	}
+}
+
+func (f *fieldsDef) Description() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "Description",
+		Type: meta.TypeString,
+		Tags: &schema.FieldTags{
+			Validate: "min=0,max=512",
+		},
+	}
+}
+
+func (f *fieldsDef) Availability() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "Availability",
+		Type: meta.TypeAvailability,
+	}
+}
+
+func (f *fieldsDef) Scope() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "Scope",
+		Type: meta.TypeScope,
+	}
+}
+
+func (f *fieldsDef) BandWidthMbps() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "BandWidthMbps",
+		Type: meta.TypeInt,
+	}
+}
+
+func (f *fieldsDef) DiskConnection() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "Connection",
+		Type: meta.TypeDiskConnection,
+		Tags: &schema.FieldTags{
+			JSON:    ",omitempty",
+			MapConv: ",omitempty",
+		},
+	}
+}
+
+func (f *fieldsDef) DiskConnectionOrder() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "ConnectionOrder",
+		Type: meta.TypeInt,
+	}
+}
+
+func (f *fieldsDef) DiskReinstallCount() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "ReinstallCount",
+		Type: meta.TypeInt,
+	}
+}
+
+func (f *fieldsDef) SizeMB() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "SizeMB",
+		Type: meta.TypeInt,
+		ExtendAccessors: []*schema.ExtendAccessor{
+			{Name: "SizeGB"},
+		},
+	}
+}
+
+func (f *fieldsDef) MigratedMB() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "MigratedMB",
+		Type: meta.TypeInt,
+		ExtendAccessors: []*schema.ExtendAccessor{
+			{Name: "MigratedGB"},
+		},
+	}
+}
+
+func (f *fieldsDef) DefaultRoute() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "DefaultRoute",
+		Type: meta.TypeString,
+		Tags: &schema.FieldTags{
+			Validate: "ipv4",
+		},
+	}
+}
+
+func (f *fieldsDef) NextHop() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "NextHop",
+		Type: meta.TypeString,
+		Description: `
+			スイッチ+ルータでの追加IPアドレスブロックを示すSubnetの中でのみ設定される項目。
+			この場合DefaultRouteの値は設定されないためNextHopを代用する。
+			StaticRouteと同じ値が設定される。`,
+		Tags: &schema.FieldTags{
+			Validate: "ipv4",
+		},
+	}
+}
+
+func (f *fieldsDef) StaticRoute() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "StaticRoute",
+		Type: meta.TypeString,
+		Description: `
+			スイッチ+ルータでの追加IPアドレスブロックを示すSubnetの中でのみ設定される項目。
+			この場合DefaultRouteの値は設定されないためNextHopを代用する。
+			NextHopと同じ値が設定される。`,
 		Tags: &schema.FieldTags{
 			Validate: "ipv4",
 		},
@@ -1098,6 +1894,20 @@ func (f *fieldsDef) IPAddress() *schema.FieldDesc {
 	}
 }
 
+func (f *fieldsDef) IPv6Prefix() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "IPv6Prefix",
+		Type: meta.TypeString,
+	}
+}
+
+func (f *fieldsDef) IPv6PrefixLen() *schema.FieldDesc {
+	return &schema.FieldDesc{
+		Name: "IPv6PrefixLen",
+		Type: meta.TypeInt,
+	}
+}
+
 func (f *fieldsDef) UserIPAddress() *schema.FieldDesc {
 	return &schema.FieldDesc{
 		Name: "UserIPAddress",
@@ -1213,7 +2023,7 @@ func (f *fieldsDef) ModifiedAt() *schema.FieldDesc {
 }
 
 /*
- for monitor
+for monitor
 */
 func (f *fieldsDef) MonitorTime() *schema.FieldDesc {
 	return &schema.FieldDesc{
@@ -0,0 +1,98 @@
+package define
+
+import (
+	"net/http"
+
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var iconAPI = &schema.Resource{
+	Name:       "Icon",
+	PathName:   "icon",
+	PathSuffix: schema.CloudAPISuffix,
+	IsGlobal:   true,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			// find
+			r.DefineOperationFind(iconNakedType, findParameter, iconView),
+
+			// create
+			r.DefineOperationCreate(iconNakedType, iconCreateParam, iconView),
+
+			// read
+			r.DefineOperationRead(iconNakedType, iconView),
+
+			// update
+			r.DefineOperationUpdate(iconNakedType, iconUpdateParam, iconView),
+
+			// delete
+			r.DefineOperationDelete(),
+
+			// get image
+			r.DefineOperation("GetImage").
+				Method(http.MethodGet).
+				PathFormat(schema.DefaultPathFormatWithID).
+				RequestEnvelope(&schema.EnvelopePayloadDesc{
+					PayloadType: meta.TypeIconSize,
+					PayloadName: "Size",
+				}).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				Argument(&schema.Argument{
+					Name:       "size",
+					Type:       meta.TypeIconSize,
+					MapConvTag: "Size",
+				}).
+				ResultFromEnvelope(iconImageView, &schema.EnvelopePayloadDesc{
+					PayloadType: iconNakedType,
+					PayloadName: "Icon",
+				}),
+		}
+	},
+}
+
+var (
+	iconNakedType = meta.Static(naked.Icon{})
+
+	iconView = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			fields.Name(),
+			fields.Tags(),
+			fields.Availability(),
+			fields.Scope(),
+			fields.IconURL(),
+			fields.CreatedAt(),
+			fields.ModifiedAt(),
+		},
+	}
+
+	iconCreateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.Name(),
+			fields.Tags(),
+			fields.IconImage(),
+		},
+	}
+
+	iconUpdateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.Name(),
+			fields.Tags(),
+		},
+	}
+
+	iconImageView = &schema.Model{
+		Name:      "IconImage",
+		NakedType: iconNakedType,
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			{
+				Name: "Image",
+				Type: meta.TypeString,
+			},
+		},
+	}
+)
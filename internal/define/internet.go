@@ -85,7 +85,24 @@ var internetAPI = &schema.Resource{
 			// monitor
 			r.DefineOperationMonitor(monitorParameter, monitors.routerModel()),
 
-			// TODO IPv6関連は後回し
+			// EnableIPv6
+			r.DefineOperation("EnableIPv6").
+				Method(http.MethodPost).
+				PathFormat(schema.IDAndSuffixPathFormat("ipv6net")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				ResultFromEnvelope(models.ipv6NetInfo(), &schema.EnvelopePayloadDesc{
+					PayloadType: meta.Static(naked.IPv6Net{}),
+					PayloadName: "IPv6Net",
+				}),
+
+			// DisableIPv6
+			r.DefineSimpleOperation("DisableIPv6", http.MethodDelete, "ipv6net/{{.ipv6netID}}",
+				&schema.Argument{
+					Name: "ipv6netID",
+					Type: meta.TypeID,
+				},
+			),
 		}
 	},
 }
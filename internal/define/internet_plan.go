@@ -0,0 +1,32 @@
+package define
+
+import (
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var internetPlanAPI = &schema.Resource{
+	Name:       "InternetPlan",
+	PathName:   "product/internet",
+	PathSuffix: schema.CloudAPISuffix,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			r.DefineOperationFind(internetPlanNakedType, findParameter, internetPlanView),
+			r.DefineOperationRead(internetPlanNakedType, internetPlanView),
+		}
+	},
+}
+
+var (
+	internetPlanNakedType = meta.Static(naked.InternetPlan{})
+
+	internetPlanView = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			fields.Name(),
+			fields.BandWidthMbps(),
+			fields.Availability(),
+		},
+	}
+)
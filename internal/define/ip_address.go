@@ -0,0 +1,82 @@
+package define
+
+import (
+	"net/http"
+
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var ipAddressAPI = &schema.Resource{
+	Name:       "IPAddress",
+	PathName:   "ipaddress",
+	PathSuffix: schema.CloudAPISuffix,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			// find
+			r.DefineOperationFind(ipAddressNakedType, findParameter, ipAddressView),
+
+			// read
+			r.DefineOperation("Read").
+				Method(http.MethodGet).
+				PathFormat(ipAddressPathFormat).
+				Argument(schema.ArgumentZone).
+				Argument(ipAddressArgument).
+				ResultFromEnvelope(ipAddressView, &schema.EnvelopePayloadDesc{
+					PayloadType: ipAddressNakedType,
+					PayloadName: "IPAddress",
+				}),
+
+			// UpdateHostName 逆引き(PTRレコード)の設定
+			r.DefineOperation("UpdateHostName").
+				Method(http.MethodPut).
+				PathFormat(ipAddressPathFormat).
+				RequestEnvelope(&schema.EnvelopePayloadDesc{
+					PayloadType: ipAddressNakedType,
+					PayloadName: "IPAddress",
+				}).
+				Argument(schema.ArgumentZone).
+				Argument(ipAddressArgument).
+				Argument(&schema.Argument{
+					Name:       "hostName",
+					Type:       meta.TypeString,
+					MapConvTag: "IPAddress.HostName",
+				}).
+				ResultFromEnvelope(ipAddressView, &schema.EnvelopePayloadDesc{
+					PayloadType: ipAddressNakedType,
+					PayloadName: "IPAddress",
+				}),
+		}
+	},
+}
+
+var (
+	ipAddressNakedType  = meta.Static(naked.IPAddress{})
+	ipAddressPathFormat = schema.DefaultPathFormat + "/{{.ipAddress}}"
+	ipAddressArgument   = &schema.Argument{
+		Name: "ipAddress",
+		Type: meta.TypeString,
+	}
+
+	ipAddressView = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.HostName(),
+			fields.IPAddress(),
+			{
+				Name: "InterfaceID",
+				Type: meta.TypeID,
+				Tags: &schema.FieldTags{
+					MapConv: "Interface.ID,omitempty",
+				},
+			},
+			{
+				Name: "SubnetID",
+				Type: meta.TypeID,
+				Tags: &schema.FieldTags{
+					MapConv: "Subnet.ID,omitempty",
+				},
+			},
+		},
+	}
+)
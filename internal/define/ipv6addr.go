@@ -0,0 +1,119 @@
+package define
+
+import (
+	"net/http"
+
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var ipv6AddrAPI = &schema.Resource{
+	Name:       "IPv6Addr",
+	PathName:   "ipv6addr",
+	PathSuffix: schema.CloudAPISuffix,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			// find
+			r.DefineOperationFind(ipv6AddrNakedType, findParameter, ipv6AddrView),
+
+			// create
+			r.DefineOperationCreate(ipv6AddrNakedType, ipv6AddrCreateParam, ipv6AddrView),
+
+			// read
+			r.DefineOperation("Read").
+				Method(http.MethodGet).
+				PathFormat(ipv6AddrPathFormat).
+				Argument(schema.ArgumentZone).
+				Argument(ipv6AddrArgument).
+				ResultFromEnvelope(ipv6AddrView, &schema.EnvelopePayloadDesc{
+					PayloadType: ipv6AddrNakedType,
+					PayloadName: "IPv6Addr",
+				}),
+
+			// update
+			r.DefineOperation("Update").
+				Method(http.MethodPut).
+				PathFormat(ipv6AddrPathFormat).
+				RequestEnvelope(&schema.EnvelopePayloadDesc{
+					PayloadType: ipv6AddrNakedType,
+					PayloadName: "IPv6Addr",
+				}).
+				Argument(schema.ArgumentZone).
+				Argument(ipv6AddrArgument).
+				MappableArgument("param", ipv6AddrUpdateParam).
+				ResultFromEnvelope(ipv6AddrView, &schema.EnvelopePayloadDesc{
+					PayloadType: ipv6AddrNakedType,
+					PayloadName: "IPv6Addr",
+				}),
+
+			// delete
+			r.DefineOperation("Delete").
+				Method(http.MethodDelete).
+				PathFormat(ipv6AddrPathFormat).
+				Argument(schema.ArgumentZone).
+				Argument(ipv6AddrArgument),
+		}
+	},
+}
+
+var (
+	ipv6AddrNakedType  = meta.Static(naked.IPv6Addr{})
+	ipv6AddrPathFormat = schema.DefaultPathFormat + "/{{.ipv6addr}}"
+	ipv6AddrArgument   = &schema.Argument{
+		Name: "ipv6addr",
+		Type: meta.TypeString,
+	}
+
+	ipv6AddrView = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "IPv6Addr",
+				Type: meta.TypeString,
+			},
+			fields.HostName(),
+			{
+				Name: "IPv6NetID",
+				Type: meta.TypeID,
+				Tags: &schema.FieldTags{
+					MapConv: "IPv6Net.ID,omitempty",
+				},
+			},
+			{
+				Name: "SwitchID",
+				Type: meta.TypeID,
+				Tags: &schema.FieldTags{
+					MapConv: "IPv6Net.Switch.ID,omitempty",
+				},
+			},
+			{
+				Name: "InterfaceID",
+				Type: meta.TypeID,
+				Tags: &schema.FieldTags{
+					MapConv: "Interface.ID,omitempty",
+				},
+			},
+		},
+	}
+
+	ipv6AddrCreateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "IPv6Addr",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					Validate: "required,ipv6",
+				},
+			},
+			fields.HostName(),
+		},
+	}
+
+	ipv6AddrUpdateParam = &schema.Model{
+		Name:      "IPv6AddrUpdateRequest",
+		NakedType: ipv6AddrNakedType,
+		Fields: []*schema.FieldDesc{
+			fields.HostName(),
+		},
+	}
+)
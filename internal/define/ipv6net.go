@@ -0,0 +1,48 @@
+package define
+
+import (
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var ipv6NetAPI = &schema.Resource{
+	Name:       "IPv6Net",
+	PathName:   "ipv6net",
+	PathSuffix: schema.CloudAPISuffix,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			r.DefineOperationFind(ipv6NetNakedType, findParameter, ipv6NetView),
+			r.DefineOperationRead(ipv6NetNakedType, ipv6NetView),
+		}
+	},
+}
+
+var (
+	ipv6NetNakedType = meta.Static(naked.IPv6Net{})
+
+	ipv6NetView = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			fields.IPv6Prefix(),
+			fields.IPv6PrefixLen(),
+			{
+				Name: "IPv6PrefixTail",
+				Type: meta.TypeString,
+			},
+			{
+				Name: "IPv6TableID",
+				Type: meta.TypeID,
+				Tags: &schema.FieldTags{
+					MapConv: "IPv6Table.ID",
+				},
+			},
+			{
+				Name: "NamedIPv6AddrCount",
+				Type: meta.TypeInt,
+			},
+			fields.CreatedAt(),
+			fields.SwitchID(),
+		},
+	}
+)
@@ -0,0 +1,60 @@
+package define
+
+import (
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var licenseAPI = &schema.Resource{
+	Name:       "License",
+	PathName:   "license",
+	PathSuffix: schema.CloudAPISuffix,
+	IsGlobal:   true,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			// find
+			r.DefineOperationFind(licenseNakedType, findParameter, licenseView),
+
+			// create
+			r.DefineOperationCreate(licenseNakedType, licenseCreateParam, licenseView),
+
+			// read
+			r.DefineOperationRead(licenseNakedType, licenseView),
+
+			// update
+			r.DefineOperationUpdate(licenseNakedType, licenseUpdateParam, licenseView),
+
+			// delete
+			r.DefineOperationDelete(),
+		}
+	},
+}
+
+var (
+	licenseNakedType = meta.Static(naked.License{})
+
+	licenseView = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			fields.Name(),
+			fields.LicenseInfoID(),
+			fields.LicenseInfoName(),
+			fields.CreatedAt(),
+			fields.ModifiedAt(),
+		},
+	}
+
+	licenseCreateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.LicenseInfoID(),
+			fields.Name(),
+		},
+	}
+
+	licenseUpdateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.Name(),
+		},
+	}
+)
@@ -0,0 +1,35 @@
+package define
+
+import (
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var licensePlanAPI = &schema.Resource{
+	Name:       "LicensePlan",
+	PathName:   "product/license",
+	PathSuffix: schema.CloudAPISuffix,
+	IsGlobal:   true,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			r.DefineOperationFind(licensePlanNakedType, findParameter, licensePlanView),
+			r.DefineOperationRead(licensePlanNakedType, licensePlanView),
+		}
+	},
+}
+
+var (
+	licensePlanNakedType = meta.Static(naked.LicensePlan{})
+
+	licensePlanView = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			fields.Name(),
+			{
+				Name: "TermsOfUse",
+				Type: meta.TypeString,
+			},
+		},
+	}
+)
@@ -0,0 +1,542 @@
+package define
+
+import (
+	"net/http"
+
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var mobileGatewayAPI = &schema.Resource{
+	Name:       "MobileGateway",
+	PathName:   "appliance",
+	PathSuffix: schema.CloudAPISuffix,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			// find
+			r.DefineOperationApplianceFind(mobileGatewayNakedType, findParameter, mobileGatewayView),
+
+			// create
+			r.DefineOperationApplianceCreate(mobileGatewayNakedType, mobileGatewayCreateParam, mobileGatewayView),
+
+			// read
+			r.DefineOperationApplianceRead(mobileGatewayNakedType, mobileGatewayView),
+
+			// update
+			r.DefineOperationApplianceUpdate(mobileGatewayNakedType, mobileGatewayUpdateParam, mobileGatewayView),
+
+			// delete
+			r.DefineOperationDelete(),
+
+			// config
+			r.DefineOperationConfig(),
+
+			// power management(boot/shutdown/reset)
+			r.DefineOperationBoot(),
+			r.DefineOperationShutdown(),
+			r.DefineOperationReset(),
+
+			// connect to switch(eth1)
+			r.DefineSimpleOperation("ConnectToSwitch", http.MethodPut, "interface/1/to/switch/{{.switchID}}",
+				&schema.Argument{
+					Name: "switchID",
+					Type: meta.TypeID,
+				},
+			),
+
+			// disconnect from switch(eth1)
+			r.DefineSimpleOperation("DisconnectFromSwitch", http.MethodDelete, "interface/1/to/switch"),
+
+			// GetDNS
+			r.DefineOperation("GetDNS").
+				Method(http.MethodGet).
+				PathFormat(schema.IDAndSuffixPathFormat("mobilegateway/dnsresolver")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				ResultFromEnvelope(mobileGatewayDNSModel, &schema.EnvelopePayloadDesc{
+					PayloadName: "SIMGroup",
+					PayloadType: meta.Static(naked.MobileGatewaySIMGroup{}),
+					Tags: &schema.FieldTags{
+						JSON: "sim_group,omitempty",
+					},
+				}),
+
+			// SetDNS
+			r.DefineOperation("SetDNS").
+				Method(http.MethodPut).
+				PathFormat(schema.IDAndSuffixPathFormat("mobilegateway/dnsresolver")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				RequestEnvelope(&schema.EnvelopePayloadDesc{
+					PayloadName: "SIMGroup",
+					PayloadType: meta.Static(naked.MobileGatewaySIMGroup{}),
+					Tags: &schema.FieldTags{
+						JSON: "sim_group,omitempty",
+					},
+				}).
+				Argument(&schema.Argument{
+					Name:       "param",
+					Type:       mobileGatewayDNSModel,
+					MapConvTag: "sim_group,recursive",
+				}),
+
+			// ListSIM
+			r.DefineOperation("ListSIM").
+				Method(http.MethodGet).
+				PathFormat(schema.IDAndSuffixPathFormat("mobilegateway/sims")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				ResultPluralFromEnvelope(mobileGatewaySIMInfoView, &schema.EnvelopePayloadDesc{
+					PayloadName: "SIM",
+					PayloadType: meta.Static(naked.SIMInfo{}),
+					Tags: &schema.FieldTags{
+						JSON: "sim,omitempty",
+					},
+				}),
+
+			// AddSIM
+			r.DefineOperation("AddSIM").
+				Method(http.MethodPost).
+				PathFormat(schema.IDAndSuffixPathFormat("mobilegateway/sims")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				RequestEnvelope(&schema.EnvelopePayloadDesc{
+					PayloadName: "SIM",
+					PayloadType: meta.Static(naked.SIMInfo{}),
+					Tags: &schema.FieldTags{
+						JSON: "sim,omitempty",
+					},
+				}).
+				Argument(&schema.Argument{
+					Name:       "param",
+					Type:       mobileGatewayAddSIMParam,
+					MapConvTag: "sim,recursive",
+				}),
+
+			// DeleteSIM
+			r.DefineSimpleOperation("DeleteSIM", http.MethodDelete, "mobilegateway/sims/{{.simID}}",
+				&schema.Argument{
+					Name: "simID",
+					Type: meta.TypeID,
+				},
+			),
+
+			// GetSIMRoutes
+			r.DefineOperation("GetSIMRoutes").
+				Method(http.MethodGet).
+				PathFormat(schema.IDAndSuffixPathFormat("mobilegateway/simroutes")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				ResultPluralFromEnvelope(mobileGatewaySIMRouteModel, &schema.EnvelopePayloadDesc{
+					PayloadName: "SIMRoutes",
+					PayloadType: meta.Static(naked.MobileGatewaySIMRoute{}),
+					Tags: &schema.FieldTags{
+						JSON: "sim_routes,omitempty",
+					},
+				}),
+
+			// SetSIMRoutes
+			r.DefineOperation("SetSIMRoutes").
+				Method(http.MethodPut).
+				PathFormat(schema.IDAndSuffixPathFormat("mobilegateway/simroutes")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				RequestEnvelopePlural(&schema.EnvelopePayloadDesc{
+					PayloadName: "SIMRoutes",
+					PayloadType: meta.Static(naked.MobileGatewaySIMRoute{}),
+					Tags: &schema.FieldTags{
+						JSON: "sim_routes",
+					},
+				}).
+				Argument(&schema.Argument{
+					Name:       "routes",
+					Type:       mobileGatewaySIMRoutesParam,
+					MapConvTag: "[]sim_routes,recursive",
+				}),
+
+			// GetTrafficConfig
+			r.DefineOperation("GetTrafficConfig").
+				Method(http.MethodGet).
+				PathFormat(schema.IDAndSuffixPathFormat("mobilegateway/traffic_monitoring")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				ResultFromEnvelope(mobileGatewayTrafficControlModel, &schema.EnvelopePayloadDesc{
+					PayloadName: "TrafficMonitoring",
+					PayloadType: meta.Static(naked.TrafficMonitoringConfig{}),
+					Tags: &schema.FieldTags{
+						JSON: "traffic_monitoring_config,omitempty",
+					},
+				}),
+
+			// SetTrafficConfig
+			r.DefineOperation("SetTrafficConfig").
+				Method(http.MethodPut).
+				PathFormat(schema.IDAndSuffixPathFormat("mobilegateway/traffic_monitoring")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				RequestEnvelope(&schema.EnvelopePayloadDesc{
+					PayloadName: "TrafficMonitoring",
+					PayloadType: meta.Static(naked.TrafficMonitoringConfig{}),
+					Tags: &schema.FieldTags{
+						JSON: "traffic_monitoring_config,omitempty",
+					},
+				}).
+				Argument(&schema.Argument{
+					Name:       "param",
+					Type:       mobileGatewayTrafficControlModel,
+					MapConvTag: "traffic_monitoring_config,recursive",
+				}),
+
+			// DeleteTrafficConfig
+			r.DefineSimpleOperation("DeleteTrafficConfig", http.MethodDelete, "mobilegateway/traffic_monitoring"),
+
+			// TrafficStatus
+			r.DefineOperation("TrafficStatus").
+				Method(http.MethodGet).
+				PathFormat(schema.IDAndSuffixPathFormat("mobilegateway/traffic_status")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				ResultFromEnvelope(mobileGatewayTrafficStatusView, &schema.EnvelopePayloadDesc{
+					PayloadName: "TrafficStatus",
+					PayloadType: meta.Static(naked.TrafficStatus{}),
+					Tags: &schema.FieldTags{
+						JSON: "traffic_status,omitempty",
+					},
+				}),
+
+			// monitor
+			r.DefineOperationMonitorChildBy("Interface", "interface",
+				monitorParameter, monitors.interfaceModel()),
+		}
+	},
+}
+
+var (
+	mobileGatewayNakedType = meta.Static(naked.MobileGateway{})
+
+	mobileGatewayView = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			fields.Name(),
+			fields.Description(),
+			fields.Tags(),
+			fields.Availability(),
+			fields.Class(),
+			fields.IconID(),
+			fields.CreatedAt(),
+			// instance
+			fields.InstanceHostName(),
+			fields.InstanceHostInfoURL(),
+			fields.InstanceStatus(),
+			fields.InstanceStatusChangedAt(),
+			// interfaces
+			fields.MobileGatewayInterfaces(),
+			// remark
+			fields.RemarkZoneID(),
+			// settings
+			{
+				Name: "Settings",
+				Type: models.mobileGatewaySetting(),
+				Tags: &schema.FieldTags{
+					MapConv: ",omitempty,recursive",
+				},
+			},
+			fields.SettingsHash(),
+		},
+	}
+
+	mobileGatewayCreateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.MobileGatewayClass(),
+			fields.Name(),
+			fields.Description(),
+			fields.Tags(),
+			fields.IconID(),
+			{
+				Name: "PlanID",
+				Type: meta.TypeID,
+				Tags: &schema.FieldTags{
+					MapConv: "Plan.ID,default=1",
+				},
+			},
+			{
+				Name: "SwitchScope",
+				Type: meta.TypeScope,
+				Tags: &schema.FieldTags{
+					MapConv: "Remark.Switch.Scope,default=shared",
+				},
+			},
+			{
+				Name: "Settings",
+				Type: models.mobileGatewaySettingCreate(),
+				Tags: &schema.FieldTags{
+					MapConv: ",omitempty,recursive",
+				},
+			},
+		},
+	}
+
+	mobileGatewayUpdateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.Name(),
+			fields.Description(),
+			fields.Tags(),
+			fields.IconID(),
+			{
+				Name: "Settings",
+				Type: models.mobileGatewaySetting(),
+				Tags: &schema.FieldTags{
+					MapConv: ",omitempty,recursive",
+				},
+			},
+		},
+	}
+
+	mobileGatewayDNSModel = &schema.Model{
+		Name:      "MobileGatewayDNSSetting",
+		NakedType: meta.Static(naked.MobileGatewaySIMGroup{}),
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "DNS1",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv:  "dns_1",
+					Validate: "ipv4",
+				},
+			},
+			{
+				Name: "DNS2",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv:  "dns_2",
+					Validate: "ipv4",
+				},
+			},
+		},
+	}
+
+	mobileGatewaySIMInfoView = &schema.Model{
+		Name:      "MobileGatewaySIMInfo",
+		NakedType: meta.Static(naked.SIMInfo{}),
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "ICCID",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv: "iccid",
+				},
+			},
+			{
+				Name: "IMSI",
+				Type: meta.TypeStringSlice,
+				Tags: &schema.FieldTags{
+					MapConv: "imsi",
+				},
+			},
+			{
+				Name: "IP",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv: "ip",
+				},
+			},
+			{
+				Name: "SessionStatus",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv: "session_status",
+				},
+			},
+			{
+				Name: "IMEILock",
+				Type: meta.TypeFlag,
+				Tags: &schema.FieldTags{
+					MapConv: "imei_lock",
+				},
+			},
+			{
+				Name: "Registered",
+				Type: meta.TypeFlag,
+				Tags: &schema.FieldTags{
+					MapConv: "registered",
+				},
+			},
+			{
+				Name: "Activated",
+				Type: meta.TypeFlag,
+				Tags: &schema.FieldTags{
+					MapConv: "activated",
+				},
+			},
+			{
+				Name: "ResourceID",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv: "resource_id",
+				},
+			},
+			{
+				Name: "RegisteredDate",
+				Type: meta.TypeTime,
+				Tags: &schema.FieldTags{
+					MapConv: "registered_date",
+				},
+			},
+			{
+				Name: "ActivatedDate",
+				Type: meta.TypeTime,
+				Tags: &schema.FieldTags{
+					MapConv: "activated_date",
+				},
+			},
+			{
+				Name: "DeactivatedDate",
+				Type: meta.TypeTime,
+				Tags: &schema.FieldTags{
+					MapConv: "deactivated_date",
+				},
+			},
+			{
+				Name: "SIMGroupID",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv: "simgroup_id",
+				},
+			},
+			{
+				Name: "ConnectedIMEI",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv: "connected_imei",
+				},
+			},
+		},
+	}
+
+	mobileGatewayAddSIMParam = &schema.Model{
+		Name:      "MobileGatewayAddSIMRequest",
+		NakedType: meta.Static(naked.SIMInfo{}),
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "SIMID",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv: "resource_id",
+				},
+			},
+		},
+	}
+
+	mobileGatewaySIMRouteModel = &schema.Model{
+		Name:      "MobileGatewaySIMRoute",
+		NakedType: meta.Static(naked.MobileGatewaySIMRoute{}),
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "ResourceID",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv: "resource_id",
+				},
+			},
+			{
+				Name: "Prefix",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv: "prefix",
+				},
+			},
+			{
+				Name: "ICCID",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv: "iccid,omitempty",
+				},
+			},
+		},
+	}
+
+	mobileGatewaySIMRoutesParam = &schema.Model{
+		Name:      mobileGatewaySIMRouteModel.Name,
+		NakedType: mobileGatewaySIMRouteModel.NakedType,
+		Fields:    mobileGatewaySIMRouteModel.Fields,
+		IsArray:   true,
+	}
+
+	mobileGatewayTrafficControlModel = &schema.Model{
+		Name:      "MobileGatewayTrafficControl",
+		NakedType: meta.Static(naked.TrafficMonitoringConfig{}),
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "TrafficQuotaInMB",
+				Type: meta.TypeInt,
+				Tags: &schema.FieldTags{
+					MapConv: "traffic_quota_in_mb",
+				},
+			},
+			{
+				Name: "BandWidthLimitInKbps",
+				Type: meta.TypeInt,
+				Tags: &schema.FieldTags{
+					MapConv: "bandwidth_limit_in_kbps",
+				},
+			},
+			{
+				Name: "EmailNotifyEnabled",
+				Type: meta.TypeFlag,
+				Tags: &schema.FieldTags{
+					MapConv: "email_config.enabled",
+				},
+			},
+			{
+				Name: "SlackNotifyEnabled",
+				Type: meta.TypeFlag,
+				Tags: &schema.FieldTags{
+					MapConv: "slack_config.enabled",
+				},
+			},
+			{
+				Name: "SlackNotifyWebhooksURL",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv: "slack_config.slack_url",
+				},
+			},
+			{
+				Name: "AutoTrafficShaping",
+				Type: meta.TypeFlag,
+				Tags: &schema.FieldTags{
+					MapConv: "auto_traffic_shaping",
+				},
+			},
+		},
+	}
+
+	mobileGatewayTrafficStatusView = &schema.Model{
+		Name:      "MobileGatewayTrafficStatus",
+		NakedType: meta.Static(naked.TrafficStatus{}),
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "UplinkBytes",
+				Type: meta.TypeStringNumber,
+				Tags: &schema.FieldTags{
+					MapConv: "uplink_bytes",
+				},
+			},
+			{
+				Name: "DownlinkBytes",
+				Type: meta.TypeStringNumber,
+				Tags: &schema.FieldTags{
+					MapConv: "downlink_bytes",
+				},
+			},
+			{
+				Name: "TrafficShaping",
+				Type: meta.TypeFlag,
+				Tags: &schema.FieldTags{
+					MapConv: "traffic_shaping",
+				},
+			},
+		},
+	}
+)
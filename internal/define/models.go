@@ -322,6 +322,18 @@ func (m *modelsDef) vpcRouterInterfaceModel() *schema.Model {
 	return ifModel
 }
 
+func (m *modelsDef) mobileGatewayInterfaceModel() *schema.Model {
+	ifModel := m.vpcRouterInterfaceModel()
+	ifModel.Name = "MobileGatewayInterface"
+	for _, f := range ifModel.Fields {
+		if f.Name == "PacketFilterID" {
+			f.Type = meta.TypeID
+			f.Tags = &schema.FieldTags{MapConv: "PacketFilter.ID,omitempty"}
+		}
+	}
+	return ifModel
+}
+
 func (m *modelsDef) bundleInfoModel() *schema.Model {
 	return &schema.Model{
 		Name:      "BundleInfo",
@@ -371,6 +383,30 @@ func (m *modelsDef) storageModel() *schema.Model {
 					JSON:    ",omitempty",
 				},
 			},
+			{
+				Name: "Description",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv: ",omitempty",
+					JSON:    ",omitempty",
+				},
+			},
+			{
+				Name: "DiskPlanID",
+				Type: meta.TypeID,
+				Tags: &schema.FieldTags{
+					MapConv: "DiskPlan.ID,omitempty",
+					JSON:    ",omitempty",
+				},
+			},
+			{
+				Name: "ZoneID",
+				Type: meta.TypeID,
+				Tags: &schema.FieldTags{
+					MapConv: "Zone.ID,omitempty",
+					JSON:    ",omitempty",
+				},
+			},
 		},
 	}
 }
@@ -614,6 +650,14 @@ func (m *modelsDef) switchInfoModel() *schema.Model {
 					MapConv: "[]Subnets,recursive",
 				},
 			},
+			{
+				Name: "IPv6Nets",
+				Type: m.ipv6NetInfoList(),
+				Tags: &schema.FieldTags{
+					MapConv: "[]IPv6Nets,recursive,omitempty",
+					JSON:    ",omitempty",
+				},
+			},
 		},
 	}
 }
@@ -634,6 +678,25 @@ func (m *modelsDef) internetSubnet() *schema.Model {
 	}
 }
 
+// ipv6NetInfo Internetリソースで有効化されたIPv6アドレス帯の情報
+func (m *modelsDef) ipv6NetInfo() *schema.Model {
+	return &schema.Model{
+		Name:      "IPv6NetInfo",
+		NakedType: meta.Static(naked.IPv6Net{}),
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			fields.IPv6Prefix(),
+			fields.IPv6PrefixLen(),
+		},
+	}
+}
+
+func (m *modelsDef) ipv6NetInfoList() *schema.Model {
+	info := m.ipv6NetInfo()
+	info.IsArray = true
+	return info
+}
+
 // internetSubnetOperationResult Internetリソースへのサブネット追加/更新時の戻り値
 //
 // internetSubnetに対しIPAddresses(文字列配列)を追加したもの
@@ -1106,3 +1169,105 @@ func (m *modelsDef) vpcRouterStaticRoute() *schema.Model {
 		},
 	}
 }
+
+func (m *modelsDef) mobileGatewaySetting() *schema.Model {
+	return &schema.Model{
+		Name:      "MobileGatewaySetting",
+		NakedType: meta.Static(naked.MobileGatewaySettings{}),
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "Interfaces",
+				Type: m.mobileGatewayInterfaceSetting(),
+				Tags: &schema.FieldTags{
+					JSON:    ",omitempty",
+					MapConv: "MobileGateway.[]Interfaces,omitempty,recursive",
+				},
+			},
+			{
+				Name: "StaticRoute",
+				Type: m.mobileGatewayStaticRoute(),
+				Tags: &schema.FieldTags{
+					JSON:    ",omitempty",
+					MapConv: "MobileGateway.[]StaticRoutes,omitempty,recursive",
+				},
+			},
+			{
+				Name: "InternetConnectionEnabled",
+				Type: meta.TypeStringFlag,
+				Tags: &schema.FieldTags{
+					MapConv: "MobileGateway.InternetConnection.Enabled",
+				},
+			},
+			{
+				Name: "InterDeviceCommunicationEnabled",
+				Type: meta.TypeStringFlag,
+				Tags: &schema.FieldTags{
+					MapConv: "MobileGateway.InterDeviceCommunication.Enabled",
+				},
+			},
+		},
+	}
+}
+
+func (m *modelsDef) mobileGatewaySettingCreate() *schema.Model {
+	return &schema.Model{
+		Name:      "MobileGatewaySettingCreate",
+		NakedType: meta.Static(naked.MobileGatewaySettings{}),
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "InternetConnectionEnabled",
+				Type: meta.TypeStringFlag,
+				Tags: &schema.FieldTags{
+					MapConv: "MobileGateway.InternetConnection.Enabled",
+				},
+			},
+			{
+				Name: "InterDeviceCommunicationEnabled",
+				Type: meta.TypeStringFlag,
+				Tags: &schema.FieldTags{
+					MapConv: "MobileGateway.InterDeviceCommunication.Enabled",
+				},
+			},
+		},
+	}
+}
+
+func (m *modelsDef) mobileGatewayInterfaceSetting() *schema.Model {
+	return &schema.Model{
+		Name:      "MobileGatewayInterfaceSetting",
+		NakedType: meta.Static(naked.MobileGatewayInterface{}),
+		IsArray:   true,
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "IPAddress",
+				Type: meta.TypeStringSlice,
+			},
+			{
+				Name: "NetworkMaskLen",
+				Type: meta.TypeInt,
+			},
+			{
+				Name: "Index",
+				Type: meta.TypeInt,
+			},
+		},
+	}
+}
+
+func (m *modelsDef) mobileGatewayStaticRoute() *schema.Model {
+	return &schema.Model{
+		Name:      "MobileGatewayStaticRoute",
+		NakedType: meta.Static(naked.MobileGatewayStaticRoute{}),
+		IsArray:   true,
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "Prefix",
+				Type: meta.TypeString,
+			},
+			{
+				Name: "NextHop",
+				Type: meta.TypeString,
+			},
+		},
+	}
+}
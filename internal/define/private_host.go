@@ -0,0 +1,77 @@
+package define
+
+import (
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var privateHostAPI = &schema.Resource{
+	Name:       "PrivateHost",
+	PathName:   "privatehost",
+	PathSuffix: schema.CloudAPISuffix,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			// find
+			r.DefineOperationFind(privateHostNakedType, findParameter, privateHostView),
+
+			// create
+			r.DefineOperationCreate(privateHostNakedType, privateHostCreateParam, privateHostView),
+
+			// read
+			r.DefineOperationRead(privateHostNakedType, privateHostView),
+
+			// update
+			r.DefineOperationUpdate(privateHostNakedType, privateHostUpdateParam, privateHostView),
+
+			// delete
+			r.DefineOperationDelete(),
+		}
+	},
+}
+
+var (
+	privateHostNakedType = meta.Static(naked.PrivateHost{})
+
+	privateHostView = &schema.Model{
+		Name: "PrivateHost",
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			fields.Name(),
+			fields.Description(),
+			fields.Tags(),
+			fields.IconID(),
+			fields.CreatedAt(),
+			// plan
+			fields.PlanID(),
+			fields.PlanName(),
+			fields.PrivateHostPlanClass(),
+			fields.PrivateHostPlanCPU(),
+			fields.PrivateHostPlanMemoryMB(),
+			// capacity
+			fields.PrivateHostAssignedCPU(),
+			fields.PrivateHostAssignedMemoryMB(),
+			// host
+			fields.PrivateHostHostName(),
+		},
+	}
+
+	privateHostCreateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.PlanID(),
+			fields.Name(),
+			fields.Description(),
+			fields.Tags(),
+			fields.IconID(),
+		},
+	}
+
+	privateHostUpdateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.Name(),
+			fields.Description(),
+			fields.Tags(),
+			fields.IconID(),
+		},
+	}
+)
@@ -0,0 +1,34 @@
+package define
+
+import (
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var privateHostPlanAPI = &schema.Resource{
+	Name:       "PrivateHostPlan",
+	PathName:   "product/privatehost",
+	PathSuffix: schema.CloudAPISuffix,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			r.DefineOperationFind(privateHostPlanNakedType, findParameter, privateHostPlanView),
+			r.DefineOperationRead(privateHostPlanNakedType, privateHostPlanView),
+		}
+	},
+}
+
+var (
+	privateHostPlanNakedType = meta.Static(naked.PrivateHostPlan{})
+
+	privateHostPlanView = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			fields.Name(),
+			fields.Class(),
+			fields.CPU(),
+			fields.MemoryMB(),
+			fields.Availability(),
+		},
+	}
+)
@@ -0,0 +1,238 @@
+package define
+
+import (
+	"net/http"
+
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var proxyLBAPI = &schema.Resource{
+	Name:       "ProxyLB",
+	PathName:   "commonserviceitem",
+	PathSuffix: schema.CloudAPISuffix,
+	IsGlobal:   true,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			// find
+			r.DefineOperationCommonServiceItemFind(proxyLBNakedType, findParameter, proxyLBView),
+
+			// create
+			r.DefineOperationCommonServiceItemCreate(proxyLBNakedType, proxyLBCreateParam, proxyLBView),
+
+			// read
+			r.DefineOperationCommonServiceItemRead(proxyLBNakedType, proxyLBView),
+
+			// update
+			r.DefineOperationCommonServiceItemUpdate(proxyLBNakedType, proxyLBUpdateParam, proxyLBView),
+
+			// delete
+			r.DefineOperationDelete(),
+
+			// get certificates
+			r.DefineOperation("GetCertificates").
+				Method(http.MethodGet).
+				PathFormat(schema.IDAndSuffixPathFormat("proxylb/sslcertificate")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				ResultFromEnvelope(proxyLBCertificatesView, &schema.EnvelopePayloadDesc{
+					PayloadName: "ProxyLB",
+					PayloadType: meta.Static(naked.ProxyLBCertificates{}),
+				}),
+
+			// set certificates
+			r.DefineOperation("SetCertificates").
+				Method(http.MethodPut).
+				PathFormat(schema.IDAndSuffixPathFormat("proxylb/sslcertificate")).
+				RequestEnvelope(&schema.EnvelopePayloadDesc{
+					PayloadName: "ProxyLB",
+					PayloadType: meta.Static(naked.ProxyLBCertificates{}),
+				}).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				MappableArgument("param", proxyLBSetCertificatesParam).
+				ResultFromEnvelope(proxyLBCertificatesView, &schema.EnvelopePayloadDesc{
+					PayloadName: "ProxyLB",
+					PayloadType: meta.Static(naked.ProxyLBCertificates{}),
+				}),
+
+			// delete certificates
+			r.DefineSimpleOperation("DeleteCertificates", http.MethodDelete, "proxylb/sslcertificate"),
+
+			// renew Let's Encrypt certificates
+			r.DefineSimpleOperation("RenewLetsEncryptCert", http.MethodPut, "proxylb/letsencryptstrategy"),
+
+			// health status
+			r.DefineOperation("HealthStatus").
+				Method(http.MethodGet).
+				PathFormat(schema.IDAndSuffixPathFormat("health")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				ResultFromEnvelope(proxyLBHealthView, &schema.EnvelopePayloadDesc{
+					PayloadName: "ProxyLB",
+					PayloadType: meta.Static(naked.ProxyLBHealth{}),
+				}),
+
+			// monitor
+			r.DefineOperationMonitorChild("Connection", "activity/proxylb",
+				monitorParameter, monitors.connectionModel()),
+		}
+	},
+}
+
+var (
+	proxyLBNakedType = meta.Static(naked.ProxyLB{})
+
+	proxyLBView = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			fields.Name(),
+			fields.Description(),
+			fields.Tags(),
+			fields.Availability(),
+			fields.IconID(),
+			fields.CreatedAt(),
+			fields.ModifiedAt(),
+			fields.ProxyLBProviderClass(),
+			// settings
+			fields.ProxyLBHealthCheck(),
+			fields.ProxyLBSorryServer(),
+			fields.ProxyLBBindPorts(),
+			fields.ProxyLBServers(),
+			fields.ProxyLBLetsEncrypt(),
+			fields.ProxyLBStickySession(),
+			fields.ProxyLBTimeout(),
+			fields.SettingsHash(),
+			// status
+			fields.ProxyLBUseVIPFailover(),
+			fields.ProxyLBRegion(),
+			fields.ProxyLBProxyNetworks(),
+			fields.ProxyLBFQDN(),
+			fields.ProxyLBVirtualIPAddress(),
+		},
+	}
+
+	proxyLBCreateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.ProxyLBProviderClass(),
+
+			fields.ProxyLBHealthCheck(),
+			fields.ProxyLBSorryServer(),
+			fields.ProxyLBBindPorts(),
+			fields.ProxyLBServers(),
+			fields.ProxyLBLetsEncrypt(),
+			fields.ProxyLBStickySession(),
+			fields.ProxyLBTimeout(),
+			fields.ProxyLBUseVIPFailover(),
+			fields.ProxyLBRegion(),
+
+			fields.Name(),
+			fields.Description(),
+			fields.Tags(),
+			fields.IconID(),
+		},
+	}
+
+	proxyLBUpdateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.ProxyLBHealthCheck(),
+			fields.ProxyLBSorryServer(),
+			fields.ProxyLBBindPorts(),
+			fields.ProxyLBServers(),
+			fields.ProxyLBLetsEncrypt(),
+			fields.ProxyLBStickySession(),
+			fields.ProxyLBTimeout(),
+
+			fields.Name(),
+			fields.Description(),
+			fields.Tags(),
+			fields.IconID(),
+		},
+	}
+
+	proxyLBCertificatesView = &schema.Model{
+		Name:      "ProxyLBCertificates",
+		NakedType: meta.Static(naked.ProxyLBCertificates{}),
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "PrimaryCert",
+				Type: proxyLBCertificateModel("ProxyLBPrimaryCert", false),
+				Tags: &schema.FieldTags{
+					MapConv: ",recursive",
+				},
+			},
+			{
+				Name: "AdditionalCerts",
+				Type: proxyLBCertificateModel("ProxyLBAdditionalCert", true),
+				Tags: &schema.FieldTags{
+					MapConv: "[]AdditionalCerts,recursive",
+				},
+			},
+		},
+	}
+
+	proxyLBSetCertificatesParam = &schema.Model{
+		Name:      "ProxyLBSetCertificatesRequest",
+		NakedType: meta.Static(naked.ProxyLBCertificates{}),
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "PrimaryCert",
+				Type: proxyLBCertificateModel("ProxyLBPrimaryCert", false),
+				Tags: &schema.FieldTags{
+					MapConv: ",recursive",
+				},
+			},
+			{
+				Name: "AdditionalCerts",
+				Type: proxyLBCertificateModel("ProxyLBAdditionalCert", true),
+				Tags: &schema.FieldTags{
+					MapConv: "[]AdditionalCerts,recursive",
+				},
+			},
+		},
+	}
+
+	proxyLBHealthView = &schema.Model{
+		Name:      "ProxyLBHealth",
+		NakedType: meta.Static(naked.ProxyLBHealth{}),
+		Fields: []*schema.FieldDesc{
+			fields.New("ActiveConn", meta.TypeInt),
+			fields.New("CPS", meta.TypeFloat64),
+			fields.New("CurrentVIP", meta.TypeString),
+			{
+				Name: "Servers",
+				Type: &schema.Model{
+					Name:      "ProxyLBHealthServer",
+					NakedType: meta.Static(naked.ProxyLBHealthServer{}),
+					IsArray:   true,
+					Fields: []*schema.FieldDesc{
+						fields.New("ActiveConn", meta.TypeInt),
+						fields.New("Status", meta.TypeString),
+						fields.New("IPAddress", meta.TypeString),
+						fields.New("Port", meta.TypeStringNumber),
+						fields.New("CPS", meta.TypeFloat64),
+					},
+				},
+				Tags: &schema.FieldTags{
+					MapConv: "[]Servers,recursive",
+				},
+			},
+		},
+	}
+)
+
+func proxyLBCertificateModel(name string, isArray bool) *schema.Model {
+	return &schema.Model{
+		Name:      name,
+		NakedType: meta.Static(naked.ProxyLBCertificate{}),
+		IsArray:   isArray,
+		Fields: []*schema.FieldDesc{
+			fields.New("ServerCertificate", meta.TypeString),
+			fields.New("IntermediateCertificate", meta.TypeString),
+			fields.New("PrivateKey", meta.TypeString),
+			fields.New("CertificateEndDate", meta.TypeTime),
+			fields.New("CertificateCommonName", meta.TypeString),
+		},
+	}
+}
@@ -0,0 +1,25 @@
+package define
+
+import (
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var regionAPI = &schema.Resource{
+	Name:       "Region",
+	PathName:   "region",
+	PathSuffix: schema.CloudAPISuffix,
+	IsGlobal:   true,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			r.DefineOperationFind(regionNakedType, findParameter, regionView),
+			r.DefineOperationRead(regionNakedType, regionView),
+		}
+	},
+}
+
+var (
+	regionNakedType = meta.Static(naked.Region{})
+	regionView      = models.region()
+)
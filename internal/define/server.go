@@ -92,6 +92,46 @@ var serverAPI = &schema.Resource{
 
 			// monitor
 			r.DefineOperationMonitor(monitorParameter, monitors.cpuTimeModel()),
+
+			// vnc proxy
+			r.DefineOperation("GetVNCProxy").
+				Method(http.MethodGet).
+				PathFormat(schema.IDAndSuffixPathFormat("vnc/proxy")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				ResultFromEnvelope(serverVNCProxyView, &schema.EnvelopePayloadDesc{
+					PayloadName: "VNCProxyInfo",
+					PayloadType: meta.Static(naked.VNCProxyInfo{}),
+					IsEmbedded:  true,
+				}),
+
+			// send key
+			r.DefineOperation("SendKey").
+				Method(http.MethodPut).
+				PathFormat(schema.IDAndSuffixPathFormat("keyboard")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				PassthroughModelArgumentWithEnvelope("keyboardParam", serverSendKeyParam),
+
+			// send nmi
+			r.DefineOperation("SendNMI").
+				Method(http.MethodPut).
+				PathFormat(schema.IDAndSuffixPathFormat("qemu/nmi")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID),
+
+			// screenshot
+			r.DefineOperation("GetScreenshot").
+				Method(http.MethodGet).
+				PathFormat(schema.IDAndSuffixPathFormat("vnc/snapshot")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				PassthroughModelArgumentWithEnvelope("condition", serverGetScreenshotParam).
+				ResultFromEnvelope(serverVNCSnapshotView, &schema.EnvelopePayloadDesc{
+					PayloadName: "VNCSnapshotInfo",
+					PayloadType: meta.Static(naked.VNCSnapshotInfo{}),
+					IsEmbedded:  true,
+				}),
 		}
 	},
 }
@@ -185,6 +225,7 @@ var (
 			fields.Description(),
 			fields.Tags(),
 			fields.IconID(),
+			fields.PrivateHostID(),
 			{
 				Name: "WaitDiskMigration",
 				Type: meta.TypeFlag,
@@ -211,8 +252,65 @@ var (
 			fields.CPU(),
 			fields.MemoryMB(),
 			fields.Generation(),
-			fields.ServerPlanCommitment(),
+			fields.Commitment(),
 		},
 		NakedType: meta.Static(naked.ServerPlan{}),
 	}
+
+	serverVNCProxyView = &schema.Model{
+		Name: "VNCProxyInfo",
+		Fields: []*schema.FieldDesc{
+			fields.New("Status", meta.TypeString),
+			fields.New("Host", meta.TypeString),
+			fields.New("IOServerHost", meta.TypeString),
+			fields.New("Port", meta.TypeStringNumber),
+			fields.New("Password", meta.TypeString),
+			fields.New("VNCFile", meta.TypeString),
+		},
+		NakedType: meta.Static(naked.VNCProxyInfo{}),
+	}
+
+	serverSendKeyParam = &schema.Model{
+		Name: "SendKeyRequest",
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "Key",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					JSON:    ",omitempty",
+					MapConv: ",omitempty",
+				},
+			},
+			{
+				Name: "Keys",
+				Type: meta.TypeStringSlice,
+				Tags: &schema.FieldTags{
+					JSON:    ",omitempty",
+					MapConv: ",omitempty",
+				},
+			},
+		},
+	}
+
+	serverGetScreenshotParam = &schema.Model{
+		Name: "GetScreenshotRequest",
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "ScreenCaptureFormat",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					JSON:    ",omitempty",
+					MapConv: ",omitempty",
+				},
+			},
+		},
+	}
+
+	serverVNCSnapshotView = &schema.Model{
+		Name: "VNCSnapshotInfo",
+		Fields: []*schema.FieldDesc{
+			fields.New("Image", meta.TypeString),
+		},
+		NakedType: meta.Static(naked.VNCSnapshotInfo{}),
+	}
 )
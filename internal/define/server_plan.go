@@ -0,0 +1,44 @@
+package define
+
+import (
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var serverPlanAPI = &schema.Resource{
+	Name:       "ServerPlan",
+	PathName:   "product/server",
+	PathSuffix: schema.CloudAPISuffix,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			r.DefineOperationFind(serverPlanNakedType, findParameter, serverPlanView),
+			r.DefineOperationRead(serverPlanNakedType, serverPlanView),
+		}
+	},
+}
+
+var (
+	serverPlanNakedType = meta.Static(naked.ServerPlan{})
+
+	serverPlanView = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			fields.Name(),
+			fields.CPU(),
+			fields.MemoryMB(),
+			{
+				Name: "Commitment",
+				Type: meta.TypeCommitment,
+				Tags: &schema.FieldTags{
+					MapConv: ",default=standard",
+				},
+			},
+			{
+				Name: "Generation",
+				Type: meta.TypePlanGeneration,
+			},
+			fields.Availability(),
+		},
+	}
+)
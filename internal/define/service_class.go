@@ -0,0 +1,76 @@
+package define
+
+import (
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var serviceClassAPI = &schema.Resource{
+	Name:       "ServiceClass",
+	PathName:   "public/price",
+	PathSuffix: schema.CloudAPISuffix,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			r.DefineOperationFind(serviceClassNakedType, findParameter, serviceClassView),
+		}
+	},
+}
+
+var (
+	serviceClassNakedType = meta.Static(naked.ServiceClass{})
+
+	serviceClassView = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			{
+				Name: "ServiceClassName",
+				Type: meta.TypeString,
+			},
+			{
+				Name: "ServiceClassPath",
+				Type: meta.TypeString,
+			},
+			{
+				Name: "DisplayName",
+				Type: meta.TypeString,
+			},
+			{
+				Name: "IsPublic",
+				Type: meta.TypeFlag,
+			},
+			{
+				Name: "Price",
+				Type: &schema.Model{
+					Name:      "Price",
+					NakedType: meta.Static(naked.Price{}),
+					Fields: []*schema.FieldDesc{
+						{
+							Name: "Base",
+							Type: meta.TypeInt,
+						},
+						{
+							Name: "Daily",
+							Type: meta.TypeInt,
+						},
+						{
+							Name: "Hourly",
+							Type: meta.TypeInt,
+						},
+						{
+							Name: "Monthly",
+							Type: meta.TypeInt,
+						},
+						{
+							Name: "Zone",
+							Type: meta.TypeString,
+						},
+					},
+				},
+				Tags: &schema.FieldTags{
+					MapConv: ",recursive",
+				},
+			},
+		},
+	}
+)
@@ -0,0 +1,134 @@
+package define
+
+import (
+	"net/http"
+
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var simpleMonitorAPI = &schema.Resource{
+	Name:       "SimpleMonitor",
+	PathName:   "commonserviceitem",
+	PathSuffix: schema.CloudAPISuffix,
+	IsGlobal:   true,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			// find
+			r.DefineOperationCommonServiceItemFind(simpleMonitorNakedType, findParameter, simpleMonitorView),
+
+			// create
+			r.DefineOperationCommonServiceItemCreate(simpleMonitorNakedType, simpleMonitorCreateParam, simpleMonitorView),
+
+			// read
+			r.DefineOperationCommonServiceItemRead(simpleMonitorNakedType, simpleMonitorView),
+
+			// update
+			r.DefineOperationCommonServiceItemUpdate(simpleMonitorNakedType, simpleMonitorUpdateParam, simpleMonitorView),
+
+			// delete
+			r.DefineOperationDelete(),
+
+			// monitor
+			r.DefineOperationMonitorChild("ResponseTime", "activity/responsetimesec",
+				monitorParameter, monitors.responseTimeSecModel()),
+
+			// health status
+			r.DefineOperation("HealthStatus").
+				Method(http.MethodGet).
+				PathFormat(schema.IDAndSuffixPathFormat("health")).
+				Argument(schema.ArgumentZone).
+				Argument(schema.ArgumentID).
+				ResultFromEnvelope(simpleMonitorHealthStatusView, &schema.EnvelopePayloadDesc{
+					PayloadName: "SimpleMonitor",
+					PayloadType: meta.Static(naked.SimpleMonitorHealthStatus{}),
+				}),
+		}
+	},
+}
+
+var (
+	simpleMonitorNakedType = meta.Static(naked.SimpleMonitor{})
+
+	simpleMonitorView = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			fields.Name(),
+			fields.Description(),
+			fields.Tags(),
+			fields.Availability(),
+			fields.IconID(),
+			fields.CreatedAt(),
+			fields.ModifiedAt(),
+			fields.SimpleMonitorProviderClass(),
+			fields.SimpleMonitorTarget(),
+			// settings
+			fields.SimpleMonitorDelayLoop(),
+			fields.SimpleMonitorEnabled(),
+			fields.SimpleMonitorHealthCheck(),
+			fields.SimpleMonitorNotifyEmailEnabled(),
+			fields.SimpleMonitorNotifyEmailHTML(),
+			fields.SimpleMonitorNotifySlackEnabled(),
+			fields.SimpleMonitorSlackWebhooksURL(),
+			fields.SimpleMonitorNotifyInterval(),
+			fields.SettingsHash(),
+		},
+	}
+
+	simpleMonitorCreateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.SimpleMonitorProviderClass(),
+			fields.SimpleMonitorTarget(),
+
+			fields.SimpleMonitorDelayLoop(),
+			fields.SimpleMonitorEnabled(),
+			fields.SimpleMonitorHealthCheck(),
+			fields.SimpleMonitorNotifyEmailEnabled(),
+			fields.SimpleMonitorNotifyEmailHTML(),
+			fields.SimpleMonitorNotifySlackEnabled(),
+			fields.SimpleMonitorSlackWebhooksURL(),
+			fields.SimpleMonitorNotifyInterval(),
+
+			fields.Description(),
+			fields.Tags(),
+			fields.IconID(),
+		},
+	}
+
+	simpleMonitorUpdateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.SimpleMonitorDelayLoop(),
+			fields.SimpleMonitorEnabled(),
+			fields.SimpleMonitorHealthCheck(),
+			fields.SimpleMonitorNotifyEmailEnabled(),
+			fields.SimpleMonitorNotifyEmailHTML(),
+			fields.SimpleMonitorNotifySlackEnabled(),
+			fields.SimpleMonitorSlackWebhooksURL(),
+			fields.SimpleMonitorNotifyInterval(),
+
+			fields.Description(),
+			fields.Tags(),
+			fields.IconID(),
+		},
+	}
+
+	simpleMonitorHealthStatusView = &schema.Model{
+		Name:      "SimpleMonitorHealthStatus",
+		NakedType: meta.Static(naked.SimpleMonitorHealthStatus{}),
+		Fields: []*schema.FieldDesc{
+			{
+				Name: "LastCheckedAt",
+				Type: meta.TypeTime,
+			},
+			{
+				Name: "LastHealthChangedAt",
+				Type: meta.TypeTime,
+			},
+			{
+				Name: "Health",
+				Type: meta.TypeSimpleMonitorHealth,
+			},
+		},
+	}
+)
@@ -0,0 +1,122 @@
+package define
+
+import (
+	"net/http"
+
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var sshKeyAPI = &schema.Resource{
+	Name:       "SSHKey",
+	PathName:   "sshkey",
+	PathSuffix: schema.CloudAPISuffix,
+	IsGlobal:   true,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			// find
+			r.DefineOperationFind(sshKeyNakedType, findParameter, sshKeyView),
+
+			// create
+			r.DefineOperationCreate(sshKeyNakedType, sshKeyCreateParam, sshKeyView),
+
+			// generate
+			r.DefineOperation("Generate").
+				Method(http.MethodPost).
+				PathFormat(schema.DefaultPathFormat+"/generate").
+				RequestEnvelope(&schema.EnvelopePayloadDesc{
+					PayloadType: meta.Static(naked.SSHKeyGenerateParam{}),
+					PayloadName: "KeyPair",
+				}).
+				Argument(schema.ArgumentZone).
+				MappableArgument("param", sshKeyGenerateParam).
+				ResultFromEnvelope(sshKeyGeneratedView, &schema.EnvelopePayloadDesc{
+					PayloadType: meta.Static(naked.SSHKeyGenerated{}),
+					PayloadName: "SSHKey",
+				}),
+
+			// read
+			r.DefineOperationRead(sshKeyNakedType, sshKeyView),
+
+			// update
+			r.DefineOperationUpdate(sshKeyNakedType, sshKeyUpdateParam, sshKeyView),
+
+			// delete
+			r.DefineOperationDelete(),
+		}
+	},
+}
+
+var (
+	sshKeyNakedType = meta.Static(naked.SSHKey{})
+
+	sshKeyView = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			fields.Name(),
+			fields.Description(),
+			fields.CreatedAt(),
+			fields.SSHKeyPublicKey(),
+			fields.SSHKeyFingerprint(),
+		},
+	}
+
+	sshKeyCreateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.Name(),
+			fields.Description(),
+			{
+				Name: "PublicKey",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					Validate: "required",
+				},
+			},
+		},
+	}
+
+	sshKeyUpdateParam = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.Name(),
+			fields.Description(),
+		},
+	}
+
+	sshKeyGenerateParam = &schema.Model{
+		Name:      "SSHKeyGenerateRequest",
+		NakedType: meta.Static(naked.SSHKeyGenerateParam{}),
+		Fields: []*schema.FieldDesc{
+			fields.Name(),
+			fields.Description(),
+			{
+				Name: "GenerateFormat",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					MapConv: ",default=openssh",
+				},
+			},
+			{
+				Name: "PassPhrase",
+				Type: meta.TypeString,
+				Tags: &schema.FieldTags{
+					Validate: "omitempty,min=8,max=64",
+				},
+			},
+		},
+	}
+
+	sshKeyGeneratedView = &schema.Model{
+		Name:      "SSHKeyGenerated",
+		NakedType: meta.Static(naked.SSHKeyGenerated{}),
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			fields.Name(),
+			fields.Description(),
+			fields.CreatedAt(),
+			fields.SSHKeyPublicKey(),
+			fields.SSHKeyPrivateKey(),
+			fields.SSHKeyFingerprint(),
+		},
+	}
+)
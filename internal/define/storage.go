@@ -0,0 +1,24 @@
+package define
+
+import (
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var storageAPI = &schema.Resource{
+	Name:       "Storage",
+	PathName:   "storage",
+	PathSuffix: schema.CloudAPISuffix,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			r.DefineOperationFind(storageNakedType, findParameter, storageView),
+			r.DefineOperationRead(storageNakedType, storageView),
+		}
+	},
+}
+
+var (
+	storageNakedType = meta.Static(naked.Storage{})
+	storageView      = models.storageModel()
+)
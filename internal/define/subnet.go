@@ -0,0 +1,57 @@
+package define
+
+import (
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+var subnetAPI = &schema.Resource{
+	Name:       "Subnet",
+	PathName:   "subnet",
+	PathSuffix: schema.CloudAPISuffix,
+	OperationsDefineFunc: func(r *schema.Resource) []*schema.Operation {
+		return []*schema.Operation{
+			r.DefineOperationFind(subnetNakedType, findParameter, subnetView),
+			r.DefineOperationRead(subnetNakedType, subnetView),
+		}
+	},
+}
+
+var (
+	subnetNakedType = meta.Static(naked.Subnet{})
+
+	subnetView = &schema.Model{
+		Fields: []*schema.FieldDesc{
+			fields.ID(),
+			fields.SwitchID(),
+			{
+				Name: "InternetID",
+				Type: meta.TypeID,
+				Tags: &schema.FieldTags{
+					MapConv: "Internet.ID,omitempty",
+				},
+			},
+			fields.DefaultRoute(),
+			fields.NextHop(),
+			fields.StaticRoute(),
+			fields.NetworkAddress(),
+			fields.NetworkMaskLen(),
+			{
+				Name: "IPAddresses",
+				Type: &schema.Model{
+					Name:      "SubnetIPAddress",
+					NakedType: meta.Static(naked.SubnetIPAddress{}),
+					IsArray:   true,
+					Fields: []*schema.FieldDesc{
+						fields.HostName(),
+						fields.IPAddress(),
+					},
+				},
+				Tags: &schema.FieldTags{
+					MapConv: "[]IPAddresses,recursive",
+				},
+			},
+		},
+	}
+)
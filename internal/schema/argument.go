@@ -2,6 +2,7 @@ package schema
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
 )
@@ -70,3 +71,18 @@ func (a *Argument) MapConvTagSrc() string {
 	}
 	return fmt.Sprintf("`mapconv:\"%s\"`", a.MapConvTag)
 }
+
+// IsPassthrough mapconvタグでsquashが指定されている(ペイロードへ直接展開される)引数か
+func (a *Argument) IsPassthrough() bool {
+	return strings.Contains(a.MapConvTag, "squash")
+}
+
+// IsMappable mapconvタグでペイロードのフィールドにマッピングされる引数か
+func (a *Argument) IsMappable() bool {
+	return a.MapConvTag != "" && !a.IsPassthrough()
+}
+
+// IsPathParameter URLのパスに埋め込まれる引数か
+func (a *Argument) IsPathParameter() bool {
+	return a.MapConvTag == ""
+}
@@ -2,6 +2,7 @@ package schema
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
 )
@@ -43,6 +44,7 @@ type EnvelopePayloadDesc struct {
 	PayloadName string    // ペイロードのフィールド名
 	PayloadType meta.Type // ペイロードの型情報
 	Tags        *FieldTags
+	IsEmbedded  bool // ペイロードをエンベロープのトップレベルへ展開するか(PayloadNameはペイロードの型名と一致させること)
 }
 
 // TypeName ペイロードの型定義
@@ -50,6 +52,14 @@ func (d *EnvelopePayloadDesc) TypeName() string {
 	return d.PayloadType.GoTypeSourceCode()
 }
 
+// FieldDefinition エンベロープでのフィールド定義
+func (d *EnvelopePayloadDesc) FieldDefinition() string {
+	if d.IsEmbedded {
+		return d.TypeName()
+	}
+	return fmt.Sprintf("%s %s %s", d.PayloadName, d.TypeName(), d.TagString())
+}
+
 // TagString タグの文字列表現
 func (d *EnvelopePayloadDesc) TagString() string {
 	if d.Tags == nil {
@@ -60,6 +70,17 @@ func (d *EnvelopePayloadDesc) TagString() string {
 	return fmt.Sprintf("`%s`", d.Tags.String())
 }
 
+// JSONKey JSONでのキー名、jsonタグで名前が指定されていない場合はPayloadName
+func (d *EnvelopePayloadDesc) JSONKey() string {
+	if d.Tags != nil && d.Tags.JSON != "" {
+		name := strings.Split(d.Tags.JSON, ",")[0]
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+	return d.PayloadName
+}
+
 // PayloadForm ペイロードの形体
 type PayloadForm int
 
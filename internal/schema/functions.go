@@ -51,3 +51,13 @@ func normalizeResourceName(name string) string {
 func toCamelWithFirstLower(name string) string {
 	return xstrings.FirstRuneToLower(xstrings.ToCamelCase(strings.Replace(normalizeResourceName(name), "-", "_", -1)))
 }
+
+// mapConvKeys mapconvタグからキー部分を取り出す
+func mapConvKeys(tag string) []string {
+	key := strings.Split(tag, ",")[0]
+	var keys []string
+	for _, k := range strings.Split(key, "/") {
+		keys = append(keys, strings.Replace(k, "[]", "", -1))
+	}
+	return keys
+}
@@ -34,20 +34,46 @@ var (
 
 	// TypeAvailability 有効状態
 	TypeAvailability = Static(types.EAvailability(""))
+	// TypeBackupSpanType 自動バックアップの取得間隔種別
+	TypeBackupSpanType = Static(types.EBackupSpanType(""))
+	// TypeBackupSpanWeekdays 自動バックアップの取得曜日
+	TypeBackupSpanWeekdays = Static([]types.EBackupSpanWeekday{})
 	// TypeCommitment サーバプランCPUコミットメント
 	TypeCommitment = Static(types.ECommitment(""))
+	// TypeDatabaseReplicationModel データベースアプライアンスのレプリケーションでの動作モデル
+	TypeDatabaseReplicationModel = Static(types.EDatabaseReplicationModel(""))
 	// TypeDiskConnection ディスク接続方法
 	TypeDiskConnection = Static(types.EDiskConnection(""))
+	// TypeDNSRecordType DNSレコード種別
+	TypeDNSRecordType = Static(types.EDNSRecordType(""))
+	// TypeIconSize アイコン画像取得時のサイズ
+	TypeIconSize = Static(types.EIconSize(""))
 	// TypeInstanceStatus インスタンスステータス
 	TypeInstanceStatus = Static(types.EServerInstanceStatus(""))
+	// TypeExternalPermission 他サービスへのアクセス権
+	TypeExternalPermission = Static(types.ExternalPermission(""))
 	// TypeInterfaceDriver インターフェースドライバ
 	TypeInterfaceDriver = Static(types.EInterfaceDriver(""))
+	// TypePermission アカウントでの権限
+	TypePermission = Static(types.EPermission(""))
 	// TypePlanGeneration プラン世代
 	TypePlanGeneration = Static(types.EPlanGeneration(0))
 	// TypeProtocol プロトコル
 	TypeProtocol = Static(types.Protocol(""))
+	// TypeProxyLBHealthCheckProtocol エンハンスドロードバランサ 監視プロトコル
+	TypeProxyLBHealthCheckProtocol = Static(types.EProxyLBHealthCheckProtocol(""))
+	// TypeProxyLBProxyMode エンハンスドロードバランサ 待ち受けポートでのプロキシ方式
+	TypeProxyLBProxyMode = Static(types.EProxyLBProxyMode(""))
+	// TypeProxyLBRegion エンハンスドロードバランサ 設置先リージョン
+	TypeProxyLBRegion = Static(types.EProxyLBRegion(""))
 	// TypeScope スコープ
 	TypeScope = Static(types.EScope(""))
+	// TypeSimpleMonitorHealth シンプル監視ステータス
+	TypeSimpleMonitorHealth = Static(types.ESimpleMonitorHealth(""))
+	// TypeSimpleMonitorProtocol シンプル監視 プロトコル
+	TypeSimpleMonitorProtocol = Static(types.ESimpleMonitorProtocol(""))
+	// TypeWebUI データベースアプライアンスのWebUI設定
+	TypeWebUI = Static(types.WebUI(""))
 
 	// TypePacketFilterNetwork パケットフィルタルールでの送信元アドレス/範囲
 	TypePacketFilterNetwork = Static(types.PacketFilterNetwork(""))
@@ -59,4 +85,6 @@ var (
 	TypeVPCFirewallPort = Static(types.VPCFirewallPort(""))
 	// TypeAction パケットフィルタルールでのallow/deny動作
 	TypeAction = Static(types.Action(""))
+	// TypeArchiveShareKey 共有アーカイブの共有キー
+	TypeArchiveShareKey = Static(types.ArchiveShareKey(""))
 )
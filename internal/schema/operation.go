@@ -3,6 +3,8 @@ package schema
 import (
 	"fmt"
 	"strings"
+
+	"github.com/sacloud/libsacloud-v2/internal/schema/meta"
 )
 
 // Operation リソースへの操作
@@ -75,10 +77,23 @@ func (o *Operation) PassthroughModelArgument(name string, model *Model) *Operati
 func (o *Operation) PassthroughModelArgumentWithEnvelope(name string, model *Model) *Operation {
 	var descs []*EnvelopePayloadDesc
 	for _, field := range model.Fields {
-		descs = append(descs, &EnvelopePayloadDesc{
-			PayloadName: field.Name,
+		// mapconvタグで単純な名前の変換先が指定されている場合はその名前でペイロードを定義する
+		payloadName := field.Name
+		if field.Tags != nil && field.Tags.MapConv != "" {
+			dest := strings.Split(field.Tags.MapConv, ",")[0]
+			if dest != "" && !strings.ContainsAny(dest, ".[]") {
+				payloadName = dest
+			}
+		}
+		desc := &EnvelopePayloadDesc{
+			PayloadName: payloadName,
 			PayloadType: field.Type,
-		})
+		}
+		// jsonタグが指定されている場合はペイロードにも引き継ぐ
+		if field.Tags != nil && field.Tags.JSON != "" {
+			desc.Tags = &FieldTags{JSON: field.Tags.JSON}
+		}
+		descs = append(descs, desc)
 	}
 	o.RequestEnvelope(descs...)
 	return o.Argument(&Argument{
@@ -235,6 +250,39 @@ func (o *Operation) AllArguments() Arguments {
 	return o.arguments
 }
 
+// PathArguments URLのパスに埋め込まれる引数を取得
+func (o *Operation) PathArguments() Arguments {
+	var args Arguments
+	for _, arg := range o.arguments {
+		if arg.IsPathParameter() {
+			args = append(args, arg)
+		}
+	}
+	return args
+}
+
+// MappableArguments ペイロードのフィールドにマッピングされる引数を取得
+func (o *Operation) MappableArguments() Arguments {
+	var args Arguments
+	for _, arg := range o.arguments {
+		if arg.IsMappable() {
+			args = append(args, arg)
+		}
+	}
+	return args
+}
+
+// PassthroughArguments ペイロードへ直接展開される引数を取得
+func (o *Operation) PassthroughArguments() Arguments {
+	var args Arguments
+	for _, arg := range o.arguments {
+		if arg.IsPassthrough() {
+			args = append(args, arg)
+		}
+	}
+	return args
+}
+
 // HasResults 戻り値が定義されているかを取得
 func (o *Operation) HasResults() bool {
 	return len(o.results) > 0
@@ -333,6 +381,75 @@ func (o *Operation) ResponsePayloads() []*EnvelopePayloadDesc {
 	return nil
 }
 
+// RequestKeys リクエストのエンベロープに含まれうるキー(ドット区切り)の一覧を取得
+//
+// 同一のメソッド/パスを持つ操作が複数ある場合に、リクエスト内容から操作を判定する用途で利用する
+func (o *Operation) RequestKeys() []string {
+	var keys []string
+	for _, arg := range o.arguments {
+		if arg.IsPathParameter() {
+			continue
+		}
+		prefixes := mapConvKeys(arg.MapConvTag)
+		model, ok := arg.Type.(*Model)
+		if !ok {
+			keys = append(keys, prefixes...)
+			continue
+		}
+
+		// recursive/squashの場合はフィールドのmapconvタグに従ってキーが決まる
+		isConverted := strings.Contains(arg.MapConvTag, "recursive") || arg.IsPassthrough()
+		for _, prefix := range prefixes {
+			for _, field := range model.Fields {
+				fieldKeys := []string{field.Name}
+				if isConverted && field.HasTag() && field.Tags.MapConv != "" {
+					fieldKeys = mapConvKeys(field.Tags.MapConv)
+				}
+				for _, key := range fieldKeys {
+					if key == "" {
+						key = field.Name
+					}
+					if prefix != "" {
+						key = prefix + "." + key
+					}
+					keys = append(keys, key)
+				}
+			}
+		}
+	}
+	return uniqStrings(keys)
+}
+
+// ResponsePayloadType 指定のペイロード名に対応するレスポンスペイロードの型情報を取得
+func (o *Operation) ResponsePayloadType(payloadName string) meta.Type {
+	for _, p := range o.ResponsePayloads() {
+		if p.PayloadName == payloadName {
+			return p.PayloadType
+		}
+	}
+	return nil
+}
+
+// ResponsePayloadKey 指定のペイロード名に対応するレスポンスペイロードのJSONでのキー名を取得
+func (o *Operation) ResponsePayloadKey(payloadName string) string {
+	for _, p := range o.ResponsePayloads() {
+		if p.PayloadName == payloadName {
+			return p.JSONKey()
+		}
+	}
+	return payloadName
+}
+
+// IsResponsePayloadEmbedded 指定のペイロード名に対応するレスポンスペイロードがトップレベルへ展開されるか
+func (o *Operation) IsResponsePayloadEmbedded(payloadName string) bool {
+	for _, p := range o.ResponsePayloads() {
+		if p.PayloadName == payloadName {
+			return p.IsEmbedded
+		}
+	}
+	return false
+}
+
 // IsRequestSingular リクエストが単数系か
 func (o *Operation) IsRequestSingular() bool {
 	if o.HasRequestEnvelope() {
@@ -498,7 +498,7 @@ func (r *Resource) FieldName(form PayloadForm) string {
 	case form.IsPlural():
 		// TODO とりあえずワードで例外指定
 		switch {
-		case r.Name == "NFS":
+		case r.Name == "NFS", r.Name == "IPAddress":
 			return r.Name
 		case strings.HasSuffix(r.Name, "ch"):
 			return r.Name + "es"
@@ -67,7 +67,7 @@ type {{ .ResponseEnvelopeStructName }} struct {
 {{ end }}
 {{ if .IsResponseSingular }}
 	{{- range .ResponsePayloads}}
-	{{.PayloadName}} {{.TypeName}} {{.TagString}}
+	{{.FieldDefinition}}
 	{{- end }}
 {{- else if .IsResponsePlural -}}
 	{{- range .ResponsePayloads}}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"log"
+	"path/filepath"
+
+	"github.com/sacloud/libsacloud-v2/internal/define"
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/tools"
+)
+
+const destination = "sacloud/fake/server/zz_handlers.go"
+
+func init() {
+	log.SetFlags(0)
+	log.SetPrefix("gen-api-fake-server: ")
+}
+
+func main() {
+	schema.IsOutOfSacloudPackage = true
+
+	tools.WriteFileWithTemplate(&tools.TemplateConfig{
+		OutputPath: filepath.Join(tools.ProjectRootPath(), destination),
+		Template:   tmpl,
+		Parameter:  define.Resources,
+	})
+	log.Printf("generated: %s\n", filepath.Join(destination))
+}
+
+const tmpl = `// generated by 'github.com/sacloud/libsacloud/internal/tools/gen-api-fake-server'; DO NOT EDIT
+
+package server
+
+import (
+	"context"
+
+	"github.com/sacloud/libsacloud-v2/pkg/mapconv"
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/fake"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+var routes = []*route{
+{{- range . }}{{ $resource := . }}
+{{- range .Operations }}
+	newRoute("{{$resource.TypeName}}", "{{.MethodName}}", "{{.GetMethod}}", "{{$resource.GetPathSuffix}}", "{{$resource.GetPathName}}", "{{.GetPathFormat}}", {{ printf "%#v" .RequestKeys }}, handle{{$resource.TypeName}}{{.MethodName}}),
+{{- end }}
+{{- end }}
+}
+
+{{ range . }}{{ $typeName := .TypeName}}
+
+/************************************************* 
+* {{$typeName}}
+*************************************************/
+{{ range .Operations }}{{ $op := . }}
+// handle{{$typeName}}{{.MethodName}} handles {{$typeName}}API.{{.MethodName}}
+func handle{{$typeName}}{{.MethodName}}(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	{{- range .PathArguments }}
+	var {{.ArgName}} {{.TypeName}}
+	if err := params.bind("{{.Name}}", &{{.ArgName}}); err != nil {
+		return nil, err
+	}
+	{{- end }}
+	{{- range .PassthroughArguments }}
+	{{.ArgName}} := {{.ZeroInitializer}}
+	if err := mapconv.ConvertFrom(body, {{.ArgName}}); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	{{- end }}
+	{{- if .MappableArguments }}
+	args := &struct {
+		{{- range .MappableArguments }}
+		Arg{{ .ArgName }} {{ .TypeName }} {{.MapConvTagSrc}}
+		{{- end }}
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	{{- range .MappableArguments }}
+	if args.Arg{{.ArgName}} == {{.ZeroValueOnSource}} {
+		args.Arg{{.ArgName}} = {{.ZeroInitializer}}
+	}
+	{{- end }}
+	{{- end }}
+
+	{{ range $i, $v := .AllResults }}result{{$i}}, {{ end }}err := fake.New{{$typeName}}Op().{{.MethodName}}(ctx{{ range .AllArguments }}, {{ if .IsMappable }}args.Arg{{ end }}{{ .ArgName }}{{ end }})
+	if err != nil {
+		return nil, err
+	}
+
+	{{ if .IsResponsePlural -}}
+	envelope := newPluralEnvelope(len(result0))
+	{{ range $i, $v := .AllResults -}}
+	{{ $payloadType := $op.ResponsePayloadType .SourceField -}}
+	var payload{{$i}} []{{$payloadType.GoTypeSourceCode}}
+	for _, v := range result{{$i}} {
+		payload := {{$payloadType.ZeroInitializeSourceCode}}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload{{$i}} = append(payload{{$i}}, payload)
+	}
+	envelope["{{$op.ResponsePayloadKey .SourceField}}"] = payload{{$i}}
+	{{ end -}}
+	{{ else -}}
+	envelope := newSingularEnvelope()
+	{{ range $i, $v := .AllResults -}}
+	{{ $payloadType := $op.ResponsePayloadType .SourceField -}}
+	{{ if eq $payloadType.GoTypeSourceCode "*naked.MonitorValues" -}}
+	payload{{$i}}, err := newMonitorValuesPayload(result{{$i}})
+	if err != nil {
+		return nil, err
+	}
+	{{ else -}}
+	payload{{$i}} := {{$payloadType.ZeroInitializeSourceCode}}
+	if err := mapconv.ConvertTo(result{{$i}}, payload{{$i}}); err != nil {
+		return nil, err
+	}
+	{{ end -}}
+	{{ if $op.IsResponsePayloadEmbedded .SourceField -}}
+	if err := embedPayload(envelope, payload{{$i}}); err != nil {
+		return nil, err
+	}
+	{{ else -}}
+	envelope["{{$op.ResponsePayloadKey .SourceField}}"] = payload{{$i}}
+	{{ end -}}
+	{{ end -}}
+	{{ end -}}
+	return envelope, nil
+}
+{{ end -}}
+{{ end -}}
+`
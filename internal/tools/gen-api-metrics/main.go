@@ -0,0 +1,80 @@
+package main
+
+import (
+	"log"
+	"path/filepath"
+
+	"github.com/sacloud/libsacloud-v2/internal/define"
+	"github.com/sacloud/libsacloud-v2/internal/schema"
+	"github.com/sacloud/libsacloud-v2/internal/tools"
+)
+
+const destination = "sacloud/metrics/zz_api_metrics.go"
+
+func init() {
+	log.SetFlags(0)
+	log.SetPrefix("gen-api-metrics: ")
+}
+
+func main() {
+	schema.IsOutOfSacloudPackage = true
+
+	tools.WriteFileWithTemplate(&tools.TemplateConfig{
+		OutputPath: filepath.Join(tools.ProjectRootPath(), destination),
+		Template:   tmpl,
+		Parameter:  define.Resources,
+	})
+	log.Printf("generated: %s\n", filepath.Join(destination))
+}
+
+const tmpl = `// generated by 'github.com/sacloud/libsacloud/internal/tools/gen-api-metrics'; DO NOT EDIT
+
+package metrics
+
+import (
+{{- range .ImportStatements "context" "time" }}
+	{{ . }}
+{{- end }}
+)
+
+{{ range . }} {{ $typeName := .TypeName }}
+
+/************************************************* 
+* {{ $typeName }}Metrics
+*************************************************/
+
+// {{ $typeName }}Metrics is for collect metrics of {{ $typeName }}Op operations
+type {{ $typeName }}Metrics struct {
+	Internal  sacloud.{{$typeName}}API
+	Collector sacloud.MetricsCollector
+}
+
+// New{{ $typeName}}Metrics creates new {{ $typeName}}Metrics instance
+func New{{ $typeName}}Metrics(in sacloud.{{$typeName}}API, collector sacloud.MetricsCollector) sacloud.{{$typeName}}API {
+	return &{{ $typeName}}Metrics {
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+{{ range .Operations }}{{ $operationName := .MethodName }}
+// {{ .MethodName }} is API call with collecting metrics
+func (m *{{ $typeName }}Metrics) {{ .MethodName }}(ctx context.Context{{ range .AllArguments }}, {{ .ArgName }} {{ .TypeName }}{{ end }}) {{.ResultsStatement}} {
+	ctx = sacloud.WithOperation(ctx, "{{ $typeName }}", "{{ .MethodName }}")
+	start := time.Now()
+
+	{{ range $i, $v := .AllResults }}result{{ $i }}, {{ end }}err := m.Internal.{{ .MethodName }}(ctx{{ range .AllArguments }}, {{ .ArgName }}{{ end }})
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "{{ $typeName }}",
+		OperationName: "{{ .MethodName }}",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return {{ range $i, $v := .AllResults }}result{{ $i }}, {{ end }}err
+}
+{{- end -}}
+
+{{ end }}
+`
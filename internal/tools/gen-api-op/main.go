@@ -72,7 +72,7 @@ func New{{ $typeName}}Op(caller APICaller) {{ $typeName}}API {
 // {{ .MethodName }} is API call
 func (o *{{ $typeName }}Op) {{ .MethodName }}(ctx context.Context{{ range .AllArguments }}, {{ .ArgName }} {{ .TypeName }}{{ end }}) {{.ResultsStatement}} {
 	url, err := buildURL("{{.GetPathFormat}}", map[string]interface{}{
-		"rootURL": SakuraCloudAPIRoot,
+		"rootURL": resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName": o.PathName,
 		{{- range .AllArguments }}
@@ -0,0 +1,25 @@
+package accessor
+
+/************************************************
+ AssignedMemoryMB - AssignedMemoryGB
+************************************************/
+
+// AssignedMemoryMB is accessor interface of AssignedMemoryMB field
+type AssignedMemoryMB interface {
+	GetAssignedMemoryMB() int
+	SetAssignedMemoryMB(size int)
+}
+
+// GetAssignedMemoryGB returns GB
+func GetAssignedMemoryGB(target AssignedMemoryMB) int {
+	sizeMB := target.GetAssignedMemoryMB()
+	if sizeMB == 0 {
+		return 0
+	}
+	return sizeMB / 1024
+}
+
+// SetAssignedMemoryGB sets AssignedMemoryMB from GB
+func SetAssignedMemoryGB(target AssignedMemoryMB, size int) {
+	target.SetAssignedMemoryMB(size * 1024)
+}
@@ -0,0 +1,31 @@
+package sacloud
+
+import (
+	"encoding/csv"
+	"strings"
+)
+
+// HeaderRow 請求明細CSVのヘッダ行
+func (o *BillDetailCSV) HeaderRow() ([]string, error) {
+	rows, err := o.rows()
+	if err != nil || len(rows) == 0 {
+		return nil, err
+	}
+	return rows[0], nil
+}
+
+// BodyRows 請求明細CSVのヘッダ行を除いた明細行
+func (o *BillDetailCSV) BodyRows() ([][]string, error) {
+	rows, err := o.rows()
+	if err != nil || len(rows) < 2 {
+		return nil, err
+	}
+	return rows[1:], nil
+}
+
+func (o *BillDetailCSV) rows() ([][]string, error) {
+	if o.RawBody == "" {
+		return nil, nil
+	}
+	return csv.NewReader(strings.NewReader(o.RawBody)).ReadAll()
+}
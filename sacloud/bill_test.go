@@ -0,0 +1,32 @@
+package sacloud
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBillDetailCSV_Rows(t *testing.T) {
+	csv := &BillDetailCSV{
+		RawBody: "ContractID,Description,Amount\n1,\"サーバ,ディスク\",100\n2,スイッチ,200\n",
+	}
+
+	header, err := csv.HeaderRow()
+	require.NoError(t, err)
+	require.Equal(t, []string{"ContractID", "Description", "Amount"}, header)
+
+	body, err := csv.BodyRows()
+	require.NoError(t, err)
+	require.Equal(t, [][]string{
+		{"1", "サーバ,ディスク", "100"},
+		{"2", "スイッチ", "200"},
+	}, body)
+
+	empty := &BillDetailCSV{}
+	header, err = empty.HeaderRow()
+	require.NoError(t, err)
+	require.Empty(t, header)
+	body, err = empty.BodyRows()
+	require.NoError(t, err)
+	require.Empty(t, body)
+}
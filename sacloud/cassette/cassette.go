@@ -0,0 +1,109 @@
+// Package cassette APIコールの記録/再生を行うAPICallerの実装
+//
+// Recorderは実際のAPICallerへのリクエスト/レスポンスをカセット(JSONファイル)へ記録し、
+// Replayerは記録済みのカセットからレスポンスを返す。
+// これによりネットワークへアクセスせずに実際のAPIのレスポンスを用いたテストを行える。
+package cassette
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Interaction 1回分のAPIコールの記録
+type Interaction struct {
+	// Method HTTPメソッド
+	Method string `json:"method"`
+	// URL リクエスト先URL
+	URL string `json:"url"`
+	// RequestBody リクエストボディ(JSON)
+	RequestBody string `json:"request_body,omitempty"`
+	// ResponseBody レスポンスボディ(JSON)
+	ResponseBody string `json:"response_body,omitempty"`
+	// Error APIコールがエラーとなった場合のエラー情報
+	Error *Error `json:"error,omitempty"`
+}
+
+// Error APIコール時のエラー情報
+type Error struct {
+	// StatusCode APIエラーの場合のレスポンスコード、APIエラー以外の場合は0
+	StatusCode int `json:"status_code,omitempty"`
+	// Message エラーメッセージ
+	Message string `json:"message"`
+	// Response APIエラーの場合のレスポンスボディ(JSON)
+	Response string `json:"response,omitempty"`
+}
+
+// Cassette APIコールの記録
+type Cassette struct {
+	// Interactions 記録されたAPIコール
+	Interactions []*Interaction `json:"interactions"`
+
+	mu sync.Mutex
+}
+
+// Add APIコールの記録を追加
+func (c *Cassette) Add(interaction *Interaction) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Interactions = append(c.Interactions, interaction)
+}
+
+// Load ファイルからカセットを読み込む
+func Load(path string) (*Cassette, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	cassette := &Cassette{}
+	if err := json.Unmarshal(data, cassette); err != nil {
+		return nil, err
+	}
+	return cassette, nil
+}
+
+// Save ファイルへカセットを書き込む
+func (c *Cassette) Save(path string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// normalizeURL URLからAPIルートURLを取り除いた{zone}/api/...以降の部分を返す
+//
+// 記録時と再生時でAPIルートURLが異なっていてもマッチさせるために利用する
+func normalizeURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	path := u.Path
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		if segment == "api" && i > 0 {
+			path = "/" + strings.Join(segments[i-1:], "/")
+			break
+		}
+	}
+	if u.RawQuery != "" {
+		query, err := url.QueryUnescape(u.RawQuery)
+		if err != nil {
+			query = u.RawQuery
+		}
+		path += "?" + query
+	}
+	return path
+}
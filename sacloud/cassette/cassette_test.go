@@ -0,0 +1,201 @@
+package cassette
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/fake/server"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testZone = "is1a"
+
+func TestRecordAndReplay(t *testing.T) {
+	apiServer := httptest.NewServer(&server.Server{})
+	defer apiServer.Close()
+
+	client := sacloud.NewClient("dummy-token", "dummy-secret")
+	client.APIRootURL = apiServer.URL
+
+	dir, err := ioutil.TempDir("", "libsacloud-v2-cassette")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "note.json")
+
+	// record
+	recorder := NewRecorder(client)
+	recorded := runNoteOperations(t, recorder)
+	require.NoError(t, recorder.Save(path))
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "dummy-token")
+
+	// replay
+	replayer, err := LoadReplayer(path)
+	require.NoError(t, err)
+
+	replayed := runNoteOperations(t, replayer)
+	require.Equal(t, recorded, replayed)
+	require.Equal(t, 0, replayer.Remaining())
+
+	_, err = sacloud.NewNoteOp(replayer).Read(context.Background(), testZone, recorded.ID)
+	require.Error(t, err)
+	require.True(t, errors.Is(err, ErrInteractionNotFound))
+}
+
+func TestRecorder_ScrubCredentialFromProvider(t *testing.T) {
+	apiServer := httptest.NewServer(&server.Server{})
+	defer apiServer.Close()
+
+	client := sacloud.NewClientWithCredentialProvider(sacloud.NewStaticCredentialProvider("provided-token", "provided-secret"))
+	client.APIRootURL = apiServer.URL
+
+	recorder := NewRecorder(client)
+	noteOp := sacloud.NewNoteOp(recorder)
+	ctx := context.Background()
+
+	// 認証情報がリクエスト/レスポンスに含まれるケース
+	note, err := noteOp.Create(ctx, testZone, &sacloud.NoteCreateRequest{
+		Name:    "provided-token",
+		Class:   "shell",
+		Content: "provided-secret",
+	})
+	require.NoError(t, err)
+	require.NoError(t, noteOp.Delete(ctx, testZone, note.ID))
+
+	dir, err := ioutil.TempDir("", "libsacloud-v2-cassette")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "note.json")
+	require.NoError(t, recorder.Save(path))
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "provided-token")
+	require.NotContains(t, string(data), "provided-secret")
+}
+
+func TestRecorder_WrappedAPIError(t *testing.T) {
+	apiErr := sacloud.NewAPIError(http.MethodGet, &url.URL{Path: "/is1a/api/cloud/1.1/note/1"}, "", http.StatusNotFound, &sacloud.APIErrorResponse{
+		Status:       "404 NotFound",
+		ErrorCode:    "not_found",
+		ErrorMessage: "not found",
+	})
+	recorder := NewRecorder(apiCallerFunc(func(ctx context.Context, method, uri string, body interface{}) ([]byte, error) {
+		return nil, fmt.Errorf("wrapped: %w", apiErr)
+	}))
+
+	_, err := recorder.Do(context.Background(), http.MethodGet, "http://localhost/is1a/api/cloud/1.1/note/1", nil)
+	require.Error(t, err)
+
+	require.Len(t, recorder.Cassette.Interactions, 1)
+	recorded := recorder.Cassette.Interactions[0].Error
+	require.Equal(t, http.StatusNotFound, recorded.StatusCode)
+	require.Contains(t, recorded.Response, "not_found")
+}
+
+type apiCallerFunc func(ctx context.Context, method, uri string, body interface{}) ([]byte, error)
+
+func (f apiCallerFunc) Do(ctx context.Context, method, uri string, body interface{}) ([]byte, error) {
+	return f(ctx, method, uri, body)
+}
+
+func runNoteOperations(t *testing.T, caller sacloud.APICaller) *sacloud.Note {
+	ctx := context.Background()
+	noteOp := sacloud.NewNoteOp(caller)
+
+	note, err := noteOp.Create(ctx, testZone, &sacloud.NoteCreateRequest{
+		Name:    "libsacloud-v2-cassette",
+		Class:   "shell",
+		Content: "content",
+	})
+	require.NoError(t, err)
+
+	read, err := noteOp.Read(ctx, testZone, note.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, noteOp.Delete(ctx, testZone, note.ID))
+
+	_, err = noteOp.Read(ctx, testZone, note.ID)
+	require.True(t, sacloud.IsNotFoundError(err))
+
+	return read
+}
+
+func TestScrubber(t *testing.T) {
+	scrubber := NewScrubber("token-value")
+
+	scrubbed := scrubber.ScrubJSON([]byte(`{"Disk":{"Password":"p@ssw0rd","Name":"token-value","SizeMB":20480},"Tags":["token-value"]}`))
+	require.JSONEq(t, `{"Disk":{"Password":"[scrubbed]","Name":"[scrubbed]","SizeMB":20480},"Tags":["[scrubbed]"]}`, scrubbed)
+
+	require.Equal(t, "http://[scrubbed]@localhost", scrubber.ScrubString("http://token-value@localhost"))
+	require.Equal(t, "not json token", NewScrubber("secret").ScrubJSON([]byte("not json token")))
+}
+
+func TestMatcher(t *testing.T) {
+	recorded := &Interaction{
+		Method:      "PUT",
+		URL:         "https://secure.sakura.ad.jp/cloud/zone/is1a/api/cloud/1.1/note/1",
+		RequestBody: `{"Note":{"Name":"foo","Tags":["a","b"]}}`,
+	}
+
+	cases := []struct {
+		msg      string
+		req      *Request
+		expected bool
+	}{
+		{
+			msg: "matched with different root url",
+			req: &Request{
+				Method: "PUT",
+				URL:    "http://127.0.0.1:8080/is1a/api/cloud/1.1/note/1",
+				Body:   `{"Note":{"Tags":["a","b"],"Name":"foo"}}`,
+			},
+			expected: true,
+		},
+		{
+			msg: "different method",
+			req: &Request{
+				Method: "GET",
+				URL:    "http://127.0.0.1:8080/is1a/api/cloud/1.1/note/1",
+				Body:   `{"Note":{"Name":"foo","Tags":["a","b"]}}`,
+			},
+		},
+		{
+			msg: "different zone",
+			req: &Request{
+				Method: "PUT",
+				URL:    "http://127.0.0.1:8080/tk1a/api/cloud/1.1/note/1",
+				Body:   `{"Note":{"Name":"foo","Tags":["a","b"]}}`,
+			},
+		},
+		{
+			msg: "different body",
+			req: &Request{
+				Method: "PUT",
+				URL:    "http://127.0.0.1:8080/is1a/api/cloud/1.1/note/1",
+				Body:   `{"Note":{"Name":"bar","Tags":["a","b"]}}`,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		require.Equal(t, tc.expected, DefaultMatcher(recorded, tc.req), tc.msg)
+	}
+
+	require.True(t, MatchAll(MatchMethod, MatchURL)(recorded, &Request{
+		Method: "PUT",
+		URL:    "http://127.0.0.1:8080/is1a/api/cloud/1.1/note/" + types.ID(1).String(),
+	}))
+}
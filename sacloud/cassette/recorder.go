@@ -0,0 +1,111 @@
+package cassette
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+)
+
+// Recorder APIコールをカセットへ記録するAPICallerの実装
+type Recorder struct {
+	// Caller 実際にAPIコールを行うAPICaller
+	Caller sacloud.APICaller
+	// Cassette 記録先のカセット
+	Cassette *Cassette
+	// Scrubber 記録時に秘匿情報を取り除くためのScrubber nilの場合は記録内容をそのまま保持する
+	Scrubber *Scrubber
+	// CredentialProvider 記録内容から取り除く認証情報の取得元
+	//
+	// 指定した場合、APIコールごとに取得したアクセストークン/シークレットもScrubberでの秘匿対象となる
+	CredentialProvider sacloud.CredentialProvider
+}
+
+// NewRecorder Recorderを作成する
+//
+// callerが*sacloud.Clientの場合、アクセストークン/シークレット(CredentialProviderから取得するものを含む)は記録内容から取り除かれる
+func NewRecorder(caller sacloud.APICaller) *Recorder {
+	var secrets []string
+	var provider sacloud.CredentialProvider
+	if client, ok := caller.(*sacloud.Client); ok {
+		secrets = append(secrets, client.AccessToken, client.AccessTokenSecret)
+		provider = client.CredentialProvider
+	}
+	return &Recorder{
+		Caller:             caller,
+		Cassette:           &Cassette{},
+		Scrubber:           NewScrubber(secrets...),
+		CredentialProvider: provider,
+	}
+}
+
+// Do APICallerの実装
+func (r *Recorder) Do(ctx context.Context, method, uri string, body interface{}) ([]byte, error) {
+	data, err := r.Caller.Do(ctx, method, uri, body)
+	scrubber := r.scrubber(ctx)
+
+	interaction := &Interaction{
+		Method: method,
+		URL:    scrubber.ScrubString(uri),
+	}
+	if body != nil {
+		requestBody, e := json.Marshal(body)
+		if e != nil {
+			return nil, e
+		}
+		interaction.RequestBody = scrubber.ScrubJSON(requestBody)
+	}
+
+	if err != nil {
+		interaction.Error = newError(scrubber, err)
+	} else {
+		interaction.ResponseBody = scrubber.ScrubJSON(data)
+	}
+
+	r.Cassette.Add(interaction)
+	return data, err
+}
+
+// ResolveAPIRootURL sacloud.APIRootURLResolverの実装
+func (r *Recorder) ResolveAPIRootURL(zone string) string {
+	if resolver, ok := r.Caller.(sacloud.APIRootURLResolver); ok {
+		return resolver.ResolveAPIRootURL(zone)
+	}
+	return ""
+}
+
+// Save カセットをファイルへ書き込む
+func (r *Recorder) Save(path string) error {
+	return r.Cassette.Save(path)
+}
+
+// scrubber CredentialProviderから取得した認証情報を秘匿対象に加えたScrubberを返す
+func (r *Recorder) scrubber(ctx context.Context) *Scrubber {
+	if r.Scrubber == nil || r.CredentialProvider == nil {
+		return r.Scrubber
+	}
+	cred, err := r.CredentialProvider.Retrieve(ctx)
+	if err != nil || cred == nil {
+		return r.Scrubber
+	}
+	return &Scrubber{
+		Keys:   r.Scrubber.Keys,
+		Values: append(append([]string{}, r.Scrubber.Values...), cred.AccessToken, cred.AccessTokenSecret),
+	}
+}
+
+func newError(scrubber *Scrubber, err error) *Error {
+	e := &Error{
+		Message: scrubber.ScrubString(err.Error()),
+	}
+	var apiError sacloud.APIError
+	if errors.As(err, &apiError) {
+		e.StatusCode = apiError.ResponseCode()
+		if orig := apiError.OrigErr(); orig != nil {
+			data, _ := json.Marshal(orig)
+			e.Response = scrubber.ScrubJSON(data)
+		}
+	}
+	return e
+}
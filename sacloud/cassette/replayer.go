@@ -0,0 +1,186 @@
+package cassette
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sync"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+)
+
+// Request 再生時のリクエスト内容、Matcherでの比較に利用される
+type Request struct {
+	// Method HTTPメソッド
+	Method string
+	// URL リクエスト先URL
+	URL string
+	// Body リクエストボディ(JSON、秘匿情報は取り除かれている)
+	Body string
+}
+
+// Matcher 記録済みのAPIコールとリクエストが一致するか判定する
+type Matcher func(recorded *Interaction, req *Request) bool
+
+// MatchMethod HTTPメソッドが一致するか判定するMatcher
+func MatchMethod(recorded *Interaction, req *Request) bool {
+	return recorded.Method == req.Method
+}
+
+// MatchURL APIルートURLを除いたURLが一致するか判定するMatcher
+func MatchURL(recorded *Interaction, req *Request) bool {
+	return normalizeURL(recorded.URL) == normalizeURL(req.URL)
+}
+
+// MatchBody リクエストボディがJSONとして等価か判定するMatcher
+func MatchBody(recorded *Interaction, req *Request) bool {
+	if recorded.RequestBody == req.Body {
+		return true
+	}
+	var v1, v2 interface{}
+	if err := decodeJSON(recorded.RequestBody, &v1); err != nil {
+		return false
+	}
+	if err := decodeJSON(req.Body, &v2); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(v1, v2)
+}
+
+// MatchAll 指定の全てのMatcherで一致するか判定するMatcherを返す
+func MatchAll(matchers ...Matcher) Matcher {
+	return func(recorded *Interaction, req *Request) bool {
+		for _, m := range matchers {
+			if !m(recorded, req) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// DefaultMatcher HTTPメソッド/URL/リクエストボディが一致するか判定するMatcher
+var DefaultMatcher = MatchAll(MatchMethod, MatchURL, MatchBody)
+
+// ErrInteractionNotFound リクエストに一致する記録済みのAPIコールが存在しない場合のエラー
+var ErrInteractionNotFound = errors.New("cassette: interaction not found")
+
+// Replayer 記録済みのカセットからレスポンスを返すAPICallerの実装
+//
+// 記録済みのAPIコールは記録された順に一度ずつ利用される
+type Replayer struct {
+	// Cassette 再生するカセット
+	Cassette *Cassette
+	// Matcher リクエストと記録済みのAPIコールの比較に利用するMatcher nilの場合DefaultMatcherが利用される
+	Matcher Matcher
+	// Scrubber リクエストから秘匿情報を取り除くためのScrubber 記録時と同じ設定にする必要がある
+	Scrubber *Scrubber
+
+	mu   sync.Mutex
+	used map[int]bool
+}
+
+// NewReplayer Replayerを作成する
+func NewReplayer(cassette *Cassette) *Replayer {
+	return &Replayer{
+		Cassette: cassette,
+		Matcher:  DefaultMatcher,
+		Scrubber: NewScrubber(),
+	}
+}
+
+// LoadReplayer ファイルからカセットを読み込みReplayerを作成する
+func LoadReplayer(path string) (*Replayer, error) {
+	cassette, err := Load(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewReplayer(cassette), nil
+}
+
+// Do APICallerの実装
+func (r *Replayer) Do(ctx context.Context, method, uri string, body interface{}) ([]byte, error) {
+	req := &Request{
+		Method: method,
+		URL:    uri,
+	}
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body = r.Scrubber.ScrubJSON(data)
+	}
+
+	interaction, err := r.find(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if interaction.Error != nil {
+		return nil, interaction.Error.toError(method, uri, req.Body)
+	}
+	return []byte(interaction.ResponseBody), nil
+}
+
+// Remaining 未使用の記録済みAPIコールの数を返す
+func (r *Replayer) Remaining() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.Cassette.Interactions) - len(r.used)
+}
+
+func (r *Replayer) find(req *Request) (*Interaction, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.used == nil {
+		r.used = make(map[int]bool)
+	}
+	matcher := r.Matcher
+	if matcher == nil {
+		matcher = DefaultMatcher
+	}
+
+	for i, interaction := range r.Cassette.Interactions {
+		if r.used[i] {
+			continue
+		}
+		if matcher(interaction, req) {
+			r.used[i] = true
+			return interaction, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrInteractionNotFound, req.Method, req.URL)
+}
+
+func (e *Error) toError(method, uri, body string) error {
+	if e.StatusCode == 0 {
+		return errors.New(e.Message)
+	}
+
+	errResponse := &sacloud.APIErrorResponse{}
+	if e.Response != "" {
+		if err := json.Unmarshal([]byte(e.Response), errResponse); err != nil {
+			return err
+		}
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return err
+	}
+	return sacloud.NewAPIError(method, u, body, e.StatusCode, errResponse)
+}
+
+func decodeJSON(data string, v interface{}) error {
+	if data == "" {
+		return nil
+	}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(data)))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
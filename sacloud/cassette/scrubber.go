@@ -0,0 +1,102 @@
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// ScrubbedValue 秘匿情報を置き換える際の値
+const ScrubbedValue = "[scrubbed]"
+
+// DefaultScrubKeys 値を秘匿するJSONのキーのデフォルト値
+var DefaultScrubKeys = []string{
+	"AccessToken",
+	"AccessTokenSecret",
+	"Password",
+	"DefaultPassword",
+	"PrivateKey",
+	"Secret",
+	"Token",
+}
+
+// Scrubber カセットへ記録する内容から秘匿情報を取り除く
+type Scrubber struct {
+	// Keys 値を秘匿するJSONのキー(大文字小文字を区別しない)
+	Keys []string
+	// Values 秘匿する文字列(アクセストークンなど)、出現する全ての箇所が置き換えられる
+	Values []string
+}
+
+// NewScrubber DefaultScrubKeysと指定の文字列を秘匿するScrubberを返す
+func NewScrubber(values ...string) *Scrubber {
+	return &Scrubber{
+		Keys:   DefaultScrubKeys,
+		Values: values,
+	}
+}
+
+// ScrubString 文字列から秘匿する文字列を取り除く
+func (s *Scrubber) ScrubString(v string) string {
+	if s == nil {
+		return v
+	}
+	for _, value := range s.Values {
+		if value != "" {
+			v = strings.Replace(v, value, ScrubbedValue, -1)
+		}
+	}
+	return v
+}
+
+// ScrubJSON JSONから秘匿情報を取り除く、JSONとして解釈できない場合はScrubStringの結果を返す
+func (s *Scrubber) ScrubJSON(data []byte) string {
+	if s == nil || len(data) == 0 {
+		return string(data)
+	}
+
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return s.ScrubString(string(data))
+	}
+
+	scrubbed, err := json.Marshal(s.scrubValue(v))
+	if err != nil {
+		return s.ScrubString(string(data))
+	}
+	return string(scrubbed)
+}
+
+func (s *Scrubber) scrubValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if _, ok := value.(string); ok && s.isScrubKey(key) {
+				v[key] = ScrubbedValue
+				continue
+			}
+			v[key] = s.scrubValue(value)
+		}
+		return v
+	case []interface{}:
+		for i, value := range v {
+			v[i] = s.scrubValue(value)
+		}
+		return v
+	case string:
+		return s.ScrubString(v)
+	default:
+		return v
+	}
+}
+
+func (s *Scrubber) isScrubKey(key string) bool {
+	for _, k := range s.Keys {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+	return false
+}
@@ -7,8 +7,8 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
-	"log"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/sacloud/libsacloud-v2"
@@ -38,6 +38,23 @@ type APICaller interface {
 	Do(ctx context.Context, method, uri string, body interface{}) ([]byte, error)
 }
 
+// APIRootURLResolver APIリクエスト送信先ルートURLを解決するインターフェース
+//
+// APICallerがこのインターフェースを実装している場合、SakuraCloudAPIRootの代わりに利用される
+type APIRootURLResolver interface {
+	ResolveAPIRootURL(zone string) string
+}
+
+// resolveAPIRootURL APICallerを元にAPIリクエスト送信先ルートURLを解決する
+func resolveAPIRootURL(caller APICaller, zone string) string {
+	if resolver, ok := caller.(APIRootURLResolver); ok {
+		if rootURL := resolver.ResolveAPIRootURL(zone); rootURL != "" {
+			return rootURL
+		}
+	}
+	return SakuraCloudAPIRoot
+}
+
 // Client APIクライアント、APICallerインターフェースを実装する
 type Client struct {
 	// AccessToken アクセストークン
@@ -52,12 +69,30 @@ type Client struct {
 	UserAgent string
 	// Accept-Language
 	AcceptLanguage string
-	// 503エラー時のリトライ回数
+	// 503エラー時のリトライ回数 RetryPolicyが指定されている場合は利用されない
 	RetryMax int
-	// 503エラー時のリトライ待ち時間
+	// 503エラー時のリトライ待ち時間 RetryPolicyが指定されている場合は利用されない
 	RetryInterval time.Duration
+	// RetryPolicy APIコール失敗時のリトライ方針 未指定の場合RetryMax/RetryIntervalを元に固定間隔でリトライする
+	RetryPolicy RetryPolicy
+	// RateLimiter APIコールの流量制限 未指定の場合は流量制限を行わない
+	RateLimiter *RateLimiter
+	// Middlewares APIコール時に追加で実行するMiddleware
+	Middlewares []Middleware
+	// Logger APIコール時のログ出力先 未指定の場合LogLevelに従い標準のlogパッケージへ出力する
+	Logger Logger
+	// Redactor ログ出力時にリクエスト/レスポンスボディから秘匿情報を取り除くためのRedactor 未指定の場合DefaultRedactorが利用される
+	Redactor *Redactor
+	// Metrics APIリクエストの計測値の収集先 未指定の場合は計測を行わない
+	Metrics MetricsCollector
+	// CredentialProvider 認証情報の提供元 指定されている場合はAccessToken/AccessTokenSecretの代わりにAPIコールごとに参照される
+	CredentialProvider CredentialProvider
 	// APIコール時に利用される*http.Client 未指定の場合http.DefaultClientが利用される
 	HTTPClient *http.Client
+	// APIRootURL APIリクエスト送信先ルートURL(末尾にスラッシュを含まない) 未指定の場合SakuraCloudAPIRootが利用される
+	APIRootURL string
+	// ZoneAPIRootURLs ゾーンごとのAPIリクエスト送信先ルートURL 指定されたゾーンではAPIRootURLより優先される
+	ZoneAPIRootURLs map[string]string
 }
 
 // NewClient APIクライアント作成
@@ -75,21 +110,64 @@ func NewClient(token, tokenSecret string) *Client {
 	return c
 }
 
+// NewClientWithCredentialProvider CredentialProviderから認証情報を取得するAPIクライアント作成
+func NewClientWithCredentialProvider(provider CredentialProvider) *Client {
+	c := NewClient("", "")
+	c.CredentialProvider = provider
+	return c
+}
+
 // Clone APIクライアント クローン作成
 func (c *Client) Clone() *Client {
 	n := &Client{
 		AccessToken:            c.AccessToken,
 		AccessTokenSecret:      c.AccessTokenSecret,
+		LogLevel:               c.LogLevel,
 		DefaultTimeoutDuration: c.DefaultTimeoutDuration,
 		UserAgent:              c.UserAgent,
 		AcceptLanguage:         c.AcceptLanguage,
 		RetryMax:               c.RetryMax,
 		RetryInterval:          c.RetryInterval,
+		RetryPolicy:            c.RetryPolicy,
+		RateLimiter:            c.RateLimiter,
+		Middlewares:            append([]Middleware(nil), c.Middlewares...),
+		CredentialProvider:     c.CredentialProvider,
+		Logger:                 c.Logger,
+		Redactor:               c.Redactor,
+		Metrics:                c.Metrics,
 		HTTPClient:             c.HTTPClient,
+		APIRootURL:             c.APIRootURL,
+	}
+	if c.ZoneAPIRootURLs != nil {
+		n.ZoneAPIRootURLs = make(map[string]string)
+		for zone, rootURL := range c.ZoneAPIRootURLs {
+			n.ZoneAPIRootURLs[zone] = rootURL
+		}
 	}
 	return n
 }
 
+// ResolveAPIRootURL 指定ゾーンのAPIリクエスト送信先ルートURLを返す、APIRootURLResolverインターフェースの実装
+func (c *Client) ResolveAPIRootURL(zone string) string {
+	if rootURL, ok := c.ZoneAPIRootURLs[zone]; ok && rootURL != "" {
+		return strings.TrimRight(rootURL, "/")
+	}
+	if c.APIRootURL != "" {
+		return strings.TrimRight(c.APIRootURL, "/")
+	}
+	return SakuraCloudAPIRoot
+}
+
+func (c *Client) retryPolicy() RetryPolicy {
+	if c.RetryPolicy != nil {
+		return c.RetryPolicy
+	}
+	return &legacyRetryPolicy{
+		retryMax:      c.RetryMax,
+		retryInterval: c.RetryInterval,
+	}
+}
+
 func (c *Client) isOkStatus(code int) bool {
 	codes := map[int]bool{
 		200: true,
@@ -118,154 +196,96 @@ func (c *Client) isOkStatus(code int) bool {
 // Do APIコール実施
 func (c *Client) Do(ctx context.Context, method, uri string, body interface{}) ([]byte, error) {
 	var (
-		client = &retryableHTTPClient{
-			Client:        c.HTTPClient,
-			retryMax:      c.RetryMax,
-			retryInterval: c.RetryInterval,
-		}
-		err     error
-		req     *request
+		url     = uri
+		reader  io.Reader
 		strBody string
 	)
-	var url = uri
 
 	if body != nil {
-		var bodyJSON []byte
-		bodyJSON, err = json.Marshal(body)
+		bodyJSON, err := json.Marshal(body)
 		if err != nil {
 			return nil, err
 		}
 		if method == "GET" {
 			url = fmt.Sprintf("%s?%s", url, bytes.NewBuffer(bodyJSON))
-			req, err = newRequest(ctx, method, url, nil)
 		} else {
-			req, err = newRequest(ctx, method, url, bytes.NewReader(bodyJSON))
+			reader = bytes.NewReader(bodyJSON)
 		}
 		b, _ := json.MarshalIndent(body, "", "\t")
 		strBody = string(b)
-		if c.LogLevel == LogLevelTrace {
-			log.Printf("[TRACE] method : %#v , url : %s , \nbody : %s", method, url, strBody)
-		}
-	} else {
-		req, err = newRequest(ctx, method, url, nil)
-		if c.LogLevel == LogLevelTrace {
-			log.Printf("[TRACE] method : %#v , url : %s ", method, url)
-		}
 	}
 
+	req, err := http.NewRequest(method, url, reader)
 	if err != nil {
 		return nil, fmt.Errorf("Error with request: %v - %q", url, err)
 	}
-
-	req.SetBasicAuth(c.AccessToken, c.AccessTokenSecret)
+	req = req.WithContext(ctx)
 	req.Header.Add("X-Sakura-Bigint-As-Int", "1") //Use BigInt on resource ids.
-	req.Header.Add("User-Agent", c.UserAgent)
-	if c.AcceptLanguage != "" {
-		req.Header.Add("Accept-Language", c.AcceptLanguage)
-	}
-	req.Method = method
 
-	resp, err := client.Do(req)
+	resp, err := c.transport().RoundTrip(req)
 	if err != nil {
-		return nil, err
+		return nil, &TransportError{Method: method, URL: uri, Err: err}
 	}
 	defer resp.Body.Close()
 
 	data, err := ioutil.ReadAll(resp.Body)
-
-	v := &map[string]interface{}{}
-	json.Unmarshal(data, v)
-	b, _ := json.MarshalIndent(v, "", "\t")
-	if c.LogLevel == LogLevelTrace {
-		log.Printf("[TRACE] response: %s", b)
+	if err != nil {
+		return nil, &TransportError{Method: method, URL: uri, Err: err}
 	}
-	if !c.isOkStatus(resp.StatusCode) {
 
+	if !c.isOkStatus(resp.StatusCode) {
 		errResponse := &APIErrorResponse{}
-		err := json.Unmarshal(data, errResponse)
-
-		if err != nil {
-			return nil, fmt.Errorf("Error in response: %s", string(data))
+		if err := json.Unmarshal(data, errResponse); err != nil {
+			// JSON以外のレスポンス(ロードバランサやメンテナンス画面など)の場合もAPIErrorとして扱う
+			errResponse = &APIErrorResponse{
+				Status:       resp.Status,
+				ErrorMessage: string(data),
+			}
 		}
 		return nil, NewAPIError(req.Method, req.URL, strBody, resp.StatusCode, errResponse)
-
-	}
-	if err != nil {
-		return nil, err
 	}
 
 	return data, nil
 }
 
-type lenReader interface {
-	Len() int
-}
-
-type request struct {
-	// body is a seekable reader over the request body payload. This is
-	// used to rewind the request data in between retries.
-	body io.ReadSeeker
-
-	// Embed an HTTP request directly. This makes a *Request act exactly
-	// like an *http.Request so that all meta methods are supported.
-	*http.Request
-}
-
-func newRequest(ctx context.Context, method, url string, body io.ReadSeeker) (*request, error) {
-	var rcBody io.ReadCloser
-	if body != nil {
-		rcBody = ioutil.NopCloser(body)
-	}
-
-	httpReq, err := http.NewRequest(method, url, rcBody)
-	if err != nil {
-		return nil, err
-	}
-
-	if lr, ok := body.(lenReader); ok {
-		httpReq.ContentLength = int64(lr.Len())
+func (c *Client) logger() Logger {
+	if c.Logger != nil {
+		return c.Logger
 	}
-
-	return &request{body, httpReq.WithContext(ctx)}, nil
+	return NewStdLogger(c.LogLevel)
 }
 
-type retryableHTTPClient struct {
-	*http.Client
-	retryInterval time.Duration
-	retryMax      int
+func (c *Client) authMiddleware() Middleware {
+	if c.CredentialProvider != nil {
+		return CredentialMiddleware(c.CredentialProvider)
+	}
+	return AuthMiddleware(c.AccessToken, c.AccessTokenSecret)
 }
 
-func (c *retryableHTTPClient) Do(req *request) (*http.Response, error) {
-	if c.Client == nil {
-		c.Client = http.DefaultClient
+// transport 組み込みのMiddlewareとMiddlewaresを連結したhttp.RoundTripperを返す
+//
+// Middlewaresは認証やヘッダ付与の後、ログ出力/リトライの外側で実行される
+func (c *Client) transport() http.RoundTripper {
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
 	}
-	for i := 0; ; i++ {
-
-		if req.body != nil {
-			if _, err := req.body.Seek(0, 0); err != nil {
-				return nil, fmt.Errorf("failed to seek body: %v", err)
-			}
-		}
-
-		res, err := c.Client.Do(req.Request)
-		if res != nil && res.StatusCode != http.StatusServiceUnavailable && res.StatusCode != http.StatusLocked {
-			return res, err
-		}
-		if res != nil && res.Body != nil {
-			res.Body.Close()
-		}
-
-		if err != nil {
-			return res, err
-		}
 
-		remain := c.retryMax - i
-		if remain == 0 {
-			break
-		}
-		time.Sleep(c.retryInterval)
+	var middlewares []Middleware
+	middlewares = append(middlewares,
+		RequestIDMiddleware(DefaultRequestIDHeader, nil),
+		UserAgentMiddleware(c.UserAgent),
+		HeaderMiddleware("Accept-Language", c.AcceptLanguage),
+		c.authMiddleware(),
+	)
+	middlewares = append(middlewares, c.Middlewares...)
+	if c.Metrics != nil {
+		middlewares = append(middlewares, requestMetricsMiddleware(c.Metrics))
 	}
+	middlewares = append(middlewares,
+		LoggingMiddleware(c.logger(), c.Redactor),
+		RetryMiddleware(c.retryPolicy(), c.RateLimiter),
+	)
 
-	return nil, fmt.Errorf("%s %s giving up after %d attempts",
-		req.Method, req.URL, c.retryMax+1)
+	return Chain(RoundTripperFunc(httpClient.Do), middlewares...)
 }
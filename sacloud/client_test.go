@@ -0,0 +1,83 @@
+package sacloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ResolveAPIRootURL(t *testing.T) {
+	cases := []struct {
+		msg      string
+		client   *Client
+		zone     string
+		expected string
+	}{
+		{
+			msg:      "default",
+			client:   &Client{},
+			zone:     "is1a",
+			expected: SakuraCloudAPIRoot,
+		},
+		{
+			msg:      "with APIRootURL",
+			client:   &Client{APIRootURL: "http://localhost:8080/"},
+			zone:     "is1a",
+			expected: "http://localhost:8080",
+		},
+		{
+			msg: "with ZoneAPIRootURLs",
+			client: &Client{
+				APIRootURL:      "http://localhost:8080",
+				ZoneAPIRootURLs: map[string]string{"tk1v": "http://localhost:8081"},
+			},
+			zone:     "tk1v",
+			expected: "http://localhost:8081",
+		},
+		{
+			msg: "with ZoneAPIRootURLs but not matched",
+			client: &Client{
+				APIRootURL:      "http://localhost:8080",
+				ZoneAPIRootURLs: map[string]string{"tk1v": "http://localhost:8081"},
+			},
+			zone:     "is1a",
+			expected: "http://localhost:8080",
+		},
+	}
+
+	for _, tc := range cases {
+		require.Equal(t, tc.expected, tc.client.ResolveAPIRootURL(tc.zone), tc.msg)
+	}
+}
+
+func TestClient_APIRootURLPerClient(t *testing.T) {
+	newServer := func(requested *string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*requested = r.URL.Path
+			w.Write([]byte(`{"Zone":{"ID":1,"Name":"is1a"},"is_ok":true}`)) // nolint
+		}))
+	}
+
+	var requested1, requested2 string
+	server1 := newServer(&requested1)
+	defer server1.Close()
+	server2 := newServer(&requested2)
+	defer server2.Close()
+
+	client1 := NewClient("token", "secret")
+	client1.APIRootURL = server1.URL
+
+	client2 := client1.Clone()
+	client2.ZoneAPIRootURLs = map[string]string{"tk1v": server2.URL}
+
+	_, err := NewZoneOp(client1).Read(context.Background(), "tk1v", 1)
+	require.NoError(t, err)
+	require.Equal(t, "/tk1v/api/cloud/1.1/zone/1", requested1)
+
+	_, err = NewZoneOp(client2).Read(context.Background(), "tk1v", 1)
+	require.NoError(t, err)
+	require.Equal(t, "/tk1v/api/cloud/1.1/zone/1", requested2)
+}
@@ -0,0 +1,246 @@
+package sacloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// EnvAccessToken アクセストークンを保持する環境変数名
+	EnvAccessToken = "SAKURACLOUD_ACCESS_TOKEN"
+	// EnvAccessTokenSecret アクセストークンシークレットを保持する環境変数名
+	EnvAccessTokenSecret = "SAKURACLOUD_ACCESS_TOKEN_SECRET"
+	// EnvProfile 利用するusacloudプロファイル名を保持する環境変数名
+	EnvProfile = "USACLOUD_PROFILE"
+	// EnvProfileDir usacloudプロファイルのベースディレクトリを保持する環境変数名
+	EnvProfileDir = "USACLOUD_PROFILE_DIR"
+
+	// DefaultProfileName デフォルトのプロファイル名
+	DefaultProfileName = "default"
+)
+
+// ErrNoCredential 認証情報が見つからない場合のエラー
+var ErrNoCredential = errors.New("credential is not found")
+
+// Credential APIキー
+type Credential struct {
+	// AccessToken アクセストークン
+	AccessToken string
+	// AccessTokenSecret アクセストークンシークレット
+	AccessTokenSecret string
+}
+
+// IsEmpty アクセストークン/シークレットのいずれかが未設定か
+func (c *Credential) IsEmpty() bool {
+	return c == nil || c.AccessToken == "" || c.AccessTokenSecret == ""
+}
+
+// CredentialProvider 認証情報を提供するインターフェース
+//
+// Clientに設定した場合はAPIコールごとに呼ばれるため、実装側で認証情報を更新することができる
+type CredentialProvider interface {
+	Retrieve(ctx context.Context) (*Credential, error)
+}
+
+// CredentialProviderFunc funcをCredentialProviderとして扱うためのアダプタ
+type CredentialProviderFunc func(ctx context.Context) (*Credential, error)
+
+// Retrieve CredentialProviderの実装
+func (f CredentialProviderFunc) Retrieve(ctx context.Context) (*Credential, error) {
+	return f(ctx)
+}
+
+// StaticCredentialProvider 固定の認証情報を提供するCredentialProvider
+type StaticCredentialProvider struct {
+	Credential Credential
+}
+
+// NewStaticCredentialProvider StaticCredentialProviderを返す
+func NewStaticCredentialProvider(token, secret string) *StaticCredentialProvider {
+	return &StaticCredentialProvider{
+		Credential: Credential{
+			AccessToken:       token,
+			AccessTokenSecret: secret,
+		},
+	}
+}
+
+// Retrieve CredentialProviderの実装
+func (p *StaticCredentialProvider) Retrieve(ctx context.Context) (*Credential, error) {
+	if p.Credential.IsEmpty() {
+		return nil, ErrNoCredential
+	}
+	c := p.Credential
+	return &c, nil
+}
+
+// EnvCredentialProvider 環境変数から認証情報を提供するCredentialProvider
+//
+// 環境変数名が未指定の場合はEnvAccessToken/EnvAccessTokenSecretが利用される
+type EnvCredentialProvider struct {
+	// TokenEnvName アクセストークンを保持する環境変数名
+	TokenEnvName string
+	// SecretEnvName アクセストークンシークレットを保持する環境変数名
+	SecretEnvName string
+}
+
+// Retrieve CredentialProviderの実装
+func (p *EnvCredentialProvider) Retrieve(ctx context.Context) (*Credential, error) {
+	tokenEnvName := p.TokenEnvName
+	if tokenEnvName == "" {
+		tokenEnvName = EnvAccessToken
+	}
+	secretEnvName := p.SecretEnvName
+	if secretEnvName == "" {
+		secretEnvName = EnvAccessTokenSecret
+	}
+
+	c := &Credential{
+		AccessToken:       os.Getenv(tokenEnvName),
+		AccessTokenSecret: os.Getenv(secretEnvName),
+	}
+	if c.IsEmpty() {
+		return nil, ErrNoCredential
+	}
+	return c, nil
+}
+
+// ProfileCredentialProvider usacloud互換のプロファイルから認証情報を提供するCredentialProvider
+//
+// プロファイルは{ベースディレクトリ}/{プロファイル名}/config.jsonから読み込まれる
+type ProfileCredentialProvider struct {
+	// ProfileName プロファイル名
+	//
+	// 未指定の場合は環境変数USACLOUD_PROFILE、{ベースディレクトリ}/currentファイルの内容、defaultの順で決定される
+	ProfileName string
+	// BaseDir プロファイルのベースディレクトリ
+	//
+	// 未指定の場合は環境変数USACLOUD_PROFILE_DIR、もしくはホームディレクトリ配下の.usacloudが利用される
+	BaseDir string
+}
+
+type profileConfig struct {
+	AccessToken       string
+	AccessTokenSecret string
+}
+
+// Retrieve CredentialProviderの実装
+func (p *ProfileCredentialProvider) Retrieve(ctx context.Context) (*Credential, error) {
+	baseDir, err := p.baseDir()
+	if err != nil {
+		return nil, err
+	}
+
+	path := filepath.Join(baseDir, p.profileName(baseDir), "config.json")
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNoCredential
+		}
+		return nil, err
+	}
+
+	config := &profileConfig{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("parsing profile %q failed: %s", path, err)
+	}
+
+	c := &Credential{
+		AccessToken:       config.AccessToken,
+		AccessTokenSecret: config.AccessTokenSecret,
+	}
+	if c.IsEmpty() {
+		return nil, ErrNoCredential
+	}
+	return c, nil
+}
+
+func (p *ProfileCredentialProvider) baseDir() (string, error) {
+	if p.BaseDir != "" {
+		return p.BaseDir, nil
+	}
+	if dir := os.Getenv(EnvProfileDir); dir != "" {
+		return filepath.Join(dir, ".usacloud"), nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".usacloud"), nil
+}
+
+func (p *ProfileCredentialProvider) profileName(baseDir string) string {
+	if p.ProfileName != "" {
+		return p.ProfileName
+	}
+	if name := os.Getenv(EnvProfile); name != "" {
+		return name
+	}
+	if data, err := ioutil.ReadFile(filepath.Join(baseDir, "current")); err == nil {
+		if name := strings.TrimSpace(string(data)); name != "" {
+			return name
+		}
+	}
+	return DefaultProfileName
+}
+
+// ChainCredentialProvider 複数のCredentialProviderを順に参照し、最初に見つかった認証情報を提供するCredentialProvider
+type ChainCredentialProvider struct {
+	Providers []CredentialProvider
+}
+
+// NewChainCredentialProvider ChainCredentialProviderを返す
+func NewChainCredentialProvider(providers ...CredentialProvider) *ChainCredentialProvider {
+	return &ChainCredentialProvider{Providers: providers}
+}
+
+// NewDefaultCredentialProvider 環境変数、usacloudプロファイルの順に参照するCredentialProviderを返す
+func NewDefaultCredentialProvider() CredentialProvider {
+	return NewChainCredentialProvider(
+		&EnvCredentialProvider{},
+		&ProfileCredentialProvider{},
+	)
+}
+
+// Retrieve CredentialProviderの実装
+//
+// ErrNoCredential以外のエラーが発生した場合はその時点でエラーを返す
+func (p *ChainCredentialProvider) Retrieve(ctx context.Context) (*Credential, error) {
+	for _, provider := range p.Providers {
+		c, err := provider.Retrieve(ctx)
+		if err != nil {
+			if errors.Is(err, ErrNoCredential) {
+				continue
+			}
+			return nil, err
+		}
+		if !c.IsEmpty() {
+			return c, nil
+		}
+	}
+	return nil, ErrNoCredential
+}
+
+// CredentialMiddleware CredentialProviderから取得した認証情報でBasic認証を行うMiddleware
+//
+// 認証情報はリクエストごとに取得される
+func CredentialMiddleware(provider CredentialProvider) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			c, err := provider.Retrieve(req.Context())
+			if err != nil {
+				return nil, err
+			}
+			r := cloneRequest(req)
+			r.SetBasicAuth(c.AccessToken, c.AccessTokenSecret)
+			return next.RoundTrip(r)
+		})
+	}
+}
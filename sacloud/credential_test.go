@@ -0,0 +1,127 @@
+package sacloud
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func setEnvForTest(t *testing.T, key, value string) func() {
+	org, exists := os.LookupEnv(key)
+	require.NoError(t, os.Setenv(key, value))
+	return func() {
+		if exists {
+			os.Setenv(key, org) // nolint
+		} else {
+			os.Unsetenv(key) // nolint
+		}
+	}
+}
+
+func TestEnvCredentialProvider(t *testing.T) {
+	defer setEnvForTest(t, EnvAccessToken, "token")()
+	defer setEnvForTest(t, EnvAccessTokenSecret, "")()
+
+	ctx := context.Background()
+	provider := &EnvCredentialProvider{}
+
+	_, err := provider.Retrieve(ctx)
+	require.True(t, errors.Is(err, ErrNoCredential))
+
+	os.Setenv(EnvAccessTokenSecret, "secret") // nolint
+	c, err := provider.Retrieve(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &Credential{AccessToken: "token", AccessTokenSecret: "secret"}, c)
+}
+
+func TestProfileCredentialProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "libsacloud-v2-profile")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	defer setEnvForTest(t, EnvProfile, "")()
+
+	writeProfile := func(name, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, name), 0755))
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name, "config.json"), []byte(content), 0600))
+	}
+	writeProfile("default", `{"AccessToken":"default-token","AccessTokenSecret":"default-secret","Zone":"is1a"}`)
+	writeProfile("test", `{"AccessToken":"test-token","AccessTokenSecret":"test-secret"}`)
+
+	ctx := context.Background()
+	provider := &ProfileCredentialProvider{BaseDir: dir}
+
+	c, err := provider.Retrieve(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "default-token", c.AccessToken)
+
+	// currentファイルでの切り替え
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "current"), []byte("test\n"), 0600))
+	c, err = provider.Retrieve(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "test-token", c.AccessToken)
+
+	// 環境変数での切り替え
+	os.Setenv(EnvProfile, "default") // nolint
+	c, err = provider.Retrieve(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "default-token", c.AccessToken)
+
+	_, err = (&ProfileCredentialProvider{BaseDir: dir, ProfileName: "not-exists"}).Retrieve(ctx)
+	require.True(t, errors.Is(err, ErrNoCredential))
+}
+
+func TestChainCredentialProvider(t *testing.T) {
+	ctx := context.Background()
+	failed := errors.New("failed")
+
+	provider := NewChainCredentialProvider(
+		NewStaticCredentialProvider("", ""),
+		NewStaticCredentialProvider("token", "secret"),
+	)
+	c, err := provider.Retrieve(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "token", c.AccessToken)
+
+	provider = NewChainCredentialProvider(
+		CredentialProviderFunc(func(ctx context.Context) (*Credential, error) {
+			return nil, failed
+		}),
+		NewStaticCredentialProvider("token", "secret"),
+	)
+	_, err = provider.Retrieve(ctx)
+	require.Equal(t, failed, err)
+
+	_, err = NewChainCredentialProvider().Retrieve(ctx)
+	require.True(t, errors.Is(err, ErrNoCredential))
+}
+
+func TestClient_CredentialProvider(t *testing.T) {
+	var token string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, _, _ = r.BasicAuth()
+		w.Write([]byte(`{"is_ok":true}`)) // nolint
+	}))
+	defer server.Close()
+
+	current := "token1"
+	client := NewClientWithCredentialProvider(CredentialProviderFunc(func(ctx context.Context) (*Credential, error) {
+		return &Credential{AccessToken: current, AccessTokenSecret: "secret"}, nil
+	}))
+
+	_, err := client.Do(context.Background(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	require.Equal(t, "token1", token)
+
+	// APIコールごとに認証情報が参照される
+	current = "token2"
+	_, err = client.Do(context.Background(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	require.Equal(t, "token2", token)
+}
@@ -0,0 +1,139 @@
+package sacloud
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// NewDNSRecord DNSレコードを作成する
+func NewDNSRecord(t types.EDNSRecordType, name, rdata string, ttl int) *DNSRecord {
+	return &DNSRecord{
+		Name:  name,
+		Type:  t,
+		RData: rdata,
+		TTL:   ttl,
+	}
+}
+
+// Equal 名前/種別/データが等しいレコードであるか判定する
+//
+// TTLは比較しない
+func (o *DNSRecord) Equal(r *DNSRecord) bool {
+	return o.matchNameAndType(r.Name, r.Type) && o.RData == r.RData
+}
+
+func (o *DNSRecord) matchNameAndType(name string, t types.EDNSRecordType) bool {
+	return strings.EqualFold(o.Name, name) && o.Type == t
+}
+
+// DNSRecords DNSレコードのリスト
+type DNSRecords []*DNSRecord
+
+// Find 名前/種別/データが一致するレコードを返す、存在しない場合はnilを返す
+func (r DNSRecords) Find(name string, t types.EDNSRecordType, rdata string) *DNSRecord {
+	target := NewDNSRecord(t, name, rdata, 0)
+	for _, record := range r {
+		if record.Equal(target) {
+			return record
+		}
+	}
+	return nil
+}
+
+// FindByNameAndType 名前/種別が一致するレコードを全て返す
+func (r DNSRecords) FindByNameAndType(name string, t types.EDNSRecordType) DNSRecords {
+	var results DNSRecords
+	for _, record := range r {
+		if record.matchNameAndType(name, t) {
+			results = append(results, record)
+		}
+	}
+	return results
+}
+
+// Add レコードを追加する
+//
+// 名前/種別/データが等しいレコードが既に存在する場合は追加せずにTTLのみ更新する
+func (r *DNSRecords) Add(records ...*DNSRecord) {
+	for _, record := range records {
+		if exists := r.Find(record.Name, record.Type, record.RData); exists != nil {
+			exists.TTL = record.TTL
+			continue
+		}
+		*r = append(*r, record)
+	}
+}
+
+// Delete 名前/種別/データが等しいレコードを削除する
+//
+// 該当するレコードが存在しない場合は何もしない
+func (r *DNSRecords) Delete(records ...*DNSRecord) {
+	var results DNSRecords
+	for _, exists := range *r {
+		deleted := false
+		for _, record := range records {
+			if exists.Equal(record) {
+				deleted = true
+				break
+			}
+		}
+		if !deleted {
+			results = append(results, exists)
+		}
+	}
+	*r = results
+}
+
+// Replace 名前/種別が一致するレコードを全て削除し、指定のレコードで置き換える
+//
+// recordsが空の場合は名前/種別が一致するレコードの削除のみ行う
+func (r *DNSRecords) Replace(name string, t types.EDNSRecordType, records ...*DNSRecord) {
+	r.Delete(r.FindByNameAndType(name, t)...)
+	r.Add(records...)
+}
+
+// Equal 同じレコードの集合であるか判定する
+//
+// レコードの順序は考慮しない
+func (r DNSRecords) Equal(records DNSRecords) bool {
+	if len(r) != len(records) {
+		return false
+	}
+	for _, record := range records {
+		exists := r.Find(record.Name, record.Type, record.RData)
+		if exists == nil || exists.TTL != record.TTL {
+			return false
+		}
+	}
+	return true
+}
+
+// UpdateDNSRecords DNSゾーンのレコードを読み込み、fで編集したレコードで更新する
+//
+// レコードに変更がない場合は更新を行わずに現在の値を返す
+func UpdateDNSRecords(ctx context.Context, api DNSAPI, id types.ID, f func(records *DNSRecords)) (*DNS, error) {
+	current, err := api.Read(ctx, DefaultZone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var records DNSRecords
+	for _, record := range current.Records {
+		copied := *record
+		records = append(records, &copied)
+	}
+	f(&records)
+
+	if records.Equal(current.Records) {
+		return current, nil
+	}
+
+	return api.Update(ctx, DefaultZone, id, &DNSUpdateRequest{
+		Records:     records,
+		Description: current.Description,
+		Tags:        current.Tags,
+		IconID:      current.IconID,
+	})
+}
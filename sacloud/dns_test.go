@@ -0,0 +1,36 @@
+package sacloud
+
+import (
+	"testing"
+
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDNSRecords(t *testing.T) {
+	records := DNSRecords{
+		NewDNSRecord(types.DNSRecordTypes.A, "www", "192.0.2.1", 300),
+	}
+
+	// 名前の大文字小文字は区別しない & 既存レコードはTTLのみ更新
+	records.Add(
+		NewDNSRecord(types.DNSRecordTypes.A, "WWW", "192.0.2.1", 600),
+		NewDNSRecord(types.DNSRecordTypes.A, "www", "192.0.2.2", 300),
+	)
+	require.Len(t, records, 2)
+	require.Equal(t, 600, records[0].TTL)
+
+	// 存在しないレコードの削除は無視
+	records.Delete(
+		NewDNSRecord(types.DNSRecordTypes.A, "www", "192.0.2.2", 0),
+		NewDNSRecord(types.DNSRecordTypes.A, "www", "192.0.2.3", 0),
+	)
+	require.Len(t, records, 1)
+
+	records.Replace("www", types.DNSRecordTypes.A, NewDNSRecord(types.DNSRecordTypes.A, "www", "192.0.2.4", 300))
+	require.Len(t, records, 1)
+	require.NotNil(t, records.Find("www", types.DNSRecordTypes.A, "192.0.2.4"))
+
+	records.Replace("www", types.DNSRecordTypes.A)
+	require.Empty(t, records)
+}
@@ -1,24 +1,127 @@
 package sacloud
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 )
 
+var (
+	// ErrBadRequest 400 Bad Request
+	ErrBadRequest = errors.New("bad request")
+	// ErrUnauthorized 401 Unauthorized
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden 403 Forbidden
+	ErrForbidden = errors.New("forbidden")
+	// ErrNotFound 404 Not Found
+	ErrNotFound = errors.New("not found")
+	// ErrConflict 409 Conflict
+	ErrConflict = errors.New("conflict")
+	// ErrLocked 423 Locked
+	ErrLocked = errors.New("locked")
+	// ErrTooManyRequests 429 Too Many Requests
+	ErrTooManyRequests = errors.New("too many requests")
+	// ErrMaintenance 503 Service Unavailable(メンテナンス中など)
+	ErrMaintenance = errors.New("service unavailable")
+)
+
+var statusErrors = map[int]error{
+	http.StatusBadRequest:         ErrBadRequest,
+	http.StatusUnauthorized:       ErrUnauthorized,
+	http.StatusForbidden:          ErrForbidden,
+	http.StatusNotFound:           ErrNotFound,
+	http.StatusConflict:           ErrConflict,
+	http.StatusLocked:             ErrLocked,
+	http.StatusTooManyRequests:    ErrTooManyRequests,
+	http.StatusServiceUnavailable: ErrMaintenance,
+}
+
 // IsNotFoundError 指定のerrorがAPI呼び出し時の404エラーであるか判定
 func IsNotFoundError(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsBadRequestError 指定のerrorがAPI呼び出し時の400エラーであるか判定
+func IsBadRequestError(err error) bool {
+	return errors.Is(err, ErrBadRequest)
+}
+
+// IsUnauthorizedError 指定のerrorがAPI呼び出し時の401エラーであるか判定
+func IsUnauthorizedError(err error) bool {
+	return errors.Is(err, ErrUnauthorized)
+}
+
+// IsForbiddenError 指定のerrorがAPI呼び出し時の403エラーであるか判定
+func IsForbiddenError(err error) bool {
+	return errors.Is(err, ErrForbidden)
+}
+
+// IsConflictError 指定のerrorがAPI呼び出し時の409エラーであるか判定
+func IsConflictError(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
+// IsLockedError 指定のerrorがAPI呼び出し時の423エラーであるか判定
+func IsLockedError(err error) bool {
+	return errors.Is(err, ErrLocked)
+}
+
+// IsTooManyRequestsError 指定のerrorがAPI呼び出し時の429エラー(流量制限)であるか判定
+func IsTooManyRequestsError(err error) bool {
+	return errors.Is(err, ErrTooManyRequests)
+}
+
+// IsMaintenanceError 指定のerrorがAPI呼び出し時の503エラーであるか判定
+func IsMaintenanceError(err error) bool {
+	return errors.Is(err, ErrMaintenance)
+}
+
+// IsRetryableError 指定のerrorがリトライにより回復する可能性のあるエラーであるか判定
+//
+// DefaultRetryableStatusCodesに含まれるレスポンスコードのAPIエラー、または一時的なネットワークエラーの場合にtrueを返す
+func IsRetryableError(err error) bool {
 	if err == nil {
 		return false
 	}
 
-	if apiError, ok := err.(APIError); ok {
-		return apiError.ResponseCode() == http.StatusNotFound
+	var apiError APIError
+	if errors.As(err, &apiError) {
+		for _, code := range DefaultRetryableStatusCodes {
+			if apiError.ResponseCode() == code {
+				return true
+			}
+		}
+		return false
 	}
 
+	var transportError *TransportError
+	if errors.As(err, &transportError) {
+		return isTransientNetworkError(transportError.Err)
+	}
 	return false
 }
 
+// TransportError APIコール時の通信エラー、リクエスト先のメソッド/URLを保持する
+type TransportError struct {
+	// Method リクエストのHTTPメソッド
+	Method string
+	// URL リクエスト先URL
+	URL string
+	// Err 通信時に発生したエラー
+	Err error
+}
+
+// Error errorインターフェース
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("%s %s: %s", e.Method, e.URL, e.Err)
+}
+
+// Unwrap 通信時に発生したエラーを返す
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
 // APIErrorResponse APIエラー型
 type APIErrorResponse struct {
 	IsFatal      bool   `json:"is_fatal,omitempty"`   // IsFatal
@@ -106,3 +209,11 @@ func (e *apiError) Serial() string {
 func (e *apiError) OrigErr() *APIErrorResponse {
 	return e.origErr
 }
+
+// Is errors.Isでの判定用、レスポンスコードに対応するErrXXXと比較する
+func (e *apiError) Is(target error) bool {
+	if err, ok := statusErrors[e.responseCode]; ok {
+		return err == target
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+package sacloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIError_Is(t *testing.T) {
+	cases := []struct {
+		code      int
+		sentinel  error
+		checker   func(error) bool
+		retryable bool
+	}{
+		{code: http.StatusBadRequest, sentinel: ErrBadRequest, checker: IsBadRequestError},
+		{code: http.StatusUnauthorized, sentinel: ErrUnauthorized, checker: IsUnauthorizedError},
+		{code: http.StatusForbidden, sentinel: ErrForbidden, checker: IsForbiddenError},
+		{code: http.StatusNotFound, sentinel: ErrNotFound, checker: IsNotFoundError},
+		{code: http.StatusConflict, sentinel: ErrConflict, checker: IsConflictError},
+		{code: http.StatusLocked, sentinel: ErrLocked, checker: IsLockedError, retryable: true},
+		{code: http.StatusTooManyRequests, sentinel: ErrTooManyRequests, checker: IsTooManyRequestsError, retryable: true},
+		{code: http.StatusServiceUnavailable, sentinel: ErrMaintenance, checker: IsMaintenanceError, retryable: true},
+	}
+
+	for _, tc := range cases {
+		err := NewAPIError("GET", nil, "", tc.code, &APIErrorResponse{})
+		wrapped := fmt.Errorf("wrapped: %w", err)
+
+		require.True(t, errors.Is(err, tc.sentinel), tc.code)
+		require.True(t, errors.Is(wrapped, tc.sentinel), tc.code)
+		require.True(t, tc.checker(wrapped), tc.code)
+		require.Equal(t, tc.retryable, IsRetryableError(wrapped), tc.code)
+
+		var apiError APIError
+		require.True(t, errors.As(wrapped, &apiError), tc.code)
+		require.Equal(t, tc.code, apiError.ResponseCode())
+
+		for _, other := range cases {
+			if other.code != tc.code {
+				require.False(t, errors.Is(err, other.sentinel), "%d is not %d", tc.code, other.code)
+			}
+		}
+	}
+
+	require.False(t, IsNotFoundError(nil))
+	require.False(t, IsRetryableError(nil))
+	require.True(t, IsRetryableError(NewAPIError("GET", nil, "", http.StatusBadGateway, nil)))
+}
+
+func TestTransportError(t *testing.T) {
+	opErr := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	err := &TransportError{Method: "GET", URL: "http://localhost", Err: opErr}
+
+	var target *net.OpError
+	require.True(t, errors.As(err, &target))
+	require.True(t, IsRetryableError(err))
+	require.False(t, IsRetryableError(&TransportError{Method: "GET", URL: "http://localhost", Err: context.Canceled}))
+}
+
+func TestClient_Do_Errors(t *testing.T) {
+	t.Run("non-JSON error response", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte("<html>maintenance</html>")) // nolint
+		}))
+		defer server.Close()
+
+		_, err := NewClient("token", "secret").Do(context.Background(), http.MethodGet, server.URL, nil)
+		require.True(t, IsMaintenanceError(err))
+
+		var apiError APIError
+		require.True(t, errors.As(err, &apiError))
+		require.Equal(t, "<html>maintenance</html>", apiError.Message())
+	})
+
+	t.Run("transport error", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		url := server.URL
+		server.Close()
+
+		_, err := NewClient("token", "secret").Do(context.Background(), http.MethodGet, url, nil)
+		require.Error(t, err)
+
+		var transportError *TransportError
+		require.True(t, errors.As(err, &transportError))
+		require.Equal(t, http.MethodGet, transportError.Method)
+		require.Equal(t, url, transportError.URL)
+	})
+}
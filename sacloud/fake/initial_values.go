@@ -1,10 +1,18 @@
 package fake
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"github.com/sacloud/libsacloud-v2/sacloud"
 	"github.com/sacloud/libsacloud-v2/sacloud/types"
 )
 
+var accountID = types.ID(123456789012)
+
+var memberCode = "abc12345"
+
 var zones = []string{"tk1a", "is1a", "is1b", "tk1v"}
 
 var zoneIDs = map[string]types.ID{
@@ -14,6 +22,27 @@ var zoneIDs = map[string]types.ID{
 	"tk1v": types.ID(29001),
 }
 
+var regions = map[string]*sacloud.Region{
+	"tk1": {
+		ID:          types.ID(210),
+		Name:        "東京",
+		Description: "東京",
+		NameServers: []string{"210.188.224.10", "210.188.224.11"},
+	},
+	"is1": {
+		ID:          types.ID(310),
+		Name:        "石狩",
+		Description: "石狩",
+		NameServers: []string{"133.242.0.3", "133.242.0.4"},
+	},
+	"tk1v": {
+		ID:          types.ID(290),
+		Name:        "Sandbox",
+		Description: "Sandbox",
+		NameServers: []string{"133.242.0.3", "133.242.0.4"},
+	},
+}
+
 var sharedSegmentSwitch = &sacloud.Switch{
 	ID:             pool.generateID(),
 	Name:           "スイッチ",
@@ -27,7 +56,17 @@ func init() {
 	initArchives()
 	initNotes()
 	initSwitch()
+	initRegions()
 	initZones()
+	initServerPlans()
+	initDiskPlans()
+	initStorages()
+	initInternetPlans()
+	initLicensePlans()
+	initPrivateHostPlans()
+	initServiceClasses()
+	initBills()
+	initCoupons()
 }
 
 func initArchives() {
@@ -85,6 +124,12 @@ func initSwitch() {
 	}
 }
 
+func initRegions() {
+	for _, region := range regions {
+		s.setRegion(sacloud.DefaultZone, region)
+	}
+}
+
 func initZones() {
 	// zones
 	s.setZone(sacloud.DefaultZone, &sacloud.Zone{
@@ -92,18 +137,21 @@ func initZones() {
 		Name:         "tk1a",
 		Description:  "東京第1ゾーン",
 		DisplayOrder: 1,
+		Region:       regions["tk1"],
 	})
 	s.setZone(sacloud.DefaultZone, &sacloud.Zone{
 		ID:           31001,
 		Name:         "is1a",
 		Description:  "石狩第1ゾーン",
 		DisplayOrder: 2,
+		Region:       regions["is1"],
 	})
 	s.setZone(sacloud.DefaultZone, &sacloud.Zone{
 		ID:           31002,
 		Name:         "is1b",
 		Description:  "石狩第2ゾーン",
 		DisplayOrder: 3,
+		Region:       regions["is1"],
 	})
 	s.setZone(sacloud.DefaultZone, &sacloud.Zone{
 		ID:           29001,
@@ -111,5 +159,274 @@ func initZones() {
 		Description:  "Sandbox",
 		DisplayOrder: 4,
 		IsDummy:      true,
+		Region:       regions["tk1v"],
+	})
+}
+
+// serverPlanGenerations ゾーンごとに提供されるサーバプランの世代
+var serverPlanGenerations = map[string]types.EPlanGeneration{
+	"tk1a": types.PlanGenerations.G100,
+	"is1a": types.PlanGenerations.G200,
+	"is1b": types.PlanGenerations.G100,
+	"tk1v": types.PlanGenerations.G100,
+}
+
+func initServerPlans() {
+	cpus := []int{1, 2, 3, 4, 5, 6, 8, 10, 12, 16, 20, 24, 32}
+	memories := []int{1, 2, 3, 4, 5, 6, 8, 10, 12, 16, 20, 24, 32, 48, 64, 96, 128, 192, 224, 256}
+	dedicatedCPUs := []int{2, 4, 6, 8, 10, 24, 32}
+
+	for _, zone := range zones {
+		generation := serverPlanGenerations[zone]
+		for _, cpu := range cpus {
+			for _, memory := range memories {
+				if memory*2 < cpu || memory > cpu*16 {
+					continue
+				}
+				s.setServerPlan(zone, &sacloud.ServerPlan{
+					ID:           types.StringID(fmt.Sprintf("%03d%03d%03d", generation, memory, cpu)),
+					Name:         fmt.Sprintf("プラン/%dCore-%dGB", cpu, memory),
+					CPU:          cpu,
+					MemoryMB:     memory * 1024,
+					Commitment:   types.Commitments.Standard,
+					Generation:   generation,
+					Availability: types.Availabilities.Available,
+				})
+			}
+		}
+
+		// コア専有プランは第2世代のみ
+		if generation != types.PlanGenerations.G200 {
+			continue
+		}
+		for _, cpu := range dedicatedCPUs {
+			for _, memory := range memories {
+				if memory < cpu*2 || memory > cpu*8 {
+					continue
+				}
+				s.setServerPlan(zone, &sacloud.ServerPlan{
+					ID:           types.StringID(fmt.Sprintf("1%03d%03d%03d", generation, memory, cpu)),
+					Name:         fmt.Sprintf("コア専有プラン/%dCore-%dGB", cpu, memory),
+					CPU:          cpu,
+					MemoryMB:     memory * 1024,
+					Commitment:   types.Commitments.DedicatedCPU,
+					Generation:   generation,
+					Availability: types.Availabilities.Available,
+				})
+			}
+		}
+	}
+}
+
+func initDiskPlans() {
+	sizes := []int{20, 40, 60, 80, 100, 250, 500, 750, 1024, 2048, 4096}
+	var sizeInfo []*sacloud.DiskPlanSizeInfo
+	for _, size := range sizes {
+		displaySize, displaySuffix := size, "GB"
+		if size >= 1024 {
+			displaySize, displaySuffix = size/1024, "TB"
+		}
+		sizeInfo = append(sizeInfo, &sacloud.DiskPlanSizeInfo{
+			Availability:  types.Availabilities.Available,
+			DisplaySize:   displaySize,
+			DisplaySuffix: displaySuffix,
+			SizeMB:        size * 1024,
+		})
+	}
+
+	plans := []*sacloud.DiskPlan{
+		{
+			ID:           types.ID(2),
+			Name:         "標準プラン",
+			StorageClass: "iscsi9999",
+			Availability: types.Availabilities.Available,
+			Size:         sizeInfo,
+		},
+		{
+			ID:           types.ID(4),
+			Name:         "SSDプラン",
+			StorageClass: "iscsi9999",
+			Availability: types.Availabilities.Available,
+			Size:         sizeInfo,
+		},
+	}
+	for _, zone := range zones {
+		for _, plan := range plans {
+			s.setDiskPlan(zone, plan)
+		}
+	}
+}
+
+func initStorages() {
+	for _, zone := range zones {
+		for _, diskPlanID := range []types.ID{2, 4} {
+			s.setStorage(zone, &sacloud.Storage{
+				ID:          pool.generateID(),
+				Name:        fmt.Sprintf("%s-storage-%s", zone, diskPlanID),
+				Description: "fake storage",
+				Class:       "iscsi9999",
+				Generation:  100,
+				DiskPlanID:  diskPlanID,
+				ZoneID:      zoneIDs[zone],
+			})
+		}
+	}
+}
+
+func initInternetPlans() {
+	bandWidths := []int{100, 250, 500, 1000, 1500, 2000, 2500, 3000, 5000}
+	for _, zone := range zones {
+		for _, bandWidth := range bandWidths {
+			s.setInternetPlan(zone, &sacloud.InternetPlan{
+				ID:            types.ID(bandWidth),
+				Name:          fmt.Sprintf("%dMbps共有", bandWidth),
+				BandWidthMbps: bandWidth,
+				Availability:  types.Availabilities.Available,
+			})
+		}
+	}
+}
+
+func initLicensePlans() {
+	plans := []*sacloud.LicensePlan{
+		{
+			ID:         types.ID(10001),
+			Name:       "Windows RDS SAL",
+			TermsOfUse: "1ライセンスにつき、1人のユーザが利用できます。",
+		},
+		{
+			ID:         types.ID(10002),
+			Name:       "Office SAL",
+			TermsOfUse: "1ライセンスにつき、1人のユーザが利用できます。",
+		},
+	}
+	for _, plan := range plans {
+		s.setLicensePlan(sacloud.DefaultZone, plan)
+	}
+}
+
+func initPrivateHostPlans() {
+	plans := []*sacloud.PrivateHostPlan{
+		{
+			ID:           types.ID(112900526366),
+			Name:         "200Core 224GB 標準",
+			Class:        "dynamic",
+			CPU:          224,
+			MemoryMB:     224 * 1024,
+			Availability: types.Availabilities.Available,
+		},
+		{
+			ID:           types.ID(112900526367),
+			Name:         "200Core 224GB Windows",
+			Class:        "ms_windows",
+			CPU:          224,
+			MemoryMB:     224 * 1024,
+			Availability: types.Availabilities.Available,
+		},
+	}
+	for _, zone := range zones {
+		for _, plan := range plans {
+			s.setPrivateHostPlan(zone, plan)
+		}
+	}
+}
+
+// initServiceClasses 登録済みのプランを元に価格情報を作成する
+func initServiceClasses() {
+	ctx := context.Background()
+	for _, zone := range zones {
+		var classes []*sacloud.ServiceClass
+
+		serverPlans, _ := NewServerPlanOp().Find(ctx, zone, nil)
+		for _, plan := range serverPlans {
+			path := fmt.Sprintf("plan/server/%dcore-%dgb", plan.CPU, plan.GetMemoryGB())
+			hourly := plan.CPU*10 + plan.GetMemoryGB()*5
+			if plan.Commitment.IsDedicatedCPU() {
+				path = "dedicatedcpu/" + path
+				hourly *= 2
+			}
+			classes = append(classes, newServiceClass(zone, path, plan.Name, hourly))
+		}
+
+		diskPlans, _ := NewDiskPlanOp().Find(ctx, zone, nil)
+		for _, plan := range diskPlans {
+			for _, size := range plan.Size {
+				path := fmt.Sprintf("plan/disk/%d/%d", plan.ID, size.GetSizeGB())
+				name := fmt.Sprintf("%s %d%s", plan.Name, size.DisplaySize, size.DisplaySuffix)
+				classes = append(classes, newServiceClass(zone, path, name, size.GetSizeGB()/10+1))
+			}
+		}
+
+		internetPlans, _ := NewInternetPlanOp().Find(ctx, zone, nil)
+		for _, plan := range internetPlans {
+			path := fmt.Sprintf("plan/internet/%d", plan.BandWidthMbps)
+			classes = append(classes, newServiceClass(zone, path, plan.Name, plan.BandWidthMbps/10))
+		}
+
+		licensePlans, _ := NewLicensePlanOp().Find(ctx, zone, nil)
+		for _, plan := range licensePlans {
+			path := fmt.Sprintf("plan/license/%d", plan.ID)
+			classes = append(classes, newServiceClass(zone, path, plan.Name, 0))
+		}
+
+		privateHostPlans, _ := NewPrivateHostPlanOp().Find(ctx, zone, nil)
+		for _, plan := range privateHostPlans {
+			path := fmt.Sprintf("plan/privatehost/%d", plan.ID)
+			classes = append(classes, newServiceClass(zone, path, plan.Name, 1000))
+		}
+
+		for _, class := range classes {
+			s.setServiceClass(zone, class)
+		}
+	}
+}
+
+func newServiceClass(zone, path, displayName string, hourly int) *sacloud.ServiceClass {
+	return &sacloud.ServiceClass{
+		ID:               pool.generateID(),
+		ServiceClassName: path,
+		ServiceClassPath: "cloud/" + path,
+		DisplayName:      displayName,
+		IsPublic:         true,
+		Price: &sacloud.Price{
+			Daily:   hourly * 10,
+			Hourly:  hourly,
+			Monthly: hourly * 200,
+			Zone:    zone,
+		},
+	}
+}
+
+// initBills 2018年1月から2019年12月までの月次の請求情報を作成する
+//
+// 金額などは年月から算出するため常に同じ値となる
+func initBills() {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	for year := 2018; year <= 2019; year++ {
+		for month := 1; month <= 12; month++ {
+			date := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, jst)
+			s.setBill(sacloud.DefaultZone, &sacloud.Bill{
+				ID:             types.ID(int64(year*100 + month)),
+				Amount:         int64(10000 + (year-2018)*12000 + month*1000),
+				Date:           date,
+				MemberID:       memberCode,
+				Paid:           !(year == 2019 && month == 12),
+				PayLimit:       date.AddDate(0, 2, -1),
+				PaymentClassID: types.ID(1),
+			})
+		}
+	}
+}
+
+func initCoupons() {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	s.setCoupon(sacloud.DefaultZone, &sacloud.Coupon{
+		ID:             types.ID(1),
+		MemberID:       memberCode,
+		ContractID:     types.ID(1),
+		ServiceClassID: types.ID(1),
+		Discount:       20000,
+		AppliedAt:      time.Date(2018, 1, 1, 0, 0, 0, 0, jst),
+		UntilAt:        time.Date(2019, 12, 31, 23, 59, 59, 0, jst),
 	})
 }
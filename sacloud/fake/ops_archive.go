@@ -3,6 +3,7 @@ package fake
 import (
 	"context"
 	"fmt"
+	"math"
 
 	"github.com/sacloud/libsacloud-v2/sacloud"
 	"github.com/sacloud/libsacloud-v2/sacloud/types"
@@ -77,6 +78,85 @@ func (o *ArchiveOp) CreateBlank(ctx context.Context, zone string, param *sacloud
 	}, nil
 }
 
+// Transfer is fake implementation
+func (o *ArchiveOp) Transfer(ctx context.Context, zone string, id types.ID, destZoneID types.ID, param *sacloud.ArchiveTransferRequest) (*sacloud.Archive, error) {
+	source, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	if source.Scope != types.Scopes.User {
+		return nil, newErrorBadRequest(o.key, id, "only user scoped archive can be transferred")
+	}
+
+	result := &sacloud.Archive{}
+	copySameNameField(param, result)
+	return o.transfer(zone, source, destZoneID, result)
+}
+
+// CreateFromShared is fake implementation
+func (o *ArchiveOp) CreateFromShared(ctx context.Context, zone string, id types.ID, destZoneID types.ID, param *sacloud.ArchiveCreateFromSharedRequest) (*sacloud.Archive, error) {
+	key := param.SourceSharedKey
+	if !key.ValidFormat() {
+		return nil, newErrorBadRequest(o.key, id, fmt.Sprintf("SourceSharedKey %q is invalid", key))
+	}
+	if key.Zone() != zone || key.SourceArchiveID() != id {
+		return nil, newErrorBadRequest(o.key, id, "SourceSharedKey does not match source archive")
+	}
+
+	source, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	shareInfo, ok := s.getByID(archiveShareInfoKey, zone, id).(*archiveShareInfo)
+	if !ok || shareInfo.SharedKey != key {
+		return nil, newErrorBadRequest(o.key, id, "source archive is not shared with SourceSharedKey")
+	}
+
+	result := &sacloud.Archive{}
+	copySameNameField(param, result)
+	return o.transfer(zone, source, destZoneID, result)
+}
+
+// transfer 転送先ゾーンへアーカイブのコピーを作成し、ディスクコピーを開始する
+func (o *ArchiveOp) transfer(zone string, source *sacloud.Archive, destZoneID types.ID, result *sacloud.Archive) (*sacloud.Archive, error) {
+	if !source.Availability.IsAvailable() {
+		return nil, newErrorConflict(o.key, source.ID, "source archive is not available")
+	}
+
+	var destZone string
+	for name, id := range zoneIDs {
+		if id == destZoneID {
+			destZone = name
+		}
+	}
+	if destZone == "" {
+		return nil, newErrorBadRequest(o.key, source.ID, fmt.Sprintf("destination zone[%s] is not found", destZoneID))
+	}
+
+	fill(result, fillID, fillCreatedAt, fillScope)
+	result.DisplayOrder = random(100)
+	result.Availability = types.Availabilities.Migrating
+	result.SizeMB = source.SizeMB
+	result.DiskPlanID = source.DiskPlanID
+	result.DiskPlanName = source.DiskPlanName
+	result.DiskPlanStorageClass = source.DiskPlanStorageClass
+	result.SourceInfo = &sacloud.SourceArchiveInfo{
+		ID:        source.ID,
+		AccountID: accountID,
+		ZoneID:    zoneIDs[zone],
+		ZoneName:  zone,
+	}
+
+	s.setArchive(destZone, result)
+
+	id := result.ID
+	startDiskCopy(o.key, destZone, func() (interface{}, error) {
+		return o.Read(context.Background(), destZone, id)
+	})
+
+	return result, nil
+}
+
 // Read is fake implementation
 func (o *ArchiveOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Archive, error) {
 	value := s.getArchiveByID(zone, id)
@@ -106,6 +186,7 @@ func (o *ArchiveOp) Delete(ctx context.Context, zone string, id types.ID) error
 		return err
 	}
 	s.delete(o.key, zone, id)
+	s.delete(archiveShareInfoKey, zone, id)
 	return nil
 }
 
@@ -140,3 +221,56 @@ func (o *ArchiveOp) CloseFTP(ctx context.Context, zone string, id types.ID) erro
 	s.setArchive(zone, value)
 	return nil
 }
+
+// Share is fake implementation
+func (o *ArchiveOp) Share(ctx context.Context, zone string, id types.ID, param *sacloud.ArchiveShareRequest) (*sacloud.ArchiveShareInfo, error) {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	if value.Scope != types.Scopes.User {
+		return nil, newErrorBadRequest(o.key, id, "only user scoped archive can be shared")
+	}
+
+	if !param.Shared {
+		s.delete(archiveShareInfoKey, zone, id)
+		return &sacloud.ArchiveShareInfo{}, nil
+	}
+	if !value.Availability.IsAvailable() {
+		return nil, newErrorConflict(o.key, id, "archive is not available")
+	}
+
+	// 共有済みの場合は同じ共有キーを返す
+	shareInfo, ok := s.getByID(archiveShareInfoKey, zone, id).(*archiveShareInfo)
+	if !ok {
+		token := fmt.Sprintf("%08x%08x", random(math.MaxInt32), random(math.MaxInt32))
+		shareInfo = &archiveShareInfo{
+			ID:        id,
+			SharedKey: types.NewArchiveShareKey(zone, id, token),
+		}
+		s.set(archiveShareInfoKey, zone, shareInfo)
+	}
+
+	return &sacloud.ArchiveShareInfo{
+		SharedKey: shareInfo.SharedKey,
+	}, nil
+}
+
+// archiveShareInfoKey アーカイブの共有情報を保持する際のキー
+const archiveShareInfoKey = "ArchiveShareInfo"
+
+// archiveShareInfo アーカイブの共有情報
+type archiveShareInfo struct {
+	ID        types.ID
+	SharedKey types.ArchiveShareKey
+}
+
+// GetID returns value of ID
+func (i *archiveShareInfo) GetID() types.ID {
+	return i.ID
+}
+
+// SetID sets value to ID
+func (i *archiveShareInfo) SetID(id types.ID) {
+	i.ID = id
+}
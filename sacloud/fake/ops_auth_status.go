@@ -0,0 +1,26 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Read is fake implementation
+func (o *AuthStatusOp) Read(ctx context.Context, zone string) (*sacloud.AuthStatus, error) {
+	return &sacloud.AuthStatus{
+		AccountID:          accountID,
+		AccountName:        "fake",
+		AccountCode:        "fake",
+		AccountClass:       "account",
+		MemberCode:         memberCode,
+		MemberClass:        "member",
+		AuthClass:          "account",
+		AuthMethod:         "apikey",
+		IsAPIKey:           true,
+		ExternalPermission: types.ExternalPermission("bill+eventlog+cdn"),
+		OperationPenalty:   "none",
+		Permission:         types.Permissions.Create,
+	}, nil
+}
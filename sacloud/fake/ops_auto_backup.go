@@ -0,0 +1,200 @@
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Find is fake implementation
+func (o *AutoBackupOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.AutoBackup, error) {
+	results, _ := find(o.key, zone, conditions)
+	var values []*sacloud.AutoBackup
+	for _, res := range results {
+		dest := &sacloud.AutoBackup{}
+		copySameNameField(res, dest)
+		values = append(values, dest)
+	}
+	return values, nil
+}
+
+// Create is fake implementation
+func (o *AutoBackupOp) Create(ctx context.Context, zone string, param *sacloud.AutoBackupCreateRequest) (*sacloud.AutoBackup, error) {
+	if _, err := NewDiskOp().Read(ctx, zone, param.DiskID); err != nil {
+		return nil, newErrorBadRequest(o.key, types.ID(0), "Disk is not found")
+	}
+	if err := validateAutoBackupMaximumNumberOfArchives(param.MaximumNumberOfArchives); err != nil {
+		return nil, newErrorBadRequest(o.key, types.ID(0), err.Error())
+	}
+	for _, v := range s.getAutoBackup(zone) {
+		if v.DiskID == param.DiskID {
+			return nil, newErrorConflict(o.key, types.ID(0), fmt.Sprintf("AutoBackup for Disk[%s] is already exists", param.DiskID))
+		}
+	}
+
+	result := &sacloud.AutoBackup{}
+	copySameNameField(param, result)
+	fill(result, fillID, fillCreatedAt, fillModifiedAt, fillAvailability)
+
+	if result.BackupSpanType == "" {
+		result.BackupSpanType = types.BackupSpanTypes.Weekdays
+	}
+	result.SettingsHash = "settingshash"
+	result.AccountID = accountID
+	result.ZoneID = zoneIDs[zone]
+	result.ZoneName = zone
+
+	s.setAutoBackup(zone, result)
+
+	id := result.ID
+	startAutoBackup(zone, id, func() (*sacloud.AutoBackup, error) {
+		return o.Read(context.Background(), zone, id)
+	})
+
+	return result, nil
+}
+
+// Read is fake implementation
+func (o *AutoBackupOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.AutoBackup, error) {
+	value := s.getAutoBackupByID(zone, id)
+	if value == nil {
+		return nil, newErrorNotFound(o.key, id)
+	}
+	dest := &sacloud.AutoBackup{}
+	copySameNameField(value, dest)
+	return dest, nil
+}
+
+// Update is fake implementation
+func (o *AutoBackupOp) Update(ctx context.Context, zone string, id types.ID, param *sacloud.AutoBackupUpdateRequest) (*sacloud.AutoBackup, error) {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateAutoBackupMaximumNumberOfArchives(param.MaximumNumberOfArchives); err != nil {
+		return nil, newErrorBadRequest(o.key, id, err.Error())
+	}
+	copySameNameField(param, value)
+	fill(value, fillModifiedAt)
+
+	if value.BackupSpanType == "" {
+		value.BackupSpanType = types.BackupSpanTypes.Weekdays
+	}
+
+	s.setAutoBackup(zone, value)
+	return value, nil
+}
+
+// Delete is fake implementation
+func (o *AutoBackupOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	_, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+	s.delete(o.key, zone, id)
+	stopAutoBackup(id)
+	return nil
+}
+
+func validateAutoBackupMaximumNumberOfArchives(v int) error {
+	if v < 1 || v > 10 {
+		return errors.New("maximum number of archives must be between 1 and 10")
+	}
+	return nil
+}
+
+// autoBackupDays 自動バックアップのスケジュールを模擬する日数
+const autoBackupDays = 30
+
+var (
+	autoBackupStopsMu sync.Mutex
+	autoBackupStops   = map[types.ID]chan struct{}{}
+)
+
+// startAutoBackup 自動バックアップのスケジュールを模擬する
+//
+// AutoBackupDurationごとに1日経過したものとして扱い、取得曜日に該当する場合はディスクからアーカイブを作成する。
+// ディスクが利用可能でない日はスキップし、保存世代数を超えたアーカイブは古いものから削除する。
+// autoBackupDays日分を模擬するか、自動バックアップが削除されると停止する。
+func startAutoBackup(zone string, id types.ID, readFunc func() (*sacloud.AutoBackup, error)) {
+	stop := make(chan struct{})
+	autoBackupStopsMu.Lock()
+	autoBackupStops[id] = stop
+	autoBackupStopsMu.Unlock()
+
+	archiveOp := NewArchiveOp()
+	diskOp := NewDiskOp()
+	var archiveIDs []types.ID
+
+	ticker := time.NewTicker(AutoBackupDuration)
+	go func() {
+		defer ticker.Stop()
+		defer stopAutoBackup(id)
+
+		for day := 1; day <= autoBackupDays; day++ {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+			}
+
+			autoBackup, err := readFunc()
+			if autoBackup == nil || err != nil {
+				return
+			}
+
+			date := autoBackup.CreatedAt.AddDate(0, 0, day)
+			if !isAutoBackupWeekday(autoBackup, date.Weekday()) {
+				continue
+			}
+
+			disk, err := diskOp.Read(context.Background(), zone, autoBackup.DiskID)
+			if err != nil {
+				return
+			}
+			if !disk.Availability.IsAvailable() {
+				continue
+			}
+
+			archive, err := archiveOp.Create(context.Background(), zone, &sacloud.ArchiveCreateRequest{
+				SourceDiskID: autoBackup.DiskID,
+				Name:         fmt.Sprintf("%s-%s", autoBackup.Name, date.Format("20060102")),
+				Description:  fmt.Sprintf("created by AutoBackup[%s]", autoBackup.ID),
+				Tags:         autoBackup.Tags,
+			})
+			if err != nil {
+				continue
+			}
+			archiveIDs = append(archiveIDs, archive.ID)
+
+			for len(archiveIDs) > autoBackup.MaximumNumberOfArchives {
+				archiveOp.Delete(context.Background(), zone, archiveIDs[0]) // nolint
+				archiveIDs = archiveIDs[1:]
+			}
+		}
+	}()
+}
+
+// stopAutoBackup 実行中の自動バックアップのスケジュールを停止する
+func stopAutoBackup(id types.ID) {
+	autoBackupStopsMu.Lock()
+	defer autoBackupStopsMu.Unlock()
+	if stop, ok := autoBackupStops[id]; ok {
+		close(stop)
+		delete(autoBackupStops, id)
+	}
+}
+
+func isAutoBackupWeekday(autoBackup *sacloud.AutoBackup, weekday time.Weekday) bool {
+	for _, w := range autoBackup.BackupSpanWeekdays {
+		if w.String() == types.BackupSpanWeekdayValues[weekday] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+package fake
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// ByContract is fake implementation
+func (o *BillOp) ByContract(ctx context.Context, zone string, accountID types.ID) ([]*sacloud.Bill, error) {
+	return o.find(accountID, func(bill *sacloud.Bill) bool { return true })
+}
+
+// ByContractYear is fake implementation
+func (o *BillOp) ByContractYear(ctx context.Context, zone string, accountID types.ID, year int) ([]*sacloud.Bill, error) {
+	return o.find(accountID, func(bill *sacloud.Bill) bool {
+		return bill.Date.Year() == year
+	})
+}
+
+// ByContractYearMonth is fake implementation
+func (o *BillOp) ByContractYearMonth(ctx context.Context, zone string, accountID types.ID, year int, month int) ([]*sacloud.Bill, error) {
+	return o.find(accountID, func(bill *sacloud.Bill) bool {
+		return bill.Date.Year() == year && int(bill.Date.Month()) == month
+	})
+}
+
+func (o *BillOp) find(id types.ID, filter func(bill *sacloud.Bill) bool) ([]*sacloud.Bill, error) {
+	if id != accountID {
+		return nil, newErrorNotFound(o.key, id)
+	}
+
+	var values []*sacloud.Bill
+	for _, bill := range s.getBill(sacloud.DefaultZone) {
+		if filter(bill) {
+			dest := &sacloud.Bill{}
+			copySameNameField(bill, dest)
+			values = append(values, dest)
+		}
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].Date.Before(values[j].Date)
+	})
+	return values, nil
+}
+
+// Read is fake implementation
+func (o *BillOp) Read(ctx context.Context, zone string, id types.ID) ([]*sacloud.Bill, error) {
+	value := s.getBillByID(sacloud.DefaultZone, id)
+	if value == nil {
+		return nil, newErrorNotFound(o.key, id)
+	}
+	dest := &sacloud.Bill{}
+	copySameNameField(value, dest)
+	return []*sacloud.Bill{dest}, nil
+}
+
+// Details is fake implementation
+func (o *BillOp) Details(ctx context.Context, zone string, memberCode string, id types.ID) ([]*sacloud.BillDetail, error) {
+	bills, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	bill := bills[0]
+	if bill.MemberID != memberCode {
+		return nil, newErrorNotFound(o.key, id)
+	}
+	return billDetails(bill), nil
+}
+
+// DetailsCSV is fake implementation
+func (o *BillOp) DetailsCSV(ctx context.Context, zone string, memberCode string, id types.ID) (*sacloud.BillDetailCSV, error) {
+	details, err := o.Details(ctx, zone, memberCode, id)
+	if err != nil {
+		return nil, err
+	}
+	bills, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	w.Write([]string{"ContractID", "Description", "Zone", "Usage", "Amount"}) // nolint
+	for _, d := range details {
+		w.Write([]string{ // nolint
+			d.ID.String(),
+			d.Description,
+			d.Zone,
+			strconv.FormatInt(d.Usage, 10),
+			strconv.FormatInt(d.Amount, 10),
+		})
+	}
+	w.Flush()
+
+	return &sacloud.BillDetailCSV{
+		Count:       len(details),
+		ResponsedAt: bills[0].Date.AddDate(0, 1, 0), // 請求月の翌月1日に出力されたものとして扱う
+		Filename:    fmt.Sprintf("sakura_cloud_%s.csv", id),
+		RawBody:     buf.String(),
+	}, nil
+}
+
+// billDetails 請求情報の金額を元に明細を作成する
+//
+// サーバ:ディスク:スイッチ+ルータ = 5:3:2で按分する
+func billDetails(bill *sacloud.Bill) []*sacloud.BillDetail {
+	items := []struct {
+		description string
+		rate        int64
+	}{
+		{description: "サーバ", rate: 5},
+		{description: "ディスク", rate: 3},
+		{description: "スイッチ+ルータ", rate: 2},
+	}
+
+	var details []*sacloud.BillDetail
+	rest := bill.Amount
+	for i, item := range items {
+		amount := bill.Amount * item.rate / 10
+		if i == len(items)-1 {
+			amount = rest
+		}
+		rest -= amount
+		details = append(details, &sacloud.BillDetail{
+			ID:             types.ID(int64(bill.ID)*10 + int64(i+1)),
+			Amount:         amount,
+			Description:    item.description,
+			ServiceClassID: types.ID(int64(i + 1)),
+			Usage:          int64(bill.Date.AddDate(0, 1, -1).Day() * 24),
+			Zone:           "is1a",
+			ContractEndAt:  bill.Date.AddDate(0, 1, 0).Add(-time.Second),
+		})
+	}
+	return details
+}
@@ -0,0 +1,22 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Find is fake implementation
+func (o *CouponOp) Find(ctx context.Context, zone string, id types.ID) ([]*sacloud.Coupon, error) {
+	if id != accountID {
+		return nil, newErrorNotFound(o.key, id)
+	}
+	var values []*sacloud.Coupon
+	for _, coupon := range s.getCoupon(sacloud.DefaultZone) {
+		dest := &sacloud.Coupon{}
+		copySameNameField(coupon, dest)
+		values = append(values, dest)
+	}
+	return values, nil
+}
@@ -0,0 +1,429 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Find is fake implementation
+func (o *DatabaseOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Database, error) {
+	results, _ := find(o.key, zone, conditions)
+	var values []*sacloud.Database
+	for _, res := range results {
+		dest := &sacloud.Database{}
+		copySameNameField(res, dest)
+		values = append(values, dest)
+	}
+	return values, nil
+}
+
+// Create is fake implementation
+func (o *DatabaseOp) Create(ctx context.Context, zone string, param *sacloud.DatabaseCreateRequest) (*sacloud.Database, error) {
+	// レプリケーション(スレーブ)の場合はマスター側の存在/設定を確認する
+	if param.ReplicationSetting != nil && param.ReplicationSetting.Model == types.DatabaseReplicationModels.AsyncReplica {
+		master, err := o.Read(ctx, zone, param.ReplicationSetting.ApplianceID)
+		if err != nil {
+			return nil, newErrorBadRequest(o.key, types.ID(0), fmt.Sprintf("master database[%s] is not found", param.ReplicationSetting.ApplianceID))
+		}
+		if master.ReplicationSetting == nil || master.ReplicationSetting.Model != types.DatabaseReplicationModels.MasterSlave {
+			return nil, newErrorBadRequest(o.key, types.ID(0), fmt.Sprintf("database[%s] is not configured as replication master", master.ID))
+		}
+	}
+
+	result := &sacloud.Database{}
+	copySameNameField(param, result)
+	fill(result, fillID, fillCreatedAt)
+
+	result.Class = "database"
+	result.Availability = types.Availabilities.Migrating
+	result.ZoneID = zoneIDs[zone]
+	result.SettingsHash = ""
+	if result.CommonSetting != nil && result.CommonSetting.WebUI.Bool() && len(result.IPAddresses) > 0 {
+		result.CommonSetting.WebUI = types.WebUI(fmt.Sprintf("https://%s:21443/", result.IPAddresses[0]))
+	}
+
+	s.setDatabase(zone, result)
+
+	id := result.ID
+	startPowerOn(o.key, zone, func() (interface{}, error) {
+		return o.Read(context.Background(), zone, id)
+	})
+	return result, nil
+}
+
+// Read is fake implementation
+func (o *DatabaseOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Database, error) {
+	value := s.getDatabaseByID(zone, id)
+	if value == nil {
+		return nil, newErrorNotFound(o.key, id)
+	}
+	dest := &sacloud.Database{}
+	copySameNameField(value, dest)
+	return dest, nil
+}
+
+// Update is fake implementation
+func (o *DatabaseOp) Update(ctx context.Context, zone string, id types.ID, param *sacloud.DatabaseUpdateRequest) (*sacloud.Database, error) {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	copySameNameField(param, value)
+	fill(value, fillModifiedAt)
+
+	s.setDatabase(zone, value)
+	return value, nil
+}
+
+// Delete is fake implementation
+func (o *DatabaseOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+	if value.InstanceStatus.IsUp() {
+		return newErrorConflict(o.key, id, fmt.Sprintf("Database[%s] is still running", id))
+	}
+	if replicas := o.replicas(zone, id); len(replicas) > 0 {
+		return newErrorConflict(o.key, id, fmt.Sprintf("Database[%s] has replicas", id))
+	}
+
+	s.delete(o.key, zone, id)
+	s.delete(databaseParameterKey, zone, id)
+	return nil
+}
+
+// Config is fake implementation
+func (o *DatabaseOp) Config(ctx context.Context, zone string, id types.ID) error {
+	_, err := o.Read(ctx, zone, id)
+	return err
+}
+
+// Boot is fake implementation
+func (o *DatabaseOp) Boot(ctx context.Context, zone string, id types.ID) error {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+	if value.InstanceStatus.IsUp() {
+		return newErrorConflict(o.key, id, "Boot is failed")
+	}
+
+	startPowerOn(o.key, zone, func() (interface{}, error) {
+		return o.Read(context.Background(), zone, id)
+	})
+
+	return err
+}
+
+// Shutdown is fake implementation
+func (o *DatabaseOp) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+	if !value.InstanceStatus.IsUp() {
+		return newErrorConflict(o.key, id, "Shutdown is failed")
+	}
+
+	startPowerOff(o.key, zone, func() (interface{}, error) {
+		return o.Read(context.Background(), zone, id)
+	})
+
+	return err
+}
+
+// Reset is fake implementation
+func (o *DatabaseOp) Reset(ctx context.Context, zone string, id types.ID) error {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+	if !value.InstanceStatus.IsUp() {
+		return newErrorConflict(o.key, id, "Reset is failed")
+	}
+
+	startPowerOn(o.key, zone, func() (interface{}, error) {
+		return o.Read(context.Background(), zone, id)
+	})
+
+	return nil
+}
+
+// MonitorCPU is fake implementation
+func (o *DatabaseOp) MonitorCPU(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.CPUTimeActivity, error) {
+	_, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &sacloud.CPUTimeActivity{}
+	for _, t := range monitorTimes() {
+		res.Values = append(res.Values, &sacloud.MonitorCPUTimeValue{
+			Time:    t,
+			CPUTime: float64(random(1000)),
+		})
+	}
+	return res, nil
+}
+
+// MonitorDisk is fake implementation
+func (o *DatabaseOp) MonitorDisk(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.DiskActivity, error) {
+	_, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &sacloud.DiskActivity{}
+	for _, t := range monitorTimes() {
+		res.Values = append(res.Values, &sacloud.MonitorDiskValue{
+			Time:  t,
+			Read:  float64(random(1000)),
+			Write: float64(random(1000)),
+		})
+	}
+	return res, nil
+}
+
+// MonitorInterface is fake implementation
+func (o *DatabaseOp) MonitorInterface(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
+	_, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &sacloud.InterfaceActivity{}
+	for _, t := range monitorTimes() {
+		res.Values = append(res.Values, &sacloud.MonitorInterfaceValue{
+			Time:    t,
+			Send:    float64(random(1000)),
+			Receive: float64(random(1000)),
+		})
+	}
+	return res, nil
+}
+
+// MonitorDatabase is fake implementation
+func (o *DatabaseOp) MonitorDatabase(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.DatabaseActivity, error) {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	isReplica := value.ReplicationSetting != nil && value.ReplicationSetting.Model == types.DatabaseReplicationModels.AsyncReplica
+
+	res := &sacloud.DatabaseActivity{}
+	for _, t := range monitorTimes() {
+		v := &sacloud.MonitorDatabaseValue{
+			Time:              t,
+			TotalMemorySize:   1024 * 1024,
+			UsedMemorySize:    float64(random(1024 * 1024)),
+			TotalDisk1Size:    20 * 1024 * 1024,
+			UsedDisk1Size:     float64(random(20 * 1024 * 1024)),
+			TotalDisk2Size:    float64(value.PlanID.Int64()) * 1024 * 1024,
+			UsedDisk2Size:     float64(random(1024 * 1024)),
+			BinlogUsedSizeKiB: float64(random(1024)),
+		}
+		if isReplica {
+			v.DelayTimeSec = float64(random(10))
+		}
+		res.Values = append(res.Values, v)
+	}
+	return res, nil
+}
+
+// Status is fake implementation
+func (o *DatabaseOp) Status(ctx context.Context, zone string, id types.ID) (*sacloud.DatabaseStatus, error) {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &sacloud.DatabaseStatus{
+		Status:  value.InstanceStatus,
+		IsFatal: false,
+		Version: &sacloud.DatabaseVersionInfo{
+			LastModified: value.CreatedAt.Format(time.RFC3339),
+			CommitHash:   "cc5b6b3b5d9c4a6e8d1f0a2b3c4d5e6f7a8b9c0d",
+			Status:       "up",
+			Tag:          "1.1",
+			Expire:       "",
+		},
+	}
+	if value.Conf != nil {
+		status.Version.Tag = value.Conf.DatabaseVersion
+	}
+
+	// レプリケーションの状態
+	if value.ReplicationSetting != nil {
+		var data string
+		switch value.ReplicationSetting.Model {
+		case types.DatabaseReplicationModels.MasterSlave:
+			data = fmt.Sprintf("replicas: %d", len(o.replicas(zone, id)))
+		case types.DatabaseReplicationModels.AsyncReplica:
+			running := "No"
+			if value.InstanceStatus.IsUp() {
+				master, err := o.Read(ctx, zone, value.ReplicationSetting.ApplianceID)
+				if err == nil && master.InstanceStatus.IsUp() {
+					running = "Yes"
+				}
+			}
+			data = fmt.Sprintf("master: %s\nSlave_IO_Running: %s\nSlave_SQL_Running: %s",
+				value.ReplicationSetting.ApplianceID, running, running)
+		}
+		status.Logs = append(status.Logs, &sacloud.DatabaseLog{
+			Name: "replication",
+			Data: data,
+			Size: types.StringNumber(len(data)),
+		})
+	}
+
+	// バックアップ履歴
+	if value.BackupSetting != nil && value.InstanceStatus.IsUp() {
+		status.Backups = append(status.Backups, &sacloud.DatabaseBackupHistory{
+			CreatedAt:    value.InstanceStatusChangedAt,
+			Availability: "available",
+			Size:         types.StringNumber(random(1024 * 1024)),
+		})
+	}
+
+	return status, nil
+}
+
+// GetParameter is fake implementation
+func (o *DatabaseOp) GetParameter(ctx context.Context, zone string, id types.ID) (*sacloud.DatabaseParameter, error) {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := make(map[string]interface{})
+	if v, ok := s.getByID(databaseParameterKey, zone, id).(*databaseParameter); ok {
+		for k, v := range v.Settings {
+			settings[k] = v
+		}
+	}
+
+	return &sacloud.DatabaseParameter{
+		Settings: settings,
+		MetaInfo: databaseParameterMetaInfo(value),
+	}, nil
+}
+
+// SetParameter is fake implementation
+func (o *DatabaseOp) SetParameter(ctx context.Context, zone string, id types.ID, param map[string]interface{}) error {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+
+	metaInfo := databaseParameterMetaInfo(value)
+	settings := make(map[string]interface{})
+	for k, v := range param {
+		var found bool
+		for _, m := range metaInfo {
+			if m.Name == k {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return newErrorBadRequest(o.key, id, fmt.Sprintf("parameter %q is not supported", k))
+		}
+		settings[k] = v
+	}
+
+	s.set(databaseParameterKey, zone, &databaseParameter{ID: id, Settings: settings})
+	return nil
+}
+
+// replicas マスター(id)を参照しているスレーブのIDを返す
+func (o *DatabaseOp) replicas(zone string, id types.ID) []types.ID {
+	var ids []types.ID
+	for _, v := range s.getDatabase(zone) {
+		if v.ReplicationSetting != nil &&
+			v.ReplicationSetting.Model == types.DatabaseReplicationModels.AsyncReplica &&
+			v.ReplicationSetting.ApplianceID == id {
+			ids = append(ids, v.ID)
+		}
+	}
+	return ids
+}
+
+// monitorTimes アクティビティモニタ向けの時刻を返す
+func monitorTimes() []time.Time {
+	now := time.Now().Truncate(time.Second)
+	m := now.Minute() % 5
+	if m != 0 {
+		now = now.Add(time.Duration(m) * time.Minute)
+	}
+
+	var times []time.Time
+	for i := 0; i < 5; i++ {
+		times = append(times, now.Add(time.Duration(i*-5)*time.Minute))
+	}
+	return times
+}
+
+// databaseParameterKey データベースのパラメータ設定を保持する際のキー
+const databaseParameterKey = "DatabaseParameter"
+
+// databaseParameter データベースのパラメータ設定
+type databaseParameter struct {
+	ID       types.ID
+	Settings map[string]interface{}
+}
+
+// GetID returns value of ID
+func (p *databaseParameter) GetID() types.ID {
+	return p.ID
+}
+
+// SetID sets value to ID
+func (p *databaseParameter) SetID(id types.ID) {
+	p.ID = id
+}
+
+// databaseParameterMetaInfo データベースの種類ごとの設定可能なパラメータ
+func databaseParameterMetaInfo(value *sacloud.Database) []*sacloud.DatabaseParameterMeta {
+	if value.Conf != nil && strings.EqualFold(value.Conf.DatabaseName, "postgres") {
+		return []*sacloud.DatabaseParameterMeta{
+			{
+				Type:    "number",
+				Name:    "postgres/postgresql.conf/max_connections",
+				Label:   "max_connections",
+				Example: "100",
+				Min:     12,
+				Max:     1000,
+			},
+			{
+				Type:    "string",
+				Name:    "postgres/postgresql.conf/work_mem",
+				Label:   "work_mem",
+				Example: "4MB",
+			},
+		}
+	}
+	return []*sacloud.DatabaseParameterMeta{
+		{
+			Type:    "number",
+			Name:    "MariaDB/server.cnf/mysqld/max_connections",
+			Label:   "max_connections",
+			Example: "100",
+			Min:     10,
+			Max:     1000,
+		},
+		{
+			Type:    "number",
+			Name:    "MariaDB/server.cnf/mysqld/long_query_time",
+			Label:   "long_query_time",
+			Example: "10",
+			Min:     0,
+			Max:     3600,
+		},
+	}
+}
@@ -0,0 +1,189 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Find is fake implementation
+func (o *DNSOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.DNS, error) {
+	results, _ := find(o.key, sacloud.DefaultZone, conditions)
+	var values []*sacloud.DNS
+	for _, res := range results {
+		dest := &sacloud.DNS{}
+		copySameNameField(res, dest)
+		values = append(values, dest)
+	}
+	return values, nil
+}
+
+// Create is fake implementation
+func (o *DNSOp) Create(ctx context.Context, zone string, param *sacloud.DNSCreateRequest) (*sacloud.DNS, error) {
+	if len(param.Name) > 253 || !hostNamePattern.MatchString(param.Name) {
+		return nil, newErrorBadRequest(o.key, types.ID(0), fmt.Sprintf("invalid zone name: %q", param.Name))
+	}
+	for _, dns := range s.getDNS(sacloud.DefaultZone) {
+		if strings.EqualFold(dns.Name, param.Name) {
+			return nil, newErrorConflict(o.key, types.ID(0), fmt.Sprintf("zone %q is already exists", param.Name))
+		}
+	}
+	if err := validateDNSRecords(param.Records); err != nil {
+		return nil, newErrorBadRequest(o.key, types.ID(0), err.Error())
+	}
+
+	result := &sacloud.DNS{}
+	copySameNameField(param, result)
+	fill(result, fillID, fillCreatedAt, fillAvailability)
+
+	nsNum := result.ID.Int64()%4 + 1
+	result.DNSNameServers = []string{
+		fmt.Sprintf("ns1.gslb%d.sakura.ne.jp", nsNum),
+		fmt.Sprintf("ns2.gslb%d.sakura.ne.jp", nsNum),
+	}
+	result.SettingsHash = "settingshash"
+	setDefaultDNSRecordTTL(result.Records)
+
+	s.setDNS(sacloud.DefaultZone, result)
+	return result, nil
+}
+
+// Read is fake implementation
+func (o *DNSOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.DNS, error) {
+	value := s.getDNSByID(sacloud.DefaultZone, id)
+	if value == nil {
+		return nil, newErrorNotFound(o.key, id)
+	}
+	dest := &sacloud.DNS{}
+	copySameNameField(value, dest)
+	return dest, nil
+}
+
+// Update is fake implementation
+func (o *DNSOp) Update(ctx context.Context, zone string, id types.ID, param *sacloud.DNSUpdateRequest) (*sacloud.DNS, error) {
+	value, err := o.Read(ctx, sacloud.DefaultZone, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateDNSRecords(param.Records); err != nil {
+		return nil, newErrorBadRequest(o.key, id, err.Error())
+	}
+
+	copySameNameField(param, value)
+	fill(value, fillModifiedAt)
+	setDefaultDNSRecordTTL(value.Records)
+
+	s.setDNS(sacloud.DefaultZone, value)
+	return value, nil
+}
+
+// Delete is fake implementation
+func (o *DNSOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	_, err := o.Read(ctx, sacloud.DefaultZone, id)
+	if err != nil {
+		return err
+	}
+	s.delete(o.key, sacloud.DefaultZone, id)
+	return nil
+}
+
+const defaultDNSRecordTTL = 3600
+
+func setDefaultDNSRecordTTL(records []*sacloud.DNSRecord) {
+	for _, record := range records {
+		if record.TTL == 0 {
+			record.TTL = defaultDNSRecordTTL
+		}
+	}
+}
+
+var (
+	dnsRecordNamePattern = regexp.MustCompile(`^(@|(\*\.)?([a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9])?)(\.[a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9])?)*|\*)$`)
+	dnsCAARDataPattern   = regexp.MustCompile(`^\d{1,3} (issue|issuewild|iodef) "[^"]*"$`)
+)
+
+// validateDNSRecords レコードの書式を種別ごとに検証する
+func validateDNSRecords(records []*sacloud.DNSRecord) error {
+	cnames := make(map[string]bool)
+	names := make(map[string]int)
+	for _, record := range records {
+		if err := validateDNSRecord(record); err != nil {
+			return err
+		}
+		name := strings.ToLower(record.Name)
+		names[name]++
+		if record.Type == types.DNSRecordTypes.CNAME {
+			cnames[name] = true
+		}
+	}
+	for name := range cnames {
+		if name == "@" {
+			return fmt.Errorf("CNAME record can not be set to zone apex")
+		}
+		if names[name] > 1 {
+			return fmt.Errorf("CNAME record %q can not coexist with other records", name)
+		}
+	}
+	return nil
+}
+
+func validateDNSRecord(record *sacloud.DNSRecord) error {
+	if !dnsRecordNamePattern.MatchString(record.Name) {
+		return fmt.Errorf("invalid record name: %q", record.Name)
+	}
+
+	rdata := record.RData
+	invalid := fmt.Errorf("invalid %s record data: %q", record.Type, rdata)
+	switch record.Type {
+	case types.DNSRecordTypes.A:
+		ip := net.ParseIP(rdata)
+		if ip == nil || ip.To4() == nil {
+			return invalid
+		}
+	case types.DNSRecordTypes.AAAA:
+		ip := net.ParseIP(rdata)
+		if ip == nil || ip.To4() != nil {
+			return invalid
+		}
+	case types.DNSRecordTypes.CNAME, types.DNSRecordTypes.NS, types.DNSRecordTypes.ALIAS:
+		if !isDNSAbsoluteName(rdata) {
+			return invalid
+		}
+	case types.DNSRecordTypes.MX:
+		fields := strings.Fields(rdata)
+		if len(fields) != 2 || !isUint16(fields[0]) || !isDNSAbsoluteName(fields[1]) {
+			return invalid
+		}
+	case types.DNSRecordTypes.SRV:
+		fields := strings.Fields(rdata)
+		if len(fields) != 4 || !isUint16(fields[0]) || !isUint16(fields[1]) || !isUint16(fields[2]) || !isDNSAbsoluteName(fields[3]) {
+			return invalid
+		}
+	case types.DNSRecordTypes.CAA:
+		if !dnsCAARDataPattern.MatchString(rdata) {
+			return invalid
+		}
+	case types.DNSRecordTypes.TXT:
+		if rdata == "" {
+			return invalid
+		}
+	default:
+		return fmt.Errorf("invalid record type: %q", record.Type)
+	}
+	return nil
+}
+
+func isDNSAbsoluteName(name string) bool {
+	return strings.HasSuffix(name, ".") && len(name) <= 254 && hostNamePattern.MatchString(name)
+}
+
+func isUint16(v string) bool {
+	_, err := strconv.ParseUint(v, 10, 16)
+	return err == nil
+}
@@ -0,0 +1,35 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Find is fake implementation
+//
+// 対象年月の請求情報の明細を元に利用実績を作成する
+func (o *ESUsageOp) Find(ctx context.Context, zone string, accountID types.ID, year int, month int) ([]*sacloud.ESUsage, error) {
+	bills, err := NewBillOp().ByContractYearMonth(ctx, zone, accountID, year, month)
+	if err != nil {
+		return nil, newErrorNotFound(o.key, accountID)
+	}
+
+	var values []*sacloud.ESUsage
+	for _, bill := range bills {
+		for _, detail := range billDetails(bill) {
+			values = append(values, &sacloud.ESUsage{
+				ID:             detail.ID,
+				MemberID:       bill.MemberID,
+				ContractID:     detail.ID,
+				ServiceClassID: detail.ServiceClassID,
+				Description:    detail.Description,
+				Usage:          detail.Usage,
+				Amount:         detail.Amount,
+				UsageDate:      bill.Date,
+			})
+		}
+	}
+	return values, nil
+}
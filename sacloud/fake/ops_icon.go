@@ -0,0 +1,115 @@
+package fake
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Find is fake implementation
+func (o *IconOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Icon, error) {
+	results, _ := find(o.key, sacloud.DefaultZone, conditions)
+	var values []*sacloud.Icon
+	for _, res := range results {
+		dest := &sacloud.Icon{}
+		copySameNameField(res, dest)
+		values = append(values, dest)
+	}
+	return values, nil
+}
+
+// Create is fake implementation
+func (o *IconOp) Create(ctx context.Context, zone string, param *sacloud.IconCreateRequest) (*sacloud.Icon, error) {
+	if _, err := base64.StdEncoding.DecodeString(param.Image); err != nil {
+		return nil, newErrorBadRequest(o.key, types.ID(0), "invalid image: image must be base64 encoded")
+	}
+
+	result := &sacloud.Icon{}
+	copySameNameField(param, result)
+	fill(result, fillID, fillCreatedAt, fillAvailability)
+	result.Scope = types.Scopes.User
+	// fakeではAPIのルートURLが不定なためルートURLからの相対パスとする
+	result.URL = fmt.Sprintf("/%s/api/cloud/1.1/icon/%s.png", sacloud.DefaultZone, result.ID)
+
+	s.setIcon(sacloud.DefaultZone, result)
+	s.set(iconImageKey, sacloud.DefaultZone, &iconImage{ID: result.ID, Image: param.Image})
+	return result, nil
+}
+
+// Read is fake implementation
+func (o *IconOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Icon, error) {
+	value := s.getIconByID(sacloud.DefaultZone, id)
+	if value == nil {
+		return nil, newErrorNotFound(o.key, id)
+	}
+
+	dest := &sacloud.Icon{}
+	copySameNameField(value, dest)
+	return dest, nil
+}
+
+// Update is fake implementation
+func (o *IconOp) Update(ctx context.Context, zone string, id types.ID, param *sacloud.IconUpdateRequest) (*sacloud.Icon, error) {
+	value, err := o.Read(ctx, sacloud.DefaultZone, id)
+	if err != nil {
+		return nil, err
+	}
+	copySameNameField(param, value)
+	fill(value, fillModifiedAt)
+
+	s.setIcon(sacloud.DefaultZone, value)
+	return value, nil
+}
+
+// Delete is fake implementation
+func (o *IconOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	_, err := o.Read(ctx, sacloud.DefaultZone, id)
+	if err != nil {
+		return err
+	}
+	s.delete(o.key, sacloud.DefaultZone, id)
+	s.delete(iconImageKey, sacloud.DefaultZone, id)
+	return nil
+}
+
+// GetImage is fake implementation
+//
+// fakeドライバーでは画像のリサイズは行わず、sizeによらずアップロードされた画像をそのまま返す
+func (o *IconOp) GetImage(ctx context.Context, zone string, id types.ID, size types.EIconSize) (*sacloud.IconImage, error) {
+	if _, err := o.Read(ctx, sacloud.DefaultZone, id); err != nil {
+		return nil, err
+	}
+
+	switch size {
+	case types.IconSizes.Small, types.IconSizes.Medium, types.IconSizes.Large:
+	default:
+		return nil, newErrorBadRequest(o.key, id, fmt.Sprintf("invalid size: %s", size))
+	}
+
+	result := &sacloud.IconImage{ID: id}
+	if v := s.getByID(iconImageKey, sacloud.DefaultZone, id); v != nil {
+		result.Image = v.(*iconImage).Image
+	}
+	return result, nil
+}
+
+const iconImageKey = "IconImage"
+
+// iconImage アップロードされたアイコン画像
+type iconImage struct {
+	ID    types.ID
+	Image string
+}
+
+// GetID returns value of ID
+func (i *iconImage) GetID() types.ID {
+	return i.ID
+}
+
+// SetID sets value to ID
+func (i *iconImage) SetID(id types.ID) {
+	i.ID = id
+}
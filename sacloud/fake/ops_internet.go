@@ -74,6 +74,7 @@ func (o *InternetOp) Create(ctx context.Context, zone string, param *sacloud.Int
 
 	s.setSwitch(zone, sw)
 	s.setInternet(zone, result)
+	setSubnetFromSwitchSubnet(zone, sw.ID, result.ID, sSubnet)
 	return result, nil
 }
 
@@ -111,6 +112,14 @@ func (o *InternetOp) Delete(ctx context.Context, zone string, id types.ID) error
 		return err
 	}
 
+	for _, subnet := range value.Switch.Subnets {
+		s.delete(ResourceSubnet, zone, subnet.ID)
+	}
+	for _, ipv6Net := range value.Switch.IPv6Nets {
+		s.delete(ResourceIPv6Net, zone, ipv6Net.ID)
+		s.delete(ipv6AddrSetKey, zone, ipv6Net.ID)
+	}
+
 	s.delete(o.key, zone, id)
 	return nil
 }
@@ -167,6 +176,7 @@ func (o *InternetOp) AddSubnet(ctx context.Context, zone string, id types.ID, pa
 
 	s.setSwitch(zone, sw)
 	s.setInternet(zone, value)
+	setSubnetFromSwitchSubnet(zone, sw.ID, value.ID, sSubnet)
 
 	return &sacloud.InternetSubnetOperationResult{
 		ID:             sSubnet.ID,
@@ -225,6 +235,12 @@ func (o *InternetOp) UpdateSubnet(ctx context.Context, zone string, id types.ID,
 		i++
 	}
 
+	if subnet := s.getSubnetByID(zone, subnetID); subnet != nil {
+		subnet.NextHop = param.NextHop
+		subnet.StaticRoute = param.NextHop
+		s.setSubnet(zone, subnet)
+	}
+
 	s.setSwitch(zone, sw)
 	s.setInternet(zone, value)
 	return &sacloud.InternetSubnetOperationResult{
@@ -268,6 +284,7 @@ func (o *InternetOp) DeleteSubnet(ctx context.Context, zone string, id types.ID,
 
 	s.setSwitch(zone, sw)
 	s.setInternet(zone, value)
+	s.delete(ResourceSubnet, zone, subnetID)
 	return nil
 }
 
@@ -295,3 +312,65 @@ func (o *InternetOp) Monitor(ctx context.Context, zone string, id types.ID, cond
 
 	return res, nil
 }
+
+// EnableIPv6 is fake implementation
+func (o *InternetOp) EnableIPv6(ctx context.Context, zone string, id types.ID) (*sacloud.IPv6NetInfo, error) {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(value.Switch.IPv6Nets) > 0 {
+		return nil, newErrorConflict(o.key, id, "IPv6 is already enabled")
+	}
+
+	ipNet := pool.nextIPv6Net()
+	tail := make(net.IP, net.IPv6len)
+	for i := range tail {
+		tail[i] = ipNet.IP[i] | ^ipNet.Mask[i]
+	}
+
+	ipv6Net := &sacloud.IPv6Net{
+		ID:             pool.generateID(),
+		IPv6Prefix:     ipNet.IP.String(),
+		IPv6PrefixLen:  64,
+		IPv6PrefixTail: tail.String(),
+		IPv6TableID:    pool.generateID(),
+		SwitchID:       value.Switch.ID,
+	}
+	fill(ipv6Net, fillCreatedAt)
+	s.setIPv6Net(zone, ipv6Net)
+
+	info := &sacloud.IPv6NetInfo{
+		ID:            ipv6Net.ID,
+		IPv6Prefix:    ipv6Net.IPv6Prefix,
+		IPv6PrefixLen: ipv6Net.IPv6PrefixLen,
+	}
+	value.Switch.IPv6Nets = []*sacloud.IPv6NetInfo{info}
+	s.setInternet(zone, value)
+
+	return info, nil
+}
+
+// DisableIPv6 is fake implementation
+func (o *InternetOp) DisableIPv6(ctx context.Context, zone string, id types.ID, ipv6netID types.ID) error {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+
+	var ipv6Nets []*sacloud.IPv6NetInfo
+	for _, ipv6Net := range value.Switch.IPv6Nets {
+		if ipv6Net.ID != ipv6netID {
+			ipv6Nets = append(ipv6Nets, ipv6Net)
+		}
+	}
+	if len(ipv6Nets) == len(value.Switch.IPv6Nets) {
+		return newErrorNotFound(ResourceIPv6Net, ipv6netID)
+	}
+	value.Switch.IPv6Nets = ipv6Nets
+
+	s.delete(ResourceIPv6Net, zone, ipv6netID)
+	s.delete(ipv6AddrSetKey, zone, ipv6netID)
+	s.setInternet(zone, value)
+	return nil
+}
@@ -0,0 +1,99 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Find is fake implementation
+func (o *IPAddressOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.IPAddress, error) {
+	if conditions == nil {
+		conditions = &sacloud.FindCondition{}
+	}
+
+	var values []*sacloud.IPAddress
+	var i int
+	for _, subnet := range o.subnets(zone) {
+		for _, ip := range subnet.IPAddresses {
+			if conditions.Count != 0 && len(values) >= conditions.Count {
+				return values, nil
+			}
+			if i >= conditions.From {
+				values = append(values, &sacloud.IPAddress{
+					HostName:  ip.HostName,
+					IPAddress: ip.IPAddress,
+					SubnetID:  subnet.ID,
+				})
+			}
+			i++
+		}
+	}
+	return values, nil
+}
+
+// Read is fake implementation
+func (o *IPAddressOp) Read(ctx context.Context, zone string, ipAddress string) (*sacloud.IPAddress, error) {
+	subnet, ip := o.lookup(zone, ipAddress)
+	if ip == nil {
+		return nil, newErrorNotFound(o.key, types.ID(0))
+	}
+	return &sacloud.IPAddress{
+		HostName:  ip.HostName,
+		IPAddress: ip.IPAddress,
+		SubnetID:  subnet.ID,
+	}, nil
+}
+
+// UpdateHostName is fake implementation
+func (o *IPAddressOp) UpdateHostName(ctx context.Context, zone string, ipAddress string, hostName string) (*sacloud.IPAddress, error) {
+	subnet, ip := o.lookup(zone, ipAddress)
+	if ip == nil {
+		return nil, newErrorNotFound(o.key, types.ID(0))
+	}
+	if err := validateHostName(hostName); err != nil {
+		return nil, newErrorBadRequest(o.key, types.ID(0), err.Error())
+	}
+
+	ip.HostName = hostName
+	s.setSubnet(zone, subnet)
+
+	return o.Read(ctx, zone, ipAddress)
+}
+
+func (o *IPAddressOp) subnets(zone string) []*sacloud.Subnet {
+	var subnets []*sacloud.Subnet
+	for _, v := range s.get(ResourceSubnet, zone) {
+		subnets = append(subnets, v.(*sacloud.Subnet))
+	}
+	return subnets
+}
+
+func (o *IPAddressOp) lookup(zone string, ipAddress string) (*sacloud.Subnet, *sacloud.SubnetIPAddress) {
+	for _, subnet := range o.subnets(zone) {
+		for _, ip := range subnet.IPAddresses {
+			if ip.IPAddress == ipAddress {
+				return subnet, ip
+			}
+		}
+	}
+	return nil, nil
+}
+
+var hostNamePattern = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z]{2,63}\.?$`)
+
+// validateHostName 逆引き(PTRレコード)に設定するホスト名を検証する
+//
+// 空文字の場合は逆引きの設定を解除するものとして扱う
+func validateHostName(hostName string) error {
+	if hostName == "" {
+		return nil
+	}
+	if len(hostName) > 253 || !hostNamePattern.MatchString(hostName) {
+		return fmt.Errorf("invalid host name: %q is not a valid FQDN", hostName)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Find is fake implementation
+func (o *IPv6AddrOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.IPv6Addr, error) {
+	if conditions == nil {
+		conditions = &sacloud.FindCondition{}
+	}
+
+	var values []*sacloud.IPv6Addr
+	var i int
+	for _, v := range s.get(ipv6AddrSetKey, zone) {
+		for _, addr := range v.(*ipv6AddrSet).Addrs {
+			if conditions.Count != 0 && len(values) >= conditions.Count {
+				return values, nil
+			}
+			if i >= conditions.From {
+				dest := &sacloud.IPv6Addr{}
+				copySameNameField(addr, dest)
+				values = append(values, dest)
+			}
+			i++
+		}
+	}
+	return values, nil
+}
+
+// Create is fake implementation
+func (o *IPv6AddrOp) Create(ctx context.Context, zone string, param *sacloud.IPv6AddrCreateRequest) (*sacloud.IPv6Addr, error) {
+	ip := net.ParseIP(param.IPv6Addr)
+	if ip == nil || ip.To4() != nil {
+		return nil, newErrorBadRequest(o.key, types.ID(0), fmt.Sprintf("invalid IPv6 address: %q", param.IPv6Addr))
+	}
+	if err := validateHostName(param.HostName); err != nil {
+		return nil, newErrorBadRequest(o.key, types.ID(0), err.Error())
+	}
+
+	ipv6Net := o.lookupIPv6Net(zone, ip)
+	if ipv6Net == nil {
+		return nil, newErrorBadRequest(o.key, types.ID(0), fmt.Sprintf("IPv6 address %q is not in any IPv6 network", param.IPv6Addr))
+	}
+	if _, err := o.Read(ctx, zone, ip.String()); err == nil {
+		return nil, newErrorConflict(o.key, types.ID(0), fmt.Sprintf("IPv6 address %q already exists", param.IPv6Addr))
+	}
+
+	result := &sacloud.IPv6Addr{
+		IPv6Addr:  ip.String(),
+		HostName:  param.HostName,
+		IPv6NetID: ipv6Net.ID,
+		SwitchID:  ipv6Net.SwitchID,
+	}
+
+	set := &ipv6AddrSet{ID: ipv6Net.ID}
+	if v := s.getByID(ipv6AddrSetKey, zone, ipv6Net.ID); v != nil {
+		set = v.(*ipv6AddrSet)
+	}
+	set.Addrs = append(set.Addrs, result)
+	s.set(ipv6AddrSetKey, zone, set)
+
+	ipv6Net.NamedIPv6AddrCount = len(set.Addrs)
+	s.setIPv6Net(zone, ipv6Net)
+
+	return result, nil
+}
+
+// Read is fake implementation
+func (o *IPv6AddrOp) Read(ctx context.Context, zone string, ipv6addr string) (*sacloud.IPv6Addr, error) {
+	_, addr := o.lookup(zone, ipv6addr)
+	if addr == nil {
+		return nil, newErrorNotFound(o.key, types.ID(0))
+	}
+	dest := &sacloud.IPv6Addr{}
+	copySameNameField(addr, dest)
+	return dest, nil
+}
+
+// Update is fake implementation
+func (o *IPv6AddrOp) Update(ctx context.Context, zone string, ipv6addr string, param *sacloud.IPv6AddrUpdateRequest) (*sacloud.IPv6Addr, error) {
+	set, addr := o.lookup(zone, ipv6addr)
+	if addr == nil {
+		return nil, newErrorNotFound(o.key, types.ID(0))
+	}
+	if err := validateHostName(param.HostName); err != nil {
+		return nil, newErrorBadRequest(o.key, types.ID(0), err.Error())
+	}
+
+	addr.HostName = param.HostName
+	s.set(ipv6AddrSetKey, zone, set)
+
+	return o.Read(ctx, zone, ipv6addr)
+}
+
+// Delete is fake implementation
+func (o *IPv6AddrOp) Delete(ctx context.Context, zone string, ipv6addr string) error {
+	set, addr := o.lookup(zone, ipv6addr)
+	if addr == nil {
+		return newErrorNotFound(o.key, types.ID(0))
+	}
+
+	var addrs []*sacloud.IPv6Addr
+	for _, v := range set.Addrs {
+		if v != addr {
+			addrs = append(addrs, v)
+		}
+	}
+	set.Addrs = addrs
+	s.set(ipv6AddrSetKey, zone, set)
+
+	if ipv6Net := s.getIPv6NetByID(zone, set.ID); ipv6Net != nil {
+		ipv6Net.NamedIPv6AddrCount = len(set.Addrs)
+		s.setIPv6Net(zone, ipv6Net)
+	}
+	return nil
+}
+
+func (o *IPv6AddrOp) lookup(zone string, ipv6addr string) (*ipv6AddrSet, *sacloud.IPv6Addr) {
+	ip := net.ParseIP(ipv6addr)
+	if ip == nil {
+		return nil, nil
+	}
+	for _, v := range s.get(ipv6AddrSetKey, zone) {
+		set := v.(*ipv6AddrSet)
+		for _, addr := range set.Addrs {
+			if net.ParseIP(addr.IPv6Addr).Equal(ip) {
+				return set, addr
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (o *IPv6AddrOp) lookupIPv6Net(zone string, ip net.IP) *sacloud.IPv6Net {
+	for _, v := range s.get(ResourceIPv6Net, zone) {
+		ipv6Net := v.(*sacloud.IPv6Net)
+		_, ipNet, err := net.ParseCIDR(fmt.Sprintf("%s/%d", ipv6Net.IPv6Prefix, ipv6Net.IPv6PrefixLen))
+		if err == nil && ipNet.Contains(ip) {
+			return ipv6Net
+		}
+	}
+	return nil
+}
+
+const ipv6AddrSetKey = "IPv6AddrSet"
+
+// ipv6AddrSet IPv6ネットワークごとに登録されたIPv6アドレス
+type ipv6AddrSet struct {
+	ID    types.ID
+	Addrs []*sacloud.IPv6Addr
+}
+
+// GetID returns value of ID
+func (i *ipv6AddrSet) GetID() types.ID {
+	return i.ID
+}
+
+// SetID sets value to ID
+func (i *ipv6AddrSet) SetID(id types.ID) {
+	i.ID = id
+}
@@ -0,0 +1,70 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Find is fake implementation
+func (o *LicenseOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.License, error) {
+	results, _ := find(o.key, sacloud.DefaultZone, conditions)
+	var values []*sacloud.License
+	for _, res := range results {
+		dest := &sacloud.License{}
+		copySameNameField(res, dest)
+		values = append(values, dest)
+	}
+	return values, nil
+}
+
+// Create is fake implementation
+func (o *LicenseOp) Create(ctx context.Context, zone string, param *sacloud.LicenseCreateRequest) (*sacloud.License, error) {
+	plan, err := NewLicensePlanOp().Read(ctx, sacloud.DefaultZone, param.LicenseInfoID)
+	if err != nil {
+		return nil, newErrorBadRequest(o.key, types.ID(0), "LicenseInfo is not found")
+	}
+
+	result := &sacloud.License{}
+	copySameNameField(param, result)
+	fill(result, fillID, fillCreatedAt, fillModifiedAt)
+	result.LicenseInfoName = plan.Name
+
+	s.setLicense(sacloud.DefaultZone, result)
+	return result, nil
+}
+
+// Read is fake implementation
+func (o *LicenseOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.License, error) {
+	value := s.getLicenseByID(sacloud.DefaultZone, id)
+	if value == nil {
+		return nil, newErrorNotFound(o.key, id)
+	}
+	dest := &sacloud.License{}
+	copySameNameField(value, dest)
+	return dest, nil
+}
+
+// Update is fake implementation
+func (o *LicenseOp) Update(ctx context.Context, zone string, id types.ID, param *sacloud.LicenseUpdateRequest) (*sacloud.License, error) {
+	value, err := o.Read(ctx, sacloud.DefaultZone, id)
+	if err != nil {
+		return nil, err
+	}
+	copySameNameField(param, value)
+	fill(value, fillModifiedAt)
+
+	s.setLicense(sacloud.DefaultZone, value)
+	return value, nil
+}
+
+// Delete is fake implementation
+func (o *LicenseOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	_, err := o.Read(ctx, sacloud.DefaultZone, id)
+	if err != nil {
+		return err
+	}
+	s.delete(o.key, sacloud.DefaultZone, id)
+	return nil
+}
@@ -0,0 +1,31 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Find is fake implementation
+func (o *LicensePlanOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.LicensePlan, error) {
+	results, _ := find(o.key, sacloud.DefaultZone, conditions)
+	var values []*sacloud.LicensePlan
+	for _, res := range results {
+		dest := &sacloud.LicensePlan{}
+		copySameNameField(res, dest)
+		values = append(values, dest)
+	}
+	return values, nil
+}
+
+// Read is fake implementation
+func (o *LicensePlanOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.LicensePlan, error) {
+	value := s.getLicensePlanByID(sacloud.DefaultZone, id)
+	if value == nil {
+		return nil, newErrorNotFound(o.key, id)
+	}
+	dest := &sacloud.LicensePlan{}
+	copySameNameField(value, dest)
+	return dest, nil
+}
@@ -0,0 +1,500 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Find is fake implementation
+func (o *MobileGatewayOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.MobileGateway, error) {
+	results, _ := find(o.key, zone, conditions)
+	var values []*sacloud.MobileGateway
+	for _, res := range results {
+		dest := &sacloud.MobileGateway{}
+		copySameNameField(res, dest)
+		values = append(values, dest)
+	}
+	return values, nil
+}
+
+// Create is fake implementation
+func (o *MobileGatewayOp) Create(ctx context.Context, zone string, param *sacloud.MobileGatewayCreateRequest) (*sacloud.MobileGateway, error) {
+	result := &sacloud.MobileGateway{}
+	copySameNameField(param, result)
+	fill(result, fillID, fillCreatedAt)
+
+	result.Class = "mobilegateway"
+	result.Availability = types.Availabilities.Migrating
+	result.ZoneID = zoneIDs[zone]
+	result.SettingsHash = ""
+
+	// eth0は常に共有セグメントへ接続される
+	ifOp := NewInterfaceOp()
+	iface, err := ifOp.Create(ctx, zone, &sacloud.InterfaceCreateRequest{ServerID: result.ID})
+	if err != nil {
+		return nil, newErrorConflict(o.key, types.ID(0), err.Error())
+	}
+	if err := ifOp.ConnectToSharedSegment(ctx, zone, iface.ID); err != nil {
+		return nil, newErrorConflict(o.key, types.ID(0), err.Error())
+	}
+	iface, err = ifOp.Read(ctx, zone, iface.ID)
+	if err != nil {
+		return nil, newErrorConflict(o.key, types.ID(0), err.Error())
+	}
+	mgwInterface := &sacloud.MobileGatewayInterface{}
+	copySameNameField(iface, mgwInterface)
+	result.Interfaces = append(result.Interfaces, mgwInterface)
+
+	s.setMobileGateway(zone, result)
+
+	id := result.ID
+	startMigration(o.key, zone, func() (interface{}, error) {
+		return o.Read(context.Background(), zone, id)
+	})
+	return result, nil
+}
+
+// Read is fake implementation
+func (o *MobileGatewayOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGateway, error) {
+	value := s.getMobileGatewayByID(zone, id)
+	if value == nil {
+		return nil, newErrorNotFound(o.key, id)
+	}
+	dest := &sacloud.MobileGateway{}
+	copySameNameField(value, dest)
+	return dest, nil
+}
+
+// Update is fake implementation
+func (o *MobileGatewayOp) Update(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayUpdateRequest) (*sacloud.MobileGateway, error) {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	copySameNameField(param, value)
+	fill(value, fillModifiedAt)
+
+	s.setMobileGateway(zone, value)
+	return value, nil
+}
+
+// Delete is fake implementation
+func (o *MobileGatewayOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	_, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+	s.delete(o.key, zone, id)
+	s.delete(mobileGatewayDetailKey, zone, id)
+	return nil
+}
+
+// Config is fake implementation
+func (o *MobileGatewayOp) Config(ctx context.Context, zone string, id types.ID) error {
+	_, err := o.Read(ctx, zone, id)
+	return err
+}
+
+// Boot is fake implementation
+func (o *MobileGatewayOp) Boot(ctx context.Context, zone string, id types.ID) error {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+	if value.InstanceStatus.IsUp() {
+		return newErrorConflict(o.key, id, "Boot is failed")
+	}
+
+	startPowerOn(o.key, zone, func() (interface{}, error) {
+		return o.Read(context.Background(), zone, id)
+	})
+
+	return err
+}
+
+// Shutdown is fake implementation
+func (o *MobileGatewayOp) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+	if !value.InstanceStatus.IsUp() {
+		return newErrorConflict(o.key, id, "Shutdown is failed")
+	}
+
+	startPowerOff(o.key, zone, func() (interface{}, error) {
+		return o.Read(context.Background(), zone, id)
+	})
+
+	return err
+}
+
+// Reset is fake implementation
+func (o *MobileGatewayOp) Reset(ctx context.Context, zone string, id types.ID) error {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+	if !value.InstanceStatus.IsUp() {
+		return newErrorConflict(o.key, id, "Reset is failed")
+	}
+
+	startPowerOn(o.key, zone, func() (interface{}, error) {
+		return o.Read(context.Background(), zone, id)
+	})
+
+	return nil
+}
+
+// ConnectToSwitch is fake implementation
+func (o *MobileGatewayOp) ConnectToSwitch(ctx context.Context, zone string, id types.ID, switchID types.ID) error {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+
+	for _, nic := range value.Interfaces {
+		if nic.Index == 1 {
+			return newErrorBadRequest(o.key, id, "nic[1] already connected to switch")
+		}
+	}
+
+	// find switch
+	swOp := NewSwitchOp()
+	_, err = swOp.Read(ctx, zone, switchID)
+	if err != nil {
+		return fmt.Errorf("ConnectToSwitch is failed: %s", err)
+	}
+
+	// create interface
+	ifOp := NewInterfaceOp()
+	iface, err := ifOp.Create(ctx, zone, &sacloud.InterfaceCreateRequest{ServerID: id})
+	if err != nil {
+		return newErrorConflict(o.key, types.ID(0), err.Error())
+	}
+
+	if err := ifOp.ConnectToSwitch(ctx, zone, iface.ID, switchID); err != nil {
+		return newErrorConflict(o.key, types.ID(0), err.Error())
+	}
+
+	iface, err = ifOp.Read(ctx, zone, iface.ID)
+	if err != nil {
+		return newErrorConflict(o.key, types.ID(0), err.Error())
+	}
+	mgwInterface := &sacloud.MobileGatewayInterface{}
+	copySameNameField(iface, mgwInterface)
+	mgwInterface.Index = 1
+	value.Interfaces = append(value.Interfaces, mgwInterface)
+
+	s.setMobileGateway(zone, value)
+	return nil
+}
+
+// DisconnectFromSwitch is fake implementation
+func (o *MobileGatewayOp) DisconnectFromSwitch(ctx context.Context, zone string, id types.ID) error {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+
+	var exists bool
+	var nicID types.ID
+	var interfaces []*sacloud.MobileGatewayInterface
+
+	for _, nic := range value.Interfaces {
+		if nic.Index == 1 {
+			exists = true
+			nicID = nic.ID
+		} else {
+			interfaces = append(interfaces, nic)
+		}
+	}
+	if !exists {
+		return newErrorBadRequest(o.key, id, "nic[1] is not exists")
+	}
+
+	ifOp := NewInterfaceOp()
+	if err := ifOp.DisconnectFromSwitch(ctx, zone, nicID); err != nil {
+		return newErrorConflict(o.key, types.ID(0), err.Error())
+	}
+
+	value.Interfaces = interfaces
+	s.setMobileGateway(zone, value)
+	return nil
+}
+
+// GetDNS is fake implementation
+func (o *MobileGatewayOp) GetDNS(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGatewayDNSSetting, error) {
+	detail, err := o.readDetail(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	dest := &sacloud.MobileGatewayDNSSetting{}
+	copySameNameField(detail.DNS, dest)
+	return dest, nil
+}
+
+// SetDNS is fake implementation
+func (o *MobileGatewayOp) SetDNS(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayDNSSetting) error {
+	detail, err := o.readDetail(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+	copySameNameField(param, detail.DNS)
+	s.set(mobileGatewayDetailKey, zone, detail)
+	return nil
+}
+
+// ListSIM is fake implementation
+func (o *MobileGatewayOp) ListSIM(ctx context.Context, zone string, id types.ID) ([]*sacloud.MobileGatewaySIMInfo, error) {
+	detail, err := o.readDetail(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	var values []*sacloud.MobileGatewaySIMInfo
+	for _, sim := range detail.SIMs {
+		dest := &sacloud.MobileGatewaySIMInfo{}
+		copySameNameField(sim, dest)
+		values = append(values, dest)
+	}
+	return values, nil
+}
+
+// AddSIM is fake implementation
+func (o *MobileGatewayOp) AddSIM(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayAddSIMRequest) error {
+	detail, err := o.readDetail(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+
+	simID := types.StringID(param.SIMID)
+	if simID.IsEmpty() {
+		return newErrorBadRequest(o.key, id, "SIMID is required")
+	}
+	for _, sim := range detail.SIMs {
+		if sim.ResourceID == simID.String() {
+			return newErrorConflict(o.key, id, fmt.Sprintf("SIM[%s] is already added", simID))
+		}
+	}
+
+	// SIMはグローバルリソースのためデフォルトゾーンから参照する
+	sim, err := NewSIMOp().Read(ctx, sacloud.DefaultZone, simID)
+	if err != nil {
+		return newErrorBadRequest(o.key, id, err.Error())
+	}
+
+	detail.SIMs = append(detail.SIMs, &sacloud.MobileGatewaySIMInfo{
+		ICCID:          sim.ICCID.String(),
+		ResourceID:     simID.String(),
+		SessionStatus:  "DOWN",
+		Activated:      true,
+		Registered:     true,
+		RegisteredDate: time.Now(),
+		ActivatedDate:  time.Now(),
+	})
+	s.set(mobileGatewayDetailKey, zone, detail)
+	return nil
+}
+
+// DeleteSIM is fake implementation
+func (o *MobileGatewayOp) DeleteSIM(ctx context.Context, zone string, id types.ID, simID types.ID) error {
+	detail, err := o.readDetail(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+
+	var exists bool
+	var sims []*sacloud.MobileGatewaySIMInfo
+	for _, sim := range detail.SIMs {
+		if sim.ResourceID == simID.String() {
+			exists = true
+			continue
+		}
+		sims = append(sims, sim)
+	}
+	if !exists {
+		return newErrorNotFound(o.key, simID)
+	}
+
+	// 削除したSIMへのルートも合わせて削除する
+	var routes []*sacloud.MobileGatewaySIMRoute
+	for _, route := range detail.SIMRoutes {
+		if route.ResourceID != simID.String() {
+			routes = append(routes, route)
+		}
+	}
+
+	detail.SIMs = sims
+	detail.SIMRoutes = routes
+	s.set(mobileGatewayDetailKey, zone, detail)
+	return nil
+}
+
+// GetSIMRoutes is fake implementation
+func (o *MobileGatewayOp) GetSIMRoutes(ctx context.Context, zone string, id types.ID) ([]*sacloud.MobileGatewaySIMRoute, error) {
+	detail, err := o.readDetail(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	var values []*sacloud.MobileGatewaySIMRoute
+	for _, route := range detail.SIMRoutes {
+		dest := &sacloud.MobileGatewaySIMRoute{}
+		copySameNameField(route, dest)
+		values = append(values, dest)
+	}
+	return values, nil
+}
+
+// SetSIMRoutes is fake implementation
+func (o *MobileGatewayOp) SetSIMRoutes(ctx context.Context, zone string, id types.ID, routes []*sacloud.MobileGatewaySIMRoute) error {
+	detail, err := o.readDetail(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+
+	var values []*sacloud.MobileGatewaySIMRoute
+	for _, route := range routes {
+		var sim *sacloud.MobileGatewaySIMInfo
+		for _, v := range detail.SIMs {
+			if v.ResourceID == route.ResourceID {
+				sim = v
+				break
+			}
+		}
+		if sim == nil {
+			return newErrorBadRequest(o.key, id, fmt.Sprintf("SIM[%s] is not added to mobile gateway", route.ResourceID))
+		}
+		values = append(values, &sacloud.MobileGatewaySIMRoute{
+			ResourceID: route.ResourceID,
+			Prefix:     route.Prefix,
+			ICCID:      sim.ICCID,
+		})
+	}
+
+	detail.SIMRoutes = values
+	s.set(mobileGatewayDetailKey, zone, detail)
+	return nil
+}
+
+// GetTrafficConfig is fake implementation
+func (o *MobileGatewayOp) GetTrafficConfig(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGatewayTrafficControl, error) {
+	detail, err := o.readDetail(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	if detail.TrafficControl == nil {
+		return nil, newErrorNotFound(o.key, id)
+	}
+	dest := &sacloud.MobileGatewayTrafficControl{}
+	copySameNameField(detail.TrafficControl, dest)
+	return dest, nil
+}
+
+// SetTrafficConfig is fake implementation
+func (o *MobileGatewayOp) SetTrafficConfig(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayTrafficControl) error {
+	detail, err := o.readDetail(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+	detail.TrafficControl = &sacloud.MobileGatewayTrafficControl{}
+	copySameNameField(param, detail.TrafficControl)
+	s.set(mobileGatewayDetailKey, zone, detail)
+	return nil
+}
+
+// DeleteTrafficConfig is fake implementation
+func (o *MobileGatewayOp) DeleteTrafficConfig(ctx context.Context, zone string, id types.ID) error {
+	detail, err := o.readDetail(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+	detail.TrafficControl = nil
+	s.set(mobileGatewayDetailKey, zone, detail)
+	return nil
+}
+
+// TrafficStatus is fake implementation
+func (o *MobileGatewayOp) TrafficStatus(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGatewayTrafficStatus, error) {
+	detail, err := o.readDetail(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &sacloud.MobileGatewayTrafficStatus{
+		UplinkBytes:   types.StringNumber(random(1000)),
+		DownlinkBytes: types.StringNumber(random(1000)),
+	}
+	if detail.TrafficControl != nil && detail.TrafficControl.TrafficQuotaInMB > 0 {
+		quota := float64(detail.TrafficControl.TrafficQuotaInMB) * 1024 * 1024
+		status.TrafficShaping = float64(status.UplinkBytes+status.DownlinkBytes) > quota
+	}
+	return status, nil
+}
+
+// MonitorInterface is fake implementation
+func (o *MobileGatewayOp) MonitorInterface(ctx context.Context, zone string, id types.ID, index int, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
+	_, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Truncate(time.Second)
+	m := now.Minute() % 5
+	if m != 0 {
+		now = now.Add(time.Duration(m) * time.Minute)
+	}
+
+	res := &sacloud.InterfaceActivity{}
+	for i := 0; i < 5; i++ {
+		res.Values = append(res.Values, &sacloud.MonitorInterfaceValue{
+			Time:    now.Add(time.Duration(i*-5) * time.Minute),
+			Send:    float64(random(1000)),
+			Receive: float64(random(1000)),
+		})
+	}
+
+	return res, nil
+}
+
+// mobileGatewayDetailKey モバイルゲートウェイ配下の設定(DNS/SIM/SIMルート/トラフィックコントロール)を保持する際のキー
+const mobileGatewayDetailKey = "MobileGatewayDetail"
+
+// mobileGatewayDetail モバイルゲートウェイ配下の設定
+type mobileGatewayDetail struct {
+	ID             types.ID
+	DNS            *sacloud.MobileGatewayDNSSetting
+	SIMs           []*sacloud.MobileGatewaySIMInfo
+	SIMRoutes      []*sacloud.MobileGatewaySIMRoute
+	TrafficControl *sacloud.MobileGatewayTrafficControl
+}
+
+// GetID returns value of ID
+func (d *mobileGatewayDetail) GetID() types.ID {
+	return d.ID
+}
+
+// SetID sets value to ID
+func (d *mobileGatewayDetail) SetID(id types.ID) {
+	d.ID = id
+}
+
+func (o *MobileGatewayOp) readDetail(ctx context.Context, zone string, id types.ID) (*mobileGatewayDetail, error) {
+	if _, err := o.Read(ctx, zone, id); err != nil {
+		return nil, err
+	}
+
+	if v, ok := s.getByID(mobileGatewayDetailKey, zone, id).(*mobileGatewayDetail); ok {
+		return v, nil
+	}
+	return &mobileGatewayDetail{
+		ID: id,
+		DNS: &sacloud.MobileGatewayDNSSetting{
+			DNS1: "133.242.0.3",
+			DNS2: "133.242.0.4",
+		},
+	}, nil
+}
@@ -0,0 +1,119 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Find is fake implementation
+func (o *PrivateHostOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.PrivateHost, error) {
+	results, _ := find(o.key, zone, conditions)
+	var values []*sacloud.PrivateHost
+	for _, res := range results {
+		dest := &sacloud.PrivateHost{}
+		copySameNameField(res, dest)
+		setPrivateHostAssignedResources(zone, dest)
+		values = append(values, dest)
+	}
+	return values, nil
+}
+
+// Create is fake implementation
+func (o *PrivateHostOp) Create(ctx context.Context, zone string, param *sacloud.PrivateHostCreateRequest) (*sacloud.PrivateHost, error) {
+	plan, err := NewPrivateHostPlanOp().Read(ctx, zone, param.PlanID)
+	if err != nil {
+		return nil, newErrorBadRequest(o.key, types.ID(0), "PrivateHostPlan is not found")
+	}
+	if !plan.Availability.IsAvailable() {
+		return nil, newErrorBadRequest(o.key, types.ID(0), fmt.Sprintf("PrivateHostPlan[%s] is not available", plan.ID))
+	}
+
+	result := &sacloud.PrivateHost{}
+	copySameNameField(param, result)
+	fill(result, fillID, fillCreatedAt)
+
+	result.PlanName = plan.Name
+	result.PlanClass = plan.Class
+	result.CPU = plan.CPU
+	result.MemoryMB = plan.MemoryMB
+	result.HostName = fmt.Sprintf("sac-%s-sv%03d", zone, random(1000))
+
+	s.setPrivateHost(zone, result)
+	return result, nil
+}
+
+// Read is fake implementation
+func (o *PrivateHostOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.PrivateHost, error) {
+	value := s.getPrivateHostByID(zone, id)
+	if value == nil {
+		return nil, newErrorNotFound(o.key, id)
+	}
+	dest := &sacloud.PrivateHost{}
+	copySameNameField(value, dest)
+	setPrivateHostAssignedResources(zone, dest)
+	return dest, nil
+}
+
+// Update is fake implementation
+func (o *PrivateHostOp) Update(ctx context.Context, zone string, id types.ID, param *sacloud.PrivateHostUpdateRequest) (*sacloud.PrivateHost, error) {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	copySameNameField(param, value)
+
+	s.setPrivateHost(zone, value)
+	return value, nil
+}
+
+// Delete is fake implementation
+func (o *PrivateHostOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+	if value.AssignedCPU > 0 {
+		return newErrorConflict(o.key, id, fmt.Sprintf("PrivateHost[%s] has assigned servers", id))
+	}
+	s.delete(o.key, zone, id)
+	return nil
+}
+
+// setPrivateHostAssignedResources 専有ホストに割り当てられたサーバのCPU/メモリの合計を設定する
+func setPrivateHostAssignedResources(zone string, host *sacloud.PrivateHost) {
+	host.AssignedCPU = 0
+	host.AssignedMemoryMB = 0
+	for _, server := range s.getServer(zone) {
+		if server.PrivateHostID == host.ID {
+			host.AssignedCPU += server.CPU
+			host.AssignedMemoryMB += server.MemoryMB
+		}
+	}
+}
+
+// assignToPrivateHost 専有ホストへサーバを割り当てられるか検証し、割り当て先の専有ホスト名を返す
+//
+// excludeServerIDを指定した場合はそのサーバの割り当て分を除いて検証する(プラン変更時など)
+func assignToPrivateHost(ctx context.Context, zone string, hostID types.ID, cpu, memoryMB int, excludeServerID types.ID) (string, error) {
+	op := NewPrivateHostOp()
+	host, err := op.Read(ctx, zone, hostID)
+	if err != nil {
+		return "", err
+	}
+
+	freeCPU := host.GetFreeCPU()
+	freeMemoryMB := host.GetFreeMemoryMB()
+	if !excludeServerID.IsEmpty() {
+		if server := s.getServerByID(zone, excludeServerID); server != nil && server.PrivateHostID == hostID {
+			freeCPU += server.CPU
+			freeMemoryMB += server.MemoryMB
+		}
+	}
+	if freeCPU < cpu || freeMemoryMB < memoryMB {
+		return "", fmt.Errorf("PrivateHost[%s] does not have enough capacity: free CPU=%d, free MemoryMB=%d", hostID, freeCPU, freeMemoryMB)
+	}
+	return host.Name, nil
+}
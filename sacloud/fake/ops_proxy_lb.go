@@ -0,0 +1,328 @@
+package fake
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Find is fake implementation
+func (o *ProxyLBOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.ProxyLB, error) {
+	results, _ := find(o.key, sacloud.DefaultZone, conditions)
+	var values []*sacloud.ProxyLB
+	for _, res := range results {
+		dest := &sacloud.ProxyLB{}
+		copySameNameField(res, dest)
+		values = append(values, dest)
+	}
+	return values, nil
+}
+
+// Create is fake implementation
+func (o *ProxyLBOp) Create(ctx context.Context, zone string, param *sacloud.ProxyLBCreateRequest) (*sacloud.ProxyLB, error) {
+	if err := validateProxyLBLetsEncrypt(param.LetsEncrypt); err != nil {
+		return nil, newErrorBadRequest(o.key, types.ID(0), err.Error())
+	}
+
+	result := &sacloud.ProxyLB{}
+	copySameNameField(param, result)
+	fill(result, fillID, fillCreatedAt, fillModifiedAt, fillAvailability)
+
+	if result.Region == "" {
+		result.Region = types.ProxyLBRegions.IS1
+	}
+	result.FQDN = fmt.Sprintf("site-%d.proxylb%d.sakura.ne.jp", result.ID, result.ID.Int64()%4+1)
+	if !result.UseVIPFailover {
+		result.VirtualIPAddress = fmt.Sprintf("192.0.2.%d", result.ID.Int64()%254+1)
+	}
+	result.ProxyNetworks = []string{"192.0.2.0/24"}
+	result.SettingsHash = "settingshash"
+
+	s.setProxyLB(sacloud.DefaultZone, result)
+	return result, nil
+}
+
+// Read is fake implementation
+func (o *ProxyLBOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.ProxyLB, error) {
+	value := s.getProxyLBByID(sacloud.DefaultZone, id)
+	if value == nil {
+		return nil, newErrorNotFound(o.key, id)
+	}
+	dest := &sacloud.ProxyLB{}
+	copySameNameField(value, dest)
+	return dest, nil
+}
+
+// Update is fake implementation
+func (o *ProxyLBOp) Update(ctx context.Context, zone string, id types.ID, param *sacloud.ProxyLBUpdateRequest) (*sacloud.ProxyLB, error) {
+	value, err := o.Read(ctx, sacloud.DefaultZone, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateProxyLBLetsEncrypt(param.LetsEncrypt); err != nil {
+		return nil, newErrorBadRequest(o.key, id, err.Error())
+	}
+
+	copySameNameField(param, value)
+	fill(value, fillModifiedAt)
+
+	s.setProxyLB(sacloud.DefaultZone, value)
+	return value, nil
+}
+
+// Delete is fake implementation
+func (o *ProxyLBOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	_, err := o.Read(ctx, sacloud.DefaultZone, id)
+	if err != nil {
+		return err
+	}
+	s.delete(proxyLBCertificatesKey, sacloud.DefaultZone, id)
+	s.delete(o.key, sacloud.DefaultZone, id)
+	return nil
+}
+
+// GetCertificates is fake implementation
+func (o *ProxyLBOp) GetCertificates(ctx context.Context, zone string, id types.ID) (*sacloud.ProxyLBCertificates, error) {
+	if _, err := o.Read(ctx, sacloud.DefaultZone, id); err != nil {
+		return nil, err
+	}
+
+	certs := &sacloud.ProxyLBCertificates{}
+	if v := s.getByID(proxyLBCertificatesKey, sacloud.DefaultZone, id); v != nil {
+		// 有効期限切れの証明書は返さない
+		now := Now()
+		stored := v.(*proxyLBCertificates)
+		if stored.PrimaryCert != nil && stored.PrimaryCert.CertificateEndDate.After(now) {
+			certs.PrimaryCert = &sacloud.ProxyLBPrimaryCert{}
+			copySameNameField(stored.PrimaryCert, certs.PrimaryCert)
+		}
+		for _, cert := range stored.AdditionalCerts {
+			if cert.CertificateEndDate.After(now) {
+				dest := &sacloud.ProxyLBAdditionalCert{}
+				copySameNameField(cert, dest)
+				certs.AdditionalCerts = append(certs.AdditionalCerts, dest)
+			}
+		}
+	}
+	return certs, nil
+}
+
+// SetCertificates is fake implementation
+func (o *ProxyLBOp) SetCertificates(ctx context.Context, zone string, id types.ID, param *sacloud.ProxyLBSetCertificatesRequest) (*sacloud.ProxyLBCertificates, error) {
+	if _, err := o.Read(ctx, sacloud.DefaultZone, id); err != nil {
+		return nil, err
+	}
+
+	certs := &proxyLBCertificates{ID: id}
+	if param.PrimaryCert != nil {
+		cert, err := newProxyLBCertificate(param.PrimaryCert.ServerCertificate, param.PrimaryCert.IntermediateCertificate, param.PrimaryCert.PrivateKey)
+		if err != nil {
+			return nil, newErrorBadRequest(o.key, id, err.Error())
+		}
+		certs.PrimaryCert = cert
+	}
+	for _, c := range param.AdditionalCerts {
+		cert, err := newProxyLBCertificate(c.ServerCertificate, c.IntermediateCertificate, c.PrivateKey)
+		if err != nil {
+			return nil, newErrorBadRequest(o.key, id, err.Error())
+		}
+		certs.AdditionalCerts = append(certs.AdditionalCerts, cert)
+	}
+	s.set(proxyLBCertificatesKey, sacloud.DefaultZone, certs)
+
+	return o.GetCertificates(ctx, zone, id)
+}
+
+// DeleteCertificates is fake implementation
+func (o *ProxyLBOp) DeleteCertificates(ctx context.Context, zone string, id types.ID) error {
+	if _, err := o.Read(ctx, sacloud.DefaultZone, id); err != nil {
+		return err
+	}
+	s.delete(proxyLBCertificatesKey, sacloud.DefaultZone, id)
+	return nil
+}
+
+// RenewLetsEncryptCert is fake implementation
+func (o *ProxyLBOp) RenewLetsEncryptCert(ctx context.Context, zone string, id types.ID) error {
+	value, err := o.Read(ctx, sacloud.DefaultZone, id)
+	if err != nil {
+		return err
+	}
+	if value.LetsEncrypt == nil || !value.LetsEncrypt.Enabled {
+		return newErrorBadRequest(o.key, id, "Let's Encrypt is not enabled")
+	}
+
+	// Let's Encryptの代わりに自己署名証明書を発行する
+	serverCert, privateKey, err := generateProxyLBCertificate(value.LetsEncrypt.CommonName, Now(), proxyLBLetsEncryptCertDuration)
+	if err != nil {
+		return newInternalServerError(o.key, id, err.Error())
+	}
+	cert, err := newProxyLBCertificate(serverCert, "", privateKey)
+	if err != nil {
+		return newInternalServerError(o.key, id, err.Error())
+	}
+
+	certs := &proxyLBCertificates{ID: id}
+	if v := s.getByID(proxyLBCertificatesKey, sacloud.DefaultZone, id); v != nil {
+		certs = v.(*proxyLBCertificates)
+	}
+	certs.PrimaryCert = cert
+	s.set(proxyLBCertificatesKey, sacloud.DefaultZone, certs)
+	return nil
+}
+
+// HealthStatus is fake implementation
+func (o *ProxyLBOp) HealthStatus(ctx context.Context, zone string, id types.ID) (*sacloud.ProxyLBHealth, error) {
+	value, err := o.Read(ctx, sacloud.DefaultZone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &sacloud.ProxyLBHealth{
+		CurrentVIP: value.VirtualIPAddress,
+	}
+	for _, server := range value.Servers {
+		status := &sacloud.ProxyLBHealthServer{
+			Status:    "DOWN",
+			IPAddress: server.IPAddress,
+			Port:      types.StringNumber(server.Port),
+		}
+		if server.Enabled {
+			status.Status = "UP"
+			status.ActiveConn = random(10)
+			status.CPS = float64(random(10))
+		}
+		res.ActiveConn += status.ActiveConn
+		res.CPS += status.CPS
+		res.Servers = append(res.Servers, status)
+	}
+	return res, nil
+}
+
+// MonitorConnection is fake implementation
+func (o *ProxyLBOp) MonitorConnection(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.ConnectionActivity, error) {
+	_, err := o.Read(ctx, sacloud.DefaultZone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Truncate(time.Second)
+	res := &sacloud.ConnectionActivity{}
+	for i := 0; i < 5; i++ {
+		res.Values = append(res.Values, &sacloud.MonitorConnectionValue{
+			Time:              now.Add(time.Duration(i*-5) * time.Minute),
+			ActiveConnections: float64(random(1000)),
+			ConnectionsPerSec: float64(random(1000)),
+		})
+	}
+	return res, nil
+}
+
+const proxyLBCertificatesKey = "ProxyLBCertificates"
+
+// proxyLBLetsEncryptCertDuration Let's Encryptで発行される証明書の有効期間
+const proxyLBLetsEncryptCertDuration = 90 * 24 * time.Hour
+
+// proxyLBCertificates エンハンスドロードバランサごとに保持するSSL証明書
+type proxyLBCertificates struct {
+	ID              types.ID
+	PrimaryCert     *proxyLBCertificate
+	AdditionalCerts []*proxyLBCertificate
+}
+
+func (c *proxyLBCertificates) GetID() types.ID {
+	return c.ID
+}
+
+func (c *proxyLBCertificates) SetID(id types.ID) {
+	c.ID = id
+}
+
+type proxyLBCertificate struct {
+	ServerCertificate       string
+	IntermediateCertificate string
+	PrivateKey              string
+	CertificateEndDate      time.Time
+	CertificateCommonName   string
+}
+
+// newProxyLBCertificate 証明書と秘密鍵の組み合わせを検証し、有効期限とCommonNameを取り出す
+func newProxyLBCertificate(serverCert, intermediateCert, privateKey string) (*proxyLBCertificate, error) {
+	pair, err := tls.X509KeyPair([]byte(serverCert), []byte(privateKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate/private key pair: %s", err)
+	}
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate: %s", err)
+	}
+	if intermediateCert != "" {
+		block, _ := pem.Decode([]byte(intermediateCert))
+		if block == nil || block.Type != "CERTIFICATE" {
+			return nil, errors.New("invalid intermediate certificate: PEM encoded certificate is required")
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return nil, fmt.Errorf("invalid intermediate certificate: %s", err)
+		}
+	}
+
+	return &proxyLBCertificate{
+		ServerCertificate:       serverCert,
+		IntermediateCertificate: intermediateCert,
+		PrivateKey:              privateKey,
+		CertificateEndDate:      cert.NotAfter,
+		CertificateCommonName:   cert.Subject.CommonName,
+	}, nil
+}
+
+// generateProxyLBCertificate 指定のCommonNameを持つ自己署名証明書と秘密鍵をPEM形式で生成する
+func generateProxyLBCertificate(commonName string, notBefore time.Time, duration time.Duration) (string, string, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(notBefore.UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		NotBefore:    notBefore,
+		NotAfter:     notBefore.Add(duration),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return "", "", err
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return "", "", err
+	}
+
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(cert), string(privateKey), nil
+}
+
+func validateProxyLBLetsEncrypt(letsEncrypt *sacloud.ProxyLBLetsEncrypt) error {
+	if letsEncrypt == nil || !letsEncrypt.Enabled {
+		return nil
+	}
+	if letsEncrypt.CommonName == "" || !hostNamePattern.MatchString(letsEncrypt.CommonName) {
+		return fmt.Errorf("invalid common name for Let's Encrypt: %q", letsEncrypt.CommonName)
+	}
+	return nil
+}
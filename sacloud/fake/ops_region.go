@@ -0,0 +1,31 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Find is fake implementation
+func (o *RegionOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Region, error) {
+	results, _ := find(o.key, sacloud.DefaultZone, conditions)
+	var values []*sacloud.Region
+	for _, res := range results {
+		dest := &sacloud.Region{}
+		copySameNameField(res, dest)
+		values = append(values, dest)
+	}
+	return values, nil
+}
+
+// Read is fake implementation
+func (o *RegionOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Region, error) {
+	value := s.getRegionByID(sacloud.DefaultZone, id)
+	if value == nil {
+		return nil, newErrorNotFound(o.key, id)
+	}
+	dest := &sacloud.Region{}
+	copySameNameField(value, dest)
+	return dest, nil
+}
@@ -1,8 +1,15 @@
 package fake
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
 	"time"
 
 	"github.com/sacloud/libsacloud-v2/sacloud"
@@ -23,22 +30,33 @@ func (o *ServerOp) Find(ctx context.Context, zone string, conditions *sacloud.Fi
 
 // Create is fake implementation
 func (o *ServerOp) Create(ctx context.Context, zone string, param *sacloud.ServerCreateRequest) (*sacloud.Server, error) {
+	plan, err := sacloud.FindServerPlan(ctx, NewServerPlanOp(), zone, &sacloud.FindServerPlanRequest{
+		CPU:        param.CPU,
+		MemoryGB:   param.GetMemoryGB(),
+		Generation: param.ServerPlanGeneration,
+		Commitment: param.ServerPlanCommitment,
+	})
+	if err != nil {
+		if errors.Is(err, sacloud.ErrServerPlanNotFound) {
+			return nil, newErrorBadRequest(o.key, types.ID(0), err.Error())
+		}
+		return nil, err
+	}
+
 	result := &sacloud.Server{}
 	copySameNameField(param, result)
 	fill(result, fillID, fillCreatedAt)
+	setServerPlan(result, plan)
 
-	result.Availability = types.Availabilities.Migrating
-	if param.ServerPlanGeneration == types.PlanGenerations.Default {
-		switch zone {
-		case "is1a":
-			result.ServerPlanGeneration = types.PlanGenerations.G200
-		default:
-			result.ServerPlanGeneration = types.PlanGenerations.G100
+	if !param.PrivateHostID.IsEmpty() {
+		hostName, err := assignToPrivateHost(ctx, zone, param.PrivateHostID, result.CPU, result.MemoryMB, types.ID(0))
+		if err != nil {
+			return nil, newErrorConflict(o.key, types.ID(0), err.Error())
 		}
+		result.PrivateHostName = hostName
 	}
-	// TODO プランAPIを実装したら修正する
-	result.ServerPlanID = types.StringID(fmt.Sprintf("%03d%03d%03d", result.ServerPlanGeneration, result.GetMemoryGB(), result.CPU))
-	result.ServerPlanName = fmt.Sprintf("世代:%03d メモリ:%03d CPU:%03d", result.ServerPlanGeneration, result.GetMemoryGB(), result.CPU)
+
+	result.Availability = types.Availabilities.Migrating
 
 	for _, cs := range param.ConnectedSwitches {
 		ifOp := NewInterfaceOp()
@@ -129,6 +147,7 @@ func (o *ServerOp) Delete(ctx context.Context, zone string, id types.ID) error {
 	}
 
 	s.delete(o.key, zone, id)
+	s.delete(serverSentKeysKey, zone, id)
 	return nil
 }
 
@@ -142,9 +161,25 @@ func (o *ServerOp) ChangePlan(ctx context.Context, zone string, id types.ID, pla
 		return nil, newErrorConflict(o.key, id, fmt.Sprintf("Server[%d] is running", value.ID))
 	}
 
-	copySameNameField(plan, value)
-	value.ServerPlanID = types.StringID(fmt.Sprintf("%03d%03d%03d", value.ServerPlanGeneration, value.GetMemoryGB(), value.CPU))
-	value.ServerPlanName = fmt.Sprintf("世代:%03d メモリ:%03d CPU:%03d", value.ServerPlanGeneration, value.GetMemoryGB(), value.CPU)
+	serverPlan, err := sacloud.FindServerPlan(ctx, NewServerPlanOp(), zone, &sacloud.FindServerPlanRequest{
+		CPU:        plan.CPU,
+		MemoryGB:   plan.GetMemoryGB(),
+		Generation: plan.ServerPlanGeneration,
+		Commitment: plan.ServerPlanCommitment,
+	})
+	if err != nil {
+		if errors.Is(err, sacloud.ErrServerPlanNotFound) {
+			return nil, newErrorBadRequest(o.key, id, err.Error())
+		}
+		return nil, err
+	}
+
+	if !value.PrivateHostID.IsEmpty() {
+		if _, err := assignToPrivateHost(ctx, zone, value.PrivateHostID, serverPlan.CPU, serverPlan.MemoryMB, value.ID); err != nil {
+			return nil, newErrorConflict(o.key, id, err.Error())
+		}
+	}
+	setServerPlan(value, serverPlan)
 
 	// ID変更
 	s.delete(o.key, zone, value.ID)
@@ -153,6 +188,12 @@ func (o *ServerOp) ChangePlan(ctx context.Context, zone string, id types.ID, pla
 	newServer.ID = pool.generateID()
 	s.setServer(zone, newServer)
 
+	// 送信済みキーは新しいIDへ引き継ぐ
+	if sentKeys, ok := s.getByID(serverSentKeysKey, zone, value.ID).(*serverSentKeys); ok {
+		s.delete(serverSentKeysKey, zone, value.ID)
+		s.set(serverSentKeysKey, zone, &serverSentKeys{ID: newServer.ID, Keys: sentKeys.Keys})
+	}
+
 	return newServer, nil
 }
 
@@ -264,3 +305,136 @@ func (o *ServerOp) Monitor(ctx context.Context, zone string, id types.ID, condit
 
 	return res, nil
 }
+
+// GetVNCProxy is fake implementation
+func (o *ServerOp) GetVNCProxy(ctx context.Context, zone string, id types.ID) (*sacloud.VNCProxyInfo, error) {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	if !value.InstanceStatus.IsUp() {
+		return nil, newErrorConflict(o.key, id, "GetVNCProxy is failed")
+	}
+
+	host := fmt.Sprintf("sac-%s-ssl.sakura.ad.jp", zone)
+	port := 51000 + random(1000)
+	password := fmt.Sprintf("%08d", random(100000000))
+
+	return &sacloud.VNCProxyInfo{
+		Status:       "OK",
+		Host:         host,
+		IOServerHost: host,
+		Port:         types.StringNumber(port),
+		Password:     password,
+		VNCFile: strings.Join([]string{
+			"[connection]",
+			fmt.Sprintf("host=%s", host),
+			fmt.Sprintf("port=%d", port),
+			fmt.Sprintf("password=%s", password),
+		}, "\n"),
+	}, nil
+}
+
+// SendKey is fake implementation
+func (o *ServerOp) SendKey(ctx context.Context, zone string, id types.ID, keyboardParam *sacloud.SendKeyRequest) error {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+	if !value.InstanceStatus.IsUp() {
+		return newErrorConflict(o.key, id, "SendKey is failed")
+	}
+	if (keyboardParam.Key == "") == (len(keyboardParam.Keys) == 0) {
+		return newErrorBadRequest(o.key, id, "either Key or Keys is required")
+	}
+
+	sentKeys := &serverSentKeys{ID: id}
+	if v, ok := s.getByID(serverSentKeysKey, zone, id).(*serverSentKeys); ok {
+		sentKeys.Keys = v.Keys
+	}
+	sent := &sacloud.SendKeyRequest{}
+	copySameNameField(keyboardParam, sent)
+	sentKeys.Keys = append(sentKeys.Keys, sent)
+	s.set(serverSentKeysKey, zone, sentKeys)
+
+	return nil
+}
+
+// SendNMI is fake implementation
+func (o *ServerOp) SendNMI(ctx context.Context, zone string, id types.ID) error {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return err
+	}
+	if !value.InstanceStatus.IsUp() {
+		return newErrorConflict(o.key, id, "SendNMI is failed")
+	}
+	return nil
+}
+
+// GetScreenshot is fake implementation
+func (o *ServerOp) GetScreenshot(ctx context.Context, zone string, id types.ID, condition *sacloud.GetScreenshotRequest) (*sacloud.VNCSnapshotInfo, error) {
+	value, err := o.Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+	if !value.InstanceStatus.IsUp() {
+		return nil, newErrorConflict(o.key, id, "GetScreenshot is failed")
+	}
+	if condition.ScreenCaptureFormat != "" && condition.ScreenCaptureFormat != "png" {
+		return nil, newErrorBadRequest(o.key, id, fmt.Sprintf("ScreenCaptureFormat %q is not supported", condition.ScreenCaptureFormat))
+	}
+
+	// コンソール画面の代わりにサーバIDから決まる色で塗りつぶした画像を返す
+	img := image.NewRGBA(image.Rect(0, 0, 640, 480))
+	fillColor := color.RGBA{R: uint8(id), G: uint8(id >> 8), B: uint8(id >> 16), A: 0xff}
+	for y := img.Rect.Min.Y; y < img.Rect.Max.Y; y++ {
+		for x := img.Rect.Min.X; x < img.Rect.Max.X; x++ {
+			img.Set(x, y, fillColor)
+		}
+	}
+	buf := bytes.NewBuffer(nil)
+	if err := png.Encode(buf, img); err != nil {
+		return nil, newInternalServerError(o.key, id, err.Error())
+	}
+
+	return &sacloud.VNCSnapshotInfo{
+		Image: base64.StdEncoding.EncodeToString(buf.Bytes()),
+	}, nil
+}
+
+// SentKeys SendKeyで送信されたキーの一覧を送信順で返す
+func SentKeys(zone string, id types.ID) []*sacloud.SendKeyRequest {
+	if v, ok := s.getByID(serverSentKeysKey, zone, id).(*serverSentKeys); ok {
+		return v.Keys
+	}
+	return nil
+}
+
+// serverSentKeysKey サーバへ送信されたキーを保持する際のキー
+const serverSentKeysKey = "ServerSentKeys"
+
+// serverSentKeys サーバへ送信されたキー
+type serverSentKeys struct {
+	ID   types.ID
+	Keys []*sacloud.SendKeyRequest
+}
+
+// GetID returns value of ID
+func (k *serverSentKeys) GetID() types.ID {
+	return k.ID
+}
+
+// SetID sets value to ID
+func (k *serverSentKeys) SetID(id types.ID) {
+	k.ID = id
+}
+
+func setServerPlan(server *sacloud.Server, plan *sacloud.ServerPlan) {
+	server.ServerPlanID = plan.ID
+	server.ServerPlanName = plan.Name
+	server.CPU = plan.CPU
+	server.MemoryMB = plan.MemoryMB
+	server.ServerPlanGeneration = plan.Generation
+	server.ServerPlanCommitment = plan.Commitment
+}
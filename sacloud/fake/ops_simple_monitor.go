@@ -0,0 +1,253 @@
+package fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Find is fake implementation
+func (o *SimpleMonitorOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.SimpleMonitor, error) {
+	results, _ := find(o.key, sacloud.DefaultZone, conditions)
+	var values []*sacloud.SimpleMonitor
+	for _, res := range results {
+		dest := &sacloud.SimpleMonitor{}
+		copySameNameField(res, dest)
+		values = append(values, dest)
+	}
+	return values, nil
+}
+
+// Create is fake implementation
+func (o *SimpleMonitorOp) Create(ctx context.Context, zone string, param *sacloud.SimpleMonitorCreateRequest) (*sacloud.SimpleMonitor, error) {
+	if err := validateSimpleMonitorHealthCheck(param.HealthCheck); err != nil {
+		return nil, newErrorBadRequest(o.key, types.ID(0), err.Error())
+	}
+
+	result := &sacloud.SimpleMonitor{}
+	copySameNameField(param, result)
+	fill(result, fillID, fillCreatedAt, fillModifiedAt, fillAvailability)
+
+	result.Name = param.Target
+	result.SettingsHash = "settingshash"
+	setSimpleMonitorDefaults(result)
+
+	s.setSimpleMonitor(sacloud.DefaultZone, result)
+	return result, nil
+}
+
+// Read is fake implementation
+func (o *SimpleMonitorOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.SimpleMonitor, error) {
+	value := s.getSimpleMonitorByID(sacloud.DefaultZone, id)
+	if value == nil {
+		return nil, newErrorNotFound(o.key, id)
+	}
+	dest := &sacloud.SimpleMonitor{}
+	copySameNameField(value, dest)
+	return dest, nil
+}
+
+// Update is fake implementation
+func (o *SimpleMonitorOp) Update(ctx context.Context, zone string, id types.ID, param *sacloud.SimpleMonitorUpdateRequest) (*sacloud.SimpleMonitor, error) {
+	value, err := o.Read(ctx, sacloud.DefaultZone, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateSimpleMonitorHealthCheck(param.HealthCheck); err != nil {
+		return nil, newErrorBadRequest(o.key, id, err.Error())
+	}
+
+	copySameNameField(param, value)
+	fill(value, fillModifiedAt)
+	setSimpleMonitorDefaults(value)
+
+	s.setSimpleMonitor(sacloud.DefaultZone, value)
+	return value, nil
+}
+
+// Delete is fake implementation
+func (o *SimpleMonitorOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	_, err := o.Read(ctx, sacloud.DefaultZone, id)
+	if err != nil {
+		return err
+	}
+	s.delete(o.key, sacloud.DefaultZone, id)
+	s.delete(simpleMonitorHealthHistoryKey, sacloud.DefaultZone, id)
+	return nil
+}
+
+// MonitorResponseTime is fake implementation
+func (o *SimpleMonitorOp) MonitorResponseTime(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.ResponseTimeSecActivity, error) {
+	value, err := o.Read(ctx, sacloud.DefaultZone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &sacloud.ResponseTimeSecActivity{}
+	if !value.Enabled.Bool() {
+		return res, nil
+	}
+
+	now := time.Now().Truncate(time.Second)
+	for i := 0; i < 5; i++ {
+		res.Values = append(res.Values, &sacloud.MonitorResponseTimeSecValue{
+			Time:            now.Add(time.Duration(i*-5) * time.Minute),
+			ResponseTimeSec: float64(random(1000)) / 1000,
+		})
+	}
+	return res, nil
+}
+
+// HealthStatus is fake implementation
+//
+// 呼び出しごとに監視を1回行ったものとして監視結果を履歴へ追加し、最新の監視結果を返す
+func (o *SimpleMonitorOp) HealthStatus(ctx context.Context, zone string, id types.ID) (*sacloud.SimpleMonitorHealthStatus, error) {
+	value, err := o.Read(ctx, sacloud.DefaultZone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	history := getSimpleMonitorHealthHistory(id)
+	if value.Enabled.Bool() || len(history.Values) == 0 {
+		history.check(value, time.Now())
+		s.set(simpleMonitorHealthHistoryKey, sacloud.DefaultZone, history)
+	}
+	result := &sacloud.SimpleMonitorHealthStatus{}
+	copySameNameField(history.Values[len(history.Values)-1], result)
+	return result, nil
+}
+
+// SimpleMonitorHealthHistory HealthStatusで行われた監視結果の履歴を古い順で返す
+func SimpleMonitorHealthHistory(id types.ID) []*sacloud.SimpleMonitorHealthStatus {
+	return getSimpleMonitorHealthHistory(id).Values
+}
+
+// SetSimpleMonitorHealth 以降の監視結果を指定のステータスに固定する、空文字を指定した場合は監視対象から判定する
+func SetSimpleMonitorHealth(id types.ID, health types.ESimpleMonitorHealth) {
+	history := getSimpleMonitorHealthHistory(id)
+	history.Health = health
+	s.set(simpleMonitorHealthHistoryKey, sacloud.DefaultZone, history)
+}
+
+// simpleMonitorHealthHistoryKey シンプル監視の監視結果を保持する際のキー
+const simpleMonitorHealthHistoryKey = "SimpleMonitorHealthHistory"
+
+// simpleMonitorHealthHistory シンプル監視の監視結果の履歴
+type simpleMonitorHealthHistory struct {
+	ID     types.ID
+	Health types.ESimpleMonitorHealth // テスト用に固定された監視結果
+	Values []*sacloud.SimpleMonitorHealthStatus
+}
+
+// GetID returns value of ID
+func (h *simpleMonitorHealthHistory) GetID() types.ID {
+	return h.ID
+}
+
+// SetID sets value to ID
+func (h *simpleMonitorHealthHistory) SetID(id types.ID) {
+	h.ID = id
+}
+
+func getSimpleMonitorHealthHistory(id types.ID) *simpleMonitorHealthHistory {
+	history := &simpleMonitorHealthHistory{ID: id}
+	if v, ok := s.getByID(simpleMonitorHealthHistoryKey, sacloud.DefaultZone, id).(*simpleMonitorHealthHistory); ok {
+		history.Health = v.Health
+		history.Values = append(history.Values, v.Values...)
+	}
+	return history
+}
+
+// check 監視を行い結果を履歴へ追加する
+func (h *simpleMonitorHealthHistory) check(value *sacloud.SimpleMonitor, now time.Time) {
+	health := h.Health
+	if health == "" {
+		health = types.SimpleMonitorHealth.Up
+		if isUnreachableSimpleMonitorTarget(value.Target) {
+			health = types.SimpleMonitorHealth.Down
+		}
+	}
+
+	changedAt := now
+	if len(h.Values) > 0 {
+		last := h.Values[len(h.Values)-1]
+		if last.Health == health {
+			changedAt = last.LastHealthChangedAt
+		}
+	}
+	h.Values = append(h.Values, &sacloud.SimpleMonitorHealthStatus{
+		LastCheckedAt:       now,
+		LastHealthChangedAt: changedAt,
+		Health:              health,
+	})
+}
+
+// isUnreachableSimpleMonitorTarget 監視対象が到達不能なアドレス(ドキュメント用のIPアドレスや予約済みのTLD)であるか
+func isUnreachableSimpleMonitorTarget(target string) bool {
+	if ip := net.ParseIP(target); ip != nil {
+		for _, cidr := range []string{"192.0.2.0/24", "198.51.100.0/24", "203.0.113.0/24", "2001:db8::/32"} {
+			_, ipNet, _ := net.ParseCIDR(cidr)
+			if ipNet.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+	host := strings.ToLower(strings.TrimSuffix(target, "."))
+	return strings.HasSuffix(host, ".invalid") || strings.HasSuffix(host, ".test")
+}
+
+const defaultSimpleMonitorDelayLoop = 60
+
+func setSimpleMonitorDefaults(value *sacloud.SimpleMonitor) {
+	if value.DelayLoop == 0 {
+		value.DelayLoop = defaultSimpleMonitorDelayLoop
+	}
+	if value.NotifyInterval == 0 {
+		value.NotifyInterval = 2 * 60 * 60
+	}
+}
+
+// validateSimpleMonitorHealthCheck プロトコルごとの必須項目を検証する
+func validateSimpleMonitorHealthCheck(hc *sacloud.SimpleMonitorHealthCheck) error {
+	if hc == nil {
+		return errors.New("health check is required")
+	}
+	switch hc.Protocol {
+	case types.SimpleMonitorProtocols.HTTP, types.SimpleMonitorProtocols.HTTPS:
+		if hc.Path == "" {
+			return errors.New("path is required with http/https protocol")
+		}
+	case types.SimpleMonitorProtocols.TCP:
+		if hc.Port.Int() == 0 {
+			return errors.New("port is required with tcp protocol")
+		}
+	case types.SimpleMonitorProtocols.DNS:
+		if hc.QName == "" {
+			return errors.New("qname is required with dns protocol")
+		}
+	case types.SimpleMonitorProtocols.SNMP:
+		if hc.Community == "" || hc.OID == "" {
+			return errors.New("community and oid are required with snmp protocol")
+		}
+		if hc.SNMPVersion != "1" && hc.SNMPVersion != "2c" {
+			return errors.New("snmp version must be 1 or 2c")
+		}
+	case types.SimpleMonitorProtocols.SSLCertificate:
+		if hc.RemainingDays < 1 || hc.RemainingDays > 9999 {
+			return errors.New("remaining days must be between 1 and 9999")
+		}
+	case types.SimpleMonitorProtocols.Ping, types.SimpleMonitorProtocols.SSH,
+		types.SimpleMonitorProtocols.SMTP, types.SimpleMonitorProtocols.POP3:
+		// 追加の必須項目なし
+	default:
+		return fmt.Errorf("unknown protocol: %q", hc.Protocol)
+	}
+	return nil
+}
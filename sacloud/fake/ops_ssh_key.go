@@ -0,0 +1,192 @@
+package fake
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" // nolint
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Find is fake implementation
+func (o *SSHKeyOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.SSHKey, error) {
+	results, _ := find(o.key, sacloud.DefaultZone, conditions)
+	var values []*sacloud.SSHKey
+	for _, res := range results {
+		dest := &sacloud.SSHKey{}
+		copySameNameField(res, dest)
+		values = append(values, dest)
+	}
+	return values, nil
+}
+
+// Create is fake implementation
+func (o *SSHKeyOp) Create(ctx context.Context, zone string, param *sacloud.SSHKeyCreateRequest) (*sacloud.SSHKey, error) {
+	fingerprint, err := sshKeyFingerprint(param.PublicKey)
+	if err != nil {
+		return nil, newErrorBadRequest(o.key, types.ID(0), err.Error())
+	}
+
+	result := &sacloud.SSHKey{}
+	copySameNameField(param, result)
+	fill(result, fillID, fillCreatedAt)
+	result.Fingerprint = fingerprint
+
+	s.setSSHKey(sacloud.DefaultZone, result)
+	return result, nil
+}
+
+// Generate is fake implementation
+func (o *SSHKeyOp) Generate(ctx context.Context, zone string, param *sacloud.SSHKeyGenerateRequest) (*sacloud.SSHKeyGenerated, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, newErrorConflict(o.key, types.ID(0), err.Error())
+	}
+
+	block := &pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+	}
+	if param.PassPhrase != "" {
+		block, err = x509.EncryptPEMBlock(rand.Reader, block.Type, block.Bytes, []byte(param.PassPhrase), x509.PEMCipherAES256) // nolint
+		if err != nil {
+			return nil, newErrorConflict(o.key, types.ID(0), err.Error())
+		}
+	}
+
+	publicKey := "ssh-rsa " + base64.StdEncoding.EncodeToString(marshalRSAPublicKey(&privateKey.PublicKey))
+	fingerprint, err := sshKeyFingerprint(publicKey)
+	if err != nil {
+		return nil, newErrorConflict(o.key, types.ID(0), err.Error())
+	}
+
+	key := &sacloud.SSHKey{
+		Name:        param.Name,
+		Description: param.Description,
+		PublicKey:   publicKey,
+		Fingerprint: fingerprint,
+	}
+	fill(key, fillID, fillCreatedAt)
+	s.setSSHKey(sacloud.DefaultZone, key)
+
+	result := &sacloud.SSHKeyGenerated{}
+	copySameNameField(key, result)
+	result.PrivateKey = string(pem.EncodeToMemory(block))
+	return result, nil
+}
+
+// Read is fake implementation
+func (o *SSHKeyOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.SSHKey, error) {
+	value := s.getSSHKeyByID(sacloud.DefaultZone, id)
+	if value == nil {
+		return nil, newErrorNotFound(o.key, id)
+	}
+
+	dest := &sacloud.SSHKey{}
+	copySameNameField(value, dest)
+	return dest, nil
+}
+
+// Update is fake implementation
+func (o *SSHKeyOp) Update(ctx context.Context, zone string, id types.ID, param *sacloud.SSHKeyUpdateRequest) (*sacloud.SSHKey, error) {
+	value, err := o.Read(ctx, sacloud.DefaultZone, id)
+	if err != nil {
+		return nil, err
+	}
+	copySameNameField(param, value)
+
+	s.setSSHKey(sacloud.DefaultZone, value)
+	return value, nil
+}
+
+// Delete is fake implementation
+func (o *SSHKeyOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	_, err := o.Read(ctx, sacloud.DefaultZone, id)
+	if err != nil {
+		return err
+	}
+	s.delete(o.key, sacloud.DefaultZone, id)
+	return nil
+}
+
+var sshPublicKeyTypes = []string{
+	"ssh-rsa",
+	"ssh-dss",
+	"ssh-ed25519",
+	"ecdsa-sha2-nistp256",
+	"ecdsa-sha2-nistp384",
+	"ecdsa-sha2-nistp521",
+}
+
+// sshKeyFingerprint authorized_keys形式の公開鍵を検証し、MD5フィンガープリントを返す
+func sshKeyFingerprint(publicKey string) (string, error) {
+	fields := strings.Fields(publicKey)
+	if len(fields) < 2 {
+		return "", errors.New("invalid public key: format must be '<type> <base64-encoded key> [comment]'")
+	}
+
+	keyType := fields[0]
+	var supported bool
+	for _, t := range sshPublicKeyTypes {
+		if t == keyType {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return "", fmt.Errorf("invalid public key: unsupported key type %q", keyType)
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return "", errors.New("invalid public key: key must be base64 encoded")
+	}
+
+	// 鍵本体の先頭には長さ付きで鍵種別が格納されている
+	if len(blob) < 4 {
+		return "", errors.New("invalid public key: key is too short")
+	}
+	l := binary.BigEndian.Uint32(blob[:4])
+	if uint32(len(blob)-4) < l || string(blob[4:4+l]) != keyType {
+		return "", errors.New("invalid public key: key type mismatch")
+	}
+
+	sum := md5.Sum(blob) // nolint
+	hexes := make([]string, len(sum))
+	for i, b := range sum {
+		hexes[i] = fmt.Sprintf("%02x", b)
+	}
+	return strings.Join(hexes, ":"), nil
+}
+
+// marshalRSAPublicKey RSA公開鍵をSSHのワイヤーフォーマット(RFC4253)で出力する
+func marshalRSAPublicKey(key *rsa.PublicKey) []byte {
+	buf := &bytes.Buffer{}
+	writeString := func(b []byte) {
+		binary.Write(buf, binary.BigEndian, uint32(len(b))) // nolint
+		buf.Write(b)
+	}
+	writeMPInt := func(n *big.Int) {
+		b := n.Bytes()
+		if len(b) > 0 && b[0]&0x80 != 0 {
+			b = append([]byte{0}, b...)
+		}
+		writeString(b)
+	}
+
+	writeString([]byte("ssh-rsa"))
+	writeMPInt(big.NewInt(int64(key.E)))
+	writeMPInt(key.N)
+	return buf.Bytes()
+}
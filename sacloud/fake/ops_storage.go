@@ -0,0 +1,31 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Find is fake implementation
+func (o *StorageOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Storage, error) {
+	results, _ := find(o.key, zone, conditions)
+	var values []*sacloud.Storage
+	for _, res := range results {
+		dest := &sacloud.Storage{}
+		copySameNameField(res, dest)
+		values = append(values, dest)
+	}
+	return values, nil
+}
+
+// Read is fake implementation
+func (o *StorageOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Storage, error) {
+	value := s.getStorageByID(zone, id)
+	if value == nil {
+		return nil, newErrorNotFound(o.key, id)
+	}
+	dest := &sacloud.Storage{}
+	copySameNameField(value, dest)
+	return dest, nil
+}
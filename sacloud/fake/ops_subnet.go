@@ -0,0 +1,49 @@
+package fake
+
+import (
+	"context"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Find is fake implementation
+func (o *SubnetOp) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Subnet, error) {
+	results, _ := find(o.key, zone, conditions)
+	var values []*sacloud.Subnet
+	for _, res := range results {
+		dest := &sacloud.Subnet{}
+		copySameNameField(res, dest)
+		values = append(values, dest)
+	}
+	return values, nil
+}
+
+// Read is fake implementation
+func (o *SubnetOp) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Subnet, error) {
+	value := s.getSubnetByID(zone, id)
+	if value == nil {
+		return nil, newErrorNotFound(o.key, id)
+	}
+	dest := &sacloud.Subnet{}
+	copySameNameField(value, dest)
+	return dest, nil
+}
+
+// setSubnetFromSwitchSubnet スイッチ+ルータに割り当てられたサブネットをSubnetとして登録する
+func setSubnetFromSwitchSubnet(zone string, switchID, internetID types.ID, subnet *sacloud.SwitchSubnet) {
+	value := &sacloud.Subnet{
+		ID:             subnet.ID,
+		SwitchID:       switchID,
+		InternetID:     internetID,
+		DefaultRoute:   subnet.DefaultRoute,
+		NextHop:        subnet.NextHop,
+		StaticRoute:    subnet.StaticRoute,
+		NetworkAddress: subnet.NetworkAddress,
+		NetworkMaskLen: subnet.NetworkMaskLen,
+	}
+	for _, ip := range subnet.GetAssignedIPAddresses() {
+		value.IPAddresses = append(value.IPAddresses, &sacloud.SubnetIPAddress{IPAddress: ip})
+	}
+	s.setSubnet(zone, value)
+}
@@ -16,6 +16,7 @@ type valuePool struct {
 	currentMACAddress    net.HardwareAddr
 	mu                   sync.Mutex
 	currentSubnets       map[int]*net.IPNet
+	currentIPv6Net       net.IP
 }
 
 var pool = &valuePool{
@@ -46,6 +47,7 @@ var pool = &valuePool{
 			Mask: net.IPMask{255, 255, 255, 240},
 		},
 	},
+	currentIPv6Net: net.ParseIP("2001:db8::"),
 }
 
 func (p *valuePool) generateID() types.ID {
@@ -123,7 +125,7 @@ func (p *valuePool) nextSubnetFull(maskLen int, defaultRoute string) *assignedSu
 
 	count := cidr.AddressCount(next)
 	current := next.IP
-	var networkAddr string
+	networkAddr := next.IP.String()
 
 	var addresses []string
 	for i := uint64(0); i < count; i++ {
@@ -139,6 +141,28 @@ func (p *valuePool) nextSubnetFull(maskLen int, defaultRoute string) *assignedSu
 	}
 }
 
+// nextIPv6Net /64のIPv6アドレス帯を払い出す
+func (p *valuePool) nextIPv6Net() *net.IPNet {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ip := make(net.IP, net.IPv6len)
+	copy(ip, p.currentIPv6Net.To16())
+	// プレフィックス部(上位64bit)をインクリメントする
+	for i := 7; i >= 0; i-- {
+		ip[i]++
+		if ip[i] != 0 {
+			break
+		}
+	}
+	p.currentIPv6Net = ip
+
+	return &net.IPNet{
+		IP:   ip,
+		Mask: net.CIDRMask(64, 128),
+	}
+}
+
 type assignedSubnet struct {
 	defaultRoute   string
 	networkMaskLen int
@@ -23,3 +23,19 @@ func TestNextSubnet(t *testing.T) {
 	require.Equal(t, "24.0.2.254", next.addresses[len(next.addresses)-1])
 
 }
+
+func TestNextSubnetFull(t *testing.T) {
+	subnet := pool.nextSubnetFull(28, "192.0.2.10")
+	require.NotEmpty(t, subnet.networkAddress)
+	require.Equal(t, "192.0.2.10", subnet.defaultRoute)
+	require.Len(t, subnet.addresses, 16)
+	require.Equal(t, subnet.networkAddress, subnet.addresses[0])
+}
+
+func TestNextIPv6Net(t *testing.T) {
+	first := pool.nextIPv6Net()
+	next := pool.nextIPv6Net()
+
+	require.Equal(t, "2001:db8:0:1::/64", first.String())
+	require.Equal(t, "2001:db8:0:2::/64", next.String())
+}
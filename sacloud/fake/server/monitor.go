@@ -0,0 +1,76 @@
+package server
+
+import (
+	"time"
+
+	"github.com/sacloud/libsacloud-v2/pkg/mapconv"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+// newMonitorValuesPayload アクティビティモニタの値をAPIと同じく対象時刻(RFC3339)をキーとした形式へ変換する
+func newMonitorValuesPayload(source interface{}) (map[string]map[string]float64, error) {
+	values := &struct {
+		CPU             []*naked.MonitorCPUTimeValue
+		Disk            []*naked.MonitorDiskValue
+		Interface       []*naked.MonitorInterfaceValue
+		Router          []*naked.MonitorRouterValue
+		Database        []*naked.MonitorDatabaseValue
+		FreeDiskSize    []*naked.MonitorFreeDiskSizeValue
+		ResponseTimeSec []*naked.MonitorResponseTimeSecValue
+		Link            []*naked.MonitorLinkValue
+		Connection      []*naked.MonitorConnectionValue
+	}{}
+	if err := mapconv.ConvertTo(source, values); err != nil {
+		return nil, err
+	}
+
+	payload := map[string]map[string]float64{}
+	set := func(t time.Time, key string, value float64) {
+		k := t.Format(time.RFC3339)
+		if _, ok := payload[k]; !ok {
+			payload[k] = map[string]float64{}
+		}
+		payload[k][key] = value
+	}
+
+	for _, v := range values.CPU {
+		set(v.Time, "CPU-TIME", v.CPUTime)
+	}
+	for _, v := range values.Disk {
+		set(v.Time, "Read", v.Read)
+		set(v.Time, "Write", v.Write)
+	}
+	for _, v := range values.Interface {
+		set(v.Time, "Send", v.Send)
+		set(v.Time, "Receive", v.Receive)
+	}
+	for _, v := range values.Router {
+		set(v.Time, "In", v.In)
+		set(v.Time, "Out", v.Out)
+	}
+	for _, v := range values.Database {
+		set(v.Time, "Total-Memory-Size", v.TotalMemorySize)
+		set(v.Time, "Used-Memory-Size", v.UsedMemorySize)
+		set(v.Time, "Total-Disk1-Size", v.TotalDisk1Size)
+		set(v.Time, "Used-Disk1-Size", v.UsedDisk1Size)
+		set(v.Time, "Total-Disk2-Size", v.TotalDisk2Size)
+		set(v.Time, "Used-Disk2-Size", v.UsedDisk2Size)
+		set(v.Time, "binlogUsedSizeKiB", v.BinlogUsedSizeKiB)
+		set(v.Time, "delayTimeSec", v.DelayTimeSec)
+	}
+	for _, v := range values.FreeDiskSize {
+		set(v.Time, "Free-Disk-Size", v.FreeDiskSize)
+	}
+	for _, v := range values.ResponseTimeSec {
+		set(v.Time, "responsetimesec", v.ResponseTimeSec)
+	}
+	for _, v := range values.Link {
+		set(v.Time, "UplinkBps", v.UplinkBPS)
+		set(v.Time, "DownlinkBps", v.DownlinkBPS)
+	}
+	for _, v := range values.Connection {
+		set(v.Time, "activeConnections", v.ActiveConnections)
+		set(v.Time, "connectionsPerSec", v.ConnectionsPerSec)
+	}
+	return payload, nil
+}
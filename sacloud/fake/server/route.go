@@ -0,0 +1,291 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sacloud/libsacloud-v2/pkg/mapconv"
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// handlerFunc 各APIオペレーションのハンドラー
+//
+// パスパラメータとリクエストボディ(エンベロープをmapとしたもの)を受け取り、レスポンスのエンベロープを返す
+type handlerFunc func(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error)
+
+var paramPattern = regexp.MustCompile(`{{\.(\w+)}}`)
+
+type route struct {
+	resourceName  string
+	operationName string
+	method        string
+	segments      []string
+	requestKeys   []string
+	handler       handlerFunc
+}
+
+func newRoute(resourceName, operationName, method, pathSuffix, pathName, pathFormat string, requestKeys []string, handler handlerFunc) *route {
+	path := strings.NewReplacer(
+		"{{.rootURL}}/", "",
+		"{{.pathSuffix}}", pathSuffix,
+		"{{.pathName}}", pathName,
+	).Replace(pathFormat)
+
+	return &route{
+		resourceName:  resourceName,
+		operationName: operationName,
+		method:        method,
+		segments:      strings.Split(path, "/"),
+		requestKeys:   requestKeys,
+		handler:       handler,
+	}
+}
+
+// match 指定のメソッド/パスにマッチする場合、パスパラメータとリテラル部分の一致数を返す
+func (r *route) match(method string, segments []string) (pathParams, int, bool) {
+	if r.method != method || len(r.segments) != len(segments) {
+		return nil, 0, false
+	}
+
+	params := pathParams{}
+	literals := 0
+	for i, segment := range r.segments {
+		if strings.Contains(segment, "{{") {
+			// {{if eq .index 0}}{{.index}}{{end}}のような条件付きの場合も考慮し、最初に見つかった変数名を採用する
+			if m := paramPattern.FindStringSubmatch(segment); len(m) > 1 {
+				params[m[1]] = segments[i]
+			}
+			continue
+		}
+		if segment != segments[i] {
+			return nil, 0, false
+		}
+		literals++
+	}
+	return params, literals, true
+}
+
+type matchedRoute struct {
+	*route
+	params pathParams
+}
+
+// score リクエストボディに含まれるキーのうち、ルートが受け付けるものの数を返す
+func (r *matchedRoute) score(body map[string]interface{}) int {
+	m := mapconv.Map(body)
+	score := 0
+	for _, key := range r.requestKeys {
+		if v, err := m.Get(key); err == nil && v != nil {
+			score++
+		}
+	}
+	return score
+}
+
+type matchedRoutes []*matchedRoute
+
+// matchRoutes 指定のメソッド/パスにマッチするルートのうち、リテラル部分の一致数が最も多いものを返す
+//
+// commonserviceitemやapplianceのように複数のリソースで同じパスを共有する場合は複数のルートが返る
+// IDを含むパスの場合、リテラル部分の一致数が少ないルートも一致数の降順で後ろに含める
+// (モバイルゲートウェイの"interface/1/to/switch"とVPCルータの"interface/{{.nicIndex}}/to/switch"など)
+func matchRoutes(method, path string) matchedRoutes {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+
+	var results matchedRoutes
+	var literalCounts []int
+	for _, r := range routes {
+		params, literals, ok := r.match(method, segments)
+		if !ok {
+			continue
+		}
+		results = append(results, &matchedRoute{route: r, params: params})
+		literalCounts = append(literalCounts, literals)
+	}
+	if len(results) == 0 {
+		return nil
+	}
+
+	sort.Stable(&byLiterals{routes: results, literals: literalCounts})
+	if results[0].params.has("id") {
+		return results
+	}
+	for i := range results {
+		if literalCounts[i] < literalCounts[0] {
+			return results[:i]
+		}
+	}
+	return results
+}
+
+type byLiterals struct {
+	routes   matchedRoutes
+	literals []int
+}
+
+func (b *byLiterals) Len() int           { return len(b.routes) }
+func (b *byLiterals) Less(i, j int) bool { return b.literals[i] > b.literals[j] }
+func (b *byLiterals) Swap(i, j int) {
+	b.routes[i], b.routes[j] = b.routes[j], b.routes[i]
+	b.literals[i], b.literals[j] = b.literals[j], b.literals[i]
+}
+
+// filterByClass リクエストボディに含まれるクラス名(Provider.Class or Appliance.Class)でルートを絞り込む
+//
+// クラス名が含まれない、またはクラス名に該当するルートが存在しない場合は絞り込まずにそのまま返す
+func (m matchedRoutes) filterByClass(body map[string]interface{}) matchedRoutes {
+	if len(m) < 2 {
+		return m
+	}
+	class := classFromBody(body)
+	if class == "" {
+		return m
+	}
+	for _, r := range m {
+		if strings.EqualFold(r.resourceName, class) {
+			return matchedRoutes{r}
+		}
+	}
+	return m
+}
+
+// filterByRequestKeys リクエストボディに含まれるキーの数が最も多いルートに絞り込む
+//
+// ディスクのCreate/CreateWithConfigのように同一のメソッド/パスを持つ操作を判定するために利用する
+func (m matchedRoutes) filterByRequestKeys(body map[string]interface{}) matchedRoutes {
+	if len(m) < 2 {
+		return m
+	}
+
+	var results matchedRoutes
+	max := -1
+	for _, r := range m {
+		score := r.score(body)
+		switch {
+		case score > max:
+			max = score
+			results = matchedRoutes{r}
+		case score == max:
+			results = append(results, r)
+		}
+	}
+	return results
+}
+
+// handle マッチしたルートのハンドラーを呼び出す
+//
+// 複数のルートがマッチした場合、IDを含むパスであればNotFound以外の結果を返したものを、
+// IDを含まないパスであれば全ての結果をマージしたものを返す
+func (m matchedRoutes) handle(ctx context.Context, body map[string]interface{}) (map[string]interface{}, error) {
+	if len(m) == 1 || m[0].params.has("id") {
+		var envelope map[string]interface{}
+		var err error
+		for _, r := range m {
+			envelope, err = r.handler(ctx, r.params, body)
+			if err == nil || !sacloud.IsNotFoundError(err) {
+				break
+			}
+		}
+		return envelope, err
+	}
+
+	var envelopes []map[string]interface{}
+	for _, r := range m {
+		envelope, err := r.handler(ctx, r.params, body)
+		if err != nil {
+			return nil, err
+		}
+		envelopes = append(envelopes, envelope)
+	}
+	return mergeEnvelopes(envelopes...), nil
+}
+
+func classFromBody(body map[string]interface{}) string {
+	for _, key := range []string{"CommonServiceItem", "Appliance"} {
+		if payload, ok := body[key].(map[string]interface{}); ok {
+			if class, ok := payload["Class"].(string); ok {
+				return class
+			}
+			if provider, ok := payload["Provider"].(map[string]interface{}); ok {
+				if class, ok := provider["Class"].(string); ok {
+					return class
+				}
+			}
+		}
+	}
+	if filter, ok := body["Filter"].(map[string]interface{}); ok {
+		for _, key := range []string{"Provider.Class", "Class"} {
+			if class, ok := filter[key].(string); ok {
+				return class
+			}
+		}
+	}
+	return ""
+}
+
+// mergeEnvelopes 複数形のレスポンスエンベロープをマージする
+func mergeEnvelopes(envelopes ...map[string]interface{}) map[string]interface{} {
+	merged := newPluralEnvelope(0)
+	var values []interface{}
+	var payloadName string
+	for _, envelope := range envelopes {
+		for k, v := range envelope {
+			switch k {
+			case "Total", "From", "Count", "is_ok", "Success":
+				continue
+			}
+			payloadName = k
+			rv := reflect.ValueOf(v)
+			if rv.Kind() != reflect.Slice {
+				continue
+			}
+			for i := 0; i < rv.Len(); i++ {
+				values = append(values, rv.Index(i).Interface())
+			}
+		}
+	}
+	if payloadName != "" {
+		merged[payloadName] = values
+	}
+	merged["Total"] = len(values)
+	merged["Count"] = len(values)
+	return merged
+}
+
+// pathParams パスに含まれるパラメータ
+type pathParams map[string]string
+
+func (p pathParams) has(name string) bool {
+	_, ok := p[name]
+	return ok
+}
+
+// bind パラメータの値を指定の型に変換してdestへ設定する
+func (p pathParams) bind(name string, dest interface{}) error {
+	v := p[name]
+	switch dest := dest.(type) {
+	case *string:
+		*dest = v
+	case *types.ID:
+		*dest = types.StringID(v)
+	case *int:
+		if v == "" {
+			*dest = 0
+			return nil
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return newErrorBadRequest(fmt.Sprintf("invalid path parameter %q: %s", name, err))
+		}
+		*dest = n
+	default:
+		return newErrorInternalServerError(fmt.Sprintf("unsupported path parameter type: %T", dest))
+	}
+	return nil
+}
@@ -0,0 +1,198 @@
+// Package server fakeドライバーを用いてさくらのクラウドAPIを模倣するHTTPサーバ
+//
+// 実際のAPIと同じURL体系(/{zone}/api/cloud/1.1/{pathName}/...)とJSONエンベロープでリクエスト/レスポンスを扱うため、
+// sacloud.Client.APIRootURLにhttptest.ServerのURLを指定することでsacloud.Clientを含めたAPI呼び出し処理全体をオフラインで検証できる
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+)
+
+// Server fakeドライバーをバックエンドとして利用するAPIサーバ、http.Handlerを実装する
+type Server struct{}
+
+// ServeHTTP http.Handlerの実装
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	defer func() {
+		if e := recover(); e != nil {
+			s.writeError(w, newErrorInternalServerError(fmt.Sprintf("%s", e)))
+		}
+	}()
+
+	body, err := s.readBody(r)
+	if err != nil {
+		s.writeError(w, newErrorBadRequest(err.Error()))
+		return
+	}
+
+	matched := matchRoutes(r.Method, r.URL.Path)
+	if len(matched) == 0 {
+		s.writeError(w, newErrorNotFound(fmt.Sprintf("%s %s is not found", r.Method, r.URL.Path)))
+		return
+	}
+	matched = matched.filterByClass(body).filterByRequestKeys(body)
+
+	envelope, err := matched.handle(r.Context(), body)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+
+	status := http.StatusOK
+	if r.Method == http.MethodPost {
+		status = http.StatusCreated
+	}
+	s.writeJSON(w, status, envelope)
+}
+
+// readBody リクエストボディを読み込む
+//
+// GETの場合はクエリ文字列にJSONが格納されている
+func (s *Server) readBody(r *http.Request) (map[string]interface{}, error) {
+	var data []byte
+	if r.Method == http.MethodGet {
+		query, err := url.QueryUnescape(r.URL.RawQuery)
+		if err != nil {
+			query = r.URL.RawQuery
+		}
+		data = []byte(query)
+	} else if r.Body != nil {
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return nil, err
+		}
+		data = b
+	}
+
+	body := make(map[string]interface{})
+	if len(strings.TrimSpace(string(data))) == 0 {
+		return body, nil
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+func (s *Server) writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		s.writeError(w, newErrorInternalServerError(err.Error()))
+		return
+	}
+
+	var values interface{}
+	decoder := json.NewDecoder(strings.NewReader(string(data)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&values); err != nil {
+		s.writeError(w, newErrorInternalServerError(err.Error()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(status)
+	// エンベロープ直下のペイロードは空であっても残しておく
+	if envelope, ok := values.(map[string]interface{}); ok {
+		for key, value := range envelope {
+			envelope[key] = pruneEmptyObjects(value)
+		}
+	} else {
+		values = pruneEmptyObjects(values)
+	}
+	json.NewEncoder(w).Encode(values)
+}
+
+// pruneEmptyObjects 値が空のオブジェクト({})を取り除く
+//
+// 実際のAPIでは未設定の項目はnullもしくは省略されるため、mapconvでの変換時に生成される空のオブジェクトを除去しておく
+func pruneEmptyObjects(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			value = pruneEmptyObjects(value)
+			if m, ok := value.(map[string]interface{}); ok && len(m) == 0 {
+				delete(v, key)
+				continue
+			}
+			v[key] = value
+		}
+		return v
+	case []interface{}:
+		for i, value := range v {
+			v[i] = pruneEmptyObjects(value)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func (s *Server) writeError(w http.ResponseWriter, err error) {
+	if apiError, ok := err.(sacloud.APIError); ok {
+		s.writeJSON(w, apiError.ResponseCode(), apiError.OrigErr())
+		return
+	}
+	s.writeError(w, newErrorInternalServerError(err.Error()))
+}
+
+func newSingularEnvelope() map[string]interface{} {
+	return map[string]interface{}{
+		"is_ok":   true,
+		"Success": true,
+	}
+}
+
+// embedPayload ペイロードの各項目をエンベロープのトップレベルへ展開する
+func embedPayload(envelope map[string]interface{}, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	values := map[string]interface{}{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	for key, value := range values {
+		envelope[key] = value
+	}
+	return nil
+}
+
+func newPluralEnvelope(count int) map[string]interface{} {
+	return map[string]interface{}{
+		"Total": count,
+		"From":  0,
+		"Count": count,
+	}
+}
+
+func newError(status int, msg string) error {
+	return sacloud.NewAPIError("", nil, "", status, &sacloud.APIErrorResponse{
+		IsFatal:      true,
+		Status:       fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		ErrorCode:    fmt.Sprintf("%d", status),
+		ErrorMessage: msg,
+	})
+}
+
+func newErrorBadRequest(msg string) error {
+	return newError(http.StatusBadRequest, msg)
+}
+
+func newErrorNotFound(msg string) error {
+	return newError(http.StatusNotFound, msg)
+}
+
+func newErrorInternalServerError(msg string) error {
+	return newError(http.StatusInternalServerError, msg)
+}
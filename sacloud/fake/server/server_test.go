@@ -0,0 +1,1211 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"image/png"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/fake"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testZone = "is1a"
+
+var (
+	testCaller  sacloud.APICaller
+	testRootURL string
+)
+
+func TestMain(m *testing.M) {
+	server := httptest.NewServer(&Server{})
+	defer server.Close()
+
+	client := sacloud.NewClient("dummy-token", "dummy-secret")
+	client.APIRootURL = server.URL
+	testCaller = client
+	testRootURL = server.URL
+
+	os.Exit(m.Run())
+}
+
+func TestServer_NoteCRUD(t *testing.T) {
+	ctx := context.Background()
+	client := sacloud.NewNoteOp(testCaller)
+
+	created, err := client.Create(ctx, testZone, &sacloud.NoteCreateRequest{
+		Name:    "libsacloud-v2-fake-server",
+		Tags:    []string{"tag1", "tag2"},
+		Class:   "shell",
+		Content: "content",
+	})
+	require.NoError(t, err)
+	require.False(t, created.ID.IsEmpty())
+	require.Equal(t, "libsacloud-v2-fake-server", created.Name)
+	require.Equal(t, []string{"tag1", "tag2"}, created.Tags)
+
+	read, err := client.Read(ctx, testZone, created.ID)
+	require.NoError(t, err)
+	require.Equal(t, created.ID, read.ID)
+	require.Equal(t, "content", read.Content)
+
+	found, err := client.Find(ctx, testZone, &sacloud.FindCondition{Count: 100})
+	require.NoError(t, err)
+	require.NotEmpty(t, found)
+
+	updated, err := client.Update(ctx, testZone, created.ID, &sacloud.NoteUpdateRequest{
+		Name:    "libsacloud-v2-fake-server-upd",
+		Class:   "shell",
+		Content: "content-upd",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "libsacloud-v2-fake-server-upd", updated.Name)
+	require.Equal(t, "content-upd", updated.Content)
+
+	require.NoError(t, client.Delete(ctx, testZone, created.ID))
+
+	_, err = client.Read(ctx, testZone, created.ID)
+	require.Error(t, err)
+	require.True(t, sacloud.IsNotFoundError(err))
+}
+
+func TestServer_SharedPathName(t *testing.T) {
+	ctx := context.Background()
+
+	swOp := sacloud.NewSwitchOp(testCaller)
+	sw, err := swOp.Create(ctx, testZone, &sacloud.SwitchCreateRequest{
+		Name: "libsacloud-v2-fake-server-switch-for-appliance",
+	})
+	require.NoError(t, err)
+
+	nfsOp := sacloud.NewNFSOp(testCaller)
+	nfs, err := nfsOp.Create(ctx, testZone, &sacloud.NFSCreateRequest{
+		Name:           "libsacloud-v2-fake-server-nfs",
+		SwitchID:       sw.ID,
+		PlanID:         types.ID(100),
+		IPAddresses:    []string{"192.168.0.11"},
+		NetworkMaskLen: 24,
+		DefaultRoute:   "192.168.0.1",
+	})
+	require.NoError(t, err)
+
+	lbOp := sacloud.NewLoadBalancerOp(testCaller)
+	lb, err := lbOp.Create(ctx, testZone, &sacloud.LoadBalancerCreateRequest{
+		Name:           "libsacloud-v2-fake-server-lb",
+		SwitchID:       sw.ID,
+		PlanID:         types.ID(1),
+		VRID:           100,
+		IPAddresses:    []string{"192.168.0.12"},
+		NetworkMaskLen: 24,
+		DefaultRoute:   "192.168.0.1",
+	})
+	require.NoError(t, err)
+
+	// applianceのようにパスを共有していてもIDで対象リソースが判定される
+	readNFS, err := nfsOp.Read(ctx, testZone, nfs.ID)
+	require.NoError(t, err)
+	require.Equal(t, nfs.Name, readNFS.Name)
+
+	readLB, err := lbOp.Read(ctx, testZone, lb.ID)
+	require.NoError(t, err)
+	require.Equal(t, lb.Name, readLB.Name)
+
+	// クラス指定なしのFindでは全てのアプライアンスが返る
+	found, err := nfsOp.Find(ctx, testZone, &sacloud.FindCondition{})
+	require.NoError(t, err)
+	require.Len(t, found, 2)
+}
+
+func TestServer_PathParameters(t *testing.T) {
+	ctx := context.Background()
+
+	swOp := sacloud.NewSwitchOp(testCaller)
+	sw, err := swOp.Create(ctx, testZone, &sacloud.SwitchCreateRequest{
+		Name: "libsacloud-v2-fake-server-switch",
+	})
+	require.NoError(t, err)
+
+	ifOp := sacloud.NewInterfaceOp(testCaller)
+	iface, err := ifOp.Create(ctx, testZone, &sacloud.InterfaceCreateRequest{})
+	require.NoError(t, err)
+
+	require.NoError(t, ifOp.ConnectToSwitch(ctx, testZone, iface.ID, sw.ID))
+
+	iface, err = ifOp.Read(ctx, testZone, iface.ID)
+	require.NoError(t, err)
+	require.Equal(t, sw.ID, iface.SwitchID)
+
+	require.NoError(t, ifOp.DisconnectFromSwitch(ctx, testZone, iface.ID))
+	require.NoError(t, ifOp.Delete(ctx, testZone, iface.ID))
+	require.NoError(t, swOp.Delete(ctx, testZone, sw.ID))
+}
+
+func TestServer_NotFound(t *testing.T) {
+	_, err := testCaller.Do(context.Background(), http.MethodGet, testRootURL+"/is1a/api/cloud/1.1/unknown", nil)
+	require.Error(t, err)
+	require.True(t, sacloud.IsNotFoundError(err))
+
+	_, err = sacloud.NewServerOp(testCaller).Read(context.Background(), testZone, types.ID(1))
+	require.True(t, sacloud.IsNotFoundError(err))
+}
+
+func TestServer_MobileGateway(t *testing.T) {
+	ctx := context.Background()
+	client := sacloud.NewMobileGatewayOp(testCaller)
+
+	mgw, err := client.Create(ctx, testZone, &sacloud.MobileGatewayCreateRequest{
+		Name: "libsacloud-v2-fake-server-mgw",
+		Settings: &sacloud.MobileGatewaySettingCreate{
+			InternetConnectionEnabled: true,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "mobilegateway", mgw.Class)
+
+	// VPCルータのinterface/{{.nicIndex}}と衝突するパスでもモバイルゲートウェイとして処理される
+	swOp := sacloud.NewSwitchOp(testCaller)
+	sw, err := swOp.Create(ctx, testZone, &sacloud.SwitchCreateRequest{
+		Name: "libsacloud-v2-fake-server-switch-for-mgw",
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.ConnectToSwitch(ctx, testZone, mgw.ID, sw.ID))
+
+	read, err := client.Read(ctx, testZone, mgw.ID)
+	require.NoError(t, err)
+	require.Len(t, read.Interfaces, 2)
+	require.Equal(t, sw.ID, read.Interfaces[1].SwitchID)
+
+	require.NoError(t, client.DisconnectFromSwitch(ctx, testZone, mgw.ID))
+
+	// DNS
+	require.NoError(t, client.SetDNS(ctx, testZone, mgw.ID, &sacloud.MobileGatewayDNSSetting{
+		DNS1: "8.8.8.8",
+		DNS2: "8.8.4.4",
+	}))
+	dns, err := client.GetDNS(ctx, testZone, mgw.ID)
+	require.NoError(t, err)
+	require.Equal(t, "8.8.8.8", dns.DNS1)
+	require.Equal(t, "8.8.4.4", dns.DNS2)
+
+	// SIM
+	// ICCIDを持つSIMはfakeのストアへ直接登録しておく
+	sim, err := fake.NewSIMOp().Create(ctx, sacloud.DefaultZone, &sacloud.SIMCreateRequest{
+		Name:     "libsacloud-v2-fake-server-sim",
+		ICCID:    types.StringNumber(1234567890),
+		PassCode: "dummy",
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, client.AddSIM(ctx, testZone, mgw.ID, &sacloud.MobileGatewayAddSIMRequest{SIMID: sim.ID.String()}))
+	sims, err := client.ListSIM(ctx, testZone, mgw.ID)
+	require.NoError(t, err)
+	require.Len(t, sims, 1)
+	require.Equal(t, sim.ID.String(), sims[0].ResourceID)
+	require.Equal(t, "1234567890", sims[0].ICCID)
+
+	// SIMルート
+	require.NoError(t, client.SetSIMRoutes(ctx, testZone, mgw.ID, []*sacloud.MobileGatewaySIMRoute{
+		{ResourceID: sim.ID.String(), Prefix: "192.168.0.0/24"},
+	}))
+	routes, err := client.GetSIMRoutes(ctx, testZone, mgw.ID)
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+	require.Equal(t, sim.ID.String(), routes[0].ResourceID)
+	require.Equal(t, "192.168.0.0/24", routes[0].Prefix)
+	require.Equal(t, "1234567890", routes[0].ICCID)
+
+	err = client.SetSIMRoutes(ctx, testZone, mgw.ID, []*sacloud.MobileGatewaySIMRoute{
+		{ResourceID: "999999999999", Prefix: "192.168.1.0/24"},
+	})
+	require.Error(t, err)
+
+	// トラフィックコントロール
+	require.NoError(t, client.SetTrafficConfig(ctx, testZone, mgw.ID, &sacloud.MobileGatewayTrafficControl{
+		TrafficQuotaInMB:       10,
+		BandWidthLimitInKbps:   128,
+		SlackNotifyEnabled:     true,
+		SlackNotifyWebhooksURL: "https://hooks.slack.com/services/xxx",
+	}))
+	traffic, err := client.GetTrafficConfig(ctx, testZone, mgw.ID)
+	require.NoError(t, err)
+	require.Equal(t, 10, traffic.TrafficQuotaInMB)
+	require.True(t, traffic.SlackNotifyEnabled)
+	require.Equal(t, "https://hooks.slack.com/services/xxx", traffic.SlackNotifyWebhooksURL)
+
+	_, err = client.TrafficStatus(ctx, testZone, mgw.ID)
+	require.NoError(t, err)
+
+	require.NoError(t, client.DeleteTrafficConfig(ctx, testZone, mgw.ID))
+	require.NoError(t, client.DeleteSIM(ctx, testZone, mgw.ID, sim.ID))
+
+	routes, err = client.GetSIMRoutes(ctx, testZone, mgw.ID)
+	require.NoError(t, err)
+	require.Empty(t, routes)
+
+	require.NoError(t, client.Delete(ctx, testZone, mgw.ID))
+	require.NoError(t, swOp.Delete(ctx, testZone, sw.ID))
+}
+
+func TestServer_Database(t *testing.T) {
+	ctx := context.Background()
+
+	swOp := sacloud.NewSwitchOp(testCaller)
+	sw, err := swOp.Create(ctx, testZone, &sacloud.SwitchCreateRequest{
+		Name: "libsacloud-v2-fake-server-switch-for-database",
+	})
+	require.NoError(t, err)
+
+	client := sacloud.NewDatabaseOp(testCaller)
+	master, err := client.Create(ctx, testZone, &sacloud.DatabaseCreateRequest{
+		Name:           "libsacloud-v2-fake-server-db",
+		PlanID:         types.ID(10),
+		SwitchID:       sw.ID,
+		IPAddresses:    []string{"192.168.0.21"},
+		NetworkMaskLen: 24,
+		DefaultRoute:   "192.168.0.1",
+		Conf: &sacloud.DatabaseRemarkDBConfCommon{
+			DatabaseName:    "MariaDB",
+			DatabaseVersion: "10.3",
+		},
+		CommonSetting: &sacloud.DatabaseSettingCommon{
+			WebUI:        types.WebUIEnabled,
+			ServicePort:  3306,
+			DefaultUser:  "user",
+			UserPassword: "password",
+			ReplicaUser:  "replica",
+		},
+		BackupSetting: &sacloud.DatabaseSettingBackup{
+			Rotate:    7,
+			Time:      "00:00",
+			DayOfWeek: []string{"mon", "tue"},
+		},
+		ReplicationSetting: &sacloud.DatabaseReplicationSetting{
+			Model: types.DatabaseReplicationModels.MasterSlave,
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "database", master.Class)
+	require.Equal(t, "MariaDB", master.Conf.DatabaseName)
+	require.Equal(t, "https://192.168.0.21:21443/", master.CommonSetting.WebUI.String())
+	require.Equal(t, []string{"mon", "tue"}, master.BackupSetting.DayOfWeek)
+
+	// スレーブ作成時はマスター側の設定が検証される
+	_, err = client.Create(ctx, testZone, &sacloud.DatabaseCreateRequest{
+		Name:     "libsacloud-v2-fake-server-db-slave",
+		PlanID:   types.ID(10),
+		SwitchID: sw.ID,
+		ReplicationSetting: &sacloud.DatabaseReplicationSetting{
+			Model:       types.DatabaseReplicationModels.AsyncReplica,
+			ApplianceID: types.ID(1),
+		},
+	})
+	require.Error(t, err)
+
+	slave, err := client.Create(ctx, testZone, &sacloud.DatabaseCreateRequest{
+		Name:           "libsacloud-v2-fake-server-db-slave",
+		PlanID:         types.ID(10),
+		SwitchID:       sw.ID,
+		IPAddresses:    []string{"192.168.0.22"},
+		NetworkMaskLen: 24,
+		DefaultRoute:   "192.168.0.1",
+		ReplicationSetting: &sacloud.DatabaseReplicationSetting{
+			Model:       types.DatabaseReplicationModels.AsyncReplica,
+			ApplianceID: master.ID,
+			IPAddress:   "192.168.0.21",
+			Port:        3306,
+			User:        "replica",
+		},
+	})
+	require.NoError(t, err)
+
+	status, err := client.Status(ctx, testZone, master.ID)
+	require.NoError(t, err)
+	require.Equal(t, "10.3", status.Version.Tag)
+	require.Len(t, status.Logs, 1)
+	require.Equal(t, "replication", status.Logs[0].Name)
+	require.Equal(t, "replicas: 1", status.Logs[0].Data)
+
+	// パラメータ
+	require.NoError(t, client.SetParameter(ctx, testZone, master.ID, map[string]interface{}{
+		"MariaDB/server.cnf/mysqld/max_connections": 200,
+	}))
+	param, err := client.GetParameter(ctx, testZone, master.ID)
+	require.NoError(t, err)
+	require.EqualValues(t, 200, param.Settings["MariaDB/server.cnf/mysqld/max_connections"])
+	require.NotEmpty(t, param.MetaInfo)
+
+	err = client.SetParameter(ctx, testZone, master.ID, map[string]interface{}{"unknown": 1})
+	require.Error(t, err)
+
+	monitor, err := client.MonitorDatabase(ctx, testZone, slave.ID, &sacloud.MonitorCondition{})
+	require.NoError(t, err)
+	require.NotEmpty(t, monitor.Values)
+
+	// APIと同じく対象時刻をキーとした形式で返されること
+	data, err := testCaller.Do(ctx, http.MethodGet, fmt.Sprintf("%s/%s/api/cloud/1.1/appliance/%s/database/monitor", testRootURL, testZone, slave.ID), nil)
+	require.NoError(t, err)
+	raw := struct {
+		Data map[string]map[string]float64
+	}{}
+	require.NoError(t, json.Unmarshal(data, &raw))
+	require.Len(t, raw.Data, len(monitor.Values))
+	for key, value := range raw.Data {
+		_, err := time.Parse(time.RFC3339, key)
+		require.NoError(t, err)
+		require.Contains(t, value, "binlogUsedSizeKiB")
+	}
+}
+
+func TestServer_Icon(t *testing.T) {
+	ctx := context.Background()
+	client := sacloud.NewIconOp(testCaller)
+
+	param := &sacloud.IconCreateRequest{
+		Name: "libsacloud-v2-fake-server-icon",
+		Tags: []string{"tag1", "tag2"},
+	}
+	require.NoError(t, param.SetImageFromReader(strings.NewReader("dummy-image")))
+
+	icon, err := client.Create(ctx, testZone, param)
+	require.NoError(t, err)
+	require.Equal(t, types.Scopes.User, icon.Scope)
+	require.Equal(t, fmt.Sprintf("/%s/api/cloud/1.1/icon/%s.png", sacloud.DefaultZone, icon.ID), icon.URL)
+
+	image, err := client.GetImage(ctx, testZone, icon.ID, types.IconSizes.Small)
+	require.NoError(t, err)
+	data, err := image.Decode()
+	require.NoError(t, err)
+	require.Equal(t, "dummy-image", string(data))
+
+	// 通常のReadでは画像データは返らない
+	read, err := client.Read(ctx, testZone, icon.ID)
+	require.NoError(t, err)
+	require.Equal(t, icon.Name, read.Name)
+
+	_, err = client.Create(ctx, testZone, &sacloud.IconCreateRequest{
+		Name:  "libsacloud-v2-fake-server-icon-invalid",
+		Image: "not-base64!",
+	})
+	require.Error(t, err)
+
+	require.NoError(t, client.Delete(ctx, testZone, icon.ID))
+	_, err = client.GetImage(ctx, testZone, icon.ID, types.IconSizes.Small)
+	require.Error(t, err)
+}
+
+func TestServer_SSHKey(t *testing.T) {
+	ctx := context.Background()
+	client := sacloud.NewSSHKeyOp(testCaller)
+
+	generated, err := client.Generate(ctx, testZone, &sacloud.SSHKeyGenerateRequest{
+		Name:       "libsacloud-v2-fake-server-sshkey",
+		PassPhrase: "libsacloud-v2-passphrase",
+	})
+	require.NoError(t, err)
+	require.Contains(t, generated.PrivateKey, "PRIVATE KEY")
+	require.True(t, strings.HasPrefix(generated.PublicKey, "ssh-rsa "))
+	require.NotEmpty(t, generated.Fingerprint)
+
+	// 生成した公開鍵はそのまま登録できる
+	created, err := client.Create(ctx, testZone, &sacloud.SSHKeyCreateRequest{
+		Name:      "libsacloud-v2-fake-server-sshkey-upload",
+		PublicKey: generated.PublicKey + " comment",
+	})
+	require.NoError(t, err)
+	require.Equal(t, generated.Fingerprint, created.Fingerprint)
+
+	// 秘密鍵は生成時以外では返らない
+	read, err := client.Read(ctx, testZone, generated.ID)
+	require.NoError(t, err)
+	require.Equal(t, generated.PublicKey, read.PublicKey)
+
+	for _, publicKey := range []string{
+		"",
+		"ssh-rsa",
+		"unknown-type AAAAB3NzaC1yc2E=",
+		"ssh-rsa not-base64!",
+		"ssh-ed25519 AAAAB3NzaC1yc2E=",
+	} {
+		_, err := client.Create(ctx, testZone, &sacloud.SSHKeyCreateRequest{
+			Name:      "libsacloud-v2-fake-server-sshkey-invalid",
+			PublicKey: publicKey,
+		})
+		require.Error(t, err, publicKey)
+	}
+}
+
+func TestServer_ServerPlan(t *testing.T) {
+	ctx := context.Background()
+	client := sacloud.NewServerOp(testCaller)
+
+	// 世代未指定の場合はゾーンで提供されている最新世代のプランが選ばれる
+	server, err := client.Create(ctx, "is1a", &sacloud.ServerCreateRequest{
+		Name:     "libsacloud-v2-fake-server-plan",
+		CPU:      2,
+		MemoryMB: 4 * 1024,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.PlanGenerations.G200, server.ServerPlanGeneration)
+	require.Equal(t, types.Commitments.Standard, server.ServerPlanCommitment)
+
+	plan, err := sacloud.NewServerPlanOp(testCaller).Read(ctx, "is1a", server.ServerPlanID)
+	require.NoError(t, err)
+	require.Equal(t, plan.Name, server.ServerPlanName)
+
+	// 存在しないプラン
+	_, err = client.Create(ctx, "is1a", &sacloud.ServerCreateRequest{
+		Name:     "libsacloud-v2-fake-server-plan-invalid",
+		CPU:      1,
+		MemoryMB: 1024 * 1024,
+	})
+	require.True(t, sacloud.IsBadRequestError(err), "%s", err)
+
+	// コア専有プランへの変更
+	changed, err := client.ChangePlan(ctx, "is1a", server.ID, &sacloud.ServerChangePlanRequest{
+		CPU:                  2,
+		MemoryMB:             4 * 1024,
+		ServerPlanCommitment: types.Commitments.DedicatedCPU,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.Commitments.DedicatedCPU, changed.ServerPlanCommitment)
+
+	// コア専有プランが提供されていないゾーン
+	server, err = client.Create(ctx, "is1b", &sacloud.ServerCreateRequest{
+		Name:     "libsacloud-v2-fake-server-plan",
+		CPU:      2,
+		MemoryMB: 4 * 1024,
+	})
+	require.NoError(t, err)
+
+	_, err = client.ChangePlan(ctx, "is1b", server.ID, &sacloud.ServerChangePlanRequest{
+		CPU:                  2,
+		MemoryMB:             4 * 1024,
+		ServerPlanCommitment: types.Commitments.DedicatedCPU,
+	})
+	require.True(t, sacloud.IsBadRequestError(err), "%s", err)
+}
+
+func TestServer_IPAddress(t *testing.T) {
+	ctx := context.Background()
+
+	internetOp := sacloud.NewInternetOp(testCaller)
+	internet, err := internetOp.Create(ctx, testZone, &sacloud.InternetCreateRequest{
+		Name:           "libsacloud-v2-fake-server-ipaddress",
+		NetworkMaskLen: 28,
+		BandWidthMbps:  100,
+	})
+	require.NoError(t, err)
+
+	subnet, err := sacloud.NewSubnetOp(testCaller).Read(ctx, testZone, internet.Switch.Subnets[0].ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, subnet.IPAddresses)
+
+	// IPv4アドレスの逆引き
+	client := sacloud.NewIPAddressOp(testCaller)
+	ip := subnet.IPAddresses[0].IPAddress
+
+	updated, err := client.UpdateHostName(ctx, testZone, ip, "libsacloud-v2.example.com")
+	require.NoError(t, err)
+	require.Equal(t, "libsacloud-v2.example.com", updated.HostName)
+	require.Equal(t, subnet.ID, updated.SubnetID)
+
+	ips, err := client.Find(ctx, testZone, &sacloud.FindCondition{})
+	require.NoError(t, err)
+	var found bool
+	for _, v := range ips {
+		if v.IPAddress == ip {
+			found = true
+			require.Equal(t, "libsacloud-v2.example.com", v.HostName)
+		}
+	}
+	require.True(t, found, "%s is not found", ip)
+
+	_, err = client.UpdateHostName(ctx, testZone, ip, "-invalid-.example")
+	require.True(t, sacloud.IsBadRequestError(err), "%s", err)
+
+	_, err = client.Read(ctx, testZone, "192.0.2.254")
+	require.True(t, sacloud.IsNotFoundError(err), "%s", err)
+
+	// IPv6アドレスの逆引き
+	ipv6Net, err := internetOp.EnableIPv6(ctx, testZone, internet.ID)
+	require.NoError(t, err)
+
+	ipv6AddrOp := sacloud.NewIPv6AddrOp(testCaller)
+	addr := ipv6Net.IPv6Prefix + "1"
+	created, err := ipv6AddrOp.Create(ctx, testZone, &sacloud.IPv6AddrCreateRequest{
+		IPv6Addr: addr,
+		HostName: "libsacloud-v2.example.com",
+	})
+	require.NoError(t, err)
+	require.Equal(t, ipv6Net.ID, created.IPv6NetID)
+	require.Equal(t, internet.Switch.ID, created.SwitchID)
+
+	read, err := ipv6AddrOp.Read(ctx, testZone, addr)
+	require.NoError(t, err)
+	require.Equal(t, created, read)
+
+	// IPv6ネットワーク外のアドレス
+	_, err = ipv6AddrOp.Create(ctx, testZone, &sacloud.IPv6AddrCreateRequest{
+		IPv6Addr: "2001:db8:ffff::1",
+	})
+	require.True(t, sacloud.IsBadRequestError(err), "%s", err)
+
+	readNet, err := sacloud.NewIPv6NetOp(testCaller).Read(ctx, testZone, ipv6Net.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, readNet.NamedIPv6AddrCount)
+
+	require.NoError(t, internetOp.DisableIPv6(ctx, testZone, internet.ID, ipv6Net.ID))
+	_, err = ipv6AddrOp.Read(ctx, testZone, addr)
+	require.True(t, sacloud.IsNotFoundError(err), "%s", err)
+
+	require.NoError(t, internetOp.Delete(ctx, testZone, internet.ID))
+}
+
+func TestServer_DNS(t *testing.T) {
+	ctx := context.Background()
+	client := sacloud.NewDNSOp(testCaller)
+
+	dns, err := client.Create(ctx, sacloud.DefaultZone, &sacloud.DNSCreateRequest{
+		Name: "libsacloud-v2-fake-server.com",
+		Records: []*sacloud.DNSRecord{
+			sacloud.NewDNSRecord(types.DNSRecordTypes.A, "www", "192.0.2.1", 0),
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, dns.DNSNameServers, 2)
+	require.Equal(t, 3600, dns.Records[0].TTL)
+
+	// 同名ゾーン
+	_, err = client.Create(ctx, sacloud.DefaultZone, &sacloud.DNSCreateRequest{Name: dns.Name})
+	require.True(t, sacloud.IsConflictError(err), "%s", err)
+
+	// レコードの編集
+	updated, err := sacloud.UpdateDNSRecords(ctx, client, dns.ID, func(records *sacloud.DNSRecords) {
+		records.Add(
+			sacloud.NewDNSRecord(types.DNSRecordTypes.A, "www", "192.0.2.1", 300),
+			sacloud.NewDNSRecord(types.DNSRecordTypes.CNAME, "app", "www.libsacloud-v2-fake-server.com.", 300),
+			sacloud.NewDNSRecord(types.DNSRecordTypes.CAA, "@", `0 issue "letsencrypt.org"`, 300),
+		)
+	})
+	require.NoError(t, err)
+	require.Len(t, updated.Records, 3)
+	require.Equal(t, 300, updated.Records[0].TTL)
+
+	updated, err = sacloud.UpdateDNSRecords(ctx, client, dns.ID, func(records *sacloud.DNSRecords) {
+		records.Replace("www", types.DNSRecordTypes.A,
+			sacloud.NewDNSRecord(types.DNSRecordTypes.A, "www", "192.0.2.2", 300),
+			sacloud.NewDNSRecord(types.DNSRecordTypes.A, "www", "192.0.2.3", 300),
+		)
+		records.Delete(sacloud.NewDNSRecord(types.DNSRecordTypes.CAA, "@", `0 issue "letsencrypt.org"`, 0))
+	})
+	require.NoError(t, err)
+	require.Len(t, updated.Records, 3)
+	require.Nil(t, sacloud.DNSRecords(updated.Records).Find("www", types.DNSRecordTypes.A, "192.0.2.1"))
+
+	// 不正なレコード
+	invalidRecords := []*sacloud.DNSRecord{
+		sacloud.NewDNSRecord(types.DNSRecordTypes.A, "www", "2001:db8::1", 300),
+		sacloud.NewDNSRecord(types.DNSRecordTypes.CNAME, "www", "example.com", 300),
+		sacloud.NewDNSRecord(types.DNSRecordTypes.CNAME, "@", "example.com.", 300),
+		sacloud.NewDNSRecord(types.DNSRecordTypes.MX, "@", "mail.example.com.", 300),
+		sacloud.NewDNSRecord(types.DNSRecordTypes.SRV, "_sip._tcp", "10 20 70000 sip.example.com.", 300),
+	}
+	for _, record := range invalidRecords {
+		_, err := client.Update(ctx, sacloud.DefaultZone, dns.ID, &sacloud.DNSUpdateRequest{
+			Records: []*sacloud.DNSRecord{record},
+		})
+		require.True(t, sacloud.IsBadRequestError(err), "%s: %s", record.RData, err)
+	}
+
+	require.NoError(t, client.Delete(ctx, sacloud.DefaultZone, dns.ID))
+}
+
+func TestServer_SimpleMonitor(t *testing.T) {
+	ctx := context.Background()
+	client := sacloud.NewSimpleMonitorOp(testCaller)
+
+	monitor, err := client.Create(ctx, sacloud.DefaultZone, &sacloud.SimpleMonitorCreateRequest{
+		Target:  "libsacloud-v2-fake-server.usacloud.jp",
+		Enabled: types.StringTrue,
+		HealthCheck: &sacloud.SimpleMonitorHealthCheck{
+			Protocol:    types.SimpleMonitorProtocols.SNMP,
+			Community:   "public",
+			SNMPVersion: "2c",
+			OID:         "1.3.6.1.2.1.1.3.0",
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 60, monitor.DelayLoop)
+
+	health, err := client.HealthStatus(ctx, sacloud.DefaultZone, monitor.ID)
+	require.NoError(t, err)
+	require.Equal(t, types.SimpleMonitorHealth.Up, health.Health)
+	require.False(t, health.LastCheckedAt.IsZero())
+
+	activity, err := client.MonitorResponseTime(ctx, sacloud.DefaultZone, monitor.ID, &sacloud.MonitorCondition{})
+	require.NoError(t, err)
+	require.NotEmpty(t, activity.Values)
+
+	// 到達不能な監視対象はDOWN
+	unreachable, err := client.Create(ctx, sacloud.DefaultZone, &sacloud.SimpleMonitorCreateRequest{
+		Target:  "203.0.113.1",
+		Enabled: types.StringTrue,
+		HealthCheck: &sacloud.SimpleMonitorHealthCheck{
+			Protocol: types.SimpleMonitorProtocols.Ping,
+		},
+	})
+	require.NoError(t, err)
+	down, err := client.HealthStatus(ctx, sacloud.DefaultZone, unreachable.ID)
+	require.NoError(t, err)
+	require.Equal(t, types.SimpleMonitorHealth.Down, down.Health)
+
+	// テスト用フックで監視結果を切り替えられる
+	fake.SetSimpleMonitorHealth(unreachable.ID, types.SimpleMonitorHealth.Up)
+	up, err := client.HealthStatus(ctx, sacloud.DefaultZone, unreachable.ID)
+	require.NoError(t, err)
+	require.Equal(t, types.SimpleMonitorHealth.Up, up.Health)
+	require.True(t, up.LastHealthChangedAt.Equal(up.LastCheckedAt))
+
+	history := fake.SimpleMonitorHealthHistory(unreachable.ID)
+	require.Len(t, history, 2)
+	require.Equal(t, types.SimpleMonitorHealth.Down, history[0].Health)
+	require.Equal(t, types.SimpleMonitorHealth.Up, history[1].Health)
+
+	require.NoError(t, client.Delete(ctx, sacloud.DefaultZone, unreachable.ID))
+	require.Empty(t, fake.SimpleMonitorHealthHistory(unreachable.ID))
+
+	// 必須項目の不足
+	_, err = client.Create(ctx, sacloud.DefaultZone, &sacloud.SimpleMonitorCreateRequest{
+		Target: "libsacloud-v2-fake-server.usacloud.jp",
+		HealthCheck: &sacloud.SimpleMonitorHealthCheck{
+			Protocol: types.SimpleMonitorProtocols.DNS,
+		},
+	})
+	require.True(t, sacloud.IsBadRequestError(err), "%s", err)
+
+	// 範囲外の有効残日数
+	_, err = client.Create(ctx, sacloud.DefaultZone, &sacloud.SimpleMonitorCreateRequest{
+		Target: "libsacloud-v2-fake-server.usacloud.jp",
+		HealthCheck: &sacloud.SimpleMonitorHealthCheck{
+			Protocol: types.SimpleMonitorProtocols.SSLCertificate,
+		},
+	})
+	require.True(t, sacloud.IsBadRequestError(err), "%s", err)
+
+	// 未知のプロトコル
+	_, err = client.Create(ctx, sacloud.DefaultZone, &sacloud.SimpleMonitorCreateRequest{
+		Target: "libsacloud-v2-fake-server.usacloud.jp",
+		HealthCheck: &sacloud.SimpleMonitorHealthCheck{
+			Protocol: types.ESimpleMonitorProtocol("unknown"),
+		},
+	})
+	require.True(t, sacloud.IsBadRequestError(err), "%s", err)
+
+	require.NoError(t, client.Delete(ctx, sacloud.DefaultZone, monitor.ID))
+}
+
+func TestServer_AutoBackup(t *testing.T) {
+	ctx := context.Background()
+
+	diskOp := sacloud.NewDiskOp(testCaller)
+	disk, err := diskOp.Create(ctx, testZone, &sacloud.DiskCreateRequest{
+		Name:       "libsacloud-v2-fake-server-auto-backup",
+		DiskPlanID: types.ID(4),
+		SizeMB:     20 * 1024,
+	})
+	require.NoError(t, err)
+
+	client := sacloud.NewAutoBackupOp(testCaller)
+	autoBackup, err := client.Create(ctx, testZone, &sacloud.AutoBackupCreateRequest{
+		Name:   "libsacloud-v2-fake-server-auto-backup",
+		DiskID: disk.ID,
+		BackupSpanWeekdays: []types.EBackupSpanWeekday{
+			types.BackupSpanWeekdays.Sunday,
+			types.BackupSpanWeekdays.Monday,
+			types.BackupSpanWeekdays.Tuesday,
+			types.BackupSpanWeekdays.Wednesday,
+			types.BackupSpanWeekdays.Thursday,
+			types.BackupSpanWeekdays.Friday,
+			types.BackupSpanWeekdays.Saturday,
+		},
+		MaximumNumberOfArchives: 2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.BackupSpanTypes.Weekdays, autoBackup.BackupSpanType)
+	require.Equal(t, disk.ID, autoBackup.DiskID)
+
+	// 同一ディスクに対する自動バックアップは1つまで
+	_, err = client.Create(ctx, testZone, &sacloud.AutoBackupCreateRequest{
+		Name:                    "libsacloud-v2-fake-server-auto-backup-dup",
+		DiskID:                  disk.ID,
+		BackupSpanWeekdays:      []types.EBackupSpanWeekday{types.BackupSpanWeekdays.Monday},
+		MaximumNumberOfArchives: 1,
+	})
+	require.True(t, sacloud.IsConflictError(err), "%s", err)
+
+	// 保存世代数は1以上
+	_, err = client.Update(ctx, testZone, autoBackup.ID, &sacloud.AutoBackupUpdateRequest{
+		BackupSpanWeekdays:      autoBackup.BackupSpanWeekdays,
+		MaximumNumberOfArchives: 0,
+	})
+	require.True(t, sacloud.IsBadRequestError(err), "%s", err)
+
+	archiveOp := sacloud.NewArchiveOp(testCaller)
+	backups := func() []*sacloud.Archive {
+		archives, err := archiveOp.Find(ctx, testZone, &sacloud.FindCondition{})
+		require.NoError(t, err)
+
+		var results []*sacloud.Archive
+		for _, archive := range archives {
+			if archive.SourceDiskID == disk.ID {
+				results = append(results, archive)
+			}
+		}
+		return results
+	}
+
+	// スケジュールに沿ってアーカイブが作成され、保存世代数を超えたものは削除される
+	deadline := time.Now().Add(5 * time.Second)
+	for len(backups()) < 2 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Len(t, backups(), 2)
+	time.Sleep(100 * time.Millisecond)
+	require.Len(t, backups(), 2)
+
+	require.NoError(t, client.Delete(ctx, testZone, autoBackup.ID))
+
+	// 削除後はアーカイブが作成されない
+	backupIDs := func() []types.ID {
+		var ids []types.ID
+		for _, archive := range backups() {
+			ids = append(ids, archive.ID)
+		}
+		return ids
+	}
+	created := backupIDs()
+	time.Sleep(100 * time.Millisecond)
+	require.ElementsMatch(t, created, backupIDs())
+
+	require.NoError(t, diskOp.Delete(ctx, testZone, disk.ID))
+}
+
+func TestServer_ProxyLB(t *testing.T) {
+	ctx := context.Background()
+	client := sacloud.NewProxyLBOp(testCaller)
+
+	proxyLB, err := client.Create(ctx, sacloud.DefaultZone, &sacloud.ProxyLBCreateRequest{
+		Name: "libsacloud-v2-fake-server-proxy-lb",
+		HealthCheck: &sacloud.ProxyLBHealthCheck{
+			Protocol:  types.ProxyLBHealthCheckProtocols.TCP,
+			DelayLoop: 10,
+		},
+		BindPorts: []*sacloud.ProxyLBBindPort{
+			{ProxyMode: types.ProxyLBProxyModes.HTTPS, Port: 443},
+		},
+		Servers: []*sacloud.ProxyLBServer{
+			{IPAddress: "192.0.2.21", Port: 80, Enabled: true},
+			{IPAddress: "192.0.2.22", Port: 80, Enabled: false},
+		},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, proxyLB.FQDN)
+	require.NotEmpty(t, proxyLB.VirtualIPAddress)
+
+	// health status
+	health, err := client.HealthStatus(ctx, sacloud.DefaultZone, proxyLB.ID)
+	require.NoError(t, err)
+	require.Equal(t, proxyLB.VirtualIPAddress, health.CurrentVIP)
+	require.Len(t, health.Servers, 2)
+	require.Equal(t, "UP", health.Servers[0].Status)
+	require.Equal(t, "DOWN", health.Servers[1].Status)
+
+	// monitor
+	activity, err := client.MonitorConnection(ctx, sacloud.DefaultZone, proxyLB.ID, &sacloud.MonitorCondition{})
+	require.NoError(t, err)
+	require.NotEmpty(t, activity.Values)
+
+	// certificates
+	now := time.Now()
+	serverCert, privateKey := testProxyLBCertificate(t, "www.usacloud.jp", now.Add(30*24*time.Hour))
+	_, anotherKey := testProxyLBCertificate(t, "www.usacloud.jp", now.Add(30*24*time.Hour))
+
+	_, err = client.SetCertificates(ctx, sacloud.DefaultZone, proxyLB.ID, &sacloud.ProxyLBSetCertificatesRequest{
+		PrimaryCert: &sacloud.ProxyLBPrimaryCert{
+			ServerCertificate: serverCert,
+			PrivateKey:        anotherKey,
+		},
+	})
+	require.True(t, sacloud.IsBadRequestError(err), "%s", err)
+
+	certs, err := client.SetCertificates(ctx, sacloud.DefaultZone, proxyLB.ID, &sacloud.ProxyLBSetCertificatesRequest{
+		PrimaryCert: &sacloud.ProxyLBPrimaryCert{
+			ServerCertificate: serverCert,
+			PrivateKey:        privateKey,
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, certs.PrimaryCert)
+	require.Equal(t, "www.usacloud.jp", certs.PrimaryCert.CertificateCommonName)
+	require.WithinDuration(t, now.Add(30*24*time.Hour), certs.PrimaryCert.CertificateEndDate, time.Second)
+
+	// 有効期限切れ
+	defer func() { fake.Now = time.Now }()
+	fake.Now = func() time.Time { return now.Add(31 * 24 * time.Hour) }
+
+	certs, err = client.GetCertificates(ctx, sacloud.DefaultZone, proxyLB.ID)
+	require.NoError(t, err)
+	require.Nil(t, certs.PrimaryCert)
+
+	// Let's Encrypt
+	err = client.RenewLetsEncryptCert(ctx, sacloud.DefaultZone, proxyLB.ID)
+	require.True(t, sacloud.IsBadRequestError(err), "%s", err)
+
+	proxyLB, err = client.Update(ctx, sacloud.DefaultZone, proxyLB.ID, &sacloud.ProxyLBUpdateRequest{
+		Name:        proxyLB.Name,
+		HealthCheck: proxyLB.HealthCheck,
+		BindPorts:   proxyLB.BindPorts,
+		Servers:     proxyLB.Servers,
+		LetsEncrypt: &sacloud.ProxyLBLetsEncrypt{
+			CommonName: "le.usacloud.jp",
+			Enabled:    true,
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, client.RenewLetsEncryptCert(ctx, sacloud.DefaultZone, proxyLB.ID))
+
+	certs, err = client.GetCertificates(ctx, sacloud.DefaultZone, proxyLB.ID)
+	require.NoError(t, err)
+	require.NotNil(t, certs.PrimaryCert)
+	require.Equal(t, "le.usacloud.jp", certs.PrimaryCert.CertificateCommonName)
+
+	require.NoError(t, client.DeleteCertificates(ctx, sacloud.DefaultZone, proxyLB.ID))
+	certs, err = client.GetCertificates(ctx, sacloud.DefaultZone, proxyLB.ID)
+	require.NoError(t, err)
+	require.Nil(t, certs.PrimaryCert)
+
+	require.NoError(t, client.Delete(ctx, sacloud.DefaultZone, proxyLB.ID))
+}
+
+func testProxyLBCertificate(t *testing.T, commonName string, notAfter time.Time) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    notAfter.Add(-90 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(cert), string(privateKey)
+}
+
+func TestServer_ServerConsole(t *testing.T) {
+	ctx := context.Background()
+	client := sacloud.NewServerOp(testCaller)
+
+	server, err := client.Create(ctx, testZone, &sacloud.ServerCreateRequest{
+		Name:     "libsacloud-v2-fake-server-console",
+		CPU:      1,
+		MemoryMB: 1 * 1024,
+	})
+	require.NoError(t, err)
+
+	// 停止中
+	_, err = client.GetScreenshot(ctx, testZone, server.ID, &sacloud.GetScreenshotRequest{})
+	require.True(t, sacloud.IsConflictError(err), "%s", err)
+
+	require.NoError(t, client.Boot(ctx, testZone, server.ID))
+	for i := 0; i < 100; i++ {
+		server, err = client.Read(ctx, testZone, server.ID)
+		require.NoError(t, err)
+		if server.InstanceStatus.IsUp() {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.True(t, server.InstanceStatus.IsUp())
+
+	// エンベロープのトップレベルに展開された項目が読み取れること
+	vncProxy, err := client.GetVNCProxy(ctx, testZone, server.ID)
+	require.NoError(t, err)
+	require.Equal(t, "OK", vncProxy.Status)
+	require.NotEmpty(t, vncProxy.Host)
+	require.NotZero(t, vncProxy.Port.Int())
+	require.True(t, strings.Contains(vncProxy.VNCFile, vncProxy.Password))
+
+	screenshot, err := client.GetScreenshot(ctx, testZone, server.ID, &sacloud.GetScreenshotRequest{ScreenCaptureFormat: "png"})
+	require.NoError(t, err)
+	data, err := base64.StdEncoding.DecodeString(screenshot.Image)
+	require.NoError(t, err)
+	_, err = png.Decode(strings.NewReader(string(data)))
+	require.NoError(t, err)
+
+	_, err = client.GetScreenshot(ctx, testZone, server.ID, &sacloud.GetScreenshotRequest{ScreenCaptureFormat: "gif"})
+	require.True(t, sacloud.IsBadRequestError(err), "%s", err)
+
+	require.NoError(t, client.SendKey(ctx, testZone, server.ID, &sacloud.SendKeyRequest{Key: "ctrl-alt-delete"}))
+	require.Equal(t, []*sacloud.SendKeyRequest{{Key: "ctrl-alt-delete"}}, fake.SentKeys(testZone, server.ID))
+
+	err = client.SendKey(ctx, testZone, server.ID, &sacloud.SendKeyRequest{})
+	require.True(t, sacloud.IsBadRequestError(err), "%s", err)
+
+	require.NoError(t, client.SendNMI(ctx, testZone, server.ID))
+
+	// プラン変更でIDが変わっても送信済みキーは引き継がれる
+	require.NoError(t, client.Shutdown(ctx, testZone, server.ID, &sacloud.ShutdownOption{Force: true}))
+	for i := 0; i < 100; i++ {
+		server, err = client.Read(ctx, testZone, server.ID)
+		require.NoError(t, err)
+		if server.InstanceStatus.IsDown() {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.True(t, server.InstanceStatus.IsDown())
+
+	changed, err := client.ChangePlan(ctx, testZone, server.ID, &sacloud.ServerChangePlanRequest{CPU: 2, MemoryMB: 4 * 1024})
+	require.NoError(t, err)
+	require.NotEqual(t, server.ID, changed.ID)
+	require.Equal(t, []*sacloud.SendKeyRequest{{Key: "ctrl-alt-delete"}}, fake.SentKeys(testZone, changed.ID))
+	require.Empty(t, fake.SentKeys(testZone, server.ID))
+}
+
+func TestServer_ArchiveShareAndTransfer(t *testing.T) {
+	ctx := context.Background()
+	client := sacloud.NewArchiveOp(testCaller)
+
+	archives, err := client.Find(ctx, testZone, nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, archives)
+
+	archive, err := client.Create(ctx, testZone, &sacloud.ArchiveCreateRequest{
+		SourceArchiveID: archives[0].ID,
+		Name:            "libsacloud-v2-fake-server-archive",
+	})
+	require.NoError(t, err)
+
+	// コピー中は共有できない
+	_, err = client.Share(ctx, testZone, archive.ID, &sacloud.ArchiveShareRequest{Shared: true})
+	require.True(t, sacloud.IsConflictError(err), "%s", err)
+
+	for i := 0; i < 100; i++ {
+		archive, err = client.Read(ctx, testZone, archive.ID)
+		require.NoError(t, err)
+		if archive.Availability.IsAvailable() {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.True(t, archive.Availability.IsAvailable())
+
+	shareInfo, err := client.Share(ctx, testZone, archive.ID, &sacloud.ArchiveShareRequest{Shared: true})
+	require.NoError(t, err)
+	require.True(t, shareInfo.SharedKey.ValidFormat())
+
+	// 同じパスのTransfer/CreateFromSharedがリクエストボディで振り分けられること
+	destZoneID := types.ID(31002) // is1b
+	fromShared, err := client.CreateFromShared(ctx, testZone, archive.ID, destZoneID, &sacloud.ArchiveCreateFromSharedRequest{
+		Name:            "libsacloud-v2-fake-server-archive-from-shared",
+		SourceSharedKey: shareInfo.SharedKey,
+	})
+	require.NoError(t, err)
+	require.Equal(t, archive.ID, fromShared.SourceInfo.ID)
+
+	_, err = client.CreateFromShared(ctx, testZone, archive.ID, destZoneID, &sacloud.ArchiveCreateFromSharedRequest{
+		Name:            "libsacloud-v2-fake-server-archive-from-shared",
+		SourceSharedKey: types.NewArchiveShareKey(testZone, archive.ID, "invalid-token"),
+	})
+	require.True(t, sacloud.IsBadRequestError(err), "%s", err)
+
+	transferred, err := client.Transfer(ctx, testZone, archive.ID, destZoneID, &sacloud.ArchiveTransferRequest{
+		Name: "libsacloud-v2-fake-server-archive-transfer",
+	})
+	require.NoError(t, err)
+	require.Equal(t, testZone, transferred.SourceInfo.ZoneName)
+
+	// 転送先ゾーンでコピーが完了すること
+	for i := 0; i < 100; i++ {
+		transferred, err = client.Read(ctx, "is1b", transferred.ID)
+		require.NoError(t, err)
+		if transferred.Availability.IsAvailable() {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.True(t, transferred.Availability.IsAvailable())
+
+	_, err = client.Transfer(ctx, testZone, archive.ID, types.ID(1), &sacloud.ArchiveTransferRequest{})
+	require.True(t, sacloud.IsBadRequestError(err), "%s", err)
+
+	// 同じパスのOpenFTP/Shareがリクエストボディで振り分けられること
+	ftpServer, err := client.OpenFTP(ctx, testZone, archive.ID, &sacloud.OpenFTPRequest{ChangePassword: true})
+	require.NoError(t, err)
+	require.NotEmpty(t, ftpServer.HostName)
+
+	shareInfo, err = client.Share(ctx, testZone, archive.ID, &sacloud.ArchiveShareRequest{Shared: false})
+	require.NoError(t, err)
+	require.Empty(t, shareInfo.SharedKey)
+}
+
+func TestServer_PrivateHost(t *testing.T) {
+	ctx := context.Background()
+	hostOp := sacloud.NewPrivateHostOp(testCaller)
+	serverOp := sacloud.NewServerOp(testCaller)
+
+	plans, err := sacloud.NewPrivateHostPlanOp(testCaller).Find(ctx, testZone, &sacloud.FindCondition{})
+	require.NoError(t, err)
+	require.NotEmpty(t, plans)
+
+	host, err := hostOp.Create(ctx, testZone, &sacloud.PrivateHostCreateRequest{
+		PlanID: plans[0].ID,
+		Name:   "libsacloud-v2-fake-private-host",
+	})
+	require.NoError(t, err)
+	require.Equal(t, plans[0].CPU, host.CPU)
+	require.Equal(t, plans[0].MemoryMB, host.MemoryMB)
+	require.NotEmpty(t, host.HostName)
+
+	server, err := serverOp.Create(ctx, testZone, &sacloud.ServerCreateRequest{
+		Name:          "libsacloud-v2-fake-server-on-private-host",
+		CPU:           32,
+		MemoryMB:      192 * 1024,
+		PrivateHostID: host.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, host.ID, server.PrivateHostID)
+
+	host, err = hostOp.Read(ctx, testZone, host.ID)
+	require.NoError(t, err)
+	require.Equal(t, 32, host.AssignedCPU)
+	require.Equal(t, 192*1024, host.AssignedMemoryMB)
+
+	// 容量超過
+	_, err = serverOp.Create(ctx, testZone, &sacloud.ServerCreateRequest{
+		Name:          "libsacloud-v2-fake-server-on-private-host",
+		CPU:           32,
+		MemoryMB:      64 * 1024,
+		PrivateHostID: host.ID,
+	})
+	require.True(t, sacloud.IsConflictError(err), "%s", err)
+
+	require.NoError(t, serverOp.Delete(ctx, testZone, server.ID))
+	require.NoError(t, hostOp.Delete(ctx, testZone, host.ID))
+
+	// License
+	licenseOp := sacloud.NewLicenseOp(testCaller)
+	license, err := licenseOp.Create(ctx, sacloud.DefaultZone, &sacloud.LicenseCreateRequest{
+		LicenseInfoID: types.ID(10002),
+		Name:          "libsacloud-v2-fake-license",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Office SAL", license.LicenseInfoName)
+	require.NoError(t, licenseOp.Delete(ctx, sacloud.DefaultZone, license.ID))
+}
+
+func TestServer_Billing(t *testing.T) {
+	ctx := context.Background()
+
+	// エンベロープのトップレベルに展開された項目が読み取れること
+	authStatus, err := sacloud.NewAuthStatusOp(testCaller).Read(ctx, sacloud.DefaultZone)
+	require.NoError(t, err)
+	require.NotEmpty(t, authStatus.AccountID)
+	require.NotEmpty(t, authStatus.MemberCode)
+	require.True(t, authStatus.Permission.Includes(types.Permissions.View))
+	require.True(t, authStatus.ExternalPermission.PermittedBill())
+
+	billOp := sacloud.NewBillOp(testCaller)
+	bills, err := billOp.ByContract(ctx, sacloud.DefaultZone, authStatus.AccountID)
+	require.NoError(t, err)
+	require.Len(t, bills, 24)
+
+	bills, err = billOp.ByContractYear(ctx, sacloud.DefaultZone, authStatus.AccountID, 2019)
+	require.NoError(t, err)
+	require.Len(t, bills, 12)
+
+	bills, err = billOp.ByContractYearMonth(ctx, sacloud.DefaultZone, authStatus.AccountID, 2019, 12)
+	require.NoError(t, err)
+	require.Len(t, bills, 1)
+	bill := bills[0]
+	require.False(t, bill.Paid)
+
+	// 同じ請求情報に対しては常に同じ値を返すこと
+	again, err := billOp.Read(ctx, sacloud.DefaultZone, bill.ID)
+	require.NoError(t, err)
+	require.Len(t, again, 1)
+	require.Equal(t, bill.Amount, again[0].Amount)
+	require.True(t, bill.Date.Equal(again[0].Date))
+
+	_, err = billOp.ByContract(ctx, sacloud.DefaultZone, types.ID(1))
+	require.True(t, sacloud.IsNotFoundError(err), "%s", err)
+
+	details, err := billOp.Details(ctx, sacloud.DefaultZone, authStatus.MemberCode, bill.ID)
+	require.NoError(t, err)
+	require.Len(t, details, 3)
+	var total int64
+	for _, d := range details {
+		total += d.Amount
+	}
+	require.Equal(t, bill.Amount, total)
+
+	detailCSV, err := billOp.DetailsCSV(ctx, sacloud.DefaultZone, authStatus.MemberCode, bill.ID)
+	require.NoError(t, err)
+	require.Equal(t, len(details), detailCSV.Count)
+	csvAgain, err := billOp.DetailsCSV(ctx, sacloud.DefaultZone, authStatus.MemberCode, bill.ID)
+	require.NoError(t, err)
+	require.True(t, detailCSV.ResponsedAt.Equal(csvAgain.ResponsedAt))
+	require.True(t, detailCSV.ResponsedAt.Equal(bill.Date.AddDate(0, 1, 0)))
+	body, err := detailCSV.BodyRows()
+	require.NoError(t, err)
+	require.Len(t, body, len(details))
+
+	coupons, err := sacloud.NewCouponOp(testCaller).Find(ctx, sacloud.DefaultZone, authStatus.AccountID)
+	require.NoError(t, err)
+	require.NotEmpty(t, coupons)
+
+	esUsageOp := sacloud.NewESUsageOp(testCaller)
+	usages, err := esUsageOp.Find(ctx, sacloud.DefaultZone, authStatus.AccountID, 2019, 12)
+	require.NoError(t, err)
+	require.Len(t, usages, len(details))
+	total = 0
+	for _, u := range usages {
+		require.True(t, u.UsageDate.Equal(bill.Date))
+		total += u.Amount
+	}
+	require.Equal(t, bill.Amount, total)
+
+	// 同じ年月に対しては常に同じ値を返すこと
+	usagesAgain, err := esUsageOp.Find(ctx, sacloud.DefaultZone, authStatus.AccountID, 2019, 12)
+	require.NoError(t, err)
+	require.Equal(t, len(usages), len(usagesAgain))
+	for i := range usages {
+		require.Equal(t, usages[i].ID, usagesAgain[i].ID)
+		require.Equal(t, usages[i].Amount, usagesAgain[i].Amount)
+	}
+
+	_, err = esUsageOp.Find(ctx, sacloud.DefaultZone, types.ID(1), 2019, 12)
+	require.True(t, sacloud.IsNotFoundError(err), "%s", err)
+}
@@ -0,0 +1,7898 @@
+// generated by 'github.com/sacloud/libsacloud/internal/tools/gen-api-fake-server'; DO NOT EDIT
+
+package server
+
+import (
+	"context"
+
+	"github.com/sacloud/libsacloud-v2/pkg/mapconv"
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/fake"
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+var routes = []*route{
+	newRoute("Archive", "Find", "GET", "api/cloud/1.1", "archive", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleArchiveFind),
+	newRoute("Archive", "Create", "POST", "api/cloud/1.1", "archive", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Archive.SourceDisk.ID", "Archive.SourceArchive.ID", "Archive.Name", "Archive.Description", "Archive.Tags", "Archive.Icon.ID"}, handleArchiveCreate),
+	newRoute("Archive", "CreateBlank", "POST", "api/cloud/1.1", "archive", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Archive.SizeMB", "Archive.Name", "Archive.Description", "Archive.Tags", "Archive.Icon.ID"}, handleArchiveCreateBlank),
+	newRoute("Archive", "Transfer", "POST", "api/cloud/1.1", "archive", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/zone/{{.destZoneID}}", []string{"Archive.Name", "Archive.Description", "Archive.Tags", "Archive.Icon.ID"}, handleArchiveTransfer),
+	newRoute("Archive", "CreateFromShared", "POST", "api/cloud/1.1", "archive", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/zone/{{.destZoneID}}", []string{"Archive.Name", "Archive.Description", "Archive.Tags", "Archive.Icon.ID", "Archive.SourceSharedKey"}, handleArchiveCreateFromShared),
+	newRoute("Archive", "Read", "GET", "api/cloud/1.1", "archive", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleArchiveRead),
+	newRoute("Archive", "Update", "PUT", "api/cloud/1.1", "archive", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"Archive.Name", "Archive.Description", "Archive.Tags", "Archive.Icon.ID"}, handleArchiveUpdate),
+	newRoute("Archive", "Delete", "DELETE", "api/cloud/1.1", "archive", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleArchiveDelete),
+	newRoute("Archive", "OpenFTP", "PUT", "api/cloud/1.1", "archive", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/ftp", []string{"ChangePassword"}, handleArchiveOpenFTP),
+	newRoute("Archive", "CloseFTP", "DELETE", "api/cloud/1.1", "archive", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/ftp", []string(nil), handleArchiveCloseFTP),
+	newRoute("Archive", "Share", "PUT", "api/cloud/1.1", "archive", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/ftp", []string{"Shared"}, handleArchiveShare),
+	newRoute("AuthStatus", "Read", "GET", "api/cloud/1.1", "auth-status", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string(nil), handleAuthStatusRead),
+	newRoute("AutoBackup", "Find", "GET", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleAutoBackupFind),
+	newRoute("AutoBackup", "Create", "POST", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"CommonServiceItem.Provider.Class", "CommonServiceItem.Status.DiskId", "CommonServiceItem.Settings.Autobackup.BackupSpanType", "CommonServiceItem.Settings.Autobackup.BackupSpanWeekdays", "CommonServiceItem.Settings.Autobackup.MaximumNumberOfArchives", "CommonServiceItem.Name", "CommonServiceItem.Description", "CommonServiceItem.Tags", "CommonServiceItem.Icon.ID"}, handleAutoBackupCreate),
+	newRoute("AutoBackup", "Read", "GET", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleAutoBackupRead),
+	newRoute("AutoBackup", "Update", "PUT", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"CommonServiceItem.Settings.Autobackup.BackupSpanType", "CommonServiceItem.Settings.Autobackup.BackupSpanWeekdays", "CommonServiceItem.Settings.Autobackup.MaximumNumberOfArchives", "CommonServiceItem.Name", "CommonServiceItem.Description", "CommonServiceItem.Tags", "CommonServiceItem.Icon.ID"}, handleAutoBackupUpdate),
+	newRoute("AutoBackup", "Delete", "DELETE", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleAutoBackupDelete),
+	newRoute("Bill", "ByContract", "GET", "api/system/1.0", "bill", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/by-contract/{{.accountID}}", []string(nil), handleBillByContract),
+	newRoute("Bill", "ByContractYear", "GET", "api/system/1.0", "bill", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/by-contract/{{.accountID}}/{{.year}}", []string(nil), handleBillByContractYear),
+	newRoute("Bill", "ByContractYearMonth", "GET", "api/system/1.0", "bill", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/by-contract/{{.accountID}}/{{.year}}/{{.month}}", []string(nil), handleBillByContractYearMonth),
+	newRoute("Bill", "Read", "GET", "api/system/1.0", "bill", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/id/{{.id}}", []string(nil), handleBillRead),
+	newRoute("Bill", "Details", "GET", "api/system/1.0", "bill", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/billdetail/{{.memberCode}}/{{.id}}", []string(nil), handleBillDetails),
+	newRoute("Bill", "DetailsCSV", "GET", "api/system/1.0", "bill", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/billdetail/{{.memberCode}}/{{.id}}/csv", []string(nil), handleBillDetailsCSV),
+	newRoute("Bridge", "Find", "GET", "api/cloud/1.1", "bridge", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleBridgeFind),
+	newRoute("Bridge", "Create", "POST", "api/cloud/1.1", "bridge", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Bridge.Name", "Bridge.Description"}, handleBridgeCreate),
+	newRoute("Bridge", "Read", "GET", "api/cloud/1.1", "bridge", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleBridgeRead),
+	newRoute("Bridge", "Update", "PUT", "api/cloud/1.1", "bridge", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"Bridge.Name", "Bridge.Description"}, handleBridgeUpdate),
+	newRoute("Bridge", "Delete", "DELETE", "api/cloud/1.1", "bridge", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleBridgeDelete),
+	newRoute("CDROM", "Find", "GET", "api/cloud/1.1", "cdrom", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleCDROMFind),
+	newRoute("CDROM", "Create", "POST", "api/cloud/1.1", "cdrom", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"CDROM.SizeMB", "CDROM.Name", "CDROM.Description", "CDROM.Tags", "CDROM.Icon.ID"}, handleCDROMCreate),
+	newRoute("CDROM", "Read", "GET", "api/cloud/1.1", "cdrom", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleCDROMRead),
+	newRoute("CDROM", "Update", "PUT", "api/cloud/1.1", "cdrom", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"CDROM.Name", "CDROM.Description", "CDROM.Tags", "CDROM.Icon.ID"}, handleCDROMUpdate),
+	newRoute("CDROM", "Delete", "DELETE", "api/cloud/1.1", "cdrom", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleCDROMDelete),
+	newRoute("CDROM", "OpenFTP", "PUT", "api/cloud/1.1", "cdrom", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/ftp", []string{"ChangePassword"}, handleCDROMOpenFTP),
+	newRoute("CDROM", "CloseFTP", "DELETE", "api/cloud/1.1", "cdrom", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/ftp", []string(nil), handleCDROMCloseFTP),
+	newRoute("Coupon", "Find", "GET", "api/system/1.0", "coupon", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.accountID}}", []string(nil), handleCouponFind),
+	newRoute("Database", "Find", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleDatabaseFind),
+	newRoute("Database", "Create", "POST", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Appliance.Class", "Appliance.Remark.Plan.ID", "Appliance.Plan.ID", "Appliance.Remark.Switch.ID", "Appliance.Remark.Servers.IPAddress", "Appliance.Remark.Network.NetworkMaskLen", "Appliance.Remark.Network.DefaultRoute", "Appliance.Remark.DBConf.Common", "Appliance.Name", "Appliance.Description", "Appliance.Tags", "Appliance.Icon.ID", "Appliance.Settings.DBConf.Common", "Appliance.Settings.DBConf.Backup", "Appliance.Settings.DBConf.Replication"}, handleDatabaseCreate),
+	newRoute("Database", "Read", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleDatabaseRead),
+	newRoute("Database", "Update", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"Appliance.Name", "Appliance.Description", "Appliance.Tags", "Appliance.Icon.ID", "Appliance.Settings.DBConf.Common", "Appliance.Settings.DBConf.Backup", "Appliance.Settings.DBConf.Replication"}, handleDatabaseUpdate),
+	newRoute("Database", "Delete", "DELETE", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleDatabaseDelete),
+	newRoute("Database", "Config", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/config", []string(nil), handleDatabaseConfig),
+	newRoute("Database", "Boot", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", []string(nil), handleDatabaseBoot),
+	newRoute("Database", "Shutdown", "DELETE", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", []string{"Force"}, handleDatabaseShutdown),
+	newRoute("Database", "Reset", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/reset", []string(nil), handleDatabaseReset),
+	newRoute("Database", "MonitorCPU", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/cpu/monitor", []string{"Start", "End"}, handleDatabaseMonitorCPU),
+	newRoute("Database", "MonitorDisk", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/disk/0/monitor", []string{"Start", "End"}, handleDatabaseMonitorDisk),
+	newRoute("Database", "MonitorInterface", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/interface/monitor", []string{"Start", "End"}, handleDatabaseMonitorInterface),
+	newRoute("Database", "MonitorDatabase", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/database/monitor", []string{"Start", "End"}, handleDatabaseMonitorDatabase),
+	newRoute("Database", "Status", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/status", []string(nil), handleDatabaseStatus),
+	newRoute("Database", "GetParameter", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/database/parameter", []string(nil), handleDatabaseGetParameter),
+	newRoute("Database", "SetParameter", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/database/parameter", []string{"Parameter.Attr"}, handleDatabaseSetParameter),
+	newRoute("Disk", "Find", "GET", "api/cloud/1.1", "disk", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleDiskFind),
+	newRoute("Disk", "Create", "POST", "api/cloud/1.1", "disk", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Disk.Plan.ID", "Disk.Connection", "Disk.SourceDisk.ID", "Disk.SourceArchive.ID", "Disk.Server.ID", "Disk.SizeMB", "Disk.Name", "Disk.Description", "Disk.Tags", "Disk.Icon.ID"}, handleDiskCreate),
+	newRoute("Disk", "CreateDistantly", "POST", "api/cloud/1.1", "disk", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Disk.DiskPlanID", "Disk.Connection", "Disk.SourceDiskID", "Disk.SourceArchiveID", "Disk.ServerID", "Disk.SizeMB", "Disk.Name", "Disk.Description", "Disk.Tags", "Disk.IconID", "DistantFrom"}, handleDiskCreateDistantly),
+	newRoute("Disk", "Config", "PUT", "api/cloud/1.1", "disk", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/config", []string{"Password", "SSHKey", "SSHKeys", "DisablePWAuth", "EnableDHCP", "ChangePartitionUUID", "HostName", "Notes", "UserIPAddress", "UserSubnet"}, handleDiskConfig),
+	newRoute("Disk", "CreateWithConfig", "POST", "api/cloud/1.1", "disk", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Disk.DiskPlanID", "Disk.Connection", "Disk.SourceDiskID", "Disk.SourceArchiveID", "Disk.ServerID", "Disk.SizeMB", "Disk.Name", "Disk.Description", "Disk.Tags", "Disk.IconID", "Config.Password", "Config.SSHKey", "Config.SSHKeys", "Config.DisablePWAuth", "Config.EnableDHCP", "Config.ChangePartitionUUID", "Config.HostName", "Config.Notes", "Config.UserIPAddress", "Config.UserSubnet", "BootAtAvailable"}, handleDiskCreateWithConfig),
+	newRoute("Disk", "CreateWithConfigDistantly", "POST", "api/cloud/1.1", "disk", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Disk.DiskPlanID", "Disk.Connection", "Disk.SourceDiskID", "Disk.SourceArchiveID", "Disk.ServerID", "Disk.SizeMB", "Disk.Name", "Disk.Description", "Disk.Tags", "Disk.IconID", "Config.Password", "Config.SSHKey", "Config.SSHKeys", "Config.DisablePWAuth", "Config.EnableDHCP", "Config.ChangePartitionUUID", "Config.HostName", "Config.Notes", "Config.UserIPAddress", "Config.UserSubnet", "BootAtAvailable", "DistantFrom"}, handleDiskCreateWithConfigDistantly),
+	newRoute("Disk", "ToBlank", "PUT", "api/cloud/1.1", "disk", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/blank", []string(nil), handleDiskToBlank),
+	newRoute("Disk", "ResizePartition", "PUT", "api/cloud/1.1", "disk", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/resize-partition", []string(nil), handleDiskResizePartition),
+	newRoute("Disk", "ConnectToServer", "PUT", "api/cloud/1.1", "disk", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/server/{{.serverID}}", []string(nil), handleDiskConnectToServer),
+	newRoute("Disk", "DisconnectFromServer", "DELETE", "api/cloud/1.1", "disk", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/server", []string(nil), handleDiskDisconnectFromServer),
+	newRoute("Disk", "InstallDistantFrom", "PUT", "api/cloud/1.1", "disk", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/install", []string{"Disk.SourceDiskID", "Disk.SourceArchiveID", "Disk.SizeMB", "DistantFrom"}, handleDiskInstallDistantFrom),
+	newRoute("Disk", "Install", "PUT", "api/cloud/1.1", "disk", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/install", []string{"Disk.SourceDiskID", "Disk.SourceArchiveID", "Disk.SizeMB"}, handleDiskInstall),
+	newRoute("Disk", "Read", "GET", "api/cloud/1.1", "disk", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleDiskRead),
+	newRoute("Disk", "Update", "PUT", "api/cloud/1.1", "disk", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"Disk.Name", "Disk.Description", "Disk.Tags", "Disk.Icon.ID", "Disk.Connection"}, handleDiskUpdate),
+	newRoute("Disk", "Delete", "DELETE", "api/cloud/1.1", "disk", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleDiskDelete),
+	newRoute("Disk", "Monitor", "GET", "api/cloud/1.1", "disk", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/monitor", []string{"Start", "End"}, handleDiskMonitor),
+	newRoute("DiskPlan", "Find", "GET", "api/cloud/1.1", "product/disk", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleDiskPlanFind),
+	newRoute("DiskPlan", "Read", "GET", "api/cloud/1.1", "product/disk", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleDiskPlanRead),
+	newRoute("DNS", "Find", "GET", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleDNSFind),
+	newRoute("DNS", "Create", "POST", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"CommonServiceItem.Provider.Class", "CommonServiceItem.Name", "CommonServiceItem.Status.Zone", "CommonServiceItem.Settings.DNS.ResourceRecordSets", "CommonServiceItem.Description", "CommonServiceItem.Tags", "CommonServiceItem.Icon.ID"}, handleDNSCreate),
+	newRoute("DNS", "Read", "GET", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleDNSRead),
+	newRoute("DNS", "Update", "PUT", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"CommonServiceItem.Settings.DNS.ResourceRecordSets", "CommonServiceItem.Description", "CommonServiceItem.Tags", "CommonServiceItem.Icon.ID"}, handleDNSUpdate),
+	newRoute("DNS", "Delete", "DELETE", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleDNSDelete),
+	newRoute("ESUsage", "Find", "GET", "api/system/1.0", "esusage", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.accountID}}/{{.year}}/{{.month}}", []string(nil), handleESUsageFind),
+	newRoute("GSLB", "Find", "GET", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleGSLBFind),
+	newRoute("GSLB", "Create", "POST", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"CommonServiceItem.Provider.Class", "CommonServiceItem.Settings.GSLB.HealthCheck.Protocol", "CommonServiceItem.Settings.GSLB.HealthCheck.Host", "CommonServiceItem.Settings.GSLB.HealthCheck.Path", "CommonServiceItem.Settings.GSLB.HealthCheck.Status", "CommonServiceItem.Settings.GSLB.HealthCheck.Port", "CommonServiceItem.Settings.GSLB.DelayLoop", "CommonServiceItem.Settings.GSLB.Weighted", "CommonServiceItem.Settings.GSLB.SorryServer", "CommonServiceItem.Settings.GSLB.Servers", "CommonServiceItem.Name", "CommonServiceItem.Description", "CommonServiceItem.Tags", "CommonServiceItem.Icon.ID"}, handleGSLBCreate),
+	newRoute("GSLB", "Read", "GET", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleGSLBRead),
+	newRoute("GSLB", "Update", "PUT", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"CommonServiceItem.Settings.GSLB.HealthCheck.Protocol", "CommonServiceItem.Settings.GSLB.HealthCheck.Host", "CommonServiceItem.Settings.GSLB.HealthCheck.Path", "CommonServiceItem.Settings.GSLB.HealthCheck.Status", "CommonServiceItem.Settings.GSLB.HealthCheck.Port", "CommonServiceItem.Settings.GSLB.DelayLoop", "CommonServiceItem.Settings.GSLB.Weighted", "CommonServiceItem.Settings.GSLB.SorryServer", "CommonServiceItem.Settings.GSLB.Servers", "CommonServiceItem.Name", "CommonServiceItem.Description", "CommonServiceItem.Tags", "CommonServiceItem.Icon.ID"}, handleGSLBUpdate),
+	newRoute("GSLB", "Delete", "DELETE", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleGSLBDelete),
+	newRoute("Icon", "Find", "GET", "api/cloud/1.1", "icon", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleIconFind),
+	newRoute("Icon", "Create", "POST", "api/cloud/1.1", "icon", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Icon.Name", "Icon.Tags", "Icon.Image"}, handleIconCreate),
+	newRoute("Icon", "Read", "GET", "api/cloud/1.1", "icon", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleIconRead),
+	newRoute("Icon", "Update", "PUT", "api/cloud/1.1", "icon", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"Icon.Name", "Icon.Tags"}, handleIconUpdate),
+	newRoute("Icon", "Delete", "DELETE", "api/cloud/1.1", "icon", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleIconDelete),
+	newRoute("Icon", "GetImage", "GET", "api/cloud/1.1", "icon", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"Size"}, handleIconGetImage),
+	newRoute("Interface", "Find", "GET", "api/cloud/1.1", "interface", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleInterfaceFind),
+	newRoute("Interface", "Create", "POST", "api/cloud/1.1", "interface", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Interface.Server.ID"}, handleInterfaceCreate),
+	newRoute("Interface", "Read", "GET", "api/cloud/1.1", "interface", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleInterfaceRead),
+	newRoute("Interface", "Update", "PUT", "api/cloud/1.1", "interface", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"Interface.UserIPAddress"}, handleInterfaceUpdate),
+	newRoute("Interface", "Delete", "DELETE", "api/cloud/1.1", "interface", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleInterfaceDelete),
+	newRoute("Interface", "Monitor", "GET", "api/cloud/1.1", "interface", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/monitor", []string{"Start", "End"}, handleInterfaceMonitor),
+	newRoute("Interface", "ConnectToSharedSegment", "PUT", "api/cloud/1.1", "interface", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/switch/shared", []string(nil), handleInterfaceConnectToSharedSegment),
+	newRoute("Interface", "ConnectToSwitch", "PUT", "api/cloud/1.1", "interface", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/switch/{{.switchID}}", []string(nil), handleInterfaceConnectToSwitch),
+	newRoute("Interface", "DisconnectFromSwitch", "DELETE", "api/cloud/1.1", "interface", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/switch", []string(nil), handleInterfaceDisconnectFromSwitch),
+	newRoute("Interface", "ConnectToPacketFilter", "PUT", "api/cloud/1.1", "interface", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/packetfilter/{{.packetFilterID}}", []string(nil), handleInterfaceConnectToPacketFilter),
+	newRoute("Interface", "DisconnectFromPacketFilter", "DELETE", "api/cloud/1.1", "interface", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/packetfilter", []string(nil), handleInterfaceDisconnectFromPacketFilter),
+	newRoute("Internet", "Find", "GET", "api/cloud/1.1", "internet", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleInternetFind),
+	newRoute("Internet", "Create", "POST", "api/cloud/1.1", "internet", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Internet.Name", "Internet.Description", "Internet.Tags", "Internet.Icon.ID", "Internet.NetworkMaskLen", "Internet.BandWidthMbps"}, handleInternetCreate),
+	newRoute("Internet", "Read", "GET", "api/cloud/1.1", "internet", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleInternetRead),
+	newRoute("Internet", "Update", "PUT", "api/cloud/1.1", "internet", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"Internet.Name", "Internet.Description", "Internet.Tags", "Internet.Icon.ID"}, handleInternetUpdate),
+	newRoute("Internet", "Delete", "DELETE", "api/cloud/1.1", "internet", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleInternetDelete),
+	newRoute("Internet", "UpdateBandWidth", "PUT", "api/cloud/1.1", "internet", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/bandwidth", []string{"Internet.BandWidthMbps"}, handleInternetUpdateBandWidth),
+	newRoute("Internet", "AddSubnet", "POST", "api/cloud/1.1", "internet", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/subnet", []string{"NetworkMaskLen", "NextHop"}, handleInternetAddSubnet),
+	newRoute("Internet", "UpdateSubnet", "PUT", "api/cloud/1.1", "internet", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/subnet/{{.subnetID}}", []string{"NextHop"}, handleInternetUpdateSubnet),
+	newRoute("Internet", "DeleteSubnet", "DELETE", "api/cloud/1.1", "internet", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/subnet/{{.subnetID}}", []string(nil), handleInternetDeleteSubnet),
+	newRoute("Internet", "Monitor", "GET", "api/cloud/1.1", "internet", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/monitor", []string{"Start", "End"}, handleInternetMonitor),
+	newRoute("Internet", "EnableIPv6", "POST", "api/cloud/1.1", "internet", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/ipv6net", []string(nil), handleInternetEnableIPv6),
+	newRoute("Internet", "DisableIPv6", "DELETE", "api/cloud/1.1", "internet", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/ipv6net/{{.ipv6netID}}", []string(nil), handleInternetDisableIPv6),
+	newRoute("InternetPlan", "Find", "GET", "api/cloud/1.1", "product/internet", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleInternetPlanFind),
+	newRoute("InternetPlan", "Read", "GET", "api/cloud/1.1", "product/internet", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleInternetPlanRead),
+	newRoute("IPAddress", "Find", "GET", "api/cloud/1.1", "ipaddress", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleIPAddressFind),
+	newRoute("IPAddress", "Read", "GET", "api/cloud/1.1", "ipaddress", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.ipAddress}}", []string(nil), handleIPAddressRead),
+	newRoute("IPAddress", "UpdateHostName", "PUT", "api/cloud/1.1", "ipaddress", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.ipAddress}}", []string{"IPAddress.HostName"}, handleIPAddressUpdateHostName),
+	newRoute("IPv6Addr", "Find", "GET", "api/cloud/1.1", "ipv6addr", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleIPv6AddrFind),
+	newRoute("IPv6Addr", "Create", "POST", "api/cloud/1.1", "ipv6addr", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"IPv6Addr.IPv6Addr", "IPv6Addr.HostName"}, handleIPv6AddrCreate),
+	newRoute("IPv6Addr", "Read", "GET", "api/cloud/1.1", "ipv6addr", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.ipv6addr}}", []string(nil), handleIPv6AddrRead),
+	newRoute("IPv6Addr", "Update", "PUT", "api/cloud/1.1", "ipv6addr", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.ipv6addr}}", []string{"IPv6Addr.HostName"}, handleIPv6AddrUpdate),
+	newRoute("IPv6Addr", "Delete", "DELETE", "api/cloud/1.1", "ipv6addr", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.ipv6addr}}", []string(nil), handleIPv6AddrDelete),
+	newRoute("IPv6Net", "Find", "GET", "api/cloud/1.1", "ipv6net", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleIPv6NetFind),
+	newRoute("IPv6Net", "Read", "GET", "api/cloud/1.1", "ipv6net", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleIPv6NetRead),
+	newRoute("License", "Find", "GET", "api/cloud/1.1", "license", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleLicenseFind),
+	newRoute("License", "Create", "POST", "api/cloud/1.1", "license", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"License.LicenseInfo.ID", "License.Name"}, handleLicenseCreate),
+	newRoute("License", "Read", "GET", "api/cloud/1.1", "license", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleLicenseRead),
+	newRoute("License", "Update", "PUT", "api/cloud/1.1", "license", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"License.Name"}, handleLicenseUpdate),
+	newRoute("License", "Delete", "DELETE", "api/cloud/1.1", "license", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleLicenseDelete),
+	newRoute("LicensePlan", "Find", "GET", "api/cloud/1.1", "product/license", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleLicensePlanFind),
+	newRoute("LicensePlan", "Read", "GET", "api/cloud/1.1", "product/license", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleLicensePlanRead),
+	newRoute("LoadBalancer", "Find", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleLoadBalancerFind),
+	newRoute("LoadBalancer", "Create", "POST", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Appliance.Class", "Appliance.Remark.Switch.ID", "Appliance.Remark.Plan.ID", "Appliance.Plan.ID", "Appliance.Remark.VRRP.VRID", "Appliance.Remark.Servers.IPAddress", "Appliance.Remark.Network.NetworkMaskLen", "Appliance.Remark.Network.DefaultRoute", "Appliance.Name", "Appliance.Description", "Appliance.Tags", "Appliance.Icon.ID", "Appliance.Settings.LoadBalancer"}, handleLoadBalancerCreate),
+	newRoute("LoadBalancer", "Read", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleLoadBalancerRead),
+	newRoute("LoadBalancer", "Update", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"Appliance.Name", "Appliance.Description", "Appliance.Tags", "Appliance.Icon.ID", "Appliance.Settings.LoadBalancer"}, handleLoadBalancerUpdate),
+	newRoute("LoadBalancer", "Delete", "DELETE", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleLoadBalancerDelete),
+	newRoute("LoadBalancer", "Config", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/config", []string(nil), handleLoadBalancerConfig),
+	newRoute("LoadBalancer", "Boot", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", []string(nil), handleLoadBalancerBoot),
+	newRoute("LoadBalancer", "Shutdown", "DELETE", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", []string{"Force"}, handleLoadBalancerShutdown),
+	newRoute("LoadBalancer", "Reset", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/reset", []string(nil), handleLoadBalancerReset),
+	newRoute("LoadBalancer", "MonitorInterface", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/interface/monitor", []string{"Start", "End"}, handleLoadBalancerMonitorInterface),
+	newRoute("LoadBalancer", "Status", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/status", []string(nil), handleLoadBalancerStatus),
+	newRoute("MobileGateway", "Find", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleMobileGatewayFind),
+	newRoute("MobileGateway", "Create", "POST", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Appliance.Class", "Appliance.Name", "Appliance.Description", "Appliance.Tags", "Appliance.Icon.ID", "Appliance.Plan.ID", "Appliance.Remark.Switch.Scope", "Appliance.Settings"}, handleMobileGatewayCreate),
+	newRoute("MobileGateway", "Read", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleMobileGatewayRead),
+	newRoute("MobileGateway", "Update", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"Appliance.Name", "Appliance.Description", "Appliance.Tags", "Appliance.Icon.ID", "Appliance.Settings"}, handleMobileGatewayUpdate),
+	newRoute("MobileGateway", "Delete", "DELETE", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleMobileGatewayDelete),
+	newRoute("MobileGateway", "Config", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/config", []string(nil), handleMobileGatewayConfig),
+	newRoute("MobileGateway", "Boot", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", []string(nil), handleMobileGatewayBoot),
+	newRoute("MobileGateway", "Shutdown", "DELETE", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", []string{"Force"}, handleMobileGatewayShutdown),
+	newRoute("MobileGateway", "Reset", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/reset", []string(nil), handleMobileGatewayReset),
+	newRoute("MobileGateway", "ConnectToSwitch", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/interface/1/to/switch/{{.switchID}}", []string(nil), handleMobileGatewayConnectToSwitch),
+	newRoute("MobileGateway", "DisconnectFromSwitch", "DELETE", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/interface/1/to/switch", []string(nil), handleMobileGatewayDisconnectFromSwitch),
+	newRoute("MobileGateway", "GetDNS", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/dnsresolver", []string(nil), handleMobileGatewayGetDNS),
+	newRoute("MobileGateway", "SetDNS", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/dnsresolver", []string{"sim_group.dns_1", "sim_group.dns_2"}, handleMobileGatewaySetDNS),
+	newRoute("MobileGateway", "ListSIM", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/sims", []string(nil), handleMobileGatewayListSIM),
+	newRoute("MobileGateway", "AddSIM", "POST", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/sims", []string{"sim.resource_id"}, handleMobileGatewayAddSIM),
+	newRoute("MobileGateway", "DeleteSIM", "DELETE", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/sims/{{.simID}}", []string(nil), handleMobileGatewayDeleteSIM),
+	newRoute("MobileGateway", "GetSIMRoutes", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/simroutes", []string(nil), handleMobileGatewayGetSIMRoutes),
+	newRoute("MobileGateway", "SetSIMRoutes", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/simroutes", []string{"sim_routes.resource_id", "sim_routes.prefix", "sim_routes.iccid"}, handleMobileGatewaySetSIMRoutes),
+	newRoute("MobileGateway", "GetTrafficConfig", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/traffic_monitoring", []string(nil), handleMobileGatewayGetTrafficConfig),
+	newRoute("MobileGateway", "SetTrafficConfig", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/traffic_monitoring", []string{"traffic_monitoring_config.traffic_quota_in_mb", "traffic_monitoring_config.bandwidth_limit_in_kbps", "traffic_monitoring_config.email_config.enabled", "traffic_monitoring_config.slack_config.enabled", "traffic_monitoring_config.slack_config.slack_url", "traffic_monitoring_config.auto_traffic_shaping"}, handleMobileGatewaySetTrafficConfig),
+	newRoute("MobileGateway", "DeleteTrafficConfig", "DELETE", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/traffic_monitoring", []string(nil), handleMobileGatewayDeleteTrafficConfig),
+	newRoute("MobileGateway", "TrafficStatus", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/traffic_status", []string(nil), handleMobileGatewayTrafficStatus),
+	newRoute("MobileGateway", "MonitorInterface", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/interface/{{if eq .index 0}}{{.index}}{{end}}/monitor", []string{"Start", "End"}, handleMobileGatewayMonitorInterface),
+	newRoute("NFS", "Find", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleNFSFind),
+	newRoute("NFS", "Create", "POST", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Appliance.Class", "Appliance.Remark.Switch.ID", "Appliance.Remark.Plan.ID", "Appliance.Plan.ID", "Appliance.Remark.Servers.IPAddress", "Appliance.Remark.Network.NetworkMaskLen", "Appliance.Remark.Network.DefaultRoute", "Appliance.Name", "Appliance.Description", "Appliance.Tags", "Appliance.Icon.ID"}, handleNFSCreate),
+	newRoute("NFS", "Read", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleNFSRead),
+	newRoute("NFS", "Update", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"Appliance.Name", "Appliance.Description", "Appliance.Tags", "Appliance.Icon.ID"}, handleNFSUpdate),
+	newRoute("NFS", "Delete", "DELETE", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleNFSDelete),
+	newRoute("NFS", "Boot", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", []string(nil), handleNFSBoot),
+	newRoute("NFS", "Shutdown", "DELETE", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", []string{"Force"}, handleNFSShutdown),
+	newRoute("NFS", "Reset", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/reset", []string(nil), handleNFSReset),
+	newRoute("NFS", "MonitorFreeDiskSize", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/database/monitor", []string{"Start", "End"}, handleNFSMonitorFreeDiskSize),
+	newRoute("NFS", "MonitorInterface", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/interface/monitor", []string{"Start", "End"}, handleNFSMonitorInterface),
+	newRoute("Note", "Find", "GET", "api/cloud/1.1", "note", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleNoteFind),
+	newRoute("Note", "Create", "POST", "api/cloud/1.1", "note", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Note.Name", "Note.Tags", "Note.Icon.ID", "Note.Class", "Note.Content"}, handleNoteCreate),
+	newRoute("Note", "Read", "GET", "api/cloud/1.1", "note", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleNoteRead),
+	newRoute("Note", "Update", "PUT", "api/cloud/1.1", "note", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"Note.Name", "Note.Tags", "Note.Icon.ID", "Note.Class", "Note.Content"}, handleNoteUpdate),
+	newRoute("Note", "Delete", "DELETE", "api/cloud/1.1", "note", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleNoteDelete),
+	newRoute("PacketFilter", "Find", "GET", "api/cloud/1.1", "packetfilter", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handlePacketFilterFind),
+	newRoute("PacketFilter", "Create", "POST", "api/cloud/1.1", "packetfilter", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"PacketFilter.Name", "PacketFilter.Description", "PacketFilter.Expression"}, handlePacketFilterCreate),
+	newRoute("PacketFilter", "Read", "GET", "api/cloud/1.1", "packetfilter", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handlePacketFilterRead),
+	newRoute("PacketFilter", "Update", "PUT", "api/cloud/1.1", "packetfilter", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"PacketFilter.Name", "PacketFilter.Description", "PacketFilter.Expression"}, handlePacketFilterUpdate),
+	newRoute("PacketFilter", "Delete", "DELETE", "api/cloud/1.1", "packetfilter", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handlePacketFilterDelete),
+	newRoute("PrivateHost", "Find", "GET", "api/cloud/1.1", "privatehost", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handlePrivateHostFind),
+	newRoute("PrivateHost", "Create", "POST", "api/cloud/1.1", "privatehost", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"PrivateHost.Plan.ID", "PrivateHost.Name", "PrivateHost.Description", "PrivateHost.Tags", "PrivateHost.Icon.ID"}, handlePrivateHostCreate),
+	newRoute("PrivateHost", "Read", "GET", "api/cloud/1.1", "privatehost", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handlePrivateHostRead),
+	newRoute("PrivateHost", "Update", "PUT", "api/cloud/1.1", "privatehost", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"PrivateHost.Name", "PrivateHost.Description", "PrivateHost.Tags", "PrivateHost.Icon.ID"}, handlePrivateHostUpdate),
+	newRoute("PrivateHost", "Delete", "DELETE", "api/cloud/1.1", "privatehost", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handlePrivateHostDelete),
+	newRoute("PrivateHostPlan", "Find", "GET", "api/cloud/1.1", "product/privatehost", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handlePrivateHostPlanFind),
+	newRoute("PrivateHostPlan", "Read", "GET", "api/cloud/1.1", "product/privatehost", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handlePrivateHostPlanRead),
+	newRoute("ProxyLB", "Find", "GET", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleProxyLBFind),
+	newRoute("ProxyLB", "Create", "POST", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"CommonServiceItem.Provider.Class", "CommonServiceItem.Settings.ProxyLB.HealthCheck", "CommonServiceItem.Settings.ProxyLB.SorryServer", "CommonServiceItem.Settings.ProxyLB.BindPorts", "CommonServiceItem.Settings.ProxyLB.Servers", "CommonServiceItem.Settings.ProxyLB.LetsEncrypt", "CommonServiceItem.Settings.ProxyLB.StickySession", "CommonServiceItem.Settings.ProxyLB.Timeout", "CommonServiceItem.Status.UseVIPFailover", "CommonServiceItem.Status.Region", "CommonServiceItem.Name", "CommonServiceItem.Description", "CommonServiceItem.Tags", "CommonServiceItem.Icon.ID"}, handleProxyLBCreate),
+	newRoute("ProxyLB", "Read", "GET", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleProxyLBRead),
+	newRoute("ProxyLB", "Update", "PUT", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"CommonServiceItem.Settings.ProxyLB.HealthCheck", "CommonServiceItem.Settings.ProxyLB.SorryServer", "CommonServiceItem.Settings.ProxyLB.BindPorts", "CommonServiceItem.Settings.ProxyLB.Servers", "CommonServiceItem.Settings.ProxyLB.LetsEncrypt", "CommonServiceItem.Settings.ProxyLB.StickySession", "CommonServiceItem.Settings.ProxyLB.Timeout", "CommonServiceItem.Name", "CommonServiceItem.Description", "CommonServiceItem.Tags", "CommonServiceItem.Icon.ID"}, handleProxyLBUpdate),
+	newRoute("ProxyLB", "Delete", "DELETE", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleProxyLBDelete),
+	newRoute("ProxyLB", "GetCertificates", "GET", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/proxylb/sslcertificate", []string(nil), handleProxyLBGetCertificates),
+	newRoute("ProxyLB", "SetCertificates", "PUT", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/proxylb/sslcertificate", []string{"ProxyLB.PrimaryCert", "ProxyLB.AdditionalCerts"}, handleProxyLBSetCertificates),
+	newRoute("ProxyLB", "DeleteCertificates", "DELETE", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/proxylb/sslcertificate", []string(nil), handleProxyLBDeleteCertificates),
+	newRoute("ProxyLB", "RenewLetsEncryptCert", "PUT", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/proxylb/letsencryptstrategy", []string(nil), handleProxyLBRenewLetsEncryptCert),
+	newRoute("ProxyLB", "HealthStatus", "GET", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/health", []string(nil), handleProxyLBHealthStatus),
+	newRoute("ProxyLB", "MonitorConnection", "GET", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/activity/proxylb/monitor", []string{"Start", "End"}, handleProxyLBMonitorConnection),
+	newRoute("Region", "Find", "GET", "api/cloud/1.1", "region", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleRegionFind),
+	newRoute("Region", "Read", "GET", "api/cloud/1.1", "region", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleRegionRead),
+	newRoute("Server", "Find", "GET", "api/cloud/1.1", "server", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleServerFind),
+	newRoute("Server", "Create", "POST", "api/cloud/1.1", "server", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Server.ServerPlan.CPU", "Server.ServerPlan.MemoryMB", "Server.ServerPlan.Commitment", "Server.ServerPlan.Generation", "Server.ConnectedSwitches", "Server.InterfaceDriver", "Server.HostName", "Server.Name", "Server.Description", "Server.Tags", "Server.Icon.ID", "Server.PrivateHost.ID", "Server.WaitDiskMigration"}, handleServerCreate),
+	newRoute("Server", "Read", "GET", "api/cloud/1.1", "server", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleServerRead),
+	newRoute("Server", "Update", "PUT", "api/cloud/1.1", "server", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"Server.Name", "Server.Description", "Server.Tags", "Server.Icon.ID"}, handleServerUpdate),
+	newRoute("Server", "Delete", "DELETE", "api/cloud/1.1", "server", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleServerDelete),
+	newRoute("Server", "ChangePlan", "PUT", "api/cloud/1.1", "server", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/plan", []string{"CPU", "MemoryMB", "Generation", "Commitment"}, handleServerChangePlan),
+	newRoute("Server", "InsertCDROM", "PUT", "api/cloud/1.1", "server", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/cdrom", []string{"CDROM.ID"}, handleServerInsertCDROM),
+	newRoute("Server", "EjectCDROM", "DELETE", "api/cloud/1.1", "server", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/cdrom", []string{"CDROM.ID"}, handleServerEjectCDROM),
+	newRoute("Server", "Boot", "PUT", "api/cloud/1.1", "server", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", []string(nil), handleServerBoot),
+	newRoute("Server", "Shutdown", "DELETE", "api/cloud/1.1", "server", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", []string{"Force"}, handleServerShutdown),
+	newRoute("Server", "Reset", "PUT", "api/cloud/1.1", "server", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/reset", []string(nil), handleServerReset),
+	newRoute("Server", "Monitor", "GET", "api/cloud/1.1", "server", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/monitor", []string{"Start", "End"}, handleServerMonitor),
+	newRoute("Server", "GetVNCProxy", "GET", "api/cloud/1.1", "server", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/vnc/proxy", []string(nil), handleServerGetVNCProxy),
+	newRoute("Server", "SendKey", "PUT", "api/cloud/1.1", "server", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/keyboard", []string{"Key", "Keys"}, handleServerSendKey),
+	newRoute("Server", "SendNMI", "PUT", "api/cloud/1.1", "server", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/qemu/nmi", []string(nil), handleServerSendNMI),
+	newRoute("Server", "GetScreenshot", "GET", "api/cloud/1.1", "server", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/vnc/snapshot", []string{"ScreenCaptureFormat"}, handleServerGetScreenshot),
+	newRoute("ServerPlan", "Find", "GET", "api/cloud/1.1", "product/server", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleServerPlanFind),
+	newRoute("ServerPlan", "Read", "GET", "api/cloud/1.1", "product/server", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleServerPlanRead),
+	newRoute("ServiceClass", "Find", "GET", "api/cloud/1.1", "public/price", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleServiceClassFind),
+	newRoute("SIM", "Find", "GET", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleSIMFind),
+	newRoute("SIM", "Create", "POST", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"CommonServiceItem.Name", "CommonServiceItem.Description", "CommonServiceItem.Tags", "CommonServiceItem.Icon.ID", "CommonServiceItem.Provider.Class", "CommonServiceItem.Status.ICCID", "CommonServiceItem.Remark.PassCode"}, handleSIMCreate),
+	newRoute("SIM", "Read", "GET", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleSIMRead),
+	newRoute("SIM", "Update", "PUT", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"CommonServiceItem.Name", "CommonServiceItem.Description", "CommonServiceItem.Tags", "CommonServiceItem.Icon.ID"}, handleSIMUpdate),
+	newRoute("SIM", "Delete", "DELETE", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleSIMDelete),
+	newRoute("SIM", "Activate", "PUT", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/activate", []string(nil), handleSIMActivate),
+	newRoute("SIM", "Deactivate", "PUT", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/deactivate", []string(nil), handleSIMDeactivate),
+	newRoute("SIM", "AssignIP", "PUT", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/ip", []string{"SIM.IP"}, handleSIMAssignIP),
+	newRoute("SIM", "ClearIP", "DELETE", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/ip", []string(nil), handleSIMClearIP),
+	newRoute("SIM", "IMEILock", "PUT", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/imeilock", []string{"SIM.IMEI"}, handleSIMIMEILock),
+	newRoute("SIM", "IMEIUnlock", "DELETE", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/imeilock", []string(nil), handleSIMIMEIUnlock),
+	newRoute("SIM", "Logs", "GET", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/sessionlog", []string(nil), handleSIMLogs),
+	newRoute("SIM", "GetNetworkOperator", "GET", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/network_operator_config", []string(nil), handleSIMGetNetworkOperator),
+	newRoute("SIM", "SetNetworkOperator", "PUT", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/network_operator_config", []string{"NetworkOperatorConfigs"}, handleSIMSetNetworkOperator),
+	newRoute("SIM", "MonitorSIM", "GET", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/metrics/monitor", []string{"Start", "End"}, handleSIMMonitorSIM),
+	newRoute("SimpleMonitor", "Find", "GET", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleSimpleMonitorFind),
+	newRoute("SimpleMonitor", "Create", "POST", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"CommonServiceItem.Provider.Class", "CommonServiceItem.Name", "CommonServiceItem.Status.Target", "CommonServiceItem.Settings.SimpleMonitor.DelayLoop", "CommonServiceItem.Settings.SimpleMonitor.Enabled", "CommonServiceItem.Settings.SimpleMonitor.HealthCheck", "CommonServiceItem.Settings.SimpleMonitor.NotifyEmail.Enabled", "CommonServiceItem.Settings.SimpleMonitor.NotifyEmail.HTML", "CommonServiceItem.Settings.SimpleMonitor.NotifySlack.Enabled", "CommonServiceItem.Settings.SimpleMonitor.NotifySlack.IncomingWebhooksURL", "CommonServiceItem.Settings.SimpleMonitor.NotifyInterval", "CommonServiceItem.Description", "CommonServiceItem.Tags", "CommonServiceItem.Icon.ID"}, handleSimpleMonitorCreate),
+	newRoute("SimpleMonitor", "Read", "GET", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleSimpleMonitorRead),
+	newRoute("SimpleMonitor", "Update", "PUT", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"CommonServiceItem.Settings.SimpleMonitor.DelayLoop", "CommonServiceItem.Settings.SimpleMonitor.Enabled", "CommonServiceItem.Settings.SimpleMonitor.HealthCheck", "CommonServiceItem.Settings.SimpleMonitor.NotifyEmail.Enabled", "CommonServiceItem.Settings.SimpleMonitor.NotifyEmail.HTML", "CommonServiceItem.Settings.SimpleMonitor.NotifySlack.Enabled", "CommonServiceItem.Settings.SimpleMonitor.NotifySlack.IncomingWebhooksURL", "CommonServiceItem.Settings.SimpleMonitor.NotifyInterval", "CommonServiceItem.Description", "CommonServiceItem.Tags", "CommonServiceItem.Icon.ID"}, handleSimpleMonitorUpdate),
+	newRoute("SimpleMonitor", "Delete", "DELETE", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleSimpleMonitorDelete),
+	newRoute("SimpleMonitor", "MonitorResponseTime", "GET", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/activity/responsetimesec/monitor", []string{"Start", "End"}, handleSimpleMonitorMonitorResponseTime),
+	newRoute("SimpleMonitor", "HealthStatus", "GET", "api/cloud/1.1", "commonserviceitem", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/health", []string(nil), handleSimpleMonitorHealthStatus),
+	newRoute("SSHKey", "Find", "GET", "api/cloud/1.1", "sshkey", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleSSHKeyFind),
+	newRoute("SSHKey", "Create", "POST", "api/cloud/1.1", "sshkey", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"SSHKey.Name", "SSHKey.Description", "SSHKey.PublicKey"}, handleSSHKeyCreate),
+	newRoute("SSHKey", "Generate", "POST", "api/cloud/1.1", "sshkey", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/generate", []string{"KeyPair.Name", "KeyPair.Description", "KeyPair.GenerateFormat", "KeyPair.PassPhrase"}, handleSSHKeyGenerate),
+	newRoute("SSHKey", "Read", "GET", "api/cloud/1.1", "sshkey", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleSSHKeyRead),
+	newRoute("SSHKey", "Update", "PUT", "api/cloud/1.1", "sshkey", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"SSHKey.Name", "SSHKey.Description"}, handleSSHKeyUpdate),
+	newRoute("SSHKey", "Delete", "DELETE", "api/cloud/1.1", "sshkey", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleSSHKeyDelete),
+	newRoute("Storage", "Find", "GET", "api/cloud/1.1", "storage", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleStorageFind),
+	newRoute("Storage", "Read", "GET", "api/cloud/1.1", "storage", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleStorageRead),
+	newRoute("Subnet", "Find", "GET", "api/cloud/1.1", "subnet", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleSubnetFind),
+	newRoute("Subnet", "Read", "GET", "api/cloud/1.1", "subnet", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleSubnetRead),
+	newRoute("Switch", "Find", "GET", "api/cloud/1.1", "switch", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleSwitchFind),
+	newRoute("Switch", "Create", "POST", "api/cloud/1.1", "switch", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Switch.Name", "Switch.UserSubnet.NetworkMaskLen", "Switch.UserSubnet.DefaultRoute", "Switch.Description", "Switch.Tags", "Switch.Icon.ID"}, handleSwitchCreate),
+	newRoute("Switch", "Read", "GET", "api/cloud/1.1", "switch", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleSwitchRead),
+	newRoute("Switch", "Update", "PUT", "api/cloud/1.1", "switch", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"Switch.Name", "Switch.UserSubnet.NetworkMaskLen", "Switch.UserSubnet.DefaultRoute", "Switch.Description", "Switch.Tags", "Switch.Icon.ID"}, handleSwitchUpdate),
+	newRoute("Switch", "Delete", "DELETE", "api/cloud/1.1", "switch", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleSwitchDelete),
+	newRoute("Switch", "ConnectToBridge", "PUT", "api/cloud/1.1", "switch", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/bridge/{{.bridgeID}}", []string(nil), handleSwitchConnectToBridge),
+	newRoute("Switch", "DisconnectFromBridge", "DELETE", "api/cloud/1.1", "switch", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/bridge/", []string(nil), handleSwitchDisconnectFromBridge),
+	newRoute("VPCRouter", "Find", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleVPCRouterFind),
+	newRoute("VPCRouter", "Create", "POST", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Appliance.Class", "Appliance.Name", "Appliance.Description", "Appliance.Tags", "Appliance.Icon.ID", "Appliance.Plan.ID", "Appliance.Remark.Switch", "Appliance.Remark.Servers.IPAddress", "Appliance.Settings"}, handleVPCRouterCreate),
+	newRoute("VPCRouter", "Read", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleVPCRouterRead),
+	newRoute("VPCRouter", "Update", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string{"Appliance.Name", "Appliance.Description", "Appliance.Tags", "Appliance.Icon.ID", "Appliance.Settings"}, handleVPCRouterUpdate),
+	newRoute("VPCRouter", "Delete", "DELETE", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleVPCRouterDelete),
+	newRoute("VPCRouter", "Config", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/config", []string(nil), handleVPCRouterConfig),
+	newRoute("VPCRouter", "Boot", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", []string(nil), handleVPCRouterBoot),
+	newRoute("VPCRouter", "Shutdown", "DELETE", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", []string{"Force"}, handleVPCRouterShutdown),
+	newRoute("VPCRouter", "Reset", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/reset", []string(nil), handleVPCRouterReset),
+	newRoute("VPCRouter", "ConnectToSwitch", "PUT", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/interface/{{.nicIndex}}/to/switch/{{.switchID}}", []string(nil), handleVPCRouterConnectToSwitch),
+	newRoute("VPCRouter", "DisconnectFromSwitch", "DELETE", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/interface/{{.nicIndex}}/to/switch", []string(nil), handleVPCRouterDisconnectFromSwitch),
+	newRoute("VPCRouter", "MonitorInterface", "GET", "api/cloud/1.1", "appliance", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/interface/{{if eq .index 0}}{{.index}}{{end}}/monitor", []string{"Start", "End"}, handleVPCRouterMonitorInterface),
+	newRoute("Zone", "Find", "GET", "api/cloud/1.1", "zone", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", []string{"Count", "From", "Sort", "Filter", "Include", "Exclude"}, handleZoneFind),
+	newRoute("Zone", "Read", "GET", "api/cloud/1.1", "zone", "{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", []string(nil), handleZoneRead),
+}
+
+/*************************************************
+* Archive
+*************************************************/
+
+// handleArchiveFind handles ArchiveAPI.Find
+func handleArchiveFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewArchiveOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.Archive
+	for _, v := range result0 {
+		payload := &naked.Archive{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Archives"] = payload0
+	return envelope, nil
+}
+
+// handleArchiveCreate handles ArchiveAPI.Create
+func handleArchiveCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.ArchiveCreateRequest `mapconv:"Archive,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.ArchiveCreateRequest{}
+	}
+
+	result0, err := fake.NewArchiveOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Archive{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Archive"] = payload0
+	return envelope, nil
+}
+
+// handleArchiveCreateBlank handles ArchiveAPI.CreateBlank
+func handleArchiveCreateBlank(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.ArchiveCreateBlankRequest `mapconv:"Archive,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.ArchiveCreateBlankRequest{}
+	}
+
+	result0, result1, err := fake.NewArchiveOp().CreateBlank(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Archive{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Archive"] = payload0
+	payload1 := &naked.OpeningFTPServer{}
+	if err := mapconv.ConvertTo(result1, payload1); err != nil {
+		return nil, err
+	}
+	envelope["FTPServer"] = payload1
+	return envelope, nil
+}
+
+// handleArchiveTransfer handles ArchiveAPI.Transfer
+func handleArchiveTransfer(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	var destZoneID types.ID
+	if err := params.bind("destZoneID", &destZoneID); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.ArchiveTransferRequest `mapconv:"Archive,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.ArchiveTransferRequest{}
+	}
+
+	result0, err := fake.NewArchiveOp().Transfer(ctx, zone, id, destZoneID, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Archive{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Archive"] = payload0
+	return envelope, nil
+}
+
+// handleArchiveCreateFromShared handles ArchiveAPI.CreateFromShared
+func handleArchiveCreateFromShared(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	var destZoneID types.ID
+	if err := params.bind("destZoneID", &destZoneID); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.ArchiveCreateFromSharedRequest `mapconv:"Archive,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.ArchiveCreateFromSharedRequest{}
+	}
+
+	result0, err := fake.NewArchiveOp().CreateFromShared(ctx, zone, id, destZoneID, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Archive{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Archive"] = payload0
+	return envelope, nil
+}
+
+// handleArchiveRead handles ArchiveAPI.Read
+func handleArchiveRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewArchiveOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Archive{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Archive"] = payload0
+	return envelope, nil
+}
+
+// handleArchiveUpdate handles ArchiveAPI.Update
+func handleArchiveUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.ArchiveUpdateRequest `mapconv:"Archive,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.ArchiveUpdateRequest{}
+	}
+
+	result0, err := fake.NewArchiveOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Archive{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Archive"] = payload0
+	return envelope, nil
+}
+
+// handleArchiveDelete handles ArchiveAPI.Delete
+func handleArchiveDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewArchiveOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleArchiveOpenFTP handles ArchiveAPI.OpenFTP
+func handleArchiveOpenFTP(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	openOption := &sacloud.OpenFTPRequest{}
+	if err := mapconv.ConvertFrom(body, openOption); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewArchiveOp().OpenFTP(ctx, zone, id, openOption)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.OpeningFTPServer{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["FTPServer"] = payload0
+	return envelope, nil
+}
+
+// handleArchiveCloseFTP handles ArchiveAPI.CloseFTP
+func handleArchiveCloseFTP(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewArchiveOp().CloseFTP(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleArchiveShare handles ArchiveAPI.Share
+func handleArchiveShare(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	param := &sacloud.ArchiveShareRequest{}
+	if err := mapconv.ConvertFrom(body, param); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewArchiveOp().Share(ctx, zone, id, param)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.ArchiveShareInfo{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["ArchiveShareInfo"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* AuthStatus
+*************************************************/
+
+// handleAuthStatusRead handles AuthStatusAPI.Read
+func handleAuthStatusRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewAuthStatusOp().Read(ctx, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.AuthStatus{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	if err := embedPayload(envelope, payload0); err != nil {
+		return nil, err
+	}
+	return envelope, nil
+}
+
+/*************************************************
+* AutoBackup
+*************************************************/
+
+// handleAutoBackupFind handles AutoBackupAPI.Find
+func handleAutoBackupFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewAutoBackupOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.AutoBackup
+	for _, v := range result0 {
+		payload := &naked.AutoBackup{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["CommonServiceItems"] = payload0
+	return envelope, nil
+}
+
+// handleAutoBackupCreate handles AutoBackupAPI.Create
+func handleAutoBackupCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.AutoBackupCreateRequest `mapconv:"CommonServiceItem,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.AutoBackupCreateRequest{}
+	}
+
+	result0, err := fake.NewAutoBackupOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.AutoBackup{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CommonServiceItem"] = payload0
+	return envelope, nil
+}
+
+// handleAutoBackupRead handles AutoBackupAPI.Read
+func handleAutoBackupRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewAutoBackupOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.AutoBackup{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CommonServiceItem"] = payload0
+	return envelope, nil
+}
+
+// handleAutoBackupUpdate handles AutoBackupAPI.Update
+func handleAutoBackupUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.AutoBackupUpdateRequest `mapconv:"CommonServiceItem,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.AutoBackupUpdateRequest{}
+	}
+
+	result0, err := fake.NewAutoBackupOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.AutoBackup{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CommonServiceItem"] = payload0
+	return envelope, nil
+}
+
+// handleAutoBackupDelete handles AutoBackupAPI.Delete
+func handleAutoBackupDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewAutoBackupOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+/*************************************************
+* Bill
+*************************************************/
+
+// handleBillByContract handles BillAPI.ByContract
+func handleBillByContract(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var accountID types.ID
+	if err := params.bind("accountID", &accountID); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewBillOp().ByContract(ctx, zone, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.Bill
+	for _, v := range result0 {
+		payload := &naked.Bill{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Bills"] = payload0
+	return envelope, nil
+}
+
+// handleBillByContractYear handles BillAPI.ByContractYear
+func handleBillByContractYear(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var accountID types.ID
+	if err := params.bind("accountID", &accountID); err != nil {
+		return nil, err
+	}
+	var year int
+	if err := params.bind("year", &year); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewBillOp().ByContractYear(ctx, zone, accountID, year)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.Bill
+	for _, v := range result0 {
+		payload := &naked.Bill{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Bills"] = payload0
+	return envelope, nil
+}
+
+// handleBillByContractYearMonth handles BillAPI.ByContractYearMonth
+func handleBillByContractYearMonth(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var accountID types.ID
+	if err := params.bind("accountID", &accountID); err != nil {
+		return nil, err
+	}
+	var year int
+	if err := params.bind("year", &year); err != nil {
+		return nil, err
+	}
+	var month int
+	if err := params.bind("month", &month); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewBillOp().ByContractYearMonth(ctx, zone, accountID, year, month)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.Bill
+	for _, v := range result0 {
+		payload := &naked.Bill{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Bills"] = payload0
+	return envelope, nil
+}
+
+// handleBillRead handles BillAPI.Read
+func handleBillRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewBillOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.Bill
+	for _, v := range result0 {
+		payload := &naked.Bill{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Bills"] = payload0
+	return envelope, nil
+}
+
+// handleBillDetails handles BillAPI.Details
+func handleBillDetails(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var memberCode string
+	if err := params.bind("memberCode", &memberCode); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewBillOp().Details(ctx, zone, memberCode, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.BillDetail
+	for _, v := range result0 {
+		payload := &naked.BillDetail{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["BillDetails"] = payload0
+	return envelope, nil
+}
+
+// handleBillDetailsCSV handles BillAPI.DetailsCSV
+func handleBillDetailsCSV(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var memberCode string
+	if err := params.bind("memberCode", &memberCode); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewBillOp().DetailsCSV(ctx, zone, memberCode, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.BillDetailCSV{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	if err := embedPayload(envelope, payload0); err != nil {
+		return nil, err
+	}
+	return envelope, nil
+}
+
+/*************************************************
+* Bridge
+*************************************************/
+
+// handleBridgeFind handles BridgeAPI.Find
+func handleBridgeFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewBridgeOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.Bridge
+	for _, v := range result0 {
+		payload := &naked.Bridge{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Bridges"] = payload0
+	return envelope, nil
+}
+
+// handleBridgeCreate handles BridgeAPI.Create
+func handleBridgeCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.BridgeCreateRequest `mapconv:"Bridge,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.BridgeCreateRequest{}
+	}
+
+	result0, err := fake.NewBridgeOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Bridge{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Bridge"] = payload0
+	return envelope, nil
+}
+
+// handleBridgeRead handles BridgeAPI.Read
+func handleBridgeRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewBridgeOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Bridge{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Bridge"] = payload0
+	return envelope, nil
+}
+
+// handleBridgeUpdate handles BridgeAPI.Update
+func handleBridgeUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.BridgeUpdateRequest `mapconv:"Bridge,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.BridgeUpdateRequest{}
+	}
+
+	result0, err := fake.NewBridgeOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Bridge{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Bridge"] = payload0
+	return envelope, nil
+}
+
+// handleBridgeDelete handles BridgeAPI.Delete
+func handleBridgeDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewBridgeOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+/*************************************************
+* CDROM
+*************************************************/
+
+// handleCDROMFind handles CDROMAPI.Find
+func handleCDROMFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewCDROMOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.CDROM
+	for _, v := range result0 {
+		payload := &naked.CDROM{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["CDROMs"] = payload0
+	return envelope, nil
+}
+
+// handleCDROMCreate handles CDROMAPI.Create
+func handleCDROMCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.CDROMCreateRequest `mapconv:"CDROM,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.CDROMCreateRequest{}
+	}
+
+	result0, result1, err := fake.NewCDROMOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.CDROM{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CDROM"] = payload0
+	payload1 := &naked.OpeningFTPServer{}
+	if err := mapconv.ConvertTo(result1, payload1); err != nil {
+		return nil, err
+	}
+	envelope["FTPServer"] = payload1
+	return envelope, nil
+}
+
+// handleCDROMRead handles CDROMAPI.Read
+func handleCDROMRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewCDROMOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.CDROM{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CDROM"] = payload0
+	return envelope, nil
+}
+
+// handleCDROMUpdate handles CDROMAPI.Update
+func handleCDROMUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.CDROMUpdateRequest `mapconv:"CDROM,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.CDROMUpdateRequest{}
+	}
+
+	result0, err := fake.NewCDROMOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.CDROM{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CDROM"] = payload0
+	return envelope, nil
+}
+
+// handleCDROMDelete handles CDROMAPI.Delete
+func handleCDROMDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewCDROMOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleCDROMOpenFTP handles CDROMAPI.OpenFTP
+func handleCDROMOpenFTP(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	openOption := &sacloud.OpenFTPRequest{}
+	if err := mapconv.ConvertFrom(body, openOption); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewCDROMOp().OpenFTP(ctx, zone, id, openOption)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.OpeningFTPServer{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["FTPServer"] = payload0
+	return envelope, nil
+}
+
+// handleCDROMCloseFTP handles CDROMAPI.CloseFTP
+func handleCDROMCloseFTP(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewCDROMOp().CloseFTP(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+/*************************************************
+* Coupon
+*************************************************/
+
+// handleCouponFind handles CouponAPI.Find
+func handleCouponFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var accountID types.ID
+	if err := params.bind("accountID", &accountID); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewCouponOp().Find(ctx, zone, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.Coupon
+	for _, v := range result0 {
+		payload := &naked.Coupon{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Coupons"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* Database
+*************************************************/
+
+// handleDatabaseFind handles DatabaseAPI.Find
+func handleDatabaseFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewDatabaseOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.Database
+	for _, v := range result0 {
+		payload := &naked.Database{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Appliances"] = payload0
+	return envelope, nil
+}
+
+// handleDatabaseCreate handles DatabaseAPI.Create
+func handleDatabaseCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.DatabaseCreateRequest `mapconv:"Appliance,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.DatabaseCreateRequest{}
+	}
+
+	result0, err := fake.NewDatabaseOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Database{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Appliance"] = payload0
+	return envelope, nil
+}
+
+// handleDatabaseRead handles DatabaseAPI.Read
+func handleDatabaseRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewDatabaseOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Database{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Appliance"] = payload0
+	return envelope, nil
+}
+
+// handleDatabaseUpdate handles DatabaseAPI.Update
+func handleDatabaseUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.DatabaseUpdateRequest `mapconv:"Appliance,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.DatabaseUpdateRequest{}
+	}
+
+	result0, err := fake.NewDatabaseOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Database{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Appliance"] = payload0
+	return envelope, nil
+}
+
+// handleDatabaseDelete handles DatabaseAPI.Delete
+func handleDatabaseDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewDatabaseOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleDatabaseConfig handles DatabaseAPI.Config
+func handleDatabaseConfig(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewDatabaseOp().Config(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleDatabaseBoot handles DatabaseAPI.Boot
+func handleDatabaseBoot(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewDatabaseOp().Boot(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleDatabaseShutdown handles DatabaseAPI.Shutdown
+func handleDatabaseShutdown(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	shutdownOption := &sacloud.ShutdownOption{}
+	if err := mapconv.ConvertFrom(body, shutdownOption); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	err := fake.NewDatabaseOp().Shutdown(ctx, zone, id, shutdownOption)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleDatabaseReset handles DatabaseAPI.Reset
+func handleDatabaseReset(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewDatabaseOp().Reset(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleDatabaseMonitorCPU handles DatabaseAPI.MonitorCPU
+func handleDatabaseMonitorCPU(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	condition := &sacloud.MonitorCondition{}
+	if err := mapconv.ConvertFrom(body, condition); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewDatabaseOp().MonitorCPU(ctx, zone, id, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0, err := newMonitorValuesPayload(result0)
+	if err != nil {
+		return nil, err
+	}
+	envelope["Data"] = payload0
+	return envelope, nil
+}
+
+// handleDatabaseMonitorDisk handles DatabaseAPI.MonitorDisk
+func handleDatabaseMonitorDisk(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	condition := &sacloud.MonitorCondition{}
+	if err := mapconv.ConvertFrom(body, condition); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewDatabaseOp().MonitorDisk(ctx, zone, id, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0, err := newMonitorValuesPayload(result0)
+	if err != nil {
+		return nil, err
+	}
+	envelope["Data"] = payload0
+	return envelope, nil
+}
+
+// handleDatabaseMonitorInterface handles DatabaseAPI.MonitorInterface
+func handleDatabaseMonitorInterface(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	condition := &sacloud.MonitorCondition{}
+	if err := mapconv.ConvertFrom(body, condition); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewDatabaseOp().MonitorInterface(ctx, zone, id, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0, err := newMonitorValuesPayload(result0)
+	if err != nil {
+		return nil, err
+	}
+	envelope["Data"] = payload0
+	return envelope, nil
+}
+
+// handleDatabaseMonitorDatabase handles DatabaseAPI.MonitorDatabase
+func handleDatabaseMonitorDatabase(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	condition := &sacloud.MonitorCondition{}
+	if err := mapconv.ConvertFrom(body, condition); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewDatabaseOp().MonitorDatabase(ctx, zone, id, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0, err := newMonitorValuesPayload(result0)
+	if err != nil {
+		return nil, err
+	}
+	envelope["Data"] = payload0
+	return envelope, nil
+}
+
+// handleDatabaseStatus handles DatabaseAPI.Status
+func handleDatabaseStatus(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewDatabaseOp().Status(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.DatabaseStatusResponse{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Appliance"] = payload0
+	return envelope, nil
+}
+
+// handleDatabaseGetParameter handles DatabaseAPI.GetParameter
+func handleDatabaseGetParameter(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewDatabaseOp().GetParameter(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.DatabaseParameter{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Database"] = payload0
+	return envelope, nil
+}
+
+// handleDatabaseSetParameter handles DatabaseAPI.SetParameter
+func handleDatabaseSetParameter(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam map[string]interface{} `mapconv:"Parameter.Attr"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = map[string]interface{}{}
+	}
+
+	err := fake.NewDatabaseOp().SetParameter(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+/*************************************************
+* Disk
+*************************************************/
+
+// handleDiskFind handles DiskAPI.Find
+func handleDiskFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewDiskOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.Disk
+	for _, v := range result0 {
+		payload := &naked.Disk{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Disks"] = payload0
+	return envelope, nil
+}
+
+// handleDiskCreate handles DiskAPI.Create
+func handleDiskCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.DiskCreateRequest `mapconv:"Disk,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.DiskCreateRequest{}
+	}
+
+	result0, err := fake.NewDiskOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Disk{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Disk"] = payload0
+	return envelope, nil
+}
+
+// handleDiskCreateDistantly handles DiskAPI.CreateDistantly
+func handleDiskCreateDistantly(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		ArgcreateParam *sacloud.DiskCreateRequest `mapconv:"Disk"`
+		ArgdistantFrom []types.ID                 `mapconv:"DistantFrom"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.ArgcreateParam == nil {
+		args.ArgcreateParam = &sacloud.DiskCreateRequest{}
+	}
+	if args.ArgdistantFrom == nil {
+		args.ArgdistantFrom = []types.ID{}
+	}
+
+	result0, err := fake.NewDiskOp().CreateDistantly(ctx, zone, args.ArgcreateParam, args.ArgdistantFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Disk{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Disk"] = payload0
+	return envelope, nil
+}
+
+// handleDiskConfig handles DiskAPI.Config
+func handleDiskConfig(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	edit := &sacloud.DiskEditRequest{}
+	if err := mapconv.ConvertFrom(body, edit); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	err := fake.NewDiskOp().Config(ctx, zone, id, edit)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleDiskCreateWithConfig handles DiskAPI.CreateWithConfig
+func handleDiskCreateWithConfig(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		ArgcreateParam     *sacloud.DiskCreateRequest `mapconv:"Disk"`
+		ArgeditParam       *sacloud.DiskEditRequest   `mapconv:"Config"`
+		ArgbootAtAvailable bool                       `mapconv:"BootAtAvailable"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.ArgcreateParam == nil {
+		args.ArgcreateParam = &sacloud.DiskCreateRequest{}
+	}
+	if args.ArgeditParam == nil {
+		args.ArgeditParam = &sacloud.DiskEditRequest{}
+	}
+	if args.ArgbootAtAvailable == false {
+		args.ArgbootAtAvailable = false
+	}
+
+	result0, err := fake.NewDiskOp().CreateWithConfig(ctx, zone, args.ArgcreateParam, args.ArgeditParam, args.ArgbootAtAvailable)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Disk{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Disk"] = payload0
+	return envelope, nil
+}
+
+// handleDiskCreateWithConfigDistantly handles DiskAPI.CreateWithConfigDistantly
+func handleDiskCreateWithConfigDistantly(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		ArgcreateParam     *sacloud.DiskCreateRequest `mapconv:"Disk"`
+		ArgeditParam       *sacloud.DiskEditRequest   `mapconv:"Config"`
+		ArgbootAtAvailable bool                       `mapconv:"BootAtAvailable"`
+		ArgdistantFrom     []types.ID                 `mapconv:"DistantFrom"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.ArgcreateParam == nil {
+		args.ArgcreateParam = &sacloud.DiskCreateRequest{}
+	}
+	if args.ArgeditParam == nil {
+		args.ArgeditParam = &sacloud.DiskEditRequest{}
+	}
+	if args.ArgbootAtAvailable == false {
+		args.ArgbootAtAvailable = false
+	}
+	if args.ArgdistantFrom == nil {
+		args.ArgdistantFrom = []types.ID{}
+	}
+
+	result0, err := fake.NewDiskOp().CreateWithConfigDistantly(ctx, zone, args.ArgcreateParam, args.ArgeditParam, args.ArgbootAtAvailable, args.ArgdistantFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Disk{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Disk"] = payload0
+	return envelope, nil
+}
+
+// handleDiskToBlank handles DiskAPI.ToBlank
+func handleDiskToBlank(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewDiskOp().ToBlank(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleDiskResizePartition handles DiskAPI.ResizePartition
+func handleDiskResizePartition(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewDiskOp().ResizePartition(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleDiskConnectToServer handles DiskAPI.ConnectToServer
+func handleDiskConnectToServer(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	var serverID types.ID
+	if err := params.bind("serverID", &serverID); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewDiskOp().ConnectToServer(ctx, zone, id, serverID)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleDiskDisconnectFromServer handles DiskAPI.DisconnectFromServer
+func handleDiskDisconnectFromServer(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewDiskOp().DisconnectFromServer(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleDiskInstallDistantFrom handles DiskAPI.InstallDistantFrom
+func handleDiskInstallDistantFrom(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		ArginstallParam *sacloud.DiskInstallRequest `mapconv:"Disk"`
+		ArgdistantFrom  []types.ID                  `mapconv:"DistantFrom"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.ArginstallParam == nil {
+		args.ArginstallParam = &sacloud.DiskInstallRequest{}
+	}
+	if args.ArgdistantFrom == nil {
+		args.ArgdistantFrom = []types.ID{}
+	}
+
+	result0, err := fake.NewDiskOp().InstallDistantFrom(ctx, zone, id, args.ArginstallParam, args.ArgdistantFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Disk{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Disk"] = payload0
+	return envelope, nil
+}
+
+// handleDiskInstall handles DiskAPI.Install
+func handleDiskInstall(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		ArginstallParam *sacloud.DiskInstallRequest `mapconv:"Disk"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.ArginstallParam == nil {
+		args.ArginstallParam = &sacloud.DiskInstallRequest{}
+	}
+
+	result0, err := fake.NewDiskOp().Install(ctx, zone, id, args.ArginstallParam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Disk{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Disk"] = payload0
+	return envelope, nil
+}
+
+// handleDiskRead handles DiskAPI.Read
+func handleDiskRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewDiskOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Disk{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Disk"] = payload0
+	return envelope, nil
+}
+
+// handleDiskUpdate handles DiskAPI.Update
+func handleDiskUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.DiskUpdateRequest `mapconv:"Disk,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.DiskUpdateRequest{}
+	}
+
+	result0, err := fake.NewDiskOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Disk{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Disk"] = payload0
+	return envelope, nil
+}
+
+// handleDiskDelete handles DiskAPI.Delete
+func handleDiskDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewDiskOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleDiskMonitor handles DiskAPI.Monitor
+func handleDiskMonitor(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	condition := &sacloud.MonitorCondition{}
+	if err := mapconv.ConvertFrom(body, condition); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewDiskOp().Monitor(ctx, zone, id, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0, err := newMonitorValuesPayload(result0)
+	if err != nil {
+		return nil, err
+	}
+	envelope["Data"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* DiskPlan
+*************************************************/
+
+// handleDiskPlanFind handles DiskPlanAPI.Find
+func handleDiskPlanFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewDiskPlanOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.DiskPlan
+	for _, v := range result0 {
+		payload := &naked.DiskPlan{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["DiskPlans"] = payload0
+	return envelope, nil
+}
+
+// handleDiskPlanRead handles DiskPlanAPI.Read
+func handleDiskPlanRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewDiskPlanOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.DiskPlan{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["DiskPlan"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* DNS
+*************************************************/
+
+// handleDNSFind handles DNSAPI.Find
+func handleDNSFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewDNSOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.DNS
+	for _, v := range result0 {
+		payload := &naked.DNS{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["CommonServiceItems"] = payload0
+	return envelope, nil
+}
+
+// handleDNSCreate handles DNSAPI.Create
+func handleDNSCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.DNSCreateRequest `mapconv:"CommonServiceItem,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.DNSCreateRequest{}
+	}
+
+	result0, err := fake.NewDNSOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.DNS{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CommonServiceItem"] = payload0
+	return envelope, nil
+}
+
+// handleDNSRead handles DNSAPI.Read
+func handleDNSRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewDNSOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.DNS{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CommonServiceItem"] = payload0
+	return envelope, nil
+}
+
+// handleDNSUpdate handles DNSAPI.Update
+func handleDNSUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.DNSUpdateRequest `mapconv:"CommonServiceItem,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.DNSUpdateRequest{}
+	}
+
+	result0, err := fake.NewDNSOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.DNS{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CommonServiceItem"] = payload0
+	return envelope, nil
+}
+
+// handleDNSDelete handles DNSAPI.Delete
+func handleDNSDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewDNSOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+/*************************************************
+* ESUsage
+*************************************************/
+
+// handleESUsageFind handles ESUsageAPI.Find
+func handleESUsageFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var accountID types.ID
+	if err := params.bind("accountID", &accountID); err != nil {
+		return nil, err
+	}
+	var year int
+	if err := params.bind("year", &year); err != nil {
+		return nil, err
+	}
+	var month int
+	if err := params.bind("month", &month); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewESUsageOp().Find(ctx, zone, accountID, year, month)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.ESUsage
+	for _, v := range result0 {
+		payload := &naked.ESUsage{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["ESUsages"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* GSLB
+*************************************************/
+
+// handleGSLBFind handles GSLBAPI.Find
+func handleGSLBFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewGSLBOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.GSLB
+	for _, v := range result0 {
+		payload := &naked.GSLB{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["CommonServiceItems"] = payload0
+	return envelope, nil
+}
+
+// handleGSLBCreate handles GSLBAPI.Create
+func handleGSLBCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.GSLBCreateRequest `mapconv:"CommonServiceItem,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.GSLBCreateRequest{}
+	}
+
+	result0, err := fake.NewGSLBOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.GSLB{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CommonServiceItem"] = payload0
+	return envelope, nil
+}
+
+// handleGSLBRead handles GSLBAPI.Read
+func handleGSLBRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewGSLBOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.GSLB{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CommonServiceItem"] = payload0
+	return envelope, nil
+}
+
+// handleGSLBUpdate handles GSLBAPI.Update
+func handleGSLBUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.GSLBUpdateRequest `mapconv:"CommonServiceItem,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.GSLBUpdateRequest{}
+	}
+
+	result0, err := fake.NewGSLBOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.GSLB{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CommonServiceItem"] = payload0
+	return envelope, nil
+}
+
+// handleGSLBDelete handles GSLBAPI.Delete
+func handleGSLBDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewGSLBOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+/*************************************************
+* Icon
+*************************************************/
+
+// handleIconFind handles IconAPI.Find
+func handleIconFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewIconOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.Icon
+	for _, v := range result0 {
+		payload := &naked.Icon{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Icons"] = payload0
+	return envelope, nil
+}
+
+// handleIconCreate handles IconAPI.Create
+func handleIconCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.IconCreateRequest `mapconv:"Icon,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.IconCreateRequest{}
+	}
+
+	result0, err := fake.NewIconOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Icon{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Icon"] = payload0
+	return envelope, nil
+}
+
+// handleIconRead handles IconAPI.Read
+func handleIconRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewIconOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Icon{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Icon"] = payload0
+	return envelope, nil
+}
+
+// handleIconUpdate handles IconAPI.Update
+func handleIconUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.IconUpdateRequest `mapconv:"Icon,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.IconUpdateRequest{}
+	}
+
+	result0, err := fake.NewIconOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Icon{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Icon"] = payload0
+	return envelope, nil
+}
+
+// handleIconDelete handles IconAPI.Delete
+func handleIconDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewIconOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleIconGetImage handles IconAPI.GetImage
+func handleIconGetImage(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argsize types.EIconSize `mapconv:"Size"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argsize == types.EIconSize("") {
+		args.Argsize = types.EIconSize("")
+	}
+
+	result0, err := fake.NewIconOp().GetImage(ctx, zone, id, args.Argsize)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Icon{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Icon"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* Interface
+*************************************************/
+
+// handleInterfaceFind handles InterfaceAPI.Find
+func handleInterfaceFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewInterfaceOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.Interface
+	for _, v := range result0 {
+		payload := &naked.Interface{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Interfaces"] = payload0
+	return envelope, nil
+}
+
+// handleInterfaceCreate handles InterfaceAPI.Create
+func handleInterfaceCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.InterfaceCreateRequest `mapconv:"Interface,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.InterfaceCreateRequest{}
+	}
+
+	result0, err := fake.NewInterfaceOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Interface{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Interface"] = payload0
+	return envelope, nil
+}
+
+// handleInterfaceRead handles InterfaceAPI.Read
+func handleInterfaceRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewInterfaceOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Interface{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Interface"] = payload0
+	return envelope, nil
+}
+
+// handleInterfaceUpdate handles InterfaceAPI.Update
+func handleInterfaceUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.InterfaceUpdateRequest `mapconv:"Interface,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.InterfaceUpdateRequest{}
+	}
+
+	result0, err := fake.NewInterfaceOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Interface{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Interface"] = payload0
+	return envelope, nil
+}
+
+// handleInterfaceDelete handles InterfaceAPI.Delete
+func handleInterfaceDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewInterfaceOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleInterfaceMonitor handles InterfaceAPI.Monitor
+func handleInterfaceMonitor(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	condition := &sacloud.MonitorCondition{}
+	if err := mapconv.ConvertFrom(body, condition); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewInterfaceOp().Monitor(ctx, zone, id, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0, err := newMonitorValuesPayload(result0)
+	if err != nil {
+		return nil, err
+	}
+	envelope["Data"] = payload0
+	return envelope, nil
+}
+
+// handleInterfaceConnectToSharedSegment handles InterfaceAPI.ConnectToSharedSegment
+func handleInterfaceConnectToSharedSegment(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewInterfaceOp().ConnectToSharedSegment(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleInterfaceConnectToSwitch handles InterfaceAPI.ConnectToSwitch
+func handleInterfaceConnectToSwitch(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	var switchID types.ID
+	if err := params.bind("switchID", &switchID); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewInterfaceOp().ConnectToSwitch(ctx, zone, id, switchID)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleInterfaceDisconnectFromSwitch handles InterfaceAPI.DisconnectFromSwitch
+func handleInterfaceDisconnectFromSwitch(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewInterfaceOp().DisconnectFromSwitch(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleInterfaceConnectToPacketFilter handles InterfaceAPI.ConnectToPacketFilter
+func handleInterfaceConnectToPacketFilter(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	var packetFilterID types.ID
+	if err := params.bind("packetFilterID", &packetFilterID); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewInterfaceOp().ConnectToPacketFilter(ctx, zone, id, packetFilterID)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleInterfaceDisconnectFromPacketFilter handles InterfaceAPI.DisconnectFromPacketFilter
+func handleInterfaceDisconnectFromPacketFilter(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewInterfaceOp().DisconnectFromPacketFilter(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+/*************************************************
+* Internet
+*************************************************/
+
+// handleInternetFind handles InternetAPI.Find
+func handleInternetFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewInternetOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.Internet
+	for _, v := range result0 {
+		payload := &naked.Internet{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Internets"] = payload0
+	return envelope, nil
+}
+
+// handleInternetCreate handles InternetAPI.Create
+func handleInternetCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.InternetCreateRequest `mapconv:"Internet,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.InternetCreateRequest{}
+	}
+
+	result0, err := fake.NewInternetOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Internet{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Internet"] = payload0
+	return envelope, nil
+}
+
+// handleInternetRead handles InternetAPI.Read
+func handleInternetRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewInternetOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Internet{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Internet"] = payload0
+	return envelope, nil
+}
+
+// handleInternetUpdate handles InternetAPI.Update
+func handleInternetUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.InternetUpdateRequest `mapconv:"Internet,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.InternetUpdateRequest{}
+	}
+
+	result0, err := fake.NewInternetOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Internet{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Internet"] = payload0
+	return envelope, nil
+}
+
+// handleInternetDelete handles InternetAPI.Delete
+func handleInternetDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewInternetOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleInternetUpdateBandWidth handles InternetAPI.UpdateBandWidth
+func handleInternetUpdateBandWidth(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.InternetUpdateBandWidthRequest `mapconv:"Internet,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.InternetUpdateBandWidthRequest{}
+	}
+
+	result0, err := fake.NewInternetOp().UpdateBandWidth(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Internet{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Internet"] = payload0
+	return envelope, nil
+}
+
+// handleInternetAddSubnet handles InternetAPI.AddSubnet
+func handleInternetAddSubnet(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	param := &sacloud.InternetAddSubnetRequest{}
+	if err := mapconv.ConvertFrom(body, param); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewInternetOp().AddSubnet(ctx, zone, id, param)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Subnet{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Subnet"] = payload0
+	return envelope, nil
+}
+
+// handleInternetUpdateSubnet handles InternetAPI.UpdateSubnet
+func handleInternetUpdateSubnet(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	var subnetID types.ID
+	if err := params.bind("subnetID", &subnetID); err != nil {
+		return nil, err
+	}
+	param := &sacloud.InternetUpdateSubnetRequest{}
+	if err := mapconv.ConvertFrom(body, param); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewInternetOp().UpdateSubnet(ctx, zone, id, subnetID, param)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Subnet{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Subnet"] = payload0
+	return envelope, nil
+}
+
+// handleInternetDeleteSubnet handles InternetAPI.DeleteSubnet
+func handleInternetDeleteSubnet(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	var subnetID types.ID
+	if err := params.bind("subnetID", &subnetID); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewInternetOp().DeleteSubnet(ctx, zone, id, subnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleInternetMonitor handles InternetAPI.Monitor
+func handleInternetMonitor(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	condition := &sacloud.MonitorCondition{}
+	if err := mapconv.ConvertFrom(body, condition); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewInternetOp().Monitor(ctx, zone, id, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0, err := newMonitorValuesPayload(result0)
+	if err != nil {
+		return nil, err
+	}
+	envelope["Data"] = payload0
+	return envelope, nil
+}
+
+// handleInternetEnableIPv6 handles InternetAPI.EnableIPv6
+func handleInternetEnableIPv6(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewInternetOp().EnableIPv6(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.IPv6Net{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["IPv6Net"] = payload0
+	return envelope, nil
+}
+
+// handleInternetDisableIPv6 handles InternetAPI.DisableIPv6
+func handleInternetDisableIPv6(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	var ipv6netID types.ID
+	if err := params.bind("ipv6netID", &ipv6netID); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewInternetOp().DisableIPv6(ctx, zone, id, ipv6netID)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+/*************************************************
+* InternetPlan
+*************************************************/
+
+// handleInternetPlanFind handles InternetPlanAPI.Find
+func handleInternetPlanFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewInternetPlanOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.InternetPlan
+	for _, v := range result0 {
+		payload := &naked.InternetPlan{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["InternetPlans"] = payload0
+	return envelope, nil
+}
+
+// handleInternetPlanRead handles InternetPlanAPI.Read
+func handleInternetPlanRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewInternetPlanOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.InternetPlan{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["InternetPlan"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* IPAddress
+*************************************************/
+
+// handleIPAddressFind handles IPAddressAPI.Find
+func handleIPAddressFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewIPAddressOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.IPAddress
+	for _, v := range result0 {
+		payload := &naked.IPAddress{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["IPAddress"] = payload0
+	return envelope, nil
+}
+
+// handleIPAddressRead handles IPAddressAPI.Read
+func handleIPAddressRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var ipAddress string
+	if err := params.bind("ipAddress", &ipAddress); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewIPAddressOp().Read(ctx, zone, ipAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.IPAddress{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["IPAddress"] = payload0
+	return envelope, nil
+}
+
+// handleIPAddressUpdateHostName handles IPAddressAPI.UpdateHostName
+func handleIPAddressUpdateHostName(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var ipAddress string
+	if err := params.bind("ipAddress", &ipAddress); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		ArghostName string `mapconv:"IPAddress.HostName"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.ArghostName == "" {
+		args.ArghostName = ""
+	}
+
+	result0, err := fake.NewIPAddressOp().UpdateHostName(ctx, zone, ipAddress, args.ArghostName)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.IPAddress{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["IPAddress"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* IPv6Addr
+*************************************************/
+
+// handleIPv6AddrFind handles IPv6AddrAPI.Find
+func handleIPv6AddrFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewIPv6AddrOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.IPv6Addr
+	for _, v := range result0 {
+		payload := &naked.IPv6Addr{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["IPv6Addrs"] = payload0
+	return envelope, nil
+}
+
+// handleIPv6AddrCreate handles IPv6AddrAPI.Create
+func handleIPv6AddrCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.IPv6AddrCreateRequest `mapconv:"IPv6Addr,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.IPv6AddrCreateRequest{}
+	}
+
+	result0, err := fake.NewIPv6AddrOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.IPv6Addr{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["IPv6Addr"] = payload0
+	return envelope, nil
+}
+
+// handleIPv6AddrRead handles IPv6AddrAPI.Read
+func handleIPv6AddrRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var ipv6addr string
+	if err := params.bind("ipv6addr", &ipv6addr); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewIPv6AddrOp().Read(ctx, zone, ipv6addr)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.IPv6Addr{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["IPv6Addr"] = payload0
+	return envelope, nil
+}
+
+// handleIPv6AddrUpdate handles IPv6AddrAPI.Update
+func handleIPv6AddrUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var ipv6addr string
+	if err := params.bind("ipv6addr", &ipv6addr); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.IPv6AddrUpdateRequest `mapconv:"IPv6Addr,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.IPv6AddrUpdateRequest{}
+	}
+
+	result0, err := fake.NewIPv6AddrOp().Update(ctx, zone, ipv6addr, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.IPv6Addr{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["IPv6Addr"] = payload0
+	return envelope, nil
+}
+
+// handleIPv6AddrDelete handles IPv6AddrAPI.Delete
+func handleIPv6AddrDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var ipv6addr string
+	if err := params.bind("ipv6addr", &ipv6addr); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewIPv6AddrOp().Delete(ctx, zone, ipv6addr)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+/*************************************************
+* IPv6Net
+*************************************************/
+
+// handleIPv6NetFind handles IPv6NetAPI.Find
+func handleIPv6NetFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewIPv6NetOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.IPv6Net
+	for _, v := range result0 {
+		payload := &naked.IPv6Net{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["IPv6Nets"] = payload0
+	return envelope, nil
+}
+
+// handleIPv6NetRead handles IPv6NetAPI.Read
+func handleIPv6NetRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewIPv6NetOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.IPv6Net{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["IPv6Net"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* License
+*************************************************/
+
+// handleLicenseFind handles LicenseAPI.Find
+func handleLicenseFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewLicenseOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.License
+	for _, v := range result0 {
+		payload := &naked.License{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Licenses"] = payload0
+	return envelope, nil
+}
+
+// handleLicenseCreate handles LicenseAPI.Create
+func handleLicenseCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.LicenseCreateRequest `mapconv:"License,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.LicenseCreateRequest{}
+	}
+
+	result0, err := fake.NewLicenseOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.License{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["License"] = payload0
+	return envelope, nil
+}
+
+// handleLicenseRead handles LicenseAPI.Read
+func handleLicenseRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewLicenseOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.License{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["License"] = payload0
+	return envelope, nil
+}
+
+// handleLicenseUpdate handles LicenseAPI.Update
+func handleLicenseUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.LicenseUpdateRequest `mapconv:"License,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.LicenseUpdateRequest{}
+	}
+
+	result0, err := fake.NewLicenseOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.License{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["License"] = payload0
+	return envelope, nil
+}
+
+// handleLicenseDelete handles LicenseAPI.Delete
+func handleLicenseDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewLicenseOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+/*************************************************
+* LicensePlan
+*************************************************/
+
+// handleLicensePlanFind handles LicensePlanAPI.Find
+func handleLicensePlanFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewLicensePlanOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.LicensePlan
+	for _, v := range result0 {
+		payload := &naked.LicensePlan{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["LicensePlans"] = payload0
+	return envelope, nil
+}
+
+// handleLicensePlanRead handles LicensePlanAPI.Read
+func handleLicensePlanRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewLicensePlanOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.LicensePlan{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["LicensePlan"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* LoadBalancer
+*************************************************/
+
+// handleLoadBalancerFind handles LoadBalancerAPI.Find
+func handleLoadBalancerFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewLoadBalancerOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.LoadBalancer
+	for _, v := range result0 {
+		payload := &naked.LoadBalancer{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Appliances"] = payload0
+	return envelope, nil
+}
+
+// handleLoadBalancerCreate handles LoadBalancerAPI.Create
+func handleLoadBalancerCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.LoadBalancerCreateRequest `mapconv:"Appliance,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.LoadBalancerCreateRequest{}
+	}
+
+	result0, err := fake.NewLoadBalancerOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.LoadBalancer{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Appliance"] = payload0
+	return envelope, nil
+}
+
+// handleLoadBalancerRead handles LoadBalancerAPI.Read
+func handleLoadBalancerRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewLoadBalancerOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.LoadBalancer{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Appliance"] = payload0
+	return envelope, nil
+}
+
+// handleLoadBalancerUpdate handles LoadBalancerAPI.Update
+func handleLoadBalancerUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.LoadBalancerUpdateRequest `mapconv:"Appliance,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.LoadBalancerUpdateRequest{}
+	}
+
+	result0, err := fake.NewLoadBalancerOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.LoadBalancer{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Appliance"] = payload0
+	return envelope, nil
+}
+
+// handleLoadBalancerDelete handles LoadBalancerAPI.Delete
+func handleLoadBalancerDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewLoadBalancerOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleLoadBalancerConfig handles LoadBalancerAPI.Config
+func handleLoadBalancerConfig(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewLoadBalancerOp().Config(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleLoadBalancerBoot handles LoadBalancerAPI.Boot
+func handleLoadBalancerBoot(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewLoadBalancerOp().Boot(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleLoadBalancerShutdown handles LoadBalancerAPI.Shutdown
+func handleLoadBalancerShutdown(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	shutdownOption := &sacloud.ShutdownOption{}
+	if err := mapconv.ConvertFrom(body, shutdownOption); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	err := fake.NewLoadBalancerOp().Shutdown(ctx, zone, id, shutdownOption)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleLoadBalancerReset handles LoadBalancerAPI.Reset
+func handleLoadBalancerReset(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewLoadBalancerOp().Reset(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleLoadBalancerMonitorInterface handles LoadBalancerAPI.MonitorInterface
+func handleLoadBalancerMonitorInterface(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	condition := &sacloud.MonitorCondition{}
+	if err := mapconv.ConvertFrom(body, condition); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewLoadBalancerOp().MonitorInterface(ctx, zone, id, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0, err := newMonitorValuesPayload(result0)
+	if err != nil {
+		return nil, err
+	}
+	envelope["Data"] = payload0
+	return envelope, nil
+}
+
+// handleLoadBalancerStatus handles LoadBalancerAPI.Status
+func handleLoadBalancerStatus(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewLoadBalancerOp().Status(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.LoadBalancerStatus
+	for _, v := range result0 {
+		payload := &naked.LoadBalancerStatus{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["LoadBalancer"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* MobileGateway
+*************************************************/
+
+// handleMobileGatewayFind handles MobileGatewayAPI.Find
+func handleMobileGatewayFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewMobileGatewayOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.MobileGateway
+	for _, v := range result0 {
+		payload := &naked.MobileGateway{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Appliances"] = payload0
+	return envelope, nil
+}
+
+// handleMobileGatewayCreate handles MobileGatewayAPI.Create
+func handleMobileGatewayCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.MobileGatewayCreateRequest `mapconv:"Appliance,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.MobileGatewayCreateRequest{}
+	}
+
+	result0, err := fake.NewMobileGatewayOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.MobileGateway{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Appliance"] = payload0
+	return envelope, nil
+}
+
+// handleMobileGatewayRead handles MobileGatewayAPI.Read
+func handleMobileGatewayRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewMobileGatewayOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.MobileGateway{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Appliance"] = payload0
+	return envelope, nil
+}
+
+// handleMobileGatewayUpdate handles MobileGatewayAPI.Update
+func handleMobileGatewayUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.MobileGatewayUpdateRequest `mapconv:"Appliance,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.MobileGatewayUpdateRequest{}
+	}
+
+	result0, err := fake.NewMobileGatewayOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.MobileGateway{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Appliance"] = payload0
+	return envelope, nil
+}
+
+// handleMobileGatewayDelete handles MobileGatewayAPI.Delete
+func handleMobileGatewayDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewMobileGatewayOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleMobileGatewayConfig handles MobileGatewayAPI.Config
+func handleMobileGatewayConfig(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewMobileGatewayOp().Config(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleMobileGatewayBoot handles MobileGatewayAPI.Boot
+func handleMobileGatewayBoot(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewMobileGatewayOp().Boot(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleMobileGatewayShutdown handles MobileGatewayAPI.Shutdown
+func handleMobileGatewayShutdown(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	shutdownOption := &sacloud.ShutdownOption{}
+	if err := mapconv.ConvertFrom(body, shutdownOption); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	err := fake.NewMobileGatewayOp().Shutdown(ctx, zone, id, shutdownOption)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleMobileGatewayReset handles MobileGatewayAPI.Reset
+func handleMobileGatewayReset(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewMobileGatewayOp().Reset(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleMobileGatewayConnectToSwitch handles MobileGatewayAPI.ConnectToSwitch
+func handleMobileGatewayConnectToSwitch(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	var switchID types.ID
+	if err := params.bind("switchID", &switchID); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewMobileGatewayOp().ConnectToSwitch(ctx, zone, id, switchID)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleMobileGatewayDisconnectFromSwitch handles MobileGatewayAPI.DisconnectFromSwitch
+func handleMobileGatewayDisconnectFromSwitch(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewMobileGatewayOp().DisconnectFromSwitch(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleMobileGatewayGetDNS handles MobileGatewayAPI.GetDNS
+func handleMobileGatewayGetDNS(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewMobileGatewayOp().GetDNS(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.MobileGatewaySIMGroup{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["sim_group"] = payload0
+	return envelope, nil
+}
+
+// handleMobileGatewaySetDNS handles MobileGatewayAPI.SetDNS
+func handleMobileGatewaySetDNS(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.MobileGatewayDNSSetting `mapconv:"sim_group,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.MobileGatewayDNSSetting{}
+	}
+
+	err := fake.NewMobileGatewayOp().SetDNS(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleMobileGatewayListSIM handles MobileGatewayAPI.ListSIM
+func handleMobileGatewayListSIM(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewMobileGatewayOp().ListSIM(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.SIMInfo
+	for _, v := range result0 {
+		payload := &naked.SIMInfo{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["sim"] = payload0
+	return envelope, nil
+}
+
+// handleMobileGatewayAddSIM handles MobileGatewayAPI.AddSIM
+func handleMobileGatewayAddSIM(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.MobileGatewayAddSIMRequest `mapconv:"sim,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.MobileGatewayAddSIMRequest{}
+	}
+
+	err := fake.NewMobileGatewayOp().AddSIM(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleMobileGatewayDeleteSIM handles MobileGatewayAPI.DeleteSIM
+func handleMobileGatewayDeleteSIM(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	var simID types.ID
+	if err := params.bind("simID", &simID); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewMobileGatewayOp().DeleteSIM(ctx, zone, id, simID)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleMobileGatewayGetSIMRoutes handles MobileGatewayAPI.GetSIMRoutes
+func handleMobileGatewayGetSIMRoutes(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewMobileGatewayOp().GetSIMRoutes(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.MobileGatewaySIMRoute
+	for _, v := range result0 {
+		payload := &naked.MobileGatewaySIMRoute{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["sim_routes"] = payload0
+	return envelope, nil
+}
+
+// handleMobileGatewaySetSIMRoutes handles MobileGatewayAPI.SetSIMRoutes
+func handleMobileGatewaySetSIMRoutes(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argroutes []*sacloud.MobileGatewaySIMRoute `mapconv:"[]sim_routes,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argroutes == nil {
+		args.Argroutes = []*sacloud.MobileGatewaySIMRoute{}
+	}
+
+	err := fake.NewMobileGatewayOp().SetSIMRoutes(ctx, zone, id, args.Argroutes)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleMobileGatewayGetTrafficConfig handles MobileGatewayAPI.GetTrafficConfig
+func handleMobileGatewayGetTrafficConfig(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewMobileGatewayOp().GetTrafficConfig(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.TrafficMonitoringConfig{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["traffic_monitoring_config"] = payload0
+	return envelope, nil
+}
+
+// handleMobileGatewaySetTrafficConfig handles MobileGatewayAPI.SetTrafficConfig
+func handleMobileGatewaySetTrafficConfig(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.MobileGatewayTrafficControl `mapconv:"traffic_monitoring_config,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.MobileGatewayTrafficControl{}
+	}
+
+	err := fake.NewMobileGatewayOp().SetTrafficConfig(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleMobileGatewayDeleteTrafficConfig handles MobileGatewayAPI.DeleteTrafficConfig
+func handleMobileGatewayDeleteTrafficConfig(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewMobileGatewayOp().DeleteTrafficConfig(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleMobileGatewayTrafficStatus handles MobileGatewayAPI.TrafficStatus
+func handleMobileGatewayTrafficStatus(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewMobileGatewayOp().TrafficStatus(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.TrafficStatus{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["traffic_status"] = payload0
+	return envelope, nil
+}
+
+// handleMobileGatewayMonitorInterface handles MobileGatewayAPI.MonitorInterface
+func handleMobileGatewayMonitorInterface(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	var index int
+	if err := params.bind("index", &index); err != nil {
+		return nil, err
+	}
+	condition := &sacloud.MonitorCondition{}
+	if err := mapconv.ConvertFrom(body, condition); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewMobileGatewayOp().MonitorInterface(ctx, zone, id, index, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0, err := newMonitorValuesPayload(result0)
+	if err != nil {
+		return nil, err
+	}
+	envelope["Data"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* NFS
+*************************************************/
+
+// handleNFSFind handles NFSAPI.Find
+func handleNFSFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewNFSOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.NFS
+	for _, v := range result0 {
+		payload := &naked.NFS{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Appliances"] = payload0
+	return envelope, nil
+}
+
+// handleNFSCreate handles NFSAPI.Create
+func handleNFSCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.NFSCreateRequest `mapconv:"Appliance,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.NFSCreateRequest{}
+	}
+
+	result0, err := fake.NewNFSOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.NFS{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Appliance"] = payload0
+	return envelope, nil
+}
+
+// handleNFSRead handles NFSAPI.Read
+func handleNFSRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewNFSOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.NFS{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Appliance"] = payload0
+	return envelope, nil
+}
+
+// handleNFSUpdate handles NFSAPI.Update
+func handleNFSUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.NFSUpdateRequest `mapconv:"Appliance,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.NFSUpdateRequest{}
+	}
+
+	result0, err := fake.NewNFSOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.NFS{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Appliance"] = payload0
+	return envelope, nil
+}
+
+// handleNFSDelete handles NFSAPI.Delete
+func handleNFSDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewNFSOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleNFSBoot handles NFSAPI.Boot
+func handleNFSBoot(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewNFSOp().Boot(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleNFSShutdown handles NFSAPI.Shutdown
+func handleNFSShutdown(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	shutdownOption := &sacloud.ShutdownOption{}
+	if err := mapconv.ConvertFrom(body, shutdownOption); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	err := fake.NewNFSOp().Shutdown(ctx, zone, id, shutdownOption)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleNFSReset handles NFSAPI.Reset
+func handleNFSReset(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewNFSOp().Reset(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleNFSMonitorFreeDiskSize handles NFSAPI.MonitorFreeDiskSize
+func handleNFSMonitorFreeDiskSize(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	condition := &sacloud.MonitorCondition{}
+	if err := mapconv.ConvertFrom(body, condition); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewNFSOp().MonitorFreeDiskSize(ctx, zone, id, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0, err := newMonitorValuesPayload(result0)
+	if err != nil {
+		return nil, err
+	}
+	envelope["Data"] = payload0
+	return envelope, nil
+}
+
+// handleNFSMonitorInterface handles NFSAPI.MonitorInterface
+func handleNFSMonitorInterface(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	condition := &sacloud.MonitorCondition{}
+	if err := mapconv.ConvertFrom(body, condition); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewNFSOp().MonitorInterface(ctx, zone, id, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0, err := newMonitorValuesPayload(result0)
+	if err != nil {
+		return nil, err
+	}
+	envelope["Data"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* Note
+*************************************************/
+
+// handleNoteFind handles NoteAPI.Find
+func handleNoteFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewNoteOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.Note
+	for _, v := range result0 {
+		payload := &naked.Note{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Notes"] = payload0
+	return envelope, nil
+}
+
+// handleNoteCreate handles NoteAPI.Create
+func handleNoteCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.NoteCreateRequest `mapconv:"Note,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.NoteCreateRequest{}
+	}
+
+	result0, err := fake.NewNoteOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Note{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Note"] = payload0
+	return envelope, nil
+}
+
+// handleNoteRead handles NoteAPI.Read
+func handleNoteRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewNoteOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Note{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Note"] = payload0
+	return envelope, nil
+}
+
+// handleNoteUpdate handles NoteAPI.Update
+func handleNoteUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.NoteUpdateRequest `mapconv:"Note,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.NoteUpdateRequest{}
+	}
+
+	result0, err := fake.NewNoteOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Note{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Note"] = payload0
+	return envelope, nil
+}
+
+// handleNoteDelete handles NoteAPI.Delete
+func handleNoteDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewNoteOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+/*************************************************
+* PacketFilter
+*************************************************/
+
+// handlePacketFilterFind handles PacketFilterAPI.Find
+func handlePacketFilterFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewPacketFilterOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.PacketFilter
+	for _, v := range result0 {
+		payload := &naked.PacketFilter{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["PacketFilters"] = payload0
+	return envelope, nil
+}
+
+// handlePacketFilterCreate handles PacketFilterAPI.Create
+func handlePacketFilterCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.PacketFilterCreateRequest `mapconv:"PacketFilter,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.PacketFilterCreateRequest{}
+	}
+
+	result0, err := fake.NewPacketFilterOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.PacketFilter{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["PacketFilter"] = payload0
+	return envelope, nil
+}
+
+// handlePacketFilterRead handles PacketFilterAPI.Read
+func handlePacketFilterRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewPacketFilterOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.PacketFilter{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["PacketFilter"] = payload0
+	return envelope, nil
+}
+
+// handlePacketFilterUpdate handles PacketFilterAPI.Update
+func handlePacketFilterUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.PacketFilterUpdateRequest `mapconv:"PacketFilter,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.PacketFilterUpdateRequest{}
+	}
+
+	result0, err := fake.NewPacketFilterOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.PacketFilter{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["PacketFilter"] = payload0
+	return envelope, nil
+}
+
+// handlePacketFilterDelete handles PacketFilterAPI.Delete
+func handlePacketFilterDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewPacketFilterOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+/*************************************************
+* PrivateHost
+*************************************************/
+
+// handlePrivateHostFind handles PrivateHostAPI.Find
+func handlePrivateHostFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewPrivateHostOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.PrivateHost
+	for _, v := range result0 {
+		payload := &naked.PrivateHost{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["PrivateHosts"] = payload0
+	return envelope, nil
+}
+
+// handlePrivateHostCreate handles PrivateHostAPI.Create
+func handlePrivateHostCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.PrivateHostCreateRequest `mapconv:"PrivateHost,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.PrivateHostCreateRequest{}
+	}
+
+	result0, err := fake.NewPrivateHostOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.PrivateHost{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["PrivateHost"] = payload0
+	return envelope, nil
+}
+
+// handlePrivateHostRead handles PrivateHostAPI.Read
+func handlePrivateHostRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewPrivateHostOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.PrivateHost{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["PrivateHost"] = payload0
+	return envelope, nil
+}
+
+// handlePrivateHostUpdate handles PrivateHostAPI.Update
+func handlePrivateHostUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.PrivateHostUpdateRequest `mapconv:"PrivateHost,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.PrivateHostUpdateRequest{}
+	}
+
+	result0, err := fake.NewPrivateHostOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.PrivateHost{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["PrivateHost"] = payload0
+	return envelope, nil
+}
+
+// handlePrivateHostDelete handles PrivateHostAPI.Delete
+func handlePrivateHostDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewPrivateHostOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+/*************************************************
+* PrivateHostPlan
+*************************************************/
+
+// handlePrivateHostPlanFind handles PrivateHostPlanAPI.Find
+func handlePrivateHostPlanFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewPrivateHostPlanOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.PrivateHostPlan
+	for _, v := range result0 {
+		payload := &naked.PrivateHostPlan{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["PrivateHostPlans"] = payload0
+	return envelope, nil
+}
+
+// handlePrivateHostPlanRead handles PrivateHostPlanAPI.Read
+func handlePrivateHostPlanRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewPrivateHostPlanOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.PrivateHostPlan{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["PrivateHostPlan"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* ProxyLB
+*************************************************/
+
+// handleProxyLBFind handles ProxyLBAPI.Find
+func handleProxyLBFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewProxyLBOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.ProxyLB
+	for _, v := range result0 {
+		payload := &naked.ProxyLB{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["CommonServiceItems"] = payload0
+	return envelope, nil
+}
+
+// handleProxyLBCreate handles ProxyLBAPI.Create
+func handleProxyLBCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.ProxyLBCreateRequest `mapconv:"CommonServiceItem,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.ProxyLBCreateRequest{}
+	}
+
+	result0, err := fake.NewProxyLBOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.ProxyLB{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CommonServiceItem"] = payload0
+	return envelope, nil
+}
+
+// handleProxyLBRead handles ProxyLBAPI.Read
+func handleProxyLBRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewProxyLBOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.ProxyLB{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CommonServiceItem"] = payload0
+	return envelope, nil
+}
+
+// handleProxyLBUpdate handles ProxyLBAPI.Update
+func handleProxyLBUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.ProxyLBUpdateRequest `mapconv:"CommonServiceItem,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.ProxyLBUpdateRequest{}
+	}
+
+	result0, err := fake.NewProxyLBOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.ProxyLB{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CommonServiceItem"] = payload0
+	return envelope, nil
+}
+
+// handleProxyLBDelete handles ProxyLBAPI.Delete
+func handleProxyLBDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewProxyLBOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleProxyLBGetCertificates handles ProxyLBAPI.GetCertificates
+func handleProxyLBGetCertificates(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewProxyLBOp().GetCertificates(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.ProxyLBCertificates{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["ProxyLB"] = payload0
+	return envelope, nil
+}
+
+// handleProxyLBSetCertificates handles ProxyLBAPI.SetCertificates
+func handleProxyLBSetCertificates(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.ProxyLBSetCertificatesRequest `mapconv:"ProxyLB,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.ProxyLBSetCertificatesRequest{}
+	}
+
+	result0, err := fake.NewProxyLBOp().SetCertificates(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.ProxyLBCertificates{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["ProxyLB"] = payload0
+	return envelope, nil
+}
+
+// handleProxyLBDeleteCertificates handles ProxyLBAPI.DeleteCertificates
+func handleProxyLBDeleteCertificates(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewProxyLBOp().DeleteCertificates(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleProxyLBRenewLetsEncryptCert handles ProxyLBAPI.RenewLetsEncryptCert
+func handleProxyLBRenewLetsEncryptCert(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewProxyLBOp().RenewLetsEncryptCert(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleProxyLBHealthStatus handles ProxyLBAPI.HealthStatus
+func handleProxyLBHealthStatus(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewProxyLBOp().HealthStatus(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.ProxyLBHealth{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["ProxyLB"] = payload0
+	return envelope, nil
+}
+
+// handleProxyLBMonitorConnection handles ProxyLBAPI.MonitorConnection
+func handleProxyLBMonitorConnection(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	condition := &sacloud.MonitorCondition{}
+	if err := mapconv.ConvertFrom(body, condition); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewProxyLBOp().MonitorConnection(ctx, zone, id, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0, err := newMonitorValuesPayload(result0)
+	if err != nil {
+		return nil, err
+	}
+	envelope["Data"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* Region
+*************************************************/
+
+// handleRegionFind handles RegionAPI.Find
+func handleRegionFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewRegionOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.Region
+	for _, v := range result0 {
+		payload := &naked.Region{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Regions"] = payload0
+	return envelope, nil
+}
+
+// handleRegionRead handles RegionAPI.Read
+func handleRegionRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewRegionOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Region{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Region"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* Server
+*************************************************/
+
+// handleServerFind handles ServerAPI.Find
+func handleServerFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewServerOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.Server
+	for _, v := range result0 {
+		payload := &naked.Server{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Servers"] = payload0
+	return envelope, nil
+}
+
+// handleServerCreate handles ServerAPI.Create
+func handleServerCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.ServerCreateRequest `mapconv:"Server,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.ServerCreateRequest{}
+	}
+
+	result0, err := fake.NewServerOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Server{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Server"] = payload0
+	return envelope, nil
+}
+
+// handleServerRead handles ServerAPI.Read
+func handleServerRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewServerOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Server{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Server"] = payload0
+	return envelope, nil
+}
+
+// handleServerUpdate handles ServerAPI.Update
+func handleServerUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.ServerUpdateRequest `mapconv:"Server,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.ServerUpdateRequest{}
+	}
+
+	result0, err := fake.NewServerOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Server{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Server"] = payload0
+	return envelope, nil
+}
+
+// handleServerDelete handles ServerAPI.Delete
+func handleServerDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewServerOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleServerChangePlan handles ServerAPI.ChangePlan
+func handleServerChangePlan(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	plan := &sacloud.ServerChangePlanRequest{}
+	if err := mapconv.ConvertFrom(body, plan); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewServerOp().ChangePlan(ctx, zone, id, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Server{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Server"] = payload0
+	return envelope, nil
+}
+
+// handleServerInsertCDROM handles ServerAPI.InsertCDROM
+func handleServerInsertCDROM(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		ArginsertParam *sacloud.InsertCDROMRequest `mapconv:"CDROM"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.ArginsertParam == nil {
+		args.ArginsertParam = &sacloud.InsertCDROMRequest{}
+	}
+
+	err := fake.NewServerOp().InsertCDROM(ctx, zone, id, args.ArginsertParam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleServerEjectCDROM handles ServerAPI.EjectCDROM
+func handleServerEjectCDROM(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		ArginsertParam *sacloud.EjectCDROMRequest `mapconv:"CDROM"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.ArginsertParam == nil {
+		args.ArginsertParam = &sacloud.EjectCDROMRequest{}
+	}
+
+	err := fake.NewServerOp().EjectCDROM(ctx, zone, id, args.ArginsertParam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleServerBoot handles ServerAPI.Boot
+func handleServerBoot(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewServerOp().Boot(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleServerShutdown handles ServerAPI.Shutdown
+func handleServerShutdown(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	shutdownOption := &sacloud.ShutdownOption{}
+	if err := mapconv.ConvertFrom(body, shutdownOption); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	err := fake.NewServerOp().Shutdown(ctx, zone, id, shutdownOption)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleServerReset handles ServerAPI.Reset
+func handleServerReset(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewServerOp().Reset(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleServerMonitor handles ServerAPI.Monitor
+func handleServerMonitor(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	condition := &sacloud.MonitorCondition{}
+	if err := mapconv.ConvertFrom(body, condition); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewServerOp().Monitor(ctx, zone, id, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0, err := newMonitorValuesPayload(result0)
+	if err != nil {
+		return nil, err
+	}
+	envelope["Data"] = payload0
+	return envelope, nil
+}
+
+// handleServerGetVNCProxy handles ServerAPI.GetVNCProxy
+func handleServerGetVNCProxy(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewServerOp().GetVNCProxy(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.VNCProxyInfo{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	if err := embedPayload(envelope, payload0); err != nil {
+		return nil, err
+	}
+	return envelope, nil
+}
+
+// handleServerSendKey handles ServerAPI.SendKey
+func handleServerSendKey(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	keyboardParam := &sacloud.SendKeyRequest{}
+	if err := mapconv.ConvertFrom(body, keyboardParam); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	err := fake.NewServerOp().SendKey(ctx, zone, id, keyboardParam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleServerSendNMI handles ServerAPI.SendNMI
+func handleServerSendNMI(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewServerOp().SendNMI(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleServerGetScreenshot handles ServerAPI.GetScreenshot
+func handleServerGetScreenshot(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	condition := &sacloud.GetScreenshotRequest{}
+	if err := mapconv.ConvertFrom(body, condition); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewServerOp().GetScreenshot(ctx, zone, id, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.VNCSnapshotInfo{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	if err := embedPayload(envelope, payload0); err != nil {
+		return nil, err
+	}
+	return envelope, nil
+}
+
+/*************************************************
+* ServerPlan
+*************************************************/
+
+// handleServerPlanFind handles ServerPlanAPI.Find
+func handleServerPlanFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewServerPlanOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.ServerPlan
+	for _, v := range result0 {
+		payload := &naked.ServerPlan{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["ServerPlans"] = payload0
+	return envelope, nil
+}
+
+// handleServerPlanRead handles ServerPlanAPI.Read
+func handleServerPlanRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewServerPlanOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.ServerPlan{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["ServerPlan"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* ServiceClass
+*************************************************/
+
+// handleServiceClassFind handles ServiceClassAPI.Find
+func handleServiceClassFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewServiceClassOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.ServiceClass
+	for _, v := range result0 {
+		payload := &naked.ServiceClass{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["ServiceClasss"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* SIM
+*************************************************/
+
+// handleSIMFind handles SIMAPI.Find
+func handleSIMFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewSIMOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.SIM
+	for _, v := range result0 {
+		payload := &naked.SIM{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["CommonServiceItems"] = payload0
+	return envelope, nil
+}
+
+// handleSIMCreate handles SIMAPI.Create
+func handleSIMCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.SIMCreateRequest `mapconv:"CommonServiceItem,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.SIMCreateRequest{}
+	}
+
+	result0, err := fake.NewSIMOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.SIM{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CommonServiceItem"] = payload0
+	return envelope, nil
+}
+
+// handleSIMRead handles SIMAPI.Read
+func handleSIMRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewSIMOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.SIM{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CommonServiceItem"] = payload0
+	return envelope, nil
+}
+
+// handleSIMUpdate handles SIMAPI.Update
+func handleSIMUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.SIMUpdateRequest `mapconv:"CommonServiceItem,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.SIMUpdateRequest{}
+	}
+
+	result0, err := fake.NewSIMOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.SIM{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CommonServiceItem"] = payload0
+	return envelope, nil
+}
+
+// handleSIMDelete handles SIMAPI.Delete
+func handleSIMDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewSIMOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleSIMActivate handles SIMAPI.Activate
+func handleSIMActivate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewSIMOp().Activate(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleSIMDeactivate handles SIMAPI.Deactivate
+func handleSIMDeactivate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewSIMOp().Deactivate(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleSIMAssignIP handles SIMAPI.AssignIP
+func handleSIMAssignIP(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.SIMAssignIPRequest `mapconv:"SIM,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.SIMAssignIPRequest{}
+	}
+
+	err := fake.NewSIMOp().AssignIP(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleSIMClearIP handles SIMAPI.ClearIP
+func handleSIMClearIP(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewSIMOp().ClearIP(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleSIMIMEILock handles SIMAPI.IMEILock
+func handleSIMIMEILock(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.SIMIMEILockRequest `mapconv:"SIM,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.SIMIMEILockRequest{}
+	}
+
+	err := fake.NewSIMOp().IMEILock(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleSIMIMEIUnlock handles SIMAPI.IMEIUnlock
+func handleSIMIMEIUnlock(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewSIMOp().IMEIUnlock(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleSIMLogs handles SIMAPI.Logs
+func handleSIMLogs(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewSIMOp().Logs(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.SIMLog
+	for _, v := range result0 {
+		payload := &naked.SIMLog{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Logs"] = payload0
+	return envelope, nil
+}
+
+// handleSIMGetNetworkOperator handles SIMAPI.GetNetworkOperator
+func handleSIMGetNetworkOperator(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewSIMOp().GetNetworkOperator(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.SIMNetworkOperatorConfig
+	for _, v := range result0 {
+		payload := &naked.SIMNetworkOperatorConfig{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["NetworkOperationConfigs"] = payload0
+	return envelope, nil
+}
+
+// handleSIMSetNetworkOperator handles SIMAPI.SetNetworkOperator
+func handleSIMSetNetworkOperator(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	configs := &sacloud.SIMNetworkOperatorConfigs{}
+	if err := mapconv.ConvertFrom(body, configs); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	err := fake.NewSIMOp().SetNetworkOperator(ctx, zone, id, configs)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleSIMMonitorSIM handles SIMAPI.MonitorSIM
+func handleSIMMonitorSIM(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	condition := &sacloud.MonitorCondition{}
+	if err := mapconv.ConvertFrom(body, condition); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewSIMOp().MonitorSIM(ctx, zone, id, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0, err := newMonitorValuesPayload(result0)
+	if err != nil {
+		return nil, err
+	}
+	envelope["Data"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* SimpleMonitor
+*************************************************/
+
+// handleSimpleMonitorFind handles SimpleMonitorAPI.Find
+func handleSimpleMonitorFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewSimpleMonitorOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.SimpleMonitor
+	for _, v := range result0 {
+		payload := &naked.SimpleMonitor{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["CommonServiceItems"] = payload0
+	return envelope, nil
+}
+
+// handleSimpleMonitorCreate handles SimpleMonitorAPI.Create
+func handleSimpleMonitorCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.SimpleMonitorCreateRequest `mapconv:"CommonServiceItem,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.SimpleMonitorCreateRequest{}
+	}
+
+	result0, err := fake.NewSimpleMonitorOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.SimpleMonitor{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CommonServiceItem"] = payload0
+	return envelope, nil
+}
+
+// handleSimpleMonitorRead handles SimpleMonitorAPI.Read
+func handleSimpleMonitorRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewSimpleMonitorOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.SimpleMonitor{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CommonServiceItem"] = payload0
+	return envelope, nil
+}
+
+// handleSimpleMonitorUpdate handles SimpleMonitorAPI.Update
+func handleSimpleMonitorUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.SimpleMonitorUpdateRequest `mapconv:"CommonServiceItem,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.SimpleMonitorUpdateRequest{}
+	}
+
+	result0, err := fake.NewSimpleMonitorOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.SimpleMonitor{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["CommonServiceItem"] = payload0
+	return envelope, nil
+}
+
+// handleSimpleMonitorDelete handles SimpleMonitorAPI.Delete
+func handleSimpleMonitorDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewSimpleMonitorOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleSimpleMonitorMonitorResponseTime handles SimpleMonitorAPI.MonitorResponseTime
+func handleSimpleMonitorMonitorResponseTime(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	condition := &sacloud.MonitorCondition{}
+	if err := mapconv.ConvertFrom(body, condition); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewSimpleMonitorOp().MonitorResponseTime(ctx, zone, id, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0, err := newMonitorValuesPayload(result0)
+	if err != nil {
+		return nil, err
+	}
+	envelope["Data"] = payload0
+	return envelope, nil
+}
+
+// handleSimpleMonitorHealthStatus handles SimpleMonitorAPI.HealthStatus
+func handleSimpleMonitorHealthStatus(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewSimpleMonitorOp().HealthStatus(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.SimpleMonitorHealthStatus{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["SimpleMonitor"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* SSHKey
+*************************************************/
+
+// handleSSHKeyFind handles SSHKeyAPI.Find
+func handleSSHKeyFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewSSHKeyOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.SSHKey
+	for _, v := range result0 {
+		payload := &naked.SSHKey{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["SSHKeys"] = payload0
+	return envelope, nil
+}
+
+// handleSSHKeyCreate handles SSHKeyAPI.Create
+func handleSSHKeyCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.SSHKeyCreateRequest `mapconv:"SSHKey,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.SSHKeyCreateRequest{}
+	}
+
+	result0, err := fake.NewSSHKeyOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.SSHKey{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["SSHKey"] = payload0
+	return envelope, nil
+}
+
+// handleSSHKeyGenerate handles SSHKeyAPI.Generate
+func handleSSHKeyGenerate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.SSHKeyGenerateRequest `mapconv:"KeyPair,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.SSHKeyGenerateRequest{}
+	}
+
+	result0, err := fake.NewSSHKeyOp().Generate(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.SSHKeyGenerated{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["SSHKey"] = payload0
+	return envelope, nil
+}
+
+// handleSSHKeyRead handles SSHKeyAPI.Read
+func handleSSHKeyRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewSSHKeyOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.SSHKey{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["SSHKey"] = payload0
+	return envelope, nil
+}
+
+// handleSSHKeyUpdate handles SSHKeyAPI.Update
+func handleSSHKeyUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.SSHKeyUpdateRequest `mapconv:"SSHKey,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.SSHKeyUpdateRequest{}
+	}
+
+	result0, err := fake.NewSSHKeyOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.SSHKey{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["SSHKey"] = payload0
+	return envelope, nil
+}
+
+// handleSSHKeyDelete handles SSHKeyAPI.Delete
+func handleSSHKeyDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewSSHKeyOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+/*************************************************
+* Storage
+*************************************************/
+
+// handleStorageFind handles StorageAPI.Find
+func handleStorageFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewStorageOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.Storage
+	for _, v := range result0 {
+		payload := &naked.Storage{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Storages"] = payload0
+	return envelope, nil
+}
+
+// handleStorageRead handles StorageAPI.Read
+func handleStorageRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewStorageOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Storage{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Storage"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* Subnet
+*************************************************/
+
+// handleSubnetFind handles SubnetAPI.Find
+func handleSubnetFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewSubnetOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.Subnet
+	for _, v := range result0 {
+		payload := &naked.Subnet{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Subnets"] = payload0
+	return envelope, nil
+}
+
+// handleSubnetRead handles SubnetAPI.Read
+func handleSubnetRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewSubnetOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Subnet{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Subnet"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* Switch
+*************************************************/
+
+// handleSwitchFind handles SwitchAPI.Find
+func handleSwitchFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewSwitchOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.Switch
+	for _, v := range result0 {
+		payload := &naked.Switch{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Switches"] = payload0
+	return envelope, nil
+}
+
+// handleSwitchCreate handles SwitchAPI.Create
+func handleSwitchCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.SwitchCreateRequest `mapconv:"Switch,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.SwitchCreateRequest{}
+	}
+
+	result0, err := fake.NewSwitchOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Switch{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Switch"] = payload0
+	return envelope, nil
+}
+
+// handleSwitchRead handles SwitchAPI.Read
+func handleSwitchRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewSwitchOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Switch{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Switch"] = payload0
+	return envelope, nil
+}
+
+// handleSwitchUpdate handles SwitchAPI.Update
+func handleSwitchUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.SwitchUpdateRequest `mapconv:"Switch,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.SwitchUpdateRequest{}
+	}
+
+	result0, err := fake.NewSwitchOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Switch{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Switch"] = payload0
+	return envelope, nil
+}
+
+// handleSwitchDelete handles SwitchAPI.Delete
+func handleSwitchDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewSwitchOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleSwitchConnectToBridge handles SwitchAPI.ConnectToBridge
+func handleSwitchConnectToBridge(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	var bridgeID types.ID
+	if err := params.bind("bridgeID", &bridgeID); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewSwitchOp().ConnectToBridge(ctx, zone, id, bridgeID)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleSwitchDisconnectFromBridge handles SwitchAPI.DisconnectFromBridge
+func handleSwitchDisconnectFromBridge(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewSwitchOp().DisconnectFromBridge(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+/*************************************************
+* VPCRouter
+*************************************************/
+
+// handleVPCRouterFind handles VPCRouterAPI.Find
+func handleVPCRouterFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewVPCRouterOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.VPCRouter
+	for _, v := range result0 {
+		payload := &naked.VPCRouter{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Appliances"] = payload0
+	return envelope, nil
+}
+
+// handleVPCRouterCreate handles VPCRouterAPI.Create
+func handleVPCRouterCreate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.VPCRouterCreateRequest `mapconv:"Appliance,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.VPCRouterCreateRequest{}
+	}
+
+	result0, err := fake.NewVPCRouterOp().Create(ctx, zone, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.VPCRouter{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Appliance"] = payload0
+	return envelope, nil
+}
+
+// handleVPCRouterRead handles VPCRouterAPI.Read
+func handleVPCRouterRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewVPCRouterOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.VPCRouter{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Appliance"] = payload0
+	return envelope, nil
+}
+
+// handleVPCRouterUpdate handles VPCRouterAPI.Update
+func handleVPCRouterUpdate(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	args := &struct {
+		Argparam *sacloud.VPCRouterUpdateRequest `mapconv:"Appliance,recursive"`
+	}{}
+	if err := mapconv.ConvertFrom(body, args); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+	if args.Argparam == nil {
+		args.Argparam = &sacloud.VPCRouterUpdateRequest{}
+	}
+
+	result0, err := fake.NewVPCRouterOp().Update(ctx, zone, id, args.Argparam)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.VPCRouter{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Appliance"] = payload0
+	return envelope, nil
+}
+
+// handleVPCRouterDelete handles VPCRouterAPI.Delete
+func handleVPCRouterDelete(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewVPCRouterOp().Delete(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleVPCRouterConfig handles VPCRouterAPI.Config
+func handleVPCRouterConfig(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewVPCRouterOp().Config(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleVPCRouterBoot handles VPCRouterAPI.Boot
+func handleVPCRouterBoot(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewVPCRouterOp().Boot(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleVPCRouterShutdown handles VPCRouterAPI.Shutdown
+func handleVPCRouterShutdown(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	shutdownOption := &sacloud.ShutdownOption{}
+	if err := mapconv.ConvertFrom(body, shutdownOption); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	err := fake.NewVPCRouterOp().Shutdown(ctx, zone, id, shutdownOption)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleVPCRouterReset handles VPCRouterAPI.Reset
+func handleVPCRouterReset(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewVPCRouterOp().Reset(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleVPCRouterConnectToSwitch handles VPCRouterAPI.ConnectToSwitch
+func handleVPCRouterConnectToSwitch(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	var nicIndex int
+	if err := params.bind("nicIndex", &nicIndex); err != nil {
+		return nil, err
+	}
+	var switchID types.ID
+	if err := params.bind("switchID", &switchID); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewVPCRouterOp().ConnectToSwitch(ctx, zone, id, nicIndex, switchID)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleVPCRouterDisconnectFromSwitch handles VPCRouterAPI.DisconnectFromSwitch
+func handleVPCRouterDisconnectFromSwitch(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	var nicIndex int
+	if err := params.bind("nicIndex", &nicIndex); err != nil {
+		return nil, err
+	}
+
+	err := fake.NewVPCRouterOp().DisconnectFromSwitch(ctx, zone, id, nicIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	return envelope, nil
+}
+
+// handleVPCRouterMonitorInterface handles VPCRouterAPI.MonitorInterface
+func handleVPCRouterMonitorInterface(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+	var index int
+	if err := params.bind("index", &index); err != nil {
+		return nil, err
+	}
+	condition := &sacloud.MonitorCondition{}
+	if err := mapconv.ConvertFrom(body, condition); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewVPCRouterOp().MonitorInterface(ctx, zone, id, index, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0, err := newMonitorValuesPayload(result0)
+	if err != nil {
+		return nil, err
+	}
+	envelope["Data"] = payload0
+	return envelope, nil
+}
+
+/*************************************************
+* Zone
+*************************************************/
+
+// handleZoneFind handles ZoneAPI.Find
+func handleZoneFind(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	conditions := &sacloud.FindCondition{}
+	if err := mapconv.ConvertFrom(body, conditions); err != nil {
+		return nil, newErrorBadRequest(err.Error())
+	}
+
+	result0, err := fake.NewZoneOp().Find(ctx, zone, conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newPluralEnvelope(len(result0))
+	var payload0 []*naked.Zone
+	for _, v := range result0 {
+		payload := &naked.Zone{}
+		if err := mapconv.ConvertTo(v, payload); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	envelope["Zones"] = payload0
+	return envelope, nil
+}
+
+// handleZoneRead handles ZoneAPI.Read
+func handleZoneRead(ctx context.Context, params pathParams, body map[string]interface{}) (map[string]interface{}, error) {
+	var zone string
+	if err := params.bind("zone", &zone); err != nil {
+		return nil, err
+	}
+	var id types.ID
+	if err := params.bind("id", &id); err != nil {
+		return nil, err
+	}
+
+	result0, err := fake.NewZoneOp().Read(ctx, zone, id)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope := newSingularEnvelope()
+	payload0 := &naked.Zone{}
+	if err := mapconv.ConvertTo(result0, payload0); err != nil {
+		return nil, err
+	}
+	envelope["Zone"] = payload0
+	return envelope, nil
+}
@@ -15,6 +15,11 @@ var (
 	PowerOnDuration = 10 * time.Millisecond
 	// PowerOffDuration 電源Off処理のtickerで利用するduration
 	PowerOffDuration = 10 * time.Millisecond
+	// AutoBackupDuration 自動バックアップのスケジュールで1日として扱うduration
+	AutoBackupDuration = 10 * time.Millisecond
+
+	// Now 証明書の有効期限判定などで現在時刻として扱う時刻を返すfunc
+	Now = time.Now
 )
 
 func startDiskCopy(resourceKey, zone string, readFunc func() (interface{}, error)) {
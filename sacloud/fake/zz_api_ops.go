@@ -11,27 +11,75 @@ func SwitchFactoryFuncToFake() {
 	sacloud.SetClientFactoryFunc(ResourceArchive, func(caller sacloud.APICaller) interface{} {
 		return NewArchiveOp()
 	})
+	sacloud.SetClientFactoryFunc(ResourceAuthStatus, func(caller sacloud.APICaller) interface{} {
+		return NewAuthStatusOp()
+	})
+	sacloud.SetClientFactoryFunc(ResourceAutoBackup, func(caller sacloud.APICaller) interface{} {
+		return NewAutoBackupOp()
+	})
+	sacloud.SetClientFactoryFunc(ResourceBill, func(caller sacloud.APICaller) interface{} {
+		return NewBillOp()
+	})
 	sacloud.SetClientFactoryFunc(ResourceBridge, func(caller sacloud.APICaller) interface{} {
 		return NewBridgeOp()
 	})
 	sacloud.SetClientFactoryFunc(ResourceCDROM, func(caller sacloud.APICaller) interface{} {
 		return NewCDROMOp()
 	})
+	sacloud.SetClientFactoryFunc(ResourceCoupon, func(caller sacloud.APICaller) interface{} {
+		return NewCouponOp()
+	})
+	sacloud.SetClientFactoryFunc(ResourceDatabase, func(caller sacloud.APICaller) interface{} {
+		return NewDatabaseOp()
+	})
 	sacloud.SetClientFactoryFunc(ResourceDisk, func(caller sacloud.APICaller) interface{} {
 		return NewDiskOp()
 	})
+	sacloud.SetClientFactoryFunc(ResourceDiskPlan, func(caller sacloud.APICaller) interface{} {
+		return NewDiskPlanOp()
+	})
+	sacloud.SetClientFactoryFunc(ResourceDNS, func(caller sacloud.APICaller) interface{} {
+		return NewDNSOp()
+	})
+	sacloud.SetClientFactoryFunc(ResourceESUsage, func(caller sacloud.APICaller) interface{} {
+		return NewESUsageOp()
+	})
 	sacloud.SetClientFactoryFunc(ResourceGSLB, func(caller sacloud.APICaller) interface{} {
 		return NewGSLBOp()
 	})
+	sacloud.SetClientFactoryFunc(ResourceIcon, func(caller sacloud.APICaller) interface{} {
+		return NewIconOp()
+	})
 	sacloud.SetClientFactoryFunc(ResourceInterface, func(caller sacloud.APICaller) interface{} {
 		return NewInterfaceOp()
 	})
 	sacloud.SetClientFactoryFunc(ResourceInternet, func(caller sacloud.APICaller) interface{} {
 		return NewInternetOp()
 	})
+	sacloud.SetClientFactoryFunc(ResourceInternetPlan, func(caller sacloud.APICaller) interface{} {
+		return NewInternetPlanOp()
+	})
+	sacloud.SetClientFactoryFunc(ResourceIPAddress, func(caller sacloud.APICaller) interface{} {
+		return NewIPAddressOp()
+	})
+	sacloud.SetClientFactoryFunc(ResourceIPv6Addr, func(caller sacloud.APICaller) interface{} {
+		return NewIPv6AddrOp()
+	})
+	sacloud.SetClientFactoryFunc(ResourceIPv6Net, func(caller sacloud.APICaller) interface{} {
+		return NewIPv6NetOp()
+	})
+	sacloud.SetClientFactoryFunc(ResourceLicense, func(caller sacloud.APICaller) interface{} {
+		return NewLicenseOp()
+	})
+	sacloud.SetClientFactoryFunc(ResourceLicensePlan, func(caller sacloud.APICaller) interface{} {
+		return NewLicensePlanOp()
+	})
 	sacloud.SetClientFactoryFunc(ResourceLoadBalancer, func(caller sacloud.APICaller) interface{} {
 		return NewLoadBalancerOp()
 	})
+	sacloud.SetClientFactoryFunc(ResourceMobileGateway, func(caller sacloud.APICaller) interface{} {
+		return NewMobileGatewayOp()
+	})
 	sacloud.SetClientFactoryFunc(ResourceNFS, func(caller sacloud.APICaller) interface{} {
 		return NewNFSOp()
 	})
@@ -41,12 +89,42 @@ func SwitchFactoryFuncToFake() {
 	sacloud.SetClientFactoryFunc(ResourcePacketFilter, func(caller sacloud.APICaller) interface{} {
 		return NewPacketFilterOp()
 	})
+	sacloud.SetClientFactoryFunc(ResourcePrivateHost, func(caller sacloud.APICaller) interface{} {
+		return NewPrivateHostOp()
+	})
+	sacloud.SetClientFactoryFunc(ResourcePrivateHostPlan, func(caller sacloud.APICaller) interface{} {
+		return NewPrivateHostPlanOp()
+	})
+	sacloud.SetClientFactoryFunc(ResourceProxyLB, func(caller sacloud.APICaller) interface{} {
+		return NewProxyLBOp()
+	})
+	sacloud.SetClientFactoryFunc(ResourceRegion, func(caller sacloud.APICaller) interface{} {
+		return NewRegionOp()
+	})
 	sacloud.SetClientFactoryFunc(ResourceServer, func(caller sacloud.APICaller) interface{} {
 		return NewServerOp()
 	})
+	sacloud.SetClientFactoryFunc(ResourceServerPlan, func(caller sacloud.APICaller) interface{} {
+		return NewServerPlanOp()
+	})
+	sacloud.SetClientFactoryFunc(ResourceServiceClass, func(caller sacloud.APICaller) interface{} {
+		return NewServiceClassOp()
+	})
 	sacloud.SetClientFactoryFunc(ResourceSIM, func(caller sacloud.APICaller) interface{} {
 		return NewSIMOp()
 	})
+	sacloud.SetClientFactoryFunc(ResourceSimpleMonitor, func(caller sacloud.APICaller) interface{} {
+		return NewSimpleMonitorOp()
+	})
+	sacloud.SetClientFactoryFunc(ResourceSSHKey, func(caller sacloud.APICaller) interface{} {
+		return NewSSHKeyOp()
+	})
+	sacloud.SetClientFactoryFunc(ResourceStorage, func(caller sacloud.APICaller) interface{} {
+		return NewStorageOp()
+	})
+	sacloud.SetClientFactoryFunc(ResourceSubnet, func(caller sacloud.APICaller) interface{} {
+		return NewSubnetOp()
+	})
 	sacloud.SetClientFactoryFunc(ResourceSwitch, func(caller sacloud.APICaller) interface{} {
 		return NewSwitchOp()
 	})
@@ -74,6 +152,54 @@ func NewArchiveOp() sacloud.ArchiveAPI {
 	}
 }
 
+/*************************************************
+* AuthStatusOp
+*************************************************/
+
+// AuthStatusOp is fake implementation of AuthStatusAPI interface
+type AuthStatusOp struct {
+	key string
+}
+
+// NewAuthStatusOp creates new AuthStatusOp instance
+func NewAuthStatusOp() sacloud.AuthStatusAPI {
+	return &AuthStatusOp{
+		key: ResourceAuthStatus,
+	}
+}
+
+/*************************************************
+* AutoBackupOp
+*************************************************/
+
+// AutoBackupOp is fake implementation of AutoBackupAPI interface
+type AutoBackupOp struct {
+	key string
+}
+
+// NewAutoBackupOp creates new AutoBackupOp instance
+func NewAutoBackupOp() sacloud.AutoBackupAPI {
+	return &AutoBackupOp{
+		key: ResourceAutoBackup,
+	}
+}
+
+/*************************************************
+* BillOp
+*************************************************/
+
+// BillOp is fake implementation of BillAPI interface
+type BillOp struct {
+	key string
+}
+
+// NewBillOp creates new BillOp instance
+func NewBillOp() sacloud.BillAPI {
+	return &BillOp{
+		key: ResourceBill,
+	}
+}
+
 /*************************************************
 * BridgeOp
 *************************************************/
@@ -106,6 +232,38 @@ func NewCDROMOp() sacloud.CDROMAPI {
 	}
 }
 
+/*************************************************
+* CouponOp
+*************************************************/
+
+// CouponOp is fake implementation of CouponAPI interface
+type CouponOp struct {
+	key string
+}
+
+// NewCouponOp creates new CouponOp instance
+func NewCouponOp() sacloud.CouponAPI {
+	return &CouponOp{
+		key: ResourceCoupon,
+	}
+}
+
+/*************************************************
+* DatabaseOp
+*************************************************/
+
+// DatabaseOp is fake implementation of DatabaseAPI interface
+type DatabaseOp struct {
+	key string
+}
+
+// NewDatabaseOp creates new DatabaseOp instance
+func NewDatabaseOp() sacloud.DatabaseAPI {
+	return &DatabaseOp{
+		key: ResourceDatabase,
+	}
+}
+
 /*************************************************
 * DiskOp
 *************************************************/
@@ -122,6 +280,54 @@ func NewDiskOp() sacloud.DiskAPI {
 	}
 }
 
+/*************************************************
+* DiskPlanOp
+*************************************************/
+
+// DiskPlanOp is fake implementation of DiskPlanAPI interface
+type DiskPlanOp struct {
+	key string
+}
+
+// NewDiskPlanOp creates new DiskPlanOp instance
+func NewDiskPlanOp() sacloud.DiskPlanAPI {
+	return &DiskPlanOp{
+		key: ResourceDiskPlan,
+	}
+}
+
+/*************************************************
+* DNSOp
+*************************************************/
+
+// DNSOp is fake implementation of DNSAPI interface
+type DNSOp struct {
+	key string
+}
+
+// NewDNSOp creates new DNSOp instance
+func NewDNSOp() sacloud.DNSAPI {
+	return &DNSOp{
+		key: ResourceDNS,
+	}
+}
+
+/*************************************************
+* ESUsageOp
+*************************************************/
+
+// ESUsageOp is fake implementation of ESUsageAPI interface
+type ESUsageOp struct {
+	key string
+}
+
+// NewESUsageOp creates new ESUsageOp instance
+func NewESUsageOp() sacloud.ESUsageAPI {
+	return &ESUsageOp{
+		key: ResourceESUsage,
+	}
+}
+
 /*************************************************
 * GSLBOp
 *************************************************/
@@ -138,6 +344,22 @@ func NewGSLBOp() sacloud.GSLBAPI {
 	}
 }
 
+/*************************************************
+* IconOp
+*************************************************/
+
+// IconOp is fake implementation of IconAPI interface
+type IconOp struct {
+	key string
+}
+
+// NewIconOp creates new IconOp instance
+func NewIconOp() sacloud.IconAPI {
+	return &IconOp{
+		key: ResourceIcon,
+	}
+}
+
 /*************************************************
 * InterfaceOp
 *************************************************/
@@ -170,6 +392,102 @@ func NewInternetOp() sacloud.InternetAPI {
 	}
 }
 
+/*************************************************
+* InternetPlanOp
+*************************************************/
+
+// InternetPlanOp is fake implementation of InternetPlanAPI interface
+type InternetPlanOp struct {
+	key string
+}
+
+// NewInternetPlanOp creates new InternetPlanOp instance
+func NewInternetPlanOp() sacloud.InternetPlanAPI {
+	return &InternetPlanOp{
+		key: ResourceInternetPlan,
+	}
+}
+
+/*************************************************
+* IPAddressOp
+*************************************************/
+
+// IPAddressOp is fake implementation of IPAddressAPI interface
+type IPAddressOp struct {
+	key string
+}
+
+// NewIPAddressOp creates new IPAddressOp instance
+func NewIPAddressOp() sacloud.IPAddressAPI {
+	return &IPAddressOp{
+		key: ResourceIPAddress,
+	}
+}
+
+/*************************************************
+* IPv6AddrOp
+*************************************************/
+
+// IPv6AddrOp is fake implementation of IPv6AddrAPI interface
+type IPv6AddrOp struct {
+	key string
+}
+
+// NewIPv6AddrOp creates new IPv6AddrOp instance
+func NewIPv6AddrOp() sacloud.IPv6AddrAPI {
+	return &IPv6AddrOp{
+		key: ResourceIPv6Addr,
+	}
+}
+
+/*************************************************
+* IPv6NetOp
+*************************************************/
+
+// IPv6NetOp is fake implementation of IPv6NetAPI interface
+type IPv6NetOp struct {
+	key string
+}
+
+// NewIPv6NetOp creates new IPv6NetOp instance
+func NewIPv6NetOp() sacloud.IPv6NetAPI {
+	return &IPv6NetOp{
+		key: ResourceIPv6Net,
+	}
+}
+
+/*************************************************
+* LicenseOp
+*************************************************/
+
+// LicenseOp is fake implementation of LicenseAPI interface
+type LicenseOp struct {
+	key string
+}
+
+// NewLicenseOp creates new LicenseOp instance
+func NewLicenseOp() sacloud.LicenseAPI {
+	return &LicenseOp{
+		key: ResourceLicense,
+	}
+}
+
+/*************************************************
+* LicensePlanOp
+*************************************************/
+
+// LicensePlanOp is fake implementation of LicensePlanAPI interface
+type LicensePlanOp struct {
+	key string
+}
+
+// NewLicensePlanOp creates new LicensePlanOp instance
+func NewLicensePlanOp() sacloud.LicensePlanAPI {
+	return &LicensePlanOp{
+		key: ResourceLicensePlan,
+	}
+}
+
 /*************************************************
 * LoadBalancerOp
 *************************************************/
@@ -186,6 +504,22 @@ func NewLoadBalancerOp() sacloud.LoadBalancerAPI {
 	}
 }
 
+/*************************************************
+* MobileGatewayOp
+*************************************************/
+
+// MobileGatewayOp is fake implementation of MobileGatewayAPI interface
+type MobileGatewayOp struct {
+	key string
+}
+
+// NewMobileGatewayOp creates new MobileGatewayOp instance
+func NewMobileGatewayOp() sacloud.MobileGatewayAPI {
+	return &MobileGatewayOp{
+		key: ResourceMobileGateway,
+	}
+}
+
 /*************************************************
 * NFSOp
 *************************************************/
@@ -234,6 +568,70 @@ func NewPacketFilterOp() sacloud.PacketFilterAPI {
 	}
 }
 
+/*************************************************
+* PrivateHostOp
+*************************************************/
+
+// PrivateHostOp is fake implementation of PrivateHostAPI interface
+type PrivateHostOp struct {
+	key string
+}
+
+// NewPrivateHostOp creates new PrivateHostOp instance
+func NewPrivateHostOp() sacloud.PrivateHostAPI {
+	return &PrivateHostOp{
+		key: ResourcePrivateHost,
+	}
+}
+
+/*************************************************
+* PrivateHostPlanOp
+*************************************************/
+
+// PrivateHostPlanOp is fake implementation of PrivateHostPlanAPI interface
+type PrivateHostPlanOp struct {
+	key string
+}
+
+// NewPrivateHostPlanOp creates new PrivateHostPlanOp instance
+func NewPrivateHostPlanOp() sacloud.PrivateHostPlanAPI {
+	return &PrivateHostPlanOp{
+		key: ResourcePrivateHostPlan,
+	}
+}
+
+/*************************************************
+* ProxyLBOp
+*************************************************/
+
+// ProxyLBOp is fake implementation of ProxyLBAPI interface
+type ProxyLBOp struct {
+	key string
+}
+
+// NewProxyLBOp creates new ProxyLBOp instance
+func NewProxyLBOp() sacloud.ProxyLBAPI {
+	return &ProxyLBOp{
+		key: ResourceProxyLB,
+	}
+}
+
+/*************************************************
+* RegionOp
+*************************************************/
+
+// RegionOp is fake implementation of RegionAPI interface
+type RegionOp struct {
+	key string
+}
+
+// NewRegionOp creates new RegionOp instance
+func NewRegionOp() sacloud.RegionAPI {
+	return &RegionOp{
+		key: ResourceRegion,
+	}
+}
+
 /*************************************************
 * ServerOp
 *************************************************/
@@ -250,6 +648,38 @@ func NewServerOp() sacloud.ServerAPI {
 	}
 }
 
+/*************************************************
+* ServerPlanOp
+*************************************************/
+
+// ServerPlanOp is fake implementation of ServerPlanAPI interface
+type ServerPlanOp struct {
+	key string
+}
+
+// NewServerPlanOp creates new ServerPlanOp instance
+func NewServerPlanOp() sacloud.ServerPlanAPI {
+	return &ServerPlanOp{
+		key: ResourceServerPlan,
+	}
+}
+
+/*************************************************
+* ServiceClassOp
+*************************************************/
+
+// ServiceClassOp is fake implementation of ServiceClassAPI interface
+type ServiceClassOp struct {
+	key string
+}
+
+// NewServiceClassOp creates new ServiceClassOp instance
+func NewServiceClassOp() sacloud.ServiceClassAPI {
+	return &ServiceClassOp{
+		key: ResourceServiceClass,
+	}
+}
+
 /*************************************************
 * SIMOp
 *************************************************/
@@ -266,6 +696,70 @@ func NewSIMOp() sacloud.SIMAPI {
 	}
 }
 
+/*************************************************
+* SimpleMonitorOp
+*************************************************/
+
+// SimpleMonitorOp is fake implementation of SimpleMonitorAPI interface
+type SimpleMonitorOp struct {
+	key string
+}
+
+// NewSimpleMonitorOp creates new SimpleMonitorOp instance
+func NewSimpleMonitorOp() sacloud.SimpleMonitorAPI {
+	return &SimpleMonitorOp{
+		key: ResourceSimpleMonitor,
+	}
+}
+
+/*************************************************
+* SSHKeyOp
+*************************************************/
+
+// SSHKeyOp is fake implementation of SSHKeyAPI interface
+type SSHKeyOp struct {
+	key string
+}
+
+// NewSSHKeyOp creates new SSHKeyOp instance
+func NewSSHKeyOp() sacloud.SSHKeyAPI {
+	return &SSHKeyOp{
+		key: ResourceSSHKey,
+	}
+}
+
+/*************************************************
+* StorageOp
+*************************************************/
+
+// StorageOp is fake implementation of StorageAPI interface
+type StorageOp struct {
+	key string
+}
+
+// NewStorageOp creates new StorageOp instance
+func NewStorageOp() sacloud.StorageAPI {
+	return &StorageOp{
+		key: ResourceStorage,
+	}
+}
+
+/*************************************************
+* SubnetOp
+*************************************************/
+
+// SubnetOp is fake implementation of SubnetAPI interface
+type SubnetOp struct {
+	key string
+}
+
+// NewSubnetOp creates new SubnetOp instance
+func NewSubnetOp() sacloud.SubnetAPI {
+	return &SubnetOp{
+		key: ResourceSubnet,
+	}
+}
+
 /*************************************************
 * SwitchOp
 *************************************************/
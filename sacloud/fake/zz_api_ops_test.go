@@ -14,6 +14,18 @@ func TestResourceOps(t *testing.T) {
 		t.Fatalf("%s is not sacloud.Archive", op)
 	}
 
+	if op, ok := NewAuthStatusOp().(sacloud.AuthStatusAPI); !ok {
+		t.Fatalf("%s is not sacloud.AuthStatus", op)
+	}
+
+	if op, ok := NewAutoBackupOp().(sacloud.AutoBackupAPI); !ok {
+		t.Fatalf("%s is not sacloud.AutoBackup", op)
+	}
+
+	if op, ok := NewBillOp().(sacloud.BillAPI); !ok {
+		t.Fatalf("%s is not sacloud.Bill", op)
+	}
+
 	if op, ok := NewBridgeOp().(sacloud.BridgeAPI); !ok {
 		t.Fatalf("%s is not sacloud.Bridge", op)
 	}
@@ -22,14 +34,38 @@ func TestResourceOps(t *testing.T) {
 		t.Fatalf("%s is not sacloud.CDROM", op)
 	}
 
+	if op, ok := NewCouponOp().(sacloud.CouponAPI); !ok {
+		t.Fatalf("%s is not sacloud.Coupon", op)
+	}
+
+	if op, ok := NewDatabaseOp().(sacloud.DatabaseAPI); !ok {
+		t.Fatalf("%s is not sacloud.Database", op)
+	}
+
 	if op, ok := NewDiskOp().(sacloud.DiskAPI); !ok {
 		t.Fatalf("%s is not sacloud.Disk", op)
 	}
 
+	if op, ok := NewDiskPlanOp().(sacloud.DiskPlanAPI); !ok {
+		t.Fatalf("%s is not sacloud.DiskPlan", op)
+	}
+
+	if op, ok := NewDNSOp().(sacloud.DNSAPI); !ok {
+		t.Fatalf("%s is not sacloud.DNS", op)
+	}
+
+	if op, ok := NewESUsageOp().(sacloud.ESUsageAPI); !ok {
+		t.Fatalf("%s is not sacloud.ESUsage", op)
+	}
+
 	if op, ok := NewGSLBOp().(sacloud.GSLBAPI); !ok {
 		t.Fatalf("%s is not sacloud.GSLB", op)
 	}
 
+	if op, ok := NewIconOp().(sacloud.IconAPI); !ok {
+		t.Fatalf("%s is not sacloud.Icon", op)
+	}
+
 	if op, ok := NewInterfaceOp().(sacloud.InterfaceAPI); !ok {
 		t.Fatalf("%s is not sacloud.Interface", op)
 	}
@@ -38,10 +74,38 @@ func TestResourceOps(t *testing.T) {
 		t.Fatalf("%s is not sacloud.Internet", op)
 	}
 
+	if op, ok := NewInternetPlanOp().(sacloud.InternetPlanAPI); !ok {
+		t.Fatalf("%s is not sacloud.InternetPlan", op)
+	}
+
+	if op, ok := NewIPAddressOp().(sacloud.IPAddressAPI); !ok {
+		t.Fatalf("%s is not sacloud.IPAddress", op)
+	}
+
+	if op, ok := NewIPv6AddrOp().(sacloud.IPv6AddrAPI); !ok {
+		t.Fatalf("%s is not sacloud.IPv6Addr", op)
+	}
+
+	if op, ok := NewIPv6NetOp().(sacloud.IPv6NetAPI); !ok {
+		t.Fatalf("%s is not sacloud.IPv6Net", op)
+	}
+
+	if op, ok := NewLicenseOp().(sacloud.LicenseAPI); !ok {
+		t.Fatalf("%s is not sacloud.License", op)
+	}
+
+	if op, ok := NewLicensePlanOp().(sacloud.LicensePlanAPI); !ok {
+		t.Fatalf("%s is not sacloud.LicensePlan", op)
+	}
+
 	if op, ok := NewLoadBalancerOp().(sacloud.LoadBalancerAPI); !ok {
 		t.Fatalf("%s is not sacloud.LoadBalancer", op)
 	}
 
+	if op, ok := NewMobileGatewayOp().(sacloud.MobileGatewayAPI); !ok {
+		t.Fatalf("%s is not sacloud.MobileGateway", op)
+	}
+
 	if op, ok := NewNFSOp().(sacloud.NFSAPI); !ok {
 		t.Fatalf("%s is not sacloud.NFS", op)
 	}
@@ -54,14 +118,54 @@ func TestResourceOps(t *testing.T) {
 		t.Fatalf("%s is not sacloud.PacketFilter", op)
 	}
 
+	if op, ok := NewPrivateHostOp().(sacloud.PrivateHostAPI); !ok {
+		t.Fatalf("%s is not sacloud.PrivateHost", op)
+	}
+
+	if op, ok := NewPrivateHostPlanOp().(sacloud.PrivateHostPlanAPI); !ok {
+		t.Fatalf("%s is not sacloud.PrivateHostPlan", op)
+	}
+
+	if op, ok := NewProxyLBOp().(sacloud.ProxyLBAPI); !ok {
+		t.Fatalf("%s is not sacloud.ProxyLB", op)
+	}
+
+	if op, ok := NewRegionOp().(sacloud.RegionAPI); !ok {
+		t.Fatalf("%s is not sacloud.Region", op)
+	}
+
 	if op, ok := NewServerOp().(sacloud.ServerAPI); !ok {
 		t.Fatalf("%s is not sacloud.Server", op)
 	}
 
+	if op, ok := NewServerPlanOp().(sacloud.ServerPlanAPI); !ok {
+		t.Fatalf("%s is not sacloud.ServerPlan", op)
+	}
+
+	if op, ok := NewServiceClassOp().(sacloud.ServiceClassAPI); !ok {
+		t.Fatalf("%s is not sacloud.ServiceClass", op)
+	}
+
 	if op, ok := NewSIMOp().(sacloud.SIMAPI); !ok {
 		t.Fatalf("%s is not sacloud.SIM", op)
 	}
 
+	if op, ok := NewSimpleMonitorOp().(sacloud.SimpleMonitorAPI); !ok {
+		t.Fatalf("%s is not sacloud.SimpleMonitor", op)
+	}
+
+	if op, ok := NewSSHKeyOp().(sacloud.SSHKeyAPI); !ok {
+		t.Fatalf("%s is not sacloud.SSHKey", op)
+	}
+
+	if op, ok := NewStorageOp().(sacloud.StorageAPI); !ok {
+		t.Fatalf("%s is not sacloud.Storage", op)
+	}
+
+	if op, ok := NewSubnetOp().(sacloud.SubnetAPI); !ok {
+		t.Fatalf("%s is not sacloud.Subnet", op)
+	}
+
 	if op, ok := NewSwitchOp().(sacloud.SwitchAPI); !ok {
 		t.Fatalf("%s is not sacloud.Switch", op)
 	}
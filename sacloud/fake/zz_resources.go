@@ -5,30 +5,82 @@ package fake
 const (
 	// ResourceArchive is resource key of fake store
 	ResourceArchive = "Archive"
+	// ResourceAuthStatus is resource key of fake store
+	ResourceAuthStatus = "AuthStatus"
+	// ResourceAutoBackup is resource key of fake store
+	ResourceAutoBackup = "AutoBackup"
+	// ResourceBill is resource key of fake store
+	ResourceBill = "Bill"
 	// ResourceBridge is resource key of fake store
 	ResourceBridge = "Bridge"
 	// ResourceCDROM is resource key of fake store
 	ResourceCDROM = "CDROM"
+	// ResourceCoupon is resource key of fake store
+	ResourceCoupon = "Coupon"
+	// ResourceDatabase is resource key of fake store
+	ResourceDatabase = "Database"
 	// ResourceDisk is resource key of fake store
 	ResourceDisk = "Disk"
+	// ResourceDiskPlan is resource key of fake store
+	ResourceDiskPlan = "DiskPlan"
+	// ResourceDNS is resource key of fake store
+	ResourceDNS = "DNS"
+	// ResourceESUsage is resource key of fake store
+	ResourceESUsage = "ESUsage"
 	// ResourceGSLB is resource key of fake store
 	ResourceGSLB = "GSLB"
+	// ResourceIcon is resource key of fake store
+	ResourceIcon = "Icon"
 	// ResourceInterface is resource key of fake store
 	ResourceInterface = "Interface"
 	// ResourceInternet is resource key of fake store
 	ResourceInternet = "Internet"
+	// ResourceInternetPlan is resource key of fake store
+	ResourceInternetPlan = "InternetPlan"
+	// ResourceIPAddress is resource key of fake store
+	ResourceIPAddress = "IPAddress"
+	// ResourceIPv6Addr is resource key of fake store
+	ResourceIPv6Addr = "IPv6Addr"
+	// ResourceIPv6Net is resource key of fake store
+	ResourceIPv6Net = "IPv6Net"
+	// ResourceLicense is resource key of fake store
+	ResourceLicense = "License"
+	// ResourceLicensePlan is resource key of fake store
+	ResourceLicensePlan = "LicensePlan"
 	// ResourceLoadBalancer is resource key of fake store
 	ResourceLoadBalancer = "LoadBalancer"
+	// ResourceMobileGateway is resource key of fake store
+	ResourceMobileGateway = "MobileGateway"
 	// ResourceNFS is resource key of fake store
 	ResourceNFS = "NFS"
 	// ResourceNote is resource key of fake store
 	ResourceNote = "Note"
 	// ResourcePacketFilter is resource key of fake store
 	ResourcePacketFilter = "PacketFilter"
+	// ResourcePrivateHost is resource key of fake store
+	ResourcePrivateHost = "PrivateHost"
+	// ResourcePrivateHostPlan is resource key of fake store
+	ResourcePrivateHostPlan = "PrivateHostPlan"
+	// ResourceProxyLB is resource key of fake store
+	ResourceProxyLB = "ProxyLB"
+	// ResourceRegion is resource key of fake store
+	ResourceRegion = "Region"
 	// ResourceServer is resource key of fake store
 	ResourceServer = "Server"
+	// ResourceServerPlan is resource key of fake store
+	ResourceServerPlan = "ServerPlan"
+	// ResourceServiceClass is resource key of fake store
+	ResourceServiceClass = "ServiceClass"
 	// ResourceSIM is resource key of fake store
 	ResourceSIM = "SIM"
+	// ResourceSimpleMonitor is resource key of fake store
+	ResourceSimpleMonitor = "SimpleMonitor"
+	// ResourceSSHKey is resource key of fake store
+	ResourceSSHKey = "SSHKey"
+	// ResourceStorage is resource key of fake store
+	ResourceStorage = "Storage"
+	// ResourceSubnet is resource key of fake store
+	ResourceSubnet = "Subnet"
 	// ResourceSwitch is resource key of fake store
 	ResourceSwitch = "Switch"
 	// ResourceVPCRouter is resource key of fake store
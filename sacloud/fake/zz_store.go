@@ -30,6 +30,75 @@ func (s *store) setArchive(zone string, value *sacloud.Archive) {
 	s.set(ResourceArchive, zone, value)
 }
 
+func (s *store) getAuthStatus(zone string) []*sacloud.AuthStatus {
+	values := s.get(ResourceAuthStatus, zone)
+	var ret []*sacloud.AuthStatus
+	for _, v := range values {
+		if v, ok := v.(*sacloud.AuthStatus); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getAuthStatusByID(zone string, id types.ID) *sacloud.AuthStatus {
+	v := s.getByID(ResourceAuthStatus, zone, id)
+	if v, ok := v.(*sacloud.AuthStatus); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setAuthStatus(zone string, value *sacloud.AuthStatus) {
+	s.set(ResourceAuthStatus, zone, value)
+}
+
+func (s *store) getAutoBackup(zone string) []*sacloud.AutoBackup {
+	values := s.get(ResourceAutoBackup, zone)
+	var ret []*sacloud.AutoBackup
+	for _, v := range values {
+		if v, ok := v.(*sacloud.AutoBackup); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getAutoBackupByID(zone string, id types.ID) *sacloud.AutoBackup {
+	v := s.getByID(ResourceAutoBackup, zone, id)
+	if v, ok := v.(*sacloud.AutoBackup); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setAutoBackup(zone string, value *sacloud.AutoBackup) {
+	s.set(ResourceAutoBackup, zone, value)
+}
+
+func (s *store) getBill(zone string) []*sacloud.Bill {
+	values := s.get(ResourceBill, zone)
+	var ret []*sacloud.Bill
+	for _, v := range values {
+		if v, ok := v.(*sacloud.Bill); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getBillByID(zone string, id types.ID) *sacloud.Bill {
+	v := s.getByID(ResourceBill, zone, id)
+	if v, ok := v.(*sacloud.Bill); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setBill(zone string, value *sacloud.Bill) {
+	s.set(ResourceBill, zone, value)
+}
+
 func (s *store) getBridge(zone string) []*sacloud.Bridge {
 	values := s.get(ResourceBridge, zone)
 	var ret []*sacloud.Bridge
@@ -76,6 +145,52 @@ func (s *store) setCDROM(zone string, value *sacloud.CDROM) {
 	s.set(ResourceCDROM, zone, value)
 }
 
+func (s *store) getCoupon(zone string) []*sacloud.Coupon {
+	values := s.get(ResourceCoupon, zone)
+	var ret []*sacloud.Coupon
+	for _, v := range values {
+		if v, ok := v.(*sacloud.Coupon); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getCouponByID(zone string, id types.ID) *sacloud.Coupon {
+	v := s.getByID(ResourceCoupon, zone, id)
+	if v, ok := v.(*sacloud.Coupon); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setCoupon(zone string, value *sacloud.Coupon) {
+	s.set(ResourceCoupon, zone, value)
+}
+
+func (s *store) getDatabase(zone string) []*sacloud.Database {
+	values := s.get(ResourceDatabase, zone)
+	var ret []*sacloud.Database
+	for _, v := range values {
+		if v, ok := v.(*sacloud.Database); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getDatabaseByID(zone string, id types.ID) *sacloud.Database {
+	v := s.getByID(ResourceDatabase, zone, id)
+	if v, ok := v.(*sacloud.Database); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setDatabase(zone string, value *sacloud.Database) {
+	s.set(ResourceDatabase, zone, value)
+}
+
 func (s *store) getDisk(zone string) []*sacloud.Disk {
 	values := s.get(ResourceDisk, zone)
 	var ret []*sacloud.Disk
@@ -99,6 +214,75 @@ func (s *store) setDisk(zone string, value *sacloud.Disk) {
 	s.set(ResourceDisk, zone, value)
 }
 
+func (s *store) getDiskPlan(zone string) []*sacloud.DiskPlan {
+	values := s.get(ResourceDiskPlan, zone)
+	var ret []*sacloud.DiskPlan
+	for _, v := range values {
+		if v, ok := v.(*sacloud.DiskPlan); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getDiskPlanByID(zone string, id types.ID) *sacloud.DiskPlan {
+	v := s.getByID(ResourceDiskPlan, zone, id)
+	if v, ok := v.(*sacloud.DiskPlan); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setDiskPlan(zone string, value *sacloud.DiskPlan) {
+	s.set(ResourceDiskPlan, zone, value)
+}
+
+func (s *store) getDNS(zone string) []*sacloud.DNS {
+	values := s.get(ResourceDNS, zone)
+	var ret []*sacloud.DNS
+	for _, v := range values {
+		if v, ok := v.(*sacloud.DNS); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getDNSByID(zone string, id types.ID) *sacloud.DNS {
+	v := s.getByID(ResourceDNS, zone, id)
+	if v, ok := v.(*sacloud.DNS); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setDNS(zone string, value *sacloud.DNS) {
+	s.set(ResourceDNS, zone, value)
+}
+
+func (s *store) getESUsage(zone string) []*sacloud.ESUsage {
+	values := s.get(ResourceESUsage, zone)
+	var ret []*sacloud.ESUsage
+	for _, v := range values {
+		if v, ok := v.(*sacloud.ESUsage); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getESUsageByID(zone string, id types.ID) *sacloud.ESUsage {
+	v := s.getByID(ResourceESUsage, zone, id)
+	if v, ok := v.(*sacloud.ESUsage); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setESUsage(zone string, value *sacloud.ESUsage) {
+	s.set(ResourceESUsage, zone, value)
+}
+
 func (s *store) getGSLB(zone string) []*sacloud.GSLB {
 	values := s.get(ResourceGSLB, zone)
 	var ret []*sacloud.GSLB
@@ -122,6 +306,29 @@ func (s *store) setGSLB(zone string, value *sacloud.GSLB) {
 	s.set(ResourceGSLB, zone, value)
 }
 
+func (s *store) getIcon(zone string) []*sacloud.Icon {
+	values := s.get(ResourceIcon, zone)
+	var ret []*sacloud.Icon
+	for _, v := range values {
+		if v, ok := v.(*sacloud.Icon); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getIconByID(zone string, id types.ID) *sacloud.Icon {
+	v := s.getByID(ResourceIcon, zone, id)
+	if v, ok := v.(*sacloud.Icon); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setIcon(zone string, value *sacloud.Icon) {
+	s.set(ResourceIcon, zone, value)
+}
+
 func (s *store) getInterface(zone string) []*sacloud.Interface {
 	values := s.get(ResourceInterface, zone)
 	var ret []*sacloud.Interface
@@ -168,6 +375,144 @@ func (s *store) setInternet(zone string, value *sacloud.Internet) {
 	s.set(ResourceInternet, zone, value)
 }
 
+func (s *store) getInternetPlan(zone string) []*sacloud.InternetPlan {
+	values := s.get(ResourceInternetPlan, zone)
+	var ret []*sacloud.InternetPlan
+	for _, v := range values {
+		if v, ok := v.(*sacloud.InternetPlan); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getInternetPlanByID(zone string, id types.ID) *sacloud.InternetPlan {
+	v := s.getByID(ResourceInternetPlan, zone, id)
+	if v, ok := v.(*sacloud.InternetPlan); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setInternetPlan(zone string, value *sacloud.InternetPlan) {
+	s.set(ResourceInternetPlan, zone, value)
+}
+
+func (s *store) getIPAddress(zone string) []*sacloud.IPAddress {
+	values := s.get(ResourceIPAddress, zone)
+	var ret []*sacloud.IPAddress
+	for _, v := range values {
+		if v, ok := v.(*sacloud.IPAddress); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getIPAddressByID(zone string, id types.ID) *sacloud.IPAddress {
+	v := s.getByID(ResourceIPAddress, zone, id)
+	if v, ok := v.(*sacloud.IPAddress); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setIPAddress(zone string, value *sacloud.IPAddress) {
+	s.set(ResourceIPAddress, zone, value)
+}
+
+func (s *store) getIPv6Addr(zone string) []*sacloud.IPv6Addr {
+	values := s.get(ResourceIPv6Addr, zone)
+	var ret []*sacloud.IPv6Addr
+	for _, v := range values {
+		if v, ok := v.(*sacloud.IPv6Addr); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getIPv6AddrByID(zone string, id types.ID) *sacloud.IPv6Addr {
+	v := s.getByID(ResourceIPv6Addr, zone, id)
+	if v, ok := v.(*sacloud.IPv6Addr); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setIPv6Addr(zone string, value *sacloud.IPv6Addr) {
+	s.set(ResourceIPv6Addr, zone, value)
+}
+
+func (s *store) getIPv6Net(zone string) []*sacloud.IPv6Net {
+	values := s.get(ResourceIPv6Net, zone)
+	var ret []*sacloud.IPv6Net
+	for _, v := range values {
+		if v, ok := v.(*sacloud.IPv6Net); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getIPv6NetByID(zone string, id types.ID) *sacloud.IPv6Net {
+	v := s.getByID(ResourceIPv6Net, zone, id)
+	if v, ok := v.(*sacloud.IPv6Net); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setIPv6Net(zone string, value *sacloud.IPv6Net) {
+	s.set(ResourceIPv6Net, zone, value)
+}
+
+func (s *store) getLicense(zone string) []*sacloud.License {
+	values := s.get(ResourceLicense, zone)
+	var ret []*sacloud.License
+	for _, v := range values {
+		if v, ok := v.(*sacloud.License); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getLicenseByID(zone string, id types.ID) *sacloud.License {
+	v := s.getByID(ResourceLicense, zone, id)
+	if v, ok := v.(*sacloud.License); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setLicense(zone string, value *sacloud.License) {
+	s.set(ResourceLicense, zone, value)
+}
+
+func (s *store) getLicensePlan(zone string) []*sacloud.LicensePlan {
+	values := s.get(ResourceLicensePlan, zone)
+	var ret []*sacloud.LicensePlan
+	for _, v := range values {
+		if v, ok := v.(*sacloud.LicensePlan); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getLicensePlanByID(zone string, id types.ID) *sacloud.LicensePlan {
+	v := s.getByID(ResourceLicensePlan, zone, id)
+	if v, ok := v.(*sacloud.LicensePlan); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setLicensePlan(zone string, value *sacloud.LicensePlan) {
+	s.set(ResourceLicensePlan, zone, value)
+}
+
 func (s *store) getLoadBalancer(zone string) []*sacloud.LoadBalancer {
 	values := s.get(ResourceLoadBalancer, zone)
 	var ret []*sacloud.LoadBalancer
@@ -191,6 +536,29 @@ func (s *store) setLoadBalancer(zone string, value *sacloud.LoadBalancer) {
 	s.set(ResourceLoadBalancer, zone, value)
 }
 
+func (s *store) getMobileGateway(zone string) []*sacloud.MobileGateway {
+	values := s.get(ResourceMobileGateway, zone)
+	var ret []*sacloud.MobileGateway
+	for _, v := range values {
+		if v, ok := v.(*sacloud.MobileGateway); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getMobileGatewayByID(zone string, id types.ID) *sacloud.MobileGateway {
+	v := s.getByID(ResourceMobileGateway, zone, id)
+	if v, ok := v.(*sacloud.MobileGateway); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setMobileGateway(zone string, value *sacloud.MobileGateway) {
+	s.set(ResourceMobileGateway, zone, value)
+}
+
 func (s *store) getNFS(zone string) []*sacloud.NFS {
 	values := s.get(ResourceNFS, zone)
 	var ret []*sacloud.NFS
@@ -260,6 +628,98 @@ func (s *store) setPacketFilter(zone string, value *sacloud.PacketFilter) {
 	s.set(ResourcePacketFilter, zone, value)
 }
 
+func (s *store) getPrivateHost(zone string) []*sacloud.PrivateHost {
+	values := s.get(ResourcePrivateHost, zone)
+	var ret []*sacloud.PrivateHost
+	for _, v := range values {
+		if v, ok := v.(*sacloud.PrivateHost); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getPrivateHostByID(zone string, id types.ID) *sacloud.PrivateHost {
+	v := s.getByID(ResourcePrivateHost, zone, id)
+	if v, ok := v.(*sacloud.PrivateHost); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setPrivateHost(zone string, value *sacloud.PrivateHost) {
+	s.set(ResourcePrivateHost, zone, value)
+}
+
+func (s *store) getPrivateHostPlan(zone string) []*sacloud.PrivateHostPlan {
+	values := s.get(ResourcePrivateHostPlan, zone)
+	var ret []*sacloud.PrivateHostPlan
+	for _, v := range values {
+		if v, ok := v.(*sacloud.PrivateHostPlan); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getPrivateHostPlanByID(zone string, id types.ID) *sacloud.PrivateHostPlan {
+	v := s.getByID(ResourcePrivateHostPlan, zone, id)
+	if v, ok := v.(*sacloud.PrivateHostPlan); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setPrivateHostPlan(zone string, value *sacloud.PrivateHostPlan) {
+	s.set(ResourcePrivateHostPlan, zone, value)
+}
+
+func (s *store) getProxyLB(zone string) []*sacloud.ProxyLB {
+	values := s.get(ResourceProxyLB, zone)
+	var ret []*sacloud.ProxyLB
+	for _, v := range values {
+		if v, ok := v.(*sacloud.ProxyLB); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getProxyLBByID(zone string, id types.ID) *sacloud.ProxyLB {
+	v := s.getByID(ResourceProxyLB, zone, id)
+	if v, ok := v.(*sacloud.ProxyLB); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setProxyLB(zone string, value *sacloud.ProxyLB) {
+	s.set(ResourceProxyLB, zone, value)
+}
+
+func (s *store) getRegion(zone string) []*sacloud.Region {
+	values := s.get(ResourceRegion, zone)
+	var ret []*sacloud.Region
+	for _, v := range values {
+		if v, ok := v.(*sacloud.Region); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getRegionByID(zone string, id types.ID) *sacloud.Region {
+	v := s.getByID(ResourceRegion, zone, id)
+	if v, ok := v.(*sacloud.Region); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setRegion(zone string, value *sacloud.Region) {
+	s.set(ResourceRegion, zone, value)
+}
+
 func (s *store) getServer(zone string) []*sacloud.Server {
 	values := s.get(ResourceServer, zone)
 	var ret []*sacloud.Server
@@ -283,6 +743,52 @@ func (s *store) setServer(zone string, value *sacloud.Server) {
 	s.set(ResourceServer, zone, value)
 }
 
+func (s *store) getServerPlan(zone string) []*sacloud.ServerPlan {
+	values := s.get(ResourceServerPlan, zone)
+	var ret []*sacloud.ServerPlan
+	for _, v := range values {
+		if v, ok := v.(*sacloud.ServerPlan); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getServerPlanByID(zone string, id types.ID) *sacloud.ServerPlan {
+	v := s.getByID(ResourceServerPlan, zone, id)
+	if v, ok := v.(*sacloud.ServerPlan); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setServerPlan(zone string, value *sacloud.ServerPlan) {
+	s.set(ResourceServerPlan, zone, value)
+}
+
+func (s *store) getServiceClass(zone string) []*sacloud.ServiceClass {
+	values := s.get(ResourceServiceClass, zone)
+	var ret []*sacloud.ServiceClass
+	for _, v := range values {
+		if v, ok := v.(*sacloud.ServiceClass); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getServiceClassByID(zone string, id types.ID) *sacloud.ServiceClass {
+	v := s.getByID(ResourceServiceClass, zone, id)
+	if v, ok := v.(*sacloud.ServiceClass); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setServiceClass(zone string, value *sacloud.ServiceClass) {
+	s.set(ResourceServiceClass, zone, value)
+}
+
 func (s *store) getSIM(zone string) []*sacloud.SIM {
 	values := s.get(ResourceSIM, zone)
 	var ret []*sacloud.SIM
@@ -306,6 +812,98 @@ func (s *store) setSIM(zone string, value *sacloud.SIM) {
 	s.set(ResourceSIM, zone, value)
 }
 
+func (s *store) getSimpleMonitor(zone string) []*sacloud.SimpleMonitor {
+	values := s.get(ResourceSimpleMonitor, zone)
+	var ret []*sacloud.SimpleMonitor
+	for _, v := range values {
+		if v, ok := v.(*sacloud.SimpleMonitor); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getSimpleMonitorByID(zone string, id types.ID) *sacloud.SimpleMonitor {
+	v := s.getByID(ResourceSimpleMonitor, zone, id)
+	if v, ok := v.(*sacloud.SimpleMonitor); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setSimpleMonitor(zone string, value *sacloud.SimpleMonitor) {
+	s.set(ResourceSimpleMonitor, zone, value)
+}
+
+func (s *store) getSSHKey(zone string) []*sacloud.SSHKey {
+	values := s.get(ResourceSSHKey, zone)
+	var ret []*sacloud.SSHKey
+	for _, v := range values {
+		if v, ok := v.(*sacloud.SSHKey); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getSSHKeyByID(zone string, id types.ID) *sacloud.SSHKey {
+	v := s.getByID(ResourceSSHKey, zone, id)
+	if v, ok := v.(*sacloud.SSHKey); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setSSHKey(zone string, value *sacloud.SSHKey) {
+	s.set(ResourceSSHKey, zone, value)
+}
+
+func (s *store) getStorage(zone string) []*sacloud.Storage {
+	values := s.get(ResourceStorage, zone)
+	var ret []*sacloud.Storage
+	for _, v := range values {
+		if v, ok := v.(*sacloud.Storage); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getStorageByID(zone string, id types.ID) *sacloud.Storage {
+	v := s.getByID(ResourceStorage, zone, id)
+	if v, ok := v.(*sacloud.Storage); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setStorage(zone string, value *sacloud.Storage) {
+	s.set(ResourceStorage, zone, value)
+}
+
+func (s *store) getSubnet(zone string) []*sacloud.Subnet {
+	values := s.get(ResourceSubnet, zone)
+	var ret []*sacloud.Subnet
+	for _, v := range values {
+		if v, ok := v.(*sacloud.Subnet); ok {
+			ret = append(ret, v)
+		}
+	}
+	return ret
+}
+
+func (s *store) getSubnetByID(zone string, id types.ID) *sacloud.Subnet {
+	v := s.getByID(ResourceSubnet, zone, id)
+	if v, ok := v.(*sacloud.Subnet); ok {
+		return v
+	}
+	return nil
+}
+
+func (s *store) setSubnet(zone string, value *sacloud.Subnet) {
+	s.set(ResourceSubnet, zone, value)
+}
+
 func (s *store) getSwitch(zone string) []*sacloud.Switch {
 	values := s.get(ResourceSwitch, zone)
 	var ret []*sacloud.Switch
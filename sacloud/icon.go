@@ -0,0 +1,26 @@
+package sacloud
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+)
+
+// SetImageFromReader 画像データを読み込み、Base64エンコードしてImageに設定する
+func (o *IconCreateRequest) SetImageFromReader(r io.Reader) error {
+	buf := &bytes.Buffer{}
+	encoder := base64.NewEncoder(base64.StdEncoding, buf)
+	if _, err := io.Copy(encoder, r); err != nil {
+		return err
+	}
+	if err := encoder.Close(); err != nil {
+		return err
+	}
+	o.Image = buf.String()
+	return nil
+}
+
+// Decode Base64エンコードされた画像データをデコードして返す
+func (o *IconImage) Decode() ([]byte, error) {
+	return base64.StdEncoding.DecodeString(o.Image)
+}
@@ -0,0 +1,79 @@
+package sacloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+)
+
+var logLevels = map[string]int{
+	LogLevelTrace: 0,
+	LogLevelDebug: 1,
+	LogLevelInfo:  2,
+	LogLevelWarn:  3,
+}
+
+// LogFields 構造化ログのフィールド
+type LogFields map[string]interface{}
+
+// Logger APIコール時のログ出力先
+type Logger interface {
+	// Enabled 指定のレベルのログが出力対象か
+	Enabled(level string) bool
+	// Log ログを出力する
+	Log(level string, msg string, fields LogFields)
+}
+
+// StdLogger 標準のlogパッケージを利用するLogger
+//
+// フィールドはキーの昇順でkey=value形式で出力される
+type StdLogger struct {
+	// Level 出力対象とする最低のログレベル [TRACE / DEBUG / WARN / INFO(default)]
+	Level string
+	// Logger 出力先、nilの場合はlogパッケージの標準ロガーが利用される
+	Logger *log.Logger
+}
+
+// NewStdLogger StdLoggerを返す
+func NewStdLogger(level string) *StdLogger {
+	return &StdLogger{Level: level}
+}
+
+// Enabled Loggerの実装
+func (l *StdLogger) Enabled(level string) bool {
+	current, ok := logLevels[strings.ToUpper(l.Level)]
+	if !ok {
+		current = logLevels[LogLevelInfo]
+	}
+	target, ok := logLevels[strings.ToUpper(level)]
+	if !ok {
+		return false
+	}
+	return current <= target
+}
+
+// Log Loggerの実装
+func (l *StdLogger) Log(level string, msg string, fields LogFields) {
+	if !l.Enabled(level) {
+		return
+	}
+
+	var keys []string
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	buf := &strings.Builder{}
+	fmt.Fprintf(buf, "[%s] %s", strings.ToUpper(level), msg)
+	for _, key := range keys {
+		fmt.Fprintf(buf, " %s=%v", key, fields[key])
+	}
+
+	if l.Logger != nil {
+		l.Logger.Print(buf.String())
+		return
+	}
+	log.Print(buf.String())
+}
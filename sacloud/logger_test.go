@@ -0,0 +1,109 @@
+package sacloud
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+	"github.com/stretchr/testify/require"
+)
+
+type testLogEntry struct {
+	level  string
+	msg    string
+	fields LogFields
+}
+
+type testLogger struct {
+	level   string
+	entries []*testLogEntry
+}
+
+func (l *testLogger) Enabled(level string) bool {
+	return (&StdLogger{Level: l.level}).Enabled(level)
+}
+
+func (l *testLogger) Log(level string, msg string, fields LogFields) {
+	l.entries = append(l.entries, &testLogEntry{level: level, msg: msg, fields: fields})
+}
+
+func TestStdLogger(t *testing.T) {
+	buf := bytes.NewBufferString("")
+	logger := &StdLogger{Level: LogLevelDebug, Logger: log.New(buf, "", 0)}
+
+	logger.Log(LogLevelTrace, "ignored", nil)
+	logger.Log(LogLevelDebug, "API call", LogFields{"status": 200, "method": "GET"})
+
+	require.Equal(t, "[DEBUG] API call method=GET status=200\n", buf.String())
+	require.False(t, NewStdLogger("").Enabled(LogLevelDebug))
+	require.True(t, NewStdLogger("").Enabled(LogLevelWarn))
+}
+
+func TestRedactor(t *testing.T) {
+	type custom struct {
+		Token string `json:"token" sensitive:"true"`
+		Name  string `json:"name"`
+	}
+	redactor := NewRedactor([]string{"password"}, &custom{}, &naked.VPCRouter{})
+
+	redacted := redactor.RedactJSON([]byte(`{"Password":"p@ss","token":"t","name":"n","Remark":{"Settings":[{"PreSharedSecret":"s","Peer":"p"}]}}`))
+	require.JSONEq(t, `{"Password":"[redacted]","token":"[redacted]","name":"n","Remark":{"Settings":[{"PreSharedSecret":"[redacted]","Peer":"p"}]}}`, redacted)
+
+	require.Equal(t, "", redactor.RedactJSON([]byte("not json")))
+}
+
+func TestDefaultRedactor_SimpleMonitor(t *testing.T) {
+	body := `{"CommonServiceItem":{"Name":"example.com","Settings":{"SimpleMonitor":{
+		"HealthCheck":{"Protocol":"https","BasicAuthUsername":"user","BasicAuthPassword":"p@ss","Community":"public"},
+		"NotifySlack":{"Enabled":"True","IncomingWebhooksURL":"https://hooks.slack.com/services/xxx"}
+	}}}}`
+
+	redacted := DefaultRedactor.RedactJSON([]byte(body))
+	require.JSONEq(t, `{"CommonServiceItem":{"Name":"example.com","Settings":{"SimpleMonitor":{
+		"HealthCheck":{"Protocol":"https","BasicAuthUsername":"user","BasicAuthPassword":"[redacted]","Community":"[redacted]"},
+		"NotifySlack":{"Enabled":"True","IncomingWebhooksURL":"[redacted]"}
+	}}}}`, redacted)
+}
+
+func TestClient_Logging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			w.Write([]byte(`{"FTPServer":{"User":"user","Password":"ftp-password"},"is_ok":true}`)) // nolint
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"is_fatal":true,"serial":"serial-value","status":"404 Not Found"}`)) // nolint
+	}))
+	defer server.Close()
+
+	logger := &testLogger{level: LogLevelTrace}
+	client := NewClient("token", "secret")
+	client.Logger = logger
+
+	ctx := WithRequestID(context.Background(), "request-id")
+	_, err := client.Do(ctx, http.MethodPut, server.URL+"/is1a/api/cloud/1.1/disk/1/config", &naked.DiskEdit{Password: "disk-password", HostName: "host"})
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, http.MethodGet, server.URL+"/is1a/api/cloud/1.1/disk/2", nil)
+	require.True(t, IsNotFoundError(err))
+
+	require.Len(t, logger.entries, 2)
+
+	entry := logger.entries[0]
+	require.Equal(t, LogLevelTrace, entry.level)
+	require.Equal(t, http.MethodPut, entry.fields["method"])
+	require.Equal(t, http.StatusOK, entry.fields["status"])
+	require.Equal(t, "request-id", entry.fields["request_id"])
+	require.Contains(t, entry.fields, "duration")
+	require.JSONEq(t, `{"Password":"[redacted]","HostName":"host"}`, entry.fields["request_body"].(string))
+	require.False(t, strings.Contains(entry.fields["response_body"].(string), "ftp-password"))
+
+	entry = logger.entries[1]
+	require.Equal(t, http.StatusNotFound, entry.fields["status"])
+	require.Equal(t, "serial-value", entry.fields["serial"])
+}
@@ -0,0 +1,115 @@
+package sacloud
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// RequestMetrics APIリクエストごとの計測値
+type RequestMetrics struct {
+	// ResourceName リソース名 contextにWithOperationで設定されている場合のみ
+	ResourceName string
+	// OperationName 操作名 contextにWithOperationで設定されている場合のみ
+	OperationName string
+	// Zone ゾーン名
+	Zone string
+	// Method HTTPメソッド
+	Method string
+	// StatusCode レスポンスのステータスコード 通信エラーの場合は0
+	StatusCode int
+	// Duration リトライを含めた所要時間
+	Duration time.Duration
+	// Retries リトライ回数
+	Retries int
+	// Err 通信エラー
+	Err error
+}
+
+// OperationMetrics リソースへの操作(ServerAPI.Bootなど)ごとの計測値
+type OperationMetrics struct {
+	// ResourceName リソース名
+	ResourceName string
+	// OperationName 操作名
+	OperationName string
+	// Zone ゾーン名
+	Zone string
+	// Duration 所要時間
+	Duration time.Duration
+	// Err 操作がエラーとなった場合のエラー
+	Err error
+}
+
+// MetricsCollector APIコールの計測値の収集先
+type MetricsCollector interface {
+	// ObserveRequest APIリクエストごとに呼ばれる
+	ObserveRequest(m *RequestMetrics)
+	// ObserveOperation リソースへの操作ごとに呼ばれる
+	ObserveOperation(m *OperationMetrics)
+}
+
+type operationContextKey struct{}
+
+type operationInfo struct {
+	resourceName  string
+	operationName string
+}
+
+// WithOperation リソース名/操作名を保持するcontextを返す
+//
+// Client.MetricsでのAPIリクエストの計測値にリソース名/操作名を付与するために利用される
+func WithOperation(ctx context.Context, resourceName, operationName string) context.Context {
+	return context.WithValue(ctx, operationContextKey{}, &operationInfo{
+		resourceName:  resourceName,
+		operationName: operationName,
+	})
+}
+
+// OperationFromContext contextからリソース名/操作名を取得する
+func OperationFromContext(ctx context.Context) (resourceName string, operationName string, ok bool) {
+	info, ok := ctx.Value(operationContextKey{}).(*operationInfo)
+	if !ok {
+		return "", "", false
+	}
+	return info.resourceName, info.operationName, true
+}
+
+type retryCounterContextKey struct{}
+
+// countRetry contextにリトライ回数のカウンタが保持されている場合はカウントアップする
+func countRetry(ctx context.Context) {
+	if counter, ok := ctx.Value(retryCounterContextKey{}).(*int); ok {
+		*counter++
+	}
+}
+
+// requestMetricsMiddleware リトライを含めたAPIリクエストの計測値をcollectorへ通知するMiddleware
+func requestMetricsMiddleware(collector MetricsCollector) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			retries := 0
+			ctx := context.WithValue(req.Context(), retryCounterContextKey{}, &retries)
+
+			start := time.Now()
+			res, err := next.RoundTrip(req.WithContext(ctx))
+
+			m := &RequestMetrics{
+				Zone:     zoneFromURL(req.URL),
+				Method:   req.Method,
+				Duration: time.Since(start),
+				Retries:  retries,
+				Err:      err,
+			}
+			if res != nil {
+				m.StatusCode = res.StatusCode
+			}
+			if resourceName, operationName, ok := OperationFromContext(ctx); ok {
+				m.ResourceName = resourceName
+				m.OperationName = operationName
+			}
+			collector.ObserveRequest(m)
+
+			return res, err
+		})
+	}
+}
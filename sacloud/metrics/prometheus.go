@@ -0,0 +1,174 @@
+// Package metrics APIコールの計測値(件数/所要時間/リトライ回数など)を収集するためのデコレータとPrometheus向けのコレクタ
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+)
+
+// DefaultNamespace メトリクス名のデフォルトのプレフィックス
+const DefaultNamespace = "sacloud"
+
+// PrometheusCollector 計測値をPrometheusのテキスト形式で公開するsacloud.MetricsCollectorの実装
+//
+// http.Handlerを実装しているため、そのままメトリクスのエンドポイントとして利用できる
+type PrometheusCollector struct {
+	// Namespace メトリクス名のプレフィックス 未指定の場合はDefaultNamespaceが利用される
+	Namespace string
+
+	mu         sync.Mutex
+	requests   map[string]*sample
+	operations map[string]*sample
+}
+
+// NewPrometheusCollector PrometheusCollectorを返す
+func NewPrometheusCollector() *PrometheusCollector {
+	return &PrometheusCollector{
+		Namespace: DefaultNamespace,
+	}
+}
+
+type sample struct {
+	labels   labels
+	count    int64
+	duration float64
+	retries  int64
+	errors   int64
+}
+
+type labels [][2]string
+
+func (l labels) String() string {
+	var pairs []string
+	for _, label := range l {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, label[0], escapeLabelValue(label[1])))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func escapeLabelValue(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(v)
+}
+
+// ObserveRequest sacloud.MetricsCollectorの実装
+func (c *PrometheusCollector) ObserveRequest(m *sacloud.RequestMetrics) {
+	status := "error"
+	if m.StatusCode > 0 {
+		status = strconv.Itoa(m.StatusCode)
+	}
+	l := labels{
+		{"resource", m.ResourceName},
+		{"operation", m.OperationName},
+		{"zone", m.Zone},
+		{"method", m.Method},
+		{"status", status},
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.requests == nil {
+		c.requests = make(map[string]*sample)
+	}
+	s := c.sample(c.requests, l)
+	s.count++
+	s.duration += m.Duration.Seconds()
+	s.retries += int64(m.Retries)
+}
+
+// ObserveOperation sacloud.MetricsCollectorの実装
+func (c *PrometheusCollector) ObserveOperation(m *sacloud.OperationMetrics) {
+	l := labels{
+		{"resource", m.ResourceName},
+		{"operation", m.OperationName},
+		{"zone", m.Zone},
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.operations == nil {
+		c.operations = make(map[string]*sample)
+	}
+	s := c.sample(c.operations, l)
+	s.count++
+	s.duration += m.Duration.Seconds()
+	if m.Err != nil {
+		s.errors++
+	}
+}
+
+func (c *PrometheusCollector) sample(samples map[string]*sample, l labels) *sample {
+	key := l.String()
+	s, ok := samples[key]
+	if !ok {
+		s = &sample{labels: l}
+		samples[key] = s
+	}
+	return s
+}
+
+// ServeHTTP http.Handlerの実装、Prometheusのテキスト形式で計測値を出力する
+func (c *PrometheusCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.Write(c.Bytes()) // nolint
+}
+
+// Bytes Prometheusのテキスト形式で計測値を返す
+func (c *PrometheusCollector) Bytes() []byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	namespace := c.Namespace
+	if namespace == "" {
+		namespace = DefaultNamespace
+	}
+	requests := sortedSamples(c.requests)
+	operations := sortedSamples(c.operations)
+
+	buf := bytes.NewBufferString("")
+	writeMetric := func(name, metricType, help string, samples []*sample, value func(s *sample) string) {
+		name = namespace + "_" + name
+		fmt.Fprintf(buf, "# HELP %s %s\n", name, help)
+		fmt.Fprintf(buf, "# TYPE %s %s\n", name, metricType)
+		for _, s := range samples {
+			fmt.Fprintf(buf, "%s%s %s\n", name, s.labels, value(s))
+		}
+	}
+	formatInt := func(v int64) string { return strconv.FormatInt(v, 10) }
+	formatFloat := func(v float64) string { return strconv.FormatFloat(v, 'g', -1, 64) }
+
+	writeMetric("api_requests_total", "counter", "Total number of API requests.", requests,
+		func(s *sample) string { return formatInt(s.count) })
+	writeMetric("api_request_duration_seconds_total", "counter", "Total time spent on API requests including retries.", requests,
+		func(s *sample) string { return formatFloat(s.duration) })
+	writeMetric("api_request_retries_total", "counter", "Total number of API request retries.", requests,
+		func(s *sample) string { return formatInt(s.retries) })
+	writeMetric("api_operations_total", "counter", "Total number of resource operations.", operations,
+		func(s *sample) string { return formatInt(s.count) })
+	writeMetric("api_operation_errors_total", "counter", "Total number of failed resource operations.", operations,
+		func(s *sample) string { return formatInt(s.errors) })
+	writeMetric("api_operation_duration_seconds_total", "counter", "Total time spent on resource operations.", operations,
+		func(s *sample) string { return formatFloat(s.duration) })
+
+	return buf.Bytes()
+}
+
+func sortedSamples(samples map[string]*sample) []*sample {
+	var keys []string
+	for key := range samples {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var results []*sample
+	for _, key := range keys {
+		results = append(results, samples[key])
+	}
+	return results
+}
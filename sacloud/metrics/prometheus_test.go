@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/fake/server"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrometheusCollector(t *testing.T) {
+	apiServer := httptest.NewServer(&server.Server{})
+	defer apiServer.Close()
+
+	collector := NewPrometheusCollector()
+
+	client := sacloud.NewClient("token", "secret")
+	client.APIRootURL = apiServer.URL
+	client.Metrics = collector
+
+	ctx := context.Background()
+	noteOp := NewNoteMetrics(sacloud.NewNoteOp(client), collector)
+
+	note, err := noteOp.Create(ctx, "is1a", &sacloud.NoteCreateRequest{
+		Name:    "libsacloud-v2-metrics",
+		Class:   "shell",
+		Content: "content",
+	})
+	require.NoError(t, err)
+
+	_, err = noteOp.Read(ctx, "is1a", note.ID)
+	require.NoError(t, err)
+
+	_, err = noteOp.Read(ctx, "is1a", types.ID(999999999))
+	require.Error(t, err)
+
+	metricsServer := httptest.NewServer(collector)
+	defer metricsServer.Close()
+
+	res, err := metricsServer.Client().Get(metricsServer.URL)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	data, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	expects := []string{
+		`# TYPE sacloud_api_requests_total counter`,
+		`sacloud_api_requests_total{resource="Note",operation="Create",zone="is1a",method="POST",status="201"} 1`,
+		`sacloud_api_requests_total{resource="Note",operation="Read",zone="is1a",method="GET",status="200"} 1`,
+		`sacloud_api_requests_total{resource="Note",operation="Read",zone="is1a",method="GET",status="404"} 1`,
+		`sacloud_api_request_retries_total{resource="Note",operation="Create",zone="is1a",method="POST",status="201"} 0`,
+		`sacloud_api_operations_total{resource="Note",operation="Read",zone="is1a"} 2`,
+		`sacloud_api_operation_errors_total{resource="Note",operation="Read",zone="is1a"} 1`,
+		`sacloud_api_operation_errors_total{resource="Note",operation="Create",zone="is1a"} 0`,
+	}
+	for _, expect := range expects {
+		require.True(t, strings.Contains(string(data), expect+"\n"), "%q is not found in:\n%s", expect, data)
+	}
+}
@@ -0,0 +1,5442 @@
+// generated by 'github.com/sacloud/libsacloud/internal/tools/gen-api-metrics'; DO NOT EDIT
+
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+/*************************************************
+* ArchiveMetrics
+*************************************************/
+
+// ArchiveMetrics is for collect metrics of ArchiveOp operations
+type ArchiveMetrics struct {
+	Internal  sacloud.ArchiveAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewArchiveMetrics creates new ArchiveMetrics instance
+func NewArchiveMetrics(in sacloud.ArchiveAPI, collector sacloud.MetricsCollector) sacloud.ArchiveAPI {
+	return &ArchiveMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *ArchiveMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Archive, error) {
+	ctx = sacloud.WithOperation(ctx, "Archive", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Archive",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *ArchiveMetrics) Create(ctx context.Context, zone string, param *sacloud.ArchiveCreateRequest) (*sacloud.Archive, error) {
+	ctx = sacloud.WithOperation(ctx, "Archive", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Archive",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// CreateBlank is API call with collecting metrics
+func (m *ArchiveMetrics) CreateBlank(ctx context.Context, zone string, param *sacloud.ArchiveCreateBlankRequest) (*sacloud.Archive, *sacloud.FTPServer, error) {
+	ctx = sacloud.WithOperation(ctx, "Archive", "CreateBlank")
+	start := time.Now()
+
+	result0, result1, err := m.Internal.CreateBlank(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Archive",
+		OperationName: "CreateBlank",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, result1, err
+}
+
+// Transfer is API call with collecting metrics
+func (m *ArchiveMetrics) Transfer(ctx context.Context, zone string, id types.ID, destZoneID types.ID, param *sacloud.ArchiveTransferRequest) (*sacloud.Archive, error) {
+	ctx = sacloud.WithOperation(ctx, "Archive", "Transfer")
+	start := time.Now()
+
+	result0, err := m.Internal.Transfer(ctx, zone, id, destZoneID, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Archive",
+		OperationName: "Transfer",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// CreateFromShared is API call with collecting metrics
+func (m *ArchiveMetrics) CreateFromShared(ctx context.Context, zone string, id types.ID, destZoneID types.ID, param *sacloud.ArchiveCreateFromSharedRequest) (*sacloud.Archive, error) {
+	ctx = sacloud.WithOperation(ctx, "Archive", "CreateFromShared")
+	start := time.Now()
+
+	result0, err := m.Internal.CreateFromShared(ctx, zone, id, destZoneID, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Archive",
+		OperationName: "CreateFromShared",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *ArchiveMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Archive, error) {
+	ctx = sacloud.WithOperation(ctx, "Archive", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Archive",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *ArchiveMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.ArchiveUpdateRequest) (*sacloud.Archive, error) {
+	ctx = sacloud.WithOperation(ctx, "Archive", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Archive",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *ArchiveMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Archive", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Archive",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// OpenFTP is API call with collecting metrics
+func (m *ArchiveMetrics) OpenFTP(ctx context.Context, zone string, id types.ID, openOption *sacloud.OpenFTPRequest) (*sacloud.FTPServer, error) {
+	ctx = sacloud.WithOperation(ctx, "Archive", "OpenFTP")
+	start := time.Now()
+
+	result0, err := m.Internal.OpenFTP(ctx, zone, id, openOption)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Archive",
+		OperationName: "OpenFTP",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// CloseFTP is API call with collecting metrics
+func (m *ArchiveMetrics) CloseFTP(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Archive", "CloseFTP")
+	start := time.Now()
+
+	err := m.Internal.CloseFTP(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Archive",
+		OperationName: "CloseFTP",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Share is API call with collecting metrics
+func (m *ArchiveMetrics) Share(ctx context.Context, zone string, id types.ID, param *sacloud.ArchiveShareRequest) (*sacloud.ArchiveShareInfo, error) {
+	ctx = sacloud.WithOperation(ctx, "Archive", "Share")
+	start := time.Now()
+
+	result0, err := m.Internal.Share(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Archive",
+		OperationName: "Share",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* AuthStatusMetrics
+*************************************************/
+
+// AuthStatusMetrics is for collect metrics of AuthStatusOp operations
+type AuthStatusMetrics struct {
+	Internal  sacloud.AuthStatusAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewAuthStatusMetrics creates new AuthStatusMetrics instance
+func NewAuthStatusMetrics(in sacloud.AuthStatusAPI, collector sacloud.MetricsCollector) sacloud.AuthStatusAPI {
+	return &AuthStatusMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Read is API call with collecting metrics
+func (m *AuthStatusMetrics) Read(ctx context.Context, zone string) (*sacloud.AuthStatus, error) {
+	ctx = sacloud.WithOperation(ctx, "AuthStatus", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "AuthStatus",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* AutoBackupMetrics
+*************************************************/
+
+// AutoBackupMetrics is for collect metrics of AutoBackupOp operations
+type AutoBackupMetrics struct {
+	Internal  sacloud.AutoBackupAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewAutoBackupMetrics creates new AutoBackupMetrics instance
+func NewAutoBackupMetrics(in sacloud.AutoBackupAPI, collector sacloud.MetricsCollector) sacloud.AutoBackupAPI {
+	return &AutoBackupMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *AutoBackupMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.AutoBackup, error) {
+	ctx = sacloud.WithOperation(ctx, "AutoBackup", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "AutoBackup",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *AutoBackupMetrics) Create(ctx context.Context, zone string, param *sacloud.AutoBackupCreateRequest) (*sacloud.AutoBackup, error) {
+	ctx = sacloud.WithOperation(ctx, "AutoBackup", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "AutoBackup",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *AutoBackupMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.AutoBackup, error) {
+	ctx = sacloud.WithOperation(ctx, "AutoBackup", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "AutoBackup",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *AutoBackupMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.AutoBackupUpdateRequest) (*sacloud.AutoBackup, error) {
+	ctx = sacloud.WithOperation(ctx, "AutoBackup", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "AutoBackup",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *AutoBackupMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "AutoBackup", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "AutoBackup",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+/*************************************************
+* BillMetrics
+*************************************************/
+
+// BillMetrics is for collect metrics of BillOp operations
+type BillMetrics struct {
+	Internal  sacloud.BillAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewBillMetrics creates new BillMetrics instance
+func NewBillMetrics(in sacloud.BillAPI, collector sacloud.MetricsCollector) sacloud.BillAPI {
+	return &BillMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// ByContract is API call with collecting metrics
+func (m *BillMetrics) ByContract(ctx context.Context, zone string, accountID types.ID) ([]*sacloud.Bill, error) {
+	ctx = sacloud.WithOperation(ctx, "Bill", "ByContract")
+	start := time.Now()
+
+	result0, err := m.Internal.ByContract(ctx, zone, accountID)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Bill",
+		OperationName: "ByContract",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// ByContractYear is API call with collecting metrics
+func (m *BillMetrics) ByContractYear(ctx context.Context, zone string, accountID types.ID, year int) ([]*sacloud.Bill, error) {
+	ctx = sacloud.WithOperation(ctx, "Bill", "ByContractYear")
+	start := time.Now()
+
+	result0, err := m.Internal.ByContractYear(ctx, zone, accountID, year)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Bill",
+		OperationName: "ByContractYear",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// ByContractYearMonth is API call with collecting metrics
+func (m *BillMetrics) ByContractYearMonth(ctx context.Context, zone string, accountID types.ID, year int, month int) ([]*sacloud.Bill, error) {
+	ctx = sacloud.WithOperation(ctx, "Bill", "ByContractYearMonth")
+	start := time.Now()
+
+	result0, err := m.Internal.ByContractYearMonth(ctx, zone, accountID, year, month)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Bill",
+		OperationName: "ByContractYearMonth",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *BillMetrics) Read(ctx context.Context, zone string, id types.ID) ([]*sacloud.Bill, error) {
+	ctx = sacloud.WithOperation(ctx, "Bill", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Bill",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Details is API call with collecting metrics
+func (m *BillMetrics) Details(ctx context.Context, zone string, memberCode string, id types.ID) ([]*sacloud.BillDetail, error) {
+	ctx = sacloud.WithOperation(ctx, "Bill", "Details")
+	start := time.Now()
+
+	result0, err := m.Internal.Details(ctx, zone, memberCode, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Bill",
+		OperationName: "Details",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// DetailsCSV is API call with collecting metrics
+func (m *BillMetrics) DetailsCSV(ctx context.Context, zone string, memberCode string, id types.ID) (*sacloud.BillDetailCSV, error) {
+	ctx = sacloud.WithOperation(ctx, "Bill", "DetailsCSV")
+	start := time.Now()
+
+	result0, err := m.Internal.DetailsCSV(ctx, zone, memberCode, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Bill",
+		OperationName: "DetailsCSV",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* BridgeMetrics
+*************************************************/
+
+// BridgeMetrics is for collect metrics of BridgeOp operations
+type BridgeMetrics struct {
+	Internal  sacloud.BridgeAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewBridgeMetrics creates new BridgeMetrics instance
+func NewBridgeMetrics(in sacloud.BridgeAPI, collector sacloud.MetricsCollector) sacloud.BridgeAPI {
+	return &BridgeMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *BridgeMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Bridge, error) {
+	ctx = sacloud.WithOperation(ctx, "Bridge", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Bridge",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *BridgeMetrics) Create(ctx context.Context, zone string, param *sacloud.BridgeCreateRequest) (*sacloud.Bridge, error) {
+	ctx = sacloud.WithOperation(ctx, "Bridge", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Bridge",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *BridgeMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Bridge, error) {
+	ctx = sacloud.WithOperation(ctx, "Bridge", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Bridge",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *BridgeMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.BridgeUpdateRequest) (*sacloud.Bridge, error) {
+	ctx = sacloud.WithOperation(ctx, "Bridge", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Bridge",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *BridgeMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Bridge", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Bridge",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+/*************************************************
+* CDROMMetrics
+*************************************************/
+
+// CDROMMetrics is for collect metrics of CDROMOp operations
+type CDROMMetrics struct {
+	Internal  sacloud.CDROMAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewCDROMMetrics creates new CDROMMetrics instance
+func NewCDROMMetrics(in sacloud.CDROMAPI, collector sacloud.MetricsCollector) sacloud.CDROMAPI {
+	return &CDROMMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *CDROMMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.CDROM, error) {
+	ctx = sacloud.WithOperation(ctx, "CDROM", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "CDROM",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *CDROMMetrics) Create(ctx context.Context, zone string, param *sacloud.CDROMCreateRequest) (*sacloud.CDROM, *sacloud.FTPServer, error) {
+	ctx = sacloud.WithOperation(ctx, "CDROM", "Create")
+	start := time.Now()
+
+	result0, result1, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "CDROM",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, result1, err
+}
+
+// Read is API call with collecting metrics
+func (m *CDROMMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.CDROM, error) {
+	ctx = sacloud.WithOperation(ctx, "CDROM", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "CDROM",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *CDROMMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.CDROMUpdateRequest) (*sacloud.CDROM, error) {
+	ctx = sacloud.WithOperation(ctx, "CDROM", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "CDROM",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *CDROMMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "CDROM", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "CDROM",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// OpenFTP is API call with collecting metrics
+func (m *CDROMMetrics) OpenFTP(ctx context.Context, zone string, id types.ID, openOption *sacloud.OpenFTPRequest) (*sacloud.FTPServer, error) {
+	ctx = sacloud.WithOperation(ctx, "CDROM", "OpenFTP")
+	start := time.Now()
+
+	result0, err := m.Internal.OpenFTP(ctx, zone, id, openOption)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "CDROM",
+		OperationName: "OpenFTP",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// CloseFTP is API call with collecting metrics
+func (m *CDROMMetrics) CloseFTP(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "CDROM", "CloseFTP")
+	start := time.Now()
+
+	err := m.Internal.CloseFTP(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "CDROM",
+		OperationName: "CloseFTP",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+/*************************************************
+* CouponMetrics
+*************************************************/
+
+// CouponMetrics is for collect metrics of CouponOp operations
+type CouponMetrics struct {
+	Internal  sacloud.CouponAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewCouponMetrics creates new CouponMetrics instance
+func NewCouponMetrics(in sacloud.CouponAPI, collector sacloud.MetricsCollector) sacloud.CouponAPI {
+	return &CouponMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *CouponMetrics) Find(ctx context.Context, zone string, accountID types.ID) ([]*sacloud.Coupon, error) {
+	ctx = sacloud.WithOperation(ctx, "Coupon", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, accountID)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Coupon",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* DatabaseMetrics
+*************************************************/
+
+// DatabaseMetrics is for collect metrics of DatabaseOp operations
+type DatabaseMetrics struct {
+	Internal  sacloud.DatabaseAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewDatabaseMetrics creates new DatabaseMetrics instance
+func NewDatabaseMetrics(in sacloud.DatabaseAPI, collector sacloud.MetricsCollector) sacloud.DatabaseAPI {
+	return &DatabaseMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *DatabaseMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Database, error) {
+	ctx = sacloud.WithOperation(ctx, "Database", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Database",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *DatabaseMetrics) Create(ctx context.Context, zone string, param *sacloud.DatabaseCreateRequest) (*sacloud.Database, error) {
+	ctx = sacloud.WithOperation(ctx, "Database", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Database",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *DatabaseMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Database, error) {
+	ctx = sacloud.WithOperation(ctx, "Database", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Database",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *DatabaseMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.DatabaseUpdateRequest) (*sacloud.Database, error) {
+	ctx = sacloud.WithOperation(ctx, "Database", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Database",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *DatabaseMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Database", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Database",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Config is API call with collecting metrics
+func (m *DatabaseMetrics) Config(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Database", "Config")
+	start := time.Now()
+
+	err := m.Internal.Config(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Database",
+		OperationName: "Config",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Boot is API call with collecting metrics
+func (m *DatabaseMetrics) Boot(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Database", "Boot")
+	start := time.Now()
+
+	err := m.Internal.Boot(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Database",
+		OperationName: "Boot",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Shutdown is API call with collecting metrics
+func (m *DatabaseMetrics) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
+	ctx = sacloud.WithOperation(ctx, "Database", "Shutdown")
+	start := time.Now()
+
+	err := m.Internal.Shutdown(ctx, zone, id, shutdownOption)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Database",
+		OperationName: "Shutdown",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Reset is API call with collecting metrics
+func (m *DatabaseMetrics) Reset(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Database", "Reset")
+	start := time.Now()
+
+	err := m.Internal.Reset(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Database",
+		OperationName: "Reset",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// MonitorCPU is API call with collecting metrics
+func (m *DatabaseMetrics) MonitorCPU(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.CPUTimeActivity, error) {
+	ctx = sacloud.WithOperation(ctx, "Database", "MonitorCPU")
+	start := time.Now()
+
+	result0, err := m.Internal.MonitorCPU(ctx, zone, id, condition)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Database",
+		OperationName: "MonitorCPU",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// MonitorDisk is API call with collecting metrics
+func (m *DatabaseMetrics) MonitorDisk(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.DiskActivity, error) {
+	ctx = sacloud.WithOperation(ctx, "Database", "MonitorDisk")
+	start := time.Now()
+
+	result0, err := m.Internal.MonitorDisk(ctx, zone, id, condition)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Database",
+		OperationName: "MonitorDisk",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// MonitorInterface is API call with collecting metrics
+func (m *DatabaseMetrics) MonitorInterface(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
+	ctx = sacloud.WithOperation(ctx, "Database", "MonitorInterface")
+	start := time.Now()
+
+	result0, err := m.Internal.MonitorInterface(ctx, zone, id, condition)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Database",
+		OperationName: "MonitorInterface",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// MonitorDatabase is API call with collecting metrics
+func (m *DatabaseMetrics) MonitorDatabase(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.DatabaseActivity, error) {
+	ctx = sacloud.WithOperation(ctx, "Database", "MonitorDatabase")
+	start := time.Now()
+
+	result0, err := m.Internal.MonitorDatabase(ctx, zone, id, condition)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Database",
+		OperationName: "MonitorDatabase",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Status is API call with collecting metrics
+func (m *DatabaseMetrics) Status(ctx context.Context, zone string, id types.ID) (*sacloud.DatabaseStatus, error) {
+	ctx = sacloud.WithOperation(ctx, "Database", "Status")
+	start := time.Now()
+
+	result0, err := m.Internal.Status(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Database",
+		OperationName: "Status",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// GetParameter is API call with collecting metrics
+func (m *DatabaseMetrics) GetParameter(ctx context.Context, zone string, id types.ID) (*sacloud.DatabaseParameter, error) {
+	ctx = sacloud.WithOperation(ctx, "Database", "GetParameter")
+	start := time.Now()
+
+	result0, err := m.Internal.GetParameter(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Database",
+		OperationName: "GetParameter",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// SetParameter is API call with collecting metrics
+func (m *DatabaseMetrics) SetParameter(ctx context.Context, zone string, id types.ID, param map[string]interface{}) error {
+	ctx = sacloud.WithOperation(ctx, "Database", "SetParameter")
+	start := time.Now()
+
+	err := m.Internal.SetParameter(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Database",
+		OperationName: "SetParameter",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+/*************************************************
+* DiskMetrics
+*************************************************/
+
+// DiskMetrics is for collect metrics of DiskOp operations
+type DiskMetrics struct {
+	Internal  sacloud.DiskAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewDiskMetrics creates new DiskMetrics instance
+func NewDiskMetrics(in sacloud.DiskAPI, collector sacloud.MetricsCollector) sacloud.DiskAPI {
+	return &DiskMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *DiskMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Disk, error) {
+	ctx = sacloud.WithOperation(ctx, "Disk", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Disk",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *DiskMetrics) Create(ctx context.Context, zone string, param *sacloud.DiskCreateRequest) (*sacloud.Disk, error) {
+	ctx = sacloud.WithOperation(ctx, "Disk", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Disk",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// CreateDistantly is API call with collecting metrics
+func (m *DiskMetrics) CreateDistantly(ctx context.Context, zone string, createParam *sacloud.DiskCreateRequest, distantFrom []types.ID) (*sacloud.Disk, error) {
+	ctx = sacloud.WithOperation(ctx, "Disk", "CreateDistantly")
+	start := time.Now()
+
+	result0, err := m.Internal.CreateDistantly(ctx, zone, createParam, distantFrom)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Disk",
+		OperationName: "CreateDistantly",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Config is API call with collecting metrics
+func (m *DiskMetrics) Config(ctx context.Context, zone string, id types.ID, edit *sacloud.DiskEditRequest) error {
+	ctx = sacloud.WithOperation(ctx, "Disk", "Config")
+	start := time.Now()
+
+	err := m.Internal.Config(ctx, zone, id, edit)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Disk",
+		OperationName: "Config",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// CreateWithConfig is API call with collecting metrics
+func (m *DiskMetrics) CreateWithConfig(ctx context.Context, zone string, createParam *sacloud.DiskCreateRequest, editParam *sacloud.DiskEditRequest, bootAtAvailable bool) (*sacloud.Disk, error) {
+	ctx = sacloud.WithOperation(ctx, "Disk", "CreateWithConfig")
+	start := time.Now()
+
+	result0, err := m.Internal.CreateWithConfig(ctx, zone, createParam, editParam, bootAtAvailable)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Disk",
+		OperationName: "CreateWithConfig",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// CreateWithConfigDistantly is API call with collecting metrics
+func (m *DiskMetrics) CreateWithConfigDistantly(ctx context.Context, zone string, createParam *sacloud.DiskCreateRequest, editParam *sacloud.DiskEditRequest, bootAtAvailable bool, distantFrom []types.ID) (*sacloud.Disk, error) {
+	ctx = sacloud.WithOperation(ctx, "Disk", "CreateWithConfigDistantly")
+	start := time.Now()
+
+	result0, err := m.Internal.CreateWithConfigDistantly(ctx, zone, createParam, editParam, bootAtAvailable, distantFrom)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Disk",
+		OperationName: "CreateWithConfigDistantly",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// ToBlank is API call with collecting metrics
+func (m *DiskMetrics) ToBlank(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Disk", "ToBlank")
+	start := time.Now()
+
+	err := m.Internal.ToBlank(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Disk",
+		OperationName: "ToBlank",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// ResizePartition is API call with collecting metrics
+func (m *DiskMetrics) ResizePartition(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Disk", "ResizePartition")
+	start := time.Now()
+
+	err := m.Internal.ResizePartition(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Disk",
+		OperationName: "ResizePartition",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// ConnectToServer is API call with collecting metrics
+func (m *DiskMetrics) ConnectToServer(ctx context.Context, zone string, id types.ID, serverID types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Disk", "ConnectToServer")
+	start := time.Now()
+
+	err := m.Internal.ConnectToServer(ctx, zone, id, serverID)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Disk",
+		OperationName: "ConnectToServer",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// DisconnectFromServer is API call with collecting metrics
+func (m *DiskMetrics) DisconnectFromServer(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Disk", "DisconnectFromServer")
+	start := time.Now()
+
+	err := m.Internal.DisconnectFromServer(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Disk",
+		OperationName: "DisconnectFromServer",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// InstallDistantFrom is API call with collecting metrics
+func (m *DiskMetrics) InstallDistantFrom(ctx context.Context, zone string, id types.ID, installParam *sacloud.DiskInstallRequest, distantFrom []types.ID) (*sacloud.Disk, error) {
+	ctx = sacloud.WithOperation(ctx, "Disk", "InstallDistantFrom")
+	start := time.Now()
+
+	result0, err := m.Internal.InstallDistantFrom(ctx, zone, id, installParam, distantFrom)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Disk",
+		OperationName: "InstallDistantFrom",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Install is API call with collecting metrics
+func (m *DiskMetrics) Install(ctx context.Context, zone string, id types.ID, installParam *sacloud.DiskInstallRequest) (*sacloud.Disk, error) {
+	ctx = sacloud.WithOperation(ctx, "Disk", "Install")
+	start := time.Now()
+
+	result0, err := m.Internal.Install(ctx, zone, id, installParam)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Disk",
+		OperationName: "Install",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *DiskMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Disk, error) {
+	ctx = sacloud.WithOperation(ctx, "Disk", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Disk",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *DiskMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.DiskUpdateRequest) (*sacloud.Disk, error) {
+	ctx = sacloud.WithOperation(ctx, "Disk", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Disk",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *DiskMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Disk", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Disk",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Monitor is API call with collecting metrics
+func (m *DiskMetrics) Monitor(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.DiskActivity, error) {
+	ctx = sacloud.WithOperation(ctx, "Disk", "Monitor")
+	start := time.Now()
+
+	result0, err := m.Internal.Monitor(ctx, zone, id, condition)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Disk",
+		OperationName: "Monitor",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* DiskPlanMetrics
+*************************************************/
+
+// DiskPlanMetrics is for collect metrics of DiskPlanOp operations
+type DiskPlanMetrics struct {
+	Internal  sacloud.DiskPlanAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewDiskPlanMetrics creates new DiskPlanMetrics instance
+func NewDiskPlanMetrics(in sacloud.DiskPlanAPI, collector sacloud.MetricsCollector) sacloud.DiskPlanAPI {
+	return &DiskPlanMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *DiskPlanMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.DiskPlan, error) {
+	ctx = sacloud.WithOperation(ctx, "DiskPlan", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "DiskPlan",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *DiskPlanMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.DiskPlan, error) {
+	ctx = sacloud.WithOperation(ctx, "DiskPlan", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "DiskPlan",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* DNSMetrics
+*************************************************/
+
+// DNSMetrics is for collect metrics of DNSOp operations
+type DNSMetrics struct {
+	Internal  sacloud.DNSAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewDNSMetrics creates new DNSMetrics instance
+func NewDNSMetrics(in sacloud.DNSAPI, collector sacloud.MetricsCollector) sacloud.DNSAPI {
+	return &DNSMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *DNSMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.DNS, error) {
+	ctx = sacloud.WithOperation(ctx, "DNS", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "DNS",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *DNSMetrics) Create(ctx context.Context, zone string, param *sacloud.DNSCreateRequest) (*sacloud.DNS, error) {
+	ctx = sacloud.WithOperation(ctx, "DNS", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "DNS",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *DNSMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.DNS, error) {
+	ctx = sacloud.WithOperation(ctx, "DNS", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "DNS",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *DNSMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.DNSUpdateRequest) (*sacloud.DNS, error) {
+	ctx = sacloud.WithOperation(ctx, "DNS", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "DNS",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *DNSMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "DNS", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "DNS",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+/*************************************************
+* ESUsageMetrics
+*************************************************/
+
+// ESUsageMetrics is for collect metrics of ESUsageOp operations
+type ESUsageMetrics struct {
+	Internal  sacloud.ESUsageAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewESUsageMetrics creates new ESUsageMetrics instance
+func NewESUsageMetrics(in sacloud.ESUsageAPI, collector sacloud.MetricsCollector) sacloud.ESUsageAPI {
+	return &ESUsageMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *ESUsageMetrics) Find(ctx context.Context, zone string, accountID types.ID, year int, month int) ([]*sacloud.ESUsage, error) {
+	ctx = sacloud.WithOperation(ctx, "ESUsage", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, accountID, year, month)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "ESUsage",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* GSLBMetrics
+*************************************************/
+
+// GSLBMetrics is for collect metrics of GSLBOp operations
+type GSLBMetrics struct {
+	Internal  sacloud.GSLBAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewGSLBMetrics creates new GSLBMetrics instance
+func NewGSLBMetrics(in sacloud.GSLBAPI, collector sacloud.MetricsCollector) sacloud.GSLBAPI {
+	return &GSLBMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *GSLBMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.GSLB, error) {
+	ctx = sacloud.WithOperation(ctx, "GSLB", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "GSLB",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *GSLBMetrics) Create(ctx context.Context, zone string, param *sacloud.GSLBCreateRequest) (*sacloud.GSLB, error) {
+	ctx = sacloud.WithOperation(ctx, "GSLB", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "GSLB",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *GSLBMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.GSLB, error) {
+	ctx = sacloud.WithOperation(ctx, "GSLB", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "GSLB",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *GSLBMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.GSLBUpdateRequest) (*sacloud.GSLB, error) {
+	ctx = sacloud.WithOperation(ctx, "GSLB", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "GSLB",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *GSLBMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "GSLB", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "GSLB",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+/*************************************************
+* IconMetrics
+*************************************************/
+
+// IconMetrics is for collect metrics of IconOp operations
+type IconMetrics struct {
+	Internal  sacloud.IconAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewIconMetrics creates new IconMetrics instance
+func NewIconMetrics(in sacloud.IconAPI, collector sacloud.MetricsCollector) sacloud.IconAPI {
+	return &IconMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *IconMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Icon, error) {
+	ctx = sacloud.WithOperation(ctx, "Icon", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Icon",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *IconMetrics) Create(ctx context.Context, zone string, param *sacloud.IconCreateRequest) (*sacloud.Icon, error) {
+	ctx = sacloud.WithOperation(ctx, "Icon", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Icon",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *IconMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Icon, error) {
+	ctx = sacloud.WithOperation(ctx, "Icon", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Icon",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *IconMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.IconUpdateRequest) (*sacloud.Icon, error) {
+	ctx = sacloud.WithOperation(ctx, "Icon", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Icon",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *IconMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Icon", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Icon",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// GetImage is API call with collecting metrics
+func (m *IconMetrics) GetImage(ctx context.Context, zone string, id types.ID, size types.EIconSize) (*sacloud.IconImage, error) {
+	ctx = sacloud.WithOperation(ctx, "Icon", "GetImage")
+	start := time.Now()
+
+	result0, err := m.Internal.GetImage(ctx, zone, id, size)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Icon",
+		OperationName: "GetImage",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* InterfaceMetrics
+*************************************************/
+
+// InterfaceMetrics is for collect metrics of InterfaceOp operations
+type InterfaceMetrics struct {
+	Internal  sacloud.InterfaceAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewInterfaceMetrics creates new InterfaceMetrics instance
+func NewInterfaceMetrics(in sacloud.InterfaceAPI, collector sacloud.MetricsCollector) sacloud.InterfaceAPI {
+	return &InterfaceMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *InterfaceMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Interface, error) {
+	ctx = sacloud.WithOperation(ctx, "Interface", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Interface",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *InterfaceMetrics) Create(ctx context.Context, zone string, param *sacloud.InterfaceCreateRequest) (*sacloud.Interface, error) {
+	ctx = sacloud.WithOperation(ctx, "Interface", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Interface",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *InterfaceMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Interface, error) {
+	ctx = sacloud.WithOperation(ctx, "Interface", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Interface",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *InterfaceMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.InterfaceUpdateRequest) (*sacloud.Interface, error) {
+	ctx = sacloud.WithOperation(ctx, "Interface", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Interface",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *InterfaceMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Interface", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Interface",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Monitor is API call with collecting metrics
+func (m *InterfaceMetrics) Monitor(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
+	ctx = sacloud.WithOperation(ctx, "Interface", "Monitor")
+	start := time.Now()
+
+	result0, err := m.Internal.Monitor(ctx, zone, id, condition)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Interface",
+		OperationName: "Monitor",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// ConnectToSharedSegment is API call with collecting metrics
+func (m *InterfaceMetrics) ConnectToSharedSegment(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Interface", "ConnectToSharedSegment")
+	start := time.Now()
+
+	err := m.Internal.ConnectToSharedSegment(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Interface",
+		OperationName: "ConnectToSharedSegment",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// ConnectToSwitch is API call with collecting metrics
+func (m *InterfaceMetrics) ConnectToSwitch(ctx context.Context, zone string, id types.ID, switchID types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Interface", "ConnectToSwitch")
+	start := time.Now()
+
+	err := m.Internal.ConnectToSwitch(ctx, zone, id, switchID)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Interface",
+		OperationName: "ConnectToSwitch",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// DisconnectFromSwitch is API call with collecting metrics
+func (m *InterfaceMetrics) DisconnectFromSwitch(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Interface", "DisconnectFromSwitch")
+	start := time.Now()
+
+	err := m.Internal.DisconnectFromSwitch(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Interface",
+		OperationName: "DisconnectFromSwitch",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// ConnectToPacketFilter is API call with collecting metrics
+func (m *InterfaceMetrics) ConnectToPacketFilter(ctx context.Context, zone string, id types.ID, packetFilterID types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Interface", "ConnectToPacketFilter")
+	start := time.Now()
+
+	err := m.Internal.ConnectToPacketFilter(ctx, zone, id, packetFilterID)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Interface",
+		OperationName: "ConnectToPacketFilter",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// DisconnectFromPacketFilter is API call with collecting metrics
+func (m *InterfaceMetrics) DisconnectFromPacketFilter(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Interface", "DisconnectFromPacketFilter")
+	start := time.Now()
+
+	err := m.Internal.DisconnectFromPacketFilter(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Interface",
+		OperationName: "DisconnectFromPacketFilter",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+/*************************************************
+* InternetMetrics
+*************************************************/
+
+// InternetMetrics is for collect metrics of InternetOp operations
+type InternetMetrics struct {
+	Internal  sacloud.InternetAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewInternetMetrics creates new InternetMetrics instance
+func NewInternetMetrics(in sacloud.InternetAPI, collector sacloud.MetricsCollector) sacloud.InternetAPI {
+	return &InternetMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *InternetMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Internet, error) {
+	ctx = sacloud.WithOperation(ctx, "Internet", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Internet",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *InternetMetrics) Create(ctx context.Context, zone string, param *sacloud.InternetCreateRequest) (*sacloud.Internet, error) {
+	ctx = sacloud.WithOperation(ctx, "Internet", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Internet",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *InternetMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Internet, error) {
+	ctx = sacloud.WithOperation(ctx, "Internet", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Internet",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *InternetMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.InternetUpdateRequest) (*sacloud.Internet, error) {
+	ctx = sacloud.WithOperation(ctx, "Internet", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Internet",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *InternetMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Internet", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Internet",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// UpdateBandWidth is API call with collecting metrics
+func (m *InternetMetrics) UpdateBandWidth(ctx context.Context, zone string, id types.ID, param *sacloud.InternetUpdateBandWidthRequest) (*sacloud.Internet, error) {
+	ctx = sacloud.WithOperation(ctx, "Internet", "UpdateBandWidth")
+	start := time.Now()
+
+	result0, err := m.Internal.UpdateBandWidth(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Internet",
+		OperationName: "UpdateBandWidth",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// AddSubnet is API call with collecting metrics
+func (m *InternetMetrics) AddSubnet(ctx context.Context, zone string, id types.ID, param *sacloud.InternetAddSubnetRequest) (*sacloud.InternetSubnetOperationResult, error) {
+	ctx = sacloud.WithOperation(ctx, "Internet", "AddSubnet")
+	start := time.Now()
+
+	result0, err := m.Internal.AddSubnet(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Internet",
+		OperationName: "AddSubnet",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// UpdateSubnet is API call with collecting metrics
+func (m *InternetMetrics) UpdateSubnet(ctx context.Context, zone string, id types.ID, subnetID types.ID, param *sacloud.InternetUpdateSubnetRequest) (*sacloud.InternetSubnetOperationResult, error) {
+	ctx = sacloud.WithOperation(ctx, "Internet", "UpdateSubnet")
+	start := time.Now()
+
+	result0, err := m.Internal.UpdateSubnet(ctx, zone, id, subnetID, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Internet",
+		OperationName: "UpdateSubnet",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// DeleteSubnet is API call with collecting metrics
+func (m *InternetMetrics) DeleteSubnet(ctx context.Context, zone string, id types.ID, subnetID types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Internet", "DeleteSubnet")
+	start := time.Now()
+
+	err := m.Internal.DeleteSubnet(ctx, zone, id, subnetID)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Internet",
+		OperationName: "DeleteSubnet",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Monitor is API call with collecting metrics
+func (m *InternetMetrics) Monitor(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.RouterActivity, error) {
+	ctx = sacloud.WithOperation(ctx, "Internet", "Monitor")
+	start := time.Now()
+
+	result0, err := m.Internal.Monitor(ctx, zone, id, condition)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Internet",
+		OperationName: "Monitor",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// EnableIPv6 is API call with collecting metrics
+func (m *InternetMetrics) EnableIPv6(ctx context.Context, zone string, id types.ID) (*sacloud.IPv6NetInfo, error) {
+	ctx = sacloud.WithOperation(ctx, "Internet", "EnableIPv6")
+	start := time.Now()
+
+	result0, err := m.Internal.EnableIPv6(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Internet",
+		OperationName: "EnableIPv6",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// DisableIPv6 is API call with collecting metrics
+func (m *InternetMetrics) DisableIPv6(ctx context.Context, zone string, id types.ID, ipv6netID types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Internet", "DisableIPv6")
+	start := time.Now()
+
+	err := m.Internal.DisableIPv6(ctx, zone, id, ipv6netID)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Internet",
+		OperationName: "DisableIPv6",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+/*************************************************
+* InternetPlanMetrics
+*************************************************/
+
+// InternetPlanMetrics is for collect metrics of InternetPlanOp operations
+type InternetPlanMetrics struct {
+	Internal  sacloud.InternetPlanAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewInternetPlanMetrics creates new InternetPlanMetrics instance
+func NewInternetPlanMetrics(in sacloud.InternetPlanAPI, collector sacloud.MetricsCollector) sacloud.InternetPlanAPI {
+	return &InternetPlanMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *InternetPlanMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.InternetPlan, error) {
+	ctx = sacloud.WithOperation(ctx, "InternetPlan", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "InternetPlan",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *InternetPlanMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.InternetPlan, error) {
+	ctx = sacloud.WithOperation(ctx, "InternetPlan", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "InternetPlan",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* IPAddressMetrics
+*************************************************/
+
+// IPAddressMetrics is for collect metrics of IPAddressOp operations
+type IPAddressMetrics struct {
+	Internal  sacloud.IPAddressAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewIPAddressMetrics creates new IPAddressMetrics instance
+func NewIPAddressMetrics(in sacloud.IPAddressAPI, collector sacloud.MetricsCollector) sacloud.IPAddressAPI {
+	return &IPAddressMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *IPAddressMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.IPAddress, error) {
+	ctx = sacloud.WithOperation(ctx, "IPAddress", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "IPAddress",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *IPAddressMetrics) Read(ctx context.Context, zone string, ipAddress string) (*sacloud.IPAddress, error) {
+	ctx = sacloud.WithOperation(ctx, "IPAddress", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, ipAddress)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "IPAddress",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// UpdateHostName is API call with collecting metrics
+func (m *IPAddressMetrics) UpdateHostName(ctx context.Context, zone string, ipAddress string, hostName string) (*sacloud.IPAddress, error) {
+	ctx = sacloud.WithOperation(ctx, "IPAddress", "UpdateHostName")
+	start := time.Now()
+
+	result0, err := m.Internal.UpdateHostName(ctx, zone, ipAddress, hostName)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "IPAddress",
+		OperationName: "UpdateHostName",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* IPv6AddrMetrics
+*************************************************/
+
+// IPv6AddrMetrics is for collect metrics of IPv6AddrOp operations
+type IPv6AddrMetrics struct {
+	Internal  sacloud.IPv6AddrAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewIPv6AddrMetrics creates new IPv6AddrMetrics instance
+func NewIPv6AddrMetrics(in sacloud.IPv6AddrAPI, collector sacloud.MetricsCollector) sacloud.IPv6AddrAPI {
+	return &IPv6AddrMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *IPv6AddrMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.IPv6Addr, error) {
+	ctx = sacloud.WithOperation(ctx, "IPv6Addr", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "IPv6Addr",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *IPv6AddrMetrics) Create(ctx context.Context, zone string, param *sacloud.IPv6AddrCreateRequest) (*sacloud.IPv6Addr, error) {
+	ctx = sacloud.WithOperation(ctx, "IPv6Addr", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "IPv6Addr",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *IPv6AddrMetrics) Read(ctx context.Context, zone string, ipv6addr string) (*sacloud.IPv6Addr, error) {
+	ctx = sacloud.WithOperation(ctx, "IPv6Addr", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, ipv6addr)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "IPv6Addr",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *IPv6AddrMetrics) Update(ctx context.Context, zone string, ipv6addr string, param *sacloud.IPv6AddrUpdateRequest) (*sacloud.IPv6Addr, error) {
+	ctx = sacloud.WithOperation(ctx, "IPv6Addr", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, ipv6addr, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "IPv6Addr",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *IPv6AddrMetrics) Delete(ctx context.Context, zone string, ipv6addr string) error {
+	ctx = sacloud.WithOperation(ctx, "IPv6Addr", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, ipv6addr)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "IPv6Addr",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+/*************************************************
+* IPv6NetMetrics
+*************************************************/
+
+// IPv6NetMetrics is for collect metrics of IPv6NetOp operations
+type IPv6NetMetrics struct {
+	Internal  sacloud.IPv6NetAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewIPv6NetMetrics creates new IPv6NetMetrics instance
+func NewIPv6NetMetrics(in sacloud.IPv6NetAPI, collector sacloud.MetricsCollector) sacloud.IPv6NetAPI {
+	return &IPv6NetMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *IPv6NetMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.IPv6Net, error) {
+	ctx = sacloud.WithOperation(ctx, "IPv6Net", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "IPv6Net",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *IPv6NetMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.IPv6Net, error) {
+	ctx = sacloud.WithOperation(ctx, "IPv6Net", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "IPv6Net",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* LicenseMetrics
+*************************************************/
+
+// LicenseMetrics is for collect metrics of LicenseOp operations
+type LicenseMetrics struct {
+	Internal  sacloud.LicenseAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewLicenseMetrics creates new LicenseMetrics instance
+func NewLicenseMetrics(in sacloud.LicenseAPI, collector sacloud.MetricsCollector) sacloud.LicenseAPI {
+	return &LicenseMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *LicenseMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.License, error) {
+	ctx = sacloud.WithOperation(ctx, "License", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "License",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *LicenseMetrics) Create(ctx context.Context, zone string, param *sacloud.LicenseCreateRequest) (*sacloud.License, error) {
+	ctx = sacloud.WithOperation(ctx, "License", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "License",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *LicenseMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.License, error) {
+	ctx = sacloud.WithOperation(ctx, "License", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "License",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *LicenseMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.LicenseUpdateRequest) (*sacloud.License, error) {
+	ctx = sacloud.WithOperation(ctx, "License", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "License",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *LicenseMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "License", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "License",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+/*************************************************
+* LicensePlanMetrics
+*************************************************/
+
+// LicensePlanMetrics is for collect metrics of LicensePlanOp operations
+type LicensePlanMetrics struct {
+	Internal  sacloud.LicensePlanAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewLicensePlanMetrics creates new LicensePlanMetrics instance
+func NewLicensePlanMetrics(in sacloud.LicensePlanAPI, collector sacloud.MetricsCollector) sacloud.LicensePlanAPI {
+	return &LicensePlanMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *LicensePlanMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.LicensePlan, error) {
+	ctx = sacloud.WithOperation(ctx, "LicensePlan", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "LicensePlan",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *LicensePlanMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.LicensePlan, error) {
+	ctx = sacloud.WithOperation(ctx, "LicensePlan", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "LicensePlan",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* LoadBalancerMetrics
+*************************************************/
+
+// LoadBalancerMetrics is for collect metrics of LoadBalancerOp operations
+type LoadBalancerMetrics struct {
+	Internal  sacloud.LoadBalancerAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewLoadBalancerMetrics creates new LoadBalancerMetrics instance
+func NewLoadBalancerMetrics(in sacloud.LoadBalancerAPI, collector sacloud.MetricsCollector) sacloud.LoadBalancerAPI {
+	return &LoadBalancerMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *LoadBalancerMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.LoadBalancer, error) {
+	ctx = sacloud.WithOperation(ctx, "LoadBalancer", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "LoadBalancer",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *LoadBalancerMetrics) Create(ctx context.Context, zone string, param *sacloud.LoadBalancerCreateRequest) (*sacloud.LoadBalancer, error) {
+	ctx = sacloud.WithOperation(ctx, "LoadBalancer", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "LoadBalancer",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *LoadBalancerMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.LoadBalancer, error) {
+	ctx = sacloud.WithOperation(ctx, "LoadBalancer", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "LoadBalancer",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *LoadBalancerMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.LoadBalancerUpdateRequest) (*sacloud.LoadBalancer, error) {
+	ctx = sacloud.WithOperation(ctx, "LoadBalancer", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "LoadBalancer",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *LoadBalancerMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "LoadBalancer", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "LoadBalancer",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Config is API call with collecting metrics
+func (m *LoadBalancerMetrics) Config(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "LoadBalancer", "Config")
+	start := time.Now()
+
+	err := m.Internal.Config(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "LoadBalancer",
+		OperationName: "Config",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Boot is API call with collecting metrics
+func (m *LoadBalancerMetrics) Boot(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "LoadBalancer", "Boot")
+	start := time.Now()
+
+	err := m.Internal.Boot(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "LoadBalancer",
+		OperationName: "Boot",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Shutdown is API call with collecting metrics
+func (m *LoadBalancerMetrics) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
+	ctx = sacloud.WithOperation(ctx, "LoadBalancer", "Shutdown")
+	start := time.Now()
+
+	err := m.Internal.Shutdown(ctx, zone, id, shutdownOption)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "LoadBalancer",
+		OperationName: "Shutdown",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Reset is API call with collecting metrics
+func (m *LoadBalancerMetrics) Reset(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "LoadBalancer", "Reset")
+	start := time.Now()
+
+	err := m.Internal.Reset(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "LoadBalancer",
+		OperationName: "Reset",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// MonitorInterface is API call with collecting metrics
+func (m *LoadBalancerMetrics) MonitorInterface(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
+	ctx = sacloud.WithOperation(ctx, "LoadBalancer", "MonitorInterface")
+	start := time.Now()
+
+	result0, err := m.Internal.MonitorInterface(ctx, zone, id, condition)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "LoadBalancer",
+		OperationName: "MonitorInterface",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Status is API call with collecting metrics
+func (m *LoadBalancerMetrics) Status(ctx context.Context, zone string, id types.ID) ([]*sacloud.LoadBalancerStatus, error) {
+	ctx = sacloud.WithOperation(ctx, "LoadBalancer", "Status")
+	start := time.Now()
+
+	result0, err := m.Internal.Status(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "LoadBalancer",
+		OperationName: "Status",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* MobileGatewayMetrics
+*************************************************/
+
+// MobileGatewayMetrics is for collect metrics of MobileGatewayOp operations
+type MobileGatewayMetrics struct {
+	Internal  sacloud.MobileGatewayAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewMobileGatewayMetrics creates new MobileGatewayMetrics instance
+func NewMobileGatewayMetrics(in sacloud.MobileGatewayAPI, collector sacloud.MetricsCollector) sacloud.MobileGatewayAPI {
+	return &MobileGatewayMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *MobileGatewayMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.MobileGateway, error) {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *MobileGatewayMetrics) Create(ctx context.Context, zone string, param *sacloud.MobileGatewayCreateRequest) (*sacloud.MobileGateway, error) {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *MobileGatewayMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGateway, error) {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *MobileGatewayMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayUpdateRequest) (*sacloud.MobileGateway, error) {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *MobileGatewayMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Config is API call with collecting metrics
+func (m *MobileGatewayMetrics) Config(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "Config")
+	start := time.Now()
+
+	err := m.Internal.Config(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "Config",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Boot is API call with collecting metrics
+func (m *MobileGatewayMetrics) Boot(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "Boot")
+	start := time.Now()
+
+	err := m.Internal.Boot(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "Boot",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Shutdown is API call with collecting metrics
+func (m *MobileGatewayMetrics) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "Shutdown")
+	start := time.Now()
+
+	err := m.Internal.Shutdown(ctx, zone, id, shutdownOption)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "Shutdown",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Reset is API call with collecting metrics
+func (m *MobileGatewayMetrics) Reset(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "Reset")
+	start := time.Now()
+
+	err := m.Internal.Reset(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "Reset",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// ConnectToSwitch is API call with collecting metrics
+func (m *MobileGatewayMetrics) ConnectToSwitch(ctx context.Context, zone string, id types.ID, switchID types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "ConnectToSwitch")
+	start := time.Now()
+
+	err := m.Internal.ConnectToSwitch(ctx, zone, id, switchID)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "ConnectToSwitch",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// DisconnectFromSwitch is API call with collecting metrics
+func (m *MobileGatewayMetrics) DisconnectFromSwitch(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "DisconnectFromSwitch")
+	start := time.Now()
+
+	err := m.Internal.DisconnectFromSwitch(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "DisconnectFromSwitch",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// GetDNS is API call with collecting metrics
+func (m *MobileGatewayMetrics) GetDNS(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGatewayDNSSetting, error) {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "GetDNS")
+	start := time.Now()
+
+	result0, err := m.Internal.GetDNS(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "GetDNS",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// SetDNS is API call with collecting metrics
+func (m *MobileGatewayMetrics) SetDNS(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayDNSSetting) error {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "SetDNS")
+	start := time.Now()
+
+	err := m.Internal.SetDNS(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "SetDNS",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// ListSIM is API call with collecting metrics
+func (m *MobileGatewayMetrics) ListSIM(ctx context.Context, zone string, id types.ID) ([]*sacloud.MobileGatewaySIMInfo, error) {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "ListSIM")
+	start := time.Now()
+
+	result0, err := m.Internal.ListSIM(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "ListSIM",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// AddSIM is API call with collecting metrics
+func (m *MobileGatewayMetrics) AddSIM(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayAddSIMRequest) error {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "AddSIM")
+	start := time.Now()
+
+	err := m.Internal.AddSIM(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "AddSIM",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// DeleteSIM is API call with collecting metrics
+func (m *MobileGatewayMetrics) DeleteSIM(ctx context.Context, zone string, id types.ID, simID types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "DeleteSIM")
+	start := time.Now()
+
+	err := m.Internal.DeleteSIM(ctx, zone, id, simID)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "DeleteSIM",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// GetSIMRoutes is API call with collecting metrics
+func (m *MobileGatewayMetrics) GetSIMRoutes(ctx context.Context, zone string, id types.ID) ([]*sacloud.MobileGatewaySIMRoute, error) {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "GetSIMRoutes")
+	start := time.Now()
+
+	result0, err := m.Internal.GetSIMRoutes(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "GetSIMRoutes",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// SetSIMRoutes is API call with collecting metrics
+func (m *MobileGatewayMetrics) SetSIMRoutes(ctx context.Context, zone string, id types.ID, routes []*sacloud.MobileGatewaySIMRoute) error {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "SetSIMRoutes")
+	start := time.Now()
+
+	err := m.Internal.SetSIMRoutes(ctx, zone, id, routes)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "SetSIMRoutes",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// GetTrafficConfig is API call with collecting metrics
+func (m *MobileGatewayMetrics) GetTrafficConfig(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGatewayTrafficControl, error) {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "GetTrafficConfig")
+	start := time.Now()
+
+	result0, err := m.Internal.GetTrafficConfig(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "GetTrafficConfig",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// SetTrafficConfig is API call with collecting metrics
+func (m *MobileGatewayMetrics) SetTrafficConfig(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayTrafficControl) error {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "SetTrafficConfig")
+	start := time.Now()
+
+	err := m.Internal.SetTrafficConfig(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "SetTrafficConfig",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// DeleteTrafficConfig is API call with collecting metrics
+func (m *MobileGatewayMetrics) DeleteTrafficConfig(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "DeleteTrafficConfig")
+	start := time.Now()
+
+	err := m.Internal.DeleteTrafficConfig(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "DeleteTrafficConfig",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// TrafficStatus is API call with collecting metrics
+func (m *MobileGatewayMetrics) TrafficStatus(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGatewayTrafficStatus, error) {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "TrafficStatus")
+	start := time.Now()
+
+	result0, err := m.Internal.TrafficStatus(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "TrafficStatus",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// MonitorInterface is API call with collecting metrics
+func (m *MobileGatewayMetrics) MonitorInterface(ctx context.Context, zone string, id types.ID, index int, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
+	ctx = sacloud.WithOperation(ctx, "MobileGateway", "MonitorInterface")
+	start := time.Now()
+
+	result0, err := m.Internal.MonitorInterface(ctx, zone, id, index, condition)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "MobileGateway",
+		OperationName: "MonitorInterface",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* NFSMetrics
+*************************************************/
+
+// NFSMetrics is for collect metrics of NFSOp operations
+type NFSMetrics struct {
+	Internal  sacloud.NFSAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewNFSMetrics creates new NFSMetrics instance
+func NewNFSMetrics(in sacloud.NFSAPI, collector sacloud.MetricsCollector) sacloud.NFSAPI {
+	return &NFSMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *NFSMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.NFS, error) {
+	ctx = sacloud.WithOperation(ctx, "NFS", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "NFS",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *NFSMetrics) Create(ctx context.Context, zone string, param *sacloud.NFSCreateRequest) (*sacloud.NFS, error) {
+	ctx = sacloud.WithOperation(ctx, "NFS", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "NFS",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *NFSMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.NFS, error) {
+	ctx = sacloud.WithOperation(ctx, "NFS", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "NFS",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *NFSMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.NFSUpdateRequest) (*sacloud.NFS, error) {
+	ctx = sacloud.WithOperation(ctx, "NFS", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "NFS",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *NFSMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "NFS", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "NFS",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Boot is API call with collecting metrics
+func (m *NFSMetrics) Boot(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "NFS", "Boot")
+	start := time.Now()
+
+	err := m.Internal.Boot(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "NFS",
+		OperationName: "Boot",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Shutdown is API call with collecting metrics
+func (m *NFSMetrics) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
+	ctx = sacloud.WithOperation(ctx, "NFS", "Shutdown")
+	start := time.Now()
+
+	err := m.Internal.Shutdown(ctx, zone, id, shutdownOption)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "NFS",
+		OperationName: "Shutdown",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Reset is API call with collecting metrics
+func (m *NFSMetrics) Reset(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "NFS", "Reset")
+	start := time.Now()
+
+	err := m.Internal.Reset(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "NFS",
+		OperationName: "Reset",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// MonitorFreeDiskSize is API call with collecting metrics
+func (m *NFSMetrics) MonitorFreeDiskSize(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.FreeDiskSizeActivity, error) {
+	ctx = sacloud.WithOperation(ctx, "NFS", "MonitorFreeDiskSize")
+	start := time.Now()
+
+	result0, err := m.Internal.MonitorFreeDiskSize(ctx, zone, id, condition)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "NFS",
+		OperationName: "MonitorFreeDiskSize",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// MonitorInterface is API call with collecting metrics
+func (m *NFSMetrics) MonitorInterface(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
+	ctx = sacloud.WithOperation(ctx, "NFS", "MonitorInterface")
+	start := time.Now()
+
+	result0, err := m.Internal.MonitorInterface(ctx, zone, id, condition)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "NFS",
+		OperationName: "MonitorInterface",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* NoteMetrics
+*************************************************/
+
+// NoteMetrics is for collect metrics of NoteOp operations
+type NoteMetrics struct {
+	Internal  sacloud.NoteAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewNoteMetrics creates new NoteMetrics instance
+func NewNoteMetrics(in sacloud.NoteAPI, collector sacloud.MetricsCollector) sacloud.NoteAPI {
+	return &NoteMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *NoteMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Note, error) {
+	ctx = sacloud.WithOperation(ctx, "Note", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Note",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *NoteMetrics) Create(ctx context.Context, zone string, param *sacloud.NoteCreateRequest) (*sacloud.Note, error) {
+	ctx = sacloud.WithOperation(ctx, "Note", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Note",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *NoteMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Note, error) {
+	ctx = sacloud.WithOperation(ctx, "Note", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Note",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *NoteMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.NoteUpdateRequest) (*sacloud.Note, error) {
+	ctx = sacloud.WithOperation(ctx, "Note", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Note",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *NoteMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Note", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Note",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+/*************************************************
+* PacketFilterMetrics
+*************************************************/
+
+// PacketFilterMetrics is for collect metrics of PacketFilterOp operations
+type PacketFilterMetrics struct {
+	Internal  sacloud.PacketFilterAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewPacketFilterMetrics creates new PacketFilterMetrics instance
+func NewPacketFilterMetrics(in sacloud.PacketFilterAPI, collector sacloud.MetricsCollector) sacloud.PacketFilterAPI {
+	return &PacketFilterMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *PacketFilterMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.PacketFilter, error) {
+	ctx = sacloud.WithOperation(ctx, "PacketFilter", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "PacketFilter",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *PacketFilterMetrics) Create(ctx context.Context, zone string, param *sacloud.PacketFilterCreateRequest) (*sacloud.PacketFilter, error) {
+	ctx = sacloud.WithOperation(ctx, "PacketFilter", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "PacketFilter",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *PacketFilterMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.PacketFilter, error) {
+	ctx = sacloud.WithOperation(ctx, "PacketFilter", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "PacketFilter",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *PacketFilterMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.PacketFilterUpdateRequest) (*sacloud.PacketFilter, error) {
+	ctx = sacloud.WithOperation(ctx, "PacketFilter", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "PacketFilter",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *PacketFilterMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "PacketFilter", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "PacketFilter",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+/*************************************************
+* PrivateHostMetrics
+*************************************************/
+
+// PrivateHostMetrics is for collect metrics of PrivateHostOp operations
+type PrivateHostMetrics struct {
+	Internal  sacloud.PrivateHostAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewPrivateHostMetrics creates new PrivateHostMetrics instance
+func NewPrivateHostMetrics(in sacloud.PrivateHostAPI, collector sacloud.MetricsCollector) sacloud.PrivateHostAPI {
+	return &PrivateHostMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *PrivateHostMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.PrivateHost, error) {
+	ctx = sacloud.WithOperation(ctx, "PrivateHost", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "PrivateHost",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *PrivateHostMetrics) Create(ctx context.Context, zone string, param *sacloud.PrivateHostCreateRequest) (*sacloud.PrivateHost, error) {
+	ctx = sacloud.WithOperation(ctx, "PrivateHost", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "PrivateHost",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *PrivateHostMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.PrivateHost, error) {
+	ctx = sacloud.WithOperation(ctx, "PrivateHost", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "PrivateHost",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *PrivateHostMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.PrivateHostUpdateRequest) (*sacloud.PrivateHost, error) {
+	ctx = sacloud.WithOperation(ctx, "PrivateHost", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "PrivateHost",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *PrivateHostMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "PrivateHost", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "PrivateHost",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+/*************************************************
+* PrivateHostPlanMetrics
+*************************************************/
+
+// PrivateHostPlanMetrics is for collect metrics of PrivateHostPlanOp operations
+type PrivateHostPlanMetrics struct {
+	Internal  sacloud.PrivateHostPlanAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewPrivateHostPlanMetrics creates new PrivateHostPlanMetrics instance
+func NewPrivateHostPlanMetrics(in sacloud.PrivateHostPlanAPI, collector sacloud.MetricsCollector) sacloud.PrivateHostPlanAPI {
+	return &PrivateHostPlanMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *PrivateHostPlanMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.PrivateHostPlan, error) {
+	ctx = sacloud.WithOperation(ctx, "PrivateHostPlan", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "PrivateHostPlan",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *PrivateHostPlanMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.PrivateHostPlan, error) {
+	ctx = sacloud.WithOperation(ctx, "PrivateHostPlan", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "PrivateHostPlan",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* ProxyLBMetrics
+*************************************************/
+
+// ProxyLBMetrics is for collect metrics of ProxyLBOp operations
+type ProxyLBMetrics struct {
+	Internal  sacloud.ProxyLBAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewProxyLBMetrics creates new ProxyLBMetrics instance
+func NewProxyLBMetrics(in sacloud.ProxyLBAPI, collector sacloud.MetricsCollector) sacloud.ProxyLBAPI {
+	return &ProxyLBMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *ProxyLBMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.ProxyLB, error) {
+	ctx = sacloud.WithOperation(ctx, "ProxyLB", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "ProxyLB",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *ProxyLBMetrics) Create(ctx context.Context, zone string, param *sacloud.ProxyLBCreateRequest) (*sacloud.ProxyLB, error) {
+	ctx = sacloud.WithOperation(ctx, "ProxyLB", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "ProxyLB",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *ProxyLBMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.ProxyLB, error) {
+	ctx = sacloud.WithOperation(ctx, "ProxyLB", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "ProxyLB",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *ProxyLBMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.ProxyLBUpdateRequest) (*sacloud.ProxyLB, error) {
+	ctx = sacloud.WithOperation(ctx, "ProxyLB", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "ProxyLB",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *ProxyLBMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "ProxyLB", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "ProxyLB",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// GetCertificates is API call with collecting metrics
+func (m *ProxyLBMetrics) GetCertificates(ctx context.Context, zone string, id types.ID) (*sacloud.ProxyLBCertificates, error) {
+	ctx = sacloud.WithOperation(ctx, "ProxyLB", "GetCertificates")
+	start := time.Now()
+
+	result0, err := m.Internal.GetCertificates(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "ProxyLB",
+		OperationName: "GetCertificates",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// SetCertificates is API call with collecting metrics
+func (m *ProxyLBMetrics) SetCertificates(ctx context.Context, zone string, id types.ID, param *sacloud.ProxyLBSetCertificatesRequest) (*sacloud.ProxyLBCertificates, error) {
+	ctx = sacloud.WithOperation(ctx, "ProxyLB", "SetCertificates")
+	start := time.Now()
+
+	result0, err := m.Internal.SetCertificates(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "ProxyLB",
+		OperationName: "SetCertificates",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// DeleteCertificates is API call with collecting metrics
+func (m *ProxyLBMetrics) DeleteCertificates(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "ProxyLB", "DeleteCertificates")
+	start := time.Now()
+
+	err := m.Internal.DeleteCertificates(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "ProxyLB",
+		OperationName: "DeleteCertificates",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// RenewLetsEncryptCert is API call with collecting metrics
+func (m *ProxyLBMetrics) RenewLetsEncryptCert(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "ProxyLB", "RenewLetsEncryptCert")
+	start := time.Now()
+
+	err := m.Internal.RenewLetsEncryptCert(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "ProxyLB",
+		OperationName: "RenewLetsEncryptCert",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// HealthStatus is API call with collecting metrics
+func (m *ProxyLBMetrics) HealthStatus(ctx context.Context, zone string, id types.ID) (*sacloud.ProxyLBHealth, error) {
+	ctx = sacloud.WithOperation(ctx, "ProxyLB", "HealthStatus")
+	start := time.Now()
+
+	result0, err := m.Internal.HealthStatus(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "ProxyLB",
+		OperationName: "HealthStatus",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// MonitorConnection is API call with collecting metrics
+func (m *ProxyLBMetrics) MonitorConnection(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.ConnectionActivity, error) {
+	ctx = sacloud.WithOperation(ctx, "ProxyLB", "MonitorConnection")
+	start := time.Now()
+
+	result0, err := m.Internal.MonitorConnection(ctx, zone, id, condition)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "ProxyLB",
+		OperationName: "MonitorConnection",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* RegionMetrics
+*************************************************/
+
+// RegionMetrics is for collect metrics of RegionOp operations
+type RegionMetrics struct {
+	Internal  sacloud.RegionAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewRegionMetrics creates new RegionMetrics instance
+func NewRegionMetrics(in sacloud.RegionAPI, collector sacloud.MetricsCollector) sacloud.RegionAPI {
+	return &RegionMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *RegionMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Region, error) {
+	ctx = sacloud.WithOperation(ctx, "Region", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Region",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *RegionMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Region, error) {
+	ctx = sacloud.WithOperation(ctx, "Region", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Region",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* ServerMetrics
+*************************************************/
+
+// ServerMetrics is for collect metrics of ServerOp operations
+type ServerMetrics struct {
+	Internal  sacloud.ServerAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewServerMetrics creates new ServerMetrics instance
+func NewServerMetrics(in sacloud.ServerAPI, collector sacloud.MetricsCollector) sacloud.ServerAPI {
+	return &ServerMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *ServerMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Server, error) {
+	ctx = sacloud.WithOperation(ctx, "Server", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Server",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *ServerMetrics) Create(ctx context.Context, zone string, param *sacloud.ServerCreateRequest) (*sacloud.Server, error) {
+	ctx = sacloud.WithOperation(ctx, "Server", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Server",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *ServerMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Server, error) {
+	ctx = sacloud.WithOperation(ctx, "Server", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Server",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *ServerMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.ServerUpdateRequest) (*sacloud.Server, error) {
+	ctx = sacloud.WithOperation(ctx, "Server", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Server",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *ServerMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Server", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Server",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// ChangePlan is API call with collecting metrics
+func (m *ServerMetrics) ChangePlan(ctx context.Context, zone string, id types.ID, plan *sacloud.ServerChangePlanRequest) (*sacloud.Server, error) {
+	ctx = sacloud.WithOperation(ctx, "Server", "ChangePlan")
+	start := time.Now()
+
+	result0, err := m.Internal.ChangePlan(ctx, zone, id, plan)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Server",
+		OperationName: "ChangePlan",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// InsertCDROM is API call with collecting metrics
+func (m *ServerMetrics) InsertCDROM(ctx context.Context, zone string, id types.ID, insertParam *sacloud.InsertCDROMRequest) error {
+	ctx = sacloud.WithOperation(ctx, "Server", "InsertCDROM")
+	start := time.Now()
+
+	err := m.Internal.InsertCDROM(ctx, zone, id, insertParam)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Server",
+		OperationName: "InsertCDROM",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// EjectCDROM is API call with collecting metrics
+func (m *ServerMetrics) EjectCDROM(ctx context.Context, zone string, id types.ID, insertParam *sacloud.EjectCDROMRequest) error {
+	ctx = sacloud.WithOperation(ctx, "Server", "EjectCDROM")
+	start := time.Now()
+
+	err := m.Internal.EjectCDROM(ctx, zone, id, insertParam)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Server",
+		OperationName: "EjectCDROM",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Boot is API call with collecting metrics
+func (m *ServerMetrics) Boot(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Server", "Boot")
+	start := time.Now()
+
+	err := m.Internal.Boot(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Server",
+		OperationName: "Boot",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Shutdown is API call with collecting metrics
+func (m *ServerMetrics) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
+	ctx = sacloud.WithOperation(ctx, "Server", "Shutdown")
+	start := time.Now()
+
+	err := m.Internal.Shutdown(ctx, zone, id, shutdownOption)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Server",
+		OperationName: "Shutdown",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Reset is API call with collecting metrics
+func (m *ServerMetrics) Reset(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Server", "Reset")
+	start := time.Now()
+
+	err := m.Internal.Reset(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Server",
+		OperationName: "Reset",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Monitor is API call with collecting metrics
+func (m *ServerMetrics) Monitor(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.CPUTimeActivity, error) {
+	ctx = sacloud.WithOperation(ctx, "Server", "Monitor")
+	start := time.Now()
+
+	result0, err := m.Internal.Monitor(ctx, zone, id, condition)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Server",
+		OperationName: "Monitor",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// GetVNCProxy is API call with collecting metrics
+func (m *ServerMetrics) GetVNCProxy(ctx context.Context, zone string, id types.ID) (*sacloud.VNCProxyInfo, error) {
+	ctx = sacloud.WithOperation(ctx, "Server", "GetVNCProxy")
+	start := time.Now()
+
+	result0, err := m.Internal.GetVNCProxy(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Server",
+		OperationName: "GetVNCProxy",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// SendKey is API call with collecting metrics
+func (m *ServerMetrics) SendKey(ctx context.Context, zone string, id types.ID, keyboardParam *sacloud.SendKeyRequest) error {
+	ctx = sacloud.WithOperation(ctx, "Server", "SendKey")
+	start := time.Now()
+
+	err := m.Internal.SendKey(ctx, zone, id, keyboardParam)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Server",
+		OperationName: "SendKey",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// SendNMI is API call with collecting metrics
+func (m *ServerMetrics) SendNMI(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Server", "SendNMI")
+	start := time.Now()
+
+	err := m.Internal.SendNMI(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Server",
+		OperationName: "SendNMI",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// GetScreenshot is API call with collecting metrics
+func (m *ServerMetrics) GetScreenshot(ctx context.Context, zone string, id types.ID, condition *sacloud.GetScreenshotRequest) (*sacloud.VNCSnapshotInfo, error) {
+	ctx = sacloud.WithOperation(ctx, "Server", "GetScreenshot")
+	start := time.Now()
+
+	result0, err := m.Internal.GetScreenshot(ctx, zone, id, condition)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Server",
+		OperationName: "GetScreenshot",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* ServerPlanMetrics
+*************************************************/
+
+// ServerPlanMetrics is for collect metrics of ServerPlanOp operations
+type ServerPlanMetrics struct {
+	Internal  sacloud.ServerPlanAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewServerPlanMetrics creates new ServerPlanMetrics instance
+func NewServerPlanMetrics(in sacloud.ServerPlanAPI, collector sacloud.MetricsCollector) sacloud.ServerPlanAPI {
+	return &ServerPlanMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *ServerPlanMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.ServerPlan, error) {
+	ctx = sacloud.WithOperation(ctx, "ServerPlan", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "ServerPlan",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *ServerPlanMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.ServerPlan, error) {
+	ctx = sacloud.WithOperation(ctx, "ServerPlan", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "ServerPlan",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* ServiceClassMetrics
+*************************************************/
+
+// ServiceClassMetrics is for collect metrics of ServiceClassOp operations
+type ServiceClassMetrics struct {
+	Internal  sacloud.ServiceClassAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewServiceClassMetrics creates new ServiceClassMetrics instance
+func NewServiceClassMetrics(in sacloud.ServiceClassAPI, collector sacloud.MetricsCollector) sacloud.ServiceClassAPI {
+	return &ServiceClassMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *ServiceClassMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.ServiceClass, error) {
+	ctx = sacloud.WithOperation(ctx, "ServiceClass", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "ServiceClass",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* SIMMetrics
+*************************************************/
+
+// SIMMetrics is for collect metrics of SIMOp operations
+type SIMMetrics struct {
+	Internal  sacloud.SIMAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewSIMMetrics creates new SIMMetrics instance
+func NewSIMMetrics(in sacloud.SIMAPI, collector sacloud.MetricsCollector) sacloud.SIMAPI {
+	return &SIMMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *SIMMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.SIM, error) {
+	ctx = sacloud.WithOperation(ctx, "SIM", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SIM",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *SIMMetrics) Create(ctx context.Context, zone string, param *sacloud.SIMCreateRequest) (*sacloud.SIM, error) {
+	ctx = sacloud.WithOperation(ctx, "SIM", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SIM",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *SIMMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.SIM, error) {
+	ctx = sacloud.WithOperation(ctx, "SIM", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SIM",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *SIMMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.SIMUpdateRequest) (*sacloud.SIM, error) {
+	ctx = sacloud.WithOperation(ctx, "SIM", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SIM",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *SIMMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "SIM", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SIM",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Activate is API call with collecting metrics
+func (m *SIMMetrics) Activate(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "SIM", "Activate")
+	start := time.Now()
+
+	err := m.Internal.Activate(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SIM",
+		OperationName: "Activate",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Deactivate is API call with collecting metrics
+func (m *SIMMetrics) Deactivate(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "SIM", "Deactivate")
+	start := time.Now()
+
+	err := m.Internal.Deactivate(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SIM",
+		OperationName: "Deactivate",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// AssignIP is API call with collecting metrics
+func (m *SIMMetrics) AssignIP(ctx context.Context, zone string, id types.ID, param *sacloud.SIMAssignIPRequest) error {
+	ctx = sacloud.WithOperation(ctx, "SIM", "AssignIP")
+	start := time.Now()
+
+	err := m.Internal.AssignIP(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SIM",
+		OperationName: "AssignIP",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// ClearIP is API call with collecting metrics
+func (m *SIMMetrics) ClearIP(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "SIM", "ClearIP")
+	start := time.Now()
+
+	err := m.Internal.ClearIP(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SIM",
+		OperationName: "ClearIP",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// IMEILock is API call with collecting metrics
+func (m *SIMMetrics) IMEILock(ctx context.Context, zone string, id types.ID, param *sacloud.SIMIMEILockRequest) error {
+	ctx = sacloud.WithOperation(ctx, "SIM", "IMEILock")
+	start := time.Now()
+
+	err := m.Internal.IMEILock(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SIM",
+		OperationName: "IMEILock",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// IMEIUnlock is API call with collecting metrics
+func (m *SIMMetrics) IMEIUnlock(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "SIM", "IMEIUnlock")
+	start := time.Now()
+
+	err := m.Internal.IMEIUnlock(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SIM",
+		OperationName: "IMEIUnlock",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Logs is API call with collecting metrics
+func (m *SIMMetrics) Logs(ctx context.Context, zone string, id types.ID) ([]*sacloud.SIMLog, error) {
+	ctx = sacloud.WithOperation(ctx, "SIM", "Logs")
+	start := time.Now()
+
+	result0, err := m.Internal.Logs(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SIM",
+		OperationName: "Logs",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// GetNetworkOperator is API call with collecting metrics
+func (m *SIMMetrics) GetNetworkOperator(ctx context.Context, zone string, id types.ID) ([]*sacloud.SIMNetworkOperatorConfig, error) {
+	ctx = sacloud.WithOperation(ctx, "SIM", "GetNetworkOperator")
+	start := time.Now()
+
+	result0, err := m.Internal.GetNetworkOperator(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SIM",
+		OperationName: "GetNetworkOperator",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// SetNetworkOperator is API call with collecting metrics
+func (m *SIMMetrics) SetNetworkOperator(ctx context.Context, zone string, id types.ID, configs *sacloud.SIMNetworkOperatorConfigs) error {
+	ctx = sacloud.WithOperation(ctx, "SIM", "SetNetworkOperator")
+	start := time.Now()
+
+	err := m.Internal.SetNetworkOperator(ctx, zone, id, configs)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SIM",
+		OperationName: "SetNetworkOperator",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// MonitorSIM is API call with collecting metrics
+func (m *SIMMetrics) MonitorSIM(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.LinkActivity, error) {
+	ctx = sacloud.WithOperation(ctx, "SIM", "MonitorSIM")
+	start := time.Now()
+
+	result0, err := m.Internal.MonitorSIM(ctx, zone, id, condition)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SIM",
+		OperationName: "MonitorSIM",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* SimpleMonitorMetrics
+*************************************************/
+
+// SimpleMonitorMetrics is for collect metrics of SimpleMonitorOp operations
+type SimpleMonitorMetrics struct {
+	Internal  sacloud.SimpleMonitorAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewSimpleMonitorMetrics creates new SimpleMonitorMetrics instance
+func NewSimpleMonitorMetrics(in sacloud.SimpleMonitorAPI, collector sacloud.MetricsCollector) sacloud.SimpleMonitorAPI {
+	return &SimpleMonitorMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *SimpleMonitorMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.SimpleMonitor, error) {
+	ctx = sacloud.WithOperation(ctx, "SimpleMonitor", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SimpleMonitor",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *SimpleMonitorMetrics) Create(ctx context.Context, zone string, param *sacloud.SimpleMonitorCreateRequest) (*sacloud.SimpleMonitor, error) {
+	ctx = sacloud.WithOperation(ctx, "SimpleMonitor", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SimpleMonitor",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *SimpleMonitorMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.SimpleMonitor, error) {
+	ctx = sacloud.WithOperation(ctx, "SimpleMonitor", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SimpleMonitor",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *SimpleMonitorMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.SimpleMonitorUpdateRequest) (*sacloud.SimpleMonitor, error) {
+	ctx = sacloud.WithOperation(ctx, "SimpleMonitor", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SimpleMonitor",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *SimpleMonitorMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "SimpleMonitor", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SimpleMonitor",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// MonitorResponseTime is API call with collecting metrics
+func (m *SimpleMonitorMetrics) MonitorResponseTime(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.ResponseTimeSecActivity, error) {
+	ctx = sacloud.WithOperation(ctx, "SimpleMonitor", "MonitorResponseTime")
+	start := time.Now()
+
+	result0, err := m.Internal.MonitorResponseTime(ctx, zone, id, condition)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SimpleMonitor",
+		OperationName: "MonitorResponseTime",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// HealthStatus is API call with collecting metrics
+func (m *SimpleMonitorMetrics) HealthStatus(ctx context.Context, zone string, id types.ID) (*sacloud.SimpleMonitorHealthStatus, error) {
+	ctx = sacloud.WithOperation(ctx, "SimpleMonitor", "HealthStatus")
+	start := time.Now()
+
+	result0, err := m.Internal.HealthStatus(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SimpleMonitor",
+		OperationName: "HealthStatus",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* SSHKeyMetrics
+*************************************************/
+
+// SSHKeyMetrics is for collect metrics of SSHKeyOp operations
+type SSHKeyMetrics struct {
+	Internal  sacloud.SSHKeyAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewSSHKeyMetrics creates new SSHKeyMetrics instance
+func NewSSHKeyMetrics(in sacloud.SSHKeyAPI, collector sacloud.MetricsCollector) sacloud.SSHKeyAPI {
+	return &SSHKeyMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *SSHKeyMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.SSHKey, error) {
+	ctx = sacloud.WithOperation(ctx, "SSHKey", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SSHKey",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *SSHKeyMetrics) Create(ctx context.Context, zone string, param *sacloud.SSHKeyCreateRequest) (*sacloud.SSHKey, error) {
+	ctx = sacloud.WithOperation(ctx, "SSHKey", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SSHKey",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Generate is API call with collecting metrics
+func (m *SSHKeyMetrics) Generate(ctx context.Context, zone string, param *sacloud.SSHKeyGenerateRequest) (*sacloud.SSHKeyGenerated, error) {
+	ctx = sacloud.WithOperation(ctx, "SSHKey", "Generate")
+	start := time.Now()
+
+	result0, err := m.Internal.Generate(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SSHKey",
+		OperationName: "Generate",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *SSHKeyMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.SSHKey, error) {
+	ctx = sacloud.WithOperation(ctx, "SSHKey", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SSHKey",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *SSHKeyMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.SSHKeyUpdateRequest) (*sacloud.SSHKey, error) {
+	ctx = sacloud.WithOperation(ctx, "SSHKey", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SSHKey",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *SSHKeyMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "SSHKey", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "SSHKey",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+/*************************************************
+* StorageMetrics
+*************************************************/
+
+// StorageMetrics is for collect metrics of StorageOp operations
+type StorageMetrics struct {
+	Internal  sacloud.StorageAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewStorageMetrics creates new StorageMetrics instance
+func NewStorageMetrics(in sacloud.StorageAPI, collector sacloud.MetricsCollector) sacloud.StorageAPI {
+	return &StorageMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *StorageMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Storage, error) {
+	ctx = sacloud.WithOperation(ctx, "Storage", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Storage",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *StorageMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Storage, error) {
+	ctx = sacloud.WithOperation(ctx, "Storage", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Storage",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* SubnetMetrics
+*************************************************/
+
+// SubnetMetrics is for collect metrics of SubnetOp operations
+type SubnetMetrics struct {
+	Internal  sacloud.SubnetAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewSubnetMetrics creates new SubnetMetrics instance
+func NewSubnetMetrics(in sacloud.SubnetAPI, collector sacloud.MetricsCollector) sacloud.SubnetAPI {
+	return &SubnetMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *SubnetMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Subnet, error) {
+	ctx = sacloud.WithOperation(ctx, "Subnet", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Subnet",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *SubnetMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Subnet, error) {
+	ctx = sacloud.WithOperation(ctx, "Subnet", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Subnet",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* SwitchMetrics
+*************************************************/
+
+// SwitchMetrics is for collect metrics of SwitchOp operations
+type SwitchMetrics struct {
+	Internal  sacloud.SwitchAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewSwitchMetrics creates new SwitchMetrics instance
+func NewSwitchMetrics(in sacloud.SwitchAPI, collector sacloud.MetricsCollector) sacloud.SwitchAPI {
+	return &SwitchMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *SwitchMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Switch, error) {
+	ctx = sacloud.WithOperation(ctx, "Switch", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Switch",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *SwitchMetrics) Create(ctx context.Context, zone string, param *sacloud.SwitchCreateRequest) (*sacloud.Switch, error) {
+	ctx = sacloud.WithOperation(ctx, "Switch", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Switch",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *SwitchMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Switch, error) {
+	ctx = sacloud.WithOperation(ctx, "Switch", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Switch",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *SwitchMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.SwitchUpdateRequest) (*sacloud.Switch, error) {
+	ctx = sacloud.WithOperation(ctx, "Switch", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Switch",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *SwitchMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Switch", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Switch",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// ConnectToBridge is API call with collecting metrics
+func (m *SwitchMetrics) ConnectToBridge(ctx context.Context, zone string, id types.ID, bridgeID types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Switch", "ConnectToBridge")
+	start := time.Now()
+
+	err := m.Internal.ConnectToBridge(ctx, zone, id, bridgeID)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Switch",
+		OperationName: "ConnectToBridge",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// DisconnectFromBridge is API call with collecting metrics
+func (m *SwitchMetrics) DisconnectFromBridge(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "Switch", "DisconnectFromBridge")
+	start := time.Now()
+
+	err := m.Internal.DisconnectFromBridge(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Switch",
+		OperationName: "DisconnectFromBridge",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+/*************************************************
+* VPCRouterMetrics
+*************************************************/
+
+// VPCRouterMetrics is for collect metrics of VPCRouterOp operations
+type VPCRouterMetrics struct {
+	Internal  sacloud.VPCRouterAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewVPCRouterMetrics creates new VPCRouterMetrics instance
+func NewVPCRouterMetrics(in sacloud.VPCRouterAPI, collector sacloud.MetricsCollector) sacloud.VPCRouterAPI {
+	return &VPCRouterMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *VPCRouterMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.VPCRouter, error) {
+	ctx = sacloud.WithOperation(ctx, "VPCRouter", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "VPCRouter",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Create is API call with collecting metrics
+func (m *VPCRouterMetrics) Create(ctx context.Context, zone string, param *sacloud.VPCRouterCreateRequest) (*sacloud.VPCRouter, error) {
+	ctx = sacloud.WithOperation(ctx, "VPCRouter", "Create")
+	start := time.Now()
+
+	result0, err := m.Internal.Create(ctx, zone, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "VPCRouter",
+		OperationName: "Create",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *VPCRouterMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.VPCRouter, error) {
+	ctx = sacloud.WithOperation(ctx, "VPCRouter", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "VPCRouter",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Update is API call with collecting metrics
+func (m *VPCRouterMetrics) Update(ctx context.Context, zone string, id types.ID, param *sacloud.VPCRouterUpdateRequest) (*sacloud.VPCRouter, error) {
+	ctx = sacloud.WithOperation(ctx, "VPCRouter", "Update")
+	start := time.Now()
+
+	result0, err := m.Internal.Update(ctx, zone, id, param)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "VPCRouter",
+		OperationName: "Update",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Delete is API call with collecting metrics
+func (m *VPCRouterMetrics) Delete(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "VPCRouter", "Delete")
+	start := time.Now()
+
+	err := m.Internal.Delete(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "VPCRouter",
+		OperationName: "Delete",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Config is API call with collecting metrics
+func (m *VPCRouterMetrics) Config(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "VPCRouter", "Config")
+	start := time.Now()
+
+	err := m.Internal.Config(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "VPCRouter",
+		OperationName: "Config",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Boot is API call with collecting metrics
+func (m *VPCRouterMetrics) Boot(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "VPCRouter", "Boot")
+	start := time.Now()
+
+	err := m.Internal.Boot(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "VPCRouter",
+		OperationName: "Boot",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Shutdown is API call with collecting metrics
+func (m *VPCRouterMetrics) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
+	ctx = sacloud.WithOperation(ctx, "VPCRouter", "Shutdown")
+	start := time.Now()
+
+	err := m.Internal.Shutdown(ctx, zone, id, shutdownOption)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "VPCRouter",
+		OperationName: "Shutdown",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// Reset is API call with collecting metrics
+func (m *VPCRouterMetrics) Reset(ctx context.Context, zone string, id types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "VPCRouter", "Reset")
+	start := time.Now()
+
+	err := m.Internal.Reset(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "VPCRouter",
+		OperationName: "Reset",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// ConnectToSwitch is API call with collecting metrics
+func (m *VPCRouterMetrics) ConnectToSwitch(ctx context.Context, zone string, id types.ID, nicIndex int, switchID types.ID) error {
+	ctx = sacloud.WithOperation(ctx, "VPCRouter", "ConnectToSwitch")
+	start := time.Now()
+
+	err := m.Internal.ConnectToSwitch(ctx, zone, id, nicIndex, switchID)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "VPCRouter",
+		OperationName: "ConnectToSwitch",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// DisconnectFromSwitch is API call with collecting metrics
+func (m *VPCRouterMetrics) DisconnectFromSwitch(ctx context.Context, zone string, id types.ID, nicIndex int) error {
+	ctx = sacloud.WithOperation(ctx, "VPCRouter", "DisconnectFromSwitch")
+	start := time.Now()
+
+	err := m.Internal.DisconnectFromSwitch(ctx, zone, id, nicIndex)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "VPCRouter",
+		OperationName: "DisconnectFromSwitch",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return err
+}
+
+// MonitorInterface is API call with collecting metrics
+func (m *VPCRouterMetrics) MonitorInterface(ctx context.Context, zone string, id types.ID, index int, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
+	ctx = sacloud.WithOperation(ctx, "VPCRouter", "MonitorInterface")
+	start := time.Now()
+
+	result0, err := m.Internal.MonitorInterface(ctx, zone, id, index, condition)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "VPCRouter",
+		OperationName: "MonitorInterface",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+/*************************************************
+* ZoneMetrics
+*************************************************/
+
+// ZoneMetrics is for collect metrics of ZoneOp operations
+type ZoneMetrics struct {
+	Internal  sacloud.ZoneAPI
+	Collector sacloud.MetricsCollector
+}
+
+// NewZoneMetrics creates new ZoneMetrics instance
+func NewZoneMetrics(in sacloud.ZoneAPI, collector sacloud.MetricsCollector) sacloud.ZoneAPI {
+	return &ZoneMetrics{
+		Internal:  in,
+		Collector: collector,
+	}
+}
+
+// Find is API call with collecting metrics
+func (m *ZoneMetrics) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Zone, error) {
+	ctx = sacloud.WithOperation(ctx, "Zone", "Find")
+	start := time.Now()
+
+	result0, err := m.Internal.Find(ctx, zone, conditions)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Zone",
+		OperationName: "Find",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
+
+// Read is API call with collecting metrics
+func (m *ZoneMetrics) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Zone, error) {
+	ctx = sacloud.WithOperation(ctx, "Zone", "Read")
+	start := time.Now()
+
+	result0, err := m.Internal.Read(ctx, zone, id)
+
+	m.Collector.ObserveOperation(&sacloud.OperationMetrics{
+		ResourceName:  "Zone",
+		OperationName: "Read",
+		Zone:          zone,
+		Duration:      time.Since(start),
+		Err:           err,
+	})
+	return result0, err
+}
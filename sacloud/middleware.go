@@ -0,0 +1,270 @@
+package sacloud
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// DefaultRequestIDHeader リクエストIDを送信する際のデフォルトのHTTPヘッダ名
+const DefaultRequestIDHeader = "X-Request-ID"
+
+// Middleware http.RoundTripperをラップしてAPIコール時の処理を追加する
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripperFunc funcをhttp.RoundTripperとして扱うためのアダプタ
+type RoundTripperFunc func(req *http.Request) (*http.Response, error)
+
+// RoundTrip http.RoundTripperの実装
+func (f RoundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Chain 複数のMiddlewareを連結する、先頭に指定したMiddlewareが最も外側で実行される
+func Chain(transport http.RoundTripper, middlewares ...Middleware) http.RoundTripper {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		if middlewares[i] != nil {
+			transport = middlewares[i](transport)
+		}
+	}
+	return transport
+}
+
+// cloneRequest ヘッダを含めてリクエストを複製する
+func cloneRequest(req *http.Request) *http.Request {
+	r := req.WithContext(req.Context())
+	r.Header = make(http.Header, len(req.Header))
+	for k, v := range req.Header {
+		r.Header[k] = append([]string(nil), v...)
+	}
+	return r
+}
+
+// HeaderMiddleware 指定のHTTPヘッダを付与するMiddleware 値が空の場合は何もしない
+func HeaderMiddleware(key, value string) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if value == "" {
+				return next.RoundTrip(req)
+			}
+			r := cloneRequest(req)
+			r.Header.Set(key, value)
+			return next.RoundTrip(r)
+		})
+	}
+}
+
+// AuthMiddleware アクセストークン/シークレットでのBasic認証を行うMiddleware
+func AuthMiddleware(token, secret string) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			r := cloneRequest(req)
+			r.SetBasicAuth(token, secret)
+			return next.RoundTrip(r)
+		})
+	}
+}
+
+// UserAgentMiddleware User-Agentヘッダを付与するMiddleware
+func UserAgentMiddleware(userAgent string) Middleware {
+	return HeaderMiddleware("User-Agent", userAgent)
+}
+
+type requestIDContextKey struct{}
+
+// WithRequestID リクエストIDを保持するcontextを返す
+//
+// RequestIDMiddlewareによりAPIリクエスト時のHTTPヘッダとして送信される
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, requestID)
+}
+
+// RequestIDFromContext contextからリクエストIDを取得する
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDContextKey{}).(string)
+	return requestID, ok && requestID != ""
+}
+
+// NewRequestID ランダムなリクエストIDを生成する
+func NewRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(b)
+}
+
+// RequestIDMiddleware リクエストIDをHTTPヘッダとして付与するMiddleware
+//
+// contextにリクエストIDが保持されている場合はその値を、それ以外の場合はgenerateで生成した値を利用する
+// generateがnilの場合、contextにリクエストIDが保持されていなければ何もしない
+func RequestIDMiddleware(header string, generate func() string) Middleware {
+	if header == "" {
+		header = DefaultRequestIDHeader
+	}
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			requestID, ok := RequestIDFromContext(req.Context())
+			if !ok && generate != nil {
+				requestID = generate()
+			}
+			if requestID == "" {
+				return next.RoundTrip(req)
+			}
+			r := cloneRequest(req)
+			r.Header.Set(header, requestID)
+			return next.RoundTrip(r)
+		})
+	}
+}
+
+// LoggingMiddleware APIコールの結果を構造化ログとして出力するMiddleware
+//
+// DEBUGレベルではメソッド/URL/ステータスコード/所要時間などを、
+// TRACEレベルではこれに加えてredactorで秘匿情報を取り除いたリクエスト/レスポンスボディを出力する
+// redactorがnilの場合はDefaultRedactorが利用される
+func LoggingMiddleware(logger Logger, redactor *Redactor) Middleware {
+	if redactor == nil {
+		redactor = DefaultRedactor
+	}
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			if logger == nil || !logger.Enabled(LogLevelDebug) {
+				return next.RoundTrip(req)
+			}
+			trace := logger.Enabled(LogLevelTrace)
+
+			fields := LogFields{
+				"method": req.Method,
+				"url":    fmt.Sprintf("%s://%s%s", req.URL.Scheme, req.URL.Host, req.URL.Path),
+			}
+			if requestID, ok := RequestIDFromContext(req.Context()); ok {
+				fields["request_id"] = requestID
+			}
+			if trace {
+				if body := readRequestBody(req); len(body) > 0 {
+					fields["request_body"] = redactor.RedactJSON(body)
+				}
+			}
+
+			start := time.Now()
+			res, err := next.RoundTrip(req)
+			fields["duration"] = time.Since(start)
+
+			level := LogLevelDebug
+			if trace {
+				level = LogLevelTrace
+			}
+			if err != nil {
+				fields["error"] = err
+				logger.Log(level, "API call failed", fields)
+				return res, err
+			}
+			fields["status"] = res.StatusCode
+
+			if res.Body != nil && (trace || res.StatusCode >= http.StatusBadRequest) {
+				data, err := ioutil.ReadAll(res.Body)
+				res.Body.Close()
+				if err != nil {
+					return nil, err
+				}
+				res.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+				if res.StatusCode >= http.StatusBadRequest {
+					errResponse := &APIErrorResponse{}
+					if err := json.Unmarshal(data, errResponse); err == nil && errResponse.Serial != "" {
+						fields["serial"] = errResponse.Serial
+					}
+				}
+				if trace {
+					fields["response_body"] = redactor.RedactJSON(data)
+				}
+			}
+
+			logger.Log(level, "API call", fields)
+			return res, nil
+		})
+	}
+}
+
+func readRequestBody(req *http.Request) []byte {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+	data, _ := ioutil.ReadAll(body)
+	return data
+}
+
+// RetryMiddleware RetryPolicyに従ってリトライを行うMiddleware
+//
+// rateLimiterが指定されている場合はリトライを含む各リクエストの送信前に流量制限を行う
+func RetryMiddleware(policy RetryPolicy, rateLimiter *RateLimiter) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+			start := time.Now()
+			for attempt := 1; ; attempt++ {
+				r := req
+				if attempt > 1 && req.GetBody != nil {
+					body, err := req.GetBody()
+					if err != nil {
+						return nil, fmt.Errorf("failed to rewind body: %v", err)
+					}
+					r = req.WithContext(ctx)
+					r.Body = body
+				}
+
+				if rateLimiter != nil {
+					if err := rateLimiter.Wait(ctx, r.Method, zoneFromURL(r.URL)); err != nil {
+						return nil, err
+					}
+				}
+
+				res, err := next.RoundTrip(r)
+				if ctx.Err() != nil || policy == nil {
+					return res, err
+				}
+
+				wait, retry := policy.Next(attempt, time.Since(start), r, res, err)
+				if !retry {
+					return res, err
+				}
+				if res != nil && res.Body != nil {
+					io.Copy(ioutil.Discard, res.Body) // nolint
+					res.Body.Close()
+				}
+
+				if err := sleepWithContext(ctx, wait); err != nil {
+					return nil, fmt.Errorf("giving up after %d attempts: %w", attempt, err)
+				}
+				countRetry(ctx)
+			}
+		})
+	}
+}
+
+// MetricsMiddleware 各リクエストの結果と所要時間をobserveへ通知するMiddleware
+func MetricsMiddleware(observe func(req *http.Request, res *http.Response, err error, elapsed time.Duration)) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			res, err := next.RoundTrip(req)
+			if observe != nil {
+				observe(req, res, err, time.Since(start))
+			}
+			return res, err
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package sacloud
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChain(t *testing.T) {
+	var called []string
+	newMiddleware := func(name string) Middleware {
+		return func(next http.RoundTripper) http.RoundTripper {
+			return RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				called = append(called, name)
+				return next.RoundTrip(req)
+			})
+		}
+	}
+
+	transport := Chain(RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		called = append(called, "transport")
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}), newMiddleware("first"), nil, newMiddleware("second"))
+
+	req, err := http.NewRequest(http.MethodGet, "http://localhost", nil)
+	require.NoError(t, err)
+
+	_, err = transport.RoundTrip(req)
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", "second", "transport"}, called)
+}
+
+func TestClient_Middlewares(t *testing.T) {
+	var (
+		header http.Header
+		bodies []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"is_ok":true}`)) // nolint
+	}))
+	defer server.Close()
+
+	var observed []int
+	client := NewClient("token", "secret")
+	client.UserAgent = "test-agent"
+	client.RetryMax = 1
+	client.RetryInterval = time.Millisecond
+	client.Middlewares = []Middleware{
+		HeaderMiddleware("X-Custom", "custom"),
+		MetricsMiddleware(func(req *http.Request, res *http.Response, err error, elapsed time.Duration) {
+			observed = append(observed, res.StatusCode)
+		}),
+	}
+
+	ctx := WithRequestID(context.Background(), "request-id")
+	_, err := client.Do(ctx, http.MethodPost, server.URL, map[string]interface{}{"Name": "foo"})
+	require.NoError(t, err)
+
+	token, secret, ok := (&http.Request{Header: header}).BasicAuth()
+	require.True(t, ok)
+	require.Equal(t, "token", token)
+	require.Equal(t, "secret", secret)
+	require.Equal(t, "test-agent", header.Get("User-Agent"))
+	require.Equal(t, "request-id", header.Get(DefaultRequestIDHeader))
+	require.Equal(t, "custom", header.Get("X-Custom"))
+
+	// リトライ時もリクエストボディが送信される
+	require.Equal(t, []string{`{"Name":"foo"}`, `{"Name":"foo"}`}, bodies)
+
+	// Middlewaresはリトライの外側で実行される
+	require.Equal(t, []int{http.StatusOK}, observed)
+}
+
+func TestRequestIDMiddleware(t *testing.T) {
+	var requestID string
+	transport := Chain(RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		requestID = req.Header.Get(DefaultRequestIDHeader)
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}), RequestIDMiddleware("", NewRequestID))
+
+	req, err := http.NewRequest(http.MethodGet, "http://localhost", nil)
+	require.NoError(t, err)
+
+	_, err = transport.RoundTrip(req)
+	require.NoError(t, err)
+	require.Len(t, requestID, 32)
+	require.Empty(t, req.Header.Get(DefaultRequestIDHeader))
+}
@@ -0,0 +1,29 @@
+package naked
+
+import "github.com/sacloud/libsacloud-v2/sacloud/types"
+
+// AuthStatus 現在の認証状態
+type AuthStatus struct {
+	Account            *Account                 `json:",omitempty" yaml:"account,omitempty" structs:",omitempty"`
+	Member             *Member                  `json:",omitempty" yaml:"member,omitempty" structs:",omitempty"`
+	AuthClass          string                   `json:",omitempty" yaml:"auth_class,omitempty" structs:",omitempty"`
+	AuthMethod         string                   `json:",omitempty" yaml:"auth_method,omitempty" structs:",omitempty"`
+	ExternalPermission types.ExternalPermission `json:",omitempty" yaml:"external_permission,omitempty" structs:",omitempty"`
+	IsAPIKey           bool                     `json:",omitempty" yaml:"is_api_key,omitempty" structs:",omitempty"`
+	OperationPenalty   string                   `json:",omitempty" yaml:"operation_penalty,omitempty" structs:",omitempty"`
+	Permission         types.EPermission        `json:",omitempty" yaml:"permission,omitempty" structs:",omitempty"`
+}
+
+// Account アカウント
+type Account struct {
+	ID    types.ID `json:",omitempty" yaml:"id,omitempty" structs:",omitempty"`
+	Name  string   `json:",omitempty" yaml:"name,omitempty" structs:",omitempty"`
+	Code  string   `json:",omitempty" yaml:"code,omitempty" structs:",omitempty"`
+	Class string   `json:",omitempty" yaml:"class,omitempty" structs:",omitempty"`
+}
+
+// Member 会員
+type Member struct {
+	Code  string `json:",omitempty" yaml:"code,omitempty" structs:",omitempty"`
+	Class string `json:",omitempty" yaml:"class,omitempty" structs:",omitempty"`
+}
@@ -0,0 +1,37 @@
+package naked
+
+import (
+	"time"
+
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Bill 請求情報
+type Bill struct {
+	BillID         types.ID   `json:",omitempty" yaml:"bill_id,omitempty" structs:",omitempty"`
+	Amount         int64      `json:",omitempty" yaml:"amount,omitempty" structs:",omitempty"`
+	BillingDate    *time.Time `json:",omitempty" yaml:"billing_date,omitempty" structs:",omitempty"`
+	MemberID       string     `json:",omitempty" yaml:"member_id,omitempty" structs:",omitempty"`
+	Paid           bool       `json:",omitempty" yaml:"paid,omitempty" structs:",omitempty"`
+	PayLimit       *time.Time `json:",omitempty" yaml:"pay_limit,omitempty" structs:",omitempty"`
+	PaymentClassID types.ID   `json:",omitempty" yaml:"payment_class_id,omitempty" structs:",omitempty"`
+}
+
+// BillDetail 請求明細
+type BillDetail struct {
+	ContractID     types.ID   `json:",omitempty" yaml:"contract_id,omitempty" structs:",omitempty"`
+	Amount         int64      `json:",omitempty" yaml:"amount,omitempty" structs:",omitempty"`
+	Description    string     `json:",omitempty" yaml:"description,omitempty" structs:",omitempty"`
+	ServiceClassID types.ID   `json:",omitempty" yaml:"service_class_id,omitempty" structs:",omitempty"`
+	Usage          int64      `json:",omitempty" yaml:"usage,omitempty" structs:",omitempty"`
+	Zone           string     `json:",omitempty" yaml:"zone,omitempty" structs:",omitempty"`
+	ContractEndAt  *time.Time `json:",omitempty" yaml:"contract_end_at,omitempty" structs:",omitempty"`
+}
+
+// BillDetailCSV 請求明細CSV
+type BillDetailCSV struct {
+	Count       int        `json:",omitempty" yaml:"count,omitempty" structs:",omitempty"`
+	ResponsedAt *time.Time `json:",omitempty" yaml:"responsed_at,omitempty" structs:",omitempty"`
+	Filename    string     `json:",omitempty" yaml:"filename,omitempty" structs:",omitempty"`
+	Body        string     `json:",omitempty" yaml:"body,omitempty" structs:",omitempty"`
+}
@@ -0,0 +1,18 @@
+package naked
+
+import (
+	"time"
+
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Coupon クーポン
+type Coupon struct {
+	CouponID       types.ID   `json:",omitempty" yaml:"coupon_id,omitempty" structs:",omitempty"`
+	MemberID       string     `json:",omitempty" yaml:"member_id,omitempty" structs:",omitempty"`
+	ContractID     types.ID   `json:",omitempty" yaml:"contract_id,omitempty" structs:",omitempty"`
+	ServiceClassID types.ID   `json:",omitempty" yaml:"service_class_id,omitempty" structs:",omitempty"`
+	Discount       int64      `json:",omitempty" yaml:"discount,omitempty" structs:",omitempty"`
+	AppliedAt      *time.Time `json:",omitempty" yaml:"applied_at,omitempty" structs:",omitempty"`
+	UntilAt        *time.Time `json:",omitempty" yaml:"until_at,omitempty" structs:",omitempty"`
+}
@@ -0,0 +1,184 @@
+package naked
+
+import (
+	"time"
+
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// Database データベース
+type Database struct {
+	ID           types.ID            `json:",omitempty" yaml:"id,omitempty" structs:",omitempty"`
+	Name         string              `json:",omitempty" yaml:"name,omitempty" structs:",omitempty"`
+	Description  string              `json:",omitempty" yaml:"description,omitempty" structs:",omitempty"`
+	Tags         []string            `json:"" yaml:"tags"`
+	Icon         *Icon               `json:",omitempty" yaml:"icon,omitempty" structs:",omitempty"`
+	CreatedAt    *time.Time          `json:",omitempty" yaml:"created_at,omitempty" structs:",omitempty"`
+	ModifiedAt   *time.Time          `json:",omitempty" yaml:"modified_at,omitempty" structs:",omitempty"`
+	Availability types.EAvailability `json:",omitempty" yaml:"availability,omitempty" structs:",omitempty"`
+	Class        string              `json:",omitempty" yaml:"class,omitempty" structs:",omitempty"`
+	Instance     *Instance           `json:",omitempty" yaml:"instance,omitempty" structs:",omitempty"`
+	Interfaces   []*Interface        `json:",omitempty" yaml:"interfaces,omitempty" structs:",omitempty"`
+	Plan         *AppliancePlan      `json:",omitempty" yaml:"plan,omitempty" structs:",omitempty"`
+	Remark       *DatabaseRemark     `json:",omitempty" yaml:"remark,omitempty" structs:",omitempty"`
+	Settings     *DatabaseSettings   `json:",omitempty" yaml:"settings,omitempty" structs:",omitempty"`
+	SettingsHash string              `json:",omitempty" yaml:"settings_hash,omitempty" structs:",omitempty"`
+	ServiceClass string              `json:",omitempty" yaml:"service_class,omitempty" structs:",omitempty"`
+	Switch       *Switch             `json:",omitempty" yaml:"switch,omitempty" structs:",omitempty"`
+}
+
+// DatabaseRemark データベースのRemark
+//
+// ApplianceRemarkにデータベースの種類/バージョンなどの情報を追加したもの
+type DatabaseRemark struct {
+	Zone    *ApplianceRemarkZone    `json:",omitempty" yaml:"zone,omitempty" structs:",omitempty"`
+	Switch  *ApplianceRemarkSwitch  `json:",omitempty" yaml:"switch,omitempty" structs:",omitempty"`
+	Network *ApplianceRemarkNetwork `json:",omitempty" yaml:"network,omitempty" structs:",omitempty"`
+	Servers ApplianceRemarkServers  `yaml:"servers"`
+	Plan    *AppliancePlan          `json:",omitempty" yaml:"plan,omitempty" structs:",omitempty"`
+	DBConf  *DatabaseRemarkDBConf   `json:",omitempty" yaml:"db_conf,omitempty" structs:",omitempty"`
+}
+
+// DatabaseRemarkDBConf データベースの種類/バージョン
+type DatabaseRemarkDBConf struct {
+	Common *DatabaseRemarkDBConfCommon `json:",omitempty" yaml:"common,omitempty" structs:",omitempty"`
+}
+
+// DatabaseRemarkDBConfCommon データベースの種類/バージョン
+type DatabaseRemarkDBConfCommon struct {
+	DatabaseName     string `json:",omitempty" yaml:"database_name,omitempty" structs:",omitempty"`
+	DatabaseVersion  string `json:",omitempty" yaml:"database_version,omitempty" structs:",omitempty"`
+	DatabaseRevision string `json:",omitempty" yaml:"database_revision,omitempty" structs:",omitempty"`
+	DatabaseTitle    string `json:",omitempty" yaml:"database_title,omitempty" structs:",omitempty"`
+}
+
+// DatabaseSettings データベース セッティング
+type DatabaseSettings struct {
+	DBConf *DatabaseSetting `json:",omitempty" yaml:"db_conf,omitempty" structs:",omitempty"`
+}
+
+// DatabaseSetting データベース セッティング
+type DatabaseSetting struct {
+	Common      *DatabaseSettingCommon      `json:",omitempty" yaml:"common,omitempty" structs:",omitempty"`
+	Backup      *DatabaseSettingBackup      `json:",omitempty" yaml:"backup,omitempty" structs:",omitempty"`
+	Replication *DatabaseSettingReplication `json:",omitempty" yaml:"replication,omitempty" structs:",omitempty"`
+}
+
+// DatabaseSettingCommon データベース設定(共通)
+type DatabaseSettingCommon struct {
+	WebUI           types.WebUI `json:"WebUI" yaml:"web_ui"`
+	ServicePort     int         `json:",omitempty" yaml:"service_port,omitempty" structs:",omitempty"`
+	SourceNetwork   []string    `json:"SourceNetwork" yaml:"source_network"`
+	DefaultUser     string      `json:",omitempty" yaml:"default_user,omitempty" structs:",omitempty"`
+	UserPassword    string      `json:",omitempty" yaml:"user_password,omitempty" structs:",omitempty" sensitive:"true"`
+	ReplicaUser     string      `json:",omitempty" yaml:"replica_user,omitempty" structs:",omitempty"`
+	ReplicaPassword string      `json:",omitempty" yaml:"replica_password,omitempty" structs:",omitempty" sensitive:"true"`
+}
+
+// DatabaseSettingBackup データベース設定(バックアップ)
+type DatabaseSettingBackup struct {
+	Rotate    int      `json:",omitempty" yaml:"rotate,omitempty" structs:",omitempty"`
+	Time      string   `json:",omitempty" yaml:"time,omitempty" structs:",omitempty"`
+	DayOfWeek []string `json:",omitempty" yaml:"day_of_week,omitempty" structs:",omitempty"`
+}
+
+// DatabaseSettingReplication データベース設定(レプリケーション)
+type DatabaseSettingReplication struct {
+	Model     types.EDatabaseReplicationModel `json:",omitempty" yaml:"model,omitempty" structs:",omitempty"`
+	Appliance *DatabaseReplicationAppliance   `json:",omitempty" yaml:"appliance,omitempty" structs:",omitempty"`
+	IPAddress string                          `json:",omitempty" yaml:"ip_address,omitempty" structs:",omitempty"`
+	Port      int                             `json:",omitempty" yaml:"port,omitempty" structs:",omitempty"`
+	User      string                          `json:",omitempty" yaml:"user,omitempty" structs:",omitempty"`
+	Password  string                          `json:",omitempty" yaml:"password,omitempty" structs:",omitempty" sensitive:"true"`
+}
+
+// DatabaseReplicationAppliance レプリケーションのマスター側アプライアンス
+type DatabaseReplicationAppliance struct {
+	ID types.ID `json:",omitempty" yaml:"id,omitempty" structs:",omitempty"`
+}
+
+// DatabaseStatusResponse データベースのステータス取得時のレスポンス
+type DatabaseStatusResponse struct {
+	SettingsResponse *DatabaseStatus `json:",omitempty" yaml:"settings_response,omitempty" structs:",omitempty"`
+}
+
+// DatabaseStatus データベースのステータス
+type DatabaseStatus struct {
+	Status  types.EServerInstanceStatus `json:"status,omitempty" yaml:"status,omitempty" structs:"status,omitempty"`
+	IsFatal bool                        `json:"is_fatal" yaml:"is_fatal" structs:"is_fatal"`
+	DBConf  *DatabaseStatusDBConf       `json:",omitempty" yaml:"db_conf,omitempty" structs:",omitempty"`
+}
+
+// DatabaseStatusDBConf データベースのステータス詳細
+type DatabaseStatusDBConf struct {
+	Version *DatabaseStatusVersion `json:"version,omitempty" yaml:"version,omitempty" structs:"version,omitempty"`
+	Log     []*DatabaseLog         `json:"log,omitempty" yaml:"log,omitempty" structs:"log,omitempty"`
+	Backup  *DatabaseBackupInfo    `json:"backup,omitempty" yaml:"backup,omitempty" structs:"backup,omitempty"`
+}
+
+// DatabaseStatusVersion データベースのバージョン情報
+type DatabaseStatusVersion struct {
+	LastModified string `json:"lastmodified,omitempty" yaml:"last_modified,omitempty" structs:"lastmodified,omitempty"`
+	CommitHash   string `json:"commithash,omitempty" yaml:"commit_hash,omitempty" structs:"commithash,omitempty"`
+	Status       string `json:"status,omitempty" yaml:"status,omitempty" structs:"status,omitempty"`
+	Tag          string `json:"tag,omitempty" yaml:"tag,omitempty" structs:"tag,omitempty"`
+	Expire       string `json:"expire,omitempty" yaml:"expire,omitempty" structs:"expire,omitempty"`
+}
+
+// DatabaseLog データベースのログ
+type DatabaseLog struct {
+	Name string             `json:"name,omitempty" yaml:"name,omitempty" structs:"name,omitempty"`
+	Data string             `json:"data,omitempty" yaml:"data,omitempty" structs:"data,omitempty"`
+	Size types.StringNumber `json:"size,omitempty" yaml:"size,omitempty" structs:"size,omitempty"`
+}
+
+// DatabaseBackupInfo データベースのバックアップ情報
+type DatabaseBackupInfo struct {
+	History []*DatabaseBackupHistory `json:"history,omitempty" yaml:"history,omitempty" structs:"history,omitempty"`
+}
+
+// DatabaseBackupHistory データベースのバックアップ履歴
+type DatabaseBackupHistory struct {
+	CreatedAt    time.Time          `json:"createdat,omitempty" yaml:"created_at,omitempty" structs:"createdat,omitempty"`
+	Availability string             `json:"availability,omitempty" yaml:"availability,omitempty" structs:"availability,omitempty"`
+	RecoveredAt  *time.Time         `json:"recoveredat,omitempty" yaml:"recovered_at,omitempty" structs:"recoveredat,omitempty"`
+	Size         types.StringNumber `json:"size,omitempty" yaml:"size,omitempty" structs:"size,omitempty"`
+}
+
+// DatabaseParameter データベースのパラメータ設定
+type DatabaseParameter struct {
+	Parameter *DatabaseParameterSetting `json:",omitempty" yaml:"parameter,omitempty" structs:",omitempty"`
+	Remark    *DatabaseParameterRemark  `json:",omitempty" yaml:"remark,omitempty" structs:",omitempty"`
+}
+
+// DatabaseParameterSetting データベースのパラメータ設定値
+//
+// Attrのキーは"MariaDB/server.cnf/mysqld/max_connections"のような"{データベース名}/{設定ファイル}/{セクション}/{パラメータ名}"形式
+type DatabaseParameterSetting struct {
+	NoteID types.ID               `json:",omitempty" yaml:"note_id,omitempty" structs:",omitempty"`
+	Attr   map[string]interface{} `json:",omitempty" yaml:"attr,omitempty" structs:",omitempty"`
+}
+
+// DatabaseParameterRemark データベースのパラメータ設定のメタ情報
+type DatabaseParameterRemark struct {
+	Settings []interface{}            `json:",omitempty" yaml:"settings,omitempty" structs:",omitempty"`
+	Form     []*DatabaseParameterForm `json:",omitempty" yaml:"form,omitempty" structs:",omitempty"`
+}
+
+// DatabaseParameterForm データベースのパラメータ設定の入力項目定義
+type DatabaseParameterForm struct {
+	Type    string                        `json:"type,omitempty" yaml:"type,omitempty" structs:"type,omitempty"`
+	Name    string                        `json:"name,omitempty" yaml:"name,omitempty" structs:"name,omitempty"`
+	Label   string                        `json:"label,omitempty" yaml:"label,omitempty" structs:"label,omitempty"`
+	Options *DatabaseParameterFormOptions `json:"options,omitempty" yaml:"options,omitempty" structs:"options,omitempty"`
+}
+
+// DatabaseParameterFormOptions データベースのパラメータ設定の入力項目のオプション
+type DatabaseParameterFormOptions struct {
+	Example string  `json:"ex,omitempty" yaml:"example,omitempty" structs:"ex,omitempty"`
+	Min     float64 `json:"min,omitempty" yaml:"min,omitempty" structs:"min,omitempty"`
+	Max     float64 `json:"max,omitempty" yaml:"max,omitempty" structs:"max,omitempty"`
+	Text    string  `json:"text,omitempty" yaml:"text,omitempty" structs:"text,omitempty"`
+	Type    string  `json:"type,omitempty" yaml:"type,omitempty" structs:"type,omitempty"`
+	Integer bool    `json:"integer,omitempty" yaml:"integer,omitempty" structs:"integer,omitempty"`
+}
@@ -0,0 +1,19 @@
+package naked
+
+import (
+	"time"
+
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// ESUsage ES利用実績
+type ESUsage struct {
+	ESUsageID      types.ID   `json:",omitempty" yaml:"es_usage_id,omitempty" structs:",omitempty"`
+	MemberID       string     `json:",omitempty" yaml:"member_id,omitempty" structs:",omitempty"`
+	ContractID     types.ID   `json:",omitempty" yaml:"contract_id,omitempty" structs:",omitempty"`
+	ServiceClassID types.ID   `json:",omitempty" yaml:"service_class_id,omitempty" structs:",omitempty"`
+	Description    string     `json:",omitempty" yaml:"description,omitempty" structs:",omitempty"`
+	Usage          int64      `json:",omitempty" yaml:"usage,omitempty" structs:",omitempty"`
+	Amount         int64      `json:",omitempty" yaml:"amount,omitempty" structs:",omitempty"`
+	UsageDate      *time.Time `json:",omitempty" yaml:"usage_date,omitempty" structs:",omitempty"`
+}
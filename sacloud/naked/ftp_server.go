@@ -15,5 +15,5 @@ type OpeningFTPServer struct {
 	HostName  string `json:",omitempty" yaml:"host_name,omitempty" structs:",omitempty"`
 	IPAddress string `json:",omitempty" yaml:"ip_address,omitempty" structs:",omitempty"`
 	User      string `json:",omitempty" yaml:"user,omitempty" structs:",omitempty"`
-	Password  string `json:",omitempty" yaml:"password,omitempty" structs:",omitempty"`
+	Password  string `json:",omitempty" yaml:"password,omitempty" structs:",omitempty" sensitive:"true"`
 }
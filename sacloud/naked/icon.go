@@ -16,4 +16,5 @@ type Icon struct {
 	URL          string              `json:"URL,omitempty" yaml:"url,omitempty" structs:",omitempty"`
 	CreatedAt    *time.Time          `json:"CreatedAt,omitempty" yaml:"created_at,omitempty" structs:",omitempty"`
 	ModifiedAt   *time.Time          `json:"ModifiedAt,omitempty" yaml:"modified_at,omitempty" structs:",omitempty"`
+	Image        string              `json:"Image,omitempty" yaml:"image,omitempty" structs:",omitempty"` // Base64エンコードされた画像データ
 }
@@ -50,14 +50,14 @@ func (i *Interfaces) UnmarshalJSON(b []byte) error {
 
 // MarshalJSON 配列中にnullが入る場合(VPCルータなど)への対応
 func (i *Interfaces) MarshalJSON() ([]byte, error) {
-	max := 0
+	max := -1
 	for _, iface := range *i {
 		if max < iface.Index {
 			max = iface.Index
 		}
 	}
 
-	var dest = make([]*Interface, max)
+	var dest = make([]*Interface, max+1)
 	for _, iface := range *i {
 		dest[iface.Index] = iface
 	}
@@ -0,0 +1,9 @@
+package naked
+
+// IPv6Addr IPアドレス(IPv6)
+type IPv6Addr struct {
+	HostName  string     `json:",omitempty" yaml:"host_name,omitempty" structs:",omitempty"`
+	IPv6Addr  string     `json:",omitempty" yaml:"ipv6addr,omitempty" structs:",omitempty"`
+	Interface *Interface `json:",omitempty" yaml:"interface,omitempty" structs:",omitempty"`
+	IPv6Net   *IPv6Net   `json:",omitempty" yaml:"ipv6net,omitempty" structs:",omitempty"`
+}
@@ -0,0 +1,16 @@
+package naked
+
+import (
+	"time"
+
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// License ライセンス
+type License struct {
+	ID          types.ID     `json:",omitempty" yaml:"id,omitempty" structs:",omitempty"`
+	Name        string       `json:",omitempty" yaml:"name,omitempty" structs:",omitempty"`
+	LicenseInfo *LicensePlan `json:",omitempty" yaml:"license_info,omitempty" structs:",omitempty"`
+	CreatedAt   *time.Time   `json:",omitempty" yaml:"created_at,omitempty" structs:",omitempty"`
+	ModifiedAt  *time.Time   `json:",omitempty" yaml:"modified_at,omitempty" structs:",omitempty"`
+}
@@ -0,0 +1,11 @@
+package naked
+
+import "github.com/sacloud/libsacloud-v2/sacloud/types"
+
+// LicensePlan ライセンスプラン
+type LicensePlan struct {
+	ID           types.ID `json:",omitempty" yaml:"id,omitempty" structs:",omitempty"`
+	Name         string   `json:",omitempty" yaml:"name,omitempty" structs:",omitempty"`
+	ServiceClass string   `json:",omitempty" yaml:"service_class,omitempty" structs:",omitempty"`
+	TermsOfUse   string   `json:",omitempty" yaml:"terms_of_use,omitempty" structs:",omitempty"`
+}
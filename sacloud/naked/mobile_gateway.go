@@ -10,10 +10,10 @@ import (
 
 // MobileGateway モバイルゲートウェイ
 type MobileGateway struct {
-	ID           int64                  `json:",omitempty" yaml:"id,omitempty" structs:",omitempty"`
+	ID           types.ID               `json:",omitempty" yaml:"id,omitempty" structs:",omitempty"`
 	Class        string                 `json:",omitempty" yaml:"class,omitempty" structs:",omitempty"`
 	Name         string                 `json:",omitempty" yaml:"name,omitempty" structs:",omitempty"`
-	Tags         []string               `yaml:"tags"`
+	Tags         []string               `json:",omitempty" yaml:"tags,omitempty" structs:",omitempty"`
 	Description  string                 `json:",omitempty" yaml:"description,omitempty" structs:",omitempty"`
 	Plan         *AppliancePlan         `json:",omitempty" yaml:"plan,omitempty" structs:",omitempty"`
 	Settings     *MobileGatewaySettings `json:",omitempty" yaml:"settings,omitempty" structs:",omitempty"`
@@ -22,10 +22,10 @@ type MobileGateway struct {
 	Availability types.EAvailability    `json:",omitempty" yaml:"availability,omitempty" structs:",omitempty"`
 	Instance     *Instance              `json:",omitempty" yaml:"instance,omitempty" structs:",omitempty"`
 	ServiceClass string                 `json:",omitempty" yaml:"service_class,omitempty" structs:",omitempty"`
-	CreatedAt    time.Time              `json:",omitempty" yaml:"created_at,omitempty" structs:",omitempty"`
+	CreatedAt    *time.Time             `json:",omitempty" yaml:"created_at,omitempty" structs:",omitempty"`
 	Icon         *Icon                  `json:",omitempty" yaml:"icon,omitempty" structs:",omitempty"`
 	Switch       *Switch                `json:",omitempty" yaml:"switch,omitempty" structs:",omitempty"`
-	Interfaces   []*Interface           `json:",omitempty" yaml:"interfaces,omitempty" structs:",omitempty"`
+	Interfaces   Interfaces             `json:",omitempty" yaml:"interfaces,omitempty" structs:",omitempty"`
 }
 
 // MobileGatewaySettings モバイルゲートウェイ セッティング
@@ -35,7 +35,7 @@ type MobileGatewaySettings struct {
 
 // MobileGatewaySetting モバイルゲートウェイ セッティング
 type MobileGatewaySetting struct {
-	Interfaces               []*MobileGatewayInterface              `json:",omitempty" yaml:"interfaces,omitempty" structs:",omitempty"`
+	Interfaces               MobileGatewayInterfaces                `json:",omitempty" yaml:"interfaces,omitempty" structs:",omitempty"`
 	InternetConnection       *MobileGatewayInternetConnection       `json:",omitempty" yaml:"internet_connection,omitempty" structs:",omitempty"`
 	StaticRoutes             []*MobileGatewayStaticRoute            `json:",omitempty" yaml:"static_routes,omitempty" structs:",omitempty"`
 	InterDeviceCommunication *MobileGatewayInterDeviceCommunication `json:",omitempty" yaml:"inter_device_communication,omitempty" structs:",omitempty"`
@@ -43,18 +43,75 @@ type MobileGatewaySetting struct {
 
 // MobileGatewayInterDeviceCommunication デバイス間通信
 type MobileGatewayInterDeviceCommunication struct {
-	Enabled string `json:",omitempty" yaml:",omitempty" structs:",omitempty"`
+	Enabled types.StringFlag `json:",omitempty" yaml:",omitempty" structs:",omitempty"`
 }
 
 // MobileGatewayInternetConnection インターネット接続
 type MobileGatewayInternetConnection struct {
-	Enabled string `json:",omitempty" yaml:",omitempty" structs:",omitempty"`
+	Enabled types.StringFlag `json:",omitempty" yaml:",omitempty" structs:",omitempty"`
 }
 
 // MobileGatewayInterface インターフェース
 type MobileGatewayInterface struct {
 	IPAddress      []string `json:",omitempty" yaml:",omitempty" structs:",omitempty"`
 	NetworkMaskLen int      `json:",omitempty" yaml:",omitempty" structs:",omitempty"`
+	// Index 仮想フィールド、APIとのやりとりでは配列中の位置として表現される
+	Index int `json:",omitempty" yaml:",omitempty" structs:",omitempty"`
+}
+
+// MobileGatewayInterfaces インターフェース設定の配列
+//
+// 配列中にnullが含まれる(eth0に対応する要素は常にnull)ことへの対応のためのtype
+type MobileGatewayInterfaces []*MobileGatewayInterface
+
+// UnmarshalJSON 配列中のnullを除去し、配列中の位置をIndexに設定する
+func (i *MobileGatewayInterfaces) UnmarshalJSON(b []byte) error {
+	type alias MobileGatewayInterfaces
+	var a alias
+	if err := json.Unmarshal(b, &a); err != nil {
+		return err
+	}
+
+	var dest []*MobileGatewayInterface
+	for index, v := range a {
+		if v == nil {
+			continue
+		}
+		if v.Index == 0 {
+			v.Index = index
+		}
+		dest = append(dest, v)
+	}
+
+	*i = MobileGatewayInterfaces(dest)
+	return nil
+}
+
+// MarshalJSON Indexの位置に各要素を配置した配列として出力する
+func (i MobileGatewayInterfaces) MarshalJSON() ([]byte, error) {
+	type element struct {
+		IPAddress      []string `json:",omitempty"`
+		NetworkMaskLen int      `json:",omitempty"`
+	}
+
+	max := -1
+	for _, iface := range i {
+		if iface != nil && max < iface.Index {
+			max = iface.Index
+		}
+	}
+
+	dest := make([]*element, max+1)
+	for _, iface := range i {
+		if iface == nil {
+			continue
+		}
+		dest[iface.Index] = &element{
+			IPAddress:      iface.IPAddress,
+			NetworkMaskLen: iface.NetworkMaskLen,
+		}
+	}
+	return json.Marshal(dest)
 }
 
 // MobileGatewayStaticRoute スタティックルート
@@ -70,8 +127,8 @@ type MobileGatewayResolver struct {
 
 // MobileGatewaySIMGroup DNS登録用SIMグループ値
 type MobileGatewaySIMGroup struct {
-	DNS1 string `json:"dns_1,omitempty" yaml:"dns_1,omitempty" structs:",omitempty"`
-	DNS2 string `json:"dns_2,omitempty" yaml:"dns_2,omitempty" structs:",omitempty"`
+	DNS1 string `json:"dns_1,omitempty" yaml:"dns_1,omitempty" structs:"dns_1,omitempty"`
+	DNS2 string `json:"dns_2,omitempty" yaml:"dns_2,omitempty" structs:"dns_2,omitempty"`
 }
 
 // UnmarshalJSON JSONアンマーシャル(配列、オブジェクトが混在するためここで対応)
@@ -92,9 +149,9 @@ func (m *MobileGatewaySIMGroup) UnmarshalJSON(data []byte) error {
 
 // MobileGatewaySIMRoute SIルート
 type MobileGatewaySIMRoute struct {
-	ICCID      string `json:"iccid,omitempty" yaml:"iccid,omitempty" structs:",omitempty"`
-	Prefix     string `json:"prefix,omitempty" yaml:"prefix,omitempty" structs:",omitempty"`
-	ResourceID string `json:"resource_id,omitempty" yaml:"resource_id,omitempty" structs:",omitempty"`
+	ICCID      string `json:"iccid,omitempty" yaml:"iccid,omitempty" structs:"iccid,omitempty"`
+	Prefix     string `json:"prefix,omitempty" yaml:"prefix,omitempty" structs:"prefix,omitempty"`
+	ResourceID string `json:"resource_id,omitempty" yaml:"resource_id,omitempty" structs:"resource_id,omitempty"`
 }
 
 // MobileGatewaySIMRoutes SIMルート一覧
@@ -104,9 +161,9 @@ type MobileGatewaySIMRoutes struct {
 
 // TrafficStatus トラフィックコントロール 当月通信量
 type TrafficStatus struct {
-	UplinkBytes    types.StringNumber `json:"uplink_bytes,omitempty" yaml:"uplink_bytes,omitempty" structs:",omitempty"`
-	DownlinkBytes  types.StringNumber `json:"downlink_bytes,omitempty" yaml:"downlink_bytes,omitempty" structs:",omitempty"`
-	TrafficShaping bool               `json:"traffic_shaping" yaml:"traffic_shaping,omitempty" structs:",omitempty"` // 帯域制限
+	UplinkBytes    types.StringNumber `json:"uplink_bytes,omitempty" yaml:"uplink_bytes,omitempty" structs:"uplink_bytes,omitempty"`
+	DownlinkBytes  types.StringNumber `json:"downlink_bytes,omitempty" yaml:"downlink_bytes,omitempty" structs:"downlink_bytes,omitempty"`
+	TrafficShaping bool               `json:"traffic_shaping" yaml:"traffic_shaping,omitempty" structs:"traffic_shaping,omitempty"` // 帯域制限
 }
 
 // UnmarshalJSON JSONアンマーシャル(uint64文字列対応)
@@ -122,20 +179,20 @@ func (s *TrafficStatus) UnmarshalJSON(data []byte) error {
 
 // TrafficMonitoringConfig トラフィックコントロール 設定
 type TrafficMonitoringConfig struct {
-	TrafficQuotaInMB     int                           `json:"traffic_quota_in_mb,omitempty" yaml:"traffic_quota_in_mb" structs:",omitempty"`
-	BandWidthLimitInKbps int                           `json:"bandwidth_limit_in_kbps,omitempty" yaml:"bandwidth_limit_in_kbps" structs:",omitempty"`
-	EMailConfig          *TrafficMonitoringNotifyEmail `json:"email_config,omitempty" yaml:"email_config,omitempty" structs:",omitempty"`
-	SlackConfig          *TrafficMonitoringNotifySlack `json:"slack_config,omitempty" yaml:"slack_config,omitempty" structs:",omitempty"`
-	AutoTrafficShaping   bool                          `json:"auto_traffic_shaping,omitempty" yaml:"auto_traffic_shaping,omitempty" structs:",omitempty"`
+	TrafficQuotaInMB     int                           `json:"traffic_quota_in_mb,omitempty" yaml:"traffic_quota_in_mb" structs:"traffic_quota_in_mb,omitempty"`
+	BandWidthLimitInKbps int                           `json:"bandwidth_limit_in_kbps,omitempty" yaml:"bandwidth_limit_in_kbps" structs:"bandwidth_limit_in_kbps,omitempty"`
+	EMailConfig          *TrafficMonitoringNotifyEmail `json:"email_config,omitempty" yaml:"email_config,omitempty" structs:"email_config,omitempty"`
+	SlackConfig          *TrafficMonitoringNotifySlack `json:"slack_config,omitempty" yaml:"slack_config,omitempty" structs:"slack_config,omitempty"`
+	AutoTrafficShaping   bool                          `json:"auto_traffic_shaping,omitempty" yaml:"auto_traffic_shaping,omitempty" structs:"auto_traffic_shaping,omitempty"`
 }
 
 // TrafficMonitoringNotifyEmail トラフィックコントロール通知設定
 type TrafficMonitoringNotifyEmail struct {
-	Enabled bool `json:"enabled" yaml:"enabled"` // 有効/無効
+	Enabled bool `json:"enabled" yaml:"enabled" structs:"enabled"` // 有効/無効
 }
 
 // TrafficMonitoringNotifySlack トラフィックコントロール通知設定
 type TrafficMonitoringNotifySlack struct {
-	Enabled             bool   `json:"enabled" yaml:"enabled"`                         // 有効/無効
-	IncomingWebhooksURL string `json:"slack_url,omitempty" yaml:"slack_url,omitempty"` // Slack通知の場合のWebhook URL
+	Enabled             bool   `json:"enabled" yaml:"enabled" structs:"enabled"`                                                      // 有効/無効
+	IncomingWebhooksURL string `json:"slack_url,omitempty" yaml:"slack_url,omitempty" structs:"slack_url,omitempty" sensitive:"true"` // Slack通知の場合のWebhook URL
 }
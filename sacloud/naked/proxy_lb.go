@@ -0,0 +1,160 @@
+package naked
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// ProxyLB エンハンスドロードバランサ
+type ProxyLB struct {
+	ID           types.ID            `json:",omitempty" yaml:"id,omitempty" structs:",omitempty"`
+	Name         string              `json:",omitempty" yaml:"name,omitempty" structs:",omitempty"`
+	Description  string              `json:",omitempty" yaml:"description,omitempty" structs:",omitempty"`
+	Tags         []string            `json:"" yaml:"tags"`
+	Icon         *Icon               `json:",omitempty" yaml:"icon,omitempty" structs:",omitempty"`
+	CreatedAt    *time.Time          `json:",omitempty" yaml:"created_at,omitempty" structs:",omitempty"`
+	ModifiedAt   *time.Time          `json:",omitempty" yaml:"modified_at,omitempty" structs:",omitempty"`
+	Availability types.EAvailability `json:",omitempty" yaml:"availability,omitempty" structs:",omitempty"`
+	ServiceClass string              `json:",omitempty" yaml:"service_class,omitempty" structs:",omitempty"`
+	Provider     *Provider           `json:",omitempty" yaml:"provider,omitempty" structs:",omitempty"`
+	Settings     *ProxyLBSettings    `json:",omitempty" yaml:"settings,omitempty" structs:",omitempty"`
+	SettingsHash string              `json:",omitempty" yaml:"settings_hash,omitempty" structs:",omitempty"`
+	Status       *ProxyLBStatus      `json:",omitempty" yaml:"status,omitempty" structs:",omitempty"`
+}
+
+// ProxyLBSettings エンハンスドロードバランサの設定
+type ProxyLBSettings struct {
+	ProxyLB *ProxyLBSetting `json:",omitempty" yaml:"proxy_lb,omitempty" structs:",omitempty"`
+}
+
+// ProxyLBSetting エンハンスドロードバランサの設定
+type ProxyLBSetting struct {
+	HealthCheck   *ProxyLBHealthCheck   `json:",omitempty" yaml:"health_check,omitempty" structs:",omitempty"`   // ヘルスチェック
+	SorryServer   *ProxyLBSorryServer   `json:",omitempty" yaml:"sorry_server,omitempty" structs:",omitempty"`   // ソーリーサーバ
+	BindPorts     []*ProxyLBBindPort    `yaml:"bind_ports"`                                                      // 待ち受けポート
+	Servers       []*ProxyLBServer      `yaml:"servers"`                                                         // 実サーバ
+	LetsEncrypt   *ProxyLBLetsEncrypt   `json:",omitempty" yaml:"lets_encrypt,omitempty" structs:",omitempty"`   // Let's Encryptでの証明書取得設定
+	StickySession *ProxyLBStickySession `json:",omitempty" yaml:"sticky_session,omitempty" structs:",omitempty"` // セッション維持
+	Timeout       *ProxyLBTimeout       `json:",omitempty" yaml:"timeout,omitempty" structs:",omitempty"`        // タイムアウト
+}
+
+// ProxyLBHealthCheck エンハンスドロードバランサのヘルスチェック
+type ProxyLBHealthCheck struct {
+	Protocol  types.EProxyLBHealthCheckProtocol `json:",omitempty" yaml:"protocol,omitempty" structs:",omitempty"`
+	Path      string                            `json:",omitempty" yaml:"path,omitempty" structs:",omitempty"`
+	Host      string                            `json:",omitempty" yaml:"host,omitempty" structs:",omitempty"`
+	DelayLoop int                               `json:",omitempty" yaml:"delay_loop,omitempty" structs:",omitempty"`
+}
+
+// ProxyLBSorryServer エンハンスドロードバランサのソーリーサーバ
+type ProxyLBSorryServer struct {
+	IPAddress string `json:",omitempty" yaml:"ip_address,omitempty" structs:",omitempty"`
+	Port      int    `json:",omitempty" yaml:"port,omitempty" structs:",omitempty"`
+}
+
+// ProxyLBBindPort エンハンスドロードバランサの待ち受けポート
+type ProxyLBBindPort struct {
+	ProxyMode       types.EProxyLBProxyMode `json:",omitempty" yaml:"proxy_mode,omitempty" structs:",omitempty"`
+	Port            int                     `json:",omitempty" yaml:"port,omitempty" structs:",omitempty"`
+	RedirectToHTTPS bool                    `yaml:"redirect_to_https"`
+	SupportHTTP2    bool                    `yaml:"support_http2"`
+}
+
+// ProxyLBServer エンハンスドロードバランサ配下の実サーバ
+type ProxyLBServer struct {
+	IPAddress string `json:",omitempty" yaml:"ip_address,omitempty" structs:",omitempty"`
+	Port      int    `json:",omitempty" yaml:"port,omitempty" structs:",omitempty"`
+	Enabled   bool   `yaml:"enabled"`
+}
+
+// ProxyLBLetsEncrypt エンハンスドロードバランサでのLet's Encryptでの証明書取得設定
+type ProxyLBLetsEncrypt struct {
+	CommonName string `json:",omitempty" yaml:"common_name,omitempty" structs:",omitempty"`
+	Enabled    bool   `yaml:"enabled"`
+}
+
+// ProxyLBStickySession エンハンスドロードバランサでのセッション維持
+type ProxyLBStickySession struct {
+	Enabled bool   `yaml:"enabled"`
+	Method  string `json:",omitempty" yaml:"method,omitempty" structs:",omitempty"`
+}
+
+// ProxyLBTimeout エンハンスドロードバランサでのタイムアウト
+type ProxyLBTimeout struct {
+	InactiveSec int `json:",omitempty" yaml:"inactive_sec,omitempty" structs:",omitempty"`
+}
+
+// ProxyLBStatus エンハンスドロードバランサのステータス
+type ProxyLBStatus struct {
+	FQDN             string               `json:",omitempty" yaml:"fqdn,omitempty" structs:",omitempty"`
+	VirtualIPAddress string               `json:",omitempty" yaml:"virtual_ip_address,omitempty" structs:",omitempty"`
+	ProxyNetworks    []string             `json:",omitempty" yaml:"proxy_networks,omitempty" structs:",omitempty"`
+	UseVIPFailover   bool                 `yaml:"use_vip_failover"`
+	Region           types.EProxyLBRegion `json:",omitempty" yaml:"region,omitempty" structs:",omitempty"`
+}
+
+// ProxyLBCertificates エンハンスドロードバランサのSSL証明書
+type ProxyLBCertificates struct {
+	PrimaryCert     *ProxyLBCertificate   `json:",omitempty" yaml:"primary_cert,omitempty" structs:",omitempty"`
+	AdditionalCerts []*ProxyLBCertificate `json:",omitempty" yaml:"additional_certs,omitempty" structs:",omitempty"`
+}
+
+// ProxyLBCertificate エンハンスドロードバランサのSSL証明書
+type ProxyLBCertificate struct {
+	ServerCertificate       string     `json:",omitempty" yaml:"server_certificate,omitempty" structs:",omitempty"`
+	IntermediateCertificate string     `json:",omitempty" yaml:"intermediate_certificate,omitempty" structs:",omitempty"`
+	PrivateKey              string     `json:",omitempty" yaml:"private_key,omitempty" structs:",omitempty"`
+	CertificateEndDate      *time.Time `json:",omitempty" yaml:"certificate_end_date,omitempty" structs:",omitempty"`
+	CertificateCommonName   string     `json:",omitempty" yaml:"certificate_common_name,omitempty" structs:",omitempty"`
+}
+
+// proxyLBCertificateEndDateLayout APIが返す証明書有効期限の書式
+const proxyLBCertificateEndDateLayout = "Jan _2 15:04:05 2006 MST"
+
+// UnmarshalJSON CertificateEndDateをAPIの書式/RFC3339のどちらでも受け付けるためのUnmarshalJSON実装
+func (c *ProxyLBCertificate) UnmarshalJSON(data []byte) error {
+	type alias ProxyLBCertificate
+	tmp := &struct {
+		*alias
+		CertificateEndDate string `json:",omitempty"`
+	}{
+		alias: (*alias)(c),
+	}
+	if err := json.Unmarshal(data, tmp); err != nil {
+		return err
+	}
+
+	c.CertificateEndDate = nil
+	if tmp.CertificateEndDate == "" {
+		return nil
+	}
+	for _, layout := range []string{time.RFC3339, proxyLBCertificateEndDateLayout} {
+		if t, err := time.Parse(layout, tmp.CertificateEndDate); err == nil {
+			c.CertificateEndDate = &t
+			return nil
+		}
+	}
+	return &time.ParseError{
+		Layout: proxyLBCertificateEndDateLayout,
+		Value:  tmp.CertificateEndDate,
+	}
+}
+
+// ProxyLBHealth エンハンスドロードバランサのヘルスチェック結果
+type ProxyLBHealth struct {
+	ActiveConn int                    `json:",omitempty" yaml:"active_conn,omitempty" structs:",omitempty"`
+	CPS        float64                `json:",omitempty" yaml:"cps,omitempty" structs:",omitempty"`
+	CurrentVIP string                 `json:",omitempty" yaml:"current_vip,omitempty" structs:",omitempty"`
+	Servers    []*ProxyLBHealthServer `json:",omitempty" yaml:"servers,omitempty" structs:",omitempty"`
+}
+
+// ProxyLBHealthServer エンハンスドロードバランサ配下の実サーバのヘルスチェック結果
+type ProxyLBHealthServer struct {
+	ActiveConn int                `json:",omitempty" yaml:"active_conn,omitempty" structs:",omitempty"`
+	Status     string             `json:",omitempty" yaml:"status,omitempty" structs:",omitempty"`
+	IPAddress  string             `json:",omitempty" yaml:"ip_address,omitempty" structs:",omitempty"`
+	Port       types.StringNumber `json:",omitempty" yaml:"port,omitempty" structs:",omitempty"`
+	CPS        float64            `json:",omitempty" yaml:"cps,omitempty" structs:",omitempty"`
+}
@@ -0,0 +1,36 @@
+package naked
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestProxyLBCertificate_UnmarshalJSON(t *testing.T) {
+	expected := time.Date(2020, 7, 10, 8, 9, 10, 0, time.UTC)
+
+	cases := []struct {
+		name string
+		data string
+	}{
+		{name: "api format", data: `{"CertificateCommonName":"www.example.com","CertificateEndDate":"Jul 10 08:09:10 2020 GMT"}`},
+		{name: "rfc3339", data: `{"CertificateCommonName":"www.example.com","CertificateEndDate":"2020-07-10T08:09:10Z"}`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var cert ProxyLBCertificate
+			require.NoError(t, json.Unmarshal([]byte(tc.data), &cert))
+			require.Equal(t, "www.example.com", cert.CertificateCommonName)
+			require.NotNil(t, cert.CertificateEndDate)
+			require.True(t, expected.Equal(*cert.CertificateEndDate))
+		})
+	}
+
+	var cert ProxyLBCertificate
+	require.NoError(t, json.Unmarshal([]byte(`{"ServerCertificate":"cert"}`), &cert))
+	require.Nil(t, cert.CertificateEndDate)
+
+	require.Error(t, json.Unmarshal([]byte(`{"CertificateEndDate":"invalid"}`), &cert))
+}
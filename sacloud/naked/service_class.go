@@ -0,0 +1,22 @@
+package naked
+
+import "github.com/sacloud/libsacloud-v2/sacloud/types"
+
+// ServiceClass 料金(サービスクラス)
+type ServiceClass struct {
+	ID               types.ID `json:",omitempty" yaml:"id,omitempty" structs:",omitempty"`
+	ServiceClassName string   `json:",omitempty" yaml:"service_class_name,omitempty" structs:",omitempty"`
+	ServiceClassPath string   `json:",omitempty" yaml:"service_class_path,omitempty" structs:",omitempty"`
+	DisplayName      string   `json:",omitempty" yaml:"display_name,omitempty" structs:",omitempty"`
+	IsPublic         bool     `json:",omitempty" yaml:"is_public,omitempty" structs:",omitempty"`
+	Price            *Price   `json:",omitempty" yaml:"price,omitempty" structs:",omitempty"`
+}
+
+// Price 価格
+type Price struct {
+	Base    int    `json:",omitempty" yaml:"base,omitempty" structs:",omitempty"`
+	Daily   int    `json:",omitempty" yaml:"daily,omitempty" structs:",omitempty"`
+	Hourly  int    `json:",omitempty" yaml:"hourly,omitempty" structs:",omitempty"`
+	Monthly int    `json:",omitempty" yaml:"monthly,omitempty" structs:",omitempty"`
+	Zone    string `json:",omitempty" yaml:"zone,omitempty" structs:",omitempty"`
+}
@@ -32,26 +32,26 @@ type SIMStatus struct {
 
 // SIMInfo SIM詳細情報
 type SIMInfo struct {
-	ICCID                      string           `json:"iccid,omitempty" yaml:"iccid,omitempty" structs:",omitempty"`
-	IMSI                       []string         `json:"imsi,omitempty" yaml:"imsi,omitempty" structs:",omitempty"`
-	IP                         string           `json:"ip,omitempty" yaml:"ip,omitempty" structs:",omitempty"`
-	SessionStatus              string           `json:"session_status,omitempty" yaml:"session_status,omitempty" structs:",omitempty"`
-	IMEILock                   bool             `json:"imei_lock,omitempty" yaml:"imei_lock,omitempty" structs:",omitempty"`
-	Registered                 bool             `json:"registered,omitempty" yaml:"registered,omitempty" structs:",omitempty"`
-	Activated                  bool             `json:"activated,omitempty" yaml:"activated,omitempty" structs:",omitempty"`
-	ResourceID                 string           `json:"resource_id,omitempty" yaml:"resource_id,omitempty" structs:",omitempty"`
-	RegisteredDate             time.Time        `json:"registered_date,omitempty" yaml:"registered_date,omitempty" structs:",omitempty"`
-	ActivatedDate              time.Time        `json:"activated_date,omitempty" yaml:"activated_date,omitempty" structs:",omitempty"`
-	DeactivatedDate            time.Time        `json:"deactivated_date,omitempty" yaml:"deactivated_date,omitempty" structs:",omitempty"`
-	SIMGroupID                 string           `json:"simgroup_id,omitempty" yaml:"simgroup_id,omitempty" structs:",omitempty"`
-	TrafficBytesOfCurrentMonth *SIMTrafficBytes `json:"traffic_bytes_of_current_month,omitempty" yaml:"traffic_bytes_of_current_month,omitempty" structs:",omitempty"`
-	ConnectedIMEI              string           `json:"connected_imei,omitempty" yaml:"connected_imei,omitempty" structs:",omitempty"`
+	ICCID                      string           `json:"iccid,omitempty" yaml:"iccid,omitempty" structs:"iccid,omitempty"`
+	IMSI                       []string         `json:"imsi,omitempty" yaml:"imsi,omitempty" structs:"imsi,omitempty"`
+	IP                         string           `json:"ip,omitempty" yaml:"ip,omitempty" structs:"ip,omitempty"`
+	SessionStatus              string           `json:"session_status,omitempty" yaml:"session_status,omitempty" structs:"session_status,omitempty"`
+	IMEILock                   bool             `json:"imei_lock,omitempty" yaml:"imei_lock,omitempty" structs:"imei_lock,omitempty"`
+	Registered                 bool             `json:"registered,omitempty" yaml:"registered,omitempty" structs:"registered,omitempty"`
+	Activated                  bool             `json:"activated,omitempty" yaml:"activated,omitempty" structs:"activated,omitempty"`
+	ResourceID                 string           `json:"resource_id,omitempty" yaml:"resource_id,omitempty" structs:"resource_id,omitempty"`
+	RegisteredDate             time.Time        `json:"registered_date,omitempty" yaml:"registered_date,omitempty" structs:"registered_date,omitempty"`
+	ActivatedDate              time.Time        `json:"activated_date,omitempty" yaml:"activated_date,omitempty" structs:"activated_date,omitempty"`
+	DeactivatedDate            time.Time        `json:"deactivated_date,omitempty" yaml:"deactivated_date,omitempty" structs:"deactivated_date,omitempty"`
+	SIMGroupID                 string           `json:"simgroup_id,omitempty" yaml:"simgroup_id,omitempty" structs:"simgroup_id,omitempty"`
+	TrafficBytesOfCurrentMonth *SIMTrafficBytes `json:"traffic_bytes_of_current_month,omitempty" yaml:"traffic_bytes_of_current_month,omitempty" structs:"traffic_bytes_of_current_month,omitempty"`
+	ConnectedIMEI              string           `json:"connected_imei,omitempty" yaml:"connected_imei,omitempty" structs:"connected_imei,omitempty"`
 }
 
 // SIMTrafficBytes 当月通信量
 type SIMTrafficBytes struct {
-	UplinkBytes   uint64 `json:"uplink_bytes,omitempty" yaml:"uplink_bytes,omitempty" structs:",omitempty"`
-	DownlinkBytes uint64 `json:"downlink_bytes,omitempty" yaml:"downlink_bytes,omitempty" structs:",omitempty"`
+	UplinkBytes   uint64 `json:"uplink_bytes,omitempty" yaml:"uplink_bytes,omitempty" structs:"uplink_bytes,omitempty"`
+	DownlinkBytes uint64 `json:"downlink_bytes,omitempty" yaml:"downlink_bytes,omitempty" structs:"downlink_bytes,omitempty"`
 }
 
 // SIMProvider SIMプロバイダー
@@ -0,0 +1,83 @@
+package naked
+
+import (
+	"time"
+
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// SimpleMonitor シンプル監視
+type SimpleMonitor struct {
+	ID           types.ID               `json:",omitempty" yaml:"id,omitempty" structs:",omitempty"`
+	Name         string                 `json:",omitempty" yaml:"name,omitempty" structs:",omitempty"`
+	Description  string                 `json:",omitempty" yaml:"description,omitempty" structs:",omitempty"`
+	Tags         []string               `json:"" yaml:"tags"`
+	Icon         *Icon                  `json:",omitempty" yaml:"icon,omitempty" structs:",omitempty"`
+	CreatedAt    *time.Time             `json:",omitempty" yaml:"created_at,omitempty" structs:",omitempty"`
+	ModifiedAt   *time.Time             `json:",omitempty" yaml:"modified_at,omitempty" structs:",omitempty"`
+	Availability types.EAvailability    `json:",omitempty" yaml:"availability,omitempty" structs:",omitempty"`
+	ServiceClass string                 `json:",omitempty" yaml:"service_class,omitempty" structs:",omitempty"`
+	Provider     *Provider              `json:",omitempty" yaml:"provider,omitempty" structs:",omitempty"`
+	Settings     *SimpleMonitorSettings `json:",omitempty" yaml:"settings,omitempty" structs:",omitempty"`
+	SettingsHash string                 `json:",omitempty" yaml:"settings_hash,omitempty" structs:",omitempty"`
+	Status       *SimpleMonitorStatus   `json:",omitempty" yaml:"status,omitempty" structs:",omitempty"`
+}
+
+// SimpleMonitorSettings シンプル監視の設定
+type SimpleMonitorSettings struct {
+	SimpleMonitor *SimpleMonitorSetting `json:",omitempty" yaml:"simple_monitor,omitempty" structs:",omitempty"`
+}
+
+// SimpleMonitorSetting シンプル監視の設定
+type SimpleMonitorSetting struct {
+	DelayLoop      int                       `json:",omitempty" yaml:"delay_loop,omitempty" structs:",omitempty"`      // 監視間隔(秒)
+	HealthCheck    *SimpleMonitorHealthCheck `json:",omitempty" yaml:"health_check,omitempty" structs:",omitempty"`    // ヘルスチェック
+	Enabled        types.StringFlag          `yaml:"enabled"`                                                          // 有効/無効
+	NotifyEmail    *SimpleMonitorNotifyEmail `json:",omitempty" yaml:"notify_email,omitempty" structs:",omitempty"`    // Email通知
+	NotifySlack    *SimpleMonitorNotifySlack `json:",omitempty" yaml:"notify_slack,omitempty" structs:",omitempty"`    // Slack通知
+	NotifyInterval int                       `json:",omitempty" yaml:"notify_interval,omitempty" structs:",omitempty"` // 再通知間隔(秒)
+}
+
+// SimpleMonitorHealthCheck シンプル監視のヘルスチェック
+//
+// プロトコルごとに利用する項目が異なる
+type SimpleMonitorHealthCheck struct {
+	Protocol          types.ESimpleMonitorProtocol `json:",omitempty" yaml:"protocol,omitempty" structs:",omitempty"`                             // プロトコル
+	Port              types.StringNumber           `json:",omitempty" yaml:"port,omitempty" structs:",omitempty"`                                 // ポート番号
+	Path              string                       `json:",omitempty" yaml:"path,omitempty" structs:",omitempty"`                                 // HTTP/HTTPSの場合のリクエストパス
+	Status            types.StringNumber           `json:",omitempty" yaml:"status,omitempty" structs:",omitempty"`                               // 期待するステータスコード
+	SNI               types.StringFlag             `json:",omitempty" yaml:"sni,omitempty" structs:",omitempty"`                                  // HTTPSの場合のSNI有効/無効
+	Host              string                       `json:",omitempty" yaml:"host,omitempty" structs:",omitempty"`                                 // HTTP/HTTPSの場合のHostヘッダ
+	BasicAuthUsername string                       `json:",omitempty" yaml:"basic_auth_username,omitempty" structs:",omitempty"`                  // HTTP/HTTPSの場合のBASIC認証ユーザー名
+	BasicAuthPassword string                       `json:",omitempty" yaml:"basic_auth_password,omitempty" structs:",omitempty" sensitive:"true"` // HTTP/HTTPSの場合のBASIC認証パスワード
+	QName             string                       `json:",omitempty" yaml:"qname,omitempty" structs:",omitempty"`                                // DNSの場合の問い合わせFQDN
+	ExpectedData      string                       `json:",omitempty" yaml:"expected_data,omitempty" structs:",omitempty"`                        // DNS/SNMPの場合の期待値
+	Community         string                       `json:",omitempty" yaml:"community,omitempty" structs:",omitempty" sensitive:"true"`           // SNMPの場合のコミュニティ名
+	SNMPVersion       string                       `json:",omitempty" yaml:"snmp_version,omitempty" structs:",omitempty"`                         // SNMPの場合のバージョン
+	OID               string                       `json:",omitempty" yaml:"oid,omitempty" structs:",omitempty"`                                  // SNMPの場合のOID
+	RemainingDays     int                          `json:",omitempty" yaml:"remaining_days,omitempty" structs:",omitempty"`                       // SSL証明書の場合の有効残日数の閾値
+}
+
+// SimpleMonitorNotifyEmail シンプル監視のEmail通知
+type SimpleMonitorNotifyEmail struct {
+	Enabled types.StringFlag `yaml:"enabled"`                                               // 有効/無効
+	HTML    types.StringFlag `json:",omitempty" yaml:"html,omitempty" structs:",omitempty"` // HTMLメール
+}
+
+// SimpleMonitorNotifySlack シンプル監視のSlack通知
+type SimpleMonitorNotifySlack struct {
+	Enabled             types.StringFlag `yaml:"enabled"`                                                                                 // 有効/無効
+	IncomingWebhooksURL string           `json:",omitempty" yaml:"incoming_webhooks_url,omitempty" structs:",omitempty" sensitive:"true"` // Webhook URL
+}
+
+// SimpleMonitorStatus シンプル監視のステータス
+type SimpleMonitorStatus struct {
+	Target string `json:",omitempty" yaml:"target,omitempty" structs:",omitempty"`
+}
+
+// SimpleMonitorHealthStatus シンプル監視の監視結果
+type SimpleMonitorHealthStatus struct {
+	LastCheckedAt       *time.Time                 `json:",omitempty" yaml:"last_checked_at,omitempty" structs:",omitempty"`
+	LastHealthChangedAt *time.Time                 `json:",omitempty" yaml:"last_health_changed_at,omitempty" structs:",omitempty"`
+	Health              types.ESimpleMonitorHealth `json:",omitempty" yaml:"health,omitempty" structs:",omitempty"`
+}
@@ -0,0 +1,36 @@
+package naked
+
+import (
+	"time"
+
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// SSHKey 公開鍵
+type SSHKey struct {
+	ID          types.ID   `json:",omitempty" yaml:"id,omitempty" structs:",omitempty"`
+	Name        string     `json:",omitempty" yaml:"name,omitempty" structs:",omitempty"`
+	Description string     `json:",omitempty" yaml:"description,omitempty" structs:",omitempty"`
+	CreatedAt   *time.Time `json:",omitempty" yaml:"created_at,omitempty" structs:",omitempty"`
+	PublicKey   string     `json:",omitempty" yaml:"public_key,omitempty" structs:",omitempty"`
+	Fingerprint string     `json:",omitempty" yaml:"fingerprint,omitempty" structs:",omitempty"`
+}
+
+// SSHKeyGenerated 鍵ペア生成時に返される公開鍵/秘密鍵
+type SSHKeyGenerated struct {
+	ID          types.ID   `json:",omitempty" yaml:"id,omitempty" structs:",omitempty"`
+	Name        string     `json:",omitempty" yaml:"name,omitempty" structs:",omitempty"`
+	Description string     `json:",omitempty" yaml:"description,omitempty" structs:",omitempty"`
+	CreatedAt   *time.Time `json:",omitempty" yaml:"created_at,omitempty" structs:",omitempty"`
+	PublicKey   string     `json:",omitempty" yaml:"public_key,omitempty" structs:",omitempty"`
+	PrivateKey  string     `json:",omitempty" yaml:"private_key,omitempty" structs:",omitempty" sensitive:"true"`
+	Fingerprint string     `json:",omitempty" yaml:"fingerprint,omitempty" structs:",omitempty"`
+}
+
+// SSHKeyGenerateParam 鍵ペア生成時のパラメータ
+type SSHKeyGenerateParam struct {
+	Name           string `json:",omitempty" yaml:"name,omitempty" structs:",omitempty"`
+	Description    string `json:",omitempty" yaml:"description,omitempty" structs:",omitempty"`
+	GenerateFormat string `json:",omitempty" yaml:"generate_format,omitempty" structs:",omitempty"`
+	PassPhrase     string `json:",omitempty" yaml:"pass_phrase,omitempty" structs:",omitempty" sensitive:"true"`
+}
@@ -27,3 +27,9 @@ type SubnetIPAddressRange struct {
 	Min string `yaml:"min"`
 	Max string `yaml:"max"`
 }
+
+// SubnetIPAddress サブネットAPIから参照できるSubnetでのIPアドレス
+type SubnetIPAddress struct {
+	HostName  string `json:",omitempty" yaml:"host_name,omitempty" structs:",omitempty"`
+	IPAddress string `json:",omitempty" yaml:"ip_address,omitempty" structs:",omitempty"`
+}
@@ -1,7 +1,24 @@
 package naked
 
+import "github.com/sacloud/libsacloud-v2/sacloud/types"
+
 // VNCProxy VNCプロキシ
 type VNCProxy struct {
 	HostName  string `json:",omitempty" yaml:"host_name,omitempty" structs:",omitempty"`
 	IPAddress string `json:",omitempty" yaml:"ip_address,omitempty" structs:",omitempty"`
 }
+
+// VNCProxyInfo VNCプロキシ接続情報
+type VNCProxyInfo struct {
+	Status       string             `json:",omitempty" yaml:"status,omitempty" structs:",omitempty"`
+	Host         string             `json:",omitempty" yaml:"host,omitempty" structs:",omitempty"`
+	IOServerHost string             `json:",omitempty" yaml:"io_server_host,omitempty" structs:",omitempty"`
+	Port         types.StringNumber `json:",omitempty" yaml:"port,omitempty" structs:",omitempty"`
+	Password     string             `json:",omitempty" yaml:"password,omitempty" structs:",omitempty"`
+	VNCFile      string             `json:",omitempty" yaml:"vnc_file,omitempty" structs:",omitempty"`
+}
+
+// VNCSnapshotInfo VNCスクリーンショット
+type VNCSnapshotInfo struct {
+	Image string `json:",omitempty" yaml:"image,omitempty" structs:",omitempty"` // Base64エンコードされた画像データ
+}
@@ -255,7 +255,7 @@ type VPCRouterL2TPIPsecServer struct {
 type VPCRouterL2TPIPsecServerConfig struct {
 	RangeStart      string `json:",omitempty" yaml:",omitempty" structs:",omitempty"`
 	RangeStop       string `json:",omitempty" yaml:",omitempty" structs:",omitempty"`
-	PreSharedSecret string `json:",omitempty" yaml:",omitempty" structs:",omitempty"`
+	PreSharedSecret string `json:",omitempty" yaml:",omitempty" structs:",omitempty" sensitive:"true"`
 }
 
 // VPCRouterRemoteAccessUsers リモートアクセスユーザー
@@ -280,7 +280,7 @@ func (f *VPCRouterRemoteAccessUsers) MarshalJSON() ([]byte, error) {
 // VPCRouterRemoteAccessUserConfig リモートアクセスユーザー
 type VPCRouterRemoteAccessUserConfig struct {
 	UserName string `json:",omitempty" yaml:",omitempty" structs:",omitempty"`
-	Password string `json:",omitempty" yaml:",omitempty" structs:",omitempty"`
+	Password string `json:",omitempty" yaml:",omitempty" structs:",omitempty" sensitive:"true"`
 }
 
 // VPCRouterSiteToSiteIPsecVPN サイト間VPN
@@ -305,7 +305,7 @@ func (f *VPCRouterSiteToSiteIPsecVPN) MarshalJSON() ([]byte, error) {
 // VPCRouterSiteToSiteIPsecVPNConfig サイト間VPN
 type VPCRouterSiteToSiteIPsecVPNConfig struct {
 	Peer            string   `json:",omitempty" yaml:",omitempty" structs:",omitempty"`
-	PreSharedSecret string   `json:",omitempty" yaml:",omitempty" structs:",omitempty"`
+	PreSharedSecret string   `json:",omitempty" yaml:",omitempty" structs:",omitempty" sensitive:"true"`
 	RemoteID        string   `json:",omitempty" yaml:",omitempty" structs:",omitempty"`
 	Routes          []string `json:",omitempty" yaml:",omitempty" structs:",omitempty"`
 	LocalPrefix     []string `json:",omitempty" yaml:",omitempty" structs:",omitempty"`
@@ -0,0 +1,16 @@
+package sacloud
+
+// GetFreeCPU 専有ホストで割り当て可能な残りCPUコア数
+func (o *PrivateHost) GetFreeCPU() int {
+	return o.CPU - o.AssignedCPU
+}
+
+// GetFreeMemoryMB 専有ホストで割り当て可能な残りメモリサイズ(MB)
+func (o *PrivateHost) GetFreeMemoryMB() int {
+	return o.MemoryMB - o.AssignedMemoryMB
+}
+
+// GetFreeMemoryGB 専有ホストで割り当て可能な残りメモリサイズ(GB)
+func (o *PrivateHost) GetFreeMemoryGB() int {
+	return o.GetFreeMemoryMB() / 1024
+}
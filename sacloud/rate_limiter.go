@@ -0,0 +1,180 @@
+package sacloud
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TokenBucket トークンバケット方式での流量制限
+type TokenBucket struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucket 1秒あたりratePerSecond個のトークンを補充し、最大burst個までトークンを保持するTokenBucketを返す
+//
+// ratePerSecondが0以下の場合は流量制限を行わない(無制限)
+func NewTokenBucket(ratePerSecond float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucket{
+		rate:   ratePerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait トークンを1つ取得できるまで待機する、待機中にctxがキャンセルされた場合はctx.Err()を返す
+func (b *TokenBucket) Wait(ctx context.Context) (time.Duration, error) {
+	wait := b.reserve()
+	if err := sleepWithContext(ctx, wait); err != nil {
+		b.cancel()
+		return wait, err
+	}
+	return wait, nil
+}
+
+// reserve トークンを1つ予約し、利用可能になるまでの待ち時間を返す
+func (b *TokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	if b.rate > 0 {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+
+	b.tokens--
+	if b.tokens >= 0 || b.rate <= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel 予約したトークンを返却する
+func (b *TokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens++
+}
+
+// RateLimiterStats RateLimiterでの待機状況
+type RateLimiterStats struct {
+	// WaitCount 待機が発生した回数
+	WaitCount int64
+	// TotalWaitDuration 待機した時間の合計
+	TotalWaitDuration time.Duration
+	// MaxWaitDuration 待機した時間の最大値
+	MaxWaitDuration time.Duration
+}
+
+// RateLimiter APIコールの流量制限
+//
+// Global/Zones/Methodsのうち該当する全てのTokenBucketからトークンを取得できるまで待機する
+type RateLimiter struct {
+	// Global 全てのAPIコールで共有されるTokenBucket
+	Global *TokenBucket
+	// Zones ゾーンごとのTokenBucket
+	Zones map[string]*TokenBucket
+	// Methods HTTPメソッドごとのTokenBucket
+	Methods map[string]*TokenBucket
+	// OnWait 待機が発生した場合に呼ばれるfunc
+	OnWait func(method, zone string, d time.Duration)
+
+	mu    sync.Mutex
+	stats RateLimiterStats
+}
+
+// NewRateLimiter 全てのAPIコールで共有されるTokenBucketを持つRateLimiterを返す
+//
+// ratePerSecondが0以下の場合は流量制限を行わない(無制限)
+func NewRateLimiter(ratePerSecond float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		Global: NewTokenBucket(ratePerSecond, burst),
+	}
+}
+
+// Wait APIコール可能になるまで待機する
+//
+// 該当する全てのTokenBucketからトークンを予約した上で最も長い待ち時間だけ待機する
+// 待機中にctxがキャンセルされた場合は予約したトークンを全て返却し、ctx.Err()を返す
+func (l *RateLimiter) Wait(ctx context.Context, method, zone string) error {
+	var buckets []*TokenBucket
+	if l.Global != nil {
+		buckets = append(buckets, l.Global)
+	}
+	if b, ok := l.Zones[zone]; ok && b != nil {
+		buckets = append(buckets, b)
+	}
+	if b, ok := l.Methods[strings.ToUpper(method)]; ok && b != nil {
+		buckets = append(buckets, b)
+	}
+
+	var wait time.Duration
+	for _, b := range buckets {
+		if d := b.reserve(); d > wait {
+			wait = d
+		}
+	}
+
+	start := time.Now()
+	err := sleepWithContext(ctx, wait)
+	if err != nil {
+		for _, b := range buckets {
+			b.cancel()
+		}
+	}
+	l.record(method, zone, time.Since(start))
+	return err
+}
+
+// Stats 待機状況を返す
+func (l *RateLimiter) Stats() RateLimiterStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.stats
+}
+
+func (l *RateLimiter) record(method, zone string, d time.Duration) {
+	if d < time.Millisecond {
+		return
+	}
+
+	l.mu.Lock()
+	l.stats.WaitCount++
+	l.stats.TotalWaitDuration += d
+	if d > l.stats.MaxWaitDuration {
+		l.stats.MaxWaitDuration = d
+	}
+	l.mu.Unlock()
+
+	if l.OnWait != nil {
+		l.OnWait(method, zone, d)
+	}
+}
+
+// zoneFromURL APIリクエスト送信先URLからゾーン名を取得する
+//
+// URLは{rootURL}/{zone}/api/...の形式である前提
+func zoneFromURL(u *url.URL) string {
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i, segment := range segments {
+		if segment == "api" && i > 0 {
+			return segments[i-1]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,139 @@
+package sacloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenBucket_Wait(t *testing.T) {
+	bucket := NewTokenBucket(100, 2)
+	ctx := context.Background()
+
+	// burst分は待機しない
+	for i := 0; i < 2; i++ {
+		wait, err := bucket.Wait(ctx)
+		require.NoError(t, err)
+		require.Equal(t, time.Duration(0), wait)
+	}
+
+	wait, err := bucket.Wait(ctx)
+	require.NoError(t, err)
+	require.True(t, wait > 0)
+}
+
+func TestTokenBucket_WaitWithCanceledContext(t *testing.T) {
+	bucket := NewTokenBucket(0.001, 1)
+
+	_, err := bucket.Wait(context.Background())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = bucket.Wait(ctx)
+	require.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestRateLimiter_Wait(t *testing.T) {
+	var waited []string
+	var mu sync.Mutex
+
+	limiter := &RateLimiter{
+		Global: NewTokenBucket(1000, 100),
+		Zones: map[string]*TokenBucket{
+			"is1a": NewTokenBucket(100, 1),
+		},
+		Methods: map[string]*TokenBucket{
+			"POST": NewTokenBucket(10, 1),
+		},
+		OnWait: func(method, zone string, d time.Duration) {
+			mu.Lock()
+			defer mu.Unlock()
+			waited = append(waited, method+" "+zone)
+		},
+	}
+	ctx := context.Background()
+
+	require.NoError(t, limiter.Wait(ctx, "GET", "is1a"))
+	require.NoError(t, limiter.Wait(ctx, "GET", "tk1a"))
+	require.NoError(t, limiter.Wait(ctx, "POST", "tk1a"))
+	require.Empty(t, waited)
+
+	require.NoError(t, limiter.Wait(ctx, "GET", "is1a"))
+	require.NoError(t, limiter.Wait(ctx, "POST", "tk1b"))
+	require.Equal(t, []string{"GET is1a", "POST tk1b"}, waited)
+
+	stats := limiter.Stats()
+	require.EqualValues(t, 2, stats.WaitCount)
+	require.True(t, stats.TotalWaitDuration >= stats.MaxWaitDuration)
+}
+
+func TestRateLimiter_WaitWithCanceledContext(t *testing.T) {
+	zoneBucket := NewTokenBucket(0.001, 1)
+	limiter := &RateLimiter{
+		Global: NewTokenBucket(0.001, 1),
+		Zones: map[string]*TokenBucket{
+			"is1a": zoneBucket,
+		},
+	}
+
+	// ゾーンのトークンを使い切っておく
+	_, err := zoneBucket.Wait(context.Background())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.Equal(t, context.DeadlineExceeded, limiter.Wait(ctx, "GET", "is1a"))
+
+	// キャンセルされた場合はGlobalから取得したトークンも返却されている
+	ctx, cancel = context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.NoError(t, limiter.Wait(ctx, "GET", "tk1a"))
+}
+
+func TestRateLimiter_Unlimited(t *testing.T) {
+	limiter := NewRateLimiter(0, 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for i := 0; i < 100; i++ {
+		require.NoError(t, limiter.Wait(ctx, "GET", "is1a"))
+	}
+	require.EqualValues(t, 0, limiter.Stats().WaitCount)
+}
+
+func TestClient_RateLimiter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"is_ok":true}`)) // nolint
+	}))
+	defer server.Close()
+
+	client := NewClient("token", "secret")
+	client.RateLimiter = NewRateLimiter(100, 1)
+
+	for i := 0; i < 3; i++ {
+		_, err := client.Do(context.Background(), http.MethodGet, server.URL+"/is1a/api/cloud/1.1/zone", nil)
+		require.NoError(t, err)
+	}
+	require.EqualValues(t, 2, client.RateLimiter.Stats().WaitCount)
+}
+
+func TestZoneFromURL(t *testing.T) {
+	cases := map[string]string{
+		"https://secure.sakura.ad.jp/cloud/zone/is1a/api/cloud/1.1/server": "is1a",
+		"http://localhost:8080/tk1v/api/cloud/1.1/server/1":                "tk1v",
+		"http://localhost:8080/":                                           "",
+	}
+	for rawURL, expected := range cases {
+		u, err := url.Parse(rawURL)
+		require.NoError(t, err)
+		require.Equal(t, expected, zoneFromURL(u), rawURL)
+	}
+}
@@ -0,0 +1,135 @@
+package sacloud
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/sacloud/libsacloud-v2/sacloud/naked"
+)
+
+// RedactedValue 秘匿情報を置き換える際の値
+const RedactedValue = "[redacted]"
+
+// SensitiveTagName 秘匿情報を持つフィールドであることを示すstructタグ名
+//
+// `sensitive:"true"`が指定されたフィールドの値はログ出力時に秘匿される
+const SensitiveTagName = "sensitive"
+
+// DefaultRedactor ログ出力時に利用されるデフォルトのRedactor
+var DefaultRedactor = NewRedactor(
+	[]string{"Password", "PreSharedSecret", "PrivateKey", "PublicKey", "AccessToken", "AccessTokenSecret"},
+	&naked.Database{},
+	&naked.DiskEdit{},
+	&naked.OpeningFTPServer{},
+	&naked.SimpleMonitor{},
+	&naked.SSHKeyGenerateParam{},
+	&naked.SSHKeyGenerated{},
+	&naked.VPCRouter{},
+	&naked.TrafficMonitoringConfig{},
+)
+
+// Redactor JSONから秘匿情報を取り除く
+type Redactor struct {
+	mu   sync.RWMutex
+	keys map[string]bool
+}
+
+// NewRedactor 指定のキー、およびstructのsensitiveタグが指定されたフィールドの値を秘匿するRedactorを返す
+func NewRedactor(keys []string, structs ...interface{}) *Redactor {
+	r := &Redactor{keys: make(map[string]bool)}
+	r.AddKeys(keys...)
+	r.AddKeysFromStruct(structs...)
+	return r
+}
+
+// AddKeys 秘匿するJSONのキーを追加する、キーは大文字小文字を区別しない
+func (r *Redactor) AddKeys(keys ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, key := range keys {
+		r.keys[strings.ToLower(key)] = true
+	}
+}
+
+// AddKeysFromStruct structのsensitiveタグが指定されたフィールドを秘匿するJSONのキーとして追加する
+func (r *Redactor) AddKeysFromStruct(structs ...interface{}) {
+	for _, v := range structs {
+		if v == nil {
+			continue
+		}
+		r.AddKeys(sensitiveKeys(reflect.TypeOf(v), make(map[reflect.Type]bool))...)
+	}
+}
+
+// RedactJSON JSONから秘匿情報を取り除く、JSONとして解釈できない場合は空文字を返す
+func (r *Redactor) RedactJSON(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return ""
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	redacted, err := json.Marshal(r.redactValue(v))
+	if err != nil {
+		return ""
+	}
+	return string(redacted)
+}
+
+func (r *Redactor) redactValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if r.keys[strings.ToLower(key)] && value != nil {
+				v[key] = RedactedValue
+				continue
+			}
+			v[key] = r.redactValue(value)
+		}
+		return v
+	case []interface{}:
+		for i, value := range v {
+			v[i] = r.redactValue(value)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// sensitiveKeys 型を再帰的に走査し、sensitiveタグが指定されたフィールドのJSONのキーを返す
+func sensitiveKeys(t reflect.Type, visited map[reflect.Type]bool) []string {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || visited[t] {
+		return nil
+	}
+	visited[t] = true
+
+	var keys []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Tag.Get(SensitiveTagName) == "true" {
+			key := strings.Split(field.Tag.Get("json"), ",")[0]
+			if key == "" {
+				key = field.Name
+			}
+			keys = append(keys, key)
+			continue
+		}
+		keys = append(keys, sensitiveKeys(field.Type, visited)...)
+	}
+	return keys
+}
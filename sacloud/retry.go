@@ -0,0 +1,260 @@
+package sacloud
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultRetryableStatusCodes リトライ対象とするHTTPステータスコードのデフォルト値
+var DefaultRetryableStatusCodes = []int{
+	http.StatusLocked,
+	http.StatusTooManyRequests,
+	http.StatusInternalServerError,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// NonIdempotentRetryableStatusCodes 冪等でないリクエストでもリトライ対象とするHTTPステータスコード
+//
+// いずれもAPI側で処理が行われていないことが明らかなもの
+var NonIdempotentRetryableStatusCodes = []int{
+	http.StatusLocked,
+	http.StatusTooManyRequests,
+	http.StatusServiceUnavailable,
+}
+
+// RetryPolicy APIコール失敗時のリトライ方針
+type RetryPolicy interface {
+	// Next attempt回目(1始まり)の試行結果を元に、次回リトライまでの待ち時間とリトライするか否かを返す
+	//
+	// elapsedは初回の試行開始からの経過時間、reqは送信したリクエスト
+	Next(attempt int, elapsed time.Duration, req *http.Request, res *http.Response, err error) (time.Duration, bool)
+}
+
+// BackoffRetryPolicy 指数バックオフでリトライを行うRetryPolicyの実装
+type BackoffRetryPolicy struct {
+	// RetryMax 最大リトライ回数
+	RetryMax int
+	// InitialInterval 初回リトライまでの待ち時間
+	InitialInterval time.Duration
+	// MaxInterval リトライ待ち時間の上限 0の場合は上限なし(Retry-Afterヘッダでの指定にも適用される)
+	MaxInterval time.Duration
+	// Multiplier リトライごとに待ち時間に掛ける係数 1未満の場合は1として扱う
+	Multiplier float64
+	// Jitter 待ち時間に加えるゆらぎの割合(0〜1) 例えば0.2の場合は待ち時間の±20%の範囲でランダムに変動する
+	Jitter float64
+	// MaxElapsedTime 初回の試行開始からリトライを行う最大経過時間 0の場合は上限なし
+	MaxElapsedTime time.Duration
+	// RetryableStatusCodes リトライ対象とするHTTPステータスコード
+	//
+	// 冪等でないリクエスト(POSTやアクション系のPUT/DELETE)の場合はNonIdempotentRetryableStatusCodesに含まれるもののみリトライする
+	RetryableStatusCodes []int
+	// RetryOnNetworkError タイムアウトや接続断などの一時的なネットワークエラー時にリトライするか
+	//
+	// 冪等でないリクエストの場合は接続確立前のエラー(名前解決や接続失敗)のみリトライする
+	RetryOnNetworkError bool
+}
+
+// NewBackoffRetryPolicy デフォルト値を設定したBackoffRetryPolicyを返す
+func NewBackoffRetryPolicy(retryMax int) *BackoffRetryPolicy {
+	return &BackoffRetryPolicy{
+		RetryMax:             retryMax,
+		InitialInterval:      time.Second,
+		MaxInterval:          30 * time.Second,
+		Multiplier:           2,
+		Jitter:               0.2,
+		RetryableStatusCodes: DefaultRetryableStatusCodes,
+		RetryOnNetworkError:  true,
+	}
+}
+
+// Next RetryPolicyの実装
+func (p *BackoffRetryPolicy) Next(attempt int, elapsed time.Duration, req *http.Request, res *http.Response, err error) (time.Duration, bool) {
+	if attempt > p.RetryMax {
+		return 0, false
+	}
+	if !p.isRetryable(isIdempotentRequest(req), res, err) {
+		return 0, false
+	}
+
+	wait := p.interval(attempt)
+	if retryAfter, ok := parseRetryAfter(res); ok {
+		wait = retryAfter
+		if p.MaxInterval > 0 && wait > p.MaxInterval {
+			wait = p.MaxInterval
+		}
+	}
+
+	if p.MaxElapsedTime > 0 && elapsed+wait > p.MaxElapsedTime {
+		return 0, false
+	}
+	return wait, true
+}
+
+func (p *BackoffRetryPolicy) isRetryable(idempotent bool, res *http.Response, err error) bool {
+	if err != nil {
+		if !p.RetryOnNetworkError {
+			return false
+		}
+		if idempotent {
+			return isTransientNetworkError(err)
+		}
+		return isConnectionError(err)
+	}
+	if res == nil {
+		return false
+	}
+	if !idempotent && !containsStatusCode(NonIdempotentRetryableStatusCodes, res.StatusCode) {
+		return false
+	}
+	return containsStatusCode(p.RetryableStatusCodes, res.StatusCode)
+}
+
+func containsStatusCode(codes []int, code int) bool {
+	for _, c := range codes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// isIdempotentRequest 再送してもAPI側の状態が変わらないリクエストか
+//
+// GET/HEAD/OPTIONSと、リソースそのもの(パスがIDで終わるもの)に対するPUT/DELETEを冪等とみなす
+// 電源操作など/{id}/powerのようなアクション系のエンドポイントへのPUT/DELETEやPOSTは冪等でないものとして扱う
+func isIdempotentRequest(req *http.Request) bool {
+	if req == nil {
+		return false
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	case http.MethodPut, http.MethodDelete:
+		if req.URL == nil {
+			return false
+		}
+		segments := strings.Split(strings.TrimRight(req.URL.Path, "/"), "/")
+		_, err := strconv.ParseInt(segments[len(segments)-1], 10, 64)
+		return err == nil
+	}
+	return false
+}
+
+func (p *BackoffRetryPolicy) interval(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	interval := float64(p.InitialInterval) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxInterval > 0 && interval > float64(p.MaxInterval) {
+		interval = float64(p.MaxInterval)
+	}
+	if p.Jitter > 0 {
+		interval += interval * p.Jitter * (rand.Float64()*2 - 1)
+	}
+	if interval < 0 {
+		interval = 0
+	}
+	return time.Duration(interval)
+}
+
+// parseRetryAfter Retry-Afterヘッダ(秒数 or HTTP-date)から待ち時間を取得する
+func parseRetryAfter(res *http.Response) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+	v := strings.TrimSpace(res.Header.Get("Retry-After"))
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		wait := time.Until(t)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// isTransientNetworkError タイムアウトや接続断などの一時的なネットワークエラーか
+func isTransientNetworkError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		if netErr.Timeout() || netErr.Temporary() { // nolint
+			return true
+		}
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr)
+}
+
+// isConnectionError 接続確立前(名前解決や接続失敗)のエラーか
+//
+// この場合リクエストはAPIへ到達していないため、冪等でないリクエストでも再送できる
+func isConnectionError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// legacyRetryPolicy RetryMax/RetryIntervalを元にした固定間隔でのリトライ方針
+type legacyRetryPolicy struct {
+	retryMax      int
+	retryInterval time.Duration
+}
+
+func (p *legacyRetryPolicy) Next(attempt int, _ time.Duration, _ *http.Request, res *http.Response, err error) (time.Duration, bool) {
+	if attempt > p.retryMax || err != nil || res == nil {
+		return 0, false
+	}
+	if res.StatusCode != http.StatusServiceUnavailable && res.StatusCode != http.StatusLocked {
+		return 0, false
+	}
+	return p.retryInterval, true
+}
+
+// sleepWithContext 指定時間待機する、待機中にctxがキャンセルされた場合はctx.Err()を返す
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,260 @@
+package sacloud
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var getRequest = httptest.NewRequest(http.MethodGet, "https://secure.sakura.ad.jp/cloud/zone/is1a/api/cloud/1.1/server", nil)
+
+func TestBackoffRetryPolicy_Next(t *testing.T) {
+	policy := &BackoffRetryPolicy{
+		RetryMax:             3,
+		InitialInterval:      time.Second,
+		MaxInterval:          3 * time.Second,
+		Multiplier:           2,
+		RetryableStatusCodes: DefaultRetryableStatusCodes,
+	}
+
+	newResponse := func(code int) *http.Response {
+		return &http.Response{StatusCode: code, Header: http.Header{}}
+	}
+
+	cases := []struct {
+		msg      string
+		attempt  int
+		res      *http.Response
+		err      error
+		expected time.Duration
+		retry    bool
+	}{
+		{msg: "ok", attempt: 1, res: newResponse(http.StatusOK)},
+		{msg: "not retryable status", attempt: 1, res: newResponse(http.StatusNotFound)},
+		{msg: "first", attempt: 1, res: newResponse(http.StatusServiceUnavailable), expected: time.Second, retry: true},
+		{msg: "second", attempt: 2, res: newResponse(http.StatusTooManyRequests), expected: 2 * time.Second, retry: true},
+		{msg: "capped by MaxInterval", attempt: 3, res: newResponse(http.StatusBadGateway), expected: 3 * time.Second, retry: true},
+		{msg: "exceeded RetryMax", attempt: 4, res: newResponse(http.StatusServiceUnavailable)},
+		{msg: "network error is not retried", attempt: 1, err: context.DeadlineExceeded},
+	}
+
+	for _, tc := range cases {
+		wait, retry := policy.Next(tc.attempt, 0, getRequest, tc.res, tc.err)
+		require.Equal(t, tc.retry, retry, tc.msg)
+		require.Equal(t, tc.expected, wait, tc.msg)
+	}
+}
+
+func TestBackoffRetryPolicy_RetryAfterAndMaxElapsedTime(t *testing.T) {
+	policy := NewBackoffRetryPolicy(3)
+	policy.MaxElapsedTime = 10 * time.Second
+
+	res := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	res.Header.Set("Retry-After", "5")
+
+	wait, retry := policy.Next(1, 0, getRequest, res, nil)
+	require.True(t, retry)
+	require.Equal(t, 5*time.Second, wait)
+
+	_, retry = policy.Next(1, 6*time.Second, getRequest, res, nil)
+	require.False(t, retry)
+
+	// Retry-AfterもMaxIntervalで頭打ちにする
+	policy.MaxElapsedTime = 0
+	res.Header.Set("Retry-After", "86400")
+	wait, retry = policy.Next(1, 0, getRequest, res, nil)
+	require.True(t, retry)
+	require.Equal(t, policy.MaxInterval, wait)
+}
+
+func TestBackoffRetryPolicy_NonIdempotent(t *testing.T) {
+	policy := NewBackoffRetryPolicy(3)
+	policy.Jitter = 0
+
+	newRequest := func(method, path string) *http.Request {
+		return httptest.NewRequest(method, "https://secure.sakura.ad.jp/cloud/zone/is1a/api/cloud/1.1/"+path, nil)
+	}
+	newResponse := func(code int) *http.Response {
+		return &http.Response{StatusCode: code, Header: http.Header{}}
+	}
+	timeoutErr := &url.Error{Op: "Post", URL: "https://example.com", Err: &net.OpError{Op: "read", Net: "tcp", Err: errors.New("i/o timeout")}}
+	dialErr := &url.Error{Op: "Post", URL: "https://example.com", Err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}}
+
+	cases := []struct {
+		msg   string
+		req   *http.Request
+		res   *http.Response
+		err   error
+		retry bool
+	}{
+		{msg: "GET 500", req: newRequest(http.MethodGet, "server/123456789012"), res: newResponse(http.StatusInternalServerError), retry: true},
+		{msg: "GET read error", req: newRequest(http.MethodGet, "server"), err: timeoutErr, retry: true},
+		{msg: "PUT(update) 502", req: newRequest(http.MethodPut, "server/123456789012"), res: newResponse(http.StatusBadGateway), retry: true},
+		{msg: "DELETE(delete) read error", req: newRequest(http.MethodDelete, "server/123456789012"), err: timeoutErr, retry: true},
+		{msg: "POST 500", req: newRequest(http.MethodPost, "server"), res: newResponse(http.StatusInternalServerError)},
+		{msg: "POST 504", req: newRequest(http.MethodPost, "server"), res: newResponse(http.StatusGatewayTimeout)},
+		{msg: "POST read error", req: newRequest(http.MethodPost, "server"), err: timeoutErr},
+		{msg: "PUT(power) 500", req: newRequest(http.MethodPut, "server/123456789012/power"), res: newResponse(http.StatusInternalServerError)},
+		{msg: "DELETE(shutdown) read error", req: newRequest(http.MethodDelete, "server/123456789012/power"), err: timeoutErr},
+		{msg: "POST 503", req: newRequest(http.MethodPost, "server"), res: newResponse(http.StatusServiceUnavailable), retry: true},
+		{msg: "POST 423", req: newRequest(http.MethodPost, "server"), res: newResponse(http.StatusLocked), retry: true},
+		{msg: "POST 429", req: newRequest(http.MethodPost, "server"), res: newResponse(http.StatusTooManyRequests), retry: true},
+		{msg: "POST dial error", req: newRequest(http.MethodPost, "server"), err: dialErr, retry: true},
+	}
+
+	for _, tc := range cases {
+		_, retry := policy.Next(1, 0, tc.req, tc.res, tc.err)
+		require.Equal(t, tc.retry, retry, tc.msg)
+	}
+}
+
+func TestBackoffRetryPolicy_Jitter(t *testing.T) {
+	policy := NewBackoffRetryPolicy(1)
+	res := &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+
+	for i := 0; i < 100; i++ {
+		wait, retry := policy.Next(1, 0, getRequest, res, nil)
+		require.True(t, retry)
+		require.True(t, wait >= 800*time.Millisecond && wait <= 1200*time.Millisecond, "unexpected wait: %s", wait)
+	}
+}
+
+func TestClient_Retry(t *testing.T) {
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&count, 1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{"is_ok":true}`)) // nolint
+	}))
+	defer server.Close()
+
+	client := NewClient("token", "secret")
+	client.RetryPolicy = &BackoffRetryPolicy{
+		RetryMax:             3,
+		InitialInterval:      time.Millisecond,
+		RetryableStatusCodes: DefaultRetryableStatusCodes,
+	}
+
+	collector := &testMetricsCollector{}
+	client.Metrics = collector
+
+	ctx := WithOperation(context.Background(), "Zone", "Find")
+	_, err := client.Do(ctx, http.MethodGet, server.URL+"/is1a/api/cloud/1.1/zone", nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, atomic.LoadInt32(&count))
+
+	require.Len(t, collector.requests, 1)
+	m := collector.requests[0]
+	require.Equal(t, "Zone", m.ResourceName)
+	require.Equal(t, "Find", m.OperationName)
+	require.Equal(t, "is1a", m.Zone)
+	require.Equal(t, http.StatusOK, m.StatusCode)
+	require.Equal(t, 2, m.Retries)
+}
+
+type testMetricsCollector struct {
+	requests   []*RequestMetrics
+	operations []*OperationMetrics
+}
+
+func (c *testMetricsCollector) ObserveRequest(m *RequestMetrics) {
+	c.requests = append(c.requests, m)
+}
+
+func (c *testMetricsCollector) ObserveOperation(m *OperationMetrics) {
+	c.operations = append(c.operations, m)
+}
+
+func TestClient_RetryNonIdempotentRequest(t *testing.T) {
+	newClient := func() *Client {
+		client := NewClient("token", "secret")
+		client.RetryPolicy = &BackoffRetryPolicy{
+			RetryMax:             3,
+			InitialInterval:      time.Millisecond,
+			RetryableStatusCodes: DefaultRetryableStatusCodes,
+			RetryOnNetworkError:  true,
+		}
+		return client
+	}
+
+	t.Run("POST is not retried on 500", func(t *testing.T) {
+		var count int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&count, 1)
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		_, err := newClient().Do(context.Background(), http.MethodPost, server.URL+"/is1a/api/cloud/1.1/server", map[string]interface{}{"Server": nil})
+		require.Error(t, err)
+		require.EqualValues(t, 1, atomic.LoadInt32(&count))
+	})
+
+	t.Run("POST is not retried after read timeout", func(t *testing.T) {
+		var count int32
+		done := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&count, 1)
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+			}
+		}))
+		defer server.Close()
+		defer close(done)
+
+		client := newClient()
+		client.HTTPClient = &http.Client{
+			Transport: &http.Transport{ResponseHeaderTimeout: 50 * time.Millisecond},
+		}
+
+		_, err := client.Do(context.Background(), http.MethodPost, server.URL+"/is1a/api/cloud/1.1/server", map[string]interface{}{"Server": nil})
+		require.Error(t, err)
+		require.EqualValues(t, 1, atomic.LoadInt32(&count))
+	})
+
+	t.Run("GET is retried on 500", func(t *testing.T) {
+		var count int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&count, 1) < 2 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			w.Write([]byte(`{"is_ok":true}`)) // nolint
+		}))
+		defer server.Close()
+
+		_, err := newClient().Do(context.Background(), http.MethodGet, server.URL+"/is1a/api/cloud/1.1/server", nil)
+		require.NoError(t, err)
+		require.EqualValues(t, 2, atomic.LoadInt32(&count))
+	})
+}
+
+func TestClient_RetryAbortsOnContextDone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewClient("token", "secret")
+	client.RetryMax = 10
+	client.RetryInterval = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Do(ctx, http.MethodGet, server.URL, nil)
+	require.Error(t, err)
+	require.True(t, time.Since(start) < 10*time.Second)
+}
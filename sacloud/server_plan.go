@@ -0,0 +1,57 @@
+package sacloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+// ErrServerPlanNotFound 条件に一致するサーバプランが存在しない
+var ErrServerPlanNotFound = errors.New("server plan is not found")
+
+// FindServerPlanRequest サーバプラン検索パラメータ
+type FindServerPlanRequest struct {
+	CPU        int
+	MemoryGB   int
+	Generation types.EPlanGeneration // 未指定(PlanGenerations.Default)の場合は利用可能な最新世代
+	Commitment types.ECommitment     // 未指定の場合はCommitments.Standard
+}
+
+// FindServerPlan 条件に一致する利用可能なサーバプランを返す
+//
+// 一致するプランが存在しない場合はErrServerPlanNotFoundをラップしたエラーを返す
+func FindServerPlan(ctx context.Context, api ServerPlanAPI, zone string, param *FindServerPlanRequest) (*ServerPlan, error) {
+	commitment := param.Commitment
+	if commitment == types.Commitments.Unknown {
+		commitment = types.Commitments.Standard
+	}
+
+	plans, err := api.Find(ctx, zone, &FindCondition{})
+	if err != nil {
+		return nil, err
+	}
+
+	var found *ServerPlan
+	for _, plan := range plans {
+		if plan.Availability != types.Availabilities.Available {
+			continue
+		}
+		if plan.CPU != param.CPU || plan.GetMemoryGB() != param.MemoryGB || plan.Commitment != commitment {
+			continue
+		}
+		if param.Generation != types.PlanGenerations.Default && plan.Generation != param.Generation {
+			continue
+		}
+		if found == nil || found.Generation < plan.Generation {
+			found = plan
+		}
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("%w: [zone=%s, CPU=%d, MemoryGB=%d, Generation=%d, Commitment=%s]",
+			ErrServerPlanNotFound, zone, param.CPU, param.MemoryGB, param.Generation, commitment)
+	}
+	return found, nil
+}
@@ -33,6 +33,18 @@ type ArchiveCreateBlankResult struct {
 	Err       error
 }
 
+// ArchiveTransferResult is expected values of the Transfer operation
+type ArchiveTransferResult struct {
+	Archive *sacloud.Archive
+	Err     error
+}
+
+// ArchiveCreateFromSharedResult is expected values of the CreateFromShared operation
+type ArchiveCreateFromSharedResult struct {
+	Archive *sacloud.Archive
+	Err     error
+}
+
 // ArchiveReadResult is expected values of the Read operation
 type ArchiveReadResult struct {
 	Archive *sacloud.Archive
@@ -61,16 +73,25 @@ type ArchiveCloseFTPResult struct {
 	Err error
 }
 
+// ArchiveShareResult is expected values of the Share operation
+type ArchiveShareResult struct {
+	ArchiveShareInfo *sacloud.ArchiveShareInfo
+	Err              error
+}
+
 // ArchiveStub is for trace ArchiveOp operations
 type ArchiveStub struct {
-	FindResult        *ArchiveFindResult
-	CreateResult      *ArchiveCreateResult
-	CreateBlankResult *ArchiveCreateBlankResult
-	ReadResult        *ArchiveReadResult
-	UpdateResult      *ArchiveUpdateResult
-	DeleteResult      *ArchiveDeleteResult
-	OpenFTPResult     *ArchiveOpenFTPResult
-	CloseFTPResult    *ArchiveCloseFTPResult
+	FindResult             *ArchiveFindResult
+	CreateResult           *ArchiveCreateResult
+	CreateBlankResult      *ArchiveCreateBlankResult
+	TransferResult         *ArchiveTransferResult
+	CreateFromSharedResult *ArchiveCreateFromSharedResult
+	ReadResult             *ArchiveReadResult
+	UpdateResult           *ArchiveUpdateResult
+	DeleteResult           *ArchiveDeleteResult
+	OpenFTPResult          *ArchiveOpenFTPResult
+	CloseFTPResult         *ArchiveCloseFTPResult
+	ShareResult            *ArchiveShareResult
 }
 
 // NewArchiveStub creates new ArchiveStub instance
@@ -102,6 +123,22 @@ func (s *ArchiveStub) CreateBlank(ctx context.Context, zone string, param *saclo
 	return s.CreateBlankResult.Archive, s.CreateBlankResult.FTPServer, s.CreateBlankResult.Err
 }
 
+// Transfer is API call with trace log
+func (s *ArchiveStub) Transfer(ctx context.Context, zone string, id types.ID, destZoneID types.ID, param *sacloud.ArchiveTransferRequest) (*sacloud.Archive, error) {
+	if s.TransferResult == nil {
+		log.Fatal("ArchiveStub.TransferResult is not set")
+	}
+	return s.TransferResult.Archive, s.TransferResult.Err
+}
+
+// CreateFromShared is API call with trace log
+func (s *ArchiveStub) CreateFromShared(ctx context.Context, zone string, id types.ID, destZoneID types.ID, param *sacloud.ArchiveCreateFromSharedRequest) (*sacloud.Archive, error) {
+	if s.CreateFromSharedResult == nil {
+		log.Fatal("ArchiveStub.CreateFromSharedResult is not set")
+	}
+	return s.CreateFromSharedResult.Archive, s.CreateFromSharedResult.Err
+}
+
 // Read is API call with trace log
 func (s *ArchiveStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Archive, error) {
 	if s.ReadResult == nil {
@@ -142,6 +179,232 @@ func (s *ArchiveStub) CloseFTP(ctx context.Context, zone string, id types.ID) er
 	return s.CloseFTPResult.Err
 }
 
+// Share is API call with trace log
+func (s *ArchiveStub) Share(ctx context.Context, zone string, id types.ID, param *sacloud.ArchiveShareRequest) (*sacloud.ArchiveShareInfo, error) {
+	if s.ShareResult == nil {
+		log.Fatal("ArchiveStub.ShareResult is not set")
+	}
+	return s.ShareResult.ArchiveShareInfo, s.ShareResult.Err
+}
+
+/*************************************************
+* AuthStatusStub
+*************************************************/
+
+// AuthStatusReadResult is expected values of the Read operation
+type AuthStatusReadResult struct {
+	AuthStatus *sacloud.AuthStatus
+	Err        error
+}
+
+// AuthStatusStub is for trace AuthStatusOp operations
+type AuthStatusStub struct {
+	ReadResult *AuthStatusReadResult
+}
+
+// NewAuthStatusStub creates new AuthStatusStub instance
+func NewAuthStatusStub(caller sacloud.APICaller) sacloud.AuthStatusAPI {
+	return &AuthStatusStub{}
+}
+
+// Read is API call with trace log
+func (s *AuthStatusStub) Read(ctx context.Context, zone string) (*sacloud.AuthStatus, error) {
+	if s.ReadResult == nil {
+		log.Fatal("AuthStatusStub.ReadResult is not set")
+	}
+	return s.ReadResult.AuthStatus, s.ReadResult.Err
+}
+
+/*************************************************
+* AutoBackupStub
+*************************************************/
+
+// AutoBackupFindResult is expected values of the Find operation
+type AutoBackupFindResult struct {
+	CommonServiceItems []*sacloud.AutoBackup
+	Err                error
+}
+
+// AutoBackupCreateResult is expected values of the Create operation
+type AutoBackupCreateResult struct {
+	CommonServiceItem *sacloud.AutoBackup
+	Err               error
+}
+
+// AutoBackupReadResult is expected values of the Read operation
+type AutoBackupReadResult struct {
+	CommonServiceItem *sacloud.AutoBackup
+	Err               error
+}
+
+// AutoBackupUpdateResult is expected values of the Update operation
+type AutoBackupUpdateResult struct {
+	CommonServiceItem *sacloud.AutoBackup
+	Err               error
+}
+
+// AutoBackupDeleteResult is expected values of the Delete operation
+type AutoBackupDeleteResult struct {
+	Err error
+}
+
+// AutoBackupStub is for trace AutoBackupOp operations
+type AutoBackupStub struct {
+	FindResult   *AutoBackupFindResult
+	CreateResult *AutoBackupCreateResult
+	ReadResult   *AutoBackupReadResult
+	UpdateResult *AutoBackupUpdateResult
+	DeleteResult *AutoBackupDeleteResult
+}
+
+// NewAutoBackupStub creates new AutoBackupStub instance
+func NewAutoBackupStub(caller sacloud.APICaller) sacloud.AutoBackupAPI {
+	return &AutoBackupStub{}
+}
+
+// Find is API call with trace log
+func (s *AutoBackupStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.AutoBackup, error) {
+	if s.FindResult == nil {
+		log.Fatal("AutoBackupStub.FindResult is not set")
+	}
+	return s.FindResult.CommonServiceItems, s.FindResult.Err
+}
+
+// Create is API call with trace log
+func (s *AutoBackupStub) Create(ctx context.Context, zone string, param *sacloud.AutoBackupCreateRequest) (*sacloud.AutoBackup, error) {
+	if s.CreateResult == nil {
+		log.Fatal("AutoBackupStub.CreateResult is not set")
+	}
+	return s.CreateResult.CommonServiceItem, s.CreateResult.Err
+}
+
+// Read is API call with trace log
+func (s *AutoBackupStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.AutoBackup, error) {
+	if s.ReadResult == nil {
+		log.Fatal("AutoBackupStub.ReadResult is not set")
+	}
+	return s.ReadResult.CommonServiceItem, s.ReadResult.Err
+}
+
+// Update is API call with trace log
+func (s *AutoBackupStub) Update(ctx context.Context, zone string, id types.ID, param *sacloud.AutoBackupUpdateRequest) (*sacloud.AutoBackup, error) {
+	if s.UpdateResult == nil {
+		log.Fatal("AutoBackupStub.UpdateResult is not set")
+	}
+	return s.UpdateResult.CommonServiceItem, s.UpdateResult.Err
+}
+
+// Delete is API call with trace log
+func (s *AutoBackupStub) Delete(ctx context.Context, zone string, id types.ID) error {
+	if s.DeleteResult == nil {
+		log.Fatal("AutoBackupStub.DeleteResult is not set")
+	}
+	return s.DeleteResult.Err
+}
+
+/*************************************************
+* BillStub
+*************************************************/
+
+// BillByContractResult is expected values of the ByContract operation
+type BillByContractResult struct {
+	Bills []*sacloud.Bill
+	Err   error
+}
+
+// BillByContractYearResult is expected values of the ByContractYear operation
+type BillByContractYearResult struct {
+	Bills []*sacloud.Bill
+	Err   error
+}
+
+// BillByContractYearMonthResult is expected values of the ByContractYearMonth operation
+type BillByContractYearMonthResult struct {
+	Bills []*sacloud.Bill
+	Err   error
+}
+
+// BillReadResult is expected values of the Read operation
+type BillReadResult struct {
+	Bills []*sacloud.Bill
+	Err   error
+}
+
+// BillDetailsResult is expected values of the Details operation
+type BillDetailsResult struct {
+	BillDetails []*sacloud.BillDetail
+	Err         error
+}
+
+// BillDetailsCSVResult is expected values of the DetailsCSV operation
+type BillDetailsCSVResult struct {
+	BillDetailCSV *sacloud.BillDetailCSV
+	Err           error
+}
+
+// BillStub is for trace BillOp operations
+type BillStub struct {
+	ByContractResult          *BillByContractResult
+	ByContractYearResult      *BillByContractYearResult
+	ByContractYearMonthResult *BillByContractYearMonthResult
+	ReadResult                *BillReadResult
+	DetailsResult             *BillDetailsResult
+	DetailsCSVResult          *BillDetailsCSVResult
+}
+
+// NewBillStub creates new BillStub instance
+func NewBillStub(caller sacloud.APICaller) sacloud.BillAPI {
+	return &BillStub{}
+}
+
+// ByContract is API call with trace log
+func (s *BillStub) ByContract(ctx context.Context, zone string, accountID types.ID) ([]*sacloud.Bill, error) {
+	if s.ByContractResult == nil {
+		log.Fatal("BillStub.ByContractResult is not set")
+	}
+	return s.ByContractResult.Bills, s.ByContractResult.Err
+}
+
+// ByContractYear is API call with trace log
+func (s *BillStub) ByContractYear(ctx context.Context, zone string, accountID types.ID, year int) ([]*sacloud.Bill, error) {
+	if s.ByContractYearResult == nil {
+		log.Fatal("BillStub.ByContractYearResult is not set")
+	}
+	return s.ByContractYearResult.Bills, s.ByContractYearResult.Err
+}
+
+// ByContractYearMonth is API call with trace log
+func (s *BillStub) ByContractYearMonth(ctx context.Context, zone string, accountID types.ID, year int, month int) ([]*sacloud.Bill, error) {
+	if s.ByContractYearMonthResult == nil {
+		log.Fatal("BillStub.ByContractYearMonthResult is not set")
+	}
+	return s.ByContractYearMonthResult.Bills, s.ByContractYearMonthResult.Err
+}
+
+// Read is API call with trace log
+func (s *BillStub) Read(ctx context.Context, zone string, id types.ID) ([]*sacloud.Bill, error) {
+	if s.ReadResult == nil {
+		log.Fatal("BillStub.ReadResult is not set")
+	}
+	return s.ReadResult.Bills, s.ReadResult.Err
+}
+
+// Details is API call with trace log
+func (s *BillStub) Details(ctx context.Context, zone string, memberCode string, id types.ID) ([]*sacloud.BillDetail, error) {
+	if s.DetailsResult == nil {
+		log.Fatal("BillStub.DetailsResult is not set")
+	}
+	return s.DetailsResult.BillDetails, s.DetailsResult.Err
+}
+
+// DetailsCSV is API call with trace log
+func (s *BillStub) DetailsCSV(ctx context.Context, zone string, memberCode string, id types.ID) (*sacloud.BillDetailCSV, error) {
+	if s.DetailsCSVResult == nil {
+		log.Fatal("BillStub.DetailsCSVResult is not set")
+	}
+	return s.DetailsCSVResult.BillDetailCSV, s.DetailsCSVResult.Err
+}
+
 /*************************************************
 * BridgeStub
 *************************************************/
@@ -347,160 +610,435 @@ func (s *CDROMStub) CloseFTP(ctx context.Context, zone string, id types.ID) erro
 }
 
 /*************************************************
-* DiskStub
+* CouponStub
 *************************************************/
 
-// DiskFindResult is expected values of the Find operation
-type DiskFindResult struct {
-	Disks []*sacloud.Disk
-	Err   error
+// CouponFindResult is expected values of the Find operation
+type CouponFindResult struct {
+	Coupons []*sacloud.Coupon
+	Err     error
 }
 
-// DiskCreateResult is expected values of the Create operation
-type DiskCreateResult struct {
-	Disk *sacloud.Disk
-	Err  error
+// CouponStub is for trace CouponOp operations
+type CouponStub struct {
+	FindResult *CouponFindResult
 }
 
-// DiskCreateDistantlyResult is expected values of the CreateDistantly operation
-type DiskCreateDistantlyResult struct {
-	Disk *sacloud.Disk
-	Err  error
+// NewCouponStub creates new CouponStub instance
+func NewCouponStub(caller sacloud.APICaller) sacloud.CouponAPI {
+	return &CouponStub{}
 }
 
-// DiskConfigResult is expected values of the Config operation
-type DiskConfigResult struct {
-	Err error
+// Find is API call with trace log
+func (s *CouponStub) Find(ctx context.Context, zone string, accountID types.ID) ([]*sacloud.Coupon, error) {
+	if s.FindResult == nil {
+		log.Fatal("CouponStub.FindResult is not set")
+	}
+	return s.FindResult.Coupons, s.FindResult.Err
 }
 
-// DiskCreateWithConfigResult is expected values of the CreateWithConfig operation
-type DiskCreateWithConfigResult struct {
-	Disk *sacloud.Disk
-	Err  error
+/*************************************************
+* DatabaseStub
+*************************************************/
+
+// DatabaseFindResult is expected values of the Find operation
+type DatabaseFindResult struct {
+	Appliances []*sacloud.Database
+	Err        error
 }
 
-// DiskCreateWithConfigDistantlyResult is expected values of the CreateWithConfigDistantly operation
-type DiskCreateWithConfigDistantlyResult struct {
-	Disk *sacloud.Disk
-	Err  error
+// DatabaseCreateResult is expected values of the Create operation
+type DatabaseCreateResult struct {
+	Appliance *sacloud.Database
+	Err       error
 }
 
-// DiskToBlankResult is expected values of the ToBlank operation
-type DiskToBlankResult struct {
+// DatabaseReadResult is expected values of the Read operation
+type DatabaseReadResult struct {
+	Appliance *sacloud.Database
+	Err       error
+}
+
+// DatabaseUpdateResult is expected values of the Update operation
+type DatabaseUpdateResult struct {
+	Appliance *sacloud.Database
+	Err       error
+}
+
+// DatabaseDeleteResult is expected values of the Delete operation
+type DatabaseDeleteResult struct {
 	Err error
 }
 
-// DiskResizePartitionResult is expected values of the ResizePartition operation
-type DiskResizePartitionResult struct {
+// DatabaseConfigResult is expected values of the Config operation
+type DatabaseConfigResult struct {
 	Err error
 }
 
-// DiskConnectToServerResult is expected values of the ConnectToServer operation
-type DiskConnectToServerResult struct {
+// DatabaseBootResult is expected values of the Boot operation
+type DatabaseBootResult struct {
 	Err error
 }
 
-// DiskDisconnectFromServerResult is expected values of the DisconnectFromServer operation
-type DiskDisconnectFromServerResult struct {
+// DatabaseShutdownResult is expected values of the Shutdown operation
+type DatabaseShutdownResult struct {
 	Err error
 }
 
-// DiskInstallDistantFromResult is expected values of the InstallDistantFrom operation
-type DiskInstallDistantFromResult struct {
-	Disk *sacloud.Disk
+// DatabaseResetResult is expected values of the Reset operation
+type DatabaseResetResult struct {
+	Err error
+}
+
+// DatabaseMonitorCPUResult is expected values of the MonitorCPU operation
+type DatabaseMonitorCPUResult struct {
+	Data *sacloud.CPUTimeActivity
 	Err  error
 }
 
-// DiskInstallResult is expected values of the Install operation
-type DiskInstallResult struct {
-	Disk *sacloud.Disk
+// DatabaseMonitorDiskResult is expected values of the MonitorDisk operation
+type DatabaseMonitorDiskResult struct {
+	Data *sacloud.DiskActivity
 	Err  error
 }
 
-// DiskReadResult is expected values of the Read operation
-type DiskReadResult struct {
-	Disk *sacloud.Disk
+// DatabaseMonitorInterfaceResult is expected values of the MonitorInterface operation
+type DatabaseMonitorInterfaceResult struct {
+	Data *sacloud.InterfaceActivity
 	Err  error
 }
 
-// DiskUpdateResult is expected values of the Update operation
-type DiskUpdateResult struct {
-	Disk *sacloud.Disk
+// DatabaseMonitorDatabaseResult is expected values of the MonitorDatabase operation
+type DatabaseMonitorDatabaseResult struct {
+	Data *sacloud.DatabaseActivity
 	Err  error
 }
 
-// DiskDeleteResult is expected values of the Delete operation
-type DiskDeleteResult struct {
-	Err error
+// DatabaseStatusResult is expected values of the Status operation
+type DatabaseStatusResult struct {
+	Appliance *sacloud.DatabaseStatus
+	Err       error
 }
 
-// DiskMonitorResult is expected values of the Monitor operation
-type DiskMonitorResult struct {
-	Data *sacloud.DiskActivity
-	Err  error
+// DatabaseGetParameterResult is expected values of the GetParameter operation
+type DatabaseGetParameterResult struct {
+	Database *sacloud.DatabaseParameter
+	Err      error
 }
 
-// DiskStub is for trace DiskOp operations
-type DiskStub struct {
-	FindResult                      *DiskFindResult
-	CreateResult                    *DiskCreateResult
-	CreateDistantlyResult           *DiskCreateDistantlyResult
-	ConfigResult                    *DiskConfigResult
-	CreateWithConfigResult          *DiskCreateWithConfigResult
-	CreateWithConfigDistantlyResult *DiskCreateWithConfigDistantlyResult
-	ToBlankResult                   *DiskToBlankResult
-	ResizePartitionResult           *DiskResizePartitionResult
-	ConnectToServerResult           *DiskConnectToServerResult
-	DisconnectFromServerResult      *DiskDisconnectFromServerResult
-	InstallDistantFromResult        *DiskInstallDistantFromResult
-	InstallResult                   *DiskInstallResult
-	ReadResult                      *DiskReadResult
-	UpdateResult                    *DiskUpdateResult
-	DeleteResult                    *DiskDeleteResult
-	MonitorResult                   *DiskMonitorResult
+// DatabaseSetParameterResult is expected values of the SetParameter operation
+type DatabaseSetParameterResult struct {
+	Err error
 }
 
-// NewDiskStub creates new DiskStub instance
-func NewDiskStub(caller sacloud.APICaller) sacloud.DiskAPI {
-	return &DiskStub{}
+// DatabaseStub is for trace DatabaseOp operations
+type DatabaseStub struct {
+	FindResult             *DatabaseFindResult
+	CreateResult           *DatabaseCreateResult
+	ReadResult             *DatabaseReadResult
+	UpdateResult           *DatabaseUpdateResult
+	DeleteResult           *DatabaseDeleteResult
+	ConfigResult           *DatabaseConfigResult
+	BootResult             *DatabaseBootResult
+	ShutdownResult         *DatabaseShutdownResult
+	ResetResult            *DatabaseResetResult
+	MonitorCPUResult       *DatabaseMonitorCPUResult
+	MonitorDiskResult      *DatabaseMonitorDiskResult
+	MonitorInterfaceResult *DatabaseMonitorInterfaceResult
+	MonitorDatabaseResult  *DatabaseMonitorDatabaseResult
+	StatusResult           *DatabaseStatusResult
+	GetParameterResult     *DatabaseGetParameterResult
+	SetParameterResult     *DatabaseSetParameterResult
+}
+
+// NewDatabaseStub creates new DatabaseStub instance
+func NewDatabaseStub(caller sacloud.APICaller) sacloud.DatabaseAPI {
+	return &DatabaseStub{}
 }
 
 // Find is API call with trace log
-func (s *DiskStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Disk, error) {
+func (s *DatabaseStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Database, error) {
 	if s.FindResult == nil {
-		log.Fatal("DiskStub.FindResult is not set")
+		log.Fatal("DatabaseStub.FindResult is not set")
 	}
-	return s.FindResult.Disks, s.FindResult.Err
+	return s.FindResult.Appliances, s.FindResult.Err
 }
 
 // Create is API call with trace log
-func (s *DiskStub) Create(ctx context.Context, zone string, param *sacloud.DiskCreateRequest) (*sacloud.Disk, error) {
+func (s *DatabaseStub) Create(ctx context.Context, zone string, param *sacloud.DatabaseCreateRequest) (*sacloud.Database, error) {
 	if s.CreateResult == nil {
-		log.Fatal("DiskStub.CreateResult is not set")
+		log.Fatal("DatabaseStub.CreateResult is not set")
 	}
-	return s.CreateResult.Disk, s.CreateResult.Err
+	return s.CreateResult.Appliance, s.CreateResult.Err
 }
 
-// CreateDistantly is API call with trace log
-func (s *DiskStub) CreateDistantly(ctx context.Context, zone string, createParam *sacloud.DiskCreateRequest, distantFrom []types.ID) (*sacloud.Disk, error) {
-	if s.CreateDistantlyResult == nil {
-		log.Fatal("DiskStub.CreateDistantlyResult is not set")
+// Read is API call with trace log
+func (s *DatabaseStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Database, error) {
+	if s.ReadResult == nil {
+		log.Fatal("DatabaseStub.ReadResult is not set")
 	}
-	return s.CreateDistantlyResult.Disk, s.CreateDistantlyResult.Err
+	return s.ReadResult.Appliance, s.ReadResult.Err
 }
 
-// Config is API call with trace log
-func (s *DiskStub) Config(ctx context.Context, zone string, id types.ID, edit *sacloud.DiskEditRequest) error {
-	if s.ConfigResult == nil {
-		log.Fatal("DiskStub.ConfigResult is not set")
+// Update is API call with trace log
+func (s *DatabaseStub) Update(ctx context.Context, zone string, id types.ID, param *sacloud.DatabaseUpdateRequest) (*sacloud.Database, error) {
+	if s.UpdateResult == nil {
+		log.Fatal("DatabaseStub.UpdateResult is not set")
 	}
-	return s.ConfigResult.Err
+	return s.UpdateResult.Appliance, s.UpdateResult.Err
 }
 
-// CreateWithConfig is API call with trace log
-func (s *DiskStub) CreateWithConfig(ctx context.Context, zone string, createParam *sacloud.DiskCreateRequest, editParam *sacloud.DiskEditRequest, bootAtAvailable bool) (*sacloud.Disk, error) {
-	if s.CreateWithConfigResult == nil {
-		log.Fatal("DiskStub.CreateWithConfigResult is not set")
+// Delete is API call with trace log
+func (s *DatabaseStub) Delete(ctx context.Context, zone string, id types.ID) error {
+	if s.DeleteResult == nil {
+		log.Fatal("DatabaseStub.DeleteResult is not set")
+	}
+	return s.DeleteResult.Err
+}
+
+// Config is API call with trace log
+func (s *DatabaseStub) Config(ctx context.Context, zone string, id types.ID) error {
+	if s.ConfigResult == nil {
+		log.Fatal("DatabaseStub.ConfigResult is not set")
+	}
+	return s.ConfigResult.Err
+}
+
+// Boot is API call with trace log
+func (s *DatabaseStub) Boot(ctx context.Context, zone string, id types.ID) error {
+	if s.BootResult == nil {
+		log.Fatal("DatabaseStub.BootResult is not set")
+	}
+	return s.BootResult.Err
+}
+
+// Shutdown is API call with trace log
+func (s *DatabaseStub) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
+	if s.ShutdownResult == nil {
+		log.Fatal("DatabaseStub.ShutdownResult is not set")
+	}
+	return s.ShutdownResult.Err
+}
+
+// Reset is API call with trace log
+func (s *DatabaseStub) Reset(ctx context.Context, zone string, id types.ID) error {
+	if s.ResetResult == nil {
+		log.Fatal("DatabaseStub.ResetResult is not set")
+	}
+	return s.ResetResult.Err
+}
+
+// MonitorCPU is API call with trace log
+func (s *DatabaseStub) MonitorCPU(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.CPUTimeActivity, error) {
+	if s.MonitorCPUResult == nil {
+		log.Fatal("DatabaseStub.MonitorCPUResult is not set")
+	}
+	return s.MonitorCPUResult.Data, s.MonitorCPUResult.Err
+}
+
+// MonitorDisk is API call with trace log
+func (s *DatabaseStub) MonitorDisk(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.DiskActivity, error) {
+	if s.MonitorDiskResult == nil {
+		log.Fatal("DatabaseStub.MonitorDiskResult is not set")
+	}
+	return s.MonitorDiskResult.Data, s.MonitorDiskResult.Err
+}
+
+// MonitorInterface is API call with trace log
+func (s *DatabaseStub) MonitorInterface(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
+	if s.MonitorInterfaceResult == nil {
+		log.Fatal("DatabaseStub.MonitorInterfaceResult is not set")
+	}
+	return s.MonitorInterfaceResult.Data, s.MonitorInterfaceResult.Err
+}
+
+// MonitorDatabase is API call with trace log
+func (s *DatabaseStub) MonitorDatabase(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.DatabaseActivity, error) {
+	if s.MonitorDatabaseResult == nil {
+		log.Fatal("DatabaseStub.MonitorDatabaseResult is not set")
+	}
+	return s.MonitorDatabaseResult.Data, s.MonitorDatabaseResult.Err
+}
+
+// Status is API call with trace log
+func (s *DatabaseStub) Status(ctx context.Context, zone string, id types.ID) (*sacloud.DatabaseStatus, error) {
+	if s.StatusResult == nil {
+		log.Fatal("DatabaseStub.StatusResult is not set")
+	}
+	return s.StatusResult.Appliance, s.StatusResult.Err
+}
+
+// GetParameter is API call with trace log
+func (s *DatabaseStub) GetParameter(ctx context.Context, zone string, id types.ID) (*sacloud.DatabaseParameter, error) {
+	if s.GetParameterResult == nil {
+		log.Fatal("DatabaseStub.GetParameterResult is not set")
+	}
+	return s.GetParameterResult.Database, s.GetParameterResult.Err
+}
+
+// SetParameter is API call with trace log
+func (s *DatabaseStub) SetParameter(ctx context.Context, zone string, id types.ID, param map[string]interface{}) error {
+	if s.SetParameterResult == nil {
+		log.Fatal("DatabaseStub.SetParameterResult is not set")
+	}
+	return s.SetParameterResult.Err
+}
+
+/*************************************************
+* DiskStub
+*************************************************/
+
+// DiskFindResult is expected values of the Find operation
+type DiskFindResult struct {
+	Disks []*sacloud.Disk
+	Err   error
+}
+
+// DiskCreateResult is expected values of the Create operation
+type DiskCreateResult struct {
+	Disk *sacloud.Disk
+	Err  error
+}
+
+// DiskCreateDistantlyResult is expected values of the CreateDistantly operation
+type DiskCreateDistantlyResult struct {
+	Disk *sacloud.Disk
+	Err  error
+}
+
+// DiskConfigResult is expected values of the Config operation
+type DiskConfigResult struct {
+	Err error
+}
+
+// DiskCreateWithConfigResult is expected values of the CreateWithConfig operation
+type DiskCreateWithConfigResult struct {
+	Disk *sacloud.Disk
+	Err  error
+}
+
+// DiskCreateWithConfigDistantlyResult is expected values of the CreateWithConfigDistantly operation
+type DiskCreateWithConfigDistantlyResult struct {
+	Disk *sacloud.Disk
+	Err  error
+}
+
+// DiskToBlankResult is expected values of the ToBlank operation
+type DiskToBlankResult struct {
+	Err error
+}
+
+// DiskResizePartitionResult is expected values of the ResizePartition operation
+type DiskResizePartitionResult struct {
+	Err error
+}
+
+// DiskConnectToServerResult is expected values of the ConnectToServer operation
+type DiskConnectToServerResult struct {
+	Err error
+}
+
+// DiskDisconnectFromServerResult is expected values of the DisconnectFromServer operation
+type DiskDisconnectFromServerResult struct {
+	Err error
+}
+
+// DiskInstallDistantFromResult is expected values of the InstallDistantFrom operation
+type DiskInstallDistantFromResult struct {
+	Disk *sacloud.Disk
+	Err  error
+}
+
+// DiskInstallResult is expected values of the Install operation
+type DiskInstallResult struct {
+	Disk *sacloud.Disk
+	Err  error
+}
+
+// DiskReadResult is expected values of the Read operation
+type DiskReadResult struct {
+	Disk *sacloud.Disk
+	Err  error
+}
+
+// DiskUpdateResult is expected values of the Update operation
+type DiskUpdateResult struct {
+	Disk *sacloud.Disk
+	Err  error
+}
+
+// DiskDeleteResult is expected values of the Delete operation
+type DiskDeleteResult struct {
+	Err error
+}
+
+// DiskMonitorResult is expected values of the Monitor operation
+type DiskMonitorResult struct {
+	Data *sacloud.DiskActivity
+	Err  error
+}
+
+// DiskStub is for trace DiskOp operations
+type DiskStub struct {
+	FindResult                      *DiskFindResult
+	CreateResult                    *DiskCreateResult
+	CreateDistantlyResult           *DiskCreateDistantlyResult
+	ConfigResult                    *DiskConfigResult
+	CreateWithConfigResult          *DiskCreateWithConfigResult
+	CreateWithConfigDistantlyResult *DiskCreateWithConfigDistantlyResult
+	ToBlankResult                   *DiskToBlankResult
+	ResizePartitionResult           *DiskResizePartitionResult
+	ConnectToServerResult           *DiskConnectToServerResult
+	DisconnectFromServerResult      *DiskDisconnectFromServerResult
+	InstallDistantFromResult        *DiskInstallDistantFromResult
+	InstallResult                   *DiskInstallResult
+	ReadResult                      *DiskReadResult
+	UpdateResult                    *DiskUpdateResult
+	DeleteResult                    *DiskDeleteResult
+	MonitorResult                   *DiskMonitorResult
+}
+
+// NewDiskStub creates new DiskStub instance
+func NewDiskStub(caller sacloud.APICaller) sacloud.DiskAPI {
+	return &DiskStub{}
+}
+
+// Find is API call with trace log
+func (s *DiskStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Disk, error) {
+	if s.FindResult == nil {
+		log.Fatal("DiskStub.FindResult is not set")
+	}
+	return s.FindResult.Disks, s.FindResult.Err
+}
+
+// Create is API call with trace log
+func (s *DiskStub) Create(ctx context.Context, zone string, param *sacloud.DiskCreateRequest) (*sacloud.Disk, error) {
+	if s.CreateResult == nil {
+		log.Fatal("DiskStub.CreateResult is not set")
+	}
+	return s.CreateResult.Disk, s.CreateResult.Err
+}
+
+// CreateDistantly is API call with trace log
+func (s *DiskStub) CreateDistantly(ctx context.Context, zone string, createParam *sacloud.DiskCreateRequest, distantFrom []types.ID) (*sacloud.Disk, error) {
+	if s.CreateDistantlyResult == nil {
+		log.Fatal("DiskStub.CreateDistantlyResult is not set")
+	}
+	return s.CreateDistantlyResult.Disk, s.CreateDistantlyResult.Err
+}
+
+// Config is API call with trace log
+func (s *DiskStub) Config(ctx context.Context, zone string, id types.ID, edit *sacloud.DiskEditRequest) error {
+	if s.ConfigResult == nil {
+		log.Fatal("DiskStub.ConfigResult is not set")
+	}
+	return s.ConfigResult.Err
+}
+
+// CreateWithConfig is API call with trace log
+func (s *DiskStub) CreateWithConfig(ctx context.Context, zone string, createParam *sacloud.DiskCreateRequest, editParam *sacloud.DiskEditRequest, bootAtAvailable bool) (*sacloud.Disk, error) {
+	if s.CreateWithConfigResult == nil {
+		log.Fatal("DiskStub.CreateWithConfigResult is not set")
 	}
 	return s.CreateWithConfigResult.Disk, s.CreateWithConfigResult.Err
 }
@@ -594,45 +1132,203 @@ func (s *DiskStub) Monitor(ctx context.Context, zone string, id types.ID, condit
 }
 
 /*************************************************
-* GSLBStub
+* DiskPlanStub
 *************************************************/
 
-// GSLBFindResult is expected values of the Find operation
-type GSLBFindResult struct {
-	CommonServiceItems []*sacloud.GSLB
+// DiskPlanFindResult is expected values of the Find operation
+type DiskPlanFindResult struct {
+	DiskPlans []*sacloud.DiskPlan
+	Err       error
+}
+
+// DiskPlanReadResult is expected values of the Read operation
+type DiskPlanReadResult struct {
+	DiskPlan *sacloud.DiskPlan
+	Err      error
+}
+
+// DiskPlanStub is for trace DiskPlanOp operations
+type DiskPlanStub struct {
+	FindResult *DiskPlanFindResult
+	ReadResult *DiskPlanReadResult
+}
+
+// NewDiskPlanStub creates new DiskPlanStub instance
+func NewDiskPlanStub(caller sacloud.APICaller) sacloud.DiskPlanAPI {
+	return &DiskPlanStub{}
+}
+
+// Find is API call with trace log
+func (s *DiskPlanStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.DiskPlan, error) {
+	if s.FindResult == nil {
+		log.Fatal("DiskPlanStub.FindResult is not set")
+	}
+	return s.FindResult.DiskPlans, s.FindResult.Err
+}
+
+// Read is API call with trace log
+func (s *DiskPlanStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.DiskPlan, error) {
+	if s.ReadResult == nil {
+		log.Fatal("DiskPlanStub.ReadResult is not set")
+	}
+	return s.ReadResult.DiskPlan, s.ReadResult.Err
+}
+
+/*************************************************
+* DNSStub
+*************************************************/
+
+// DNSFindResult is expected values of the Find operation
+type DNSFindResult struct {
+	CommonServiceItems []*sacloud.DNS
 	Err                error
 }
 
-// GSLBCreateResult is expected values of the Create operation
-type GSLBCreateResult struct {
-	CommonServiceItem *sacloud.GSLB
+// DNSCreateResult is expected values of the Create operation
+type DNSCreateResult struct {
+	CommonServiceItem *sacloud.DNS
 	Err               error
 }
 
-// GSLBReadResult is expected values of the Read operation
-type GSLBReadResult struct {
-	CommonServiceItem *sacloud.GSLB
+// DNSReadResult is expected values of the Read operation
+type DNSReadResult struct {
+	CommonServiceItem *sacloud.DNS
 	Err               error
 }
 
-// GSLBUpdateResult is expected values of the Update operation
-type GSLBUpdateResult struct {
-	CommonServiceItem *sacloud.GSLB
+// DNSUpdateResult is expected values of the Update operation
+type DNSUpdateResult struct {
+	CommonServiceItem *sacloud.DNS
 	Err               error
 }
 
-// GSLBDeleteResult is expected values of the Delete operation
-type GSLBDeleteResult struct {
+// DNSDeleteResult is expected values of the Delete operation
+type DNSDeleteResult struct {
 	Err error
 }
 
-// GSLBStub is for trace GSLBOp operations
-type GSLBStub struct {
-	FindResult   *GSLBFindResult
-	CreateResult *GSLBCreateResult
-	ReadResult   *GSLBReadResult
-	UpdateResult *GSLBUpdateResult
-	DeleteResult *GSLBDeleteResult
+// DNSStub is for trace DNSOp operations
+type DNSStub struct {
+	FindResult   *DNSFindResult
+	CreateResult *DNSCreateResult
+	ReadResult   *DNSReadResult
+	UpdateResult *DNSUpdateResult
+	DeleteResult *DNSDeleteResult
+}
+
+// NewDNSStub creates new DNSStub instance
+func NewDNSStub(caller sacloud.APICaller) sacloud.DNSAPI {
+	return &DNSStub{}
+}
+
+// Find is API call with trace log
+func (s *DNSStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.DNS, error) {
+	if s.FindResult == nil {
+		log.Fatal("DNSStub.FindResult is not set")
+	}
+	return s.FindResult.CommonServiceItems, s.FindResult.Err
+}
+
+// Create is API call with trace log
+func (s *DNSStub) Create(ctx context.Context, zone string, param *sacloud.DNSCreateRequest) (*sacloud.DNS, error) {
+	if s.CreateResult == nil {
+		log.Fatal("DNSStub.CreateResult is not set")
+	}
+	return s.CreateResult.CommonServiceItem, s.CreateResult.Err
+}
+
+// Read is API call with trace log
+func (s *DNSStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.DNS, error) {
+	if s.ReadResult == nil {
+		log.Fatal("DNSStub.ReadResult is not set")
+	}
+	return s.ReadResult.CommonServiceItem, s.ReadResult.Err
+}
+
+// Update is API call with trace log
+func (s *DNSStub) Update(ctx context.Context, zone string, id types.ID, param *sacloud.DNSUpdateRequest) (*sacloud.DNS, error) {
+	if s.UpdateResult == nil {
+		log.Fatal("DNSStub.UpdateResult is not set")
+	}
+	return s.UpdateResult.CommonServiceItem, s.UpdateResult.Err
+}
+
+// Delete is API call with trace log
+func (s *DNSStub) Delete(ctx context.Context, zone string, id types.ID) error {
+	if s.DeleteResult == nil {
+		log.Fatal("DNSStub.DeleteResult is not set")
+	}
+	return s.DeleteResult.Err
+}
+
+/*************************************************
+* ESUsageStub
+*************************************************/
+
+// ESUsageFindResult is expected values of the Find operation
+type ESUsageFindResult struct {
+	ESUsages []*sacloud.ESUsage
+	Err      error
+}
+
+// ESUsageStub is for trace ESUsageOp operations
+type ESUsageStub struct {
+	FindResult *ESUsageFindResult
+}
+
+// NewESUsageStub creates new ESUsageStub instance
+func NewESUsageStub(caller sacloud.APICaller) sacloud.ESUsageAPI {
+	return &ESUsageStub{}
+}
+
+// Find is API call with trace log
+func (s *ESUsageStub) Find(ctx context.Context, zone string, accountID types.ID, year int, month int) ([]*sacloud.ESUsage, error) {
+	if s.FindResult == nil {
+		log.Fatal("ESUsageStub.FindResult is not set")
+	}
+	return s.FindResult.ESUsages, s.FindResult.Err
+}
+
+/*************************************************
+* GSLBStub
+*************************************************/
+
+// GSLBFindResult is expected values of the Find operation
+type GSLBFindResult struct {
+	CommonServiceItems []*sacloud.GSLB
+	Err                error
+}
+
+// GSLBCreateResult is expected values of the Create operation
+type GSLBCreateResult struct {
+	CommonServiceItem *sacloud.GSLB
+	Err               error
+}
+
+// GSLBReadResult is expected values of the Read operation
+type GSLBReadResult struct {
+	CommonServiceItem *sacloud.GSLB
+	Err               error
+}
+
+// GSLBUpdateResult is expected values of the Update operation
+type GSLBUpdateResult struct {
+	CommonServiceItem *sacloud.GSLB
+	Err               error
+}
+
+// GSLBDeleteResult is expected values of the Delete operation
+type GSLBDeleteResult struct {
+	Err error
+}
+
+// GSLBStub is for trace GSLBOp operations
+type GSLBStub struct {
+	FindResult   *GSLBFindResult
+	CreateResult *GSLBCreateResult
+	ReadResult   *GSLBReadResult
+	UpdateResult *GSLBUpdateResult
+	DeleteResult *GSLBDeleteResult
 }
 
 // NewGSLBStub creates new GSLBStub instance
@@ -680,6 +1376,108 @@ func (s *GSLBStub) Delete(ctx context.Context, zone string, id types.ID) error {
 	return s.DeleteResult.Err
 }
 
+/*************************************************
+* IconStub
+*************************************************/
+
+// IconFindResult is expected values of the Find operation
+type IconFindResult struct {
+	Icons []*sacloud.Icon
+	Err   error
+}
+
+// IconCreateResult is expected values of the Create operation
+type IconCreateResult struct {
+	Icon *sacloud.Icon
+	Err  error
+}
+
+// IconReadResult is expected values of the Read operation
+type IconReadResult struct {
+	Icon *sacloud.Icon
+	Err  error
+}
+
+// IconUpdateResult is expected values of the Update operation
+type IconUpdateResult struct {
+	Icon *sacloud.Icon
+	Err  error
+}
+
+// IconDeleteResult is expected values of the Delete operation
+type IconDeleteResult struct {
+	Err error
+}
+
+// IconGetImageResult is expected values of the GetImage operation
+type IconGetImageResult struct {
+	Icon *sacloud.IconImage
+	Err  error
+}
+
+// IconStub is for trace IconOp operations
+type IconStub struct {
+	FindResult     *IconFindResult
+	CreateResult   *IconCreateResult
+	ReadResult     *IconReadResult
+	UpdateResult   *IconUpdateResult
+	DeleteResult   *IconDeleteResult
+	GetImageResult *IconGetImageResult
+}
+
+// NewIconStub creates new IconStub instance
+func NewIconStub(caller sacloud.APICaller) sacloud.IconAPI {
+	return &IconStub{}
+}
+
+// Find is API call with trace log
+func (s *IconStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Icon, error) {
+	if s.FindResult == nil {
+		log.Fatal("IconStub.FindResult is not set")
+	}
+	return s.FindResult.Icons, s.FindResult.Err
+}
+
+// Create is API call with trace log
+func (s *IconStub) Create(ctx context.Context, zone string, param *sacloud.IconCreateRequest) (*sacloud.Icon, error) {
+	if s.CreateResult == nil {
+		log.Fatal("IconStub.CreateResult is not set")
+	}
+	return s.CreateResult.Icon, s.CreateResult.Err
+}
+
+// Read is API call with trace log
+func (s *IconStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Icon, error) {
+	if s.ReadResult == nil {
+		log.Fatal("IconStub.ReadResult is not set")
+	}
+	return s.ReadResult.Icon, s.ReadResult.Err
+}
+
+// Update is API call with trace log
+func (s *IconStub) Update(ctx context.Context, zone string, id types.ID, param *sacloud.IconUpdateRequest) (*sacloud.Icon, error) {
+	if s.UpdateResult == nil {
+		log.Fatal("IconStub.UpdateResult is not set")
+	}
+	return s.UpdateResult.Icon, s.UpdateResult.Err
+}
+
+// Delete is API call with trace log
+func (s *IconStub) Delete(ctx context.Context, zone string, id types.ID) error {
+	if s.DeleteResult == nil {
+		log.Fatal("IconStub.DeleteResult is not set")
+	}
+	return s.DeleteResult.Err
+}
+
+// GetImage is API call with trace log
+func (s *IconStub) GetImage(ctx context.Context, zone string, id types.ID, size types.EIconSize) (*sacloud.IconImage, error) {
+	if s.GetImageResult == nil {
+		log.Fatal("IconStub.GetImageResult is not set")
+	}
+	return s.GetImageResult.Icon, s.GetImageResult.Err
+}
+
 /*************************************************
 * InterfaceStub
 *************************************************/
@@ -880,137 +1678,527 @@ type InternetUpdateResult struct {
 	Err      error
 }
 
-// InternetDeleteResult is expected values of the Delete operation
-type InternetDeleteResult struct {
-	Err error
+// InternetDeleteResult is expected values of the Delete operation
+type InternetDeleteResult struct {
+	Err error
+}
+
+// InternetUpdateBandWidthResult is expected values of the UpdateBandWidth operation
+type InternetUpdateBandWidthResult struct {
+	Internet *sacloud.Internet
+	Err      error
+}
+
+// InternetAddSubnetResult is expected values of the AddSubnet operation
+type InternetAddSubnetResult struct {
+	Subnet *sacloud.InternetSubnetOperationResult
+	Err    error
+}
+
+// InternetUpdateSubnetResult is expected values of the UpdateSubnet operation
+type InternetUpdateSubnetResult struct {
+	Subnet *sacloud.InternetSubnetOperationResult
+	Err    error
+}
+
+// InternetDeleteSubnetResult is expected values of the DeleteSubnet operation
+type InternetDeleteSubnetResult struct {
+	Err error
+}
+
+// InternetMonitorResult is expected values of the Monitor operation
+type InternetMonitorResult struct {
+	Data *sacloud.RouterActivity
+	Err  error
+}
+
+// InternetEnableIPv6Result is expected values of the EnableIPv6 operation
+type InternetEnableIPv6Result struct {
+	IPv6Net *sacloud.IPv6NetInfo
+	Err     error
+}
+
+// InternetDisableIPv6Result is expected values of the DisableIPv6 operation
+type InternetDisableIPv6Result struct {
+	Err error
+}
+
+// InternetStub is for trace InternetOp operations
+type InternetStub struct {
+	FindResult            *InternetFindResult
+	CreateResult          *InternetCreateResult
+	ReadResult            *InternetReadResult
+	UpdateResult          *InternetUpdateResult
+	DeleteResult          *InternetDeleteResult
+	UpdateBandWidthResult *InternetUpdateBandWidthResult
+	AddSubnetResult       *InternetAddSubnetResult
+	UpdateSubnetResult    *InternetUpdateSubnetResult
+	DeleteSubnetResult    *InternetDeleteSubnetResult
+	MonitorResult         *InternetMonitorResult
+	EnableIPv6Result      *InternetEnableIPv6Result
+	DisableIPv6Result     *InternetDisableIPv6Result
+}
+
+// NewInternetStub creates new InternetStub instance
+func NewInternetStub(caller sacloud.APICaller) sacloud.InternetAPI {
+	return &InternetStub{}
+}
+
+// Find is API call with trace log
+func (s *InternetStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Internet, error) {
+	if s.FindResult == nil {
+		log.Fatal("InternetStub.FindResult is not set")
+	}
+	return s.FindResult.Internets, s.FindResult.Err
+}
+
+// Create is API call with trace log
+func (s *InternetStub) Create(ctx context.Context, zone string, param *sacloud.InternetCreateRequest) (*sacloud.Internet, error) {
+	if s.CreateResult == nil {
+		log.Fatal("InternetStub.CreateResult is not set")
+	}
+	return s.CreateResult.Internet, s.CreateResult.Err
+}
+
+// Read is API call with trace log
+func (s *InternetStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Internet, error) {
+	if s.ReadResult == nil {
+		log.Fatal("InternetStub.ReadResult is not set")
+	}
+	return s.ReadResult.Internet, s.ReadResult.Err
+}
+
+// Update is API call with trace log
+func (s *InternetStub) Update(ctx context.Context, zone string, id types.ID, param *sacloud.InternetUpdateRequest) (*sacloud.Internet, error) {
+	if s.UpdateResult == nil {
+		log.Fatal("InternetStub.UpdateResult is not set")
+	}
+	return s.UpdateResult.Internet, s.UpdateResult.Err
+}
+
+// Delete is API call with trace log
+func (s *InternetStub) Delete(ctx context.Context, zone string, id types.ID) error {
+	if s.DeleteResult == nil {
+		log.Fatal("InternetStub.DeleteResult is not set")
+	}
+	return s.DeleteResult.Err
+}
+
+// UpdateBandWidth is API call with trace log
+func (s *InternetStub) UpdateBandWidth(ctx context.Context, zone string, id types.ID, param *sacloud.InternetUpdateBandWidthRequest) (*sacloud.Internet, error) {
+	if s.UpdateBandWidthResult == nil {
+		log.Fatal("InternetStub.UpdateBandWidthResult is not set")
+	}
+	return s.UpdateBandWidthResult.Internet, s.UpdateBandWidthResult.Err
+}
+
+// AddSubnet is API call with trace log
+func (s *InternetStub) AddSubnet(ctx context.Context, zone string, id types.ID, param *sacloud.InternetAddSubnetRequest) (*sacloud.InternetSubnetOperationResult, error) {
+	if s.AddSubnetResult == nil {
+		log.Fatal("InternetStub.AddSubnetResult is not set")
+	}
+	return s.AddSubnetResult.Subnet, s.AddSubnetResult.Err
+}
+
+// UpdateSubnet is API call with trace log
+func (s *InternetStub) UpdateSubnet(ctx context.Context, zone string, id types.ID, subnetID types.ID, param *sacloud.InternetUpdateSubnetRequest) (*sacloud.InternetSubnetOperationResult, error) {
+	if s.UpdateSubnetResult == nil {
+		log.Fatal("InternetStub.UpdateSubnetResult is not set")
+	}
+	return s.UpdateSubnetResult.Subnet, s.UpdateSubnetResult.Err
+}
+
+// DeleteSubnet is API call with trace log
+func (s *InternetStub) DeleteSubnet(ctx context.Context, zone string, id types.ID, subnetID types.ID) error {
+	if s.DeleteSubnetResult == nil {
+		log.Fatal("InternetStub.DeleteSubnetResult is not set")
+	}
+	return s.DeleteSubnetResult.Err
+}
+
+// Monitor is API call with trace log
+func (s *InternetStub) Monitor(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.RouterActivity, error) {
+	if s.MonitorResult == nil {
+		log.Fatal("InternetStub.MonitorResult is not set")
+	}
+	return s.MonitorResult.Data, s.MonitorResult.Err
+}
+
+// EnableIPv6 is API call with trace log
+func (s *InternetStub) EnableIPv6(ctx context.Context, zone string, id types.ID) (*sacloud.IPv6NetInfo, error) {
+	if s.EnableIPv6Result == nil {
+		log.Fatal("InternetStub.EnableIPv6Result is not set")
+	}
+	return s.EnableIPv6Result.IPv6Net, s.EnableIPv6Result.Err
+}
+
+// DisableIPv6 is API call with trace log
+func (s *InternetStub) DisableIPv6(ctx context.Context, zone string, id types.ID, ipv6netID types.ID) error {
+	if s.DisableIPv6Result == nil {
+		log.Fatal("InternetStub.DisableIPv6Result is not set")
+	}
+	return s.DisableIPv6Result.Err
+}
+
+/*************************************************
+* InternetPlanStub
+*************************************************/
+
+// InternetPlanFindResult is expected values of the Find operation
+type InternetPlanFindResult struct {
+	InternetPlans []*sacloud.InternetPlan
+	Err           error
+}
+
+// InternetPlanReadResult is expected values of the Read operation
+type InternetPlanReadResult struct {
+	InternetPlan *sacloud.InternetPlan
+	Err          error
+}
+
+// InternetPlanStub is for trace InternetPlanOp operations
+type InternetPlanStub struct {
+	FindResult *InternetPlanFindResult
+	ReadResult *InternetPlanReadResult
+}
+
+// NewInternetPlanStub creates new InternetPlanStub instance
+func NewInternetPlanStub(caller sacloud.APICaller) sacloud.InternetPlanAPI {
+	return &InternetPlanStub{}
+}
+
+// Find is API call with trace log
+func (s *InternetPlanStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.InternetPlan, error) {
+	if s.FindResult == nil {
+		log.Fatal("InternetPlanStub.FindResult is not set")
+	}
+	return s.FindResult.InternetPlans, s.FindResult.Err
+}
+
+// Read is API call with trace log
+func (s *InternetPlanStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.InternetPlan, error) {
+	if s.ReadResult == nil {
+		log.Fatal("InternetPlanStub.ReadResult is not set")
+	}
+	return s.ReadResult.InternetPlan, s.ReadResult.Err
+}
+
+/*************************************************
+* IPAddressStub
+*************************************************/
+
+// IPAddressFindResult is expected values of the Find operation
+type IPAddressFindResult struct {
+	IPAddress []*sacloud.IPAddress
+	Err       error
+}
+
+// IPAddressReadResult is expected values of the Read operation
+type IPAddressReadResult struct {
+	IPAddress *sacloud.IPAddress
+	Err       error
+}
+
+// IPAddressUpdateHostNameResult is expected values of the UpdateHostName operation
+type IPAddressUpdateHostNameResult struct {
+	IPAddress *sacloud.IPAddress
+	Err       error
+}
+
+// IPAddressStub is for trace IPAddressOp operations
+type IPAddressStub struct {
+	FindResult           *IPAddressFindResult
+	ReadResult           *IPAddressReadResult
+	UpdateHostNameResult *IPAddressUpdateHostNameResult
+}
+
+// NewIPAddressStub creates new IPAddressStub instance
+func NewIPAddressStub(caller sacloud.APICaller) sacloud.IPAddressAPI {
+	return &IPAddressStub{}
+}
+
+// Find is API call with trace log
+func (s *IPAddressStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.IPAddress, error) {
+	if s.FindResult == nil {
+		log.Fatal("IPAddressStub.FindResult is not set")
+	}
+	return s.FindResult.IPAddress, s.FindResult.Err
+}
+
+// Read is API call with trace log
+func (s *IPAddressStub) Read(ctx context.Context, zone string, ipAddress string) (*sacloud.IPAddress, error) {
+	if s.ReadResult == nil {
+		log.Fatal("IPAddressStub.ReadResult is not set")
+	}
+	return s.ReadResult.IPAddress, s.ReadResult.Err
+}
+
+// UpdateHostName is API call with trace log
+func (s *IPAddressStub) UpdateHostName(ctx context.Context, zone string, ipAddress string, hostName string) (*sacloud.IPAddress, error) {
+	if s.UpdateHostNameResult == nil {
+		log.Fatal("IPAddressStub.UpdateHostNameResult is not set")
+	}
+	return s.UpdateHostNameResult.IPAddress, s.UpdateHostNameResult.Err
+}
+
+/*************************************************
+* IPv6AddrStub
+*************************************************/
+
+// IPv6AddrFindResult is expected values of the Find operation
+type IPv6AddrFindResult struct {
+	IPv6Addrs []*sacloud.IPv6Addr
+	Err       error
+}
+
+// IPv6AddrCreateResult is expected values of the Create operation
+type IPv6AddrCreateResult struct {
+	IPv6Addr *sacloud.IPv6Addr
+	Err      error
+}
+
+// IPv6AddrReadResult is expected values of the Read operation
+type IPv6AddrReadResult struct {
+	IPv6Addr *sacloud.IPv6Addr
+	Err      error
+}
+
+// IPv6AddrUpdateResult is expected values of the Update operation
+type IPv6AddrUpdateResult struct {
+	IPv6Addr *sacloud.IPv6Addr
+	Err      error
+}
+
+// IPv6AddrDeleteResult is expected values of the Delete operation
+type IPv6AddrDeleteResult struct {
+	Err error
+}
+
+// IPv6AddrStub is for trace IPv6AddrOp operations
+type IPv6AddrStub struct {
+	FindResult   *IPv6AddrFindResult
+	CreateResult *IPv6AddrCreateResult
+	ReadResult   *IPv6AddrReadResult
+	UpdateResult *IPv6AddrUpdateResult
+	DeleteResult *IPv6AddrDeleteResult
+}
+
+// NewIPv6AddrStub creates new IPv6AddrStub instance
+func NewIPv6AddrStub(caller sacloud.APICaller) sacloud.IPv6AddrAPI {
+	return &IPv6AddrStub{}
+}
+
+// Find is API call with trace log
+func (s *IPv6AddrStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.IPv6Addr, error) {
+	if s.FindResult == nil {
+		log.Fatal("IPv6AddrStub.FindResult is not set")
+	}
+	return s.FindResult.IPv6Addrs, s.FindResult.Err
+}
+
+// Create is API call with trace log
+func (s *IPv6AddrStub) Create(ctx context.Context, zone string, param *sacloud.IPv6AddrCreateRequest) (*sacloud.IPv6Addr, error) {
+	if s.CreateResult == nil {
+		log.Fatal("IPv6AddrStub.CreateResult is not set")
+	}
+	return s.CreateResult.IPv6Addr, s.CreateResult.Err
+}
+
+// Read is API call with trace log
+func (s *IPv6AddrStub) Read(ctx context.Context, zone string, ipv6addr string) (*sacloud.IPv6Addr, error) {
+	if s.ReadResult == nil {
+		log.Fatal("IPv6AddrStub.ReadResult is not set")
+	}
+	return s.ReadResult.IPv6Addr, s.ReadResult.Err
+}
+
+// Update is API call with trace log
+func (s *IPv6AddrStub) Update(ctx context.Context, zone string, ipv6addr string, param *sacloud.IPv6AddrUpdateRequest) (*sacloud.IPv6Addr, error) {
+	if s.UpdateResult == nil {
+		log.Fatal("IPv6AddrStub.UpdateResult is not set")
+	}
+	return s.UpdateResult.IPv6Addr, s.UpdateResult.Err
+}
+
+// Delete is API call with trace log
+func (s *IPv6AddrStub) Delete(ctx context.Context, zone string, ipv6addr string) error {
+	if s.DeleteResult == nil {
+		log.Fatal("IPv6AddrStub.DeleteResult is not set")
+	}
+	return s.DeleteResult.Err
+}
+
+/*************************************************
+* IPv6NetStub
+*************************************************/
+
+// IPv6NetFindResult is expected values of the Find operation
+type IPv6NetFindResult struct {
+	IPv6Nets []*sacloud.IPv6Net
+	Err      error
+}
+
+// IPv6NetReadResult is expected values of the Read operation
+type IPv6NetReadResult struct {
+	IPv6Net *sacloud.IPv6Net
+	Err     error
+}
+
+// IPv6NetStub is for trace IPv6NetOp operations
+type IPv6NetStub struct {
+	FindResult *IPv6NetFindResult
+	ReadResult *IPv6NetReadResult
+}
+
+// NewIPv6NetStub creates new IPv6NetStub instance
+func NewIPv6NetStub(caller sacloud.APICaller) sacloud.IPv6NetAPI {
+	return &IPv6NetStub{}
+}
+
+// Find is API call with trace log
+func (s *IPv6NetStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.IPv6Net, error) {
+	if s.FindResult == nil {
+		log.Fatal("IPv6NetStub.FindResult is not set")
+	}
+	return s.FindResult.IPv6Nets, s.FindResult.Err
+}
+
+// Read is API call with trace log
+func (s *IPv6NetStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.IPv6Net, error) {
+	if s.ReadResult == nil {
+		log.Fatal("IPv6NetStub.ReadResult is not set")
+	}
+	return s.ReadResult.IPv6Net, s.ReadResult.Err
 }
 
-// InternetUpdateBandWidthResult is expected values of the UpdateBandWidth operation
-type InternetUpdateBandWidthResult struct {
-	Internet *sacloud.Internet
+/*************************************************
+* LicenseStub
+*************************************************/
+
+// LicenseFindResult is expected values of the Find operation
+type LicenseFindResult struct {
+	Licenses []*sacloud.License
 	Err      error
 }
 
-// InternetAddSubnetResult is expected values of the AddSubnet operation
-type InternetAddSubnetResult struct {
-	Subnet *sacloud.InternetSubnetOperationResult
-	Err    error
+// LicenseCreateResult is expected values of the Create operation
+type LicenseCreateResult struct {
+	License *sacloud.License
+	Err     error
 }
 
-// InternetUpdateSubnetResult is expected values of the UpdateSubnet operation
-type InternetUpdateSubnetResult struct {
-	Subnet *sacloud.InternetSubnetOperationResult
-	Err    error
+// LicenseReadResult is expected values of the Read operation
+type LicenseReadResult struct {
+	License *sacloud.License
+	Err     error
 }
 
-// InternetDeleteSubnetResult is expected values of the DeleteSubnet operation
-type InternetDeleteSubnetResult struct {
-	Err error
+// LicenseUpdateResult is expected values of the Update operation
+type LicenseUpdateResult struct {
+	License *sacloud.License
+	Err     error
 }
 
-// InternetMonitorResult is expected values of the Monitor operation
-type InternetMonitorResult struct {
-	Data *sacloud.RouterActivity
-	Err  error
+// LicenseDeleteResult is expected values of the Delete operation
+type LicenseDeleteResult struct {
+	Err error
 }
 
-// InternetStub is for trace InternetOp operations
-type InternetStub struct {
-	FindResult            *InternetFindResult
-	CreateResult          *InternetCreateResult
-	ReadResult            *InternetReadResult
-	UpdateResult          *InternetUpdateResult
-	DeleteResult          *InternetDeleteResult
-	UpdateBandWidthResult *InternetUpdateBandWidthResult
-	AddSubnetResult       *InternetAddSubnetResult
-	UpdateSubnetResult    *InternetUpdateSubnetResult
-	DeleteSubnetResult    *InternetDeleteSubnetResult
-	MonitorResult         *InternetMonitorResult
+// LicenseStub is for trace LicenseOp operations
+type LicenseStub struct {
+	FindResult   *LicenseFindResult
+	CreateResult *LicenseCreateResult
+	ReadResult   *LicenseReadResult
+	UpdateResult *LicenseUpdateResult
+	DeleteResult *LicenseDeleteResult
 }
 
-// NewInternetStub creates new InternetStub instance
-func NewInternetStub(caller sacloud.APICaller) sacloud.InternetAPI {
-	return &InternetStub{}
+// NewLicenseStub creates new LicenseStub instance
+func NewLicenseStub(caller sacloud.APICaller) sacloud.LicenseAPI {
+	return &LicenseStub{}
 }
 
 // Find is API call with trace log
-func (s *InternetStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Internet, error) {
+func (s *LicenseStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.License, error) {
 	if s.FindResult == nil {
-		log.Fatal("InternetStub.FindResult is not set")
+		log.Fatal("LicenseStub.FindResult is not set")
 	}
-	return s.FindResult.Internets, s.FindResult.Err
+	return s.FindResult.Licenses, s.FindResult.Err
 }
 
 // Create is API call with trace log
-func (s *InternetStub) Create(ctx context.Context, zone string, param *sacloud.InternetCreateRequest) (*sacloud.Internet, error) {
+func (s *LicenseStub) Create(ctx context.Context, zone string, param *sacloud.LicenseCreateRequest) (*sacloud.License, error) {
 	if s.CreateResult == nil {
-		log.Fatal("InternetStub.CreateResult is not set")
+		log.Fatal("LicenseStub.CreateResult is not set")
 	}
-	return s.CreateResult.Internet, s.CreateResult.Err
+	return s.CreateResult.License, s.CreateResult.Err
 }
 
 // Read is API call with trace log
-func (s *InternetStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Internet, error) {
+func (s *LicenseStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.License, error) {
 	if s.ReadResult == nil {
-		log.Fatal("InternetStub.ReadResult is not set")
+		log.Fatal("LicenseStub.ReadResult is not set")
 	}
-	return s.ReadResult.Internet, s.ReadResult.Err
+	return s.ReadResult.License, s.ReadResult.Err
 }
 
 // Update is API call with trace log
-func (s *InternetStub) Update(ctx context.Context, zone string, id types.ID, param *sacloud.InternetUpdateRequest) (*sacloud.Internet, error) {
+func (s *LicenseStub) Update(ctx context.Context, zone string, id types.ID, param *sacloud.LicenseUpdateRequest) (*sacloud.License, error) {
 	if s.UpdateResult == nil {
-		log.Fatal("InternetStub.UpdateResult is not set")
+		log.Fatal("LicenseStub.UpdateResult is not set")
 	}
-	return s.UpdateResult.Internet, s.UpdateResult.Err
+	return s.UpdateResult.License, s.UpdateResult.Err
 }
 
 // Delete is API call with trace log
-func (s *InternetStub) Delete(ctx context.Context, zone string, id types.ID) error {
+func (s *LicenseStub) Delete(ctx context.Context, zone string, id types.ID) error {
 	if s.DeleteResult == nil {
-		log.Fatal("InternetStub.DeleteResult is not set")
+		log.Fatal("LicenseStub.DeleteResult is not set")
 	}
 	return s.DeleteResult.Err
 }
 
-// UpdateBandWidth is API call with trace log
-func (s *InternetStub) UpdateBandWidth(ctx context.Context, zone string, id types.ID, param *sacloud.InternetUpdateBandWidthRequest) (*sacloud.Internet, error) {
-	if s.UpdateBandWidthResult == nil {
-		log.Fatal("InternetStub.UpdateBandWidthResult is not set")
-	}
-	return s.UpdateBandWidthResult.Internet, s.UpdateBandWidthResult.Err
+/*************************************************
+* LicensePlanStub
+*************************************************/
+
+// LicensePlanFindResult is expected values of the Find operation
+type LicensePlanFindResult struct {
+	LicensePlans []*sacloud.LicensePlan
+	Err          error
 }
 
-// AddSubnet is API call with trace log
-func (s *InternetStub) AddSubnet(ctx context.Context, zone string, id types.ID, param *sacloud.InternetAddSubnetRequest) (*sacloud.InternetSubnetOperationResult, error) {
-	if s.AddSubnetResult == nil {
-		log.Fatal("InternetStub.AddSubnetResult is not set")
-	}
-	return s.AddSubnetResult.Subnet, s.AddSubnetResult.Err
+// LicensePlanReadResult is expected values of the Read operation
+type LicensePlanReadResult struct {
+	LicensePlan *sacloud.LicensePlan
+	Err         error
 }
 
-// UpdateSubnet is API call with trace log
-func (s *InternetStub) UpdateSubnet(ctx context.Context, zone string, id types.ID, subnetID types.ID, param *sacloud.InternetUpdateSubnetRequest) (*sacloud.InternetSubnetOperationResult, error) {
-	if s.UpdateSubnetResult == nil {
-		log.Fatal("InternetStub.UpdateSubnetResult is not set")
-	}
-	return s.UpdateSubnetResult.Subnet, s.UpdateSubnetResult.Err
+// LicensePlanStub is for trace LicensePlanOp operations
+type LicensePlanStub struct {
+	FindResult *LicensePlanFindResult
+	ReadResult *LicensePlanReadResult
 }
 
-// DeleteSubnet is API call with trace log
-func (s *InternetStub) DeleteSubnet(ctx context.Context, zone string, id types.ID, subnetID types.ID) error {
-	if s.DeleteSubnetResult == nil {
-		log.Fatal("InternetStub.DeleteSubnetResult is not set")
+// NewLicensePlanStub creates new LicensePlanStub instance
+func NewLicensePlanStub(caller sacloud.APICaller) sacloud.LicensePlanAPI {
+	return &LicensePlanStub{}
+}
+
+// Find is API call with trace log
+func (s *LicensePlanStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.LicensePlan, error) {
+	if s.FindResult == nil {
+		log.Fatal("LicensePlanStub.FindResult is not set")
 	}
-	return s.DeleteSubnetResult.Err
+	return s.FindResult.LicensePlans, s.FindResult.Err
 }
 
-// Monitor is API call with trace log
-func (s *InternetStub) Monitor(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.RouterActivity, error) {
-	if s.MonitorResult == nil {
-		log.Fatal("InternetStub.MonitorResult is not set")
+// Read is API call with trace log
+func (s *LicensePlanStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.LicensePlan, error) {
+	if s.ReadResult == nil {
+		log.Fatal("LicensePlanStub.ReadResult is not set")
 	}
-	return s.MonitorResult.Data, s.MonitorResult.Err
+	return s.ReadResult.LicensePlan, s.ReadResult.Err
 }
 
 /*************************************************
@@ -1123,67 +2311,412 @@ func (s *LoadBalancerStub) Read(ctx context.Context, zone string, id types.ID) (
 }
 
 // Update is API call with trace log
-func (s *LoadBalancerStub) Update(ctx context.Context, zone string, id types.ID, param *sacloud.LoadBalancerUpdateRequest) (*sacloud.LoadBalancer, error) {
+func (s *LoadBalancerStub) Update(ctx context.Context, zone string, id types.ID, param *sacloud.LoadBalancerUpdateRequest) (*sacloud.LoadBalancer, error) {
+	if s.UpdateResult == nil {
+		log.Fatal("LoadBalancerStub.UpdateResult is not set")
+	}
+	return s.UpdateResult.Appliance, s.UpdateResult.Err
+}
+
+// Delete is API call with trace log
+func (s *LoadBalancerStub) Delete(ctx context.Context, zone string, id types.ID) error {
+	if s.DeleteResult == nil {
+		log.Fatal("LoadBalancerStub.DeleteResult is not set")
+	}
+	return s.DeleteResult.Err
+}
+
+// Config is API call with trace log
+func (s *LoadBalancerStub) Config(ctx context.Context, zone string, id types.ID) error {
+	if s.ConfigResult == nil {
+		log.Fatal("LoadBalancerStub.ConfigResult is not set")
+	}
+	return s.ConfigResult.Err
+}
+
+// Boot is API call with trace log
+func (s *LoadBalancerStub) Boot(ctx context.Context, zone string, id types.ID) error {
+	if s.BootResult == nil {
+		log.Fatal("LoadBalancerStub.BootResult is not set")
+	}
+	return s.BootResult.Err
+}
+
+// Shutdown is API call with trace log
+func (s *LoadBalancerStub) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
+	if s.ShutdownResult == nil {
+		log.Fatal("LoadBalancerStub.ShutdownResult is not set")
+	}
+	return s.ShutdownResult.Err
+}
+
+// Reset is API call with trace log
+func (s *LoadBalancerStub) Reset(ctx context.Context, zone string, id types.ID) error {
+	if s.ResetResult == nil {
+		log.Fatal("LoadBalancerStub.ResetResult is not set")
+	}
+	return s.ResetResult.Err
+}
+
+// MonitorInterface is API call with trace log
+func (s *LoadBalancerStub) MonitorInterface(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
+	if s.MonitorInterfaceResult == nil {
+		log.Fatal("LoadBalancerStub.MonitorInterfaceResult is not set")
+	}
+	return s.MonitorInterfaceResult.Data, s.MonitorInterfaceResult.Err
+}
+
+// Status is API call with trace log
+func (s *LoadBalancerStub) Status(ctx context.Context, zone string, id types.ID) ([]*sacloud.LoadBalancerStatus, error) {
+	if s.StatusResult == nil {
+		log.Fatal("LoadBalancerStub.StatusResult is not set")
+	}
+	return s.StatusResult.LoadBalancer, s.StatusResult.Err
+}
+
+/*************************************************
+* MobileGatewayStub
+*************************************************/
+
+// MobileGatewayFindResult is expected values of the Find operation
+type MobileGatewayFindResult struct {
+	Appliances []*sacloud.MobileGateway
+	Err        error
+}
+
+// MobileGatewayCreateResult is expected values of the Create operation
+type MobileGatewayCreateResult struct {
+	Appliance *sacloud.MobileGateway
+	Err       error
+}
+
+// MobileGatewayReadResult is expected values of the Read operation
+type MobileGatewayReadResult struct {
+	Appliance *sacloud.MobileGateway
+	Err       error
+}
+
+// MobileGatewayUpdateResult is expected values of the Update operation
+type MobileGatewayUpdateResult struct {
+	Appliance *sacloud.MobileGateway
+	Err       error
+}
+
+// MobileGatewayDeleteResult is expected values of the Delete operation
+type MobileGatewayDeleteResult struct {
+	Err error
+}
+
+// MobileGatewayConfigResult is expected values of the Config operation
+type MobileGatewayConfigResult struct {
+	Err error
+}
+
+// MobileGatewayBootResult is expected values of the Boot operation
+type MobileGatewayBootResult struct {
+	Err error
+}
+
+// MobileGatewayShutdownResult is expected values of the Shutdown operation
+type MobileGatewayShutdownResult struct {
+	Err error
+}
+
+// MobileGatewayResetResult is expected values of the Reset operation
+type MobileGatewayResetResult struct {
+	Err error
+}
+
+// MobileGatewayConnectToSwitchResult is expected values of the ConnectToSwitch operation
+type MobileGatewayConnectToSwitchResult struct {
+	Err error
+}
+
+// MobileGatewayDisconnectFromSwitchResult is expected values of the DisconnectFromSwitch operation
+type MobileGatewayDisconnectFromSwitchResult struct {
+	Err error
+}
+
+// MobileGatewayGetDNSResult is expected values of the GetDNS operation
+type MobileGatewayGetDNSResult struct {
+	SIMGroup *sacloud.MobileGatewayDNSSetting
+	Err      error
+}
+
+// MobileGatewaySetDNSResult is expected values of the SetDNS operation
+type MobileGatewaySetDNSResult struct {
+	Err error
+}
+
+// MobileGatewayListSIMResult is expected values of the ListSIM operation
+type MobileGatewayListSIMResult struct {
+	SIM []*sacloud.MobileGatewaySIMInfo
+	Err error
+}
+
+// MobileGatewayAddSIMResult is expected values of the AddSIM operation
+type MobileGatewayAddSIMResult struct {
+	Err error
+}
+
+// MobileGatewayDeleteSIMResult is expected values of the DeleteSIM operation
+type MobileGatewayDeleteSIMResult struct {
+	Err error
+}
+
+// MobileGatewayGetSIMRoutesResult is expected values of the GetSIMRoutes operation
+type MobileGatewayGetSIMRoutesResult struct {
+	SIMRoutes []*sacloud.MobileGatewaySIMRoute
+	Err       error
+}
+
+// MobileGatewaySetSIMRoutesResult is expected values of the SetSIMRoutes operation
+type MobileGatewaySetSIMRoutesResult struct {
+	Err error
+}
+
+// MobileGatewayGetTrafficConfigResult is expected values of the GetTrafficConfig operation
+type MobileGatewayGetTrafficConfigResult struct {
+	TrafficMonitoring *sacloud.MobileGatewayTrafficControl
+	Err               error
+}
+
+// MobileGatewaySetTrafficConfigResult is expected values of the SetTrafficConfig operation
+type MobileGatewaySetTrafficConfigResult struct {
+	Err error
+}
+
+// MobileGatewayDeleteTrafficConfigResult is expected values of the DeleteTrafficConfig operation
+type MobileGatewayDeleteTrafficConfigResult struct {
+	Err error
+}
+
+// MobileGatewayTrafficStatusResult is expected values of the TrafficStatus operation
+type MobileGatewayTrafficStatusResult struct {
+	TrafficStatus *sacloud.MobileGatewayTrafficStatus
+	Err           error
+}
+
+// MobileGatewayMonitorInterfaceResult is expected values of the MonitorInterface operation
+type MobileGatewayMonitorInterfaceResult struct {
+	Data *sacloud.InterfaceActivity
+	Err  error
+}
+
+// MobileGatewayStub is for trace MobileGatewayOp operations
+type MobileGatewayStub struct {
+	FindResult                 *MobileGatewayFindResult
+	CreateResult               *MobileGatewayCreateResult
+	ReadResult                 *MobileGatewayReadResult
+	UpdateResult               *MobileGatewayUpdateResult
+	DeleteResult               *MobileGatewayDeleteResult
+	ConfigResult               *MobileGatewayConfigResult
+	BootResult                 *MobileGatewayBootResult
+	ShutdownResult             *MobileGatewayShutdownResult
+	ResetResult                *MobileGatewayResetResult
+	ConnectToSwitchResult      *MobileGatewayConnectToSwitchResult
+	DisconnectFromSwitchResult *MobileGatewayDisconnectFromSwitchResult
+	GetDNSResult               *MobileGatewayGetDNSResult
+	SetDNSResult               *MobileGatewaySetDNSResult
+	ListSIMResult              *MobileGatewayListSIMResult
+	AddSIMResult               *MobileGatewayAddSIMResult
+	DeleteSIMResult            *MobileGatewayDeleteSIMResult
+	GetSIMRoutesResult         *MobileGatewayGetSIMRoutesResult
+	SetSIMRoutesResult         *MobileGatewaySetSIMRoutesResult
+	GetTrafficConfigResult     *MobileGatewayGetTrafficConfigResult
+	SetTrafficConfigResult     *MobileGatewaySetTrafficConfigResult
+	DeleteTrafficConfigResult  *MobileGatewayDeleteTrafficConfigResult
+	TrafficStatusResult        *MobileGatewayTrafficStatusResult
+	MonitorInterfaceResult     *MobileGatewayMonitorInterfaceResult
+}
+
+// NewMobileGatewayStub creates new MobileGatewayStub instance
+func NewMobileGatewayStub(caller sacloud.APICaller) sacloud.MobileGatewayAPI {
+	return &MobileGatewayStub{}
+}
+
+// Find is API call with trace log
+func (s *MobileGatewayStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.MobileGateway, error) {
+	if s.FindResult == nil {
+		log.Fatal("MobileGatewayStub.FindResult is not set")
+	}
+	return s.FindResult.Appliances, s.FindResult.Err
+}
+
+// Create is API call with trace log
+func (s *MobileGatewayStub) Create(ctx context.Context, zone string, param *sacloud.MobileGatewayCreateRequest) (*sacloud.MobileGateway, error) {
+	if s.CreateResult == nil {
+		log.Fatal("MobileGatewayStub.CreateResult is not set")
+	}
+	return s.CreateResult.Appliance, s.CreateResult.Err
+}
+
+// Read is API call with trace log
+func (s *MobileGatewayStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGateway, error) {
+	if s.ReadResult == nil {
+		log.Fatal("MobileGatewayStub.ReadResult is not set")
+	}
+	return s.ReadResult.Appliance, s.ReadResult.Err
+}
+
+// Update is API call with trace log
+func (s *MobileGatewayStub) Update(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayUpdateRequest) (*sacloud.MobileGateway, error) {
 	if s.UpdateResult == nil {
-		log.Fatal("LoadBalancerStub.UpdateResult is not set")
+		log.Fatal("MobileGatewayStub.UpdateResult is not set")
 	}
 	return s.UpdateResult.Appliance, s.UpdateResult.Err
 }
 
 // Delete is API call with trace log
-func (s *LoadBalancerStub) Delete(ctx context.Context, zone string, id types.ID) error {
+func (s *MobileGatewayStub) Delete(ctx context.Context, zone string, id types.ID) error {
 	if s.DeleteResult == nil {
-		log.Fatal("LoadBalancerStub.DeleteResult is not set")
+		log.Fatal("MobileGatewayStub.DeleteResult is not set")
 	}
 	return s.DeleteResult.Err
 }
 
 // Config is API call with trace log
-func (s *LoadBalancerStub) Config(ctx context.Context, zone string, id types.ID) error {
+func (s *MobileGatewayStub) Config(ctx context.Context, zone string, id types.ID) error {
 	if s.ConfigResult == nil {
-		log.Fatal("LoadBalancerStub.ConfigResult is not set")
+		log.Fatal("MobileGatewayStub.ConfigResult is not set")
 	}
 	return s.ConfigResult.Err
 }
 
 // Boot is API call with trace log
-func (s *LoadBalancerStub) Boot(ctx context.Context, zone string, id types.ID) error {
+func (s *MobileGatewayStub) Boot(ctx context.Context, zone string, id types.ID) error {
 	if s.BootResult == nil {
-		log.Fatal("LoadBalancerStub.BootResult is not set")
+		log.Fatal("MobileGatewayStub.BootResult is not set")
 	}
 	return s.BootResult.Err
 }
 
 // Shutdown is API call with trace log
-func (s *LoadBalancerStub) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
+func (s *MobileGatewayStub) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
 	if s.ShutdownResult == nil {
-		log.Fatal("LoadBalancerStub.ShutdownResult is not set")
+		log.Fatal("MobileGatewayStub.ShutdownResult is not set")
 	}
 	return s.ShutdownResult.Err
 }
 
 // Reset is API call with trace log
-func (s *LoadBalancerStub) Reset(ctx context.Context, zone string, id types.ID) error {
+func (s *MobileGatewayStub) Reset(ctx context.Context, zone string, id types.ID) error {
 	if s.ResetResult == nil {
-		log.Fatal("LoadBalancerStub.ResetResult is not set")
+		log.Fatal("MobileGatewayStub.ResetResult is not set")
 	}
 	return s.ResetResult.Err
 }
 
-// MonitorInterface is API call with trace log
-func (s *LoadBalancerStub) MonitorInterface(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
-	if s.MonitorInterfaceResult == nil {
-		log.Fatal("LoadBalancerStub.MonitorInterfaceResult is not set")
+// ConnectToSwitch is API call with trace log
+func (s *MobileGatewayStub) ConnectToSwitch(ctx context.Context, zone string, id types.ID, switchID types.ID) error {
+	if s.ConnectToSwitchResult == nil {
+		log.Fatal("MobileGatewayStub.ConnectToSwitchResult is not set")
 	}
-	return s.MonitorInterfaceResult.Data, s.MonitorInterfaceResult.Err
+	return s.ConnectToSwitchResult.Err
 }
 
-// Status is API call with trace log
-func (s *LoadBalancerStub) Status(ctx context.Context, zone string, id types.ID) ([]*sacloud.LoadBalancerStatus, error) {
-	if s.StatusResult == nil {
-		log.Fatal("LoadBalancerStub.StatusResult is not set")
+// DisconnectFromSwitch is API call with trace log
+func (s *MobileGatewayStub) DisconnectFromSwitch(ctx context.Context, zone string, id types.ID) error {
+	if s.DisconnectFromSwitchResult == nil {
+		log.Fatal("MobileGatewayStub.DisconnectFromSwitchResult is not set")
 	}
-	return s.StatusResult.LoadBalancer, s.StatusResult.Err
+	return s.DisconnectFromSwitchResult.Err
+}
+
+// GetDNS is API call with trace log
+func (s *MobileGatewayStub) GetDNS(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGatewayDNSSetting, error) {
+	if s.GetDNSResult == nil {
+		log.Fatal("MobileGatewayStub.GetDNSResult is not set")
+	}
+	return s.GetDNSResult.SIMGroup, s.GetDNSResult.Err
+}
+
+// SetDNS is API call with trace log
+func (s *MobileGatewayStub) SetDNS(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayDNSSetting) error {
+	if s.SetDNSResult == nil {
+		log.Fatal("MobileGatewayStub.SetDNSResult is not set")
+	}
+	return s.SetDNSResult.Err
+}
+
+// ListSIM is API call with trace log
+func (s *MobileGatewayStub) ListSIM(ctx context.Context, zone string, id types.ID) ([]*sacloud.MobileGatewaySIMInfo, error) {
+	if s.ListSIMResult == nil {
+		log.Fatal("MobileGatewayStub.ListSIMResult is not set")
+	}
+	return s.ListSIMResult.SIM, s.ListSIMResult.Err
+}
+
+// AddSIM is API call with trace log
+func (s *MobileGatewayStub) AddSIM(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayAddSIMRequest) error {
+	if s.AddSIMResult == nil {
+		log.Fatal("MobileGatewayStub.AddSIMResult is not set")
+	}
+	return s.AddSIMResult.Err
+}
+
+// DeleteSIM is API call with trace log
+func (s *MobileGatewayStub) DeleteSIM(ctx context.Context, zone string, id types.ID, simID types.ID) error {
+	if s.DeleteSIMResult == nil {
+		log.Fatal("MobileGatewayStub.DeleteSIMResult is not set")
+	}
+	return s.DeleteSIMResult.Err
+}
+
+// GetSIMRoutes is API call with trace log
+func (s *MobileGatewayStub) GetSIMRoutes(ctx context.Context, zone string, id types.ID) ([]*sacloud.MobileGatewaySIMRoute, error) {
+	if s.GetSIMRoutesResult == nil {
+		log.Fatal("MobileGatewayStub.GetSIMRoutesResult is not set")
+	}
+	return s.GetSIMRoutesResult.SIMRoutes, s.GetSIMRoutesResult.Err
+}
+
+// SetSIMRoutes is API call with trace log
+func (s *MobileGatewayStub) SetSIMRoutes(ctx context.Context, zone string, id types.ID, routes []*sacloud.MobileGatewaySIMRoute) error {
+	if s.SetSIMRoutesResult == nil {
+		log.Fatal("MobileGatewayStub.SetSIMRoutesResult is not set")
+	}
+	return s.SetSIMRoutesResult.Err
+}
+
+// GetTrafficConfig is API call with trace log
+func (s *MobileGatewayStub) GetTrafficConfig(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGatewayTrafficControl, error) {
+	if s.GetTrafficConfigResult == nil {
+		log.Fatal("MobileGatewayStub.GetTrafficConfigResult is not set")
+	}
+	return s.GetTrafficConfigResult.TrafficMonitoring, s.GetTrafficConfigResult.Err
+}
+
+// SetTrafficConfig is API call with trace log
+func (s *MobileGatewayStub) SetTrafficConfig(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayTrafficControl) error {
+	if s.SetTrafficConfigResult == nil {
+		log.Fatal("MobileGatewayStub.SetTrafficConfigResult is not set")
+	}
+	return s.SetTrafficConfigResult.Err
+}
+
+// DeleteTrafficConfig is API call with trace log
+func (s *MobileGatewayStub) DeleteTrafficConfig(ctx context.Context, zone string, id types.ID) error {
+	if s.DeleteTrafficConfigResult == nil {
+		log.Fatal("MobileGatewayStub.DeleteTrafficConfigResult is not set")
+	}
+	return s.DeleteTrafficConfigResult.Err
+}
+
+// TrafficStatus is API call with trace log
+func (s *MobileGatewayStub) TrafficStatus(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGatewayTrafficStatus, error) {
+	if s.TrafficStatusResult == nil {
+		log.Fatal("MobileGatewayStub.TrafficStatusResult is not set")
+	}
+	return s.TrafficStatusResult.TrafficStatus, s.TrafficStatusResult.Err
+}
+
+// MonitorInterface is API call with trace log
+func (s *MobileGatewayStub) MonitorInterface(ctx context.Context, zone string, id types.ID, index int, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
+	if s.MonitorInterfaceResult == nil {
+		log.Fatal("MobileGatewayStub.MonitorInterfaceResult is not set")
+	}
+	return s.MonitorInterfaceResult.Data, s.MonitorInterfaceResult.Err
 }
 
 /*************************************************
@@ -1487,36 +3020,384 @@ func (s *PacketFilterStub) Find(ctx context.Context, zone string, conditions *sa
 	return s.FindResult.PacketFilters, s.FindResult.Err
 }
 
-// Create is API call with trace log
-func (s *PacketFilterStub) Create(ctx context.Context, zone string, param *sacloud.PacketFilterCreateRequest) (*sacloud.PacketFilter, error) {
-	if s.CreateResult == nil {
-		log.Fatal("PacketFilterStub.CreateResult is not set")
-	}
-	return s.CreateResult.PacketFilter, s.CreateResult.Err
+// Create is API call with trace log
+func (s *PacketFilterStub) Create(ctx context.Context, zone string, param *sacloud.PacketFilterCreateRequest) (*sacloud.PacketFilter, error) {
+	if s.CreateResult == nil {
+		log.Fatal("PacketFilterStub.CreateResult is not set")
+	}
+	return s.CreateResult.PacketFilter, s.CreateResult.Err
+}
+
+// Read is API call with trace log
+func (s *PacketFilterStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.PacketFilter, error) {
+	if s.ReadResult == nil {
+		log.Fatal("PacketFilterStub.ReadResult is not set")
+	}
+	return s.ReadResult.PacketFilter, s.ReadResult.Err
+}
+
+// Update is API call with trace log
+func (s *PacketFilterStub) Update(ctx context.Context, zone string, id types.ID, param *sacloud.PacketFilterUpdateRequest) (*sacloud.PacketFilter, error) {
+	if s.UpdateResult == nil {
+		log.Fatal("PacketFilterStub.UpdateResult is not set")
+	}
+	return s.UpdateResult.PacketFilter, s.UpdateResult.Err
+}
+
+// Delete is API call with trace log
+func (s *PacketFilterStub) Delete(ctx context.Context, zone string, id types.ID) error {
+	if s.DeleteResult == nil {
+		log.Fatal("PacketFilterStub.DeleteResult is not set")
+	}
+	return s.DeleteResult.Err
+}
+
+/*************************************************
+* PrivateHostStub
+*************************************************/
+
+// PrivateHostFindResult is expected values of the Find operation
+type PrivateHostFindResult struct {
+	PrivateHosts []*sacloud.PrivateHost
+	Err          error
+}
+
+// PrivateHostCreateResult is expected values of the Create operation
+type PrivateHostCreateResult struct {
+	PrivateHost *sacloud.PrivateHost
+	Err         error
+}
+
+// PrivateHostReadResult is expected values of the Read operation
+type PrivateHostReadResult struct {
+	PrivateHost *sacloud.PrivateHost
+	Err         error
+}
+
+// PrivateHostUpdateResult is expected values of the Update operation
+type PrivateHostUpdateResult struct {
+	PrivateHost *sacloud.PrivateHost
+	Err         error
+}
+
+// PrivateHostDeleteResult is expected values of the Delete operation
+type PrivateHostDeleteResult struct {
+	Err error
+}
+
+// PrivateHostStub is for trace PrivateHostOp operations
+type PrivateHostStub struct {
+	FindResult   *PrivateHostFindResult
+	CreateResult *PrivateHostCreateResult
+	ReadResult   *PrivateHostReadResult
+	UpdateResult *PrivateHostUpdateResult
+	DeleteResult *PrivateHostDeleteResult
+}
+
+// NewPrivateHostStub creates new PrivateHostStub instance
+func NewPrivateHostStub(caller sacloud.APICaller) sacloud.PrivateHostAPI {
+	return &PrivateHostStub{}
+}
+
+// Find is API call with trace log
+func (s *PrivateHostStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.PrivateHost, error) {
+	if s.FindResult == nil {
+		log.Fatal("PrivateHostStub.FindResult is not set")
+	}
+	return s.FindResult.PrivateHosts, s.FindResult.Err
+}
+
+// Create is API call with trace log
+func (s *PrivateHostStub) Create(ctx context.Context, zone string, param *sacloud.PrivateHostCreateRequest) (*sacloud.PrivateHost, error) {
+	if s.CreateResult == nil {
+		log.Fatal("PrivateHostStub.CreateResult is not set")
+	}
+	return s.CreateResult.PrivateHost, s.CreateResult.Err
+}
+
+// Read is API call with trace log
+func (s *PrivateHostStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.PrivateHost, error) {
+	if s.ReadResult == nil {
+		log.Fatal("PrivateHostStub.ReadResult is not set")
+	}
+	return s.ReadResult.PrivateHost, s.ReadResult.Err
+}
+
+// Update is API call with trace log
+func (s *PrivateHostStub) Update(ctx context.Context, zone string, id types.ID, param *sacloud.PrivateHostUpdateRequest) (*sacloud.PrivateHost, error) {
+	if s.UpdateResult == nil {
+		log.Fatal("PrivateHostStub.UpdateResult is not set")
+	}
+	return s.UpdateResult.PrivateHost, s.UpdateResult.Err
+}
+
+// Delete is API call with trace log
+func (s *PrivateHostStub) Delete(ctx context.Context, zone string, id types.ID) error {
+	if s.DeleteResult == nil {
+		log.Fatal("PrivateHostStub.DeleteResult is not set")
+	}
+	return s.DeleteResult.Err
+}
+
+/*************************************************
+* PrivateHostPlanStub
+*************************************************/
+
+// PrivateHostPlanFindResult is expected values of the Find operation
+type PrivateHostPlanFindResult struct {
+	PrivateHostPlans []*sacloud.PrivateHostPlan
+	Err              error
+}
+
+// PrivateHostPlanReadResult is expected values of the Read operation
+type PrivateHostPlanReadResult struct {
+	PrivateHostPlan *sacloud.PrivateHostPlan
+	Err             error
+}
+
+// PrivateHostPlanStub is for trace PrivateHostPlanOp operations
+type PrivateHostPlanStub struct {
+	FindResult *PrivateHostPlanFindResult
+	ReadResult *PrivateHostPlanReadResult
+}
+
+// NewPrivateHostPlanStub creates new PrivateHostPlanStub instance
+func NewPrivateHostPlanStub(caller sacloud.APICaller) sacloud.PrivateHostPlanAPI {
+	return &PrivateHostPlanStub{}
+}
+
+// Find is API call with trace log
+func (s *PrivateHostPlanStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.PrivateHostPlan, error) {
+	if s.FindResult == nil {
+		log.Fatal("PrivateHostPlanStub.FindResult is not set")
+	}
+	return s.FindResult.PrivateHostPlans, s.FindResult.Err
+}
+
+// Read is API call with trace log
+func (s *PrivateHostPlanStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.PrivateHostPlan, error) {
+	if s.ReadResult == nil {
+		log.Fatal("PrivateHostPlanStub.ReadResult is not set")
+	}
+	return s.ReadResult.PrivateHostPlan, s.ReadResult.Err
+}
+
+/*************************************************
+* ProxyLBStub
+*************************************************/
+
+// ProxyLBFindResult is expected values of the Find operation
+type ProxyLBFindResult struct {
+	CommonServiceItems []*sacloud.ProxyLB
+	Err                error
+}
+
+// ProxyLBCreateResult is expected values of the Create operation
+type ProxyLBCreateResult struct {
+	CommonServiceItem *sacloud.ProxyLB
+	Err               error
+}
+
+// ProxyLBReadResult is expected values of the Read operation
+type ProxyLBReadResult struct {
+	CommonServiceItem *sacloud.ProxyLB
+	Err               error
+}
+
+// ProxyLBUpdateResult is expected values of the Update operation
+type ProxyLBUpdateResult struct {
+	CommonServiceItem *sacloud.ProxyLB
+	Err               error
+}
+
+// ProxyLBDeleteResult is expected values of the Delete operation
+type ProxyLBDeleteResult struct {
+	Err error
+}
+
+// ProxyLBGetCertificatesResult is expected values of the GetCertificates operation
+type ProxyLBGetCertificatesResult struct {
+	ProxyLB *sacloud.ProxyLBCertificates
+	Err     error
+}
+
+// ProxyLBSetCertificatesResult is expected values of the SetCertificates operation
+type ProxyLBSetCertificatesResult struct {
+	ProxyLB *sacloud.ProxyLBCertificates
+	Err     error
+}
+
+// ProxyLBDeleteCertificatesResult is expected values of the DeleteCertificates operation
+type ProxyLBDeleteCertificatesResult struct {
+	Err error
+}
+
+// ProxyLBRenewLetsEncryptCertResult is expected values of the RenewLetsEncryptCert operation
+type ProxyLBRenewLetsEncryptCertResult struct {
+	Err error
+}
+
+// ProxyLBHealthStatusResult is expected values of the HealthStatus operation
+type ProxyLBHealthStatusResult struct {
+	ProxyLB *sacloud.ProxyLBHealth
+	Err     error
+}
+
+// ProxyLBMonitorConnectionResult is expected values of the MonitorConnection operation
+type ProxyLBMonitorConnectionResult struct {
+	Data *sacloud.ConnectionActivity
+	Err  error
+}
+
+// ProxyLBStub is for trace ProxyLBOp operations
+type ProxyLBStub struct {
+	FindResult                 *ProxyLBFindResult
+	CreateResult               *ProxyLBCreateResult
+	ReadResult                 *ProxyLBReadResult
+	UpdateResult               *ProxyLBUpdateResult
+	DeleteResult               *ProxyLBDeleteResult
+	GetCertificatesResult      *ProxyLBGetCertificatesResult
+	SetCertificatesResult      *ProxyLBSetCertificatesResult
+	DeleteCertificatesResult   *ProxyLBDeleteCertificatesResult
+	RenewLetsEncryptCertResult *ProxyLBRenewLetsEncryptCertResult
+	HealthStatusResult         *ProxyLBHealthStatusResult
+	MonitorConnectionResult    *ProxyLBMonitorConnectionResult
+}
+
+// NewProxyLBStub creates new ProxyLBStub instance
+func NewProxyLBStub(caller sacloud.APICaller) sacloud.ProxyLBAPI {
+	return &ProxyLBStub{}
+}
+
+// Find is API call with trace log
+func (s *ProxyLBStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.ProxyLB, error) {
+	if s.FindResult == nil {
+		log.Fatal("ProxyLBStub.FindResult is not set")
+	}
+	return s.FindResult.CommonServiceItems, s.FindResult.Err
+}
+
+// Create is API call with trace log
+func (s *ProxyLBStub) Create(ctx context.Context, zone string, param *sacloud.ProxyLBCreateRequest) (*sacloud.ProxyLB, error) {
+	if s.CreateResult == nil {
+		log.Fatal("ProxyLBStub.CreateResult is not set")
+	}
+	return s.CreateResult.CommonServiceItem, s.CreateResult.Err
+}
+
+// Read is API call with trace log
+func (s *ProxyLBStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.ProxyLB, error) {
+	if s.ReadResult == nil {
+		log.Fatal("ProxyLBStub.ReadResult is not set")
+	}
+	return s.ReadResult.CommonServiceItem, s.ReadResult.Err
+}
+
+// Update is API call with trace log
+func (s *ProxyLBStub) Update(ctx context.Context, zone string, id types.ID, param *sacloud.ProxyLBUpdateRequest) (*sacloud.ProxyLB, error) {
+	if s.UpdateResult == nil {
+		log.Fatal("ProxyLBStub.UpdateResult is not set")
+	}
+	return s.UpdateResult.CommonServiceItem, s.UpdateResult.Err
+}
+
+// Delete is API call with trace log
+func (s *ProxyLBStub) Delete(ctx context.Context, zone string, id types.ID) error {
+	if s.DeleteResult == nil {
+		log.Fatal("ProxyLBStub.DeleteResult is not set")
+	}
+	return s.DeleteResult.Err
+}
+
+// GetCertificates is API call with trace log
+func (s *ProxyLBStub) GetCertificates(ctx context.Context, zone string, id types.ID) (*sacloud.ProxyLBCertificates, error) {
+	if s.GetCertificatesResult == nil {
+		log.Fatal("ProxyLBStub.GetCertificatesResult is not set")
+	}
+	return s.GetCertificatesResult.ProxyLB, s.GetCertificatesResult.Err
+}
+
+// SetCertificates is API call with trace log
+func (s *ProxyLBStub) SetCertificates(ctx context.Context, zone string, id types.ID, param *sacloud.ProxyLBSetCertificatesRequest) (*sacloud.ProxyLBCertificates, error) {
+	if s.SetCertificatesResult == nil {
+		log.Fatal("ProxyLBStub.SetCertificatesResult is not set")
+	}
+	return s.SetCertificatesResult.ProxyLB, s.SetCertificatesResult.Err
+}
+
+// DeleteCertificates is API call with trace log
+func (s *ProxyLBStub) DeleteCertificates(ctx context.Context, zone string, id types.ID) error {
+	if s.DeleteCertificatesResult == nil {
+		log.Fatal("ProxyLBStub.DeleteCertificatesResult is not set")
+	}
+	return s.DeleteCertificatesResult.Err
+}
+
+// RenewLetsEncryptCert is API call with trace log
+func (s *ProxyLBStub) RenewLetsEncryptCert(ctx context.Context, zone string, id types.ID) error {
+	if s.RenewLetsEncryptCertResult == nil {
+		log.Fatal("ProxyLBStub.RenewLetsEncryptCertResult is not set")
+	}
+	return s.RenewLetsEncryptCertResult.Err
+}
+
+// HealthStatus is API call with trace log
+func (s *ProxyLBStub) HealthStatus(ctx context.Context, zone string, id types.ID) (*sacloud.ProxyLBHealth, error) {
+	if s.HealthStatusResult == nil {
+		log.Fatal("ProxyLBStub.HealthStatusResult is not set")
+	}
+	return s.HealthStatusResult.ProxyLB, s.HealthStatusResult.Err
+}
+
+// MonitorConnection is API call with trace log
+func (s *ProxyLBStub) MonitorConnection(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.ConnectionActivity, error) {
+	if s.MonitorConnectionResult == nil {
+		log.Fatal("ProxyLBStub.MonitorConnectionResult is not set")
+	}
+	return s.MonitorConnectionResult.Data, s.MonitorConnectionResult.Err
+}
+
+/*************************************************
+* RegionStub
+*************************************************/
+
+// RegionFindResult is expected values of the Find operation
+type RegionFindResult struct {
+	Regions []*sacloud.Region
+	Err     error
+}
+
+// RegionReadResult is expected values of the Read operation
+type RegionReadResult struct {
+	Region *sacloud.Region
+	Err    error
 }
 
-// Read is API call with trace log
-func (s *PacketFilterStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.PacketFilter, error) {
-	if s.ReadResult == nil {
-		log.Fatal("PacketFilterStub.ReadResult is not set")
-	}
-	return s.ReadResult.PacketFilter, s.ReadResult.Err
+// RegionStub is for trace RegionOp operations
+type RegionStub struct {
+	FindResult *RegionFindResult
+	ReadResult *RegionReadResult
 }
 
-// Update is API call with trace log
-func (s *PacketFilterStub) Update(ctx context.Context, zone string, id types.ID, param *sacloud.PacketFilterUpdateRequest) (*sacloud.PacketFilter, error) {
-	if s.UpdateResult == nil {
-		log.Fatal("PacketFilterStub.UpdateResult is not set")
+// NewRegionStub creates new RegionStub instance
+func NewRegionStub(caller sacloud.APICaller) sacloud.RegionAPI {
+	return &RegionStub{}
+}
+
+// Find is API call with trace log
+func (s *RegionStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Region, error) {
+	if s.FindResult == nil {
+		log.Fatal("RegionStub.FindResult is not set")
 	}
-	return s.UpdateResult.PacketFilter, s.UpdateResult.Err
+	return s.FindResult.Regions, s.FindResult.Err
 }
 
-// Delete is API call with trace log
-func (s *PacketFilterStub) Delete(ctx context.Context, zone string, id types.ID) error {
-	if s.DeleteResult == nil {
-		log.Fatal("PacketFilterStub.DeleteResult is not set")
+// Read is API call with trace log
+func (s *RegionStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Region, error) {
+	if s.ReadResult == nil {
+		log.Fatal("RegionStub.ReadResult is not set")
 	}
-	return s.DeleteResult.Err
+	return s.ReadResult.Region, s.ReadResult.Err
 }
 
 /*************************************************
@@ -1589,20 +3470,46 @@ type ServerMonitorResult struct {
 	Err  error
 }
 
+// ServerGetVNCProxyResult is expected values of the GetVNCProxy operation
+type ServerGetVNCProxyResult struct {
+	VNCProxyInfo *sacloud.VNCProxyInfo
+	Err          error
+}
+
+// ServerSendKeyResult is expected values of the SendKey operation
+type ServerSendKeyResult struct {
+	Err error
+}
+
+// ServerSendNMIResult is expected values of the SendNMI operation
+type ServerSendNMIResult struct {
+	Err error
+}
+
+// ServerGetScreenshotResult is expected values of the GetScreenshot operation
+type ServerGetScreenshotResult struct {
+	VNCSnapshotInfo *sacloud.VNCSnapshotInfo
+	Err             error
+}
+
 // ServerStub is for trace ServerOp operations
 type ServerStub struct {
-	FindResult        *ServerFindResult
-	CreateResult      *ServerCreateResult
-	ReadResult        *ServerReadResult
-	UpdateResult      *ServerUpdateResult
-	DeleteResult      *ServerDeleteResult
-	ChangePlanResult  *ServerChangePlanResult
-	InsertCDROMResult *ServerInsertCDROMResult
-	EjectCDROMResult  *ServerEjectCDROMResult
-	BootResult        *ServerBootResult
-	ShutdownResult    *ServerShutdownResult
-	ResetResult       *ServerResetResult
-	MonitorResult     *ServerMonitorResult
+	FindResult          *ServerFindResult
+	CreateResult        *ServerCreateResult
+	ReadResult          *ServerReadResult
+	UpdateResult        *ServerUpdateResult
+	DeleteResult        *ServerDeleteResult
+	ChangePlanResult    *ServerChangePlanResult
+	InsertCDROMResult   *ServerInsertCDROMResult
+	EjectCDROMResult    *ServerEjectCDROMResult
+	BootResult          *ServerBootResult
+	ShutdownResult      *ServerShutdownResult
+	ResetResult         *ServerResetResult
+	MonitorResult       *ServerMonitorResult
+	GetVNCProxyResult   *ServerGetVNCProxyResult
+	SendKeyResult       *ServerSendKeyResult
+	SendNMIResult       *ServerSendNMIResult
+	GetScreenshotResult *ServerGetScreenshotResult
 }
 
 // NewServerStub creates new ServerStub instance
@@ -1706,6 +3613,109 @@ func (s *ServerStub) Monitor(ctx context.Context, zone string, id types.ID, cond
 	return s.MonitorResult.Data, s.MonitorResult.Err
 }
 
+// GetVNCProxy is API call with trace log
+func (s *ServerStub) GetVNCProxy(ctx context.Context, zone string, id types.ID) (*sacloud.VNCProxyInfo, error) {
+	if s.GetVNCProxyResult == nil {
+		log.Fatal("ServerStub.GetVNCProxyResult is not set")
+	}
+	return s.GetVNCProxyResult.VNCProxyInfo, s.GetVNCProxyResult.Err
+}
+
+// SendKey is API call with trace log
+func (s *ServerStub) SendKey(ctx context.Context, zone string, id types.ID, keyboardParam *sacloud.SendKeyRequest) error {
+	if s.SendKeyResult == nil {
+		log.Fatal("ServerStub.SendKeyResult is not set")
+	}
+	return s.SendKeyResult.Err
+}
+
+// SendNMI is API call with trace log
+func (s *ServerStub) SendNMI(ctx context.Context, zone string, id types.ID) error {
+	if s.SendNMIResult == nil {
+		log.Fatal("ServerStub.SendNMIResult is not set")
+	}
+	return s.SendNMIResult.Err
+}
+
+// GetScreenshot is API call with trace log
+func (s *ServerStub) GetScreenshot(ctx context.Context, zone string, id types.ID, condition *sacloud.GetScreenshotRequest) (*sacloud.VNCSnapshotInfo, error) {
+	if s.GetScreenshotResult == nil {
+		log.Fatal("ServerStub.GetScreenshotResult is not set")
+	}
+	return s.GetScreenshotResult.VNCSnapshotInfo, s.GetScreenshotResult.Err
+}
+
+/*************************************************
+* ServerPlanStub
+*************************************************/
+
+// ServerPlanFindResult is expected values of the Find operation
+type ServerPlanFindResult struct {
+	ServerPlans []*sacloud.ServerPlan
+	Err         error
+}
+
+// ServerPlanReadResult is expected values of the Read operation
+type ServerPlanReadResult struct {
+	ServerPlan *sacloud.ServerPlan
+	Err        error
+}
+
+// ServerPlanStub is for trace ServerPlanOp operations
+type ServerPlanStub struct {
+	FindResult *ServerPlanFindResult
+	ReadResult *ServerPlanReadResult
+}
+
+// NewServerPlanStub creates new ServerPlanStub instance
+func NewServerPlanStub(caller sacloud.APICaller) sacloud.ServerPlanAPI {
+	return &ServerPlanStub{}
+}
+
+// Find is API call with trace log
+func (s *ServerPlanStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.ServerPlan, error) {
+	if s.FindResult == nil {
+		log.Fatal("ServerPlanStub.FindResult is not set")
+	}
+	return s.FindResult.ServerPlans, s.FindResult.Err
+}
+
+// Read is API call with trace log
+func (s *ServerPlanStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.ServerPlan, error) {
+	if s.ReadResult == nil {
+		log.Fatal("ServerPlanStub.ReadResult is not set")
+	}
+	return s.ReadResult.ServerPlan, s.ReadResult.Err
+}
+
+/*************************************************
+* ServiceClassStub
+*************************************************/
+
+// ServiceClassFindResult is expected values of the Find operation
+type ServiceClassFindResult struct {
+	ServiceClasss []*sacloud.ServiceClass
+	Err           error
+}
+
+// ServiceClassStub is for trace ServiceClassOp operations
+type ServiceClassStub struct {
+	FindResult *ServiceClassFindResult
+}
+
+// NewServiceClassStub creates new ServiceClassStub instance
+func NewServiceClassStub(caller sacloud.APICaller) sacloud.ServiceClassAPI {
+	return &ServiceClassStub{}
+}
+
+// Find is API call with trace log
+func (s *ServiceClassStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.ServiceClass, error) {
+	if s.FindResult == nil {
+		log.Fatal("ServiceClassStub.FindResult is not set")
+	}
+	return s.FindResult.ServiceClasss, s.FindResult.Err
+}
+
 /*************************************************
 * SIMStub
 *************************************************/
@@ -1936,6 +3946,311 @@ func (s *SIMStub) MonitorSIM(ctx context.Context, zone string, id types.ID, cond
 	return s.MonitorSIMResult.Data, s.MonitorSIMResult.Err
 }
 
+/*************************************************
+* SimpleMonitorStub
+*************************************************/
+
+// SimpleMonitorFindResult is expected values of the Find operation
+type SimpleMonitorFindResult struct {
+	CommonServiceItems []*sacloud.SimpleMonitor
+	Err                error
+}
+
+// SimpleMonitorCreateResult is expected values of the Create operation
+type SimpleMonitorCreateResult struct {
+	CommonServiceItem *sacloud.SimpleMonitor
+	Err               error
+}
+
+// SimpleMonitorReadResult is expected values of the Read operation
+type SimpleMonitorReadResult struct {
+	CommonServiceItem *sacloud.SimpleMonitor
+	Err               error
+}
+
+// SimpleMonitorUpdateResult is expected values of the Update operation
+type SimpleMonitorUpdateResult struct {
+	CommonServiceItem *sacloud.SimpleMonitor
+	Err               error
+}
+
+// SimpleMonitorDeleteResult is expected values of the Delete operation
+type SimpleMonitorDeleteResult struct {
+	Err error
+}
+
+// SimpleMonitorMonitorResponseTimeResult is expected values of the MonitorResponseTime operation
+type SimpleMonitorMonitorResponseTimeResult struct {
+	Data *sacloud.ResponseTimeSecActivity
+	Err  error
+}
+
+// SimpleMonitorHealthStatusResult is expected values of the HealthStatus operation
+type SimpleMonitorHealthStatusResult struct {
+	SimpleMonitor *sacloud.SimpleMonitorHealthStatus
+	Err           error
+}
+
+// SimpleMonitorStub is for trace SimpleMonitorOp operations
+type SimpleMonitorStub struct {
+	FindResult                *SimpleMonitorFindResult
+	CreateResult              *SimpleMonitorCreateResult
+	ReadResult                *SimpleMonitorReadResult
+	UpdateResult              *SimpleMonitorUpdateResult
+	DeleteResult              *SimpleMonitorDeleteResult
+	MonitorResponseTimeResult *SimpleMonitorMonitorResponseTimeResult
+	HealthStatusResult        *SimpleMonitorHealthStatusResult
+}
+
+// NewSimpleMonitorStub creates new SimpleMonitorStub instance
+func NewSimpleMonitorStub(caller sacloud.APICaller) sacloud.SimpleMonitorAPI {
+	return &SimpleMonitorStub{}
+}
+
+// Find is API call with trace log
+func (s *SimpleMonitorStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.SimpleMonitor, error) {
+	if s.FindResult == nil {
+		log.Fatal("SimpleMonitorStub.FindResult is not set")
+	}
+	return s.FindResult.CommonServiceItems, s.FindResult.Err
+}
+
+// Create is API call with trace log
+func (s *SimpleMonitorStub) Create(ctx context.Context, zone string, param *sacloud.SimpleMonitorCreateRequest) (*sacloud.SimpleMonitor, error) {
+	if s.CreateResult == nil {
+		log.Fatal("SimpleMonitorStub.CreateResult is not set")
+	}
+	return s.CreateResult.CommonServiceItem, s.CreateResult.Err
+}
+
+// Read is API call with trace log
+func (s *SimpleMonitorStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.SimpleMonitor, error) {
+	if s.ReadResult == nil {
+		log.Fatal("SimpleMonitorStub.ReadResult is not set")
+	}
+	return s.ReadResult.CommonServiceItem, s.ReadResult.Err
+}
+
+// Update is API call with trace log
+func (s *SimpleMonitorStub) Update(ctx context.Context, zone string, id types.ID, param *sacloud.SimpleMonitorUpdateRequest) (*sacloud.SimpleMonitor, error) {
+	if s.UpdateResult == nil {
+		log.Fatal("SimpleMonitorStub.UpdateResult is not set")
+	}
+	return s.UpdateResult.CommonServiceItem, s.UpdateResult.Err
+}
+
+// Delete is API call with trace log
+func (s *SimpleMonitorStub) Delete(ctx context.Context, zone string, id types.ID) error {
+	if s.DeleteResult == nil {
+		log.Fatal("SimpleMonitorStub.DeleteResult is not set")
+	}
+	return s.DeleteResult.Err
+}
+
+// MonitorResponseTime is API call with trace log
+func (s *SimpleMonitorStub) MonitorResponseTime(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.ResponseTimeSecActivity, error) {
+	if s.MonitorResponseTimeResult == nil {
+		log.Fatal("SimpleMonitorStub.MonitorResponseTimeResult is not set")
+	}
+	return s.MonitorResponseTimeResult.Data, s.MonitorResponseTimeResult.Err
+}
+
+// HealthStatus is API call with trace log
+func (s *SimpleMonitorStub) HealthStatus(ctx context.Context, zone string, id types.ID) (*sacloud.SimpleMonitorHealthStatus, error) {
+	if s.HealthStatusResult == nil {
+		log.Fatal("SimpleMonitorStub.HealthStatusResult is not set")
+	}
+	return s.HealthStatusResult.SimpleMonitor, s.HealthStatusResult.Err
+}
+
+/*************************************************
+* SSHKeyStub
+*************************************************/
+
+// SSHKeyFindResult is expected values of the Find operation
+type SSHKeyFindResult struct {
+	SSHKeys []*sacloud.SSHKey
+	Err     error
+}
+
+// SSHKeyCreateResult is expected values of the Create operation
+type SSHKeyCreateResult struct {
+	SSHKey *sacloud.SSHKey
+	Err    error
+}
+
+// SSHKeyGenerateResult is expected values of the Generate operation
+type SSHKeyGenerateResult struct {
+	SSHKey *sacloud.SSHKeyGenerated
+	Err    error
+}
+
+// SSHKeyReadResult is expected values of the Read operation
+type SSHKeyReadResult struct {
+	SSHKey *sacloud.SSHKey
+	Err    error
+}
+
+// SSHKeyUpdateResult is expected values of the Update operation
+type SSHKeyUpdateResult struct {
+	SSHKey *sacloud.SSHKey
+	Err    error
+}
+
+// SSHKeyDeleteResult is expected values of the Delete operation
+type SSHKeyDeleteResult struct {
+	Err error
+}
+
+// SSHKeyStub is for trace SSHKeyOp operations
+type SSHKeyStub struct {
+	FindResult     *SSHKeyFindResult
+	CreateResult   *SSHKeyCreateResult
+	GenerateResult *SSHKeyGenerateResult
+	ReadResult     *SSHKeyReadResult
+	UpdateResult   *SSHKeyUpdateResult
+	DeleteResult   *SSHKeyDeleteResult
+}
+
+// NewSSHKeyStub creates new SSHKeyStub instance
+func NewSSHKeyStub(caller sacloud.APICaller) sacloud.SSHKeyAPI {
+	return &SSHKeyStub{}
+}
+
+// Find is API call with trace log
+func (s *SSHKeyStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.SSHKey, error) {
+	if s.FindResult == nil {
+		log.Fatal("SSHKeyStub.FindResult is not set")
+	}
+	return s.FindResult.SSHKeys, s.FindResult.Err
+}
+
+// Create is API call with trace log
+func (s *SSHKeyStub) Create(ctx context.Context, zone string, param *sacloud.SSHKeyCreateRequest) (*sacloud.SSHKey, error) {
+	if s.CreateResult == nil {
+		log.Fatal("SSHKeyStub.CreateResult is not set")
+	}
+	return s.CreateResult.SSHKey, s.CreateResult.Err
+}
+
+// Generate is API call with trace log
+func (s *SSHKeyStub) Generate(ctx context.Context, zone string, param *sacloud.SSHKeyGenerateRequest) (*sacloud.SSHKeyGenerated, error) {
+	if s.GenerateResult == nil {
+		log.Fatal("SSHKeyStub.GenerateResult is not set")
+	}
+	return s.GenerateResult.SSHKey, s.GenerateResult.Err
+}
+
+// Read is API call with trace log
+func (s *SSHKeyStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.SSHKey, error) {
+	if s.ReadResult == nil {
+		log.Fatal("SSHKeyStub.ReadResult is not set")
+	}
+	return s.ReadResult.SSHKey, s.ReadResult.Err
+}
+
+// Update is API call with trace log
+func (s *SSHKeyStub) Update(ctx context.Context, zone string, id types.ID, param *sacloud.SSHKeyUpdateRequest) (*sacloud.SSHKey, error) {
+	if s.UpdateResult == nil {
+		log.Fatal("SSHKeyStub.UpdateResult is not set")
+	}
+	return s.UpdateResult.SSHKey, s.UpdateResult.Err
+}
+
+// Delete is API call with trace log
+func (s *SSHKeyStub) Delete(ctx context.Context, zone string, id types.ID) error {
+	if s.DeleteResult == nil {
+		log.Fatal("SSHKeyStub.DeleteResult is not set")
+	}
+	return s.DeleteResult.Err
+}
+
+/*************************************************
+* StorageStub
+*************************************************/
+
+// StorageFindResult is expected values of the Find operation
+type StorageFindResult struct {
+	Storages []*sacloud.Storage
+	Err      error
+}
+
+// StorageReadResult is expected values of the Read operation
+type StorageReadResult struct {
+	Storage *sacloud.Storage
+	Err     error
+}
+
+// StorageStub is for trace StorageOp operations
+type StorageStub struct {
+	FindResult *StorageFindResult
+	ReadResult *StorageReadResult
+}
+
+// NewStorageStub creates new StorageStub instance
+func NewStorageStub(caller sacloud.APICaller) sacloud.StorageAPI {
+	return &StorageStub{}
+}
+
+// Find is API call with trace log
+func (s *StorageStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Storage, error) {
+	if s.FindResult == nil {
+		log.Fatal("StorageStub.FindResult is not set")
+	}
+	return s.FindResult.Storages, s.FindResult.Err
+}
+
+// Read is API call with trace log
+func (s *StorageStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Storage, error) {
+	if s.ReadResult == nil {
+		log.Fatal("StorageStub.ReadResult is not set")
+	}
+	return s.ReadResult.Storage, s.ReadResult.Err
+}
+
+/*************************************************
+* SubnetStub
+*************************************************/
+
+// SubnetFindResult is expected values of the Find operation
+type SubnetFindResult struct {
+	Subnets []*sacloud.Subnet
+	Err     error
+}
+
+// SubnetReadResult is expected values of the Read operation
+type SubnetReadResult struct {
+	Subnet *sacloud.Subnet
+	Err    error
+}
+
+// SubnetStub is for trace SubnetOp operations
+type SubnetStub struct {
+	FindResult *SubnetFindResult
+	ReadResult *SubnetReadResult
+}
+
+// NewSubnetStub creates new SubnetStub instance
+func NewSubnetStub(caller sacloud.APICaller) sacloud.SubnetAPI {
+	return &SubnetStub{}
+}
+
+// Find is API call with trace log
+func (s *SubnetStub) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Subnet, error) {
+	if s.FindResult == nil {
+		log.Fatal("SubnetStub.FindResult is not set")
+	}
+	return s.FindResult.Subnets, s.FindResult.Err
+}
+
+// Read is API call with trace log
+func (s *SubnetStub) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Subnet, error) {
+	if s.ReadResult == nil {
+		log.Fatal("SubnetStub.ReadResult is not set")
+	}
+	return s.ReadResult.Subnet, s.ReadResult.Err
+}
+
 /*************************************************
 * SwitchStub
 *************************************************/
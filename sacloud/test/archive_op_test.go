@@ -149,3 +149,98 @@ func TestArchiveOp_CreateBlank(t *testing.T) {
 		client.Delete(context.Background(), testZone, archive.ID) // nolint ignore error
 	}()
 }
+
+func TestArchiveOp_ShareAndTransfer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	caller := singletonAPICaller()
+	client := sacloud.NewArchiveOp(caller)
+
+	// 転送先ゾーン
+	zones, err := sacloud.NewZoneOp(caller).Find(ctx, sacloud.DefaultZone, nil)
+	require.NoError(t, err)
+	var destZone *sacloud.Zone
+	for _, zone := range zones {
+		if zone.Name != testZone && !zone.IsDummy {
+			destZone = zone
+			break
+		}
+	}
+	require.NotNil(t, destZone)
+
+	// 転送元アーカイブ
+	archives, err := client.Find(ctx, testZone, nil)
+	require.NoError(t, err)
+	var sourceArchiveID types.ID
+	for _, a := range archives {
+		if a.GetSizeGB() == 20 && a.Availability.IsAvailable() {
+			sourceArchiveID = a.ID
+			break
+		}
+	}
+	require.False(t, sourceArchiveID.IsEmpty())
+
+	archive, err := client.Create(ctx, testZone, &sacloud.ArchiveCreateRequest{
+		SourceArchiveID: sourceArchiveID,
+		Name:            "libsacloud-v2-archive-share",
+	})
+	require.NoError(t, err)
+	defer func() {
+		client.Delete(ctx, testZone, archive.ID) // nolint ignore error
+	}()
+	_, err = sacloud.WaiterForReady(func() (interface{}, error) {
+		return client.Read(ctx, testZone, archive.ID)
+	}).WaitForState(ctx)
+	require.NoError(t, err)
+
+	// share
+	shareInfo, err := client.Share(ctx, testZone, archive.ID, &sacloud.ArchiveShareRequest{Shared: true})
+	require.NoError(t, err)
+	require.True(t, shareInfo.SharedKey.ValidFormat())
+	require.Equal(t, testZone, shareInfo.SharedKey.Zone())
+	require.Equal(t, archive.ID, shareInfo.SharedKey.SourceArchiveID())
+
+	// create from shared
+	transferred := make([]*sacloud.Archive, 0, 2)
+	fromShared, err := client.CreateFromShared(ctx, testZone, archive.ID, destZone.ID, &sacloud.ArchiveCreateFromSharedRequest{
+		Name:            "libsacloud-v2-archive-from-shared",
+		SourceSharedKey: shareInfo.SharedKey,
+	})
+	require.NoError(t, err)
+	transferred = append(transferred, fromShared)
+
+	// transfer
+	transfer, err := client.Transfer(ctx, testZone, archive.ID, destZone.ID, &sacloud.ArchiveTransferRequest{
+		Name: "libsacloud-v2-archive-transfer",
+	})
+	require.NoError(t, err)
+	transferred = append(transferred, transfer)
+
+	for _, a := range transferred {
+		id := a.ID
+		defer func() {
+			client.Delete(ctx, destZone.Name, id) // nolint ignore error
+		}()
+
+		v, err := sacloud.WaiterForReady(func() (interface{}, error) {
+			return client.Read(ctx, destZone.Name, id)
+		}).WaitForState(ctx)
+		require.NoError(t, err)
+
+		read := v.(*sacloud.Archive)
+		require.Equal(t, archive.SizeMB, read.SizeMB)
+		require.NotNil(t, read.SourceInfo)
+		require.Equal(t, archive.ID, read.SourceInfo.ID)
+		require.Equal(t, testZone, read.SourceInfo.ZoneName)
+	}
+
+	// unshare
+	_, err = client.Share(ctx, testZone, archive.ID, &sacloud.ArchiveShareRequest{Shared: false})
+	require.NoError(t, err)
+	_, err = client.CreateFromShared(ctx, testZone, archive.ID, destZone.ID, &sacloud.ArchiveCreateFromSharedRequest{
+		Name:            "libsacloud-v2-archive-from-shared",
+		SourceSharedKey: shareInfo.SharedKey,
+	})
+	require.Error(t, err)
+}
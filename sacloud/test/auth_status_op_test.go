@@ -0,0 +1,19 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthStatusOp_Read(t *testing.T) {
+	client := sacloud.NewAuthStatusOp(singletonAPICaller())
+
+	authStatus, err := client.Read(context.Background(), sacloud.DefaultZone)
+	require.NoError(t, err)
+	require.NotEmpty(t, authStatus.AccountID)
+	require.NotEmpty(t, authStatus.MemberCode)
+	require.NotEmpty(t, authStatus.Permission)
+}
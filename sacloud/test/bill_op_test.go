@@ -0,0 +1,57 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBillOp(t *testing.T) {
+	caller := singletonAPICaller()
+	ctx := context.Background()
+
+	authStatus, err := sacloud.NewAuthStatusOp(caller).Read(ctx, sacloud.DefaultZone)
+	require.NoError(t, err)
+	if !authStatus.ExternalPermission.PermittedBill() {
+		t.Skip("account does not have permission to read bills")
+	}
+
+	client := sacloud.NewBillOp(caller)
+
+	bills, err := client.ByContract(ctx, sacloud.DefaultZone, authStatus.AccountID)
+	require.NoError(t, err)
+	if len(bills) == 0 {
+		t.Skip("account has no bills")
+	}
+	bill := bills[0]
+
+	bills, err = client.ByContractYear(ctx, sacloud.DefaultZone, authStatus.AccountID, bill.Date.Year())
+	require.NoError(t, err)
+	require.NotEmpty(t, bills)
+
+	bills, err = client.ByContractYearMonth(ctx, sacloud.DefaultZone, authStatus.AccountID, bill.Date.Year(), int(bill.Date.Month()))
+	require.NoError(t, err)
+	require.NotEmpty(t, bills)
+
+	bills, err = client.Read(ctx, sacloud.DefaultZone, bill.ID)
+	require.NoError(t, err)
+	require.Len(t, bills, 1)
+	require.Equal(t, bill, bills[0])
+
+	details, err := client.Details(ctx, sacloud.DefaultZone, authStatus.MemberCode, bill.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, details)
+
+	detailCSV, err := client.DetailsCSV(ctx, sacloud.DefaultZone, authStatus.MemberCode, bill.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, detailCSV.Filename)
+
+	header, err := detailCSV.HeaderRow()
+	require.NoError(t, err)
+	require.NotEmpty(t, header)
+	body, err := detailCSV.BodyRows()
+	require.NoError(t, err)
+	require.Len(t, body, detailCSV.Count)
+}
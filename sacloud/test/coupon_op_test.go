@@ -0,0 +1,24 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCouponOp_Find(t *testing.T) {
+	caller := singletonAPICaller()
+	ctx := context.Background()
+
+	authStatus, err := sacloud.NewAuthStatusOp(caller).Read(ctx, sacloud.DefaultZone)
+	require.NoError(t, err)
+	if !authStatus.ExternalPermission.PermittedBill() {
+		t.Skip("account does not have permission to read coupons")
+	}
+
+	client := sacloud.NewCouponOp(caller)
+	_, err = client.Find(ctx, sacloud.DefaultZone, authStatus.AccountID)
+	require.NoError(t, err)
+}
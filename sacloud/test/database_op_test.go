@@ -0,0 +1,173 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+func TestDatabaseOpCRUD(t *testing.T) {
+	Run(t, &CRUDTestCase{
+		Parallel: true,
+
+		SetupAPICaller: singletonAPICaller,
+		Setup: func(testContext *CRUDTestContext, caller sacloud.APICaller) error {
+			swClient := sacloud.NewSwitchOp(caller)
+			sw, err := swClient.Create(context.Background(), testZone, &sacloud.SwitchCreateRequest{
+				Name: "libsacloud-v2-switch-for-database",
+			})
+			if err != nil {
+				return err
+			}
+
+			testContext.Values["database/switch"] = sw.ID
+			createDatabaseParam.SwitchID = sw.ID
+			createDatabaseExpected.SwitchID = sw.ID
+			updateDatabaseExpected.SwitchID = sw.ID
+			return nil
+		},
+
+		Create: &CRUDTestFunc{
+			Func: testDatabaseCreate,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  createDatabaseExpected,
+				IgnoreFields: ignoreDatabaseFields,
+			},
+		},
+
+		Read: &CRUDTestFunc{
+			Func: testDatabaseRead,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  createDatabaseExpected,
+				IgnoreFields: ignoreDatabaseFields,
+			},
+		},
+
+		Update: &CRUDTestFunc{
+			Func: testDatabaseUpdate,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  updateDatabaseExpected,
+				IgnoreFields: ignoreDatabaseFields,
+			},
+		},
+
+		Shutdown: func(testContext *CRUDTestContext, caller sacloud.APICaller) error {
+			client := sacloud.NewDatabaseOp(caller)
+			return client.Shutdown(context.Background(), testZone, testContext.ID, &sacloud.ShutdownOption{Force: true})
+		},
+
+		Delete: &CRUDTestDeleteFunc{
+			Func: testDatabaseDelete,
+		},
+
+		Cleanup: func(testContext *CRUDTestContext, caller sacloud.APICaller) error {
+
+			switchID, ok := testContext.Values["database/switch"]
+			if !ok {
+				return nil
+			}
+
+			swClient := sacloud.NewSwitchOp(caller)
+			return swClient.Delete(context.Background(), testZone, switchID.(types.ID))
+		},
+	})
+}
+
+var (
+	ignoreDatabaseFields = []string{
+		"ID",
+		"Class",
+		"Availability",
+		"InstanceStatus",
+		"InstanceHostName",
+		"InstanceHostInfoURL",
+		"InstanceStatusChangedAt",
+		"ZoneID",
+		"IconID",
+		"CreatedAt",
+		"ModifiedAt",
+		"CommonSetting",
+		"BackupSetting",
+		"ReplicationSetting",
+		"SettingsHash",
+	}
+	createDatabaseParam = &sacloud.DatabaseCreateRequest{
+		PlanID:         types.ID(10),
+		IPAddresses:    []string{"192.168.0.11"},
+		NetworkMaskLen: 24,
+		DefaultRoute:   "192.168.0.1",
+		Conf: &sacloud.DatabaseRemarkDBConfCommon{
+			DatabaseName:    "MariaDB",
+			DatabaseVersion: "10.3",
+		},
+		CommonSetting: &sacloud.DatabaseSettingCommon{
+			DefaultUser:  "libsacloud",
+			UserPassword: "libsacloud-v2-password",
+		},
+		Name:        "libsacloud-v2-database",
+		Description: "desc",
+		Tags:        []string{"tag1", "tag2"},
+	}
+	createDatabaseExpected = &sacloud.Database{
+		Name:           createDatabaseParam.Name,
+		Description:    createDatabaseParam.Description,
+		Tags:           createDatabaseParam.Tags,
+		PlanID:         createDatabaseParam.PlanID,
+		DefaultRoute:   createDatabaseParam.DefaultRoute,
+		NetworkMaskLen: createDatabaseParam.NetworkMaskLen,
+		IPAddresses:    createDatabaseParam.IPAddresses,
+		Conf:           createDatabaseParam.Conf,
+	}
+	updateDatabaseParam = &sacloud.DatabaseUpdateRequest{
+		Name:        "libsacloud-v2-database-upd",
+		Tags:        []string{"tag1-upd", "tag2-upd"},
+		Description: "desc-upd",
+		CommonSetting: &sacloud.DatabaseSettingCommon{
+			DefaultUser:  "libsacloud",
+			UserPassword: "libsacloud-v2-password-upd",
+		},
+	}
+	updateDatabaseExpected = &sacloud.Database{
+		Name:           updateDatabaseParam.Name,
+		Description:    updateDatabaseParam.Description,
+		Tags:           updateDatabaseParam.Tags,
+		PlanID:         createDatabaseParam.PlanID,
+		DefaultRoute:   createDatabaseParam.DefaultRoute,
+		NetworkMaskLen: createDatabaseParam.NetworkMaskLen,
+		IPAddresses:    createDatabaseParam.IPAddresses,
+		Conf:           createDatabaseParam.Conf,
+	}
+)
+
+func testDatabaseCreate(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewDatabaseOp(caller)
+	v, err := client.Create(context.Background(), testZone, createDatabaseParam)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := sacloud.WaiterForUp(func() (interface{}, error) {
+		return client.Read(context.Background(), testZone, v.ID)
+	}).WaitForState(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return n.(*sacloud.Database), nil
+}
+
+func testDatabaseRead(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewDatabaseOp(caller)
+	return client.Read(context.Background(), testZone, testContext.ID)
+}
+
+func testDatabaseUpdate(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewDatabaseOp(caller)
+	return client.Update(context.Background(), testZone, testContext.ID, updateDatabaseParam)
+}
+
+func testDatabaseDelete(testContext *CRUDTestContext, caller sacloud.APICaller) error {
+	client := sacloud.NewDatabaseOp(caller)
+	return client.Delete(context.Background(), testZone, testContext.ID)
+}
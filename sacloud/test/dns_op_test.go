@@ -0,0 +1,108 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+func TestDNSOpCRUD(t *testing.T) {
+	Run(t, &CRUDTestCase{
+		Parallel: true,
+
+		SetupAPICaller: singletonAPICaller,
+
+		Create: &CRUDTestFunc{
+			Func: testDNSCreate,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  createDNSExpected,
+				IgnoreFields: ignoreDNSFields,
+			},
+		},
+
+		Read: &CRUDTestFunc{
+			Func: testDNSRead,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  createDNSExpected,
+				IgnoreFields: ignoreDNSFields,
+			},
+		},
+
+		Update: &CRUDTestFunc{
+			Func: testDNSUpdate,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  updateDNSExpected,
+				IgnoreFields: ignoreDNSFields,
+			},
+		},
+
+		Delete: &CRUDTestDeleteFunc{
+			Func: testDNSDelete,
+		},
+	})
+}
+
+var (
+	ignoreDNSFields = []string{
+		"ID",
+		"Class",
+		"SettingsHash",
+		"DNSNameServers",
+		"IconID",
+		"CreatedAt",
+		"ModifiedAt",
+	}
+	createDNSParam = &sacloud.DNSCreateRequest{
+		Name:        "libsacloud-v2-dns.com",
+		Description: "desc",
+		Tags:        []string{"tag1", "tag2"},
+		Records: []*sacloud.DNSRecord{
+			sacloud.NewDNSRecord(types.DNSRecordTypes.A, "www", "192.0.2.1", 300),
+			sacloud.NewDNSRecord(types.DNSRecordTypes.MX, "@", "10 mail.libsacloud-v2-dns.com.", 3600),
+		},
+	}
+	createDNSExpected = &sacloud.DNS{
+		Name:         createDNSParam.Name,
+		Description:  createDNSParam.Description,
+		Tags:         createDNSParam.Tags,
+		Availability: types.Availabilities.Available,
+		Records:      createDNSParam.Records,
+	}
+	updateDNSParam = &sacloud.DNSUpdateRequest{
+		Description: "desc-upd",
+		Tags:        []string{"tag1-upd", "tag2-upd"},
+		Records: []*sacloud.DNSRecord{
+			sacloud.NewDNSRecord(types.DNSRecordTypes.A, "www", "192.0.2.2", 600),
+			sacloud.NewDNSRecord(types.DNSRecordTypes.TXT, "@", "v=spf1 -all", 3600),
+		},
+	}
+	updateDNSExpected = &sacloud.DNS{
+		Name:         createDNSParam.Name,
+		Description:  updateDNSParam.Description,
+		Tags:         updateDNSParam.Tags,
+		Availability: types.Availabilities.Available,
+		Records:      updateDNSParam.Records,
+	}
+)
+
+func testDNSCreate(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewDNSOp(caller)
+	return client.Create(context.Background(), sacloud.DefaultZone, createDNSParam)
+}
+
+func testDNSRead(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewDNSOp(caller)
+	return client.Read(context.Background(), sacloud.DefaultZone, testContext.ID)
+}
+
+func testDNSUpdate(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewDNSOp(caller)
+	return client.Update(context.Background(), sacloud.DefaultZone, testContext.ID, updateDNSParam)
+}
+
+func testDNSDelete(testContext *CRUDTestContext, caller sacloud.APICaller) error {
+	client := sacloud.NewDNSOp(caller)
+	return client.Delete(context.Background(), sacloud.DefaultZone, testContext.ID)
+}
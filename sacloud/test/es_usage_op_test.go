@@ -0,0 +1,26 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/stretchr/testify/require"
+)
+
+func TestESUsageOp_Find(t *testing.T) {
+	caller := singletonAPICaller()
+	ctx := context.Background()
+
+	authStatus, err := sacloud.NewAuthStatusOp(caller).Read(ctx, sacloud.DefaultZone)
+	require.NoError(t, err)
+	if !authStatus.ExternalPermission.PermittedBill() {
+		t.Skip("account does not have permission to read ES usages")
+	}
+
+	client := sacloud.NewESUsageOp(caller)
+	lastMonth := time.Now().AddDate(0, -1, 0)
+	_, err = client.Find(ctx, sacloud.DefaultZone, authStatus.AccountID, lastMonth.Year(), int(lastMonth.Month()))
+	require.NoError(t, err)
+}
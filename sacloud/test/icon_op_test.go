@@ -0,0 +1,110 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIconOpCRUD(t *testing.T) {
+	Run(t, &CRUDTestCase{
+		Parallel:          true,
+		IgnoreStartupWait: true,
+		SetupAPICaller:    singletonAPICaller,
+		Create: &CRUDTestFunc{
+			Func: testIconCreate,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  createIconExpected,
+				IgnoreFields: ignoreIconFields,
+			},
+		},
+		Read: &CRUDTestFunc{
+			Func: testIconRead,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  createIconExpected,
+				IgnoreFields: ignoreIconFields,
+			},
+		},
+		Update: &CRUDTestFunc{
+			Func: testIconUpdate,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  updateIconExpected,
+				IgnoreFields: ignoreIconFields,
+			},
+		},
+		Delete: &CRUDTestDeleteFunc{
+			Func: testIconDelete,
+		},
+	})
+}
+
+var (
+	ignoreIconFields = []string{"ID", "URL", "CreatedAt", "ModifiedAt"}
+
+	// 1x1の透過PNG
+	testIconImage = "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAQAAAC1HAwCAAAAC0lEQVR42mNkYAAAAAYAAjCB0C8AAAAASUVORK5CYII="
+
+	createIconParam = &sacloud.IconCreateRequest{
+		Name:  "libsacloud-v2-icon",
+		Tags:  []string{"tag1", "tag2"},
+		Image: testIconImage,
+	}
+	createIconExpected = &sacloud.Icon{
+		Name:         createIconParam.Name,
+		Tags:         createIconParam.Tags,
+		Scope:        types.Scopes.User,
+		Availability: types.Availabilities.Available,
+	}
+	updateIconParam = &sacloud.IconUpdateRequest{
+		Name: "libsacloud-v2-icon-upd",
+		Tags: []string{"tag1-upd", "tag2-upd"},
+	}
+	updateIconExpected = &sacloud.Icon{
+		Name:         updateIconParam.Name,
+		Tags:         updateIconParam.Tags,
+		Scope:        types.Scopes.User,
+		Availability: types.Availabilities.Available,
+	}
+)
+
+func testIconCreate(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewIconOp(caller)
+	return client.Create(context.Background(), sacloud.DefaultZone, createIconParam)
+}
+
+func testIconRead(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewIconOp(caller)
+	return client.Read(context.Background(), sacloud.DefaultZone, testContext.ID)
+}
+
+func testIconUpdate(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewIconOp(caller)
+	return client.Update(context.Background(), sacloud.DefaultZone, testContext.ID, updateIconParam)
+}
+
+func testIconDelete(testContext *CRUDTestContext, caller sacloud.APICaller) error {
+	client := sacloud.NewIconOp(caller)
+	return client.Delete(context.Background(), sacloud.DefaultZone, testContext.ID)
+}
+
+func TestIconOp_GetImage(t *testing.T) {
+	client := sacloud.NewIconOp(singletonAPICaller())
+	ctx := context.Background()
+
+	icon, err := client.Create(ctx, sacloud.DefaultZone, &sacloud.IconCreateRequest{
+		Name:  "libsacloud-v2-icon-image",
+		Image: testIconImage,
+	})
+	require.NoError(t, err)
+	defer client.Delete(ctx, sacloud.DefaultZone, icon.ID) // nolint
+
+	image, err := client.GetImage(ctx, sacloud.DefaultZone, icon.ID, types.IconSizes.Small)
+	require.NoError(t, err)
+
+	data, err := image.Decode()
+	require.NoError(t, err)
+	require.NotEmpty(t, data)
+}
@@ -0,0 +1,128 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIPAddressOp_UpdateHostName(t *testing.T) {
+	caller := singletonAPICaller()
+	ctx := context.Background()
+
+	// prepare
+	internetOp := sacloud.NewInternetOp(caller)
+	internet, err := internetOp.Create(ctx, testZone, &sacloud.InternetCreateRequest{
+		Name:           "libsacloud-v2-internet-for-ipaddress",
+		NetworkMaskLen: 28,
+		BandWidthMbps:  100,
+	})
+	require.NoError(t, err)
+	v, err := readInternet(internet.ID, caller)
+	require.NoError(t, err)
+	internet = v.(*sacloud.Internet)
+
+	// subnet
+	subnetOp := sacloud.NewSubnetOp(caller)
+	subnet, err := subnetOp.Read(ctx, testZone, internet.Switch.Subnets[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, internet.ID, subnet.InternetID)
+	require.Equal(t, internet.Switch.ID, subnet.SwitchID)
+	require.NotEmpty(t, subnet.IPAddresses)
+
+	// ip address
+	client := sacloud.NewIPAddressOp(caller)
+	ip := subnet.IPAddresses[0].IPAddress
+
+	ipAddress, err := client.Read(ctx, testZone, ip)
+	require.NoError(t, err)
+	require.Equal(t, ip, ipAddress.IPAddress)
+	require.Empty(t, ipAddress.HostName)
+
+	ipAddress, err = client.UpdateHostName(ctx, testZone, ip, "libsacloud-v2.example.com")
+	require.NoError(t, err)
+	require.Equal(t, "libsacloud-v2.example.com", ipAddress.HostName)
+
+	subnet, err = subnetOp.Read(ctx, testZone, subnet.ID)
+	require.NoError(t, err)
+	require.Equal(t, "libsacloud-v2.example.com", subnet.IPAddresses[0].HostName)
+
+	_, err = client.UpdateHostName(ctx, testZone, ip, "invalid host name")
+	require.Error(t, err)
+
+	ipAddress, err = client.UpdateHostName(ctx, testZone, ip, "")
+	require.NoError(t, err)
+	require.Empty(t, ipAddress.HostName)
+
+	// cleanup
+	err = internetOp.Delete(ctx, testZone, internet.ID)
+	require.NoError(t, err)
+}
+
+func TestIPv6AddrOp_CRUD(t *testing.T) {
+	caller := singletonAPICaller()
+	ctx := context.Background()
+
+	// prepare
+	internetOp := sacloud.NewInternetOp(caller)
+	internet, err := internetOp.Create(ctx, testZone, &sacloud.InternetCreateRequest{
+		Name:           "libsacloud-v2-internet-for-ipv6addr",
+		NetworkMaskLen: 28,
+		BandWidthMbps:  100,
+	})
+	require.NoError(t, err)
+	_, err = readInternet(internet.ID, caller)
+	require.NoError(t, err)
+
+	// enable IPv6
+	ipv6NetInfo, err := internetOp.EnableIPv6(ctx, testZone, internet.ID)
+	require.NoError(t, err)
+	require.Equal(t, 64, ipv6NetInfo.IPv6PrefixLen)
+
+	internet, err = internetOp.Read(ctx, testZone, internet.ID)
+	require.NoError(t, err)
+	require.Len(t, internet.Switch.IPv6Nets, 1)
+	require.Equal(t, ipv6NetInfo.ID, internet.Switch.IPv6Nets[0].ID)
+
+	ipv6Net, err := sacloud.NewIPv6NetOp(caller).Read(ctx, testZone, ipv6NetInfo.ID)
+	require.NoError(t, err)
+	require.Equal(t, ipv6NetInfo.IPv6Prefix, ipv6Net.IPv6Prefix)
+	require.Equal(t, internet.Switch.ID, ipv6Net.SwitchID)
+
+	// ipv6 address
+	client := sacloud.NewIPv6AddrOp(caller)
+	addr := ipv6NetInfo.IPv6Prefix + "10"
+
+	created, err := client.Create(ctx, testZone, &sacloud.IPv6AddrCreateRequest{
+		IPv6Addr: addr,
+		HostName: "libsacloud-v2.example.com",
+	})
+	require.NoError(t, err)
+	require.Equal(t, ipv6NetInfo.ID, created.IPv6NetID)
+
+	read, err := client.Read(ctx, testZone, addr)
+	require.NoError(t, err)
+	require.Equal(t, "libsacloud-v2.example.com", read.HostName)
+
+	updated, err := client.Update(ctx, testZone, addr, &sacloud.IPv6AddrUpdateRequest{
+		HostName: "libsacloud-v2-upd.example.com",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "libsacloud-v2-upd.example.com", updated.HostName)
+
+	err = client.Delete(ctx, testZone, addr)
+	require.NoError(t, err)
+
+	_, err = client.Read(ctx, testZone, addr)
+	require.True(t, sacloud.IsNotFoundError(err), "%s", err)
+
+	// disable IPv6
+	err = internetOp.DisableIPv6(ctx, testZone, internet.ID, ipv6NetInfo.ID)
+	require.NoError(t, err)
+
+	// cleanup
+	err = internetOp.Delete(ctx, testZone, internet.ID)
+	require.NoError(t, err)
+}
@@ -0,0 +1,83 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+func TestLicenseOpCRUD(t *testing.T) {
+	Run(t, &CRUDTestCase{
+		Parallel:          true,
+		IgnoreStartupWait: true,
+		SetupAPICaller:    singletonAPICaller,
+		Create: &CRUDTestFunc{
+			Func: testLicenseCreate,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  createLicenseExpected,
+				IgnoreFields: ignoreLicenseFields,
+			},
+		},
+		Read: &CRUDTestFunc{
+			Func: testLicenseRead,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  createLicenseExpected,
+				IgnoreFields: ignoreLicenseFields,
+			},
+		},
+		Update: &CRUDTestFunc{
+			Func: testLicenseUpdate,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  updateLicenseExpected,
+				IgnoreFields: ignoreLicenseFields,
+			},
+		},
+		Delete: &CRUDTestDeleteFunc{
+			Func: testLicenseDelete,
+		},
+	})
+}
+
+var (
+	ignoreLicenseFields = []string{"ID", "CreatedAt", "ModifiedAt"}
+
+	createLicenseParam = &sacloud.LicenseCreateRequest{
+		LicenseInfoID: types.ID(10001),
+		Name:          "libsacloud-v2-license",
+	}
+	createLicenseExpected = &sacloud.License{
+		Name:            createLicenseParam.Name,
+		LicenseInfoID:   createLicenseParam.LicenseInfoID,
+		LicenseInfoName: "Windows RDS SAL",
+	}
+	updateLicenseParam = &sacloud.LicenseUpdateRequest{
+		Name: "libsacloud-v2-license-upd",
+	}
+	updateLicenseExpected = &sacloud.License{
+		Name:            updateLicenseParam.Name,
+		LicenseInfoID:   createLicenseParam.LicenseInfoID,
+		LicenseInfoName: "Windows RDS SAL",
+	}
+)
+
+func testLicenseCreate(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewLicenseOp(caller)
+	return client.Create(context.Background(), sacloud.DefaultZone, createLicenseParam)
+}
+
+func testLicenseRead(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewLicenseOp(caller)
+	return client.Read(context.Background(), sacloud.DefaultZone, testContext.ID)
+}
+
+func testLicenseUpdate(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewLicenseOp(caller)
+	return client.Update(context.Background(), sacloud.DefaultZone, testContext.ID, updateLicenseParam)
+}
+
+func testLicenseDelete(testContext *CRUDTestContext, caller sacloud.APICaller) error {
+	client := sacloud.NewLicenseOp(caller)
+	return client.Delete(context.Background(), sacloud.DefaultZone, testContext.ID)
+}
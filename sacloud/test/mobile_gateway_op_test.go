@@ -0,0 +1,150 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+func TestMobileGatewayOpCRUD(t *testing.T) {
+	Run(t, &CRUDTestCase{
+		Parallel:       true,
+		SetupAPICaller: singletonAPICaller,
+		Create: &CRUDTestFunc{
+			Func: testMobileGatewayCreate,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  createMobileGatewayExpected,
+				IgnoreFields: ignoreMobileGatewayFields,
+			},
+		},
+
+		Read: &CRUDTestFunc{
+			Func: testMobileGatewayRead,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  createMobileGatewayExpected,
+				IgnoreFields: ignoreMobileGatewayFields,
+			},
+		},
+
+		Update: &CRUDTestFunc{
+			Func: testMobileGatewayUpdate,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  updateMobileGatewayExpected,
+				IgnoreFields: ignoreMobileGatewayFields,
+			},
+		},
+
+		Shutdown: func(testContext *CRUDTestContext, caller sacloud.APICaller) error {
+			client := sacloud.NewMobileGatewayOp(caller)
+			return client.Shutdown(context.Background(), testZone, testContext.ID, &sacloud.ShutdownOption{Force: true})
+		},
+
+		Delete: &CRUDTestDeleteFunc{
+			Func: testMobileGatewayDelete,
+		},
+	})
+}
+
+var (
+	ignoreMobileGatewayFields = []string{
+		"ID",
+		"Availability",
+		"Class",
+		"IconID",
+		"CreatedAt",
+		"SettingsHash",
+		"Settings",
+		"InstanceHostName",
+		"InstanceHostInfoURL",
+		"InstanceStatus",
+		"InstanceStatusChangedAt",
+		"Interfaces",
+		"ZoneID",
+	}
+
+	createMobileGatewayParam = &sacloud.MobileGatewayCreateRequest{
+		Name:        "libsacloud-v2-mobile-gateway",
+		Description: "desc",
+		Tags:        []string{"tag1", "tag2"},
+		Settings: &sacloud.MobileGatewaySettingCreate{
+			InternetConnectionEnabled:       true,
+			InterDeviceCommunicationEnabled: true,
+		},
+	}
+	createMobileGatewayExpected = &sacloud.MobileGateway{
+		Class:          "mobilegateway",
+		Name:           createMobileGatewayParam.Name,
+		Description:    createMobileGatewayParam.Description,
+		Tags:           createMobileGatewayParam.Tags,
+		Availability:   types.Availabilities.Available,
+		InstanceStatus: types.ServerInstanceStatuses.Up,
+	}
+	updateMobileGatewayParam = &sacloud.MobileGatewayUpdateRequest{
+		Name:        "libsacloud-v2-mobile-gateway-upd",
+		Tags:        []string{"tag1-upd", "tag2-upd"},
+		Description: "desc-upd",
+	}
+	updateMobileGatewayExpected = &sacloud.MobileGateway{
+		Class:          "mobilegateway",
+		Name:           updateMobileGatewayParam.Name,
+		Description:    updateMobileGatewayParam.Description,
+		Tags:           updateMobileGatewayParam.Tags,
+		Availability:   types.Availabilities.Available,
+		InstanceStatus: types.ServerInstanceStatuses.Up,
+	}
+)
+
+func testMobileGatewayCreate(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewMobileGatewayOp(caller)
+	v, err := client.Create(context.Background(), testZone, createMobileGatewayParam)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := sacloud.WaiterForReady(func() (interface{}, error) {
+		return client.Read(context.Background(), testZone, v.ID)
+	}).WaitForState(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.Boot(context.Background(), testZone, v.ID); err != nil {
+		return nil, err
+	}
+
+	return n.(*sacloud.MobileGateway), nil
+}
+
+func testMobileGatewayRead(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewMobileGatewayOp(caller)
+	return client.Read(context.Background(), testZone, testContext.ID)
+}
+
+func testMobileGatewayUpdate(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewMobileGatewayOp(caller)
+	ctx := context.Background()
+
+	// DNS/トラフィックコントロールの設定も合わせて更新
+	if err := client.SetDNS(ctx, testZone, testContext.ID, &sacloud.MobileGatewayDNSSetting{
+		DNS1: "8.8.8.8",
+		DNS2: "8.8.4.4",
+	}); err != nil {
+		return nil, err
+	}
+	if err := client.SetTrafficConfig(ctx, testZone, testContext.ID, &sacloud.MobileGatewayTrafficControl{
+		TrafficQuotaInMB:     10,
+		BandWidthLimitInKbps: 128,
+		EmailNotifyEnabled:   true,
+	}); err != nil {
+		return nil, err
+	}
+
+	return client.Update(ctx, testZone, testContext.ID, updateMobileGatewayParam)
+}
+
+func testMobileGatewayDelete(testContext *CRUDTestContext, caller sacloud.APICaller) error {
+	client := sacloud.NewMobileGatewayOp(caller)
+	return client.Delete(context.Background(), testZone, testContext.ID)
+}
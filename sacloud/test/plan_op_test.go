@@ -0,0 +1,96 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerPlanOp_Find(t *testing.T) {
+	client := sacloud.NewServerPlanOp(singletonAPICaller())
+
+	plans, err := client.Find(context.Background(), testZone, &sacloud.FindCondition{Count: 1})
+	require.NoError(t, err)
+	require.Len(t, plans, 1)
+
+	plan, err := client.Read(context.Background(), testZone, plans[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, plans[0], plan)
+}
+
+func TestDiskPlanOp_Find(t *testing.T) {
+	client := sacloud.NewDiskPlanOp(singletonAPICaller())
+
+	plans, err := client.Find(context.Background(), testZone, &sacloud.FindCondition{})
+	require.NoError(t, err)
+	require.NotEmpty(t, plans)
+	require.NotEmpty(t, plans[0].Size)
+}
+
+func TestInternetPlanOp_Find(t *testing.T) {
+	client := sacloud.NewInternetPlanOp(singletonAPICaller())
+
+	plans, err := client.Find(context.Background(), testZone, &sacloud.FindCondition{})
+	require.NoError(t, err)
+	require.NotEmpty(t, plans)
+}
+
+func TestFindServerPlan(t *testing.T) {
+	client := sacloud.NewServerPlanOp(singletonAPICaller())
+	ctx := context.Background()
+
+	plan, err := sacloud.FindServerPlan(ctx, client, testZone, &sacloud.FindServerPlanRequest{
+		CPU:      2,
+		MemoryGB: 4,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, plan.CPU)
+	require.Equal(t, 4, plan.GetMemoryGB())
+	require.Equal(t, types.Commitments.Standard, plan.Commitment)
+
+	_, err = sacloud.FindServerPlan(ctx, client, testZone, &sacloud.FindServerPlanRequest{
+		CPU:      1,
+		MemoryGB: 1024,
+	})
+	require.Error(t, err)
+	require.True(t, errors.Is(err, sacloud.ErrServerPlanNotFound))
+	require.False(t, sacloud.IsNotFoundError(err))
+}
+
+func TestLicensePlanOp_Find(t *testing.T) {
+	client := sacloud.NewLicensePlanOp(singletonAPICaller())
+
+	plans, err := client.Find(context.Background(), sacloud.DefaultZone, &sacloud.FindCondition{})
+	require.NoError(t, err)
+	require.NotEmpty(t, plans)
+
+	plan, err := client.Read(context.Background(), sacloud.DefaultZone, plans[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, plans[0], plan)
+}
+
+func TestServiceClassOp_Find(t *testing.T) {
+	client := sacloud.NewServiceClassOp(singletonAPICaller())
+
+	classes, err := client.Find(context.Background(), testZone, &sacloud.FindCondition{})
+	require.NoError(t, err)
+	require.NotEmpty(t, classes)
+	require.NotNil(t, classes[0].Price)
+	require.Equal(t, testZone, classes[0].Price.Zone)
+}
+
+func TestPrivateHostPlanOp_Find(t *testing.T) {
+	client := sacloud.NewPrivateHostPlanOp(singletonAPICaller())
+
+	plans, err := client.Find(context.Background(), testZone, &sacloud.FindCondition{})
+	require.NoError(t, err)
+	require.NotEmpty(t, plans)
+
+	plan, err := client.Read(context.Background(), testZone, plans[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, plans[0], plan)
+}
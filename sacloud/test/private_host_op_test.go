@@ -0,0 +1,171 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivateHostOpCRUD(t *testing.T) {
+	Run(t, &CRUDTestCase{
+		Parallel:          true,
+		IgnoreStartupWait: true,
+		SetupAPICaller:    singletonAPICaller,
+		Setup: func(testContext *CRUDTestContext, caller sacloud.APICaller) error {
+			planOp := sacloud.NewPrivateHostPlanOp(caller)
+			plans, err := planOp.Find(context.Background(), testZone, &sacloud.FindCondition{})
+			if err != nil {
+				return err
+			}
+			plan := plans[0]
+			createPrivateHostParam.PlanID = plan.ID
+			createPrivateHostExpected.PlanID = plan.ID
+			createPrivateHostExpected.PlanName = plan.Name
+			createPrivateHostExpected.PlanClass = plan.Class
+			createPrivateHostExpected.CPU = plan.CPU
+			createPrivateHostExpected.MemoryMB = plan.MemoryMB
+			updatePrivateHostExpected.PlanID = plan.ID
+			updatePrivateHostExpected.PlanName = plan.Name
+			updatePrivateHostExpected.PlanClass = plan.Class
+			updatePrivateHostExpected.CPU = plan.CPU
+			updatePrivateHostExpected.MemoryMB = plan.MemoryMB
+			return nil
+		},
+		Create: &CRUDTestFunc{
+			Func: testPrivateHostCreate,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  createPrivateHostExpected,
+				IgnoreFields: ignorePrivateHostFields,
+			},
+		},
+		Read: &CRUDTestFunc{
+			Func: testPrivateHostRead,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  createPrivateHostExpected,
+				IgnoreFields: ignorePrivateHostFields,
+			},
+		},
+		Update: &CRUDTestFunc{
+			Func: testPrivateHostUpdate,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  updatePrivateHostExpected,
+				IgnoreFields: ignorePrivateHostFields,
+			},
+		},
+		Delete: &CRUDTestDeleteFunc{
+			Func: testPrivateHostDelete,
+		},
+	})
+}
+
+var (
+	ignorePrivateHostFields = []string{"ID", "CreatedAt", "HostName"}
+
+	createPrivateHostParam = &sacloud.PrivateHostCreateRequest{
+		Name:        "libsacloud-v2-private-host",
+		Description: "desc",
+		Tags:        []string{"tag1", "tag2"},
+	}
+	createPrivateHostExpected = &sacloud.PrivateHost{
+		Name:        createPrivateHostParam.Name,
+		Description: createPrivateHostParam.Description,
+		Tags:        createPrivateHostParam.Tags,
+	}
+	updatePrivateHostParam = &sacloud.PrivateHostUpdateRequest{
+		Name:        "libsacloud-v2-private-host-upd",
+		Description: "desc-upd",
+		Tags:        []string{"tag1-upd", "tag2-upd"},
+	}
+	updatePrivateHostExpected = &sacloud.PrivateHost{
+		Name:        updatePrivateHostParam.Name,
+		Description: updatePrivateHostParam.Description,
+		Tags:        updatePrivateHostParam.Tags,
+	}
+)
+
+func testPrivateHostCreate(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewPrivateHostOp(caller)
+	return client.Create(context.Background(), testZone, createPrivateHostParam)
+}
+
+func testPrivateHostRead(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewPrivateHostOp(caller)
+	return client.Read(context.Background(), testZone, testContext.ID)
+}
+
+func testPrivateHostUpdate(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewPrivateHostOp(caller)
+	return client.Update(context.Background(), testZone, testContext.ID, updatePrivateHostParam)
+}
+
+func testPrivateHostDelete(testContext *CRUDTestContext, caller sacloud.APICaller) error {
+	client := sacloud.NewPrivateHostOp(caller)
+	return client.Delete(context.Background(), testZone, testContext.ID)
+}
+
+func TestPrivateHostOp_AssignServer(t *testing.T) {
+	caller := singletonAPICaller()
+	hostOp := sacloud.NewPrivateHostOp(caller)
+	serverOp := sacloud.NewServerOp(caller)
+	ctx := context.Background()
+
+	plans, err := sacloud.NewPrivateHostPlanOp(caller).Find(ctx, testZone, &sacloud.FindCondition{})
+	require.NoError(t, err)
+	require.NotEmpty(t, plans)
+	plan := plans[0]
+
+	host, err := hostOp.Create(ctx, testZone, &sacloud.PrivateHostCreateRequest{
+		PlanID: plan.ID,
+		Name:   "libsacloud-v2-private-host",
+	})
+	require.NoError(t, err)
+	require.Equal(t, plan.CPU, host.GetFreeCPU())
+	require.Equal(t, plan.MemoryMB, host.GetFreeMemoryMB())
+
+	newServerParam := func(cpu, memoryGB int) *sacloud.ServerCreateRequest {
+		return &sacloud.ServerCreateRequest{
+			CPU:             cpu,
+			MemoryMB:        memoryGB * 1024,
+			InterfaceDriver: types.InterfaceDrivers.VirtIO,
+			Name:            "libsacloud-v2-server-on-private-host",
+			PrivateHostID:   host.ID,
+		}
+	}
+
+	server, err := serverOp.Create(ctx, testZone, newServerParam(32, 128))
+	require.NoError(t, err)
+	require.Equal(t, host.ID, server.PrivateHostID)
+	require.Equal(t, host.Name, server.PrivateHostName)
+
+	host, err = hostOp.Read(ctx, testZone, host.ID)
+	require.NoError(t, err)
+	require.Equal(t, 32, host.AssignedCPU)
+	require.Equal(t, 128, host.GetAssignedMemoryGB())
+	require.Equal(t, plan.CPU-32, host.GetFreeCPU())
+
+	// 専有ホストのメモリ容量を超える場合はエラー
+	_, err = serverOp.Create(ctx, testZone, newServerParam(32, 128))
+	require.Error(t, err)
+
+	// 割り当て済みのサーバがある専有ホストは削除できない
+	err = hostOp.Delete(ctx, testZone, host.ID)
+	require.Error(t, err)
+
+	// プラン変更でも専有ホストの容量を検証する
+	_, err = serverOp.ChangePlan(ctx, testZone, server.ID, &sacloud.ServerChangePlanRequest{CPU: 32, MemoryMB: 256 * 1024})
+	require.Error(t, err)
+	server, err = serverOp.ChangePlan(ctx, testZone, server.ID, &sacloud.ServerChangePlanRequest{CPU: 32, MemoryMB: 192 * 1024})
+	require.NoError(t, err)
+
+	host, err = hostOp.Read(ctx, testZone, host.ID)
+	require.NoError(t, err)
+	require.Equal(t, 192, host.GetAssignedMemoryGB())
+
+	err = serverOp.Delete(ctx, testZone, server.ID)
+	require.NoError(t, err)
+	err = hostOp.Delete(ctx, testZone, host.ID)
+	require.NoError(t, err)
+}
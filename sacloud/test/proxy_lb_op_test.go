@@ -0,0 +1,175 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+func TestProxyLBOpCRUD(t *testing.T) {
+	Run(t, &CRUDTestCase{
+		Parallel: true,
+
+		SetupAPICaller: singletonAPICaller,
+
+		Create: &CRUDTestFunc{
+			Func: testProxyLBCreate,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  createProxyLBExpected,
+				IgnoreFields: ignoreProxyLBFields,
+			},
+		},
+
+		Read: &CRUDTestFunc{
+			Func: testProxyLBRead,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  createProxyLBExpected,
+				IgnoreFields: ignoreProxyLBFields,
+			},
+		},
+
+		Update: &CRUDTestFunc{
+			Func: testProxyLBUpdate,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  updateProxyLBExpected,
+				IgnoreFields: ignoreProxyLBFields,
+			},
+		},
+
+		Delete: &CRUDTestDeleteFunc{
+			Func: testProxyLBDelete,
+		},
+	})
+}
+
+var (
+	ignoreProxyLBFields = []string{
+		"ID",
+		"Class",
+		"SettingsHash",
+		"FQDN",
+		"VirtualIPAddress",
+		"ProxyNetworks",
+		"IconID",
+		"CreatedAt",
+		"ModifiedAt",
+	}
+	createProxyLBParam = &sacloud.ProxyLBCreateRequest{
+		Name:        "libsacloud-v2-proxy-lb",
+		Description: "desc",
+		Tags:        []string{"tag1", "tag2"},
+		HealthCheck: &sacloud.ProxyLBHealthCheck{
+			Protocol:  types.ProxyLBHealthCheckProtocols.HTTP,
+			Path:      "/index.html",
+			DelayLoop: 10,
+		},
+		SorryServer: &sacloud.ProxyLBSorryServer{
+			IPAddress: "192.0.2.11",
+			Port:      80,
+		},
+		BindPorts: []*sacloud.ProxyLBBindPort{
+			{
+				ProxyMode: types.ProxyLBProxyModes.HTTP,
+				Port:      80,
+			},
+		},
+		Servers: []*sacloud.ProxyLBServer{
+			{
+				IPAddress: "192.0.2.21",
+				Port:      80,
+				Enabled:   true,
+			},
+		},
+		StickySession: &sacloud.ProxyLBStickySession{
+			Enabled: true,
+			Method:  "cookie",
+		},
+		Timeout: &sacloud.ProxyLBTimeout{
+			InactiveSec: 10,
+		},
+		Region: types.ProxyLBRegions.IS1,
+	}
+	createProxyLBExpected = &sacloud.ProxyLB{
+		Name:          createProxyLBParam.Name,
+		Description:   createProxyLBParam.Description,
+		Tags:          createProxyLBParam.Tags,
+		Availability:  types.Availabilities.Available,
+		HealthCheck:   createProxyLBParam.HealthCheck,
+		SorryServer:   createProxyLBParam.SorryServer,
+		BindPorts:     createProxyLBParam.BindPorts,
+		Servers:       createProxyLBParam.Servers,
+		StickySession: createProxyLBParam.StickySession,
+		Timeout:       createProxyLBParam.Timeout,
+		Region:        createProxyLBParam.Region,
+	}
+	updateProxyLBParam = &sacloud.ProxyLBUpdateRequest{
+		Name:        "libsacloud-v2-proxy-lb-upd",
+		Description: "desc-upd",
+		Tags:        []string{"tag1-upd", "tag2-upd"},
+		HealthCheck: &sacloud.ProxyLBHealthCheck{
+			Protocol:  types.ProxyLBHealthCheckProtocols.TCP,
+			DelayLoop: 20,
+		},
+		BindPorts: []*sacloud.ProxyLBBindPort{
+			{
+				ProxyMode:       types.ProxyLBProxyModes.HTTP,
+				Port:            80,
+				RedirectToHTTPS: true,
+			},
+			{
+				ProxyMode:    types.ProxyLBProxyModes.HTTPS,
+				Port:         443,
+				SupportHTTP2: true,
+			},
+		},
+		Servers: []*sacloud.ProxyLBServer{
+			{
+				IPAddress: "192.0.2.21",
+				Port:      80,
+				Enabled:   true,
+			},
+			{
+				IPAddress: "192.0.2.22",
+				Port:      80,
+				Enabled:   false,
+			},
+		},
+		LetsEncrypt: &sacloud.ProxyLBLetsEncrypt{
+			CommonName: "libsacloud-v2-proxy-lb.usacloud.jp",
+			Enabled:    true,
+		},
+	}
+	updateProxyLBExpected = &sacloud.ProxyLB{
+		Name:         updateProxyLBParam.Name,
+		Description:  updateProxyLBParam.Description,
+		Tags:         updateProxyLBParam.Tags,
+		Availability: types.Availabilities.Available,
+		HealthCheck:  updateProxyLBParam.HealthCheck,
+		BindPorts:    updateProxyLBParam.BindPorts,
+		Servers:      updateProxyLBParam.Servers,
+		LetsEncrypt:  updateProxyLBParam.LetsEncrypt,
+		Region:       createProxyLBParam.Region,
+	}
+)
+
+func testProxyLBCreate(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewProxyLBOp(caller)
+	return client.Create(context.Background(), sacloud.DefaultZone, createProxyLBParam)
+}
+
+func testProxyLBRead(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewProxyLBOp(caller)
+	return client.Read(context.Background(), sacloud.DefaultZone, testContext.ID)
+}
+
+func testProxyLBUpdate(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewProxyLBOp(caller)
+	return client.Update(context.Background(), sacloud.DefaultZone, testContext.ID, updateProxyLBParam)
+}
+
+func testProxyLBDelete(testContext *CRUDTestContext, caller sacloud.APICaller) error {
+	client := sacloud.NewProxyLBOp(caller)
+	return client.Delete(context.Background(), sacloud.DefaultZone, testContext.ID)
+}
@@ -0,0 +1,28 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegionOp_Find(t *testing.T) {
+	client := sacloud.NewRegionOp(singletonAPICaller())
+
+	regions, err := client.Find(context.Background(), sacloud.DefaultZone, &sacloud.FindCondition{})
+	require.NoError(t, err)
+	require.NotEmpty(t, regions)
+
+	region, err := client.Read(context.Background(), sacloud.DefaultZone, regions[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, regions[0], region)
+
+	// ゾーンは所属するリージョンを保持している
+	zones, err := sacloud.NewZoneOp(singletonAPICaller()).Find(context.Background(), sacloud.DefaultZone, &sacloud.FindCondition{})
+	require.NoError(t, err)
+	for _, zone := range zones {
+		require.NotNil(t, zone.Region, "%s", zone.Name)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/fake"
 	"github.com/sacloud/libsacloud-v2/sacloud/types"
 	"github.com/stretchr/testify/require"
 )
@@ -192,3 +193,68 @@ func TestServerOp_ChangePlan(t *testing.T) {
 	require.NoError(t, err)
 
 }
+
+func TestServerOp_Console(t *testing.T) {
+	client := sacloud.NewServerOp(singletonAPICaller())
+	ctx := context.Background()
+	server, err := client.Create(ctx, testZone, &sacloud.ServerCreateRequest{
+		CPU:      1,
+		MemoryMB: 1 * 1024,
+		ConnectedSwitches: []*sacloud.ConnectedSwitch{
+			{
+				Scope: types.Scopes.Shared,
+			},
+		},
+		InterfaceDriver:   types.InterfaceDrivers.VirtIO,
+		HostName:          "libsacloud-v2-server-console",
+		Name:              "libsacloud-v2-server-console",
+		WaitDiskMigration: false,
+	})
+	require.NoError(t, err)
+
+	// 停止中は利用できない
+	_, err = client.GetVNCProxy(ctx, testZone, server.ID)
+	require.Error(t, err)
+
+	require.NoError(t, client.Boot(ctx, testZone, server.ID))
+	_, err = sacloud.WaiterForUp(func() (interface{}, error) {
+		return client.Read(ctx, testZone, server.ID)
+	}).WaitForState(ctx)
+	require.NoError(t, err)
+
+	// vnc proxy
+	vncProxy, err := client.GetVNCProxy(ctx, testZone, server.ID)
+	require.NoError(t, err)
+	require.NotEmpty(t, vncProxy.Host)
+	require.NotEmpty(t, vncProxy.Port)
+	require.NotEmpty(t, vncProxy.Password)
+	require.NotEmpty(t, vncProxy.VNCFile)
+
+	// send key
+	err = client.SendKey(ctx, testZone, server.ID, &sacloud.SendKeyRequest{Key: "ctrl-alt-delete"})
+	require.NoError(t, err)
+	err = client.SendKey(ctx, testZone, server.ID, &sacloud.SendKeyRequest{Keys: []string{"ctrlleft", "altleft", "delete"}})
+	require.NoError(t, err)
+	if !isAccTest() {
+		require.Equal(t, []*sacloud.SendKeyRequest{
+			{Key: "ctrl-alt-delete"},
+			{Keys: []string{"ctrlleft", "altleft", "delete"}},
+		}, fake.SentKeys(testZone, server.ID))
+	}
+
+	// screenshot
+	screenshot, err := client.GetScreenshot(ctx, testZone, server.ID, &sacloud.GetScreenshotRequest{ScreenCaptureFormat: "png"})
+	require.NoError(t, err)
+	require.NotEmpty(t, screenshot.Image)
+
+	// send nmi
+	require.NoError(t, client.SendNMI(ctx, testZone, server.ID))
+
+	// cleanup
+	require.NoError(t, client.Shutdown(ctx, testZone, server.ID, &sacloud.ShutdownOption{Force: true}))
+	_, err = sacloud.WaiterForDown(func() (interface{}, error) {
+		return client.Read(ctx, testZone, server.ID)
+	}).WaitForState(ctx)
+	require.NoError(t, err)
+	require.NoError(t, client.Delete(ctx, testZone, server.ID))
+}
@@ -0,0 +1,136 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/sacloud/libsacloud-v2/sacloud/types"
+)
+
+func TestSimpleMonitorOpCRUD(t *testing.T) {
+	Run(t, &CRUDTestCase{
+		Parallel: true,
+
+		SetupAPICaller: singletonAPICaller,
+
+		Create: &CRUDTestFunc{
+			Func: testSimpleMonitorCreate,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  createSimpleMonitorExpected,
+				IgnoreFields: ignoreSimpleMonitorFields,
+			},
+		},
+
+		Read: &CRUDTestFunc{
+			Func: testSimpleMonitorRead,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  createSimpleMonitorExpected,
+				IgnoreFields: ignoreSimpleMonitorFields,
+			},
+		},
+
+		Update: &CRUDTestFunc{
+			Func: testSimpleMonitorUpdate,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  updateSimpleMonitorExpected,
+				IgnoreFields: ignoreSimpleMonitorFields,
+			},
+		},
+
+		Delete: &CRUDTestDeleteFunc{
+			Func: testSimpleMonitorDelete,
+		},
+	})
+}
+
+var (
+	ignoreSimpleMonitorFields = []string{
+		"ID",
+		"Class",
+		"SettingsHash",
+		"IconID",
+		"CreatedAt",
+		"ModifiedAt",
+	}
+	createSimpleMonitorParam = &sacloud.SimpleMonitorCreateRequest{
+		Target:      "libsacloud-v2-simple-monitor.usacloud.jp",
+		Description: "desc",
+		Tags:        []string{"tag1", "tag2"},
+		DelayLoop:   60,
+		Enabled:     types.StringTrue,
+		HealthCheck: &sacloud.SimpleMonitorHealthCheck{
+			Protocol: types.SimpleMonitorProtocols.HTTP,
+			Port:     types.StringNumber(80),
+			Path:     "/index.html",
+			Status:   types.StringNumber(200),
+			Host:     "usacloud.jp",
+		},
+		NotifyEmailEnabled: types.StringTrue,
+		NotifyEmailHTML:    types.StringTrue,
+		NotifySlackEnabled: types.StringFalse,
+		NotifyInterval:     2 * 60 * 60,
+	}
+	createSimpleMonitorExpected = &sacloud.SimpleMonitor{
+		Name:               createSimpleMonitorParam.Target,
+		Target:             createSimpleMonitorParam.Target,
+		Description:        createSimpleMonitorParam.Description,
+		Tags:               createSimpleMonitorParam.Tags,
+		Availability:       types.Availabilities.Available,
+		DelayLoop:          createSimpleMonitorParam.DelayLoop,
+		Enabled:            createSimpleMonitorParam.Enabled,
+		HealthCheck:        createSimpleMonitorParam.HealthCheck,
+		NotifyEmailEnabled: createSimpleMonitorParam.NotifyEmailEnabled,
+		NotifyEmailHTML:    createSimpleMonitorParam.NotifyEmailHTML,
+		NotifySlackEnabled: createSimpleMonitorParam.NotifySlackEnabled,
+		NotifyInterval:     createSimpleMonitorParam.NotifyInterval,
+	}
+	updateSimpleMonitorParam = &sacloud.SimpleMonitorUpdateRequest{
+		Description: "desc-upd",
+		Tags:        []string{"tag1-upd", "tag2-upd"},
+		DelayLoop:   120,
+		Enabled:     types.StringFalse,
+		HealthCheck: &sacloud.SimpleMonitorHealthCheck{
+			Protocol:      types.SimpleMonitorProtocols.SSLCertificate,
+			RemainingDays: 30,
+		},
+		NotifyEmailEnabled: types.StringFalse,
+		NotifySlackEnabled: types.StringTrue,
+		SlackWebhooksURL:   "https://hooks.slack.com/services/XXXXXXXXX/XXXXXXXXX/XXXXXXXXXXXXXXXXXXXXXXXX",
+		NotifyInterval:     3 * 60 * 60,
+	}
+	updateSimpleMonitorExpected = &sacloud.SimpleMonitor{
+		Name:               createSimpleMonitorParam.Target,
+		Target:             createSimpleMonitorParam.Target,
+		Description:        updateSimpleMonitorParam.Description,
+		Tags:               updateSimpleMonitorParam.Tags,
+		Availability:       types.Availabilities.Available,
+		DelayLoop:          updateSimpleMonitorParam.DelayLoop,
+		Enabled:            updateSimpleMonitorParam.Enabled,
+		HealthCheck:        updateSimpleMonitorParam.HealthCheck,
+		NotifyEmailEnabled: updateSimpleMonitorParam.NotifyEmailEnabled,
+		NotifySlackEnabled: updateSimpleMonitorParam.NotifySlackEnabled,
+		SlackWebhooksURL:   updateSimpleMonitorParam.SlackWebhooksURL,
+		NotifyInterval:     updateSimpleMonitorParam.NotifyInterval,
+	}
+)
+
+func testSimpleMonitorCreate(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewSimpleMonitorOp(caller)
+	return client.Create(context.Background(), sacloud.DefaultZone, createSimpleMonitorParam)
+}
+
+func testSimpleMonitorRead(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewSimpleMonitorOp(caller)
+	return client.Read(context.Background(), sacloud.DefaultZone, testContext.ID)
+}
+
+func testSimpleMonitorUpdate(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewSimpleMonitorOp(caller)
+	return client.Update(context.Background(), sacloud.DefaultZone, testContext.ID, updateSimpleMonitorParam)
+}
+
+func testSimpleMonitorDelete(testContext *CRUDTestContext, caller sacloud.APICaller) error {
+	client := sacloud.NewSimpleMonitorOp(caller)
+	return client.Delete(context.Background(), sacloud.DefaultZone, testContext.ID)
+}
@@ -0,0 +1,101 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSHKeyOpCRUD(t *testing.T) {
+	Run(t, &CRUDTestCase{
+		Parallel:          true,
+		IgnoreStartupWait: true,
+		SetupAPICaller:    singletonAPICaller,
+		Create: &CRUDTestFunc{
+			Func: testSSHKeyCreate,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  createSSHKeyExpected,
+				IgnoreFields: ignoreSSHKeyFields,
+			},
+		},
+		Read: &CRUDTestFunc{
+			Func: testSSHKeyRead,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  createSSHKeyExpected,
+				IgnoreFields: ignoreSSHKeyFields,
+			},
+		},
+		Update: &CRUDTestFunc{
+			Func: testSSHKeyUpdate,
+			Expect: &CRUDTestExpect{
+				ExpectValue:  updateSSHKeyExpected,
+				IgnoreFields: ignoreSSHKeyFields,
+			},
+		},
+		Delete: &CRUDTestDeleteFunc{
+			Func: testSSHKeyDelete,
+		},
+	})
+}
+
+var (
+	ignoreSSHKeyFields = []string{"ID", "CreatedAt", "Fingerprint"}
+
+	createSSHKeyParam = &sacloud.SSHKeyCreateRequest{
+		Name:        "libsacloud-v2-sshkey",
+		Description: "desc",
+		PublicKey:   "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAAAgQDKiMLz5q+3V7kwGYnGqvY8BhdZoCPZlf7OLsg1dEHrs0FgJpzZ7qiQbCGGx6tR0V5R2gEJ/SOfGA6wVkQmX8+HuVHdcyJGuRaXHvfSvM2Ss3JZ29mOmsBkINpRx/SJ0JmqMxlO1IzGALk9ivsX9Zsgu4WRz2f/LGp/+9Br8oB1+w==",
+	}
+	createSSHKeyExpected = &sacloud.SSHKey{
+		Name:        createSSHKeyParam.Name,
+		Description: createSSHKeyParam.Description,
+		PublicKey:   createSSHKeyParam.PublicKey,
+	}
+	updateSSHKeyParam = &sacloud.SSHKeyUpdateRequest{
+		Name:        "libsacloud-v2-sshkey-upd",
+		Description: "desc-upd",
+	}
+	updateSSHKeyExpected = &sacloud.SSHKey{
+		Name:        updateSSHKeyParam.Name,
+		Description: updateSSHKeyParam.Description,
+		PublicKey:   createSSHKeyParam.PublicKey,
+	}
+)
+
+func testSSHKeyCreate(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewSSHKeyOp(caller)
+	return client.Create(context.Background(), sacloud.DefaultZone, createSSHKeyParam)
+}
+
+func testSSHKeyRead(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewSSHKeyOp(caller)
+	return client.Read(context.Background(), sacloud.DefaultZone, testContext.ID)
+}
+
+func testSSHKeyUpdate(testContext *CRUDTestContext, caller sacloud.APICaller) (interface{}, error) {
+	client := sacloud.NewSSHKeyOp(caller)
+	return client.Update(context.Background(), sacloud.DefaultZone, testContext.ID, updateSSHKeyParam)
+}
+
+func testSSHKeyDelete(testContext *CRUDTestContext, caller sacloud.APICaller) error {
+	client := sacloud.NewSSHKeyOp(caller)
+	return client.Delete(context.Background(), sacloud.DefaultZone, testContext.ID)
+}
+
+func TestSSHKeyOp_Generate(t *testing.T) {
+	client := sacloud.NewSSHKeyOp(singletonAPICaller())
+	ctx := context.Background()
+
+	key, err := client.Generate(ctx, sacloud.DefaultZone, &sacloud.SSHKeyGenerateRequest{
+		Name:        "libsacloud-v2-sshkey-generate",
+		Description: "desc",
+	})
+	require.NoError(t, err)
+	defer client.Delete(ctx, sacloud.DefaultZone, key.ID) // nolint
+
+	require.NotEmpty(t, key.PublicKey)
+	require.NotEmpty(t, key.PrivateKey)
+	require.NotEmpty(t, key.Fingerprint)
+}
@@ -0,0 +1,23 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sacloud/libsacloud-v2/sacloud"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageOp_Find(t *testing.T) {
+	client := sacloud.NewStorageOp(singletonAPICaller())
+
+	storages, err := client.Find(context.Background(), testZone, &sacloud.FindCondition{})
+	require.NoError(t, err)
+	require.NotEmpty(t, storages)
+
+	storage, err := client.Read(context.Background(), testZone, storages[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, storages[0], storage)
+	require.False(t, storage.DiskPlanID.IsEmpty())
+	require.False(t, storage.ZoneID.IsEmpty())
+}
@@ -56,6 +56,26 @@ func (t *ArchiveTracer) CreateBlank(ctx context.Context, zone string, param *sac
 	return t.Internal.CreateBlank(ctx, zone, param)
 }
 
+// Transfer is API call with trace log
+func (t *ArchiveTracer) Transfer(ctx context.Context, zone string, id types.ID, destZoneID types.ID, param *sacloud.ArchiveTransferRequest) (*sacloud.Archive, error) {
+	log.Println("[TRACE] ArchiveTracer.Transfer start:	args => [", "zone=", zone, "id=", id, "destZoneID=", destZoneID, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] ArchiveTracer.Transfer: end")
+	}()
+
+	return t.Internal.Transfer(ctx, zone, id, destZoneID, param)
+}
+
+// CreateFromShared is API call with trace log
+func (t *ArchiveTracer) CreateFromShared(ctx context.Context, zone string, id types.ID, destZoneID types.ID, param *sacloud.ArchiveCreateFromSharedRequest) (*sacloud.Archive, error) {
+	log.Println("[TRACE] ArchiveTracer.CreateFromShared start:	args => [", "zone=", zone, "id=", id, "destZoneID=", destZoneID, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] ArchiveTracer.CreateFromShared: end")
+	}()
+
+	return t.Internal.CreateFromShared(ctx, zone, id, destZoneID, param)
+}
+
 // Read is API call with trace log
 func (t *ArchiveTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Archive, error) {
 	log.Println("[TRACE] ArchiveTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
@@ -106,6 +126,184 @@ func (t *ArchiveTracer) CloseFTP(ctx context.Context, zone string, id types.ID)
 	return t.Internal.CloseFTP(ctx, zone, id)
 }
 
+// Share is API call with trace log
+func (t *ArchiveTracer) Share(ctx context.Context, zone string, id types.ID, param *sacloud.ArchiveShareRequest) (*sacloud.ArchiveShareInfo, error) {
+	log.Println("[TRACE] ArchiveTracer.Share start:	args => [", "zone=", zone, "id=", id, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] ArchiveTracer.Share: end")
+	}()
+
+	return t.Internal.Share(ctx, zone, id, param)
+}
+
+/*************************************************
+* AuthStatusTracer
+*************************************************/
+
+// AuthStatusTracer is for trace AuthStatusOp operations
+type AuthStatusTracer struct {
+	Internal sacloud.AuthStatusAPI
+}
+
+// NewAuthStatusTracer creates new AuthStatusTracer instance
+func NewAuthStatusTracer(in sacloud.AuthStatusAPI) sacloud.AuthStatusAPI {
+	return &AuthStatusTracer{
+		Internal: in,
+	}
+}
+
+// Read is API call with trace log
+func (t *AuthStatusTracer) Read(ctx context.Context, zone string) (*sacloud.AuthStatus, error) {
+	log.Println("[TRACE] AuthStatusTracer.Read start:	args => [", "zone=", zone, "]")
+	defer func() {
+		log.Println("[TRACE] AuthStatusTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone)
+}
+
+/*************************************************
+* AutoBackupTracer
+*************************************************/
+
+// AutoBackupTracer is for trace AutoBackupOp operations
+type AutoBackupTracer struct {
+	Internal sacloud.AutoBackupAPI
+}
+
+// NewAutoBackupTracer creates new AutoBackupTracer instance
+func NewAutoBackupTracer(in sacloud.AutoBackupAPI) sacloud.AutoBackupAPI {
+	return &AutoBackupTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *AutoBackupTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.AutoBackup, error) {
+	log.Println("[TRACE] AutoBackupTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+	defer func() {
+		log.Println("[TRACE] AutoBackupTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, conditions)
+}
+
+// Create is API call with trace log
+func (t *AutoBackupTracer) Create(ctx context.Context, zone string, param *sacloud.AutoBackupCreateRequest) (*sacloud.AutoBackup, error) {
+	log.Println("[TRACE] AutoBackupTracer.Create start:	args => [", "zone=", zone, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] AutoBackupTracer.Create: end")
+	}()
+
+	return t.Internal.Create(ctx, zone, param)
+}
+
+// Read is API call with trace log
+func (t *AutoBackupTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.AutoBackup, error) {
+	log.Println("[TRACE] AutoBackupTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] AutoBackupTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone, id)
+}
+
+// Update is API call with trace log
+func (t *AutoBackupTracer) Update(ctx context.Context, zone string, id types.ID, param *sacloud.AutoBackupUpdateRequest) (*sacloud.AutoBackup, error) {
+	log.Println("[TRACE] AutoBackupTracer.Update start:	args => [", "zone=", zone, "id=", id, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] AutoBackupTracer.Update: end")
+	}()
+
+	return t.Internal.Update(ctx, zone, id, param)
+}
+
+// Delete is API call with trace log
+func (t *AutoBackupTracer) Delete(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] AutoBackupTracer.Delete start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] AutoBackupTracer.Delete: end")
+	}()
+
+	return t.Internal.Delete(ctx, zone, id)
+}
+
+/*************************************************
+* BillTracer
+*************************************************/
+
+// BillTracer is for trace BillOp operations
+type BillTracer struct {
+	Internal sacloud.BillAPI
+}
+
+// NewBillTracer creates new BillTracer instance
+func NewBillTracer(in sacloud.BillAPI) sacloud.BillAPI {
+	return &BillTracer{
+		Internal: in,
+	}
+}
+
+// ByContract is API call with trace log
+func (t *BillTracer) ByContract(ctx context.Context, zone string, accountID types.ID) ([]*sacloud.Bill, error) {
+	log.Println("[TRACE] BillTracer.ByContract start:	args => [", "zone=", zone, "accountID=", accountID, "]")
+	defer func() {
+		log.Println("[TRACE] BillTracer.ByContract: end")
+	}()
+
+	return t.Internal.ByContract(ctx, zone, accountID)
+}
+
+// ByContractYear is API call with trace log
+func (t *BillTracer) ByContractYear(ctx context.Context, zone string, accountID types.ID, year int) ([]*sacloud.Bill, error) {
+	log.Println("[TRACE] BillTracer.ByContractYear start:	args => [", "zone=", zone, "accountID=", accountID, "year=", year, "]")
+	defer func() {
+		log.Println("[TRACE] BillTracer.ByContractYear: end")
+	}()
+
+	return t.Internal.ByContractYear(ctx, zone, accountID, year)
+}
+
+// ByContractYearMonth is API call with trace log
+func (t *BillTracer) ByContractYearMonth(ctx context.Context, zone string, accountID types.ID, year int, month int) ([]*sacloud.Bill, error) {
+	log.Println("[TRACE] BillTracer.ByContractYearMonth start:	args => [", "zone=", zone, "accountID=", accountID, "year=", year, "month=", month, "]")
+	defer func() {
+		log.Println("[TRACE] BillTracer.ByContractYearMonth: end")
+	}()
+
+	return t.Internal.ByContractYearMonth(ctx, zone, accountID, year, month)
+}
+
+// Read is API call with trace log
+func (t *BillTracer) Read(ctx context.Context, zone string, id types.ID) ([]*sacloud.Bill, error) {
+	log.Println("[TRACE] BillTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] BillTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone, id)
+}
+
+// Details is API call with trace log
+func (t *BillTracer) Details(ctx context.Context, zone string, memberCode string, id types.ID) ([]*sacloud.BillDetail, error) {
+	log.Println("[TRACE] BillTracer.Details start:	args => [", "zone=", zone, "memberCode=", memberCode, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] BillTracer.Details: end")
+	}()
+
+	return t.Internal.Details(ctx, zone, memberCode, id)
+}
+
+// DetailsCSV is API call with trace log
+func (t *BillTracer) DetailsCSV(ctx context.Context, zone string, memberCode string, id types.ID) (*sacloud.BillDetailCSV, error) {
+	log.Println("[TRACE] BillTracer.DetailsCSV start:	args => [", "zone=", zone, "memberCode=", memberCode, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] BillTracer.DetailsCSV: end")
+	}()
+
+	return t.Internal.DetailsCSV(ctx, zone, memberCode, id)
+}
+
 /*************************************************
 * BridgeTracer
 *************************************************/
@@ -258,6 +456,208 @@ func (t *CDROMTracer) CloseFTP(ctx context.Context, zone string, id types.ID) er
 	return t.Internal.CloseFTP(ctx, zone, id)
 }
 
+/*************************************************
+* CouponTracer
+*************************************************/
+
+// CouponTracer is for trace CouponOp operations
+type CouponTracer struct {
+	Internal sacloud.CouponAPI
+}
+
+// NewCouponTracer creates new CouponTracer instance
+func NewCouponTracer(in sacloud.CouponAPI) sacloud.CouponAPI {
+	return &CouponTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *CouponTracer) Find(ctx context.Context, zone string, accountID types.ID) ([]*sacloud.Coupon, error) {
+	log.Println("[TRACE] CouponTracer.Find start:	args => [", "zone=", zone, "accountID=", accountID, "]")
+	defer func() {
+		log.Println("[TRACE] CouponTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, accountID)
+}
+
+/*************************************************
+* DatabaseTracer
+*************************************************/
+
+// DatabaseTracer is for trace DatabaseOp operations
+type DatabaseTracer struct {
+	Internal sacloud.DatabaseAPI
+}
+
+// NewDatabaseTracer creates new DatabaseTracer instance
+func NewDatabaseTracer(in sacloud.DatabaseAPI) sacloud.DatabaseAPI {
+	return &DatabaseTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *DatabaseTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Database, error) {
+	log.Println("[TRACE] DatabaseTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+	defer func() {
+		log.Println("[TRACE] DatabaseTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, conditions)
+}
+
+// Create is API call with trace log
+func (t *DatabaseTracer) Create(ctx context.Context, zone string, param *sacloud.DatabaseCreateRequest) (*sacloud.Database, error) {
+	log.Println("[TRACE] DatabaseTracer.Create start:	args => [", "zone=", zone, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] DatabaseTracer.Create: end")
+	}()
+
+	return t.Internal.Create(ctx, zone, param)
+}
+
+// Read is API call with trace log
+func (t *DatabaseTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Database, error) {
+	log.Println("[TRACE] DatabaseTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] DatabaseTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone, id)
+}
+
+// Update is API call with trace log
+func (t *DatabaseTracer) Update(ctx context.Context, zone string, id types.ID, param *sacloud.DatabaseUpdateRequest) (*sacloud.Database, error) {
+	log.Println("[TRACE] DatabaseTracer.Update start:	args => [", "zone=", zone, "id=", id, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] DatabaseTracer.Update: end")
+	}()
+
+	return t.Internal.Update(ctx, zone, id, param)
+}
+
+// Delete is API call with trace log
+func (t *DatabaseTracer) Delete(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] DatabaseTracer.Delete start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] DatabaseTracer.Delete: end")
+	}()
+
+	return t.Internal.Delete(ctx, zone, id)
+}
+
+// Config is API call with trace log
+func (t *DatabaseTracer) Config(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] DatabaseTracer.Config start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] DatabaseTracer.Config: end")
+	}()
+
+	return t.Internal.Config(ctx, zone, id)
+}
+
+// Boot is API call with trace log
+func (t *DatabaseTracer) Boot(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] DatabaseTracer.Boot start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] DatabaseTracer.Boot: end")
+	}()
+
+	return t.Internal.Boot(ctx, zone, id)
+}
+
+// Shutdown is API call with trace log
+func (t *DatabaseTracer) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
+	log.Println("[TRACE] DatabaseTracer.Shutdown start:	args => [", "zone=", zone, "id=", id, "shutdownOption=", shutdownOption, "]")
+	defer func() {
+		log.Println("[TRACE] DatabaseTracer.Shutdown: end")
+	}()
+
+	return t.Internal.Shutdown(ctx, zone, id, shutdownOption)
+}
+
+// Reset is API call with trace log
+func (t *DatabaseTracer) Reset(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] DatabaseTracer.Reset start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] DatabaseTracer.Reset: end")
+	}()
+
+	return t.Internal.Reset(ctx, zone, id)
+}
+
+// MonitorCPU is API call with trace log
+func (t *DatabaseTracer) MonitorCPU(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.CPUTimeActivity, error) {
+	log.Println("[TRACE] DatabaseTracer.MonitorCPU start:	args => [", "zone=", zone, "id=", id, "condition=", condition, "]")
+	defer func() {
+		log.Println("[TRACE] DatabaseTracer.MonitorCPU: end")
+	}()
+
+	return t.Internal.MonitorCPU(ctx, zone, id, condition)
+}
+
+// MonitorDisk is API call with trace log
+func (t *DatabaseTracer) MonitorDisk(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.DiskActivity, error) {
+	log.Println("[TRACE] DatabaseTracer.MonitorDisk start:	args => [", "zone=", zone, "id=", id, "condition=", condition, "]")
+	defer func() {
+		log.Println("[TRACE] DatabaseTracer.MonitorDisk: end")
+	}()
+
+	return t.Internal.MonitorDisk(ctx, zone, id, condition)
+}
+
+// MonitorInterface is API call with trace log
+func (t *DatabaseTracer) MonitorInterface(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
+	log.Println("[TRACE] DatabaseTracer.MonitorInterface start:	args => [", "zone=", zone, "id=", id, "condition=", condition, "]")
+	defer func() {
+		log.Println("[TRACE] DatabaseTracer.MonitorInterface: end")
+	}()
+
+	return t.Internal.MonitorInterface(ctx, zone, id, condition)
+}
+
+// MonitorDatabase is API call with trace log
+func (t *DatabaseTracer) MonitorDatabase(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.DatabaseActivity, error) {
+	log.Println("[TRACE] DatabaseTracer.MonitorDatabase start:	args => [", "zone=", zone, "id=", id, "condition=", condition, "]")
+	defer func() {
+		log.Println("[TRACE] DatabaseTracer.MonitorDatabase: end")
+	}()
+
+	return t.Internal.MonitorDatabase(ctx, zone, id, condition)
+}
+
+// Status is API call with trace log
+func (t *DatabaseTracer) Status(ctx context.Context, zone string, id types.ID) (*sacloud.DatabaseStatus, error) {
+	log.Println("[TRACE] DatabaseTracer.Status start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] DatabaseTracer.Status: end")
+	}()
+
+	return t.Internal.Status(ctx, zone, id)
+}
+
+// GetParameter is API call with trace log
+func (t *DatabaseTracer) GetParameter(ctx context.Context, zone string, id types.ID) (*sacloud.DatabaseParameter, error) {
+	log.Println("[TRACE] DatabaseTracer.GetParameter start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] DatabaseTracer.GetParameter: end")
+	}()
+
+	return t.Internal.GetParameter(ctx, zone, id)
+}
+
+// SetParameter is API call with trace log
+func (t *DatabaseTracer) SetParameter(ctx context.Context, zone string, id types.ID, param map[string]interface{}) error {
+	log.Println("[TRACE] DatabaseTracer.SetParameter start:	args => [", "zone=", zone, "id=", id, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] DatabaseTracer.SetParameter: end")
+	}()
+
+	return t.Internal.SetParameter(ctx, zone, id, param)
+}
+
 /*************************************************
 * DiskTracer
 *************************************************/
@@ -435,36 +835,164 @@ func (t *DiskTracer) Monitor(ctx context.Context, zone string, id types.ID, cond
 }
 
 /*************************************************
-* GSLBTracer
+* DiskPlanTracer
 *************************************************/
 
-// GSLBTracer is for trace GSLBOp operations
-type GSLBTracer struct {
-	Internal sacloud.GSLBAPI
+// DiskPlanTracer is for trace DiskPlanOp operations
+type DiskPlanTracer struct {
+	Internal sacloud.DiskPlanAPI
 }
 
-// NewGSLBTracer creates new GSLBTracer instance
-func NewGSLBTracer(in sacloud.GSLBAPI) sacloud.GSLBAPI {
-	return &GSLBTracer{
+// NewDiskPlanTracer creates new DiskPlanTracer instance
+func NewDiskPlanTracer(in sacloud.DiskPlanAPI) sacloud.DiskPlanAPI {
+	return &DiskPlanTracer{
 		Internal: in,
 	}
 }
 
 // Find is API call with trace log
-func (t *GSLBTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.GSLB, error) {
-	log.Println("[TRACE] GSLBTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+func (t *DiskPlanTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.DiskPlan, error) {
+	log.Println("[TRACE] DiskPlanTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
 	defer func() {
-		log.Println("[TRACE] GSLBTracer.Find: end")
+		log.Println("[TRACE] DiskPlanTracer.Find: end")
 	}()
 
 	return t.Internal.Find(ctx, zone, conditions)
 }
 
-// Create is API call with trace log
-func (t *GSLBTracer) Create(ctx context.Context, zone string, param *sacloud.GSLBCreateRequest) (*sacloud.GSLB, error) {
-	log.Println("[TRACE] GSLBTracer.Create start:	args => [", "zone=", zone, "param=", param, "]")
+// Read is API call with trace log
+func (t *DiskPlanTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.DiskPlan, error) {
+	log.Println("[TRACE] DiskPlanTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
 	defer func() {
-		log.Println("[TRACE] GSLBTracer.Create: end")
+		log.Println("[TRACE] DiskPlanTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone, id)
+}
+
+/*************************************************
+* DNSTracer
+*************************************************/
+
+// DNSTracer is for trace DNSOp operations
+type DNSTracer struct {
+	Internal sacloud.DNSAPI
+}
+
+// NewDNSTracer creates new DNSTracer instance
+func NewDNSTracer(in sacloud.DNSAPI) sacloud.DNSAPI {
+	return &DNSTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *DNSTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.DNS, error) {
+	log.Println("[TRACE] DNSTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+	defer func() {
+		log.Println("[TRACE] DNSTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, conditions)
+}
+
+// Create is API call with trace log
+func (t *DNSTracer) Create(ctx context.Context, zone string, param *sacloud.DNSCreateRequest) (*sacloud.DNS, error) {
+	log.Println("[TRACE] DNSTracer.Create start:	args => [", "zone=", zone, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] DNSTracer.Create: end")
+	}()
+
+	return t.Internal.Create(ctx, zone, param)
+}
+
+// Read is API call with trace log
+func (t *DNSTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.DNS, error) {
+	log.Println("[TRACE] DNSTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] DNSTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone, id)
+}
+
+// Update is API call with trace log
+func (t *DNSTracer) Update(ctx context.Context, zone string, id types.ID, param *sacloud.DNSUpdateRequest) (*sacloud.DNS, error) {
+	log.Println("[TRACE] DNSTracer.Update start:	args => [", "zone=", zone, "id=", id, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] DNSTracer.Update: end")
+	}()
+
+	return t.Internal.Update(ctx, zone, id, param)
+}
+
+// Delete is API call with trace log
+func (t *DNSTracer) Delete(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] DNSTracer.Delete start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] DNSTracer.Delete: end")
+	}()
+
+	return t.Internal.Delete(ctx, zone, id)
+}
+
+/*************************************************
+* ESUsageTracer
+*************************************************/
+
+// ESUsageTracer is for trace ESUsageOp operations
+type ESUsageTracer struct {
+	Internal sacloud.ESUsageAPI
+}
+
+// NewESUsageTracer creates new ESUsageTracer instance
+func NewESUsageTracer(in sacloud.ESUsageAPI) sacloud.ESUsageAPI {
+	return &ESUsageTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *ESUsageTracer) Find(ctx context.Context, zone string, accountID types.ID, year int, month int) ([]*sacloud.ESUsage, error) {
+	log.Println("[TRACE] ESUsageTracer.Find start:	args => [", "zone=", zone, "accountID=", accountID, "year=", year, "month=", month, "]")
+	defer func() {
+		log.Println("[TRACE] ESUsageTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, accountID, year, month)
+}
+
+/*************************************************
+* GSLBTracer
+*************************************************/
+
+// GSLBTracer is for trace GSLBOp operations
+type GSLBTracer struct {
+	Internal sacloud.GSLBAPI
+}
+
+// NewGSLBTracer creates new GSLBTracer instance
+func NewGSLBTracer(in sacloud.GSLBAPI) sacloud.GSLBAPI {
+	return &GSLBTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *GSLBTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.GSLB, error) {
+	log.Println("[TRACE] GSLBTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+	defer func() {
+		log.Println("[TRACE] GSLBTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, conditions)
+}
+
+// Create is API call with trace log
+func (t *GSLBTracer) Create(ctx context.Context, zone string, param *sacloud.GSLBCreateRequest) (*sacloud.GSLB, error) {
+	log.Println("[TRACE] GSLBTracer.Create start:	args => [", "zone=", zone, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] GSLBTracer.Create: end")
 	}()
 
 	return t.Internal.Create(ctx, zone, param)
@@ -500,6 +1028,82 @@ func (t *GSLBTracer) Delete(ctx context.Context, zone string, id types.ID) error
 	return t.Internal.Delete(ctx, zone, id)
 }
 
+/*************************************************
+* IconTracer
+*************************************************/
+
+// IconTracer is for trace IconOp operations
+type IconTracer struct {
+	Internal sacloud.IconAPI
+}
+
+// NewIconTracer creates new IconTracer instance
+func NewIconTracer(in sacloud.IconAPI) sacloud.IconAPI {
+	return &IconTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *IconTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Icon, error) {
+	log.Println("[TRACE] IconTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+	defer func() {
+		log.Println("[TRACE] IconTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, conditions)
+}
+
+// Create is API call with trace log
+func (t *IconTracer) Create(ctx context.Context, zone string, param *sacloud.IconCreateRequest) (*sacloud.Icon, error) {
+	log.Println("[TRACE] IconTracer.Create start:	args => [", "zone=", zone, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] IconTracer.Create: end")
+	}()
+
+	return t.Internal.Create(ctx, zone, param)
+}
+
+// Read is API call with trace log
+func (t *IconTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Icon, error) {
+	log.Println("[TRACE] IconTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] IconTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone, id)
+}
+
+// Update is API call with trace log
+func (t *IconTracer) Update(ctx context.Context, zone string, id types.ID, param *sacloud.IconUpdateRequest) (*sacloud.Icon, error) {
+	log.Println("[TRACE] IconTracer.Update start:	args => [", "zone=", zone, "id=", id, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] IconTracer.Update: end")
+	}()
+
+	return t.Internal.Update(ctx, zone, id, param)
+}
+
+// Delete is API call with trace log
+func (t *IconTracer) Delete(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] IconTracer.Delete start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] IconTracer.Delete: end")
+	}()
+
+	return t.Internal.Delete(ctx, zone, id)
+}
+
+// GetImage is API call with trace log
+func (t *IconTracer) GetImage(ctx context.Context, zone string, id types.ID, size types.EIconSize) (*sacloud.IconImage, error) {
+	log.Println("[TRACE] IconTracer.GetImage start:	args => [", "zone=", zone, "id=", id, "size=", size, "]")
+	defer func() {
+		log.Println("[TRACE] IconTracer.GetImage: end")
+	}()
+
+	return t.Internal.GetImage(ctx, zone, id, size)
+}
+
 /*************************************************
 * InterfaceTracer
 *************************************************/
@@ -742,161 +1346,713 @@ func (t *InternetTracer) Monitor(ctx context.Context, zone string, id types.ID,
 	return t.Internal.Monitor(ctx, zone, id, condition)
 }
 
+// EnableIPv6 is API call with trace log
+func (t *InternetTracer) EnableIPv6(ctx context.Context, zone string, id types.ID) (*sacloud.IPv6NetInfo, error) {
+	log.Println("[TRACE] InternetTracer.EnableIPv6 start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] InternetTracer.EnableIPv6: end")
+	}()
+
+	return t.Internal.EnableIPv6(ctx, zone, id)
+}
+
+// DisableIPv6 is API call with trace log
+func (t *InternetTracer) DisableIPv6(ctx context.Context, zone string, id types.ID, ipv6netID types.ID) error {
+	log.Println("[TRACE] InternetTracer.DisableIPv6 start:	args => [", "zone=", zone, "id=", id, "ipv6netID=", ipv6netID, "]")
+	defer func() {
+		log.Println("[TRACE] InternetTracer.DisableIPv6: end")
+	}()
+
+	return t.Internal.DisableIPv6(ctx, zone, id, ipv6netID)
+}
+
 /*************************************************
-* LoadBalancerTracer
+* InternetPlanTracer
 *************************************************/
 
-// LoadBalancerTracer is for trace LoadBalancerOp operations
-type LoadBalancerTracer struct {
-	Internal sacloud.LoadBalancerAPI
+// InternetPlanTracer is for trace InternetPlanOp operations
+type InternetPlanTracer struct {
+	Internal sacloud.InternetPlanAPI
 }
 
-// NewLoadBalancerTracer creates new LoadBalancerTracer instance
-func NewLoadBalancerTracer(in sacloud.LoadBalancerAPI) sacloud.LoadBalancerAPI {
-	return &LoadBalancerTracer{
+// NewInternetPlanTracer creates new InternetPlanTracer instance
+func NewInternetPlanTracer(in sacloud.InternetPlanAPI) sacloud.InternetPlanAPI {
+	return &InternetPlanTracer{
 		Internal: in,
 	}
 }
 
 // Find is API call with trace log
-func (t *LoadBalancerTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.LoadBalancer, error) {
-	log.Println("[TRACE] LoadBalancerTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+func (t *InternetPlanTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.InternetPlan, error) {
+	log.Println("[TRACE] InternetPlanTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
 	defer func() {
-		log.Println("[TRACE] LoadBalancerTracer.Find: end")
+		log.Println("[TRACE] InternetPlanTracer.Find: end")
 	}()
 
 	return t.Internal.Find(ctx, zone, conditions)
 }
 
-// Create is API call with trace log
-func (t *LoadBalancerTracer) Create(ctx context.Context, zone string, param *sacloud.LoadBalancerCreateRequest) (*sacloud.LoadBalancer, error) {
-	log.Println("[TRACE] LoadBalancerTracer.Create start:	args => [", "zone=", zone, "param=", param, "]")
+// Read is API call with trace log
+func (t *InternetPlanTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.InternetPlan, error) {
+	log.Println("[TRACE] InternetPlanTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
 	defer func() {
-		log.Println("[TRACE] LoadBalancerTracer.Create: end")
+		log.Println("[TRACE] InternetPlanTracer.Read: end")
 	}()
 
-	return t.Internal.Create(ctx, zone, param)
+	return t.Internal.Read(ctx, zone, id)
 }
 
-// Read is API call with trace log
-func (t *LoadBalancerTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.LoadBalancer, error) {
-	log.Println("[TRACE] LoadBalancerTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
-	defer func() {
-		log.Println("[TRACE] LoadBalancerTracer.Read: end")
-	}()
+/*************************************************
+* IPAddressTracer
+*************************************************/
 
-	return t.Internal.Read(ctx, zone, id)
+// IPAddressTracer is for trace IPAddressOp operations
+type IPAddressTracer struct {
+	Internal sacloud.IPAddressAPI
 }
 
-// Update is API call with trace log
-func (t *LoadBalancerTracer) Update(ctx context.Context, zone string, id types.ID, param *sacloud.LoadBalancerUpdateRequest) (*sacloud.LoadBalancer, error) {
-	log.Println("[TRACE] LoadBalancerTracer.Update start:	args => [", "zone=", zone, "id=", id, "param=", param, "]")
+// NewIPAddressTracer creates new IPAddressTracer instance
+func NewIPAddressTracer(in sacloud.IPAddressAPI) sacloud.IPAddressAPI {
+	return &IPAddressTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *IPAddressTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.IPAddress, error) {
+	log.Println("[TRACE] IPAddressTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
 	defer func() {
-		log.Println("[TRACE] LoadBalancerTracer.Update: end")
+		log.Println("[TRACE] IPAddressTracer.Find: end")
 	}()
 
-	return t.Internal.Update(ctx, zone, id, param)
+	return t.Internal.Find(ctx, zone, conditions)
 }
 
-// Delete is API call with trace log
-func (t *LoadBalancerTracer) Delete(ctx context.Context, zone string, id types.ID) error {
-	log.Println("[TRACE] LoadBalancerTracer.Delete start:	args => [", "zone=", zone, "id=", id, "]")
+// Read is API call with trace log
+func (t *IPAddressTracer) Read(ctx context.Context, zone string, ipAddress string) (*sacloud.IPAddress, error) {
+	log.Println("[TRACE] IPAddressTracer.Read start:	args => [", "zone=", zone, "ipAddress=", ipAddress, "]")
 	defer func() {
-		log.Println("[TRACE] LoadBalancerTracer.Delete: end")
+		log.Println("[TRACE] IPAddressTracer.Read: end")
 	}()
 
-	return t.Internal.Delete(ctx, zone, id)
+	return t.Internal.Read(ctx, zone, ipAddress)
 }
 
-// Config is API call with trace log
-func (t *LoadBalancerTracer) Config(ctx context.Context, zone string, id types.ID) error {
-	log.Println("[TRACE] LoadBalancerTracer.Config start:	args => [", "zone=", zone, "id=", id, "]")
+// UpdateHostName is API call with trace log
+func (t *IPAddressTracer) UpdateHostName(ctx context.Context, zone string, ipAddress string, hostName string) (*sacloud.IPAddress, error) {
+	log.Println("[TRACE] IPAddressTracer.UpdateHostName start:	args => [", "zone=", zone, "ipAddress=", ipAddress, "hostName=", hostName, "]")
 	defer func() {
-		log.Println("[TRACE] LoadBalancerTracer.Config: end")
+		log.Println("[TRACE] IPAddressTracer.UpdateHostName: end")
 	}()
 
-	return t.Internal.Config(ctx, zone, id)
+	return t.Internal.UpdateHostName(ctx, zone, ipAddress, hostName)
 }
 
-// Boot is API call with trace log
-func (t *LoadBalancerTracer) Boot(ctx context.Context, zone string, id types.ID) error {
-	log.Println("[TRACE] LoadBalancerTracer.Boot start:	args => [", "zone=", zone, "id=", id, "]")
+/*************************************************
+* IPv6AddrTracer
+*************************************************/
+
+// IPv6AddrTracer is for trace IPv6AddrOp operations
+type IPv6AddrTracer struct {
+	Internal sacloud.IPv6AddrAPI
+}
+
+// NewIPv6AddrTracer creates new IPv6AddrTracer instance
+func NewIPv6AddrTracer(in sacloud.IPv6AddrAPI) sacloud.IPv6AddrAPI {
+	return &IPv6AddrTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *IPv6AddrTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.IPv6Addr, error) {
+	log.Println("[TRACE] IPv6AddrTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
 	defer func() {
-		log.Println("[TRACE] LoadBalancerTracer.Boot: end")
+		log.Println("[TRACE] IPv6AddrTracer.Find: end")
 	}()
 
-	return t.Internal.Boot(ctx, zone, id)
+	return t.Internal.Find(ctx, zone, conditions)
 }
 
-// Shutdown is API call with trace log
-func (t *LoadBalancerTracer) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
-	log.Println("[TRACE] LoadBalancerTracer.Shutdown start:	args => [", "zone=", zone, "id=", id, "shutdownOption=", shutdownOption, "]")
+// Create is API call with trace log
+func (t *IPv6AddrTracer) Create(ctx context.Context, zone string, param *sacloud.IPv6AddrCreateRequest) (*sacloud.IPv6Addr, error) {
+	log.Println("[TRACE] IPv6AddrTracer.Create start:	args => [", "zone=", zone, "param=", param, "]")
 	defer func() {
-		log.Println("[TRACE] LoadBalancerTracer.Shutdown: end")
+		log.Println("[TRACE] IPv6AddrTracer.Create: end")
 	}()
 
-	return t.Internal.Shutdown(ctx, zone, id, shutdownOption)
+	return t.Internal.Create(ctx, zone, param)
 }
 
-// Reset is API call with trace log
-func (t *LoadBalancerTracer) Reset(ctx context.Context, zone string, id types.ID) error {
-	log.Println("[TRACE] LoadBalancerTracer.Reset start:	args => [", "zone=", zone, "id=", id, "]")
+// Read is API call with trace log
+func (t *IPv6AddrTracer) Read(ctx context.Context, zone string, ipv6addr string) (*sacloud.IPv6Addr, error) {
+	log.Println("[TRACE] IPv6AddrTracer.Read start:	args => [", "zone=", zone, "ipv6addr=", ipv6addr, "]")
 	defer func() {
-		log.Println("[TRACE] LoadBalancerTracer.Reset: end")
+		log.Println("[TRACE] IPv6AddrTracer.Read: end")
 	}()
 
-	return t.Internal.Reset(ctx, zone, id)
+	return t.Internal.Read(ctx, zone, ipv6addr)
 }
 
-// MonitorInterface is API call with trace log
-func (t *LoadBalancerTracer) MonitorInterface(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
-	log.Println("[TRACE] LoadBalancerTracer.MonitorInterface start:	args => [", "zone=", zone, "id=", id, "condition=", condition, "]")
+// Update is API call with trace log
+func (t *IPv6AddrTracer) Update(ctx context.Context, zone string, ipv6addr string, param *sacloud.IPv6AddrUpdateRequest) (*sacloud.IPv6Addr, error) {
+	log.Println("[TRACE] IPv6AddrTracer.Update start:	args => [", "zone=", zone, "ipv6addr=", ipv6addr, "param=", param, "]")
 	defer func() {
-		log.Println("[TRACE] LoadBalancerTracer.MonitorInterface: end")
+		log.Println("[TRACE] IPv6AddrTracer.Update: end")
 	}()
 
-	return t.Internal.MonitorInterface(ctx, zone, id, condition)
+	return t.Internal.Update(ctx, zone, ipv6addr, param)
 }
 
-// Status is API call with trace log
-func (t *LoadBalancerTracer) Status(ctx context.Context, zone string, id types.ID) ([]*sacloud.LoadBalancerStatus, error) {
-	log.Println("[TRACE] LoadBalancerTracer.Status start:	args => [", "zone=", zone, "id=", id, "]")
+// Delete is API call with trace log
+func (t *IPv6AddrTracer) Delete(ctx context.Context, zone string, ipv6addr string) error {
+	log.Println("[TRACE] IPv6AddrTracer.Delete start:	args => [", "zone=", zone, "ipv6addr=", ipv6addr, "]")
 	defer func() {
-		log.Println("[TRACE] LoadBalancerTracer.Status: end")
+		log.Println("[TRACE] IPv6AddrTracer.Delete: end")
 	}()
 
-	return t.Internal.Status(ctx, zone, id)
+	return t.Internal.Delete(ctx, zone, ipv6addr)
 }
 
 /*************************************************
-* NFSTracer
+* IPv6NetTracer
 *************************************************/
 
-// NFSTracer is for trace NFSOp operations
-type NFSTracer struct {
-	Internal sacloud.NFSAPI
+// IPv6NetTracer is for trace IPv6NetOp operations
+type IPv6NetTracer struct {
+	Internal sacloud.IPv6NetAPI
 }
 
-// NewNFSTracer creates new NFSTracer instance
-func NewNFSTracer(in sacloud.NFSAPI) sacloud.NFSAPI {
-	return &NFSTracer{
+// NewIPv6NetTracer creates new IPv6NetTracer instance
+func NewIPv6NetTracer(in sacloud.IPv6NetAPI) sacloud.IPv6NetAPI {
+	return &IPv6NetTracer{
 		Internal: in,
 	}
 }
 
 // Find is API call with trace log
-func (t *NFSTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.NFS, error) {
-	log.Println("[TRACE] NFSTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+func (t *IPv6NetTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.IPv6Net, error) {
+	log.Println("[TRACE] IPv6NetTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
 	defer func() {
-		log.Println("[TRACE] NFSTracer.Find: end")
+		log.Println("[TRACE] IPv6NetTracer.Find: end")
 	}()
 
 	return t.Internal.Find(ctx, zone, conditions)
 }
 
-// Create is API call with trace log
-func (t *NFSTracer) Create(ctx context.Context, zone string, param *sacloud.NFSCreateRequest) (*sacloud.NFS, error) {
-	log.Println("[TRACE] NFSTracer.Create start:	args => [", "zone=", zone, "param=", param, "]")
+// Read is API call with trace log
+func (t *IPv6NetTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.IPv6Net, error) {
+	log.Println("[TRACE] IPv6NetTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] IPv6NetTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone, id)
+}
+
+/*************************************************
+* LicenseTracer
+*************************************************/
+
+// LicenseTracer is for trace LicenseOp operations
+type LicenseTracer struct {
+	Internal sacloud.LicenseAPI
+}
+
+// NewLicenseTracer creates new LicenseTracer instance
+func NewLicenseTracer(in sacloud.LicenseAPI) sacloud.LicenseAPI {
+	return &LicenseTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *LicenseTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.License, error) {
+	log.Println("[TRACE] LicenseTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+	defer func() {
+		log.Println("[TRACE] LicenseTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, conditions)
+}
+
+// Create is API call with trace log
+func (t *LicenseTracer) Create(ctx context.Context, zone string, param *sacloud.LicenseCreateRequest) (*sacloud.License, error) {
+	log.Println("[TRACE] LicenseTracer.Create start:	args => [", "zone=", zone, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] LicenseTracer.Create: end")
+	}()
+
+	return t.Internal.Create(ctx, zone, param)
+}
+
+// Read is API call with trace log
+func (t *LicenseTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.License, error) {
+	log.Println("[TRACE] LicenseTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] LicenseTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone, id)
+}
+
+// Update is API call with trace log
+func (t *LicenseTracer) Update(ctx context.Context, zone string, id types.ID, param *sacloud.LicenseUpdateRequest) (*sacloud.License, error) {
+	log.Println("[TRACE] LicenseTracer.Update start:	args => [", "zone=", zone, "id=", id, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] LicenseTracer.Update: end")
+	}()
+
+	return t.Internal.Update(ctx, zone, id, param)
+}
+
+// Delete is API call with trace log
+func (t *LicenseTracer) Delete(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] LicenseTracer.Delete start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] LicenseTracer.Delete: end")
+	}()
+
+	return t.Internal.Delete(ctx, zone, id)
+}
+
+/*************************************************
+* LicensePlanTracer
+*************************************************/
+
+// LicensePlanTracer is for trace LicensePlanOp operations
+type LicensePlanTracer struct {
+	Internal sacloud.LicensePlanAPI
+}
+
+// NewLicensePlanTracer creates new LicensePlanTracer instance
+func NewLicensePlanTracer(in sacloud.LicensePlanAPI) sacloud.LicensePlanAPI {
+	return &LicensePlanTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *LicensePlanTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.LicensePlan, error) {
+	log.Println("[TRACE] LicensePlanTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+	defer func() {
+		log.Println("[TRACE] LicensePlanTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, conditions)
+}
+
+// Read is API call with trace log
+func (t *LicensePlanTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.LicensePlan, error) {
+	log.Println("[TRACE] LicensePlanTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] LicensePlanTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone, id)
+}
+
+/*************************************************
+* LoadBalancerTracer
+*************************************************/
+
+// LoadBalancerTracer is for trace LoadBalancerOp operations
+type LoadBalancerTracer struct {
+	Internal sacloud.LoadBalancerAPI
+}
+
+// NewLoadBalancerTracer creates new LoadBalancerTracer instance
+func NewLoadBalancerTracer(in sacloud.LoadBalancerAPI) sacloud.LoadBalancerAPI {
+	return &LoadBalancerTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *LoadBalancerTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.LoadBalancer, error) {
+	log.Println("[TRACE] LoadBalancerTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+	defer func() {
+		log.Println("[TRACE] LoadBalancerTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, conditions)
+}
+
+// Create is API call with trace log
+func (t *LoadBalancerTracer) Create(ctx context.Context, zone string, param *sacloud.LoadBalancerCreateRequest) (*sacloud.LoadBalancer, error) {
+	log.Println("[TRACE] LoadBalancerTracer.Create start:	args => [", "zone=", zone, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] LoadBalancerTracer.Create: end")
+	}()
+
+	return t.Internal.Create(ctx, zone, param)
+}
+
+// Read is API call with trace log
+func (t *LoadBalancerTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.LoadBalancer, error) {
+	log.Println("[TRACE] LoadBalancerTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] LoadBalancerTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone, id)
+}
+
+// Update is API call with trace log
+func (t *LoadBalancerTracer) Update(ctx context.Context, zone string, id types.ID, param *sacloud.LoadBalancerUpdateRequest) (*sacloud.LoadBalancer, error) {
+	log.Println("[TRACE] LoadBalancerTracer.Update start:	args => [", "zone=", zone, "id=", id, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] LoadBalancerTracer.Update: end")
+	}()
+
+	return t.Internal.Update(ctx, zone, id, param)
+}
+
+// Delete is API call with trace log
+func (t *LoadBalancerTracer) Delete(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] LoadBalancerTracer.Delete start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] LoadBalancerTracer.Delete: end")
+	}()
+
+	return t.Internal.Delete(ctx, zone, id)
+}
+
+// Config is API call with trace log
+func (t *LoadBalancerTracer) Config(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] LoadBalancerTracer.Config start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] LoadBalancerTracer.Config: end")
+	}()
+
+	return t.Internal.Config(ctx, zone, id)
+}
+
+// Boot is API call with trace log
+func (t *LoadBalancerTracer) Boot(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] LoadBalancerTracer.Boot start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] LoadBalancerTracer.Boot: end")
+	}()
+
+	return t.Internal.Boot(ctx, zone, id)
+}
+
+// Shutdown is API call with trace log
+func (t *LoadBalancerTracer) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
+	log.Println("[TRACE] LoadBalancerTracer.Shutdown start:	args => [", "zone=", zone, "id=", id, "shutdownOption=", shutdownOption, "]")
+	defer func() {
+		log.Println("[TRACE] LoadBalancerTracer.Shutdown: end")
+	}()
+
+	return t.Internal.Shutdown(ctx, zone, id, shutdownOption)
+}
+
+// Reset is API call with trace log
+func (t *LoadBalancerTracer) Reset(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] LoadBalancerTracer.Reset start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] LoadBalancerTracer.Reset: end")
+	}()
+
+	return t.Internal.Reset(ctx, zone, id)
+}
+
+// MonitorInterface is API call with trace log
+func (t *LoadBalancerTracer) MonitorInterface(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
+	log.Println("[TRACE] LoadBalancerTracer.MonitorInterface start:	args => [", "zone=", zone, "id=", id, "condition=", condition, "]")
+	defer func() {
+		log.Println("[TRACE] LoadBalancerTracer.MonitorInterface: end")
+	}()
+
+	return t.Internal.MonitorInterface(ctx, zone, id, condition)
+}
+
+// Status is API call with trace log
+func (t *LoadBalancerTracer) Status(ctx context.Context, zone string, id types.ID) ([]*sacloud.LoadBalancerStatus, error) {
+	log.Println("[TRACE] LoadBalancerTracer.Status start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] LoadBalancerTracer.Status: end")
+	}()
+
+	return t.Internal.Status(ctx, zone, id)
+}
+
+/*************************************************
+* MobileGatewayTracer
+*************************************************/
+
+// MobileGatewayTracer is for trace MobileGatewayOp operations
+type MobileGatewayTracer struct {
+	Internal sacloud.MobileGatewayAPI
+}
+
+// NewMobileGatewayTracer creates new MobileGatewayTracer instance
+func NewMobileGatewayTracer(in sacloud.MobileGatewayAPI) sacloud.MobileGatewayAPI {
+	return &MobileGatewayTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *MobileGatewayTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.MobileGateway, error) {
+	log.Println("[TRACE] MobileGatewayTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, conditions)
+}
+
+// Create is API call with trace log
+func (t *MobileGatewayTracer) Create(ctx context.Context, zone string, param *sacloud.MobileGatewayCreateRequest) (*sacloud.MobileGateway, error) {
+	log.Println("[TRACE] MobileGatewayTracer.Create start:	args => [", "zone=", zone, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.Create: end")
+	}()
+
+	return t.Internal.Create(ctx, zone, param)
+}
+
+// Read is API call with trace log
+func (t *MobileGatewayTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGateway, error) {
+	log.Println("[TRACE] MobileGatewayTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone, id)
+}
+
+// Update is API call with trace log
+func (t *MobileGatewayTracer) Update(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayUpdateRequest) (*sacloud.MobileGateway, error) {
+	log.Println("[TRACE] MobileGatewayTracer.Update start:	args => [", "zone=", zone, "id=", id, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.Update: end")
+	}()
+
+	return t.Internal.Update(ctx, zone, id, param)
+}
+
+// Delete is API call with trace log
+func (t *MobileGatewayTracer) Delete(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] MobileGatewayTracer.Delete start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.Delete: end")
+	}()
+
+	return t.Internal.Delete(ctx, zone, id)
+}
+
+// Config is API call with trace log
+func (t *MobileGatewayTracer) Config(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] MobileGatewayTracer.Config start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.Config: end")
+	}()
+
+	return t.Internal.Config(ctx, zone, id)
+}
+
+// Boot is API call with trace log
+func (t *MobileGatewayTracer) Boot(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] MobileGatewayTracer.Boot start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.Boot: end")
+	}()
+
+	return t.Internal.Boot(ctx, zone, id)
+}
+
+// Shutdown is API call with trace log
+func (t *MobileGatewayTracer) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *sacloud.ShutdownOption) error {
+	log.Println("[TRACE] MobileGatewayTracer.Shutdown start:	args => [", "zone=", zone, "id=", id, "shutdownOption=", shutdownOption, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.Shutdown: end")
+	}()
+
+	return t.Internal.Shutdown(ctx, zone, id, shutdownOption)
+}
+
+// Reset is API call with trace log
+func (t *MobileGatewayTracer) Reset(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] MobileGatewayTracer.Reset start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.Reset: end")
+	}()
+
+	return t.Internal.Reset(ctx, zone, id)
+}
+
+// ConnectToSwitch is API call with trace log
+func (t *MobileGatewayTracer) ConnectToSwitch(ctx context.Context, zone string, id types.ID, switchID types.ID) error {
+	log.Println("[TRACE] MobileGatewayTracer.ConnectToSwitch start:	args => [", "zone=", zone, "id=", id, "switchID=", switchID, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.ConnectToSwitch: end")
+	}()
+
+	return t.Internal.ConnectToSwitch(ctx, zone, id, switchID)
+}
+
+// DisconnectFromSwitch is API call with trace log
+func (t *MobileGatewayTracer) DisconnectFromSwitch(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] MobileGatewayTracer.DisconnectFromSwitch start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.DisconnectFromSwitch: end")
+	}()
+
+	return t.Internal.DisconnectFromSwitch(ctx, zone, id)
+}
+
+// GetDNS is API call with trace log
+func (t *MobileGatewayTracer) GetDNS(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGatewayDNSSetting, error) {
+	log.Println("[TRACE] MobileGatewayTracer.GetDNS start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.GetDNS: end")
+	}()
+
+	return t.Internal.GetDNS(ctx, zone, id)
+}
+
+// SetDNS is API call with trace log
+func (t *MobileGatewayTracer) SetDNS(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayDNSSetting) error {
+	log.Println("[TRACE] MobileGatewayTracer.SetDNS start:	args => [", "zone=", zone, "id=", id, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.SetDNS: end")
+	}()
+
+	return t.Internal.SetDNS(ctx, zone, id, param)
+}
+
+// ListSIM is API call with trace log
+func (t *MobileGatewayTracer) ListSIM(ctx context.Context, zone string, id types.ID) ([]*sacloud.MobileGatewaySIMInfo, error) {
+	log.Println("[TRACE] MobileGatewayTracer.ListSIM start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.ListSIM: end")
+	}()
+
+	return t.Internal.ListSIM(ctx, zone, id)
+}
+
+// AddSIM is API call with trace log
+func (t *MobileGatewayTracer) AddSIM(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayAddSIMRequest) error {
+	log.Println("[TRACE] MobileGatewayTracer.AddSIM start:	args => [", "zone=", zone, "id=", id, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.AddSIM: end")
+	}()
+
+	return t.Internal.AddSIM(ctx, zone, id, param)
+}
+
+// DeleteSIM is API call with trace log
+func (t *MobileGatewayTracer) DeleteSIM(ctx context.Context, zone string, id types.ID, simID types.ID) error {
+	log.Println("[TRACE] MobileGatewayTracer.DeleteSIM start:	args => [", "zone=", zone, "id=", id, "simID=", simID, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.DeleteSIM: end")
+	}()
+
+	return t.Internal.DeleteSIM(ctx, zone, id, simID)
+}
+
+// GetSIMRoutes is API call with trace log
+func (t *MobileGatewayTracer) GetSIMRoutes(ctx context.Context, zone string, id types.ID) ([]*sacloud.MobileGatewaySIMRoute, error) {
+	log.Println("[TRACE] MobileGatewayTracer.GetSIMRoutes start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.GetSIMRoutes: end")
+	}()
+
+	return t.Internal.GetSIMRoutes(ctx, zone, id)
+}
+
+// SetSIMRoutes is API call with trace log
+func (t *MobileGatewayTracer) SetSIMRoutes(ctx context.Context, zone string, id types.ID, routes []*sacloud.MobileGatewaySIMRoute) error {
+	log.Println("[TRACE] MobileGatewayTracer.SetSIMRoutes start:	args => [", "zone=", zone, "id=", id, "routes=", routes, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.SetSIMRoutes: end")
+	}()
+
+	return t.Internal.SetSIMRoutes(ctx, zone, id, routes)
+}
+
+// GetTrafficConfig is API call with trace log
+func (t *MobileGatewayTracer) GetTrafficConfig(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGatewayTrafficControl, error) {
+	log.Println("[TRACE] MobileGatewayTracer.GetTrafficConfig start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.GetTrafficConfig: end")
+	}()
+
+	return t.Internal.GetTrafficConfig(ctx, zone, id)
+}
+
+// SetTrafficConfig is API call with trace log
+func (t *MobileGatewayTracer) SetTrafficConfig(ctx context.Context, zone string, id types.ID, param *sacloud.MobileGatewayTrafficControl) error {
+	log.Println("[TRACE] MobileGatewayTracer.SetTrafficConfig start:	args => [", "zone=", zone, "id=", id, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.SetTrafficConfig: end")
+	}()
+
+	return t.Internal.SetTrafficConfig(ctx, zone, id, param)
+}
+
+// DeleteTrafficConfig is API call with trace log
+func (t *MobileGatewayTracer) DeleteTrafficConfig(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] MobileGatewayTracer.DeleteTrafficConfig start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.DeleteTrafficConfig: end")
+	}()
+
+	return t.Internal.DeleteTrafficConfig(ctx, zone, id)
+}
+
+// TrafficStatus is API call with trace log
+func (t *MobileGatewayTracer) TrafficStatus(ctx context.Context, zone string, id types.ID) (*sacloud.MobileGatewayTrafficStatus, error) {
+	log.Println("[TRACE] MobileGatewayTracer.TrafficStatus start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.TrafficStatus: end")
+	}()
+
+	return t.Internal.TrafficStatus(ctx, zone, id)
+}
+
+// MonitorInterface is API call with trace log
+func (t *MobileGatewayTracer) MonitorInterface(ctx context.Context, zone string, id types.ID, index int, condition *sacloud.MonitorCondition) (*sacloud.InterfaceActivity, error) {
+	log.Println("[TRACE] MobileGatewayTracer.MonitorInterface start:	args => [", "zone=", zone, "id=", id, "index=", index, "condition=", condition, "]")
+	defer func() {
+		log.Println("[TRACE] MobileGatewayTracer.MonitorInterface: end")
+	}()
+
+	return t.Internal.MonitorInterface(ctx, zone, id, index, condition)
+}
+
+/*************************************************
+* NFSTracer
+*************************************************/
+
+// NFSTracer is for trace NFSOp operations
+type NFSTracer struct {
+	Internal sacloud.NFSAPI
+}
+
+// NewNFSTracer creates new NFSTracer instance
+func NewNFSTracer(in sacloud.NFSAPI) sacloud.NFSAPI {
+	return &NFSTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *NFSTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.NFS, error) {
+	log.Println("[TRACE] NFSTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+	defer func() {
+		log.Println("[TRACE] NFSTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, conditions)
+}
+
+// Create is API call with trace log
+func (t *NFSTracer) Create(ctx context.Context, zone string, param *sacloud.NFSCreateRequest) (*sacloud.NFS, error) {
+	log.Println("[TRACE] NFSTracer.Create start:	args => [", "zone=", zone, "param=", param, "]")
 	defer func() {
 		log.Println("[TRACE] NFSTracer.Create: end")
 	}()
@@ -1040,82 +2196,346 @@ func (t *NoteTracer) Update(ctx context.Context, zone string, id types.ID, param
 	return t.Internal.Update(ctx, zone, id, param)
 }
 
-// Delete is API call with trace log
-func (t *NoteTracer) Delete(ctx context.Context, zone string, id types.ID) error {
-	log.Println("[TRACE] NoteTracer.Delete start:	args => [", "zone=", zone, "id=", id, "]")
+// Delete is API call with trace log
+func (t *NoteTracer) Delete(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] NoteTracer.Delete start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] NoteTracer.Delete: end")
+	}()
+
+	return t.Internal.Delete(ctx, zone, id)
+}
+
+/*************************************************
+* PacketFilterTracer
+*************************************************/
+
+// PacketFilterTracer is for trace PacketFilterOp operations
+type PacketFilterTracer struct {
+	Internal sacloud.PacketFilterAPI
+}
+
+// NewPacketFilterTracer creates new PacketFilterTracer instance
+func NewPacketFilterTracer(in sacloud.PacketFilterAPI) sacloud.PacketFilterAPI {
+	return &PacketFilterTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *PacketFilterTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.PacketFilter, error) {
+	log.Println("[TRACE] PacketFilterTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+	defer func() {
+		log.Println("[TRACE] PacketFilterTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, conditions)
+}
+
+// Create is API call with trace log
+func (t *PacketFilterTracer) Create(ctx context.Context, zone string, param *sacloud.PacketFilterCreateRequest) (*sacloud.PacketFilter, error) {
+	log.Println("[TRACE] PacketFilterTracer.Create start:	args => [", "zone=", zone, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] PacketFilterTracer.Create: end")
+	}()
+
+	return t.Internal.Create(ctx, zone, param)
+}
+
+// Read is API call with trace log
+func (t *PacketFilterTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.PacketFilter, error) {
+	log.Println("[TRACE] PacketFilterTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] PacketFilterTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone, id)
+}
+
+// Update is API call with trace log
+func (t *PacketFilterTracer) Update(ctx context.Context, zone string, id types.ID, param *sacloud.PacketFilterUpdateRequest) (*sacloud.PacketFilter, error) {
+	log.Println("[TRACE] PacketFilterTracer.Update start:	args => [", "zone=", zone, "id=", id, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] PacketFilterTracer.Update: end")
+	}()
+
+	return t.Internal.Update(ctx, zone, id, param)
+}
+
+// Delete is API call with trace log
+func (t *PacketFilterTracer) Delete(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] PacketFilterTracer.Delete start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] PacketFilterTracer.Delete: end")
+	}()
+
+	return t.Internal.Delete(ctx, zone, id)
+}
+
+/*************************************************
+* PrivateHostTracer
+*************************************************/
+
+// PrivateHostTracer is for trace PrivateHostOp operations
+type PrivateHostTracer struct {
+	Internal sacloud.PrivateHostAPI
+}
+
+// NewPrivateHostTracer creates new PrivateHostTracer instance
+func NewPrivateHostTracer(in sacloud.PrivateHostAPI) sacloud.PrivateHostAPI {
+	return &PrivateHostTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *PrivateHostTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.PrivateHost, error) {
+	log.Println("[TRACE] PrivateHostTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+	defer func() {
+		log.Println("[TRACE] PrivateHostTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, conditions)
+}
+
+// Create is API call with trace log
+func (t *PrivateHostTracer) Create(ctx context.Context, zone string, param *sacloud.PrivateHostCreateRequest) (*sacloud.PrivateHost, error) {
+	log.Println("[TRACE] PrivateHostTracer.Create start:	args => [", "zone=", zone, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] PrivateHostTracer.Create: end")
+	}()
+
+	return t.Internal.Create(ctx, zone, param)
+}
+
+// Read is API call with trace log
+func (t *PrivateHostTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.PrivateHost, error) {
+	log.Println("[TRACE] PrivateHostTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] PrivateHostTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone, id)
+}
+
+// Update is API call with trace log
+func (t *PrivateHostTracer) Update(ctx context.Context, zone string, id types.ID, param *sacloud.PrivateHostUpdateRequest) (*sacloud.PrivateHost, error) {
+	log.Println("[TRACE] PrivateHostTracer.Update start:	args => [", "zone=", zone, "id=", id, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] PrivateHostTracer.Update: end")
+	}()
+
+	return t.Internal.Update(ctx, zone, id, param)
+}
+
+// Delete is API call with trace log
+func (t *PrivateHostTracer) Delete(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] PrivateHostTracer.Delete start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] PrivateHostTracer.Delete: end")
+	}()
+
+	return t.Internal.Delete(ctx, zone, id)
+}
+
+/*************************************************
+* PrivateHostPlanTracer
+*************************************************/
+
+// PrivateHostPlanTracer is for trace PrivateHostPlanOp operations
+type PrivateHostPlanTracer struct {
+	Internal sacloud.PrivateHostPlanAPI
+}
+
+// NewPrivateHostPlanTracer creates new PrivateHostPlanTracer instance
+func NewPrivateHostPlanTracer(in sacloud.PrivateHostPlanAPI) sacloud.PrivateHostPlanAPI {
+	return &PrivateHostPlanTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *PrivateHostPlanTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.PrivateHostPlan, error) {
+	log.Println("[TRACE] PrivateHostPlanTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+	defer func() {
+		log.Println("[TRACE] PrivateHostPlanTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, conditions)
+}
+
+// Read is API call with trace log
+func (t *PrivateHostPlanTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.PrivateHostPlan, error) {
+	log.Println("[TRACE] PrivateHostPlanTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] PrivateHostPlanTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone, id)
+}
+
+/*************************************************
+* ProxyLBTracer
+*************************************************/
+
+// ProxyLBTracer is for trace ProxyLBOp operations
+type ProxyLBTracer struct {
+	Internal sacloud.ProxyLBAPI
+}
+
+// NewProxyLBTracer creates new ProxyLBTracer instance
+func NewProxyLBTracer(in sacloud.ProxyLBAPI) sacloud.ProxyLBAPI {
+	return &ProxyLBTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *ProxyLBTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.ProxyLB, error) {
+	log.Println("[TRACE] ProxyLBTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+	defer func() {
+		log.Println("[TRACE] ProxyLBTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, conditions)
+}
+
+// Create is API call with trace log
+func (t *ProxyLBTracer) Create(ctx context.Context, zone string, param *sacloud.ProxyLBCreateRequest) (*sacloud.ProxyLB, error) {
+	log.Println("[TRACE] ProxyLBTracer.Create start:	args => [", "zone=", zone, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] ProxyLBTracer.Create: end")
+	}()
+
+	return t.Internal.Create(ctx, zone, param)
+}
+
+// Read is API call with trace log
+func (t *ProxyLBTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.ProxyLB, error) {
+	log.Println("[TRACE] ProxyLBTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] ProxyLBTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone, id)
+}
+
+// Update is API call with trace log
+func (t *ProxyLBTracer) Update(ctx context.Context, zone string, id types.ID, param *sacloud.ProxyLBUpdateRequest) (*sacloud.ProxyLB, error) {
+	log.Println("[TRACE] ProxyLBTracer.Update start:	args => [", "zone=", zone, "id=", id, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] ProxyLBTracer.Update: end")
+	}()
+
+	return t.Internal.Update(ctx, zone, id, param)
+}
+
+// Delete is API call with trace log
+func (t *ProxyLBTracer) Delete(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] ProxyLBTracer.Delete start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] ProxyLBTracer.Delete: end")
+	}()
+
+	return t.Internal.Delete(ctx, zone, id)
+}
+
+// GetCertificates is API call with trace log
+func (t *ProxyLBTracer) GetCertificates(ctx context.Context, zone string, id types.ID) (*sacloud.ProxyLBCertificates, error) {
+	log.Println("[TRACE] ProxyLBTracer.GetCertificates start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] ProxyLBTracer.GetCertificates: end")
+	}()
+
+	return t.Internal.GetCertificates(ctx, zone, id)
+}
+
+// SetCertificates is API call with trace log
+func (t *ProxyLBTracer) SetCertificates(ctx context.Context, zone string, id types.ID, param *sacloud.ProxyLBSetCertificatesRequest) (*sacloud.ProxyLBCertificates, error) {
+	log.Println("[TRACE] ProxyLBTracer.SetCertificates start:	args => [", "zone=", zone, "id=", id, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] ProxyLBTracer.SetCertificates: end")
+	}()
+
+	return t.Internal.SetCertificates(ctx, zone, id, param)
+}
+
+// DeleteCertificates is API call with trace log
+func (t *ProxyLBTracer) DeleteCertificates(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] ProxyLBTracer.DeleteCertificates start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] ProxyLBTracer.DeleteCertificates: end")
+	}()
+
+	return t.Internal.DeleteCertificates(ctx, zone, id)
+}
+
+// RenewLetsEncryptCert is API call with trace log
+func (t *ProxyLBTracer) RenewLetsEncryptCert(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] ProxyLBTracer.RenewLetsEncryptCert start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] ProxyLBTracer.RenewLetsEncryptCert: end")
+	}()
+
+	return t.Internal.RenewLetsEncryptCert(ctx, zone, id)
+}
+
+// HealthStatus is API call with trace log
+func (t *ProxyLBTracer) HealthStatus(ctx context.Context, zone string, id types.ID) (*sacloud.ProxyLBHealth, error) {
+	log.Println("[TRACE] ProxyLBTracer.HealthStatus start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] ProxyLBTracer.HealthStatus: end")
+	}()
+
+	return t.Internal.HealthStatus(ctx, zone, id)
+}
+
+// MonitorConnection is API call with trace log
+func (t *ProxyLBTracer) MonitorConnection(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.ConnectionActivity, error) {
+	log.Println("[TRACE] ProxyLBTracer.MonitorConnection start:	args => [", "zone=", zone, "id=", id, "condition=", condition, "]")
 	defer func() {
-		log.Println("[TRACE] NoteTracer.Delete: end")
+		log.Println("[TRACE] ProxyLBTracer.MonitorConnection: end")
 	}()
 
-	return t.Internal.Delete(ctx, zone, id)
+	return t.Internal.MonitorConnection(ctx, zone, id, condition)
 }
 
 /*************************************************
-* PacketFilterTracer
+* RegionTracer
 *************************************************/
 
-// PacketFilterTracer is for trace PacketFilterOp operations
-type PacketFilterTracer struct {
-	Internal sacloud.PacketFilterAPI
+// RegionTracer is for trace RegionOp operations
+type RegionTracer struct {
+	Internal sacloud.RegionAPI
 }
 
-// NewPacketFilterTracer creates new PacketFilterTracer instance
-func NewPacketFilterTracer(in sacloud.PacketFilterAPI) sacloud.PacketFilterAPI {
-	return &PacketFilterTracer{
+// NewRegionTracer creates new RegionTracer instance
+func NewRegionTracer(in sacloud.RegionAPI) sacloud.RegionAPI {
+	return &RegionTracer{
 		Internal: in,
 	}
 }
 
 // Find is API call with trace log
-func (t *PacketFilterTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.PacketFilter, error) {
-	log.Println("[TRACE] PacketFilterTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+func (t *RegionTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Region, error) {
+	log.Println("[TRACE] RegionTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
 	defer func() {
-		log.Println("[TRACE] PacketFilterTracer.Find: end")
+		log.Println("[TRACE] RegionTracer.Find: end")
 	}()
 
 	return t.Internal.Find(ctx, zone, conditions)
 }
 
-// Create is API call with trace log
-func (t *PacketFilterTracer) Create(ctx context.Context, zone string, param *sacloud.PacketFilterCreateRequest) (*sacloud.PacketFilter, error) {
-	log.Println("[TRACE] PacketFilterTracer.Create start:	args => [", "zone=", zone, "param=", param, "]")
-	defer func() {
-		log.Println("[TRACE] PacketFilterTracer.Create: end")
-	}()
-
-	return t.Internal.Create(ctx, zone, param)
-}
-
 // Read is API call with trace log
-func (t *PacketFilterTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.PacketFilter, error) {
-	log.Println("[TRACE] PacketFilterTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
+func (t *RegionTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Region, error) {
+	log.Println("[TRACE] RegionTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
 	defer func() {
-		log.Println("[TRACE] PacketFilterTracer.Read: end")
+		log.Println("[TRACE] RegionTracer.Read: end")
 	}()
 
 	return t.Internal.Read(ctx, zone, id)
 }
 
-// Update is API call with trace log
-func (t *PacketFilterTracer) Update(ctx context.Context, zone string, id types.ID, param *sacloud.PacketFilterUpdateRequest) (*sacloud.PacketFilter, error) {
-	log.Println("[TRACE] PacketFilterTracer.Update start:	args => [", "zone=", zone, "id=", id, "param=", param, "]")
-	defer func() {
-		log.Println("[TRACE] PacketFilterTracer.Update: end")
-	}()
-
-	return t.Internal.Update(ctx, zone, id, param)
-}
-
-// Delete is API call with trace log
-func (t *PacketFilterTracer) Delete(ctx context.Context, zone string, id types.ID) error {
-	log.Println("[TRACE] PacketFilterTracer.Delete start:	args => [", "zone=", zone, "id=", id, "]")
-	defer func() {
-		log.Println("[TRACE] PacketFilterTracer.Delete: end")
-	}()
-
-	return t.Internal.Delete(ctx, zone, id)
-}
-
 /*************************************************
 * ServerTracer
 *************************************************/
@@ -1252,6 +2672,108 @@ func (t *ServerTracer) Monitor(ctx context.Context, zone string, id types.ID, co
 	return t.Internal.Monitor(ctx, zone, id, condition)
 }
 
+// GetVNCProxy is API call with trace log
+func (t *ServerTracer) GetVNCProxy(ctx context.Context, zone string, id types.ID) (*sacloud.VNCProxyInfo, error) {
+	log.Println("[TRACE] ServerTracer.GetVNCProxy start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] ServerTracer.GetVNCProxy: end")
+	}()
+
+	return t.Internal.GetVNCProxy(ctx, zone, id)
+}
+
+// SendKey is API call with trace log
+func (t *ServerTracer) SendKey(ctx context.Context, zone string, id types.ID, keyboardParam *sacloud.SendKeyRequest) error {
+	log.Println("[TRACE] ServerTracer.SendKey start:	args => [", "zone=", zone, "id=", id, "keyboardParam=", keyboardParam, "]")
+	defer func() {
+		log.Println("[TRACE] ServerTracer.SendKey: end")
+	}()
+
+	return t.Internal.SendKey(ctx, zone, id, keyboardParam)
+}
+
+// SendNMI is API call with trace log
+func (t *ServerTracer) SendNMI(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] ServerTracer.SendNMI start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] ServerTracer.SendNMI: end")
+	}()
+
+	return t.Internal.SendNMI(ctx, zone, id)
+}
+
+// GetScreenshot is API call with trace log
+func (t *ServerTracer) GetScreenshot(ctx context.Context, zone string, id types.ID, condition *sacloud.GetScreenshotRequest) (*sacloud.VNCSnapshotInfo, error) {
+	log.Println("[TRACE] ServerTracer.GetScreenshot start:	args => [", "zone=", zone, "id=", id, "condition=", condition, "]")
+	defer func() {
+		log.Println("[TRACE] ServerTracer.GetScreenshot: end")
+	}()
+
+	return t.Internal.GetScreenshot(ctx, zone, id, condition)
+}
+
+/*************************************************
+* ServerPlanTracer
+*************************************************/
+
+// ServerPlanTracer is for trace ServerPlanOp operations
+type ServerPlanTracer struct {
+	Internal sacloud.ServerPlanAPI
+}
+
+// NewServerPlanTracer creates new ServerPlanTracer instance
+func NewServerPlanTracer(in sacloud.ServerPlanAPI) sacloud.ServerPlanAPI {
+	return &ServerPlanTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *ServerPlanTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.ServerPlan, error) {
+	log.Println("[TRACE] ServerPlanTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+	defer func() {
+		log.Println("[TRACE] ServerPlanTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, conditions)
+}
+
+// Read is API call with trace log
+func (t *ServerPlanTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.ServerPlan, error) {
+	log.Println("[TRACE] ServerPlanTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] ServerPlanTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone, id)
+}
+
+/*************************************************
+* ServiceClassTracer
+*************************************************/
+
+// ServiceClassTracer is for trace ServiceClassOp operations
+type ServiceClassTracer struct {
+	Internal sacloud.ServiceClassAPI
+}
+
+// NewServiceClassTracer creates new ServiceClassTracer instance
+func NewServiceClassTracer(in sacloud.ServiceClassAPI) sacloud.ServiceClassAPI {
+	return &ServiceClassTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *ServiceClassTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.ServiceClass, error) {
+	log.Println("[TRACE] ServiceClassTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+	defer func() {
+		log.Println("[TRACE] ServiceClassTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, conditions)
+}
+
 /*************************************************
 * SIMTracer
 *************************************************/
@@ -1418,6 +2940,240 @@ func (t *SIMTracer) MonitorSIM(ctx context.Context, zone string, id types.ID, co
 	return t.Internal.MonitorSIM(ctx, zone, id, condition)
 }
 
+/*************************************************
+* SimpleMonitorTracer
+*************************************************/
+
+// SimpleMonitorTracer is for trace SimpleMonitorOp operations
+type SimpleMonitorTracer struct {
+	Internal sacloud.SimpleMonitorAPI
+}
+
+// NewSimpleMonitorTracer creates new SimpleMonitorTracer instance
+func NewSimpleMonitorTracer(in sacloud.SimpleMonitorAPI) sacloud.SimpleMonitorAPI {
+	return &SimpleMonitorTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *SimpleMonitorTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.SimpleMonitor, error) {
+	log.Println("[TRACE] SimpleMonitorTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+	defer func() {
+		log.Println("[TRACE] SimpleMonitorTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, conditions)
+}
+
+// Create is API call with trace log
+func (t *SimpleMonitorTracer) Create(ctx context.Context, zone string, param *sacloud.SimpleMonitorCreateRequest) (*sacloud.SimpleMonitor, error) {
+	log.Println("[TRACE] SimpleMonitorTracer.Create start:	args => [", "zone=", zone, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] SimpleMonitorTracer.Create: end")
+	}()
+
+	return t.Internal.Create(ctx, zone, param)
+}
+
+// Read is API call with trace log
+func (t *SimpleMonitorTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.SimpleMonitor, error) {
+	log.Println("[TRACE] SimpleMonitorTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] SimpleMonitorTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone, id)
+}
+
+// Update is API call with trace log
+func (t *SimpleMonitorTracer) Update(ctx context.Context, zone string, id types.ID, param *sacloud.SimpleMonitorUpdateRequest) (*sacloud.SimpleMonitor, error) {
+	log.Println("[TRACE] SimpleMonitorTracer.Update start:	args => [", "zone=", zone, "id=", id, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] SimpleMonitorTracer.Update: end")
+	}()
+
+	return t.Internal.Update(ctx, zone, id, param)
+}
+
+// Delete is API call with trace log
+func (t *SimpleMonitorTracer) Delete(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] SimpleMonitorTracer.Delete start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] SimpleMonitorTracer.Delete: end")
+	}()
+
+	return t.Internal.Delete(ctx, zone, id)
+}
+
+// MonitorResponseTime is API call with trace log
+func (t *SimpleMonitorTracer) MonitorResponseTime(ctx context.Context, zone string, id types.ID, condition *sacloud.MonitorCondition) (*sacloud.ResponseTimeSecActivity, error) {
+	log.Println("[TRACE] SimpleMonitorTracer.MonitorResponseTime start:	args => [", "zone=", zone, "id=", id, "condition=", condition, "]")
+	defer func() {
+		log.Println("[TRACE] SimpleMonitorTracer.MonitorResponseTime: end")
+	}()
+
+	return t.Internal.MonitorResponseTime(ctx, zone, id, condition)
+}
+
+// HealthStatus is API call with trace log
+func (t *SimpleMonitorTracer) HealthStatus(ctx context.Context, zone string, id types.ID) (*sacloud.SimpleMonitorHealthStatus, error) {
+	log.Println("[TRACE] SimpleMonitorTracer.HealthStatus start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] SimpleMonitorTracer.HealthStatus: end")
+	}()
+
+	return t.Internal.HealthStatus(ctx, zone, id)
+}
+
+/*************************************************
+* SSHKeyTracer
+*************************************************/
+
+// SSHKeyTracer is for trace SSHKeyOp operations
+type SSHKeyTracer struct {
+	Internal sacloud.SSHKeyAPI
+}
+
+// NewSSHKeyTracer creates new SSHKeyTracer instance
+func NewSSHKeyTracer(in sacloud.SSHKeyAPI) sacloud.SSHKeyAPI {
+	return &SSHKeyTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *SSHKeyTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.SSHKey, error) {
+	log.Println("[TRACE] SSHKeyTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+	defer func() {
+		log.Println("[TRACE] SSHKeyTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, conditions)
+}
+
+// Create is API call with trace log
+func (t *SSHKeyTracer) Create(ctx context.Context, zone string, param *sacloud.SSHKeyCreateRequest) (*sacloud.SSHKey, error) {
+	log.Println("[TRACE] SSHKeyTracer.Create start:	args => [", "zone=", zone, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] SSHKeyTracer.Create: end")
+	}()
+
+	return t.Internal.Create(ctx, zone, param)
+}
+
+// Generate is API call with trace log
+func (t *SSHKeyTracer) Generate(ctx context.Context, zone string, param *sacloud.SSHKeyGenerateRequest) (*sacloud.SSHKeyGenerated, error) {
+	log.Println("[TRACE] SSHKeyTracer.Generate start:	args => [", "zone=", zone, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] SSHKeyTracer.Generate: end")
+	}()
+
+	return t.Internal.Generate(ctx, zone, param)
+}
+
+// Read is API call with trace log
+func (t *SSHKeyTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.SSHKey, error) {
+	log.Println("[TRACE] SSHKeyTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] SSHKeyTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone, id)
+}
+
+// Update is API call with trace log
+func (t *SSHKeyTracer) Update(ctx context.Context, zone string, id types.ID, param *sacloud.SSHKeyUpdateRequest) (*sacloud.SSHKey, error) {
+	log.Println("[TRACE] SSHKeyTracer.Update start:	args => [", "zone=", zone, "id=", id, "param=", param, "]")
+	defer func() {
+		log.Println("[TRACE] SSHKeyTracer.Update: end")
+	}()
+
+	return t.Internal.Update(ctx, zone, id, param)
+}
+
+// Delete is API call with trace log
+func (t *SSHKeyTracer) Delete(ctx context.Context, zone string, id types.ID) error {
+	log.Println("[TRACE] SSHKeyTracer.Delete start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] SSHKeyTracer.Delete: end")
+	}()
+
+	return t.Internal.Delete(ctx, zone, id)
+}
+
+/*************************************************
+* StorageTracer
+*************************************************/
+
+// StorageTracer is for trace StorageOp operations
+type StorageTracer struct {
+	Internal sacloud.StorageAPI
+}
+
+// NewStorageTracer creates new StorageTracer instance
+func NewStorageTracer(in sacloud.StorageAPI) sacloud.StorageAPI {
+	return &StorageTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *StorageTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Storage, error) {
+	log.Println("[TRACE] StorageTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+	defer func() {
+		log.Println("[TRACE] StorageTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, conditions)
+}
+
+// Read is API call with trace log
+func (t *StorageTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Storage, error) {
+	log.Println("[TRACE] StorageTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] StorageTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone, id)
+}
+
+/*************************************************
+* SubnetTracer
+*************************************************/
+
+// SubnetTracer is for trace SubnetOp operations
+type SubnetTracer struct {
+	Internal sacloud.SubnetAPI
+}
+
+// NewSubnetTracer creates new SubnetTracer instance
+func NewSubnetTracer(in sacloud.SubnetAPI) sacloud.SubnetAPI {
+	return &SubnetTracer{
+		Internal: in,
+	}
+}
+
+// Find is API call with trace log
+func (t *SubnetTracer) Find(ctx context.Context, zone string, conditions *sacloud.FindCondition) ([]*sacloud.Subnet, error) {
+	log.Println("[TRACE] SubnetTracer.Find start:	args => [", "zone=", zone, "conditions=", conditions, "]")
+	defer func() {
+		log.Println("[TRACE] SubnetTracer.Find: end")
+	}()
+
+	return t.Internal.Find(ctx, zone, conditions)
+}
+
+// Read is API call with trace log
+func (t *SubnetTracer) Read(ctx context.Context, zone string, id types.ID) (*sacloud.Subnet, error) {
+	log.Println("[TRACE] SubnetTracer.Read start:	args => [", "zone=", zone, "id=", id, "]")
+	defer func() {
+		log.Println("[TRACE] SubnetTracer.Read: end")
+	}()
+
+	return t.Internal.Read(ctx, zone, id)
+}
+
 /*************************************************
 * SwitchTracer
 *************************************************/
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ArchiveShareKey 共有アーカイブの共有キー
+//
+// "<ゾーン名>:<アーカイブID>:<トークン>"の形式
+type ArchiveShareKey string
+
+// NewArchiveShareKey 共有キーを組み立てる
+func NewArchiveShareKey(zone string, archiveID ID, token string) ArchiveShareKey {
+	return ArchiveShareKey(fmt.Sprintf("%s:%s:%s", zone, archiveID, token))
+}
+
+// String 共有キーの文字列表現
+func (key ArchiveShareKey) String() string {
+	return string(key)
+}
+
+// ValidFormat 共有キーの形式が正しいか
+func (key ArchiveShareKey) ValidFormat() bool {
+	tokens := key.tokens()
+	if len(tokens) != 3 {
+		return false
+	}
+	for _, t := range tokens {
+		if t == "" {
+			return false
+		}
+	}
+	return !StringID(tokens[1]).IsEmpty()
+}
+
+// Zone 共有元アーカイブのゾーン名
+func (key ArchiveShareKey) Zone() string {
+	if !key.ValidFormat() {
+		return ""
+	}
+	return key.tokens()[0]
+}
+
+// SourceArchiveID 共有元アーカイブのID
+func (key ArchiveShareKey) SourceArchiveID() ID {
+	if !key.ValidFormat() {
+		return ID(0)
+	}
+	return StringID(key.tokens()[1])
+}
+
+// Token 共有キーのトークン部分
+func (key ArchiveShareKey) Token() string {
+	if !key.ValidFormat() {
+		return ""
+	}
+	return key.tokens()[2]
+}
+
+func (key ArchiveShareKey) tokens() []string {
+	return strings.Split(string(key), ":")
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveShareKey(t *testing.T) {
+	expects := []struct {
+		input ArchiveShareKey
+		valid bool
+		zone  string
+		id    ID
+		token string
+	}{
+		{input: "is1a:123456789012:abcdef", valid: true, zone: "is1a", id: ID(123456789012), token: "abcdef"},
+		{input: NewArchiveShareKey("tk1a", ID(1), "token"), valid: true, zone: "tk1a", id: ID(1), token: "token"},
+		{input: "", valid: false},
+		{input: "is1a:123456789012", valid: false},
+		{input: "is1a::abcdef", valid: false},
+		{input: "is1a:not-a-number:abcdef", valid: false},
+		{input: "is1a:1:abcdef:extra", valid: false},
+	}
+
+	for _, tc := range expects {
+		require.Equal(t, tc.valid, tc.input.ValidFormat(), "input: %s", tc.input)
+		require.Equal(t, tc.zone, tc.input.Zone(), "input: %s", tc.input)
+		require.Equal(t, tc.id, tc.input.SourceArchiveID(), "input: %s", tc.input)
+		require.Equal(t, tc.token, tc.input.Token(), "input: %s", tc.input)
+	}
+}
@@ -0,0 +1,66 @@
+package types
+
+// EBackupSpanType 自動バックアップの取得間隔種別
+type EBackupSpanType string
+
+// String EBackupSpanTypeの文字列表現
+func (t EBackupSpanType) String() string {
+	return string(t)
+}
+
+// EBackupSpanWeekday 自動バックアップの取得曜日
+type EBackupSpanWeekday string
+
+// String EBackupSpanWeekdayの文字列表現
+func (w EBackupSpanWeekday) String() string {
+	return string(w)
+}
+
+var (
+	// BackupSpanTypes 自動バックアップの取得間隔種別
+	BackupSpanTypes = struct {
+		// Weekdays 曜日指定
+		Weekdays EBackupSpanType
+	}{
+		Weekdays: EBackupSpanType("weekdays"),
+	}
+
+	// BackupSpanWeekdays 自動バックアップの取得曜日
+	BackupSpanWeekdays = struct {
+		// Sunday 日曜
+		Sunday EBackupSpanWeekday
+		// Monday 月曜
+		Monday EBackupSpanWeekday
+		// Tuesday 火曜
+		Tuesday EBackupSpanWeekday
+		// Wednesday 水曜
+		Wednesday EBackupSpanWeekday
+		// Thursday 木曜
+		Thursday EBackupSpanWeekday
+		// Friday 金曜
+		Friday EBackupSpanWeekday
+		// Saturday 土曜
+		Saturday EBackupSpanWeekday
+	}{
+		Sunday:    EBackupSpanWeekday("sun"),
+		Monday:    EBackupSpanWeekday("mon"),
+		Tuesday:   EBackupSpanWeekday("tue"),
+		Wednesday: EBackupSpanWeekday("wed"),
+		Thursday:  EBackupSpanWeekday("thu"),
+		Friday:    EBackupSpanWeekday("fri"),
+		Saturday:  EBackupSpanWeekday("sat"),
+	}
+
+	// BackupSpanWeekdayValues 自動バックアップの取得曜日の値
+	//
+	// time.Weekdayの順(日曜始まり)で並ぶ
+	BackupSpanWeekdayValues = []string{
+		BackupSpanWeekdays.Sunday.String(),
+		BackupSpanWeekdays.Monday.String(),
+		BackupSpanWeekdays.Tuesday.String(),
+		BackupSpanWeekdays.Wednesday.String(),
+		BackupSpanWeekdays.Thursday.String(),
+		BackupSpanWeekdays.Friday.String(),
+		BackupSpanWeekdays.Saturday.String(),
+	}
+)
@@ -0,0 +1,20 @@
+package types
+
+// EDatabaseReplicationModel データベースアプライアンスのレプリケーションでの動作モデル
+type EDatabaseReplicationModel string
+
+// String EDatabaseReplicationModelの文字列表現
+func (m EDatabaseReplicationModel) String() string {
+	return string(m)
+}
+
+// DatabaseReplicationModels データベースアプライアンスのレプリケーションでの動作モデル
+var DatabaseReplicationModels = struct {
+	// MasterSlave マスター側
+	MasterSlave EDatabaseReplicationModel
+	// AsyncReplica スレーブ側
+	AsyncReplica EDatabaseReplicationModel
+}{
+	MasterSlave:  EDatabaseReplicationModel("Master-Slave"),
+	AsyncReplica: EDatabaseReplicationModel("Async-Replica"),
+}
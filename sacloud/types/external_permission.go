@@ -0,0 +1,53 @@
+package types
+
+import "strings"
+
+// ExternalPermission 他サービスへのアクセス権
+//
+// APIからは"bill+eventlog+cdn"のように"+"区切りで返される
+type ExternalPermission string
+
+var (
+	// ExternalPermissionBill 請求情報
+	ExternalPermissionBill = ExternalPermission("bill")
+	// ExternalPermissionEventLog イベントログ
+	ExternalPermissionEventLog = ExternalPermission("eventlog")
+	// ExternalPermissionCDN ウェブアクセラレータ
+	ExternalPermissionCDN = ExternalPermission("cdn")
+)
+
+// Values "+"区切りの各アクセス権のリスト
+func (p ExternalPermission) Values() []ExternalPermission {
+	var values []ExternalPermission
+	for _, v := range strings.Split(string(p), "+") {
+		if v != "" {
+			values = append(values, ExternalPermission(v))
+		}
+	}
+	return values
+}
+
+// Includes 指定のアクセス権を含むか判定
+func (p ExternalPermission) Includes(permission ExternalPermission) bool {
+	for _, v := range p.Values() {
+		if v == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// PermittedBill 請求情報へのアクセス権を持つか
+func (p ExternalPermission) PermittedBill() bool {
+	return p.Includes(ExternalPermissionBill)
+}
+
+// PermittedEventLog イベントログへのアクセス権を持つか
+func (p ExternalPermission) PermittedEventLog() bool {
+	return p.Includes(ExternalPermissionEventLog)
+}
+
+// PermittedCDN ウェブアクセラレータへのアクセス権を持つか
+func (p ExternalPermission) PermittedCDN() bool {
+	return p.Includes(ExternalPermissionCDN)
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExternalPermission(t *testing.T) {
+	expects := []struct {
+		input    ExternalPermission
+		bill     bool
+		eventLog bool
+		cdn      bool
+	}{
+		{input: "", bill: false, eventLog: false, cdn: false},
+		{input: "bill", bill: true, eventLog: false, cdn: false},
+		{input: "eventlog+cdn", bill: false, eventLog: true, cdn: true},
+		{input: "bill+eventlog+cdn", bill: true, eventLog: true, cdn: true},
+	}
+
+	for _, tc := range expects {
+		require.Equal(t, tc.bill, tc.input.PermittedBill(), "input: %s", tc.input)
+		require.Equal(t, tc.eventLog, tc.input.PermittedEventLog(), "input: %s", tc.input)
+		require.Equal(t, tc.cdn, tc.input.PermittedCDN(), "input: %s", tc.input)
+	}
+}
@@ -0,0 +1,23 @@
+package types
+
+// EIconSize アイコン画像取得時のサイズ
+type EIconSize string
+
+// String EIconSizeの文字列表現
+func (s EIconSize) String() string {
+	return string(s)
+}
+
+// IconSizes アイコン画像取得時のサイズ
+var IconSizes = struct {
+	// Small 小(16x16)
+	Small EIconSize
+	// Medium 中(32x32)
+	Medium EIconSize
+	// Large 大(64x64)
+	Large EIconSize
+}{
+	Small:  EIconSize("small"),
+	Medium: EIconSize("medium"),
+	Large:  EIconSize("large"),
+}
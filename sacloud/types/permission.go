@@ -0,0 +1,43 @@
+package types
+
+// EPermission アカウントでの権限
+type EPermission string
+
+// Permissions アカウントでの権限
+var Permissions = struct {
+	// Unknown 不明
+	Unknown EPermission
+	// Create 作成・削除
+	Create EPermission
+	// Arrange 設定変更
+	Arrange EPermission
+	// Power 電源操作
+	Power EPermission
+	// View リソース閲覧
+	View EPermission
+}{
+	Unknown: EPermission(""),
+	Create:  EPermission("create"),
+	Arrange: EPermission("arrange"),
+	Power:   EPermission("power"),
+	View:    EPermission("view"),
+}
+
+// permissionLevels 権限の強さ(値が大きいほど強い権限)
+var permissionLevels = map[EPermission]int{
+	Permissions.View:    1,
+	Permissions.Power:   2,
+	Permissions.Arrange: 3,
+	Permissions.Create:  4,
+}
+
+// Includes 指定の権限の操作が許可されているか判定
+//
+// 例えばCreateはArrange/Power/Viewの操作を含む
+func (p EPermission) Includes(other EPermission) bool {
+	level, ok := permissionLevels[p]
+	if !ok {
+		return false
+	}
+	return level >= permissionLevels[other] && permissionLevels[other] > 0
+}
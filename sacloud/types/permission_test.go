@@ -0,0 +1,17 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPermission_Includes(t *testing.T) {
+	require.True(t, Permissions.Create.Includes(Permissions.View))
+	require.True(t, Permissions.Arrange.Includes(Permissions.Power))
+	require.True(t, Permissions.Power.Includes(Permissions.Power))
+	require.False(t, Permissions.Power.Includes(Permissions.Arrange))
+	require.False(t, Permissions.View.Includes(Permissions.Create))
+	require.False(t, Permissions.Unknown.Includes(Permissions.View))
+	require.False(t, Permissions.Create.Includes(Permissions.Unknown))
+}
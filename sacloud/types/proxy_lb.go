@@ -0,0 +1,73 @@
+package types
+
+// EProxyLBProxyMode エンハンスドロードバランサ 待ち受けポートでのプロキシ方式
+type EProxyLBProxyMode string
+
+// String EProxyLBProxyModeの文字列表現
+func (m EProxyLBProxyMode) String() string {
+	return string(m)
+}
+
+// EProxyLBHealthCheckProtocol エンハンスドロードバランサ 監視プロトコル
+type EProxyLBHealthCheckProtocol string
+
+// String EProxyLBHealthCheckProtocolの文字列表現
+func (p EProxyLBHealthCheckProtocol) String() string {
+	return string(p)
+}
+
+// EProxyLBRegion エンハンスドロードバランサ 設置先リージョン
+type EProxyLBRegion string
+
+// String EProxyLBRegionの文字列表現
+func (r EProxyLBRegion) String() string {
+	return string(r)
+}
+
+var (
+	// ProxyLBProxyModes エンハンスドロードバランサ 待ち受けポートでのプロキシ方式
+	ProxyLBProxyModes = struct {
+		// HTTP http
+		HTTP EProxyLBProxyMode
+		// HTTPS https
+		HTTPS EProxyLBProxyMode
+		// TCP tcp
+		TCP EProxyLBProxyMode
+	}{
+		HTTP:  EProxyLBProxyMode("http"),
+		HTTPS: EProxyLBProxyMode("https"),
+		TCP:   EProxyLBProxyMode("tcp"),
+	}
+
+	// ProxyLBProxyModeValues エンハンスドロードバランサ 待ち受けポートでのプロキシ方式の値
+	ProxyLBProxyModeValues = []string{
+		ProxyLBProxyModes.HTTP.String(),
+		ProxyLBProxyModes.HTTPS.String(),
+		ProxyLBProxyModes.TCP.String(),
+	}
+
+	// ProxyLBHealthCheckProtocols エンハンスドロードバランサ 監視プロトコル
+	ProxyLBHealthCheckProtocols = struct {
+		// HTTP http
+		HTTP EProxyLBHealthCheckProtocol
+		// TCP tcp
+		TCP EProxyLBHealthCheckProtocol
+	}{
+		HTTP: EProxyLBHealthCheckProtocol("http"),
+		TCP:  EProxyLBHealthCheckProtocol("tcp"),
+	}
+
+	// ProxyLBRegions エンハンスドロードバランサ 設置先リージョン
+	ProxyLBRegions = struct {
+		// TK1 東京
+		TK1 EProxyLBRegion
+		// IS1 石狩
+		IS1 EProxyLBRegion
+		// Anycast エニーキャスト
+		Anycast EProxyLBRegion
+	}{
+		TK1:     EProxyLBRegion("tk1"),
+		IS1:     EProxyLBRegion("is1"),
+		Anycast: EProxyLBRegion("anycast"),
+	}
+)
@@ -0,0 +1,79 @@
+package types
+
+// ESimpleMonitorProtocol シンプル監視 プロトコル
+type ESimpleMonitorProtocol string
+
+// String ESimpleMonitorProtocolの文字列表現
+func (p ESimpleMonitorProtocol) String() string {
+	return string(p)
+}
+
+// ESimpleMonitorHealth シンプル監視ステータス
+type ESimpleMonitorHealth string
+
+// String ESimpleMonitorHealthの文字列表現
+func (h ESimpleMonitorHealth) String() string {
+	return string(h)
+}
+
+var (
+	// SimpleMonitorProtocols シンプル監視 プロトコル
+	SimpleMonitorProtocols = struct {
+		// HTTP http
+		HTTP ESimpleMonitorProtocol
+		// HTTPS https
+		HTTPS ESimpleMonitorProtocol
+		// Ping ping
+		Ping ESimpleMonitorProtocol
+		// TCP tcp
+		TCP ESimpleMonitorProtocol
+		// DNS dns
+		DNS ESimpleMonitorProtocol
+		// SSH ssh
+		SSH ESimpleMonitorProtocol
+		// SMTP smtp
+		SMTP ESimpleMonitorProtocol
+		// POP3 pop3
+		POP3 ESimpleMonitorProtocol
+		// SNMP snmp
+		SNMP ESimpleMonitorProtocol
+		// SSLCertificate sslcertificate
+		SSLCertificate ESimpleMonitorProtocol
+	}{
+		HTTP:           ESimpleMonitorProtocol("http"),
+		HTTPS:          ESimpleMonitorProtocol("https"),
+		Ping:           ESimpleMonitorProtocol("ping"),
+		TCP:            ESimpleMonitorProtocol("tcp"),
+		DNS:            ESimpleMonitorProtocol("dns"),
+		SSH:            ESimpleMonitorProtocol("ssh"),
+		SMTP:           ESimpleMonitorProtocol("smtp"),
+		POP3:           ESimpleMonitorProtocol("pop3"),
+		SNMP:           ESimpleMonitorProtocol("snmp"),
+		SSLCertificate: ESimpleMonitorProtocol("sslcertificate"),
+	}
+
+	// SimpleMonitorProtocolValues シンプル監視 プロトコルの値
+	SimpleMonitorProtocolValues = []string{
+		SimpleMonitorProtocols.HTTP.String(),
+		SimpleMonitorProtocols.HTTPS.String(),
+		SimpleMonitorProtocols.Ping.String(),
+		SimpleMonitorProtocols.TCP.String(),
+		SimpleMonitorProtocols.DNS.String(),
+		SimpleMonitorProtocols.SSH.String(),
+		SimpleMonitorProtocols.SMTP.String(),
+		SimpleMonitorProtocols.POP3.String(),
+		SimpleMonitorProtocols.SNMP.String(),
+		SimpleMonitorProtocols.SSLCertificate.String(),
+	}
+
+	// SimpleMonitorHealth シンプル監視ステータス
+	SimpleMonitorHealth = struct {
+		// Up Up
+		Up ESimpleMonitorHealth
+		// Down Down
+		Down ESimpleMonitorHealth
+	}{
+		Up:   ESimpleMonitorHealth("UP"),
+		Down: ESimpleMonitorHealth("DOWN"),
+	}
+)
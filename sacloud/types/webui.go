@@ -0,0 +1,62 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// WebUI データベースアプライアンスのWebUI設定
+//
+// APIへのリクエスト時はbool値、レスポンスではURL(有効な場合)もしくはfalseとなるため、ここで吸収する
+type WebUI string
+
+var (
+	// WebUIEnabled WebUI有効
+	WebUIEnabled = WebUI("true")
+	// WebUIDisabled WebUI無効
+	WebUIDisabled = WebUI("")
+)
+
+// Bool WebUIが有効な場合true
+func (w WebUI) Bool() bool {
+	s := strings.ToLower(string(w))
+	return s != "" && s != "false"
+}
+
+// String WebUIの文字列表現、有効かつURLを保持している場合はURLを返す
+func (w WebUI) String() string {
+	return string(w)
+}
+
+// MarshalJSON true/false(空文字)の場合はbool値、それ以外(URL)の場合は文字列として出力する
+func (w WebUI) MarshalJSON() ([]byte, error) {
+	switch strings.ToLower(string(w)) {
+	case "", "false":
+		return []byte("false"), nil
+	case "true":
+		return []byte("true"), nil
+	}
+	return json.Marshal(string(w))
+}
+
+// UnmarshalJSON bool値/文字列(URL)の両方に対応するためのUnmarshalJSON実装
+func (w *WebUI) UnmarshalJSON(b []byte) error {
+	switch string(b) {
+	case "", "null", "false":
+		*w = WebUIDisabled
+		return nil
+	case "true":
+		*w = WebUIEnabled
+		return nil
+	}
+
+	var v string
+	if err := json.Unmarshal(b, &v); err != nil {
+		return err
+	}
+	if strings.ToLower(v) == "false" {
+		v = ""
+	}
+	*w = WebUI(v)
+	return nil
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebUI(t *testing.T) {
+	expects := []struct {
+		input   string
+		expect  WebUI
+		enabled bool
+		output  string
+	}{
+		{input: `true`, expect: WebUIEnabled, enabled: true, output: `true`},
+		{input: `false`, expect: WebUIDisabled, enabled: false, output: `false`},
+		{input: `null`, expect: WebUIDisabled, enabled: false, output: `false`},
+		{input: `"false"`, expect: WebUIDisabled, enabled: false, output: `false`},
+		{
+			input:   `"https://192.0.2.11:21443/"`,
+			expect:  WebUI("https://192.0.2.11:21443/"),
+			enabled: true,
+			output:  `"https://192.0.2.11:21443/"`,
+		},
+	}
+
+	for _, tc := range expects {
+		var w WebUI
+		err := json.Unmarshal([]byte(tc.input), &w)
+		require.NoError(t, err, "input: %s", tc.input)
+		require.Equal(t, tc.expect, w)
+		require.Equal(t, tc.enabled, w.Bool())
+
+		data, err := json.Marshal(w)
+		require.NoError(t, err)
+		require.Equal(t, tc.output, string(data))
+	}
+}
@@ -20,6 +20,30 @@ func init() {
 		}
 	})
 
+	SetClientFactoryFunc("AuthStatus", func(caller APICaller) interface{} {
+		return &AuthStatusOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "auth-status",
+		}
+	})
+
+	SetClientFactoryFunc("AutoBackup", func(caller APICaller) interface{} {
+		return &AutoBackupOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "commonserviceitem",
+		}
+	})
+
+	SetClientFactoryFunc("Bill", func(caller APICaller) interface{} {
+		return &BillOp{
+			Client:     caller,
+			PathSuffix: "api/system/1.0",
+			PathName:   "bill",
+		}
+	})
+
 	SetClientFactoryFunc("Bridge", func(caller APICaller) interface{} {
 		return &BridgeOp{
 			Client:     caller,
@@ -36,6 +60,22 @@ func init() {
 		}
 	})
 
+	SetClientFactoryFunc("Coupon", func(caller APICaller) interface{} {
+		return &CouponOp{
+			Client:     caller,
+			PathSuffix: "api/system/1.0",
+			PathName:   "coupon",
+		}
+	})
+
+	SetClientFactoryFunc("Database", func(caller APICaller) interface{} {
+		return &DatabaseOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "appliance",
+		}
+	})
+
 	SetClientFactoryFunc("Disk", func(caller APICaller) interface{} {
 		return &DiskOp{
 			Client:     caller,
@@ -44,6 +84,30 @@ func init() {
 		}
 	})
 
+	SetClientFactoryFunc("DiskPlan", func(caller APICaller) interface{} {
+		return &DiskPlanOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "product/disk",
+		}
+	})
+
+	SetClientFactoryFunc("DNS", func(caller APICaller) interface{} {
+		return &DNSOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "commonserviceitem",
+		}
+	})
+
+	SetClientFactoryFunc("ESUsage", func(caller APICaller) interface{} {
+		return &ESUsageOp{
+			Client:     caller,
+			PathSuffix: "api/system/1.0",
+			PathName:   "esusage",
+		}
+	})
+
 	SetClientFactoryFunc("GSLB", func(caller APICaller) interface{} {
 		return &GSLBOp{
 			Client:     caller,
@@ -52,6 +116,14 @@ func init() {
 		}
 	})
 
+	SetClientFactoryFunc("Icon", func(caller APICaller) interface{} {
+		return &IconOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "icon",
+		}
+	})
+
 	SetClientFactoryFunc("Interface", func(caller APICaller) interface{} {
 		return &InterfaceOp{
 			Client:     caller,
@@ -68,6 +140,54 @@ func init() {
 		}
 	})
 
+	SetClientFactoryFunc("InternetPlan", func(caller APICaller) interface{} {
+		return &InternetPlanOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "product/internet",
+		}
+	})
+
+	SetClientFactoryFunc("IPAddress", func(caller APICaller) interface{} {
+		return &IPAddressOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "ipaddress",
+		}
+	})
+
+	SetClientFactoryFunc("IPv6Addr", func(caller APICaller) interface{} {
+		return &IPv6AddrOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "ipv6addr",
+		}
+	})
+
+	SetClientFactoryFunc("IPv6Net", func(caller APICaller) interface{} {
+		return &IPv6NetOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "ipv6net",
+		}
+	})
+
+	SetClientFactoryFunc("License", func(caller APICaller) interface{} {
+		return &LicenseOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "license",
+		}
+	})
+
+	SetClientFactoryFunc("LicensePlan", func(caller APICaller) interface{} {
+		return &LicensePlanOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "product/license",
+		}
+	})
+
 	SetClientFactoryFunc("LoadBalancer", func(caller APICaller) interface{} {
 		return &LoadBalancerOp{
 			Client:     caller,
@@ -76,6 +196,14 @@ func init() {
 		}
 	})
 
+	SetClientFactoryFunc("MobileGateway", func(caller APICaller) interface{} {
+		return &MobileGatewayOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "appliance",
+		}
+	})
+
 	SetClientFactoryFunc("NFS", func(caller APICaller) interface{} {
 		return &NFSOp{
 			Client:     caller,
@@ -100,6 +228,38 @@ func init() {
 		}
 	})
 
+	SetClientFactoryFunc("PrivateHost", func(caller APICaller) interface{} {
+		return &PrivateHostOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "privatehost",
+		}
+	})
+
+	SetClientFactoryFunc("PrivateHostPlan", func(caller APICaller) interface{} {
+		return &PrivateHostPlanOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "product/privatehost",
+		}
+	})
+
+	SetClientFactoryFunc("ProxyLB", func(caller APICaller) interface{} {
+		return &ProxyLBOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "commonserviceitem",
+		}
+	})
+
+	SetClientFactoryFunc("Region", func(caller APICaller) interface{} {
+		return &RegionOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "region",
+		}
+	})
+
 	SetClientFactoryFunc("Server", func(caller APICaller) interface{} {
 		return &ServerOp{
 			Client:     caller,
@@ -108,6 +268,22 @@ func init() {
 		}
 	})
 
+	SetClientFactoryFunc("ServerPlan", func(caller APICaller) interface{} {
+		return &ServerPlanOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "product/server",
+		}
+	})
+
+	SetClientFactoryFunc("ServiceClass", func(caller APICaller) interface{} {
+		return &ServiceClassOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "public/price",
+		}
+	})
+
 	SetClientFactoryFunc("SIM", func(caller APICaller) interface{} {
 		return &SIMOp{
 			Client:     caller,
@@ -116,6 +292,38 @@ func init() {
 		}
 	})
 
+	SetClientFactoryFunc("SimpleMonitor", func(caller APICaller) interface{} {
+		return &SimpleMonitorOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "commonserviceitem",
+		}
+	})
+
+	SetClientFactoryFunc("SSHKey", func(caller APICaller) interface{} {
+		return &SSHKeyOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "sshkey",
+		}
+	})
+
+	SetClientFactoryFunc("Storage", func(caller APICaller) interface{} {
+		return &StorageOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "storage",
+		}
+	})
+
+	SetClientFactoryFunc("Subnet", func(caller APICaller) interface{} {
+		return &SubnetOp{
+			Client:     caller,
+			PathSuffix: "api/cloud/1.1",
+			PathName:   "subnet",
+		}
+	})
+
 	SetClientFactoryFunc("Switch", func(caller APICaller) interface{} {
 		return &SwitchOp{
 			Client:     caller,
@@ -163,7 +371,7 @@ func NewArchiveOp(caller APICaller) ArchiveAPI {
 // Find is API call
 func (o *ArchiveOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Archive, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -219,7 +427,7 @@ func (o *ArchiveOp) Find(ctx context.Context, zone string, conditions *FindCondi
 // Create is API call
 func (o *ArchiveOp) Create(ctx context.Context, zone string, param *ArchiveCreateRequest) (*Archive, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -271,7 +479,7 @@ func (o *ArchiveOp) Create(ctx context.Context, zone string, param *ArchiveCreat
 // CreateBlank is API call
 func (o *ArchiveOp) CreateBlank(ctx context.Context, zone string, param *ArchiveCreateBlankRequest) (*Archive, *FTPServer, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -324,10 +532,138 @@ func (o *ArchiveOp) CreateBlank(ctx context.Context, zone string, param *Archive
 	return payload0, payload1, nil
 }
 
+// Transfer is API call
+func (o *ArchiveOp) Transfer(ctx context.Context, zone string, id types.ID, destZoneID types.ID, param *ArchiveTransferRequest) (*Archive, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/zone/{{.destZoneID}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"destZoneID": destZoneID,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if destZoneID == types.ID(int64(0)) {
+		destZoneID = types.ID(int64(0))
+	}
+	if param == nil {
+		param = &ArchiveTransferRequest{}
+	}
+	args := &struct {
+		Argzone       string
+		Argid         types.ID
+		ArgdestZoneID types.ID
+		Argparam      *ArchiveTransferRequest `mapconv:"Archive,recursive"`
+	}{
+		Argzone:       zone,
+		Argid:         id,
+		ArgdestZoneID: destZoneID,
+		Argparam:      param,
+	}
+
+	v := &archiveTransferRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &archiveTransferResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Archive{}
+	if err := payload0.convertFrom(nakedResponse.Archive); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// CreateFromShared is API call
+func (o *ArchiveOp) CreateFromShared(ctx context.Context, zone string, id types.ID, destZoneID types.ID, param *ArchiveCreateFromSharedRequest) (*Archive, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/zone/{{.destZoneID}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"destZoneID": destZoneID,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if destZoneID == types.ID(int64(0)) {
+		destZoneID = types.ID(int64(0))
+	}
+	if param == nil {
+		param = &ArchiveCreateFromSharedRequest{}
+	}
+	args := &struct {
+		Argzone       string
+		Argid         types.ID
+		ArgdestZoneID types.ID
+		Argparam      *ArchiveCreateFromSharedRequest `mapconv:"Archive,recursive"`
+	}{
+		Argzone:       zone,
+		Argid:         id,
+		ArgdestZoneID: destZoneID,
+		Argparam:      param,
+	}
+
+	v := &archiveCreateFromSharedRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &archiveCreateFromSharedResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Archive{}
+	if err := payload0.convertFrom(nakedResponse.Archive); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
 // Read is API call
 func (o *ArchiveOp) Read(ctx context.Context, zone string, id types.ID) (*Archive, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -359,7 +695,7 @@ func (o *ArchiveOp) Read(ctx context.Context, zone string, id types.ID) (*Archiv
 // Update is API call
 func (o *ArchiveOp) Update(ctx context.Context, zone string, id types.ID, param *ArchiveUpdateRequest) (*Archive, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -417,7 +753,7 @@ func (o *ArchiveOp) Update(ctx context.Context, zone string, id types.ID, param
 // Delete is API call
 func (o *ArchiveOp) Delete(ctx context.Context, zone string, id types.ID) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -440,7 +776,7 @@ func (o *ArchiveOp) Delete(ctx context.Context, zone string, id types.ID) error
 // OpenFTP is API call
 func (o *ArchiveOp) OpenFTP(ctx context.Context, zone string, id types.ID, openOption *OpenFTPRequest) (*FTPServer, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/ftp", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -498,7 +834,7 @@ func (o *ArchiveOp) OpenFTP(ctx context.Context, zone string, id types.ID, openO
 // CloseFTP is API call
 func (o *ArchiveOp) CloseFTP(ctx context.Context, zone string, id types.ID) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/ftp", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -518,29 +854,137 @@ func (o *ArchiveOp) CloseFTP(ctx context.Context, zone string, id types.ID) erro
 	return nil
 }
 
-/*************************************************
-* BridgeOp
-*************************************************/
-
-// BridgeOp implements BridgeAPI interface
-type BridgeOp struct {
-	// Client APICaller
-	Client APICaller
-	// PathSuffix is used when building URL
-	PathSuffix string
-	// PathName is used when building URL
-	PathName string
-}
-
-// NewBridgeOp creates new BridgeOp instance
-func NewBridgeOp(caller APICaller) BridgeAPI {
-	return GetClientFactoryFunc("Bridge")(caller).(BridgeAPI)
-}
-
-// Find is API call
-func (o *BridgeOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Bridge, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// Share is API call
+func (o *ArchiveOp) Share(ctx context.Context, zone string, id types.ID, param *ArchiveShareRequest) (*ArchiveShareInfo, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/ftp", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if param == nil {
+		param = &ArchiveShareRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argid    types.ID
+		Argparam *ArchiveShareRequest `mapconv:",squash"`
+	}{
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
+	}
+
+	v := &archiveShareRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &archiveShareResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &ArchiveShareInfo{}
+	if err := payload0.convertFrom(nakedResponse.ArchiveShareInfo); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+/*************************************************
+* AuthStatusOp
+*************************************************/
+
+// AuthStatusOp implements AuthStatusAPI interface
+type AuthStatusOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewAuthStatusOp creates new AuthStatusOp instance
+func NewAuthStatusOp(caller APICaller) AuthStatusAPI {
+	return GetClientFactoryFunc("AuthStatus")(caller).(AuthStatusAPI)
+}
+
+// Read is API call
+func (o *AuthStatusOp) Read(ctx context.Context, zone string) (*AuthStatus, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &authstatusReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &AuthStatus{}
+	if err := payload0.convertFrom(nakedResponse.AuthStatus); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+/*************************************************
+* AutoBackupOp
+*************************************************/
+
+// AutoBackupOp implements AutoBackupAPI interface
+type AutoBackupOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewAutoBackupOp creates new AutoBackupOp instance
+func NewAutoBackupOp(caller APICaller) AutoBackupAPI {
+	return GetClientFactoryFunc("AutoBackup")(caller).(AutoBackupAPI)
+}
+
+// Find is API call
+func (o *AutoBackupOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*AutoBackup, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -566,7 +1010,7 @@ func (o *BridgeOp) Find(ctx context.Context, zone string, conditions *FindCondit
 		Argconditions: conditions,
 	}
 
-	v := &bridgeFindRequestEnvelope{}
+	v := &autobackupFindRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -577,14 +1021,14 @@ func (o *BridgeOp) Find(ctx context.Context, zone string, conditions *FindCondit
 		return nil, err
 	}
 
-	nakedResponse := &bridgeFindResponseEnvelope{}
+	nakedResponse := &autobackupFindResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	var payload0 []*Bridge
-	for _, v := range nakedResponse.Bridges {
-		payload := &Bridge{}
+	var payload0 []*AutoBackup
+	for _, v := range nakedResponse.CommonServiceItems {
+		payload := &AutoBackup{}
 		if err := payload.convertFrom(v); err != nil {
 			return nil, err
 		}
@@ -594,9 +1038,9 @@ func (o *BridgeOp) Find(ctx context.Context, zone string, conditions *FindCondit
 }
 
 // Create is API call
-func (o *BridgeOp) Create(ctx context.Context, zone string, param *BridgeCreateRequest) (*Bridge, error) {
+func (o *AutoBackupOp) Create(ctx context.Context, zone string, param *AutoBackupCreateRequest) (*AutoBackup, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -612,17 +1056,17 @@ func (o *BridgeOp) Create(ctx context.Context, zone string, param *BridgeCreateR
 		zone = ""
 	}
 	if param == nil {
-		param = &BridgeCreateRequest{}
+		param = &AutoBackupCreateRequest{}
 	}
 	args := &struct {
 		Argzone  string
-		Argparam *BridgeCreateRequest `mapconv:"Bridge,recursive"`
+		Argparam *AutoBackupCreateRequest `mapconv:"CommonServiceItem,recursive"`
 	}{
 		Argzone:  zone,
 		Argparam: param,
 	}
 
-	v := &bridgeCreateRequestEnvelope{}
+	v := &autobackupCreateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -633,22 +1077,22 @@ func (o *BridgeOp) Create(ctx context.Context, zone string, param *BridgeCreateR
 		return nil, err
 	}
 
-	nakedResponse := &bridgeCreateResponseEnvelope{}
+	nakedResponse := &autobackupCreateResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &Bridge{}
-	if err := payload0.convertFrom(nakedResponse.Bridge); err != nil {
+	payload0 := &AutoBackup{}
+	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
 // Read is API call
-func (o *BridgeOp) Read(ctx context.Context, zone string, id types.ID) (*Bridge, error) {
+func (o *AutoBackupOp) Read(ctx context.Context, zone string, id types.ID) (*AutoBackup, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -665,22 +1109,22 @@ func (o *BridgeOp) Read(ctx context.Context, zone string, id types.ID) (*Bridge,
 		return nil, err
 	}
 
-	nakedResponse := &bridgeReadResponseEnvelope{}
+	nakedResponse := &autobackupReadResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &Bridge{}
-	if err := payload0.convertFrom(nakedResponse.Bridge); err != nil {
+	payload0 := &AutoBackup{}
+	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
 // Update is API call
-func (o *BridgeOp) Update(ctx context.Context, zone string, id types.ID, param *BridgeUpdateRequest) (*Bridge, error) {
+func (o *AutoBackupOp) Update(ctx context.Context, zone string, id types.ID, param *AutoBackupUpdateRequest) (*AutoBackup, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -700,19 +1144,19 @@ func (o *BridgeOp) Update(ctx context.Context, zone string, id types.ID, param *
 		id = types.ID(int64(0))
 	}
 	if param == nil {
-		param = &BridgeUpdateRequest{}
+		param = &AutoBackupUpdateRequest{}
 	}
 	args := &struct {
 		Argzone  string
 		Argid    types.ID
-		Argparam *BridgeUpdateRequest `mapconv:"Bridge,recursive"`
+		Argparam *AutoBackupUpdateRequest `mapconv:"CommonServiceItem,recursive"`
 	}{
 		Argzone:  zone,
 		Argid:    id,
 		Argparam: param,
 	}
 
-	v := &bridgeUpdateRequestEnvelope{}
+	v := &autobackupUpdateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -723,22 +1167,22 @@ func (o *BridgeOp) Update(ctx context.Context, zone string, id types.ID, param *
 		return nil, err
 	}
 
-	nakedResponse := &bridgeUpdateResponseEnvelope{}
+	nakedResponse := &autobackupUpdateResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &Bridge{}
-	if err := payload0.convertFrom(nakedResponse.Bridge); err != nil {
+	payload0 := &AutoBackup{}
+	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
 // Delete is API call
-func (o *BridgeOp) Delete(ctx context.Context, zone string, id types.ID) error {
+func (o *AutoBackupOp) Delete(ctx context.Context, zone string, id types.ID) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -759,11 +1203,11 @@ func (o *BridgeOp) Delete(ctx context.Context, zone string, id types.ID) error {
 }
 
 /*************************************************
-* CDROMOp
+* BillOp
 *************************************************/
 
-// CDROMOp implements CDROMAPI interface
-type CDROMOp struct {
+// BillOp implements BillAPI interface
+type BillOp struct {
 	// Client APICaller
 	Client APICaller
 	// PathSuffix is used when building URL
@@ -772,19 +1216,19 @@ type CDROMOp struct {
 	PathName string
 }
 
-// NewCDROMOp creates new CDROMOp instance
-func NewCDROMOp(caller APICaller) CDROMAPI {
-	return GetClientFactoryFunc("CDROM")(caller).(CDROMAPI)
+// NewBillOp creates new BillOp instance
+func NewBillOp(caller APICaller) BillAPI {
+	return GetClientFactoryFunc("Bill")(caller).(BillAPI)
 }
 
-// Find is API call
-func (o *CDROMOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*CDROM, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// ByContract is API call
+func (o *BillOp) ByContract(ctx context.Context, zone string, accountID types.ID) ([]*Bill, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/by-contract/{{.accountID}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
-		"conditions": conditions,
+		"accountID":  accountID,
 	})
 	if err != nil {
 		return nil, err
@@ -792,39 +1236,56 @@ func (o *CDROMOp) Find(ctx context.Context, zone string, conditions *FindConditi
 
 	var body interface{}
 
-	if zone == "" {
-		zone = ""
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
 	}
-	if conditions == nil {
-		conditions = &FindCondition{}
+
+	nakedResponse := &billByContractResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
 	}
-	args := &struct {
-		Argzone       string
-		Argconditions *FindCondition `mapconv:",squash"`
-	}{
-		Argzone:       zone,
-		Argconditions: conditions,
+
+	var payload0 []*Bill
+	for _, v := range nakedResponse.Bills {
+		payload := &Bill{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
 	}
+	return payload0, nil
+}
 
-	v := &cdromFindRequestEnvelope{}
-	if err := mapconv.ConvertTo(args, v); err != nil {
+// ByContractYear is API call
+func (o *BillOp) ByContractYear(ctx context.Context, zone string, accountID types.ID, year int) ([]*Bill, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/by-contract/{{.accountID}}/{{.year}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"accountID":  accountID,
+		"year":       year,
+	})
+	if err != nil {
 		return nil, err
 	}
-	body = v
+
+	var body interface{}
 
 	data, err := o.Client.Do(ctx, "GET", url, body)
 	if err != nil {
 		return nil, err
 	}
 
-	nakedResponse := &cdromFindResponseEnvelope{}
+	nakedResponse := &billByContractYearResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	var payload0 []*CDROM
-	for _, v := range nakedResponse.CDROMs {
-		payload := &CDROM{}
+	var payload0 []*Bill
+	for _, v := range nakedResponse.Bills {
+		payload := &Bill{}
 		if err := payload.convertFrom(v); err != nil {
 			return nil, err
 		}
@@ -833,69 +1294,88 @@ func (o *CDROMOp) Find(ctx context.Context, zone string, conditions *FindConditi
 	return payload0, nil
 }
 
-// Create is API call
-func (o *CDROMOp) Create(ctx context.Context, zone string, param *CDROMCreateRequest) (*CDROM, *FTPServer, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// ByContractYearMonth is API call
+func (o *BillOp) ByContractYearMonth(ctx context.Context, zone string, accountID types.ID, year int, month int) ([]*Bill, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/by-contract/{{.accountID}}/{{.year}}/{{.month}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
-		"param":      param,
+		"accountID":  accountID,
+		"year":       year,
+		"month":      month,
 	})
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	var body interface{}
 
-	if zone == "" {
-		zone = ""
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
 	}
-	if param == nil {
-		param = &CDROMCreateRequest{}
+
+	nakedResponse := &billByContractYearMonthResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
 	}
-	args := &struct {
-		Argzone  string
-		Argparam *CDROMCreateRequest `mapconv:"CDROM,recursive"`
-	}{
-		Argzone:  zone,
-		Argparam: param,
+
+	var payload0 []*Bill
+	for _, v := range nakedResponse.Bills {
+		payload := &Bill{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
 	}
+	return payload0, nil
+}
 
-	v := &cdromCreateRequestEnvelope{}
-	if err := mapconv.ConvertTo(args, v); err != nil {
-		return nil, nil, err
+// Read is API call
+func (o *BillOp) Read(ctx context.Context, zone string, id types.ID) ([]*Bill, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/id/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
 	}
-	body = v
 
-	data, err := o.Client.Do(ctx, "POST", url, body)
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	nakedResponse := &cdromCreateResponseEnvelope{}
+	nakedResponse := &billReadResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	payload0 := &CDROM{}
-	if err := payload0.convertFrom(nakedResponse.CDROM); err != nil {
-		return nil, nil, err
-	}
-	payload1 := &FTPServer{}
-	if err := payload1.convertFrom(nakedResponse.FTPServer); err != nil {
-		return nil, nil, err
+	var payload0 []*Bill
+	for _, v := range nakedResponse.Bills {
+		payload := &Bill{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
 	}
-	return payload0, payload1, nil
+	return payload0, nil
 }
 
-// Read is API call
-func (o *CDROMOp) Read(ctx context.Context, zone string, id types.ID) (*CDROM, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// Details is API call
+func (o *BillOp) Details(ctx context.Context, zone string, memberCode string, id types.ID) ([]*BillDetail, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/billdetail/{{.memberCode}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
+		"memberCode": memberCode,
 		"id":         id,
 	})
 	if err != nil {
@@ -909,27 +1389,31 @@ func (o *CDROMOp) Read(ctx context.Context, zone string, id types.ID) (*CDROM, e
 		return nil, err
 	}
 
-	nakedResponse := &cdromReadResponseEnvelope{}
+	nakedResponse := &billDetailsResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &CDROM{}
-	if err := payload0.convertFrom(nakedResponse.CDROM); err != nil {
-		return nil, err
+	var payload0 []*BillDetail
+	for _, v := range nakedResponse.BillDetails {
+		payload := &BillDetail{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
 	}
 	return payload0, nil
 }
 
-// Update is API call
-func (o *CDROMOp) Update(ctx context.Context, zone string, id types.ID, param *CDROMUpdateRequest) (*CDROM, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// DetailsCSV is API call
+func (o *BillOp) DetailsCSV(ctx context.Context, zone string, memberCode string, id types.ID) (*BillDetailCSV, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/billdetail/{{.memberCode}}/{{.id}}/csv", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
+		"memberCode": memberCode,
 		"id":         id,
-		"param":      param,
 	})
 	if err != nil {
 		return nil, err
@@ -937,80 +1421,191 @@ func (o *CDROMOp) Update(ctx context.Context, zone string, id types.ID, param *C
 
 	var body interface{}
 
-	if zone == "" {
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &billDetailsCSVResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &BillDetailCSV{}
+	if err := payload0.convertFrom(nakedResponse.BillDetailCSV); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+/*************************************************
+* BridgeOp
+*************************************************/
+
+// BridgeOp implements BridgeAPI interface
+type BridgeOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewBridgeOp creates new BridgeOp instance
+func NewBridgeOp(caller APICaller) BridgeAPI {
+	return GetClientFactoryFunc("Bridge")(caller).(BridgeAPI)
+}
+
+// Find is API call
+func (o *BridgeOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Bridge, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
 		zone = ""
 	}
-	if id == types.ID(int64(0)) {
-		id = types.ID(int64(0))
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
+	}
+
+	v := &bridgeFindRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &bridgeFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*Bridge
+	for _, v := range nakedResponse.Bridges {
+		payload := &Bridge{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+// Create is API call
+func (o *BridgeOp) Create(ctx context.Context, zone string, param *BridgeCreateRequest) (*Bridge, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
 	}
 	if param == nil {
-		param = &CDROMUpdateRequest{}
+		param = &BridgeCreateRequest{}
 	}
 	args := &struct {
 		Argzone  string
-		Argid    types.ID
-		Argparam *CDROMUpdateRequest `mapconv:"CDROM,recursive"`
+		Argparam *BridgeCreateRequest `mapconv:"Bridge,recursive"`
 	}{
 		Argzone:  zone,
-		Argid:    id,
 		Argparam: param,
 	}
 
-	v := &cdromUpdateRequestEnvelope{}
+	v := &bridgeCreateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
 	body = v
 
-	data, err := o.Client.Do(ctx, "PUT", url, body)
+	data, err := o.Client.Do(ctx, "POST", url, body)
 	if err != nil {
 		return nil, err
 	}
 
-	nakedResponse := &cdromUpdateResponseEnvelope{}
+	nakedResponse := &bridgeCreateResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &CDROM{}
-	if err := payload0.convertFrom(nakedResponse.CDROM); err != nil {
+	payload0 := &Bridge{}
+	if err := payload0.convertFrom(nakedResponse.Bridge); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
-// Delete is API call
-func (o *CDROMOp) Delete(ctx context.Context, zone string, id types.ID) error {
+// Read is API call
+func (o *BridgeOp) Read(ctx context.Context, zone string, id types.ID) (*Bridge, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
 		"id":         id,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var body interface{}
 
-	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	data, err := o.Client.Do(ctx, "GET", url, body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	nakedResponse := &bridgeReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Bridge{}
+	if err := payload0.convertFrom(nakedResponse.Bridge); err != nil {
+		return nil, err
+	}
+	return payload0, nil
 }
 
-// OpenFTP is API call
-func (o *CDROMOp) OpenFTP(ctx context.Context, zone string, id types.ID, openOption *OpenFTPRequest) (*FTPServer, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/ftp", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// Update is API call
+func (o *BridgeOp) Update(ctx context.Context, zone string, id types.ID, param *BridgeUpdateRequest) (*Bridge, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
 		"id":         id,
-		"openOption": openOption,
+		"param":      param,
 	})
 	if err != nil {
 		return nil, err
@@ -1024,20 +1619,20 @@ func (o *CDROMOp) OpenFTP(ctx context.Context, zone string, id types.ID, openOpt
 	if id == types.ID(int64(0)) {
 		id = types.ID(int64(0))
 	}
-	if openOption == nil {
-		openOption = &OpenFTPRequest{}
+	if param == nil {
+		param = &BridgeUpdateRequest{}
 	}
 	args := &struct {
-		Argzone       string
-		Argid         types.ID
-		ArgopenOption *OpenFTPRequest `mapconv:",squash"`
+		Argzone  string
+		Argid    types.ID
+		Argparam *BridgeUpdateRequest `mapconv:"Bridge,recursive"`
 	}{
-		Argzone:       zone,
-		Argid:         id,
-		ArgopenOption: openOption,
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
 	}
 
-	v := &cdromOpenFTPRequestEnvelope{}
+	v := &bridgeUpdateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -1048,22 +1643,22 @@ func (o *CDROMOp) OpenFTP(ctx context.Context, zone string, id types.ID, openOpt
 		return nil, err
 	}
 
-	nakedResponse := &cdromOpenFTPResponseEnvelope{}
+	nakedResponse := &bridgeUpdateResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &FTPServer{}
-	if err := payload0.convertFrom(nakedResponse.FTPServer); err != nil {
+	payload0 := &Bridge{}
+	if err := payload0.convertFrom(nakedResponse.Bridge); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
-// CloseFTP is API call
-func (o *CDROMOp) CloseFTP(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/ftp", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// Delete is API call
+func (o *BridgeOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -1084,11 +1679,11 @@ func (o *CDROMOp) CloseFTP(ctx context.Context, zone string, id types.ID) error
 }
 
 /*************************************************
-* DiskOp
+* CDROMOp
 *************************************************/
 
-// DiskOp implements DiskAPI interface
-type DiskOp struct {
+// CDROMOp implements CDROMAPI interface
+type CDROMOp struct {
 	// Client APICaller
 	Client APICaller
 	// PathSuffix is used when building URL
@@ -1097,15 +1692,15 @@ type DiskOp struct {
 	PathName string
 }
 
-// NewDiskOp creates new DiskOp instance
-func NewDiskOp(caller APICaller) DiskAPI {
-	return GetClientFactoryFunc("Disk")(caller).(DiskAPI)
+// NewCDROMOp creates new CDROMOp instance
+func NewCDROMOp(caller APICaller) CDROMAPI {
+	return GetClientFactoryFunc("CDROM")(caller).(CDROMAPI)
 }
 
 // Find is API call
-func (o *DiskOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Disk, error) {
+func (o *CDROMOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*CDROM, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -1131,7 +1726,7 @@ func (o *DiskOp) Find(ctx context.Context, zone string, conditions *FindConditio
 		Argconditions: conditions,
 	}
 
-	v := &diskFindRequestEnvelope{}
+	v := &cdromFindRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -1142,14 +1737,14 @@ func (o *DiskOp) Find(ctx context.Context, zone string, conditions *FindConditio
 		return nil, err
 	}
 
-	nakedResponse := &diskFindResponseEnvelope{}
+	nakedResponse := &cdromFindResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	var payload0 []*Disk
-	for _, v := range nakedResponse.Disks {
-		payload := &Disk{}
+	var payload0 []*CDROM
+	for _, v := range nakedResponse.CDROMs {
+		payload := &CDROM{}
 		if err := payload.convertFrom(v); err != nil {
 			return nil, err
 		}
@@ -1159,16 +1754,16 @@ func (o *DiskOp) Find(ctx context.Context, zone string, conditions *FindConditio
 }
 
 // Create is API call
-func (o *DiskOp) Create(ctx context.Context, zone string, param *DiskCreateRequest) (*Disk, error) {
+func (o *CDROMOp) Create(ctx context.Context, zone string, param *CDROMCreateRequest) (*CDROM, *FTPServer, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
 		"param":      param,
 	})
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	var body interface{}
@@ -1177,48 +1772,51 @@ func (o *DiskOp) Create(ctx context.Context, zone string, param *DiskCreateReque
 		zone = ""
 	}
 	if param == nil {
-		param = &DiskCreateRequest{}
+		param = &CDROMCreateRequest{}
 	}
 	args := &struct {
 		Argzone  string
-		Argparam *DiskCreateRequest `mapconv:"Disk,recursive"`
+		Argparam *CDROMCreateRequest `mapconv:"CDROM,recursive"`
 	}{
 		Argzone:  zone,
 		Argparam: param,
 	}
 
-	v := &diskCreateRequestEnvelope{}
+	v := &cdromCreateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	body = v
 
 	data, err := o.Client.Do(ctx, "POST", url, body)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	nakedResponse := &diskCreateResponseEnvelope{}
+	nakedResponse := &cdromCreateResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	payload0 := &Disk{}
-	if err := payload0.convertFrom(nakedResponse.Disk); err != nil {
-		return nil, err
+	payload0 := &CDROM{}
+	if err := payload0.convertFrom(nakedResponse.CDROM); err != nil {
+		return nil, nil, err
 	}
-	return payload0, nil
+	payload1 := &FTPServer{}
+	if err := payload1.convertFrom(nakedResponse.FTPServer); err != nil {
+		return nil, nil, err
+	}
+	return payload0, payload1, nil
 }
 
-// CreateDistantly is API call
-func (o *DiskOp) CreateDistantly(ctx context.Context, zone string, createParam *DiskCreateRequest, distantFrom []types.ID) (*Disk, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":     SakuraCloudAPIRoot,
-		"pathSuffix":  o.PathSuffix,
-		"pathName":    o.PathName,
-		"zone":        zone,
-		"createParam": createParam,
-		"distantFrom": distantFrom,
+// Read is API call
+func (o *CDROMOp) Read(ctx context.Context, zone string, id types.ID) (*CDROM, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
 	})
 	if err != nil {
 		return nil, err
@@ -1226,57 +1824,5476 @@ func (o *DiskOp) CreateDistantly(ctx context.Context, zone string, createParam *
 
 	var body interface{}
 
-	if zone == "" {
-		zone = ""
-	}
-	if createParam == nil {
-		createParam = &DiskCreateRequest{}
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &cdromReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &CDROM{}
+	if err := payload0.convertFrom(nakedResponse.CDROM); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Update is API call
+func (o *CDROMOp) Update(ctx context.Context, zone string, id types.ID, param *CDROMUpdateRequest) (*CDROM, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if param == nil {
+		param = &CDROMUpdateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argid    types.ID
+		Argparam *CDROMUpdateRequest `mapconv:"CDROM,recursive"`
+	}{
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
+	}
+
+	v := &cdromUpdateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &cdromUpdateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &CDROM{}
+	if err := payload0.convertFrom(nakedResponse.CDROM); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Delete is API call
+func (o *CDROMOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// OpenFTP is API call
+func (o *CDROMOp) OpenFTP(ctx context.Context, zone string, id types.ID, openOption *OpenFTPRequest) (*FTPServer, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/ftp", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"openOption": openOption,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if openOption == nil {
+		openOption = &OpenFTPRequest{}
+	}
+	args := &struct {
+		Argzone       string
+		Argid         types.ID
+		ArgopenOption *OpenFTPRequest `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argid:         id,
+		ArgopenOption: openOption,
+	}
+
+	v := &cdromOpenFTPRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &cdromOpenFTPResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &FTPServer{}
+	if err := payload0.convertFrom(nakedResponse.FTPServer); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// CloseFTP is API call
+func (o *CDROMOp) CloseFTP(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/ftp", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+/*************************************************
+* CouponOp
+*************************************************/
+
+// CouponOp implements CouponAPI interface
+type CouponOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewCouponOp creates new CouponOp instance
+func NewCouponOp(caller APICaller) CouponAPI {
+	return GetClientFactoryFunc("Coupon")(caller).(CouponAPI)
+}
+
+// Find is API call
+func (o *CouponOp) Find(ctx context.Context, zone string, accountID types.ID) ([]*Coupon, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.accountID}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"accountID":  accountID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &couponFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*Coupon
+	for _, v := range nakedResponse.Coupons {
+		payload := &Coupon{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+/*************************************************
+* DatabaseOp
+*************************************************/
+
+// DatabaseOp implements DatabaseAPI interface
+type DatabaseOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewDatabaseOp creates new DatabaseOp instance
+func NewDatabaseOp(caller APICaller) DatabaseAPI {
+	return GetClientFactoryFunc("Database")(caller).(DatabaseAPI)
+}
+
+// Find is API call
+func (o *DatabaseOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Database, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
+	}
+
+	v := &databaseFindRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &databaseFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*Database
+	for _, v := range nakedResponse.Appliances {
+		payload := &Database{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+// Create is API call
+func (o *DatabaseOp) Create(ctx context.Context, zone string, param *DatabaseCreateRequest) (*Database, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if param == nil {
+		param = &DatabaseCreateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argparam *DatabaseCreateRequest `mapconv:"Appliance,recursive"`
+	}{
+		Argzone:  zone,
+		Argparam: param,
+	}
+
+	v := &databaseCreateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &databaseCreateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Database{}
+	if err := payload0.convertFrom(nakedResponse.Appliance); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Read is API call
+func (o *DatabaseOp) Read(ctx context.Context, zone string, id types.ID) (*Database, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &databaseReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Database{}
+	if err := payload0.convertFrom(nakedResponse.Appliance); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Update is API call
+func (o *DatabaseOp) Update(ctx context.Context, zone string, id types.ID, param *DatabaseUpdateRequest) (*Database, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if param == nil {
+		param = &DatabaseUpdateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argid    types.ID
+		Argparam *DatabaseUpdateRequest `mapconv:"Appliance,recursive"`
+	}{
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
+	}
+
+	v := &databaseUpdateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &databaseUpdateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Database{}
+	if err := payload0.convertFrom(nakedResponse.Appliance); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Delete is API call
+func (o *DatabaseOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Config is API call
+func (o *DatabaseOp) Config(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/config", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Boot is API call
+func (o *DatabaseOp) Boot(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Shutdown is API call
+func (o *DatabaseOp) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *ShutdownOption) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", map[string]interface{}{
+		"rootURL":        resolveAPIRootURL(o.Client, zone),
+		"pathSuffix":     o.PathSuffix,
+		"pathName":       o.PathName,
+		"zone":           zone,
+		"id":             id,
+		"shutdownOption": shutdownOption,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if shutdownOption == nil {
+		shutdownOption = &ShutdownOption{}
+	}
+	args := &struct {
+		Argzone           string
+		Argid             types.ID
+		ArgshutdownOption *ShutdownOption `mapconv:",squash"`
+	}{
+		Argzone:           zone,
+		Argid:             id,
+		ArgshutdownOption: shutdownOption,
+	}
+
+	v := &databaseShutdownRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return err
+	}
+	body = v
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Reset is API call
+func (o *DatabaseOp) Reset(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/reset", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MonitorCPU is API call
+func (o *DatabaseOp) MonitorCPU(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*CPUTimeActivity, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/cpu/monitor", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"condition":  condition,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if condition == nil {
+		condition = &MonitorCondition{}
+	}
+	args := &struct {
+		Argzone      string
+		Argid        types.ID
+		Argcondition *MonitorCondition `mapconv:",squash"`
+	}{
+		Argzone:      zone,
+		Argid:        id,
+		Argcondition: condition,
+	}
+
+	v := &databaseMonitorCPURequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &databaseMonitorCPUResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &CPUTimeActivity{}
+	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// MonitorDisk is API call
+func (o *DatabaseOp) MonitorDisk(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*DiskActivity, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/disk/0/monitor", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"condition":  condition,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if condition == nil {
+		condition = &MonitorCondition{}
+	}
+	args := &struct {
+		Argzone      string
+		Argid        types.ID
+		Argcondition *MonitorCondition `mapconv:",squash"`
+	}{
+		Argzone:      zone,
+		Argid:        id,
+		Argcondition: condition,
+	}
+
+	v := &databaseMonitorDiskRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &databaseMonitorDiskResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &DiskActivity{}
+	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// MonitorInterface is API call
+func (o *DatabaseOp) MonitorInterface(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*InterfaceActivity, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/interface/monitor", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"condition":  condition,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if condition == nil {
+		condition = &MonitorCondition{}
+	}
+	args := &struct {
+		Argzone      string
+		Argid        types.ID
+		Argcondition *MonitorCondition `mapconv:",squash"`
+	}{
+		Argzone:      zone,
+		Argid:        id,
+		Argcondition: condition,
+	}
+
+	v := &databaseMonitorInterfaceRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &databaseMonitorInterfaceResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &InterfaceActivity{}
+	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// MonitorDatabase is API call
+func (o *DatabaseOp) MonitorDatabase(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*DatabaseActivity, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/database/monitor", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"condition":  condition,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if condition == nil {
+		condition = &MonitorCondition{}
+	}
+	args := &struct {
+		Argzone      string
+		Argid        types.ID
+		Argcondition *MonitorCondition `mapconv:",squash"`
+	}{
+		Argzone:      zone,
+		Argid:        id,
+		Argcondition: condition,
+	}
+
+	v := &databaseMonitorDatabaseRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &databaseMonitorDatabaseResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &DatabaseActivity{}
+	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Status is API call
+func (o *DatabaseOp) Status(ctx context.Context, zone string, id types.ID) (*DatabaseStatus, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/status", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &databaseStatusResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &DatabaseStatus{}
+	if err := payload0.convertFrom(nakedResponse.Appliance); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// GetParameter is API call
+func (o *DatabaseOp) GetParameter(ctx context.Context, zone string, id types.ID) (*DatabaseParameter, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/database/parameter", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &databaseGetParameterResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &DatabaseParameter{}
+	if err := payload0.convertFrom(nakedResponse.Database); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// SetParameter is API call
+func (o *DatabaseOp) SetParameter(ctx context.Context, zone string, id types.ID, param map[string]interface{}) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/database/parameter", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"param":      param,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if param == nil {
+		param = map[string]interface{}{}
+	}
+	args := &struct {
+		Argzone  string
+		Argid    types.ID
+		Argparam map[string]interface{} `mapconv:"Parameter.Attr"`
+	}{
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
+	}
+
+	v := &databaseSetParameterRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return err
+	}
+	body = v
+
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+/*************************************************
+* DiskOp
+*************************************************/
+
+// DiskOp implements DiskAPI interface
+type DiskOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewDiskOp creates new DiskOp instance
+func NewDiskOp(caller APICaller) DiskAPI {
+	return GetClientFactoryFunc("Disk")(caller).(DiskAPI)
+}
+
+// Find is API call
+func (o *DiskOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Disk, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
+	}
+
+	v := &diskFindRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &diskFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*Disk
+	for _, v := range nakedResponse.Disks {
+		payload := &Disk{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+// Create is API call
+func (o *DiskOp) Create(ctx context.Context, zone string, param *DiskCreateRequest) (*Disk, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if param == nil {
+		param = &DiskCreateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argparam *DiskCreateRequest `mapconv:"Disk,recursive"`
+	}{
+		Argzone:  zone,
+		Argparam: param,
+	}
+
+	v := &diskCreateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &diskCreateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Disk{}
+	if err := payload0.convertFrom(nakedResponse.Disk); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// CreateDistantly is API call
+func (o *DiskOp) CreateDistantly(ctx context.Context, zone string, createParam *DiskCreateRequest, distantFrom []types.ID) (*Disk, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":     resolveAPIRootURL(o.Client, zone),
+		"pathSuffix":  o.PathSuffix,
+		"pathName":    o.PathName,
+		"zone":        zone,
+		"createParam": createParam,
+		"distantFrom": distantFrom,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if createParam == nil {
+		createParam = &DiskCreateRequest{}
+	}
+	if distantFrom == nil {
+		distantFrom = []types.ID{}
+	}
+	args := &struct {
+		Argzone        string
+		ArgcreateParam *DiskCreateRequest `mapconv:"Disk"`
+		ArgdistantFrom []types.ID         `mapconv:"DistantFrom"`
+	}{
+		Argzone:        zone,
+		ArgcreateParam: createParam,
+		ArgdistantFrom: distantFrom,
+	}
+
+	v := &diskCreateDistantlyRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &diskCreateDistantlyResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Disk{}
+	if err := payload0.convertFrom(nakedResponse.Disk); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Config is API call
+func (o *DiskOp) Config(ctx context.Context, zone string, id types.ID, edit *DiskEditRequest) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/config", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"edit":       edit,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if edit == nil {
+		edit = &DiskEditRequest{}
+	}
+	args := &struct {
+		Argzone string
+		Argid   types.ID
+		Argedit *DiskEditRequest `mapconv:",squash"`
+	}{
+		Argzone: zone,
+		Argid:   id,
+		Argedit: edit,
+	}
+
+	v := &diskConfigRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return err
+	}
+	body = v
+
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CreateWithConfig is API call
+func (o *DiskOp) CreateWithConfig(ctx context.Context, zone string, createParam *DiskCreateRequest, editParam *DiskEditRequest, bootAtAvailable bool) (*Disk, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":         resolveAPIRootURL(o.Client, zone),
+		"pathSuffix":      o.PathSuffix,
+		"pathName":        o.PathName,
+		"zone":            zone,
+		"createParam":     createParam,
+		"editParam":       editParam,
+		"bootAtAvailable": bootAtAvailable,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if createParam == nil {
+		createParam = &DiskCreateRequest{}
+	}
+	if editParam == nil {
+		editParam = &DiskEditRequest{}
+	}
+	if bootAtAvailable == false {
+		bootAtAvailable = false
+	}
+	args := &struct {
+		Argzone            string
+		ArgcreateParam     *DiskCreateRequest `mapconv:"Disk"`
+		ArgeditParam       *DiskEditRequest   `mapconv:"Config"`
+		ArgbootAtAvailable bool               `mapconv:"BootAtAvailable"`
+	}{
+		Argzone:            zone,
+		ArgcreateParam:     createParam,
+		ArgeditParam:       editParam,
+		ArgbootAtAvailable: bootAtAvailable,
+	}
+
+	v := &diskCreateWithConfigRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &diskCreateWithConfigResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Disk{}
+	if err := payload0.convertFrom(nakedResponse.Disk); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// CreateWithConfigDistantly is API call
+func (o *DiskOp) CreateWithConfigDistantly(ctx context.Context, zone string, createParam *DiskCreateRequest, editParam *DiskEditRequest, bootAtAvailable bool, distantFrom []types.ID) (*Disk, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":         resolveAPIRootURL(o.Client, zone),
+		"pathSuffix":      o.PathSuffix,
+		"pathName":        o.PathName,
+		"zone":            zone,
+		"createParam":     createParam,
+		"editParam":       editParam,
+		"bootAtAvailable": bootAtAvailable,
+		"distantFrom":     distantFrom,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if createParam == nil {
+		createParam = &DiskCreateRequest{}
+	}
+	if editParam == nil {
+		editParam = &DiskEditRequest{}
+	}
+	if bootAtAvailable == false {
+		bootAtAvailable = false
+	}
+	if distantFrom == nil {
+		distantFrom = []types.ID{}
+	}
+	args := &struct {
+		Argzone            string
+		ArgcreateParam     *DiskCreateRequest `mapconv:"Disk"`
+		ArgeditParam       *DiskEditRequest   `mapconv:"Config"`
+		ArgbootAtAvailable bool               `mapconv:"BootAtAvailable"`
+		ArgdistantFrom     []types.ID         `mapconv:"DistantFrom"`
+	}{
+		Argzone:            zone,
+		ArgcreateParam:     createParam,
+		ArgeditParam:       editParam,
+		ArgbootAtAvailable: bootAtAvailable,
+		ArgdistantFrom:     distantFrom,
+	}
+
+	v := &diskCreateWithConfigDistantlyRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &diskCreateWithConfigDistantlyResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Disk{}
+	if err := payload0.convertFrom(nakedResponse.Disk); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// ToBlank is API call
+func (o *DiskOp) ToBlank(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/blank", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ResizePartition is API call
+func (o *DiskOp) ResizePartition(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/resize-partition", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConnectToServer is API call
+func (o *DiskOp) ConnectToServer(ctx context.Context, zone string, id types.ID, serverID types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/server/{{.serverID}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"serverID":   serverID,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DisconnectFromServer is API call
+func (o *DiskOp) DisconnectFromServer(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/server", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// InstallDistantFrom is API call
+func (o *DiskOp) InstallDistantFrom(ctx context.Context, zone string, id types.ID, installParam *DiskInstallRequest, distantFrom []types.ID) (*Disk, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/install", map[string]interface{}{
+		"rootURL":      resolveAPIRootURL(o.Client, zone),
+		"pathSuffix":   o.PathSuffix,
+		"pathName":     o.PathName,
+		"zone":         zone,
+		"id":           id,
+		"installParam": installParam,
+		"distantFrom":  distantFrom,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if installParam == nil {
+		installParam = &DiskInstallRequest{}
 	}
 	if distantFrom == nil {
 		distantFrom = []types.ID{}
 	}
 	args := &struct {
-		Argzone        string
-		ArgcreateParam *DiskCreateRequest `mapconv:"Disk"`
-		ArgdistantFrom []types.ID         `mapconv:"DistantFrom"`
+		Argzone         string
+		Argid           types.ID
+		ArginstallParam *DiskInstallRequest `mapconv:"Disk"`
+		ArgdistantFrom  []types.ID          `mapconv:"DistantFrom"`
+	}{
+		Argzone:         zone,
+		Argid:           id,
+		ArginstallParam: installParam,
+		ArgdistantFrom:  distantFrom,
+	}
+
+	v := &diskInstallDistantFromRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &diskInstallDistantFromResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Disk{}
+	if err := payload0.convertFrom(nakedResponse.Disk); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Install is API call
+func (o *DiskOp) Install(ctx context.Context, zone string, id types.ID, installParam *DiskInstallRequest) (*Disk, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/install", map[string]interface{}{
+		"rootURL":      resolveAPIRootURL(o.Client, zone),
+		"pathSuffix":   o.PathSuffix,
+		"pathName":     o.PathName,
+		"zone":         zone,
+		"id":           id,
+		"installParam": installParam,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if installParam == nil {
+		installParam = &DiskInstallRequest{}
+	}
+	args := &struct {
+		Argzone         string
+		Argid           types.ID
+		ArginstallParam *DiskInstallRequest `mapconv:"Disk"`
+	}{
+		Argzone:         zone,
+		Argid:           id,
+		ArginstallParam: installParam,
+	}
+
+	v := &diskInstallRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &diskInstallResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Disk{}
+	if err := payload0.convertFrom(nakedResponse.Disk); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Read is API call
+func (o *DiskOp) Read(ctx context.Context, zone string, id types.ID) (*Disk, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &diskReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Disk{}
+	if err := payload0.convertFrom(nakedResponse.Disk); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Update is API call
+func (o *DiskOp) Update(ctx context.Context, zone string, id types.ID, param *DiskUpdateRequest) (*Disk, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if param == nil {
+		param = &DiskUpdateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argid    types.ID
+		Argparam *DiskUpdateRequest `mapconv:"Disk,recursive"`
+	}{
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
+	}
+
+	v := &diskUpdateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &diskUpdateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Disk{}
+	if err := payload0.convertFrom(nakedResponse.Disk); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Delete is API call
+func (o *DiskOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Monitor is API call
+func (o *DiskOp) Monitor(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*DiskActivity, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/monitor", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"condition":  condition,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if condition == nil {
+		condition = &MonitorCondition{}
+	}
+	args := &struct {
+		Argzone      string
+		Argid        types.ID
+		Argcondition *MonitorCondition `mapconv:",squash"`
+	}{
+		Argzone:      zone,
+		Argid:        id,
+		Argcondition: condition,
+	}
+
+	v := &diskMonitorRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &diskMonitorResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &DiskActivity{}
+	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+/*************************************************
+* DiskPlanOp
+*************************************************/
+
+// DiskPlanOp implements DiskPlanAPI interface
+type DiskPlanOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewDiskPlanOp creates new DiskPlanOp instance
+func NewDiskPlanOp(caller APICaller) DiskPlanAPI {
+	return GetClientFactoryFunc("DiskPlan")(caller).(DiskPlanAPI)
+}
+
+// Find is API call
+func (o *DiskPlanOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*DiskPlan, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
+	}
+
+	v := &diskplanFindRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &diskplanFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*DiskPlan
+	for _, v := range nakedResponse.DiskPlans {
+		payload := &DiskPlan{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+// Read is API call
+func (o *DiskPlanOp) Read(ctx context.Context, zone string, id types.ID) (*DiskPlan, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &diskplanReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &DiskPlan{}
+	if err := payload0.convertFrom(nakedResponse.DiskPlan); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+/*************************************************
+* DNSOp
+*************************************************/
+
+// DNSOp implements DNSAPI interface
+type DNSOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewDNSOp creates new DNSOp instance
+func NewDNSOp(caller APICaller) DNSAPI {
+	return GetClientFactoryFunc("DNS")(caller).(DNSAPI)
+}
+
+// Find is API call
+func (o *DNSOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*DNS, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
+	}
+
+	v := &dnsFindRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &dnsFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*DNS
+	for _, v := range nakedResponse.CommonServiceItems {
+		payload := &DNS{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+// Create is API call
+func (o *DNSOp) Create(ctx context.Context, zone string, param *DNSCreateRequest) (*DNS, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if param == nil {
+		param = &DNSCreateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argparam *DNSCreateRequest `mapconv:"CommonServiceItem,recursive"`
+	}{
+		Argzone:  zone,
+		Argparam: param,
+	}
+
+	v := &dnsCreateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &dnsCreateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &DNS{}
+	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Read is API call
+func (o *DNSOp) Read(ctx context.Context, zone string, id types.ID) (*DNS, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &dnsReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &DNS{}
+	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Update is API call
+func (o *DNSOp) Update(ctx context.Context, zone string, id types.ID, param *DNSUpdateRequest) (*DNS, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if param == nil {
+		param = &DNSUpdateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argid    types.ID
+		Argparam *DNSUpdateRequest `mapconv:"CommonServiceItem,recursive"`
+	}{
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
+	}
+
+	v := &dnsUpdateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &dnsUpdateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &DNS{}
+	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Delete is API call
+func (o *DNSOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+/*************************************************
+* ESUsageOp
+*************************************************/
+
+// ESUsageOp implements ESUsageAPI interface
+type ESUsageOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewESUsageOp creates new ESUsageOp instance
+func NewESUsageOp(caller APICaller) ESUsageAPI {
+	return GetClientFactoryFunc("ESUsage")(caller).(ESUsageAPI)
+}
+
+// Find is API call
+func (o *ESUsageOp) Find(ctx context.Context, zone string, accountID types.ID, year int, month int) ([]*ESUsage, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.accountID}}/{{.year}}/{{.month}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"accountID":  accountID,
+		"year":       year,
+		"month":      month,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &esusageFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*ESUsage
+	for _, v := range nakedResponse.ESUsages {
+		payload := &ESUsage{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+/*************************************************
+* GSLBOp
+*************************************************/
+
+// GSLBOp implements GSLBAPI interface
+type GSLBOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewGSLBOp creates new GSLBOp instance
+func NewGSLBOp(caller APICaller) GSLBAPI {
+	return GetClientFactoryFunc("GSLB")(caller).(GSLBAPI)
+}
+
+// Find is API call
+func (o *GSLBOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*GSLB, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
+	}
+
+	v := &gslbFindRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &gslbFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*GSLB
+	for _, v := range nakedResponse.CommonServiceItems {
+		payload := &GSLB{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+// Create is API call
+func (o *GSLBOp) Create(ctx context.Context, zone string, param *GSLBCreateRequest) (*GSLB, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if param == nil {
+		param = &GSLBCreateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argparam *GSLBCreateRequest `mapconv:"CommonServiceItem,recursive"`
+	}{
+		Argzone:  zone,
+		Argparam: param,
+	}
+
+	v := &gslbCreateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &gslbCreateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &GSLB{}
+	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Read is API call
+func (o *GSLBOp) Read(ctx context.Context, zone string, id types.ID) (*GSLB, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &gslbReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &GSLB{}
+	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Update is API call
+func (o *GSLBOp) Update(ctx context.Context, zone string, id types.ID, param *GSLBUpdateRequest) (*GSLB, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if param == nil {
+		param = &GSLBUpdateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argid    types.ID
+		Argparam *GSLBUpdateRequest `mapconv:"CommonServiceItem,recursive"`
+	}{
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
+	}
+
+	v := &gslbUpdateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &gslbUpdateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &GSLB{}
+	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Delete is API call
+func (o *GSLBOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+/*************************************************
+* IconOp
+*************************************************/
+
+// IconOp implements IconAPI interface
+type IconOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewIconOp creates new IconOp instance
+func NewIconOp(caller APICaller) IconAPI {
+	return GetClientFactoryFunc("Icon")(caller).(IconAPI)
+}
+
+// Find is API call
+func (o *IconOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Icon, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
+	}
+
+	v := &iconFindRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &iconFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*Icon
+	for _, v := range nakedResponse.Icons {
+		payload := &Icon{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+// Create is API call
+func (o *IconOp) Create(ctx context.Context, zone string, param *IconCreateRequest) (*Icon, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if param == nil {
+		param = &IconCreateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argparam *IconCreateRequest `mapconv:"Icon,recursive"`
+	}{
+		Argzone:  zone,
+		Argparam: param,
+	}
+
+	v := &iconCreateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &iconCreateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Icon{}
+	if err := payload0.convertFrom(nakedResponse.Icon); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Read is API call
+func (o *IconOp) Read(ctx context.Context, zone string, id types.ID) (*Icon, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &iconReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Icon{}
+	if err := payload0.convertFrom(nakedResponse.Icon); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Update is API call
+func (o *IconOp) Update(ctx context.Context, zone string, id types.ID, param *IconUpdateRequest) (*Icon, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if param == nil {
+		param = &IconUpdateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argid    types.ID
+		Argparam *IconUpdateRequest `mapconv:"Icon,recursive"`
+	}{
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
+	}
+
+	v := &iconUpdateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &iconUpdateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Icon{}
+	if err := payload0.convertFrom(nakedResponse.Icon); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Delete is API call
+func (o *IconOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetImage is API call
+func (o *IconOp) GetImage(ctx context.Context, zone string, id types.ID, size types.EIconSize) (*IconImage, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"size":       size,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if size == types.EIconSize("") {
+		size = types.EIconSize("")
+	}
+	args := &struct {
+		Argzone string
+		Argid   types.ID
+		Argsize types.EIconSize `mapconv:"Size"`
+	}{
+		Argzone: zone,
+		Argid:   id,
+		Argsize: size,
+	}
+
+	v := &iconGetImageRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &iconGetImageResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &IconImage{}
+	if err := payload0.convertFrom(nakedResponse.Icon); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+/*************************************************
+* InterfaceOp
+*************************************************/
+
+// InterfaceOp implements InterfaceAPI interface
+type InterfaceOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewInterfaceOp creates new InterfaceOp instance
+func NewInterfaceOp(caller APICaller) InterfaceAPI {
+	return GetClientFactoryFunc("Interface")(caller).(InterfaceAPI)
+}
+
+// Find is API call
+func (o *InterfaceOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Interface, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
+	}
+
+	v := &interfaceFindRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &interfaceFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*Interface
+	for _, v := range nakedResponse.Interfaces {
+		payload := &Interface{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+// Create is API call
+func (o *InterfaceOp) Create(ctx context.Context, zone string, param *InterfaceCreateRequest) (*Interface, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if param == nil {
+		param = &InterfaceCreateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argparam *InterfaceCreateRequest `mapconv:"Interface,recursive"`
+	}{
+		Argzone:  zone,
+		Argparam: param,
+	}
+
+	v := &interfaceCreateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &interfaceCreateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Interface{}
+	if err := payload0.convertFrom(nakedResponse.Interface); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Read is API call
+func (o *InterfaceOp) Read(ctx context.Context, zone string, id types.ID) (*Interface, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &interfaceReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Interface{}
+	if err := payload0.convertFrom(nakedResponse.Interface); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Update is API call
+func (o *InterfaceOp) Update(ctx context.Context, zone string, id types.ID, param *InterfaceUpdateRequest) (*Interface, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if param == nil {
+		param = &InterfaceUpdateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argid    types.ID
+		Argparam *InterfaceUpdateRequest `mapconv:"Interface,recursive"`
+	}{
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
+	}
+
+	v := &interfaceUpdateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &interfaceUpdateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Interface{}
+	if err := payload0.convertFrom(nakedResponse.Interface); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Delete is API call
+func (o *InterfaceOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Monitor is API call
+func (o *InterfaceOp) Monitor(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*InterfaceActivity, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/monitor", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"condition":  condition,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if condition == nil {
+		condition = &MonitorCondition{}
+	}
+	args := &struct {
+		Argzone      string
+		Argid        types.ID
+		Argcondition *MonitorCondition `mapconv:",squash"`
+	}{
+		Argzone:      zone,
+		Argid:        id,
+		Argcondition: condition,
+	}
+
+	v := &interfaceMonitorRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &interfaceMonitorResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &InterfaceActivity{}
+	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// ConnectToSharedSegment is API call
+func (o *InterfaceOp) ConnectToSharedSegment(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/switch/shared", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConnectToSwitch is API call
+func (o *InterfaceOp) ConnectToSwitch(ctx context.Context, zone string, id types.ID, switchID types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/switch/{{.switchID}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"switchID":   switchID,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DisconnectFromSwitch is API call
+func (o *InterfaceOp) DisconnectFromSwitch(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/switch", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConnectToPacketFilter is API call
+func (o *InterfaceOp) ConnectToPacketFilter(ctx context.Context, zone string, id types.ID, packetFilterID types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/packetfilter/{{.packetFilterID}}", map[string]interface{}{
+		"rootURL":        resolveAPIRootURL(o.Client, zone),
+		"pathSuffix":     o.PathSuffix,
+		"pathName":       o.PathName,
+		"zone":           zone,
+		"id":             id,
+		"packetFilterID": packetFilterID,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DisconnectFromPacketFilter is API call
+func (o *InterfaceOp) DisconnectFromPacketFilter(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/packetfilter", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+/*************************************************
+* InternetOp
+*************************************************/
+
+// InternetOp implements InternetAPI interface
+type InternetOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewInternetOp creates new InternetOp instance
+func NewInternetOp(caller APICaller) InternetAPI {
+	return GetClientFactoryFunc("Internet")(caller).(InternetAPI)
+}
+
+// Find is API call
+func (o *InternetOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Internet, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
+	}
+
+	v := &internetFindRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &internetFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*Internet
+	for _, v := range nakedResponse.Internets {
+		payload := &Internet{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+// Create is API call
+func (o *InternetOp) Create(ctx context.Context, zone string, param *InternetCreateRequest) (*Internet, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if param == nil {
+		param = &InternetCreateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argparam *InternetCreateRequest `mapconv:"Internet,recursive"`
+	}{
+		Argzone:  zone,
+		Argparam: param,
+	}
+
+	v := &internetCreateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &internetCreateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Internet{}
+	if err := payload0.convertFrom(nakedResponse.Internet); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Read is API call
+func (o *InternetOp) Read(ctx context.Context, zone string, id types.ID) (*Internet, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &internetReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Internet{}
+	if err := payload0.convertFrom(nakedResponse.Internet); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Update is API call
+func (o *InternetOp) Update(ctx context.Context, zone string, id types.ID, param *InternetUpdateRequest) (*Internet, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if param == nil {
+		param = &InternetUpdateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argid    types.ID
+		Argparam *InternetUpdateRequest `mapconv:"Internet,recursive"`
+	}{
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
+	}
+
+	v := &internetUpdateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &internetUpdateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Internet{}
+	if err := payload0.convertFrom(nakedResponse.Internet); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Delete is API call
+func (o *InternetOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UpdateBandWidth is API call
+func (o *InternetOp) UpdateBandWidth(ctx context.Context, zone string, id types.ID, param *InternetUpdateBandWidthRequest) (*Internet, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/bandwidth", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if param == nil {
+		param = &InternetUpdateBandWidthRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argid    types.ID
+		Argparam *InternetUpdateBandWidthRequest `mapconv:"Internet,recursive"`
+	}{
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
+	}
+
+	v := &internetUpdateBandWidthRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &internetUpdateBandWidthResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Internet{}
+	if err := payload0.convertFrom(nakedResponse.Internet); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// AddSubnet is API call
+func (o *InternetOp) AddSubnet(ctx context.Context, zone string, id types.ID, param *InternetAddSubnetRequest) (*InternetSubnetOperationResult, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/subnet", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if param == nil {
+		param = &InternetAddSubnetRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argid    types.ID
+		Argparam *InternetAddSubnetRequest `mapconv:",squash"`
+	}{
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
+	}
+
+	v := &internetAddSubnetRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &internetAddSubnetResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &InternetSubnetOperationResult{}
+	if err := payload0.convertFrom(nakedResponse.Subnet); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// UpdateSubnet is API call
+func (o *InternetOp) UpdateSubnet(ctx context.Context, zone string, id types.ID, subnetID types.ID, param *InternetUpdateSubnetRequest) (*InternetSubnetOperationResult, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/subnet/{{.subnetID}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"subnetID":   subnetID,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if subnetID == types.ID(int64(0)) {
+		subnetID = types.ID(int64(0))
+	}
+	if param == nil {
+		param = &InternetUpdateSubnetRequest{}
+	}
+	args := &struct {
+		Argzone     string
+		Argid       types.ID
+		ArgsubnetID types.ID
+		Argparam    *InternetUpdateSubnetRequest `mapconv:",squash"`
+	}{
+		Argzone:     zone,
+		Argid:       id,
+		ArgsubnetID: subnetID,
+		Argparam:    param,
+	}
+
+	v := &internetUpdateSubnetRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &internetUpdateSubnetResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &InternetSubnetOperationResult{}
+	if err := payload0.convertFrom(nakedResponse.Subnet); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// DeleteSubnet is API call
+func (o *InternetOp) DeleteSubnet(ctx context.Context, zone string, id types.ID, subnetID types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/subnet/{{.subnetID}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"subnetID":   subnetID,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Monitor is API call
+func (o *InternetOp) Monitor(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*RouterActivity, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/monitor", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"condition":  condition,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if condition == nil {
+		condition = &MonitorCondition{}
+	}
+	args := &struct {
+		Argzone      string
+		Argid        types.ID
+		Argcondition *MonitorCondition `mapconv:",squash"`
+	}{
+		Argzone:      zone,
+		Argid:        id,
+		Argcondition: condition,
+	}
+
+	v := &internetMonitorRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &internetMonitorResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &RouterActivity{}
+	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// EnableIPv6 is API call
+func (o *InternetOp) EnableIPv6(ctx context.Context, zone string, id types.ID) (*IPv6NetInfo, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/ipv6net", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &internetEnableIPv6ResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &IPv6NetInfo{}
+	if err := payload0.convertFrom(nakedResponse.IPv6Net); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// DisableIPv6 is API call
+func (o *InternetOp) DisableIPv6(ctx context.Context, zone string, id types.ID, ipv6netID types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/ipv6net/{{.ipv6netID}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"ipv6netID":  ipv6netID,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+/*************************************************
+* InternetPlanOp
+*************************************************/
+
+// InternetPlanOp implements InternetPlanAPI interface
+type InternetPlanOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewInternetPlanOp creates new InternetPlanOp instance
+func NewInternetPlanOp(caller APICaller) InternetPlanAPI {
+	return GetClientFactoryFunc("InternetPlan")(caller).(InternetPlanAPI)
+}
+
+// Find is API call
+func (o *InternetPlanOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*InternetPlan, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
+	}
+
+	v := &internetplanFindRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &internetplanFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*InternetPlan
+	for _, v := range nakedResponse.InternetPlans {
+		payload := &InternetPlan{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+// Read is API call
+func (o *InternetPlanOp) Read(ctx context.Context, zone string, id types.ID) (*InternetPlan, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &internetplanReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &InternetPlan{}
+	if err := payload0.convertFrom(nakedResponse.InternetPlan); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+/*************************************************
+* IPAddressOp
+*************************************************/
+
+// IPAddressOp implements IPAddressAPI interface
+type IPAddressOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewIPAddressOp creates new IPAddressOp instance
+func NewIPAddressOp(caller APICaller) IPAddressAPI {
+	return GetClientFactoryFunc("IPAddress")(caller).(IPAddressAPI)
+}
+
+// Find is API call
+func (o *IPAddressOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*IPAddress, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
+	}
+
+	v := &ipaddressFindRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &ipaddressFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*IPAddress
+	for _, v := range nakedResponse.IPAddress {
+		payload := &IPAddress{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+// Read is API call
+func (o *IPAddressOp) Read(ctx context.Context, zone string, ipAddress string) (*IPAddress, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.ipAddress}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"ipAddress":  ipAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &ipaddressReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &IPAddress{}
+	if err := payload0.convertFrom(nakedResponse.IPAddress); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// UpdateHostName is API call
+func (o *IPAddressOp) UpdateHostName(ctx context.Context, zone string, ipAddress string, hostName string) (*IPAddress, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.ipAddress}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"ipAddress":  ipAddress,
+		"hostName":   hostName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if ipAddress == "" {
+		ipAddress = ""
+	}
+	if hostName == "" {
+		hostName = ""
+	}
+	args := &struct {
+		Argzone      string
+		ArgipAddress string
+		ArghostName  string `mapconv:"IPAddress.HostName"`
+	}{
+		Argzone:      zone,
+		ArgipAddress: ipAddress,
+		ArghostName:  hostName,
+	}
+
+	v := &ipaddressUpdateHostNameRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &ipaddressUpdateHostNameResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &IPAddress{}
+	if err := payload0.convertFrom(nakedResponse.IPAddress); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+/*************************************************
+* IPv6AddrOp
+*************************************************/
+
+// IPv6AddrOp implements IPv6AddrAPI interface
+type IPv6AddrOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewIPv6AddrOp creates new IPv6AddrOp instance
+func NewIPv6AddrOp(caller APICaller) IPv6AddrAPI {
+	return GetClientFactoryFunc("IPv6Addr")(caller).(IPv6AddrAPI)
+}
+
+// Find is API call
+func (o *IPv6AddrOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*IPv6Addr, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
+	}
+
+	v := &ipv6addrFindRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &ipv6addrFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*IPv6Addr
+	for _, v := range nakedResponse.IPv6Addrs {
+		payload := &IPv6Addr{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+// Create is API call
+func (o *IPv6AddrOp) Create(ctx context.Context, zone string, param *IPv6AddrCreateRequest) (*IPv6Addr, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if param == nil {
+		param = &IPv6AddrCreateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argparam *IPv6AddrCreateRequest `mapconv:"IPv6Addr,recursive"`
+	}{
+		Argzone:  zone,
+		Argparam: param,
+	}
+
+	v := &ipv6addrCreateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &ipv6addrCreateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &IPv6Addr{}
+	if err := payload0.convertFrom(nakedResponse.IPv6Addr); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Read is API call
+func (o *IPv6AddrOp) Read(ctx context.Context, zone string, ipv6addr string) (*IPv6Addr, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.ipv6addr}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"ipv6addr":   ipv6addr,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &ipv6addrReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &IPv6Addr{}
+	if err := payload0.convertFrom(nakedResponse.IPv6Addr); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Update is API call
+func (o *IPv6AddrOp) Update(ctx context.Context, zone string, ipv6addr string, param *IPv6AddrUpdateRequest) (*IPv6Addr, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.ipv6addr}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"ipv6addr":   ipv6addr,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if ipv6addr == "" {
+		ipv6addr = ""
+	}
+	if param == nil {
+		param = &IPv6AddrUpdateRequest{}
+	}
+	args := &struct {
+		Argzone     string
+		Argipv6addr string
+		Argparam    *IPv6AddrUpdateRequest `mapconv:"IPv6Addr,recursive"`
+	}{
+		Argzone:     zone,
+		Argipv6addr: ipv6addr,
+		Argparam:    param,
+	}
+
+	v := &ipv6addrUpdateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &ipv6addrUpdateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &IPv6Addr{}
+	if err := payload0.convertFrom(nakedResponse.IPv6Addr); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Delete is API call
+func (o *IPv6AddrOp) Delete(ctx context.Context, zone string, ipv6addr string) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.ipv6addr}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"ipv6addr":   ipv6addr,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+/*************************************************
+* IPv6NetOp
+*************************************************/
+
+// IPv6NetOp implements IPv6NetAPI interface
+type IPv6NetOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewIPv6NetOp creates new IPv6NetOp instance
+func NewIPv6NetOp(caller APICaller) IPv6NetAPI {
+	return GetClientFactoryFunc("IPv6Net")(caller).(IPv6NetAPI)
+}
+
+// Find is API call
+func (o *IPv6NetOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*IPv6Net, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
+	}
+
+	v := &ipv6netFindRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &ipv6netFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*IPv6Net
+	for _, v := range nakedResponse.IPv6Nets {
+		payload := &IPv6Net{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+// Read is API call
+func (o *IPv6NetOp) Read(ctx context.Context, zone string, id types.ID) (*IPv6Net, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &ipv6netReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &IPv6Net{}
+	if err := payload0.convertFrom(nakedResponse.IPv6Net); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+/*************************************************
+* LicenseOp
+*************************************************/
+
+// LicenseOp implements LicenseAPI interface
+type LicenseOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewLicenseOp creates new LicenseOp instance
+func NewLicenseOp(caller APICaller) LicenseAPI {
+	return GetClientFactoryFunc("License")(caller).(LicenseAPI)
+}
+
+// Find is API call
+func (o *LicenseOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*License, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
+	}
+
+	v := &licenseFindRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &licenseFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*License
+	for _, v := range nakedResponse.Licenses {
+		payload := &License{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+// Create is API call
+func (o *LicenseOp) Create(ctx context.Context, zone string, param *LicenseCreateRequest) (*License, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if param == nil {
+		param = &LicenseCreateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argparam *LicenseCreateRequest `mapconv:"License,recursive"`
+	}{
+		Argzone:  zone,
+		Argparam: param,
+	}
+
+	v := &licenseCreateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &licenseCreateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &License{}
+	if err := payload0.convertFrom(nakedResponse.License); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Read is API call
+func (o *LicenseOp) Read(ctx context.Context, zone string, id types.ID) (*License, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &licenseReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &License{}
+	if err := payload0.convertFrom(nakedResponse.License); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Update is API call
+func (o *LicenseOp) Update(ctx context.Context, zone string, id types.ID, param *LicenseUpdateRequest) (*License, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if param == nil {
+		param = &LicenseUpdateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argid    types.ID
+		Argparam *LicenseUpdateRequest `mapconv:"License,recursive"`
+	}{
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
+	}
+
+	v := &licenseUpdateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &licenseUpdateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &License{}
+	if err := payload0.convertFrom(nakedResponse.License); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Delete is API call
+func (o *LicenseOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+/*************************************************
+* LicensePlanOp
+*************************************************/
+
+// LicensePlanOp implements LicensePlanAPI interface
+type LicensePlanOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewLicensePlanOp creates new LicensePlanOp instance
+func NewLicensePlanOp(caller APICaller) LicensePlanAPI {
+	return GetClientFactoryFunc("LicensePlan")(caller).(LicensePlanAPI)
+}
+
+// Find is API call
+func (o *LicensePlanOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*LicensePlan, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
+	}
+
+	v := &licenseplanFindRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &licenseplanFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*LicensePlan
+	for _, v := range nakedResponse.LicensePlans {
+		payload := &LicensePlan{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+// Read is API call
+func (o *LicensePlanOp) Read(ctx context.Context, zone string, id types.ID) (*LicensePlan, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &licenseplanReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &LicensePlan{}
+	if err := payload0.convertFrom(nakedResponse.LicensePlan); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+/*************************************************
+* LoadBalancerOp
+*************************************************/
+
+// LoadBalancerOp implements LoadBalancerAPI interface
+type LoadBalancerOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewLoadBalancerOp creates new LoadBalancerOp instance
+func NewLoadBalancerOp(caller APICaller) LoadBalancerAPI {
+	return GetClientFactoryFunc("LoadBalancer")(caller).(LoadBalancerAPI)
+}
+
+// Find is API call
+func (o *LoadBalancerOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*LoadBalancer, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
+	}
+
+	v := &loadbalancerFindRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &loadbalancerFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*LoadBalancer
+	for _, v := range nakedResponse.Appliances {
+		payload := &LoadBalancer{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+// Create is API call
+func (o *LoadBalancerOp) Create(ctx context.Context, zone string, param *LoadBalancerCreateRequest) (*LoadBalancer, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if param == nil {
+		param = &LoadBalancerCreateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argparam *LoadBalancerCreateRequest `mapconv:"Appliance,recursive"`
+	}{
+		Argzone:  zone,
+		Argparam: param,
+	}
+
+	v := &loadbalancerCreateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &loadbalancerCreateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &LoadBalancer{}
+	if err := payload0.convertFrom(nakedResponse.Appliance); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Read is API call
+func (o *LoadBalancerOp) Read(ctx context.Context, zone string, id types.ID) (*LoadBalancer, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &loadbalancerReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &LoadBalancer{}
+	if err := payload0.convertFrom(nakedResponse.Appliance); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Update is API call
+func (o *LoadBalancerOp) Update(ctx context.Context, zone string, id types.ID, param *LoadBalancerUpdateRequest) (*LoadBalancer, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if param == nil {
+		param = &LoadBalancerUpdateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argid    types.ID
+		Argparam *LoadBalancerUpdateRequest `mapconv:"Appliance,recursive"`
+	}{
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
+	}
+
+	v := &loadbalancerUpdateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &loadbalancerUpdateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &LoadBalancer{}
+	if err := payload0.convertFrom(nakedResponse.Appliance); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Delete is API call
+func (o *LoadBalancerOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Config is API call
+func (o *LoadBalancerOp) Config(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/config", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Boot is API call
+func (o *LoadBalancerOp) Boot(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Shutdown is API call
+func (o *LoadBalancerOp) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *ShutdownOption) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", map[string]interface{}{
+		"rootURL":        resolveAPIRootURL(o.Client, zone),
+		"pathSuffix":     o.PathSuffix,
+		"pathName":       o.PathName,
+		"zone":           zone,
+		"id":             id,
+		"shutdownOption": shutdownOption,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if shutdownOption == nil {
+		shutdownOption = &ShutdownOption{}
+	}
+	args := &struct {
+		Argzone           string
+		Argid             types.ID
+		ArgshutdownOption *ShutdownOption `mapconv:",squash"`
+	}{
+		Argzone:           zone,
+		Argid:             id,
+		ArgshutdownOption: shutdownOption,
+	}
+
+	v := &loadbalancerShutdownRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return err
+	}
+	body = v
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Reset is API call
+func (o *LoadBalancerOp) Reset(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/reset", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MonitorInterface is API call
+func (o *LoadBalancerOp) MonitorInterface(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*InterfaceActivity, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/interface/monitor", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"condition":  condition,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if condition == nil {
+		condition = &MonitorCondition{}
+	}
+	args := &struct {
+		Argzone      string
+		Argid        types.ID
+		Argcondition *MonitorCondition `mapconv:",squash"`
+	}{
+		Argzone:      zone,
+		Argid:        id,
+		Argcondition: condition,
+	}
+
+	v := &loadbalancerMonitorInterfaceRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &loadbalancerMonitorInterfaceResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &InterfaceActivity{}
+	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Status is API call
+func (o *LoadBalancerOp) Status(ctx context.Context, zone string, id types.ID) ([]*LoadBalancerStatus, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/status", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &loadbalancerStatusResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*LoadBalancerStatus
+	for _, v := range nakedResponse.LoadBalancer {
+		payload := &LoadBalancerStatus{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+/*************************************************
+* MobileGatewayOp
+*************************************************/
+
+// MobileGatewayOp implements MobileGatewayAPI interface
+type MobileGatewayOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewMobileGatewayOp creates new MobileGatewayOp instance
+func NewMobileGatewayOp(caller APICaller) MobileGatewayAPI {
+	return GetClientFactoryFunc("MobileGateway")(caller).(MobileGatewayAPI)
+}
+
+// Find is API call
+func (o *MobileGatewayOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*MobileGateway, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
+	}
+
+	v := &mobilegatewayFindRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &mobilegatewayFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*MobileGateway
+	for _, v := range nakedResponse.Appliances {
+		payload := &MobileGateway{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+// Create is API call
+func (o *MobileGatewayOp) Create(ctx context.Context, zone string, param *MobileGatewayCreateRequest) (*MobileGateway, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if param == nil {
+		param = &MobileGatewayCreateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argparam *MobileGatewayCreateRequest `mapconv:"Appliance,recursive"`
+	}{
+		Argzone:  zone,
+		Argparam: param,
+	}
+
+	v := &mobilegatewayCreateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "POST", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &mobilegatewayCreateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &MobileGateway{}
+	if err := payload0.convertFrom(nakedResponse.Appliance); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Read is API call
+func (o *MobileGatewayOp) Read(ctx context.Context, zone string, id types.ID) (*MobileGateway, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &mobilegatewayReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &MobileGateway{}
+	if err := payload0.convertFrom(nakedResponse.Appliance); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Update is API call
+func (o *MobileGatewayOp) Update(ctx context.Context, zone string, id types.ID, param *MobileGatewayUpdateRequest) (*MobileGateway, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if param == nil {
+		param = &MobileGatewayUpdateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argid    types.ID
+		Argparam *MobileGatewayUpdateRequest `mapconv:"Appliance,recursive"`
+	}{
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
+	}
+
+	v := &mobilegatewayUpdateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &mobilegatewayUpdateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &MobileGateway{}
+	if err := payload0.convertFrom(nakedResponse.Appliance); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Delete is API call
+func (o *MobileGatewayOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Config is API call
+func (o *MobileGatewayOp) Config(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/config", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Boot is API call
+func (o *MobileGatewayOp) Boot(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Shutdown is API call
+func (o *MobileGatewayOp) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *ShutdownOption) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", map[string]interface{}{
+		"rootURL":        resolveAPIRootURL(o.Client, zone),
+		"pathSuffix":     o.PathSuffix,
+		"pathName":       o.PathName,
+		"zone":           zone,
+		"id":             id,
+		"shutdownOption": shutdownOption,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if shutdownOption == nil {
+		shutdownOption = &ShutdownOption{}
+	}
+	args := &struct {
+		Argzone           string
+		Argid             types.ID
+		ArgshutdownOption *ShutdownOption `mapconv:",squash"`
 	}{
-		Argzone:        zone,
-		ArgcreateParam: createParam,
-		ArgdistantFrom: distantFrom,
+		Argzone:           zone,
+		Argid:             id,
+		ArgshutdownOption: shutdownOption,
 	}
 
-	v := &diskCreateDistantlyRequestEnvelope{}
+	v := &mobilegatewayShutdownRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
-		return nil, err
+		return err
 	}
 	body = v
 
-	data, err := o.Client.Do(ctx, "POST", url, body)
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Reset is API call
+func (o *MobileGatewayOp) Reset(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/reset", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConnectToSwitch is API call
+func (o *MobileGatewayOp) ConnectToSwitch(ctx context.Context, zone string, id types.ID, switchID types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/interface/1/to/switch/{{.switchID}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"switchID":   switchID,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DisconnectFromSwitch is API call
+func (o *MobileGatewayOp) DisconnectFromSwitch(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/interface/1/to/switch", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetDNS is API call
+func (o *MobileGatewayOp) GetDNS(ctx context.Context, zone string, id types.ID) (*MobileGatewayDNSSetting, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/dnsresolver", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	nakedResponse := &diskCreateDistantlyResponseEnvelope{}
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &mobilegatewayGetDNSResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &Disk{}
-	if err := payload0.convertFrom(nakedResponse.Disk); err != nil {
+	payload0 := &MobileGatewayDNSSetting{}
+	if err := payload0.convertFrom(nakedResponse.SIMGroup); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
-// Config is API call
-func (o *DiskOp) Config(ctx context.Context, zone string, id types.ID, edit *DiskEditRequest) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/config", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// SetDNS is API call
+func (o *MobileGatewayOp) SetDNS(ctx context.Context, zone string, id types.ID, param *MobileGatewayDNSSetting) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/dnsresolver", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
 		"id":         id,
-		"edit":       edit,
+		"param":      param,
 	})
 	if err != nil {
 		return err
@@ -1290,20 +7307,20 @@ func (o *DiskOp) Config(ctx context.Context, zone string, id types.ID, edit *Dis
 	if id == types.ID(int64(0)) {
 		id = types.ID(int64(0))
 	}
-	if edit == nil {
-		edit = &DiskEditRequest{}
+	if param == nil {
+		param = &MobileGatewayDNSSetting{}
 	}
 	args := &struct {
-		Argzone string
-		Argid   types.ID
-		Argedit *DiskEditRequest `mapconv:",squash"`
+		Argzone  string
+		Argid    types.ID
+		Argparam *MobileGatewayDNSSetting `mapconv:"sim_group,recursive"`
 	}{
-		Argzone: zone,
-		Argid:   id,
-		Argedit: edit,
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
 	}
 
-	v := &diskConfigRequestEnvelope{}
+	v := &mobilegatewaySetDNSRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return err
 	}
@@ -1317,16 +7334,14 @@ func (o *DiskOp) Config(ctx context.Context, zone string, id types.ID, edit *Dis
 	return nil
 }
 
-// CreateWithConfig is API call
-func (o *DiskOp) CreateWithConfig(ctx context.Context, zone string, createParam *DiskCreateRequest, editParam *DiskEditRequest, bootAtAvailable bool) (*Disk, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":         SakuraCloudAPIRoot,
-		"pathSuffix":      o.PathSuffix,
-		"pathName":        o.PathName,
-		"zone":            zone,
-		"createParam":     createParam,
-		"editParam":       editParam,
-		"bootAtAvailable": bootAtAvailable,
+// ListSIM is API call
+func (o *MobileGatewayOp) ListSIM(ctx context.Context, zone string, id types.ID) ([]*MobileGatewaySIMInfo, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/sims", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
 	})
 	if err != nil {
 		return nil, err
@@ -1334,67 +7349,148 @@ func (o *DiskOp) CreateWithConfig(ctx context.Context, zone string, createParam
 
 	var body interface{}
 
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &mobilegatewayListSIMResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*MobileGatewaySIMInfo
+	for _, v := range nakedResponse.SIM {
+		payload := &MobileGatewaySIMInfo{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+// AddSIM is API call
+func (o *MobileGatewayOp) AddSIM(ctx context.Context, zone string, id types.ID, param *MobileGatewayAddSIMRequest) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/sims", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"param":      param,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
 	if zone == "" {
 		zone = ""
 	}
-	if createParam == nil {
-		createParam = &DiskCreateRequest{}
-	}
-	if editParam == nil {
-		editParam = &DiskEditRequest{}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
 	}
-	if bootAtAvailable == false {
-		bootAtAvailable = false
+	if param == nil {
+		param = &MobileGatewayAddSIMRequest{}
 	}
 	args := &struct {
-		Argzone            string
-		ArgcreateParam     *DiskCreateRequest `mapconv:"Disk"`
-		ArgeditParam       *DiskEditRequest   `mapconv:"Config"`
-		ArgbootAtAvailable bool               `mapconv:"BootAtAvailable"`
+		Argzone  string
+		Argid    types.ID
+		Argparam *MobileGatewayAddSIMRequest `mapconv:"sim,recursive"`
 	}{
-		Argzone:            zone,
-		ArgcreateParam:     createParam,
-		ArgeditParam:       editParam,
-		ArgbootAtAvailable: bootAtAvailable,
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
 	}
 
-	v := &diskCreateWithConfigRequestEnvelope{}
+	v := &mobilegatewayAddSIMRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
-		return nil, err
+		return err
 	}
 	body = v
 
-	data, err := o.Client.Do(ctx, "POST", url, body)
+	_, err = o.Client.Do(ctx, "POST", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteSIM is API call
+func (o *MobileGatewayOp) DeleteSIM(ctx context.Context, zone string, id types.ID, simID types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/sims/{{.simID}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"simID":      simID,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSIMRoutes is API call
+func (o *MobileGatewayOp) GetSIMRoutes(ctx context.Context, zone string, id types.ID) ([]*MobileGatewaySIMRoute, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/simroutes", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	nakedResponse := &diskCreateWithConfigResponseEnvelope{}
-	if err := json.Unmarshal(data, nakedResponse); err != nil {
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
 		return nil, err
 	}
 
-	payload0 := &Disk{}
-	if err := payload0.convertFrom(nakedResponse.Disk); err != nil {
+	nakedResponse := &mobilegatewayGetSIMRoutesResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
+
+	var payload0 []*MobileGatewaySIMRoute
+	for _, v := range nakedResponse.SIMRoutes {
+		payload := &MobileGatewaySIMRoute{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
 	return payload0, nil
 }
 
-// CreateWithConfigDistantly is API call
-func (o *DiskOp) CreateWithConfigDistantly(ctx context.Context, zone string, createParam *DiskCreateRequest, editParam *DiskEditRequest, bootAtAvailable bool, distantFrom []types.ID) (*Disk, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":         SakuraCloudAPIRoot,
-		"pathSuffix":      o.PathSuffix,
-		"pathName":        o.PathName,
-		"zone":            zone,
-		"createParam":     createParam,
-		"editParam":       editParam,
-		"bootAtAvailable": bootAtAvailable,
-		"distantFrom":     distantFrom,
+// SetSIMRoutes is API call
+func (o *MobileGatewayOp) SetSIMRoutes(ctx context.Context, zone string, id types.ID, routes []*MobileGatewaySIMRoute) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/simroutes", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"routes":     routes,
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	var body interface{}
@@ -1402,63 +7498,77 @@ func (o *DiskOp) CreateWithConfigDistantly(ctx context.Context, zone string, cre
 	if zone == "" {
 		zone = ""
 	}
-	if createParam == nil {
-		createParam = &DiskCreateRequest{}
-	}
-	if editParam == nil {
-		editParam = &DiskEditRequest{}
-	}
-	if bootAtAvailable == false {
-		bootAtAvailable = false
-	}
-	if distantFrom == nil {
-		distantFrom = []types.ID{}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if routes == nil {
+		routes = []*MobileGatewaySIMRoute{}
 	}
 	args := &struct {
-		Argzone            string
-		ArgcreateParam     *DiskCreateRequest `mapconv:"Disk"`
-		ArgeditParam       *DiskEditRequest   `mapconv:"Config"`
-		ArgbootAtAvailable bool               `mapconv:"BootAtAvailable"`
-		ArgdistantFrom     []types.ID         `mapconv:"DistantFrom"`
+		Argzone   string
+		Argid     types.ID
+		Argroutes []*MobileGatewaySIMRoute `mapconv:"[]sim_routes,recursive"`
 	}{
-		Argzone:            zone,
-		ArgcreateParam:     createParam,
-		ArgeditParam:       editParam,
-		ArgbootAtAvailable: bootAtAvailable,
-		ArgdistantFrom:     distantFrom,
+		Argzone:   zone,
+		Argid:     id,
+		Argroutes: routes,
 	}
 
-	v := &diskCreateWithConfigDistantlyRequestEnvelope{}
+	v := &mobilegatewaySetSIMRoutesRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
-		return nil, err
+		return err
 	}
 	body = v
 
-	data, err := o.Client.Do(ctx, "POST", url, body)
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetTrafficConfig is API call
+func (o *MobileGatewayOp) GetTrafficConfig(ctx context.Context, zone string, id types.ID) (*MobileGatewayTrafficControl, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/traffic_monitoring", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	nakedResponse := &diskCreateWithConfigDistantlyResponseEnvelope{}
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &mobilegatewayGetTrafficConfigResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &Disk{}
-	if err := payload0.convertFrom(nakedResponse.Disk); err != nil {
+	payload0 := &MobileGatewayTrafficControl{}
+	if err := payload0.convertFrom(nakedResponse.TrafficMonitoring); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
-// ToBlank is API call
-func (o *DiskOp) ToBlank(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/blank", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// SetTrafficConfig is API call
+func (o *MobileGatewayOp) SetTrafficConfig(ctx context.Context, zone string, id types.ID, param *MobileGatewayTrafficControl) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/traffic_monitoring", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
 		"id":         id,
+		"param":      param,
 	})
 	if err != nil {
 		return err
@@ -1466,6 +7576,31 @@ func (o *DiskOp) ToBlank(ctx context.Context, zone string, id types.ID) error {
 
 	var body interface{}
 
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if param == nil {
+		param = &MobileGatewayTrafficControl{}
+	}
+	args := &struct {
+		Argzone  string
+		Argid    types.ID
+		Argparam *MobileGatewayTrafficControl `mapconv:"traffic_monitoring_config,recursive"`
+	}{
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
+	}
+
+	v := &mobilegatewaySetTrafficConfigRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return err
+	}
+	body = v
+
 	_, err = o.Client.Do(ctx, "PUT", url, body)
 	if err != nil {
 		return err
@@ -1474,10 +7609,10 @@ func (o *DiskOp) ToBlank(ctx context.Context, zone string, id types.ID) error {
 	return nil
 }
 
-// ResizePartition is API call
-func (o *DiskOp) ResizePartition(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/resize-partition", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// DeleteTrafficConfig is API call
+func (o *MobileGatewayOp) DeleteTrafficConfig(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/traffic_monitoring", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -1489,7 +7624,7 @@ func (o *DiskOp) ResizePartition(ctx context.Context, zone string, id types.ID)
 
 	var body interface{}
 
-	_, err = o.Client.Do(ctx, "PUT", url, body)
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
 	if err != nil {
 		return err
 	}
@@ -1497,63 +7632,129 @@ func (o *DiskOp) ResizePartition(ctx context.Context, zone string, id types.ID)
 	return nil
 }
 
-// ConnectToServer is API call
-func (o *DiskOp) ConnectToServer(ctx context.Context, zone string, id types.ID, serverID types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/server/{{.serverID}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// TrafficStatus is API call
+func (o *MobileGatewayOp) TrafficStatus(ctx context.Context, zone string, id types.ID) (*MobileGatewayTrafficStatus, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/mobilegateway/traffic_status", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
 		"id":         id,
-		"serverID":   serverID,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var body interface{}
 
-	_, err = o.Client.Do(ctx, "PUT", url, body)
+	data, err := o.Client.Do(ctx, "GET", url, body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	nakedResponse := &mobilegatewayTrafficStatusResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &MobileGatewayTrafficStatus{}
+	if err := payload0.convertFrom(nakedResponse.TrafficStatus); err != nil {
+		return nil, err
+	}
+	return payload0, nil
 }
 
-// DisconnectFromServer is API call
-func (o *DiskOp) DisconnectFromServer(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/server", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// MonitorInterface is API call
+func (o *MobileGatewayOp) MonitorInterface(ctx context.Context, zone string, id types.ID, index int, condition *MonitorCondition) (*InterfaceActivity, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/interface/{{if eq .index 0}}{{.index}}{{end}}/monitor", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
 		"id":         id,
+		"index":      index,
+		"condition":  condition,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var body interface{}
 
-	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if index == 0 {
+		index = 0
+	}
+	if condition == nil {
+		condition = &MonitorCondition{}
+	}
+	args := &struct {
+		Argzone      string
+		Argid        types.ID
+		Argindex     int
+		Argcondition *MonitorCondition `mapconv:",squash"`
+	}{
+		Argzone:      zone,
+		Argid:        id,
+		Argindex:     index,
+		Argcondition: condition,
+	}
+
+	v := &mobilegatewayMonitorInterfaceRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	nakedResponse := &mobilegatewayMonitorInterfaceResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &InterfaceActivity{}
+	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
+		return nil, err
+	}
+	return payload0, nil
 }
 
-// InstallDistantFrom is API call
-func (o *DiskOp) InstallDistantFrom(ctx context.Context, zone string, id types.ID, installParam *DiskInstallRequest, distantFrom []types.ID) (*Disk, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/install", map[string]interface{}{
-		"rootURL":      SakuraCloudAPIRoot,
-		"pathSuffix":   o.PathSuffix,
-		"pathName":     o.PathName,
-		"zone":         zone,
-		"id":           id,
-		"installParam": installParam,
-		"distantFrom":  distantFrom,
+/*************************************************
+* NFSOp
+*************************************************/
+
+// NFSOp implements NFSAPI interface
+type NFSOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewNFSOp creates new NFSOp instance
+func NewNFSOp(caller APICaller) NFSAPI {
+	return GetClientFactoryFunc("NFS")(caller).(NFSAPI)
+}
+
+// Find is API call
+func (o *NFSOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*NFS, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
 	})
 	if err != nil {
 		return nil, err
@@ -1564,59 +7765,52 @@ func (o *DiskOp) InstallDistantFrom(ctx context.Context, zone string, id types.I
 	if zone == "" {
 		zone = ""
 	}
-	if id == types.ID(int64(0)) {
-		id = types.ID(int64(0))
-	}
-	if installParam == nil {
-		installParam = &DiskInstallRequest{}
-	}
-	if distantFrom == nil {
-		distantFrom = []types.ID{}
+	if conditions == nil {
+		conditions = &FindCondition{}
 	}
 	args := &struct {
-		Argzone         string
-		Argid           types.ID
-		ArginstallParam *DiskInstallRequest `mapconv:"Disk"`
-		ArgdistantFrom  []types.ID          `mapconv:"DistantFrom"`
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
 	}{
-		Argzone:         zone,
-		Argid:           id,
-		ArginstallParam: installParam,
-		ArgdistantFrom:  distantFrom,
+		Argzone:       zone,
+		Argconditions: conditions,
 	}
 
-	v := &diskInstallDistantFromRequestEnvelope{}
+	v := &nfsFindRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
 	body = v
 
-	data, err := o.Client.Do(ctx, "PUT", url, body)
+	data, err := o.Client.Do(ctx, "GET", url, body)
 	if err != nil {
 		return nil, err
 	}
 
-	nakedResponse := &diskInstallDistantFromResponseEnvelope{}
+	nakedResponse := &nfsFindResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &Disk{}
-	if err := payload0.convertFrom(nakedResponse.Disk); err != nil {
-		return nil, err
+	var payload0 []*NFS
+	for _, v := range nakedResponse.Appliances {
+		payload := &NFS{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
 	}
 	return payload0, nil
 }
 
-// Install is API call
-func (o *DiskOp) Install(ctx context.Context, zone string, id types.ID, installParam *DiskInstallRequest) (*Disk, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/install", map[string]interface{}{
-		"rootURL":      SakuraCloudAPIRoot,
-		"pathSuffix":   o.PathSuffix,
-		"pathName":     o.PathName,
-		"zone":         zone,
-		"id":           id,
-		"installParam": installParam,
+// Create is API call
+func (o *NFSOp) Create(ctx context.Context, zone string, param *NFSCreateRequest) (*NFS, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"param":      param,
 	})
 	if err != nil {
 		return nil, err
@@ -1627,49 +7821,44 @@ func (o *DiskOp) Install(ctx context.Context, zone string, id types.ID, installP
 	if zone == "" {
 		zone = ""
 	}
-	if id == types.ID(int64(0)) {
-		id = types.ID(int64(0))
-	}
-	if installParam == nil {
-		installParam = &DiskInstallRequest{}
+	if param == nil {
+		param = &NFSCreateRequest{}
 	}
 	args := &struct {
-		Argzone         string
-		Argid           types.ID
-		ArginstallParam *DiskInstallRequest `mapconv:"Disk"`
+		Argzone  string
+		Argparam *NFSCreateRequest `mapconv:"Appliance,recursive"`
 	}{
-		Argzone:         zone,
-		Argid:           id,
-		ArginstallParam: installParam,
+		Argzone:  zone,
+		Argparam: param,
 	}
 
-	v := &diskInstallRequestEnvelope{}
+	v := &nfsCreateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
 	body = v
 
-	data, err := o.Client.Do(ctx, "PUT", url, body)
+	data, err := o.Client.Do(ctx, "POST", url, body)
 	if err != nil {
 		return nil, err
 	}
 
-	nakedResponse := &diskInstallResponseEnvelope{}
+	nakedResponse := &nfsCreateResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &Disk{}
-	if err := payload0.convertFrom(nakedResponse.Disk); err != nil {
+	payload0 := &NFS{}
+	if err := payload0.convertFrom(nakedResponse.Appliance); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
 // Read is API call
-func (o *DiskOp) Read(ctx context.Context, zone string, id types.ID) (*Disk, error) {
+func (o *NFSOp) Read(ctx context.Context, zone string, id types.ID) (*NFS, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -1686,22 +7875,22 @@ func (o *DiskOp) Read(ctx context.Context, zone string, id types.ID) (*Disk, err
 		return nil, err
 	}
 
-	nakedResponse := &diskReadResponseEnvelope{}
+	nakedResponse := &nfsReadResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &Disk{}
-	if err := payload0.convertFrom(nakedResponse.Disk); err != nil {
+	payload0 := &NFS{}
+	if err := payload0.convertFrom(nakedResponse.Appliance); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
 // Update is API call
-func (o *DiskOp) Update(ctx context.Context, zone string, id types.ID, param *DiskUpdateRequest) (*Disk, error) {
+func (o *NFSOp) Update(ctx context.Context, zone string, id types.ID, param *NFSUpdateRequest) (*NFS, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -1721,19 +7910,19 @@ func (o *DiskOp) Update(ctx context.Context, zone string, id types.ID, param *Di
 		id = types.ID(int64(0))
 	}
 	if param == nil {
-		param = &DiskUpdateRequest{}
+		param = &NFSUpdateRequest{}
 	}
 	args := &struct {
 		Argzone  string
 		Argid    types.ID
-		Argparam *DiskUpdateRequest `mapconv:"Disk,recursive"`
+		Argparam *NFSUpdateRequest `mapconv:"Appliance,recursive"`
 	}{
 		Argzone:  zone,
 		Argid:    id,
 		Argparam: param,
 	}
 
-	v := &diskUpdateRequestEnvelope{}
+	v := &nfsUpdateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -1744,22 +7933,22 @@ func (o *DiskOp) Update(ctx context.Context, zone string, id types.ID, param *Di
 		return nil, err
 	}
 
-	nakedResponse := &diskUpdateResponseEnvelope{}
+	nakedResponse := &nfsUpdateResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &Disk{}
-	if err := payload0.convertFrom(nakedResponse.Disk); err != nil {
+	payload0 := &NFS{}
+	if err := payload0.convertFrom(nakedResponse.Appliance); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
 // Delete is API call
-func (o *DiskOp) Delete(ctx context.Context, zone string, id types.ID) error {
+func (o *NFSOp) Delete(ctx context.Context, zone string, id types.ID) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -1779,18 +7968,41 @@ func (o *DiskOp) Delete(ctx context.Context, zone string, id types.ID) error {
 	return nil
 }
 
-// Monitor is API call
-func (o *DiskOp) Monitor(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*DiskActivity, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/monitor", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// Boot is API call
+func (o *NFSOp) Boot(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
 		"id":         id,
-		"condition":  condition,
 	})
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Shutdown is API call
+func (o *NFSOp) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *ShutdownOption) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", map[string]interface{}{
+		"rootURL":        resolveAPIRootURL(o.Client, zone),
+		"pathSuffix":     o.PathSuffix,
+		"pathName":       o.PathName,
+		"zone":           zone,
+		"id":             id,
+		"shutdownOption": shutdownOption,
+	})
+	if err != nil {
+		return err
 	}
 
 	var body interface{}
@@ -1801,125 +8013,65 @@ func (o *DiskOp) Monitor(ctx context.Context, zone string, id types.ID, conditio
 	if id == types.ID(int64(0)) {
 		id = types.ID(int64(0))
 	}
-	if condition == nil {
-		condition = &MonitorCondition{}
+	if shutdownOption == nil {
+		shutdownOption = &ShutdownOption{}
 	}
 	args := &struct {
-		Argzone      string
-		Argid        types.ID
-		Argcondition *MonitorCondition `mapconv:",squash"`
+		Argzone           string
+		Argid             types.ID
+		ArgshutdownOption *ShutdownOption `mapconv:",squash"`
 	}{
-		Argzone:      zone,
-		Argid:        id,
-		Argcondition: condition,
+		Argzone:           zone,
+		Argid:             id,
+		ArgshutdownOption: shutdownOption,
 	}
 
-	v := &diskMonitorRequestEnvelope{}
+	v := &nfsShutdownRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
-		return nil, err
+		return err
 	}
 	body = v
 
-	data, err := o.Client.Do(ctx, "GET", url, body)
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
 	if err != nil {
-		return nil, err
-	}
-
-	nakedResponse := &diskMonitorResponseEnvelope{}
-	if err := json.Unmarshal(data, nakedResponse); err != nil {
-		return nil, err
-	}
-
-	payload0 := &DiskActivity{}
-	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
-		return nil, err
+		return err
 	}
-	return payload0, nil
-}
-
-/*************************************************
-* GSLBOp
-*************************************************/
-
-// GSLBOp implements GSLBAPI interface
-type GSLBOp struct {
-	// Client APICaller
-	Client APICaller
-	// PathSuffix is used when building URL
-	PathSuffix string
-	// PathName is used when building URL
-	PathName string
-}
 
-// NewGSLBOp creates new GSLBOp instance
-func NewGSLBOp(caller APICaller) GSLBAPI {
-	return GetClientFactoryFunc("GSLB")(caller).(GSLBAPI)
+	return nil
 }
 
-// Find is API call
-func (o *GSLBOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*GSLB, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// Reset is API call
+func (o *NFSOp) Reset(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/reset", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
-		"conditions": conditions,
+		"id":         id,
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	var body interface{}
 
-	if zone == "" {
-		zone = ""
-	}
-	if conditions == nil {
-		conditions = &FindCondition{}
-	}
-	args := &struct {
-		Argzone       string
-		Argconditions *FindCondition `mapconv:",squash"`
-	}{
-		Argzone:       zone,
-		Argconditions: conditions,
-	}
-
-	v := &gslbFindRequestEnvelope{}
-	if err := mapconv.ConvertTo(args, v); err != nil {
-		return nil, err
-	}
-	body = v
-
-	data, err := o.Client.Do(ctx, "GET", url, body)
+	_, err = o.Client.Do(ctx, "PUT", url, body)
 	if err != nil {
-		return nil, err
-	}
-
-	nakedResponse := &gslbFindResponseEnvelope{}
-	if err := json.Unmarshal(data, nakedResponse); err != nil {
-		return nil, err
+		return err
 	}
 
-	var payload0 []*GSLB
-	for _, v := range nakedResponse.CommonServiceItems {
-		payload := &GSLB{}
-		if err := payload.convertFrom(v); err != nil {
-			return nil, err
-		}
-		payload0 = append(payload0, payload)
-	}
-	return payload0, nil
+	return nil
 }
 
-// Create is API call
-func (o *GSLBOp) Create(ctx context.Context, zone string, param *GSLBCreateRequest) (*GSLB, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// MonitorFreeDiskSize is API call
+func (o *NFSOp) MonitorFreeDiskSize(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*FreeDiskSizeActivity, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/database/monitor", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
-		"param":      param,
+		"id":         id,
+		"condition":  condition,
 	})
 	if err != nil {
 		return nil, err
@@ -1930,81 +8082,54 @@ func (o *GSLBOp) Create(ctx context.Context, zone string, param *GSLBCreateReque
 	if zone == "" {
 		zone = ""
 	}
-	if param == nil {
-		param = &GSLBCreateRequest{}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if condition == nil {
+		condition = &MonitorCondition{}
 	}
 	args := &struct {
-		Argzone  string
-		Argparam *GSLBCreateRequest `mapconv:"CommonServiceItem,recursive"`
+		Argzone      string
+		Argid        types.ID
+		Argcondition *MonitorCondition `mapconv:",squash"`
 	}{
-		Argzone:  zone,
-		Argparam: param,
+		Argzone:      zone,
+		Argid:        id,
+		Argcondition: condition,
 	}
 
-	v := &gslbCreateRequestEnvelope{}
+	v := &nfsMonitorFreeDiskSizeRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
 	body = v
 
-	data, err := o.Client.Do(ctx, "POST", url, body)
-	if err != nil {
-		return nil, err
-	}
-
-	nakedResponse := &gslbCreateResponseEnvelope{}
-	if err := json.Unmarshal(data, nakedResponse); err != nil {
-		return nil, err
-	}
-
-	payload0 := &GSLB{}
-	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
-		return nil, err
-	}
-	return payload0, nil
-}
-
-// Read is API call
-func (o *GSLBOp) Read(ctx context.Context, zone string, id types.ID) (*GSLB, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
-		"pathSuffix": o.PathSuffix,
-		"pathName":   o.PathName,
-		"zone":       zone,
-		"id":         id,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	var body interface{}
-
 	data, err := o.Client.Do(ctx, "GET", url, body)
 	if err != nil {
 		return nil, err
 	}
 
-	nakedResponse := &gslbReadResponseEnvelope{}
+	nakedResponse := &nfsMonitorFreeDiskSizeResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &GSLB{}
-	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
+	payload0 := &FreeDiskSizeActivity{}
+	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
-// Update is API call
-func (o *GSLBOp) Update(ctx context.Context, zone string, id types.ID, param *GSLBUpdateRequest) (*GSLB, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// MonitorInterface is API call
+func (o *NFSOp) MonitorInterface(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*InterfaceActivity, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/interface/monitor", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
 		"id":         id,
-		"param":      param,
+		"condition":  condition,
 	})
 	if err != nil {
 		return nil, err
@@ -2018,71 +8143,48 @@ func (o *GSLBOp) Update(ctx context.Context, zone string, id types.ID, param *GS
 	if id == types.ID(int64(0)) {
 		id = types.ID(int64(0))
 	}
-	if param == nil {
-		param = &GSLBUpdateRequest{}
+	if condition == nil {
+		condition = &MonitorCondition{}
 	}
 	args := &struct {
-		Argzone  string
-		Argid    types.ID
-		Argparam *GSLBUpdateRequest `mapconv:"CommonServiceItem,recursive"`
+		Argzone      string
+		Argid        types.ID
+		Argcondition *MonitorCondition `mapconv:",squash"`
 	}{
-		Argzone:  zone,
-		Argid:    id,
-		Argparam: param,
+		Argzone:      zone,
+		Argid:        id,
+		Argcondition: condition,
 	}
 
-	v := &gslbUpdateRequestEnvelope{}
+	v := &nfsMonitorInterfaceRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
 	body = v
 
-	data, err := o.Client.Do(ctx, "PUT", url, body)
+	data, err := o.Client.Do(ctx, "GET", url, body)
 	if err != nil {
 		return nil, err
 	}
 
-	nakedResponse := &gslbUpdateResponseEnvelope{}
+	nakedResponse := &nfsMonitorInterfaceResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &GSLB{}
-	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
+	payload0 := &InterfaceActivity{}
+	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
-// Delete is API call
-func (o *GSLBOp) Delete(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
-		"pathSuffix": o.PathSuffix,
-		"pathName":   o.PathName,
-		"zone":       zone,
-		"id":         id,
-	})
-	if err != nil {
-		return err
-	}
-
-	var body interface{}
-
-	_, err = o.Client.Do(ctx, "DELETE", url, body)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
 /*************************************************
-* InterfaceOp
+* NoteOp
 *************************************************/
 
-// InterfaceOp implements InterfaceAPI interface
-type InterfaceOp struct {
+// NoteOp implements NoteAPI interface
+type NoteOp struct {
 	// Client APICaller
 	Client APICaller
 	// PathSuffix is used when building URL
@@ -2091,15 +8193,15 @@ type InterfaceOp struct {
 	PathName string
 }
 
-// NewInterfaceOp creates new InterfaceOp instance
-func NewInterfaceOp(caller APICaller) InterfaceAPI {
-	return GetClientFactoryFunc("Interface")(caller).(InterfaceAPI)
+// NewNoteOp creates new NoteOp instance
+func NewNoteOp(caller APICaller) NoteAPI {
+	return GetClientFactoryFunc("Note")(caller).(NoteAPI)
 }
 
 // Find is API call
-func (o *InterfaceOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Interface, error) {
+func (o *NoteOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Note, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -2125,7 +8227,7 @@ func (o *InterfaceOp) Find(ctx context.Context, zone string, conditions *FindCon
 		Argconditions: conditions,
 	}
 
-	v := &interfaceFindRequestEnvelope{}
+	v := &noteFindRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -2136,14 +8238,14 @@ func (o *InterfaceOp) Find(ctx context.Context, zone string, conditions *FindCon
 		return nil, err
 	}
 
-	nakedResponse := &interfaceFindResponseEnvelope{}
+	nakedResponse := &noteFindResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	var payload0 []*Interface
-	for _, v := range nakedResponse.Interfaces {
-		payload := &Interface{}
+	var payload0 []*Note
+	for _, v := range nakedResponse.Notes {
+		payload := &Note{}
 		if err := payload.convertFrom(v); err != nil {
 			return nil, err
 		}
@@ -2153,9 +8255,9 @@ func (o *InterfaceOp) Find(ctx context.Context, zone string, conditions *FindCon
 }
 
 // Create is API call
-func (o *InterfaceOp) Create(ctx context.Context, zone string, param *InterfaceCreateRequest) (*Interface, error) {
+func (o *NoteOp) Create(ctx context.Context, zone string, param *NoteCreateRequest) (*Note, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -2171,17 +8273,17 @@ func (o *InterfaceOp) Create(ctx context.Context, zone string, param *InterfaceC
 		zone = ""
 	}
 	if param == nil {
-		param = &InterfaceCreateRequest{}
+		param = &NoteCreateRequest{}
 	}
 	args := &struct {
 		Argzone  string
-		Argparam *InterfaceCreateRequest `mapconv:"Interface,recursive"`
+		Argparam *NoteCreateRequest `mapconv:"Note,recursive"`
 	}{
 		Argzone:  zone,
 		Argparam: param,
 	}
 
-	v := &interfaceCreateRequestEnvelope{}
+	v := &noteCreateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -2192,22 +8294,22 @@ func (o *InterfaceOp) Create(ctx context.Context, zone string, param *InterfaceC
 		return nil, err
 	}
 
-	nakedResponse := &interfaceCreateResponseEnvelope{}
+	nakedResponse := &noteCreateResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &Interface{}
-	if err := payload0.convertFrom(nakedResponse.Interface); err != nil {
+	payload0 := &Note{}
+	if err := payload0.convertFrom(nakedResponse.Note); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
 // Read is API call
-func (o *InterfaceOp) Read(ctx context.Context, zone string, id types.ID) (*Interface, error) {
+func (o *NoteOp) Read(ctx context.Context, zone string, id types.ID) (*Note, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -2224,22 +8326,22 @@ func (o *InterfaceOp) Read(ctx context.Context, zone string, id types.ID) (*Inte
 		return nil, err
 	}
 
-	nakedResponse := &interfaceReadResponseEnvelope{}
+	nakedResponse := &noteReadResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &Interface{}
-	if err := payload0.convertFrom(nakedResponse.Interface); err != nil {
+	payload0 := &Note{}
+	if err := payload0.convertFrom(nakedResponse.Note); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
 // Update is API call
-func (o *InterfaceOp) Update(ctx context.Context, zone string, id types.ID, param *InterfaceUpdateRequest) (*Interface, error) {
+func (o *NoteOp) Update(ctx context.Context, zone string, id types.ID, param *NoteUpdateRequest) (*Note, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -2259,19 +8361,19 @@ func (o *InterfaceOp) Update(ctx context.Context, zone string, id types.ID, para
 		id = types.ID(int64(0))
 	}
 	if param == nil {
-		param = &InterfaceUpdateRequest{}
+		param = &NoteUpdateRequest{}
 	}
 	args := &struct {
 		Argzone  string
 		Argid    types.ID
-		Argparam *InterfaceUpdateRequest `mapconv:"Interface,recursive"`
+		Argparam *NoteUpdateRequest `mapconv:"Note,recursive"`
 	}{
 		Argzone:  zone,
 		Argid:    id,
 		Argparam: param,
 	}
 
-	v := &interfaceUpdateRequestEnvelope{}
+	v := &noteUpdateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -2282,197 +8384,22 @@ func (o *InterfaceOp) Update(ctx context.Context, zone string, id types.ID, para
 		return nil, err
 	}
 
-	nakedResponse := &interfaceUpdateResponseEnvelope{}
-	if err := json.Unmarshal(data, nakedResponse); err != nil {
-		return nil, err
-	}
-
-	payload0 := &Interface{}
-	if err := payload0.convertFrom(nakedResponse.Interface); err != nil {
-		return nil, err
-	}
-	return payload0, nil
-}
-
-// Delete is API call
-func (o *InterfaceOp) Delete(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
-		"pathSuffix": o.PathSuffix,
-		"pathName":   o.PathName,
-		"zone":       zone,
-		"id":         id,
-	})
-	if err != nil {
-		return err
-	}
-
-	var body interface{}
-
-	_, err = o.Client.Do(ctx, "DELETE", url, body)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// Monitor is API call
-func (o *InterfaceOp) Monitor(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*InterfaceActivity, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/monitor", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
-		"pathSuffix": o.PathSuffix,
-		"pathName":   o.PathName,
-		"zone":       zone,
-		"id":         id,
-		"condition":  condition,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	var body interface{}
-
-	if zone == "" {
-		zone = ""
-	}
-	if id == types.ID(int64(0)) {
-		id = types.ID(int64(0))
-	}
-	if condition == nil {
-		condition = &MonitorCondition{}
-	}
-	args := &struct {
-		Argzone      string
-		Argid        types.ID
-		Argcondition *MonitorCondition `mapconv:",squash"`
-	}{
-		Argzone:      zone,
-		Argid:        id,
-		Argcondition: condition,
-	}
-
-	v := &interfaceMonitorRequestEnvelope{}
-	if err := mapconv.ConvertTo(args, v); err != nil {
-		return nil, err
-	}
-	body = v
-
-	data, err := o.Client.Do(ctx, "GET", url, body)
-	if err != nil {
-		return nil, err
-	}
-
-	nakedResponse := &interfaceMonitorResponseEnvelope{}
+	nakedResponse := &noteUpdateResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
-		return nil, err
-	}
-
-	payload0 := &InterfaceActivity{}
-	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
-		return nil, err
-	}
-	return payload0, nil
-}
-
-// ConnectToSharedSegment is API call
-func (o *InterfaceOp) ConnectToSharedSegment(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/switch/shared", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
-		"pathSuffix": o.PathSuffix,
-		"pathName":   o.PathName,
-		"zone":       zone,
-		"id":         id,
-	})
-	if err != nil {
-		return err
-	}
-
-	var body interface{}
-
-	_, err = o.Client.Do(ctx, "PUT", url, body)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// ConnectToSwitch is API call
-func (o *InterfaceOp) ConnectToSwitch(ctx context.Context, zone string, id types.ID, switchID types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/switch/{{.switchID}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
-		"pathSuffix": o.PathSuffix,
-		"pathName":   o.PathName,
-		"zone":       zone,
-		"id":         id,
-		"switchID":   switchID,
-	})
-	if err != nil {
-		return err
-	}
-
-	var body interface{}
-
-	_, err = o.Client.Do(ctx, "PUT", url, body)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// DisconnectFromSwitch is API call
-func (o *InterfaceOp) DisconnectFromSwitch(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/switch", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
-		"pathSuffix": o.PathSuffix,
-		"pathName":   o.PathName,
-		"zone":       zone,
-		"id":         id,
-	})
-	if err != nil {
-		return err
-	}
-
-	var body interface{}
-
-	_, err = o.Client.Do(ctx, "DELETE", url, body)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// ConnectToPacketFilter is API call
-func (o *InterfaceOp) ConnectToPacketFilter(ctx context.Context, zone string, id types.ID, packetFilterID types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/packetfilter/{{.packetFilterID}}", map[string]interface{}{
-		"rootURL":        SakuraCloudAPIRoot,
-		"pathSuffix":     o.PathSuffix,
-		"pathName":       o.PathName,
-		"zone":           zone,
-		"id":             id,
-		"packetFilterID": packetFilterID,
-	})
-	if err != nil {
-		return err
-	}
-
-	var body interface{}
-
-	_, err = o.Client.Do(ctx, "PUT", url, body)
-	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	payload0 := &Note{}
+	if err := payload0.convertFrom(nakedResponse.Note); err != nil {
+		return nil, err
+	}
+	return payload0, nil
 }
 
-// DisconnectFromPacketFilter is API call
-func (o *InterfaceOp) DisconnectFromPacketFilter(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/packetfilter", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// Delete is API call
+func (o *NoteOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -2493,11 +8420,11 @@ func (o *InterfaceOp) DisconnectFromPacketFilter(ctx context.Context, zone strin
 }
 
 /*************************************************
-* InternetOp
+* PacketFilterOp
 *************************************************/
 
-// InternetOp implements InternetAPI interface
-type InternetOp struct {
+// PacketFilterOp implements PacketFilterAPI interface
+type PacketFilterOp struct {
 	// Client APICaller
 	Client APICaller
 	// PathSuffix is used when building URL
@@ -2506,15 +8433,15 @@ type InternetOp struct {
 	PathName string
 }
 
-// NewInternetOp creates new InternetOp instance
-func NewInternetOp(caller APICaller) InternetAPI {
-	return GetClientFactoryFunc("Internet")(caller).(InternetAPI)
+// NewPacketFilterOp creates new PacketFilterOp instance
+func NewPacketFilterOp(caller APICaller) PacketFilterAPI {
+	return GetClientFactoryFunc("PacketFilter")(caller).(PacketFilterAPI)
 }
 
 // Find is API call
-func (o *InternetOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Internet, error) {
+func (o *PacketFilterOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*PacketFilter, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -2540,7 +8467,7 @@ func (o *InternetOp) Find(ctx context.Context, zone string, conditions *FindCond
 		Argconditions: conditions,
 	}
 
-	v := &internetFindRequestEnvelope{}
+	v := &packetfilterFindRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -2551,14 +8478,14 @@ func (o *InternetOp) Find(ctx context.Context, zone string, conditions *FindCond
 		return nil, err
 	}
 
-	nakedResponse := &internetFindResponseEnvelope{}
+	nakedResponse := &packetfilterFindResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	var payload0 []*Internet
-	for _, v := range nakedResponse.Internets {
-		payload := &Internet{}
+	var payload0 []*PacketFilter
+	for _, v := range nakedResponse.PacketFilters {
+		payload := &PacketFilter{}
 		if err := payload.convertFrom(v); err != nil {
 			return nil, err
 		}
@@ -2568,9 +8495,9 @@ func (o *InternetOp) Find(ctx context.Context, zone string, conditions *FindCond
 }
 
 // Create is API call
-func (o *InternetOp) Create(ctx context.Context, zone string, param *InternetCreateRequest) (*Internet, error) {
+func (o *PacketFilterOp) Create(ctx context.Context, zone string, param *PacketFilterCreateRequest) (*PacketFilter, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -2586,17 +8513,17 @@ func (o *InternetOp) Create(ctx context.Context, zone string, param *InternetCre
 		zone = ""
 	}
 	if param == nil {
-		param = &InternetCreateRequest{}
+		param = &PacketFilterCreateRequest{}
 	}
 	args := &struct {
 		Argzone  string
-		Argparam *InternetCreateRequest `mapconv:"Internet,recursive"`
+		Argparam *PacketFilterCreateRequest `mapconv:"PacketFilter,recursive"`
 	}{
 		Argzone:  zone,
 		Argparam: param,
 	}
 
-	v := &internetCreateRequestEnvelope{}
+	v := &packetfilterCreateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -2607,22 +8534,22 @@ func (o *InternetOp) Create(ctx context.Context, zone string, param *InternetCre
 		return nil, err
 	}
 
-	nakedResponse := &internetCreateResponseEnvelope{}
+	nakedResponse := &packetfilterCreateResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &Internet{}
-	if err := payload0.convertFrom(nakedResponse.Internet); err != nil {
+	payload0 := &PacketFilter{}
+	if err := payload0.convertFrom(nakedResponse.PacketFilter); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
 // Read is API call
-func (o *InternetOp) Read(ctx context.Context, zone string, id types.ID) (*Internet, error) {
+func (o *PacketFilterOp) Read(ctx context.Context, zone string, id types.ID) (*PacketFilter, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -2639,22 +8566,22 @@ func (o *InternetOp) Read(ctx context.Context, zone string, id types.ID) (*Inter
 		return nil, err
 	}
 
-	nakedResponse := &internetReadResponseEnvelope{}
+	nakedResponse := &packetfilterReadResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &Internet{}
-	if err := payload0.convertFrom(nakedResponse.Internet); err != nil {
+	payload0 := &PacketFilter{}
+	if err := payload0.convertFrom(nakedResponse.PacketFilter); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
 // Update is API call
-func (o *InternetOp) Update(ctx context.Context, zone string, id types.ID, param *InternetUpdateRequest) (*Internet, error) {
+func (o *PacketFilterOp) Update(ctx context.Context, zone string, id types.ID, param *PacketFilterUpdateRequest) (*PacketFilter, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -2674,19 +8601,19 @@ func (o *InternetOp) Update(ctx context.Context, zone string, id types.ID, param
 		id = types.ID(int64(0))
 	}
 	if param == nil {
-		param = &InternetUpdateRequest{}
+		param = &PacketFilterUpdateRequest{}
 	}
 	args := &struct {
 		Argzone  string
 		Argid    types.ID
-		Argparam *InternetUpdateRequest `mapconv:"Internet,recursive"`
+		Argparam *PacketFilterUpdateRequest `mapconv:"PacketFilter,recursive"`
 	}{
 		Argzone:  zone,
 		Argid:    id,
 		Argparam: param,
 	}
 
-	v := &internetUpdateRequestEnvelope{}
+	v := &packetfilterUpdateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -2697,22 +8624,22 @@ func (o *InternetOp) Update(ctx context.Context, zone string, id types.ID, param
 		return nil, err
 	}
 
-	nakedResponse := &internetUpdateResponseEnvelope{}
+	nakedResponse := &packetfilterUpdateResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &Internet{}
-	if err := payload0.convertFrom(nakedResponse.Internet); err != nil {
+	payload0 := &PacketFilter{}
+	if err := payload0.convertFrom(nakedResponse.PacketFilter); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
 // Delete is API call
-func (o *InternetOp) Delete(ctx context.Context, zone string, id types.ID) error {
+func (o *PacketFilterOp) Delete(ctx context.Context, zone string, id types.ID) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -2732,15 +8659,33 @@ func (o *InternetOp) Delete(ctx context.Context, zone string, id types.ID) error
 	return nil
 }
 
-// UpdateBandWidth is API call
-func (o *InternetOp) UpdateBandWidth(ctx context.Context, zone string, id types.ID, param *InternetUpdateBandWidthRequest) (*Internet, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/bandwidth", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+/*************************************************
+* PrivateHostOp
+*************************************************/
+
+// PrivateHostOp implements PrivateHostAPI interface
+type PrivateHostOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewPrivateHostOp creates new PrivateHostOp instance
+func NewPrivateHostOp(caller APICaller) PrivateHostAPI {
+	return GetClientFactoryFunc("PrivateHost")(caller).(PrivateHostAPI)
+}
+
+// Find is API call
+func (o *PrivateHostOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*PrivateHost, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
-		"id":         id,
-		"param":      param,
+		"conditions": conditions,
 	})
 	if err != nil {
 		return nil, err
@@ -2751,53 +8696,51 @@ func (o *InternetOp) UpdateBandWidth(ctx context.Context, zone string, id types.
 	if zone == "" {
 		zone = ""
 	}
-	if id == types.ID(int64(0)) {
-		id = types.ID(int64(0))
-	}
-	if param == nil {
-		param = &InternetUpdateBandWidthRequest{}
+	if conditions == nil {
+		conditions = &FindCondition{}
 	}
 	args := &struct {
-		Argzone  string
-		Argid    types.ID
-		Argparam *InternetUpdateBandWidthRequest `mapconv:"Internet,recursive"`
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
 	}{
-		Argzone:  zone,
-		Argid:    id,
-		Argparam: param,
+		Argzone:       zone,
+		Argconditions: conditions,
 	}
 
-	v := &internetUpdateBandWidthRequestEnvelope{}
+	v := &privatehostFindRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
 	body = v
 
-	data, err := o.Client.Do(ctx, "PUT", url, body)
+	data, err := o.Client.Do(ctx, "GET", url, body)
 	if err != nil {
 		return nil, err
 	}
 
-	nakedResponse := &internetUpdateBandWidthResponseEnvelope{}
+	nakedResponse := &privatehostFindResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &Internet{}
-	if err := payload0.convertFrom(nakedResponse.Internet); err != nil {
-		return nil, err
+	var payload0 []*PrivateHost
+	for _, v := range nakedResponse.PrivateHosts {
+		payload := &PrivateHost{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
 	}
 	return payload0, nil
 }
 
-// AddSubnet is API call
-func (o *InternetOp) AddSubnet(ctx context.Context, zone string, id types.ID, param *InternetAddSubnetRequest) (*InternetSubnetOperationResult, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/subnet", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// Create is API call
+func (o *PrivateHostOp) Create(ctx context.Context, zone string, param *PrivateHostCreateRequest) (*PrivateHost, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
-		"id":         id,
 		"param":      param,
 	})
 	if err != nil {
@@ -2809,23 +8752,18 @@ func (o *InternetOp) AddSubnet(ctx context.Context, zone string, id types.ID, pa
 	if zone == "" {
 		zone = ""
 	}
-	if id == types.ID(int64(0)) {
-		id = types.ID(int64(0))
-	}
 	if param == nil {
-		param = &InternetAddSubnetRequest{}
+		param = &PrivateHostCreateRequest{}
 	}
 	args := &struct {
 		Argzone  string
-		Argid    types.ID
-		Argparam *InternetAddSubnetRequest `mapconv:",squash"`
+		Argparam *PrivateHostCreateRequest `mapconv:"PrivateHost,recursive"`
 	}{
 		Argzone:  zone,
-		Argid:    id,
 		Argparam: param,
 	}
 
-	v := &internetAddSubnetRequestEnvelope{}
+	v := &privatehostCreateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -2836,27 +8774,58 @@ func (o *InternetOp) AddSubnet(ctx context.Context, zone string, id types.ID, pa
 		return nil, err
 	}
 
-	nakedResponse := &internetAddSubnetResponseEnvelope{}
+	nakedResponse := &privatehostCreateResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &InternetSubnetOperationResult{}
-	if err := payload0.convertFrom(nakedResponse.Subnet); err != nil {
+	payload0 := &PrivateHost{}
+	if err := payload0.convertFrom(nakedResponse.PrivateHost); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
-// UpdateSubnet is API call
-func (o *InternetOp) UpdateSubnet(ctx context.Context, zone string, id types.ID, subnetID types.ID, param *InternetUpdateSubnetRequest) (*InternetSubnetOperationResult, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/subnet/{{.subnetID}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// Read is API call
+func (o *PrivateHostOp) Read(ctx context.Context, zone string, id types.ID) (*PrivateHost, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &privatehostReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &PrivateHost{}
+	if err := payload0.convertFrom(nakedResponse.PrivateHost); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Update is API call
+func (o *PrivateHostOp) Update(ctx context.Context, zone string, id types.ID, param *PrivateHostUpdateRequest) (*PrivateHost, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
 		"id":         id,
-		"subnetID":   subnetID,
 		"param":      param,
 	})
 	if err != nil {
@@ -2871,25 +8840,20 @@ func (o *InternetOp) UpdateSubnet(ctx context.Context, zone string, id types.ID,
 	if id == types.ID(int64(0)) {
 		id = types.ID(int64(0))
 	}
-	if subnetID == types.ID(int64(0)) {
-		subnetID = types.ID(int64(0))
-	}
 	if param == nil {
-		param = &InternetUpdateSubnetRequest{}
+		param = &PrivateHostUpdateRequest{}
 	}
 	args := &struct {
-		Argzone     string
-		Argid       types.ID
-		ArgsubnetID types.ID
-		Argparam    *InternetUpdateSubnetRequest `mapconv:",squash"`
+		Argzone  string
+		Argid    types.ID
+		Argparam *PrivateHostUpdateRequest `mapconv:"PrivateHost,recursive"`
 	}{
-		Argzone:     zone,
-		Argid:       id,
-		ArgsubnetID: subnetID,
-		Argparam:    param,
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
 	}
 
-	v := &internetUpdateSubnetRequestEnvelope{}
+	v := &privatehostUpdateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -2900,27 +8864,26 @@ func (o *InternetOp) UpdateSubnet(ctx context.Context, zone string, id types.ID,
 		return nil, err
 	}
 
-	nakedResponse := &internetUpdateSubnetResponseEnvelope{}
+	nakedResponse := &privatehostUpdateResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &InternetSubnetOperationResult{}
-	if err := payload0.convertFrom(nakedResponse.Subnet); err != nil {
+	payload0 := &PrivateHost{}
+	if err := payload0.convertFrom(nakedResponse.PrivateHost); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
-// DeleteSubnet is API call
-func (o *InternetOp) DeleteSubnet(ctx context.Context, zone string, id types.ID, subnetID types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/subnet/{{.subnetID}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// Delete is API call
+func (o *PrivateHostOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
 		"id":         id,
-		"subnetID":   subnetID,
 	})
 	if err != nil {
 		return err
@@ -2933,18 +8896,92 @@ func (o *InternetOp) DeleteSubnet(ctx context.Context, zone string, id types.ID,
 		return err
 	}
 
-	return nil
+	return nil
+}
+
+/*************************************************
+* PrivateHostPlanOp
+*************************************************/
+
+// PrivateHostPlanOp implements PrivateHostPlanAPI interface
+type PrivateHostPlanOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewPrivateHostPlanOp creates new PrivateHostPlanOp instance
+func NewPrivateHostPlanOp(caller APICaller) PrivateHostPlanAPI {
+	return GetClientFactoryFunc("PrivateHostPlan")(caller).(PrivateHostPlanAPI)
+}
+
+// Find is API call
+func (o *PrivateHostPlanOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*PrivateHostPlan, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
+	}
+
+	v := &privatehostplanFindRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &privatehostplanFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*PrivateHostPlan
+	for _, v := range nakedResponse.PrivateHostPlans {
+		payload := &PrivateHostPlan{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
 }
 
-// Monitor is API call
-func (o *InternetOp) Monitor(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*RouterActivity, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/monitor", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// Read is API call
+func (o *PrivateHostPlanOp) Read(ctx context.Context, zone string, id types.ID) (*PrivateHostPlan, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
 		"id":         id,
-		"condition":  condition,
 	})
 	if err != nil {
 		return nil, err
@@ -2952,54 +8989,29 @@ func (o *InternetOp) Monitor(ctx context.Context, zone string, id types.ID, cond
 
 	var body interface{}
 
-	if zone == "" {
-		zone = ""
-	}
-	if id == types.ID(int64(0)) {
-		id = types.ID(int64(0))
-	}
-	if condition == nil {
-		condition = &MonitorCondition{}
-	}
-	args := &struct {
-		Argzone      string
-		Argid        types.ID
-		Argcondition *MonitorCondition `mapconv:",squash"`
-	}{
-		Argzone:      zone,
-		Argid:        id,
-		Argcondition: condition,
-	}
-
-	v := &internetMonitorRequestEnvelope{}
-	if err := mapconv.ConvertTo(args, v); err != nil {
-		return nil, err
-	}
-	body = v
-
 	data, err := o.Client.Do(ctx, "GET", url, body)
 	if err != nil {
 		return nil, err
 	}
 
-	nakedResponse := &internetMonitorResponseEnvelope{}
+	nakedResponse := &privatehostplanReadResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &RouterActivity{}
-	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
+	payload0 := &PrivateHostPlan{}
+	if err := payload0.convertFrom(nakedResponse.PrivateHostPlan); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
 /*************************************************
-* LoadBalancerOp
+* ProxyLBOp
 *************************************************/
 
-// LoadBalancerOp implements LoadBalancerAPI interface
-type LoadBalancerOp struct {
+// ProxyLBOp implements ProxyLBAPI interface
+type ProxyLBOp struct {
 	// Client APICaller
 	Client APICaller
 	// PathSuffix is used when building URL
@@ -3008,15 +9020,15 @@ type LoadBalancerOp struct {
 	PathName string
 }
 
-// NewLoadBalancerOp creates new LoadBalancerOp instance
-func NewLoadBalancerOp(caller APICaller) LoadBalancerAPI {
-	return GetClientFactoryFunc("LoadBalancer")(caller).(LoadBalancerAPI)
+// NewProxyLBOp creates new ProxyLBOp instance
+func NewProxyLBOp(caller APICaller) ProxyLBAPI {
+	return GetClientFactoryFunc("ProxyLB")(caller).(ProxyLBAPI)
 }
 
 // Find is API call
-func (o *LoadBalancerOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*LoadBalancer, error) {
+func (o *ProxyLBOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*ProxyLB, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -3042,7 +9054,7 @@ func (o *LoadBalancerOp) Find(ctx context.Context, zone string, conditions *Find
 		Argconditions: conditions,
 	}
 
-	v := &loadbalancerFindRequestEnvelope{}
+	v := &proxylbFindRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -3053,14 +9065,14 @@ func (o *LoadBalancerOp) Find(ctx context.Context, zone string, conditions *Find
 		return nil, err
 	}
 
-	nakedResponse := &loadbalancerFindResponseEnvelope{}
+	nakedResponse := &proxylbFindResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	var payload0 []*LoadBalancer
-	for _, v := range nakedResponse.Appliances {
-		payload := &LoadBalancer{}
+	var payload0 []*ProxyLB
+	for _, v := range nakedResponse.CommonServiceItems {
+		payload := &ProxyLB{}
 		if err := payload.convertFrom(v); err != nil {
 			return nil, err
 		}
@@ -3070,9 +9082,9 @@ func (o *LoadBalancerOp) Find(ctx context.Context, zone string, conditions *Find
 }
 
 // Create is API call
-func (o *LoadBalancerOp) Create(ctx context.Context, zone string, param *LoadBalancerCreateRequest) (*LoadBalancer, error) {
+func (o *ProxyLBOp) Create(ctx context.Context, zone string, param *ProxyLBCreateRequest) (*ProxyLB, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -3088,17 +9100,17 @@ func (o *LoadBalancerOp) Create(ctx context.Context, zone string, param *LoadBal
 		zone = ""
 	}
 	if param == nil {
-		param = &LoadBalancerCreateRequest{}
+		param = &ProxyLBCreateRequest{}
 	}
 	args := &struct {
 		Argzone  string
-		Argparam *LoadBalancerCreateRequest `mapconv:"Appliance,recursive"`
+		Argparam *ProxyLBCreateRequest `mapconv:"CommonServiceItem,recursive"`
 	}{
 		Argzone:  zone,
 		Argparam: param,
 	}
 
-	v := &loadbalancerCreateRequestEnvelope{}
+	v := &proxylbCreateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -3109,22 +9121,22 @@ func (o *LoadBalancerOp) Create(ctx context.Context, zone string, param *LoadBal
 		return nil, err
 	}
 
-	nakedResponse := &loadbalancerCreateResponseEnvelope{}
+	nakedResponse := &proxylbCreateResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &LoadBalancer{}
-	if err := payload0.convertFrom(nakedResponse.Appliance); err != nil {
+	payload0 := &ProxyLB{}
+	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
 // Read is API call
-func (o *LoadBalancerOp) Read(ctx context.Context, zone string, id types.ID) (*LoadBalancer, error) {
+func (o *ProxyLBOp) Read(ctx context.Context, zone string, id types.ID) (*ProxyLB, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -3141,22 +9153,22 @@ func (o *LoadBalancerOp) Read(ctx context.Context, zone string, id types.ID) (*L
 		return nil, err
 	}
 
-	nakedResponse := &loadbalancerReadResponseEnvelope{}
+	nakedResponse := &proxylbReadResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &LoadBalancer{}
-	if err := payload0.convertFrom(nakedResponse.Appliance); err != nil {
+	payload0 := &ProxyLB{}
+	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
 // Update is API call
-func (o *LoadBalancerOp) Update(ctx context.Context, zone string, id types.ID, param *LoadBalancerUpdateRequest) (*LoadBalancer, error) {
+func (o *ProxyLBOp) Update(ctx context.Context, zone string, id types.ID, param *ProxyLBUpdateRequest) (*ProxyLB, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -3176,19 +9188,19 @@ func (o *LoadBalancerOp) Update(ctx context.Context, zone string, id types.ID, p
 		id = types.ID(int64(0))
 	}
 	if param == nil {
-		param = &LoadBalancerUpdateRequest{}
+		param = &ProxyLBUpdateRequest{}
 	}
 	args := &struct {
 		Argzone  string
 		Argid    types.ID
-		Argparam *LoadBalancerUpdateRequest `mapconv:"Appliance,recursive"`
+		Argparam *ProxyLBUpdateRequest `mapconv:"CommonServiceItem,recursive"`
 	}{
 		Argzone:  zone,
 		Argid:    id,
 		Argparam: param,
 	}
 
-	v := &loadbalancerUpdateRequestEnvelope{}
+	v := &proxylbUpdateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -3199,22 +9211,22 @@ func (o *LoadBalancerOp) Update(ctx context.Context, zone string, id types.ID, p
 		return nil, err
 	}
 
-	nakedResponse := &loadbalancerUpdateResponseEnvelope{}
+	nakedResponse := &proxylbUpdateResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &LoadBalancer{}
-	if err := payload0.convertFrom(nakedResponse.Appliance); err != nil {
+	payload0 := &ProxyLB{}
+	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
 // Delete is API call
-func (o *LoadBalancerOp) Delete(ctx context.Context, zone string, id types.ID) error {
+func (o *ProxyLBOp) Delete(ctx context.Context, zone string, id types.ID) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -3234,64 +9246,50 @@ func (o *LoadBalancerOp) Delete(ctx context.Context, zone string, id types.ID) e
 	return nil
 }
 
-// Config is API call
-func (o *LoadBalancerOp) Config(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/config", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// GetCertificates is API call
+func (o *ProxyLBOp) GetCertificates(ctx context.Context, zone string, id types.ID) (*ProxyLBCertificates, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/proxylb/sslcertificate", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
 		"id":         id,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var body interface{}
 
-	_, err = o.Client.Do(ctx, "PUT", url, body)
+	data, err := o.Client.Do(ctx, "GET", url, body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	nakedResponse := &proxylbGetCertificatesResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &ProxyLBCertificates{}
+	if err := payload0.convertFrom(nakedResponse.ProxyLB); err != nil {
+		return nil, err
+	}
+	return payload0, nil
 }
 
-// Boot is API call
-func (o *LoadBalancerOp) Boot(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// SetCertificates is API call
+func (o *ProxyLBOp) SetCertificates(ctx context.Context, zone string, id types.ID, param *ProxyLBSetCertificatesRequest) (*ProxyLBCertificates, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/proxylb/sslcertificate", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
 		"id":         id,
+		"param":      param,
 	})
 	if err != nil {
-		return err
-	}
-
-	var body interface{}
-
-	_, err = o.Client.Do(ctx, "PUT", url, body)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// Shutdown is API call
-func (o *LoadBalancerOp) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *ShutdownOption) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", map[string]interface{}{
-		"rootURL":        SakuraCloudAPIRoot,
-		"pathSuffix":     o.PathSuffix,
-		"pathName":       o.PathName,
-		"zone":           zone,
-		"id":             id,
-		"shutdownOption": shutdownOption,
-	})
-	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var body interface{}
@@ -3302,25 +9300,57 @@ func (o *LoadBalancerOp) Shutdown(ctx context.Context, zone string, id types.ID,
 	if id == types.ID(int64(0)) {
 		id = types.ID(int64(0))
 	}
-	if shutdownOption == nil {
-		shutdownOption = &ShutdownOption{}
+	if param == nil {
+		param = &ProxyLBSetCertificatesRequest{}
 	}
 	args := &struct {
-		Argzone           string
-		Argid             types.ID
-		ArgshutdownOption *ShutdownOption `mapconv:",squash"`
+		Argzone  string
+		Argid    types.ID
+		Argparam *ProxyLBSetCertificatesRequest `mapconv:"ProxyLB,recursive"`
 	}{
-		Argzone:           zone,
-		Argid:             id,
-		ArgshutdownOption: shutdownOption,
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
 	}
 
-	v := &loadbalancerShutdownRequestEnvelope{}
+	v := &proxylbSetCertificatesRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
-		return err
+		return nil, err
 	}
 	body = v
 
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &proxylbSetCertificatesResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &ProxyLBCertificates{}
+	if err := payload0.convertFrom(nakedResponse.ProxyLB); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// DeleteCertificates is API call
+func (o *ProxyLBOp) DeleteCertificates(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/proxylb/sslcertificate", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
 	_, err = o.Client.Do(ctx, "DELETE", url, body)
 	if err != nil {
 		return err
@@ -3329,10 +9359,10 @@ func (o *LoadBalancerOp) Shutdown(ctx context.Context, zone string, id types.ID,
 	return nil
 }
 
-// Reset is API call
-func (o *LoadBalancerOp) Reset(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/reset", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// RenewLetsEncryptCert is API call
+func (o *ProxyLBOp) RenewLetsEncryptCert(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/proxylb/letsencryptstrategy", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -3352,10 +9382,42 @@ func (o *LoadBalancerOp) Reset(ctx context.Context, zone string, id types.ID) er
 	return nil
 }
 
-// MonitorInterface is API call
-func (o *LoadBalancerOp) MonitorInterface(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*InterfaceActivity, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/interface/monitor", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// HealthStatus is API call
+func (o *ProxyLBOp) HealthStatus(ctx context.Context, zone string, id types.ID) (*ProxyLBHealth, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/health", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &proxylbHealthStatusResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &ProxyLBHealth{}
+	if err := payload0.convertFrom(nakedResponse.ProxyLB); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// MonitorConnection is API call
+func (o *ProxyLBOp) MonitorConnection(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*ConnectionActivity, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/activity/proxylb/monitor", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -3382,12 +9444,83 @@ func (o *LoadBalancerOp) MonitorInterface(ctx context.Context, zone string, id t
 		Argid        types.ID
 		Argcondition *MonitorCondition `mapconv:",squash"`
 	}{
-		Argzone:      zone,
-		Argid:        id,
-		Argcondition: condition,
+		Argzone:      zone,
+		Argid:        id,
+		Argcondition: condition,
+	}
+
+	v := &proxylbMonitorConnectionRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &proxylbMonitorConnectionResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &ConnectionActivity{}
+	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+/*************************************************
+* RegionOp
+*************************************************/
+
+// RegionOp implements RegionAPI interface
+type RegionOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewRegionOp creates new RegionOp instance
+func NewRegionOp(caller APICaller) RegionAPI {
+	return GetClientFactoryFunc("Region")(caller).(RegionAPI)
+}
+
+// Find is API call
+func (o *RegionOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Region, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
 	}
 
-	v := &loadbalancerMonitorInterfaceRequestEnvelope{}
+	v := &regionFindRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -3398,22 +9531,26 @@ func (o *LoadBalancerOp) MonitorInterface(ctx context.Context, zone string, id t
 		return nil, err
 	}
 
-	nakedResponse := &loadbalancerMonitorInterfaceResponseEnvelope{}
+	nakedResponse := &regionFindResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &InterfaceActivity{}
-	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
-		return nil, err
+	var payload0 []*Region
+	for _, v := range nakedResponse.Regions {
+		payload := &Region{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
 	}
 	return payload0, nil
 }
 
-// Status is API call
-func (o *LoadBalancerOp) Status(ctx context.Context, zone string, id types.ID) ([]*LoadBalancerStatus, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/status", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// Read is API call
+func (o *RegionOp) Read(ctx context.Context, zone string, id types.ID) (*Region, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -3430,28 +9567,24 @@ func (o *LoadBalancerOp) Status(ctx context.Context, zone string, id types.ID) (
 		return nil, err
 	}
 
-	nakedResponse := &loadbalancerStatusResponseEnvelope{}
+	nakedResponse := &regionReadResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	var payload0 []*LoadBalancerStatus
-	for _, v := range nakedResponse.LoadBalancer {
-		payload := &LoadBalancerStatus{}
-		if err := payload.convertFrom(v); err != nil {
-			return nil, err
-		}
-		payload0 = append(payload0, payload)
+	payload0 := &Region{}
+	if err := payload0.convertFrom(nakedResponse.Region); err != nil {
+		return nil, err
 	}
 	return payload0, nil
 }
 
 /*************************************************
-* NFSOp
+* ServerOp
 *************************************************/
 
-// NFSOp implements NFSAPI interface
-type NFSOp struct {
+// ServerOp implements ServerAPI interface
+type ServerOp struct {
 	// Client APICaller
 	Client APICaller
 	// PathSuffix is used when building URL
@@ -3460,15 +9593,15 @@ type NFSOp struct {
 	PathName string
 }
 
-// NewNFSOp creates new NFSOp instance
-func NewNFSOp(caller APICaller) NFSAPI {
-	return GetClientFactoryFunc("NFS")(caller).(NFSAPI)
+// NewServerOp creates new ServerOp instance
+func NewServerOp(caller APICaller) ServerAPI {
+	return GetClientFactoryFunc("Server")(caller).(ServerAPI)
 }
 
 // Find is API call
-func (o *NFSOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*NFS, error) {
+func (o *ServerOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Server, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -3494,7 +9627,7 @@ func (o *NFSOp) Find(ctx context.Context, zone string, conditions *FindCondition
 		Argconditions: conditions,
 	}
 
-	v := &nfsFindRequestEnvelope{}
+	v := &serverFindRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -3505,14 +9638,14 @@ func (o *NFSOp) Find(ctx context.Context, zone string, conditions *FindCondition
 		return nil, err
 	}
 
-	nakedResponse := &nfsFindResponseEnvelope{}
+	nakedResponse := &serverFindResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	var payload0 []*NFS
-	for _, v := range nakedResponse.Appliances {
-		payload := &NFS{}
+	var payload0 []*Server
+	for _, v := range nakedResponse.Servers {
+		payload := &Server{}
 		if err := payload.convertFrom(v); err != nil {
 			return nil, err
 		}
@@ -3522,9 +9655,9 @@ func (o *NFSOp) Find(ctx context.Context, zone string, conditions *FindCondition
 }
 
 // Create is API call
-func (o *NFSOp) Create(ctx context.Context, zone string, param *NFSCreateRequest) (*NFS, error) {
+func (o *ServerOp) Create(ctx context.Context, zone string, param *ServerCreateRequest) (*Server, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -3540,17 +9673,17 @@ func (o *NFSOp) Create(ctx context.Context, zone string, param *NFSCreateRequest
 		zone = ""
 	}
 	if param == nil {
-		param = &NFSCreateRequest{}
+		param = &ServerCreateRequest{}
 	}
 	args := &struct {
 		Argzone  string
-		Argparam *NFSCreateRequest `mapconv:"Appliance,recursive"`
+		Argparam *ServerCreateRequest `mapconv:"Server,recursive"`
 	}{
 		Argzone:  zone,
 		Argparam: param,
 	}
 
-	v := &nfsCreateRequestEnvelope{}
+	v := &serverCreateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -3561,22 +9694,22 @@ func (o *NFSOp) Create(ctx context.Context, zone string, param *NFSCreateRequest
 		return nil, err
 	}
 
-	nakedResponse := &nfsCreateResponseEnvelope{}
+	nakedResponse := &serverCreateResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &NFS{}
-	if err := payload0.convertFrom(nakedResponse.Appliance); err != nil {
+	payload0 := &Server{}
+	if err := payload0.convertFrom(nakedResponse.Server); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
 // Read is API call
-func (o *NFSOp) Read(ctx context.Context, zone string, id types.ID) (*NFS, error) {
+func (o *ServerOp) Read(ctx context.Context, zone string, id types.ID) (*Server, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -3593,22 +9726,22 @@ func (o *NFSOp) Read(ctx context.Context, zone string, id types.ID) (*NFS, error
 		return nil, err
 	}
 
-	nakedResponse := &nfsReadResponseEnvelope{}
+	nakedResponse := &serverReadResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &NFS{}
-	if err := payload0.convertFrom(nakedResponse.Appliance); err != nil {
+	payload0 := &Server{}
+	if err := payload0.convertFrom(nakedResponse.Server); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
 // Update is API call
-func (o *NFSOp) Update(ctx context.Context, zone string, id types.ID, param *NFSUpdateRequest) (*NFS, error) {
+func (o *ServerOp) Update(ctx context.Context, zone string, id types.ID, param *ServerUpdateRequest) (*Server, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -3616,7 +9749,195 @@ func (o *NFSOp) Update(ctx context.Context, zone string, id types.ID, param *NFS
 		"param":      param,
 	})
 	if err != nil {
-		return nil, err
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if param == nil {
+		param = &ServerUpdateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argid    types.ID
+		Argparam *ServerUpdateRequest `mapconv:"Server,recursive"`
+	}{
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
+	}
+
+	v := &serverUpdateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &serverUpdateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Server{}
+	if err := payload0.convertFrom(nakedResponse.Server); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// Delete is API call
+func (o *ServerOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ChangePlan is API call
+func (o *ServerOp) ChangePlan(ctx context.Context, zone string, id types.ID, plan *ServerChangePlanRequest) (*Server, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/plan", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"plan":       plan,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if plan == nil {
+		plan = &ServerChangePlanRequest{}
+	}
+	args := &struct {
+		Argzone string
+		Argid   types.ID
+		Argplan *ServerChangePlanRequest `mapconv:",squash"`
+	}{
+		Argzone: zone,
+		Argid:   id,
+		Argplan: plan,
+	}
+
+	v := &serverChangePlanRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &serverChangePlanResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &Server{}
+	if err := payload0.convertFrom(nakedResponse.Server); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// InsertCDROM is API call
+func (o *ServerOp) InsertCDROM(ctx context.Context, zone string, id types.ID, insertParam *InsertCDROMRequest) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/cdrom", map[string]interface{}{
+		"rootURL":     resolveAPIRootURL(o.Client, zone),
+		"pathSuffix":  o.PathSuffix,
+		"pathName":    o.PathName,
+		"zone":        zone,
+		"id":          id,
+		"insertParam": insertParam,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if insertParam == nil {
+		insertParam = &InsertCDROMRequest{}
+	}
+	args := &struct {
+		Argzone        string
+		Argid          types.ID
+		ArginsertParam *InsertCDROMRequest `mapconv:"CDROM"`
+	}{
+		Argzone:        zone,
+		Argid:          id,
+		ArginsertParam: insertParam,
+	}
+
+	v := &serverInsertCDROMRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return err
+	}
+	body = v
+
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// EjectCDROM is API call
+func (o *ServerOp) EjectCDROM(ctx context.Context, zone string, id types.ID, insertParam *EjectCDROMRequest) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/cdrom", map[string]interface{}{
+		"rootURL":     resolveAPIRootURL(o.Client, zone),
+		"pathSuffix":  o.PathSuffix,
+		"pathName":    o.PathName,
+		"zone":        zone,
+		"id":          id,
+		"insertParam": insertParam,
+	})
+	if err != nil {
+		return err
 	}
 
 	var body interface{}
@@ -3627,56 +9948,24 @@ func (o *NFSOp) Update(ctx context.Context, zone string, id types.ID, param *NFS
 	if id == types.ID(int64(0)) {
 		id = types.ID(int64(0))
 	}
-	if param == nil {
-		param = &NFSUpdateRequest{}
+	if insertParam == nil {
+		insertParam = &EjectCDROMRequest{}
 	}
 	args := &struct {
-		Argzone  string
-		Argid    types.ID
-		Argparam *NFSUpdateRequest `mapconv:"Appliance,recursive"`
+		Argzone        string
+		Argid          types.ID
+		ArginsertParam *EjectCDROMRequest `mapconv:"CDROM"`
 	}{
-		Argzone:  zone,
-		Argid:    id,
-		Argparam: param,
+		Argzone:        zone,
+		Argid:          id,
+		ArginsertParam: insertParam,
 	}
 
-	v := &nfsUpdateRequestEnvelope{}
+	v := &serverEjectCDROMRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
-		return nil, err
-	}
-	body = v
-
-	data, err := o.Client.Do(ctx, "PUT", url, body)
-	if err != nil {
-		return nil, err
-	}
-
-	nakedResponse := &nfsUpdateResponseEnvelope{}
-	if err := json.Unmarshal(data, nakedResponse); err != nil {
-		return nil, err
-	}
-
-	payload0 := &NFS{}
-	if err := payload0.convertFrom(nakedResponse.Appliance); err != nil {
-		return nil, err
-	}
-	return payload0, nil
-}
-
-// Delete is API call
-func (o *NFSOp) Delete(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
-		"pathSuffix": o.PathSuffix,
-		"pathName":   o.PathName,
-		"zone":       zone,
-		"id":         id,
-	})
-	if err != nil {
 		return err
 	}
-
-	var body interface{}
+	body = v
 
 	_, err = o.Client.Do(ctx, "DELETE", url, body)
 	if err != nil {
@@ -3687,9 +9976,9 @@ func (o *NFSOp) Delete(ctx context.Context, zone string, id types.ID) error {
 }
 
 // Boot is API call
-func (o *NFSOp) Boot(ctx context.Context, zone string, id types.ID) error {
+func (o *ServerOp) Boot(ctx context.Context, zone string, id types.ID) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -3710,9 +9999,9 @@ func (o *NFSOp) Boot(ctx context.Context, zone string, id types.ID) error {
 }
 
 // Shutdown is API call
-func (o *NFSOp) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *ShutdownOption) error {
+func (o *ServerOp) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *ShutdownOption) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", map[string]interface{}{
-		"rootURL":        SakuraCloudAPIRoot,
+		"rootURL":        resolveAPIRootURL(o.Client, zone),
 		"pathSuffix":     o.PathSuffix,
 		"pathName":       o.PathName,
 		"zone":           zone,
@@ -3744,7 +10033,7 @@ func (o *NFSOp) Shutdown(ctx context.Context, zone string, id types.ID, shutdown
 		ArgshutdownOption: shutdownOption,
 	}
 
-	v := &nfsShutdownRequestEnvelope{}
+	v := &serverShutdownRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return err
 	}
@@ -3759,9 +10048,9 @@ func (o *NFSOp) Shutdown(ctx context.Context, zone string, id types.ID, shutdown
 }
 
 // Reset is API call
-func (o *NFSOp) Reset(ctx context.Context, zone string, id types.ID) error {
+func (o *ServerOp) Reset(ctx context.Context, zone string, id types.ID) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/reset", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -3781,68 +10070,10 @@ func (o *NFSOp) Reset(ctx context.Context, zone string, id types.ID) error {
 	return nil
 }
 
-// MonitorFreeDiskSize is API call
-func (o *NFSOp) MonitorFreeDiskSize(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*FreeDiskSizeActivity, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/database/monitor", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
-		"pathSuffix": o.PathSuffix,
-		"pathName":   o.PathName,
-		"zone":       zone,
-		"id":         id,
-		"condition":  condition,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	var body interface{}
-
-	if zone == "" {
-		zone = ""
-	}
-	if id == types.ID(int64(0)) {
-		id = types.ID(int64(0))
-	}
-	if condition == nil {
-		condition = &MonitorCondition{}
-	}
-	args := &struct {
-		Argzone      string
-		Argid        types.ID
-		Argcondition *MonitorCondition `mapconv:",squash"`
-	}{
-		Argzone:      zone,
-		Argid:        id,
-		Argcondition: condition,
-	}
-
-	v := &nfsMonitorFreeDiskSizeRequestEnvelope{}
-	if err := mapconv.ConvertTo(args, v); err != nil {
-		return nil, err
-	}
-	body = v
-
-	data, err := o.Client.Do(ctx, "GET", url, body)
-	if err != nil {
-		return nil, err
-	}
-
-	nakedResponse := &nfsMonitorFreeDiskSizeResponseEnvelope{}
-	if err := json.Unmarshal(data, nakedResponse); err != nil {
-		return nil, err
-	}
-
-	payload0 := &FreeDiskSizeActivity{}
-	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
-		return nil, err
-	}
-	return payload0, nil
-}
-
-// MonitorInterface is API call
-func (o *NFSOp) MonitorInterface(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*InterfaceActivity, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/interface/monitor", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// Monitor is API call
+func (o *ServerOp) Monitor(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*CPUTimeActivity, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/monitor", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -3874,160 +10105,33 @@ func (o *NFSOp) MonitorInterface(ctx context.Context, zone string, id types.ID,
 		Argcondition: condition,
 	}
 
-	v := &nfsMonitorInterfaceRequestEnvelope{}
-	if err := mapconv.ConvertTo(args, v); err != nil {
-		return nil, err
-	}
-	body = v
-
-	data, err := o.Client.Do(ctx, "GET", url, body)
-	if err != nil {
-		return nil, err
-	}
-
-	nakedResponse := &nfsMonitorInterfaceResponseEnvelope{}
-	if err := json.Unmarshal(data, nakedResponse); err != nil {
-		return nil, err
-	}
-
-	payload0 := &InterfaceActivity{}
-	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
-		return nil, err
-	}
-	return payload0, nil
-}
-
-/*************************************************
-* NoteOp
-*************************************************/
-
-// NoteOp implements NoteAPI interface
-type NoteOp struct {
-	// Client APICaller
-	Client APICaller
-	// PathSuffix is used when building URL
-	PathSuffix string
-	// PathName is used when building URL
-	PathName string
-}
-
-// NewNoteOp creates new NoteOp instance
-func NewNoteOp(caller APICaller) NoteAPI {
-	return GetClientFactoryFunc("Note")(caller).(NoteAPI)
-}
-
-// Find is API call
-func (o *NoteOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Note, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
-		"pathSuffix": o.PathSuffix,
-		"pathName":   o.PathName,
-		"zone":       zone,
-		"conditions": conditions,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	var body interface{}
-
-	if zone == "" {
-		zone = ""
-	}
-	if conditions == nil {
-		conditions = &FindCondition{}
-	}
-	args := &struct {
-		Argzone       string
-		Argconditions *FindCondition `mapconv:",squash"`
-	}{
-		Argzone:       zone,
-		Argconditions: conditions,
-	}
-
-	v := &noteFindRequestEnvelope{}
-	if err := mapconv.ConvertTo(args, v); err != nil {
-		return nil, err
-	}
-	body = v
-
-	data, err := o.Client.Do(ctx, "GET", url, body)
-	if err != nil {
-		return nil, err
-	}
-
-	nakedResponse := &noteFindResponseEnvelope{}
-	if err := json.Unmarshal(data, nakedResponse); err != nil {
-		return nil, err
-	}
-
-	var payload0 []*Note
-	for _, v := range nakedResponse.Notes {
-		payload := &Note{}
-		if err := payload.convertFrom(v); err != nil {
-			return nil, err
-		}
-		payload0 = append(payload0, payload)
-	}
-	return payload0, nil
-}
-
-// Create is API call
-func (o *NoteOp) Create(ctx context.Context, zone string, param *NoteCreateRequest) (*Note, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
-		"pathSuffix": o.PathSuffix,
-		"pathName":   o.PathName,
-		"zone":       zone,
-		"param":      param,
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	var body interface{}
-
-	if zone == "" {
-		zone = ""
-	}
-	if param == nil {
-		param = &NoteCreateRequest{}
-	}
-	args := &struct {
-		Argzone  string
-		Argparam *NoteCreateRequest `mapconv:"Note,recursive"`
-	}{
-		Argzone:  zone,
-		Argparam: param,
-	}
-
-	v := &noteCreateRequestEnvelope{}
+	v := &serverMonitorRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
 	body = v
 
-	data, err := o.Client.Do(ctx, "POST", url, body)
+	data, err := o.Client.Do(ctx, "GET", url, body)
 	if err != nil {
 		return nil, err
 	}
 
-	nakedResponse := &noteCreateResponseEnvelope{}
+	nakedResponse := &serverMonitorResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &Note{}
-	if err := payload0.convertFrom(nakedResponse.Note); err != nil {
+	payload0 := &CPUTimeActivity{}
+	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
-// Read is API call
-func (o *NoteOp) Read(ctx context.Context, zone string, id types.ID) (*Note, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// GetVNCProxy is API call
+func (o *ServerOp) GetVNCProxy(ctx context.Context, zone string, id types.ID) (*VNCProxyInfo, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/vnc/proxy", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -4044,30 +10148,30 @@ func (o *NoteOp) Read(ctx context.Context, zone string, id types.ID) (*Note, err
 		return nil, err
 	}
 
-	nakedResponse := &noteReadResponseEnvelope{}
+	nakedResponse := &serverGetVNCProxyResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &Note{}
-	if err := payload0.convertFrom(nakedResponse.Note); err != nil {
+	payload0 := &VNCProxyInfo{}
+	if err := payload0.convertFrom(nakedResponse.VNCProxyInfo); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
-// Update is API call
-func (o *NoteOp) Update(ctx context.Context, zone string, id types.ID, param *NoteUpdateRequest) (*Note, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
-		"pathSuffix": o.PathSuffix,
-		"pathName":   o.PathName,
-		"zone":       zone,
-		"id":         id,
-		"param":      param,
+// SendKey is API call
+func (o *ServerOp) SendKey(ctx context.Context, zone string, id types.ID, keyboardParam *SendKeyRequest) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/keyboard", map[string]interface{}{
+		"rootURL":       resolveAPIRootURL(o.Client, zone),
+		"pathSuffix":    o.PathSuffix,
+		"pathName":      o.PathName,
+		"zone":          zone,
+		"id":            id,
+		"keyboardParam": keyboardParam,
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	var body interface{}
@@ -4078,46 +10182,37 @@ func (o *NoteOp) Update(ctx context.Context, zone string, id types.ID, param *No
 	if id == types.ID(int64(0)) {
 		id = types.ID(int64(0))
 	}
-	if param == nil {
-		param = &NoteUpdateRequest{}
+	if keyboardParam == nil {
+		keyboardParam = &SendKeyRequest{}
 	}
 	args := &struct {
-		Argzone  string
-		Argid    types.ID
-		Argparam *NoteUpdateRequest `mapconv:"Note,recursive"`
+		Argzone          string
+		Argid            types.ID
+		ArgkeyboardParam *SendKeyRequest `mapconv:",squash"`
 	}{
-		Argzone:  zone,
-		Argid:    id,
-		Argparam: param,
+		Argzone:          zone,
+		Argid:            id,
+		ArgkeyboardParam: keyboardParam,
 	}
 
-	v := &noteUpdateRequestEnvelope{}
+	v := &serverSendKeyRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
-		return nil, err
+		return err
 	}
 	body = v
 
-	data, err := o.Client.Do(ctx, "PUT", url, body)
+	_, err = o.Client.Do(ctx, "PUT", url, body)
 	if err != nil {
-		return nil, err
-	}
-
-	nakedResponse := &noteUpdateResponseEnvelope{}
-	if err := json.Unmarshal(data, nakedResponse); err != nil {
-		return nil, err
+		return err
 	}
 
-	payload0 := &Note{}
-	if err := payload0.convertFrom(nakedResponse.Note); err != nil {
-		return nil, err
-	}
-	return payload0, nil
+	return nil
 }
 
-// Delete is API call
-func (o *NoteOp) Delete(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// SendNMI is API call
+func (o *ServerOp) SendNMI(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/qemu/nmi", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -4129,7 +10224,7 @@ func (o *NoteOp) Delete(ctx context.Context, zone string, id types.ID) error {
 
 	var body interface{}
 
-	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	_, err = o.Client.Do(ctx, "PUT", url, body)
 	if err != nil {
 		return err
 	}
@@ -4137,33 +10232,15 @@ func (o *NoteOp) Delete(ctx context.Context, zone string, id types.ID) error {
 	return nil
 }
 
-/*************************************************
-* PacketFilterOp
-*************************************************/
-
-// PacketFilterOp implements PacketFilterAPI interface
-type PacketFilterOp struct {
-	// Client APICaller
-	Client APICaller
-	// PathSuffix is used when building URL
-	PathSuffix string
-	// PathName is used when building URL
-	PathName string
-}
-
-// NewPacketFilterOp creates new PacketFilterOp instance
-func NewPacketFilterOp(caller APICaller) PacketFilterAPI {
-	return GetClientFactoryFunc("PacketFilter")(caller).(PacketFilterAPI)
-}
-
-// Find is API call
-func (o *PacketFilterOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*PacketFilter, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// GetScreenshot is API call
+func (o *ServerOp) GetScreenshot(ctx context.Context, zone string, id types.ID, condition *GetScreenshotRequest) (*VNCSnapshotInfo, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/vnc/snapshot", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
-		"conditions": conditions,
+		"id":         id,
+		"condition":  condition,
 	})
 	if err != nil {
 		return nil, err
@@ -4174,18 +10251,23 @@ func (o *PacketFilterOp) Find(ctx context.Context, zone string, conditions *Find
 	if zone == "" {
 		zone = ""
 	}
-	if conditions == nil {
-		conditions = &FindCondition{}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if condition == nil {
+		condition = &GetScreenshotRequest{}
 	}
 	args := &struct {
-		Argzone       string
-		Argconditions *FindCondition `mapconv:",squash"`
+		Argzone      string
+		Argid        types.ID
+		Argcondition *GetScreenshotRequest `mapconv:",squash"`
 	}{
-		Argzone:       zone,
-		Argconditions: conditions,
+		Argzone:      zone,
+		Argid:        id,
+		Argcondition: condition,
 	}
 
-	v := &packetfilterFindRequestEnvelope{}
+	v := &serverGetScreenshotRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -4196,30 +10278,45 @@ func (o *PacketFilterOp) Find(ctx context.Context, zone string, conditions *Find
 		return nil, err
 	}
 
-	nakedResponse := &packetfilterFindResponseEnvelope{}
+	nakedResponse := &serverGetScreenshotResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	var payload0 []*PacketFilter
-	for _, v := range nakedResponse.PacketFilters {
-		payload := &PacketFilter{}
-		if err := payload.convertFrom(v); err != nil {
-			return nil, err
-		}
-		payload0 = append(payload0, payload)
+	payload0 := &VNCSnapshotInfo{}
+	if err := payload0.convertFrom(nakedResponse.VNCSnapshotInfo); err != nil {
+		return nil, err
 	}
 	return payload0, nil
 }
 
-// Create is API call
-func (o *PacketFilterOp) Create(ctx context.Context, zone string, param *PacketFilterCreateRequest) (*PacketFilter, error) {
+/*************************************************
+* ServerPlanOp
+*************************************************/
+
+// ServerPlanOp implements ServerPlanAPI interface
+type ServerPlanOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewServerPlanOp creates new ServerPlanOp instance
+func NewServerPlanOp(caller APICaller) ServerPlanAPI {
+	return GetClientFactoryFunc("ServerPlan")(caller).(ServerPlanAPI)
+}
+
+// Find is API call
+func (o *ServerPlanOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*ServerPlan, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
-		"param":      param,
+		"conditions": conditions,
 	})
 	if err != nil {
 		return nil, err
@@ -4230,44 +10327,48 @@ func (o *PacketFilterOp) Create(ctx context.Context, zone string, param *PacketF
 	if zone == "" {
 		zone = ""
 	}
-	if param == nil {
-		param = &PacketFilterCreateRequest{}
+	if conditions == nil {
+		conditions = &FindCondition{}
 	}
 	args := &struct {
-		Argzone  string
-		Argparam *PacketFilterCreateRequest `mapconv:"PacketFilter,recursive"`
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
 	}{
-		Argzone:  zone,
-		Argparam: param,
+		Argzone:       zone,
+		Argconditions: conditions,
 	}
 
-	v := &packetfilterCreateRequestEnvelope{}
+	v := &serverplanFindRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
 	body = v
 
-	data, err := o.Client.Do(ctx, "POST", url, body)
+	data, err := o.Client.Do(ctx, "GET", url, body)
 	if err != nil {
 		return nil, err
 	}
 
-	nakedResponse := &packetfilterCreateResponseEnvelope{}
+	nakedResponse := &serverplanFindResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &PacketFilter{}
-	if err := payload0.convertFrom(nakedResponse.PacketFilter); err != nil {
-		return nil, err
+	var payload0 []*ServerPlan
+	for _, v := range nakedResponse.ServerPlans {
+		payload := &ServerPlan{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
 	}
 	return payload0, nil
 }
 
 // Read is API call
-func (o *PacketFilterOp) Read(ctx context.Context, zone string, id types.ID) (*PacketFilter, error) {
+func (o *ServerPlanOp) Read(ctx context.Context, zone string, id types.ID) (*ServerPlan, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -4284,27 +10385,45 @@ func (o *PacketFilterOp) Read(ctx context.Context, zone string, id types.ID) (*P
 		return nil, err
 	}
 
-	nakedResponse := &packetfilterReadResponseEnvelope{}
+	nakedResponse := &serverplanReadResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &PacketFilter{}
-	if err := payload0.convertFrom(nakedResponse.PacketFilter); err != nil {
+	payload0 := &ServerPlan{}
+	if err := payload0.convertFrom(nakedResponse.ServerPlan); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
-// Update is API call
-func (o *PacketFilterOp) Update(ctx context.Context, zone string, id types.ID, param *PacketFilterUpdateRequest) (*PacketFilter, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+/*************************************************
+* ServiceClassOp
+*************************************************/
+
+// ServiceClassOp implements ServiceClassAPI interface
+type ServiceClassOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewServiceClassOp creates new ServiceClassOp instance
+func NewServiceClassOp(caller APICaller) ServiceClassAPI {
+	return GetClientFactoryFunc("ServiceClass")(caller).(ServiceClassAPI)
+}
+
+// Find is API call
+func (o *ServiceClassOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*ServiceClass, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
-		"id":         id,
-		"param":      param,
+		"conditions": conditions,
 	})
 	if err != nil {
 		return nil, err
@@ -4315,74 +10434,50 @@ func (o *PacketFilterOp) Update(ctx context.Context, zone string, id types.ID, p
 	if zone == "" {
 		zone = ""
 	}
-	if id == types.ID(int64(0)) {
-		id = types.ID(int64(0))
-	}
-	if param == nil {
-		param = &PacketFilterUpdateRequest{}
+	if conditions == nil {
+		conditions = &FindCondition{}
 	}
 	args := &struct {
-		Argzone  string
-		Argid    types.ID
-		Argparam *PacketFilterUpdateRequest `mapconv:"PacketFilter,recursive"`
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
 	}{
-		Argzone:  zone,
-		Argid:    id,
-		Argparam: param,
+		Argzone:       zone,
+		Argconditions: conditions,
 	}
 
-	v := &packetfilterUpdateRequestEnvelope{}
+	v := &serviceclassFindRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
 	body = v
 
-	data, err := o.Client.Do(ctx, "PUT", url, body)
+	data, err := o.Client.Do(ctx, "GET", url, body)
 	if err != nil {
 		return nil, err
 	}
 
-	nakedResponse := &packetfilterUpdateResponseEnvelope{}
+	nakedResponse := &serviceclassFindResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &PacketFilter{}
-	if err := payload0.convertFrom(nakedResponse.PacketFilter); err != nil {
-		return nil, err
+	var payload0 []*ServiceClass
+	for _, v := range nakedResponse.ServiceClasss {
+		payload := &ServiceClass{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
 	}
 	return payload0, nil
 }
 
-// Delete is API call
-func (o *PacketFilterOp) Delete(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
-		"pathSuffix": o.PathSuffix,
-		"pathName":   o.PathName,
-		"zone":       zone,
-		"id":         id,
-	})
-	if err != nil {
-		return err
-	}
-
-	var body interface{}
-
-	_, err = o.Client.Do(ctx, "DELETE", url, body)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
 /*************************************************
-* ServerOp
+* SIMOp
 *************************************************/
 
-// ServerOp implements ServerAPI interface
-type ServerOp struct {
+// SIMOp implements SIMAPI interface
+type SIMOp struct {
 	// Client APICaller
 	Client APICaller
 	// PathSuffix is used when building URL
@@ -4391,15 +10486,15 @@ type ServerOp struct {
 	PathName string
 }
 
-// NewServerOp creates new ServerOp instance
-func NewServerOp(caller APICaller) ServerAPI {
-	return GetClientFactoryFunc("Server")(caller).(ServerAPI)
+// NewSIMOp creates new SIMOp instance
+func NewSIMOp(caller APICaller) SIMAPI {
+	return GetClientFactoryFunc("SIM")(caller).(SIMAPI)
 }
 
 // Find is API call
-func (o *ServerOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Server, error) {
+func (o *SIMOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*SIM, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -4425,7 +10520,7 @@ func (o *ServerOp) Find(ctx context.Context, zone string, conditions *FindCondit
 		Argconditions: conditions,
 	}
 
-	v := &serverFindRequestEnvelope{}
+	v := &simFindRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -4436,14 +10531,14 @@ func (o *ServerOp) Find(ctx context.Context, zone string, conditions *FindCondit
 		return nil, err
 	}
 
-	nakedResponse := &serverFindResponseEnvelope{}
+	nakedResponse := &simFindResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	var payload0 []*Server
-	for _, v := range nakedResponse.Servers {
-		payload := &Server{}
+	var payload0 []*SIM
+	for _, v := range nakedResponse.CommonServiceItems {
+		payload := &SIM{}
 		if err := payload.convertFrom(v); err != nil {
 			return nil, err
 		}
@@ -4453,9 +10548,9 @@ func (o *ServerOp) Find(ctx context.Context, zone string, conditions *FindCondit
 }
 
 // Create is API call
-func (o *ServerOp) Create(ctx context.Context, zone string, param *ServerCreateRequest) (*Server, error) {
+func (o *SIMOp) Create(ctx context.Context, zone string, param *SIMCreateRequest) (*SIM, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -4471,17 +10566,17 @@ func (o *ServerOp) Create(ctx context.Context, zone string, param *ServerCreateR
 		zone = ""
 	}
 	if param == nil {
-		param = &ServerCreateRequest{}
+		param = &SIMCreateRequest{}
 	}
 	args := &struct {
 		Argzone  string
-		Argparam *ServerCreateRequest `mapconv:"Server,recursive"`
+		Argparam *SIMCreateRequest `mapconv:"CommonServiceItem,recursive"`
 	}{
 		Argzone:  zone,
 		Argparam: param,
 	}
 
-	v := &serverCreateRequestEnvelope{}
+	v := &simCreateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -4492,22 +10587,22 @@ func (o *ServerOp) Create(ctx context.Context, zone string, param *ServerCreateR
 		return nil, err
 	}
 
-	nakedResponse := &serverCreateResponseEnvelope{}
+	nakedResponse := &simCreateResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &Server{}
-	if err := payload0.convertFrom(nakedResponse.Server); err != nil {
+	payload0 := &SIM{}
+	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
 // Read is API call
-func (o *ServerOp) Read(ctx context.Context, zone string, id types.ID) (*Server, error) {
+func (o *SIMOp) Read(ctx context.Context, zone string, id types.ID) (*SIM, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -4524,22 +10619,22 @@ func (o *ServerOp) Read(ctx context.Context, zone string, id types.ID) (*Server,
 		return nil, err
 	}
 
-	nakedResponse := &serverReadResponseEnvelope{}
+	nakedResponse := &simReadResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &Server{}
-	if err := payload0.convertFrom(nakedResponse.Server); err != nil {
+	payload0 := &SIM{}
+	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
 // Update is API call
-func (o *ServerOp) Update(ctx context.Context, zone string, id types.ID, param *ServerUpdateRequest) (*Server, error) {
+func (o *SIMOp) Update(ctx context.Context, zone string, id types.ID, param *SIMUpdateRequest) (*SIM, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -4559,19 +10654,19 @@ func (o *ServerOp) Update(ctx context.Context, zone string, id types.ID, param *
 		id = types.ID(int64(0))
 	}
 	if param == nil {
-		param = &ServerUpdateRequest{}
+		param = &SIMUpdateRequest{}
 	}
 	args := &struct {
 		Argzone  string
 		Argid    types.ID
-		Argparam *ServerUpdateRequest `mapconv:"Server,recursive"`
+		Argparam *SIMUpdateRequest `mapconv:"CommonServiceItem,recursive"`
 	}{
 		Argzone:  zone,
 		Argid:    id,
 		Argparam: param,
 	}
 
-	v := &serverUpdateRequestEnvelope{}
+	v := &simUpdateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -4582,22 +10677,22 @@ func (o *ServerOp) Update(ctx context.Context, zone string, id types.ID, param *
 		return nil, err
 	}
 
-	nakedResponse := &serverUpdateResponseEnvelope{}
+	nakedResponse := &simUpdateResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &Server{}
-	if err := payload0.convertFrom(nakedResponse.Server); err != nil {
+	payload0 := &SIM{}
+	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
 		return nil, err
 	}
 	return payload0, nil
 }
 
 // Delete is API call
-func (o *ServerOp) Delete(ctx context.Context, zone string, id types.ID) error {
+func (o *SIMOp) Delete(ctx context.Context, zone string, id types.ID) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -4617,73 +10712,61 @@ func (o *ServerOp) Delete(ctx context.Context, zone string, id types.ID) error {
 	return nil
 }
 
-// ChangePlan is API call
-func (o *ServerOp) ChangePlan(ctx context.Context, zone string, id types.ID, plan *ServerChangePlanRequest) (*Server, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/plan", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// Activate is API call
+func (o *SIMOp) Activate(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/activate", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
 		"id":         id,
-		"plan":       plan,
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	var body interface{}
 
-	if zone == "" {
-		zone = ""
-	}
-	if id == types.ID(int64(0)) {
-		id = types.ID(int64(0))
-	}
-	if plan == nil {
-		plan = &ServerChangePlanRequest{}
-	}
-	args := &struct {
-		Argzone string
-		Argid   types.ID
-		Argplan *ServerChangePlanRequest `mapconv:",squash"`
-	}{
-		Argzone: zone,
-		Argid:   id,
-		Argplan: plan,
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
 	}
 
-	v := &serverChangePlanRequestEnvelope{}
-	if err := mapconv.ConvertTo(args, v); err != nil {
-		return nil, err
-	}
-	body = v
+	return nil
+}
 
-	data, err := o.Client.Do(ctx, "PUT", url, body)
+// Deactivate is API call
+func (o *SIMOp) Deactivate(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/deactivate", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	nakedResponse := &serverChangePlanResponseEnvelope{}
-	if err := json.Unmarshal(data, nakedResponse); err != nil {
-		return nil, err
-	}
+	var body interface{}
 
-	payload0 := &Server{}
-	if err := payload0.convertFrom(nakedResponse.Server); err != nil {
-		return nil, err
+	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if err != nil {
+		return err
 	}
-	return payload0, nil
+
+	return nil
 }
 
-// InsertCDROM is API call
-func (o *ServerOp) InsertCDROM(ctx context.Context, zone string, id types.ID, insertParam *InsertCDROMRequest) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/cdrom", map[string]interface{}{
-		"rootURL":     SakuraCloudAPIRoot,
-		"pathSuffix":  o.PathSuffix,
-		"pathName":    o.PathName,
-		"zone":        zone,
-		"id":          id,
-		"insertParam": insertParam,
+// AssignIP is API call
+func (o *SIMOp) AssignIP(ctx context.Context, zone string, id types.ID, param *SIMAssignIPRequest) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/ip", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"param":      param,
 	})
 	if err != nil {
 		return err
@@ -4697,20 +10780,20 @@ func (o *ServerOp) InsertCDROM(ctx context.Context, zone string, id types.ID, in
 	if id == types.ID(int64(0)) {
 		id = types.ID(int64(0))
 	}
-	if insertParam == nil {
-		insertParam = &InsertCDROMRequest{}
+	if param == nil {
+		param = &SIMAssignIPRequest{}
 	}
 	args := &struct {
-		Argzone        string
-		Argid          types.ID
-		ArginsertParam *InsertCDROMRequest `mapconv:"CDROM"`
+		Argzone  string
+		Argid    types.ID
+		Argparam *SIMAssignIPRequest `mapconv:"SIM,recursive"`
 	}{
-		Argzone:        zone,
-		Argid:          id,
-		ArginsertParam: insertParam,
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
 	}
 
-	v := &serverInsertCDROMRequestEnvelope{}
+	v := &simAssignIPRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return err
 	}
@@ -4724,15 +10807,38 @@ func (o *ServerOp) InsertCDROM(ctx context.Context, zone string, id types.ID, in
 	return nil
 }
 
-// EjectCDROM is API call
-func (o *ServerOp) EjectCDROM(ctx context.Context, zone string, id types.ID, insertParam *EjectCDROMRequest) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/cdrom", map[string]interface{}{
-		"rootURL":     SakuraCloudAPIRoot,
-		"pathSuffix":  o.PathSuffix,
-		"pathName":    o.PathName,
-		"zone":        zone,
-		"id":          id,
-		"insertParam": insertParam,
+// ClearIP is API call
+func (o *SIMOp) ClearIP(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/ip", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return err
+	}
+
+	var body interface{}
+
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// IMEILock is API call
+func (o *SIMOp) IMEILock(ctx context.Context, zone string, id types.ID, param *SIMIMEILockRequest) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/imeilock", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"param":      param,
 	})
 	if err != nil {
 		return err
@@ -4746,26 +10852,26 @@ func (o *ServerOp) EjectCDROM(ctx context.Context, zone string, id types.ID, ins
 	if id == types.ID(int64(0)) {
 		id = types.ID(int64(0))
 	}
-	if insertParam == nil {
-		insertParam = &EjectCDROMRequest{}
+	if param == nil {
+		param = &SIMIMEILockRequest{}
 	}
 	args := &struct {
-		Argzone        string
-		Argid          types.ID
-		ArginsertParam *EjectCDROMRequest `mapconv:"CDROM"`
+		Argzone  string
+		Argid    types.ID
+		Argparam *SIMIMEILockRequest `mapconv:"SIM,recursive"`
 	}{
-		Argzone:        zone,
-		Argid:          id,
-		ArginsertParam: insertParam,
+		Argzone:  zone,
+		Argid:    id,
+		Argparam: param,
 	}
 
-	v := &serverEjectCDROMRequestEnvelope{}
+	v := &simIMEILockRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return err
 	}
 	body = v
 
-	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	_, err = o.Client.Do(ctx, "PUT", url, body)
 	if err != nil {
 		return err
 	}
@@ -4773,10 +10879,10 @@ func (o *ServerOp) EjectCDROM(ctx context.Context, zone string, id types.ID, ins
 	return nil
 }
 
-// Boot is API call
-func (o *ServerOp) Boot(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// IMEIUnlock is API call
+func (o *SIMOp) IMEIUnlock(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/imeilock", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -4788,23 +10894,95 @@ func (o *ServerOp) Boot(ctx context.Context, zone string, id types.ID) error {
 
 	var body interface{}
 
-	_, err = o.Client.Do(ctx, "PUT", url, body)
+	_, err = o.Client.Do(ctx, "DELETE", url, body)
 	if err != nil {
 		return err
 	}
-
-	return nil
+
+	return nil
+}
+
+// Logs is API call
+func (o *SIMOp) Logs(ctx context.Context, zone string, id types.ID) ([]*SIMLog, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/sessionlog", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &simLogsResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*SIMLog
+	for _, v := range nakedResponse.Logs {
+		payload := &SIMLog{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+// GetNetworkOperator is API call
+func (o *SIMOp) GetNetworkOperator(ctx context.Context, zone string, id types.ID) ([]*SIMNetworkOperatorConfig, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/network_operator_config", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &simGetNetworkOperatorResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*SIMNetworkOperatorConfig
+	for _, v := range nakedResponse.NetworkOperationConfigs {
+		payload := &SIMNetworkOperatorConfig{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
 }
 
-// Shutdown is API call
-func (o *ServerOp) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *ShutdownOption) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", map[string]interface{}{
-		"rootURL":        SakuraCloudAPIRoot,
-		"pathSuffix":     o.PathSuffix,
-		"pathName":       o.PathName,
-		"zone":           zone,
-		"id":             id,
-		"shutdownOption": shutdownOption,
+// SetNetworkOperator is API call
+func (o *SIMOp) SetNetworkOperator(ctx context.Context, zone string, id types.ID, configs *SIMNetworkOperatorConfigs) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/network_operator_config", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+		"configs":    configs,
 	})
 	if err != nil {
 		return err
@@ -4818,48 +10996,25 @@ func (o *ServerOp) Shutdown(ctx context.Context, zone string, id types.ID, shutd
 	if id == types.ID(int64(0)) {
 		id = types.ID(int64(0))
 	}
-	if shutdownOption == nil {
-		shutdownOption = &ShutdownOption{}
+	if configs == nil {
+		configs = &SIMNetworkOperatorConfigs{}
 	}
 	args := &struct {
-		Argzone           string
-		Argid             types.ID
-		ArgshutdownOption *ShutdownOption `mapconv:",squash"`
+		Argzone    string
+		Argid      types.ID
+		Argconfigs *SIMNetworkOperatorConfigs `mapconv:",squash"`
 	}{
-		Argzone:           zone,
-		Argid:             id,
-		ArgshutdownOption: shutdownOption,
+		Argzone:    zone,
+		Argid:      id,
+		Argconfigs: configs,
 	}
 
-	v := &serverShutdownRequestEnvelope{}
+	v := &simSetNetworkOperatorRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return err
 	}
 	body = v
 
-	_, err = o.Client.Do(ctx, "DELETE", url, body)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// Reset is API call
-func (o *ServerOp) Reset(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/reset", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
-		"pathSuffix": o.PathSuffix,
-		"pathName":   o.PathName,
-		"zone":       zone,
-		"id":         id,
-	})
-	if err != nil {
-		return err
-	}
-
-	var body interface{}
-
 	_, err = o.Client.Do(ctx, "PUT", url, body)
 	if err != nil {
 		return err
@@ -4868,10 +11023,10 @@ func (o *ServerOp) Reset(ctx context.Context, zone string, id types.ID) error {
 	return nil
 }
 
-// Monitor is API call
-func (o *ServerOp) Monitor(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*CPUTimeActivity, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/monitor", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// MonitorSIM is API call
+func (o *SIMOp) MonitorSIM(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*LinkActivity, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/metrics/monitor", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -4903,7 +11058,7 @@ func (o *ServerOp) Monitor(ctx context.Context, zone string, id types.ID, condit
 		Argcondition: condition,
 	}
 
-	v := &serverMonitorRequestEnvelope{}
+	v := &simMonitorSIMRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -4914,12 +11069,12 @@ func (o *ServerOp) Monitor(ctx context.Context, zone string, id types.ID, condit
 		return nil, err
 	}
 
-	nakedResponse := &serverMonitorResponseEnvelope{}
+	nakedResponse := &simMonitorSIMResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &CPUTimeActivity{}
+	payload0 := &LinkActivity{}
 	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
 		return nil, err
 	}
@@ -4927,11 +11082,11 @@ func (o *ServerOp) Monitor(ctx context.Context, zone string, id types.ID, condit
 }
 
 /*************************************************
-* SIMOp
+* SimpleMonitorOp
 *************************************************/
 
-// SIMOp implements SIMAPI interface
-type SIMOp struct {
+// SimpleMonitorOp implements SimpleMonitorAPI interface
+type SimpleMonitorOp struct {
 	// Client APICaller
 	Client APICaller
 	// PathSuffix is used when building URL
@@ -4940,15 +11095,15 @@ type SIMOp struct {
 	PathName string
 }
 
-// NewSIMOp creates new SIMOp instance
-func NewSIMOp(caller APICaller) SIMAPI {
-	return GetClientFactoryFunc("SIM")(caller).(SIMAPI)
+// NewSimpleMonitorOp creates new SimpleMonitorOp instance
+func NewSimpleMonitorOp(caller APICaller) SimpleMonitorAPI {
+	return GetClientFactoryFunc("SimpleMonitor")(caller).(SimpleMonitorAPI)
 }
 
 // Find is API call
-func (o *SIMOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*SIM, error) {
+func (o *SimpleMonitorOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*SimpleMonitor, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -4974,7 +11129,7 @@ func (o *SIMOp) Find(ctx context.Context, zone string, conditions *FindCondition
 		Argconditions: conditions,
 	}
 
-	v := &simFindRequestEnvelope{}
+	v := &simplemonitorFindRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -4985,14 +11140,14 @@ func (o *SIMOp) Find(ctx context.Context, zone string, conditions *FindCondition
 		return nil, err
 	}
 
-	nakedResponse := &simFindResponseEnvelope{}
+	nakedResponse := &simplemonitorFindResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	var payload0 []*SIM
+	var payload0 []*SimpleMonitor
 	for _, v := range nakedResponse.CommonServiceItems {
-		payload := &SIM{}
+		payload := &SimpleMonitor{}
 		if err := payload.convertFrom(v); err != nil {
 			return nil, err
 		}
@@ -5002,9 +11157,9 @@ func (o *SIMOp) Find(ctx context.Context, zone string, conditions *FindCondition
 }
 
 // Create is API call
-func (o *SIMOp) Create(ctx context.Context, zone string, param *SIMCreateRequest) (*SIM, error) {
+func (o *SimpleMonitorOp) Create(ctx context.Context, zone string, param *SimpleMonitorCreateRequest) (*SimpleMonitor, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -5020,17 +11175,17 @@ func (o *SIMOp) Create(ctx context.Context, zone string, param *SIMCreateRequest
 		zone = ""
 	}
 	if param == nil {
-		param = &SIMCreateRequest{}
+		param = &SimpleMonitorCreateRequest{}
 	}
 	args := &struct {
 		Argzone  string
-		Argparam *SIMCreateRequest `mapconv:"CommonServiceItem,recursive"`
+		Argparam *SimpleMonitorCreateRequest `mapconv:"CommonServiceItem,recursive"`
 	}{
 		Argzone:  zone,
 		Argparam: param,
 	}
 
-	v := &simCreateRequestEnvelope{}
+	v := &simplemonitorCreateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -5041,12 +11196,12 @@ func (o *SIMOp) Create(ctx context.Context, zone string, param *SIMCreateRequest
 		return nil, err
 	}
 
-	nakedResponse := &simCreateResponseEnvelope{}
+	nakedResponse := &simplemonitorCreateResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &SIM{}
+	payload0 := &SimpleMonitor{}
 	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
 		return nil, err
 	}
@@ -5054,9 +11209,9 @@ func (o *SIMOp) Create(ctx context.Context, zone string, param *SIMCreateRequest
 }
 
 // Read is API call
-func (o *SIMOp) Read(ctx context.Context, zone string, id types.ID) (*SIM, error) {
+func (o *SimpleMonitorOp) Read(ctx context.Context, zone string, id types.ID) (*SimpleMonitor, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -5073,12 +11228,12 @@ func (o *SIMOp) Read(ctx context.Context, zone string, id types.ID) (*SIM, error
 		return nil, err
 	}
 
-	nakedResponse := &simReadResponseEnvelope{}
+	nakedResponse := &simplemonitorReadResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &SIM{}
+	payload0 := &SimpleMonitor{}
 	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
 		return nil, err
 	}
@@ -5086,9 +11241,9 @@ func (o *SIMOp) Read(ctx context.Context, zone string, id types.ID) (*SIM, error
 }
 
 // Update is API call
-func (o *SIMOp) Update(ctx context.Context, zone string, id types.ID, param *SIMUpdateRequest) (*SIM, error) {
+func (o *SimpleMonitorOp) Update(ctx context.Context, zone string, id types.ID, param *SimpleMonitorUpdateRequest) (*SimpleMonitor, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -5108,19 +11263,19 @@ func (o *SIMOp) Update(ctx context.Context, zone string, id types.ID, param *SIM
 		id = types.ID(int64(0))
 	}
 	if param == nil {
-		param = &SIMUpdateRequest{}
+		param = &SimpleMonitorUpdateRequest{}
 	}
 	args := &struct {
 		Argzone  string
 		Argid    types.ID
-		Argparam *SIMUpdateRequest `mapconv:"CommonServiceItem,recursive"`
+		Argparam *SimpleMonitorUpdateRequest `mapconv:"CommonServiceItem,recursive"`
 	}{
 		Argzone:  zone,
 		Argid:    id,
 		Argparam: param,
 	}
 
-	v := &simUpdateRequestEnvelope{}
+	v := &simplemonitorUpdateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
 		return nil, err
 	}
@@ -5131,12 +11286,12 @@ func (o *SIMOp) Update(ctx context.Context, zone string, id types.ID, param *SIM
 		return nil, err
 	}
 
-	nakedResponse := &simUpdateResponseEnvelope{}
+	nakedResponse := &simplemonitorUpdateResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &SIM{}
+	payload0 := &SimpleMonitor{}
 	if err := payload0.convertFrom(nakedResponse.CommonServiceItem); err != nil {
 		return nil, err
 	}
@@ -5144,9 +11299,9 @@ func (o *SIMOp) Update(ctx context.Context, zone string, id types.ID, param *SIM
 }
 
 // Delete is API call
-func (o *SIMOp) Delete(ctx context.Context, zone string, id types.ID) error {
+func (o *SimpleMonitorOp) Delete(ctx context.Context, zone string, id types.ID) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -5166,64 +11321,234 @@ func (o *SIMOp) Delete(ctx context.Context, zone string, id types.ID) error {
 	return nil
 }
 
-// Activate is API call
-func (o *SIMOp) Activate(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/activate", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// MonitorResponseTime is API call
+func (o *SimpleMonitorOp) MonitorResponseTime(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*ResponseTimeSecActivity, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/activity/responsetimesec/monitor", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
 		"id":         id,
+		"condition":  condition,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var body interface{}
 
-	_, err = o.Client.Do(ctx, "PUT", url, body)
+	if zone == "" {
+		zone = ""
+	}
+	if id == types.ID(int64(0)) {
+		id = types.ID(int64(0))
+	}
+	if condition == nil {
+		condition = &MonitorCondition{}
+	}
+	args := &struct {
+		Argzone      string
+		Argid        types.ID
+		Argcondition *MonitorCondition `mapconv:",squash"`
+	}{
+		Argzone:      zone,
+		Argid:        id,
+		Argcondition: condition,
+	}
+
+	v := &simplemonitorMonitorResponseTimeRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	nakedResponse := &simplemonitorMonitorResponseTimeResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &ResponseTimeSecActivity{}
+	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+// HealthStatus is API call
+func (o *SimpleMonitorOp) HealthStatus(ctx context.Context, zone string, id types.ID) (*SimpleMonitorHealthStatus, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/health", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"id":         id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &simplemonitorHealthStatusResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &SimpleMonitorHealthStatus{}
+	if err := payload0.convertFrom(nakedResponse.SimpleMonitor); err != nil {
+		return nil, err
+	}
+	return payload0, nil
+}
+
+/*************************************************
+* SSHKeyOp
+*************************************************/
+
+// SSHKeyOp implements SSHKeyAPI interface
+type SSHKeyOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewSSHKeyOp creates new SSHKeyOp instance
+func NewSSHKeyOp(caller APICaller) SSHKeyAPI {
+	return GetClientFactoryFunc("SSHKey")(caller).(SSHKeyAPI)
+}
+
+// Find is API call
+func (o *SSHKeyOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*SSHKey, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"conditions": conditions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
+	}
+
+	v := &sshkeyFindRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
+	data, err := o.Client.Do(ctx, "GET", url, body)
+	if err != nil {
+		return nil, err
+	}
+
+	nakedResponse := &sshkeyFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*SSHKey
+	for _, v := range nakedResponse.SSHKeys {
+		payload := &SSHKey{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
+}
+
+// Create is API call
+func (o *SSHKeyOp) Create(ctx context.Context, zone string, param *SSHKeyCreateRequest) (*SSHKey, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
+		"pathSuffix": o.PathSuffix,
+		"pathName":   o.PathName,
+		"zone":       zone,
+		"param":      param,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var body interface{}
+
+	if zone == "" {
+		zone = ""
+	}
+	if param == nil {
+		param = &SSHKeyCreateRequest{}
+	}
+	args := &struct {
+		Argzone  string
+		Argparam *SSHKeyCreateRequest `mapconv:"SSHKey,recursive"`
+	}{
+		Argzone:  zone,
+		Argparam: param,
 	}
 
-	return nil
-}
+	v := &sshkeyCreateRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
 
-// Deactivate is API call
-func (o *SIMOp) Deactivate(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/deactivate", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
-		"pathSuffix": o.PathSuffix,
-		"pathName":   o.PathName,
-		"zone":       zone,
-		"id":         id,
-	})
+	data, err := o.Client.Do(ctx, "POST", url, body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	var body interface{}
-
-	_, err = o.Client.Do(ctx, "PUT", url, body)
-	if err != nil {
-		return err
+	nakedResponse := &sshkeyCreateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
 	}
 
-	return nil
+	payload0 := &SSHKey{}
+	if err := payload0.convertFrom(nakedResponse.SSHKey); err != nil {
+		return nil, err
+	}
+	return payload0, nil
 }
 
-// AssignIP is API call
-func (o *SIMOp) AssignIP(ctx context.Context, zone string, id types.ID, param *SIMAssignIPRequest) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/ip", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// Generate is API call
+func (o *SSHKeyOp) Generate(ctx context.Context, zone string, param *SSHKeyGenerateRequest) (*SSHKeyGenerated, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/generate", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
-		"id":         id,
 		"param":      param,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var body interface{}
@@ -5231,63 +11556,76 @@ func (o *SIMOp) AssignIP(ctx context.Context, zone string, id types.ID, param *S
 	if zone == "" {
 		zone = ""
 	}
-	if id == types.ID(int64(0)) {
-		id = types.ID(int64(0))
-	}
 	if param == nil {
-		param = &SIMAssignIPRequest{}
+		param = &SSHKeyGenerateRequest{}
 	}
 	args := &struct {
 		Argzone  string
-		Argid    types.ID
-		Argparam *SIMAssignIPRequest `mapconv:"SIM,recursive"`
+		Argparam *SSHKeyGenerateRequest `mapconv:"KeyPair,recursive"`
 	}{
 		Argzone:  zone,
-		Argid:    id,
 		Argparam: param,
 	}
 
-	v := &simAssignIPRequestEnvelope{}
+	v := &sshkeyGenerateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
-		return err
+		return nil, err
 	}
 	body = v
 
-	_, err = o.Client.Do(ctx, "PUT", url, body)
+	data, err := o.Client.Do(ctx, "POST", url, body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	nakedResponse := &sshkeyGenerateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &SSHKeyGenerated{}
+	if err := payload0.convertFrom(nakedResponse.SSHKey); err != nil {
+		return nil, err
+	}
+	return payload0, nil
 }
 
-// ClearIP is API call
-func (o *SIMOp) ClearIP(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/ip", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// Read is API call
+func (o *SSHKeyOp) Read(ctx context.Context, zone string, id types.ID) (*SSHKey, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
 		"id":         id,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var body interface{}
 
-	_, err = o.Client.Do(ctx, "DELETE", url, body)
+	data, err := o.Client.Do(ctx, "GET", url, body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	nakedResponse := &sshkeyReadResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &SSHKey{}
+	if err := payload0.convertFrom(nakedResponse.SSHKey); err != nil {
+		return nil, err
+	}
+	return payload0, nil
 }
 
-// IMEILock is API call
-func (o *SIMOp) IMEILock(ctx context.Context, zone string, id types.ID, param *SIMIMEILockRequest) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/imeilock", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// Update is API call
+func (o *SSHKeyOp) Update(ctx context.Context, zone string, id types.ID, param *SSHKeyUpdateRequest) (*SSHKey, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -5295,7 +11633,7 @@ func (o *SIMOp) IMEILock(ctx context.Context, zone string, id types.ID, param *S
 		"param":      param,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var body interface{}
@@ -5307,36 +11645,45 @@ func (o *SIMOp) IMEILock(ctx context.Context, zone string, id types.ID, param *S
 		id = types.ID(int64(0))
 	}
 	if param == nil {
-		param = &SIMIMEILockRequest{}
+		param = &SSHKeyUpdateRequest{}
 	}
 	args := &struct {
 		Argzone  string
 		Argid    types.ID
-		Argparam *SIMIMEILockRequest `mapconv:"SIM,recursive"`
+		Argparam *SSHKeyUpdateRequest `mapconv:"SSHKey,recursive"`
 	}{
 		Argzone:  zone,
 		Argid:    id,
 		Argparam: param,
 	}
 
-	v := &simIMEILockRequestEnvelope{}
+	v := &sshkeyUpdateRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
-		return err
+		return nil, err
 	}
 	body = v
 
-	_, err = o.Client.Do(ctx, "PUT", url, body)
+	data, err := o.Client.Do(ctx, "PUT", url, body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	nakedResponse := &sshkeyUpdateResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	payload0 := &SSHKey{}
+	if err := payload0.convertFrom(nakedResponse.SSHKey); err != nil {
+		return nil, err
+	}
+	return payload0, nil
 }
 
-// IMEIUnlock is API call
-func (o *SIMOp) IMEIUnlock(ctx context.Context, zone string, id types.ID) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/imeilock", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// Delete is API call
+func (o *SSHKeyOp) Delete(ctx context.Context, zone string, id types.ID) error {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -5356,14 +11703,33 @@ func (o *SIMOp) IMEIUnlock(ctx context.Context, zone string, id types.ID) error
 	return nil
 }
 
-// Logs is API call
-func (o *SIMOp) Logs(ctx context.Context, zone string, id types.ID) ([]*SIMLog, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/sessionlog", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+/*************************************************
+* StorageOp
+*************************************************/
+
+// StorageOp implements StorageAPI interface
+type StorageOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewStorageOp creates new StorageOp instance
+func NewStorageOp(caller APICaller) StorageAPI {
+	return GetClientFactoryFunc("Storage")(caller).(StorageAPI)
+}
+
+// Find is API call
+func (o *StorageOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Storage, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
-		"id":         id,
+		"conditions": conditions,
 	})
 	if err != nil {
 		return nil, err
@@ -5371,19 +11737,39 @@ func (o *SIMOp) Logs(ctx context.Context, zone string, id types.ID) ([]*SIMLog,
 
 	var body interface{}
 
+	if zone == "" {
+		zone = ""
+	}
+	if conditions == nil {
+		conditions = &FindCondition{}
+	}
+	args := &struct {
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
+	}{
+		Argzone:       zone,
+		Argconditions: conditions,
+	}
+
+	v := &storageFindRequestEnvelope{}
+	if err := mapconv.ConvertTo(args, v); err != nil {
+		return nil, err
+	}
+	body = v
+
 	data, err := o.Client.Do(ctx, "GET", url, body)
 	if err != nil {
 		return nil, err
 	}
 
-	nakedResponse := &simLogsResponseEnvelope{}
+	nakedResponse := &storageFindResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	var payload0 []*SIMLog
-	for _, v := range nakedResponse.Logs {
-		payload := &SIMLog{}
+	var payload0 []*Storage
+	for _, v := range nakedResponse.Storages {
+		payload := &Storage{}
 		if err := payload.convertFrom(v); err != nil {
 			return nil, err
 		}
@@ -5392,10 +11778,10 @@ func (o *SIMOp) Logs(ctx context.Context, zone string, id types.ID) ([]*SIMLog,
 	return payload0, nil
 }
 
-// GetNetworkOperator is API call
-func (o *SIMOp) GetNetworkOperator(ctx context.Context, zone string, id types.ID) ([]*SIMNetworkOperatorConfig, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/network_operator_config", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// Read is API call
+func (o *StorageOp) Read(ctx context.Context, zone string, id types.ID) (*Storage, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -5412,34 +11798,48 @@ func (o *SIMOp) GetNetworkOperator(ctx context.Context, zone string, id types.ID
 		return nil, err
 	}
 
-	nakedResponse := &simGetNetworkOperatorResponseEnvelope{}
+	nakedResponse := &storageReadResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	var payload0 []*SIMNetworkOperatorConfig
-	for _, v := range nakedResponse.NetworkOperationConfigs {
-		payload := &SIMNetworkOperatorConfig{}
-		if err := payload.convertFrom(v); err != nil {
-			return nil, err
-		}
-		payload0 = append(payload0, payload)
+	payload0 := &Storage{}
+	if err := payload0.convertFrom(nakedResponse.Storage); err != nil {
+		return nil, err
 	}
 	return payload0, nil
 }
 
-// SetNetworkOperator is API call
-func (o *SIMOp) SetNetworkOperator(ctx context.Context, zone string, id types.ID, configs *SIMNetworkOperatorConfigs) error {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/network_operator_config", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+/*************************************************
+* SubnetOp
+*************************************************/
+
+// SubnetOp implements SubnetAPI interface
+type SubnetOp struct {
+	// Client APICaller
+	Client APICaller
+	// PathSuffix is used when building URL
+	PathSuffix string
+	// PathName is used when building URL
+	PathName string
+}
+
+// NewSubnetOp creates new SubnetOp instance
+func NewSubnetOp(caller APICaller) SubnetAPI {
+	return GetClientFactoryFunc("Subnet")(caller).(SubnetAPI)
+}
+
+// Find is API call
+func (o *SubnetOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Subnet, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
-		"id":         id,
-		"configs":    configs,
+		"conditions": conditions,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var body interface{}
@@ -5447,45 +11847,52 @@ func (o *SIMOp) SetNetworkOperator(ctx context.Context, zone string, id types.ID
 	if zone == "" {
 		zone = ""
 	}
-	if id == types.ID(int64(0)) {
-		id = types.ID(int64(0))
-	}
-	if configs == nil {
-		configs = &SIMNetworkOperatorConfigs{}
+	if conditions == nil {
+		conditions = &FindCondition{}
 	}
 	args := &struct {
-		Argzone    string
-		Argid      types.ID
-		Argconfigs *SIMNetworkOperatorConfigs `mapconv:",squash"`
+		Argzone       string
+		Argconditions *FindCondition `mapconv:",squash"`
 	}{
-		Argzone:    zone,
-		Argid:      id,
-		Argconfigs: configs,
+		Argzone:       zone,
+		Argconditions: conditions,
 	}
 
-	v := &simSetNetworkOperatorRequestEnvelope{}
+	v := &subnetFindRequestEnvelope{}
 	if err := mapconv.ConvertTo(args, v); err != nil {
-		return err
+		return nil, err
 	}
 	body = v
 
-	_, err = o.Client.Do(ctx, "PUT", url, body)
+	data, err := o.Client.Do(ctx, "GET", url, body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	nakedResponse := &subnetFindResponseEnvelope{}
+	if err := json.Unmarshal(data, nakedResponse); err != nil {
+		return nil, err
+	}
+
+	var payload0 []*Subnet
+	for _, v := range nakedResponse.Subnets {
+		payload := &Subnet{}
+		if err := payload.convertFrom(v); err != nil {
+			return nil, err
+		}
+		payload0 = append(payload0, payload)
+	}
+	return payload0, nil
 }
 
-// MonitorSIM is API call
-func (o *SIMOp) MonitorSIM(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*LinkActivity, error) {
-	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/sim/metrics/monitor", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+// Read is API call
+func (o *SubnetOp) Read(ctx context.Context, zone string, id types.ID) (*Subnet, error) {
+	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
 		"id":         id,
-		"condition":  condition,
 	})
 	if err != nil {
 		return nil, err
@@ -5493,43 +11900,18 @@ func (o *SIMOp) MonitorSIM(ctx context.Context, zone string, id types.ID, condit
 
 	var body interface{}
 
-	if zone == "" {
-		zone = ""
-	}
-	if id == types.ID(int64(0)) {
-		id = types.ID(int64(0))
-	}
-	if condition == nil {
-		condition = &MonitorCondition{}
-	}
-	args := &struct {
-		Argzone      string
-		Argid        types.ID
-		Argcondition *MonitorCondition `mapconv:",squash"`
-	}{
-		Argzone:      zone,
-		Argid:        id,
-		Argcondition: condition,
-	}
-
-	v := &simMonitorSIMRequestEnvelope{}
-	if err := mapconv.ConvertTo(args, v); err != nil {
-		return nil, err
-	}
-	body = v
-
 	data, err := o.Client.Do(ctx, "GET", url, body)
 	if err != nil {
 		return nil, err
 	}
 
-	nakedResponse := &simMonitorSIMResponseEnvelope{}
+	nakedResponse := &subnetReadResponseEnvelope{}
 	if err := json.Unmarshal(data, nakedResponse); err != nil {
 		return nil, err
 	}
 
-	payload0 := &LinkActivity{}
-	if err := payload0.convertFrom(nakedResponse.Data); err != nil {
+	payload0 := &Subnet{}
+	if err := payload0.convertFrom(nakedResponse.Subnet); err != nil {
 		return nil, err
 	}
 	return payload0, nil
@@ -5557,7 +11939,7 @@ func NewSwitchOp(caller APICaller) SwitchAPI {
 // Find is API call
 func (o *SwitchOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Switch, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -5613,7 +11995,7 @@ func (o *SwitchOp) Find(ctx context.Context, zone string, conditions *FindCondit
 // Create is API call
 func (o *SwitchOp) Create(ctx context.Context, zone string, param *SwitchCreateRequest) (*Switch, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -5665,7 +12047,7 @@ func (o *SwitchOp) Create(ctx context.Context, zone string, param *SwitchCreateR
 // Read is API call
 func (o *SwitchOp) Read(ctx context.Context, zone string, id types.ID) (*Switch, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -5697,7 +12079,7 @@ func (o *SwitchOp) Read(ctx context.Context, zone string, id types.ID) (*Switch,
 // Update is API call
 func (o *SwitchOp) Update(ctx context.Context, zone string, id types.ID, param *SwitchUpdateRequest) (*Switch, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -5755,7 +12137,7 @@ func (o *SwitchOp) Update(ctx context.Context, zone string, id types.ID, param *
 // Delete is API call
 func (o *SwitchOp) Delete(ctx context.Context, zone string, id types.ID) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -5778,7 +12160,7 @@ func (o *SwitchOp) Delete(ctx context.Context, zone string, id types.ID) error {
 // ConnectToBridge is API call
 func (o *SwitchOp) ConnectToBridge(ctx context.Context, zone string, id types.ID, bridgeID types.ID) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/bridge/{{.bridgeID}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -5802,7 +12184,7 @@ func (o *SwitchOp) ConnectToBridge(ctx context.Context, zone string, id types.ID
 // DisconnectFromBridge is API call
 func (o *SwitchOp) DisconnectFromBridge(ctx context.Context, zone string, id types.ID) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/to/bridge/", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -5844,7 +12226,7 @@ func NewVPCRouterOp(caller APICaller) VPCRouterAPI {
 // Find is API call
 func (o *VPCRouterOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*VPCRouter, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -5900,7 +12282,7 @@ func (o *VPCRouterOp) Find(ctx context.Context, zone string, conditions *FindCon
 // Create is API call
 func (o *VPCRouterOp) Create(ctx context.Context, zone string, param *VPCRouterCreateRequest) (*VPCRouter, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -5952,7 +12334,7 @@ func (o *VPCRouterOp) Create(ctx context.Context, zone string, param *VPCRouterC
 // Read is API call
 func (o *VPCRouterOp) Read(ctx context.Context, zone string, id types.ID) (*VPCRouter, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -5984,7 +12366,7 @@ func (o *VPCRouterOp) Read(ctx context.Context, zone string, id types.ID) (*VPCR
 // Update is API call
 func (o *VPCRouterOp) Update(ctx context.Context, zone string, id types.ID, param *VPCRouterUpdateRequest) (*VPCRouter, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -6042,7 +12424,7 @@ func (o *VPCRouterOp) Update(ctx context.Context, zone string, id types.ID, para
 // Delete is API call
 func (o *VPCRouterOp) Delete(ctx context.Context, zone string, id types.ID) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -6065,7 +12447,7 @@ func (o *VPCRouterOp) Delete(ctx context.Context, zone string, id types.ID) erro
 // Config is API call
 func (o *VPCRouterOp) Config(ctx context.Context, zone string, id types.ID) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/config", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -6088,7 +12470,7 @@ func (o *VPCRouterOp) Config(ctx context.Context, zone string, id types.ID) erro
 // Boot is API call
 func (o *VPCRouterOp) Boot(ctx context.Context, zone string, id types.ID) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -6111,7 +12493,7 @@ func (o *VPCRouterOp) Boot(ctx context.Context, zone string, id types.ID) error
 // Shutdown is API call
 func (o *VPCRouterOp) Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *ShutdownOption) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/power", map[string]interface{}{
-		"rootURL":        SakuraCloudAPIRoot,
+		"rootURL":        resolveAPIRootURL(o.Client, zone),
 		"pathSuffix":     o.PathSuffix,
 		"pathName":       o.PathName,
 		"zone":           zone,
@@ -6160,7 +12542,7 @@ func (o *VPCRouterOp) Shutdown(ctx context.Context, zone string, id types.ID, sh
 // Reset is API call
 func (o *VPCRouterOp) Reset(ctx context.Context, zone string, id types.ID) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/reset", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -6183,7 +12565,7 @@ func (o *VPCRouterOp) Reset(ctx context.Context, zone string, id types.ID) error
 // ConnectToSwitch is API call
 func (o *VPCRouterOp) ConnectToSwitch(ctx context.Context, zone string, id types.ID, nicIndex int, switchID types.ID) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/interface/{{.nicIndex}}/to/switch/{{.switchID}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -6208,7 +12590,7 @@ func (o *VPCRouterOp) ConnectToSwitch(ctx context.Context, zone string, id types
 // DisconnectFromSwitch is API call
 func (o *VPCRouterOp) DisconnectFromSwitch(ctx context.Context, zone string, id types.ID, nicIndex int) error {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/interface/{{.nicIndex}}/to/switch", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -6232,7 +12614,7 @@ func (o *VPCRouterOp) DisconnectFromSwitch(ctx context.Context, zone string, id
 // MonitorInterface is API call
 func (o *VPCRouterOp) MonitorInterface(ctx context.Context, zone string, id types.ID, index int, condition *MonitorCondition) (*InterfaceActivity, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}/interface/{{if eq .index 0}}{{.index}}{{end}}/monitor", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -6315,7 +12697,7 @@ func NewZoneOp(caller APICaller) ZoneAPI {
 // Find is API call
 func (o *ZoneOp) Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Zone, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
@@ -6371,7 +12753,7 @@ func (o *ZoneOp) Find(ctx context.Context, zone string, conditions *FindConditio
 // Read is API call
 func (o *ZoneOp) Read(ctx context.Context, zone string, id types.ID) (*Zone, error) {
 	url, err := buildURL("{{.rootURL}}/{{.zone}}/{{.pathSuffix}}/{{.pathName}}/{{.id}}", map[string]interface{}{
-		"rootURL":    SakuraCloudAPIRoot,
+		"rootURL":    resolveAPIRootURL(o.Client, zone),
 		"pathSuffix": o.PathSuffix,
 		"pathName":   o.PathName,
 		"zone":       zone,
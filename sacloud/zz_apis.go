@@ -17,11 +17,50 @@ type ArchiveAPI interface {
 	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Archive, error)
 	Create(ctx context.Context, zone string, param *ArchiveCreateRequest) (*Archive, error)
 	CreateBlank(ctx context.Context, zone string, param *ArchiveCreateBlankRequest) (*Archive, *FTPServer, error)
+	Transfer(ctx context.Context, zone string, id types.ID, destZoneID types.ID, param *ArchiveTransferRequest) (*Archive, error)
+	CreateFromShared(ctx context.Context, zone string, id types.ID, destZoneID types.ID, param *ArchiveCreateFromSharedRequest) (*Archive, error)
 	Read(ctx context.Context, zone string, id types.ID) (*Archive, error)
 	Update(ctx context.Context, zone string, id types.ID, param *ArchiveUpdateRequest) (*Archive, error)
 	Delete(ctx context.Context, zone string, id types.ID) error
 	OpenFTP(ctx context.Context, zone string, id types.ID, openOption *OpenFTPRequest) (*FTPServer, error)
 	CloseFTP(ctx context.Context, zone string, id types.ID) error
+	Share(ctx context.Context, zone string, id types.ID, param *ArchiveShareRequest) (*ArchiveShareInfo, error)
+}
+
+/*************************************************
+* AuthStatusAPI
+*************************************************/
+
+// AuthStatusAPI is interface for operate AuthStatus resource
+type AuthStatusAPI interface {
+	Read(ctx context.Context, zone string) (*AuthStatus, error)
+}
+
+/*************************************************
+* AutoBackupAPI
+*************************************************/
+
+// AutoBackupAPI is interface for operate AutoBackup resource
+type AutoBackupAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*AutoBackup, error)
+	Create(ctx context.Context, zone string, param *AutoBackupCreateRequest) (*AutoBackup, error)
+	Read(ctx context.Context, zone string, id types.ID) (*AutoBackup, error)
+	Update(ctx context.Context, zone string, id types.ID, param *AutoBackupUpdateRequest) (*AutoBackup, error)
+	Delete(ctx context.Context, zone string, id types.ID) error
+}
+
+/*************************************************
+* BillAPI
+*************************************************/
+
+// BillAPI is interface for operate Bill resource
+type BillAPI interface {
+	ByContract(ctx context.Context, zone string, accountID types.ID) ([]*Bill, error)
+	ByContractYear(ctx context.Context, zone string, accountID types.ID, year int) ([]*Bill, error)
+	ByContractYearMonth(ctx context.Context, zone string, accountID types.ID, year int, month int) ([]*Bill, error)
+	Read(ctx context.Context, zone string, id types.ID) ([]*Bill, error)
+	Details(ctx context.Context, zone string, memberCode string, id types.ID) ([]*BillDetail, error)
+	DetailsCSV(ctx context.Context, zone string, memberCode string, id types.ID) (*BillDetailCSV, error)
 }
 
 /*************************************************
@@ -52,6 +91,39 @@ type CDROMAPI interface {
 	CloseFTP(ctx context.Context, zone string, id types.ID) error
 }
 
+/*************************************************
+* CouponAPI
+*************************************************/
+
+// CouponAPI is interface for operate Coupon resource
+type CouponAPI interface {
+	Find(ctx context.Context, zone string, accountID types.ID) ([]*Coupon, error)
+}
+
+/*************************************************
+* DatabaseAPI
+*************************************************/
+
+// DatabaseAPI is interface for operate Database resource
+type DatabaseAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Database, error)
+	Create(ctx context.Context, zone string, param *DatabaseCreateRequest) (*Database, error)
+	Read(ctx context.Context, zone string, id types.ID) (*Database, error)
+	Update(ctx context.Context, zone string, id types.ID, param *DatabaseUpdateRequest) (*Database, error)
+	Delete(ctx context.Context, zone string, id types.ID) error
+	Config(ctx context.Context, zone string, id types.ID) error
+	Boot(ctx context.Context, zone string, id types.ID) error
+	Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *ShutdownOption) error
+	Reset(ctx context.Context, zone string, id types.ID) error
+	MonitorCPU(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*CPUTimeActivity, error)
+	MonitorDisk(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*DiskActivity, error)
+	MonitorInterface(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*InterfaceActivity, error)
+	MonitorDatabase(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*DatabaseActivity, error)
+	Status(ctx context.Context, zone string, id types.ID) (*DatabaseStatus, error)
+	GetParameter(ctx context.Context, zone string, id types.ID) (*DatabaseParameter, error)
+	SetParameter(ctx context.Context, zone string, id types.ID, param map[string]interface{}) error
+}
+
 /*************************************************
 * DiskAPI
 *************************************************/
@@ -76,6 +148,38 @@ type DiskAPI interface {
 	Monitor(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*DiskActivity, error)
 }
 
+/*************************************************
+* DiskPlanAPI
+*************************************************/
+
+// DiskPlanAPI is interface for operate DiskPlan resource
+type DiskPlanAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*DiskPlan, error)
+	Read(ctx context.Context, zone string, id types.ID) (*DiskPlan, error)
+}
+
+/*************************************************
+* DNSAPI
+*************************************************/
+
+// DNSAPI is interface for operate DNS resource
+type DNSAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*DNS, error)
+	Create(ctx context.Context, zone string, param *DNSCreateRequest) (*DNS, error)
+	Read(ctx context.Context, zone string, id types.ID) (*DNS, error)
+	Update(ctx context.Context, zone string, id types.ID, param *DNSUpdateRequest) (*DNS, error)
+	Delete(ctx context.Context, zone string, id types.ID) error
+}
+
+/*************************************************
+* ESUsageAPI
+*************************************************/
+
+// ESUsageAPI is interface for operate ESUsage resource
+type ESUsageAPI interface {
+	Find(ctx context.Context, zone string, accountID types.ID, year int, month int) ([]*ESUsage, error)
+}
+
 /*************************************************
 * GSLBAPI
 *************************************************/
@@ -89,6 +193,20 @@ type GSLBAPI interface {
 	Delete(ctx context.Context, zone string, id types.ID) error
 }
 
+/*************************************************
+* IconAPI
+*************************************************/
+
+// IconAPI is interface for operate Icon resource
+type IconAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Icon, error)
+	Create(ctx context.Context, zone string, param *IconCreateRequest) (*Icon, error)
+	Read(ctx context.Context, zone string, id types.ID) (*Icon, error)
+	Update(ctx context.Context, zone string, id types.ID, param *IconUpdateRequest) (*Icon, error)
+	Delete(ctx context.Context, zone string, id types.ID) error
+	GetImage(ctx context.Context, zone string, id types.ID, size types.EIconSize) (*IconImage, error)
+}
+
 /*************************************************
 * InterfaceAPI
 *************************************************/
@@ -124,6 +242,75 @@ type InternetAPI interface {
 	UpdateSubnet(ctx context.Context, zone string, id types.ID, subnetID types.ID, param *InternetUpdateSubnetRequest) (*InternetSubnetOperationResult, error)
 	DeleteSubnet(ctx context.Context, zone string, id types.ID, subnetID types.ID) error
 	Monitor(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*RouterActivity, error)
+	EnableIPv6(ctx context.Context, zone string, id types.ID) (*IPv6NetInfo, error)
+	DisableIPv6(ctx context.Context, zone string, id types.ID, ipv6netID types.ID) error
+}
+
+/*************************************************
+* InternetPlanAPI
+*************************************************/
+
+// InternetPlanAPI is interface for operate InternetPlan resource
+type InternetPlanAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*InternetPlan, error)
+	Read(ctx context.Context, zone string, id types.ID) (*InternetPlan, error)
+}
+
+/*************************************************
+* IPAddressAPI
+*************************************************/
+
+// IPAddressAPI is interface for operate IPAddress resource
+type IPAddressAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*IPAddress, error)
+	Read(ctx context.Context, zone string, ipAddress string) (*IPAddress, error)
+	UpdateHostName(ctx context.Context, zone string, ipAddress string, hostName string) (*IPAddress, error)
+}
+
+/*************************************************
+* IPv6AddrAPI
+*************************************************/
+
+// IPv6AddrAPI is interface for operate IPv6Addr resource
+type IPv6AddrAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*IPv6Addr, error)
+	Create(ctx context.Context, zone string, param *IPv6AddrCreateRequest) (*IPv6Addr, error)
+	Read(ctx context.Context, zone string, ipv6addr string) (*IPv6Addr, error)
+	Update(ctx context.Context, zone string, ipv6addr string, param *IPv6AddrUpdateRequest) (*IPv6Addr, error)
+	Delete(ctx context.Context, zone string, ipv6addr string) error
+}
+
+/*************************************************
+* IPv6NetAPI
+*************************************************/
+
+// IPv6NetAPI is interface for operate IPv6Net resource
+type IPv6NetAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*IPv6Net, error)
+	Read(ctx context.Context, zone string, id types.ID) (*IPv6Net, error)
+}
+
+/*************************************************
+* LicenseAPI
+*************************************************/
+
+// LicenseAPI is interface for operate License resource
+type LicenseAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*License, error)
+	Create(ctx context.Context, zone string, param *LicenseCreateRequest) (*License, error)
+	Read(ctx context.Context, zone string, id types.ID) (*License, error)
+	Update(ctx context.Context, zone string, id types.ID, param *LicenseUpdateRequest) (*License, error)
+	Delete(ctx context.Context, zone string, id types.ID) error
+}
+
+/*************************************************
+* LicensePlanAPI
+*************************************************/
+
+// LicensePlanAPI is interface for operate LicensePlan resource
+type LicensePlanAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*LicensePlan, error)
+	Read(ctx context.Context, zone string, id types.ID) (*LicensePlan, error)
 }
 
 /*************************************************
@@ -145,6 +332,37 @@ type LoadBalancerAPI interface {
 	Status(ctx context.Context, zone string, id types.ID) ([]*LoadBalancerStatus, error)
 }
 
+/*************************************************
+* MobileGatewayAPI
+*************************************************/
+
+// MobileGatewayAPI is interface for operate MobileGateway resource
+type MobileGatewayAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*MobileGateway, error)
+	Create(ctx context.Context, zone string, param *MobileGatewayCreateRequest) (*MobileGateway, error)
+	Read(ctx context.Context, zone string, id types.ID) (*MobileGateway, error)
+	Update(ctx context.Context, zone string, id types.ID, param *MobileGatewayUpdateRequest) (*MobileGateway, error)
+	Delete(ctx context.Context, zone string, id types.ID) error
+	Config(ctx context.Context, zone string, id types.ID) error
+	Boot(ctx context.Context, zone string, id types.ID) error
+	Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *ShutdownOption) error
+	Reset(ctx context.Context, zone string, id types.ID) error
+	ConnectToSwitch(ctx context.Context, zone string, id types.ID, switchID types.ID) error
+	DisconnectFromSwitch(ctx context.Context, zone string, id types.ID) error
+	GetDNS(ctx context.Context, zone string, id types.ID) (*MobileGatewayDNSSetting, error)
+	SetDNS(ctx context.Context, zone string, id types.ID, param *MobileGatewayDNSSetting) error
+	ListSIM(ctx context.Context, zone string, id types.ID) ([]*MobileGatewaySIMInfo, error)
+	AddSIM(ctx context.Context, zone string, id types.ID, param *MobileGatewayAddSIMRequest) error
+	DeleteSIM(ctx context.Context, zone string, id types.ID, simID types.ID) error
+	GetSIMRoutes(ctx context.Context, zone string, id types.ID) ([]*MobileGatewaySIMRoute, error)
+	SetSIMRoutes(ctx context.Context, zone string, id types.ID, routes []*MobileGatewaySIMRoute) error
+	GetTrafficConfig(ctx context.Context, zone string, id types.ID) (*MobileGatewayTrafficControl, error)
+	SetTrafficConfig(ctx context.Context, zone string, id types.ID, param *MobileGatewayTrafficControl) error
+	DeleteTrafficConfig(ctx context.Context, zone string, id types.ID) error
+	TrafficStatus(ctx context.Context, zone string, id types.ID) (*MobileGatewayTrafficStatus, error)
+	MonitorInterface(ctx context.Context, zone string, id types.ID, index int, condition *MonitorCondition) (*InterfaceActivity, error)
+}
+
 /*************************************************
 * NFSAPI
 *************************************************/
@@ -189,6 +407,58 @@ type PacketFilterAPI interface {
 	Delete(ctx context.Context, zone string, id types.ID) error
 }
 
+/*************************************************
+* PrivateHostAPI
+*************************************************/
+
+// PrivateHostAPI is interface for operate PrivateHost resource
+type PrivateHostAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*PrivateHost, error)
+	Create(ctx context.Context, zone string, param *PrivateHostCreateRequest) (*PrivateHost, error)
+	Read(ctx context.Context, zone string, id types.ID) (*PrivateHost, error)
+	Update(ctx context.Context, zone string, id types.ID, param *PrivateHostUpdateRequest) (*PrivateHost, error)
+	Delete(ctx context.Context, zone string, id types.ID) error
+}
+
+/*************************************************
+* PrivateHostPlanAPI
+*************************************************/
+
+// PrivateHostPlanAPI is interface for operate PrivateHostPlan resource
+type PrivateHostPlanAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*PrivateHostPlan, error)
+	Read(ctx context.Context, zone string, id types.ID) (*PrivateHostPlan, error)
+}
+
+/*************************************************
+* ProxyLBAPI
+*************************************************/
+
+// ProxyLBAPI is interface for operate ProxyLB resource
+type ProxyLBAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*ProxyLB, error)
+	Create(ctx context.Context, zone string, param *ProxyLBCreateRequest) (*ProxyLB, error)
+	Read(ctx context.Context, zone string, id types.ID) (*ProxyLB, error)
+	Update(ctx context.Context, zone string, id types.ID, param *ProxyLBUpdateRequest) (*ProxyLB, error)
+	Delete(ctx context.Context, zone string, id types.ID) error
+	GetCertificates(ctx context.Context, zone string, id types.ID) (*ProxyLBCertificates, error)
+	SetCertificates(ctx context.Context, zone string, id types.ID, param *ProxyLBSetCertificatesRequest) (*ProxyLBCertificates, error)
+	DeleteCertificates(ctx context.Context, zone string, id types.ID) error
+	RenewLetsEncryptCert(ctx context.Context, zone string, id types.ID) error
+	HealthStatus(ctx context.Context, zone string, id types.ID) (*ProxyLBHealth, error)
+	MonitorConnection(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*ConnectionActivity, error)
+}
+
+/*************************************************
+* RegionAPI
+*************************************************/
+
+// RegionAPI is interface for operate Region resource
+type RegionAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Region, error)
+	Read(ctx context.Context, zone string, id types.ID) (*Region, error)
+}
+
 /*************************************************
 * ServerAPI
 *************************************************/
@@ -207,6 +477,29 @@ type ServerAPI interface {
 	Shutdown(ctx context.Context, zone string, id types.ID, shutdownOption *ShutdownOption) error
 	Reset(ctx context.Context, zone string, id types.ID) error
 	Monitor(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*CPUTimeActivity, error)
+	GetVNCProxy(ctx context.Context, zone string, id types.ID) (*VNCProxyInfo, error)
+	SendKey(ctx context.Context, zone string, id types.ID, keyboardParam *SendKeyRequest) error
+	SendNMI(ctx context.Context, zone string, id types.ID) error
+	GetScreenshot(ctx context.Context, zone string, id types.ID, condition *GetScreenshotRequest) (*VNCSnapshotInfo, error)
+}
+
+/*************************************************
+* ServerPlanAPI
+*************************************************/
+
+// ServerPlanAPI is interface for operate ServerPlan resource
+type ServerPlanAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*ServerPlan, error)
+	Read(ctx context.Context, zone string, id types.ID) (*ServerPlan, error)
+}
+
+/*************************************************
+* ServiceClassAPI
+*************************************************/
+
+// ServiceClassAPI is interface for operate ServiceClass resource
+type ServiceClassAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*ServiceClass, error)
 }
 
 /*************************************************
@@ -232,6 +525,55 @@ type SIMAPI interface {
 	MonitorSIM(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*LinkActivity, error)
 }
 
+/*************************************************
+* SimpleMonitorAPI
+*************************************************/
+
+// SimpleMonitorAPI is interface for operate SimpleMonitor resource
+type SimpleMonitorAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*SimpleMonitor, error)
+	Create(ctx context.Context, zone string, param *SimpleMonitorCreateRequest) (*SimpleMonitor, error)
+	Read(ctx context.Context, zone string, id types.ID) (*SimpleMonitor, error)
+	Update(ctx context.Context, zone string, id types.ID, param *SimpleMonitorUpdateRequest) (*SimpleMonitor, error)
+	Delete(ctx context.Context, zone string, id types.ID) error
+	MonitorResponseTime(ctx context.Context, zone string, id types.ID, condition *MonitorCondition) (*ResponseTimeSecActivity, error)
+	HealthStatus(ctx context.Context, zone string, id types.ID) (*SimpleMonitorHealthStatus, error)
+}
+
+/*************************************************
+* SSHKeyAPI
+*************************************************/
+
+// SSHKeyAPI is interface for operate SSHKey resource
+type SSHKeyAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*SSHKey, error)
+	Create(ctx context.Context, zone string, param *SSHKeyCreateRequest) (*SSHKey, error)
+	Generate(ctx context.Context, zone string, param *SSHKeyGenerateRequest) (*SSHKeyGenerated, error)
+	Read(ctx context.Context, zone string, id types.ID) (*SSHKey, error)
+	Update(ctx context.Context, zone string, id types.ID, param *SSHKeyUpdateRequest) (*SSHKey, error)
+	Delete(ctx context.Context, zone string, id types.ID) error
+}
+
+/*************************************************
+* StorageAPI
+*************************************************/
+
+// StorageAPI is interface for operate Storage resource
+type StorageAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Storage, error)
+	Read(ctx context.Context, zone string, id types.ID) (*Storage, error)
+}
+
+/*************************************************
+* SubnetAPI
+*************************************************/
+
+// SubnetAPI is interface for operate Subnet resource
+type SubnetAPI interface {
+	Find(ctx context.Context, zone string, conditions *FindCondition) ([]*Subnet, error)
+	Read(ctx context.Context, zone string, id types.ID) (*Subnet, error)
+}
+
 /*************************************************
 * SwitchAPI
 *************************************************/
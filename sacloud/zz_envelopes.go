@@ -55,6 +55,32 @@ type archiveCreateBlankResponseEnvelope struct {
 	FTPServer *naked.OpeningFTPServer `json:",omitempty"`
 }
 
+// archiveTransferRequestEnvelope is envelop of API request
+type archiveTransferRequestEnvelope struct {
+	Archive *naked.Archive `json:",omitempty"`
+}
+
+// archiveTransferResponseEnvelope is envelop of API response
+type archiveTransferResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Archive *naked.Archive `json:",omitempty"`
+}
+
+// archiveCreateFromSharedRequestEnvelope is envelop of API request
+type archiveCreateFromSharedRequestEnvelope struct {
+	Archive *naked.Archive `json:",omitempty"`
+}
+
+// archiveCreateFromSharedResponseEnvelope is envelop of API response
+type archiveCreateFromSharedResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Archive *naked.Archive `json:",omitempty"`
+}
+
 // archiveReadResponseEnvelope is envelop of API response
 type archiveReadResponseEnvelope struct {
 	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
@@ -89,6 +115,133 @@ type archiveOpenFTPResponseEnvelope struct {
 	FTPServer *naked.OpeningFTPServer `json:",omitempty"`
 }
 
+// archiveShareRequestEnvelope is envelop of API request
+type archiveShareRequestEnvelope struct {
+	Shared bool `json:"Shared"`
+}
+
+// archiveShareResponseEnvelope is envelop of API response
+type archiveShareResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	ArchiveShareInfo *naked.ArchiveShareInfo `json:",omitempty"`
+}
+
+// authstatusReadResponseEnvelope is envelop of API response
+type authstatusReadResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	*naked.AuthStatus
+}
+
+// autobackupFindRequestEnvelope is envelop of API request
+type autobackupFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
+}
+
+// autobackupFindResponseEnvelope is envelop of API response
+type autobackupFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	CommonServiceItems []*naked.AutoBackup `json:",omitempty"`
+}
+
+// autobackupCreateRequestEnvelope is envelop of API request
+type autobackupCreateRequestEnvelope struct {
+	CommonServiceItem *naked.AutoBackup `json:",omitempty"`
+}
+
+// autobackupCreateResponseEnvelope is envelop of API response
+type autobackupCreateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	CommonServiceItem *naked.AutoBackup `json:",omitempty"`
+}
+
+// autobackupReadResponseEnvelope is envelop of API response
+type autobackupReadResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	CommonServiceItem *naked.AutoBackup `json:",omitempty"`
+}
+
+// autobackupUpdateRequestEnvelope is envelop of API request
+type autobackupUpdateRequestEnvelope struct {
+	CommonServiceItem *naked.AutoBackup `json:",omitempty"`
+}
+
+// autobackupUpdateResponseEnvelope is envelop of API response
+type autobackupUpdateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	CommonServiceItem *naked.AutoBackup `json:",omitempty"`
+}
+
+// billByContractResponseEnvelope is envelop of API response
+type billByContractResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	Bills []*naked.Bill `json:",omitempty"`
+}
+
+// billByContractYearResponseEnvelope is envelop of API response
+type billByContractYearResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	Bills []*naked.Bill `json:",omitempty"`
+}
+
+// billByContractYearMonthResponseEnvelope is envelop of API response
+type billByContractYearMonthResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	Bills []*naked.Bill `json:",omitempty"`
+}
+
+// billReadResponseEnvelope is envelop of API response
+type billReadResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	Bills []*naked.Bill `json:",omitempty"`
+}
+
+// billDetailsResponseEnvelope is envelop of API response
+type billDetailsResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	BillDetails []*naked.BillDetail `json:",omitempty"`
+}
+
+// billDetailsCSVResponseEnvelope is envelop of API response
+type billDetailsCSVResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	*naked.BillDetailCSV
+}
+
 // bridgeFindRequestEnvelope is envelop of API request
 type bridgeFindRequestEnvelope struct {
 	Count   int                    `json:",omitempty"`
@@ -209,6 +362,150 @@ type cdromOpenFTPResponseEnvelope struct {
 	FTPServer *naked.OpeningFTPServer `json:",omitempty"`
 }
 
+// couponFindResponseEnvelope is envelop of API response
+type couponFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	Coupons []*naked.Coupon `json:",omitempty"`
+}
+
+// databaseFindRequestEnvelope is envelop of API request
+type databaseFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
+}
+
+// databaseFindResponseEnvelope is envelop of API response
+type databaseFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	Appliances []*naked.Database `json:",omitempty"`
+}
+
+// databaseCreateRequestEnvelope is envelop of API request
+type databaseCreateRequestEnvelope struct {
+	Appliance *naked.Database `json:",omitempty"`
+}
+
+// databaseCreateResponseEnvelope is envelop of API response
+type databaseCreateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Appliance *naked.Database `json:",omitempty"`
+}
+
+// databaseReadResponseEnvelope is envelop of API response
+type databaseReadResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Appliance *naked.Database `json:",omitempty"`
+}
+
+// databaseUpdateRequestEnvelope is envelop of API request
+type databaseUpdateRequestEnvelope struct {
+	Appliance *naked.Database `json:",omitempty"`
+}
+
+// databaseUpdateResponseEnvelope is envelop of API response
+type databaseUpdateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Appliance *naked.Database `json:",omitempty"`
+}
+
+// databaseShutdownRequestEnvelope is envelop of API request
+type databaseShutdownRequestEnvelope struct {
+	Force bool `json:",omitempty"`
+}
+
+// databaseMonitorCPURequestEnvelope is envelop of API request
+type databaseMonitorCPURequestEnvelope struct {
+	Start time.Time `json:",omitempty"`
+	End   time.Time `json:",omitempty"`
+}
+
+// databaseMonitorCPUResponseEnvelope is envelop of API response
+type databaseMonitorCPUResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Data *naked.MonitorValues `json:",omitempty"`
+}
+
+// databaseMonitorDiskRequestEnvelope is envelop of API request
+type databaseMonitorDiskRequestEnvelope struct {
+	Start time.Time `json:",omitempty"`
+	End   time.Time `json:",omitempty"`
+}
+
+// databaseMonitorDiskResponseEnvelope is envelop of API response
+type databaseMonitorDiskResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Data *naked.MonitorValues `json:",omitempty"`
+}
+
+// databaseMonitorInterfaceRequestEnvelope is envelop of API request
+type databaseMonitorInterfaceRequestEnvelope struct {
+	Start time.Time `json:",omitempty"`
+	End   time.Time `json:",omitempty"`
+}
+
+// databaseMonitorInterfaceResponseEnvelope is envelop of API response
+type databaseMonitorInterfaceResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Data *naked.MonitorValues `json:",omitempty"`
+}
+
+// databaseMonitorDatabaseRequestEnvelope is envelop of API request
+type databaseMonitorDatabaseRequestEnvelope struct {
+	Start time.Time `json:",omitempty"`
+	End   time.Time `json:",omitempty"`
+}
+
+// databaseMonitorDatabaseResponseEnvelope is envelop of API response
+type databaseMonitorDatabaseResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Data *naked.MonitorValues `json:",omitempty"`
+}
+
+// databaseStatusResponseEnvelope is envelop of API response
+type databaseStatusResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Appliance *naked.DatabaseStatusResponse `json:",omitempty"`
+}
+
+// databaseGetParameterResponseEnvelope is envelop of API response
+type databaseGetParameterResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Database *naked.DatabaseParameter `json:",omitempty"`
+}
+
+// databaseSetParameterRequestEnvelope is envelop of API request
+type databaseSetParameterRequestEnvelope struct {
+	Parameter *naked.DatabaseParameterSetting `json:",omitempty"`
+}
+
 // diskFindRequestEnvelope is envelop of API request
 type diskFindRequestEnvelope struct {
 	Count   int                    `json:",omitempty"`
@@ -362,6 +659,95 @@ type diskMonitorResponseEnvelope struct {
 	Data *naked.MonitorValues `json:",omitempty"`
 }
 
+// diskplanFindRequestEnvelope is envelop of API request
+type diskplanFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
+}
+
+// diskplanFindResponseEnvelope is envelop of API response
+type diskplanFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	DiskPlans []*naked.DiskPlan `json:",omitempty"`
+}
+
+// diskplanReadResponseEnvelope is envelop of API response
+type diskplanReadResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	DiskPlan *naked.DiskPlan `json:",omitempty"`
+}
+
+// dnsFindRequestEnvelope is envelop of API request
+type dnsFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
+}
+
+// dnsFindResponseEnvelope is envelop of API response
+type dnsFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	CommonServiceItems []*naked.DNS `json:",omitempty"`
+}
+
+// dnsCreateRequestEnvelope is envelop of API request
+type dnsCreateRequestEnvelope struct {
+	CommonServiceItem *naked.DNS `json:",omitempty"`
+}
+
+// dnsCreateResponseEnvelope is envelop of API response
+type dnsCreateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	CommonServiceItem *naked.DNS `json:",omitempty"`
+}
+
+// dnsReadResponseEnvelope is envelop of API response
+type dnsReadResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	CommonServiceItem *naked.DNS `json:",omitempty"`
+}
+
+// dnsUpdateRequestEnvelope is envelop of API request
+type dnsUpdateRequestEnvelope struct {
+	CommonServiceItem *naked.DNS `json:",omitempty"`
+}
+
+// dnsUpdateResponseEnvelope is envelop of API response
+type dnsUpdateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	CommonServiceItem *naked.DNS `json:",omitempty"`
+}
+
+// esusageFindResponseEnvelope is envelop of API response
+type esusageFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	ESUsages []*naked.ESUsage `json:",omitempty"`
+}
+
 // gslbFindRequestEnvelope is envelop of API request
 type gslbFindRequestEnvelope struct {
 	Count   int                    `json:",omitempty"`
@@ -415,8 +801,8 @@ type gslbUpdateResponseEnvelope struct {
 	CommonServiceItem *naked.GSLB `json:",omitempty"`
 }
 
-// interfaceFindRequestEnvelope is envelop of API request
-type interfaceFindRequestEnvelope struct {
+// iconFindRequestEnvelope is envelop of API request
+type iconFindRequestEnvelope struct {
 	Count   int                    `json:",omitempty"`
 	From    int                    `json:",omitempty"`
 	Sort    []string               `json:",omitempty"`
@@ -425,16 +811,82 @@ type interfaceFindRequestEnvelope struct {
 	Exclude []string               `json:",omitempty"`
 }
 
-// interfaceFindResponseEnvelope is envelop of API response
-type interfaceFindResponseEnvelope struct {
+// iconFindResponseEnvelope is envelop of API response
+type iconFindResponseEnvelope struct {
 	Total int `json:",omitempty"` // トータル件数
 	From  int `json:",omitempty"` // ページング開始ページ
 	Count int `json:",omitempty"` // 件数
 
-	Interfaces []*naked.Interface `json:",omitempty"`
+	Icons []*naked.Icon `json:",omitempty"`
 }
 
-// interfaceCreateRequestEnvelope is envelop of API request
+// iconCreateRequestEnvelope is envelop of API request
+type iconCreateRequestEnvelope struct {
+	Icon *naked.Icon `json:",omitempty"`
+}
+
+// iconCreateResponseEnvelope is envelop of API response
+type iconCreateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Icon *naked.Icon `json:",omitempty"`
+}
+
+// iconReadResponseEnvelope is envelop of API response
+type iconReadResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Icon *naked.Icon `json:",omitempty"`
+}
+
+// iconUpdateRequestEnvelope is envelop of API request
+type iconUpdateRequestEnvelope struct {
+	Icon *naked.Icon `json:",omitempty"`
+}
+
+// iconUpdateResponseEnvelope is envelop of API response
+type iconUpdateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Icon *naked.Icon `json:",omitempty"`
+}
+
+// iconGetImageRequestEnvelope is envelop of API request
+type iconGetImageRequestEnvelope struct {
+	Size types.EIconSize `json:",omitempty"`
+}
+
+// iconGetImageResponseEnvelope is envelop of API response
+type iconGetImageResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Icon *naked.Icon `json:",omitempty"`
+}
+
+// interfaceFindRequestEnvelope is envelop of API request
+type interfaceFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
+}
+
+// interfaceFindResponseEnvelope is envelop of API response
+type interfaceFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	Interfaces []*naked.Interface `json:",omitempty"`
+}
+
+// interfaceCreateRequestEnvelope is envelop of API request
 type interfaceCreateRequestEnvelope struct {
 	Interface *naked.Interface `json:",omitempty"`
 }
@@ -589,8 +1041,16 @@ type internetMonitorResponseEnvelope struct {
 	Data *naked.MonitorValues `json:",omitempty"`
 }
 
-// loadbalancerFindRequestEnvelope is envelop of API request
-type loadbalancerFindRequestEnvelope struct {
+// internetEnableIPv6ResponseEnvelope is envelop of API response
+type internetEnableIPv6ResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	IPv6Net *naked.IPv6Net `json:",omitempty"`
+}
+
+// internetplanFindRequestEnvelope is envelop of API request
+type internetplanFindRequestEnvelope struct {
 	Count   int                    `json:",omitempty"`
 	From    int                    `json:",omitempty"`
 	Sort    []string               `json:",omitempty"`
@@ -599,79 +1059,65 @@ type loadbalancerFindRequestEnvelope struct {
 	Exclude []string               `json:",omitempty"`
 }
 
-// loadbalancerFindResponseEnvelope is envelop of API response
-type loadbalancerFindResponseEnvelope struct {
+// internetplanFindResponseEnvelope is envelop of API response
+type internetplanFindResponseEnvelope struct {
 	Total int `json:",omitempty"` // トータル件数
 	From  int `json:",omitempty"` // ページング開始ページ
 	Count int `json:",omitempty"` // 件数
 
-	Appliances []*naked.LoadBalancer `json:",omitempty"`
-}
-
-// loadbalancerCreateRequestEnvelope is envelop of API request
-type loadbalancerCreateRequestEnvelope struct {
-	Appliance *naked.LoadBalancer `json:",omitempty"`
+	InternetPlans []*naked.InternetPlan `json:",omitempty"`
 }
 
-// loadbalancerCreateResponseEnvelope is envelop of API response
-type loadbalancerCreateResponseEnvelope struct {
+// internetplanReadResponseEnvelope is envelop of API response
+type internetplanReadResponseEnvelope struct {
 	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
 	Success types.APIResult `json:",omitempty"`      // success項目
 
-	Appliance *naked.LoadBalancer `json:",omitempty"`
+	InternetPlan *naked.InternetPlan `json:",omitempty"`
 }
 
-// loadbalancerReadResponseEnvelope is envelop of API response
-type loadbalancerReadResponseEnvelope struct {
-	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
-	Success types.APIResult `json:",omitempty"`      // success項目
-
-	Appliance *naked.LoadBalancer `json:",omitempty"`
+// ipaddressFindRequestEnvelope is envelop of API request
+type ipaddressFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
 }
 
-// loadbalancerUpdateRequestEnvelope is envelop of API request
-type loadbalancerUpdateRequestEnvelope struct {
-	Appliance *naked.LoadBalancer `json:",omitempty"`
+// ipaddressFindResponseEnvelope is envelop of API response
+type ipaddressFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	IPAddress []*naked.IPAddress `json:",omitempty"`
 }
 
-// loadbalancerUpdateResponseEnvelope is envelop of API response
-type loadbalancerUpdateResponseEnvelope struct {
+// ipaddressReadResponseEnvelope is envelop of API response
+type ipaddressReadResponseEnvelope struct {
 	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
 	Success types.APIResult `json:",omitempty"`      // success項目
 
-	Appliance *naked.LoadBalancer `json:",omitempty"`
-}
-
-// loadbalancerShutdownRequestEnvelope is envelop of API request
-type loadbalancerShutdownRequestEnvelope struct {
-	Force bool `json:",omitempty"`
+	IPAddress *naked.IPAddress `json:",omitempty"`
 }
 
-// loadbalancerMonitorInterfaceRequestEnvelope is envelop of API request
-type loadbalancerMonitorInterfaceRequestEnvelope struct {
-	Start time.Time `json:",omitempty"`
-	End   time.Time `json:",omitempty"`
+// ipaddressUpdateHostNameRequestEnvelope is envelop of API request
+type ipaddressUpdateHostNameRequestEnvelope struct {
+	IPAddress *naked.IPAddress `json:",omitempty"`
 }
 
-// loadbalancerMonitorInterfaceResponseEnvelope is envelop of API response
-type loadbalancerMonitorInterfaceResponseEnvelope struct {
+// ipaddressUpdateHostNameResponseEnvelope is envelop of API response
+type ipaddressUpdateHostNameResponseEnvelope struct {
 	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
 	Success types.APIResult `json:",omitempty"`      // success項目
 
-	Data *naked.MonitorValues `json:",omitempty"`
-}
-
-// loadbalancerStatusResponseEnvelope is envelop of API response
-type loadbalancerStatusResponseEnvelope struct {
-	Total int `json:",omitempty"` // トータル件数
-	From  int `json:",omitempty"` // ページング開始ページ
-	Count int `json:",omitempty"` // 件数
-
-	LoadBalancer []*naked.LoadBalancerStatus `json:",omitempty"`
+	IPAddress *naked.IPAddress `json:",omitempty"`
 }
 
-// nfsFindRequestEnvelope is envelop of API request
-type nfsFindRequestEnvelope struct {
+// ipv6addrFindRequestEnvelope is envelop of API request
+type ipv6addrFindRequestEnvelope struct {
 	Count   int                    `json:",omitempty"`
 	From    int                    `json:",omitempty"`
 	Sort    []string               `json:",omitempty"`
@@ -680,84 +1126,78 @@ type nfsFindRequestEnvelope struct {
 	Exclude []string               `json:",omitempty"`
 }
 
-// nfsFindResponseEnvelope is envelop of API response
-type nfsFindResponseEnvelope struct {
+// ipv6addrFindResponseEnvelope is envelop of API response
+type ipv6addrFindResponseEnvelope struct {
 	Total int `json:",omitempty"` // トータル件数
 	From  int `json:",omitempty"` // ページング開始ページ
 	Count int `json:",omitempty"` // 件数
 
-	Appliances []*naked.NFS `json:",omitempty"`
+	IPv6Addrs []*naked.IPv6Addr `json:",omitempty"`
 }
 
-// nfsCreateRequestEnvelope is envelop of API request
-type nfsCreateRequestEnvelope struct {
-	Appliance *naked.NFS `json:",omitempty"`
+// ipv6addrCreateRequestEnvelope is envelop of API request
+type ipv6addrCreateRequestEnvelope struct {
+	IPv6Addr *naked.IPv6Addr `json:",omitempty"`
 }
 
-// nfsCreateResponseEnvelope is envelop of API response
-type nfsCreateResponseEnvelope struct {
+// ipv6addrCreateResponseEnvelope is envelop of API response
+type ipv6addrCreateResponseEnvelope struct {
 	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
 	Success types.APIResult `json:",omitempty"`      // success項目
 
-	Appliance *naked.NFS `json:",omitempty"`
+	IPv6Addr *naked.IPv6Addr `json:",omitempty"`
 }
 
-// nfsReadResponseEnvelope is envelop of API response
-type nfsReadResponseEnvelope struct {
+// ipv6addrReadResponseEnvelope is envelop of API response
+type ipv6addrReadResponseEnvelope struct {
 	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
 	Success types.APIResult `json:",omitempty"`      // success項目
 
-	Appliance *naked.NFS `json:",omitempty"`
+	IPv6Addr *naked.IPv6Addr `json:",omitempty"`
 }
 
-// nfsUpdateRequestEnvelope is envelop of API request
-type nfsUpdateRequestEnvelope struct {
-	Appliance *naked.NFS `json:",omitempty"`
+// ipv6addrUpdateRequestEnvelope is envelop of API request
+type ipv6addrUpdateRequestEnvelope struct {
+	IPv6Addr *naked.IPv6Addr `json:",omitempty"`
 }
 
-// nfsUpdateResponseEnvelope is envelop of API response
-type nfsUpdateResponseEnvelope struct {
+// ipv6addrUpdateResponseEnvelope is envelop of API response
+type ipv6addrUpdateResponseEnvelope struct {
 	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
 	Success types.APIResult `json:",omitempty"`      // success項目
 
-	Appliance *naked.NFS `json:",omitempty"`
-}
-
-// nfsShutdownRequestEnvelope is envelop of API request
-type nfsShutdownRequestEnvelope struct {
-	Force bool `json:",omitempty"`
+	IPv6Addr *naked.IPv6Addr `json:",omitempty"`
 }
 
-// nfsMonitorFreeDiskSizeRequestEnvelope is envelop of API request
-type nfsMonitorFreeDiskSizeRequestEnvelope struct {
-	Start time.Time `json:",omitempty"`
-	End   time.Time `json:",omitempty"`
+// ipv6netFindRequestEnvelope is envelop of API request
+type ipv6netFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
 }
 
-// nfsMonitorFreeDiskSizeResponseEnvelope is envelop of API response
-type nfsMonitorFreeDiskSizeResponseEnvelope struct {
-	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
-	Success types.APIResult `json:",omitempty"`      // success項目
-
-	Data *naked.MonitorValues `json:",omitempty"`
-}
+// ipv6netFindResponseEnvelope is envelop of API response
+type ipv6netFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
 
-// nfsMonitorInterfaceRequestEnvelope is envelop of API request
-type nfsMonitorInterfaceRequestEnvelope struct {
-	Start time.Time `json:",omitempty"`
-	End   time.Time `json:",omitempty"`
+	IPv6Nets []*naked.IPv6Net `json:",omitempty"`
 }
 
-// nfsMonitorInterfaceResponseEnvelope is envelop of API response
-type nfsMonitorInterfaceResponseEnvelope struct {
+// ipv6netReadResponseEnvelope is envelop of API response
+type ipv6netReadResponseEnvelope struct {
 	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
 	Success types.APIResult `json:",omitempty"`      // success項目
 
-	Data *naked.MonitorValues `json:",omitempty"`
+	IPv6Net *naked.IPv6Net `json:",omitempty"`
 }
 
-// noteFindRequestEnvelope is envelop of API request
-type noteFindRequestEnvelope struct {
+// licenseFindRequestEnvelope is envelop of API request
+type licenseFindRequestEnvelope struct {
 	Count   int                    `json:",omitempty"`
 	From    int                    `json:",omitempty"`
 	Sort    []string               `json:",omitempty"`
@@ -766,51 +1206,51 @@ type noteFindRequestEnvelope struct {
 	Exclude []string               `json:",omitempty"`
 }
 
-// noteFindResponseEnvelope is envelop of API response
-type noteFindResponseEnvelope struct {
+// licenseFindResponseEnvelope is envelop of API response
+type licenseFindResponseEnvelope struct {
 	Total int `json:",omitempty"` // トータル件数
 	From  int `json:",omitempty"` // ページング開始ページ
 	Count int `json:",omitempty"` // 件数
 
-	Notes []*naked.Note `json:",omitempty"`
+	Licenses []*naked.License `json:",omitempty"`
 }
 
-// noteCreateRequestEnvelope is envelop of API request
-type noteCreateRequestEnvelope struct {
-	Note *naked.Note `json:",omitempty"`
+// licenseCreateRequestEnvelope is envelop of API request
+type licenseCreateRequestEnvelope struct {
+	License *naked.License `json:",omitempty"`
 }
 
-// noteCreateResponseEnvelope is envelop of API response
-type noteCreateResponseEnvelope struct {
+// licenseCreateResponseEnvelope is envelop of API response
+type licenseCreateResponseEnvelope struct {
 	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
 	Success types.APIResult `json:",omitempty"`      // success項目
 
-	Note *naked.Note `json:",omitempty"`
+	License *naked.License `json:",omitempty"`
 }
 
-// noteReadResponseEnvelope is envelop of API response
-type noteReadResponseEnvelope struct {
+// licenseReadResponseEnvelope is envelop of API response
+type licenseReadResponseEnvelope struct {
 	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
 	Success types.APIResult `json:",omitempty"`      // success項目
 
-	Note *naked.Note `json:",omitempty"`
+	License *naked.License `json:",omitempty"`
 }
 
-// noteUpdateRequestEnvelope is envelop of API request
-type noteUpdateRequestEnvelope struct {
-	Note *naked.Note `json:",omitempty"`
+// licenseUpdateRequestEnvelope is envelop of API request
+type licenseUpdateRequestEnvelope struct {
+	License *naked.License `json:",omitempty"`
 }
 
-// noteUpdateResponseEnvelope is envelop of API response
-type noteUpdateResponseEnvelope struct {
+// licenseUpdateResponseEnvelope is envelop of API response
+type licenseUpdateResponseEnvelope struct {
 	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
 	Success types.APIResult `json:",omitempty"`      // success項目
 
-	Note *naked.Note `json:",omitempty"`
+	License *naked.License `json:",omitempty"`
 }
 
-// packetfilterFindRequestEnvelope is envelop of API request
-type packetfilterFindRequestEnvelope struct {
+// licenseplanFindRequestEnvelope is envelop of API request
+type licenseplanFindRequestEnvelope struct {
 	Count   int                    `json:",omitempty"`
 	From    int                    `json:",omitempty"`
 	Sort    []string               `json:",omitempty"`
@@ -819,51 +1259,25 @@ type packetfilterFindRequestEnvelope struct {
 	Exclude []string               `json:",omitempty"`
 }
 
-// packetfilterFindResponseEnvelope is envelop of API response
-type packetfilterFindResponseEnvelope struct {
+// licenseplanFindResponseEnvelope is envelop of API response
+type licenseplanFindResponseEnvelope struct {
 	Total int `json:",omitempty"` // トータル件数
 	From  int `json:",omitempty"` // ページング開始ページ
 	Count int `json:",omitempty"` // 件数
 
-	PacketFilters []*naked.PacketFilter `json:",omitempty"`
-}
-
-// packetfilterCreateRequestEnvelope is envelop of API request
-type packetfilterCreateRequestEnvelope struct {
-	PacketFilter *naked.PacketFilter `json:",omitempty"`
-}
-
-// packetfilterCreateResponseEnvelope is envelop of API response
-type packetfilterCreateResponseEnvelope struct {
-	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
-	Success types.APIResult `json:",omitempty"`      // success項目
-
-	PacketFilter *naked.PacketFilter `json:",omitempty"`
-}
-
-// packetfilterReadResponseEnvelope is envelop of API response
-type packetfilterReadResponseEnvelope struct {
-	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
-	Success types.APIResult `json:",omitempty"`      // success項目
-
-	PacketFilter *naked.PacketFilter `json:",omitempty"`
-}
-
-// packetfilterUpdateRequestEnvelope is envelop of API request
-type packetfilterUpdateRequestEnvelope struct {
-	PacketFilter *naked.PacketFilter `json:",omitempty"`
+	LicensePlans []*naked.LicensePlan `json:",omitempty"`
 }
 
-// packetfilterUpdateResponseEnvelope is envelop of API response
-type packetfilterUpdateResponseEnvelope struct {
+// licenseplanReadResponseEnvelope is envelop of API response
+type licenseplanReadResponseEnvelope struct {
 	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
 	Success types.APIResult `json:",omitempty"`      // success項目
 
-	PacketFilter *naked.PacketFilter `json:",omitempty"`
+	LicensePlan *naked.LicensePlan `json:",omitempty"`
 }
 
-// serverFindRequestEnvelope is envelop of API request
-type serverFindRequestEnvelope struct {
+// loadbalancerFindRequestEnvelope is envelop of API request
+type loadbalancerFindRequestEnvelope struct {
 	Count   int                    `json:",omitempty"`
 	From    int                    `json:",omitempty"`
 	Sort    []string               `json:",omitempty"`
@@ -872,56 +1286,666 @@ type serverFindRequestEnvelope struct {
 	Exclude []string               `json:",omitempty"`
 }
 
-// serverFindResponseEnvelope is envelop of API response
-type serverFindResponseEnvelope struct {
+// loadbalancerFindResponseEnvelope is envelop of API response
+type loadbalancerFindResponseEnvelope struct {
 	Total int `json:",omitempty"` // トータル件数
 	From  int `json:",omitempty"` // ページング開始ページ
 	Count int `json:",omitempty"` // 件数
 
-	Servers []*naked.Server `json:",omitempty"`
+	Appliances []*naked.LoadBalancer `json:",omitempty"`
 }
 
-// serverCreateRequestEnvelope is envelop of API request
-type serverCreateRequestEnvelope struct {
-	Server *naked.Server `json:",omitempty"`
+// loadbalancerCreateRequestEnvelope is envelop of API request
+type loadbalancerCreateRequestEnvelope struct {
+	Appliance *naked.LoadBalancer `json:",omitempty"`
 }
 
-// serverCreateResponseEnvelope is envelop of API response
-type serverCreateResponseEnvelope struct {
+// loadbalancerCreateResponseEnvelope is envelop of API response
+type loadbalancerCreateResponseEnvelope struct {
 	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
 	Success types.APIResult `json:",omitempty"`      // success項目
 
-	Server *naked.Server `json:",omitempty"`
+	Appliance *naked.LoadBalancer `json:",omitempty"`
 }
 
-// serverReadResponseEnvelope is envelop of API response
-type serverReadResponseEnvelope struct {
+// loadbalancerReadResponseEnvelope is envelop of API response
+type loadbalancerReadResponseEnvelope struct {
 	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
 	Success types.APIResult `json:",omitempty"`      // success項目
 
-	Server *naked.Server `json:",omitempty"`
+	Appliance *naked.LoadBalancer `json:",omitempty"`
 }
 
-// serverUpdateRequestEnvelope is envelop of API request
-type serverUpdateRequestEnvelope struct {
-	Server *naked.Server `json:",omitempty"`
+// loadbalancerUpdateRequestEnvelope is envelop of API request
+type loadbalancerUpdateRequestEnvelope struct {
+	Appliance *naked.LoadBalancer `json:",omitempty"`
 }
 
-// serverUpdateResponseEnvelope is envelop of API response
-type serverUpdateResponseEnvelope struct {
+// loadbalancerUpdateResponseEnvelope is envelop of API response
+type loadbalancerUpdateResponseEnvelope struct {
 	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
 	Success types.APIResult `json:",omitempty"`      // success項目
 
-	Server *naked.Server `json:",omitempty"`
+	Appliance *naked.LoadBalancer `json:",omitempty"`
 }
 
-// serverChangePlanRequestEnvelope is envelop of API request
-type serverChangePlanRequestEnvelope struct {
-	CPU                  int                   `json:",omitempty"`
-	MemoryMB             int                   `json:",omitempty"`
-	ServerPlanGeneration types.EPlanGeneration `json:",omitempty"`
-	ServerPlanCommitment types.ECommitment     `json:",omitempty"`
-}
+// loadbalancerShutdownRequestEnvelope is envelop of API request
+type loadbalancerShutdownRequestEnvelope struct {
+	Force bool `json:",omitempty"`
+}
+
+// loadbalancerMonitorInterfaceRequestEnvelope is envelop of API request
+type loadbalancerMonitorInterfaceRequestEnvelope struct {
+	Start time.Time `json:",omitempty"`
+	End   time.Time `json:",omitempty"`
+}
+
+// loadbalancerMonitorInterfaceResponseEnvelope is envelop of API response
+type loadbalancerMonitorInterfaceResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Data *naked.MonitorValues `json:",omitempty"`
+}
+
+// loadbalancerStatusResponseEnvelope is envelop of API response
+type loadbalancerStatusResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	LoadBalancer []*naked.LoadBalancerStatus `json:",omitempty"`
+}
+
+// mobilegatewayFindRequestEnvelope is envelop of API request
+type mobilegatewayFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
+}
+
+// mobilegatewayFindResponseEnvelope is envelop of API response
+type mobilegatewayFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	Appliances []*naked.MobileGateway `json:",omitempty"`
+}
+
+// mobilegatewayCreateRequestEnvelope is envelop of API request
+type mobilegatewayCreateRequestEnvelope struct {
+	Appliance *naked.MobileGateway `json:",omitempty"`
+}
+
+// mobilegatewayCreateResponseEnvelope is envelop of API response
+type mobilegatewayCreateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Appliance *naked.MobileGateway `json:",omitempty"`
+}
+
+// mobilegatewayReadResponseEnvelope is envelop of API response
+type mobilegatewayReadResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Appliance *naked.MobileGateway `json:",omitempty"`
+}
+
+// mobilegatewayUpdateRequestEnvelope is envelop of API request
+type mobilegatewayUpdateRequestEnvelope struct {
+	Appliance *naked.MobileGateway `json:",omitempty"`
+}
+
+// mobilegatewayUpdateResponseEnvelope is envelop of API response
+type mobilegatewayUpdateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Appliance *naked.MobileGateway `json:",omitempty"`
+}
+
+// mobilegatewayShutdownRequestEnvelope is envelop of API request
+type mobilegatewayShutdownRequestEnvelope struct {
+	Force bool `json:",omitempty"`
+}
+
+// mobilegatewayGetDNSResponseEnvelope is envelop of API response
+type mobilegatewayGetDNSResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	SIMGroup *naked.MobileGatewaySIMGroup `json:"sim_group,omitempty"`
+}
+
+// mobilegatewaySetDNSRequestEnvelope is envelop of API request
+type mobilegatewaySetDNSRequestEnvelope struct {
+	SIMGroup *naked.MobileGatewaySIMGroup `json:"sim_group,omitempty"`
+}
+
+// mobilegatewayListSIMResponseEnvelope is envelop of API response
+type mobilegatewayListSIMResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	SIM []*naked.SIMInfo `json:"sim,omitempty"`
+}
+
+// mobilegatewayAddSIMRequestEnvelope is envelop of API request
+type mobilegatewayAddSIMRequestEnvelope struct {
+	SIM *naked.SIMInfo `json:"sim,omitempty"`
+}
+
+// mobilegatewayGetSIMRoutesResponseEnvelope is envelop of API response
+type mobilegatewayGetSIMRoutesResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	SIMRoutes []*naked.MobileGatewaySIMRoute `json:"sim_routes,omitempty"`
+}
+
+// mobilegatewaySetSIMRoutesRequestEnvelope is envelop of API request
+type mobilegatewaySetSIMRoutesRequestEnvelope struct {
+	SIMRoutes []*naked.MobileGatewaySIMRoute `json:"sim_routes"`
+}
+
+// mobilegatewayGetTrafficConfigResponseEnvelope is envelop of API response
+type mobilegatewayGetTrafficConfigResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	TrafficMonitoring *naked.TrafficMonitoringConfig `json:"traffic_monitoring_config,omitempty"`
+}
+
+// mobilegatewaySetTrafficConfigRequestEnvelope is envelop of API request
+type mobilegatewaySetTrafficConfigRequestEnvelope struct {
+	TrafficMonitoring *naked.TrafficMonitoringConfig `json:"traffic_monitoring_config,omitempty"`
+}
+
+// mobilegatewayTrafficStatusResponseEnvelope is envelop of API response
+type mobilegatewayTrafficStatusResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	TrafficStatus *naked.TrafficStatus `json:"traffic_status,omitempty"`
+}
+
+// mobilegatewayMonitorInterfaceRequestEnvelope is envelop of API request
+type mobilegatewayMonitorInterfaceRequestEnvelope struct {
+	Start time.Time `json:",omitempty"`
+	End   time.Time `json:",omitempty"`
+}
+
+// mobilegatewayMonitorInterfaceResponseEnvelope is envelop of API response
+type mobilegatewayMonitorInterfaceResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Data *naked.MonitorValues `json:",omitempty"`
+}
+
+// nfsFindRequestEnvelope is envelop of API request
+type nfsFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
+}
+
+// nfsFindResponseEnvelope is envelop of API response
+type nfsFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	Appliances []*naked.NFS `json:",omitempty"`
+}
+
+// nfsCreateRequestEnvelope is envelop of API request
+type nfsCreateRequestEnvelope struct {
+	Appliance *naked.NFS `json:",omitempty"`
+}
+
+// nfsCreateResponseEnvelope is envelop of API response
+type nfsCreateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Appliance *naked.NFS `json:",omitempty"`
+}
+
+// nfsReadResponseEnvelope is envelop of API response
+type nfsReadResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Appliance *naked.NFS `json:",omitempty"`
+}
+
+// nfsUpdateRequestEnvelope is envelop of API request
+type nfsUpdateRequestEnvelope struct {
+	Appliance *naked.NFS `json:",omitempty"`
+}
+
+// nfsUpdateResponseEnvelope is envelop of API response
+type nfsUpdateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Appliance *naked.NFS `json:",omitempty"`
+}
+
+// nfsShutdownRequestEnvelope is envelop of API request
+type nfsShutdownRequestEnvelope struct {
+	Force bool `json:",omitempty"`
+}
+
+// nfsMonitorFreeDiskSizeRequestEnvelope is envelop of API request
+type nfsMonitorFreeDiskSizeRequestEnvelope struct {
+	Start time.Time `json:",omitempty"`
+	End   time.Time `json:",omitempty"`
+}
+
+// nfsMonitorFreeDiskSizeResponseEnvelope is envelop of API response
+type nfsMonitorFreeDiskSizeResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Data *naked.MonitorValues `json:",omitempty"`
+}
+
+// nfsMonitorInterfaceRequestEnvelope is envelop of API request
+type nfsMonitorInterfaceRequestEnvelope struct {
+	Start time.Time `json:",omitempty"`
+	End   time.Time `json:",omitempty"`
+}
+
+// nfsMonitorInterfaceResponseEnvelope is envelop of API response
+type nfsMonitorInterfaceResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Data *naked.MonitorValues `json:",omitempty"`
+}
+
+// noteFindRequestEnvelope is envelop of API request
+type noteFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
+}
+
+// noteFindResponseEnvelope is envelop of API response
+type noteFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	Notes []*naked.Note `json:",omitempty"`
+}
+
+// noteCreateRequestEnvelope is envelop of API request
+type noteCreateRequestEnvelope struct {
+	Note *naked.Note `json:",omitempty"`
+}
+
+// noteCreateResponseEnvelope is envelop of API response
+type noteCreateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Note *naked.Note `json:",omitempty"`
+}
+
+// noteReadResponseEnvelope is envelop of API response
+type noteReadResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Note *naked.Note `json:",omitempty"`
+}
+
+// noteUpdateRequestEnvelope is envelop of API request
+type noteUpdateRequestEnvelope struct {
+	Note *naked.Note `json:",omitempty"`
+}
+
+// noteUpdateResponseEnvelope is envelop of API response
+type noteUpdateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Note *naked.Note `json:",omitempty"`
+}
+
+// packetfilterFindRequestEnvelope is envelop of API request
+type packetfilterFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
+}
+
+// packetfilterFindResponseEnvelope is envelop of API response
+type packetfilterFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	PacketFilters []*naked.PacketFilter `json:",omitempty"`
+}
+
+// packetfilterCreateRequestEnvelope is envelop of API request
+type packetfilterCreateRequestEnvelope struct {
+	PacketFilter *naked.PacketFilter `json:",omitempty"`
+}
+
+// packetfilterCreateResponseEnvelope is envelop of API response
+type packetfilterCreateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	PacketFilter *naked.PacketFilter `json:",omitempty"`
+}
+
+// packetfilterReadResponseEnvelope is envelop of API response
+type packetfilterReadResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	PacketFilter *naked.PacketFilter `json:",omitempty"`
+}
+
+// packetfilterUpdateRequestEnvelope is envelop of API request
+type packetfilterUpdateRequestEnvelope struct {
+	PacketFilter *naked.PacketFilter `json:",omitempty"`
+}
+
+// packetfilterUpdateResponseEnvelope is envelop of API response
+type packetfilterUpdateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	PacketFilter *naked.PacketFilter `json:",omitempty"`
+}
+
+// privatehostFindRequestEnvelope is envelop of API request
+type privatehostFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
+}
+
+// privatehostFindResponseEnvelope is envelop of API response
+type privatehostFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	PrivateHosts []*naked.PrivateHost `json:",omitempty"`
+}
+
+// privatehostCreateRequestEnvelope is envelop of API request
+type privatehostCreateRequestEnvelope struct {
+	PrivateHost *naked.PrivateHost `json:",omitempty"`
+}
+
+// privatehostCreateResponseEnvelope is envelop of API response
+type privatehostCreateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	PrivateHost *naked.PrivateHost `json:",omitempty"`
+}
+
+// privatehostReadResponseEnvelope is envelop of API response
+type privatehostReadResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	PrivateHost *naked.PrivateHost `json:",omitempty"`
+}
+
+// privatehostUpdateRequestEnvelope is envelop of API request
+type privatehostUpdateRequestEnvelope struct {
+	PrivateHost *naked.PrivateHost `json:",omitempty"`
+}
+
+// privatehostUpdateResponseEnvelope is envelop of API response
+type privatehostUpdateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	PrivateHost *naked.PrivateHost `json:",omitempty"`
+}
+
+// privatehostplanFindRequestEnvelope is envelop of API request
+type privatehostplanFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
+}
+
+// privatehostplanFindResponseEnvelope is envelop of API response
+type privatehostplanFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	PrivateHostPlans []*naked.PrivateHostPlan `json:",omitempty"`
+}
+
+// privatehostplanReadResponseEnvelope is envelop of API response
+type privatehostplanReadResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	PrivateHostPlan *naked.PrivateHostPlan `json:",omitempty"`
+}
+
+// proxylbFindRequestEnvelope is envelop of API request
+type proxylbFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
+}
+
+// proxylbFindResponseEnvelope is envelop of API response
+type proxylbFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	CommonServiceItems []*naked.ProxyLB `json:",omitempty"`
+}
+
+// proxylbCreateRequestEnvelope is envelop of API request
+type proxylbCreateRequestEnvelope struct {
+	CommonServiceItem *naked.ProxyLB `json:",omitempty"`
+}
+
+// proxylbCreateResponseEnvelope is envelop of API response
+type proxylbCreateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	CommonServiceItem *naked.ProxyLB `json:",omitempty"`
+}
+
+// proxylbReadResponseEnvelope is envelop of API response
+type proxylbReadResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	CommonServiceItem *naked.ProxyLB `json:",omitempty"`
+}
+
+// proxylbUpdateRequestEnvelope is envelop of API request
+type proxylbUpdateRequestEnvelope struct {
+	CommonServiceItem *naked.ProxyLB `json:",omitempty"`
+}
+
+// proxylbUpdateResponseEnvelope is envelop of API response
+type proxylbUpdateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	CommonServiceItem *naked.ProxyLB `json:",omitempty"`
+}
+
+// proxylbGetCertificatesResponseEnvelope is envelop of API response
+type proxylbGetCertificatesResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	ProxyLB *naked.ProxyLBCertificates `json:",omitempty"`
+}
+
+// proxylbSetCertificatesRequestEnvelope is envelop of API request
+type proxylbSetCertificatesRequestEnvelope struct {
+	ProxyLB *naked.ProxyLBCertificates `json:",omitempty"`
+}
+
+// proxylbSetCertificatesResponseEnvelope is envelop of API response
+type proxylbSetCertificatesResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	ProxyLB *naked.ProxyLBCertificates `json:",omitempty"`
+}
+
+// proxylbHealthStatusResponseEnvelope is envelop of API response
+type proxylbHealthStatusResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	ProxyLB *naked.ProxyLBHealth `json:",omitempty"`
+}
+
+// proxylbMonitorConnectionRequestEnvelope is envelop of API request
+type proxylbMonitorConnectionRequestEnvelope struct {
+	Start time.Time `json:",omitempty"`
+	End   time.Time `json:",omitempty"`
+}
+
+// proxylbMonitorConnectionResponseEnvelope is envelop of API response
+type proxylbMonitorConnectionResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Data *naked.MonitorValues `json:",omitempty"`
+}
+
+// regionFindRequestEnvelope is envelop of API request
+type regionFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
+}
+
+// regionFindResponseEnvelope is envelop of API response
+type regionFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	Regions []*naked.Region `json:",omitempty"`
+}
+
+// regionReadResponseEnvelope is envelop of API response
+type regionReadResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Region *naked.Region `json:",omitempty"`
+}
+
+// serverFindRequestEnvelope is envelop of API request
+type serverFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
+}
+
+// serverFindResponseEnvelope is envelop of API response
+type serverFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	Servers []*naked.Server `json:",omitempty"`
+}
+
+// serverCreateRequestEnvelope is envelop of API request
+type serverCreateRequestEnvelope struct {
+	Server *naked.Server `json:",omitempty"`
+}
+
+// serverCreateResponseEnvelope is envelop of API response
+type serverCreateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Server *naked.Server `json:",omitempty"`
+}
+
+// serverReadResponseEnvelope is envelop of API response
+type serverReadResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Server *naked.Server `json:",omitempty"`
+}
+
+// serverUpdateRequestEnvelope is envelop of API request
+type serverUpdateRequestEnvelope struct {
+	Server *naked.Server `json:",omitempty"`
+}
+
+// serverUpdateResponseEnvelope is envelop of API response
+type serverUpdateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Server *naked.Server `json:",omitempty"`
+}
+
+// serverChangePlanRequestEnvelope is envelop of API request
+type serverChangePlanRequestEnvelope struct {
+	CPU        int                   `json:",omitempty"`
+	MemoryMB   int                   `json:",omitempty"`
+	Generation types.EPlanGeneration `json:",omitempty"`
+	Commitment types.ECommitment     `json:",omitempty"`
+}
 
 // serverChangePlanResponseEnvelope is envelop of API response
 type serverChangePlanResponseEnvelope struct {
@@ -960,6 +1984,79 @@ type serverMonitorResponseEnvelope struct {
 	Data *naked.MonitorValues `json:",omitempty"`
 }
 
+// serverGetVNCProxyResponseEnvelope is envelop of API response
+type serverGetVNCProxyResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	*naked.VNCProxyInfo
+}
+
+// serverSendKeyRequestEnvelope is envelop of API request
+type serverSendKeyRequestEnvelope struct {
+	Key  string   `json:",omitempty"`
+	Keys []string `json:",omitempty"`
+}
+
+// serverGetScreenshotRequestEnvelope is envelop of API request
+type serverGetScreenshotRequestEnvelope struct {
+	ScreenCaptureFormat string `json:",omitempty"`
+}
+
+// serverGetScreenshotResponseEnvelope is envelop of API response
+type serverGetScreenshotResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	*naked.VNCSnapshotInfo
+}
+
+// serverplanFindRequestEnvelope is envelop of API request
+type serverplanFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
+}
+
+// serverplanFindResponseEnvelope is envelop of API response
+type serverplanFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	ServerPlans []*naked.ServerPlan `json:",omitempty"`
+}
+
+// serverplanReadResponseEnvelope is envelop of API response
+type serverplanReadResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	ServerPlan *naked.ServerPlan `json:",omitempty"`
+}
+
+// serviceclassFindRequestEnvelope is envelop of API request
+type serviceclassFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
+}
+
+// serviceclassFindResponseEnvelope is envelop of API response
+type serviceclassFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	ServiceClasss []*naked.ServiceClass `json:",omitempty"`
+}
+
 // simFindRequestEnvelope is envelop of API request
 type simFindRequestEnvelope struct {
 	Count   int                    `json:",omitempty"`
@@ -1060,6 +2157,201 @@ type simMonitorSIMResponseEnvelope struct {
 	Data *naked.MonitorValues `json:",omitempty"`
 }
 
+// simplemonitorFindRequestEnvelope is envelop of API request
+type simplemonitorFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
+}
+
+// simplemonitorFindResponseEnvelope is envelop of API response
+type simplemonitorFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	CommonServiceItems []*naked.SimpleMonitor `json:",omitempty"`
+}
+
+// simplemonitorCreateRequestEnvelope is envelop of API request
+type simplemonitorCreateRequestEnvelope struct {
+	CommonServiceItem *naked.SimpleMonitor `json:",omitempty"`
+}
+
+// simplemonitorCreateResponseEnvelope is envelop of API response
+type simplemonitorCreateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	CommonServiceItem *naked.SimpleMonitor `json:",omitempty"`
+}
+
+// simplemonitorReadResponseEnvelope is envelop of API response
+type simplemonitorReadResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	CommonServiceItem *naked.SimpleMonitor `json:",omitempty"`
+}
+
+// simplemonitorUpdateRequestEnvelope is envelop of API request
+type simplemonitorUpdateRequestEnvelope struct {
+	CommonServiceItem *naked.SimpleMonitor `json:",omitempty"`
+}
+
+// simplemonitorUpdateResponseEnvelope is envelop of API response
+type simplemonitorUpdateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	CommonServiceItem *naked.SimpleMonitor `json:",omitempty"`
+}
+
+// simplemonitorMonitorResponseTimeRequestEnvelope is envelop of API request
+type simplemonitorMonitorResponseTimeRequestEnvelope struct {
+	Start time.Time `json:",omitempty"`
+	End   time.Time `json:",omitempty"`
+}
+
+// simplemonitorMonitorResponseTimeResponseEnvelope is envelop of API response
+type simplemonitorMonitorResponseTimeResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Data *naked.MonitorValues `json:",omitempty"`
+}
+
+// simplemonitorHealthStatusResponseEnvelope is envelop of API response
+type simplemonitorHealthStatusResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	SimpleMonitor *naked.SimpleMonitorHealthStatus `json:",omitempty"`
+}
+
+// sshkeyFindRequestEnvelope is envelop of API request
+type sshkeyFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
+}
+
+// sshkeyFindResponseEnvelope is envelop of API response
+type sshkeyFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	SSHKeys []*naked.SSHKey `json:",omitempty"`
+}
+
+// sshkeyCreateRequestEnvelope is envelop of API request
+type sshkeyCreateRequestEnvelope struct {
+	SSHKey *naked.SSHKey `json:",omitempty"`
+}
+
+// sshkeyCreateResponseEnvelope is envelop of API response
+type sshkeyCreateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	SSHKey *naked.SSHKey `json:",omitempty"`
+}
+
+// sshkeyGenerateRequestEnvelope is envelop of API request
+type sshkeyGenerateRequestEnvelope struct {
+	KeyPair *naked.SSHKeyGenerateParam `json:",omitempty"`
+}
+
+// sshkeyGenerateResponseEnvelope is envelop of API response
+type sshkeyGenerateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	SSHKey *naked.SSHKeyGenerated `json:",omitempty"`
+}
+
+// sshkeyReadResponseEnvelope is envelop of API response
+type sshkeyReadResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	SSHKey *naked.SSHKey `json:",omitempty"`
+}
+
+// sshkeyUpdateRequestEnvelope is envelop of API request
+type sshkeyUpdateRequestEnvelope struct {
+	SSHKey *naked.SSHKey `json:",omitempty"`
+}
+
+// sshkeyUpdateResponseEnvelope is envelop of API response
+type sshkeyUpdateResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	SSHKey *naked.SSHKey `json:",omitempty"`
+}
+
+// storageFindRequestEnvelope is envelop of API request
+type storageFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
+}
+
+// storageFindResponseEnvelope is envelop of API response
+type storageFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	Storages []*naked.Storage `json:",omitempty"`
+}
+
+// storageReadResponseEnvelope is envelop of API response
+type storageReadResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Storage *naked.Storage `json:",omitempty"`
+}
+
+// subnetFindRequestEnvelope is envelop of API request
+type subnetFindRequestEnvelope struct {
+	Count   int                    `json:",omitempty"`
+	From    int                    `json:",omitempty"`
+	Sort    []string               `json:",omitempty"`
+	Filter  map[string]interface{} `json:",omitempty"`
+	Include []string               `json:",omitempty"`
+	Exclude []string               `json:",omitempty"`
+}
+
+// subnetFindResponseEnvelope is envelop of API response
+type subnetFindResponseEnvelope struct {
+	Total int `json:",omitempty"` // トータル件数
+	From  int `json:",omitempty"` // ページング開始ページ
+	Count int `json:",omitempty"` // 件数
+
+	Subnets []*naked.Subnet `json:",omitempty"`
+}
+
+// subnetReadResponseEnvelope is envelop of API response
+type subnetReadResponseEnvelope struct {
+	IsOk    bool            `json:"is_ok,omitempty"` // is_ok項目
+	Success types.APIResult `json:",omitempty"`      // success項目
+
+	Subnet *naked.Subnet `json:",omitempty"`
+}
+
 // switchFindRequestEnvelope is envelop of API request
 type switchFindRequestEnvelope struct {
 	Count   int                    `json:",omitempty"`
@@ -414,10 +414,13 @@ func (o *BundleInfo) convertFrom(naked *naked.BundleInfo) error {
 
 // Storage represents API parameter/response structure
 type Storage struct {
-	ID         types.ID
-	Name       string `validate:"required"`
-	Class      string `json:",omitempty" mapconv:",omitempty"`
-	Generation int    `json:",omitempty" mapconv:",omitempty"`
+	ID          types.ID
+	Name        string   `validate:"required"`
+	Class       string   `json:",omitempty" mapconv:",omitempty"`
+	Generation  int      `json:",omitempty" mapconv:",omitempty"`
+	Description string   `json:",omitempty" mapconv:",omitempty"`
+	DiskPlanID  types.ID `json:",omitempty" mapconv:"DiskPlan.ID,omitempty"`
+	ZoneID      types.ID `json:",omitempty" mapconv:"Zone.ID,omitempty"`
 }
 
 // Validate validates by field tags
@@ -485,6 +488,36 @@ func (o *Storage) SetGeneration(v int) {
 	o.Generation = v
 }
 
+// GetDescription returns value of Description
+func (o *Storage) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *Storage) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetDiskPlanID returns value of DiskPlanID
+func (o *Storage) GetDiskPlanID() types.ID {
+	return o.DiskPlanID
+}
+
+// SetDiskPlanID sets value to DiskPlanID
+func (o *Storage) SetDiskPlanID(v types.ID) {
+	o.DiskPlanID = v
+}
+
+// GetZoneID returns value of ZoneID
+func (o *Storage) GetZoneID() types.ID {
+	return o.ZoneID
+}
+
+// SetZoneID sets value to ZoneID
+func (o *Storage) SetZoneID(v types.ID) {
+	o.ZoneID = v
+}
+
 // convertTo returns naked Storage
 func (o *Storage) convertTo() (*naked.Storage, error) {
 	dest := &naked.Storage{}
@@ -883,6 +916,155 @@ func (o *ArchiveCreateBlankRequest) convertFrom(naked *naked.Archive) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
+/*************************************************
+* ArchiveTransferRequest
+*************************************************/
+
+// ArchiveTransferRequest represents API parameter/response structure
+type ArchiveTransferRequest struct {
+	Name        string `validate:"required"`
+	Description string `validate:"min=0,max=512"`
+	Tags        []string
+	IconID      types.ID `mapconv:"Icon.ID"`
+}
+
+// Validate validates by field tags
+func (o *ArchiveTransferRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetName returns value of Name
+func (o *ArchiveTransferRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *ArchiveTransferRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *ArchiveTransferRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *ArchiveTransferRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *ArchiveTransferRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *ArchiveTransferRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *ArchiveTransferRequest) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *ArchiveTransferRequest) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// convertTo returns naked ArchiveTransferRequest
+func (o *ArchiveTransferRequest) convertTo() (*naked.Archive, error) {
+	dest := &naked.Archive{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked ArchiveTransferRequest
+func (o *ArchiveTransferRequest) convertFrom(naked *naked.Archive) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* ArchiveCreateFromSharedRequest
+*************************************************/
+
+// ArchiveCreateFromSharedRequest represents API parameter/response structure
+type ArchiveCreateFromSharedRequest struct {
+	Name            string `validate:"required"`
+	Description     string `validate:"min=0,max=512"`
+	Tags            []string
+	IconID          types.ID `mapconv:"Icon.ID"`
+	SourceSharedKey types.ArchiveShareKey
+}
+
+// Validate validates by field tags
+func (o *ArchiveCreateFromSharedRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetName returns value of Name
+func (o *ArchiveCreateFromSharedRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *ArchiveCreateFromSharedRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *ArchiveCreateFromSharedRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *ArchiveCreateFromSharedRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *ArchiveCreateFromSharedRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *ArchiveCreateFromSharedRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *ArchiveCreateFromSharedRequest) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *ArchiveCreateFromSharedRequest) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// GetSourceSharedKey returns value of SourceSharedKey
+func (o *ArchiveCreateFromSharedRequest) GetSourceSharedKey() types.ArchiveShareKey {
+	return o.SourceSharedKey
+}
+
+// SetSourceSharedKey sets value to SourceSharedKey
+func (o *ArchiveCreateFromSharedRequest) SetSourceSharedKey(v types.ArchiveShareKey) {
+	o.SourceSharedKey = v
+}
+
+// convertTo returns naked ArchiveCreateFromSharedRequest
+func (o *ArchiveCreateFromSharedRequest) convertTo() (*naked.Archive, error) {
+	dest := &naked.Archive{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked ArchiveCreateFromSharedRequest
+func (o *ArchiveCreateFromSharedRequest) convertFrom(naked *naked.Archive) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
 /*************************************************
 * ArchiveUpdateRequest
 *************************************************/
@@ -977,1800 +1159,1931 @@ func (o *OpenFTPRequest) SetChangePassword(v bool) {
 }
 
 /*************************************************
-* Bridge
+* ArchiveShareInfo
 *************************************************/
 
-// Bridge represents API parameter/response structure
-type Bridge struct {
-	ID           types.ID
-	Name         string `validate:"required"`
-	Description  string `validate:"min=0,max=512"`
-	CreatedAt    time.Time
-	Region       *Region       `json:",omitempty"`
-	BridgeInfo   []*BridgeInfo `mapconv:"[]Switches,recursive"`
-	SwitchInZone *BridgeSwitchInfo
+// ArchiveShareInfo represents API parameter/response structure
+type ArchiveShareInfo struct {
+	SharedKey types.ArchiveShareKey
 }
 
 // Validate validates by field tags
-func (o *Bridge) Validate() error {
+func (o *ArchiveShareInfo) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetID returns value of ID
-func (o *Bridge) GetID() types.ID {
-	return o.ID
+// GetSharedKey returns value of SharedKey
+func (o *ArchiveShareInfo) GetSharedKey() types.ArchiveShareKey {
+	return o.SharedKey
 }
 
-// SetID sets value to ID
-func (o *Bridge) SetID(v types.ID) {
-	o.ID = v
+// SetSharedKey sets value to SharedKey
+func (o *ArchiveShareInfo) SetSharedKey(v types.ArchiveShareKey) {
+	o.SharedKey = v
 }
 
-// GetStringID gets value to StringID
-func (o *Bridge) GetStringID() string {
-	return accessor.GetStringID(o)
+// convertTo returns naked ArchiveShareInfo
+func (o *ArchiveShareInfo) convertTo() (*naked.ArchiveShareInfo, error) {
+	dest := &naked.ArchiveShareInfo{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetStringID sets value to StringID
-func (o *Bridge) SetStringID(v string) {
-	accessor.SetStringID(o, v)
+// convertFrom parse values from naked ArchiveShareInfo
+func (o *ArchiveShareInfo) convertFrom(naked *naked.ArchiveShareInfo) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// GetInt64ID gets value to Int64ID
-func (o *Bridge) GetInt64ID() int64 {
-	return accessor.GetInt64ID(o)
+/*************************************************
+* ArchiveShareRequest
+*************************************************/
+
+// ArchiveShareRequest represents API parameter/response structure
+type ArchiveShareRequest struct {
+	Shared bool `json:"Shared"`
 }
 
-// SetInt64ID sets value to Int64ID
-func (o *Bridge) SetInt64ID(v int64) {
-	accessor.SetInt64ID(o, v)
+// Validate validates by field tags
+func (o *ArchiveShareRequest) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetName returns value of Name
-func (o *Bridge) GetName() string {
-	return o.Name
+// GetShared returns value of Shared
+func (o *ArchiveShareRequest) GetShared() bool {
+	return o.Shared
 }
 
-// SetName sets value to Name
-func (o *Bridge) SetName(v string) {
-	o.Name = v
+// SetShared sets value to Shared
+func (o *ArchiveShareRequest) SetShared(v bool) {
+	o.Shared = v
 }
 
-// GetDescription returns value of Description
-func (o *Bridge) GetDescription() string {
-	return o.Description
+/*************************************************
+* AuthStatus
+*************************************************/
+
+// AuthStatus represents API parameter/response structure
+type AuthStatus struct {
+	AccountID          types.ID `mapconv:"Account.ID"`
+	AccountName        string   `mapconv:"Account.Name"`
+	AccountCode        string   `mapconv:"Account.Code"`
+	AccountClass       string   `mapconv:"Account.Class"`
+	MemberCode         string   `mapconv:"Member.Code"`
+	MemberClass        string   `mapconv:"Member.Class"`
+	AuthClass          string
+	AuthMethod         string
+	IsAPIKey           bool
+	ExternalPermission types.ExternalPermission
+	OperationPenalty   string
+	Permission         types.EPermission
 }
 
-// SetDescription sets value to Description
-func (o *Bridge) SetDescription(v string) {
-	o.Description = v
+// Validate validates by field tags
+func (o *AuthStatus) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetCreatedAt returns value of CreatedAt
-func (o *Bridge) GetCreatedAt() time.Time {
-	return o.CreatedAt
+// GetAccountID returns value of AccountID
+func (o *AuthStatus) GetAccountID() types.ID {
+	return o.AccountID
 }
 
-// SetCreatedAt sets value to CreatedAt
-func (o *Bridge) SetCreatedAt(v time.Time) {
-	o.CreatedAt = v
+// SetAccountID sets value to AccountID
+func (o *AuthStatus) SetAccountID(v types.ID) {
+	o.AccountID = v
 }
 
-// GetRegion returns value of Region
-func (o *Bridge) GetRegion() *Region {
-	return o.Region
+// GetAccountName returns value of AccountName
+func (o *AuthStatus) GetAccountName() string {
+	return o.AccountName
 }
 
-// SetRegion sets value to Region
-func (o *Bridge) SetRegion(v *Region) {
-	o.Region = v
+// SetAccountName sets value to AccountName
+func (o *AuthStatus) SetAccountName(v string) {
+	o.AccountName = v
 }
 
-// GetBridgeInfo returns value of BridgeInfo
-func (o *Bridge) GetBridgeInfo() []*BridgeInfo {
-	return o.BridgeInfo
+// GetAccountCode returns value of AccountCode
+func (o *AuthStatus) GetAccountCode() string {
+	return o.AccountCode
 }
 
-// SetBridgeInfo sets value to BridgeInfo
-func (o *Bridge) SetBridgeInfo(v []*BridgeInfo) {
-	o.BridgeInfo = v
+// SetAccountCode sets value to AccountCode
+func (o *AuthStatus) SetAccountCode(v string) {
+	o.AccountCode = v
 }
 
-// GetSwitchInZone returns value of SwitchInZone
-func (o *Bridge) GetSwitchInZone() *BridgeSwitchInfo {
-	return o.SwitchInZone
+// GetAccountClass returns value of AccountClass
+func (o *AuthStatus) GetAccountClass() string {
+	return o.AccountClass
 }
 
-// SetSwitchInZone sets value to SwitchInZone
-func (o *Bridge) SetSwitchInZone(v *BridgeSwitchInfo) {
-	o.SwitchInZone = v
+// SetAccountClass sets value to AccountClass
+func (o *AuthStatus) SetAccountClass(v string) {
+	o.AccountClass = v
 }
 
-// convertTo returns naked Bridge
-func (o *Bridge) convertTo() (*naked.Bridge, error) {
-	dest := &naked.Bridge{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetMemberCode returns value of MemberCode
+func (o *AuthStatus) GetMemberCode() string {
+	return o.MemberCode
 }
 
-// convertFrom parse values from naked Bridge
-func (o *Bridge) convertFrom(naked *naked.Bridge) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetMemberCode sets value to MemberCode
+func (o *AuthStatus) SetMemberCode(v string) {
+	o.MemberCode = v
 }
 
-/*************************************************
-* Region
-*************************************************/
+// GetMemberClass returns value of MemberClass
+func (o *AuthStatus) GetMemberClass() string {
+	return o.MemberClass
+}
 
-// Region represents API parameter/response structure
-type Region struct {
-	ID          types.ID
-	Name        string   `validate:"required"`
-	Description string   `validate:"min=0,max=512"`
-	NameServers []string `json:",omitempty" mapconv:",omitempty"`
+// SetMemberClass sets value to MemberClass
+func (o *AuthStatus) SetMemberClass(v string) {
+	o.MemberClass = v
 }
 
-// Validate validates by field tags
-func (o *Region) Validate() error {
-	return validator.New().Struct(o)
+// GetAuthClass returns value of AuthClass
+func (o *AuthStatus) GetAuthClass() string {
+	return o.AuthClass
 }
 
-// GetID returns value of ID
-func (o *Region) GetID() types.ID {
-	return o.ID
+// SetAuthClass sets value to AuthClass
+func (o *AuthStatus) SetAuthClass(v string) {
+	o.AuthClass = v
 }
 
-// SetID sets value to ID
-func (o *Region) SetID(v types.ID) {
-	o.ID = v
+// GetAuthMethod returns value of AuthMethod
+func (o *AuthStatus) GetAuthMethod() string {
+	return o.AuthMethod
 }
 
-// GetStringID gets value to StringID
-func (o *Region) GetStringID() string {
-	return accessor.GetStringID(o)
+// SetAuthMethod sets value to AuthMethod
+func (o *AuthStatus) SetAuthMethod(v string) {
+	o.AuthMethod = v
 }
 
-// SetStringID sets value to StringID
-func (o *Region) SetStringID(v string) {
-	accessor.SetStringID(o, v)
+// GetIsAPIKey returns value of IsAPIKey
+func (o *AuthStatus) GetIsAPIKey() bool {
+	return o.IsAPIKey
 }
 
-// GetInt64ID gets value to Int64ID
-func (o *Region) GetInt64ID() int64 {
-	return accessor.GetInt64ID(o)
+// SetIsAPIKey sets value to IsAPIKey
+func (o *AuthStatus) SetIsAPIKey(v bool) {
+	o.IsAPIKey = v
 }
 
-// SetInt64ID sets value to Int64ID
-func (o *Region) SetInt64ID(v int64) {
-	accessor.SetInt64ID(o, v)
-}
-
-// GetName returns value of Name
-func (o *Region) GetName() string {
-	return o.Name
+// GetExternalPermission returns value of ExternalPermission
+func (o *AuthStatus) GetExternalPermission() types.ExternalPermission {
+	return o.ExternalPermission
 }
 
-// SetName sets value to Name
-func (o *Region) SetName(v string) {
-	o.Name = v
+// SetExternalPermission sets value to ExternalPermission
+func (o *AuthStatus) SetExternalPermission(v types.ExternalPermission) {
+	o.ExternalPermission = v
 }
 
-// GetDescription returns value of Description
-func (o *Region) GetDescription() string {
-	return o.Description
+// GetOperationPenalty returns value of OperationPenalty
+func (o *AuthStatus) GetOperationPenalty() string {
+	return o.OperationPenalty
 }
 
-// SetDescription sets value to Description
-func (o *Region) SetDescription(v string) {
-	o.Description = v
+// SetOperationPenalty sets value to OperationPenalty
+func (o *AuthStatus) SetOperationPenalty(v string) {
+	o.OperationPenalty = v
 }
 
-// GetNameServers returns value of NameServers
-func (o *Region) GetNameServers() []string {
-	return o.NameServers
+// GetPermission returns value of Permission
+func (o *AuthStatus) GetPermission() types.EPermission {
+	return o.Permission
 }
 
-// SetNameServers sets value to NameServers
-func (o *Region) SetNameServers(v []string) {
-	o.NameServers = v
+// SetPermission sets value to Permission
+func (o *AuthStatus) SetPermission(v types.EPermission) {
+	o.Permission = v
 }
 
-// convertTo returns naked Region
-func (o *Region) convertTo() (*naked.Region, error) {
-	dest := &naked.Region{}
+// convertTo returns naked AuthStatus
+func (o *AuthStatus) convertTo() (*naked.AuthStatus, error) {
+	dest := &naked.AuthStatus{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked Region
-func (o *Region) convertFrom(naked *naked.Region) error {
+// convertFrom parse values from naked AuthStatus
+func (o *AuthStatus) convertFrom(naked *naked.AuthStatus) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* BridgeInfo
+* AutoBackup
 *************************************************/
 
-// BridgeInfo represents API parameter/response structure
-type BridgeInfo struct {
-	ID     types.ID
-	Name   string   `validate:"required"`
-	ZoneID types.ID `mapconv:"Zone.ID"`
+// AutoBackup represents API parameter/response structure
+type AutoBackup struct {
+	ID                      types.ID
+	Name                    string `validate:"required"`
+	Description             string `validate:"min=0,max=512"`
+	Tags                    []string
+	Availability            types.EAvailability
+	IconID                  types.ID `mapconv:"Icon.ID"`
+	CreatedAt               time.Time
+	ModifiedAt              time.Time
+	Class                   string                     `mapconv:"Provider.Class,default=autobackup"`
+	BackupSpanType          types.EBackupSpanType      `mapconv:"Settings.Autobackup.BackupSpanType,default=weekdays"`
+	BackupSpanWeekdays      []types.EBackupSpanWeekday `mapconv:"Settings.Autobackup.BackupSpanWeekdays" validate:"required,min=1,max=7,dive,oneof=sun mon tue wed thu fri sat"`
+	MaximumNumberOfArchives int                        `mapconv:"Settings.Autobackup.MaximumNumberOfArchives" validate:"min=1,max=10"`
+	SettingsHash            string
+	DiskID                  types.ID `mapconv:"Status.DiskId" validate:"required"`
+	AccountID               types.ID `mapconv:"Status.AccountId"`
+	ZoneID                  types.ID `mapconv:"Status.ZoneId"`
+	ZoneName                string   `mapconv:"Status.ZoneName"`
 }
 
 // Validate validates by field tags
-func (o *BridgeInfo) Validate() error {
+func (o *AutoBackup) Validate() error {
 	return validator.New().Struct(o)
 }
 
 // GetID returns value of ID
-func (o *BridgeInfo) GetID() types.ID {
+func (o *AutoBackup) GetID() types.ID {
 	return o.ID
 }
 
 // SetID sets value to ID
-func (o *BridgeInfo) SetID(v types.ID) {
+func (o *AutoBackup) SetID(v types.ID) {
 	o.ID = v
 }
 
 // GetStringID gets value to StringID
-func (o *BridgeInfo) GetStringID() string {
+func (o *AutoBackup) GetStringID() string {
 	return accessor.GetStringID(o)
 }
 
 // SetStringID sets value to StringID
-func (o *BridgeInfo) SetStringID(v string) {
+func (o *AutoBackup) SetStringID(v string) {
 	accessor.SetStringID(o, v)
 }
 
 // GetInt64ID gets value to Int64ID
-func (o *BridgeInfo) GetInt64ID() int64 {
+func (o *AutoBackup) GetInt64ID() int64 {
 	return accessor.GetInt64ID(o)
 }
 
 // SetInt64ID sets value to Int64ID
-func (o *BridgeInfo) SetInt64ID(v int64) {
+func (o *AutoBackup) SetInt64ID(v int64) {
 	accessor.SetInt64ID(o, v)
 }
 
 // GetName returns value of Name
-func (o *BridgeInfo) GetName() string {
+func (o *AutoBackup) GetName() string {
 	return o.Name
 }
 
 // SetName sets value to Name
-func (o *BridgeInfo) SetName(v string) {
+func (o *AutoBackup) SetName(v string) {
 	o.Name = v
 }
 
-// GetZoneID returns value of ZoneID
-func (o *BridgeInfo) GetZoneID() types.ID {
-	return o.ZoneID
+// GetDescription returns value of Description
+func (o *AutoBackup) GetDescription() string {
+	return o.Description
 }
 
-// SetZoneID sets value to ZoneID
-func (o *BridgeInfo) SetZoneID(v types.ID) {
-	o.ZoneID = v
+// SetDescription sets value to Description
+func (o *AutoBackup) SetDescription(v string) {
+	o.Description = v
 }
 
-// convertTo returns naked BridgeInfo
-func (o *BridgeInfo) convertTo() (*naked.Switch, error) {
-	dest := &naked.Switch{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetTags returns value of Tags
+func (o *AutoBackup) GetTags() []string {
+	return o.Tags
 }
 
-// convertFrom parse values from naked BridgeInfo
-func (o *BridgeInfo) convertFrom(naked *naked.Switch) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetTags sets value to Tags
+func (o *AutoBackup) SetTags(v []string) {
+	o.Tags = v
 }
 
-/*************************************************
-* BridgeSwitchInfo
-*************************************************/
+// GetAvailability returns value of Availability
+func (o *AutoBackup) GetAvailability() types.EAvailability {
+	return o.Availability
+}
 
-// BridgeSwitchInfo represents API parameter/response structure
-type BridgeSwitchInfo struct {
-	ID             types.ID
-	Name           string `validate:"required"`
-	Scope          types.EScope
-	ServerCount    int
-	ApplianceCount int
+// SetAvailability sets value to Availability
+func (o *AutoBackup) SetAvailability(v types.EAvailability) {
+	o.Availability = v
 }
 
-// Validate validates by field tags
-func (o *BridgeSwitchInfo) Validate() error {
-	return validator.New().Struct(o)
+// GetIconID returns value of IconID
+func (o *AutoBackup) GetIconID() types.ID {
+	return o.IconID
 }
 
-// GetID returns value of ID
-func (o *BridgeSwitchInfo) GetID() types.ID {
-	return o.ID
+// SetIconID sets value to IconID
+func (o *AutoBackup) SetIconID(v types.ID) {
+	o.IconID = v
 }
 
-// SetID sets value to ID
-func (o *BridgeSwitchInfo) SetID(v types.ID) {
-	o.ID = v
+// GetCreatedAt returns value of CreatedAt
+func (o *AutoBackup) GetCreatedAt() time.Time {
+	return o.CreatedAt
 }
 
-// GetStringID gets value to StringID
-func (o *BridgeSwitchInfo) GetStringID() string {
-	return accessor.GetStringID(o)
+// SetCreatedAt sets value to CreatedAt
+func (o *AutoBackup) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
 }
 
-// SetStringID sets value to StringID
-func (o *BridgeSwitchInfo) SetStringID(v string) {
-	accessor.SetStringID(o, v)
+// GetModifiedAt returns value of ModifiedAt
+func (o *AutoBackup) GetModifiedAt() time.Time {
+	return o.ModifiedAt
 }
 
-// GetInt64ID gets value to Int64ID
-func (o *BridgeSwitchInfo) GetInt64ID() int64 {
-	return accessor.GetInt64ID(o)
+// SetModifiedAt sets value to ModifiedAt
+func (o *AutoBackup) SetModifiedAt(v time.Time) {
+	o.ModifiedAt = v
 }
 
-// SetInt64ID sets value to Int64ID
-func (o *BridgeSwitchInfo) SetInt64ID(v int64) {
-	accessor.SetInt64ID(o, v)
+// GetClass returns value of Class
+func (o *AutoBackup) GetClass() string {
+	return o.Class
 }
 
-// GetName returns value of Name
-func (o *BridgeSwitchInfo) GetName() string {
-	return o.Name
+// SetClass sets value to Class
+func (o *AutoBackup) SetClass(v string) {
+	o.Class = v
 }
 
-// SetName sets value to Name
-func (o *BridgeSwitchInfo) SetName(v string) {
-	o.Name = v
+// GetBackupSpanType returns value of BackupSpanType
+func (o *AutoBackup) GetBackupSpanType() types.EBackupSpanType {
+	return o.BackupSpanType
 }
 
-// GetScope returns value of Scope
-func (o *BridgeSwitchInfo) GetScope() types.EScope {
-	return o.Scope
+// SetBackupSpanType sets value to BackupSpanType
+func (o *AutoBackup) SetBackupSpanType(v types.EBackupSpanType) {
+	o.BackupSpanType = v
 }
 
-// SetScope sets value to Scope
-func (o *BridgeSwitchInfo) SetScope(v types.EScope) {
-	o.Scope = v
+// GetBackupSpanWeekdays returns value of BackupSpanWeekdays
+func (o *AutoBackup) GetBackupSpanWeekdays() []types.EBackupSpanWeekday {
+	return o.BackupSpanWeekdays
 }
 
-// GetServerCount returns value of ServerCount
-func (o *BridgeSwitchInfo) GetServerCount() int {
-	return o.ServerCount
+// SetBackupSpanWeekdays sets value to BackupSpanWeekdays
+func (o *AutoBackup) SetBackupSpanWeekdays(v []types.EBackupSpanWeekday) {
+	o.BackupSpanWeekdays = v
 }
 
-// SetServerCount sets value to ServerCount
-func (o *BridgeSwitchInfo) SetServerCount(v int) {
-	o.ServerCount = v
+// GetMaximumNumberOfArchives returns value of MaximumNumberOfArchives
+func (o *AutoBackup) GetMaximumNumberOfArchives() int {
+	return o.MaximumNumberOfArchives
 }
 
-// GetApplianceCount returns value of ApplianceCount
-func (o *BridgeSwitchInfo) GetApplianceCount() int {
-	return o.ApplianceCount
+// SetMaximumNumberOfArchives sets value to MaximumNumberOfArchives
+func (o *AutoBackup) SetMaximumNumberOfArchives(v int) {
+	o.MaximumNumberOfArchives = v
 }
 
-// SetApplianceCount sets value to ApplianceCount
-func (o *BridgeSwitchInfo) SetApplianceCount(v int) {
-	o.ApplianceCount = v
+// GetSettingsHash returns value of SettingsHash
+func (o *AutoBackup) GetSettingsHash() string {
+	return o.SettingsHash
 }
 
-// convertTo returns naked BridgeSwitchInfo
-func (o *BridgeSwitchInfo) convertTo() (*naked.BridgeSwitchInfo, error) {
-	dest := &naked.BridgeSwitchInfo{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// SetSettingsHash sets value to SettingsHash
+func (o *AutoBackup) SetSettingsHash(v string) {
+	o.SettingsHash = v
 }
 
-// convertFrom parse values from naked BridgeSwitchInfo
-func (o *BridgeSwitchInfo) convertFrom(naked *naked.BridgeSwitchInfo) error {
-	return mapconv.ConvertFrom(naked, o)
+// GetDiskID returns value of DiskID
+func (o *AutoBackup) GetDiskID() types.ID {
+	return o.DiskID
 }
 
-/*************************************************
-* BridgeCreateRequest
-*************************************************/
+// SetDiskID sets value to DiskID
+func (o *AutoBackup) SetDiskID(v types.ID) {
+	o.DiskID = v
+}
 
-// BridgeCreateRequest represents API parameter/response structure
-type BridgeCreateRequest struct {
-	Name        string `validate:"required"`
-	Description string `validate:"min=0,max=512"`
+// GetAccountID returns value of AccountID
+func (o *AutoBackup) GetAccountID() types.ID {
+	return o.AccountID
 }
 
-// Validate validates by field tags
-func (o *BridgeCreateRequest) Validate() error {
-	return validator.New().Struct(o)
+// SetAccountID sets value to AccountID
+func (o *AutoBackup) SetAccountID(v types.ID) {
+	o.AccountID = v
 }
 
-// GetName returns value of Name
-func (o *BridgeCreateRequest) GetName() string {
-	return o.Name
+// GetZoneID returns value of ZoneID
+func (o *AutoBackup) GetZoneID() types.ID {
+	return o.ZoneID
 }
 
-// SetName sets value to Name
-func (o *BridgeCreateRequest) SetName(v string) {
-	o.Name = v
+// SetZoneID sets value to ZoneID
+func (o *AutoBackup) SetZoneID(v types.ID) {
+	o.ZoneID = v
 }
 
-// GetDescription returns value of Description
-func (o *BridgeCreateRequest) GetDescription() string {
-	return o.Description
+// GetZoneName returns value of ZoneName
+func (o *AutoBackup) GetZoneName() string {
+	return o.ZoneName
 }
 
-// SetDescription sets value to Description
-func (o *BridgeCreateRequest) SetDescription(v string) {
-	o.Description = v
+// SetZoneName sets value to ZoneName
+func (o *AutoBackup) SetZoneName(v string) {
+	o.ZoneName = v
 }
 
-// convertTo returns naked BridgeCreateRequest
-func (o *BridgeCreateRequest) convertTo() (*naked.Bridge, error) {
-	dest := &naked.Bridge{}
+// convertTo returns naked AutoBackup
+func (o *AutoBackup) convertTo() (*naked.AutoBackup, error) {
+	dest := &naked.AutoBackup{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked BridgeCreateRequest
-func (o *BridgeCreateRequest) convertFrom(naked *naked.Bridge) error {
+// convertFrom parse values from naked AutoBackup
+func (o *AutoBackup) convertFrom(naked *naked.AutoBackup) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* BridgeUpdateRequest
+* AutoBackupCreateRequest
 *************************************************/
 
-// BridgeUpdateRequest represents API parameter/response structure
-type BridgeUpdateRequest struct {
-	Name        string `validate:"required"`
-	Description string `validate:"min=0,max=512"`
+// AutoBackupCreateRequest represents API parameter/response structure
+type AutoBackupCreateRequest struct {
+	Class                   string                     `mapconv:"Provider.Class,default=autobackup"`
+	DiskID                  types.ID                   `mapconv:"Status.DiskId" validate:"required"`
+	BackupSpanType          types.EBackupSpanType      `mapconv:"Settings.Autobackup.BackupSpanType,default=weekdays"`
+	BackupSpanWeekdays      []types.EBackupSpanWeekday `mapconv:"Settings.Autobackup.BackupSpanWeekdays" validate:"required,min=1,max=7,dive,oneof=sun mon tue wed thu fri sat"`
+	MaximumNumberOfArchives int                        `mapconv:"Settings.Autobackup.MaximumNumberOfArchives" validate:"min=1,max=10"`
+	Name                    string                     `validate:"required"`
+	Description             string                     `validate:"min=0,max=512"`
+	Tags                    []string
+	IconID                  types.ID `mapconv:"Icon.ID"`
 }
 
 // Validate validates by field tags
-func (o *BridgeUpdateRequest) Validate() error {
+func (o *AutoBackupCreateRequest) Validate() error {
 	return validator.New().Struct(o)
 }
 
+// GetClass returns value of Class
+func (o *AutoBackupCreateRequest) GetClass() string {
+	return o.Class
+}
+
+// SetClass sets value to Class
+func (o *AutoBackupCreateRequest) SetClass(v string) {
+	o.Class = v
+}
+
+// GetDiskID returns value of DiskID
+func (o *AutoBackupCreateRequest) GetDiskID() types.ID {
+	return o.DiskID
+}
+
+// SetDiskID sets value to DiskID
+func (o *AutoBackupCreateRequest) SetDiskID(v types.ID) {
+	o.DiskID = v
+}
+
+// GetBackupSpanType returns value of BackupSpanType
+func (o *AutoBackupCreateRequest) GetBackupSpanType() types.EBackupSpanType {
+	return o.BackupSpanType
+}
+
+// SetBackupSpanType sets value to BackupSpanType
+func (o *AutoBackupCreateRequest) SetBackupSpanType(v types.EBackupSpanType) {
+	o.BackupSpanType = v
+}
+
+// GetBackupSpanWeekdays returns value of BackupSpanWeekdays
+func (o *AutoBackupCreateRequest) GetBackupSpanWeekdays() []types.EBackupSpanWeekday {
+	return o.BackupSpanWeekdays
+}
+
+// SetBackupSpanWeekdays sets value to BackupSpanWeekdays
+func (o *AutoBackupCreateRequest) SetBackupSpanWeekdays(v []types.EBackupSpanWeekday) {
+	o.BackupSpanWeekdays = v
+}
+
+// GetMaximumNumberOfArchives returns value of MaximumNumberOfArchives
+func (o *AutoBackupCreateRequest) GetMaximumNumberOfArchives() int {
+	return o.MaximumNumberOfArchives
+}
+
+// SetMaximumNumberOfArchives sets value to MaximumNumberOfArchives
+func (o *AutoBackupCreateRequest) SetMaximumNumberOfArchives(v int) {
+	o.MaximumNumberOfArchives = v
+}
+
 // GetName returns value of Name
-func (o *BridgeUpdateRequest) GetName() string {
+func (o *AutoBackupCreateRequest) GetName() string {
 	return o.Name
 }
 
 // SetName sets value to Name
-func (o *BridgeUpdateRequest) SetName(v string) {
+func (o *AutoBackupCreateRequest) SetName(v string) {
 	o.Name = v
 }
 
 // GetDescription returns value of Description
-func (o *BridgeUpdateRequest) GetDescription() string {
+func (o *AutoBackupCreateRequest) GetDescription() string {
 	return o.Description
 }
 
 // SetDescription sets value to Description
-func (o *BridgeUpdateRequest) SetDescription(v string) {
+func (o *AutoBackupCreateRequest) SetDescription(v string) {
 	o.Description = v
 }
 
-// convertTo returns naked BridgeUpdateRequest
-func (o *BridgeUpdateRequest) convertTo() (*naked.Bridge, error) {
-	dest := &naked.Bridge{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetTags returns value of Tags
+func (o *AutoBackupCreateRequest) GetTags() []string {
+	return o.Tags
 }
 
-// convertFrom parse values from naked BridgeUpdateRequest
-func (o *BridgeUpdateRequest) convertFrom(naked *naked.Bridge) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetTags sets value to Tags
+func (o *AutoBackupCreateRequest) SetTags(v []string) {
+	o.Tags = v
 }
 
-/*************************************************
-* CDROM
-*************************************************/
+// GetIconID returns value of IconID
+func (o *AutoBackupCreateRequest) GetIconID() types.ID {
+	return o.IconID
+}
 
-// CDROM represents API parameter/response structure
-type CDROM struct {
-	ID           types.ID
-	Name         string `validate:"required"`
-	Description  string `validate:"min=0,max=512"`
-	DisplayOrder int
-	Tags         []string
-	Availability types.EAvailability
-	Scope        types.EScope
-	Storage      *Storage `json:",omitempty" mapconv:",omitempty,recursive"`
-	IconID       types.ID `mapconv:"Icon.ID"`
-	CreatedAt    time.Time
-	ModifiedAt   time.Time
+// SetIconID sets value to IconID
+func (o *AutoBackupCreateRequest) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// convertTo returns naked AutoBackupCreateRequest
+func (o *AutoBackupCreateRequest) convertTo() (*naked.AutoBackup, error) {
+	dest := &naked.AutoBackup{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked AutoBackupCreateRequest
+func (o *AutoBackupCreateRequest) convertFrom(naked *naked.AutoBackup) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* AutoBackupUpdateRequest
+*************************************************/
+
+// AutoBackupUpdateRequest represents API parameter/response structure
+type AutoBackupUpdateRequest struct {
+	BackupSpanType          types.EBackupSpanType      `mapconv:"Settings.Autobackup.BackupSpanType,default=weekdays"`
+	BackupSpanWeekdays      []types.EBackupSpanWeekday `mapconv:"Settings.Autobackup.BackupSpanWeekdays" validate:"required,min=1,max=7,dive,oneof=sun mon tue wed thu fri sat"`
+	MaximumNumberOfArchives int                        `mapconv:"Settings.Autobackup.MaximumNumberOfArchives" validate:"min=1,max=10"`
+	Name                    string                     `validate:"required"`
+	Description             string                     `validate:"min=0,max=512"`
+	Tags                    []string
+	IconID                  types.ID `mapconv:"Icon.ID"`
 }
 
 // Validate validates by field tags
-func (o *CDROM) Validate() error {
+func (o *AutoBackupUpdateRequest) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetID returns value of ID
-func (o *CDROM) GetID() types.ID {
-	return o.ID
+// GetBackupSpanType returns value of BackupSpanType
+func (o *AutoBackupUpdateRequest) GetBackupSpanType() types.EBackupSpanType {
+	return o.BackupSpanType
 }
 
-// SetID sets value to ID
-func (o *CDROM) SetID(v types.ID) {
-	o.ID = v
+// SetBackupSpanType sets value to BackupSpanType
+func (o *AutoBackupUpdateRequest) SetBackupSpanType(v types.EBackupSpanType) {
+	o.BackupSpanType = v
 }
 
-// GetStringID gets value to StringID
-func (o *CDROM) GetStringID() string {
-	return accessor.GetStringID(o)
+// GetBackupSpanWeekdays returns value of BackupSpanWeekdays
+func (o *AutoBackupUpdateRequest) GetBackupSpanWeekdays() []types.EBackupSpanWeekday {
+	return o.BackupSpanWeekdays
 }
 
-// SetStringID sets value to StringID
-func (o *CDROM) SetStringID(v string) {
-	accessor.SetStringID(o, v)
+// SetBackupSpanWeekdays sets value to BackupSpanWeekdays
+func (o *AutoBackupUpdateRequest) SetBackupSpanWeekdays(v []types.EBackupSpanWeekday) {
+	o.BackupSpanWeekdays = v
 }
 
-// GetInt64ID gets value to Int64ID
-func (o *CDROM) GetInt64ID() int64 {
-	return accessor.GetInt64ID(o)
+// GetMaximumNumberOfArchives returns value of MaximumNumberOfArchives
+func (o *AutoBackupUpdateRequest) GetMaximumNumberOfArchives() int {
+	return o.MaximumNumberOfArchives
 }
 
-// SetInt64ID sets value to Int64ID
-func (o *CDROM) SetInt64ID(v int64) {
-	accessor.SetInt64ID(o, v)
+// SetMaximumNumberOfArchives sets value to MaximumNumberOfArchives
+func (o *AutoBackupUpdateRequest) SetMaximumNumberOfArchives(v int) {
+	o.MaximumNumberOfArchives = v
 }
 
 // GetName returns value of Name
-func (o *CDROM) GetName() string {
+func (o *AutoBackupUpdateRequest) GetName() string {
 	return o.Name
 }
 
 // SetName sets value to Name
-func (o *CDROM) SetName(v string) {
+func (o *AutoBackupUpdateRequest) SetName(v string) {
 	o.Name = v
 }
 
 // GetDescription returns value of Description
-func (o *CDROM) GetDescription() string {
+func (o *AutoBackupUpdateRequest) GetDescription() string {
 	return o.Description
 }
 
 // SetDescription sets value to Description
-func (o *CDROM) SetDescription(v string) {
+func (o *AutoBackupUpdateRequest) SetDescription(v string) {
 	o.Description = v
 }
 
-// GetDisplayOrder returns value of DisplayOrder
-func (o *CDROM) GetDisplayOrder() int {
-	return o.DisplayOrder
-}
-
-// SetDisplayOrder sets value to DisplayOrder
-func (o *CDROM) SetDisplayOrder(v int) {
-	o.DisplayOrder = v
-}
-
 // GetTags returns value of Tags
-func (o *CDROM) GetTags() []string {
+func (o *AutoBackupUpdateRequest) GetTags() []string {
 	return o.Tags
 }
 
 // SetTags sets value to Tags
-func (o *CDROM) SetTags(v []string) {
+func (o *AutoBackupUpdateRequest) SetTags(v []string) {
 	o.Tags = v
 }
 
-// GetAvailability returns value of Availability
-func (o *CDROM) GetAvailability() types.EAvailability {
-	return o.Availability
+// GetIconID returns value of IconID
+func (o *AutoBackupUpdateRequest) GetIconID() types.ID {
+	return o.IconID
 }
 
-// SetAvailability sets value to Availability
-func (o *CDROM) SetAvailability(v types.EAvailability) {
-	o.Availability = v
+// SetIconID sets value to IconID
+func (o *AutoBackupUpdateRequest) SetIconID(v types.ID) {
+	o.IconID = v
 }
 
-// GetScope returns value of Scope
-func (o *CDROM) GetScope() types.EScope {
-	return o.Scope
+// convertTo returns naked AutoBackupUpdateRequest
+func (o *AutoBackupUpdateRequest) convertTo() (*naked.AutoBackup, error) {
+	dest := &naked.AutoBackup{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetScope sets value to Scope
-func (o *CDROM) SetScope(v types.EScope) {
-	o.Scope = v
+// convertFrom parse values from naked AutoBackupUpdateRequest
+func (o *AutoBackupUpdateRequest) convertFrom(naked *naked.AutoBackup) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// GetStorage returns value of Storage
-func (o *CDROM) GetStorage() *Storage {
-	return o.Storage
+/*************************************************
+* Bill
+*************************************************/
+
+// Bill represents API parameter/response structure
+type Bill struct {
+	ID             types.ID `mapconv:"BillID"`
+	Amount         int64
+	Date           time.Time `mapconv:"BillingDate"`
+	MemberID       string
+	Paid           bool
+	PayLimit       time.Time
+	PaymentClassID types.ID
 }
 
-// SetStorage sets value to Storage
-func (o *CDROM) SetStorage(v *Storage) {
-	o.Storage = v
+// Validate validates by field tags
+func (o *Bill) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetIconID returns value of IconID
-func (o *CDROM) GetIconID() types.ID {
-	return o.IconID
+// GetID returns value of ID
+func (o *Bill) GetID() types.ID {
+	return o.ID
 }
 
-// SetIconID sets value to IconID
-func (o *CDROM) SetIconID(v types.ID) {
-	o.IconID = v
+// SetID sets value to ID
+func (o *Bill) SetID(v types.ID) {
+	o.ID = v
 }
 
-// GetCreatedAt returns value of CreatedAt
-func (o *CDROM) GetCreatedAt() time.Time {
-	return o.CreatedAt
+// GetStringID gets value to StringID
+func (o *Bill) GetStringID() string {
+	return accessor.GetStringID(o)
 }
 
-// SetCreatedAt sets value to CreatedAt
-func (o *CDROM) SetCreatedAt(v time.Time) {
-	o.CreatedAt = v
+// SetStringID sets value to StringID
+func (o *Bill) SetStringID(v string) {
+	accessor.SetStringID(o, v)
 }
 
-// GetModifiedAt returns value of ModifiedAt
-func (o *CDROM) GetModifiedAt() time.Time {
-	return o.ModifiedAt
+// GetInt64ID gets value to Int64ID
+func (o *Bill) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
 }
 
-// SetModifiedAt sets value to ModifiedAt
-func (o *CDROM) SetModifiedAt(v time.Time) {
-	o.ModifiedAt = v
+// SetInt64ID sets value to Int64ID
+func (o *Bill) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
 }
 
-// convertTo returns naked CDROM
-func (o *CDROM) convertTo() (*naked.CDROM, error) {
-	dest := &naked.CDROM{}
+// GetAmount returns value of Amount
+func (o *Bill) GetAmount() int64 {
+	return o.Amount
+}
+
+// SetAmount sets value to Amount
+func (o *Bill) SetAmount(v int64) {
+	o.Amount = v
+}
+
+// GetDate returns value of Date
+func (o *Bill) GetDate() time.Time {
+	return o.Date
+}
+
+// SetDate sets value to Date
+func (o *Bill) SetDate(v time.Time) {
+	o.Date = v
+}
+
+// GetMemberID returns value of MemberID
+func (o *Bill) GetMemberID() string {
+	return o.MemberID
+}
+
+// SetMemberID sets value to MemberID
+func (o *Bill) SetMemberID(v string) {
+	o.MemberID = v
+}
+
+// GetPaid returns value of Paid
+func (o *Bill) GetPaid() bool {
+	return o.Paid
+}
+
+// SetPaid sets value to Paid
+func (o *Bill) SetPaid(v bool) {
+	o.Paid = v
+}
+
+// GetPayLimit returns value of PayLimit
+func (o *Bill) GetPayLimit() time.Time {
+	return o.PayLimit
+}
+
+// SetPayLimit sets value to PayLimit
+func (o *Bill) SetPayLimit(v time.Time) {
+	o.PayLimit = v
+}
+
+// GetPaymentClassID returns value of PaymentClassID
+func (o *Bill) GetPaymentClassID() types.ID {
+	return o.PaymentClassID
+}
+
+// SetPaymentClassID sets value to PaymentClassID
+func (o *Bill) SetPaymentClassID(v types.ID) {
+	o.PaymentClassID = v
+}
+
+// convertTo returns naked Bill
+func (o *Bill) convertTo() (*naked.Bill, error) {
+	dest := &naked.Bill{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked CDROM
-func (o *CDROM) convertFrom(naked *naked.CDROM) error {
+// convertFrom parse values from naked Bill
+func (o *Bill) convertFrom(naked *naked.Bill) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* CDROMCreateRequest
+* BillDetail
 *************************************************/
 
-// CDROMCreateRequest represents API parameter/response structure
-type CDROMCreateRequest struct {
-	SizeMB      int
-	Name        string `validate:"required"`
-	Description string `validate:"min=0,max=512"`
-	Tags        []string
-	IconID      types.ID `mapconv:"Icon.ID"`
+// BillDetail represents API parameter/response structure
+type BillDetail struct {
+	ID             types.ID `mapconv:"ContractID"`
+	Amount         int64
+	Description    string `validate:"min=0,max=512"`
+	ServiceClassID types.ID
+	Usage          int64
+	Zone           string
+	ContractEndAt  time.Time
 }
 
 // Validate validates by field tags
-func (o *CDROMCreateRequest) Validate() error {
+func (o *BillDetail) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetSizeMB returns value of SizeMB
-func (o *CDROMCreateRequest) GetSizeMB() int {
-	return o.SizeMB
+// GetID returns value of ID
+func (o *BillDetail) GetID() types.ID {
+	return o.ID
 }
 
-// SetSizeMB sets value to SizeMB
-func (o *CDROMCreateRequest) SetSizeMB(v int) {
-	o.SizeMB = v
+// SetID sets value to ID
+func (o *BillDetail) SetID(v types.ID) {
+	o.ID = v
 }
 
-// GetSizeGB gets value to SizeGB
-func (o *CDROMCreateRequest) GetSizeGB() int {
-	return accessor.GetSizeGB(o)
+// GetStringID gets value to StringID
+func (o *BillDetail) GetStringID() string {
+	return accessor.GetStringID(o)
 }
 
-// SetSizeGB sets value to SizeGB
-func (o *CDROMCreateRequest) SetSizeGB(v int) {
-	accessor.SetSizeGB(o, v)
+// SetStringID sets value to StringID
+func (o *BillDetail) SetStringID(v string) {
+	accessor.SetStringID(o, v)
 }
 
-// GetName returns value of Name
-func (o *CDROMCreateRequest) GetName() string {
-	return o.Name
+// GetInt64ID gets value to Int64ID
+func (o *BillDetail) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
 }
 
-// SetName sets value to Name
-func (o *CDROMCreateRequest) SetName(v string) {
-	o.Name = v
+// SetInt64ID sets value to Int64ID
+func (o *BillDetail) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetAmount returns value of Amount
+func (o *BillDetail) GetAmount() int64 {
+	return o.Amount
+}
+
+// SetAmount sets value to Amount
+func (o *BillDetail) SetAmount(v int64) {
+	o.Amount = v
 }
 
 // GetDescription returns value of Description
-func (o *CDROMCreateRequest) GetDescription() string {
+func (o *BillDetail) GetDescription() string {
 	return o.Description
 }
 
 // SetDescription sets value to Description
-func (o *CDROMCreateRequest) SetDescription(v string) {
+func (o *BillDetail) SetDescription(v string) {
 	o.Description = v
 }
 
-// GetTags returns value of Tags
-func (o *CDROMCreateRequest) GetTags() []string {
-	return o.Tags
+// GetServiceClassID returns value of ServiceClassID
+func (o *BillDetail) GetServiceClassID() types.ID {
+	return o.ServiceClassID
 }
 
-// SetTags sets value to Tags
-func (o *CDROMCreateRequest) SetTags(v []string) {
-	o.Tags = v
+// SetServiceClassID sets value to ServiceClassID
+func (o *BillDetail) SetServiceClassID(v types.ID) {
+	o.ServiceClassID = v
 }
 
-// GetIconID returns value of IconID
-func (o *CDROMCreateRequest) GetIconID() types.ID {
-	return o.IconID
+// GetUsage returns value of Usage
+func (o *BillDetail) GetUsage() int64 {
+	return o.Usage
 }
 
-// SetIconID sets value to IconID
-func (o *CDROMCreateRequest) SetIconID(v types.ID) {
-	o.IconID = v
+// SetUsage sets value to Usage
+func (o *BillDetail) SetUsage(v int64) {
+	o.Usage = v
 }
 
-// convertTo returns naked CDROMCreateRequest
-func (o *CDROMCreateRequest) convertTo() (*naked.CDROM, error) {
-	dest := &naked.CDROM{}
+// GetZone returns value of Zone
+func (o *BillDetail) GetZone() string {
+	return o.Zone
+}
+
+// SetZone sets value to Zone
+func (o *BillDetail) SetZone(v string) {
+	o.Zone = v
+}
+
+// GetContractEndAt returns value of ContractEndAt
+func (o *BillDetail) GetContractEndAt() time.Time {
+	return o.ContractEndAt
+}
+
+// SetContractEndAt sets value to ContractEndAt
+func (o *BillDetail) SetContractEndAt(v time.Time) {
+	o.ContractEndAt = v
+}
+
+// convertTo returns naked BillDetail
+func (o *BillDetail) convertTo() (*naked.BillDetail, error) {
+	dest := &naked.BillDetail{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked CDROMCreateRequest
-func (o *CDROMCreateRequest) convertFrom(naked *naked.CDROM) error {
+// convertFrom parse values from naked BillDetail
+func (o *BillDetail) convertFrom(naked *naked.BillDetail) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* CDROMUpdateRequest
+* BillDetailCSV
 *************************************************/
 
-// CDROMUpdateRequest represents API parameter/response structure
-type CDROMUpdateRequest struct {
-	Name        string `validate:"required"`
-	Description string `validate:"min=0,max=512"`
-	Tags        []string
-	IconID      types.ID `mapconv:"Icon.ID"`
+// BillDetailCSV represents API parameter/response structure
+type BillDetailCSV struct {
+	Count       int
+	ResponsedAt time.Time
+	Filename    string
+	RawBody     string `mapconv:"Body"`
 }
 
 // Validate validates by field tags
-func (o *CDROMUpdateRequest) Validate() error {
+func (o *BillDetailCSV) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetName returns value of Name
-func (o *CDROMUpdateRequest) GetName() string {
-	return o.Name
+// GetCount returns value of Count
+func (o *BillDetailCSV) GetCount() int {
+	return o.Count
 }
 
-// SetName sets value to Name
-func (o *CDROMUpdateRequest) SetName(v string) {
-	o.Name = v
+// SetCount sets value to Count
+func (o *BillDetailCSV) SetCount(v int) {
+	o.Count = v
 }
 
-// GetDescription returns value of Description
-func (o *CDROMUpdateRequest) GetDescription() string {
-	return o.Description
+// GetResponsedAt returns value of ResponsedAt
+func (o *BillDetailCSV) GetResponsedAt() time.Time {
+	return o.ResponsedAt
 }
 
-// SetDescription sets value to Description
-func (o *CDROMUpdateRequest) SetDescription(v string) {
-	o.Description = v
+// SetResponsedAt sets value to ResponsedAt
+func (o *BillDetailCSV) SetResponsedAt(v time.Time) {
+	o.ResponsedAt = v
 }
 
-// GetTags returns value of Tags
-func (o *CDROMUpdateRequest) GetTags() []string {
-	return o.Tags
+// GetFilename returns value of Filename
+func (o *BillDetailCSV) GetFilename() string {
+	return o.Filename
 }
 
-// SetTags sets value to Tags
-func (o *CDROMUpdateRequest) SetTags(v []string) {
-	o.Tags = v
+// SetFilename sets value to Filename
+func (o *BillDetailCSV) SetFilename(v string) {
+	o.Filename = v
 }
 
-// GetIconID returns value of IconID
-func (o *CDROMUpdateRequest) GetIconID() types.ID {
-	return o.IconID
+// GetRawBody returns value of RawBody
+func (o *BillDetailCSV) GetRawBody() string {
+	return o.RawBody
 }
 
-// SetIconID sets value to IconID
-func (o *CDROMUpdateRequest) SetIconID(v types.ID) {
-	o.IconID = v
+// SetRawBody sets value to RawBody
+func (o *BillDetailCSV) SetRawBody(v string) {
+	o.RawBody = v
 }
 
-// convertTo returns naked CDROMUpdateRequest
-func (o *CDROMUpdateRequest) convertTo() (*naked.CDROM, error) {
-	dest := &naked.CDROM{}
+// convertTo returns naked BillDetailCSV
+func (o *BillDetailCSV) convertTo() (*naked.BillDetailCSV, error) {
+	dest := &naked.BillDetailCSV{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked CDROMUpdateRequest
-func (o *CDROMUpdateRequest) convertFrom(naked *naked.CDROM) error {
+// convertFrom parse values from naked BillDetailCSV
+func (o *BillDetailCSV) convertFrom(naked *naked.BillDetailCSV) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* Disk
+* Bridge
 *************************************************/
 
-// Disk represents API parameter/response structure
-type Disk struct {
-	ID                        types.ID
-	Name                      string `validate:"required"`
-	Description               string `validate:"min=0,max=512"`
-	Tags                      []string
-	Availability              types.EAvailability
-	Connection                types.EDiskConnection `json:",omitempty" mapconv:",omitempty"`
-	ConnectionOrder           int
-	ReinstallCount            int
-	SizeMB                    int
-	MigratedMB                int
-	DiskPlanID                types.ID            `mapconv:"Plan.ID"`
-	DiskPlanName              string              `mapconv:"Plan.Name"`
-	DiskPlanStorageClass      string              `mapconv:"Plan.StorageClass"`
-	SourceDiskID              types.ID            `mapconv:"SourceDisk.ID,omitempty"`
-	SourceDiskAvailability    types.EAvailability `mapconv:"SourceDisk.Availability,omitempty"`
-	SourceArchiveID           types.ID            `mapconv:"SourceArchive.ID,omitempty"`
-	SourceArchiveAvailability types.EAvailability `mapconv:"SourceArchive.Availability,omitempty"`
-	BundleInfo                *BundleInfo         `json:",omitempty" mapconv:",omitempty,recursive"`
-	Storage                   *Storage            `json:",omitempty" mapconv:",omitempty,recursive"`
-	ServerID                  types.ID            `mapconv:"Server.ID,omitempty"`
-	IconID                    types.ID            `mapconv:"Icon.ID"`
-	CreatedAt                 time.Time
-	ModifiedAt                time.Time
+// Bridge represents API parameter/response structure
+type Bridge struct {
+	ID           types.ID
+	Name         string `validate:"required"`
+	Description  string `validate:"min=0,max=512"`
+	CreatedAt    time.Time
+	Region       *Region       `json:",omitempty"`
+	BridgeInfo   []*BridgeInfo `mapconv:"[]Switches,recursive"`
+	SwitchInZone *BridgeSwitchInfo
 }
 
 // Validate validates by field tags
-func (o *Disk) Validate() error {
+func (o *Bridge) Validate() error {
 	return validator.New().Struct(o)
 }
 
 // GetID returns value of ID
-func (o *Disk) GetID() types.ID {
+func (o *Bridge) GetID() types.ID {
 	return o.ID
 }
 
 // SetID sets value to ID
-func (o *Disk) SetID(v types.ID) {
+func (o *Bridge) SetID(v types.ID) {
 	o.ID = v
 }
 
 // GetStringID gets value to StringID
-func (o *Disk) GetStringID() string {
+func (o *Bridge) GetStringID() string {
 	return accessor.GetStringID(o)
 }
 
 // SetStringID sets value to StringID
-func (o *Disk) SetStringID(v string) {
+func (o *Bridge) SetStringID(v string) {
 	accessor.SetStringID(o, v)
 }
 
 // GetInt64ID gets value to Int64ID
-func (o *Disk) GetInt64ID() int64 {
+func (o *Bridge) GetInt64ID() int64 {
 	return accessor.GetInt64ID(o)
 }
 
 // SetInt64ID sets value to Int64ID
-func (o *Disk) SetInt64ID(v int64) {
+func (o *Bridge) SetInt64ID(v int64) {
 	accessor.SetInt64ID(o, v)
 }
 
 // GetName returns value of Name
-func (o *Disk) GetName() string {
+func (o *Bridge) GetName() string {
 	return o.Name
 }
 
 // SetName sets value to Name
-func (o *Disk) SetName(v string) {
+func (o *Bridge) SetName(v string) {
 	o.Name = v
 }
 
 // GetDescription returns value of Description
-func (o *Disk) GetDescription() string {
+func (o *Bridge) GetDescription() string {
 	return o.Description
 }
 
 // SetDescription sets value to Description
-func (o *Disk) SetDescription(v string) {
+func (o *Bridge) SetDescription(v string) {
 	o.Description = v
 }
 
-// GetTags returns value of Tags
-func (o *Disk) GetTags() []string {
-	return o.Tags
+// GetCreatedAt returns value of CreatedAt
+func (o *Bridge) GetCreatedAt() time.Time {
+	return o.CreatedAt
 }
 
-// SetTags sets value to Tags
-func (o *Disk) SetTags(v []string) {
-	o.Tags = v
+// SetCreatedAt sets value to CreatedAt
+func (o *Bridge) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
 }
 
-// GetAvailability returns value of Availability
-func (o *Disk) GetAvailability() types.EAvailability {
-	return o.Availability
+// GetRegion returns value of Region
+func (o *Bridge) GetRegion() *Region {
+	return o.Region
 }
 
-// SetAvailability sets value to Availability
-func (o *Disk) SetAvailability(v types.EAvailability) {
-	o.Availability = v
+// SetRegion sets value to Region
+func (o *Bridge) SetRegion(v *Region) {
+	o.Region = v
 }
 
-// GetConnection returns value of Connection
-func (o *Disk) GetConnection() types.EDiskConnection {
-	return o.Connection
+// GetBridgeInfo returns value of BridgeInfo
+func (o *Bridge) GetBridgeInfo() []*BridgeInfo {
+	return o.BridgeInfo
 }
 
-// SetConnection sets value to Connection
-func (o *Disk) SetConnection(v types.EDiskConnection) {
-	o.Connection = v
+// SetBridgeInfo sets value to BridgeInfo
+func (o *Bridge) SetBridgeInfo(v []*BridgeInfo) {
+	o.BridgeInfo = v
 }
 
-// GetConnectionOrder returns value of ConnectionOrder
-func (o *Disk) GetConnectionOrder() int {
-	return o.ConnectionOrder
+// GetSwitchInZone returns value of SwitchInZone
+func (o *Bridge) GetSwitchInZone() *BridgeSwitchInfo {
+	return o.SwitchInZone
 }
 
-// SetConnectionOrder sets value to ConnectionOrder
-func (o *Disk) SetConnectionOrder(v int) {
-	o.ConnectionOrder = v
+// SetSwitchInZone sets value to SwitchInZone
+func (o *Bridge) SetSwitchInZone(v *BridgeSwitchInfo) {
+	o.SwitchInZone = v
 }
 
-// GetReinstallCount returns value of ReinstallCount
-func (o *Disk) GetReinstallCount() int {
-	return o.ReinstallCount
+// convertTo returns naked Bridge
+func (o *Bridge) convertTo() (*naked.Bridge, error) {
+	dest := &naked.Bridge{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetReinstallCount sets value to ReinstallCount
-func (o *Disk) SetReinstallCount(v int) {
-	o.ReinstallCount = v
+// convertFrom parse values from naked Bridge
+func (o *Bridge) convertFrom(naked *naked.Bridge) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// GetSizeMB returns value of SizeMB
-func (o *Disk) GetSizeMB() int {
-	return o.SizeMB
-}
+/*************************************************
+* Region
+*************************************************/
 
-// SetSizeMB sets value to SizeMB
-func (o *Disk) SetSizeMB(v int) {
-	o.SizeMB = v
+// Region represents API parameter/response structure
+type Region struct {
+	ID          types.ID
+	Name        string   `validate:"required"`
+	Description string   `validate:"min=0,max=512"`
+	NameServers []string `json:",omitempty" mapconv:",omitempty"`
 }
 
-// GetSizeGB gets value to SizeGB
-func (o *Disk) GetSizeGB() int {
-	return accessor.GetSizeGB(o)
+// Validate validates by field tags
+func (o *Region) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// SetSizeGB sets value to SizeGB
-func (o *Disk) SetSizeGB(v int) {
-	accessor.SetSizeGB(o, v)
+// GetID returns value of ID
+func (o *Region) GetID() types.ID {
+	return o.ID
 }
 
-// GetMigratedMB returns value of MigratedMB
-func (o *Disk) GetMigratedMB() int {
-	return o.MigratedMB
+// SetID sets value to ID
+func (o *Region) SetID(v types.ID) {
+	o.ID = v
 }
 
-// SetMigratedMB sets value to MigratedMB
-func (o *Disk) SetMigratedMB(v int) {
-	o.MigratedMB = v
+// GetStringID gets value to StringID
+func (o *Region) GetStringID() string {
+	return accessor.GetStringID(o)
 }
 
-// GetMigratedGB gets value to MigratedGB
-func (o *Disk) GetMigratedGB() int {
-	return accessor.GetMigratedGB(o)
+// SetStringID sets value to StringID
+func (o *Region) SetStringID(v string) {
+	accessor.SetStringID(o, v)
 }
 
-// SetMigratedGB sets value to MigratedGB
-func (o *Disk) SetMigratedGB(v int) {
-	accessor.SetMigratedGB(o, v)
+// GetInt64ID gets value to Int64ID
+func (o *Region) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
 }
 
-// GetDiskPlanID returns value of DiskPlanID
-func (o *Disk) GetDiskPlanID() types.ID {
-	return o.DiskPlanID
+// SetInt64ID sets value to Int64ID
+func (o *Region) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
 }
 
-// SetDiskPlanID sets value to DiskPlanID
-func (o *Disk) SetDiskPlanID(v types.ID) {
-	o.DiskPlanID = v
+// GetName returns value of Name
+func (o *Region) GetName() string {
+	return o.Name
 }
 
-// GetDiskPlanName returns value of DiskPlanName
-func (o *Disk) GetDiskPlanName() string {
-	return o.DiskPlanName
+// SetName sets value to Name
+func (o *Region) SetName(v string) {
+	o.Name = v
 }
 
-// SetDiskPlanName sets value to DiskPlanName
-func (o *Disk) SetDiskPlanName(v string) {
-	o.DiskPlanName = v
+// GetDescription returns value of Description
+func (o *Region) GetDescription() string {
+	return o.Description
 }
 
-// GetDiskPlanStorageClass returns value of DiskPlanStorageClass
-func (o *Disk) GetDiskPlanStorageClass() string {
-	return o.DiskPlanStorageClass
+// SetDescription sets value to Description
+func (o *Region) SetDescription(v string) {
+	o.Description = v
 }
 
-// SetDiskPlanStorageClass sets value to DiskPlanStorageClass
-func (o *Disk) SetDiskPlanStorageClass(v string) {
-	o.DiskPlanStorageClass = v
+// GetNameServers returns value of NameServers
+func (o *Region) GetNameServers() []string {
+	return o.NameServers
 }
 
-// GetSourceDiskID returns value of SourceDiskID
-func (o *Disk) GetSourceDiskID() types.ID {
-	return o.SourceDiskID
+// SetNameServers sets value to NameServers
+func (o *Region) SetNameServers(v []string) {
+	o.NameServers = v
 }
 
-// SetSourceDiskID sets value to SourceDiskID
-func (o *Disk) SetSourceDiskID(v types.ID) {
-	o.SourceDiskID = v
+// convertTo returns naked Region
+func (o *Region) convertTo() (*naked.Region, error) {
+	dest := &naked.Region{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// GetSourceDiskAvailability returns value of SourceDiskAvailability
-func (o *Disk) GetSourceDiskAvailability() types.EAvailability {
-	return o.SourceDiskAvailability
+// convertFrom parse values from naked Region
+func (o *Region) convertFrom(naked *naked.Region) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// SetSourceDiskAvailability sets value to SourceDiskAvailability
-func (o *Disk) SetSourceDiskAvailability(v types.EAvailability) {
-	o.SourceDiskAvailability = v
-}
+/*************************************************
+* BridgeInfo
+*************************************************/
 
-// GetSourceArchiveID returns value of SourceArchiveID
-func (o *Disk) GetSourceArchiveID() types.ID {
-	return o.SourceArchiveID
-}
-
-// SetSourceArchiveID sets value to SourceArchiveID
-func (o *Disk) SetSourceArchiveID(v types.ID) {
-	o.SourceArchiveID = v
-}
-
-// GetSourceArchiveAvailability returns value of SourceArchiveAvailability
-func (o *Disk) GetSourceArchiveAvailability() types.EAvailability {
-	return o.SourceArchiveAvailability
-}
-
-// SetSourceArchiveAvailability sets value to SourceArchiveAvailability
-func (o *Disk) SetSourceArchiveAvailability(v types.EAvailability) {
-	o.SourceArchiveAvailability = v
-}
-
-// GetBundleInfo returns value of BundleInfo
-func (o *Disk) GetBundleInfo() *BundleInfo {
-	return o.BundleInfo
+// BridgeInfo represents API parameter/response structure
+type BridgeInfo struct {
+	ID     types.ID
+	Name   string   `validate:"required"`
+	ZoneID types.ID `mapconv:"Zone.ID"`
 }
 
-// SetBundleInfo sets value to BundleInfo
-func (o *Disk) SetBundleInfo(v *BundleInfo) {
-	o.BundleInfo = v
+// Validate validates by field tags
+func (o *BridgeInfo) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetStorage returns value of Storage
-func (o *Disk) GetStorage() *Storage {
-	return o.Storage
+// GetID returns value of ID
+func (o *BridgeInfo) GetID() types.ID {
+	return o.ID
 }
 
-// SetStorage sets value to Storage
-func (o *Disk) SetStorage(v *Storage) {
-	o.Storage = v
+// SetID sets value to ID
+func (o *BridgeInfo) SetID(v types.ID) {
+	o.ID = v
 }
 
-// GetServerID returns value of ServerID
-func (o *Disk) GetServerID() types.ID {
-	return o.ServerID
+// GetStringID gets value to StringID
+func (o *BridgeInfo) GetStringID() string {
+	return accessor.GetStringID(o)
 }
 
-// SetServerID sets value to ServerID
-func (o *Disk) SetServerID(v types.ID) {
-	o.ServerID = v
+// SetStringID sets value to StringID
+func (o *BridgeInfo) SetStringID(v string) {
+	accessor.SetStringID(o, v)
 }
 
-// GetIconID returns value of IconID
-func (o *Disk) GetIconID() types.ID {
-	return o.IconID
+// GetInt64ID gets value to Int64ID
+func (o *BridgeInfo) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
 }
 
-// SetIconID sets value to IconID
-func (o *Disk) SetIconID(v types.ID) {
-	o.IconID = v
+// SetInt64ID sets value to Int64ID
+func (o *BridgeInfo) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
 }
 
-// GetCreatedAt returns value of CreatedAt
-func (o *Disk) GetCreatedAt() time.Time {
-	return o.CreatedAt
+// GetName returns value of Name
+func (o *BridgeInfo) GetName() string {
+	return o.Name
 }
 
-// SetCreatedAt sets value to CreatedAt
-func (o *Disk) SetCreatedAt(v time.Time) {
-	o.CreatedAt = v
+// SetName sets value to Name
+func (o *BridgeInfo) SetName(v string) {
+	o.Name = v
 }
 
-// GetModifiedAt returns value of ModifiedAt
-func (o *Disk) GetModifiedAt() time.Time {
-	return o.ModifiedAt
+// GetZoneID returns value of ZoneID
+func (o *BridgeInfo) GetZoneID() types.ID {
+	return o.ZoneID
 }
 
-// SetModifiedAt sets value to ModifiedAt
-func (o *Disk) SetModifiedAt(v time.Time) {
-	o.ModifiedAt = v
+// SetZoneID sets value to ZoneID
+func (o *BridgeInfo) SetZoneID(v types.ID) {
+	o.ZoneID = v
 }
 
-// convertTo returns naked Disk
-func (o *Disk) convertTo() (*naked.Disk, error) {
-	dest := &naked.Disk{}
+// convertTo returns naked BridgeInfo
+func (o *BridgeInfo) convertTo() (*naked.Switch, error) {
+	dest := &naked.Switch{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked Disk
-func (o *Disk) convertFrom(naked *naked.Disk) error {
+// convertFrom parse values from naked BridgeInfo
+func (o *BridgeInfo) convertFrom(naked *naked.Switch) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* DiskCreateRequest
+* BridgeSwitchInfo
 *************************************************/
 
-// DiskCreateRequest represents API parameter/response structure
-type DiskCreateRequest struct {
-	DiskPlanID      types.ID              `mapconv:"Plan.ID"`
-	Connection      types.EDiskConnection `json:",omitempty" mapconv:",omitempty"`
-	SourceDiskID    types.ID              `mapconv:"SourceDisk.ID,omitempty"`
-	SourceArchiveID types.ID              `mapconv:"SourceArchive.ID,omitempty"`
-	ServerID        types.ID              `mapconv:"Server.ID,omitempty"`
-	SizeMB          int
-	Name            string `validate:"required"`
-	Description     string `validate:"min=0,max=512"`
-	Tags            []string
-	IconID          types.ID `mapconv:"Icon.ID"`
+// BridgeSwitchInfo represents API parameter/response structure
+type BridgeSwitchInfo struct {
+	ID             types.ID
+	Name           string `validate:"required"`
+	Scope          types.EScope
+	ServerCount    int
+	ApplianceCount int
 }
 
 // Validate validates by field tags
-func (o *DiskCreateRequest) Validate() error {
+func (o *BridgeSwitchInfo) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetDiskPlanID returns value of DiskPlanID
-func (o *DiskCreateRequest) GetDiskPlanID() types.ID {
-	return o.DiskPlanID
+// GetID returns value of ID
+func (o *BridgeSwitchInfo) GetID() types.ID {
+	return o.ID
 }
 
-// SetDiskPlanID sets value to DiskPlanID
-func (o *DiskCreateRequest) SetDiskPlanID(v types.ID) {
-	o.DiskPlanID = v
+// SetID sets value to ID
+func (o *BridgeSwitchInfo) SetID(v types.ID) {
+	o.ID = v
 }
 
-// GetConnection returns value of Connection
-func (o *DiskCreateRequest) GetConnection() types.EDiskConnection {
-	return o.Connection
+// GetStringID gets value to StringID
+func (o *BridgeSwitchInfo) GetStringID() string {
+	return accessor.GetStringID(o)
 }
 
-// SetConnection sets value to Connection
-func (o *DiskCreateRequest) SetConnection(v types.EDiskConnection) {
-	o.Connection = v
+// SetStringID sets value to StringID
+func (o *BridgeSwitchInfo) SetStringID(v string) {
+	accessor.SetStringID(o, v)
 }
 
-// GetSourceDiskID returns value of SourceDiskID
-func (o *DiskCreateRequest) GetSourceDiskID() types.ID {
-	return o.SourceDiskID
+// GetInt64ID gets value to Int64ID
+func (o *BridgeSwitchInfo) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
 }
 
-// SetSourceDiskID sets value to SourceDiskID
-func (o *DiskCreateRequest) SetSourceDiskID(v types.ID) {
-	o.SourceDiskID = v
+// SetInt64ID sets value to Int64ID
+func (o *BridgeSwitchInfo) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
 }
 
-// GetSourceArchiveID returns value of SourceArchiveID
-func (o *DiskCreateRequest) GetSourceArchiveID() types.ID {
-	return o.SourceArchiveID
+// GetName returns value of Name
+func (o *BridgeSwitchInfo) GetName() string {
+	return o.Name
 }
 
-// SetSourceArchiveID sets value to SourceArchiveID
-func (o *DiskCreateRequest) SetSourceArchiveID(v types.ID) {
-	o.SourceArchiveID = v
+// SetName sets value to Name
+func (o *BridgeSwitchInfo) SetName(v string) {
+	o.Name = v
 }
 
-// GetServerID returns value of ServerID
-func (o *DiskCreateRequest) GetServerID() types.ID {
-	return o.ServerID
+// GetScope returns value of Scope
+func (o *BridgeSwitchInfo) GetScope() types.EScope {
+	return o.Scope
 }
 
-// SetServerID sets value to ServerID
-func (o *DiskCreateRequest) SetServerID(v types.ID) {
-	o.ServerID = v
+// SetScope sets value to Scope
+func (o *BridgeSwitchInfo) SetScope(v types.EScope) {
+	o.Scope = v
 }
 
-// GetSizeMB returns value of SizeMB
-func (o *DiskCreateRequest) GetSizeMB() int {
-	return o.SizeMB
+// GetServerCount returns value of ServerCount
+func (o *BridgeSwitchInfo) GetServerCount() int {
+	return o.ServerCount
 }
 
-// SetSizeMB sets value to SizeMB
-func (o *DiskCreateRequest) SetSizeMB(v int) {
-	o.SizeMB = v
+// SetServerCount sets value to ServerCount
+func (o *BridgeSwitchInfo) SetServerCount(v int) {
+	o.ServerCount = v
 }
 
-// GetSizeGB gets value to SizeGB
-func (o *DiskCreateRequest) GetSizeGB() int {
-	return accessor.GetSizeGB(o)
+// GetApplianceCount returns value of ApplianceCount
+func (o *BridgeSwitchInfo) GetApplianceCount() int {
+	return o.ApplianceCount
 }
 
-// SetSizeGB sets value to SizeGB
-func (o *DiskCreateRequest) SetSizeGB(v int) {
-	accessor.SetSizeGB(o, v)
+// SetApplianceCount sets value to ApplianceCount
+func (o *BridgeSwitchInfo) SetApplianceCount(v int) {
+	o.ApplianceCount = v
+}
+
+// convertTo returns naked BridgeSwitchInfo
+func (o *BridgeSwitchInfo) convertTo() (*naked.BridgeSwitchInfo, error) {
+	dest := &naked.BridgeSwitchInfo{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked BridgeSwitchInfo
+func (o *BridgeSwitchInfo) convertFrom(naked *naked.BridgeSwitchInfo) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* BridgeCreateRequest
+*************************************************/
+
+// BridgeCreateRequest represents API parameter/response structure
+type BridgeCreateRequest struct {
+	Name        string `validate:"required"`
+	Description string `validate:"min=0,max=512"`
+}
+
+// Validate validates by field tags
+func (o *BridgeCreateRequest) Validate() error {
+	return validator.New().Struct(o)
 }
 
 // GetName returns value of Name
-func (o *DiskCreateRequest) GetName() string {
+func (o *BridgeCreateRequest) GetName() string {
 	return o.Name
 }
 
 // SetName sets value to Name
-func (o *DiskCreateRequest) SetName(v string) {
+func (o *BridgeCreateRequest) SetName(v string) {
 	o.Name = v
 }
 
 // GetDescription returns value of Description
-func (o *DiskCreateRequest) GetDescription() string {
+func (o *BridgeCreateRequest) GetDescription() string {
 	return o.Description
 }
 
 // SetDescription sets value to Description
-func (o *DiskCreateRequest) SetDescription(v string) {
+func (o *BridgeCreateRequest) SetDescription(v string) {
 	o.Description = v
 }
 
-// GetTags returns value of Tags
-func (o *DiskCreateRequest) GetTags() []string {
-	return o.Tags
+// convertTo returns naked BridgeCreateRequest
+func (o *BridgeCreateRequest) convertTo() (*naked.Bridge, error) {
+	dest := &naked.Bridge{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetTags sets value to Tags
-func (o *DiskCreateRequest) SetTags(v []string) {
-	o.Tags = v
+// convertFrom parse values from naked BridgeCreateRequest
+func (o *BridgeCreateRequest) convertFrom(naked *naked.Bridge) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// GetIconID returns value of IconID
-func (o *DiskCreateRequest) GetIconID() types.ID {
-	return o.IconID
+/*************************************************
+* BridgeUpdateRequest
+*************************************************/
+
+// BridgeUpdateRequest represents API parameter/response structure
+type BridgeUpdateRequest struct {
+	Name        string `validate:"required"`
+	Description string `validate:"min=0,max=512"`
 }
 
-// SetIconID sets value to IconID
-func (o *DiskCreateRequest) SetIconID(v types.ID) {
-	o.IconID = v
+// Validate validates by field tags
+func (o *BridgeUpdateRequest) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// convertTo returns naked DiskCreateRequest
-func (o *DiskCreateRequest) convertTo() (*naked.Disk, error) {
-	dest := &naked.Disk{}
+// GetName returns value of Name
+func (o *BridgeUpdateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *BridgeUpdateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *BridgeUpdateRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *BridgeUpdateRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// convertTo returns naked BridgeUpdateRequest
+func (o *BridgeUpdateRequest) convertTo() (*naked.Bridge, error) {
+	dest := &naked.Bridge{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked DiskCreateRequest
-func (o *DiskCreateRequest) convertFrom(naked *naked.Disk) error {
+// convertFrom parse values from naked BridgeUpdateRequest
+func (o *BridgeUpdateRequest) convertFrom(naked *naked.Bridge) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* DiskEditRequest
+* CDROM
 *************************************************/
 
-// DiskEditRequest represents API parameter/response structure
-type DiskEditRequest struct {
-	Password            string              `json:",omitempty" mapconv:",omitempty"`
-	SSHKey              *DiskEditSSHKey     `json:",omitempty" mapconv:",omitempty,recursive"`
-	SSHKeys             []*DiskEditSSHKey   `json:",omitempty" mapconv:"[]SSHKeys,omitempty,recursive"`
-	DisablePWAuth       bool                `json:",omitempty" mapconv:",omitempty"`
-	EnableDHCP          bool                `json:",omitempty" mapconv:",omitempty"`
-	ChangePartitionUUID bool                `json:",omitempty" mapconv:",omitempty"`
-	HostName            string              `json:",omitempty" mapconv:",omitempty"`
-	Notes               []*DiskEditNote     `json:",omitempty" mapconv:",omitempty,recursive"`
-	UserIPAddress       string              `json:",omitempty" mapconv:",omitempty"`
-	UserSubnet          *DiskEditUserSubnet `json:",omitempty" mapconv:",omitempty"`
+// CDROM represents API parameter/response structure
+type CDROM struct {
+	ID           types.ID
+	Name         string `validate:"required"`
+	Description  string `validate:"min=0,max=512"`
+	DisplayOrder int
+	Tags         []string
+	Availability types.EAvailability
+	Scope        types.EScope
+	Storage      *Storage `json:",omitempty" mapconv:",omitempty,recursive"`
+	IconID       types.ID `mapconv:"Icon.ID"`
+	CreatedAt    time.Time
+	ModifiedAt   time.Time
 }
 
 // Validate validates by field tags
-func (o *DiskEditRequest) Validate() error {
+func (o *CDROM) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetPassword returns value of Password
-func (o *DiskEditRequest) GetPassword() string {
-	return o.Password
+// GetID returns value of ID
+func (o *CDROM) GetID() types.ID {
+	return o.ID
 }
 
-// SetPassword sets value to Password
-func (o *DiskEditRequest) SetPassword(v string) {
-	o.Password = v
+// SetID sets value to ID
+func (o *CDROM) SetID(v types.ID) {
+	o.ID = v
 }
 
-// GetSSHKey returns value of SSHKey
-func (o *DiskEditRequest) GetSSHKey() *DiskEditSSHKey {
-	return o.SSHKey
+// GetStringID gets value to StringID
+func (o *CDROM) GetStringID() string {
+	return accessor.GetStringID(o)
 }
 
-// SetSSHKey sets value to SSHKey
-func (o *DiskEditRequest) SetSSHKey(v *DiskEditSSHKey) {
-	o.SSHKey = v
+// SetStringID sets value to StringID
+func (o *CDROM) SetStringID(v string) {
+	accessor.SetStringID(o, v)
 }
 
-// GetSSHKeys returns value of SSHKeys
-func (o *DiskEditRequest) GetSSHKeys() []*DiskEditSSHKey {
-	return o.SSHKeys
+// GetInt64ID gets value to Int64ID
+func (o *CDROM) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
 }
 
-// SetSSHKeys sets value to SSHKeys
-func (o *DiskEditRequest) SetSSHKeys(v []*DiskEditSSHKey) {
-	o.SSHKeys = v
+// SetInt64ID sets value to Int64ID
+func (o *CDROM) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
 }
 
-// GetDisablePWAuth returns value of DisablePWAuth
-func (o *DiskEditRequest) GetDisablePWAuth() bool {
-	return o.DisablePWAuth
+// GetName returns value of Name
+func (o *CDROM) GetName() string {
+	return o.Name
 }
 
-// SetDisablePWAuth sets value to DisablePWAuth
-func (o *DiskEditRequest) SetDisablePWAuth(v bool) {
-	o.DisablePWAuth = v
+// SetName sets value to Name
+func (o *CDROM) SetName(v string) {
+	o.Name = v
 }
 
-// GetEnableDHCP returns value of EnableDHCP
-func (o *DiskEditRequest) GetEnableDHCP() bool {
-	return o.EnableDHCP
+// GetDescription returns value of Description
+func (o *CDROM) GetDescription() string {
+	return o.Description
 }
 
-// SetEnableDHCP sets value to EnableDHCP
-func (o *DiskEditRequest) SetEnableDHCP(v bool) {
-	o.EnableDHCP = v
+// SetDescription sets value to Description
+func (o *CDROM) SetDescription(v string) {
+	o.Description = v
 }
 
-// GetChangePartitionUUID returns value of ChangePartitionUUID
-func (o *DiskEditRequest) GetChangePartitionUUID() bool {
-	return o.ChangePartitionUUID
+// GetDisplayOrder returns value of DisplayOrder
+func (o *CDROM) GetDisplayOrder() int {
+	return o.DisplayOrder
 }
 
-// SetChangePartitionUUID sets value to ChangePartitionUUID
-func (o *DiskEditRequest) SetChangePartitionUUID(v bool) {
-	o.ChangePartitionUUID = v
+// SetDisplayOrder sets value to DisplayOrder
+func (o *CDROM) SetDisplayOrder(v int) {
+	o.DisplayOrder = v
 }
 
-// GetHostName returns value of HostName
-func (o *DiskEditRequest) GetHostName() string {
-	return o.HostName
+// GetTags returns value of Tags
+func (o *CDROM) GetTags() []string {
+	return o.Tags
 }
 
-// SetHostName sets value to HostName
-func (o *DiskEditRequest) SetHostName(v string) {
-	o.HostName = v
+// SetTags sets value to Tags
+func (o *CDROM) SetTags(v []string) {
+	o.Tags = v
 }
 
-// GetNotes returns value of Notes
-func (o *DiskEditRequest) GetNotes() []*DiskEditNote {
-	return o.Notes
+// GetAvailability returns value of Availability
+func (o *CDROM) GetAvailability() types.EAvailability {
+	return o.Availability
 }
 
-// SetNotes sets value to Notes
-func (o *DiskEditRequest) SetNotes(v []*DiskEditNote) {
-	o.Notes = v
+// SetAvailability sets value to Availability
+func (o *CDROM) SetAvailability(v types.EAvailability) {
+	o.Availability = v
 }
 
-// GetUserIPAddress returns value of UserIPAddress
-func (o *DiskEditRequest) GetUserIPAddress() string {
-	return o.UserIPAddress
+// GetScope returns value of Scope
+func (o *CDROM) GetScope() types.EScope {
+	return o.Scope
 }
 
-// SetUserIPAddress sets value to UserIPAddress
-func (o *DiskEditRequest) SetUserIPAddress(v string) {
-	o.UserIPAddress = v
+// SetScope sets value to Scope
+func (o *CDROM) SetScope(v types.EScope) {
+	o.Scope = v
 }
 
-// GetUserSubnet returns value of UserSubnet
-func (o *DiskEditRequest) GetUserSubnet() *DiskEditUserSubnet {
-	return o.UserSubnet
+// GetStorage returns value of Storage
+func (o *CDROM) GetStorage() *Storage {
+	return o.Storage
 }
 
-// SetUserSubnet sets value to UserSubnet
-func (o *DiskEditRequest) SetUserSubnet(v *DiskEditUserSubnet) {
-	o.UserSubnet = v
+// SetStorage sets value to Storage
+func (o *CDROM) SetStorage(v *Storage) {
+	o.Storage = v
 }
 
-// convertTo returns naked DiskEditRequest
-func (o *DiskEditRequest) convertTo() (*naked.DiskEdit, error) {
-	dest := &naked.DiskEdit{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetIconID returns value of IconID
+func (o *CDROM) GetIconID() types.ID {
+	return o.IconID
 }
 
-// convertFrom parse values from naked DiskEditRequest
-func (o *DiskEditRequest) convertFrom(naked *naked.DiskEdit) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetIconID sets value to IconID
+func (o *CDROM) SetIconID(v types.ID) {
+	o.IconID = v
 }
 
-/*************************************************
-* DiskEditSSHKey
-*************************************************/
-
-// DiskEditSSHKey represents API parameter/response structure
-type DiskEditSSHKey struct {
-	ID        types.ID `json:",omitempty" mapconv:",omitempty"`
-	PublicKey string   `json:",omitempty" mapconv:",omitempty"`
+// GetCreatedAt returns value of CreatedAt
+func (o *CDROM) GetCreatedAt() time.Time {
+	return o.CreatedAt
 }
 
-// Validate validates by field tags
-func (o *DiskEditSSHKey) Validate() error {
-	return validator.New().Struct(o)
+// SetCreatedAt sets value to CreatedAt
+func (o *CDROM) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
 }
 
-// GetID returns value of ID
-func (o *DiskEditSSHKey) GetID() types.ID {
-	return o.ID
+// GetModifiedAt returns value of ModifiedAt
+func (o *CDROM) GetModifiedAt() time.Time {
+	return o.ModifiedAt
 }
 
-// SetID sets value to ID
-func (o *DiskEditSSHKey) SetID(v types.ID) {
-	o.ID = v
+// SetModifiedAt sets value to ModifiedAt
+func (o *CDROM) SetModifiedAt(v time.Time) {
+	o.ModifiedAt = v
 }
 
-// GetPublicKey returns value of PublicKey
-func (o *DiskEditSSHKey) GetPublicKey() string {
-	return o.PublicKey
+// convertTo returns naked CDROM
+func (o *CDROM) convertTo() (*naked.CDROM, error) {
+	dest := &naked.CDROM{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetPublicKey sets value to PublicKey
-func (o *DiskEditSSHKey) SetPublicKey(v string) {
-	o.PublicKey = v
+// convertFrom parse values from naked CDROM
+func (o *CDROM) convertFrom(naked *naked.CDROM) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* DiskEditNote
+* CDROMCreateRequest
 *************************************************/
 
-// DiskEditNote represents API parameter/response structure
-type DiskEditNote struct {
-	ID        types.ID               `json:",omitempty" mapconv:",omitempty"`
-	Variables map[string]interface{} `json:",omitempty" mapconv:",omitempty"`
-}
-
-// Validate validates by field tags
-func (o *DiskEditNote) Validate() error {
-	return validator.New().Struct(o)
-}
-
-// GetID returns value of ID
-func (o *DiskEditNote) GetID() types.ID {
-	return o.ID
-}
-
-// SetID sets value to ID
-func (o *DiskEditNote) SetID(v types.ID) {
-	o.ID = v
-}
-
-// GetVariables returns value of Variables
-func (o *DiskEditNote) GetVariables() map[string]interface{} {
-	return o.Variables
-}
-
-// SetVariables sets value to Variables
-func (o *DiskEditNote) SetVariables(v map[string]interface{}) {
-	o.Variables = v
-}
-
-/*************************************************
-* DiskEditUserSubnet
-*************************************************/
-
-// DiskEditUserSubnet represents API parameter/response structure
-type DiskEditUserSubnet struct {
-	DefaultRoute   string `json:",omitempty" mapconv:",omitempty"`
-	NetworkMaskLen int    `json:",omitempty" mapconv:",omitempty" validate:"min=0,max=32"`
+// CDROMCreateRequest represents API parameter/response structure
+type CDROMCreateRequest struct {
+	SizeMB      int
+	Name        string `validate:"required"`
+	Description string `validate:"min=0,max=512"`
+	Tags        []string
+	IconID      types.ID `mapconv:"Icon.ID"`
 }
 
 // Validate validates by field tags
-func (o *DiskEditUserSubnet) Validate() error {
+func (o *CDROMCreateRequest) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetDefaultRoute returns value of DefaultRoute
-func (o *DiskEditUserSubnet) GetDefaultRoute() string {
-	return o.DefaultRoute
-}
-
-// SetDefaultRoute sets value to DefaultRoute
-func (o *DiskEditUserSubnet) SetDefaultRoute(v string) {
-	o.DefaultRoute = v
-}
-
-// GetNetworkMaskLen returns value of NetworkMaskLen
-func (o *DiskEditUserSubnet) GetNetworkMaskLen() int {
-	return o.NetworkMaskLen
+// GetSizeMB returns value of SizeMB
+func (o *CDROMCreateRequest) GetSizeMB() int {
+	return o.SizeMB
 }
 
-// SetNetworkMaskLen sets value to NetworkMaskLen
-func (o *DiskEditUserSubnet) SetNetworkMaskLen(v int) {
-	o.NetworkMaskLen = v
+// SetSizeMB sets value to SizeMB
+func (o *CDROMCreateRequest) SetSizeMB(v int) {
+	o.SizeMB = v
 }
 
-/*************************************************
-* DiskInstallRequest
-*************************************************/
-
-// DiskInstallRequest represents API parameter/response structure
-type DiskInstallRequest struct {
-	SourceDiskID    types.ID `mapconv:"SourceDisk.ID,omitempty"`
-	SourceArchiveID types.ID `mapconv:"SourceArchive.ID,omitempty"`
-	SizeMB          int
+// GetSizeGB gets value to SizeGB
+func (o *CDROMCreateRequest) GetSizeGB() int {
+	return accessor.GetSizeGB(o)
 }
 
-// Validate validates by field tags
-func (o *DiskInstallRequest) Validate() error {
-	return validator.New().Struct(o)
+// SetSizeGB sets value to SizeGB
+func (o *CDROMCreateRequest) SetSizeGB(v int) {
+	accessor.SetSizeGB(o, v)
 }
 
-// GetSourceDiskID returns value of SourceDiskID
-func (o *DiskInstallRequest) GetSourceDiskID() types.ID {
-	return o.SourceDiskID
+// GetName returns value of Name
+func (o *CDROMCreateRequest) GetName() string {
+	return o.Name
 }
 
-// SetSourceDiskID sets value to SourceDiskID
-func (o *DiskInstallRequest) SetSourceDiskID(v types.ID) {
-	o.SourceDiskID = v
+// SetName sets value to Name
+func (o *CDROMCreateRequest) SetName(v string) {
+	o.Name = v
 }
 
-// GetSourceArchiveID returns value of SourceArchiveID
-func (o *DiskInstallRequest) GetSourceArchiveID() types.ID {
-	return o.SourceArchiveID
+// GetDescription returns value of Description
+func (o *CDROMCreateRequest) GetDescription() string {
+	return o.Description
 }
 
-// SetSourceArchiveID sets value to SourceArchiveID
-func (o *DiskInstallRequest) SetSourceArchiveID(v types.ID) {
-	o.SourceArchiveID = v
+// SetDescription sets value to Description
+func (o *CDROMCreateRequest) SetDescription(v string) {
+	o.Description = v
 }
 
-// GetSizeMB returns value of SizeMB
-func (o *DiskInstallRequest) GetSizeMB() int {
-	return o.SizeMB
+// GetTags returns value of Tags
+func (o *CDROMCreateRequest) GetTags() []string {
+	return o.Tags
 }
 
-// SetSizeMB sets value to SizeMB
-func (o *DiskInstallRequest) SetSizeMB(v int) {
-	o.SizeMB = v
+// SetTags sets value to Tags
+func (o *CDROMCreateRequest) SetTags(v []string) {
+	o.Tags = v
 }
 
-// GetSizeGB gets value to SizeGB
-func (o *DiskInstallRequest) GetSizeGB() int {
-	return accessor.GetSizeGB(o)
+// GetIconID returns value of IconID
+func (o *CDROMCreateRequest) GetIconID() types.ID {
+	return o.IconID
 }
 
-// SetSizeGB sets value to SizeGB
-func (o *DiskInstallRequest) SetSizeGB(v int) {
-	accessor.SetSizeGB(o, v)
+// SetIconID sets value to IconID
+func (o *CDROMCreateRequest) SetIconID(v types.ID) {
+	o.IconID = v
 }
 
-// convertTo returns naked DiskInstallRequest
-func (o *DiskInstallRequest) convertTo() (*naked.Disk, error) {
-	dest := &naked.Disk{}
+// convertTo returns naked CDROMCreateRequest
+func (o *CDROMCreateRequest) convertTo() (*naked.CDROM, error) {
+	dest := &naked.CDROM{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked DiskInstallRequest
-func (o *DiskInstallRequest) convertFrom(naked *naked.Disk) error {
+// convertFrom parse values from naked CDROMCreateRequest
+func (o *CDROMCreateRequest) convertFrom(naked *naked.CDROM) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* DiskUpdateRequest
+* CDROMUpdateRequest
 *************************************************/
 
-// DiskUpdateRequest represents API parameter/response structure
-type DiskUpdateRequest struct {
+// CDROMUpdateRequest represents API parameter/response structure
+type CDROMUpdateRequest struct {
 	Name        string `validate:"required"`
 	Description string `validate:"min=0,max=512"`
 	Tags        []string
-	IconID      types.ID              `mapconv:"Icon.ID"`
-	Connection  types.EDiskConnection `json:",omitempty" mapconv:",omitempty"`
+	IconID      types.ID `mapconv:"Icon.ID"`
 }
 
 // Validate validates by field tags
-func (o *DiskUpdateRequest) Validate() error {
+func (o *CDROMUpdateRequest) Validate() error {
 	return validator.New().Struct(o)
 }
 
 // GetName returns value of Name
-func (o *DiskUpdateRequest) GetName() string {
+func (o *CDROMUpdateRequest) GetName() string {
 	return o.Name
 }
 
 // SetName sets value to Name
-func (o *DiskUpdateRequest) SetName(v string) {
+func (o *CDROMUpdateRequest) SetName(v string) {
 	o.Name = v
 }
 
 // GetDescription returns value of Description
-func (o *DiskUpdateRequest) GetDescription() string {
+func (o *CDROMUpdateRequest) GetDescription() string {
 	return o.Description
 }
 
 // SetDescription sets value to Description
-func (o *DiskUpdateRequest) SetDescription(v string) {
+func (o *CDROMUpdateRequest) SetDescription(v string) {
 	o.Description = v
 }
 
 // GetTags returns value of Tags
-func (o *DiskUpdateRequest) GetTags() []string {
+func (o *CDROMUpdateRequest) GetTags() []string {
 	return o.Tags
 }
 
 // SetTags sets value to Tags
-func (o *DiskUpdateRequest) SetTags(v []string) {
+func (o *CDROMUpdateRequest) SetTags(v []string) {
 	o.Tags = v
 }
 
 // GetIconID returns value of IconID
-func (o *DiskUpdateRequest) GetIconID() types.ID {
+func (o *CDROMUpdateRequest) GetIconID() types.ID {
 	return o.IconID
 }
 
 // SetIconID sets value to IconID
-func (o *DiskUpdateRequest) SetIconID(v types.ID) {
+func (o *CDROMUpdateRequest) SetIconID(v types.ID) {
 	o.IconID = v
 }
 
-// GetConnection returns value of Connection
-func (o *DiskUpdateRequest) GetConnection() types.EDiskConnection {
-	return o.Connection
-}
-
-// SetConnection sets value to Connection
-func (o *DiskUpdateRequest) SetConnection(v types.EDiskConnection) {
-	o.Connection = v
-}
-
-// convertTo returns naked DiskUpdateRequest
-func (o *DiskUpdateRequest) convertTo() (*naked.Disk, error) {
-	dest := &naked.Disk{}
+// convertTo returns naked CDROMUpdateRequest
+func (o *CDROMUpdateRequest) convertTo() (*naked.CDROM, error) {
+	dest := &naked.CDROM{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked DiskUpdateRequest
-func (o *DiskUpdateRequest) convertFrom(naked *naked.Disk) error {
+// convertFrom parse values from naked CDROMUpdateRequest
+func (o *CDROMUpdateRequest) convertFrom(naked *naked.CDROM) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* DiskActivity
+* Coupon
 *************************************************/
 
-// DiskActivity represents API parameter/response structure
-type DiskActivity struct {
-	Values []*MonitorDiskValue `mapconv:"[]Disk"`
+// Coupon represents API parameter/response structure
+type Coupon struct {
+	ID             types.ID `mapconv:"CouponID"`
+	MemberID       string
+	ContractID     types.ID
+	ServiceClassID types.ID
+	Discount       int64
+	AppliedAt      time.Time
+	UntilAt        time.Time
 }
 
 // Validate validates by field tags
-func (o *DiskActivity) Validate() error {
+func (o *Coupon) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetValues returns value of Values
-func (o *DiskActivity) GetValues() []*MonitorDiskValue {
-	return o.Values
+// GetID returns value of ID
+func (o *Coupon) GetID() types.ID {
+	return o.ID
 }
 
-// SetValues sets value to Values
-func (o *DiskActivity) SetValues(v []*MonitorDiskValue) {
-	o.Values = v
+// SetID sets value to ID
+func (o *Coupon) SetID(v types.ID) {
+	o.ID = v
 }
 
-// convertTo returns naked DiskActivity
-func (o *DiskActivity) convertTo() (*naked.MonitorValues, error) {
-	dest := &naked.MonitorValues{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetStringID gets value to StringID
+func (o *Coupon) GetStringID() string {
+	return accessor.GetStringID(o)
 }
 
-// convertFrom parse values from naked DiskActivity
-func (o *DiskActivity) convertFrom(naked *naked.MonitorValues) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetStringID sets value to StringID
+func (o *Coupon) SetStringID(v string) {
+	accessor.SetStringID(o, v)
 }
 
-/*************************************************
-* MonitorDiskValue
-*************************************************/
+// GetInt64ID gets value to Int64ID
+func (o *Coupon) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
 
-// MonitorDiskValue represents API parameter/response structure
-type MonitorDiskValue struct {
-	Time  time.Time `json:",omitempty" mapconv:",omitempty"`
-	Read  float64   `json:",omitempty" mapconv:",omitempty"`
-	Write float64   `json:",omitempty" mapconv:",omitempty"`
+// SetInt64ID sets value to Int64ID
+func (o *Coupon) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
 }
 
-// Validate validates by field tags
-func (o *MonitorDiskValue) Validate() error {
-	return validator.New().Struct(o)
+// GetMemberID returns value of MemberID
+func (o *Coupon) GetMemberID() string {
+	return o.MemberID
 }
 
-// GetTime returns value of Time
-func (o *MonitorDiskValue) GetTime() time.Time {
-	return o.Time
+// SetMemberID sets value to MemberID
+func (o *Coupon) SetMemberID(v string) {
+	o.MemberID = v
 }
 
-// SetTime sets value to Time
-func (o *MonitorDiskValue) SetTime(v time.Time) {
-	o.Time = v
+// GetContractID returns value of ContractID
+func (o *Coupon) GetContractID() types.ID {
+	return o.ContractID
 }
 
-// GetRead returns value of Read
-func (o *MonitorDiskValue) GetRead() float64 {
-	return o.Read
+// SetContractID sets value to ContractID
+func (o *Coupon) SetContractID(v types.ID) {
+	o.ContractID = v
 }
 
-// SetRead sets value to Read
-func (o *MonitorDiskValue) SetRead(v float64) {
-	o.Read = v
+// GetServiceClassID returns value of ServiceClassID
+func (o *Coupon) GetServiceClassID() types.ID {
+	return o.ServiceClassID
 }
 
-// GetWrite returns value of Write
-func (o *MonitorDiskValue) GetWrite() float64 {
-	return o.Write
+// SetServiceClassID sets value to ServiceClassID
+func (o *Coupon) SetServiceClassID(v types.ID) {
+	o.ServiceClassID = v
 }
 
-// SetWrite sets value to Write
-func (o *MonitorDiskValue) SetWrite(v float64) {
-	o.Write = v
+// GetDiscount returns value of Discount
+func (o *Coupon) GetDiscount() int64 {
+	return o.Discount
 }
 
-// convertTo returns naked MonitorDiskValue
-func (o *MonitorDiskValue) convertTo() (*naked.MonitorDiskValue, error) {
-	dest := &naked.MonitorDiskValue{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
-}
-
-// convertFrom parse values from naked MonitorDiskValue
-func (o *MonitorDiskValue) convertFrom(naked *naked.MonitorDiskValue) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetDiscount sets value to Discount
+func (o *Coupon) SetDiscount(v int64) {
+	o.Discount = v
 }
 
-/*************************************************
-* MonitorCondition
-*************************************************/
-
-// MonitorCondition represents API parameter/response structure
-type MonitorCondition struct {
-	Start time.Time `json:",omitempty"`
-	End   time.Time `json:",omitempty"`
+// GetAppliedAt returns value of AppliedAt
+func (o *Coupon) GetAppliedAt() time.Time {
+	return o.AppliedAt
 }
 
-// Validate validates by field tags
-func (o *MonitorCondition) Validate() error {
-	return validator.New().Struct(o)
+// SetAppliedAt sets value to AppliedAt
+func (o *Coupon) SetAppliedAt(v time.Time) {
+	o.AppliedAt = v
 }
 
-// GetStart returns value of Start
-func (o *MonitorCondition) GetStart() time.Time {
-	return o.Start
+// GetUntilAt returns value of UntilAt
+func (o *Coupon) GetUntilAt() time.Time {
+	return o.UntilAt
 }
 
-// SetStart sets value to Start
-func (o *MonitorCondition) SetStart(v time.Time) {
-	o.Start = v
+// SetUntilAt sets value to UntilAt
+func (o *Coupon) SetUntilAt(v time.Time) {
+	o.UntilAt = v
 }
 
-// GetEnd returns value of End
-func (o *MonitorCondition) GetEnd() time.Time {
-	return o.End
+// convertTo returns naked Coupon
+func (o *Coupon) convertTo() (*naked.Coupon, error) {
+	dest := &naked.Coupon{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetEnd sets value to End
-func (o *MonitorCondition) SetEnd(v time.Time) {
-	o.End = v
+// convertFrom parse values from naked Coupon
+func (o *Coupon) convertFrom(naked *naked.Coupon) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* GSLB
+* Database
 *************************************************/
 
-// GSLB represents API parameter/response structure
-type GSLB struct {
+// Database represents API parameter/response structure
+type Database struct {
 	ID                      types.ID
+	Class                   string
 	Name                    string `validate:"required"`
 	Description             string `validate:"min=0,max=512"`
 	Tags                    []string
@@ -2778,893 +3091,1094 @@ type GSLB struct {
 	IconID                  types.ID `mapconv:"Icon.ID"`
 	CreatedAt               time.Time
 	ModifiedAt              time.Time
-	Class                   string `mapconv:"Provider.Class,default=gslb"`
+	InstanceHostName        string                      `mapconv:"Instance.Host.Name"`
+	InstanceHostInfoURL     string                      `mapconv:"Instance.Host.InfoURL"`
+	InstanceStatus          types.EServerInstanceStatus `mapconv:"Instance.Status"`
+	InstanceStatusChangedAt time.Time                   `mapconv:"Instance.StatusChangedAt"`
+	PlanID                  types.ID                    `mapconv:"Remark.Plan.ID/Plan.ID"`
+	SwitchID                types.ID                    `mapconv:"Remark.Switch.ID"`
+	Conf                    *DatabaseRemarkDBConfCommon `mapconv:"Remark.DBConf.Common,recursive"`
+	DefaultRoute            string                      `mapconv:"Remark.Network.DefaultRoute" validate:"ipv4"`
+	NetworkMaskLen          int                         `mapconv:"Remark.Network.NetworkMaskLen" validate:"min=1,max=32"`
+	IPAddresses             []string                    `mapconv:"Remark.[]Servers.IPAddress"`
+	ZoneID                  types.ID                    `mapconv:"Remark.Zone.ID"`
+	CommonSetting           *DatabaseSettingCommon      `mapconv:"Settings.DBConf.Common,recursive"`
+	BackupSetting           *DatabaseSettingBackup      `mapconv:"Settings.DBConf.Backup,recursive"`
+	ReplicationSetting      *DatabaseReplicationSetting `mapconv:"Settings.DBConf.Replication,recursive"`
 	SettingsHash            string
-	FQDN                    string             `mapconv:"Status.FQDN"`
-	DelayLoop               int                `mapconv:"Settings.GSLB.DelayLoop,default=10" validate:"min=10,max=60"`
-	Weighted                types.StringFlag   `mapconv:"Settings.GSLB.Weighted"`
-	HealthCheckProtocol     types.Protocol     `mapconv:"Settings.GSLB.HealthCheck.Protocol" validate:"oneof=http https ping tcp"`
-	HealthCheckHostHeader   string             `mapconv:"Settings.GSLB.HealthCheck.Host"`
-	HealthCheckPath         string             `mapconv:"Settings.GSLB.HealthCheck.Path"`
-	HealthCheckResponseCode types.StringNumber `mapconv:"Settings.GSLB.HealthCheck.Status"`
-	HealthCheckPort         types.StringNumber `mapconv:"Settings.GSLB.HealthCheck.Port"`
-	SorryServer             string             `mapconv:"Settings.GSLB.SorryServer"`
-	DestinationServers      []*GSLBServer      `mapconv:"Settings.GSLB.[]Servers,recursive" validate:"min=0,max=6"`
 }
 
 // Validate validates by field tags
-func (o *GSLB) Validate() error {
+func (o *Database) Validate() error {
 	return validator.New().Struct(o)
 }
 
 // GetID returns value of ID
-func (o *GSLB) GetID() types.ID {
+func (o *Database) GetID() types.ID {
 	return o.ID
 }
 
 // SetID sets value to ID
-func (o *GSLB) SetID(v types.ID) {
+func (o *Database) SetID(v types.ID) {
 	o.ID = v
 }
 
 // GetStringID gets value to StringID
-func (o *GSLB) GetStringID() string {
+func (o *Database) GetStringID() string {
 	return accessor.GetStringID(o)
 }
 
 // SetStringID sets value to StringID
-func (o *GSLB) SetStringID(v string) {
+func (o *Database) SetStringID(v string) {
 	accessor.SetStringID(o, v)
 }
 
 // GetInt64ID gets value to Int64ID
-func (o *GSLB) GetInt64ID() int64 {
+func (o *Database) GetInt64ID() int64 {
 	return accessor.GetInt64ID(o)
 }
 
 // SetInt64ID sets value to Int64ID
-func (o *GSLB) SetInt64ID(v int64) {
+func (o *Database) SetInt64ID(v int64) {
 	accessor.SetInt64ID(o, v)
 }
 
+// GetClass returns value of Class
+func (o *Database) GetClass() string {
+	return o.Class
+}
+
+// SetClass sets value to Class
+func (o *Database) SetClass(v string) {
+	o.Class = v
+}
+
 // GetName returns value of Name
-func (o *GSLB) GetName() string {
+func (o *Database) GetName() string {
 	return o.Name
 }
 
 // SetName sets value to Name
-func (o *GSLB) SetName(v string) {
+func (o *Database) SetName(v string) {
 	o.Name = v
 }
 
 // GetDescription returns value of Description
-func (o *GSLB) GetDescription() string {
+func (o *Database) GetDescription() string {
 	return o.Description
 }
 
 // SetDescription sets value to Description
-func (o *GSLB) SetDescription(v string) {
+func (o *Database) SetDescription(v string) {
 	o.Description = v
 }
 
 // GetTags returns value of Tags
-func (o *GSLB) GetTags() []string {
+func (o *Database) GetTags() []string {
 	return o.Tags
 }
 
 // SetTags sets value to Tags
-func (o *GSLB) SetTags(v []string) {
+func (o *Database) SetTags(v []string) {
 	o.Tags = v
 }
 
 // GetAvailability returns value of Availability
-func (o *GSLB) GetAvailability() types.EAvailability {
+func (o *Database) GetAvailability() types.EAvailability {
 	return o.Availability
 }
 
 // SetAvailability sets value to Availability
-func (o *GSLB) SetAvailability(v types.EAvailability) {
+func (o *Database) SetAvailability(v types.EAvailability) {
 	o.Availability = v
 }
 
 // GetIconID returns value of IconID
-func (o *GSLB) GetIconID() types.ID {
+func (o *Database) GetIconID() types.ID {
 	return o.IconID
 }
 
 // SetIconID sets value to IconID
-func (o *GSLB) SetIconID(v types.ID) {
+func (o *Database) SetIconID(v types.ID) {
 	o.IconID = v
 }
 
 // GetCreatedAt returns value of CreatedAt
-func (o *GSLB) GetCreatedAt() time.Time {
+func (o *Database) GetCreatedAt() time.Time {
 	return o.CreatedAt
 }
 
 // SetCreatedAt sets value to CreatedAt
-func (o *GSLB) SetCreatedAt(v time.Time) {
+func (o *Database) SetCreatedAt(v time.Time) {
 	o.CreatedAt = v
 }
 
 // GetModifiedAt returns value of ModifiedAt
-func (o *GSLB) GetModifiedAt() time.Time {
+func (o *Database) GetModifiedAt() time.Time {
 	return o.ModifiedAt
 }
 
 // SetModifiedAt sets value to ModifiedAt
-func (o *GSLB) SetModifiedAt(v time.Time) {
+func (o *Database) SetModifiedAt(v time.Time) {
 	o.ModifiedAt = v
 }
 
-// GetClass returns value of Class
-func (o *GSLB) GetClass() string {
-	return o.Class
+// GetInstanceHostName returns value of InstanceHostName
+func (o *Database) GetInstanceHostName() string {
+	return o.InstanceHostName
 }
 
-// SetClass sets value to Class
-func (o *GSLB) SetClass(v string) {
-	o.Class = v
+// SetInstanceHostName sets value to InstanceHostName
+func (o *Database) SetInstanceHostName(v string) {
+	o.InstanceHostName = v
 }
 
-// GetSettingsHash returns value of SettingsHash
-func (o *GSLB) GetSettingsHash() string {
-	return o.SettingsHash
+// GetInstanceHostInfoURL returns value of InstanceHostInfoURL
+func (o *Database) GetInstanceHostInfoURL() string {
+	return o.InstanceHostInfoURL
 }
 
-// SetSettingsHash sets value to SettingsHash
-func (o *GSLB) SetSettingsHash(v string) {
-	o.SettingsHash = v
+// SetInstanceHostInfoURL sets value to InstanceHostInfoURL
+func (o *Database) SetInstanceHostInfoURL(v string) {
+	o.InstanceHostInfoURL = v
 }
 
-// GetFQDN returns value of FQDN
-func (o *GSLB) GetFQDN() string {
-	return o.FQDN
+// GetInstanceStatus returns value of InstanceStatus
+func (o *Database) GetInstanceStatus() types.EServerInstanceStatus {
+	return o.InstanceStatus
 }
 
-// SetFQDN sets value to FQDN
-func (o *GSLB) SetFQDN(v string) {
-	o.FQDN = v
+// SetInstanceStatus sets value to InstanceStatus
+func (o *Database) SetInstanceStatus(v types.EServerInstanceStatus) {
+	o.InstanceStatus = v
 }
 
-// GetDelayLoop returns value of DelayLoop
-func (o *GSLB) GetDelayLoop() int {
-	return o.DelayLoop
+// GetInstanceStatusChangedAt returns value of InstanceStatusChangedAt
+func (o *Database) GetInstanceStatusChangedAt() time.Time {
+	return o.InstanceStatusChangedAt
 }
 
-// SetDelayLoop sets value to DelayLoop
-func (o *GSLB) SetDelayLoop(v int) {
-	o.DelayLoop = v
+// SetInstanceStatusChangedAt sets value to InstanceStatusChangedAt
+func (o *Database) SetInstanceStatusChangedAt(v time.Time) {
+	o.InstanceStatusChangedAt = v
 }
 
-// GetWeighted returns value of Weighted
-func (o *GSLB) GetWeighted() types.StringFlag {
-	return o.Weighted
+// GetPlanID returns value of PlanID
+func (o *Database) GetPlanID() types.ID {
+	return o.PlanID
 }
 
-// SetWeighted sets value to Weighted
-func (o *GSLB) SetWeighted(v types.StringFlag) {
-	o.Weighted = v
+// SetPlanID sets value to PlanID
+func (o *Database) SetPlanID(v types.ID) {
+	o.PlanID = v
 }
 
-// GetHealthCheckProtocol returns value of HealthCheckProtocol
-func (o *GSLB) GetHealthCheckProtocol() types.Protocol {
-	return o.HealthCheckProtocol
+// GetSwitchID returns value of SwitchID
+func (o *Database) GetSwitchID() types.ID {
+	return o.SwitchID
 }
 
-// SetHealthCheckProtocol sets value to HealthCheckProtocol
-func (o *GSLB) SetHealthCheckProtocol(v types.Protocol) {
-	o.HealthCheckProtocol = v
+// SetSwitchID sets value to SwitchID
+func (o *Database) SetSwitchID(v types.ID) {
+	o.SwitchID = v
 }
 
-// GetHealthCheckHostHeader returns value of HealthCheckHostHeader
-func (o *GSLB) GetHealthCheckHostHeader() string {
-	return o.HealthCheckHostHeader
+// GetConf returns value of Conf
+func (o *Database) GetConf() *DatabaseRemarkDBConfCommon {
+	return o.Conf
 }
 
-// SetHealthCheckHostHeader sets value to HealthCheckHostHeader
-func (o *GSLB) SetHealthCheckHostHeader(v string) {
-	o.HealthCheckHostHeader = v
+// SetConf sets value to Conf
+func (o *Database) SetConf(v *DatabaseRemarkDBConfCommon) {
+	o.Conf = v
 }
 
-// GetHealthCheckPath returns value of HealthCheckPath
-func (o *GSLB) GetHealthCheckPath() string {
-	return o.HealthCheckPath
+// GetDefaultRoute returns value of DefaultRoute
+func (o *Database) GetDefaultRoute() string {
+	return o.DefaultRoute
 }
 
-// SetHealthCheckPath sets value to HealthCheckPath
-func (o *GSLB) SetHealthCheckPath(v string) {
-	o.HealthCheckPath = v
+// SetDefaultRoute sets value to DefaultRoute
+func (o *Database) SetDefaultRoute(v string) {
+	o.DefaultRoute = v
 }
 
-// GetHealthCheckResponseCode returns value of HealthCheckResponseCode
-func (o *GSLB) GetHealthCheckResponseCode() types.StringNumber {
-	return o.HealthCheckResponseCode
+// GetNetworkMaskLen returns value of NetworkMaskLen
+func (o *Database) GetNetworkMaskLen() int {
+	return o.NetworkMaskLen
 }
 
-// SetHealthCheckResponseCode sets value to HealthCheckResponseCode
-func (o *GSLB) SetHealthCheckResponseCode(v types.StringNumber) {
-	o.HealthCheckResponseCode = v
+// SetNetworkMaskLen sets value to NetworkMaskLen
+func (o *Database) SetNetworkMaskLen(v int) {
+	o.NetworkMaskLen = v
 }
 
-// GetHealthCheckPort returns value of HealthCheckPort
-func (o *GSLB) GetHealthCheckPort() types.StringNumber {
-	return o.HealthCheckPort
+// GetIPAddresses returns value of IPAddresses
+func (o *Database) GetIPAddresses() []string {
+	return o.IPAddresses
 }
 
-// SetHealthCheckPort sets value to HealthCheckPort
-func (o *GSLB) SetHealthCheckPort(v types.StringNumber) {
-	o.HealthCheckPort = v
+// SetIPAddresses sets value to IPAddresses
+func (o *Database) SetIPAddresses(v []string) {
+	o.IPAddresses = v
 }
 
-// GetSorryServer returns value of SorryServer
-func (o *GSLB) GetSorryServer() string {
-	return o.SorryServer
+// GetZoneID returns value of ZoneID
+func (o *Database) GetZoneID() types.ID {
+	return o.ZoneID
 }
 
-// SetSorryServer sets value to SorryServer
-func (o *GSLB) SetSorryServer(v string) {
-	o.SorryServer = v
+// SetZoneID sets value to ZoneID
+func (o *Database) SetZoneID(v types.ID) {
+	o.ZoneID = v
 }
 
-// GetDestinationServers returns value of DestinationServers
-func (o *GSLB) GetDestinationServers() []*GSLBServer {
-	return o.DestinationServers
+// GetCommonSetting returns value of CommonSetting
+func (o *Database) GetCommonSetting() *DatabaseSettingCommon {
+	return o.CommonSetting
 }
 
-// SetDestinationServers sets value to DestinationServers
-func (o *GSLB) SetDestinationServers(v []*GSLBServer) {
-	o.DestinationServers = v
+// SetCommonSetting sets value to CommonSetting
+func (o *Database) SetCommonSetting(v *DatabaseSettingCommon) {
+	o.CommonSetting = v
 }
 
-// convertTo returns naked GSLB
-func (o *GSLB) convertTo() (*naked.GSLB, error) {
-	dest := &naked.GSLB{}
+// GetBackupSetting returns value of BackupSetting
+func (o *Database) GetBackupSetting() *DatabaseSettingBackup {
+	return o.BackupSetting
+}
+
+// SetBackupSetting sets value to BackupSetting
+func (o *Database) SetBackupSetting(v *DatabaseSettingBackup) {
+	o.BackupSetting = v
+}
+
+// GetReplicationSetting returns value of ReplicationSetting
+func (o *Database) GetReplicationSetting() *DatabaseReplicationSetting {
+	return o.ReplicationSetting
+}
+
+// SetReplicationSetting sets value to ReplicationSetting
+func (o *Database) SetReplicationSetting(v *DatabaseReplicationSetting) {
+	o.ReplicationSetting = v
+}
+
+// GetSettingsHash returns value of SettingsHash
+func (o *Database) GetSettingsHash() string {
+	return o.SettingsHash
+}
+
+// SetSettingsHash sets value to SettingsHash
+func (o *Database) SetSettingsHash(v string) {
+	o.SettingsHash = v
+}
+
+// convertTo returns naked Database
+func (o *Database) convertTo() (*naked.Database, error) {
+	dest := &naked.Database{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked GSLB
-func (o *GSLB) convertFrom(naked *naked.GSLB) error {
+// convertFrom parse values from naked Database
+func (o *Database) convertFrom(naked *naked.Database) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* GSLBServer
+* DatabaseRemarkDBConfCommon
 *************************************************/
 
-// GSLBServer represents API parameter/response structure
-type GSLBServer struct {
-	IPAddress string `validate:"ipv4"`
-	Enabled   types.StringFlag
-	Weight    types.StringNumber `mapconv:",default=1"`
+// DatabaseRemarkDBConfCommon represents API parameter/response structure
+type DatabaseRemarkDBConfCommon struct {
+	DatabaseName     string
+	DatabaseVersion  string
+	DatabaseRevision string
 }
 
 // Validate validates by field tags
-func (o *GSLBServer) Validate() error {
+func (o *DatabaseRemarkDBConfCommon) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetIPAddress returns value of IPAddress
-func (o *GSLBServer) GetIPAddress() string {
-	return o.IPAddress
+// GetDatabaseName returns value of DatabaseName
+func (o *DatabaseRemarkDBConfCommon) GetDatabaseName() string {
+	return o.DatabaseName
 }
 
-// SetIPAddress sets value to IPAddress
-func (o *GSLBServer) SetIPAddress(v string) {
-	o.IPAddress = v
+// SetDatabaseName sets value to DatabaseName
+func (o *DatabaseRemarkDBConfCommon) SetDatabaseName(v string) {
+	o.DatabaseName = v
 }
 
-// GetEnabled returns value of Enabled
-func (o *GSLBServer) GetEnabled() types.StringFlag {
-	return o.Enabled
+// GetDatabaseVersion returns value of DatabaseVersion
+func (o *DatabaseRemarkDBConfCommon) GetDatabaseVersion() string {
+	return o.DatabaseVersion
 }
 
-// SetEnabled sets value to Enabled
-func (o *GSLBServer) SetEnabled(v types.StringFlag) {
-	o.Enabled = v
+// SetDatabaseVersion sets value to DatabaseVersion
+func (o *DatabaseRemarkDBConfCommon) SetDatabaseVersion(v string) {
+	o.DatabaseVersion = v
 }
 
-// GetWeight returns value of Weight
-func (o *GSLBServer) GetWeight() types.StringNumber {
-	return o.Weight
+// GetDatabaseRevision returns value of DatabaseRevision
+func (o *DatabaseRemarkDBConfCommon) GetDatabaseRevision() string {
+	return o.DatabaseRevision
 }
 
-// SetWeight sets value to Weight
-func (o *GSLBServer) SetWeight(v types.StringNumber) {
-	o.Weight = v
+// SetDatabaseRevision sets value to DatabaseRevision
+func (o *DatabaseRemarkDBConfCommon) SetDatabaseRevision(v string) {
+	o.DatabaseRevision = v
 }
 
 /*************************************************
-* GSLBCreateRequest
+* DatabaseSettingCommon
 *************************************************/
 
-// GSLBCreateRequest represents API parameter/response structure
-type GSLBCreateRequest struct {
-	Class                   string             `mapconv:"Provider.Class,default=gslb"`
-	HealthCheckProtocol     types.Protocol     `mapconv:"Settings.GSLB.HealthCheck.Protocol" validate:"oneof=http https ping tcp"`
-	HealthCheckHostHeader   string             `mapconv:"Settings.GSLB.HealthCheck.Host"`
-	HealthCheckPath         string             `mapconv:"Settings.GSLB.HealthCheck.Path"`
-	HealthCheckResponseCode types.StringNumber `mapconv:"Settings.GSLB.HealthCheck.Status"`
-	HealthCheckPort         types.StringNumber `mapconv:"Settings.GSLB.HealthCheck.Port"`
-	DelayLoop               int                `mapconv:"Settings.GSLB.DelayLoop,default=10" validate:"min=10,max=60"`
-	Weighted                types.StringFlag   `mapconv:"Settings.GSLB.Weighted"`
-	SorryServer             string             `mapconv:"Settings.GSLB.SorryServer"`
-	DestinationServers      []*GSLBServer      `mapconv:"Settings.GSLB.[]Servers,recursive" validate:"min=0,max=6"`
-	Name                    string             `validate:"required"`
-	Description             string             `validate:"min=0,max=512"`
-	Tags                    []string
-	IconID                  types.ID `mapconv:"Icon.ID"`
+// DatabaseSettingCommon represents API parameter/response structure
+type DatabaseSettingCommon struct {
+	WebUI           types.WebUI
+	ServicePort     int `validate:"min=0,max=65535"`
+	SourceNetwork   []string
+	DefaultUser     string
+	UserPassword    string
+	ReplicaUser     string
+	ReplicaPassword string
 }
 
 // Validate validates by field tags
-func (o *GSLBCreateRequest) Validate() error {
+func (o *DatabaseSettingCommon) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetClass returns value of Class
-func (o *GSLBCreateRequest) GetClass() string {
-	return o.Class
+// GetWebUI returns value of WebUI
+func (o *DatabaseSettingCommon) GetWebUI() types.WebUI {
+	return o.WebUI
 }
 
-// SetClass sets value to Class
-func (o *GSLBCreateRequest) SetClass(v string) {
-	o.Class = v
+// SetWebUI sets value to WebUI
+func (o *DatabaseSettingCommon) SetWebUI(v types.WebUI) {
+	o.WebUI = v
 }
 
-// GetHealthCheckProtocol returns value of HealthCheckProtocol
-func (o *GSLBCreateRequest) GetHealthCheckProtocol() types.Protocol {
-	return o.HealthCheckProtocol
+// GetServicePort returns value of ServicePort
+func (o *DatabaseSettingCommon) GetServicePort() int {
+	return o.ServicePort
 }
 
-// SetHealthCheckProtocol sets value to HealthCheckProtocol
-func (o *GSLBCreateRequest) SetHealthCheckProtocol(v types.Protocol) {
-	o.HealthCheckProtocol = v
+// SetServicePort sets value to ServicePort
+func (o *DatabaseSettingCommon) SetServicePort(v int) {
+	o.ServicePort = v
 }
 
-// GetHealthCheckHostHeader returns value of HealthCheckHostHeader
-func (o *GSLBCreateRequest) GetHealthCheckHostHeader() string {
-	return o.HealthCheckHostHeader
+// GetSourceNetwork returns value of SourceNetwork
+func (o *DatabaseSettingCommon) GetSourceNetwork() []string {
+	return o.SourceNetwork
 }
 
-// SetHealthCheckHostHeader sets value to HealthCheckHostHeader
-func (o *GSLBCreateRequest) SetHealthCheckHostHeader(v string) {
-	o.HealthCheckHostHeader = v
+// SetSourceNetwork sets value to SourceNetwork
+func (o *DatabaseSettingCommon) SetSourceNetwork(v []string) {
+	o.SourceNetwork = v
 }
 
-// GetHealthCheckPath returns value of HealthCheckPath
-func (o *GSLBCreateRequest) GetHealthCheckPath() string {
-	return o.HealthCheckPath
+// GetDefaultUser returns value of DefaultUser
+func (o *DatabaseSettingCommon) GetDefaultUser() string {
+	return o.DefaultUser
 }
 
-// SetHealthCheckPath sets value to HealthCheckPath
-func (o *GSLBCreateRequest) SetHealthCheckPath(v string) {
-	o.HealthCheckPath = v
+// SetDefaultUser sets value to DefaultUser
+func (o *DatabaseSettingCommon) SetDefaultUser(v string) {
+	o.DefaultUser = v
 }
 
-// GetHealthCheckResponseCode returns value of HealthCheckResponseCode
-func (o *GSLBCreateRequest) GetHealthCheckResponseCode() types.StringNumber {
-	return o.HealthCheckResponseCode
+// GetUserPassword returns value of UserPassword
+func (o *DatabaseSettingCommon) GetUserPassword() string {
+	return o.UserPassword
 }
 
-// SetHealthCheckResponseCode sets value to HealthCheckResponseCode
-func (o *GSLBCreateRequest) SetHealthCheckResponseCode(v types.StringNumber) {
-	o.HealthCheckResponseCode = v
+// SetUserPassword sets value to UserPassword
+func (o *DatabaseSettingCommon) SetUserPassword(v string) {
+	o.UserPassword = v
 }
 
-// GetHealthCheckPort returns value of HealthCheckPort
-func (o *GSLBCreateRequest) GetHealthCheckPort() types.StringNumber {
-	return o.HealthCheckPort
+// GetReplicaUser returns value of ReplicaUser
+func (o *DatabaseSettingCommon) GetReplicaUser() string {
+	return o.ReplicaUser
 }
 
-// SetHealthCheckPort sets value to HealthCheckPort
-func (o *GSLBCreateRequest) SetHealthCheckPort(v types.StringNumber) {
-	o.HealthCheckPort = v
+// SetReplicaUser sets value to ReplicaUser
+func (o *DatabaseSettingCommon) SetReplicaUser(v string) {
+	o.ReplicaUser = v
 }
 
-// GetDelayLoop returns value of DelayLoop
-func (o *GSLBCreateRequest) GetDelayLoop() int {
-	return o.DelayLoop
+// GetReplicaPassword returns value of ReplicaPassword
+func (o *DatabaseSettingCommon) GetReplicaPassword() string {
+	return o.ReplicaPassword
 }
 
-// SetDelayLoop sets value to DelayLoop
-func (o *GSLBCreateRequest) SetDelayLoop(v int) {
-	o.DelayLoop = v
+// SetReplicaPassword sets value to ReplicaPassword
+func (o *DatabaseSettingCommon) SetReplicaPassword(v string) {
+	o.ReplicaPassword = v
 }
 
-// GetWeighted returns value of Weighted
-func (o *GSLBCreateRequest) GetWeighted() types.StringFlag {
-	return o.Weighted
+/*************************************************
+* DatabaseSettingBackup
+*************************************************/
+
+// DatabaseSettingBackup represents API parameter/response structure
+type DatabaseSettingBackup struct {
+	Rotate    int `validate:"min=0,max=8"`
+	Time      string
+	DayOfWeek []string
 }
 
-// SetWeighted sets value to Weighted
-func (o *GSLBCreateRequest) SetWeighted(v types.StringFlag) {
-	o.Weighted = v
+// Validate validates by field tags
+func (o *DatabaseSettingBackup) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetSorryServer returns value of SorryServer
-func (o *GSLBCreateRequest) GetSorryServer() string {
-	return o.SorryServer
+// GetRotate returns value of Rotate
+func (o *DatabaseSettingBackup) GetRotate() int {
+	return o.Rotate
 }
 
-// SetSorryServer sets value to SorryServer
-func (o *GSLBCreateRequest) SetSorryServer(v string) {
-	o.SorryServer = v
+// SetRotate sets value to Rotate
+func (o *DatabaseSettingBackup) SetRotate(v int) {
+	o.Rotate = v
 }
 
-// GetDestinationServers returns value of DestinationServers
-func (o *GSLBCreateRequest) GetDestinationServers() []*GSLBServer {
-	return o.DestinationServers
+// GetTime returns value of Time
+func (o *DatabaseSettingBackup) GetTime() string {
+	return o.Time
 }
 
-// SetDestinationServers sets value to DestinationServers
-func (o *GSLBCreateRequest) SetDestinationServers(v []*GSLBServer) {
-	o.DestinationServers = v
+// SetTime sets value to Time
+func (o *DatabaseSettingBackup) SetTime(v string) {
+	o.Time = v
 }
 
-// GetName returns value of Name
-func (o *GSLBCreateRequest) GetName() string {
-	return o.Name
+// GetDayOfWeek returns value of DayOfWeek
+func (o *DatabaseSettingBackup) GetDayOfWeek() []string {
+	return o.DayOfWeek
 }
 
-// SetName sets value to Name
-func (o *GSLBCreateRequest) SetName(v string) {
-	o.Name = v
+// SetDayOfWeek sets value to DayOfWeek
+func (o *DatabaseSettingBackup) SetDayOfWeek(v []string) {
+	o.DayOfWeek = v
 }
 
-// GetDescription returns value of Description
-func (o *GSLBCreateRequest) GetDescription() string {
-	return o.Description
+/*************************************************
+* DatabaseReplicationSetting
+*************************************************/
+
+// DatabaseReplicationSetting represents API parameter/response structure
+type DatabaseReplicationSetting struct {
+	Model       types.EDatabaseReplicationModel
+	IPAddress   string `validate:"omitempty,ipv4"`
+	Port        int
+	User        string
+	Password    string
+	ApplianceID types.ID `mapconv:"Appliance.ID"`
 }
 
-// SetDescription sets value to Description
-func (o *GSLBCreateRequest) SetDescription(v string) {
-	o.Description = v
+// Validate validates by field tags
+func (o *DatabaseReplicationSetting) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetTags returns value of Tags
-func (o *GSLBCreateRequest) GetTags() []string {
-	return o.Tags
+// GetModel returns value of Model
+func (o *DatabaseReplicationSetting) GetModel() types.EDatabaseReplicationModel {
+	return o.Model
 }
 
-// SetTags sets value to Tags
-func (o *GSLBCreateRequest) SetTags(v []string) {
-	o.Tags = v
+// SetModel sets value to Model
+func (o *DatabaseReplicationSetting) SetModel(v types.EDatabaseReplicationModel) {
+	o.Model = v
 }
 
-// GetIconID returns value of IconID
-func (o *GSLBCreateRequest) GetIconID() types.ID {
-	return o.IconID
+// GetIPAddress returns value of IPAddress
+func (o *DatabaseReplicationSetting) GetIPAddress() string {
+	return o.IPAddress
 }
 
-// SetIconID sets value to IconID
-func (o *GSLBCreateRequest) SetIconID(v types.ID) {
-	o.IconID = v
+// SetIPAddress sets value to IPAddress
+func (o *DatabaseReplicationSetting) SetIPAddress(v string) {
+	o.IPAddress = v
 }
 
-// convertTo returns naked GSLBCreateRequest
-func (o *GSLBCreateRequest) convertTo() (*naked.GSLB, error) {
-	dest := &naked.GSLB{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetPort returns value of Port
+func (o *DatabaseReplicationSetting) GetPort() int {
+	return o.Port
 }
 
-// convertFrom parse values from naked GSLBCreateRequest
-func (o *GSLBCreateRequest) convertFrom(naked *naked.GSLB) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetPort sets value to Port
+func (o *DatabaseReplicationSetting) SetPort(v int) {
+	o.Port = v
 }
 
-/*************************************************
-* GSLBUpdateRequest
-*************************************************/
+// GetUser returns value of User
+func (o *DatabaseReplicationSetting) GetUser() string {
+	return o.User
+}
 
-// GSLBUpdateRequest represents API parameter/response structure
-type GSLBUpdateRequest struct {
-	HealthCheckProtocol     types.Protocol     `mapconv:"Settings.GSLB.HealthCheck.Protocol" validate:"oneof=http https ping tcp"`
-	HealthCheckHostHeader   string             `mapconv:"Settings.GSLB.HealthCheck.Host"`
-	HealthCheckPath         string             `mapconv:"Settings.GSLB.HealthCheck.Path"`
-	HealthCheckResponseCode types.StringNumber `mapconv:"Settings.GSLB.HealthCheck.Status"`
-	HealthCheckPort         types.StringNumber `mapconv:"Settings.GSLB.HealthCheck.Port"`
-	DelayLoop               int                `mapconv:"Settings.GSLB.DelayLoop,default=10" validate:"min=10,max=60"`
-	Weighted                types.StringFlag   `mapconv:"Settings.GSLB.Weighted"`
-	SorryServer             string             `mapconv:"Settings.GSLB.SorryServer"`
-	DestinationServers      []*GSLBServer      `mapconv:"Settings.GSLB.[]Servers,recursive" validate:"min=0,max=6"`
-	Name                    string             `validate:"required"`
-	Description             string             `validate:"min=0,max=512"`
-	Tags                    []string
-	IconID                  types.ID `mapconv:"Icon.ID"`
+// SetUser sets value to User
+func (o *DatabaseReplicationSetting) SetUser(v string) {
+	o.User = v
 }
 
-// Validate validates by field tags
-func (o *GSLBUpdateRequest) Validate() error {
-	return validator.New().Struct(o)
+// GetPassword returns value of Password
+func (o *DatabaseReplicationSetting) GetPassword() string {
+	return o.Password
 }
 
-// GetHealthCheckProtocol returns value of HealthCheckProtocol
-func (o *GSLBUpdateRequest) GetHealthCheckProtocol() types.Protocol {
-	return o.HealthCheckProtocol
+// SetPassword sets value to Password
+func (o *DatabaseReplicationSetting) SetPassword(v string) {
+	o.Password = v
 }
 
-// SetHealthCheckProtocol sets value to HealthCheckProtocol
-func (o *GSLBUpdateRequest) SetHealthCheckProtocol(v types.Protocol) {
-	o.HealthCheckProtocol = v
+// GetApplianceID returns value of ApplianceID
+func (o *DatabaseReplicationSetting) GetApplianceID() types.ID {
+	return o.ApplianceID
 }
 
-// GetHealthCheckHostHeader returns value of HealthCheckHostHeader
-func (o *GSLBUpdateRequest) GetHealthCheckHostHeader() string {
-	return o.HealthCheckHostHeader
+// SetApplianceID sets value to ApplianceID
+func (o *DatabaseReplicationSetting) SetApplianceID(v types.ID) {
+	o.ApplianceID = v
 }
 
-// SetHealthCheckHostHeader sets value to HealthCheckHostHeader
-func (o *GSLBUpdateRequest) SetHealthCheckHostHeader(v string) {
-	o.HealthCheckHostHeader = v
+/*************************************************
+* DatabaseCreateRequest
+*************************************************/
+
+// DatabaseCreateRequest represents API parameter/response structure
+type DatabaseCreateRequest struct {
+	Class              string                      `mapconv:",default=database"`
+	PlanID             types.ID                    `mapconv:"Remark.Plan.ID/Plan.ID"`
+	SwitchID           types.ID                    `mapconv:"Remark.Switch.ID"`
+	IPAddresses        []string                    `mapconv:"Remark.[]Servers.IPAddress" validate:"min=1,max=2,dive,ipv4"`
+	NetworkMaskLen     int                         `mapconv:"Remark.Network.NetworkMaskLen" validate:"min=1,max=32"`
+	DefaultRoute       string                      `mapconv:"Remark.Network.DefaultRoute" validate:"ipv4"`
+	Conf               *DatabaseRemarkDBConfCommon `mapconv:"Remark.DBConf.Common,recursive"`
+	Name               string                      `validate:"required"`
+	Description        string                      `validate:"min=0,max=512"`
+	Tags               []string
+	IconID             types.ID                    `mapconv:"Icon.ID"`
+	CommonSetting      *DatabaseSettingCommon      `mapconv:"Settings.DBConf.Common,recursive"`
+	BackupSetting      *DatabaseSettingBackup      `mapconv:"Settings.DBConf.Backup,recursive"`
+	ReplicationSetting *DatabaseReplicationSetting `mapconv:"Settings.DBConf.Replication,recursive"`
 }
 
-// GetHealthCheckPath returns value of HealthCheckPath
-func (o *GSLBUpdateRequest) GetHealthCheckPath() string {
-	return o.HealthCheckPath
+// Validate validates by field tags
+func (o *DatabaseCreateRequest) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// SetHealthCheckPath sets value to HealthCheckPath
-func (o *GSLBUpdateRequest) SetHealthCheckPath(v string) {
-	o.HealthCheckPath = v
+// GetClass returns value of Class
+func (o *DatabaseCreateRequest) GetClass() string {
+	return o.Class
 }
 
-// GetHealthCheckResponseCode returns value of HealthCheckResponseCode
-func (o *GSLBUpdateRequest) GetHealthCheckResponseCode() types.StringNumber {
-	return o.HealthCheckResponseCode
+// SetClass sets value to Class
+func (o *DatabaseCreateRequest) SetClass(v string) {
+	o.Class = v
 }
 
-// SetHealthCheckResponseCode sets value to HealthCheckResponseCode
-func (o *GSLBUpdateRequest) SetHealthCheckResponseCode(v types.StringNumber) {
-	o.HealthCheckResponseCode = v
+// GetPlanID returns value of PlanID
+func (o *DatabaseCreateRequest) GetPlanID() types.ID {
+	return o.PlanID
 }
 
-// GetHealthCheckPort returns value of HealthCheckPort
-func (o *GSLBUpdateRequest) GetHealthCheckPort() types.StringNumber {
-	return o.HealthCheckPort
+// SetPlanID sets value to PlanID
+func (o *DatabaseCreateRequest) SetPlanID(v types.ID) {
+	o.PlanID = v
 }
 
-// SetHealthCheckPort sets value to HealthCheckPort
-func (o *GSLBUpdateRequest) SetHealthCheckPort(v types.StringNumber) {
-	o.HealthCheckPort = v
+// GetSwitchID returns value of SwitchID
+func (o *DatabaseCreateRequest) GetSwitchID() types.ID {
+	return o.SwitchID
 }
 
-// GetDelayLoop returns value of DelayLoop
-func (o *GSLBUpdateRequest) GetDelayLoop() int {
-	return o.DelayLoop
+// SetSwitchID sets value to SwitchID
+func (o *DatabaseCreateRequest) SetSwitchID(v types.ID) {
+	o.SwitchID = v
 }
 
-// SetDelayLoop sets value to DelayLoop
-func (o *GSLBUpdateRequest) SetDelayLoop(v int) {
-	o.DelayLoop = v
+// GetIPAddresses returns value of IPAddresses
+func (o *DatabaseCreateRequest) GetIPAddresses() []string {
+	return o.IPAddresses
 }
 
-// GetWeighted returns value of Weighted
-func (o *GSLBUpdateRequest) GetWeighted() types.StringFlag {
-	return o.Weighted
+// SetIPAddresses sets value to IPAddresses
+func (o *DatabaseCreateRequest) SetIPAddresses(v []string) {
+	o.IPAddresses = v
 }
 
-// SetWeighted sets value to Weighted
-func (o *GSLBUpdateRequest) SetWeighted(v types.StringFlag) {
-	o.Weighted = v
+// GetNetworkMaskLen returns value of NetworkMaskLen
+func (o *DatabaseCreateRequest) GetNetworkMaskLen() int {
+	return o.NetworkMaskLen
 }
 
-// GetSorryServer returns value of SorryServer
-func (o *GSLBUpdateRequest) GetSorryServer() string {
-	return o.SorryServer
+// SetNetworkMaskLen sets value to NetworkMaskLen
+func (o *DatabaseCreateRequest) SetNetworkMaskLen(v int) {
+	o.NetworkMaskLen = v
 }
 
-// SetSorryServer sets value to SorryServer
-func (o *GSLBUpdateRequest) SetSorryServer(v string) {
-	o.SorryServer = v
+// GetDefaultRoute returns value of DefaultRoute
+func (o *DatabaseCreateRequest) GetDefaultRoute() string {
+	return o.DefaultRoute
 }
 
-// GetDestinationServers returns value of DestinationServers
-func (o *GSLBUpdateRequest) GetDestinationServers() []*GSLBServer {
-	return o.DestinationServers
+// SetDefaultRoute sets value to DefaultRoute
+func (o *DatabaseCreateRequest) SetDefaultRoute(v string) {
+	o.DefaultRoute = v
 }
 
-// SetDestinationServers sets value to DestinationServers
-func (o *GSLBUpdateRequest) SetDestinationServers(v []*GSLBServer) {
-	o.DestinationServers = v
+// GetConf returns value of Conf
+func (o *DatabaseCreateRequest) GetConf() *DatabaseRemarkDBConfCommon {
+	return o.Conf
+}
+
+// SetConf sets value to Conf
+func (o *DatabaseCreateRequest) SetConf(v *DatabaseRemarkDBConfCommon) {
+	o.Conf = v
 }
 
 // GetName returns value of Name
-func (o *GSLBUpdateRequest) GetName() string {
+func (o *DatabaseCreateRequest) GetName() string {
 	return o.Name
 }
 
 // SetName sets value to Name
-func (o *GSLBUpdateRequest) SetName(v string) {
+func (o *DatabaseCreateRequest) SetName(v string) {
 	o.Name = v
 }
 
 // GetDescription returns value of Description
-func (o *GSLBUpdateRequest) GetDescription() string {
+func (o *DatabaseCreateRequest) GetDescription() string {
 	return o.Description
 }
 
 // SetDescription sets value to Description
-func (o *GSLBUpdateRequest) SetDescription(v string) {
+func (o *DatabaseCreateRequest) SetDescription(v string) {
 	o.Description = v
 }
 
 // GetTags returns value of Tags
-func (o *GSLBUpdateRequest) GetTags() []string {
+func (o *DatabaseCreateRequest) GetTags() []string {
 	return o.Tags
 }
 
 // SetTags sets value to Tags
-func (o *GSLBUpdateRequest) SetTags(v []string) {
+func (o *DatabaseCreateRequest) SetTags(v []string) {
 	o.Tags = v
 }
 
 // GetIconID returns value of IconID
-func (o *GSLBUpdateRequest) GetIconID() types.ID {
+func (o *DatabaseCreateRequest) GetIconID() types.ID {
 	return o.IconID
 }
 
 // SetIconID sets value to IconID
-func (o *GSLBUpdateRequest) SetIconID(v types.ID) {
+func (o *DatabaseCreateRequest) SetIconID(v types.ID) {
 	o.IconID = v
 }
 
-// convertTo returns naked GSLBUpdateRequest
-func (o *GSLBUpdateRequest) convertTo() (*naked.GSLB, error) {
-	dest := &naked.GSLB{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetCommonSetting returns value of CommonSetting
+func (o *DatabaseCreateRequest) GetCommonSetting() *DatabaseSettingCommon {
+	return o.CommonSetting
 }
 
-// convertFrom parse values from naked GSLBUpdateRequest
-func (o *GSLBUpdateRequest) convertFrom(naked *naked.GSLB) error {
+// SetCommonSetting sets value to CommonSetting
+func (o *DatabaseCreateRequest) SetCommonSetting(v *DatabaseSettingCommon) {
+	o.CommonSetting = v
+}
+
+// GetBackupSetting returns value of BackupSetting
+func (o *DatabaseCreateRequest) GetBackupSetting() *DatabaseSettingBackup {
+	return o.BackupSetting
+}
+
+// SetBackupSetting sets value to BackupSetting
+func (o *DatabaseCreateRequest) SetBackupSetting(v *DatabaseSettingBackup) {
+	o.BackupSetting = v
+}
+
+// GetReplicationSetting returns value of ReplicationSetting
+func (o *DatabaseCreateRequest) GetReplicationSetting() *DatabaseReplicationSetting {
+	return o.ReplicationSetting
+}
+
+// SetReplicationSetting sets value to ReplicationSetting
+func (o *DatabaseCreateRequest) SetReplicationSetting(v *DatabaseReplicationSetting) {
+	o.ReplicationSetting = v
+}
+
+// convertTo returns naked DatabaseCreateRequest
+func (o *DatabaseCreateRequest) convertTo() (*naked.Database, error) {
+	dest := &naked.Database{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked DatabaseCreateRequest
+func (o *DatabaseCreateRequest) convertFrom(naked *naked.Database) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* Interface
+* DatabaseUpdateRequest
 *************************************************/
 
-// Interface represents API parameter/response structure
-type Interface struct {
-	ID             types.ID
-	MACAddress     string
-	IPAddress      string
-	UserIPAddress  string
-	HostName       string
-	SwitchID       types.ID `mapconv:"Switch.ID,omitempty"`
-	PacketFilterID types.ID `mapconv:"PacketFilter.ID,omitempty"`
-	ServerID       types.ID `mapconv:"Server.ID,omitempty"`
-	CreatedAt      time.Time
-	ModifiedAt     time.Time
+// DatabaseUpdateRequest represents API parameter/response structure
+type DatabaseUpdateRequest struct {
+	Name               string `validate:"required"`
+	Description        string `validate:"min=0,max=512"`
+	Tags               []string
+	IconID             types.ID                    `mapconv:"Icon.ID"`
+	CommonSetting      *DatabaseSettingCommon      `mapconv:"Settings.DBConf.Common,recursive"`
+	BackupSetting      *DatabaseSettingBackup      `mapconv:"Settings.DBConf.Backup,recursive"`
+	ReplicationSetting *DatabaseReplicationSetting `mapconv:"Settings.DBConf.Replication,recursive"`
 }
 
 // Validate validates by field tags
-func (o *Interface) Validate() error {
+func (o *DatabaseUpdateRequest) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetID returns value of ID
-func (o *Interface) GetID() types.ID {
-	return o.ID
+// GetName returns value of Name
+func (o *DatabaseUpdateRequest) GetName() string {
+	return o.Name
 }
 
-// SetID sets value to ID
-func (o *Interface) SetID(v types.ID) {
-	o.ID = v
+// SetName sets value to Name
+func (o *DatabaseUpdateRequest) SetName(v string) {
+	o.Name = v
 }
 
-// GetStringID gets value to StringID
-func (o *Interface) GetStringID() string {
-	return accessor.GetStringID(o)
+// GetDescription returns value of Description
+func (o *DatabaseUpdateRequest) GetDescription() string {
+	return o.Description
 }
 
-// SetStringID sets value to StringID
-func (o *Interface) SetStringID(v string) {
-	accessor.SetStringID(o, v)
+// SetDescription sets value to Description
+func (o *DatabaseUpdateRequest) SetDescription(v string) {
+	o.Description = v
 }
 
-// GetInt64ID gets value to Int64ID
-func (o *Interface) GetInt64ID() int64 {
-	return accessor.GetInt64ID(o)
+// GetTags returns value of Tags
+func (o *DatabaseUpdateRequest) GetTags() []string {
+	return o.Tags
 }
 
-// SetInt64ID sets value to Int64ID
-func (o *Interface) SetInt64ID(v int64) {
-	accessor.SetInt64ID(o, v)
+// SetTags sets value to Tags
+func (o *DatabaseUpdateRequest) SetTags(v []string) {
+	o.Tags = v
 }
 
-// GetMACAddress returns value of MACAddress
-func (o *Interface) GetMACAddress() string {
-	return o.MACAddress
+// GetIconID returns value of IconID
+func (o *DatabaseUpdateRequest) GetIconID() types.ID {
+	return o.IconID
 }
 
-// SetMACAddress sets value to MACAddress
-func (o *Interface) SetMACAddress(v string) {
-	o.MACAddress = v
+// SetIconID sets value to IconID
+func (o *DatabaseUpdateRequest) SetIconID(v types.ID) {
+	o.IconID = v
 }
 
-// GetIPAddress returns value of IPAddress
-func (o *Interface) GetIPAddress() string {
-	return o.IPAddress
+// GetCommonSetting returns value of CommonSetting
+func (o *DatabaseUpdateRequest) GetCommonSetting() *DatabaseSettingCommon {
+	return o.CommonSetting
 }
 
-// SetIPAddress sets value to IPAddress
-func (o *Interface) SetIPAddress(v string) {
-	o.IPAddress = v
+// SetCommonSetting sets value to CommonSetting
+func (o *DatabaseUpdateRequest) SetCommonSetting(v *DatabaseSettingCommon) {
+	o.CommonSetting = v
 }
 
-// GetUserIPAddress returns value of UserIPAddress
-func (o *Interface) GetUserIPAddress() string {
-	return o.UserIPAddress
+// GetBackupSetting returns value of BackupSetting
+func (o *DatabaseUpdateRequest) GetBackupSetting() *DatabaseSettingBackup {
+	return o.BackupSetting
 }
 
-// SetUserIPAddress sets value to UserIPAddress
-func (o *Interface) SetUserIPAddress(v string) {
-	o.UserIPAddress = v
+// SetBackupSetting sets value to BackupSetting
+func (o *DatabaseUpdateRequest) SetBackupSetting(v *DatabaseSettingBackup) {
+	o.BackupSetting = v
 }
 
-// GetHostName returns value of HostName
-func (o *Interface) GetHostName() string {
-	return o.HostName
+// GetReplicationSetting returns value of ReplicationSetting
+func (o *DatabaseUpdateRequest) GetReplicationSetting() *DatabaseReplicationSetting {
+	return o.ReplicationSetting
 }
 
-// SetHostName sets value to HostName
-func (o *Interface) SetHostName(v string) {
-	o.HostName = v
+// SetReplicationSetting sets value to ReplicationSetting
+func (o *DatabaseUpdateRequest) SetReplicationSetting(v *DatabaseReplicationSetting) {
+	o.ReplicationSetting = v
 }
 
-// GetSwitchID returns value of SwitchID
-func (o *Interface) GetSwitchID() types.ID {
-	return o.SwitchID
+// convertTo returns naked DatabaseUpdateRequest
+func (o *DatabaseUpdateRequest) convertTo() (*naked.Database, error) {
+	dest := &naked.Database{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetSwitchID sets value to SwitchID
-func (o *Interface) SetSwitchID(v types.ID) {
-	o.SwitchID = v
+// convertFrom parse values from naked DatabaseUpdateRequest
+func (o *DatabaseUpdateRequest) convertFrom(naked *naked.Database) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// GetPacketFilterID returns value of PacketFilterID
-func (o *Interface) GetPacketFilterID() types.ID {
-	return o.PacketFilterID
+/*************************************************
+* ShutdownOption
+*************************************************/
+
+// ShutdownOption represents API parameter/response structure
+type ShutdownOption struct {
+	Force bool
 }
 
-// SetPacketFilterID sets value to PacketFilterID
-func (o *Interface) SetPacketFilterID(v types.ID) {
-	o.PacketFilterID = v
+// Validate validates by field tags
+func (o *ShutdownOption) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetServerID returns value of ServerID
-func (o *Interface) GetServerID() types.ID {
-	return o.ServerID
+// GetForce returns value of Force
+func (o *ShutdownOption) GetForce() bool {
+	return o.Force
 }
 
-// SetServerID sets value to ServerID
-func (o *Interface) SetServerID(v types.ID) {
-	o.ServerID = v
+// SetForce sets value to Force
+func (o *ShutdownOption) SetForce(v bool) {
+	o.Force = v
 }
 
-// GetCreatedAt returns value of CreatedAt
-func (o *Interface) GetCreatedAt() time.Time {
-	return o.CreatedAt
+/*************************************************
+* CPUTimeActivity
+*************************************************/
+
+// CPUTimeActivity represents API parameter/response structure
+type CPUTimeActivity struct {
+	Values []*MonitorCPUTimeValue `mapconv:"[]CPU"`
 }
 
-// SetCreatedAt sets value to CreatedAt
-func (o *Interface) SetCreatedAt(v time.Time) {
-	o.CreatedAt = v
+// Validate validates by field tags
+func (o *CPUTimeActivity) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetModifiedAt returns value of ModifiedAt
-func (o *Interface) GetModifiedAt() time.Time {
-	return o.ModifiedAt
+// GetValues returns value of Values
+func (o *CPUTimeActivity) GetValues() []*MonitorCPUTimeValue {
+	return o.Values
 }
 
-// SetModifiedAt sets value to ModifiedAt
-func (o *Interface) SetModifiedAt(v time.Time) {
-	o.ModifiedAt = v
+// SetValues sets value to Values
+func (o *CPUTimeActivity) SetValues(v []*MonitorCPUTimeValue) {
+	o.Values = v
 }
 
-// convertTo returns naked Interface
-func (o *Interface) convertTo() (*naked.Interface, error) {
-	dest := &naked.Interface{}
+// convertTo returns naked CPUTimeActivity
+func (o *CPUTimeActivity) convertTo() (*naked.MonitorValues, error) {
+	dest := &naked.MonitorValues{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked Interface
-func (o *Interface) convertFrom(naked *naked.Interface) error {
+// convertFrom parse values from naked CPUTimeActivity
+func (o *CPUTimeActivity) convertFrom(naked *naked.MonitorValues) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* InterfaceCreateRequest
+* MonitorCPUTimeValue
 *************************************************/
 
-// InterfaceCreateRequest represents API parameter/response structure
-type InterfaceCreateRequest struct {
-	ServerID types.ID `mapconv:"Server.ID,omitempty"`
+// MonitorCPUTimeValue represents API parameter/response structure
+type MonitorCPUTimeValue struct {
+	Time    time.Time `json:",omitempty" mapconv:",omitempty"`
+	CPUTime float64   `json:",omitempty" mapconv:",omitempty"`
 }
 
 // Validate validates by field tags
-func (o *InterfaceCreateRequest) Validate() error {
+func (o *MonitorCPUTimeValue) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetServerID returns value of ServerID
-func (o *InterfaceCreateRequest) GetServerID() types.ID {
-	return o.ServerID
+// GetTime returns value of Time
+func (o *MonitorCPUTimeValue) GetTime() time.Time {
+	return o.Time
 }
 
-// SetServerID sets value to ServerID
-func (o *InterfaceCreateRequest) SetServerID(v types.ID) {
-	o.ServerID = v
+// SetTime sets value to Time
+func (o *MonitorCPUTimeValue) SetTime(v time.Time) {
+	o.Time = v
 }
 
-// convertTo returns naked InterfaceCreateRequest
-func (o *InterfaceCreateRequest) convertTo() (*naked.Interface, error) {
-	dest := &naked.Interface{}
+// GetCPUTime returns value of CPUTime
+func (o *MonitorCPUTimeValue) GetCPUTime() float64 {
+	return o.CPUTime
+}
+
+// SetCPUTime sets value to CPUTime
+func (o *MonitorCPUTimeValue) SetCPUTime(v float64) {
+	o.CPUTime = v
+}
+
+// convertTo returns naked MonitorCPUTimeValue
+func (o *MonitorCPUTimeValue) convertTo() (*naked.MonitorCPUTimeValue, error) {
+	dest := &naked.MonitorCPUTimeValue{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked InterfaceCreateRequest
-func (o *InterfaceCreateRequest) convertFrom(naked *naked.Interface) error {
+// convertFrom parse values from naked MonitorCPUTimeValue
+func (o *MonitorCPUTimeValue) convertFrom(naked *naked.MonitorCPUTimeValue) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* InterfaceUpdateRequest
+* MonitorCondition
 *************************************************/
 
-// InterfaceUpdateRequest represents API parameter/response structure
-type InterfaceUpdateRequest struct {
-	UserIPAddress string
+// MonitorCondition represents API parameter/response structure
+type MonitorCondition struct {
+	Start time.Time `json:",omitempty"`
+	End   time.Time `json:",omitempty"`
 }
 
 // Validate validates by field tags
-func (o *InterfaceUpdateRequest) Validate() error {
+func (o *MonitorCondition) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetUserIPAddress returns value of UserIPAddress
-func (o *InterfaceUpdateRequest) GetUserIPAddress() string {
-	return o.UserIPAddress
+// GetStart returns value of Start
+func (o *MonitorCondition) GetStart() time.Time {
+	return o.Start
 }
 
-// SetUserIPAddress sets value to UserIPAddress
-func (o *InterfaceUpdateRequest) SetUserIPAddress(v string) {
-	o.UserIPAddress = v
+// SetStart sets value to Start
+func (o *MonitorCondition) SetStart(v time.Time) {
+	o.Start = v
 }
 
-// convertTo returns naked InterfaceUpdateRequest
-func (o *InterfaceUpdateRequest) convertTo() (*naked.Interface, error) {
-	dest := &naked.Interface{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetEnd returns value of End
+func (o *MonitorCondition) GetEnd() time.Time {
+	return o.End
 }
 
-// convertFrom parse values from naked InterfaceUpdateRequest
-func (o *InterfaceUpdateRequest) convertFrom(naked *naked.Interface) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetEnd sets value to End
+func (o *MonitorCondition) SetEnd(v time.Time) {
+	o.End = v
 }
 
 /*************************************************
-* InterfaceActivity
+* DiskActivity
 *************************************************/
 
-// InterfaceActivity represents API parameter/response structure
-type InterfaceActivity struct {
-	Values []*MonitorInterfaceValue `mapconv:"[]Interface"`
+// DiskActivity represents API parameter/response structure
+type DiskActivity struct {
+	Values []*MonitorDiskValue `mapconv:"[]Disk"`
 }
 
 // Validate validates by field tags
-func (o *InterfaceActivity) Validate() error {
+func (o *DiskActivity) Validate() error {
 	return validator.New().Struct(o)
 }
 
 // GetValues returns value of Values
-func (o *InterfaceActivity) GetValues() []*MonitorInterfaceValue {
+func (o *DiskActivity) GetValues() []*MonitorDiskValue {
+	return o.Values
+}
+
+// SetValues sets value to Values
+func (o *DiskActivity) SetValues(v []*MonitorDiskValue) {
+	o.Values = v
+}
+
+// convertTo returns naked DiskActivity
+func (o *DiskActivity) convertTo() (*naked.MonitorValues, error) {
+	dest := &naked.MonitorValues{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked DiskActivity
+func (o *DiskActivity) convertFrom(naked *naked.MonitorValues) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* MonitorDiskValue
+*************************************************/
+
+// MonitorDiskValue represents API parameter/response structure
+type MonitorDiskValue struct {
+	Time  time.Time `json:",omitempty" mapconv:",omitempty"`
+	Read  float64   `json:",omitempty" mapconv:",omitempty"`
+	Write float64   `json:",omitempty" mapconv:",omitempty"`
+}
+
+// Validate validates by field tags
+func (o *MonitorDiskValue) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetTime returns value of Time
+func (o *MonitorDiskValue) GetTime() time.Time {
+	return o.Time
+}
+
+// SetTime sets value to Time
+func (o *MonitorDiskValue) SetTime(v time.Time) {
+	o.Time = v
+}
+
+// GetRead returns value of Read
+func (o *MonitorDiskValue) GetRead() float64 {
+	return o.Read
+}
+
+// SetRead sets value to Read
+func (o *MonitorDiskValue) SetRead(v float64) {
+	o.Read = v
+}
+
+// GetWrite returns value of Write
+func (o *MonitorDiskValue) GetWrite() float64 {
+	return o.Write
+}
+
+// SetWrite sets value to Write
+func (o *MonitorDiskValue) SetWrite(v float64) {
+	o.Write = v
+}
+
+// convertTo returns naked MonitorDiskValue
+func (o *MonitorDiskValue) convertTo() (*naked.MonitorDiskValue, error) {
+	dest := &naked.MonitorDiskValue{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked MonitorDiskValue
+func (o *MonitorDiskValue) convertFrom(naked *naked.MonitorDiskValue) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* InterfaceActivity
+*************************************************/
+
+// InterfaceActivity represents API parameter/response structure
+type InterfaceActivity struct {
+	Values []*MonitorInterfaceValue `mapconv:"[]Interface"`
+}
+
+// Validate validates by field tags
+func (o *InterfaceActivity) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetValues returns value of Values
+func (o *InterfaceActivity) GetValues() []*MonitorInterfaceValue {
 	return o.Values
 }
 
@@ -3744,2126 +4258,11125 @@ func (o *MonitorInterfaceValue) convertFrom(naked *naked.MonitorInterfaceValue)
 }
 
 /*************************************************
-* Internet
+* DatabaseActivity
 *************************************************/
 
-// Internet represents API parameter/response structure
-type Internet struct {
-	ID             types.ID
-	Name           string `validate:"required"`
-	Description    string `validate:"min=0,max=512"`
-	Tags           []string
-	IconID         types.ID `mapconv:"Icon.ID"`
-	CreatedAt      time.Time
-	BandWidthMbps  int
-	NetworkMaskLen int         `validate:"min=24,max=28"`
-	Switch         *SwitchInfo `mapconv:",recursive"`
+// DatabaseActivity represents API parameter/response structure
+type DatabaseActivity struct {
+	Values []*MonitorDatabaseValue `mapconv:"[]Database"`
 }
 
 // Validate validates by field tags
-func (o *Internet) Validate() error {
+func (o *DatabaseActivity) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetID returns value of ID
-func (o *Internet) GetID() types.ID {
-	return o.ID
+// GetValues returns value of Values
+func (o *DatabaseActivity) GetValues() []*MonitorDatabaseValue {
+	return o.Values
 }
 
-// SetID sets value to ID
-func (o *Internet) SetID(v types.ID) {
-	o.ID = v
+// SetValues sets value to Values
+func (o *DatabaseActivity) SetValues(v []*MonitorDatabaseValue) {
+	o.Values = v
 }
 
-// GetStringID gets value to StringID
-func (o *Internet) GetStringID() string {
-	return accessor.GetStringID(o)
+// convertTo returns naked DatabaseActivity
+func (o *DatabaseActivity) convertTo() (*naked.MonitorValues, error) {
+	dest := &naked.MonitorValues{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetStringID sets value to StringID
-func (o *Internet) SetStringID(v string) {
-	accessor.SetStringID(o, v)
+// convertFrom parse values from naked DatabaseActivity
+func (o *DatabaseActivity) convertFrom(naked *naked.MonitorValues) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// GetInt64ID gets value to Int64ID
-func (o *Internet) GetInt64ID() int64 {
-	return accessor.GetInt64ID(o)
+/*************************************************
+* MonitorDatabaseValue
+*************************************************/
+
+// MonitorDatabaseValue represents API parameter/response structure
+type MonitorDatabaseValue struct {
+	Time              time.Time `json:",omitempty" mapconv:",omitempty"`
+	TotalMemorySize   float64   `json:",omitempty" mapconv:",omitempty"`
+	UsedMemorySize    float64   `json:",omitempty" mapconv:",omitempty"`
+	TotalDisk1Size    float64   `json:",omitempty" mapconv:",omitempty"`
+	UsedDisk1Size     float64   `json:",omitempty" mapconv:",omitempty"`
+	TotalDisk2Size    float64   `json:",omitempty" mapconv:",omitempty"`
+	UsedDisk2Size     float64   `json:",omitempty" mapconv:",omitempty"`
+	BinlogUsedSizeKiB float64   `json:",omitempty" mapconv:",omitempty"`
+	DelayTimeSec      float64   `json:",omitempty" mapconv:",omitempty"`
 }
 
-// SetInt64ID sets value to Int64ID
-func (o *Internet) SetInt64ID(v int64) {
-	accessor.SetInt64ID(o, v)
+// Validate validates by field tags
+func (o *MonitorDatabaseValue) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetName returns value of Name
-func (o *Internet) GetName() string {
-	return o.Name
+// GetTime returns value of Time
+func (o *MonitorDatabaseValue) GetTime() time.Time {
+	return o.Time
 }
 
-// SetName sets value to Name
-func (o *Internet) SetName(v string) {
-	o.Name = v
+// SetTime sets value to Time
+func (o *MonitorDatabaseValue) SetTime(v time.Time) {
+	o.Time = v
 }
 
-// GetDescription returns value of Description
-func (o *Internet) GetDescription() string {
-	return o.Description
+// GetTotalMemorySize  returns value of TotalMemorySize
+func (o *MonitorDatabaseValue) GetTotalMemorySize() float64 {
+	return o.TotalMemorySize
 }
 
-// SetDescription sets value to Description
-func (o *Internet) SetDescription(v string) {
-	o.Description = v
+// SetTotalMemorySize  sets value to TotalMemorySize
+func (o *MonitorDatabaseValue) SetTotalMemorySize(v float64) {
+	o.TotalMemorySize = v
 }
 
-// GetTags returns value of Tags
-func (o *Internet) GetTags() []string {
-	return o.Tags
+// GetUsedMemorySize returns value of UsedMemorySize
+func (o *MonitorDatabaseValue) GetUsedMemorySize() float64 {
+	return o.UsedMemorySize
 }
 
-// SetTags sets value to Tags
-func (o *Internet) SetTags(v []string) {
-	o.Tags = v
+// SetUsedMemorySize sets value to UsedMemorySize
+func (o *MonitorDatabaseValue) SetUsedMemorySize(v float64) {
+	o.UsedMemorySize = v
 }
 
-// GetIconID returns value of IconID
-func (o *Internet) GetIconID() types.ID {
-	return o.IconID
+// GetTotalDisk1Size returns value of TotalDisk1Size
+func (o *MonitorDatabaseValue) GetTotalDisk1Size() float64 {
+	return o.TotalDisk1Size
 }
 
-// SetIconID sets value to IconID
-func (o *Internet) SetIconID(v types.ID) {
-	o.IconID = v
+// SetTotalDisk1Size sets value to TotalDisk1Size
+func (o *MonitorDatabaseValue) SetTotalDisk1Size(v float64) {
+	o.TotalDisk1Size = v
 }
 
-// GetCreatedAt returns value of CreatedAt
-func (o *Internet) GetCreatedAt() time.Time {
-	return o.CreatedAt
+// GetUsedDisk1Size returns value of UsedDisk1Size
+func (o *MonitorDatabaseValue) GetUsedDisk1Size() float64 {
+	return o.UsedDisk1Size
 }
 
-// SetCreatedAt sets value to CreatedAt
-func (o *Internet) SetCreatedAt(v time.Time) {
-	o.CreatedAt = v
+// SetUsedDisk1Size sets value to UsedDisk1Size
+func (o *MonitorDatabaseValue) SetUsedDisk1Size(v float64) {
+	o.UsedDisk1Size = v
 }
 
-// GetBandWidthMbps returns value of BandWidthMbps
-func (o *Internet) GetBandWidthMbps() int {
-	return o.BandWidthMbps
+// GetTotalDisk2Size returns value of TotalDisk2Size
+func (o *MonitorDatabaseValue) GetTotalDisk2Size() float64 {
+	return o.TotalDisk2Size
 }
 
-// SetBandWidthMbps sets value to BandWidthMbps
-func (o *Internet) SetBandWidthMbps(v int) {
-	o.BandWidthMbps = v
+// SetTotalDisk2Size sets value to TotalDisk2Size
+func (o *MonitorDatabaseValue) SetTotalDisk2Size(v float64) {
+	o.TotalDisk2Size = v
 }
 
-// GetNetworkMaskLen returns value of NetworkMaskLen
-func (o *Internet) GetNetworkMaskLen() int {
-	return o.NetworkMaskLen
+// GetUsedDisk2Size returns value of UsedDisk2Size
+func (o *MonitorDatabaseValue) GetUsedDisk2Size() float64 {
+	return o.UsedDisk2Size
 }
 
-// SetNetworkMaskLen sets value to NetworkMaskLen
-func (o *Internet) SetNetworkMaskLen(v int) {
-	o.NetworkMaskLen = v
+// SetUsedDisk2Size sets value to UsedDisk2Size
+func (o *MonitorDatabaseValue) SetUsedDisk2Size(v float64) {
+	o.UsedDisk2Size = v
 }
 
-// GetSwitch returns value of Switch
-func (o *Internet) GetSwitch() *SwitchInfo {
-	return o.Switch
+// GetBinlogUsedSizeKiB returns value of BinlogUsedSizeKiB
+func (o *MonitorDatabaseValue) GetBinlogUsedSizeKiB() float64 {
+	return o.BinlogUsedSizeKiB
 }
 
-// SetSwitch sets value to Switch
-func (o *Internet) SetSwitch(v *SwitchInfo) {
-	o.Switch = v
+// SetBinlogUsedSizeKiB sets value to BinlogUsedSizeKiB
+func (o *MonitorDatabaseValue) SetBinlogUsedSizeKiB(v float64) {
+	o.BinlogUsedSizeKiB = v
 }
 
-// convertTo returns naked Internet
-func (o *Internet) convertTo() (*naked.Internet, error) {
-	dest := &naked.Internet{}
+// GetDelayTimeSec returns value of DelayTimeSec
+func (o *MonitorDatabaseValue) GetDelayTimeSec() float64 {
+	return o.DelayTimeSec
+}
+
+// SetDelayTimeSec sets value to DelayTimeSec
+func (o *MonitorDatabaseValue) SetDelayTimeSec(v float64) {
+	o.DelayTimeSec = v
+}
+
+// convertTo returns naked MonitorDatabaseValue
+func (o *MonitorDatabaseValue) convertTo() (*naked.MonitorDatabaseValue, error) {
+	dest := &naked.MonitorDatabaseValue{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked Internet
-func (o *Internet) convertFrom(naked *naked.Internet) error {
+// convertFrom parse values from naked MonitorDatabaseValue
+func (o *MonitorDatabaseValue) convertFrom(naked *naked.MonitorDatabaseValue) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* SwitchInfo
+* DatabaseStatus
 *************************************************/
 
-// SwitchInfo represents API parameter/response structure
-type SwitchInfo struct {
-	ID          types.ID
-	Name        string `validate:"required"`
-	Description string `validate:"min=0,max=512"`
-	Tags        []string
-	Scope       types.EScope
-	Subnets     []*InternetSubnet `mapconv:"[]Subnets,recursive"`
+// DatabaseStatus represents API parameter/response structure
+type DatabaseStatus struct {
+	Status  types.EServerInstanceStatus `mapconv:"SettingsResponse.status"`
+	IsFatal bool                        `mapconv:"SettingsResponse.is_fatal"`
+	Version *DatabaseVersionInfo        `mapconv:"SettingsResponse.DBConf.version,recursive"`
+	Logs    []*DatabaseLog              `mapconv:"SettingsResponse.DBConf.[]log,recursive"`
+	Backups []*DatabaseBackupHistory    `mapconv:"SettingsResponse.DBConf.backup.[]history,recursive"`
 }
 
 // Validate validates by field tags
-func (o *SwitchInfo) Validate() error {
+func (o *DatabaseStatus) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetID returns value of ID
-func (o *SwitchInfo) GetID() types.ID {
-	return o.ID
+// GetStatus returns value of Status
+func (o *DatabaseStatus) GetStatus() types.EServerInstanceStatus {
+	return o.Status
 }
 
-// SetID sets value to ID
-func (o *SwitchInfo) SetID(v types.ID) {
-	o.ID = v
+// SetStatus sets value to Status
+func (o *DatabaseStatus) SetStatus(v types.EServerInstanceStatus) {
+	o.Status = v
 }
 
-// GetStringID gets value to StringID
-func (o *SwitchInfo) GetStringID() string {
-	return accessor.GetStringID(o)
+// GetIsFatal returns value of IsFatal
+func (o *DatabaseStatus) GetIsFatal() bool {
+	return o.IsFatal
 }
 
-// SetStringID sets value to StringID
-func (o *SwitchInfo) SetStringID(v string) {
-	accessor.SetStringID(o, v)
+// SetIsFatal sets value to IsFatal
+func (o *DatabaseStatus) SetIsFatal(v bool) {
+	o.IsFatal = v
 }
 
-// GetInt64ID gets value to Int64ID
-func (o *SwitchInfo) GetInt64ID() int64 {
-	return accessor.GetInt64ID(o)
+// GetVersion returns value of Version
+func (o *DatabaseStatus) GetVersion() *DatabaseVersionInfo {
+	return o.Version
 }
 
-// SetInt64ID sets value to Int64ID
-func (o *SwitchInfo) SetInt64ID(v int64) {
-	accessor.SetInt64ID(o, v)
+// SetVersion sets value to Version
+func (o *DatabaseStatus) SetVersion(v *DatabaseVersionInfo) {
+	o.Version = v
 }
 
-// GetName returns value of Name
-func (o *SwitchInfo) GetName() string {
-	return o.Name
+// GetLogs returns value of Logs
+func (o *DatabaseStatus) GetLogs() []*DatabaseLog {
+	return o.Logs
 }
 
-// SetName sets value to Name
-func (o *SwitchInfo) SetName(v string) {
-	o.Name = v
+// SetLogs sets value to Logs
+func (o *DatabaseStatus) SetLogs(v []*DatabaseLog) {
+	o.Logs = v
 }
 
-// GetDescription returns value of Description
-func (o *SwitchInfo) GetDescription() string {
-	return o.Description
+// GetBackups returns value of Backups
+func (o *DatabaseStatus) GetBackups() []*DatabaseBackupHistory {
+	return o.Backups
 }
 
-// SetDescription sets value to Description
-func (o *SwitchInfo) SetDescription(v string) {
-	o.Description = v
+// SetBackups sets value to Backups
+func (o *DatabaseStatus) SetBackups(v []*DatabaseBackupHistory) {
+	o.Backups = v
 }
 
-// GetTags returns value of Tags
-func (o *SwitchInfo) GetTags() []string {
-	return o.Tags
+// convertTo returns naked DatabaseStatus
+func (o *DatabaseStatus) convertTo() (*naked.DatabaseStatusResponse, error) {
+	dest := &naked.DatabaseStatusResponse{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetTags sets value to Tags
-func (o *SwitchInfo) SetTags(v []string) {
-	o.Tags = v
+// convertFrom parse values from naked DatabaseStatus
+func (o *DatabaseStatus) convertFrom(naked *naked.DatabaseStatusResponse) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// GetScope returns value of Scope
-func (o *SwitchInfo) GetScope() types.EScope {
-	return o.Scope
-}
+/*************************************************
+* DatabaseVersionInfo
+*************************************************/
 
-// SetScope sets value to Scope
-func (o *SwitchInfo) SetScope(v types.EScope) {
-	o.Scope = v
+// DatabaseVersionInfo represents API parameter/response structure
+type DatabaseVersionInfo struct {
+	LastModified string `mapconv:"lastmodified"`
+	CommitHash   string `mapconv:"commithash"`
+	Status       string `mapconv:"status"`
+	Tag          string `mapconv:"tag"`
+	Expire       string `mapconv:"expire"`
 }
 
-// GetSubnets returns value of Subnets
-func (o *SwitchInfo) GetSubnets() []*InternetSubnet {
-	return o.Subnets
+// Validate validates by field tags
+func (o *DatabaseVersionInfo) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// SetSubnets sets value to Subnets
-func (o *SwitchInfo) SetSubnets(v []*InternetSubnet) {
-	o.Subnets = v
+// GetLastModified returns value of LastModified
+func (o *DatabaseVersionInfo) GetLastModified() string {
+	return o.LastModified
 }
 
-// convertTo returns naked SwitchInfo
-func (o *SwitchInfo) convertTo() (*naked.Switch, error) {
-	dest := &naked.Switch{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// SetLastModified sets value to LastModified
+func (o *DatabaseVersionInfo) SetLastModified(v string) {
+	o.LastModified = v
 }
 
-// convertFrom parse values from naked SwitchInfo
-func (o *SwitchInfo) convertFrom(naked *naked.Switch) error {
-	return mapconv.ConvertFrom(naked, o)
+// GetCommitHash returns value of CommitHash
+func (o *DatabaseVersionInfo) GetCommitHash() string {
+	return o.CommitHash
 }
 
-/*************************************************
-* InternetSubnet
-*************************************************/
-
-// InternetSubnet represents API parameter/response structure
-type InternetSubnet struct {
-	ID             types.ID
-	DefaultRoute   string `validate:"ipv4"`
-	NextHop        string `validate:"ipv4"`
-	StaticRoute    string `validate:"ipv4"`
-	NetworkAddress string `validate:"ipv4"`
-	NetworkMaskLen int    `validate:"min=24,max=28"`
+// SetCommitHash sets value to CommitHash
+func (o *DatabaseVersionInfo) SetCommitHash(v string) {
+	o.CommitHash = v
 }
 
-// Validate validates by field tags
-func (o *InternetSubnet) Validate() error {
-	return validator.New().Struct(o)
+// GetStatus returns value of Status
+func (o *DatabaseVersionInfo) GetStatus() string {
+	return o.Status
 }
 
-// GetID returns value of ID
-func (o *InternetSubnet) GetID() types.ID {
-	return o.ID
+// SetStatus sets value to Status
+func (o *DatabaseVersionInfo) SetStatus(v string) {
+	o.Status = v
 }
 
-// SetID sets value to ID
-func (o *InternetSubnet) SetID(v types.ID) {
-	o.ID = v
+// GetTag returns value of Tag
+func (o *DatabaseVersionInfo) GetTag() string {
+	return o.Tag
 }
 
-// GetStringID gets value to StringID
-func (o *InternetSubnet) GetStringID() string {
-	return accessor.GetStringID(o)
+// SetTag sets value to Tag
+func (o *DatabaseVersionInfo) SetTag(v string) {
+	o.Tag = v
 }
 
-// SetStringID sets value to StringID
-func (o *InternetSubnet) SetStringID(v string) {
-	accessor.SetStringID(o, v)
+// GetExpire returns value of Expire
+func (o *DatabaseVersionInfo) GetExpire() string {
+	return o.Expire
 }
 
-// GetInt64ID gets value to Int64ID
-func (o *InternetSubnet) GetInt64ID() int64 {
-	return accessor.GetInt64ID(o)
+// SetExpire sets value to Expire
+func (o *DatabaseVersionInfo) SetExpire(v string) {
+	o.Expire = v
 }
 
-// SetInt64ID sets value to Int64ID
-func (o *InternetSubnet) SetInt64ID(v int64) {
-	accessor.SetInt64ID(o, v)
+// convertTo returns naked DatabaseVersionInfo
+func (o *DatabaseVersionInfo) convertTo() (*naked.DatabaseStatusVersion, error) {
+	dest := &naked.DatabaseStatusVersion{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// GetDefaultRoute returns value of DefaultRoute
-func (o *InternetSubnet) GetDefaultRoute() string {
-	return o.DefaultRoute
+// convertFrom parse values from naked DatabaseVersionInfo
+func (o *DatabaseVersionInfo) convertFrom(naked *naked.DatabaseStatusVersion) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// SetDefaultRoute sets value to DefaultRoute
-func (o *InternetSubnet) SetDefaultRoute(v string) {
-	o.DefaultRoute = v
-}
+/*************************************************
+* DatabaseLog
+*************************************************/
 
-// GetNextHop returns value of NextHop
-func (o *InternetSubnet) GetNextHop() string {
-	return o.NextHop
+// DatabaseLog represents API parameter/response structure
+type DatabaseLog struct {
+	Name string             `mapconv:"name"`
+	Data string             `mapconv:"data"`
+	Size types.StringNumber `mapconv:"size"`
 }
 
-// SetNextHop sets value to NextHop
-func (o *InternetSubnet) SetNextHop(v string) {
-	o.NextHop = v
+// Validate validates by field tags
+func (o *DatabaseLog) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetStaticRoute returns value of StaticRoute
-func (o *InternetSubnet) GetStaticRoute() string {
-	return o.StaticRoute
+// GetName returns value of Name
+func (o *DatabaseLog) GetName() string {
+	return o.Name
 }
 
-// SetStaticRoute sets value to StaticRoute
-func (o *InternetSubnet) SetStaticRoute(v string) {
-	o.StaticRoute = v
+// SetName sets value to Name
+func (o *DatabaseLog) SetName(v string) {
+	o.Name = v
 }
 
-// GetNetworkAddress returns value of NetworkAddress
-func (o *InternetSubnet) GetNetworkAddress() string {
-	return o.NetworkAddress
+// GetData returns value of Data
+func (o *DatabaseLog) GetData() string {
+	return o.Data
 }
 
-// SetNetworkAddress sets value to NetworkAddress
-func (o *InternetSubnet) SetNetworkAddress(v string) {
-	o.NetworkAddress = v
+// SetData sets value to Data
+func (o *DatabaseLog) SetData(v string) {
+	o.Data = v
 }
 
-// GetNetworkMaskLen returns value of NetworkMaskLen
-func (o *InternetSubnet) GetNetworkMaskLen() int {
-	return o.NetworkMaskLen
+// GetSize returns value of Size
+func (o *DatabaseLog) GetSize() types.StringNumber {
+	return o.Size
 }
 
-// SetNetworkMaskLen sets value to NetworkMaskLen
-func (o *InternetSubnet) SetNetworkMaskLen(v int) {
-	o.NetworkMaskLen = v
+// SetSize sets value to Size
+func (o *DatabaseLog) SetSize(v types.StringNumber) {
+	o.Size = v
 }
 
-// convertTo returns naked InternetSubnet
-func (o *InternetSubnet) convertTo() (*naked.Subnet, error) {
-	dest := &naked.Subnet{}
+// convertTo returns naked DatabaseLog
+func (o *DatabaseLog) convertTo() (*naked.DatabaseLog, error) {
+	dest := &naked.DatabaseLog{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked InternetSubnet
-func (o *InternetSubnet) convertFrom(naked *naked.Subnet) error {
+// convertFrom parse values from naked DatabaseLog
+func (o *DatabaseLog) convertFrom(naked *naked.DatabaseLog) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* InternetCreateRequest
+* DatabaseBackupHistory
 *************************************************/
 
-// InternetCreateRequest represents API parameter/response structure
-type InternetCreateRequest struct {
-	Name           string `validate:"required"`
-	Description    string `validate:"min=0,max=512"`
-	Tags           []string
-	IconID         types.ID `mapconv:"Icon.ID"`
-	NetworkMaskLen int      `validate:"min=24,max=28"`
-	BandWidthMbps  int
+// DatabaseBackupHistory represents API parameter/response structure
+type DatabaseBackupHistory struct {
+	CreatedAt    time.Time          `mapconv:"createdat"`
+	Availability string             `mapconv:"availability"`
+	RecoveredAt  time.Time          `mapconv:"recoveredat"`
+	Size         types.StringNumber `mapconv:"size"`
 }
 
 // Validate validates by field tags
-func (o *InternetCreateRequest) Validate() error {
+func (o *DatabaseBackupHistory) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetName returns value of Name
-func (o *InternetCreateRequest) GetName() string {
-	return o.Name
+// GetCreatedAt returns value of CreatedAt
+func (o *DatabaseBackupHistory) GetCreatedAt() time.Time {
+	return o.CreatedAt
 }
 
-// SetName sets value to Name
-func (o *InternetCreateRequest) SetName(v string) {
-	o.Name = v
+// SetCreatedAt sets value to CreatedAt
+func (o *DatabaseBackupHistory) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
 }
 
-// GetDescription returns value of Description
-func (o *InternetCreateRequest) GetDescription() string {
-	return o.Description
+// GetAvailability returns value of Availability
+func (o *DatabaseBackupHistory) GetAvailability() string {
+	return o.Availability
 }
 
-// SetDescription sets value to Description
-func (o *InternetCreateRequest) SetDescription(v string) {
-	o.Description = v
+// SetAvailability sets value to Availability
+func (o *DatabaseBackupHistory) SetAvailability(v string) {
+	o.Availability = v
 }
 
-// GetTags returns value of Tags
-func (o *InternetCreateRequest) GetTags() []string {
-	return o.Tags
+// GetRecoveredAt returns value of RecoveredAt
+func (o *DatabaseBackupHistory) GetRecoveredAt() time.Time {
+	return o.RecoveredAt
 }
 
-// SetTags sets value to Tags
-func (o *InternetCreateRequest) SetTags(v []string) {
-	o.Tags = v
+// SetRecoveredAt sets value to RecoveredAt
+func (o *DatabaseBackupHistory) SetRecoveredAt(v time.Time) {
+	o.RecoveredAt = v
 }
 
-// GetIconID returns value of IconID
-func (o *InternetCreateRequest) GetIconID() types.ID {
-	return o.IconID
+// GetSize returns value of Size
+func (o *DatabaseBackupHistory) GetSize() types.StringNumber {
+	return o.Size
 }
 
-// SetIconID sets value to IconID
-func (o *InternetCreateRequest) SetIconID(v types.ID) {
-	o.IconID = v
+// SetSize sets value to Size
+func (o *DatabaseBackupHistory) SetSize(v types.StringNumber) {
+	o.Size = v
 }
 
-// GetNetworkMaskLen returns value of NetworkMaskLen
-func (o *InternetCreateRequest) GetNetworkMaskLen() int {
-	return o.NetworkMaskLen
+// convertTo returns naked DatabaseBackupHistory
+func (o *DatabaseBackupHistory) convertTo() (*naked.DatabaseBackupHistory, error) {
+	dest := &naked.DatabaseBackupHistory{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetNetworkMaskLen sets value to NetworkMaskLen
-func (o *InternetCreateRequest) SetNetworkMaskLen(v int) {
-	o.NetworkMaskLen = v
+// convertFrom parse values from naked DatabaseBackupHistory
+func (o *DatabaseBackupHistory) convertFrom(naked *naked.DatabaseBackupHistory) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// GetBandWidthMbps returns value of BandWidthMbps
-func (o *InternetCreateRequest) GetBandWidthMbps() int {
-	return o.BandWidthMbps
+/*************************************************
+* DatabaseParameter
+*************************************************/
+
+// DatabaseParameter represents API parameter/response structure
+type DatabaseParameter struct {
+	Settings map[string]interface{}   `mapconv:"Parameter.Attr"`
+	MetaInfo []*DatabaseParameterMeta `mapconv:"Remark.[]Form,recursive"`
 }
 
-// SetBandWidthMbps sets value to BandWidthMbps
-func (o *InternetCreateRequest) SetBandWidthMbps(v int) {
-	o.BandWidthMbps = v
+// Validate validates by field tags
+func (o *DatabaseParameter) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// convertTo returns naked InternetCreateRequest
-func (o *InternetCreateRequest) convertTo() (*naked.Internet, error) {
-	dest := &naked.Internet{}
+// GetSettings returns value of Settings
+func (o *DatabaseParameter) GetSettings() map[string]interface{} {
+	return o.Settings
+}
+
+// SetSettings sets value to Settings
+func (o *DatabaseParameter) SetSettings(v map[string]interface{}) {
+	o.Settings = v
+}
+
+// GetMetaInfo returns value of MetaInfo
+func (o *DatabaseParameter) GetMetaInfo() []*DatabaseParameterMeta {
+	return o.MetaInfo
+}
+
+// SetMetaInfo sets value to MetaInfo
+func (o *DatabaseParameter) SetMetaInfo(v []*DatabaseParameterMeta) {
+	o.MetaInfo = v
+}
+
+// convertTo returns naked DatabaseParameter
+func (o *DatabaseParameter) convertTo() (*naked.DatabaseParameter, error) {
+	dest := &naked.DatabaseParameter{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked InternetCreateRequest
-func (o *InternetCreateRequest) convertFrom(naked *naked.Internet) error {
+// convertFrom parse values from naked DatabaseParameter
+func (o *DatabaseParameter) convertFrom(naked *naked.DatabaseParameter) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* InternetUpdateRequest
+* DatabaseParameterMeta
 *************************************************/
 
-// InternetUpdateRequest represents API parameter/response structure
-type InternetUpdateRequest struct {
-	Name        string `validate:"required"`
-	Description string `validate:"min=0,max=512"`
-	Tags        []string
-	IconID      types.ID `mapconv:"Icon.ID"`
+// DatabaseParameterMeta represents API parameter/response structure
+type DatabaseParameterMeta struct {
+	Type    string  `mapconv:"type"`
+	Name    string  `mapconv:"name"`
+	Label   string  `mapconv:"label"`
+	Text    string  `mapconv:"options.text"`
+	Example string  `mapconv:"options.ex"`
+	Min     float64 `mapconv:"options.min"`
+	Max     float64 `mapconv:"options.max"`
 }
 
 // Validate validates by field tags
-func (o *InternetUpdateRequest) Validate() error {
+func (o *DatabaseParameterMeta) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetName returns value of Name
-func (o *InternetUpdateRequest) GetName() string {
-	return o.Name
+// GetType returns value of Type
+func (o *DatabaseParameterMeta) GetType() string {
+	return o.Type
 }
 
-// SetName sets value to Name
-func (o *InternetUpdateRequest) SetName(v string) {
-	o.Name = v
+// SetType sets value to Type
+func (o *DatabaseParameterMeta) SetType(v string) {
+	o.Type = v
 }
 
-// GetDescription returns value of Description
-func (o *InternetUpdateRequest) GetDescription() string {
-	return o.Description
+// GetName returns value of Name
+func (o *DatabaseParameterMeta) GetName() string {
+	return o.Name
 }
 
-// SetDescription sets value to Description
-func (o *InternetUpdateRequest) SetDescription(v string) {
-	o.Description = v
+// SetName sets value to Name
+func (o *DatabaseParameterMeta) SetName(v string) {
+	o.Name = v
 }
 
-// GetTags returns value of Tags
-func (o *InternetUpdateRequest) GetTags() []string {
-	return o.Tags
+// GetLabel returns value of Label
+func (o *DatabaseParameterMeta) GetLabel() string {
+	return o.Label
 }
 
-// SetTags sets value to Tags
-func (o *InternetUpdateRequest) SetTags(v []string) {
-	o.Tags = v
+// SetLabel sets value to Label
+func (o *DatabaseParameterMeta) SetLabel(v string) {
+	o.Label = v
 }
 
-// GetIconID returns value of IconID
-func (o *InternetUpdateRequest) GetIconID() types.ID {
-	return o.IconID
+// GetText returns value of Text
+func (o *DatabaseParameterMeta) GetText() string {
+	return o.Text
 }
 
-// SetIconID sets value to IconID
-func (o *InternetUpdateRequest) SetIconID(v types.ID) {
-	o.IconID = v
+// SetText sets value to Text
+func (o *DatabaseParameterMeta) SetText(v string) {
+	o.Text = v
 }
 
-// convertTo returns naked InternetUpdateRequest
-func (o *InternetUpdateRequest) convertTo() (*naked.Internet, error) {
-	dest := &naked.Internet{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetExample returns value of Example
+func (o *DatabaseParameterMeta) GetExample() string {
+	return o.Example
 }
 
-// convertFrom parse values from naked InternetUpdateRequest
-func (o *InternetUpdateRequest) convertFrom(naked *naked.Internet) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetExample sets value to Example
+func (o *DatabaseParameterMeta) SetExample(v string) {
+	o.Example = v
 }
 
-/*************************************************
-* InternetUpdateBandWidthRequest
-*************************************************/
-
-// InternetUpdateBandWidthRequest represents API parameter/response structure
-type InternetUpdateBandWidthRequest struct {
-	BandWidthMbps int
+// GetMin returns value of Min
+func (o *DatabaseParameterMeta) GetMin() float64 {
+	return o.Min
 }
 
-// Validate validates by field tags
-func (o *InternetUpdateBandWidthRequest) Validate() error {
-	return validator.New().Struct(o)
+// SetMin sets value to Min
+func (o *DatabaseParameterMeta) SetMin(v float64) {
+	o.Min = v
 }
 
-// GetBandWidthMbps returns value of BandWidthMbps
-func (o *InternetUpdateBandWidthRequest) GetBandWidthMbps() int {
-	return o.BandWidthMbps
+// GetMax returns value of Max
+func (o *DatabaseParameterMeta) GetMax() float64 {
+	return o.Max
 }
 
-// SetBandWidthMbps sets value to BandWidthMbps
-func (o *InternetUpdateBandWidthRequest) SetBandWidthMbps(v int) {
-	o.BandWidthMbps = v
+// SetMax sets value to Max
+func (o *DatabaseParameterMeta) SetMax(v float64) {
+	o.Max = v
 }
 
-// convertTo returns naked InternetUpdateBandWidthRequest
-func (o *InternetUpdateBandWidthRequest) convertTo() (*naked.Internet, error) {
-	dest := &naked.Internet{}
+// convertTo returns naked DatabaseParameterMeta
+func (o *DatabaseParameterMeta) convertTo() (*naked.DatabaseParameterForm, error) {
+	dest := &naked.DatabaseParameterForm{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked InternetUpdateBandWidthRequest
-func (o *InternetUpdateBandWidthRequest) convertFrom(naked *naked.Internet) error {
+// convertFrom parse values from naked DatabaseParameterMeta
+func (o *DatabaseParameterMeta) convertFrom(naked *naked.DatabaseParameterForm) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* InternetSubnetOperationResult
+* Disk
 *************************************************/
 
-// InternetSubnetOperationResult represents API parameter/response structure
-type InternetSubnetOperationResult struct {
-	ID             types.ID
-	DefaultRoute   string   `validate:"ipv4"`
-	NextHop        string   `validate:"ipv4"`
-	StaticRoute    string   `validate:"ipv4"`
-	NetworkAddress string   `validate:"ipv4"`
-	NetworkMaskLen int      `validate:"min=24,max=28"`
-	IPAddresses    []string `mapconv:"[]IPAddresses.IPAddress"`
+// Disk represents API parameter/response structure
+type Disk struct {
+	ID                        types.ID
+	Name                      string `validate:"required"`
+	Description               string `validate:"min=0,max=512"`
+	Tags                      []string
+	Availability              types.EAvailability
+	Connection                types.EDiskConnection `json:",omitempty" mapconv:",omitempty"`
+	ConnectionOrder           int
+	ReinstallCount            int
+	SizeMB                    int
+	MigratedMB                int
+	DiskPlanID                types.ID            `mapconv:"Plan.ID"`
+	DiskPlanName              string              `mapconv:"Plan.Name"`
+	DiskPlanStorageClass      string              `mapconv:"Plan.StorageClass"`
+	SourceDiskID              types.ID            `mapconv:"SourceDisk.ID,omitempty"`
+	SourceDiskAvailability    types.EAvailability `mapconv:"SourceDisk.Availability,omitempty"`
+	SourceArchiveID           types.ID            `mapconv:"SourceArchive.ID,omitempty"`
+	SourceArchiveAvailability types.EAvailability `mapconv:"SourceArchive.Availability,omitempty"`
+	BundleInfo                *BundleInfo         `json:",omitempty" mapconv:",omitempty,recursive"`
+	Storage                   *Storage            `json:",omitempty" mapconv:",omitempty,recursive"`
+	ServerID                  types.ID            `mapconv:"Server.ID,omitempty"`
+	IconID                    types.ID            `mapconv:"Icon.ID"`
+	CreatedAt                 time.Time
+	ModifiedAt                time.Time
 }
 
 // Validate validates by field tags
-func (o *InternetSubnetOperationResult) Validate() error {
+func (o *Disk) Validate() error {
 	return validator.New().Struct(o)
 }
 
 // GetID returns value of ID
-func (o *InternetSubnetOperationResult) GetID() types.ID {
+func (o *Disk) GetID() types.ID {
 	return o.ID
 }
 
 // SetID sets value to ID
-func (o *InternetSubnetOperationResult) SetID(v types.ID) {
+func (o *Disk) SetID(v types.ID) {
 	o.ID = v
 }
 
 // GetStringID gets value to StringID
-func (o *InternetSubnetOperationResult) GetStringID() string {
+func (o *Disk) GetStringID() string {
 	return accessor.GetStringID(o)
 }
 
 // SetStringID sets value to StringID
-func (o *InternetSubnetOperationResult) SetStringID(v string) {
+func (o *Disk) SetStringID(v string) {
 	accessor.SetStringID(o, v)
 }
 
 // GetInt64ID gets value to Int64ID
-func (o *InternetSubnetOperationResult) GetInt64ID() int64 {
+func (o *Disk) GetInt64ID() int64 {
 	return accessor.GetInt64ID(o)
 }
 
 // SetInt64ID sets value to Int64ID
-func (o *InternetSubnetOperationResult) SetInt64ID(v int64) {
+func (o *Disk) SetInt64ID(v int64) {
 	accessor.SetInt64ID(o, v)
 }
 
-// GetDefaultRoute returns value of DefaultRoute
-func (o *InternetSubnetOperationResult) GetDefaultRoute() string {
-	return o.DefaultRoute
+// GetName returns value of Name
+func (o *Disk) GetName() string {
+	return o.Name
 }
 
-// SetDefaultRoute sets value to DefaultRoute
-func (o *InternetSubnetOperationResult) SetDefaultRoute(v string) {
-	o.DefaultRoute = v
+// SetName sets value to Name
+func (o *Disk) SetName(v string) {
+	o.Name = v
 }
 
-// GetNextHop returns value of NextHop
-func (o *InternetSubnetOperationResult) GetNextHop() string {
-	return o.NextHop
+// GetDescription returns value of Description
+func (o *Disk) GetDescription() string {
+	return o.Description
 }
 
-// SetNextHop sets value to NextHop
-func (o *InternetSubnetOperationResult) SetNextHop(v string) {
-	o.NextHop = v
+// SetDescription sets value to Description
+func (o *Disk) SetDescription(v string) {
+	o.Description = v
 }
 
-// GetStaticRoute returns value of StaticRoute
-func (o *InternetSubnetOperationResult) GetStaticRoute() string {
-	return o.StaticRoute
+// GetTags returns value of Tags
+func (o *Disk) GetTags() []string {
+	return o.Tags
 }
 
-// SetStaticRoute sets value to StaticRoute
-func (o *InternetSubnetOperationResult) SetStaticRoute(v string) {
-	o.StaticRoute = v
+// SetTags sets value to Tags
+func (o *Disk) SetTags(v []string) {
+	o.Tags = v
 }
 
-// GetNetworkAddress returns value of NetworkAddress
-func (o *InternetSubnetOperationResult) GetNetworkAddress() string {
-	return o.NetworkAddress
+// GetAvailability returns value of Availability
+func (o *Disk) GetAvailability() types.EAvailability {
+	return o.Availability
 }
 
-// SetNetworkAddress sets value to NetworkAddress
-func (o *InternetSubnetOperationResult) SetNetworkAddress(v string) {
-	o.NetworkAddress = v
+// SetAvailability sets value to Availability
+func (o *Disk) SetAvailability(v types.EAvailability) {
+	o.Availability = v
 }
 
-// GetNetworkMaskLen returns value of NetworkMaskLen
-func (o *InternetSubnetOperationResult) GetNetworkMaskLen() int {
-	return o.NetworkMaskLen
+// GetConnection returns value of Connection
+func (o *Disk) GetConnection() types.EDiskConnection {
+	return o.Connection
 }
 
-// SetNetworkMaskLen sets value to NetworkMaskLen
-func (o *InternetSubnetOperationResult) SetNetworkMaskLen(v int) {
-	o.NetworkMaskLen = v
+// SetConnection sets value to Connection
+func (o *Disk) SetConnection(v types.EDiskConnection) {
+	o.Connection = v
 }
 
-// GetIPAddresses returns value of IPAddresses
-func (o *InternetSubnetOperationResult) GetIPAddresses() []string {
-	return o.IPAddresses
+// GetConnectionOrder returns value of ConnectionOrder
+func (o *Disk) GetConnectionOrder() int {
+	return o.ConnectionOrder
 }
 
-// SetIPAddresses sets value to IPAddresses
-func (o *InternetSubnetOperationResult) SetIPAddresses(v []string) {
-	o.IPAddresses = v
+// SetConnectionOrder sets value to ConnectionOrder
+func (o *Disk) SetConnectionOrder(v int) {
+	o.ConnectionOrder = v
 }
 
-// convertTo returns naked InternetSubnetOperationResult
-func (o *InternetSubnetOperationResult) convertTo() (*naked.Subnet, error) {
-	dest := &naked.Subnet{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetReinstallCount returns value of ReinstallCount
+func (o *Disk) GetReinstallCount() int {
+	return o.ReinstallCount
 }
 
-// convertFrom parse values from naked InternetSubnetOperationResult
-func (o *InternetSubnetOperationResult) convertFrom(naked *naked.Subnet) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetReinstallCount sets value to ReinstallCount
+func (o *Disk) SetReinstallCount(v int) {
+	o.ReinstallCount = v
 }
 
-/*************************************************
-* InternetAddSubnetRequest
-*************************************************/
-
-// InternetAddSubnetRequest represents API parameter/response structure
-type InternetAddSubnetRequest struct {
-	NetworkMaskLen int    `validate:"min=24,max=28"`
-	NextHop        string `validate:"ipv4"`
+// GetSizeMB returns value of SizeMB
+func (o *Disk) GetSizeMB() int {
+	return o.SizeMB
 }
 
-// Validate validates by field tags
-func (o *InternetAddSubnetRequest) Validate() error {
-	return validator.New().Struct(o)
+// SetSizeMB sets value to SizeMB
+func (o *Disk) SetSizeMB(v int) {
+	o.SizeMB = v
 }
 
-// GetNetworkMaskLen returns value of NetworkMaskLen
-func (o *InternetAddSubnetRequest) GetNetworkMaskLen() int {
-	return o.NetworkMaskLen
+// GetSizeGB gets value to SizeGB
+func (o *Disk) GetSizeGB() int {
+	return accessor.GetSizeGB(o)
 }
 
-// SetNetworkMaskLen sets value to NetworkMaskLen
-func (o *InternetAddSubnetRequest) SetNetworkMaskLen(v int) {
-	o.NetworkMaskLen = v
+// SetSizeGB sets value to SizeGB
+func (o *Disk) SetSizeGB(v int) {
+	accessor.SetSizeGB(o, v)
 }
 
-// GetNextHop returns value of NextHop
-func (o *InternetAddSubnetRequest) GetNextHop() string {
-	return o.NextHop
+// GetMigratedMB returns value of MigratedMB
+func (o *Disk) GetMigratedMB() int {
+	return o.MigratedMB
 }
 
-// SetNextHop sets value to NextHop
-func (o *InternetAddSubnetRequest) SetNextHop(v string) {
-	o.NextHop = v
+// SetMigratedMB sets value to MigratedMB
+func (o *Disk) SetMigratedMB(v int) {
+	o.MigratedMB = v
 }
 
-// convertTo returns naked InternetAddSubnetRequest
-func (o *InternetAddSubnetRequest) convertTo() (*naked.SubnetOperationRequest, error) {
-	dest := &naked.SubnetOperationRequest{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetMigratedGB gets value to MigratedGB
+func (o *Disk) GetMigratedGB() int {
+	return accessor.GetMigratedGB(o)
 }
 
-// convertFrom parse values from naked InternetAddSubnetRequest
-func (o *InternetAddSubnetRequest) convertFrom(naked *naked.SubnetOperationRequest) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetMigratedGB sets value to MigratedGB
+func (o *Disk) SetMigratedGB(v int) {
+	accessor.SetMigratedGB(o, v)
 }
 
-/*************************************************
-* InternetUpdateSubnetRequest
-*************************************************/
-
-// InternetUpdateSubnetRequest represents API parameter/response structure
-type InternetUpdateSubnetRequest struct {
-	NextHop string `validate:"ipv4"`
+// GetDiskPlanID returns value of DiskPlanID
+func (o *Disk) GetDiskPlanID() types.ID {
+	return o.DiskPlanID
 }
 
-// Validate validates by field tags
-func (o *InternetUpdateSubnetRequest) Validate() error {
-	return validator.New().Struct(o)
+// SetDiskPlanID sets value to DiskPlanID
+func (o *Disk) SetDiskPlanID(v types.ID) {
+	o.DiskPlanID = v
 }
 
-// GetNextHop returns value of NextHop
-func (o *InternetUpdateSubnetRequest) GetNextHop() string {
-	return o.NextHop
+// GetDiskPlanName returns value of DiskPlanName
+func (o *Disk) GetDiskPlanName() string {
+	return o.DiskPlanName
 }
 
-// SetNextHop sets value to NextHop
-func (o *InternetUpdateSubnetRequest) SetNextHop(v string) {
-	o.NextHop = v
+// SetDiskPlanName sets value to DiskPlanName
+func (o *Disk) SetDiskPlanName(v string) {
+	o.DiskPlanName = v
 }
 
-// convertTo returns naked InternetUpdateSubnetRequest
-func (o *InternetUpdateSubnetRequest) convertTo() (*naked.SubnetOperationRequest, error) {
-	dest := &naked.SubnetOperationRequest{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetDiskPlanStorageClass returns value of DiskPlanStorageClass
+func (o *Disk) GetDiskPlanStorageClass() string {
+	return o.DiskPlanStorageClass
 }
 
-// convertFrom parse values from naked InternetUpdateSubnetRequest
-func (o *InternetUpdateSubnetRequest) convertFrom(naked *naked.SubnetOperationRequest) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetDiskPlanStorageClass sets value to DiskPlanStorageClass
+func (o *Disk) SetDiskPlanStorageClass(v string) {
+	o.DiskPlanStorageClass = v
 }
 
-/*************************************************
-* RouterActivity
-*************************************************/
-
-// RouterActivity represents API parameter/response structure
-type RouterActivity struct {
-	Values []*MonitorRouterValue `mapconv:"[]Router"`
+// GetSourceDiskID returns value of SourceDiskID
+func (o *Disk) GetSourceDiskID() types.ID {
+	return o.SourceDiskID
 }
 
-// Validate validates by field tags
-func (o *RouterActivity) Validate() error {
-	return validator.New().Struct(o)
+// SetSourceDiskID sets value to SourceDiskID
+func (o *Disk) SetSourceDiskID(v types.ID) {
+	o.SourceDiskID = v
 }
 
-// GetValues returns value of Values
-func (o *RouterActivity) GetValues() []*MonitorRouterValue {
-	return o.Values
+// GetSourceDiskAvailability returns value of SourceDiskAvailability
+func (o *Disk) GetSourceDiskAvailability() types.EAvailability {
+	return o.SourceDiskAvailability
 }
 
-// SetValues sets value to Values
-func (o *RouterActivity) SetValues(v []*MonitorRouterValue) {
-	o.Values = v
+// SetSourceDiskAvailability sets value to SourceDiskAvailability
+func (o *Disk) SetSourceDiskAvailability(v types.EAvailability) {
+	o.SourceDiskAvailability = v
 }
 
-// convertTo returns naked RouterActivity
-func (o *RouterActivity) convertTo() (*naked.MonitorValues, error) {
-	dest := &naked.MonitorValues{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetSourceArchiveID returns value of SourceArchiveID
+func (o *Disk) GetSourceArchiveID() types.ID {
+	return o.SourceArchiveID
 }
 
-// convertFrom parse values from naked RouterActivity
-func (o *RouterActivity) convertFrom(naked *naked.MonitorValues) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetSourceArchiveID sets value to SourceArchiveID
+func (o *Disk) SetSourceArchiveID(v types.ID) {
+	o.SourceArchiveID = v
 }
 
-/*************************************************
-* MonitorRouterValue
-*************************************************/
+// GetSourceArchiveAvailability returns value of SourceArchiveAvailability
+func (o *Disk) GetSourceArchiveAvailability() types.EAvailability {
+	return o.SourceArchiveAvailability
+}
 
-// MonitorRouterValue represents API parameter/response structure
-type MonitorRouterValue struct {
-	Time time.Time `json:",omitempty" mapconv:",omitempty"`
-	In   float64   `json:",omitempty" mapconv:",omitempty"`
-	Out  float64   `json:",omitempty" mapconv:",omitempty"`
+// SetSourceArchiveAvailability sets value to SourceArchiveAvailability
+func (o *Disk) SetSourceArchiveAvailability(v types.EAvailability) {
+	o.SourceArchiveAvailability = v
 }
 
-// Validate validates by field tags
-func (o *MonitorRouterValue) Validate() error {
-	return validator.New().Struct(o)
+// GetBundleInfo returns value of BundleInfo
+func (o *Disk) GetBundleInfo() *BundleInfo {
+	return o.BundleInfo
 }
 
-// GetTime returns value of Time
-func (o *MonitorRouterValue) GetTime() time.Time {
-	return o.Time
+// SetBundleInfo sets value to BundleInfo
+func (o *Disk) SetBundleInfo(v *BundleInfo) {
+	o.BundleInfo = v
 }
 
-// SetTime sets value to Time
-func (o *MonitorRouterValue) SetTime(v time.Time) {
-	o.Time = v
+// GetStorage returns value of Storage
+func (o *Disk) GetStorage() *Storage {
+	return o.Storage
 }
 
-// GetIn returns value of In
-func (o *MonitorRouterValue) GetIn() float64 {
-	return o.In
+// SetStorage sets value to Storage
+func (o *Disk) SetStorage(v *Storage) {
+	o.Storage = v
 }
 
-// SetIn sets value to In
-func (o *MonitorRouterValue) SetIn(v float64) {
-	o.In = v
+// GetServerID returns value of ServerID
+func (o *Disk) GetServerID() types.ID {
+	return o.ServerID
 }
 
-// GetOut returns value of Out
-func (o *MonitorRouterValue) GetOut() float64 {
-	return o.Out
+// SetServerID sets value to ServerID
+func (o *Disk) SetServerID(v types.ID) {
+	o.ServerID = v
 }
 
-// SetOut sets value to Out
-func (o *MonitorRouterValue) SetOut(v float64) {
-	o.Out = v
+// GetIconID returns value of IconID
+func (o *Disk) GetIconID() types.ID {
+	return o.IconID
 }
 
-// convertTo returns naked MonitorRouterValue
-func (o *MonitorRouterValue) convertTo() (*naked.MonitorRouterValue, error) {
-	dest := &naked.MonitorRouterValue{}
+// SetIconID sets value to IconID
+func (o *Disk) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// GetCreatedAt returns value of CreatedAt
+func (o *Disk) GetCreatedAt() time.Time {
+	return o.CreatedAt
+}
+
+// SetCreatedAt sets value to CreatedAt
+func (o *Disk) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
+}
+
+// GetModifiedAt returns value of ModifiedAt
+func (o *Disk) GetModifiedAt() time.Time {
+	return o.ModifiedAt
+}
+
+// SetModifiedAt sets value to ModifiedAt
+func (o *Disk) SetModifiedAt(v time.Time) {
+	o.ModifiedAt = v
+}
+
+// convertTo returns naked Disk
+func (o *Disk) convertTo() (*naked.Disk, error) {
+	dest := &naked.Disk{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked MonitorRouterValue
-func (o *MonitorRouterValue) convertFrom(naked *naked.MonitorRouterValue) error {
+// convertFrom parse values from naked Disk
+func (o *Disk) convertFrom(naked *naked.Disk) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* LoadBalancer
+* DiskCreateRequest
 *************************************************/
 
-// LoadBalancer represents API parameter/response structure
-type LoadBalancer struct {
-	ID                      types.ID
-	Name                    string `validate:"required"`
-	Description             string `validate:"min=0,max=512"`
-	Tags                    []string
-	Availability            types.EAvailability
-	Class                   string
-	IconID                  types.ID `mapconv:"Icon.ID"`
-	CreatedAt               time.Time
-	ModifiedAt              time.Time
-	InstanceHostName        string                          `mapconv:"Instance.Host.Name"`
-	InstanceHostInfoURL     string                          `mapconv:"Instance.Host.InfoURL"`
-	InstanceStatus          types.EServerInstanceStatus     `mapconv:"Instance.Status"`
-	InstanceStatusChangedAt time.Time                       `mapconv:"Instance.StatusChangedAt"`
-	PlanID                  types.ID                        `mapconv:"Remark.Plan.ID/Plan.ID"`
-	SwitchID                types.ID                        `mapconv:"Remark.Switch.ID"`
-	DefaultRoute            string                          `mapconv:"Remark.Network.DefaultRoute" validate:"ipv4"`
-	NetworkMaskLen          int                             `mapconv:"Remark.Network.NetworkMaskLen" validate:"min=1,max=32"`
-	IPAddresses             []string                        `mapconv:"Remark.[]Servers.IPAddress"`
-	ZoneID                  types.ID                        `mapconv:"Remark.Zone.ID"`
-	VRID                    int                             `mapconv:"Remark.VRRP.VRID"`
-	VirtualIPAddresses      []*LoadBalancerVirtualIPAddress `mapconv:"Settings.[]LoadBalancer,recursive" validate:"min=0,max=10"`
-	SettingsHash            string
-	Interfaces              []*Interface `json:",omitempty" mapconv:"[]Interfaces,recursive,omitempty"`
+// DiskCreateRequest represents API parameter/response structure
+type DiskCreateRequest struct {
+	DiskPlanID      types.ID              `mapconv:"Plan.ID"`
+	Connection      types.EDiskConnection `json:",omitempty" mapconv:",omitempty"`
+	SourceDiskID    types.ID              `mapconv:"SourceDisk.ID,omitempty"`
+	SourceArchiveID types.ID              `mapconv:"SourceArchive.ID,omitempty"`
+	ServerID        types.ID              `mapconv:"Server.ID,omitempty"`
+	SizeMB          int
+	Name            string `validate:"required"`
+	Description     string `validate:"min=0,max=512"`
+	Tags            []string
+	IconID          types.ID `mapconv:"Icon.ID"`
 }
 
 // Validate validates by field tags
-func (o *LoadBalancer) Validate() error {
+func (o *DiskCreateRequest) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetID returns value of ID
-func (o *LoadBalancer) GetID() types.ID {
-	return o.ID
+// GetDiskPlanID returns value of DiskPlanID
+func (o *DiskCreateRequest) GetDiskPlanID() types.ID {
+	return o.DiskPlanID
 }
 
-// SetID sets value to ID
-func (o *LoadBalancer) SetID(v types.ID) {
-	o.ID = v
+// SetDiskPlanID sets value to DiskPlanID
+func (o *DiskCreateRequest) SetDiskPlanID(v types.ID) {
+	o.DiskPlanID = v
 }
 
-// GetStringID gets value to StringID
-func (o *LoadBalancer) GetStringID() string {
-	return accessor.GetStringID(o)
+// GetConnection returns value of Connection
+func (o *DiskCreateRequest) GetConnection() types.EDiskConnection {
+	return o.Connection
 }
 
-// SetStringID sets value to StringID
-func (o *LoadBalancer) SetStringID(v string) {
-	accessor.SetStringID(o, v)
+// SetConnection sets value to Connection
+func (o *DiskCreateRequest) SetConnection(v types.EDiskConnection) {
+	o.Connection = v
 }
 
-// GetInt64ID gets value to Int64ID
-func (o *LoadBalancer) GetInt64ID() int64 {
-	return accessor.GetInt64ID(o)
+// GetSourceDiskID returns value of SourceDiskID
+func (o *DiskCreateRequest) GetSourceDiskID() types.ID {
+	return o.SourceDiskID
 }
 
-// SetInt64ID sets value to Int64ID
-func (o *LoadBalancer) SetInt64ID(v int64) {
-	accessor.SetInt64ID(o, v)
+// SetSourceDiskID sets value to SourceDiskID
+func (o *DiskCreateRequest) SetSourceDiskID(v types.ID) {
+	o.SourceDiskID = v
+}
+
+// GetSourceArchiveID returns value of SourceArchiveID
+func (o *DiskCreateRequest) GetSourceArchiveID() types.ID {
+	return o.SourceArchiveID
+}
+
+// SetSourceArchiveID sets value to SourceArchiveID
+func (o *DiskCreateRequest) SetSourceArchiveID(v types.ID) {
+	o.SourceArchiveID = v
+}
+
+// GetServerID returns value of ServerID
+func (o *DiskCreateRequest) GetServerID() types.ID {
+	return o.ServerID
+}
+
+// SetServerID sets value to ServerID
+func (o *DiskCreateRequest) SetServerID(v types.ID) {
+	o.ServerID = v
+}
+
+// GetSizeMB returns value of SizeMB
+func (o *DiskCreateRequest) GetSizeMB() int {
+	return o.SizeMB
+}
+
+// SetSizeMB sets value to SizeMB
+func (o *DiskCreateRequest) SetSizeMB(v int) {
+	o.SizeMB = v
+}
+
+// GetSizeGB gets value to SizeGB
+func (o *DiskCreateRequest) GetSizeGB() int {
+	return accessor.GetSizeGB(o)
+}
+
+// SetSizeGB sets value to SizeGB
+func (o *DiskCreateRequest) SetSizeGB(v int) {
+	accessor.SetSizeGB(o, v)
 }
 
 // GetName returns value of Name
-func (o *LoadBalancer) GetName() string {
+func (o *DiskCreateRequest) GetName() string {
 	return o.Name
 }
 
 // SetName sets value to Name
-func (o *LoadBalancer) SetName(v string) {
+func (o *DiskCreateRequest) SetName(v string) {
 	o.Name = v
 }
 
 // GetDescription returns value of Description
-func (o *LoadBalancer) GetDescription() string {
+func (o *DiskCreateRequest) GetDescription() string {
 	return o.Description
 }
 
 // SetDescription sets value to Description
-func (o *LoadBalancer) SetDescription(v string) {
+func (o *DiskCreateRequest) SetDescription(v string) {
 	o.Description = v
 }
 
 // GetTags returns value of Tags
-func (o *LoadBalancer) GetTags() []string {
+func (o *DiskCreateRequest) GetTags() []string {
 	return o.Tags
 }
 
 // SetTags sets value to Tags
-func (o *LoadBalancer) SetTags(v []string) {
+func (o *DiskCreateRequest) SetTags(v []string) {
 	o.Tags = v
 }
 
-// GetAvailability returns value of Availability
-func (o *LoadBalancer) GetAvailability() types.EAvailability {
-	return o.Availability
+// GetIconID returns value of IconID
+func (o *DiskCreateRequest) GetIconID() types.ID {
+	return o.IconID
 }
 
-// SetAvailability sets value to Availability
-func (o *LoadBalancer) SetAvailability(v types.EAvailability) {
-	o.Availability = v
+// SetIconID sets value to IconID
+func (o *DiskCreateRequest) SetIconID(v types.ID) {
+	o.IconID = v
 }
 
-// GetClass returns value of Class
-func (o *LoadBalancer) GetClass() string {
-	return o.Class
+// convertTo returns naked DiskCreateRequest
+func (o *DiskCreateRequest) convertTo() (*naked.Disk, error) {
+	dest := &naked.Disk{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetClass sets value to Class
-func (o *LoadBalancer) SetClass(v string) {
-	o.Class = v
+// convertFrom parse values from naked DiskCreateRequest
+func (o *DiskCreateRequest) convertFrom(naked *naked.Disk) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// GetIconID returns value of IconID
-func (o *LoadBalancer) GetIconID() types.ID {
-	return o.IconID
+/*************************************************
+* DiskEditRequest
+*************************************************/
+
+// DiskEditRequest represents API parameter/response structure
+type DiskEditRequest struct {
+	Password            string              `json:",omitempty" mapconv:",omitempty"`
+	SSHKey              *DiskEditSSHKey     `json:",omitempty" mapconv:",omitempty,recursive"`
+	SSHKeys             []*DiskEditSSHKey   `json:",omitempty" mapconv:"[]SSHKeys,omitempty,recursive"`
+	DisablePWAuth       bool                `json:",omitempty" mapconv:",omitempty"`
+	EnableDHCP          bool                `json:",omitempty" mapconv:",omitempty"`
+	ChangePartitionUUID bool                `json:",omitempty" mapconv:",omitempty"`
+	HostName            string              `json:",omitempty" mapconv:",omitempty"`
+	Notes               []*DiskEditNote     `json:",omitempty" mapconv:",omitempty,recursive"`
+	UserIPAddress       string              `json:",omitempty" mapconv:",omitempty"`
+	UserSubnet          *DiskEditUserSubnet `json:",omitempty" mapconv:",omitempty"`
 }
 
-// SetIconID sets value to IconID
-func (o *LoadBalancer) SetIconID(v types.ID) {
-	o.IconID = v
+// Validate validates by field tags
+func (o *DiskEditRequest) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetCreatedAt returns value of CreatedAt
-func (o *LoadBalancer) GetCreatedAt() time.Time {
-	return o.CreatedAt
+// GetPassword returns value of Password
+func (o *DiskEditRequest) GetPassword() string {
+	return o.Password
 }
 
-// SetCreatedAt sets value to CreatedAt
-func (o *LoadBalancer) SetCreatedAt(v time.Time) {
-	o.CreatedAt = v
+// SetPassword sets value to Password
+func (o *DiskEditRequest) SetPassword(v string) {
+	o.Password = v
 }
 
-// GetModifiedAt returns value of ModifiedAt
-func (o *LoadBalancer) GetModifiedAt() time.Time {
-	return o.ModifiedAt
+// GetSSHKey returns value of SSHKey
+func (o *DiskEditRequest) GetSSHKey() *DiskEditSSHKey {
+	return o.SSHKey
 }
 
-// SetModifiedAt sets value to ModifiedAt
-func (o *LoadBalancer) SetModifiedAt(v time.Time) {
-	o.ModifiedAt = v
+// SetSSHKey sets value to SSHKey
+func (o *DiskEditRequest) SetSSHKey(v *DiskEditSSHKey) {
+	o.SSHKey = v
 }
 
-// GetInstanceHostName returns value of InstanceHostName
-func (o *LoadBalancer) GetInstanceHostName() string {
-	return o.InstanceHostName
+// GetSSHKeys returns value of SSHKeys
+func (o *DiskEditRequest) GetSSHKeys() []*DiskEditSSHKey {
+	return o.SSHKeys
 }
 
-// SetInstanceHostName sets value to InstanceHostName
-func (o *LoadBalancer) SetInstanceHostName(v string) {
-	o.InstanceHostName = v
+// SetSSHKeys sets value to SSHKeys
+func (o *DiskEditRequest) SetSSHKeys(v []*DiskEditSSHKey) {
+	o.SSHKeys = v
 }
 
-// GetInstanceHostInfoURL returns value of InstanceHostInfoURL
-func (o *LoadBalancer) GetInstanceHostInfoURL() string {
-	return o.InstanceHostInfoURL
+// GetDisablePWAuth returns value of DisablePWAuth
+func (o *DiskEditRequest) GetDisablePWAuth() bool {
+	return o.DisablePWAuth
 }
 
-// SetInstanceHostInfoURL sets value to InstanceHostInfoURL
-func (o *LoadBalancer) SetInstanceHostInfoURL(v string) {
-	o.InstanceHostInfoURL = v
+// SetDisablePWAuth sets value to DisablePWAuth
+func (o *DiskEditRequest) SetDisablePWAuth(v bool) {
+	o.DisablePWAuth = v
 }
 
-// GetInstanceStatus returns value of InstanceStatus
-func (o *LoadBalancer) GetInstanceStatus() types.EServerInstanceStatus {
-	return o.InstanceStatus
+// GetEnableDHCP returns value of EnableDHCP
+func (o *DiskEditRequest) GetEnableDHCP() bool {
+	return o.EnableDHCP
 }
 
-// SetInstanceStatus sets value to InstanceStatus
-func (o *LoadBalancer) SetInstanceStatus(v types.EServerInstanceStatus) {
-	o.InstanceStatus = v
+// SetEnableDHCP sets value to EnableDHCP
+func (o *DiskEditRequest) SetEnableDHCP(v bool) {
+	o.EnableDHCP = v
 }
 
-// GetInstanceStatusChangedAt returns value of InstanceStatusChangedAt
-func (o *LoadBalancer) GetInstanceStatusChangedAt() time.Time {
-	return o.InstanceStatusChangedAt
+// GetChangePartitionUUID returns value of ChangePartitionUUID
+func (o *DiskEditRequest) GetChangePartitionUUID() bool {
+	return o.ChangePartitionUUID
 }
 
-// SetInstanceStatusChangedAt sets value to InstanceStatusChangedAt
-func (o *LoadBalancer) SetInstanceStatusChangedAt(v time.Time) {
-	o.InstanceStatusChangedAt = v
+// SetChangePartitionUUID sets value to ChangePartitionUUID
+func (o *DiskEditRequest) SetChangePartitionUUID(v bool) {
+	o.ChangePartitionUUID = v
 }
 
-// GetPlanID returns value of PlanID
-func (o *LoadBalancer) GetPlanID() types.ID {
-	return o.PlanID
+// GetHostName returns value of HostName
+func (o *DiskEditRequest) GetHostName() string {
+	return o.HostName
 }
 
-// SetPlanID sets value to PlanID
-func (o *LoadBalancer) SetPlanID(v types.ID) {
-	o.PlanID = v
+// SetHostName sets value to HostName
+func (o *DiskEditRequest) SetHostName(v string) {
+	o.HostName = v
 }
 
-// GetSwitchID returns value of SwitchID
-func (o *LoadBalancer) GetSwitchID() types.ID {
-	return o.SwitchID
+// GetNotes returns value of Notes
+func (o *DiskEditRequest) GetNotes() []*DiskEditNote {
+	return o.Notes
 }
 
-// SetSwitchID sets value to SwitchID
-func (o *LoadBalancer) SetSwitchID(v types.ID) {
-	o.SwitchID = v
+// SetNotes sets value to Notes
+func (o *DiskEditRequest) SetNotes(v []*DiskEditNote) {
+	o.Notes = v
 }
 
-// GetDefaultRoute returns value of DefaultRoute
-func (o *LoadBalancer) GetDefaultRoute() string {
-	return o.DefaultRoute
+// GetUserIPAddress returns value of UserIPAddress
+func (o *DiskEditRequest) GetUserIPAddress() string {
+	return o.UserIPAddress
 }
 
-// SetDefaultRoute sets value to DefaultRoute
-func (o *LoadBalancer) SetDefaultRoute(v string) {
-	o.DefaultRoute = v
+// SetUserIPAddress sets value to UserIPAddress
+func (o *DiskEditRequest) SetUserIPAddress(v string) {
+	o.UserIPAddress = v
 }
 
-// GetNetworkMaskLen returns value of NetworkMaskLen
-func (o *LoadBalancer) GetNetworkMaskLen() int {
-	return o.NetworkMaskLen
+// GetUserSubnet returns value of UserSubnet
+func (o *DiskEditRequest) GetUserSubnet() *DiskEditUserSubnet {
+	return o.UserSubnet
 }
 
-// SetNetworkMaskLen sets value to NetworkMaskLen
-func (o *LoadBalancer) SetNetworkMaskLen(v int) {
-	o.NetworkMaskLen = v
+// SetUserSubnet sets value to UserSubnet
+func (o *DiskEditRequest) SetUserSubnet(v *DiskEditUserSubnet) {
+	o.UserSubnet = v
 }
 
-// GetIPAddresses returns value of IPAddresses
-func (o *LoadBalancer) GetIPAddresses() []string {
-	return o.IPAddresses
+// convertTo returns naked DiskEditRequest
+func (o *DiskEditRequest) convertTo() (*naked.DiskEdit, error) {
+	dest := &naked.DiskEdit{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetIPAddresses sets value to IPAddresses
-func (o *LoadBalancer) SetIPAddresses(v []string) {
-	o.IPAddresses = v
+// convertFrom parse values from naked DiskEditRequest
+func (o *DiskEditRequest) convertFrom(naked *naked.DiskEdit) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// GetZoneID returns value of ZoneID
-func (o *LoadBalancer) GetZoneID() types.ID {
-	return o.ZoneID
+/*************************************************
+* DiskEditSSHKey
+*************************************************/
+
+// DiskEditSSHKey represents API parameter/response structure
+type DiskEditSSHKey struct {
+	ID        types.ID `json:",omitempty" mapconv:",omitempty"`
+	PublicKey string   `json:",omitempty" mapconv:",omitempty"`
 }
 
-// SetZoneID sets value to ZoneID
-func (o *LoadBalancer) SetZoneID(v types.ID) {
-	o.ZoneID = v
+// Validate validates by field tags
+func (o *DiskEditSSHKey) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetVRID returns value of VRID
-func (o *LoadBalancer) GetVRID() int {
-	return o.VRID
+// GetID returns value of ID
+func (o *DiskEditSSHKey) GetID() types.ID {
+	return o.ID
 }
 
-// SetVRID sets value to VRID
-func (o *LoadBalancer) SetVRID(v int) {
-	o.VRID = v
+// SetID sets value to ID
+func (o *DiskEditSSHKey) SetID(v types.ID) {
+	o.ID = v
 }
 
-// GetVirtualIPAddresses returns value of VirtualIPAddresses
-func (o *LoadBalancer) GetVirtualIPAddresses() []*LoadBalancerVirtualIPAddress {
-	return o.VirtualIPAddresses
+// GetPublicKey returns value of PublicKey
+func (o *DiskEditSSHKey) GetPublicKey() string {
+	return o.PublicKey
 }
 
-// SetVirtualIPAddresses sets value to VirtualIPAddresses
-func (o *LoadBalancer) SetVirtualIPAddresses(v []*LoadBalancerVirtualIPAddress) {
-	o.VirtualIPAddresses = v
+// SetPublicKey sets value to PublicKey
+func (o *DiskEditSSHKey) SetPublicKey(v string) {
+	o.PublicKey = v
 }
 
-// GetSettingsHash returns value of SettingsHash
-func (o *LoadBalancer) GetSettingsHash() string {
-	return o.SettingsHash
+/*************************************************
+* DiskEditNote
+*************************************************/
+
+// DiskEditNote represents API parameter/response structure
+type DiskEditNote struct {
+	ID        types.ID               `json:",omitempty" mapconv:",omitempty"`
+	Variables map[string]interface{} `json:",omitempty" mapconv:",omitempty"`
 }
 
-// SetSettingsHash sets value to SettingsHash
-func (o *LoadBalancer) SetSettingsHash(v string) {
-	o.SettingsHash = v
+// Validate validates by field tags
+func (o *DiskEditNote) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetInterfaces returns value of Interfaces
-func (o *LoadBalancer) GetInterfaces() []*Interface {
-	return o.Interfaces
+// GetID returns value of ID
+func (o *DiskEditNote) GetID() types.ID {
+	return o.ID
 }
 
-// SetInterfaces sets value to Interfaces
-func (o *LoadBalancer) SetInterfaces(v []*Interface) {
-	o.Interfaces = v
+// SetID sets value to ID
+func (o *DiskEditNote) SetID(v types.ID) {
+	o.ID = v
 }
 
-// convertTo returns naked LoadBalancer
-func (o *LoadBalancer) convertTo() (*naked.LoadBalancer, error) {
-	dest := &naked.LoadBalancer{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetVariables returns value of Variables
+func (o *DiskEditNote) GetVariables() map[string]interface{} {
+	return o.Variables
 }
 
-// convertFrom parse values from naked LoadBalancer
-func (o *LoadBalancer) convertFrom(naked *naked.LoadBalancer) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetVariables sets value to Variables
+func (o *DiskEditNote) SetVariables(v map[string]interface{}) {
+	o.Variables = v
 }
 
 /*************************************************
-* LoadBalancerVirtualIPAddress
+* DiskEditUserSubnet
 *************************************************/
 
-// LoadBalancerVirtualIPAddress represents API parameter/response structure
-type LoadBalancerVirtualIPAddress struct {
-	VirtualIPAddress string `validate:"ipv4"`
-	Port             types.StringNumber
-	DelayLoop        types.StringNumber    `mapconv:",default=10" validate:"min=0,max=60"`
-	SorryServer      string                `validate:"ipv4"`
-	Description      string                `validate:"min=0,max=512"`
-	Servers          []*LoadBalancerServer `mapconv:",recursive" validate:"min=0,max=40"`
+// DiskEditUserSubnet represents API parameter/response structure
+type DiskEditUserSubnet struct {
+	DefaultRoute   string `json:",omitempty" mapconv:",omitempty"`
+	NetworkMaskLen int    `json:",omitempty" mapconv:",omitempty" validate:"min=0,max=32"`
 }
 
 // Validate validates by field tags
-func (o *LoadBalancerVirtualIPAddress) Validate() error {
+func (o *DiskEditUserSubnet) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetVirtualIPAddress returns value of VirtualIPAddress
-func (o *LoadBalancerVirtualIPAddress) GetVirtualIPAddress() string {
-	return o.VirtualIPAddress
+// GetDefaultRoute returns value of DefaultRoute
+func (o *DiskEditUserSubnet) GetDefaultRoute() string {
+	return o.DefaultRoute
 }
 
-// SetVirtualIPAddress sets value to VirtualIPAddress
-func (o *LoadBalancerVirtualIPAddress) SetVirtualIPAddress(v string) {
-	o.VirtualIPAddress = v
+// SetDefaultRoute sets value to DefaultRoute
+func (o *DiskEditUserSubnet) SetDefaultRoute(v string) {
+	o.DefaultRoute = v
 }
 
-// GetPort returns value of Port
-func (o *LoadBalancerVirtualIPAddress) GetPort() types.StringNumber {
-	return o.Port
+// GetNetworkMaskLen returns value of NetworkMaskLen
+func (o *DiskEditUserSubnet) GetNetworkMaskLen() int {
+	return o.NetworkMaskLen
 }
 
-// SetPort sets value to Port
-func (o *LoadBalancerVirtualIPAddress) SetPort(v types.StringNumber) {
-	o.Port = v
+// SetNetworkMaskLen sets value to NetworkMaskLen
+func (o *DiskEditUserSubnet) SetNetworkMaskLen(v int) {
+	o.NetworkMaskLen = v
 }
 
-// GetDelayLoop returns value of DelayLoop
-func (o *LoadBalancerVirtualIPAddress) GetDelayLoop() types.StringNumber {
-	return o.DelayLoop
+/*************************************************
+* DiskInstallRequest
+*************************************************/
+
+// DiskInstallRequest represents API parameter/response structure
+type DiskInstallRequest struct {
+	SourceDiskID    types.ID `mapconv:"SourceDisk.ID,omitempty"`
+	SourceArchiveID types.ID `mapconv:"SourceArchive.ID,omitempty"`
+	SizeMB          int
 }
 
-// SetDelayLoop sets value to DelayLoop
-func (o *LoadBalancerVirtualIPAddress) SetDelayLoop(v types.StringNumber) {
-	o.DelayLoop = v
+// Validate validates by field tags
+func (o *DiskInstallRequest) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetSorryServer returns value of SorryServer
-func (o *LoadBalancerVirtualIPAddress) GetSorryServer() string {
-	return o.SorryServer
+// GetSourceDiskID returns value of SourceDiskID
+func (o *DiskInstallRequest) GetSourceDiskID() types.ID {
+	return o.SourceDiskID
 }
 
-// SetSorryServer sets value to SorryServer
-func (o *LoadBalancerVirtualIPAddress) SetSorryServer(v string) {
-	o.SorryServer = v
+// SetSourceDiskID sets value to SourceDiskID
+func (o *DiskInstallRequest) SetSourceDiskID(v types.ID) {
+	o.SourceDiskID = v
 }
 
-// GetDescription returns value of Description
-func (o *LoadBalancerVirtualIPAddress) GetDescription() string {
-	return o.Description
+// GetSourceArchiveID returns value of SourceArchiveID
+func (o *DiskInstallRequest) GetSourceArchiveID() types.ID {
+	return o.SourceArchiveID
 }
 
-// SetDescription sets value to Description
-func (o *LoadBalancerVirtualIPAddress) SetDescription(v string) {
-	o.Description = v
+// SetSourceArchiveID sets value to SourceArchiveID
+func (o *DiskInstallRequest) SetSourceArchiveID(v types.ID) {
+	o.SourceArchiveID = v
 }
 
-// GetServers returns value of Servers
-func (o *LoadBalancerVirtualIPAddress) GetServers() []*LoadBalancerServer {
-	return o.Servers
+// GetSizeMB returns value of SizeMB
+func (o *DiskInstallRequest) GetSizeMB() int {
+	return o.SizeMB
 }
 
-// SetServers sets value to Servers
-func (o *LoadBalancerVirtualIPAddress) SetServers(v []*LoadBalancerServer) {
-	o.Servers = v
+// SetSizeMB sets value to SizeMB
+func (o *DiskInstallRequest) SetSizeMB(v int) {
+	o.SizeMB = v
+}
+
+// GetSizeGB gets value to SizeGB
+func (o *DiskInstallRequest) GetSizeGB() int {
+	return accessor.GetSizeGB(o)
+}
+
+// SetSizeGB sets value to SizeGB
+func (o *DiskInstallRequest) SetSizeGB(v int) {
+	accessor.SetSizeGB(o, v)
+}
+
+// convertTo returns naked DiskInstallRequest
+func (o *DiskInstallRequest) convertTo() (*naked.Disk, error) {
+	dest := &naked.Disk{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked DiskInstallRequest
+func (o *DiskInstallRequest) convertFrom(naked *naked.Disk) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* LoadBalancerServer
+* DiskUpdateRequest
 *************************************************/
 
-// LoadBalancerServer represents API parameter/response structure
-type LoadBalancerServer struct {
-	IPAddress               string             `validate:"ipv4"`
-	Port                    types.StringNumber `validate:"min=1,max=65535"`
-	Enabled                 types.StringFlag
-	HealthCheckProtocol     types.Protocol     `mapconv:"HealthCheck.Protocol" validate:"oneof=http https ping tcp"`
-	HealthCheckPath         string             `mapconv:"HealthCheck.Path"`
-	HealthCheckResponseCode types.StringNumber `mapconv:"HealthCheck.Status"`
+// DiskUpdateRequest represents API parameter/response structure
+type DiskUpdateRequest struct {
+	Name        string `validate:"required"`
+	Description string `validate:"min=0,max=512"`
+	Tags        []string
+	IconID      types.ID              `mapconv:"Icon.ID"`
+	Connection  types.EDiskConnection `json:",omitempty" mapconv:",omitempty"`
 }
 
 // Validate validates by field tags
-func (o *LoadBalancerServer) Validate() error {
+func (o *DiskUpdateRequest) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetIPAddress returns value of IPAddress
-func (o *LoadBalancerServer) GetIPAddress() string {
-	return o.IPAddress
+// GetName returns value of Name
+func (o *DiskUpdateRequest) GetName() string {
+	return o.Name
 }
 
-// SetIPAddress sets value to IPAddress
-func (o *LoadBalancerServer) SetIPAddress(v string) {
-	o.IPAddress = v
+// SetName sets value to Name
+func (o *DiskUpdateRequest) SetName(v string) {
+	o.Name = v
 }
 
-// GetPort returns value of Port
-func (o *LoadBalancerServer) GetPort() types.StringNumber {
-	return o.Port
+// GetDescription returns value of Description
+func (o *DiskUpdateRequest) GetDescription() string {
+	return o.Description
 }
 
-// SetPort sets value to Port
-func (o *LoadBalancerServer) SetPort(v types.StringNumber) {
-	o.Port = v
+// SetDescription sets value to Description
+func (o *DiskUpdateRequest) SetDescription(v string) {
+	o.Description = v
 }
 
-// GetEnabled returns value of Enabled
-func (o *LoadBalancerServer) GetEnabled() types.StringFlag {
-	return o.Enabled
+// GetTags returns value of Tags
+func (o *DiskUpdateRequest) GetTags() []string {
+	return o.Tags
 }
 
-// SetEnabled sets value to Enabled
-func (o *LoadBalancerServer) SetEnabled(v types.StringFlag) {
-	o.Enabled = v
+// SetTags sets value to Tags
+func (o *DiskUpdateRequest) SetTags(v []string) {
+	o.Tags = v
 }
 
-// GetHealthCheckProtocol returns value of HealthCheckProtocol
-func (o *LoadBalancerServer) GetHealthCheckProtocol() types.Protocol {
-	return o.HealthCheckProtocol
+// GetIconID returns value of IconID
+func (o *DiskUpdateRequest) GetIconID() types.ID {
+	return o.IconID
 }
 
-// SetHealthCheckProtocol sets value to HealthCheckProtocol
-func (o *LoadBalancerServer) SetHealthCheckProtocol(v types.Protocol) {
-	o.HealthCheckProtocol = v
+// SetIconID sets value to IconID
+func (o *DiskUpdateRequest) SetIconID(v types.ID) {
+	o.IconID = v
 }
 
-// GetHealthCheckPath returns value of HealthCheckPath
-func (o *LoadBalancerServer) GetHealthCheckPath() string {
-	return o.HealthCheckPath
+// GetConnection returns value of Connection
+func (o *DiskUpdateRequest) GetConnection() types.EDiskConnection {
+	return o.Connection
 }
 
-// SetHealthCheckPath sets value to HealthCheckPath
-func (o *LoadBalancerServer) SetHealthCheckPath(v string) {
-	o.HealthCheckPath = v
+// SetConnection sets value to Connection
+func (o *DiskUpdateRequest) SetConnection(v types.EDiskConnection) {
+	o.Connection = v
 }
 
-// GetHealthCheckResponseCode returns value of HealthCheckResponseCode
-func (o *LoadBalancerServer) GetHealthCheckResponseCode() types.StringNumber {
-	return o.HealthCheckResponseCode
+// convertTo returns naked DiskUpdateRequest
+func (o *DiskUpdateRequest) convertTo() (*naked.Disk, error) {
+	dest := &naked.Disk{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetHealthCheckResponseCode sets value to HealthCheckResponseCode
-func (o *LoadBalancerServer) SetHealthCheckResponseCode(v types.StringNumber) {
-	o.HealthCheckResponseCode = v
+// convertFrom parse values from naked DiskUpdateRequest
+func (o *DiskUpdateRequest) convertFrom(naked *naked.Disk) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* LoadBalancerCreateRequest
+* DiskPlan
 *************************************************/
 
-// LoadBalancerCreateRequest represents API parameter/response structure
-type LoadBalancerCreateRequest struct {
-	Class              string   `mapconv:",default=loadbalancer"`
-	SwitchID           types.ID `mapconv:"Remark.Switch.ID"`
-	PlanID             types.ID `mapconv:"Remark.Plan.ID/Plan.ID"`
-	VRID               int      `mapconv:"Remark.VRRP.VRID"`
-	IPAddresses        []string `mapconv:"Remark.[]Servers.IPAddress" validate:"min=1,max=2,dive,ipv4"`
-	NetworkMaskLen     int      `mapconv:"Remark.Network.NetworkMaskLen" validate:"min=1,max=32"`
-	DefaultRoute       string   `mapconv:"Remark.Network.DefaultRoute" validate:"ipv4"`
-	Name               string   `validate:"required"`
-	Description        string   `validate:"min=0,max=512"`
-	Tags               []string
-	IconID             types.ID                        `mapconv:"Icon.ID"`
-	VirtualIPAddresses []*LoadBalancerVirtualIPAddress `mapconv:"Settings.[]LoadBalancer,recursive" validate:"min=0,max=10"`
+// DiskPlan represents API parameter/response structure
+type DiskPlan struct {
+	ID           types.ID
+	Name         string `validate:"required"`
+	StorageClass string
+	Availability types.EAvailability
+	Size         []*DiskPlanSizeInfo `mapconv:"[]Size,recursive"`
 }
 
 // Validate validates by field tags
-func (o *LoadBalancerCreateRequest) Validate() error {
+func (o *DiskPlan) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetClass returns value of Class
-func (o *LoadBalancerCreateRequest) GetClass() string {
-	return o.Class
+// GetID returns value of ID
+func (o *DiskPlan) GetID() types.ID {
+	return o.ID
 }
 
-// SetClass sets value to Class
-func (o *LoadBalancerCreateRequest) SetClass(v string) {
-	o.Class = v
+// SetID sets value to ID
+func (o *DiskPlan) SetID(v types.ID) {
+	o.ID = v
 }
 
-// GetSwitchID returns value of SwitchID
-func (o *LoadBalancerCreateRequest) GetSwitchID() types.ID {
-	return o.SwitchID
+// GetStringID gets value to StringID
+func (o *DiskPlan) GetStringID() string {
+	return accessor.GetStringID(o)
 }
 
-// SetSwitchID sets value to SwitchID
-func (o *LoadBalancerCreateRequest) SetSwitchID(v types.ID) {
-	o.SwitchID = v
+// SetStringID sets value to StringID
+func (o *DiskPlan) SetStringID(v string) {
+	accessor.SetStringID(o, v)
 }
 
-// GetPlanID returns value of PlanID
-func (o *LoadBalancerCreateRequest) GetPlanID() types.ID {
-	return o.PlanID
+// GetInt64ID gets value to Int64ID
+func (o *DiskPlan) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
 }
 
-// SetPlanID sets value to PlanID
-func (o *LoadBalancerCreateRequest) SetPlanID(v types.ID) {
-	o.PlanID = v
+// SetInt64ID sets value to Int64ID
+func (o *DiskPlan) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
 }
 
-// GetVRID returns value of VRID
-func (o *LoadBalancerCreateRequest) GetVRID() int {
-	return o.VRID
+// GetName returns value of Name
+func (o *DiskPlan) GetName() string {
+	return o.Name
 }
 
-// SetVRID sets value to VRID
-func (o *LoadBalancerCreateRequest) SetVRID(v int) {
-	o.VRID = v
+// SetName sets value to Name
+func (o *DiskPlan) SetName(v string) {
+	o.Name = v
 }
 
-// GetIPAddresses returns value of IPAddresses
-func (o *LoadBalancerCreateRequest) GetIPAddresses() []string {
-	return o.IPAddresses
+// GetStorageClass returns value of StorageClass
+func (o *DiskPlan) GetStorageClass() string {
+	return o.StorageClass
 }
 
-// SetIPAddresses sets value to IPAddresses
-func (o *LoadBalancerCreateRequest) SetIPAddresses(v []string) {
-	o.IPAddresses = v
+// SetStorageClass sets value to StorageClass
+func (o *DiskPlan) SetStorageClass(v string) {
+	o.StorageClass = v
 }
 
-// GetNetworkMaskLen returns value of NetworkMaskLen
-func (o *LoadBalancerCreateRequest) GetNetworkMaskLen() int {
-	return o.NetworkMaskLen
+// GetAvailability returns value of Availability
+func (o *DiskPlan) GetAvailability() types.EAvailability {
+	return o.Availability
 }
 
-// SetNetworkMaskLen sets value to NetworkMaskLen
-func (o *LoadBalancerCreateRequest) SetNetworkMaskLen(v int) {
-	o.NetworkMaskLen = v
+// SetAvailability sets value to Availability
+func (o *DiskPlan) SetAvailability(v types.EAvailability) {
+	o.Availability = v
 }
 
-// GetDefaultRoute returns value of DefaultRoute
-func (o *LoadBalancerCreateRequest) GetDefaultRoute() string {
-	return o.DefaultRoute
+// GetSize returns value of Size
+func (o *DiskPlan) GetSize() []*DiskPlanSizeInfo {
+	return o.Size
 }
 
-// SetDefaultRoute sets value to DefaultRoute
-func (o *LoadBalancerCreateRequest) SetDefaultRoute(v string) {
-	o.DefaultRoute = v
+// SetSize sets value to Size
+func (o *DiskPlan) SetSize(v []*DiskPlanSizeInfo) {
+	o.Size = v
 }
 
-// GetName returns value of Name
-func (o *LoadBalancerCreateRequest) GetName() string {
-	return o.Name
+// convertTo returns naked DiskPlan
+func (o *DiskPlan) convertTo() (*naked.DiskPlan, error) {
+	dest := &naked.DiskPlan{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetName sets value to Name
-func (o *LoadBalancerCreateRequest) SetName(v string) {
-	o.Name = v
+// convertFrom parse values from naked DiskPlan
+func (o *DiskPlan) convertFrom(naked *naked.DiskPlan) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// GetDescription returns value of Description
-func (o *LoadBalancerCreateRequest) GetDescription() string {
-	return o.Description
+/*************************************************
+* DiskPlanSizeInfo
+*************************************************/
+
+// DiskPlanSizeInfo represents API parameter/response structure
+type DiskPlanSizeInfo struct {
+	Availability  types.EAvailability
+	DisplaySize   int
+	DisplaySuffix string
+	SizeMB        int
 }
 
-// SetDescription sets value to Description
-func (o *LoadBalancerCreateRequest) SetDescription(v string) {
-	o.Description = v
+// Validate validates by field tags
+func (o *DiskPlanSizeInfo) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetTags returns value of Tags
-func (o *LoadBalancerCreateRequest) GetTags() []string {
-	return o.Tags
+// GetAvailability returns value of Availability
+func (o *DiskPlanSizeInfo) GetAvailability() types.EAvailability {
+	return o.Availability
 }
 
-// SetTags sets value to Tags
-func (o *LoadBalancerCreateRequest) SetTags(v []string) {
-	o.Tags = v
+// SetAvailability sets value to Availability
+func (o *DiskPlanSizeInfo) SetAvailability(v types.EAvailability) {
+	o.Availability = v
 }
 
-// GetIconID returns value of IconID
-func (o *LoadBalancerCreateRequest) GetIconID() types.ID {
-	return o.IconID
+// GetDisplaySize returns value of DisplaySize
+func (o *DiskPlanSizeInfo) GetDisplaySize() int {
+	return o.DisplaySize
 }
 
-// SetIconID sets value to IconID
-func (o *LoadBalancerCreateRequest) SetIconID(v types.ID) {
-	o.IconID = v
+// SetDisplaySize sets value to DisplaySize
+func (o *DiskPlanSizeInfo) SetDisplaySize(v int) {
+	o.DisplaySize = v
 }
 
-// GetVirtualIPAddresses returns value of VirtualIPAddresses
-func (o *LoadBalancerCreateRequest) GetVirtualIPAddresses() []*LoadBalancerVirtualIPAddress {
-	return o.VirtualIPAddresses
+// GetDisplaySuffix returns value of DisplaySuffix
+func (o *DiskPlanSizeInfo) GetDisplaySuffix() string {
+	return o.DisplaySuffix
 }
 
-// SetVirtualIPAddresses sets value to VirtualIPAddresses
-func (o *LoadBalancerCreateRequest) SetVirtualIPAddresses(v []*LoadBalancerVirtualIPAddress) {
-	o.VirtualIPAddresses = v
+// SetDisplaySuffix sets value to DisplaySuffix
+func (o *DiskPlanSizeInfo) SetDisplaySuffix(v string) {
+	o.DisplaySuffix = v
 }
 
-// convertTo returns naked LoadBalancerCreateRequest
-func (o *LoadBalancerCreateRequest) convertTo() (*naked.LoadBalancer, error) {
-	dest := &naked.LoadBalancer{}
+// GetSizeMB returns value of SizeMB
+func (o *DiskPlanSizeInfo) GetSizeMB() int {
+	return o.SizeMB
+}
+
+// SetSizeMB sets value to SizeMB
+func (o *DiskPlanSizeInfo) SetSizeMB(v int) {
+	o.SizeMB = v
+}
+
+// GetSizeGB gets value to SizeGB
+func (o *DiskPlanSizeInfo) GetSizeGB() int {
+	return accessor.GetSizeGB(o)
+}
+
+// SetSizeGB sets value to SizeGB
+func (o *DiskPlanSizeInfo) SetSizeGB(v int) {
+	accessor.SetSizeGB(o, v)
+}
+
+// convertTo returns naked DiskPlanSizeInfo
+func (o *DiskPlanSizeInfo) convertTo() (*naked.DiskPlanSizeInfo, error) {
+	dest := &naked.DiskPlanSizeInfo{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked LoadBalancerCreateRequest
-func (o *LoadBalancerCreateRequest) convertFrom(naked *naked.LoadBalancer) error {
+// convertFrom parse values from naked DiskPlanSizeInfo
+func (o *DiskPlanSizeInfo) convertFrom(naked *naked.DiskPlanSizeInfo) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* LoadBalancerUpdateRequest
+* DNS
 *************************************************/
 
-// LoadBalancerUpdateRequest represents API parameter/response structure
-type LoadBalancerUpdateRequest struct {
-	Name               string `validate:"required"`
-	Description        string `validate:"min=0,max=512"`
-	Tags               []string
-	IconID             types.ID                        `mapconv:"Icon.ID"`
-	VirtualIPAddresses []*LoadBalancerVirtualIPAddress `mapconv:"Settings.[]LoadBalancer,recursive" validate:"min=0,max=10"`
+// DNS represents API parameter/response structure
+type DNS struct {
+	ID             types.ID
+	Name           string `validate:"required"`
+	Description    string `validate:"min=0,max=512"`
+	Tags           []string
+	Availability   types.EAvailability
+	IconID         types.ID `mapconv:"Icon.ID"`
+	CreatedAt      time.Time
+	ModifiedAt     time.Time
+	Class          string       `mapconv:"Provider.Class,default=dns"`
+	Records        []*DNSRecord `mapconv:"Settings.DNS.[]ResourceRecordSets,recursive" validate:"min=0,max=1000"`
+	SettingsHash   string
+	DNSNameServers []string `mapconv:"Status.NS"`
 }
 
 // Validate validates by field tags
-func (o *LoadBalancerUpdateRequest) Validate() error {
+func (o *DNS) Validate() error {
 	return validator.New().Struct(o)
 }
 
+// GetID returns value of ID
+func (o *DNS) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *DNS) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *DNS) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *DNS) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *DNS) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *DNS) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
 // GetName returns value of Name
-func (o *LoadBalancerUpdateRequest) GetName() string {
+func (o *DNS) GetName() string {
 	return o.Name
 }
 
 // SetName sets value to Name
-func (o *LoadBalancerUpdateRequest) SetName(v string) {
+func (o *DNS) SetName(v string) {
 	o.Name = v
 }
 
 // GetDescription returns value of Description
-func (o *LoadBalancerUpdateRequest) GetDescription() string {
+func (o *DNS) GetDescription() string {
 	return o.Description
 }
 
 // SetDescription sets value to Description
-func (o *LoadBalancerUpdateRequest) SetDescription(v string) {
+func (o *DNS) SetDescription(v string) {
 	o.Description = v
 }
 
 // GetTags returns value of Tags
-func (o *LoadBalancerUpdateRequest) GetTags() []string {
+func (o *DNS) GetTags() []string {
 	return o.Tags
 }
 
 // SetTags sets value to Tags
-func (o *LoadBalancerUpdateRequest) SetTags(v []string) {
+func (o *DNS) SetTags(v []string) {
 	o.Tags = v
 }
 
+// GetAvailability returns value of Availability
+func (o *DNS) GetAvailability() types.EAvailability {
+	return o.Availability
+}
+
+// SetAvailability sets value to Availability
+func (o *DNS) SetAvailability(v types.EAvailability) {
+	o.Availability = v
+}
+
 // GetIconID returns value of IconID
-func (o *LoadBalancerUpdateRequest) GetIconID() types.ID {
+func (o *DNS) GetIconID() types.ID {
 	return o.IconID
 }
 
 // SetIconID sets value to IconID
-func (o *LoadBalancerUpdateRequest) SetIconID(v types.ID) {
+func (o *DNS) SetIconID(v types.ID) {
 	o.IconID = v
 }
 
-// GetVirtualIPAddresses returns value of VirtualIPAddresses
-func (o *LoadBalancerUpdateRequest) GetVirtualIPAddresses() []*LoadBalancerVirtualIPAddress {
-	return o.VirtualIPAddresses
+// GetCreatedAt returns value of CreatedAt
+func (o *DNS) GetCreatedAt() time.Time {
+	return o.CreatedAt
 }
 
-// SetVirtualIPAddresses sets value to VirtualIPAddresses
-func (o *LoadBalancerUpdateRequest) SetVirtualIPAddresses(v []*LoadBalancerVirtualIPAddress) {
-	o.VirtualIPAddresses = v
+// SetCreatedAt sets value to CreatedAt
+func (o *DNS) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
 }
 
-// convertTo returns naked LoadBalancerUpdateRequest
-func (o *LoadBalancerUpdateRequest) convertTo() (*naked.LoadBalancer, error) {
-	dest := &naked.LoadBalancer{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetModifiedAt returns value of ModifiedAt
+func (o *DNS) GetModifiedAt() time.Time {
+	return o.ModifiedAt
 }
 
-// convertFrom parse values from naked LoadBalancerUpdateRequest
-func (o *LoadBalancerUpdateRequest) convertFrom(naked *naked.LoadBalancer) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetModifiedAt sets value to ModifiedAt
+func (o *DNS) SetModifiedAt(v time.Time) {
+	o.ModifiedAt = v
 }
 
-/*************************************************
-* ShutdownOption
-*************************************************/
+// GetClass returns value of Class
+func (o *DNS) GetClass() string {
+	return o.Class
+}
 
-// ShutdownOption represents API parameter/response structure
-type ShutdownOption struct {
-	Force bool
+// SetClass sets value to Class
+func (o *DNS) SetClass(v string) {
+	o.Class = v
 }
 
-// Validate validates by field tags
-func (o *ShutdownOption) Validate() error {
-	return validator.New().Struct(o)
+// GetRecords returns value of Records
+func (o *DNS) GetRecords() []*DNSRecord {
+	return o.Records
 }
 
-// GetForce returns value of Force
-func (o *ShutdownOption) GetForce() bool {
-	return o.Force
+// SetRecords sets value to Records
+func (o *DNS) SetRecords(v []*DNSRecord) {
+	o.Records = v
 }
 
-// SetForce sets value to Force
-func (o *ShutdownOption) SetForce(v bool) {
-	o.Force = v
+// GetSettingsHash returns value of SettingsHash
+func (o *DNS) GetSettingsHash() string {
+	return o.SettingsHash
+}
+
+// SetSettingsHash sets value to SettingsHash
+func (o *DNS) SetSettingsHash(v string) {
+	o.SettingsHash = v
+}
+
+// GetDNSNameServers returns value of DNSNameServers
+func (o *DNS) GetDNSNameServers() []string {
+	return o.DNSNameServers
+}
+
+// SetDNSNameServers sets value to DNSNameServers
+func (o *DNS) SetDNSNameServers(v []string) {
+	o.DNSNameServers = v
+}
+
+// convertTo returns naked DNS
+func (o *DNS) convertTo() (*naked.DNS, error) {
+	dest := &naked.DNS{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked DNS
+func (o *DNS) convertFrom(naked *naked.DNS) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* LoadBalancerStatus
+* DNSRecord
 *************************************************/
 
-// LoadBalancerStatus represents API parameter/response structure
-type LoadBalancerStatus struct {
-	VirtualIPAddress string
-	Port             types.StringNumber
-	CPS              types.StringNumber
-	Servers          []*LoadBalancerServerStatus `mapconv:",recursive"`
+// DNSRecord represents API parameter/response structure
+type DNSRecord struct {
+	Name  string               `validate:"required"`
+	Type  types.EDNSRecordType `validate:"required,oneof=A AAAA ALIAS CNAME NS MX TXT SRV CAA"`
+	RData string               `validate:"required"`
+	TTL   int                  `validate:"omitempty,min=10,max=3600000"`
 }
 
 // Validate validates by field tags
-func (o *LoadBalancerStatus) Validate() error {
+func (o *DNSRecord) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetVirtualIPAddress returns value of VirtualIPAddress
-func (o *LoadBalancerStatus) GetVirtualIPAddress() string {
-	return o.VirtualIPAddress
+// GetName returns value of Name
+func (o *DNSRecord) GetName() string {
+	return o.Name
 }
 
-// SetVirtualIPAddress sets value to VirtualIPAddress
-func (o *LoadBalancerStatus) SetVirtualIPAddress(v string) {
-	o.VirtualIPAddress = v
+// SetName sets value to Name
+func (o *DNSRecord) SetName(v string) {
+	o.Name = v
 }
 
-// GetPort returns value of Port
-func (o *LoadBalancerStatus) GetPort() types.StringNumber {
-	return o.Port
+// GetType returns value of Type
+func (o *DNSRecord) GetType() types.EDNSRecordType {
+	return o.Type
 }
 
-// SetPort sets value to Port
-func (o *LoadBalancerStatus) SetPort(v types.StringNumber) {
-	o.Port = v
+// SetType sets value to Type
+func (o *DNSRecord) SetType(v types.EDNSRecordType) {
+	o.Type = v
 }
 
-// GetCPS returns value of CPS
-func (o *LoadBalancerStatus) GetCPS() types.StringNumber {
-	return o.CPS
+// GetRData returns value of RData
+func (o *DNSRecord) GetRData() string {
+	return o.RData
 }
 
-// SetCPS sets value to CPS
-func (o *LoadBalancerStatus) SetCPS(v types.StringNumber) {
-	o.CPS = v
+// SetRData sets value to RData
+func (o *DNSRecord) SetRData(v string) {
+	o.RData = v
 }
 
-// GetServers returns value of Servers
-func (o *LoadBalancerStatus) GetServers() []*LoadBalancerServerStatus {
-	return o.Servers
+// GetTTL returns value of TTL
+func (o *DNSRecord) GetTTL() int {
+	return o.TTL
 }
 
-// SetServers sets value to Servers
-func (o *LoadBalancerStatus) SetServers(v []*LoadBalancerServerStatus) {
-	o.Servers = v
+// SetTTL sets value to TTL
+func (o *DNSRecord) SetTTL(v int) {
+	o.TTL = v
 }
 
-// convertTo returns naked LoadBalancerStatus
-func (o *LoadBalancerStatus) convertTo() (*naked.LoadBalancerStatus, error) {
-	dest := &naked.LoadBalancerStatus{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+/*************************************************
+* DNSCreateRequest
+*************************************************/
+
+// DNSCreateRequest represents API parameter/response structure
+type DNSCreateRequest struct {
+	Class       string       `mapconv:"Provider.Class,default=dns"`
+	Name        string       `mapconv:"Name/Status.Zone" validate:"required"`
+	Records     []*DNSRecord `mapconv:"Settings.DNS.[]ResourceRecordSets,recursive" validate:"min=0,max=1000"`
+	Description string       `validate:"min=0,max=512"`
+	Tags        []string
+	IconID      types.ID `mapconv:"Icon.ID"`
 }
 
-// convertFrom parse values from naked LoadBalancerStatus
-func (o *LoadBalancerStatus) convertFrom(naked *naked.LoadBalancerStatus) error {
-	return mapconv.ConvertFrom(naked, o)
+// Validate validates by field tags
+func (o *DNSCreateRequest) Validate() error {
+	return validator.New().Struct(o)
 }
 
-/*************************************************
-* LoadBalancerServerStatus
-*************************************************/
+// GetClass returns value of Class
+func (o *DNSCreateRequest) GetClass() string {
+	return o.Class
+}
 
-// LoadBalancerServerStatus represents API parameter/response structure
-type LoadBalancerServerStatus struct {
-	ActiveConn types.StringNumber
-	Status     types.EServerInstanceStatus
-	IPAddress  string
-	Port       types.StringNumber
-	CPS        types.StringNumber
+// SetClass sets value to Class
+func (o *DNSCreateRequest) SetClass(v string) {
+	o.Class = v
 }
 
-// Validate validates by field tags
-func (o *LoadBalancerServerStatus) Validate() error {
-	return validator.New().Struct(o)
+// GetName returns value of Name
+func (o *DNSCreateRequest) GetName() string {
+	return o.Name
 }
 
-// GetActiveConn returns value of ActiveConn
-func (o *LoadBalancerServerStatus) GetActiveConn() types.StringNumber {
-	return o.ActiveConn
+// SetName sets value to Name
+func (o *DNSCreateRequest) SetName(v string) {
+	o.Name = v
 }
 
-// SetActiveConn sets value to ActiveConn
-func (o *LoadBalancerServerStatus) SetActiveConn(v types.StringNumber) {
-	o.ActiveConn = v
+// GetRecords returns value of Records
+func (o *DNSCreateRequest) GetRecords() []*DNSRecord {
+	return o.Records
 }
 
-// GetStatus returns value of Status
-func (o *LoadBalancerServerStatus) GetStatus() types.EServerInstanceStatus {
-	return o.Status
+// SetRecords sets value to Records
+func (o *DNSCreateRequest) SetRecords(v []*DNSRecord) {
+	o.Records = v
 }
 
-// SetStatus sets value to Status
-func (o *LoadBalancerServerStatus) SetStatus(v types.EServerInstanceStatus) {
-	o.Status = v
+// GetDescription returns value of Description
+func (o *DNSCreateRequest) GetDescription() string {
+	return o.Description
 }
 
-// GetIPAddress returns value of IPAddress
-func (o *LoadBalancerServerStatus) GetIPAddress() string {
-	return o.IPAddress
+// SetDescription sets value to Description
+func (o *DNSCreateRequest) SetDescription(v string) {
+	o.Description = v
 }
 
-// SetIPAddress sets value to IPAddress
-func (o *LoadBalancerServerStatus) SetIPAddress(v string) {
-	o.IPAddress = v
+// GetTags returns value of Tags
+func (o *DNSCreateRequest) GetTags() []string {
+	return o.Tags
 }
 
-// GetPort returns value of Port
-func (o *LoadBalancerServerStatus) GetPort() types.StringNumber {
-	return o.Port
+// SetTags sets value to Tags
+func (o *DNSCreateRequest) SetTags(v []string) {
+	o.Tags = v
 }
 
-// SetPort sets value to Port
-func (o *LoadBalancerServerStatus) SetPort(v types.StringNumber) {
-	o.Port = v
+// GetIconID returns value of IconID
+func (o *DNSCreateRequest) GetIconID() types.ID {
+	return o.IconID
 }
 
-// GetCPS returns value of CPS
-func (o *LoadBalancerServerStatus) GetCPS() types.StringNumber {
-	return o.CPS
+// SetIconID sets value to IconID
+func (o *DNSCreateRequest) SetIconID(v types.ID) {
+	o.IconID = v
 }
 
-// SetCPS sets value to CPS
-func (o *LoadBalancerServerStatus) SetCPS(v types.StringNumber) {
-	o.CPS = v
+// convertTo returns naked DNSCreateRequest
+func (o *DNSCreateRequest) convertTo() (*naked.DNS, error) {
+	dest := &naked.DNS{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked DNSCreateRequest
+func (o *DNSCreateRequest) convertFrom(naked *naked.DNS) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* NFS
+* DNSUpdateRequest
 *************************************************/
 
-// NFS represents API parameter/response structure
-type NFS struct {
-	ID                      types.ID
-	Name                    string `validate:"required"`
-	Description             string `validate:"min=0,max=512"`
-	Tags                    []string
-	Availability            types.EAvailability
-	Class                   string
-	InstanceHostName        string                      `mapconv:"Instance.Host.Name"`
-	InstanceHostInfoURL     string                      `mapconv:"Instance.Host.InfoURL"`
-	InstanceStatus          types.EServerInstanceStatus `mapconv:"Instance.Status"`
-	InstanceStatusChangedAt time.Time                   `mapconv:"Instance.StatusChangedAt"`
-	Interfaces              []*Interface                `json:",omitempty" mapconv:"[]Interfaces,recursive,omitempty"`
-	PlanID                  types.ID                    `mapconv:"Remark.Plan.ID/Plan.ID"`
-	SwitchID                types.ID                    `mapconv:"Remark.Switch.ID"`
-	DefaultRoute            string                      `mapconv:"Remark.Network.DefaultRoute" validate:"ipv4"`
-	NetworkMaskLen          int                         `mapconv:"Remark.Network.NetworkMaskLen" validate:"min=1,max=32"`
-	IPAddresses             []string                    `mapconv:"Remark.[]Servers.IPAddress"`
-	ZoneID                  types.ID                    `mapconv:"Remark.Zone.ID"`
-	IconID                  types.ID                    `mapconv:"Icon.ID"`
-	CreatedAt               time.Time
-	ModifiedAt              time.Time
+// DNSUpdateRequest represents API parameter/response structure
+type DNSUpdateRequest struct {
+	Records     []*DNSRecord `mapconv:"Settings.DNS.[]ResourceRecordSets,recursive" validate:"min=0,max=1000"`
+	Description string       `validate:"min=0,max=512"`
+	Tags        []string
+	IconID      types.ID `mapconv:"Icon.ID"`
 }
 
 // Validate validates by field tags
-func (o *NFS) Validate() error {
+func (o *DNSUpdateRequest) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetID returns value of ID
-func (o *NFS) GetID() types.ID {
-	return o.ID
-}
-
-// SetID sets value to ID
-func (o *NFS) SetID(v types.ID) {
-	o.ID = v
-}
-
-// GetStringID gets value to StringID
-func (o *NFS) GetStringID() string {
-	return accessor.GetStringID(o)
-}
-
-// SetStringID sets value to StringID
-func (o *NFS) SetStringID(v string) {
-	accessor.SetStringID(o, v)
-}
-
-// GetInt64ID gets value to Int64ID
-func (o *NFS) GetInt64ID() int64 {
-	return accessor.GetInt64ID(o)
-}
-
-// SetInt64ID sets value to Int64ID
-func (o *NFS) SetInt64ID(v int64) {
-	accessor.SetInt64ID(o, v)
+// GetRecords returns value of Records
+func (o *DNSUpdateRequest) GetRecords() []*DNSRecord {
+	return o.Records
 }
 
-// GetName returns value of Name
-func (o *NFS) GetName() string {
-	return o.Name
-}
-
-// SetName sets value to Name
-func (o *NFS) SetName(v string) {
-	o.Name = v
+// SetRecords sets value to Records
+func (o *DNSUpdateRequest) SetRecords(v []*DNSRecord) {
+	o.Records = v
 }
 
 // GetDescription returns value of Description
-func (o *NFS) GetDescription() string {
+func (o *DNSUpdateRequest) GetDescription() string {
 	return o.Description
 }
 
 // SetDescription sets value to Description
-func (o *NFS) SetDescription(v string) {
+func (o *DNSUpdateRequest) SetDescription(v string) {
 	o.Description = v
 }
 
 // GetTags returns value of Tags
-func (o *NFS) GetTags() []string {
+func (o *DNSUpdateRequest) GetTags() []string {
 	return o.Tags
 }
 
 // SetTags sets value to Tags
-func (o *NFS) SetTags(v []string) {
+func (o *DNSUpdateRequest) SetTags(v []string) {
 	o.Tags = v
 }
 
-// GetAvailability returns value of Availability
-func (o *NFS) GetAvailability() types.EAvailability {
-	return o.Availability
-}
-
-// SetAvailability sets value to Availability
-func (o *NFS) SetAvailability(v types.EAvailability) {
-	o.Availability = v
+// GetIconID returns value of IconID
+func (o *DNSUpdateRequest) GetIconID() types.ID {
+	return o.IconID
 }
 
-// GetClass returns value of Class
-func (o *NFS) GetClass() string {
-	return o.Class
+// SetIconID sets value to IconID
+func (o *DNSUpdateRequest) SetIconID(v types.ID) {
+	o.IconID = v
 }
 
-// SetClass sets value to Class
-func (o *NFS) SetClass(v string) {
-	o.Class = v
+// convertTo returns naked DNSUpdateRequest
+func (o *DNSUpdateRequest) convertTo() (*naked.DNS, error) {
+	dest := &naked.DNS{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// GetInstanceHostName returns value of InstanceHostName
-func (o *NFS) GetInstanceHostName() string {
-	return o.InstanceHostName
+// convertFrom parse values from naked DNSUpdateRequest
+func (o *DNSUpdateRequest) convertFrom(naked *naked.DNS) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// SetInstanceHostName sets value to InstanceHostName
-func (o *NFS) SetInstanceHostName(v string) {
-	o.InstanceHostName = v
-}
+/*************************************************
+* ESUsage
+*************************************************/
 
-// GetInstanceHostInfoURL returns value of InstanceHostInfoURL
-func (o *NFS) GetInstanceHostInfoURL() string {
-	return o.InstanceHostInfoURL
+// ESUsage represents API parameter/response structure
+type ESUsage struct {
+	ID             types.ID `mapconv:"ESUsageID"`
+	MemberID       string
+	ContractID     types.ID
+	ServiceClassID types.ID
+	Description    string `validate:"min=0,max=512"`
+	Usage          int64
+	Amount         int64
+	UsageDate      time.Time
 }
 
-// SetInstanceHostInfoURL sets value to InstanceHostInfoURL
-func (o *NFS) SetInstanceHostInfoURL(v string) {
-	o.InstanceHostInfoURL = v
+// Validate validates by field tags
+func (o *ESUsage) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetInstanceStatus returns value of InstanceStatus
-func (o *NFS) GetInstanceStatus() types.EServerInstanceStatus {
-	return o.InstanceStatus
+// GetID returns value of ID
+func (o *ESUsage) GetID() types.ID {
+	return o.ID
 }
 
-// SetInstanceStatus sets value to InstanceStatus
-func (o *NFS) SetInstanceStatus(v types.EServerInstanceStatus) {
-	o.InstanceStatus = v
+// SetID sets value to ID
+func (o *ESUsage) SetID(v types.ID) {
+	o.ID = v
 }
 
-// GetInstanceStatusChangedAt returns value of InstanceStatusChangedAt
-func (o *NFS) GetInstanceStatusChangedAt() time.Time {
-	return o.InstanceStatusChangedAt
+// GetStringID gets value to StringID
+func (o *ESUsage) GetStringID() string {
+	return accessor.GetStringID(o)
 }
 
-// SetInstanceStatusChangedAt sets value to InstanceStatusChangedAt
-func (o *NFS) SetInstanceStatusChangedAt(v time.Time) {
-	o.InstanceStatusChangedAt = v
+// SetStringID sets value to StringID
+func (o *ESUsage) SetStringID(v string) {
+	accessor.SetStringID(o, v)
 }
 
-// GetInterfaces returns value of Interfaces
-func (o *NFS) GetInterfaces() []*Interface {
-	return o.Interfaces
+// GetInt64ID gets value to Int64ID
+func (o *ESUsage) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
 }
 
-// SetInterfaces sets value to Interfaces
-func (o *NFS) SetInterfaces(v []*Interface) {
-	o.Interfaces = v
+// SetInt64ID sets value to Int64ID
+func (o *ESUsage) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
 }
 
-// GetPlanID returns value of PlanID
-func (o *NFS) GetPlanID() types.ID {
-	return o.PlanID
+// GetMemberID returns value of MemberID
+func (o *ESUsage) GetMemberID() string {
+	return o.MemberID
 }
 
-// SetPlanID sets value to PlanID
-func (o *NFS) SetPlanID(v types.ID) {
-	o.PlanID = v
+// SetMemberID sets value to MemberID
+func (o *ESUsage) SetMemberID(v string) {
+	o.MemberID = v
 }
 
-// GetSwitchID returns value of SwitchID
-func (o *NFS) GetSwitchID() types.ID {
-	return o.SwitchID
+// GetContractID returns value of ContractID
+func (o *ESUsage) GetContractID() types.ID {
+	return o.ContractID
 }
 
-// SetSwitchID sets value to SwitchID
-func (o *NFS) SetSwitchID(v types.ID) {
-	o.SwitchID = v
+// SetContractID sets value to ContractID
+func (o *ESUsage) SetContractID(v types.ID) {
+	o.ContractID = v
 }
 
-// GetDefaultRoute returns value of DefaultRoute
-func (o *NFS) GetDefaultRoute() string {
-	return o.DefaultRoute
+// GetServiceClassID returns value of ServiceClassID
+func (o *ESUsage) GetServiceClassID() types.ID {
+	return o.ServiceClassID
 }
 
-// SetDefaultRoute sets value to DefaultRoute
-func (o *NFS) SetDefaultRoute(v string) {
-	o.DefaultRoute = v
+// SetServiceClassID sets value to ServiceClassID
+func (o *ESUsage) SetServiceClassID(v types.ID) {
+	o.ServiceClassID = v
 }
 
-// GetNetworkMaskLen returns value of NetworkMaskLen
-func (o *NFS) GetNetworkMaskLen() int {
-	return o.NetworkMaskLen
+// GetDescription returns value of Description
+func (o *ESUsage) GetDescription() string {
+	return o.Description
 }
 
-// SetNetworkMaskLen sets value to NetworkMaskLen
-func (o *NFS) SetNetworkMaskLen(v int) {
-	o.NetworkMaskLen = v
+// SetDescription sets value to Description
+func (o *ESUsage) SetDescription(v string) {
+	o.Description = v
 }
 
-// GetIPAddresses returns value of IPAddresses
-func (o *NFS) GetIPAddresses() []string {
-	return o.IPAddresses
+// GetUsage returns value of Usage
+func (o *ESUsage) GetUsage() int64 {
+	return o.Usage
 }
 
-// SetIPAddresses sets value to IPAddresses
-func (o *NFS) SetIPAddresses(v []string) {
-	o.IPAddresses = v
+// SetUsage sets value to Usage
+func (o *ESUsage) SetUsage(v int64) {
+	o.Usage = v
 }
 
-// GetZoneID returns value of ZoneID
-func (o *NFS) GetZoneID() types.ID {
-	return o.ZoneID
+// GetAmount returns value of Amount
+func (o *ESUsage) GetAmount() int64 {
+	return o.Amount
 }
 
-// SetZoneID sets value to ZoneID
-func (o *NFS) SetZoneID(v types.ID) {
-	o.ZoneID = v
+// SetAmount sets value to Amount
+func (o *ESUsage) SetAmount(v int64) {
+	o.Amount = v
 }
 
-// GetIconID returns value of IconID
-func (o *NFS) GetIconID() types.ID {
-	return o.IconID
+// GetUsageDate returns value of UsageDate
+func (o *ESUsage) GetUsageDate() time.Time {
+	return o.UsageDate
 }
 
-// SetIconID sets value to IconID
-func (o *NFS) SetIconID(v types.ID) {
-	o.IconID = v
+// SetUsageDate sets value to UsageDate
+func (o *ESUsage) SetUsageDate(v time.Time) {
+	o.UsageDate = v
 }
 
-// GetCreatedAt returns value of CreatedAt
-func (o *NFS) GetCreatedAt() time.Time {
-	return o.CreatedAt
+// convertTo returns naked ESUsage
+func (o *ESUsage) convertTo() (*naked.ESUsage, error) {
+	dest := &naked.ESUsage{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetCreatedAt sets value to CreatedAt
-func (o *NFS) SetCreatedAt(v time.Time) {
-	o.CreatedAt = v
+// convertFrom parse values from naked ESUsage
+func (o *ESUsage) convertFrom(naked *naked.ESUsage) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* GSLB
+*************************************************/
+
+// GSLB represents API parameter/response structure
+type GSLB struct {
+	ID                      types.ID
+	Name                    string `validate:"required"`
+	Description             string `validate:"min=0,max=512"`
+	Tags                    []string
+	Availability            types.EAvailability
+	IconID                  types.ID `mapconv:"Icon.ID"`
+	CreatedAt               time.Time
+	ModifiedAt              time.Time
+	Class                   string `mapconv:"Provider.Class,default=gslb"`
+	SettingsHash            string
+	FQDN                    string             `mapconv:"Status.FQDN"`
+	DelayLoop               int                `mapconv:"Settings.GSLB.DelayLoop,default=10" validate:"min=10,max=60"`
+	Weighted                types.StringFlag   `mapconv:"Settings.GSLB.Weighted"`
+	HealthCheckProtocol     types.Protocol     `mapconv:"Settings.GSLB.HealthCheck.Protocol" validate:"oneof=http https ping tcp"`
+	HealthCheckHostHeader   string             `mapconv:"Settings.GSLB.HealthCheck.Host"`
+	HealthCheckPath         string             `mapconv:"Settings.GSLB.HealthCheck.Path"`
+	HealthCheckResponseCode types.StringNumber `mapconv:"Settings.GSLB.HealthCheck.Status"`
+	HealthCheckPort         types.StringNumber `mapconv:"Settings.GSLB.HealthCheck.Port"`
+	SorryServer             string             `mapconv:"Settings.GSLB.SorryServer"`
+	DestinationServers      []*GSLBServer      `mapconv:"Settings.GSLB.[]Servers,recursive" validate:"min=0,max=6"`
+}
+
+// Validate validates by field tags
+func (o *GSLB) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *GSLB) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *GSLB) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *GSLB) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *GSLB) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *GSLB) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *GSLB) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetName returns value of Name
+func (o *GSLB) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *GSLB) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *GSLB) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *GSLB) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *GSLB) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *GSLB) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetAvailability returns value of Availability
+func (o *GSLB) GetAvailability() types.EAvailability {
+	return o.Availability
+}
+
+// SetAvailability sets value to Availability
+func (o *GSLB) SetAvailability(v types.EAvailability) {
+	o.Availability = v
+}
+
+// GetIconID returns value of IconID
+func (o *GSLB) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *GSLB) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// GetCreatedAt returns value of CreatedAt
+func (o *GSLB) GetCreatedAt() time.Time {
+	return o.CreatedAt
+}
+
+// SetCreatedAt sets value to CreatedAt
+func (o *GSLB) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
+}
+
+// GetModifiedAt returns value of ModifiedAt
+func (o *GSLB) GetModifiedAt() time.Time {
+	return o.ModifiedAt
+}
+
+// SetModifiedAt sets value to ModifiedAt
+func (o *GSLB) SetModifiedAt(v time.Time) {
+	o.ModifiedAt = v
+}
+
+// GetClass returns value of Class
+func (o *GSLB) GetClass() string {
+	return o.Class
+}
+
+// SetClass sets value to Class
+func (o *GSLB) SetClass(v string) {
+	o.Class = v
+}
+
+// GetSettingsHash returns value of SettingsHash
+func (o *GSLB) GetSettingsHash() string {
+	return o.SettingsHash
+}
+
+// SetSettingsHash sets value to SettingsHash
+func (o *GSLB) SetSettingsHash(v string) {
+	o.SettingsHash = v
+}
+
+// GetFQDN returns value of FQDN
+func (o *GSLB) GetFQDN() string {
+	return o.FQDN
+}
+
+// SetFQDN sets value to FQDN
+func (o *GSLB) SetFQDN(v string) {
+	o.FQDN = v
+}
+
+// GetDelayLoop returns value of DelayLoop
+func (o *GSLB) GetDelayLoop() int {
+	return o.DelayLoop
+}
+
+// SetDelayLoop sets value to DelayLoop
+func (o *GSLB) SetDelayLoop(v int) {
+	o.DelayLoop = v
+}
+
+// GetWeighted returns value of Weighted
+func (o *GSLB) GetWeighted() types.StringFlag {
+	return o.Weighted
+}
+
+// SetWeighted sets value to Weighted
+func (o *GSLB) SetWeighted(v types.StringFlag) {
+	o.Weighted = v
+}
+
+// GetHealthCheckProtocol returns value of HealthCheckProtocol
+func (o *GSLB) GetHealthCheckProtocol() types.Protocol {
+	return o.HealthCheckProtocol
+}
+
+// SetHealthCheckProtocol sets value to HealthCheckProtocol
+func (o *GSLB) SetHealthCheckProtocol(v types.Protocol) {
+	o.HealthCheckProtocol = v
+}
+
+// GetHealthCheckHostHeader returns value of HealthCheckHostHeader
+func (o *GSLB) GetHealthCheckHostHeader() string {
+	return o.HealthCheckHostHeader
+}
+
+// SetHealthCheckHostHeader sets value to HealthCheckHostHeader
+func (o *GSLB) SetHealthCheckHostHeader(v string) {
+	o.HealthCheckHostHeader = v
+}
+
+// GetHealthCheckPath returns value of HealthCheckPath
+func (o *GSLB) GetHealthCheckPath() string {
+	return o.HealthCheckPath
+}
+
+// SetHealthCheckPath sets value to HealthCheckPath
+func (o *GSLB) SetHealthCheckPath(v string) {
+	o.HealthCheckPath = v
+}
+
+// GetHealthCheckResponseCode returns value of HealthCheckResponseCode
+func (o *GSLB) GetHealthCheckResponseCode() types.StringNumber {
+	return o.HealthCheckResponseCode
+}
+
+// SetHealthCheckResponseCode sets value to HealthCheckResponseCode
+func (o *GSLB) SetHealthCheckResponseCode(v types.StringNumber) {
+	o.HealthCheckResponseCode = v
+}
+
+// GetHealthCheckPort returns value of HealthCheckPort
+func (o *GSLB) GetHealthCheckPort() types.StringNumber {
+	return o.HealthCheckPort
+}
+
+// SetHealthCheckPort sets value to HealthCheckPort
+func (o *GSLB) SetHealthCheckPort(v types.StringNumber) {
+	o.HealthCheckPort = v
+}
+
+// GetSorryServer returns value of SorryServer
+func (o *GSLB) GetSorryServer() string {
+	return o.SorryServer
+}
+
+// SetSorryServer sets value to SorryServer
+func (o *GSLB) SetSorryServer(v string) {
+	o.SorryServer = v
+}
+
+// GetDestinationServers returns value of DestinationServers
+func (o *GSLB) GetDestinationServers() []*GSLBServer {
+	return o.DestinationServers
+}
+
+// SetDestinationServers sets value to DestinationServers
+func (o *GSLB) SetDestinationServers(v []*GSLBServer) {
+	o.DestinationServers = v
+}
+
+// convertTo returns naked GSLB
+func (o *GSLB) convertTo() (*naked.GSLB, error) {
+	dest := &naked.GSLB{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked GSLB
+func (o *GSLB) convertFrom(naked *naked.GSLB) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* GSLBServer
+*************************************************/
+
+// GSLBServer represents API parameter/response structure
+type GSLBServer struct {
+	IPAddress string `validate:"ipv4"`
+	Enabled   types.StringFlag
+	Weight    types.StringNumber `mapconv:",default=1"`
+}
+
+// Validate validates by field tags
+func (o *GSLBServer) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetIPAddress returns value of IPAddress
+func (o *GSLBServer) GetIPAddress() string {
+	return o.IPAddress
+}
+
+// SetIPAddress sets value to IPAddress
+func (o *GSLBServer) SetIPAddress(v string) {
+	o.IPAddress = v
+}
+
+// GetEnabled returns value of Enabled
+func (o *GSLBServer) GetEnabled() types.StringFlag {
+	return o.Enabled
+}
+
+// SetEnabled sets value to Enabled
+func (o *GSLBServer) SetEnabled(v types.StringFlag) {
+	o.Enabled = v
+}
+
+// GetWeight returns value of Weight
+func (o *GSLBServer) GetWeight() types.StringNumber {
+	return o.Weight
+}
+
+// SetWeight sets value to Weight
+func (o *GSLBServer) SetWeight(v types.StringNumber) {
+	o.Weight = v
+}
+
+/*************************************************
+* GSLBCreateRequest
+*************************************************/
+
+// GSLBCreateRequest represents API parameter/response structure
+type GSLBCreateRequest struct {
+	Class                   string             `mapconv:"Provider.Class,default=gslb"`
+	HealthCheckProtocol     types.Protocol     `mapconv:"Settings.GSLB.HealthCheck.Protocol" validate:"oneof=http https ping tcp"`
+	HealthCheckHostHeader   string             `mapconv:"Settings.GSLB.HealthCheck.Host"`
+	HealthCheckPath         string             `mapconv:"Settings.GSLB.HealthCheck.Path"`
+	HealthCheckResponseCode types.StringNumber `mapconv:"Settings.GSLB.HealthCheck.Status"`
+	HealthCheckPort         types.StringNumber `mapconv:"Settings.GSLB.HealthCheck.Port"`
+	DelayLoop               int                `mapconv:"Settings.GSLB.DelayLoop,default=10" validate:"min=10,max=60"`
+	Weighted                types.StringFlag   `mapconv:"Settings.GSLB.Weighted"`
+	SorryServer             string             `mapconv:"Settings.GSLB.SorryServer"`
+	DestinationServers      []*GSLBServer      `mapconv:"Settings.GSLB.[]Servers,recursive" validate:"min=0,max=6"`
+	Name                    string             `validate:"required"`
+	Description             string             `validate:"min=0,max=512"`
+	Tags                    []string
+	IconID                  types.ID `mapconv:"Icon.ID"`
+}
+
+// Validate validates by field tags
+func (o *GSLBCreateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetClass returns value of Class
+func (o *GSLBCreateRequest) GetClass() string {
+	return o.Class
+}
+
+// SetClass sets value to Class
+func (o *GSLBCreateRequest) SetClass(v string) {
+	o.Class = v
+}
+
+// GetHealthCheckProtocol returns value of HealthCheckProtocol
+func (o *GSLBCreateRequest) GetHealthCheckProtocol() types.Protocol {
+	return o.HealthCheckProtocol
+}
+
+// SetHealthCheckProtocol sets value to HealthCheckProtocol
+func (o *GSLBCreateRequest) SetHealthCheckProtocol(v types.Protocol) {
+	o.HealthCheckProtocol = v
+}
+
+// GetHealthCheckHostHeader returns value of HealthCheckHostHeader
+func (o *GSLBCreateRequest) GetHealthCheckHostHeader() string {
+	return o.HealthCheckHostHeader
+}
+
+// SetHealthCheckHostHeader sets value to HealthCheckHostHeader
+func (o *GSLBCreateRequest) SetHealthCheckHostHeader(v string) {
+	o.HealthCheckHostHeader = v
+}
+
+// GetHealthCheckPath returns value of HealthCheckPath
+func (o *GSLBCreateRequest) GetHealthCheckPath() string {
+	return o.HealthCheckPath
+}
+
+// SetHealthCheckPath sets value to HealthCheckPath
+func (o *GSLBCreateRequest) SetHealthCheckPath(v string) {
+	o.HealthCheckPath = v
+}
+
+// GetHealthCheckResponseCode returns value of HealthCheckResponseCode
+func (o *GSLBCreateRequest) GetHealthCheckResponseCode() types.StringNumber {
+	return o.HealthCheckResponseCode
+}
+
+// SetHealthCheckResponseCode sets value to HealthCheckResponseCode
+func (o *GSLBCreateRequest) SetHealthCheckResponseCode(v types.StringNumber) {
+	o.HealthCheckResponseCode = v
+}
+
+// GetHealthCheckPort returns value of HealthCheckPort
+func (o *GSLBCreateRequest) GetHealthCheckPort() types.StringNumber {
+	return o.HealthCheckPort
+}
+
+// SetHealthCheckPort sets value to HealthCheckPort
+func (o *GSLBCreateRequest) SetHealthCheckPort(v types.StringNumber) {
+	o.HealthCheckPort = v
+}
+
+// GetDelayLoop returns value of DelayLoop
+func (o *GSLBCreateRequest) GetDelayLoop() int {
+	return o.DelayLoop
+}
+
+// SetDelayLoop sets value to DelayLoop
+func (o *GSLBCreateRequest) SetDelayLoop(v int) {
+	o.DelayLoop = v
+}
+
+// GetWeighted returns value of Weighted
+func (o *GSLBCreateRequest) GetWeighted() types.StringFlag {
+	return o.Weighted
+}
+
+// SetWeighted sets value to Weighted
+func (o *GSLBCreateRequest) SetWeighted(v types.StringFlag) {
+	o.Weighted = v
+}
+
+// GetSorryServer returns value of SorryServer
+func (o *GSLBCreateRequest) GetSorryServer() string {
+	return o.SorryServer
+}
+
+// SetSorryServer sets value to SorryServer
+func (o *GSLBCreateRequest) SetSorryServer(v string) {
+	o.SorryServer = v
+}
+
+// GetDestinationServers returns value of DestinationServers
+func (o *GSLBCreateRequest) GetDestinationServers() []*GSLBServer {
+	return o.DestinationServers
+}
+
+// SetDestinationServers sets value to DestinationServers
+func (o *GSLBCreateRequest) SetDestinationServers(v []*GSLBServer) {
+	o.DestinationServers = v
+}
+
+// GetName returns value of Name
+func (o *GSLBCreateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *GSLBCreateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *GSLBCreateRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *GSLBCreateRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *GSLBCreateRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *GSLBCreateRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *GSLBCreateRequest) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *GSLBCreateRequest) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// convertTo returns naked GSLBCreateRequest
+func (o *GSLBCreateRequest) convertTo() (*naked.GSLB, error) {
+	dest := &naked.GSLB{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked GSLBCreateRequest
+func (o *GSLBCreateRequest) convertFrom(naked *naked.GSLB) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* GSLBUpdateRequest
+*************************************************/
+
+// GSLBUpdateRequest represents API parameter/response structure
+type GSLBUpdateRequest struct {
+	HealthCheckProtocol     types.Protocol     `mapconv:"Settings.GSLB.HealthCheck.Protocol" validate:"oneof=http https ping tcp"`
+	HealthCheckHostHeader   string             `mapconv:"Settings.GSLB.HealthCheck.Host"`
+	HealthCheckPath         string             `mapconv:"Settings.GSLB.HealthCheck.Path"`
+	HealthCheckResponseCode types.StringNumber `mapconv:"Settings.GSLB.HealthCheck.Status"`
+	HealthCheckPort         types.StringNumber `mapconv:"Settings.GSLB.HealthCheck.Port"`
+	DelayLoop               int                `mapconv:"Settings.GSLB.DelayLoop,default=10" validate:"min=10,max=60"`
+	Weighted                types.StringFlag   `mapconv:"Settings.GSLB.Weighted"`
+	SorryServer             string             `mapconv:"Settings.GSLB.SorryServer"`
+	DestinationServers      []*GSLBServer      `mapconv:"Settings.GSLB.[]Servers,recursive" validate:"min=0,max=6"`
+	Name                    string             `validate:"required"`
+	Description             string             `validate:"min=0,max=512"`
+	Tags                    []string
+	IconID                  types.ID `mapconv:"Icon.ID"`
+}
+
+// Validate validates by field tags
+func (o *GSLBUpdateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetHealthCheckProtocol returns value of HealthCheckProtocol
+func (o *GSLBUpdateRequest) GetHealthCheckProtocol() types.Protocol {
+	return o.HealthCheckProtocol
+}
+
+// SetHealthCheckProtocol sets value to HealthCheckProtocol
+func (o *GSLBUpdateRequest) SetHealthCheckProtocol(v types.Protocol) {
+	o.HealthCheckProtocol = v
+}
+
+// GetHealthCheckHostHeader returns value of HealthCheckHostHeader
+func (o *GSLBUpdateRequest) GetHealthCheckHostHeader() string {
+	return o.HealthCheckHostHeader
+}
+
+// SetHealthCheckHostHeader sets value to HealthCheckHostHeader
+func (o *GSLBUpdateRequest) SetHealthCheckHostHeader(v string) {
+	o.HealthCheckHostHeader = v
+}
+
+// GetHealthCheckPath returns value of HealthCheckPath
+func (o *GSLBUpdateRequest) GetHealthCheckPath() string {
+	return o.HealthCheckPath
+}
+
+// SetHealthCheckPath sets value to HealthCheckPath
+func (o *GSLBUpdateRequest) SetHealthCheckPath(v string) {
+	o.HealthCheckPath = v
+}
+
+// GetHealthCheckResponseCode returns value of HealthCheckResponseCode
+func (o *GSLBUpdateRequest) GetHealthCheckResponseCode() types.StringNumber {
+	return o.HealthCheckResponseCode
+}
+
+// SetHealthCheckResponseCode sets value to HealthCheckResponseCode
+func (o *GSLBUpdateRequest) SetHealthCheckResponseCode(v types.StringNumber) {
+	o.HealthCheckResponseCode = v
+}
+
+// GetHealthCheckPort returns value of HealthCheckPort
+func (o *GSLBUpdateRequest) GetHealthCheckPort() types.StringNumber {
+	return o.HealthCheckPort
+}
+
+// SetHealthCheckPort sets value to HealthCheckPort
+func (o *GSLBUpdateRequest) SetHealthCheckPort(v types.StringNumber) {
+	o.HealthCheckPort = v
+}
+
+// GetDelayLoop returns value of DelayLoop
+func (o *GSLBUpdateRequest) GetDelayLoop() int {
+	return o.DelayLoop
+}
+
+// SetDelayLoop sets value to DelayLoop
+func (o *GSLBUpdateRequest) SetDelayLoop(v int) {
+	o.DelayLoop = v
+}
+
+// GetWeighted returns value of Weighted
+func (o *GSLBUpdateRequest) GetWeighted() types.StringFlag {
+	return o.Weighted
+}
+
+// SetWeighted sets value to Weighted
+func (o *GSLBUpdateRequest) SetWeighted(v types.StringFlag) {
+	o.Weighted = v
+}
+
+// GetSorryServer returns value of SorryServer
+func (o *GSLBUpdateRequest) GetSorryServer() string {
+	return o.SorryServer
+}
+
+// SetSorryServer sets value to SorryServer
+func (o *GSLBUpdateRequest) SetSorryServer(v string) {
+	o.SorryServer = v
+}
+
+// GetDestinationServers returns value of DestinationServers
+func (o *GSLBUpdateRequest) GetDestinationServers() []*GSLBServer {
+	return o.DestinationServers
+}
+
+// SetDestinationServers sets value to DestinationServers
+func (o *GSLBUpdateRequest) SetDestinationServers(v []*GSLBServer) {
+	o.DestinationServers = v
+}
+
+// GetName returns value of Name
+func (o *GSLBUpdateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *GSLBUpdateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *GSLBUpdateRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *GSLBUpdateRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *GSLBUpdateRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *GSLBUpdateRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *GSLBUpdateRequest) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *GSLBUpdateRequest) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// convertTo returns naked GSLBUpdateRequest
+func (o *GSLBUpdateRequest) convertTo() (*naked.GSLB, error) {
+	dest := &naked.GSLB{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked GSLBUpdateRequest
+func (o *GSLBUpdateRequest) convertFrom(naked *naked.GSLB) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* Icon
+*************************************************/
+
+// Icon represents API parameter/response structure
+type Icon struct {
+	ID           types.ID
+	Name         string `validate:"required"`
+	Tags         []string
+	Availability types.EAvailability
+	Scope        types.EScope
+	URL          string
+	CreatedAt    time.Time
+	ModifiedAt   time.Time
+}
+
+// Validate validates by field tags
+func (o *Icon) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *Icon) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *Icon) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *Icon) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *Icon) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *Icon) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *Icon) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetName returns value of Name
+func (o *Icon) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *Icon) SetName(v string) {
+	o.Name = v
+}
+
+// GetTags returns value of Tags
+func (o *Icon) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *Icon) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetAvailability returns value of Availability
+func (o *Icon) GetAvailability() types.EAvailability {
+	return o.Availability
+}
+
+// SetAvailability sets value to Availability
+func (o *Icon) SetAvailability(v types.EAvailability) {
+	o.Availability = v
+}
+
+// GetScope returns value of Scope
+func (o *Icon) GetScope() types.EScope {
+	return o.Scope
+}
+
+// SetScope sets value to Scope
+func (o *Icon) SetScope(v types.EScope) {
+	o.Scope = v
+}
+
+// GetURL returns value of URL
+func (o *Icon) GetURL() string {
+	return o.URL
+}
+
+// SetURL sets value to URL
+func (o *Icon) SetURL(v string) {
+	o.URL = v
+}
+
+// GetCreatedAt returns value of CreatedAt
+func (o *Icon) GetCreatedAt() time.Time {
+	return o.CreatedAt
+}
+
+// SetCreatedAt sets value to CreatedAt
+func (o *Icon) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
+}
+
+// GetModifiedAt returns value of ModifiedAt
+func (o *Icon) GetModifiedAt() time.Time {
+	return o.ModifiedAt
+}
+
+// SetModifiedAt sets value to ModifiedAt
+func (o *Icon) SetModifiedAt(v time.Time) {
+	o.ModifiedAt = v
+}
+
+// convertTo returns naked Icon
+func (o *Icon) convertTo() (*naked.Icon, error) {
+	dest := &naked.Icon{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked Icon
+func (o *Icon) convertFrom(naked *naked.Icon) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* IconCreateRequest
+*************************************************/
+
+// IconCreateRequest represents API parameter/response structure
+type IconCreateRequest struct {
+	Name  string `validate:"required"`
+	Tags  []string
+	Image string `validate:"required,base64"`
+}
+
+// Validate validates by field tags
+func (o *IconCreateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetName returns value of Name
+func (o *IconCreateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *IconCreateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetTags returns value of Tags
+func (o *IconCreateRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *IconCreateRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetImage returns value of Image
+func (o *IconCreateRequest) GetImage() string {
+	return o.Image
+}
+
+// SetImage sets value to Image
+func (o *IconCreateRequest) SetImage(v string) {
+	o.Image = v
+}
+
+// convertTo returns naked IconCreateRequest
+func (o *IconCreateRequest) convertTo() (*naked.Icon, error) {
+	dest := &naked.Icon{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked IconCreateRequest
+func (o *IconCreateRequest) convertFrom(naked *naked.Icon) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* IconUpdateRequest
+*************************************************/
+
+// IconUpdateRequest represents API parameter/response structure
+type IconUpdateRequest struct {
+	Name string `validate:"required"`
+	Tags []string
+}
+
+// Validate validates by field tags
+func (o *IconUpdateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetName returns value of Name
+func (o *IconUpdateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *IconUpdateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetTags returns value of Tags
+func (o *IconUpdateRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *IconUpdateRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// convertTo returns naked IconUpdateRequest
+func (o *IconUpdateRequest) convertTo() (*naked.Icon, error) {
+	dest := &naked.Icon{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked IconUpdateRequest
+func (o *IconUpdateRequest) convertFrom(naked *naked.Icon) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* IconImage
+*************************************************/
+
+// IconImage represents API parameter/response structure
+type IconImage struct {
+	ID    types.ID
+	Image string
+}
+
+// Validate validates by field tags
+func (o *IconImage) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *IconImage) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *IconImage) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *IconImage) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *IconImage) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *IconImage) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *IconImage) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetImage returns value of Image
+func (o *IconImage) GetImage() string {
+	return o.Image
+}
+
+// SetImage sets value to Image
+func (o *IconImage) SetImage(v string) {
+	o.Image = v
+}
+
+// convertTo returns naked IconImage
+func (o *IconImage) convertTo() (*naked.Icon, error) {
+	dest := &naked.Icon{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked IconImage
+func (o *IconImage) convertFrom(naked *naked.Icon) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* Interface
+*************************************************/
+
+// Interface represents API parameter/response structure
+type Interface struct {
+	ID             types.ID
+	MACAddress     string
+	IPAddress      string
+	UserIPAddress  string
+	HostName       string
+	SwitchID       types.ID `mapconv:"Switch.ID,omitempty"`
+	PacketFilterID types.ID `mapconv:"PacketFilter.ID,omitempty"`
+	ServerID       types.ID `mapconv:"Server.ID,omitempty"`
+	CreatedAt      time.Time
+	ModifiedAt     time.Time
+}
+
+// Validate validates by field tags
+func (o *Interface) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *Interface) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *Interface) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *Interface) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *Interface) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *Interface) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *Interface) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetMACAddress returns value of MACAddress
+func (o *Interface) GetMACAddress() string {
+	return o.MACAddress
+}
+
+// SetMACAddress sets value to MACAddress
+func (o *Interface) SetMACAddress(v string) {
+	o.MACAddress = v
+}
+
+// GetIPAddress returns value of IPAddress
+func (o *Interface) GetIPAddress() string {
+	return o.IPAddress
+}
+
+// SetIPAddress sets value to IPAddress
+func (o *Interface) SetIPAddress(v string) {
+	o.IPAddress = v
+}
+
+// GetUserIPAddress returns value of UserIPAddress
+func (o *Interface) GetUserIPAddress() string {
+	return o.UserIPAddress
+}
+
+// SetUserIPAddress sets value to UserIPAddress
+func (o *Interface) SetUserIPAddress(v string) {
+	o.UserIPAddress = v
+}
+
+// GetHostName returns value of HostName
+func (o *Interface) GetHostName() string {
+	return o.HostName
+}
+
+// SetHostName sets value to HostName
+func (o *Interface) SetHostName(v string) {
+	o.HostName = v
+}
+
+// GetSwitchID returns value of SwitchID
+func (o *Interface) GetSwitchID() types.ID {
+	return o.SwitchID
+}
+
+// SetSwitchID sets value to SwitchID
+func (o *Interface) SetSwitchID(v types.ID) {
+	o.SwitchID = v
+}
+
+// GetPacketFilterID returns value of PacketFilterID
+func (o *Interface) GetPacketFilterID() types.ID {
+	return o.PacketFilterID
+}
+
+// SetPacketFilterID sets value to PacketFilterID
+func (o *Interface) SetPacketFilterID(v types.ID) {
+	o.PacketFilterID = v
+}
+
+// GetServerID returns value of ServerID
+func (o *Interface) GetServerID() types.ID {
+	return o.ServerID
+}
+
+// SetServerID sets value to ServerID
+func (o *Interface) SetServerID(v types.ID) {
+	o.ServerID = v
+}
+
+// GetCreatedAt returns value of CreatedAt
+func (o *Interface) GetCreatedAt() time.Time {
+	return o.CreatedAt
+}
+
+// SetCreatedAt sets value to CreatedAt
+func (o *Interface) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
+}
+
+// GetModifiedAt returns value of ModifiedAt
+func (o *Interface) GetModifiedAt() time.Time {
+	return o.ModifiedAt
+}
+
+// SetModifiedAt sets value to ModifiedAt
+func (o *Interface) SetModifiedAt(v time.Time) {
+	o.ModifiedAt = v
+}
+
+// convertTo returns naked Interface
+func (o *Interface) convertTo() (*naked.Interface, error) {
+	dest := &naked.Interface{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked Interface
+func (o *Interface) convertFrom(naked *naked.Interface) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* InterfaceCreateRequest
+*************************************************/
+
+// InterfaceCreateRequest represents API parameter/response structure
+type InterfaceCreateRequest struct {
+	ServerID types.ID `mapconv:"Server.ID,omitempty"`
+}
+
+// Validate validates by field tags
+func (o *InterfaceCreateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetServerID returns value of ServerID
+func (o *InterfaceCreateRequest) GetServerID() types.ID {
+	return o.ServerID
+}
+
+// SetServerID sets value to ServerID
+func (o *InterfaceCreateRequest) SetServerID(v types.ID) {
+	o.ServerID = v
+}
+
+// convertTo returns naked InterfaceCreateRequest
+func (o *InterfaceCreateRequest) convertTo() (*naked.Interface, error) {
+	dest := &naked.Interface{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked InterfaceCreateRequest
+func (o *InterfaceCreateRequest) convertFrom(naked *naked.Interface) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* InterfaceUpdateRequest
+*************************************************/
+
+// InterfaceUpdateRequest represents API parameter/response structure
+type InterfaceUpdateRequest struct {
+	UserIPAddress string
+}
+
+// Validate validates by field tags
+func (o *InterfaceUpdateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetUserIPAddress returns value of UserIPAddress
+func (o *InterfaceUpdateRequest) GetUserIPAddress() string {
+	return o.UserIPAddress
+}
+
+// SetUserIPAddress sets value to UserIPAddress
+func (o *InterfaceUpdateRequest) SetUserIPAddress(v string) {
+	o.UserIPAddress = v
+}
+
+// convertTo returns naked InterfaceUpdateRequest
+func (o *InterfaceUpdateRequest) convertTo() (*naked.Interface, error) {
+	dest := &naked.Interface{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked InterfaceUpdateRequest
+func (o *InterfaceUpdateRequest) convertFrom(naked *naked.Interface) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* Internet
+*************************************************/
+
+// Internet represents API parameter/response structure
+type Internet struct {
+	ID             types.ID
+	Name           string `validate:"required"`
+	Description    string `validate:"min=0,max=512"`
+	Tags           []string
+	IconID         types.ID `mapconv:"Icon.ID"`
+	CreatedAt      time.Time
+	BandWidthMbps  int
+	NetworkMaskLen int         `validate:"min=24,max=28"`
+	Switch         *SwitchInfo `mapconv:",recursive"`
+}
+
+// Validate validates by field tags
+func (o *Internet) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *Internet) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *Internet) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *Internet) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *Internet) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *Internet) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *Internet) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetName returns value of Name
+func (o *Internet) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *Internet) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *Internet) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *Internet) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *Internet) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *Internet) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *Internet) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *Internet) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// GetCreatedAt returns value of CreatedAt
+func (o *Internet) GetCreatedAt() time.Time {
+	return o.CreatedAt
+}
+
+// SetCreatedAt sets value to CreatedAt
+func (o *Internet) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
+}
+
+// GetBandWidthMbps returns value of BandWidthMbps
+func (o *Internet) GetBandWidthMbps() int {
+	return o.BandWidthMbps
+}
+
+// SetBandWidthMbps sets value to BandWidthMbps
+func (o *Internet) SetBandWidthMbps(v int) {
+	o.BandWidthMbps = v
+}
+
+// GetNetworkMaskLen returns value of NetworkMaskLen
+func (o *Internet) GetNetworkMaskLen() int {
+	return o.NetworkMaskLen
+}
+
+// SetNetworkMaskLen sets value to NetworkMaskLen
+func (o *Internet) SetNetworkMaskLen(v int) {
+	o.NetworkMaskLen = v
+}
+
+// GetSwitch returns value of Switch
+func (o *Internet) GetSwitch() *SwitchInfo {
+	return o.Switch
+}
+
+// SetSwitch sets value to Switch
+func (o *Internet) SetSwitch(v *SwitchInfo) {
+	o.Switch = v
+}
+
+// convertTo returns naked Internet
+func (o *Internet) convertTo() (*naked.Internet, error) {
+	dest := &naked.Internet{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked Internet
+func (o *Internet) convertFrom(naked *naked.Internet) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* SwitchInfo
+*************************************************/
+
+// SwitchInfo represents API parameter/response structure
+type SwitchInfo struct {
+	ID          types.ID
+	Name        string `validate:"required"`
+	Description string `validate:"min=0,max=512"`
+	Tags        []string
+	Scope       types.EScope
+	Subnets     []*InternetSubnet `mapconv:"[]Subnets,recursive"`
+	IPv6Nets    []*IPv6NetInfo    `json:",omitempty" mapconv:"[]IPv6Nets,recursive,omitempty"`
+}
+
+// Validate validates by field tags
+func (o *SwitchInfo) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *SwitchInfo) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *SwitchInfo) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *SwitchInfo) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *SwitchInfo) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *SwitchInfo) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *SwitchInfo) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetName returns value of Name
+func (o *SwitchInfo) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *SwitchInfo) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *SwitchInfo) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *SwitchInfo) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *SwitchInfo) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *SwitchInfo) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetScope returns value of Scope
+func (o *SwitchInfo) GetScope() types.EScope {
+	return o.Scope
+}
+
+// SetScope sets value to Scope
+func (o *SwitchInfo) SetScope(v types.EScope) {
+	o.Scope = v
+}
+
+// GetSubnets returns value of Subnets
+func (o *SwitchInfo) GetSubnets() []*InternetSubnet {
+	return o.Subnets
+}
+
+// SetSubnets sets value to Subnets
+func (o *SwitchInfo) SetSubnets(v []*InternetSubnet) {
+	o.Subnets = v
+}
+
+// GetIPv6Nets returns value of IPv6Nets
+func (o *SwitchInfo) GetIPv6Nets() []*IPv6NetInfo {
+	return o.IPv6Nets
+}
+
+// SetIPv6Nets sets value to IPv6Nets
+func (o *SwitchInfo) SetIPv6Nets(v []*IPv6NetInfo) {
+	o.IPv6Nets = v
+}
+
+// convertTo returns naked SwitchInfo
+func (o *SwitchInfo) convertTo() (*naked.Switch, error) {
+	dest := &naked.Switch{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked SwitchInfo
+func (o *SwitchInfo) convertFrom(naked *naked.Switch) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* InternetSubnet
+*************************************************/
+
+// InternetSubnet represents API parameter/response structure
+type InternetSubnet struct {
+	ID             types.ID
+	DefaultRoute   string `validate:"ipv4"`
+	NextHop        string `validate:"ipv4"`
+	StaticRoute    string `validate:"ipv4"`
+	NetworkAddress string `validate:"ipv4"`
+	NetworkMaskLen int    `validate:"min=24,max=28"`
+}
+
+// Validate validates by field tags
+func (o *InternetSubnet) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *InternetSubnet) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *InternetSubnet) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *InternetSubnet) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *InternetSubnet) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *InternetSubnet) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *InternetSubnet) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetDefaultRoute returns value of DefaultRoute
+func (o *InternetSubnet) GetDefaultRoute() string {
+	return o.DefaultRoute
+}
+
+// SetDefaultRoute sets value to DefaultRoute
+func (o *InternetSubnet) SetDefaultRoute(v string) {
+	o.DefaultRoute = v
+}
+
+// GetNextHop returns value of NextHop
+func (o *InternetSubnet) GetNextHop() string {
+	return o.NextHop
+}
+
+// SetNextHop sets value to NextHop
+func (o *InternetSubnet) SetNextHop(v string) {
+	o.NextHop = v
+}
+
+// GetStaticRoute returns value of StaticRoute
+func (o *InternetSubnet) GetStaticRoute() string {
+	return o.StaticRoute
+}
+
+// SetStaticRoute sets value to StaticRoute
+func (o *InternetSubnet) SetStaticRoute(v string) {
+	o.StaticRoute = v
+}
+
+// GetNetworkAddress returns value of NetworkAddress
+func (o *InternetSubnet) GetNetworkAddress() string {
+	return o.NetworkAddress
+}
+
+// SetNetworkAddress sets value to NetworkAddress
+func (o *InternetSubnet) SetNetworkAddress(v string) {
+	o.NetworkAddress = v
+}
+
+// GetNetworkMaskLen returns value of NetworkMaskLen
+func (o *InternetSubnet) GetNetworkMaskLen() int {
+	return o.NetworkMaskLen
+}
+
+// SetNetworkMaskLen sets value to NetworkMaskLen
+func (o *InternetSubnet) SetNetworkMaskLen(v int) {
+	o.NetworkMaskLen = v
+}
+
+// convertTo returns naked InternetSubnet
+func (o *InternetSubnet) convertTo() (*naked.Subnet, error) {
+	dest := &naked.Subnet{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked InternetSubnet
+func (o *InternetSubnet) convertFrom(naked *naked.Subnet) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* IPv6NetInfo
+*************************************************/
+
+// IPv6NetInfo represents API parameter/response structure
+type IPv6NetInfo struct {
+	ID            types.ID
+	IPv6Prefix    string
+	IPv6PrefixLen int
+}
+
+// Validate validates by field tags
+func (o *IPv6NetInfo) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *IPv6NetInfo) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *IPv6NetInfo) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *IPv6NetInfo) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *IPv6NetInfo) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *IPv6NetInfo) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *IPv6NetInfo) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetIPv6Prefix returns value of IPv6Prefix
+func (o *IPv6NetInfo) GetIPv6Prefix() string {
+	return o.IPv6Prefix
+}
+
+// SetIPv6Prefix sets value to IPv6Prefix
+func (o *IPv6NetInfo) SetIPv6Prefix(v string) {
+	o.IPv6Prefix = v
+}
+
+// GetIPv6PrefixLen returns value of IPv6PrefixLen
+func (o *IPv6NetInfo) GetIPv6PrefixLen() int {
+	return o.IPv6PrefixLen
+}
+
+// SetIPv6PrefixLen sets value to IPv6PrefixLen
+func (o *IPv6NetInfo) SetIPv6PrefixLen(v int) {
+	o.IPv6PrefixLen = v
+}
+
+// convertTo returns naked IPv6NetInfo
+func (o *IPv6NetInfo) convertTo() (*naked.IPv6Net, error) {
+	dest := &naked.IPv6Net{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked IPv6NetInfo
+func (o *IPv6NetInfo) convertFrom(naked *naked.IPv6Net) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* InternetCreateRequest
+*************************************************/
+
+// InternetCreateRequest represents API parameter/response structure
+type InternetCreateRequest struct {
+	Name           string `validate:"required"`
+	Description    string `validate:"min=0,max=512"`
+	Tags           []string
+	IconID         types.ID `mapconv:"Icon.ID"`
+	NetworkMaskLen int      `validate:"min=24,max=28"`
+	BandWidthMbps  int
+}
+
+// Validate validates by field tags
+func (o *InternetCreateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetName returns value of Name
+func (o *InternetCreateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *InternetCreateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *InternetCreateRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *InternetCreateRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *InternetCreateRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *InternetCreateRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *InternetCreateRequest) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *InternetCreateRequest) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// GetNetworkMaskLen returns value of NetworkMaskLen
+func (o *InternetCreateRequest) GetNetworkMaskLen() int {
+	return o.NetworkMaskLen
+}
+
+// SetNetworkMaskLen sets value to NetworkMaskLen
+func (o *InternetCreateRequest) SetNetworkMaskLen(v int) {
+	o.NetworkMaskLen = v
+}
+
+// GetBandWidthMbps returns value of BandWidthMbps
+func (o *InternetCreateRequest) GetBandWidthMbps() int {
+	return o.BandWidthMbps
+}
+
+// SetBandWidthMbps sets value to BandWidthMbps
+func (o *InternetCreateRequest) SetBandWidthMbps(v int) {
+	o.BandWidthMbps = v
+}
+
+// convertTo returns naked InternetCreateRequest
+func (o *InternetCreateRequest) convertTo() (*naked.Internet, error) {
+	dest := &naked.Internet{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked InternetCreateRequest
+func (o *InternetCreateRequest) convertFrom(naked *naked.Internet) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* InternetUpdateRequest
+*************************************************/
+
+// InternetUpdateRequest represents API parameter/response structure
+type InternetUpdateRequest struct {
+	Name        string `validate:"required"`
+	Description string `validate:"min=0,max=512"`
+	Tags        []string
+	IconID      types.ID `mapconv:"Icon.ID"`
+}
+
+// Validate validates by field tags
+func (o *InternetUpdateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetName returns value of Name
+func (o *InternetUpdateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *InternetUpdateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *InternetUpdateRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *InternetUpdateRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *InternetUpdateRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *InternetUpdateRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *InternetUpdateRequest) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *InternetUpdateRequest) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// convertTo returns naked InternetUpdateRequest
+func (o *InternetUpdateRequest) convertTo() (*naked.Internet, error) {
+	dest := &naked.Internet{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked InternetUpdateRequest
+func (o *InternetUpdateRequest) convertFrom(naked *naked.Internet) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* InternetUpdateBandWidthRequest
+*************************************************/
+
+// InternetUpdateBandWidthRequest represents API parameter/response structure
+type InternetUpdateBandWidthRequest struct {
+	BandWidthMbps int
+}
+
+// Validate validates by field tags
+func (o *InternetUpdateBandWidthRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetBandWidthMbps returns value of BandWidthMbps
+func (o *InternetUpdateBandWidthRequest) GetBandWidthMbps() int {
+	return o.BandWidthMbps
+}
+
+// SetBandWidthMbps sets value to BandWidthMbps
+func (o *InternetUpdateBandWidthRequest) SetBandWidthMbps(v int) {
+	o.BandWidthMbps = v
+}
+
+// convertTo returns naked InternetUpdateBandWidthRequest
+func (o *InternetUpdateBandWidthRequest) convertTo() (*naked.Internet, error) {
+	dest := &naked.Internet{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked InternetUpdateBandWidthRequest
+func (o *InternetUpdateBandWidthRequest) convertFrom(naked *naked.Internet) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* InternetSubnetOperationResult
+*************************************************/
+
+// InternetSubnetOperationResult represents API parameter/response structure
+type InternetSubnetOperationResult struct {
+	ID             types.ID
+	DefaultRoute   string   `validate:"ipv4"`
+	NextHop        string   `validate:"ipv4"`
+	StaticRoute    string   `validate:"ipv4"`
+	NetworkAddress string   `validate:"ipv4"`
+	NetworkMaskLen int      `validate:"min=24,max=28"`
+	IPAddresses    []string `mapconv:"[]IPAddresses.IPAddress"`
+}
+
+// Validate validates by field tags
+func (o *InternetSubnetOperationResult) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *InternetSubnetOperationResult) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *InternetSubnetOperationResult) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *InternetSubnetOperationResult) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *InternetSubnetOperationResult) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *InternetSubnetOperationResult) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *InternetSubnetOperationResult) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetDefaultRoute returns value of DefaultRoute
+func (o *InternetSubnetOperationResult) GetDefaultRoute() string {
+	return o.DefaultRoute
+}
+
+// SetDefaultRoute sets value to DefaultRoute
+func (o *InternetSubnetOperationResult) SetDefaultRoute(v string) {
+	o.DefaultRoute = v
+}
+
+// GetNextHop returns value of NextHop
+func (o *InternetSubnetOperationResult) GetNextHop() string {
+	return o.NextHop
+}
+
+// SetNextHop sets value to NextHop
+func (o *InternetSubnetOperationResult) SetNextHop(v string) {
+	o.NextHop = v
+}
+
+// GetStaticRoute returns value of StaticRoute
+func (o *InternetSubnetOperationResult) GetStaticRoute() string {
+	return o.StaticRoute
+}
+
+// SetStaticRoute sets value to StaticRoute
+func (o *InternetSubnetOperationResult) SetStaticRoute(v string) {
+	o.StaticRoute = v
+}
+
+// GetNetworkAddress returns value of NetworkAddress
+func (o *InternetSubnetOperationResult) GetNetworkAddress() string {
+	return o.NetworkAddress
+}
+
+// SetNetworkAddress sets value to NetworkAddress
+func (o *InternetSubnetOperationResult) SetNetworkAddress(v string) {
+	o.NetworkAddress = v
+}
+
+// GetNetworkMaskLen returns value of NetworkMaskLen
+func (o *InternetSubnetOperationResult) GetNetworkMaskLen() int {
+	return o.NetworkMaskLen
+}
+
+// SetNetworkMaskLen sets value to NetworkMaskLen
+func (o *InternetSubnetOperationResult) SetNetworkMaskLen(v int) {
+	o.NetworkMaskLen = v
+}
+
+// GetIPAddresses returns value of IPAddresses
+func (o *InternetSubnetOperationResult) GetIPAddresses() []string {
+	return o.IPAddresses
+}
+
+// SetIPAddresses sets value to IPAddresses
+func (o *InternetSubnetOperationResult) SetIPAddresses(v []string) {
+	o.IPAddresses = v
+}
+
+// convertTo returns naked InternetSubnetOperationResult
+func (o *InternetSubnetOperationResult) convertTo() (*naked.Subnet, error) {
+	dest := &naked.Subnet{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked InternetSubnetOperationResult
+func (o *InternetSubnetOperationResult) convertFrom(naked *naked.Subnet) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* InternetAddSubnetRequest
+*************************************************/
+
+// InternetAddSubnetRequest represents API parameter/response structure
+type InternetAddSubnetRequest struct {
+	NetworkMaskLen int    `validate:"min=24,max=28"`
+	NextHop        string `validate:"ipv4"`
+}
+
+// Validate validates by field tags
+func (o *InternetAddSubnetRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetNetworkMaskLen returns value of NetworkMaskLen
+func (o *InternetAddSubnetRequest) GetNetworkMaskLen() int {
+	return o.NetworkMaskLen
+}
+
+// SetNetworkMaskLen sets value to NetworkMaskLen
+func (o *InternetAddSubnetRequest) SetNetworkMaskLen(v int) {
+	o.NetworkMaskLen = v
+}
+
+// GetNextHop returns value of NextHop
+func (o *InternetAddSubnetRequest) GetNextHop() string {
+	return o.NextHop
+}
+
+// SetNextHop sets value to NextHop
+func (o *InternetAddSubnetRequest) SetNextHop(v string) {
+	o.NextHop = v
+}
+
+// convertTo returns naked InternetAddSubnetRequest
+func (o *InternetAddSubnetRequest) convertTo() (*naked.SubnetOperationRequest, error) {
+	dest := &naked.SubnetOperationRequest{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked InternetAddSubnetRequest
+func (o *InternetAddSubnetRequest) convertFrom(naked *naked.SubnetOperationRequest) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* InternetUpdateSubnetRequest
+*************************************************/
+
+// InternetUpdateSubnetRequest represents API parameter/response structure
+type InternetUpdateSubnetRequest struct {
+	NextHop string `validate:"ipv4"`
+}
+
+// Validate validates by field tags
+func (o *InternetUpdateSubnetRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetNextHop returns value of NextHop
+func (o *InternetUpdateSubnetRequest) GetNextHop() string {
+	return o.NextHop
+}
+
+// SetNextHop sets value to NextHop
+func (o *InternetUpdateSubnetRequest) SetNextHop(v string) {
+	o.NextHop = v
+}
+
+// convertTo returns naked InternetUpdateSubnetRequest
+func (o *InternetUpdateSubnetRequest) convertTo() (*naked.SubnetOperationRequest, error) {
+	dest := &naked.SubnetOperationRequest{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked InternetUpdateSubnetRequest
+func (o *InternetUpdateSubnetRequest) convertFrom(naked *naked.SubnetOperationRequest) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* RouterActivity
+*************************************************/
+
+// RouterActivity represents API parameter/response structure
+type RouterActivity struct {
+	Values []*MonitorRouterValue `mapconv:"[]Router"`
+}
+
+// Validate validates by field tags
+func (o *RouterActivity) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetValues returns value of Values
+func (o *RouterActivity) GetValues() []*MonitorRouterValue {
+	return o.Values
+}
+
+// SetValues sets value to Values
+func (o *RouterActivity) SetValues(v []*MonitorRouterValue) {
+	o.Values = v
+}
+
+// convertTo returns naked RouterActivity
+func (o *RouterActivity) convertTo() (*naked.MonitorValues, error) {
+	dest := &naked.MonitorValues{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked RouterActivity
+func (o *RouterActivity) convertFrom(naked *naked.MonitorValues) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* MonitorRouterValue
+*************************************************/
+
+// MonitorRouterValue represents API parameter/response structure
+type MonitorRouterValue struct {
+	Time time.Time `json:",omitempty" mapconv:",omitempty"`
+	In   float64   `json:",omitempty" mapconv:",omitempty"`
+	Out  float64   `json:",omitempty" mapconv:",omitempty"`
+}
+
+// Validate validates by field tags
+func (o *MonitorRouterValue) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetTime returns value of Time
+func (o *MonitorRouterValue) GetTime() time.Time {
+	return o.Time
+}
+
+// SetTime sets value to Time
+func (o *MonitorRouterValue) SetTime(v time.Time) {
+	o.Time = v
+}
+
+// GetIn returns value of In
+func (o *MonitorRouterValue) GetIn() float64 {
+	return o.In
+}
+
+// SetIn sets value to In
+func (o *MonitorRouterValue) SetIn(v float64) {
+	o.In = v
+}
+
+// GetOut returns value of Out
+func (o *MonitorRouterValue) GetOut() float64 {
+	return o.Out
+}
+
+// SetOut sets value to Out
+func (o *MonitorRouterValue) SetOut(v float64) {
+	o.Out = v
+}
+
+// convertTo returns naked MonitorRouterValue
+func (o *MonitorRouterValue) convertTo() (*naked.MonitorRouterValue, error) {
+	dest := &naked.MonitorRouterValue{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked MonitorRouterValue
+func (o *MonitorRouterValue) convertFrom(naked *naked.MonitorRouterValue) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* InternetPlan
+*************************************************/
+
+// InternetPlan represents API parameter/response structure
+type InternetPlan struct {
+	ID            types.ID
+	Name          string `validate:"required"`
+	BandWidthMbps int
+	Availability  types.EAvailability
+}
+
+// Validate validates by field tags
+func (o *InternetPlan) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *InternetPlan) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *InternetPlan) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *InternetPlan) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *InternetPlan) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *InternetPlan) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *InternetPlan) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetName returns value of Name
+func (o *InternetPlan) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *InternetPlan) SetName(v string) {
+	o.Name = v
+}
+
+// GetBandWidthMbps returns value of BandWidthMbps
+func (o *InternetPlan) GetBandWidthMbps() int {
+	return o.BandWidthMbps
+}
+
+// SetBandWidthMbps sets value to BandWidthMbps
+func (o *InternetPlan) SetBandWidthMbps(v int) {
+	o.BandWidthMbps = v
+}
+
+// GetAvailability returns value of Availability
+func (o *InternetPlan) GetAvailability() types.EAvailability {
+	return o.Availability
+}
+
+// SetAvailability sets value to Availability
+func (o *InternetPlan) SetAvailability(v types.EAvailability) {
+	o.Availability = v
+}
+
+// convertTo returns naked InternetPlan
+func (o *InternetPlan) convertTo() (*naked.InternetPlan, error) {
+	dest := &naked.InternetPlan{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked InternetPlan
+func (o *InternetPlan) convertFrom(naked *naked.InternetPlan) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* IPAddress
+*************************************************/
+
+// IPAddress represents API parameter/response structure
+type IPAddress struct {
+	HostName    string
+	IPAddress   string
+	InterfaceID types.ID `mapconv:"Interface.ID,omitempty"`
+	SubnetID    types.ID `mapconv:"Subnet.ID,omitempty"`
+}
+
+// Validate validates by field tags
+func (o *IPAddress) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetHostName returns value of HostName
+func (o *IPAddress) GetHostName() string {
+	return o.HostName
+}
+
+// SetHostName sets value to HostName
+func (o *IPAddress) SetHostName(v string) {
+	o.HostName = v
+}
+
+// GetIPAddress returns value of IPAddress
+func (o *IPAddress) GetIPAddress() string {
+	return o.IPAddress
+}
+
+// SetIPAddress sets value to IPAddress
+func (o *IPAddress) SetIPAddress(v string) {
+	o.IPAddress = v
+}
+
+// GetInterfaceID returns value of InterfaceID
+func (o *IPAddress) GetInterfaceID() types.ID {
+	return o.InterfaceID
+}
+
+// SetInterfaceID sets value to InterfaceID
+func (o *IPAddress) SetInterfaceID(v types.ID) {
+	o.InterfaceID = v
+}
+
+// GetSubnetID returns value of SubnetID
+func (o *IPAddress) GetSubnetID() types.ID {
+	return o.SubnetID
+}
+
+// SetSubnetID sets value to SubnetID
+func (o *IPAddress) SetSubnetID(v types.ID) {
+	o.SubnetID = v
+}
+
+// convertTo returns naked IPAddress
+func (o *IPAddress) convertTo() (*naked.IPAddress, error) {
+	dest := &naked.IPAddress{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked IPAddress
+func (o *IPAddress) convertFrom(naked *naked.IPAddress) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* IPv6Addr
+*************************************************/
+
+// IPv6Addr represents API parameter/response structure
+type IPv6Addr struct {
+	IPv6Addr    string
+	HostName    string
+	IPv6NetID   types.ID `mapconv:"IPv6Net.ID,omitempty"`
+	SwitchID    types.ID `mapconv:"IPv6Net.Switch.ID,omitempty"`
+	InterfaceID types.ID `mapconv:"Interface.ID,omitempty"`
+}
+
+// Validate validates by field tags
+func (o *IPv6Addr) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetIPv6Addr returns value of IPv6Addr
+func (o *IPv6Addr) GetIPv6Addr() string {
+	return o.IPv6Addr
+}
+
+// SetIPv6Addr sets value to IPv6Addr
+func (o *IPv6Addr) SetIPv6Addr(v string) {
+	o.IPv6Addr = v
+}
+
+// GetHostName returns value of HostName
+func (o *IPv6Addr) GetHostName() string {
+	return o.HostName
+}
+
+// SetHostName sets value to HostName
+func (o *IPv6Addr) SetHostName(v string) {
+	o.HostName = v
+}
+
+// GetIPv6NetID returns value of IPv6NetID
+func (o *IPv6Addr) GetIPv6NetID() types.ID {
+	return o.IPv6NetID
+}
+
+// SetIPv6NetID sets value to IPv6NetID
+func (o *IPv6Addr) SetIPv6NetID(v types.ID) {
+	o.IPv6NetID = v
+}
+
+// GetSwitchID returns value of SwitchID
+func (o *IPv6Addr) GetSwitchID() types.ID {
+	return o.SwitchID
+}
+
+// SetSwitchID sets value to SwitchID
+func (o *IPv6Addr) SetSwitchID(v types.ID) {
+	o.SwitchID = v
+}
+
+// GetInterfaceID returns value of InterfaceID
+func (o *IPv6Addr) GetInterfaceID() types.ID {
+	return o.InterfaceID
+}
+
+// SetInterfaceID sets value to InterfaceID
+func (o *IPv6Addr) SetInterfaceID(v types.ID) {
+	o.InterfaceID = v
+}
+
+// convertTo returns naked IPv6Addr
+func (o *IPv6Addr) convertTo() (*naked.IPv6Addr, error) {
+	dest := &naked.IPv6Addr{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked IPv6Addr
+func (o *IPv6Addr) convertFrom(naked *naked.IPv6Addr) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* IPv6AddrCreateRequest
+*************************************************/
+
+// IPv6AddrCreateRequest represents API parameter/response structure
+type IPv6AddrCreateRequest struct {
+	IPv6Addr string `validate:"required,ipv6"`
+	HostName string
+}
+
+// Validate validates by field tags
+func (o *IPv6AddrCreateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetIPv6Addr returns value of IPv6Addr
+func (o *IPv6AddrCreateRequest) GetIPv6Addr() string {
+	return o.IPv6Addr
+}
+
+// SetIPv6Addr sets value to IPv6Addr
+func (o *IPv6AddrCreateRequest) SetIPv6Addr(v string) {
+	o.IPv6Addr = v
+}
+
+// GetHostName returns value of HostName
+func (o *IPv6AddrCreateRequest) GetHostName() string {
+	return o.HostName
+}
+
+// SetHostName sets value to HostName
+func (o *IPv6AddrCreateRequest) SetHostName(v string) {
+	o.HostName = v
+}
+
+// convertTo returns naked IPv6AddrCreateRequest
+func (o *IPv6AddrCreateRequest) convertTo() (*naked.IPv6Addr, error) {
+	dest := &naked.IPv6Addr{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked IPv6AddrCreateRequest
+func (o *IPv6AddrCreateRequest) convertFrom(naked *naked.IPv6Addr) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* IPv6AddrUpdateRequest
+*************************************************/
+
+// IPv6AddrUpdateRequest represents API parameter/response structure
+type IPv6AddrUpdateRequest struct {
+	HostName string
+}
+
+// Validate validates by field tags
+func (o *IPv6AddrUpdateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetHostName returns value of HostName
+func (o *IPv6AddrUpdateRequest) GetHostName() string {
+	return o.HostName
+}
+
+// SetHostName sets value to HostName
+func (o *IPv6AddrUpdateRequest) SetHostName(v string) {
+	o.HostName = v
+}
+
+// convertTo returns naked IPv6AddrUpdateRequest
+func (o *IPv6AddrUpdateRequest) convertTo() (*naked.IPv6Addr, error) {
+	dest := &naked.IPv6Addr{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked IPv6AddrUpdateRequest
+func (o *IPv6AddrUpdateRequest) convertFrom(naked *naked.IPv6Addr) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* IPv6Net
+*************************************************/
+
+// IPv6Net represents API parameter/response structure
+type IPv6Net struct {
+	ID                 types.ID
+	IPv6Prefix         string
+	IPv6PrefixLen      int
+	IPv6PrefixTail     string
+	IPv6TableID        types.ID `mapconv:"IPv6Table.ID"`
+	NamedIPv6AddrCount int
+	CreatedAt          time.Time
+	SwitchID           types.ID `mapconv:"Switch.ID,omitempty"`
+}
+
+// Validate validates by field tags
+func (o *IPv6Net) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *IPv6Net) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *IPv6Net) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *IPv6Net) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *IPv6Net) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *IPv6Net) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *IPv6Net) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetIPv6Prefix returns value of IPv6Prefix
+func (o *IPv6Net) GetIPv6Prefix() string {
+	return o.IPv6Prefix
+}
+
+// SetIPv6Prefix sets value to IPv6Prefix
+func (o *IPv6Net) SetIPv6Prefix(v string) {
+	o.IPv6Prefix = v
+}
+
+// GetIPv6PrefixLen returns value of IPv6PrefixLen
+func (o *IPv6Net) GetIPv6PrefixLen() int {
+	return o.IPv6PrefixLen
+}
+
+// SetIPv6PrefixLen sets value to IPv6PrefixLen
+func (o *IPv6Net) SetIPv6PrefixLen(v int) {
+	o.IPv6PrefixLen = v
+}
+
+// GetIPv6PrefixTail returns value of IPv6PrefixTail
+func (o *IPv6Net) GetIPv6PrefixTail() string {
+	return o.IPv6PrefixTail
+}
+
+// SetIPv6PrefixTail sets value to IPv6PrefixTail
+func (o *IPv6Net) SetIPv6PrefixTail(v string) {
+	o.IPv6PrefixTail = v
+}
+
+// GetIPv6TableID returns value of IPv6TableID
+func (o *IPv6Net) GetIPv6TableID() types.ID {
+	return o.IPv6TableID
+}
+
+// SetIPv6TableID sets value to IPv6TableID
+func (o *IPv6Net) SetIPv6TableID(v types.ID) {
+	o.IPv6TableID = v
+}
+
+// GetNamedIPv6AddrCount returns value of NamedIPv6AddrCount
+func (o *IPv6Net) GetNamedIPv6AddrCount() int {
+	return o.NamedIPv6AddrCount
+}
+
+// SetNamedIPv6AddrCount sets value to NamedIPv6AddrCount
+func (o *IPv6Net) SetNamedIPv6AddrCount(v int) {
+	o.NamedIPv6AddrCount = v
+}
+
+// GetCreatedAt returns value of CreatedAt
+func (o *IPv6Net) GetCreatedAt() time.Time {
+	return o.CreatedAt
+}
+
+// SetCreatedAt sets value to CreatedAt
+func (o *IPv6Net) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
+}
+
+// GetSwitchID returns value of SwitchID
+func (o *IPv6Net) GetSwitchID() types.ID {
+	return o.SwitchID
+}
+
+// SetSwitchID sets value to SwitchID
+func (o *IPv6Net) SetSwitchID(v types.ID) {
+	o.SwitchID = v
+}
+
+// convertTo returns naked IPv6Net
+func (o *IPv6Net) convertTo() (*naked.IPv6Net, error) {
+	dest := &naked.IPv6Net{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked IPv6Net
+func (o *IPv6Net) convertFrom(naked *naked.IPv6Net) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* License
+*************************************************/
+
+// License represents API parameter/response structure
+type License struct {
+	ID              types.ID
+	Name            string   `validate:"required"`
+	LicenseInfoID   types.ID `mapconv:"LicenseInfo.ID"`
+	LicenseInfoName string   `mapconv:"LicenseInfo.Name"`
+	CreatedAt       time.Time
+	ModifiedAt      time.Time
+}
+
+// Validate validates by field tags
+func (o *License) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *License) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *License) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *License) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *License) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *License) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *License) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetName returns value of Name
+func (o *License) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *License) SetName(v string) {
+	o.Name = v
+}
+
+// GetLicenseInfoID returns value of LicenseInfoID
+func (o *License) GetLicenseInfoID() types.ID {
+	return o.LicenseInfoID
+}
+
+// SetLicenseInfoID sets value to LicenseInfoID
+func (o *License) SetLicenseInfoID(v types.ID) {
+	o.LicenseInfoID = v
+}
+
+// GetLicenseInfoName returns value of LicenseInfoName
+func (o *License) GetLicenseInfoName() string {
+	return o.LicenseInfoName
+}
+
+// SetLicenseInfoName sets value to LicenseInfoName
+func (o *License) SetLicenseInfoName(v string) {
+	o.LicenseInfoName = v
+}
+
+// GetCreatedAt returns value of CreatedAt
+func (o *License) GetCreatedAt() time.Time {
+	return o.CreatedAt
+}
+
+// SetCreatedAt sets value to CreatedAt
+func (o *License) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
+}
+
+// GetModifiedAt returns value of ModifiedAt
+func (o *License) GetModifiedAt() time.Time {
+	return o.ModifiedAt
+}
+
+// SetModifiedAt sets value to ModifiedAt
+func (o *License) SetModifiedAt(v time.Time) {
+	o.ModifiedAt = v
+}
+
+// convertTo returns naked License
+func (o *License) convertTo() (*naked.License, error) {
+	dest := &naked.License{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked License
+func (o *License) convertFrom(naked *naked.License) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* LicenseCreateRequest
+*************************************************/
+
+// LicenseCreateRequest represents API parameter/response structure
+type LicenseCreateRequest struct {
+	LicenseInfoID types.ID `mapconv:"LicenseInfo.ID"`
+	Name          string   `validate:"required"`
+}
+
+// Validate validates by field tags
+func (o *LicenseCreateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetLicenseInfoID returns value of LicenseInfoID
+func (o *LicenseCreateRequest) GetLicenseInfoID() types.ID {
+	return o.LicenseInfoID
+}
+
+// SetLicenseInfoID sets value to LicenseInfoID
+func (o *LicenseCreateRequest) SetLicenseInfoID(v types.ID) {
+	o.LicenseInfoID = v
+}
+
+// GetName returns value of Name
+func (o *LicenseCreateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *LicenseCreateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// convertTo returns naked LicenseCreateRequest
+func (o *LicenseCreateRequest) convertTo() (*naked.License, error) {
+	dest := &naked.License{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked LicenseCreateRequest
+func (o *LicenseCreateRequest) convertFrom(naked *naked.License) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* LicenseUpdateRequest
+*************************************************/
+
+// LicenseUpdateRequest represents API parameter/response structure
+type LicenseUpdateRequest struct {
+	Name string `validate:"required"`
+}
+
+// Validate validates by field tags
+func (o *LicenseUpdateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetName returns value of Name
+func (o *LicenseUpdateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *LicenseUpdateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// convertTo returns naked LicenseUpdateRequest
+func (o *LicenseUpdateRequest) convertTo() (*naked.License, error) {
+	dest := &naked.License{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked LicenseUpdateRequest
+func (o *LicenseUpdateRequest) convertFrom(naked *naked.License) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* LicensePlan
+*************************************************/
+
+// LicensePlan represents API parameter/response structure
+type LicensePlan struct {
+	ID         types.ID
+	Name       string `validate:"required"`
+	TermsOfUse string
+}
+
+// Validate validates by field tags
+func (o *LicensePlan) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *LicensePlan) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *LicensePlan) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *LicensePlan) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *LicensePlan) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *LicensePlan) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *LicensePlan) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetName returns value of Name
+func (o *LicensePlan) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *LicensePlan) SetName(v string) {
+	o.Name = v
+}
+
+// GetTermsOfUse returns value of TermsOfUse
+func (o *LicensePlan) GetTermsOfUse() string {
+	return o.TermsOfUse
+}
+
+// SetTermsOfUse sets value to TermsOfUse
+func (o *LicensePlan) SetTermsOfUse(v string) {
+	o.TermsOfUse = v
+}
+
+// convertTo returns naked LicensePlan
+func (o *LicensePlan) convertTo() (*naked.LicensePlan, error) {
+	dest := &naked.LicensePlan{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked LicensePlan
+func (o *LicensePlan) convertFrom(naked *naked.LicensePlan) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* LoadBalancer
+*************************************************/
+
+// LoadBalancer represents API parameter/response structure
+type LoadBalancer struct {
+	ID                      types.ID
+	Name                    string `validate:"required"`
+	Description             string `validate:"min=0,max=512"`
+	Tags                    []string
+	Availability            types.EAvailability
+	Class                   string
+	IconID                  types.ID `mapconv:"Icon.ID"`
+	CreatedAt               time.Time
+	ModifiedAt              time.Time
+	InstanceHostName        string                          `mapconv:"Instance.Host.Name"`
+	InstanceHostInfoURL     string                          `mapconv:"Instance.Host.InfoURL"`
+	InstanceStatus          types.EServerInstanceStatus     `mapconv:"Instance.Status"`
+	InstanceStatusChangedAt time.Time                       `mapconv:"Instance.StatusChangedAt"`
+	PlanID                  types.ID                        `mapconv:"Remark.Plan.ID/Plan.ID"`
+	SwitchID                types.ID                        `mapconv:"Remark.Switch.ID"`
+	DefaultRoute            string                          `mapconv:"Remark.Network.DefaultRoute" validate:"ipv4"`
+	NetworkMaskLen          int                             `mapconv:"Remark.Network.NetworkMaskLen" validate:"min=1,max=32"`
+	IPAddresses             []string                        `mapconv:"Remark.[]Servers.IPAddress"`
+	ZoneID                  types.ID                        `mapconv:"Remark.Zone.ID"`
+	VRID                    int                             `mapconv:"Remark.VRRP.VRID"`
+	VirtualIPAddresses      []*LoadBalancerVirtualIPAddress `mapconv:"Settings.[]LoadBalancer,recursive" validate:"min=0,max=10"`
+	SettingsHash            string
+	Interfaces              []*Interface `json:",omitempty" mapconv:"[]Interfaces,recursive,omitempty"`
+}
+
+// Validate validates by field tags
+func (o *LoadBalancer) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *LoadBalancer) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *LoadBalancer) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *LoadBalancer) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *LoadBalancer) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *LoadBalancer) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *LoadBalancer) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetName returns value of Name
+func (o *LoadBalancer) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *LoadBalancer) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *LoadBalancer) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *LoadBalancer) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *LoadBalancer) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *LoadBalancer) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetAvailability returns value of Availability
+func (o *LoadBalancer) GetAvailability() types.EAvailability {
+	return o.Availability
+}
+
+// SetAvailability sets value to Availability
+func (o *LoadBalancer) SetAvailability(v types.EAvailability) {
+	o.Availability = v
+}
+
+// GetClass returns value of Class
+func (o *LoadBalancer) GetClass() string {
+	return o.Class
+}
+
+// SetClass sets value to Class
+func (o *LoadBalancer) SetClass(v string) {
+	o.Class = v
+}
+
+// GetIconID returns value of IconID
+func (o *LoadBalancer) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *LoadBalancer) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// GetCreatedAt returns value of CreatedAt
+func (o *LoadBalancer) GetCreatedAt() time.Time {
+	return o.CreatedAt
+}
+
+// SetCreatedAt sets value to CreatedAt
+func (o *LoadBalancer) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
+}
+
+// GetModifiedAt returns value of ModifiedAt
+func (o *LoadBalancer) GetModifiedAt() time.Time {
+	return o.ModifiedAt
+}
+
+// SetModifiedAt sets value to ModifiedAt
+func (o *LoadBalancer) SetModifiedAt(v time.Time) {
+	o.ModifiedAt = v
+}
+
+// GetInstanceHostName returns value of InstanceHostName
+func (o *LoadBalancer) GetInstanceHostName() string {
+	return o.InstanceHostName
+}
+
+// SetInstanceHostName sets value to InstanceHostName
+func (o *LoadBalancer) SetInstanceHostName(v string) {
+	o.InstanceHostName = v
+}
+
+// GetInstanceHostInfoURL returns value of InstanceHostInfoURL
+func (o *LoadBalancer) GetInstanceHostInfoURL() string {
+	return o.InstanceHostInfoURL
+}
+
+// SetInstanceHostInfoURL sets value to InstanceHostInfoURL
+func (o *LoadBalancer) SetInstanceHostInfoURL(v string) {
+	o.InstanceHostInfoURL = v
+}
+
+// GetInstanceStatus returns value of InstanceStatus
+func (o *LoadBalancer) GetInstanceStatus() types.EServerInstanceStatus {
+	return o.InstanceStatus
+}
+
+// SetInstanceStatus sets value to InstanceStatus
+func (o *LoadBalancer) SetInstanceStatus(v types.EServerInstanceStatus) {
+	o.InstanceStatus = v
+}
+
+// GetInstanceStatusChangedAt returns value of InstanceStatusChangedAt
+func (o *LoadBalancer) GetInstanceStatusChangedAt() time.Time {
+	return o.InstanceStatusChangedAt
+}
+
+// SetInstanceStatusChangedAt sets value to InstanceStatusChangedAt
+func (o *LoadBalancer) SetInstanceStatusChangedAt(v time.Time) {
+	o.InstanceStatusChangedAt = v
+}
+
+// GetPlanID returns value of PlanID
+func (o *LoadBalancer) GetPlanID() types.ID {
+	return o.PlanID
+}
+
+// SetPlanID sets value to PlanID
+func (o *LoadBalancer) SetPlanID(v types.ID) {
+	o.PlanID = v
+}
+
+// GetSwitchID returns value of SwitchID
+func (o *LoadBalancer) GetSwitchID() types.ID {
+	return o.SwitchID
+}
+
+// SetSwitchID sets value to SwitchID
+func (o *LoadBalancer) SetSwitchID(v types.ID) {
+	o.SwitchID = v
+}
+
+// GetDefaultRoute returns value of DefaultRoute
+func (o *LoadBalancer) GetDefaultRoute() string {
+	return o.DefaultRoute
+}
+
+// SetDefaultRoute sets value to DefaultRoute
+func (o *LoadBalancer) SetDefaultRoute(v string) {
+	o.DefaultRoute = v
+}
+
+// GetNetworkMaskLen returns value of NetworkMaskLen
+func (o *LoadBalancer) GetNetworkMaskLen() int {
+	return o.NetworkMaskLen
+}
+
+// SetNetworkMaskLen sets value to NetworkMaskLen
+func (o *LoadBalancer) SetNetworkMaskLen(v int) {
+	o.NetworkMaskLen = v
+}
+
+// GetIPAddresses returns value of IPAddresses
+func (o *LoadBalancer) GetIPAddresses() []string {
+	return o.IPAddresses
+}
+
+// SetIPAddresses sets value to IPAddresses
+func (o *LoadBalancer) SetIPAddresses(v []string) {
+	o.IPAddresses = v
+}
+
+// GetZoneID returns value of ZoneID
+func (o *LoadBalancer) GetZoneID() types.ID {
+	return o.ZoneID
+}
+
+// SetZoneID sets value to ZoneID
+func (o *LoadBalancer) SetZoneID(v types.ID) {
+	o.ZoneID = v
+}
+
+// GetVRID returns value of VRID
+func (o *LoadBalancer) GetVRID() int {
+	return o.VRID
+}
+
+// SetVRID sets value to VRID
+func (o *LoadBalancer) SetVRID(v int) {
+	o.VRID = v
+}
+
+// GetVirtualIPAddresses returns value of VirtualIPAddresses
+func (o *LoadBalancer) GetVirtualIPAddresses() []*LoadBalancerVirtualIPAddress {
+	return o.VirtualIPAddresses
+}
+
+// SetVirtualIPAddresses sets value to VirtualIPAddresses
+func (o *LoadBalancer) SetVirtualIPAddresses(v []*LoadBalancerVirtualIPAddress) {
+	o.VirtualIPAddresses = v
+}
+
+// GetSettingsHash returns value of SettingsHash
+func (o *LoadBalancer) GetSettingsHash() string {
+	return o.SettingsHash
+}
+
+// SetSettingsHash sets value to SettingsHash
+func (o *LoadBalancer) SetSettingsHash(v string) {
+	o.SettingsHash = v
+}
+
+// GetInterfaces returns value of Interfaces
+func (o *LoadBalancer) GetInterfaces() []*Interface {
+	return o.Interfaces
+}
+
+// SetInterfaces sets value to Interfaces
+func (o *LoadBalancer) SetInterfaces(v []*Interface) {
+	o.Interfaces = v
+}
+
+// convertTo returns naked LoadBalancer
+func (o *LoadBalancer) convertTo() (*naked.LoadBalancer, error) {
+	dest := &naked.LoadBalancer{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked LoadBalancer
+func (o *LoadBalancer) convertFrom(naked *naked.LoadBalancer) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* LoadBalancerVirtualIPAddress
+*************************************************/
+
+// LoadBalancerVirtualIPAddress represents API parameter/response structure
+type LoadBalancerVirtualIPAddress struct {
+	VirtualIPAddress string `validate:"ipv4"`
+	Port             types.StringNumber
+	DelayLoop        types.StringNumber    `mapconv:",default=10" validate:"min=0,max=60"`
+	SorryServer      string                `validate:"ipv4"`
+	Description      string                `validate:"min=0,max=512"`
+	Servers          []*LoadBalancerServer `mapconv:",recursive" validate:"min=0,max=40"`
+}
+
+// Validate validates by field tags
+func (o *LoadBalancerVirtualIPAddress) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetVirtualIPAddress returns value of VirtualIPAddress
+func (o *LoadBalancerVirtualIPAddress) GetVirtualIPAddress() string {
+	return o.VirtualIPAddress
+}
+
+// SetVirtualIPAddress sets value to VirtualIPAddress
+func (o *LoadBalancerVirtualIPAddress) SetVirtualIPAddress(v string) {
+	o.VirtualIPAddress = v
+}
+
+// GetPort returns value of Port
+func (o *LoadBalancerVirtualIPAddress) GetPort() types.StringNumber {
+	return o.Port
+}
+
+// SetPort sets value to Port
+func (o *LoadBalancerVirtualIPAddress) SetPort(v types.StringNumber) {
+	o.Port = v
+}
+
+// GetDelayLoop returns value of DelayLoop
+func (o *LoadBalancerVirtualIPAddress) GetDelayLoop() types.StringNumber {
+	return o.DelayLoop
+}
+
+// SetDelayLoop sets value to DelayLoop
+func (o *LoadBalancerVirtualIPAddress) SetDelayLoop(v types.StringNumber) {
+	o.DelayLoop = v
+}
+
+// GetSorryServer returns value of SorryServer
+func (o *LoadBalancerVirtualIPAddress) GetSorryServer() string {
+	return o.SorryServer
+}
+
+// SetSorryServer sets value to SorryServer
+func (o *LoadBalancerVirtualIPAddress) SetSorryServer(v string) {
+	o.SorryServer = v
+}
+
+// GetDescription returns value of Description
+func (o *LoadBalancerVirtualIPAddress) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *LoadBalancerVirtualIPAddress) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetServers returns value of Servers
+func (o *LoadBalancerVirtualIPAddress) GetServers() []*LoadBalancerServer {
+	return o.Servers
+}
+
+// SetServers sets value to Servers
+func (o *LoadBalancerVirtualIPAddress) SetServers(v []*LoadBalancerServer) {
+	o.Servers = v
+}
+
+/*************************************************
+* LoadBalancerServer
+*************************************************/
+
+// LoadBalancerServer represents API parameter/response structure
+type LoadBalancerServer struct {
+	IPAddress               string             `validate:"ipv4"`
+	Port                    types.StringNumber `validate:"min=1,max=65535"`
+	Enabled                 types.StringFlag
+	HealthCheckProtocol     types.Protocol     `mapconv:"HealthCheck.Protocol" validate:"oneof=http https ping tcp"`
+	HealthCheckPath         string             `mapconv:"HealthCheck.Path"`
+	HealthCheckResponseCode types.StringNumber `mapconv:"HealthCheck.Status"`
+}
+
+// Validate validates by field tags
+func (o *LoadBalancerServer) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetIPAddress returns value of IPAddress
+func (o *LoadBalancerServer) GetIPAddress() string {
+	return o.IPAddress
+}
+
+// SetIPAddress sets value to IPAddress
+func (o *LoadBalancerServer) SetIPAddress(v string) {
+	o.IPAddress = v
+}
+
+// GetPort returns value of Port
+func (o *LoadBalancerServer) GetPort() types.StringNumber {
+	return o.Port
+}
+
+// SetPort sets value to Port
+func (o *LoadBalancerServer) SetPort(v types.StringNumber) {
+	o.Port = v
+}
+
+// GetEnabled returns value of Enabled
+func (o *LoadBalancerServer) GetEnabled() types.StringFlag {
+	return o.Enabled
+}
+
+// SetEnabled sets value to Enabled
+func (o *LoadBalancerServer) SetEnabled(v types.StringFlag) {
+	o.Enabled = v
+}
+
+// GetHealthCheckProtocol returns value of HealthCheckProtocol
+func (o *LoadBalancerServer) GetHealthCheckProtocol() types.Protocol {
+	return o.HealthCheckProtocol
+}
+
+// SetHealthCheckProtocol sets value to HealthCheckProtocol
+func (o *LoadBalancerServer) SetHealthCheckProtocol(v types.Protocol) {
+	o.HealthCheckProtocol = v
+}
+
+// GetHealthCheckPath returns value of HealthCheckPath
+func (o *LoadBalancerServer) GetHealthCheckPath() string {
+	return o.HealthCheckPath
+}
+
+// SetHealthCheckPath sets value to HealthCheckPath
+func (o *LoadBalancerServer) SetHealthCheckPath(v string) {
+	o.HealthCheckPath = v
+}
+
+// GetHealthCheckResponseCode returns value of HealthCheckResponseCode
+func (o *LoadBalancerServer) GetHealthCheckResponseCode() types.StringNumber {
+	return o.HealthCheckResponseCode
+}
+
+// SetHealthCheckResponseCode sets value to HealthCheckResponseCode
+func (o *LoadBalancerServer) SetHealthCheckResponseCode(v types.StringNumber) {
+	o.HealthCheckResponseCode = v
+}
+
+/*************************************************
+* LoadBalancerCreateRequest
+*************************************************/
+
+// LoadBalancerCreateRequest represents API parameter/response structure
+type LoadBalancerCreateRequest struct {
+	Class              string   `mapconv:",default=loadbalancer"`
+	SwitchID           types.ID `mapconv:"Remark.Switch.ID"`
+	PlanID             types.ID `mapconv:"Remark.Plan.ID/Plan.ID"`
+	VRID               int      `mapconv:"Remark.VRRP.VRID"`
+	IPAddresses        []string `mapconv:"Remark.[]Servers.IPAddress" validate:"min=1,max=2,dive,ipv4"`
+	NetworkMaskLen     int      `mapconv:"Remark.Network.NetworkMaskLen" validate:"min=1,max=32"`
+	DefaultRoute       string   `mapconv:"Remark.Network.DefaultRoute" validate:"ipv4"`
+	Name               string   `validate:"required"`
+	Description        string   `validate:"min=0,max=512"`
+	Tags               []string
+	IconID             types.ID                        `mapconv:"Icon.ID"`
+	VirtualIPAddresses []*LoadBalancerVirtualIPAddress `mapconv:"Settings.[]LoadBalancer,recursive" validate:"min=0,max=10"`
+}
+
+// Validate validates by field tags
+func (o *LoadBalancerCreateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetClass returns value of Class
+func (o *LoadBalancerCreateRequest) GetClass() string {
+	return o.Class
+}
+
+// SetClass sets value to Class
+func (o *LoadBalancerCreateRequest) SetClass(v string) {
+	o.Class = v
+}
+
+// GetSwitchID returns value of SwitchID
+func (o *LoadBalancerCreateRequest) GetSwitchID() types.ID {
+	return o.SwitchID
+}
+
+// SetSwitchID sets value to SwitchID
+func (o *LoadBalancerCreateRequest) SetSwitchID(v types.ID) {
+	o.SwitchID = v
+}
+
+// GetPlanID returns value of PlanID
+func (o *LoadBalancerCreateRequest) GetPlanID() types.ID {
+	return o.PlanID
+}
+
+// SetPlanID sets value to PlanID
+func (o *LoadBalancerCreateRequest) SetPlanID(v types.ID) {
+	o.PlanID = v
+}
+
+// GetVRID returns value of VRID
+func (o *LoadBalancerCreateRequest) GetVRID() int {
+	return o.VRID
+}
+
+// SetVRID sets value to VRID
+func (o *LoadBalancerCreateRequest) SetVRID(v int) {
+	o.VRID = v
+}
+
+// GetIPAddresses returns value of IPAddresses
+func (o *LoadBalancerCreateRequest) GetIPAddresses() []string {
+	return o.IPAddresses
+}
+
+// SetIPAddresses sets value to IPAddresses
+func (o *LoadBalancerCreateRequest) SetIPAddresses(v []string) {
+	o.IPAddresses = v
+}
+
+// GetNetworkMaskLen returns value of NetworkMaskLen
+func (o *LoadBalancerCreateRequest) GetNetworkMaskLen() int {
+	return o.NetworkMaskLen
+}
+
+// SetNetworkMaskLen sets value to NetworkMaskLen
+func (o *LoadBalancerCreateRequest) SetNetworkMaskLen(v int) {
+	o.NetworkMaskLen = v
+}
+
+// GetDefaultRoute returns value of DefaultRoute
+func (o *LoadBalancerCreateRequest) GetDefaultRoute() string {
+	return o.DefaultRoute
+}
+
+// SetDefaultRoute sets value to DefaultRoute
+func (o *LoadBalancerCreateRequest) SetDefaultRoute(v string) {
+	o.DefaultRoute = v
+}
+
+// GetName returns value of Name
+func (o *LoadBalancerCreateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *LoadBalancerCreateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *LoadBalancerCreateRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *LoadBalancerCreateRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *LoadBalancerCreateRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *LoadBalancerCreateRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *LoadBalancerCreateRequest) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *LoadBalancerCreateRequest) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// GetVirtualIPAddresses returns value of VirtualIPAddresses
+func (o *LoadBalancerCreateRequest) GetVirtualIPAddresses() []*LoadBalancerVirtualIPAddress {
+	return o.VirtualIPAddresses
+}
+
+// SetVirtualIPAddresses sets value to VirtualIPAddresses
+func (o *LoadBalancerCreateRequest) SetVirtualIPAddresses(v []*LoadBalancerVirtualIPAddress) {
+	o.VirtualIPAddresses = v
+}
+
+// convertTo returns naked LoadBalancerCreateRequest
+func (o *LoadBalancerCreateRequest) convertTo() (*naked.LoadBalancer, error) {
+	dest := &naked.LoadBalancer{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked LoadBalancerCreateRequest
+func (o *LoadBalancerCreateRequest) convertFrom(naked *naked.LoadBalancer) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* LoadBalancerUpdateRequest
+*************************************************/
+
+// LoadBalancerUpdateRequest represents API parameter/response structure
+type LoadBalancerUpdateRequest struct {
+	Name               string `validate:"required"`
+	Description        string `validate:"min=0,max=512"`
+	Tags               []string
+	IconID             types.ID                        `mapconv:"Icon.ID"`
+	VirtualIPAddresses []*LoadBalancerVirtualIPAddress `mapconv:"Settings.[]LoadBalancer,recursive" validate:"min=0,max=10"`
+}
+
+// Validate validates by field tags
+func (o *LoadBalancerUpdateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetName returns value of Name
+func (o *LoadBalancerUpdateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *LoadBalancerUpdateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *LoadBalancerUpdateRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *LoadBalancerUpdateRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *LoadBalancerUpdateRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *LoadBalancerUpdateRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *LoadBalancerUpdateRequest) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *LoadBalancerUpdateRequest) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// GetVirtualIPAddresses returns value of VirtualIPAddresses
+func (o *LoadBalancerUpdateRequest) GetVirtualIPAddresses() []*LoadBalancerVirtualIPAddress {
+	return o.VirtualIPAddresses
+}
+
+// SetVirtualIPAddresses sets value to VirtualIPAddresses
+func (o *LoadBalancerUpdateRequest) SetVirtualIPAddresses(v []*LoadBalancerVirtualIPAddress) {
+	o.VirtualIPAddresses = v
+}
+
+// convertTo returns naked LoadBalancerUpdateRequest
+func (o *LoadBalancerUpdateRequest) convertTo() (*naked.LoadBalancer, error) {
+	dest := &naked.LoadBalancer{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked LoadBalancerUpdateRequest
+func (o *LoadBalancerUpdateRequest) convertFrom(naked *naked.LoadBalancer) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* LoadBalancerStatus
+*************************************************/
+
+// LoadBalancerStatus represents API parameter/response structure
+type LoadBalancerStatus struct {
+	VirtualIPAddress string
+	Port             types.StringNumber
+	CPS              types.StringNumber
+	Servers          []*LoadBalancerServerStatus `mapconv:",recursive"`
+}
+
+// Validate validates by field tags
+func (o *LoadBalancerStatus) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetVirtualIPAddress returns value of VirtualIPAddress
+func (o *LoadBalancerStatus) GetVirtualIPAddress() string {
+	return o.VirtualIPAddress
+}
+
+// SetVirtualIPAddress sets value to VirtualIPAddress
+func (o *LoadBalancerStatus) SetVirtualIPAddress(v string) {
+	o.VirtualIPAddress = v
+}
+
+// GetPort returns value of Port
+func (o *LoadBalancerStatus) GetPort() types.StringNumber {
+	return o.Port
+}
+
+// SetPort sets value to Port
+func (o *LoadBalancerStatus) SetPort(v types.StringNumber) {
+	o.Port = v
+}
+
+// GetCPS returns value of CPS
+func (o *LoadBalancerStatus) GetCPS() types.StringNumber {
+	return o.CPS
+}
+
+// SetCPS sets value to CPS
+func (o *LoadBalancerStatus) SetCPS(v types.StringNumber) {
+	o.CPS = v
+}
+
+// GetServers returns value of Servers
+func (o *LoadBalancerStatus) GetServers() []*LoadBalancerServerStatus {
+	return o.Servers
+}
+
+// SetServers sets value to Servers
+func (o *LoadBalancerStatus) SetServers(v []*LoadBalancerServerStatus) {
+	o.Servers = v
+}
+
+// convertTo returns naked LoadBalancerStatus
+func (o *LoadBalancerStatus) convertTo() (*naked.LoadBalancerStatus, error) {
+	dest := &naked.LoadBalancerStatus{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked LoadBalancerStatus
+func (o *LoadBalancerStatus) convertFrom(naked *naked.LoadBalancerStatus) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* LoadBalancerServerStatus
+*************************************************/
+
+// LoadBalancerServerStatus represents API parameter/response structure
+type LoadBalancerServerStatus struct {
+	ActiveConn types.StringNumber
+	Status     types.EServerInstanceStatus
+	IPAddress  string
+	Port       types.StringNumber
+	CPS        types.StringNumber
+}
+
+// Validate validates by field tags
+func (o *LoadBalancerServerStatus) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetActiveConn returns value of ActiveConn
+func (o *LoadBalancerServerStatus) GetActiveConn() types.StringNumber {
+	return o.ActiveConn
+}
+
+// SetActiveConn sets value to ActiveConn
+func (o *LoadBalancerServerStatus) SetActiveConn(v types.StringNumber) {
+	o.ActiveConn = v
+}
+
+// GetStatus returns value of Status
+func (o *LoadBalancerServerStatus) GetStatus() types.EServerInstanceStatus {
+	return o.Status
+}
+
+// SetStatus sets value to Status
+func (o *LoadBalancerServerStatus) SetStatus(v types.EServerInstanceStatus) {
+	o.Status = v
+}
+
+// GetIPAddress returns value of IPAddress
+func (o *LoadBalancerServerStatus) GetIPAddress() string {
+	return o.IPAddress
+}
+
+// SetIPAddress sets value to IPAddress
+func (o *LoadBalancerServerStatus) SetIPAddress(v string) {
+	o.IPAddress = v
+}
+
+// GetPort returns value of Port
+func (o *LoadBalancerServerStatus) GetPort() types.StringNumber {
+	return o.Port
+}
+
+// SetPort sets value to Port
+func (o *LoadBalancerServerStatus) SetPort(v types.StringNumber) {
+	o.Port = v
+}
+
+// GetCPS returns value of CPS
+func (o *LoadBalancerServerStatus) GetCPS() types.StringNumber {
+	return o.CPS
+}
+
+// SetCPS sets value to CPS
+func (o *LoadBalancerServerStatus) SetCPS(v types.StringNumber) {
+	o.CPS = v
+}
+
+/*************************************************
+* MobileGateway
+*************************************************/
+
+// MobileGateway represents API parameter/response structure
+type MobileGateway struct {
+	ID                      types.ID
+	Name                    string `validate:"required"`
+	Description             string `validate:"min=0,max=512"`
+	Tags                    []string
+	Availability            types.EAvailability
+	Class                   string
+	IconID                  types.ID `mapconv:"Icon.ID"`
+	CreatedAt               time.Time
+	InstanceHostName        string                      `mapconv:"Instance.Host.Name"`
+	InstanceHostInfoURL     string                      `mapconv:"Instance.Host.InfoURL"`
+	InstanceStatus          types.EServerInstanceStatus `mapconv:"Instance.Status"`
+	InstanceStatusChangedAt time.Time                   `mapconv:"Instance.StatusChangedAt"`
+	Interfaces              []*MobileGatewayInterface   `json:",omitempty" mapconv:"[]Interfaces,recursive,omitempty"`
+	ZoneID                  types.ID                    `mapconv:"Remark.Zone.ID"`
+	Settings                *MobileGatewaySetting       `mapconv:",omitempty,recursive"`
+	SettingsHash            string
+}
+
+// Validate validates by field tags
+func (o *MobileGateway) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *MobileGateway) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *MobileGateway) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *MobileGateway) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *MobileGateway) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *MobileGateway) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *MobileGateway) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetName returns value of Name
+func (o *MobileGateway) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *MobileGateway) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *MobileGateway) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *MobileGateway) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *MobileGateway) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *MobileGateway) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetAvailability returns value of Availability
+func (o *MobileGateway) GetAvailability() types.EAvailability {
+	return o.Availability
+}
+
+// SetAvailability sets value to Availability
+func (o *MobileGateway) SetAvailability(v types.EAvailability) {
+	o.Availability = v
+}
+
+// GetClass returns value of Class
+func (o *MobileGateway) GetClass() string {
+	return o.Class
+}
+
+// SetClass sets value to Class
+func (o *MobileGateway) SetClass(v string) {
+	o.Class = v
+}
+
+// GetIconID returns value of IconID
+func (o *MobileGateway) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *MobileGateway) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// GetCreatedAt returns value of CreatedAt
+func (o *MobileGateway) GetCreatedAt() time.Time {
+	return o.CreatedAt
+}
+
+// SetCreatedAt sets value to CreatedAt
+func (o *MobileGateway) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
+}
+
+// GetInstanceHostName returns value of InstanceHostName
+func (o *MobileGateway) GetInstanceHostName() string {
+	return o.InstanceHostName
+}
+
+// SetInstanceHostName sets value to InstanceHostName
+func (o *MobileGateway) SetInstanceHostName(v string) {
+	o.InstanceHostName = v
+}
+
+// GetInstanceHostInfoURL returns value of InstanceHostInfoURL
+func (o *MobileGateway) GetInstanceHostInfoURL() string {
+	return o.InstanceHostInfoURL
+}
+
+// SetInstanceHostInfoURL sets value to InstanceHostInfoURL
+func (o *MobileGateway) SetInstanceHostInfoURL(v string) {
+	o.InstanceHostInfoURL = v
+}
+
+// GetInstanceStatus returns value of InstanceStatus
+func (o *MobileGateway) GetInstanceStatus() types.EServerInstanceStatus {
+	return o.InstanceStatus
+}
+
+// SetInstanceStatus sets value to InstanceStatus
+func (o *MobileGateway) SetInstanceStatus(v types.EServerInstanceStatus) {
+	o.InstanceStatus = v
+}
+
+// GetInstanceStatusChangedAt returns value of InstanceStatusChangedAt
+func (o *MobileGateway) GetInstanceStatusChangedAt() time.Time {
+	return o.InstanceStatusChangedAt
+}
+
+// SetInstanceStatusChangedAt sets value to InstanceStatusChangedAt
+func (o *MobileGateway) SetInstanceStatusChangedAt(v time.Time) {
+	o.InstanceStatusChangedAt = v
+}
+
+// GetInterfaces returns value of Interfaces
+func (o *MobileGateway) GetInterfaces() []*MobileGatewayInterface {
+	return o.Interfaces
+}
+
+// SetInterfaces sets value to Interfaces
+func (o *MobileGateway) SetInterfaces(v []*MobileGatewayInterface) {
+	o.Interfaces = v
+}
+
+// GetZoneID returns value of ZoneID
+func (o *MobileGateway) GetZoneID() types.ID {
+	return o.ZoneID
+}
+
+// SetZoneID sets value to ZoneID
+func (o *MobileGateway) SetZoneID(v types.ID) {
+	o.ZoneID = v
+}
+
+// GetSettings returns value of Settings
+func (o *MobileGateway) GetSettings() *MobileGatewaySetting {
+	return o.Settings
+}
+
+// SetSettings sets value to Settings
+func (o *MobileGateway) SetSettings(v *MobileGatewaySetting) {
+	o.Settings = v
+}
+
+// GetSettingsHash returns value of SettingsHash
+func (o *MobileGateway) GetSettingsHash() string {
+	return o.SettingsHash
+}
+
+// SetSettingsHash sets value to SettingsHash
+func (o *MobileGateway) SetSettingsHash(v string) {
+	o.SettingsHash = v
+}
+
+// convertTo returns naked MobileGateway
+func (o *MobileGateway) convertTo() (*naked.MobileGateway, error) {
+	dest := &naked.MobileGateway{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked MobileGateway
+func (o *MobileGateway) convertFrom(naked *naked.MobileGateway) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* MobileGatewayInterface
+*************************************************/
+
+// MobileGatewayInterface represents API parameter/response structure
+type MobileGatewayInterface struct {
+	ID                              types.ID
+	MACAddress                      string
+	IPAddress                       string
+	UserIPAddress                   string
+	HostName                        string
+	SwitchID                        types.ID           `mapconv:"Switch.ID"`
+	SwitchName                      string             `mapconv:"Switch.Name"`
+	SwitchScope                     types.EScope       `mapconv:"Switch.Scope"`
+	UserSubnetDefaultRoute          string             `mapconv:"Switch.UserSubnet.DefaultRoute"`
+	UserSubnetNetworkMaskLen        int                `mapconv:"Switch.UserSubnet.NetworkMaskLen"`
+	SubnetDefaultRoute              string             `mapconv:"Switch.Subnet.DefaultRoute"`
+	SubnetNetworkMaskLen            int                `mapconv:"Switch.Subnet.NetworkMaskLen"`
+	SubnetNetworkAddress            string             `mapconv:"Switch.Subnet.NetworkAddress"`
+	SubnetBandWidthMbps             int                `mapconv:"Switch.Subnet.Internet.BandWidthMbps"`
+	PacketFilterID                  types.ID           `mapconv:"PacketFilter.ID,omitempty"`
+	PacketFilterName                string             `mapconv:"PacketFilter.Name"`
+	PacketFilterRequiredHostVersion types.StringNumber `mapconv:"PacketFilter.RequiredHostVersionn"`
+	Index                           int                `mapconv:",omitempty"`
+}
+
+// Validate validates by field tags
+func (o *MobileGatewayInterface) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *MobileGatewayInterface) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *MobileGatewayInterface) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *MobileGatewayInterface) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *MobileGatewayInterface) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *MobileGatewayInterface) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *MobileGatewayInterface) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetMACAddress returns value of MACAddress
+func (o *MobileGatewayInterface) GetMACAddress() string {
+	return o.MACAddress
+}
+
+// SetMACAddress sets value to MACAddress
+func (o *MobileGatewayInterface) SetMACAddress(v string) {
+	o.MACAddress = v
+}
+
+// GetIPAddress returns value of IPAddress
+func (o *MobileGatewayInterface) GetIPAddress() string {
+	return o.IPAddress
+}
+
+// SetIPAddress sets value to IPAddress
+func (o *MobileGatewayInterface) SetIPAddress(v string) {
+	o.IPAddress = v
+}
+
+// GetUserIPAddress returns value of UserIPAddress
+func (o *MobileGatewayInterface) GetUserIPAddress() string {
+	return o.UserIPAddress
+}
+
+// SetUserIPAddress sets value to UserIPAddress
+func (o *MobileGatewayInterface) SetUserIPAddress(v string) {
+	o.UserIPAddress = v
+}
+
+// GetHostName returns value of HostName
+func (o *MobileGatewayInterface) GetHostName() string {
+	return o.HostName
+}
+
+// SetHostName sets value to HostName
+func (o *MobileGatewayInterface) SetHostName(v string) {
+	o.HostName = v
+}
+
+// GetSwitchID returns value of SwitchID
+func (o *MobileGatewayInterface) GetSwitchID() types.ID {
+	return o.SwitchID
+}
+
+// SetSwitchID sets value to SwitchID
+func (o *MobileGatewayInterface) SetSwitchID(v types.ID) {
+	o.SwitchID = v
+}
+
+// GetSwitchName returns value of SwitchName
+func (o *MobileGatewayInterface) GetSwitchName() string {
+	return o.SwitchName
+}
+
+// SetSwitchName sets value to SwitchName
+func (o *MobileGatewayInterface) SetSwitchName(v string) {
+	o.SwitchName = v
+}
+
+// GetSwitchScope returns value of SwitchScope
+func (o *MobileGatewayInterface) GetSwitchScope() types.EScope {
+	return o.SwitchScope
+}
+
+// SetSwitchScope sets value to SwitchScope
+func (o *MobileGatewayInterface) SetSwitchScope(v types.EScope) {
+	o.SwitchScope = v
+}
+
+// GetUserSubnetDefaultRoute returns value of UserSubnetDefaultRoute
+func (o *MobileGatewayInterface) GetUserSubnetDefaultRoute() string {
+	return o.UserSubnetDefaultRoute
+}
+
+// SetUserSubnetDefaultRoute sets value to UserSubnetDefaultRoute
+func (o *MobileGatewayInterface) SetUserSubnetDefaultRoute(v string) {
+	o.UserSubnetDefaultRoute = v
+}
+
+// GetUserSubnetNetworkMaskLen returns value of UserSubnetNetworkMaskLen
+func (o *MobileGatewayInterface) GetUserSubnetNetworkMaskLen() int {
+	return o.UserSubnetNetworkMaskLen
+}
+
+// SetUserSubnetNetworkMaskLen sets value to UserSubnetNetworkMaskLen
+func (o *MobileGatewayInterface) SetUserSubnetNetworkMaskLen(v int) {
+	o.UserSubnetNetworkMaskLen = v
+}
+
+// GetSubnetDefaultRoute returns value of SubnetDefaultRoute
+func (o *MobileGatewayInterface) GetSubnetDefaultRoute() string {
+	return o.SubnetDefaultRoute
+}
+
+// SetSubnetDefaultRoute sets value to SubnetDefaultRoute
+func (o *MobileGatewayInterface) SetSubnetDefaultRoute(v string) {
+	o.SubnetDefaultRoute = v
+}
+
+// GetSubnetNetworkMaskLen returns value of SubnetNetworkMaskLen
+func (o *MobileGatewayInterface) GetSubnetNetworkMaskLen() int {
+	return o.SubnetNetworkMaskLen
+}
+
+// SetSubnetNetworkMaskLen sets value to SubnetNetworkMaskLen
+func (o *MobileGatewayInterface) SetSubnetNetworkMaskLen(v int) {
+	o.SubnetNetworkMaskLen = v
+}
+
+// GetSubnetNetworkAddress returns value of SubnetNetworkAddress
+func (o *MobileGatewayInterface) GetSubnetNetworkAddress() string {
+	return o.SubnetNetworkAddress
+}
+
+// SetSubnetNetworkAddress sets value to SubnetNetworkAddress
+func (o *MobileGatewayInterface) SetSubnetNetworkAddress(v string) {
+	o.SubnetNetworkAddress = v
+}
+
+// GetSubnetBandWidthMbps returns value of SubnetBandWidthMbps
+func (o *MobileGatewayInterface) GetSubnetBandWidthMbps() int {
+	return o.SubnetBandWidthMbps
+}
+
+// SetSubnetBandWidthMbps sets value to SubnetBandWidthMbps
+func (o *MobileGatewayInterface) SetSubnetBandWidthMbps(v int) {
+	o.SubnetBandWidthMbps = v
+}
+
+// GetPacketFilterID returns value of PacketFilterID
+func (o *MobileGatewayInterface) GetPacketFilterID() types.ID {
+	return o.PacketFilterID
+}
+
+// SetPacketFilterID sets value to PacketFilterID
+func (o *MobileGatewayInterface) SetPacketFilterID(v types.ID) {
+	o.PacketFilterID = v
+}
+
+// GetPacketFilterName returns value of PacketFilterName
+func (o *MobileGatewayInterface) GetPacketFilterName() string {
+	return o.PacketFilterName
+}
+
+// SetPacketFilterName sets value to PacketFilterName
+func (o *MobileGatewayInterface) SetPacketFilterName(v string) {
+	o.PacketFilterName = v
+}
+
+// GetPacketFilterRequiredHostVersion returns value of PacketFilterRequiredHostVersion
+func (o *MobileGatewayInterface) GetPacketFilterRequiredHostVersion() types.StringNumber {
+	return o.PacketFilterRequiredHostVersion
+}
+
+// SetPacketFilterRequiredHostVersion sets value to PacketFilterRequiredHostVersion
+func (o *MobileGatewayInterface) SetPacketFilterRequiredHostVersion(v types.StringNumber) {
+	o.PacketFilterRequiredHostVersion = v
+}
+
+// GetIndex returns value of Index
+func (o *MobileGatewayInterface) GetIndex() int {
+	return o.Index
+}
+
+// SetIndex sets value to Index
+func (o *MobileGatewayInterface) SetIndex(v int) {
+	o.Index = v
+}
+
+// convertTo returns naked MobileGatewayInterface
+func (o *MobileGatewayInterface) convertTo() (*naked.Interface, error) {
+	dest := &naked.Interface{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked MobileGatewayInterface
+func (o *MobileGatewayInterface) convertFrom(naked *naked.Interface) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* MobileGatewaySetting
+*************************************************/
+
+// MobileGatewaySetting represents API parameter/response structure
+type MobileGatewaySetting struct {
+	Interfaces                      []*MobileGatewayInterfaceSetting `json:",omitempty" mapconv:"MobileGateway.[]Interfaces,omitempty,recursive"`
+	StaticRoute                     []*MobileGatewayStaticRoute      `json:",omitempty" mapconv:"MobileGateway.[]StaticRoutes,omitempty,recursive"`
+	InternetConnectionEnabled       types.StringFlag                 `mapconv:"MobileGateway.InternetConnection.Enabled"`
+	InterDeviceCommunicationEnabled types.StringFlag                 `mapconv:"MobileGateway.InterDeviceCommunication.Enabled"`
+}
+
+// Validate validates by field tags
+func (o *MobileGatewaySetting) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetInterfaces returns value of Interfaces
+func (o *MobileGatewaySetting) GetInterfaces() []*MobileGatewayInterfaceSetting {
+	return o.Interfaces
+}
+
+// SetInterfaces sets value to Interfaces
+func (o *MobileGatewaySetting) SetInterfaces(v []*MobileGatewayInterfaceSetting) {
+	o.Interfaces = v
+}
+
+// GetStaticRoute returns value of StaticRoute
+func (o *MobileGatewaySetting) GetStaticRoute() []*MobileGatewayStaticRoute {
+	return o.StaticRoute
+}
+
+// SetStaticRoute sets value to StaticRoute
+func (o *MobileGatewaySetting) SetStaticRoute(v []*MobileGatewayStaticRoute) {
+	o.StaticRoute = v
+}
+
+// GetInternetConnectionEnabled returns value of InternetConnectionEnabled
+func (o *MobileGatewaySetting) GetInternetConnectionEnabled() types.StringFlag {
+	return o.InternetConnectionEnabled
+}
+
+// SetInternetConnectionEnabled sets value to InternetConnectionEnabled
+func (o *MobileGatewaySetting) SetInternetConnectionEnabled(v types.StringFlag) {
+	o.InternetConnectionEnabled = v
+}
+
+// GetInterDeviceCommunicationEnabled returns value of InterDeviceCommunicationEnabled
+func (o *MobileGatewaySetting) GetInterDeviceCommunicationEnabled() types.StringFlag {
+	return o.InterDeviceCommunicationEnabled
+}
+
+// SetInterDeviceCommunicationEnabled sets value to InterDeviceCommunicationEnabled
+func (o *MobileGatewaySetting) SetInterDeviceCommunicationEnabled(v types.StringFlag) {
+	o.InterDeviceCommunicationEnabled = v
+}
+
+// convertTo returns naked MobileGatewaySetting
+func (o *MobileGatewaySetting) convertTo() (*naked.MobileGatewaySettings, error) {
+	dest := &naked.MobileGatewaySettings{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked MobileGatewaySetting
+func (o *MobileGatewaySetting) convertFrom(naked *naked.MobileGatewaySettings) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* MobileGatewayInterfaceSetting
+*************************************************/
+
+// MobileGatewayInterfaceSetting represents API parameter/response structure
+type MobileGatewayInterfaceSetting struct {
+	IPAddress      []string
+	NetworkMaskLen int
+	Index          int
+}
+
+// Validate validates by field tags
+func (o *MobileGatewayInterfaceSetting) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetIPAddress returns value of IPAddress
+func (o *MobileGatewayInterfaceSetting) GetIPAddress() []string {
+	return o.IPAddress
+}
+
+// SetIPAddress sets value to IPAddress
+func (o *MobileGatewayInterfaceSetting) SetIPAddress(v []string) {
+	o.IPAddress = v
+}
+
+// GetNetworkMaskLen returns value of NetworkMaskLen
+func (o *MobileGatewayInterfaceSetting) GetNetworkMaskLen() int {
+	return o.NetworkMaskLen
+}
+
+// SetNetworkMaskLen sets value to NetworkMaskLen
+func (o *MobileGatewayInterfaceSetting) SetNetworkMaskLen(v int) {
+	o.NetworkMaskLen = v
+}
+
+// GetIndex returns value of Index
+func (o *MobileGatewayInterfaceSetting) GetIndex() int {
+	return o.Index
+}
+
+// SetIndex sets value to Index
+func (o *MobileGatewayInterfaceSetting) SetIndex(v int) {
+	o.Index = v
+}
+
+// convertTo returns naked MobileGatewayInterfaceSetting
+func (o *MobileGatewayInterfaceSetting) convertTo() (*naked.MobileGatewayInterface, error) {
+	dest := &naked.MobileGatewayInterface{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked MobileGatewayInterfaceSetting
+func (o *MobileGatewayInterfaceSetting) convertFrom(naked *naked.MobileGatewayInterface) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* MobileGatewayStaticRoute
+*************************************************/
+
+// MobileGatewayStaticRoute represents API parameter/response structure
+type MobileGatewayStaticRoute struct {
+	Prefix  string
+	NextHop string
+}
+
+// Validate validates by field tags
+func (o *MobileGatewayStaticRoute) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetPrefix returns value of Prefix
+func (o *MobileGatewayStaticRoute) GetPrefix() string {
+	return o.Prefix
+}
+
+// SetPrefix sets value to Prefix
+func (o *MobileGatewayStaticRoute) SetPrefix(v string) {
+	o.Prefix = v
+}
+
+// GetNextHop returns value of NextHop
+func (o *MobileGatewayStaticRoute) GetNextHop() string {
+	return o.NextHop
+}
+
+// SetNextHop sets value to NextHop
+func (o *MobileGatewayStaticRoute) SetNextHop(v string) {
+	o.NextHop = v
+}
+
+// convertTo returns naked MobileGatewayStaticRoute
+func (o *MobileGatewayStaticRoute) convertTo() (*naked.MobileGatewayStaticRoute, error) {
+	dest := &naked.MobileGatewayStaticRoute{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked MobileGatewayStaticRoute
+func (o *MobileGatewayStaticRoute) convertFrom(naked *naked.MobileGatewayStaticRoute) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* MobileGatewayCreateRequest
+*************************************************/
+
+// MobileGatewayCreateRequest represents API parameter/response structure
+type MobileGatewayCreateRequest struct {
+	Class       string `mapconv:",default=mobilegateway"`
+	Name        string `validate:"required"`
+	Description string `validate:"min=0,max=512"`
+	Tags        []string
+	IconID      types.ID                    `mapconv:"Icon.ID"`
+	PlanID      types.ID                    `mapconv:"Plan.ID,default=1"`
+	SwitchScope types.EScope                `mapconv:"Remark.Switch.Scope,default=shared"`
+	Settings    *MobileGatewaySettingCreate `mapconv:",omitempty,recursive"`
+}
+
+// Validate validates by field tags
+func (o *MobileGatewayCreateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetClass returns value of Class
+func (o *MobileGatewayCreateRequest) GetClass() string {
+	return o.Class
+}
+
+// SetClass sets value to Class
+func (o *MobileGatewayCreateRequest) SetClass(v string) {
+	o.Class = v
+}
+
+// GetName returns value of Name
+func (o *MobileGatewayCreateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *MobileGatewayCreateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *MobileGatewayCreateRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *MobileGatewayCreateRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *MobileGatewayCreateRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *MobileGatewayCreateRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *MobileGatewayCreateRequest) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *MobileGatewayCreateRequest) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// GetPlanID returns value of PlanID
+func (o *MobileGatewayCreateRequest) GetPlanID() types.ID {
+	return o.PlanID
+}
+
+// SetPlanID sets value to PlanID
+func (o *MobileGatewayCreateRequest) SetPlanID(v types.ID) {
+	o.PlanID = v
+}
+
+// GetSwitchScope returns value of SwitchScope
+func (o *MobileGatewayCreateRequest) GetSwitchScope() types.EScope {
+	return o.SwitchScope
+}
+
+// SetSwitchScope sets value to SwitchScope
+func (o *MobileGatewayCreateRequest) SetSwitchScope(v types.EScope) {
+	o.SwitchScope = v
+}
+
+// GetSettings returns value of Settings
+func (o *MobileGatewayCreateRequest) GetSettings() *MobileGatewaySettingCreate {
+	return o.Settings
+}
+
+// SetSettings sets value to Settings
+func (o *MobileGatewayCreateRequest) SetSettings(v *MobileGatewaySettingCreate) {
+	o.Settings = v
+}
+
+// convertTo returns naked MobileGatewayCreateRequest
+func (o *MobileGatewayCreateRequest) convertTo() (*naked.MobileGateway, error) {
+	dest := &naked.MobileGateway{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked MobileGatewayCreateRequest
+func (o *MobileGatewayCreateRequest) convertFrom(naked *naked.MobileGateway) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* MobileGatewaySettingCreate
+*************************************************/
+
+// MobileGatewaySettingCreate represents API parameter/response structure
+type MobileGatewaySettingCreate struct {
+	InternetConnectionEnabled       types.StringFlag `mapconv:"MobileGateway.InternetConnection.Enabled"`
+	InterDeviceCommunicationEnabled types.StringFlag `mapconv:"MobileGateway.InterDeviceCommunication.Enabled"`
+}
+
+// Validate validates by field tags
+func (o *MobileGatewaySettingCreate) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetInternetConnectionEnabled returns value of InternetConnectionEnabled
+func (o *MobileGatewaySettingCreate) GetInternetConnectionEnabled() types.StringFlag {
+	return o.InternetConnectionEnabled
+}
+
+// SetInternetConnectionEnabled sets value to InternetConnectionEnabled
+func (o *MobileGatewaySettingCreate) SetInternetConnectionEnabled(v types.StringFlag) {
+	o.InternetConnectionEnabled = v
+}
+
+// GetInterDeviceCommunicationEnabled returns value of InterDeviceCommunicationEnabled
+func (o *MobileGatewaySettingCreate) GetInterDeviceCommunicationEnabled() types.StringFlag {
+	return o.InterDeviceCommunicationEnabled
+}
+
+// SetInterDeviceCommunicationEnabled sets value to InterDeviceCommunicationEnabled
+func (o *MobileGatewaySettingCreate) SetInterDeviceCommunicationEnabled(v types.StringFlag) {
+	o.InterDeviceCommunicationEnabled = v
+}
+
+// convertTo returns naked MobileGatewaySettingCreate
+func (o *MobileGatewaySettingCreate) convertTo() (*naked.MobileGatewaySettings, error) {
+	dest := &naked.MobileGatewaySettings{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked MobileGatewaySettingCreate
+func (o *MobileGatewaySettingCreate) convertFrom(naked *naked.MobileGatewaySettings) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* MobileGatewayUpdateRequest
+*************************************************/
+
+// MobileGatewayUpdateRequest represents API parameter/response structure
+type MobileGatewayUpdateRequest struct {
+	Name        string `validate:"required"`
+	Description string `validate:"min=0,max=512"`
+	Tags        []string
+	IconID      types.ID              `mapconv:"Icon.ID"`
+	Settings    *MobileGatewaySetting `mapconv:",omitempty,recursive"`
+}
+
+// Validate validates by field tags
+func (o *MobileGatewayUpdateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetName returns value of Name
+func (o *MobileGatewayUpdateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *MobileGatewayUpdateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *MobileGatewayUpdateRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *MobileGatewayUpdateRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *MobileGatewayUpdateRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *MobileGatewayUpdateRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *MobileGatewayUpdateRequest) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *MobileGatewayUpdateRequest) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// GetSettings returns value of Settings
+func (o *MobileGatewayUpdateRequest) GetSettings() *MobileGatewaySetting {
+	return o.Settings
+}
+
+// SetSettings sets value to Settings
+func (o *MobileGatewayUpdateRequest) SetSettings(v *MobileGatewaySetting) {
+	o.Settings = v
+}
+
+// convertTo returns naked MobileGatewayUpdateRequest
+func (o *MobileGatewayUpdateRequest) convertTo() (*naked.MobileGateway, error) {
+	dest := &naked.MobileGateway{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked MobileGatewayUpdateRequest
+func (o *MobileGatewayUpdateRequest) convertFrom(naked *naked.MobileGateway) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* MobileGatewayDNSSetting
+*************************************************/
+
+// MobileGatewayDNSSetting represents API parameter/response structure
+type MobileGatewayDNSSetting struct {
+	DNS1 string `mapconv:"dns_1" validate:"ipv4"`
+	DNS2 string `mapconv:"dns_2" validate:"ipv4"`
+}
+
+// Validate validates by field tags
+func (o *MobileGatewayDNSSetting) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetDNS1 returns value of DNS1
+func (o *MobileGatewayDNSSetting) GetDNS1() string {
+	return o.DNS1
+}
+
+// SetDNS1 sets value to DNS1
+func (o *MobileGatewayDNSSetting) SetDNS1(v string) {
+	o.DNS1 = v
+}
+
+// GetDNS2 returns value of DNS2
+func (o *MobileGatewayDNSSetting) GetDNS2() string {
+	return o.DNS2
+}
+
+// SetDNS2 sets value to DNS2
+func (o *MobileGatewayDNSSetting) SetDNS2(v string) {
+	o.DNS2 = v
+}
+
+// convertTo returns naked MobileGatewayDNSSetting
+func (o *MobileGatewayDNSSetting) convertTo() (*naked.MobileGatewaySIMGroup, error) {
+	dest := &naked.MobileGatewaySIMGroup{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked MobileGatewayDNSSetting
+func (o *MobileGatewayDNSSetting) convertFrom(naked *naked.MobileGatewaySIMGroup) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* MobileGatewaySIMInfo
+*************************************************/
+
+// MobileGatewaySIMInfo represents API parameter/response structure
+type MobileGatewaySIMInfo struct {
+	ICCID           string    `mapconv:"iccid"`
+	IMSI            []string  `mapconv:"imsi"`
+	IP              string    `mapconv:"ip"`
+	SessionStatus   string    `mapconv:"session_status"`
+	IMEILock        bool      `mapconv:"imei_lock"`
+	Registered      bool      `mapconv:"registered"`
+	Activated       bool      `mapconv:"activated"`
+	ResourceID      string    `mapconv:"resource_id"`
+	RegisteredDate  time.Time `mapconv:"registered_date"`
+	ActivatedDate   time.Time `mapconv:"activated_date"`
+	DeactivatedDate time.Time `mapconv:"deactivated_date"`
+	SIMGroupID      string    `mapconv:"simgroup_id"`
+	ConnectedIMEI   string    `mapconv:"connected_imei"`
+}
+
+// Validate validates by field tags
+func (o *MobileGatewaySIMInfo) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetICCID returns value of ICCID
+func (o *MobileGatewaySIMInfo) GetICCID() string {
+	return o.ICCID
+}
+
+// SetICCID sets value to ICCID
+func (o *MobileGatewaySIMInfo) SetICCID(v string) {
+	o.ICCID = v
+}
+
+// GetIMSI returns value of IMSI
+func (o *MobileGatewaySIMInfo) GetIMSI() []string {
+	return o.IMSI
+}
+
+// SetIMSI sets value to IMSI
+func (o *MobileGatewaySIMInfo) SetIMSI(v []string) {
+	o.IMSI = v
+}
+
+// GetIP returns value of IP
+func (o *MobileGatewaySIMInfo) GetIP() string {
+	return o.IP
+}
+
+// SetIP sets value to IP
+func (o *MobileGatewaySIMInfo) SetIP(v string) {
+	o.IP = v
+}
+
+// GetSessionStatus returns value of SessionStatus
+func (o *MobileGatewaySIMInfo) GetSessionStatus() string {
+	return o.SessionStatus
+}
+
+// SetSessionStatus sets value to SessionStatus
+func (o *MobileGatewaySIMInfo) SetSessionStatus(v string) {
+	o.SessionStatus = v
+}
+
+// GetIMEILock returns value of IMEILock
+func (o *MobileGatewaySIMInfo) GetIMEILock() bool {
+	return o.IMEILock
+}
+
+// SetIMEILock sets value to IMEILock
+func (o *MobileGatewaySIMInfo) SetIMEILock(v bool) {
+	o.IMEILock = v
+}
+
+// GetRegistered returns value of Registered
+func (o *MobileGatewaySIMInfo) GetRegistered() bool {
+	return o.Registered
+}
+
+// SetRegistered sets value to Registered
+func (o *MobileGatewaySIMInfo) SetRegistered(v bool) {
+	o.Registered = v
+}
+
+// GetActivated returns value of Activated
+func (o *MobileGatewaySIMInfo) GetActivated() bool {
+	return o.Activated
+}
+
+// SetActivated sets value to Activated
+func (o *MobileGatewaySIMInfo) SetActivated(v bool) {
+	o.Activated = v
+}
+
+// GetResourceID returns value of ResourceID
+func (o *MobileGatewaySIMInfo) GetResourceID() string {
+	return o.ResourceID
+}
+
+// SetResourceID sets value to ResourceID
+func (o *MobileGatewaySIMInfo) SetResourceID(v string) {
+	o.ResourceID = v
+}
+
+// GetRegisteredDate returns value of RegisteredDate
+func (o *MobileGatewaySIMInfo) GetRegisteredDate() time.Time {
+	return o.RegisteredDate
+}
+
+// SetRegisteredDate sets value to RegisteredDate
+func (o *MobileGatewaySIMInfo) SetRegisteredDate(v time.Time) {
+	o.RegisteredDate = v
+}
+
+// GetActivatedDate returns value of ActivatedDate
+func (o *MobileGatewaySIMInfo) GetActivatedDate() time.Time {
+	return o.ActivatedDate
+}
+
+// SetActivatedDate sets value to ActivatedDate
+func (o *MobileGatewaySIMInfo) SetActivatedDate(v time.Time) {
+	o.ActivatedDate = v
+}
+
+// GetDeactivatedDate returns value of DeactivatedDate
+func (o *MobileGatewaySIMInfo) GetDeactivatedDate() time.Time {
+	return o.DeactivatedDate
+}
+
+// SetDeactivatedDate sets value to DeactivatedDate
+func (o *MobileGatewaySIMInfo) SetDeactivatedDate(v time.Time) {
+	o.DeactivatedDate = v
+}
+
+// GetSIMGroupID returns value of SIMGroupID
+func (o *MobileGatewaySIMInfo) GetSIMGroupID() string {
+	return o.SIMGroupID
+}
+
+// SetSIMGroupID sets value to SIMGroupID
+func (o *MobileGatewaySIMInfo) SetSIMGroupID(v string) {
+	o.SIMGroupID = v
+}
+
+// GetConnectedIMEI returns value of ConnectedIMEI
+func (o *MobileGatewaySIMInfo) GetConnectedIMEI() string {
+	return o.ConnectedIMEI
+}
+
+// SetConnectedIMEI sets value to ConnectedIMEI
+func (o *MobileGatewaySIMInfo) SetConnectedIMEI(v string) {
+	o.ConnectedIMEI = v
+}
+
+// convertTo returns naked MobileGatewaySIMInfo
+func (o *MobileGatewaySIMInfo) convertTo() (*naked.SIMInfo, error) {
+	dest := &naked.SIMInfo{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked MobileGatewaySIMInfo
+func (o *MobileGatewaySIMInfo) convertFrom(naked *naked.SIMInfo) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* MobileGatewayAddSIMRequest
+*************************************************/
+
+// MobileGatewayAddSIMRequest represents API parameter/response structure
+type MobileGatewayAddSIMRequest struct {
+	SIMID string `mapconv:"resource_id"`
+}
+
+// Validate validates by field tags
+func (o *MobileGatewayAddSIMRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetSIMID returns value of SIMID
+func (o *MobileGatewayAddSIMRequest) GetSIMID() string {
+	return o.SIMID
+}
+
+// SetSIMID sets value to SIMID
+func (o *MobileGatewayAddSIMRequest) SetSIMID(v string) {
+	o.SIMID = v
+}
+
+// convertTo returns naked MobileGatewayAddSIMRequest
+func (o *MobileGatewayAddSIMRequest) convertTo() (*naked.SIMInfo, error) {
+	dest := &naked.SIMInfo{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked MobileGatewayAddSIMRequest
+func (o *MobileGatewayAddSIMRequest) convertFrom(naked *naked.SIMInfo) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* MobileGatewaySIMRoute
+*************************************************/
+
+// MobileGatewaySIMRoute represents API parameter/response structure
+type MobileGatewaySIMRoute struct {
+	ResourceID string `mapconv:"resource_id"`
+	Prefix     string `mapconv:"prefix"`
+	ICCID      string `mapconv:"iccid,omitempty"`
+}
+
+// Validate validates by field tags
+func (o *MobileGatewaySIMRoute) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetResourceID returns value of ResourceID
+func (o *MobileGatewaySIMRoute) GetResourceID() string {
+	return o.ResourceID
+}
+
+// SetResourceID sets value to ResourceID
+func (o *MobileGatewaySIMRoute) SetResourceID(v string) {
+	o.ResourceID = v
+}
+
+// GetPrefix returns value of Prefix
+func (o *MobileGatewaySIMRoute) GetPrefix() string {
+	return o.Prefix
+}
+
+// SetPrefix sets value to Prefix
+func (o *MobileGatewaySIMRoute) SetPrefix(v string) {
+	o.Prefix = v
+}
+
+// GetICCID returns value of ICCID
+func (o *MobileGatewaySIMRoute) GetICCID() string {
+	return o.ICCID
+}
+
+// SetICCID sets value to ICCID
+func (o *MobileGatewaySIMRoute) SetICCID(v string) {
+	o.ICCID = v
+}
+
+// convertTo returns naked MobileGatewaySIMRoute
+func (o *MobileGatewaySIMRoute) convertTo() (*naked.MobileGatewaySIMRoute, error) {
+	dest := &naked.MobileGatewaySIMRoute{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked MobileGatewaySIMRoute
+func (o *MobileGatewaySIMRoute) convertFrom(naked *naked.MobileGatewaySIMRoute) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* MobileGatewayTrafficControl
+*************************************************/
+
+// MobileGatewayTrafficControl represents API parameter/response structure
+type MobileGatewayTrafficControl struct {
+	TrafficQuotaInMB       int    `mapconv:"traffic_quota_in_mb"`
+	BandWidthLimitInKbps   int    `mapconv:"bandwidth_limit_in_kbps"`
+	EmailNotifyEnabled     bool   `mapconv:"email_config.enabled"`
+	SlackNotifyEnabled     bool   `mapconv:"slack_config.enabled"`
+	SlackNotifyWebhooksURL string `mapconv:"slack_config.slack_url"`
+	AutoTrafficShaping     bool   `mapconv:"auto_traffic_shaping"`
+}
+
+// Validate validates by field tags
+func (o *MobileGatewayTrafficControl) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetTrafficQuotaInMB returns value of TrafficQuotaInMB
+func (o *MobileGatewayTrafficControl) GetTrafficQuotaInMB() int {
+	return o.TrafficQuotaInMB
+}
+
+// SetTrafficQuotaInMB sets value to TrafficQuotaInMB
+func (o *MobileGatewayTrafficControl) SetTrafficQuotaInMB(v int) {
+	o.TrafficQuotaInMB = v
+}
+
+// GetBandWidthLimitInKbps returns value of BandWidthLimitInKbps
+func (o *MobileGatewayTrafficControl) GetBandWidthLimitInKbps() int {
+	return o.BandWidthLimitInKbps
+}
+
+// SetBandWidthLimitInKbps sets value to BandWidthLimitInKbps
+func (o *MobileGatewayTrafficControl) SetBandWidthLimitInKbps(v int) {
+	o.BandWidthLimitInKbps = v
+}
+
+// GetEmailNotifyEnabled returns value of EmailNotifyEnabled
+func (o *MobileGatewayTrafficControl) GetEmailNotifyEnabled() bool {
+	return o.EmailNotifyEnabled
+}
+
+// SetEmailNotifyEnabled sets value to EmailNotifyEnabled
+func (o *MobileGatewayTrafficControl) SetEmailNotifyEnabled(v bool) {
+	o.EmailNotifyEnabled = v
+}
+
+// GetSlackNotifyEnabled returns value of SlackNotifyEnabled
+func (o *MobileGatewayTrafficControl) GetSlackNotifyEnabled() bool {
+	return o.SlackNotifyEnabled
+}
+
+// SetSlackNotifyEnabled sets value to SlackNotifyEnabled
+func (o *MobileGatewayTrafficControl) SetSlackNotifyEnabled(v bool) {
+	o.SlackNotifyEnabled = v
+}
+
+// GetSlackNotifyWebhooksURL returns value of SlackNotifyWebhooksURL
+func (o *MobileGatewayTrafficControl) GetSlackNotifyWebhooksURL() string {
+	return o.SlackNotifyWebhooksURL
+}
+
+// SetSlackNotifyWebhooksURL sets value to SlackNotifyWebhooksURL
+func (o *MobileGatewayTrafficControl) SetSlackNotifyWebhooksURL(v string) {
+	o.SlackNotifyWebhooksURL = v
+}
+
+// GetAutoTrafficShaping returns value of AutoTrafficShaping
+func (o *MobileGatewayTrafficControl) GetAutoTrafficShaping() bool {
+	return o.AutoTrafficShaping
+}
+
+// SetAutoTrafficShaping sets value to AutoTrafficShaping
+func (o *MobileGatewayTrafficControl) SetAutoTrafficShaping(v bool) {
+	o.AutoTrafficShaping = v
+}
+
+// convertTo returns naked MobileGatewayTrafficControl
+func (o *MobileGatewayTrafficControl) convertTo() (*naked.TrafficMonitoringConfig, error) {
+	dest := &naked.TrafficMonitoringConfig{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked MobileGatewayTrafficControl
+func (o *MobileGatewayTrafficControl) convertFrom(naked *naked.TrafficMonitoringConfig) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* MobileGatewayTrafficStatus
+*************************************************/
+
+// MobileGatewayTrafficStatus represents API parameter/response structure
+type MobileGatewayTrafficStatus struct {
+	UplinkBytes    types.StringNumber `mapconv:"uplink_bytes"`
+	DownlinkBytes  types.StringNumber `mapconv:"downlink_bytes"`
+	TrafficShaping bool               `mapconv:"traffic_shaping"`
+}
+
+// Validate validates by field tags
+func (o *MobileGatewayTrafficStatus) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetUplinkBytes returns value of UplinkBytes
+func (o *MobileGatewayTrafficStatus) GetUplinkBytes() types.StringNumber {
+	return o.UplinkBytes
+}
+
+// SetUplinkBytes sets value to UplinkBytes
+func (o *MobileGatewayTrafficStatus) SetUplinkBytes(v types.StringNumber) {
+	o.UplinkBytes = v
+}
+
+// GetDownlinkBytes returns value of DownlinkBytes
+func (o *MobileGatewayTrafficStatus) GetDownlinkBytes() types.StringNumber {
+	return o.DownlinkBytes
+}
+
+// SetDownlinkBytes sets value to DownlinkBytes
+func (o *MobileGatewayTrafficStatus) SetDownlinkBytes(v types.StringNumber) {
+	o.DownlinkBytes = v
+}
+
+// GetTrafficShaping returns value of TrafficShaping
+func (o *MobileGatewayTrafficStatus) GetTrafficShaping() bool {
+	return o.TrafficShaping
+}
+
+// SetTrafficShaping sets value to TrafficShaping
+func (o *MobileGatewayTrafficStatus) SetTrafficShaping(v bool) {
+	o.TrafficShaping = v
+}
+
+// convertTo returns naked MobileGatewayTrafficStatus
+func (o *MobileGatewayTrafficStatus) convertTo() (*naked.TrafficStatus, error) {
+	dest := &naked.TrafficStatus{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked MobileGatewayTrafficStatus
+func (o *MobileGatewayTrafficStatus) convertFrom(naked *naked.TrafficStatus) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* NFS
+*************************************************/
+
+// NFS represents API parameter/response structure
+type NFS struct {
+	ID                      types.ID
+	Name                    string `validate:"required"`
+	Description             string `validate:"min=0,max=512"`
+	Tags                    []string
+	Availability            types.EAvailability
+	Class                   string
+	InstanceHostName        string                      `mapconv:"Instance.Host.Name"`
+	InstanceHostInfoURL     string                      `mapconv:"Instance.Host.InfoURL"`
+	InstanceStatus          types.EServerInstanceStatus `mapconv:"Instance.Status"`
+	InstanceStatusChangedAt time.Time                   `mapconv:"Instance.StatusChangedAt"`
+	Interfaces              []*Interface                `json:",omitempty" mapconv:"[]Interfaces,recursive,omitempty"`
+	PlanID                  types.ID                    `mapconv:"Remark.Plan.ID/Plan.ID"`
+	SwitchID                types.ID                    `mapconv:"Remark.Switch.ID"`
+	DefaultRoute            string                      `mapconv:"Remark.Network.DefaultRoute" validate:"ipv4"`
+	NetworkMaskLen          int                         `mapconv:"Remark.Network.NetworkMaskLen" validate:"min=1,max=32"`
+	IPAddresses             []string                    `mapconv:"Remark.[]Servers.IPAddress"`
+	ZoneID                  types.ID                    `mapconv:"Remark.Zone.ID"`
+	IconID                  types.ID                    `mapconv:"Icon.ID"`
+	CreatedAt               time.Time
+	ModifiedAt              time.Time
+}
+
+// Validate validates by field tags
+func (o *NFS) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *NFS) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *NFS) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *NFS) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *NFS) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *NFS) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *NFS) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetName returns value of Name
+func (o *NFS) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *NFS) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *NFS) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *NFS) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *NFS) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *NFS) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetAvailability returns value of Availability
+func (o *NFS) GetAvailability() types.EAvailability {
+	return o.Availability
+}
+
+// SetAvailability sets value to Availability
+func (o *NFS) SetAvailability(v types.EAvailability) {
+	o.Availability = v
+}
+
+// GetClass returns value of Class
+func (o *NFS) GetClass() string {
+	return o.Class
+}
+
+// SetClass sets value to Class
+func (o *NFS) SetClass(v string) {
+	o.Class = v
+}
+
+// GetInstanceHostName returns value of InstanceHostName
+func (o *NFS) GetInstanceHostName() string {
+	return o.InstanceHostName
+}
+
+// SetInstanceHostName sets value to InstanceHostName
+func (o *NFS) SetInstanceHostName(v string) {
+	o.InstanceHostName = v
+}
+
+// GetInstanceHostInfoURL returns value of InstanceHostInfoURL
+func (o *NFS) GetInstanceHostInfoURL() string {
+	return o.InstanceHostInfoURL
+}
+
+// SetInstanceHostInfoURL sets value to InstanceHostInfoURL
+func (o *NFS) SetInstanceHostInfoURL(v string) {
+	o.InstanceHostInfoURL = v
+}
+
+// GetInstanceStatus returns value of InstanceStatus
+func (o *NFS) GetInstanceStatus() types.EServerInstanceStatus {
+	return o.InstanceStatus
+}
+
+// SetInstanceStatus sets value to InstanceStatus
+func (o *NFS) SetInstanceStatus(v types.EServerInstanceStatus) {
+	o.InstanceStatus = v
+}
+
+// GetInstanceStatusChangedAt returns value of InstanceStatusChangedAt
+func (o *NFS) GetInstanceStatusChangedAt() time.Time {
+	return o.InstanceStatusChangedAt
+}
+
+// SetInstanceStatusChangedAt sets value to InstanceStatusChangedAt
+func (o *NFS) SetInstanceStatusChangedAt(v time.Time) {
+	o.InstanceStatusChangedAt = v
+}
+
+// GetInterfaces returns value of Interfaces
+func (o *NFS) GetInterfaces() []*Interface {
+	return o.Interfaces
+}
+
+// SetInterfaces sets value to Interfaces
+func (o *NFS) SetInterfaces(v []*Interface) {
+	o.Interfaces = v
+}
+
+// GetPlanID returns value of PlanID
+func (o *NFS) GetPlanID() types.ID {
+	return o.PlanID
+}
+
+// SetPlanID sets value to PlanID
+func (o *NFS) SetPlanID(v types.ID) {
+	o.PlanID = v
+}
+
+// GetSwitchID returns value of SwitchID
+func (o *NFS) GetSwitchID() types.ID {
+	return o.SwitchID
+}
+
+// SetSwitchID sets value to SwitchID
+func (o *NFS) SetSwitchID(v types.ID) {
+	o.SwitchID = v
+}
+
+// GetDefaultRoute returns value of DefaultRoute
+func (o *NFS) GetDefaultRoute() string {
+	return o.DefaultRoute
+}
+
+// SetDefaultRoute sets value to DefaultRoute
+func (o *NFS) SetDefaultRoute(v string) {
+	o.DefaultRoute = v
+}
+
+// GetNetworkMaskLen returns value of NetworkMaskLen
+func (o *NFS) GetNetworkMaskLen() int {
+	return o.NetworkMaskLen
+}
+
+// SetNetworkMaskLen sets value to NetworkMaskLen
+func (o *NFS) SetNetworkMaskLen(v int) {
+	o.NetworkMaskLen = v
+}
+
+// GetIPAddresses returns value of IPAddresses
+func (o *NFS) GetIPAddresses() []string {
+	return o.IPAddresses
+}
+
+// SetIPAddresses sets value to IPAddresses
+func (o *NFS) SetIPAddresses(v []string) {
+	o.IPAddresses = v
+}
+
+// GetZoneID returns value of ZoneID
+func (o *NFS) GetZoneID() types.ID {
+	return o.ZoneID
+}
+
+// SetZoneID sets value to ZoneID
+func (o *NFS) SetZoneID(v types.ID) {
+	o.ZoneID = v
+}
+
+// GetIconID returns value of IconID
+func (o *NFS) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *NFS) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// GetCreatedAt returns value of CreatedAt
+func (o *NFS) GetCreatedAt() time.Time {
+	return o.CreatedAt
+}
+
+// SetCreatedAt sets value to CreatedAt
+func (o *NFS) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
+}
+
+// GetModifiedAt returns value of ModifiedAt
+func (o *NFS) GetModifiedAt() time.Time {
+	return o.ModifiedAt
+}
+
+// SetModifiedAt sets value to ModifiedAt
+func (o *NFS) SetModifiedAt(v time.Time) {
+	o.ModifiedAt = v
+}
+
+// convertTo returns naked NFS
+func (o *NFS) convertTo() (*naked.NFS, error) {
+	dest := &naked.NFS{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked NFS
+func (o *NFS) convertFrom(naked *naked.NFS) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* NFSCreateRequest
+*************************************************/
+
+// NFSCreateRequest represents API parameter/response structure
+type NFSCreateRequest struct {
+	Class          string   `mapconv:",default=nfs"`
+	SwitchID       types.ID `mapconv:"Remark.Switch.ID"`
+	PlanID         types.ID `mapconv:"Remark.Plan.ID/Plan.ID"`
+	IPAddresses    []string `mapconv:"Remark.[]Servers.IPAddress" validate:"min=1,max=2,dive,ipv4"`
+	NetworkMaskLen int      `mapconv:"Remark.Network.NetworkMaskLen" validate:"min=1,max=32"`
+	DefaultRoute   string   `mapconv:"Remark.Network.DefaultRoute" validate:"ipv4"`
+	Name           string   `validate:"required"`
+	Description    string   `validate:"min=0,max=512"`
+	Tags           []string
+	IconID         types.ID `mapconv:"Icon.ID"`
+}
+
+// Validate validates by field tags
+func (o *NFSCreateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetClass returns value of Class
+func (o *NFSCreateRequest) GetClass() string {
+	return o.Class
+}
+
+// SetClass sets value to Class
+func (o *NFSCreateRequest) SetClass(v string) {
+	o.Class = v
+}
+
+// GetSwitchID returns value of SwitchID
+func (o *NFSCreateRequest) GetSwitchID() types.ID {
+	return o.SwitchID
+}
+
+// SetSwitchID sets value to SwitchID
+func (o *NFSCreateRequest) SetSwitchID(v types.ID) {
+	o.SwitchID = v
+}
+
+// GetPlanID returns value of PlanID
+func (o *NFSCreateRequest) GetPlanID() types.ID {
+	return o.PlanID
+}
+
+// SetPlanID sets value to PlanID
+func (o *NFSCreateRequest) SetPlanID(v types.ID) {
+	o.PlanID = v
+}
+
+// GetIPAddresses returns value of IPAddresses
+func (o *NFSCreateRequest) GetIPAddresses() []string {
+	return o.IPAddresses
+}
+
+// SetIPAddresses sets value to IPAddresses
+func (o *NFSCreateRequest) SetIPAddresses(v []string) {
+	o.IPAddresses = v
+}
+
+// GetNetworkMaskLen returns value of NetworkMaskLen
+func (o *NFSCreateRequest) GetNetworkMaskLen() int {
+	return o.NetworkMaskLen
+}
+
+// SetNetworkMaskLen sets value to NetworkMaskLen
+func (o *NFSCreateRequest) SetNetworkMaskLen(v int) {
+	o.NetworkMaskLen = v
+}
+
+// GetDefaultRoute returns value of DefaultRoute
+func (o *NFSCreateRequest) GetDefaultRoute() string {
+	return o.DefaultRoute
+}
+
+// SetDefaultRoute sets value to DefaultRoute
+func (o *NFSCreateRequest) SetDefaultRoute(v string) {
+	o.DefaultRoute = v
+}
+
+// GetName returns value of Name
+func (o *NFSCreateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *NFSCreateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *NFSCreateRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *NFSCreateRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *NFSCreateRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *NFSCreateRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *NFSCreateRequest) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *NFSCreateRequest) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// convertTo returns naked NFSCreateRequest
+func (o *NFSCreateRequest) convertTo() (*naked.NFS, error) {
+	dest := &naked.NFS{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked NFSCreateRequest
+func (o *NFSCreateRequest) convertFrom(naked *naked.NFS) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* NFSUpdateRequest
+*************************************************/
+
+// NFSUpdateRequest represents API parameter/response structure
+type NFSUpdateRequest struct {
+	Name        string `validate:"required"`
+	Description string `validate:"min=0,max=512"`
+	Tags        []string
+	IconID      types.ID `mapconv:"Icon.ID"`
+}
+
+// Validate validates by field tags
+func (o *NFSUpdateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetName returns value of Name
+func (o *NFSUpdateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *NFSUpdateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *NFSUpdateRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *NFSUpdateRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *NFSUpdateRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *NFSUpdateRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *NFSUpdateRequest) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *NFSUpdateRequest) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// convertTo returns naked NFSUpdateRequest
+func (o *NFSUpdateRequest) convertTo() (*naked.NFS, error) {
+	dest := &naked.NFS{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked NFSUpdateRequest
+func (o *NFSUpdateRequest) convertFrom(naked *naked.NFS) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* FreeDiskSizeActivity
+*************************************************/
+
+// FreeDiskSizeActivity represents API parameter/response structure
+type FreeDiskSizeActivity struct {
+	Values []*MonitorFreeDiskSizeValue `mapconv:"[]FreeDiskSize"`
+}
+
+// Validate validates by field tags
+func (o *FreeDiskSizeActivity) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetValues returns value of Values
+func (o *FreeDiskSizeActivity) GetValues() []*MonitorFreeDiskSizeValue {
+	return o.Values
+}
+
+// SetValues sets value to Values
+func (o *FreeDiskSizeActivity) SetValues(v []*MonitorFreeDiskSizeValue) {
+	o.Values = v
+}
+
+// convertTo returns naked FreeDiskSizeActivity
+func (o *FreeDiskSizeActivity) convertTo() (*naked.MonitorValues, error) {
+	dest := &naked.MonitorValues{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked FreeDiskSizeActivity
+func (o *FreeDiskSizeActivity) convertFrom(naked *naked.MonitorValues) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* MonitorFreeDiskSizeValue
+*************************************************/
+
+// MonitorFreeDiskSizeValue represents API parameter/response structure
+type MonitorFreeDiskSizeValue struct {
+	Time         time.Time `json:",omitempty" mapconv:",omitempty"`
+	FreeDiskSize float64   `json:",omitempty" mapconv:",omitempty"`
+}
+
+// Validate validates by field tags
+func (o *MonitorFreeDiskSizeValue) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetTime returns value of Time
+func (o *MonitorFreeDiskSizeValue) GetTime() time.Time {
+	return o.Time
+}
+
+// SetTime sets value to Time
+func (o *MonitorFreeDiskSizeValue) SetTime(v time.Time) {
+	o.Time = v
+}
+
+// GetFreeDiskSize returns value of FreeDiskSize
+func (o *MonitorFreeDiskSizeValue) GetFreeDiskSize() float64 {
+	return o.FreeDiskSize
+}
+
+// SetFreeDiskSize sets value to FreeDiskSize
+func (o *MonitorFreeDiskSizeValue) SetFreeDiskSize(v float64) {
+	o.FreeDiskSize = v
+}
+
+// convertTo returns naked MonitorFreeDiskSizeValue
+func (o *MonitorFreeDiskSizeValue) convertTo() (*naked.MonitorFreeDiskSizeValue, error) {
+	dest := &naked.MonitorFreeDiskSizeValue{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked MonitorFreeDiskSizeValue
+func (o *MonitorFreeDiskSizeValue) convertFrom(naked *naked.MonitorFreeDiskSizeValue) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* Note
+*************************************************/
+
+// Note represents API parameter/response structure
+type Note struct {
+	ID           types.ID
+	Name         string `validate:"required"`
+	Description  string `validate:"min=0,max=512"`
+	Tags         []string
+	Availability types.EAvailability
+	Scope        types.EScope
+	Class        string
+	Content      string
+	IconID       types.ID `mapconv:"Icon.ID"`
+	CreatedAt    time.Time
+	ModifiedAt   time.Time
+}
+
+// Validate validates by field tags
+func (o *Note) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *Note) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *Note) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *Note) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *Note) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *Note) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *Note) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetName returns value of Name
+func (o *Note) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *Note) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *Note) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *Note) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *Note) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *Note) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetAvailability returns value of Availability
+func (o *Note) GetAvailability() types.EAvailability {
+	return o.Availability
+}
+
+// SetAvailability sets value to Availability
+func (o *Note) SetAvailability(v types.EAvailability) {
+	o.Availability = v
+}
+
+// GetScope returns value of Scope
+func (o *Note) GetScope() types.EScope {
+	return o.Scope
+}
+
+// SetScope sets value to Scope
+func (o *Note) SetScope(v types.EScope) {
+	o.Scope = v
+}
+
+// GetClass returns value of Class
+func (o *Note) GetClass() string {
+	return o.Class
+}
+
+// SetClass sets value to Class
+func (o *Note) SetClass(v string) {
+	o.Class = v
+}
+
+// GetContent returns value of Content
+func (o *Note) GetContent() string {
+	return o.Content
+}
+
+// SetContent sets value to Content
+func (o *Note) SetContent(v string) {
+	o.Content = v
+}
+
+// GetIconID returns value of IconID
+func (o *Note) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *Note) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// GetCreatedAt returns value of CreatedAt
+func (o *Note) GetCreatedAt() time.Time {
+	return o.CreatedAt
+}
+
+// SetCreatedAt sets value to CreatedAt
+func (o *Note) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
+}
+
+// GetModifiedAt returns value of ModifiedAt
+func (o *Note) GetModifiedAt() time.Time {
+	return o.ModifiedAt
+}
+
+// SetModifiedAt sets value to ModifiedAt
+func (o *Note) SetModifiedAt(v time.Time) {
+	o.ModifiedAt = v
+}
+
+// convertTo returns naked Note
+func (o *Note) convertTo() (*naked.Note, error) {
+	dest := &naked.Note{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked Note
+func (o *Note) convertFrom(naked *naked.Note) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* NoteCreateRequest
+*************************************************/
+
+// NoteCreateRequest represents API parameter/response structure
+type NoteCreateRequest struct {
+	Name    string `validate:"required"`
+	Tags    []string
+	IconID  types.ID `mapconv:"Icon.ID"`
+	Class   string
+	Content string
+}
+
+// Validate validates by field tags
+func (o *NoteCreateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetName returns value of Name
+func (o *NoteCreateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *NoteCreateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetTags returns value of Tags
+func (o *NoteCreateRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *NoteCreateRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *NoteCreateRequest) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *NoteCreateRequest) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// GetClass returns value of Class
+func (o *NoteCreateRequest) GetClass() string {
+	return o.Class
+}
+
+// SetClass sets value to Class
+func (o *NoteCreateRequest) SetClass(v string) {
+	o.Class = v
+}
+
+// GetContent returns value of Content
+func (o *NoteCreateRequest) GetContent() string {
+	return o.Content
+}
+
+// SetContent sets value to Content
+func (o *NoteCreateRequest) SetContent(v string) {
+	o.Content = v
+}
+
+// convertTo returns naked NoteCreateRequest
+func (o *NoteCreateRequest) convertTo() (*naked.Note, error) {
+	dest := &naked.Note{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked NoteCreateRequest
+func (o *NoteCreateRequest) convertFrom(naked *naked.Note) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* NoteUpdateRequest
+*************************************************/
+
+// NoteUpdateRequest represents API parameter/response structure
+type NoteUpdateRequest struct {
+	Name    string `validate:"required"`
+	Tags    []string
+	IconID  types.ID `mapconv:"Icon.ID"`
+	Class   string
+	Content string
+}
+
+// Validate validates by field tags
+func (o *NoteUpdateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetName returns value of Name
+func (o *NoteUpdateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *NoteUpdateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetTags returns value of Tags
+func (o *NoteUpdateRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *NoteUpdateRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *NoteUpdateRequest) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *NoteUpdateRequest) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// GetClass returns value of Class
+func (o *NoteUpdateRequest) GetClass() string {
+	return o.Class
+}
+
+// SetClass sets value to Class
+func (o *NoteUpdateRequest) SetClass(v string) {
+	o.Class = v
+}
+
+// GetContent returns value of Content
+func (o *NoteUpdateRequest) GetContent() string {
+	return o.Content
+}
+
+// SetContent sets value to Content
+func (o *NoteUpdateRequest) SetContent(v string) {
+	o.Content = v
+}
+
+// convertTo returns naked NoteUpdateRequest
+func (o *NoteUpdateRequest) convertTo() (*naked.Note, error) {
+	dest := &naked.Note{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked NoteUpdateRequest
+func (o *NoteUpdateRequest) convertFrom(naked *naked.Note) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* PacketFilter
+*************************************************/
+
+// PacketFilter represents API parameter/response structure
+type PacketFilter struct {
+	ID                  types.ID
+	Name                string `validate:"required"`
+	Description         string `validate:"min=0,max=512"`
+	RequiredHostVersion types.StringNumber
+	Expression          []*PacketFilterExpression `mapconv:"[]Expression,recursive"`
+	ExpressionHash      string
+	CreatedAt           time.Time
+}
+
+// Validate validates by field tags
+func (o *PacketFilter) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *PacketFilter) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *PacketFilter) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *PacketFilter) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *PacketFilter) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *PacketFilter) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *PacketFilter) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetName returns value of Name
+func (o *PacketFilter) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *PacketFilter) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *PacketFilter) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *PacketFilter) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetRequiredHostVersion returns value of RequiredHostVersion
+func (o *PacketFilter) GetRequiredHostVersion() types.StringNumber {
+	return o.RequiredHostVersion
+}
+
+// SetRequiredHostVersion sets value to RequiredHostVersion
+func (o *PacketFilter) SetRequiredHostVersion(v types.StringNumber) {
+	o.RequiredHostVersion = v
+}
+
+// GetExpression returns value of Expression
+func (o *PacketFilter) GetExpression() []*PacketFilterExpression {
+	return o.Expression
+}
+
+// SetExpression sets value to Expression
+func (o *PacketFilter) SetExpression(v []*PacketFilterExpression) {
+	o.Expression = v
+}
+
+// GetExpressionHash returns value of ExpressionHash
+func (o *PacketFilter) GetExpressionHash() string {
+	return o.ExpressionHash
+}
+
+// SetExpressionHash sets value to ExpressionHash
+func (o *PacketFilter) SetExpressionHash(v string) {
+	o.ExpressionHash = v
+}
+
+// GetCreatedAt returns value of CreatedAt
+func (o *PacketFilter) GetCreatedAt() time.Time {
+	return o.CreatedAt
+}
+
+// SetCreatedAt sets value to CreatedAt
+func (o *PacketFilter) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
+}
+
+// convertTo returns naked PacketFilter
+func (o *PacketFilter) convertTo() (*naked.PacketFilter, error) {
+	dest := &naked.PacketFilter{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked PacketFilter
+func (o *PacketFilter) convertFrom(naked *naked.PacketFilter) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* PacketFilterExpression
+*************************************************/
+
+// PacketFilterExpression represents API parameter/response structure
+type PacketFilterExpression struct {
+	Protocol        types.Protocol
+	SourceNetwork   types.PacketFilterNetwork
+	SourcePort      types.PacketFilterPort
+	DestinationPort types.PacketFilterPort
+	Action          types.Action
+}
+
+// Validate validates by field tags
+func (o *PacketFilterExpression) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetProtocol returns value of Protocol
+func (o *PacketFilterExpression) GetProtocol() types.Protocol {
+	return o.Protocol
+}
+
+// SetProtocol sets value to Protocol
+func (o *PacketFilterExpression) SetProtocol(v types.Protocol) {
+	o.Protocol = v
+}
+
+// GetSourceNetwork returns value of SourceNetwork
+func (o *PacketFilterExpression) GetSourceNetwork() types.PacketFilterNetwork {
+	return o.SourceNetwork
+}
+
+// SetSourceNetwork sets value to SourceNetwork
+func (o *PacketFilterExpression) SetSourceNetwork(v types.PacketFilterNetwork) {
+	o.SourceNetwork = v
+}
+
+// GetSourcePort returns value of SourcePort
+func (o *PacketFilterExpression) GetSourcePort() types.PacketFilterPort {
+	return o.SourcePort
+}
+
+// SetSourcePort sets value to SourcePort
+func (o *PacketFilterExpression) SetSourcePort(v types.PacketFilterPort) {
+	o.SourcePort = v
+}
+
+// GetDestinationPort returns value of DestinationPort
+func (o *PacketFilterExpression) GetDestinationPort() types.PacketFilterPort {
+	return o.DestinationPort
+}
+
+// SetDestinationPort sets value to DestinationPort
+func (o *PacketFilterExpression) SetDestinationPort(v types.PacketFilterPort) {
+	o.DestinationPort = v
+}
+
+// GetAction returns value of Action
+func (o *PacketFilterExpression) GetAction() types.Action {
+	return o.Action
+}
+
+// SetAction sets value to Action
+func (o *PacketFilterExpression) SetAction(v types.Action) {
+	o.Action = v
+}
+
+// convertTo returns naked PacketFilterExpression
+func (o *PacketFilterExpression) convertTo() (*naked.PacketFilterExpression, error) {
+	dest := &naked.PacketFilterExpression{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked PacketFilterExpression
+func (o *PacketFilterExpression) convertFrom(naked *naked.PacketFilterExpression) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* PacketFilterCreateRequest
+*************************************************/
+
+// PacketFilterCreateRequest represents API parameter/response structure
+type PacketFilterCreateRequest struct {
+	Name        string                    `validate:"required"`
+	Description string                    `validate:"min=0,max=512"`
+	Expression  []*PacketFilterExpression `mapconv:"[]Expression,recursive"`
+}
+
+// Validate validates by field tags
+func (o *PacketFilterCreateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetName returns value of Name
+func (o *PacketFilterCreateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *PacketFilterCreateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *PacketFilterCreateRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *PacketFilterCreateRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetExpression returns value of Expression
+func (o *PacketFilterCreateRequest) GetExpression() []*PacketFilterExpression {
+	return o.Expression
+}
+
+// SetExpression sets value to Expression
+func (o *PacketFilterCreateRequest) SetExpression(v []*PacketFilterExpression) {
+	o.Expression = v
+}
+
+// convertTo returns naked PacketFilterCreateRequest
+func (o *PacketFilterCreateRequest) convertTo() (*naked.PacketFilter, error) {
+	dest := &naked.PacketFilter{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked PacketFilterCreateRequest
+func (o *PacketFilterCreateRequest) convertFrom(naked *naked.PacketFilter) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* PacketFilterUpdateRequest
+*************************************************/
+
+// PacketFilterUpdateRequest represents API parameter/response structure
+type PacketFilterUpdateRequest struct {
+	Name        string                    `validate:"required"`
+	Description string                    `validate:"min=0,max=512"`
+	Expression  []*PacketFilterExpression `mapconv:"[]Expression,recursive"`
+}
+
+// Validate validates by field tags
+func (o *PacketFilterUpdateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetName returns value of Name
+func (o *PacketFilterUpdateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *PacketFilterUpdateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *PacketFilterUpdateRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *PacketFilterUpdateRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetExpression returns value of Expression
+func (o *PacketFilterUpdateRequest) GetExpression() []*PacketFilterExpression {
+	return o.Expression
+}
+
+// SetExpression sets value to Expression
+func (o *PacketFilterUpdateRequest) SetExpression(v []*PacketFilterExpression) {
+	o.Expression = v
+}
+
+// convertTo returns naked PacketFilterUpdateRequest
+func (o *PacketFilterUpdateRequest) convertTo() (*naked.PacketFilter, error) {
+	dest := &naked.PacketFilter{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked PacketFilterUpdateRequest
+func (o *PacketFilterUpdateRequest) convertFrom(naked *naked.PacketFilter) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* PrivateHost
+*************************************************/
+
+// PrivateHost represents API parameter/response structure
+type PrivateHost struct {
+	ID               types.ID
+	Name             string `validate:"required"`
+	Description      string `validate:"min=0,max=512"`
+	Tags             []string
+	IconID           types.ID `mapconv:"Icon.ID"`
+	CreatedAt        time.Time
+	PlanID           types.ID `mapconv:"Plan.ID"`
+	PlanName         string   `mapconv:"Plan.Name"`
+	PlanClass        string   `mapconv:"Plan.Class"`
+	CPU              int      `mapconv:"Plan.CPU"`
+	MemoryMB         int      `mapconv:"Plan.MemoryMB"`
+	AssignedCPU      int
+	AssignedMemoryMB int
+	HostName         string `mapconv:"Host.Name"`
+}
+
+// Validate validates by field tags
+func (o *PrivateHost) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *PrivateHost) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *PrivateHost) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *PrivateHost) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *PrivateHost) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *PrivateHost) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *PrivateHost) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetName returns value of Name
+func (o *PrivateHost) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *PrivateHost) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *PrivateHost) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *PrivateHost) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *PrivateHost) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *PrivateHost) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *PrivateHost) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *PrivateHost) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// GetCreatedAt returns value of CreatedAt
+func (o *PrivateHost) GetCreatedAt() time.Time {
+	return o.CreatedAt
+}
+
+// SetCreatedAt sets value to CreatedAt
+func (o *PrivateHost) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
+}
+
+// GetPlanID returns value of PlanID
+func (o *PrivateHost) GetPlanID() types.ID {
+	return o.PlanID
+}
+
+// SetPlanID sets value to PlanID
+func (o *PrivateHost) SetPlanID(v types.ID) {
+	o.PlanID = v
+}
+
+// GetPlanName returns value of PlanName
+func (o *PrivateHost) GetPlanName() string {
+	return o.PlanName
+}
+
+// SetPlanName sets value to PlanName
+func (o *PrivateHost) SetPlanName(v string) {
+	o.PlanName = v
+}
+
+// GetPlanClass returns value of PlanClass
+func (o *PrivateHost) GetPlanClass() string {
+	return o.PlanClass
+}
+
+// SetPlanClass sets value to PlanClass
+func (o *PrivateHost) SetPlanClass(v string) {
+	o.PlanClass = v
+}
+
+// GetCPU returns value of CPU
+func (o *PrivateHost) GetCPU() int {
+	return o.CPU
+}
+
+// SetCPU sets value to CPU
+func (o *PrivateHost) SetCPU(v int) {
+	o.CPU = v
+}
+
+// GetMemoryMB returns value of MemoryMB
+func (o *PrivateHost) GetMemoryMB() int {
+	return o.MemoryMB
+}
+
+// SetMemoryMB sets value to MemoryMB
+func (o *PrivateHost) SetMemoryMB(v int) {
+	o.MemoryMB = v
+}
+
+// GetMemoryGB gets value to MemoryGB
+func (o *PrivateHost) GetMemoryGB() int {
+	return accessor.GetMemoryGB(o)
+}
+
+// SetMemoryGB sets value to MemoryGB
+func (o *PrivateHost) SetMemoryGB(v int) {
+	accessor.SetMemoryGB(o, v)
+}
+
+// GetAssignedCPU returns value of AssignedCPU
+func (o *PrivateHost) GetAssignedCPU() int {
+	return o.AssignedCPU
+}
+
+// SetAssignedCPU sets value to AssignedCPU
+func (o *PrivateHost) SetAssignedCPU(v int) {
+	o.AssignedCPU = v
+}
+
+// GetAssignedMemoryMB returns value of AssignedMemoryMB
+func (o *PrivateHost) GetAssignedMemoryMB() int {
+	return o.AssignedMemoryMB
+}
+
+// SetAssignedMemoryMB sets value to AssignedMemoryMB
+func (o *PrivateHost) SetAssignedMemoryMB(v int) {
+	o.AssignedMemoryMB = v
+}
+
+// GetAssignedMemoryGB gets value to AssignedMemoryGB
+func (o *PrivateHost) GetAssignedMemoryGB() int {
+	return accessor.GetAssignedMemoryGB(o)
+}
+
+// SetAssignedMemoryGB sets value to AssignedMemoryGB
+func (o *PrivateHost) SetAssignedMemoryGB(v int) {
+	accessor.SetAssignedMemoryGB(o, v)
+}
+
+// GetHostName returns value of HostName
+func (o *PrivateHost) GetHostName() string {
+	return o.HostName
+}
+
+// SetHostName sets value to HostName
+func (o *PrivateHost) SetHostName(v string) {
+	o.HostName = v
+}
+
+// convertTo returns naked PrivateHost
+func (o *PrivateHost) convertTo() (*naked.PrivateHost, error) {
+	dest := &naked.PrivateHost{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked PrivateHost
+func (o *PrivateHost) convertFrom(naked *naked.PrivateHost) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* PrivateHostCreateRequest
+*************************************************/
+
+// PrivateHostCreateRequest represents API parameter/response structure
+type PrivateHostCreateRequest struct {
+	PlanID      types.ID `mapconv:"Plan.ID"`
+	Name        string   `validate:"required"`
+	Description string   `validate:"min=0,max=512"`
+	Tags        []string
+	IconID      types.ID `mapconv:"Icon.ID"`
+}
+
+// Validate validates by field tags
+func (o *PrivateHostCreateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetPlanID returns value of PlanID
+func (o *PrivateHostCreateRequest) GetPlanID() types.ID {
+	return o.PlanID
+}
+
+// SetPlanID sets value to PlanID
+func (o *PrivateHostCreateRequest) SetPlanID(v types.ID) {
+	o.PlanID = v
+}
+
+// GetName returns value of Name
+func (o *PrivateHostCreateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *PrivateHostCreateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *PrivateHostCreateRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *PrivateHostCreateRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *PrivateHostCreateRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *PrivateHostCreateRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *PrivateHostCreateRequest) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *PrivateHostCreateRequest) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// convertTo returns naked PrivateHostCreateRequest
+func (o *PrivateHostCreateRequest) convertTo() (*naked.PrivateHost, error) {
+	dest := &naked.PrivateHost{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked PrivateHostCreateRequest
+func (o *PrivateHostCreateRequest) convertFrom(naked *naked.PrivateHost) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* PrivateHostUpdateRequest
+*************************************************/
+
+// PrivateHostUpdateRequest represents API parameter/response structure
+type PrivateHostUpdateRequest struct {
+	Name        string `validate:"required"`
+	Description string `validate:"min=0,max=512"`
+	Tags        []string
+	IconID      types.ID `mapconv:"Icon.ID"`
+}
+
+// Validate validates by field tags
+func (o *PrivateHostUpdateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetName returns value of Name
+func (o *PrivateHostUpdateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *PrivateHostUpdateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *PrivateHostUpdateRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *PrivateHostUpdateRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *PrivateHostUpdateRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *PrivateHostUpdateRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *PrivateHostUpdateRequest) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *PrivateHostUpdateRequest) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// convertTo returns naked PrivateHostUpdateRequest
+func (o *PrivateHostUpdateRequest) convertTo() (*naked.PrivateHost, error) {
+	dest := &naked.PrivateHost{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked PrivateHostUpdateRequest
+func (o *PrivateHostUpdateRequest) convertFrom(naked *naked.PrivateHost) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* PrivateHostPlan
+*************************************************/
+
+// PrivateHostPlan represents API parameter/response structure
+type PrivateHostPlan struct {
+	ID           types.ID
+	Name         string `validate:"required"`
+	Class        string
+	CPU          int
+	MemoryMB     int
+	Availability types.EAvailability
+}
+
+// Validate validates by field tags
+func (o *PrivateHostPlan) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *PrivateHostPlan) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *PrivateHostPlan) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *PrivateHostPlan) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *PrivateHostPlan) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *PrivateHostPlan) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *PrivateHostPlan) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetName returns value of Name
+func (o *PrivateHostPlan) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *PrivateHostPlan) SetName(v string) {
+	o.Name = v
+}
+
+// GetClass returns value of Class
+func (o *PrivateHostPlan) GetClass() string {
+	return o.Class
+}
+
+// SetClass sets value to Class
+func (o *PrivateHostPlan) SetClass(v string) {
+	o.Class = v
+}
+
+// GetCPU returns value of CPU
+func (o *PrivateHostPlan) GetCPU() int {
+	return o.CPU
+}
+
+// SetCPU sets value to CPU
+func (o *PrivateHostPlan) SetCPU(v int) {
+	o.CPU = v
+}
+
+// GetMemoryMB returns value of MemoryMB
+func (o *PrivateHostPlan) GetMemoryMB() int {
+	return o.MemoryMB
+}
+
+// SetMemoryMB sets value to MemoryMB
+func (o *PrivateHostPlan) SetMemoryMB(v int) {
+	o.MemoryMB = v
+}
+
+// GetMemoryGB gets value to MemoryGB
+func (o *PrivateHostPlan) GetMemoryGB() int {
+	return accessor.GetMemoryGB(o)
+}
+
+// SetMemoryGB sets value to MemoryGB
+func (o *PrivateHostPlan) SetMemoryGB(v int) {
+	accessor.SetMemoryGB(o, v)
+}
+
+// GetAvailability returns value of Availability
+func (o *PrivateHostPlan) GetAvailability() types.EAvailability {
+	return o.Availability
+}
+
+// SetAvailability sets value to Availability
+func (o *PrivateHostPlan) SetAvailability(v types.EAvailability) {
+	o.Availability = v
+}
+
+// convertTo returns naked PrivateHostPlan
+func (o *PrivateHostPlan) convertTo() (*naked.PrivateHostPlan, error) {
+	dest := &naked.PrivateHostPlan{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked PrivateHostPlan
+func (o *PrivateHostPlan) convertFrom(naked *naked.PrivateHostPlan) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* ProxyLB
+*************************************************/
+
+// ProxyLB represents API parameter/response structure
+type ProxyLB struct {
+	ID               types.ID
+	Name             string `validate:"required"`
+	Description      string `validate:"min=0,max=512"`
+	Tags             []string
+	Availability     types.EAvailability
+	IconID           types.ID `mapconv:"Icon.ID"`
+	CreatedAt        time.Time
+	ModifiedAt       time.Time
+	Class            string                `mapconv:"Provider.Class,default=proxylb"`
+	HealthCheck      *ProxyLBHealthCheck   `mapconv:"Settings.ProxyLB.HealthCheck,recursive" validate:"required"`
+	SorryServer      *ProxyLBSorryServer   `mapconv:"Settings.ProxyLB.SorryServer,recursive"`
+	BindPorts        []*ProxyLBBindPort    `mapconv:"Settings.ProxyLB.[]BindPorts,recursive" validate:"min=0,max=2"`
+	Servers          []*ProxyLBServer      `mapconv:"Settings.ProxyLB.[]Servers,recursive" validate:"min=0,max=40"`
+	LetsEncrypt      *ProxyLBLetsEncrypt   `mapconv:"Settings.ProxyLB.LetsEncrypt,recursive"`
+	StickySession    *ProxyLBStickySession `mapconv:"Settings.ProxyLB.StickySession,recursive"`
+	Timeout          *ProxyLBTimeout       `mapconv:"Settings.ProxyLB.Timeout,recursive"`
+	SettingsHash     string
+	UseVIPFailover   bool                 `mapconv:"Status.UseVIPFailover"`
+	Region           types.EProxyLBRegion `mapconv:"Status.Region" validate:"omitempty,oneof=tk1 is1 anycast"`
+	ProxyNetworks    []string             `mapconv:"Status.ProxyNetworks"`
+	FQDN             string               `mapconv:"Status.FQDN"`
+	VirtualIPAddress string               `mapconv:"Status.VirtualIPAddress"`
+}
+
+// Validate validates by field tags
+func (o *ProxyLB) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *ProxyLB) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *ProxyLB) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *ProxyLB) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *ProxyLB) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *ProxyLB) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *ProxyLB) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetName returns value of Name
+func (o *ProxyLB) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *ProxyLB) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *ProxyLB) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *ProxyLB) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *ProxyLB) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *ProxyLB) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetAvailability returns value of Availability
+func (o *ProxyLB) GetAvailability() types.EAvailability {
+	return o.Availability
+}
+
+// SetAvailability sets value to Availability
+func (o *ProxyLB) SetAvailability(v types.EAvailability) {
+	o.Availability = v
+}
+
+// GetIconID returns value of IconID
+func (o *ProxyLB) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *ProxyLB) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// GetCreatedAt returns value of CreatedAt
+func (o *ProxyLB) GetCreatedAt() time.Time {
+	return o.CreatedAt
+}
+
+// SetCreatedAt sets value to CreatedAt
+func (o *ProxyLB) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
+}
+
+// GetModifiedAt returns value of ModifiedAt
+func (o *ProxyLB) GetModifiedAt() time.Time {
+	return o.ModifiedAt
+}
+
+// SetModifiedAt sets value to ModifiedAt
+func (o *ProxyLB) SetModifiedAt(v time.Time) {
+	o.ModifiedAt = v
+}
+
+// GetClass returns value of Class
+func (o *ProxyLB) GetClass() string {
+	return o.Class
+}
+
+// SetClass sets value to Class
+func (o *ProxyLB) SetClass(v string) {
+	o.Class = v
+}
+
+// GetHealthCheck returns value of HealthCheck
+func (o *ProxyLB) GetHealthCheck() *ProxyLBHealthCheck {
+	return o.HealthCheck
+}
+
+// SetHealthCheck sets value to HealthCheck
+func (o *ProxyLB) SetHealthCheck(v *ProxyLBHealthCheck) {
+	o.HealthCheck = v
+}
+
+// GetSorryServer returns value of SorryServer
+func (o *ProxyLB) GetSorryServer() *ProxyLBSorryServer {
+	return o.SorryServer
+}
+
+// SetSorryServer sets value to SorryServer
+func (o *ProxyLB) SetSorryServer(v *ProxyLBSorryServer) {
+	o.SorryServer = v
+}
+
+// GetBindPorts returns value of BindPorts
+func (o *ProxyLB) GetBindPorts() []*ProxyLBBindPort {
+	return o.BindPorts
+}
+
+// SetBindPorts sets value to BindPorts
+func (o *ProxyLB) SetBindPorts(v []*ProxyLBBindPort) {
+	o.BindPorts = v
+}
+
+// GetServers returns value of Servers
+func (o *ProxyLB) GetServers() []*ProxyLBServer {
+	return o.Servers
+}
+
+// SetServers sets value to Servers
+func (o *ProxyLB) SetServers(v []*ProxyLBServer) {
+	o.Servers = v
+}
+
+// GetLetsEncrypt returns value of LetsEncrypt
+func (o *ProxyLB) GetLetsEncrypt() *ProxyLBLetsEncrypt {
+	return o.LetsEncrypt
+}
+
+// SetLetsEncrypt sets value to LetsEncrypt
+func (o *ProxyLB) SetLetsEncrypt(v *ProxyLBLetsEncrypt) {
+	o.LetsEncrypt = v
+}
+
+// GetStickySession returns value of StickySession
+func (o *ProxyLB) GetStickySession() *ProxyLBStickySession {
+	return o.StickySession
+}
+
+// SetStickySession sets value to StickySession
+func (o *ProxyLB) SetStickySession(v *ProxyLBStickySession) {
+	o.StickySession = v
+}
+
+// GetTimeout returns value of Timeout
+func (o *ProxyLB) GetTimeout() *ProxyLBTimeout {
+	return o.Timeout
+}
+
+// SetTimeout sets value to Timeout
+func (o *ProxyLB) SetTimeout(v *ProxyLBTimeout) {
+	o.Timeout = v
+}
+
+// GetSettingsHash returns value of SettingsHash
+func (o *ProxyLB) GetSettingsHash() string {
+	return o.SettingsHash
+}
+
+// SetSettingsHash sets value to SettingsHash
+func (o *ProxyLB) SetSettingsHash(v string) {
+	o.SettingsHash = v
+}
+
+// GetUseVIPFailover returns value of UseVIPFailover
+func (o *ProxyLB) GetUseVIPFailover() bool {
+	return o.UseVIPFailover
+}
+
+// SetUseVIPFailover sets value to UseVIPFailover
+func (o *ProxyLB) SetUseVIPFailover(v bool) {
+	o.UseVIPFailover = v
+}
+
+// GetRegion returns value of Region
+func (o *ProxyLB) GetRegion() types.EProxyLBRegion {
+	return o.Region
+}
+
+// SetRegion sets value to Region
+func (o *ProxyLB) SetRegion(v types.EProxyLBRegion) {
+	o.Region = v
+}
+
+// GetProxyNetworks returns value of ProxyNetworks
+func (o *ProxyLB) GetProxyNetworks() []string {
+	return o.ProxyNetworks
+}
+
+// SetProxyNetworks sets value to ProxyNetworks
+func (o *ProxyLB) SetProxyNetworks(v []string) {
+	o.ProxyNetworks = v
+}
+
+// GetFQDN returns value of FQDN
+func (o *ProxyLB) GetFQDN() string {
+	return o.FQDN
+}
+
+// SetFQDN sets value to FQDN
+func (o *ProxyLB) SetFQDN(v string) {
+	o.FQDN = v
+}
+
+// GetVirtualIPAddress returns value of VirtualIPAddress
+func (o *ProxyLB) GetVirtualIPAddress() string {
+	return o.VirtualIPAddress
+}
+
+// SetVirtualIPAddress sets value to VirtualIPAddress
+func (o *ProxyLB) SetVirtualIPAddress(v string) {
+	o.VirtualIPAddress = v
+}
+
+// convertTo returns naked ProxyLB
+func (o *ProxyLB) convertTo() (*naked.ProxyLB, error) {
+	dest := &naked.ProxyLB{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked ProxyLB
+func (o *ProxyLB) convertFrom(naked *naked.ProxyLB) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* ProxyLBHealthCheck
+*************************************************/
+
+// ProxyLBHealthCheck represents API parameter/response structure
+type ProxyLBHealthCheck struct {
+	Protocol  types.EProxyLBHealthCheckProtocol `validate:"oneof=http tcp"`
+	Path      string
+	Host      string
+	DelayLoop int `validate:"omitempty,min=10,max=60"`
+}
+
+// Validate validates by field tags
+func (o *ProxyLBHealthCheck) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetProtocol returns value of Protocol
+func (o *ProxyLBHealthCheck) GetProtocol() types.EProxyLBHealthCheckProtocol {
+	return o.Protocol
+}
+
+// SetProtocol sets value to Protocol
+func (o *ProxyLBHealthCheck) SetProtocol(v types.EProxyLBHealthCheckProtocol) {
+	o.Protocol = v
+}
+
+// GetPath returns value of Path
+func (o *ProxyLBHealthCheck) GetPath() string {
+	return o.Path
+}
+
+// SetPath sets value to Path
+func (o *ProxyLBHealthCheck) SetPath(v string) {
+	o.Path = v
+}
+
+// GetHost returns value of Host
+func (o *ProxyLBHealthCheck) GetHost() string {
+	return o.Host
+}
+
+// SetHost sets value to Host
+func (o *ProxyLBHealthCheck) SetHost(v string) {
+	o.Host = v
+}
+
+// GetDelayLoop returns value of DelayLoop
+func (o *ProxyLBHealthCheck) GetDelayLoop() int {
+	return o.DelayLoop
+}
+
+// SetDelayLoop sets value to DelayLoop
+func (o *ProxyLBHealthCheck) SetDelayLoop(v int) {
+	o.DelayLoop = v
+}
+
+/*************************************************
+* ProxyLBSorryServer
+*************************************************/
+
+// ProxyLBSorryServer represents API parameter/response structure
+type ProxyLBSorryServer struct {
+	IPAddress string `validate:"omitempty,ipv4"`
+	Port      int    `validate:"omitempty,min=0,max=65535"`
+}
+
+// Validate validates by field tags
+func (o *ProxyLBSorryServer) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetIPAddress returns value of IPAddress
+func (o *ProxyLBSorryServer) GetIPAddress() string {
+	return o.IPAddress
+}
+
+// SetIPAddress sets value to IPAddress
+func (o *ProxyLBSorryServer) SetIPAddress(v string) {
+	o.IPAddress = v
+}
+
+// GetPort returns value of Port
+func (o *ProxyLBSorryServer) GetPort() int {
+	return o.Port
+}
+
+// SetPort sets value to Port
+func (o *ProxyLBSorryServer) SetPort(v int) {
+	o.Port = v
+}
+
+/*************************************************
+* ProxyLBBindPort
+*************************************************/
+
+// ProxyLBBindPort represents API parameter/response structure
+type ProxyLBBindPort struct {
+	ProxyMode       types.EProxyLBProxyMode `validate:"required,oneof=http https tcp"`
+	Port            int                     `validate:"min=0,max=65535"`
+	RedirectToHTTPS bool
+	SupportHTTP2    bool
+}
+
+// Validate validates by field tags
+func (o *ProxyLBBindPort) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetProxyMode returns value of ProxyMode
+func (o *ProxyLBBindPort) GetProxyMode() types.EProxyLBProxyMode {
+	return o.ProxyMode
+}
+
+// SetProxyMode sets value to ProxyMode
+func (o *ProxyLBBindPort) SetProxyMode(v types.EProxyLBProxyMode) {
+	o.ProxyMode = v
+}
+
+// GetPort returns value of Port
+func (o *ProxyLBBindPort) GetPort() int {
+	return o.Port
+}
+
+// SetPort sets value to Port
+func (o *ProxyLBBindPort) SetPort(v int) {
+	o.Port = v
+}
+
+// GetRedirectToHTTPS returns value of RedirectToHTTPS
+func (o *ProxyLBBindPort) GetRedirectToHTTPS() bool {
+	return o.RedirectToHTTPS
+}
+
+// SetRedirectToHTTPS sets value to RedirectToHTTPS
+func (o *ProxyLBBindPort) SetRedirectToHTTPS(v bool) {
+	o.RedirectToHTTPS = v
+}
+
+// GetSupportHTTP2 returns value of SupportHTTP2
+func (o *ProxyLBBindPort) GetSupportHTTP2() bool {
+	return o.SupportHTTP2
+}
+
+// SetSupportHTTP2 sets value to SupportHTTP2
+func (o *ProxyLBBindPort) SetSupportHTTP2(v bool) {
+	o.SupportHTTP2 = v
+}
+
+/*************************************************
+* ProxyLBServer
+*************************************************/
+
+// ProxyLBServer represents API parameter/response structure
+type ProxyLBServer struct {
+	IPAddress string `validate:"required,ipv4"`
+	Port      int    `validate:"min=0,max=65535"`
+	Enabled   bool
+}
+
+// Validate validates by field tags
+func (o *ProxyLBServer) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetIPAddress returns value of IPAddress
+func (o *ProxyLBServer) GetIPAddress() string {
+	return o.IPAddress
+}
+
+// SetIPAddress sets value to IPAddress
+func (o *ProxyLBServer) SetIPAddress(v string) {
+	o.IPAddress = v
+}
+
+// GetPort returns value of Port
+func (o *ProxyLBServer) GetPort() int {
+	return o.Port
+}
+
+// SetPort sets value to Port
+func (o *ProxyLBServer) SetPort(v int) {
+	o.Port = v
+}
+
+// GetEnabled returns value of Enabled
+func (o *ProxyLBServer) GetEnabled() bool {
+	return o.Enabled
+}
+
+// SetEnabled sets value to Enabled
+func (o *ProxyLBServer) SetEnabled(v bool) {
+	o.Enabled = v
+}
+
+/*************************************************
+* ProxyLBLetsEncrypt
+*************************************************/
+
+// ProxyLBLetsEncrypt represents API parameter/response structure
+type ProxyLBLetsEncrypt struct {
+	CommonName string
+	Enabled    bool
+}
+
+// Validate validates by field tags
+func (o *ProxyLBLetsEncrypt) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetCommonName returns value of CommonName
+func (o *ProxyLBLetsEncrypt) GetCommonName() string {
+	return o.CommonName
+}
+
+// SetCommonName sets value to CommonName
+func (o *ProxyLBLetsEncrypt) SetCommonName(v string) {
+	o.CommonName = v
+}
+
+// GetEnabled returns value of Enabled
+func (o *ProxyLBLetsEncrypt) GetEnabled() bool {
+	return o.Enabled
+}
+
+// SetEnabled sets value to Enabled
+func (o *ProxyLBLetsEncrypt) SetEnabled(v bool) {
+	o.Enabled = v
+}
+
+/*************************************************
+* ProxyLBStickySession
+*************************************************/
+
+// ProxyLBStickySession represents API parameter/response structure
+type ProxyLBStickySession struct {
+	Enabled bool
+	Method  string `validate:"omitempty,oneof=cookie"`
+}
+
+// Validate validates by field tags
+func (o *ProxyLBStickySession) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetEnabled returns value of Enabled
+func (o *ProxyLBStickySession) GetEnabled() bool {
+	return o.Enabled
+}
+
+// SetEnabled sets value to Enabled
+func (o *ProxyLBStickySession) SetEnabled(v bool) {
+	o.Enabled = v
+}
+
+// GetMethod returns value of Method
+func (o *ProxyLBStickySession) GetMethod() string {
+	return o.Method
+}
+
+// SetMethod sets value to Method
+func (o *ProxyLBStickySession) SetMethod(v string) {
+	o.Method = v
+}
+
+/*************************************************
+* ProxyLBTimeout
+*************************************************/
+
+// ProxyLBTimeout represents API parameter/response structure
+type ProxyLBTimeout struct {
+	InactiveSec int `validate:"omitempty,min=10,max=600"`
+}
+
+// Validate validates by field tags
+func (o *ProxyLBTimeout) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetInactiveSec returns value of InactiveSec
+func (o *ProxyLBTimeout) GetInactiveSec() int {
+	return o.InactiveSec
+}
+
+// SetInactiveSec sets value to InactiveSec
+func (o *ProxyLBTimeout) SetInactiveSec(v int) {
+	o.InactiveSec = v
+}
+
+/*************************************************
+* ProxyLBCreateRequest
+*************************************************/
+
+// ProxyLBCreateRequest represents API parameter/response structure
+type ProxyLBCreateRequest struct {
+	Class          string                `mapconv:"Provider.Class,default=proxylb"`
+	HealthCheck    *ProxyLBHealthCheck   `mapconv:"Settings.ProxyLB.HealthCheck,recursive" validate:"required"`
+	SorryServer    *ProxyLBSorryServer   `mapconv:"Settings.ProxyLB.SorryServer,recursive"`
+	BindPorts      []*ProxyLBBindPort    `mapconv:"Settings.ProxyLB.[]BindPorts,recursive" validate:"min=0,max=2"`
+	Servers        []*ProxyLBServer      `mapconv:"Settings.ProxyLB.[]Servers,recursive" validate:"min=0,max=40"`
+	LetsEncrypt    *ProxyLBLetsEncrypt   `mapconv:"Settings.ProxyLB.LetsEncrypt,recursive"`
+	StickySession  *ProxyLBStickySession `mapconv:"Settings.ProxyLB.StickySession,recursive"`
+	Timeout        *ProxyLBTimeout       `mapconv:"Settings.ProxyLB.Timeout,recursive"`
+	UseVIPFailover bool                  `mapconv:"Status.UseVIPFailover"`
+	Region         types.EProxyLBRegion  `mapconv:"Status.Region" validate:"omitempty,oneof=tk1 is1 anycast"`
+	Name           string                `validate:"required"`
+	Description    string                `validate:"min=0,max=512"`
+	Tags           []string
+	IconID         types.ID `mapconv:"Icon.ID"`
+}
+
+// Validate validates by field tags
+func (o *ProxyLBCreateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetClass returns value of Class
+func (o *ProxyLBCreateRequest) GetClass() string {
+	return o.Class
+}
+
+// SetClass sets value to Class
+func (o *ProxyLBCreateRequest) SetClass(v string) {
+	o.Class = v
+}
+
+// GetHealthCheck returns value of HealthCheck
+func (o *ProxyLBCreateRequest) GetHealthCheck() *ProxyLBHealthCheck {
+	return o.HealthCheck
+}
+
+// SetHealthCheck sets value to HealthCheck
+func (o *ProxyLBCreateRequest) SetHealthCheck(v *ProxyLBHealthCheck) {
+	o.HealthCheck = v
+}
+
+// GetSorryServer returns value of SorryServer
+func (o *ProxyLBCreateRequest) GetSorryServer() *ProxyLBSorryServer {
+	return o.SorryServer
+}
+
+// SetSorryServer sets value to SorryServer
+func (o *ProxyLBCreateRequest) SetSorryServer(v *ProxyLBSorryServer) {
+	o.SorryServer = v
+}
+
+// GetBindPorts returns value of BindPorts
+func (o *ProxyLBCreateRequest) GetBindPorts() []*ProxyLBBindPort {
+	return o.BindPorts
+}
+
+// SetBindPorts sets value to BindPorts
+func (o *ProxyLBCreateRequest) SetBindPorts(v []*ProxyLBBindPort) {
+	o.BindPorts = v
+}
+
+// GetServers returns value of Servers
+func (o *ProxyLBCreateRequest) GetServers() []*ProxyLBServer {
+	return o.Servers
+}
+
+// SetServers sets value to Servers
+func (o *ProxyLBCreateRequest) SetServers(v []*ProxyLBServer) {
+	o.Servers = v
+}
+
+// GetLetsEncrypt returns value of LetsEncrypt
+func (o *ProxyLBCreateRequest) GetLetsEncrypt() *ProxyLBLetsEncrypt {
+	return o.LetsEncrypt
+}
+
+// SetLetsEncrypt sets value to LetsEncrypt
+func (o *ProxyLBCreateRequest) SetLetsEncrypt(v *ProxyLBLetsEncrypt) {
+	o.LetsEncrypt = v
+}
+
+// GetStickySession returns value of StickySession
+func (o *ProxyLBCreateRequest) GetStickySession() *ProxyLBStickySession {
+	return o.StickySession
+}
+
+// SetStickySession sets value to StickySession
+func (o *ProxyLBCreateRequest) SetStickySession(v *ProxyLBStickySession) {
+	o.StickySession = v
+}
+
+// GetTimeout returns value of Timeout
+func (o *ProxyLBCreateRequest) GetTimeout() *ProxyLBTimeout {
+	return o.Timeout
+}
+
+// SetTimeout sets value to Timeout
+func (o *ProxyLBCreateRequest) SetTimeout(v *ProxyLBTimeout) {
+	o.Timeout = v
+}
+
+// GetUseVIPFailover returns value of UseVIPFailover
+func (o *ProxyLBCreateRequest) GetUseVIPFailover() bool {
+	return o.UseVIPFailover
+}
+
+// SetUseVIPFailover sets value to UseVIPFailover
+func (o *ProxyLBCreateRequest) SetUseVIPFailover(v bool) {
+	o.UseVIPFailover = v
+}
+
+// GetRegion returns value of Region
+func (o *ProxyLBCreateRequest) GetRegion() types.EProxyLBRegion {
+	return o.Region
+}
+
+// SetRegion sets value to Region
+func (o *ProxyLBCreateRequest) SetRegion(v types.EProxyLBRegion) {
+	o.Region = v
+}
+
+// GetName returns value of Name
+func (o *ProxyLBCreateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *ProxyLBCreateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *ProxyLBCreateRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *ProxyLBCreateRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *ProxyLBCreateRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *ProxyLBCreateRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *ProxyLBCreateRequest) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *ProxyLBCreateRequest) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// convertTo returns naked ProxyLBCreateRequest
+func (o *ProxyLBCreateRequest) convertTo() (*naked.ProxyLB, error) {
+	dest := &naked.ProxyLB{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked ProxyLBCreateRequest
+func (o *ProxyLBCreateRequest) convertFrom(naked *naked.ProxyLB) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* ProxyLBUpdateRequest
+*************************************************/
+
+// ProxyLBUpdateRequest represents API parameter/response structure
+type ProxyLBUpdateRequest struct {
+	HealthCheck   *ProxyLBHealthCheck   `mapconv:"Settings.ProxyLB.HealthCheck,recursive" validate:"required"`
+	SorryServer   *ProxyLBSorryServer   `mapconv:"Settings.ProxyLB.SorryServer,recursive"`
+	BindPorts     []*ProxyLBBindPort    `mapconv:"Settings.ProxyLB.[]BindPorts,recursive" validate:"min=0,max=2"`
+	Servers       []*ProxyLBServer      `mapconv:"Settings.ProxyLB.[]Servers,recursive" validate:"min=0,max=40"`
+	LetsEncrypt   *ProxyLBLetsEncrypt   `mapconv:"Settings.ProxyLB.LetsEncrypt,recursive"`
+	StickySession *ProxyLBStickySession `mapconv:"Settings.ProxyLB.StickySession,recursive"`
+	Timeout       *ProxyLBTimeout       `mapconv:"Settings.ProxyLB.Timeout,recursive"`
+	Name          string                `validate:"required"`
+	Description   string                `validate:"min=0,max=512"`
+	Tags          []string
+	IconID        types.ID `mapconv:"Icon.ID"`
+}
+
+// Validate validates by field tags
+func (o *ProxyLBUpdateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetHealthCheck returns value of HealthCheck
+func (o *ProxyLBUpdateRequest) GetHealthCheck() *ProxyLBHealthCheck {
+	return o.HealthCheck
+}
+
+// SetHealthCheck sets value to HealthCheck
+func (o *ProxyLBUpdateRequest) SetHealthCheck(v *ProxyLBHealthCheck) {
+	o.HealthCheck = v
+}
+
+// GetSorryServer returns value of SorryServer
+func (o *ProxyLBUpdateRequest) GetSorryServer() *ProxyLBSorryServer {
+	return o.SorryServer
+}
+
+// SetSorryServer sets value to SorryServer
+func (o *ProxyLBUpdateRequest) SetSorryServer(v *ProxyLBSorryServer) {
+	o.SorryServer = v
+}
+
+// GetBindPorts returns value of BindPorts
+func (o *ProxyLBUpdateRequest) GetBindPorts() []*ProxyLBBindPort {
+	return o.BindPorts
+}
+
+// SetBindPorts sets value to BindPorts
+func (o *ProxyLBUpdateRequest) SetBindPorts(v []*ProxyLBBindPort) {
+	o.BindPorts = v
+}
+
+// GetServers returns value of Servers
+func (o *ProxyLBUpdateRequest) GetServers() []*ProxyLBServer {
+	return o.Servers
+}
+
+// SetServers sets value to Servers
+func (o *ProxyLBUpdateRequest) SetServers(v []*ProxyLBServer) {
+	o.Servers = v
+}
+
+// GetLetsEncrypt returns value of LetsEncrypt
+func (o *ProxyLBUpdateRequest) GetLetsEncrypt() *ProxyLBLetsEncrypt {
+	return o.LetsEncrypt
+}
+
+// SetLetsEncrypt sets value to LetsEncrypt
+func (o *ProxyLBUpdateRequest) SetLetsEncrypt(v *ProxyLBLetsEncrypt) {
+	o.LetsEncrypt = v
+}
+
+// GetStickySession returns value of StickySession
+func (o *ProxyLBUpdateRequest) GetStickySession() *ProxyLBStickySession {
+	return o.StickySession
+}
+
+// SetStickySession sets value to StickySession
+func (o *ProxyLBUpdateRequest) SetStickySession(v *ProxyLBStickySession) {
+	o.StickySession = v
+}
+
+// GetTimeout returns value of Timeout
+func (o *ProxyLBUpdateRequest) GetTimeout() *ProxyLBTimeout {
+	return o.Timeout
+}
+
+// SetTimeout sets value to Timeout
+func (o *ProxyLBUpdateRequest) SetTimeout(v *ProxyLBTimeout) {
+	o.Timeout = v
+}
+
+// GetName returns value of Name
+func (o *ProxyLBUpdateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *ProxyLBUpdateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *ProxyLBUpdateRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *ProxyLBUpdateRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *ProxyLBUpdateRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *ProxyLBUpdateRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *ProxyLBUpdateRequest) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *ProxyLBUpdateRequest) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// convertTo returns naked ProxyLBUpdateRequest
+func (o *ProxyLBUpdateRequest) convertTo() (*naked.ProxyLB, error) {
+	dest := &naked.ProxyLB{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked ProxyLBUpdateRequest
+func (o *ProxyLBUpdateRequest) convertFrom(naked *naked.ProxyLB) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* ProxyLBCertificates
+*************************************************/
+
+// ProxyLBCertificates represents API parameter/response structure
+type ProxyLBCertificates struct {
+	PrimaryCert     *ProxyLBPrimaryCert      `mapconv:",recursive"`
+	AdditionalCerts []*ProxyLBAdditionalCert `mapconv:"[]AdditionalCerts,recursive"`
+}
+
+// Validate validates by field tags
+func (o *ProxyLBCertificates) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetPrimaryCert returns value of PrimaryCert
+func (o *ProxyLBCertificates) GetPrimaryCert() *ProxyLBPrimaryCert {
+	return o.PrimaryCert
+}
+
+// SetPrimaryCert sets value to PrimaryCert
+func (o *ProxyLBCertificates) SetPrimaryCert(v *ProxyLBPrimaryCert) {
+	o.PrimaryCert = v
+}
+
+// GetAdditionalCerts returns value of AdditionalCerts
+func (o *ProxyLBCertificates) GetAdditionalCerts() []*ProxyLBAdditionalCert {
+	return o.AdditionalCerts
+}
+
+// SetAdditionalCerts sets value to AdditionalCerts
+func (o *ProxyLBCertificates) SetAdditionalCerts(v []*ProxyLBAdditionalCert) {
+	o.AdditionalCerts = v
+}
+
+// convertTo returns naked ProxyLBCertificates
+func (o *ProxyLBCertificates) convertTo() (*naked.ProxyLBCertificates, error) {
+	dest := &naked.ProxyLBCertificates{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked ProxyLBCertificates
+func (o *ProxyLBCertificates) convertFrom(naked *naked.ProxyLBCertificates) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* ProxyLBPrimaryCert
+*************************************************/
+
+// ProxyLBPrimaryCert represents API parameter/response structure
+type ProxyLBPrimaryCert struct {
+	ServerCertificate       string
+	IntermediateCertificate string
+	PrivateKey              string
+	CertificateEndDate      time.Time
+	CertificateCommonName   string
+}
+
+// Validate validates by field tags
+func (o *ProxyLBPrimaryCert) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetServerCertificate returns value of ServerCertificate
+func (o *ProxyLBPrimaryCert) GetServerCertificate() string {
+	return o.ServerCertificate
+}
+
+// SetServerCertificate sets value to ServerCertificate
+func (o *ProxyLBPrimaryCert) SetServerCertificate(v string) {
+	o.ServerCertificate = v
+}
+
+// GetIntermediateCertificate returns value of IntermediateCertificate
+func (o *ProxyLBPrimaryCert) GetIntermediateCertificate() string {
+	return o.IntermediateCertificate
+}
+
+// SetIntermediateCertificate sets value to IntermediateCertificate
+func (o *ProxyLBPrimaryCert) SetIntermediateCertificate(v string) {
+	o.IntermediateCertificate = v
+}
+
+// GetPrivateKey returns value of PrivateKey
+func (o *ProxyLBPrimaryCert) GetPrivateKey() string {
+	return o.PrivateKey
+}
+
+// SetPrivateKey sets value to PrivateKey
+func (o *ProxyLBPrimaryCert) SetPrivateKey(v string) {
+	o.PrivateKey = v
+}
+
+// GetCertificateEndDate returns value of CertificateEndDate
+func (o *ProxyLBPrimaryCert) GetCertificateEndDate() time.Time {
+	return o.CertificateEndDate
+}
+
+// SetCertificateEndDate sets value to CertificateEndDate
+func (o *ProxyLBPrimaryCert) SetCertificateEndDate(v time.Time) {
+	o.CertificateEndDate = v
+}
+
+// GetCertificateCommonName returns value of CertificateCommonName
+func (o *ProxyLBPrimaryCert) GetCertificateCommonName() string {
+	return o.CertificateCommonName
+}
+
+// SetCertificateCommonName sets value to CertificateCommonName
+func (o *ProxyLBPrimaryCert) SetCertificateCommonName(v string) {
+	o.CertificateCommonName = v
+}
+
+// convertTo returns naked ProxyLBPrimaryCert
+func (o *ProxyLBPrimaryCert) convertTo() (*naked.ProxyLBCertificate, error) {
+	dest := &naked.ProxyLBCertificate{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked ProxyLBPrimaryCert
+func (o *ProxyLBPrimaryCert) convertFrom(naked *naked.ProxyLBCertificate) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* ProxyLBAdditionalCert
+*************************************************/
+
+// ProxyLBAdditionalCert represents API parameter/response structure
+type ProxyLBAdditionalCert struct {
+	ServerCertificate       string
+	IntermediateCertificate string
+	PrivateKey              string
+	CertificateEndDate      time.Time
+	CertificateCommonName   string
+}
+
+// Validate validates by field tags
+func (o *ProxyLBAdditionalCert) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetServerCertificate returns value of ServerCertificate
+func (o *ProxyLBAdditionalCert) GetServerCertificate() string {
+	return o.ServerCertificate
+}
+
+// SetServerCertificate sets value to ServerCertificate
+func (o *ProxyLBAdditionalCert) SetServerCertificate(v string) {
+	o.ServerCertificate = v
+}
+
+// GetIntermediateCertificate returns value of IntermediateCertificate
+func (o *ProxyLBAdditionalCert) GetIntermediateCertificate() string {
+	return o.IntermediateCertificate
+}
+
+// SetIntermediateCertificate sets value to IntermediateCertificate
+func (o *ProxyLBAdditionalCert) SetIntermediateCertificate(v string) {
+	o.IntermediateCertificate = v
+}
+
+// GetPrivateKey returns value of PrivateKey
+func (o *ProxyLBAdditionalCert) GetPrivateKey() string {
+	return o.PrivateKey
+}
+
+// SetPrivateKey sets value to PrivateKey
+func (o *ProxyLBAdditionalCert) SetPrivateKey(v string) {
+	o.PrivateKey = v
+}
+
+// GetCertificateEndDate returns value of CertificateEndDate
+func (o *ProxyLBAdditionalCert) GetCertificateEndDate() time.Time {
+	return o.CertificateEndDate
+}
+
+// SetCertificateEndDate sets value to CertificateEndDate
+func (o *ProxyLBAdditionalCert) SetCertificateEndDate(v time.Time) {
+	o.CertificateEndDate = v
+}
+
+// GetCertificateCommonName returns value of CertificateCommonName
+func (o *ProxyLBAdditionalCert) GetCertificateCommonName() string {
+	return o.CertificateCommonName
+}
+
+// SetCertificateCommonName sets value to CertificateCommonName
+func (o *ProxyLBAdditionalCert) SetCertificateCommonName(v string) {
+	o.CertificateCommonName = v
+}
+
+// convertTo returns naked ProxyLBAdditionalCert
+func (o *ProxyLBAdditionalCert) convertTo() (*naked.ProxyLBCertificate, error) {
+	dest := &naked.ProxyLBCertificate{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked ProxyLBAdditionalCert
+func (o *ProxyLBAdditionalCert) convertFrom(naked *naked.ProxyLBCertificate) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* ProxyLBSetCertificatesRequest
+*************************************************/
+
+// ProxyLBSetCertificatesRequest represents API parameter/response structure
+type ProxyLBSetCertificatesRequest struct {
+	PrimaryCert     *ProxyLBPrimaryCert      `mapconv:",recursive"`
+	AdditionalCerts []*ProxyLBAdditionalCert `mapconv:"[]AdditionalCerts,recursive"`
+}
+
+// Validate validates by field tags
+func (o *ProxyLBSetCertificatesRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetPrimaryCert returns value of PrimaryCert
+func (o *ProxyLBSetCertificatesRequest) GetPrimaryCert() *ProxyLBPrimaryCert {
+	return o.PrimaryCert
+}
+
+// SetPrimaryCert sets value to PrimaryCert
+func (o *ProxyLBSetCertificatesRequest) SetPrimaryCert(v *ProxyLBPrimaryCert) {
+	o.PrimaryCert = v
+}
+
+// GetAdditionalCerts returns value of AdditionalCerts
+func (o *ProxyLBSetCertificatesRequest) GetAdditionalCerts() []*ProxyLBAdditionalCert {
+	return o.AdditionalCerts
+}
+
+// SetAdditionalCerts sets value to AdditionalCerts
+func (o *ProxyLBSetCertificatesRequest) SetAdditionalCerts(v []*ProxyLBAdditionalCert) {
+	o.AdditionalCerts = v
+}
+
+// convertTo returns naked ProxyLBSetCertificatesRequest
+func (o *ProxyLBSetCertificatesRequest) convertTo() (*naked.ProxyLBCertificates, error) {
+	dest := &naked.ProxyLBCertificates{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked ProxyLBSetCertificatesRequest
+func (o *ProxyLBSetCertificatesRequest) convertFrom(naked *naked.ProxyLBCertificates) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* ProxyLBHealth
+*************************************************/
+
+// ProxyLBHealth represents API parameter/response structure
+type ProxyLBHealth struct {
+	ActiveConn int
+	CPS        float64
+	CurrentVIP string
+	Servers    []*ProxyLBHealthServer `mapconv:"[]Servers,recursive"`
+}
+
+// Validate validates by field tags
+func (o *ProxyLBHealth) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetActiveConn returns value of ActiveConn
+func (o *ProxyLBHealth) GetActiveConn() int {
+	return o.ActiveConn
+}
+
+// SetActiveConn sets value to ActiveConn
+func (o *ProxyLBHealth) SetActiveConn(v int) {
+	o.ActiveConn = v
+}
+
+// GetCPS returns value of CPS
+func (o *ProxyLBHealth) GetCPS() float64 {
+	return o.CPS
+}
+
+// SetCPS sets value to CPS
+func (o *ProxyLBHealth) SetCPS(v float64) {
+	o.CPS = v
+}
+
+// GetCurrentVIP returns value of CurrentVIP
+func (o *ProxyLBHealth) GetCurrentVIP() string {
+	return o.CurrentVIP
+}
+
+// SetCurrentVIP sets value to CurrentVIP
+func (o *ProxyLBHealth) SetCurrentVIP(v string) {
+	o.CurrentVIP = v
+}
+
+// GetServers returns value of Servers
+func (o *ProxyLBHealth) GetServers() []*ProxyLBHealthServer {
+	return o.Servers
+}
+
+// SetServers sets value to Servers
+func (o *ProxyLBHealth) SetServers(v []*ProxyLBHealthServer) {
+	o.Servers = v
+}
+
+// convertTo returns naked ProxyLBHealth
+func (o *ProxyLBHealth) convertTo() (*naked.ProxyLBHealth, error) {
+	dest := &naked.ProxyLBHealth{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked ProxyLBHealth
+func (o *ProxyLBHealth) convertFrom(naked *naked.ProxyLBHealth) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* ProxyLBHealthServer
+*************************************************/
+
+// ProxyLBHealthServer represents API parameter/response structure
+type ProxyLBHealthServer struct {
+	ActiveConn int
+	Status     string
+	IPAddress  string
+	Port       types.StringNumber
+	CPS        float64
+}
+
+// Validate validates by field tags
+func (o *ProxyLBHealthServer) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetActiveConn returns value of ActiveConn
+func (o *ProxyLBHealthServer) GetActiveConn() int {
+	return o.ActiveConn
+}
+
+// SetActiveConn sets value to ActiveConn
+func (o *ProxyLBHealthServer) SetActiveConn(v int) {
+	o.ActiveConn = v
+}
+
+// GetStatus returns value of Status
+func (o *ProxyLBHealthServer) GetStatus() string {
+	return o.Status
+}
+
+// SetStatus sets value to Status
+func (o *ProxyLBHealthServer) SetStatus(v string) {
+	o.Status = v
+}
+
+// GetIPAddress returns value of IPAddress
+func (o *ProxyLBHealthServer) GetIPAddress() string {
+	return o.IPAddress
+}
+
+// SetIPAddress sets value to IPAddress
+func (o *ProxyLBHealthServer) SetIPAddress(v string) {
+	o.IPAddress = v
+}
+
+// GetPort returns value of Port
+func (o *ProxyLBHealthServer) GetPort() types.StringNumber {
+	return o.Port
+}
+
+// SetPort sets value to Port
+func (o *ProxyLBHealthServer) SetPort(v types.StringNumber) {
+	o.Port = v
+}
+
+// GetCPS returns value of CPS
+func (o *ProxyLBHealthServer) GetCPS() float64 {
+	return o.CPS
+}
+
+// SetCPS sets value to CPS
+func (o *ProxyLBHealthServer) SetCPS(v float64) {
+	o.CPS = v
+}
+
+// convertTo returns naked ProxyLBHealthServer
+func (o *ProxyLBHealthServer) convertTo() (*naked.ProxyLBHealthServer, error) {
+	dest := &naked.ProxyLBHealthServer{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked ProxyLBHealthServer
+func (o *ProxyLBHealthServer) convertFrom(naked *naked.ProxyLBHealthServer) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* ConnectionActivity
+*************************************************/
+
+// ConnectionActivity represents API parameter/response structure
+type ConnectionActivity struct {
+	Values []*MonitorConnectionValue `mapconv:"[]Connection"`
+}
+
+// Validate validates by field tags
+func (o *ConnectionActivity) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetValues returns value of Values
+func (o *ConnectionActivity) GetValues() []*MonitorConnectionValue {
+	return o.Values
+}
+
+// SetValues sets value to Values
+func (o *ConnectionActivity) SetValues(v []*MonitorConnectionValue) {
+	o.Values = v
+}
+
+// convertTo returns naked ConnectionActivity
+func (o *ConnectionActivity) convertTo() (*naked.MonitorValues, error) {
+	dest := &naked.MonitorValues{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked ConnectionActivity
+func (o *ConnectionActivity) convertFrom(naked *naked.MonitorValues) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* MonitorConnectionValue
+*************************************************/
+
+// MonitorConnectionValue represents API parameter/response structure
+type MonitorConnectionValue struct {
+	Time              time.Time `json:",omitempty" mapconv:",omitempty"`
+	ActiveConnections float64   `json:",omitempty" mapconv:",omitempty"`
+	ConnectionsPerSec float64   `json:",omitempty" mapconv:",omitempty"`
+}
+
+// Validate validates by field tags
+func (o *MonitorConnectionValue) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetTime returns value of Time
+func (o *MonitorConnectionValue) GetTime() time.Time {
+	return o.Time
+}
+
+// SetTime sets value to Time
+func (o *MonitorConnectionValue) SetTime(v time.Time) {
+	o.Time = v
+}
+
+// GetActiveConnections returns value of ActiveConnections
+func (o *MonitorConnectionValue) GetActiveConnections() float64 {
+	return o.ActiveConnections
+}
+
+// SetActiveConnections sets value to ActiveConnections
+func (o *MonitorConnectionValue) SetActiveConnections(v float64) {
+	o.ActiveConnections = v
+}
+
+// GetConnectionsPerSec returns value of ConnectionsPerSec
+func (o *MonitorConnectionValue) GetConnectionsPerSec() float64 {
+	return o.ConnectionsPerSec
+}
+
+// SetConnectionsPerSec sets value to ConnectionsPerSec
+func (o *MonitorConnectionValue) SetConnectionsPerSec(v float64) {
+	o.ConnectionsPerSec = v
+}
+
+// convertTo returns naked MonitorConnectionValue
+func (o *MonitorConnectionValue) convertTo() (*naked.MonitorConnectionValue, error) {
+	dest := &naked.MonitorConnectionValue{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked MonitorConnectionValue
+func (o *MonitorConnectionValue) convertFrom(naked *naked.MonitorConnectionValue) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* Server
+*************************************************/
+
+// Server represents API parameter/response structure
+type Server struct {
+	ID                      types.ID
+	Name                    string `validate:"required"`
+	Description             string `validate:"min=0,max=512"`
+	Tags                    []string
+	Availability            types.EAvailability
+	HostName                string
+	InterfaceDriver         types.EInterfaceDriver      `mapconv:",default=virtio"`
+	ServerPlanID            types.ID                    `mapconv:"ServerPlan.ID"`
+	ServerPlanName          string                      `mapconv:"ServerPlan.Name"`
+	CPU                     int                         `mapconv:"ServerPlan.CPU"`
+	MemoryMB                int                         `mapconv:"ServerPlan.MemoryMB"`
+	ServerPlanCommitment    types.ECommitment           `mapconv:"ServerPlan.Commitment,default=standard"`
+	ServerPlanGeneration    types.EPlanGeneration       `mapconv:"ServerPlan.Generation"`
+	Zone                    *ZoneInfo                   `json:",omitempty" mapconv:",omitempty,recursive"`
+	InstanceHostName        string                      `mapconv:"Instance.Host.Name"`
+	InstanceHostInfoURL     string                      `mapconv:"Instance.Host.InfoURL"`
+	InstanceStatus          types.EServerInstanceStatus `mapconv:"Instance.Status"`
+	InstanceBeforeStatus    types.EServerInstanceStatus `mapconv:"Instance.BeforeStatus"`
+	InstanceStatusChangedAt time.Time                   `mapconv:"Instance.StatusChangedAt"`
+	InstanceWarnings        string                      `mapconv:"Instance.Warnings"`
+	InstanceWarningsValue   int                         `mapconv:"Instance.WarningsValue"`
+	Disks                   []*Disk                     `json:",omitempty" mapconv:",recursive"`
+	Interfaces              []*Interface                `json:",omitempty" mapconv:"[]Interfaces,recursive,omitempty"`
+	CDROMID                 types.ID                    `mapconv:"CDROM.ID"`
+	PrivateHostID           types.ID                    `mapconv:"PrivateHost.ID"`
+	PrivateHostName         string                      `mapconv:"PrivateHost.Name"`
+	BundleInfo              *BundleInfo                 `json:",omitempty" mapconv:",omitempty,recursive"`
+	IconID                  types.ID                    `mapconv:"Icon.ID"`
+	CreatedAt               time.Time
+	ModifiedAt              time.Time
+}
+
+// Validate validates by field tags
+func (o *Server) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *Server) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *Server) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *Server) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *Server) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *Server) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *Server) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetName returns value of Name
+func (o *Server) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *Server) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *Server) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *Server) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *Server) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *Server) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetAvailability returns value of Availability
+func (o *Server) GetAvailability() types.EAvailability {
+	return o.Availability
+}
+
+// SetAvailability sets value to Availability
+func (o *Server) SetAvailability(v types.EAvailability) {
+	o.Availability = v
+}
+
+// GetHostName returns value of HostName
+func (o *Server) GetHostName() string {
+	return o.HostName
+}
+
+// SetHostName sets value to HostName
+func (o *Server) SetHostName(v string) {
+	o.HostName = v
+}
+
+// GetInterfaceDriver returns value of InterfaceDriver
+func (o *Server) GetInterfaceDriver() types.EInterfaceDriver {
+	return o.InterfaceDriver
+}
+
+// SetInterfaceDriver sets value to InterfaceDriver
+func (o *Server) SetInterfaceDriver(v types.EInterfaceDriver) {
+	o.InterfaceDriver = v
+}
+
+// GetServerPlanID returns value of ServerPlanID
+func (o *Server) GetServerPlanID() types.ID {
+	return o.ServerPlanID
+}
+
+// SetServerPlanID sets value to ServerPlanID
+func (o *Server) SetServerPlanID(v types.ID) {
+	o.ServerPlanID = v
+}
+
+// GetServerPlanName returns value of ServerPlanName
+func (o *Server) GetServerPlanName() string {
+	return o.ServerPlanName
+}
+
+// SetServerPlanName sets value to ServerPlanName
+func (o *Server) SetServerPlanName(v string) {
+	o.ServerPlanName = v
+}
+
+// GetCPU returns value of CPU
+func (o *Server) GetCPU() int {
+	return o.CPU
+}
+
+// SetCPU sets value to CPU
+func (o *Server) SetCPU(v int) {
+	o.CPU = v
+}
+
+// GetMemoryMB returns value of MemoryMB
+func (o *Server) GetMemoryMB() int {
+	return o.MemoryMB
+}
+
+// SetMemoryMB sets value to MemoryMB
+func (o *Server) SetMemoryMB(v int) {
+	o.MemoryMB = v
+}
+
+// GetMemoryGB gets value to MemoryGB
+func (o *Server) GetMemoryGB() int {
+	return accessor.GetMemoryGB(o)
+}
+
+// SetMemoryGB sets value to MemoryGB
+func (o *Server) SetMemoryGB(v int) {
+	accessor.SetMemoryGB(o, v)
+}
+
+// GetServerPlanCommitment returns value of ServerPlanCommitment
+func (o *Server) GetServerPlanCommitment() types.ECommitment {
+	return o.ServerPlanCommitment
+}
+
+// SetServerPlanCommitment sets value to ServerPlanCommitment
+func (o *Server) SetServerPlanCommitment(v types.ECommitment) {
+	o.ServerPlanCommitment = v
+}
+
+// GetServerPlanGeneration returns value of ServerPlanGeneration
+func (o *Server) GetServerPlanGeneration() types.EPlanGeneration {
+	return o.ServerPlanGeneration
+}
+
+// SetServerPlanGeneration sets value to ServerPlanGeneration
+func (o *Server) SetServerPlanGeneration(v types.EPlanGeneration) {
+	o.ServerPlanGeneration = v
+}
+
+// GetZone returns value of Zone
+func (o *Server) GetZone() *ZoneInfo {
+	return o.Zone
+}
+
+// SetZone sets value to Zone
+func (o *Server) SetZone(v *ZoneInfo) {
+	o.Zone = v
+}
+
+// GetInstanceHostName returns value of InstanceHostName
+func (o *Server) GetInstanceHostName() string {
+	return o.InstanceHostName
+}
+
+// SetInstanceHostName sets value to InstanceHostName
+func (o *Server) SetInstanceHostName(v string) {
+	o.InstanceHostName = v
+}
+
+// GetInstanceHostInfoURL returns value of InstanceHostInfoURL
+func (o *Server) GetInstanceHostInfoURL() string {
+	return o.InstanceHostInfoURL
+}
+
+// SetInstanceHostInfoURL sets value to InstanceHostInfoURL
+func (o *Server) SetInstanceHostInfoURL(v string) {
+	o.InstanceHostInfoURL = v
+}
+
+// GetInstanceStatus returns value of InstanceStatus
+func (o *Server) GetInstanceStatus() types.EServerInstanceStatus {
+	return o.InstanceStatus
+}
+
+// SetInstanceStatus sets value to InstanceStatus
+func (o *Server) SetInstanceStatus(v types.EServerInstanceStatus) {
+	o.InstanceStatus = v
+}
+
+// GetInstanceBeforeStatus returns value of InstanceBeforeStatus
+func (o *Server) GetInstanceBeforeStatus() types.EServerInstanceStatus {
+	return o.InstanceBeforeStatus
+}
+
+// SetInstanceBeforeStatus sets value to InstanceBeforeStatus
+func (o *Server) SetInstanceBeforeStatus(v types.EServerInstanceStatus) {
+	o.InstanceBeforeStatus = v
+}
+
+// GetInstanceStatusChangedAt returns value of InstanceStatusChangedAt
+func (o *Server) GetInstanceStatusChangedAt() time.Time {
+	return o.InstanceStatusChangedAt
+}
+
+// SetInstanceStatusChangedAt sets value to InstanceStatusChangedAt
+func (o *Server) SetInstanceStatusChangedAt(v time.Time) {
+	o.InstanceStatusChangedAt = v
+}
+
+// GetInstanceWarnings returns value of InstanceWarnings
+func (o *Server) GetInstanceWarnings() string {
+	return o.InstanceWarnings
+}
+
+// SetInstanceWarnings sets value to InstanceWarnings
+func (o *Server) SetInstanceWarnings(v string) {
+	o.InstanceWarnings = v
+}
+
+// GetInstanceWarningsValue returns value of InstanceWarningsValue
+func (o *Server) GetInstanceWarningsValue() int {
+	return o.InstanceWarningsValue
+}
+
+// SetInstanceWarningsValue sets value to InstanceWarningsValue
+func (o *Server) SetInstanceWarningsValue(v int) {
+	o.InstanceWarningsValue = v
+}
+
+// GetDisks returns value of Disks
+func (o *Server) GetDisks() []*Disk {
+	return o.Disks
+}
+
+// SetDisks sets value to Disks
+func (o *Server) SetDisks(v []*Disk) {
+	o.Disks = v
+}
+
+// GetInterfaces returns value of Interfaces
+func (o *Server) GetInterfaces() []*Interface {
+	return o.Interfaces
+}
+
+// SetInterfaces sets value to Interfaces
+func (o *Server) SetInterfaces(v []*Interface) {
+	o.Interfaces = v
+}
+
+// GetCDROMID returns value of CDROMID
+func (o *Server) GetCDROMID() types.ID {
+	return o.CDROMID
+}
+
+// SetCDROMID sets value to CDROMID
+func (o *Server) SetCDROMID(v types.ID) {
+	o.CDROMID = v
+}
+
+// GetPrivateHostID returns value of PrivateHostID
+func (o *Server) GetPrivateHostID() types.ID {
+	return o.PrivateHostID
+}
+
+// SetPrivateHostID sets value to PrivateHostID
+func (o *Server) SetPrivateHostID(v types.ID) {
+	o.PrivateHostID = v
+}
+
+// GetPrivateHostName returns value of PrivateHostName
+func (o *Server) GetPrivateHostName() string {
+	return o.PrivateHostName
+}
+
+// SetPrivateHostName sets value to PrivateHostName
+func (o *Server) SetPrivateHostName(v string) {
+	o.PrivateHostName = v
+}
+
+// GetBundleInfo returns value of BundleInfo
+func (o *Server) GetBundleInfo() *BundleInfo {
+	return o.BundleInfo
+}
+
+// SetBundleInfo sets value to BundleInfo
+func (o *Server) SetBundleInfo(v *BundleInfo) {
+	o.BundleInfo = v
+}
+
+// GetIconID returns value of IconID
+func (o *Server) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *Server) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// GetCreatedAt returns value of CreatedAt
+func (o *Server) GetCreatedAt() time.Time {
+	return o.CreatedAt
+}
+
+// SetCreatedAt sets value to CreatedAt
+func (o *Server) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
 }
 
 // GetModifiedAt returns value of ModifiedAt
-func (o *NFS) GetModifiedAt() time.Time {
+func (o *Server) GetModifiedAt() time.Time {
 	return o.ModifiedAt
 }
 
-// SetModifiedAt sets value to ModifiedAt
-func (o *NFS) SetModifiedAt(v time.Time) {
-	o.ModifiedAt = v
+// SetModifiedAt sets value to ModifiedAt
+func (o *Server) SetModifiedAt(v time.Time) {
+	o.ModifiedAt = v
+}
+
+// convertTo returns naked Server
+func (o *Server) convertTo() (*naked.Server, error) {
+	dest := &naked.Server{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked Server
+func (o *Server) convertFrom(naked *naked.Server) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* ZoneInfo
+*************************************************/
+
+// ZoneInfo represents API parameter/response structure
+type ZoneInfo struct {
+	ID          types.ID
+	Name        string         `validate:"required"`
+	DisplayName string         `json:",omitempty" mapconv:"Description,omitempty"`
+	IsDummy     bool           `json:",omitempty" mapconv:",omitempty"`
+	VNCProxy    *VNCProxy      `json:",omitempty" mapconv:",omitempty,recursive"`
+	FTPServer   *FTPServerInfo `json:",omitempty" mapconv:",omitempty,recursive"`
+	Region      *Region        `json:",omitempty" mapconv:",omitempty,recursive"`
+}
+
+// Validate validates by field tags
+func (o *ZoneInfo) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetID returns value of ID
+func (o *ZoneInfo) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *ZoneInfo) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *ZoneInfo) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *ZoneInfo) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *ZoneInfo) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *ZoneInfo) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// GetName returns value of Name
+func (o *ZoneInfo) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *ZoneInfo) SetName(v string) {
+	o.Name = v
+}
+
+// GetDisplayName returns value of DisplayName
+func (o *ZoneInfo) GetDisplayName() string {
+	return o.DisplayName
+}
+
+// SetDisplayName sets value to DisplayName
+func (o *ZoneInfo) SetDisplayName(v string) {
+	o.DisplayName = v
+}
+
+// GetIsDummy returns value of IsDummy
+func (o *ZoneInfo) GetIsDummy() bool {
+	return o.IsDummy
+}
+
+// SetIsDummy sets value to IsDummy
+func (o *ZoneInfo) SetIsDummy(v bool) {
+	o.IsDummy = v
+}
+
+// GetVNCProxy returns value of VNCProxy
+func (o *ZoneInfo) GetVNCProxy() *VNCProxy {
+	return o.VNCProxy
+}
+
+// SetVNCProxy sets value to VNCProxy
+func (o *ZoneInfo) SetVNCProxy(v *VNCProxy) {
+	o.VNCProxy = v
+}
+
+// GetFTPServer returns value of FTPServer
+func (o *ZoneInfo) GetFTPServer() *FTPServerInfo {
+	return o.FTPServer
+}
+
+// SetFTPServer sets value to FTPServer
+func (o *ZoneInfo) SetFTPServer(v *FTPServerInfo) {
+	o.FTPServer = v
+}
+
+// GetRegion returns value of Region
+func (o *ZoneInfo) GetRegion() *Region {
+	return o.Region
+}
+
+// SetRegion sets value to Region
+func (o *ZoneInfo) SetRegion(v *Region) {
+	o.Region = v
+}
+
+// convertTo returns naked ZoneInfo
+func (o *ZoneInfo) convertTo() (*naked.Zone, error) {
+	dest := &naked.Zone{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked ZoneInfo
+func (o *ZoneInfo) convertFrom(naked *naked.Zone) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* VNCProxy
+*************************************************/
+
+// VNCProxy represents API parameter/response structure
+type VNCProxy struct {
+	HostName  string `json:",omitempty" mapconv:",omitempty"`
+	IPAddress string `json:",omitempty" mapconv:",omitempty"`
+}
+
+// Validate validates by field tags
+func (o *VNCProxy) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetHostName returns value of HostName
+func (o *VNCProxy) GetHostName() string {
+	return o.HostName
+}
+
+// SetHostName sets value to HostName
+func (o *VNCProxy) SetHostName(v string) {
+	o.HostName = v
+}
+
+// GetIPAddress returns value of IPAddress
+func (o *VNCProxy) GetIPAddress() string {
+	return o.IPAddress
+}
+
+// SetIPAddress sets value to IPAddress
+func (o *VNCProxy) SetIPAddress(v string) {
+	o.IPAddress = v
 }
 
-// convertTo returns naked NFS
-func (o *NFS) convertTo() (*naked.NFS, error) {
-	dest := &naked.NFS{}
+// convertTo returns naked VNCProxy
+func (o *VNCProxy) convertTo() (*naked.VNCProxy, error) {
+	dest := &naked.VNCProxy{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked NFS
-func (o *NFS) convertFrom(naked *naked.NFS) error {
+// convertFrom parse values from naked VNCProxy
+func (o *VNCProxy) convertFrom(naked *naked.VNCProxy) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* NFSCreateRequest
+* FTPServerInfo
 *************************************************/
 
-// NFSCreateRequest represents API parameter/response structure
-type NFSCreateRequest struct {
-	Class          string   `mapconv:",default=nfs"`
-	SwitchID       types.ID `mapconv:"Remark.Switch.ID"`
-	PlanID         types.ID `mapconv:"Remark.Plan.ID/Plan.ID"`
-	IPAddresses    []string `mapconv:"Remark.[]Servers.IPAddress" validate:"min=1,max=2,dive,ipv4"`
-	NetworkMaskLen int      `mapconv:"Remark.Network.NetworkMaskLen" validate:"min=1,max=32"`
-	DefaultRoute   string   `mapconv:"Remark.Network.DefaultRoute" validate:"ipv4"`
-	Name           string   `validate:"required"`
-	Description    string   `validate:"min=0,max=512"`
-	Tags           []string
-	IconID         types.ID `mapconv:"Icon.ID"`
+// FTPServerInfo represents API parameter/response structure
+type FTPServerInfo struct {
+	HostName  string
+	IPAddress string
 }
 
 // Validate validates by field tags
-func (o *NFSCreateRequest) Validate() error {
+func (o *FTPServerInfo) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetClass returns value of Class
-func (o *NFSCreateRequest) GetClass() string {
-	return o.Class
+// GetHostName returns value of HostName
+func (o *FTPServerInfo) GetHostName() string {
+	return o.HostName
 }
 
-// SetClass sets value to Class
-func (o *NFSCreateRequest) SetClass(v string) {
-	o.Class = v
+// SetHostName sets value to HostName
+func (o *FTPServerInfo) SetHostName(v string) {
+	o.HostName = v
 }
 
-// GetSwitchID returns value of SwitchID
-func (o *NFSCreateRequest) GetSwitchID() types.ID {
-	return o.SwitchID
+// GetIPAddress returns value of IPAddress
+func (o *FTPServerInfo) GetIPAddress() string {
+	return o.IPAddress
 }
 
-// SetSwitchID sets value to SwitchID
-func (o *NFSCreateRequest) SetSwitchID(v types.ID) {
-	o.SwitchID = v
+// SetIPAddress sets value to IPAddress
+func (o *FTPServerInfo) SetIPAddress(v string) {
+	o.IPAddress = v
 }
 
-// GetPlanID returns value of PlanID
-func (o *NFSCreateRequest) GetPlanID() types.ID {
-	return o.PlanID
+// convertTo returns naked FTPServerInfo
+func (o *FTPServerInfo) convertTo() (*naked.FTPServer, error) {
+	dest := &naked.FTPServer{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetPlanID sets value to PlanID
-func (o *NFSCreateRequest) SetPlanID(v types.ID) {
-	o.PlanID = v
+// convertFrom parse values from naked FTPServerInfo
+func (o *FTPServerInfo) convertFrom(naked *naked.FTPServer) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// GetIPAddresses returns value of IPAddresses
-func (o *NFSCreateRequest) GetIPAddresses() []string {
-	return o.IPAddresses
+/*************************************************
+* ServerCreateRequest
+*************************************************/
+
+// ServerCreateRequest represents API parameter/response structure
+type ServerCreateRequest struct {
+	CPU                  int                    `mapconv:"ServerPlan.CPU"`
+	MemoryMB             int                    `mapconv:"ServerPlan.MemoryMB"`
+	ServerPlanCommitment types.ECommitment      `mapconv:"ServerPlan.Commitment,default=standard"`
+	ServerPlanGeneration types.EPlanGeneration  `mapconv:"ServerPlan.Generation"`
+	ConnectedSwitches    []*ConnectedSwitch     `json:",omitempty" mapconv:"[]ConnectedSwitches,recursive"`
+	InterfaceDriver      types.EInterfaceDriver `mapconv:",default=virtio"`
+	HostName             string
+	Name                 string `validate:"required"`
+	Description          string `validate:"min=0,max=512"`
+	Tags                 []string
+	IconID               types.ID `mapconv:"Icon.ID"`
+	PrivateHostID        types.ID `mapconv:"PrivateHost.ID"`
+	WaitDiskMigration    bool     `json:",omitempty" mapconv:",omitempty"`
 }
 
-// SetIPAddresses sets value to IPAddresses
-func (o *NFSCreateRequest) SetIPAddresses(v []string) {
-	o.IPAddresses = v
+// Validate validates by field tags
+func (o *ServerCreateRequest) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetNetworkMaskLen returns value of NetworkMaskLen
-func (o *NFSCreateRequest) GetNetworkMaskLen() int {
-	return o.NetworkMaskLen
+// GetCPU returns value of CPU
+func (o *ServerCreateRequest) GetCPU() int {
+	return o.CPU
 }
 
-// SetNetworkMaskLen sets value to NetworkMaskLen
-func (o *NFSCreateRequest) SetNetworkMaskLen(v int) {
-	o.NetworkMaskLen = v
+// SetCPU sets value to CPU
+func (o *ServerCreateRequest) SetCPU(v int) {
+	o.CPU = v
+}
+
+// GetMemoryMB returns value of MemoryMB
+func (o *ServerCreateRequest) GetMemoryMB() int {
+	return o.MemoryMB
+}
+
+// SetMemoryMB sets value to MemoryMB
+func (o *ServerCreateRequest) SetMemoryMB(v int) {
+	o.MemoryMB = v
+}
+
+// GetMemoryGB gets value to MemoryGB
+func (o *ServerCreateRequest) GetMemoryGB() int {
+	return accessor.GetMemoryGB(o)
+}
+
+// SetMemoryGB sets value to MemoryGB
+func (o *ServerCreateRequest) SetMemoryGB(v int) {
+	accessor.SetMemoryGB(o, v)
+}
+
+// GetServerPlanCommitment returns value of ServerPlanCommitment
+func (o *ServerCreateRequest) GetServerPlanCommitment() types.ECommitment {
+	return o.ServerPlanCommitment
+}
+
+// SetServerPlanCommitment sets value to ServerPlanCommitment
+func (o *ServerCreateRequest) SetServerPlanCommitment(v types.ECommitment) {
+	o.ServerPlanCommitment = v
+}
+
+// GetServerPlanGeneration returns value of ServerPlanGeneration
+func (o *ServerCreateRequest) GetServerPlanGeneration() types.EPlanGeneration {
+	return o.ServerPlanGeneration
+}
+
+// SetServerPlanGeneration sets value to ServerPlanGeneration
+func (o *ServerCreateRequest) SetServerPlanGeneration(v types.EPlanGeneration) {
+	o.ServerPlanGeneration = v
+}
+
+// GetConnectedSwitches returns value of ConnectedSwitches
+func (o *ServerCreateRequest) GetConnectedSwitches() []*ConnectedSwitch {
+	return o.ConnectedSwitches
+}
+
+// SetConnectedSwitches sets value to ConnectedSwitches
+func (o *ServerCreateRequest) SetConnectedSwitches(v []*ConnectedSwitch) {
+	o.ConnectedSwitches = v
+}
+
+// GetInterfaceDriver returns value of InterfaceDriver
+func (o *ServerCreateRequest) GetInterfaceDriver() types.EInterfaceDriver {
+	return o.InterfaceDriver
+}
+
+// SetInterfaceDriver sets value to InterfaceDriver
+func (o *ServerCreateRequest) SetInterfaceDriver(v types.EInterfaceDriver) {
+	o.InterfaceDriver = v
+}
+
+// GetHostName returns value of HostName
+func (o *ServerCreateRequest) GetHostName() string {
+	return o.HostName
+}
+
+// SetHostName sets value to HostName
+func (o *ServerCreateRequest) SetHostName(v string) {
+	o.HostName = v
+}
+
+// GetName returns value of Name
+func (o *ServerCreateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *ServerCreateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *ServerCreateRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *ServerCreateRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetTags returns value of Tags
+func (o *ServerCreateRequest) GetTags() []string {
+	return o.Tags
+}
+
+// SetTags sets value to Tags
+func (o *ServerCreateRequest) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetIconID returns value of IconID
+func (o *ServerCreateRequest) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *ServerCreateRequest) SetIconID(v types.ID) {
+	o.IconID = v
 }
 
-// GetDefaultRoute returns value of DefaultRoute
-func (o *NFSCreateRequest) GetDefaultRoute() string {
-	return o.DefaultRoute
+// GetPrivateHostID returns value of PrivateHostID
+func (o *ServerCreateRequest) GetPrivateHostID() types.ID {
+	return o.PrivateHostID
+}
+
+// SetPrivateHostID sets value to PrivateHostID
+func (o *ServerCreateRequest) SetPrivateHostID(v types.ID) {
+	o.PrivateHostID = v
+}
+
+// GetWaitDiskMigration returns value of WaitDiskMigration
+func (o *ServerCreateRequest) GetWaitDiskMigration() bool {
+	return o.WaitDiskMigration
+}
+
+// SetWaitDiskMigration sets value to WaitDiskMigration
+func (o *ServerCreateRequest) SetWaitDiskMigration(v bool) {
+	o.WaitDiskMigration = v
+}
+
+// convertTo returns naked ServerCreateRequest
+func (o *ServerCreateRequest) convertTo() (*naked.Server, error) {
+	dest := &naked.Server{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
+}
+
+// convertFrom parse values from naked ServerCreateRequest
+func (o *ServerCreateRequest) convertFrom(naked *naked.Server) error {
+	return mapconv.ConvertFrom(naked, o)
+}
+
+/*************************************************
+* ConnectedSwitch
+*************************************************/
+
+// ConnectedSwitch represents API parameter/response structure
+type ConnectedSwitch struct {
+	ID    types.ID
+	Scope types.EScope
 }
 
-// SetDefaultRoute sets value to DefaultRoute
-func (o *NFSCreateRequest) SetDefaultRoute(v string) {
-	o.DefaultRoute = v
+// Validate validates by field tags
+func (o *ConnectedSwitch) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetName returns value of Name
-func (o *NFSCreateRequest) GetName() string {
-	return o.Name
+// GetID returns value of ID
+func (o *ConnectedSwitch) GetID() types.ID {
+	return o.ID
 }
 
-// SetName sets value to Name
-func (o *NFSCreateRequest) SetName(v string) {
-	o.Name = v
+// SetID sets value to ID
+func (o *ConnectedSwitch) SetID(v types.ID) {
+	o.ID = v
 }
 
-// GetDescription returns value of Description
-func (o *NFSCreateRequest) GetDescription() string {
-	return o.Description
+// GetStringID gets value to StringID
+func (o *ConnectedSwitch) GetStringID() string {
+	return accessor.GetStringID(o)
 }
 
-// SetDescription sets value to Description
-func (o *NFSCreateRequest) SetDescription(v string) {
-	o.Description = v
+// SetStringID sets value to StringID
+func (o *ConnectedSwitch) SetStringID(v string) {
+	accessor.SetStringID(o, v)
 }
 
-// GetTags returns value of Tags
-func (o *NFSCreateRequest) GetTags() []string {
-	return o.Tags
+// GetInt64ID gets value to Int64ID
+func (o *ConnectedSwitch) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
 }
 
-// SetTags sets value to Tags
-func (o *NFSCreateRequest) SetTags(v []string) {
-	o.Tags = v
+// SetInt64ID sets value to Int64ID
+func (o *ConnectedSwitch) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
 }
 
-// GetIconID returns value of IconID
-func (o *NFSCreateRequest) GetIconID() types.ID {
-	return o.IconID
+// GetScope returns value of Scope
+func (o *ConnectedSwitch) GetScope() types.EScope {
+	return o.Scope
 }
 
-// SetIconID sets value to IconID
-func (o *NFSCreateRequest) SetIconID(v types.ID) {
-	o.IconID = v
+// SetScope sets value to Scope
+func (o *ConnectedSwitch) SetScope(v types.EScope) {
+	o.Scope = v
 }
 
-// convertTo returns naked NFSCreateRequest
-func (o *NFSCreateRequest) convertTo() (*naked.NFS, error) {
-	dest := &naked.NFS{}
+// convertTo returns naked ConnectedSwitch
+func (o *ConnectedSwitch) convertTo() (*naked.ConnectedSwitch, error) {
+	dest := &naked.ConnectedSwitch{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked NFSCreateRequest
-func (o *NFSCreateRequest) convertFrom(naked *naked.NFS) error {
+// convertFrom parse values from naked ConnectedSwitch
+func (o *ConnectedSwitch) convertFrom(naked *naked.ConnectedSwitch) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* NFSUpdateRequest
+* ServerUpdateRequest
 *************************************************/
 
-// NFSUpdateRequest represents API parameter/response structure
-type NFSUpdateRequest struct {
+// ServerUpdateRequest represents API parameter/response structure
+type ServerUpdateRequest struct {
 	Name        string `validate:"required"`
 	Description string `validate:"min=0,max=512"`
 	Tags        []string
@@ -5871,2630 +15384,2899 @@ type NFSUpdateRequest struct {
 }
 
 // Validate validates by field tags
-func (o *NFSUpdateRequest) Validate() error {
+func (o *ServerUpdateRequest) Validate() error {
 	return validator.New().Struct(o)
 }
 
 // GetName returns value of Name
-func (o *NFSUpdateRequest) GetName() string {
+func (o *ServerUpdateRequest) GetName() string {
 	return o.Name
 }
 
 // SetName sets value to Name
-func (o *NFSUpdateRequest) SetName(v string) {
+func (o *ServerUpdateRequest) SetName(v string) {
 	o.Name = v
 }
 
 // GetDescription returns value of Description
-func (o *NFSUpdateRequest) GetDescription() string {
+func (o *ServerUpdateRequest) GetDescription() string {
 	return o.Description
 }
 
 // SetDescription sets value to Description
-func (o *NFSUpdateRequest) SetDescription(v string) {
+func (o *ServerUpdateRequest) SetDescription(v string) {
 	o.Description = v
 }
 
 // GetTags returns value of Tags
-func (o *NFSUpdateRequest) GetTags() []string {
+func (o *ServerUpdateRequest) GetTags() []string {
 	return o.Tags
 }
 
 // SetTags sets value to Tags
-func (o *NFSUpdateRequest) SetTags(v []string) {
+func (o *ServerUpdateRequest) SetTags(v []string) {
 	o.Tags = v
 }
 
 // GetIconID returns value of IconID
-func (o *NFSUpdateRequest) GetIconID() types.ID {
+func (o *ServerUpdateRequest) GetIconID() types.ID {
 	return o.IconID
 }
 
 // SetIconID sets value to IconID
-func (o *NFSUpdateRequest) SetIconID(v types.ID) {
+func (o *ServerUpdateRequest) SetIconID(v types.ID) {
 	o.IconID = v
 }
 
-// convertTo returns naked NFSUpdateRequest
-func (o *NFSUpdateRequest) convertTo() (*naked.NFS, error) {
-	dest := &naked.NFS{}
+// convertTo returns naked ServerUpdateRequest
+func (o *ServerUpdateRequest) convertTo() (*naked.Server, error) {
+	dest := &naked.Server{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked NFSUpdateRequest
-func (o *NFSUpdateRequest) convertFrom(naked *naked.NFS) error {
+// convertFrom parse values from naked ServerUpdateRequest
+func (o *ServerUpdateRequest) convertFrom(naked *naked.Server) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* FreeDiskSizeActivity
+* ServerChangePlanRequest
 *************************************************/
 
-// FreeDiskSizeActivity represents API parameter/response structure
-type FreeDiskSizeActivity struct {
-	Values []*MonitorFreeDiskSizeValue `mapconv:"[]FreeDiskSize"`
+// ServerChangePlanRequest represents API parameter/response structure
+type ServerChangePlanRequest struct {
+	CPU                  int
+	MemoryMB             int
+	ServerPlanGeneration types.EPlanGeneration `mapconv:"Generation"`
+	ServerPlanCommitment types.ECommitment     `mapconv:"Commitment,default=standard"`
 }
 
 // Validate validates by field tags
-func (o *FreeDiskSizeActivity) Validate() error {
+func (o *ServerChangePlanRequest) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetValues returns value of Values
-func (o *FreeDiskSizeActivity) GetValues() []*MonitorFreeDiskSizeValue {
-	return o.Values
+// GetCPU returns value of CPU
+func (o *ServerChangePlanRequest) GetCPU() int {
+	return o.CPU
 }
 
-// SetValues sets value to Values
-func (o *FreeDiskSizeActivity) SetValues(v []*MonitorFreeDiskSizeValue) {
-	o.Values = v
+// SetCPU sets value to CPU
+func (o *ServerChangePlanRequest) SetCPU(v int) {
+	o.CPU = v
 }
 
-// convertTo returns naked FreeDiskSizeActivity
-func (o *FreeDiskSizeActivity) convertTo() (*naked.MonitorValues, error) {
-	dest := &naked.MonitorValues{}
+// GetMemoryMB returns value of MemoryMB
+func (o *ServerChangePlanRequest) GetMemoryMB() int {
+	return o.MemoryMB
+}
+
+// SetMemoryMB sets value to MemoryMB
+func (o *ServerChangePlanRequest) SetMemoryMB(v int) {
+	o.MemoryMB = v
+}
+
+// GetMemoryGB gets value to MemoryGB
+func (o *ServerChangePlanRequest) GetMemoryGB() int {
+	return accessor.GetMemoryGB(o)
+}
+
+// SetMemoryGB sets value to MemoryGB
+func (o *ServerChangePlanRequest) SetMemoryGB(v int) {
+	accessor.SetMemoryGB(o, v)
+}
+
+// GetServerPlanGeneration returns value of ServerPlanGeneration
+func (o *ServerChangePlanRequest) GetServerPlanGeneration() types.EPlanGeneration {
+	return o.ServerPlanGeneration
+}
+
+// SetServerPlanGeneration sets value to ServerPlanGeneration
+func (o *ServerChangePlanRequest) SetServerPlanGeneration(v types.EPlanGeneration) {
+	o.ServerPlanGeneration = v
+}
+
+// GetServerPlanCommitment returns value of ServerPlanCommitment
+func (o *ServerChangePlanRequest) GetServerPlanCommitment() types.ECommitment {
+	return o.ServerPlanCommitment
+}
+
+// SetServerPlanCommitment sets value to ServerPlanCommitment
+func (o *ServerChangePlanRequest) SetServerPlanCommitment(v types.ECommitment) {
+	o.ServerPlanCommitment = v
+}
+
+// convertTo returns naked ServerChangePlanRequest
+func (o *ServerChangePlanRequest) convertTo() (*naked.ServerPlan, error) {
+	dest := &naked.ServerPlan{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked FreeDiskSizeActivity
-func (o *FreeDiskSizeActivity) convertFrom(naked *naked.MonitorValues) error {
+// convertFrom parse values from naked ServerChangePlanRequest
+func (o *ServerChangePlanRequest) convertFrom(naked *naked.ServerPlan) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* MonitorFreeDiskSizeValue
+* InsertCDROMRequest
 *************************************************/
 
-// MonitorFreeDiskSizeValue represents API parameter/response structure
-type MonitorFreeDiskSizeValue struct {
-	Time         time.Time `json:",omitempty" mapconv:",omitempty"`
-	FreeDiskSize float64   `json:",omitempty" mapconv:",omitempty"`
+// InsertCDROMRequest represents API parameter/response structure
+type InsertCDROMRequest struct {
+	ID types.ID
 }
 
 // Validate validates by field tags
-func (o *MonitorFreeDiskSizeValue) Validate() error {
+func (o *InsertCDROMRequest) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetTime returns value of Time
-func (o *MonitorFreeDiskSizeValue) GetTime() time.Time {
-	return o.Time
+// GetID returns value of ID
+func (o *InsertCDROMRequest) GetID() types.ID {
+	return o.ID
 }
 
-// SetTime sets value to Time
-func (o *MonitorFreeDiskSizeValue) SetTime(v time.Time) {
-	o.Time = v
+// SetID sets value to ID
+func (o *InsertCDROMRequest) SetID(v types.ID) {
+	o.ID = v
 }
 
-// GetFreeDiskSize returns value of FreeDiskSize
-func (o *MonitorFreeDiskSizeValue) GetFreeDiskSize() float64 {
-	return o.FreeDiskSize
+// GetStringID gets value to StringID
+func (o *InsertCDROMRequest) GetStringID() string {
+	return accessor.GetStringID(o)
 }
 
-// SetFreeDiskSize sets value to FreeDiskSize
-func (o *MonitorFreeDiskSizeValue) SetFreeDiskSize(v float64) {
-	o.FreeDiskSize = v
+// SetStringID sets value to StringID
+func (o *InsertCDROMRequest) SetStringID(v string) {
+	accessor.SetStringID(o, v)
 }
 
-// convertTo returns naked MonitorFreeDiskSizeValue
-func (o *MonitorFreeDiskSizeValue) convertTo() (*naked.MonitorFreeDiskSizeValue, error) {
-	dest := &naked.MonitorFreeDiskSizeValue{}
+// GetInt64ID gets value to Int64ID
+func (o *InsertCDROMRequest) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *InsertCDROMRequest) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
+// convertTo returns naked InsertCDROMRequest
+func (o *InsertCDROMRequest) convertTo() (*naked.CDROM, error) {
+	dest := &naked.CDROM{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked MonitorFreeDiskSizeValue
-func (o *MonitorFreeDiskSizeValue) convertFrom(naked *naked.MonitorFreeDiskSizeValue) error {
+// convertFrom parse values from naked InsertCDROMRequest
+func (o *InsertCDROMRequest) convertFrom(naked *naked.CDROM) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* Note
+* EjectCDROMRequest
 *************************************************/
 
-// Note represents API parameter/response structure
-type Note struct {
-	ID           types.ID
-	Name         string `validate:"required"`
-	Description  string `validate:"min=0,max=512"`
-	Tags         []string
-	Availability types.EAvailability
-	Scope        types.EScope
-	Class        string
-	Content      string
-	IconID       types.ID `mapconv:"Icon.ID"`
-	CreatedAt    time.Time
-	ModifiedAt   time.Time
+// EjectCDROMRequest represents API parameter/response structure
+type EjectCDROMRequest struct {
+	ID types.ID
 }
 
 // Validate validates by field tags
-func (o *Note) Validate() error {
+func (o *EjectCDROMRequest) Validate() error {
 	return validator.New().Struct(o)
 }
 
 // GetID returns value of ID
-func (o *Note) GetID() types.ID {
+func (o *EjectCDROMRequest) GetID() types.ID {
 	return o.ID
 }
 
 // SetID sets value to ID
-func (o *Note) SetID(v types.ID) {
+func (o *EjectCDROMRequest) SetID(v types.ID) {
 	o.ID = v
 }
 
 // GetStringID gets value to StringID
-func (o *Note) GetStringID() string {
+func (o *EjectCDROMRequest) GetStringID() string {
 	return accessor.GetStringID(o)
 }
 
 // SetStringID sets value to StringID
-func (o *Note) SetStringID(v string) {
+func (o *EjectCDROMRequest) SetStringID(v string) {
 	accessor.SetStringID(o, v)
 }
 
 // GetInt64ID gets value to Int64ID
-func (o *Note) GetInt64ID() int64 {
+func (o *EjectCDROMRequest) GetInt64ID() int64 {
 	return accessor.GetInt64ID(o)
 }
 
 // SetInt64ID sets value to Int64ID
-func (o *Note) SetInt64ID(v int64) {
+func (o *EjectCDROMRequest) SetInt64ID(v int64) {
 	accessor.SetInt64ID(o, v)
 }
 
-// GetName returns value of Name
-func (o *Note) GetName() string {
-	return o.Name
-}
-
-// SetName sets value to Name
-func (o *Note) SetName(v string) {
-	o.Name = v
-}
-
-// GetDescription returns value of Description
-func (o *Note) GetDescription() string {
-	return o.Description
-}
-
-// SetDescription sets value to Description
-func (o *Note) SetDescription(v string) {
-	o.Description = v
+// convertTo returns naked EjectCDROMRequest
+func (o *EjectCDROMRequest) convertTo() (*naked.CDROM, error) {
+	dest := &naked.CDROM{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// GetTags returns value of Tags
-func (o *Note) GetTags() []string {
-	return o.Tags
+// convertFrom parse values from naked EjectCDROMRequest
+func (o *EjectCDROMRequest) convertFrom(naked *naked.CDROM) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// SetTags sets value to Tags
-func (o *Note) SetTags(v []string) {
-	o.Tags = v
-}
+/*************************************************
+* VNCProxyInfo
+*************************************************/
 
-// GetAvailability returns value of Availability
-func (o *Note) GetAvailability() types.EAvailability {
-	return o.Availability
+// VNCProxyInfo represents API parameter/response structure
+type VNCProxyInfo struct {
+	Status       string
+	Host         string
+	IOServerHost string
+	Port         types.StringNumber
+	Password     string
+	VNCFile      string
 }
 
-// SetAvailability sets value to Availability
-func (o *Note) SetAvailability(v types.EAvailability) {
-	o.Availability = v
+// Validate validates by field tags
+func (o *VNCProxyInfo) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetScope returns value of Scope
-func (o *Note) GetScope() types.EScope {
-	return o.Scope
+// GetStatus returns value of Status
+func (o *VNCProxyInfo) GetStatus() string {
+	return o.Status
 }
 
-// SetScope sets value to Scope
-func (o *Note) SetScope(v types.EScope) {
-	o.Scope = v
+// SetStatus sets value to Status
+func (o *VNCProxyInfo) SetStatus(v string) {
+	o.Status = v
 }
 
-// GetClass returns value of Class
-func (o *Note) GetClass() string {
-	return o.Class
+// GetHost returns value of Host
+func (o *VNCProxyInfo) GetHost() string {
+	return o.Host
 }
 
-// SetClass sets value to Class
-func (o *Note) SetClass(v string) {
-	o.Class = v
+// SetHost sets value to Host
+func (o *VNCProxyInfo) SetHost(v string) {
+	o.Host = v
 }
 
-// GetContent returns value of Content
-func (o *Note) GetContent() string {
-	return o.Content
+// GetIOServerHost returns value of IOServerHost
+func (o *VNCProxyInfo) GetIOServerHost() string {
+	return o.IOServerHost
 }
 
-// SetContent sets value to Content
-func (o *Note) SetContent(v string) {
-	o.Content = v
+// SetIOServerHost sets value to IOServerHost
+func (o *VNCProxyInfo) SetIOServerHost(v string) {
+	o.IOServerHost = v
 }
 
-// GetIconID returns value of IconID
-func (o *Note) GetIconID() types.ID {
-	return o.IconID
+// GetPort returns value of Port
+func (o *VNCProxyInfo) GetPort() types.StringNumber {
+	return o.Port
 }
 
-// SetIconID sets value to IconID
-func (o *Note) SetIconID(v types.ID) {
-	o.IconID = v
+// SetPort sets value to Port
+func (o *VNCProxyInfo) SetPort(v types.StringNumber) {
+	o.Port = v
 }
 
-// GetCreatedAt returns value of CreatedAt
-func (o *Note) GetCreatedAt() time.Time {
-	return o.CreatedAt
+// GetPassword returns value of Password
+func (o *VNCProxyInfo) GetPassword() string {
+	return o.Password
 }
 
-// SetCreatedAt sets value to CreatedAt
-func (o *Note) SetCreatedAt(v time.Time) {
-	o.CreatedAt = v
+// SetPassword sets value to Password
+func (o *VNCProxyInfo) SetPassword(v string) {
+	o.Password = v
 }
 
-// GetModifiedAt returns value of ModifiedAt
-func (o *Note) GetModifiedAt() time.Time {
-	return o.ModifiedAt
+// GetVNCFile returns value of VNCFile
+func (o *VNCProxyInfo) GetVNCFile() string {
+	return o.VNCFile
 }
 
-// SetModifiedAt sets value to ModifiedAt
-func (o *Note) SetModifiedAt(v time.Time) {
-	o.ModifiedAt = v
+// SetVNCFile sets value to VNCFile
+func (o *VNCProxyInfo) SetVNCFile(v string) {
+	o.VNCFile = v
 }
 
-// convertTo returns naked Note
-func (o *Note) convertTo() (*naked.Note, error) {
-	dest := &naked.Note{}
+// convertTo returns naked VNCProxyInfo
+func (o *VNCProxyInfo) convertTo() (*naked.VNCProxyInfo, error) {
+	dest := &naked.VNCProxyInfo{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked Note
-func (o *Note) convertFrom(naked *naked.Note) error {
+// convertFrom parse values from naked VNCProxyInfo
+func (o *VNCProxyInfo) convertFrom(naked *naked.VNCProxyInfo) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* NoteCreateRequest
+* SendKeyRequest
 *************************************************/
 
-// NoteCreateRequest represents API parameter/response structure
-type NoteCreateRequest struct {
-	Name    string `validate:"required"`
-	Tags    []string
-	IconID  types.ID `mapconv:"Icon.ID"`
-	Class   string
-	Content string
+// SendKeyRequest represents API parameter/response structure
+type SendKeyRequest struct {
+	Key  string   `json:",omitempty" mapconv:",omitempty"`
+	Keys []string `json:",omitempty" mapconv:",omitempty"`
 }
 
 // Validate validates by field tags
-func (o *NoteCreateRequest) Validate() error {
+func (o *SendKeyRequest) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetName returns value of Name
-func (o *NoteCreateRequest) GetName() string {
-	return o.Name
-}
-
-// SetName sets value to Name
-func (o *NoteCreateRequest) SetName(v string) {
-	o.Name = v
+// GetKey returns value of Key
+func (o *SendKeyRequest) GetKey() string {
+	return o.Key
 }
 
-// GetTags returns value of Tags
-func (o *NoteCreateRequest) GetTags() []string {
-	return o.Tags
+// SetKey sets value to Key
+func (o *SendKeyRequest) SetKey(v string) {
+	o.Key = v
 }
 
-// SetTags sets value to Tags
-func (o *NoteCreateRequest) SetTags(v []string) {
-	o.Tags = v
+// GetKeys returns value of Keys
+func (o *SendKeyRequest) GetKeys() []string {
+	return o.Keys
 }
 
-// GetIconID returns value of IconID
-func (o *NoteCreateRequest) GetIconID() types.ID {
-	return o.IconID
+// SetKeys sets value to Keys
+func (o *SendKeyRequest) SetKeys(v []string) {
+	o.Keys = v
 }
 
-// SetIconID sets value to IconID
-func (o *NoteCreateRequest) SetIconID(v types.ID) {
-	o.IconID = v
-}
+/*************************************************
+* VNCSnapshotInfo
+*************************************************/
 
-// GetClass returns value of Class
-func (o *NoteCreateRequest) GetClass() string {
-	return o.Class
+// VNCSnapshotInfo represents API parameter/response structure
+type VNCSnapshotInfo struct {
+	Image string
 }
 
-// SetClass sets value to Class
-func (o *NoteCreateRequest) SetClass(v string) {
-	o.Class = v
+// Validate validates by field tags
+func (o *VNCSnapshotInfo) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetContent returns value of Content
-func (o *NoteCreateRequest) GetContent() string {
-	return o.Content
+// GetImage returns value of Image
+func (o *VNCSnapshotInfo) GetImage() string {
+	return o.Image
 }
 
-// SetContent sets value to Content
-func (o *NoteCreateRequest) SetContent(v string) {
-	o.Content = v
+// SetImage sets value to Image
+func (o *VNCSnapshotInfo) SetImage(v string) {
+	o.Image = v
 }
 
-// convertTo returns naked NoteCreateRequest
-func (o *NoteCreateRequest) convertTo() (*naked.Note, error) {
-	dest := &naked.Note{}
+// convertTo returns naked VNCSnapshotInfo
+func (o *VNCSnapshotInfo) convertTo() (*naked.VNCSnapshotInfo, error) {
+	dest := &naked.VNCSnapshotInfo{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked NoteCreateRequest
-func (o *NoteCreateRequest) convertFrom(naked *naked.Note) error {
+// convertFrom parse values from naked VNCSnapshotInfo
+func (o *VNCSnapshotInfo) convertFrom(naked *naked.VNCSnapshotInfo) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* NoteUpdateRequest
+* GetScreenshotRequest
 *************************************************/
 
-// NoteUpdateRequest represents API parameter/response structure
-type NoteUpdateRequest struct {
-	Name    string `validate:"required"`
-	Tags    []string
-	IconID  types.ID `mapconv:"Icon.ID"`
-	Class   string
-	Content string
+// GetScreenshotRequest represents API parameter/response structure
+type GetScreenshotRequest struct {
+	ScreenCaptureFormat string `json:",omitempty" mapconv:",omitempty"`
 }
 
 // Validate validates by field tags
-func (o *NoteUpdateRequest) Validate() error {
+func (o *GetScreenshotRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetScreenCaptureFormat returns value of ScreenCaptureFormat
+func (o *GetScreenshotRequest) GetScreenCaptureFormat() string {
+	return o.ScreenCaptureFormat
+}
+
+// SetScreenCaptureFormat sets value to ScreenCaptureFormat
+func (o *GetScreenshotRequest) SetScreenCaptureFormat(v string) {
+	o.ScreenCaptureFormat = v
+}
+
+/*************************************************
+* ServerPlan
+*************************************************/
+
+// ServerPlan represents API parameter/response structure
+type ServerPlan struct {
+	ID           types.ID
+	Name         string `validate:"required"`
+	CPU          int
+	MemoryMB     int
+	Commitment   types.ECommitment `mapconv:",default=standard"`
+	Generation   types.EPlanGeneration
+	Availability types.EAvailability
+}
+
+// Validate validates by field tags
+func (o *ServerPlan) Validate() error {
 	return validator.New().Struct(o)
 }
 
+// GetID returns value of ID
+func (o *ServerPlan) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *ServerPlan) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *ServerPlan) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *ServerPlan) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *ServerPlan) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *ServerPlan) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
 // GetName returns value of Name
-func (o *NoteUpdateRequest) GetName() string {
+func (o *ServerPlan) GetName() string {
 	return o.Name
 }
 
 // SetName sets value to Name
-func (o *NoteUpdateRequest) SetName(v string) {
+func (o *ServerPlan) SetName(v string) {
 	o.Name = v
 }
 
-// GetTags returns value of Tags
-func (o *NoteUpdateRequest) GetTags() []string {
-	return o.Tags
+// GetCPU returns value of CPU
+func (o *ServerPlan) GetCPU() int {
+	return o.CPU
 }
 
-// SetTags sets value to Tags
-func (o *NoteUpdateRequest) SetTags(v []string) {
-	o.Tags = v
+// SetCPU sets value to CPU
+func (o *ServerPlan) SetCPU(v int) {
+	o.CPU = v
 }
 
-// GetIconID returns value of IconID
-func (o *NoteUpdateRequest) GetIconID() types.ID {
-	return o.IconID
+// GetMemoryMB returns value of MemoryMB
+func (o *ServerPlan) GetMemoryMB() int {
+	return o.MemoryMB
 }
 
-// SetIconID sets value to IconID
-func (o *NoteUpdateRequest) SetIconID(v types.ID) {
-	o.IconID = v
+// SetMemoryMB sets value to MemoryMB
+func (o *ServerPlan) SetMemoryMB(v int) {
+	o.MemoryMB = v
 }
 
-// GetClass returns value of Class
-func (o *NoteUpdateRequest) GetClass() string {
-	return o.Class
+// GetMemoryGB gets value to MemoryGB
+func (o *ServerPlan) GetMemoryGB() int {
+	return accessor.GetMemoryGB(o)
 }
 
-// SetClass sets value to Class
-func (o *NoteUpdateRequest) SetClass(v string) {
-	o.Class = v
+// SetMemoryGB sets value to MemoryGB
+func (o *ServerPlan) SetMemoryGB(v int) {
+	accessor.SetMemoryGB(o, v)
 }
 
-// GetContent returns value of Content
-func (o *NoteUpdateRequest) GetContent() string {
-	return o.Content
+// GetCommitment returns value of Commitment
+func (o *ServerPlan) GetCommitment() types.ECommitment {
+	return o.Commitment
+}
+
+// SetCommitment sets value to Commitment
+func (o *ServerPlan) SetCommitment(v types.ECommitment) {
+	o.Commitment = v
+}
+
+// GetGeneration returns value of Generation
+func (o *ServerPlan) GetGeneration() types.EPlanGeneration {
+	return o.Generation
+}
+
+// SetGeneration sets value to Generation
+func (o *ServerPlan) SetGeneration(v types.EPlanGeneration) {
+	o.Generation = v
+}
+
+// GetAvailability returns value of Availability
+func (o *ServerPlan) GetAvailability() types.EAvailability {
+	return o.Availability
 }
 
-// SetContent sets value to Content
-func (o *NoteUpdateRequest) SetContent(v string) {
-	o.Content = v
+// SetAvailability sets value to Availability
+func (o *ServerPlan) SetAvailability(v types.EAvailability) {
+	o.Availability = v
 }
 
-// convertTo returns naked NoteUpdateRequest
-func (o *NoteUpdateRequest) convertTo() (*naked.Note, error) {
-	dest := &naked.Note{}
+// convertTo returns naked ServerPlan
+func (o *ServerPlan) convertTo() (*naked.ServerPlan, error) {
+	dest := &naked.ServerPlan{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked NoteUpdateRequest
-func (o *NoteUpdateRequest) convertFrom(naked *naked.Note) error {
+// convertFrom parse values from naked ServerPlan
+func (o *ServerPlan) convertFrom(naked *naked.ServerPlan) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* PacketFilter
+* ServiceClass
 *************************************************/
 
-// PacketFilter represents API parameter/response structure
-type PacketFilter struct {
-	ID                  types.ID
-	Name                string `validate:"required"`
-	Description         string `validate:"min=0,max=512"`
-	RequiredHostVersion types.StringNumber
-	Expression          []*PacketFilterExpression `mapconv:"[]Expression,recursive"`
-	ExpressionHash      string
-	CreatedAt           time.Time
+// ServiceClass represents API parameter/response structure
+type ServiceClass struct {
+	ID               types.ID
+	ServiceClassName string
+	ServiceClassPath string
+	DisplayName      string
+	IsPublic         bool
+	Price            *Price `mapconv:",recursive"`
 }
 
 // Validate validates by field tags
-func (o *PacketFilter) Validate() error {
+func (o *ServiceClass) Validate() error {
 	return validator.New().Struct(o)
 }
 
 // GetID returns value of ID
-func (o *PacketFilter) GetID() types.ID {
+func (o *ServiceClass) GetID() types.ID {
 	return o.ID
 }
 
 // SetID sets value to ID
-func (o *PacketFilter) SetID(v types.ID) {
+func (o *ServiceClass) SetID(v types.ID) {
 	o.ID = v
 }
 
 // GetStringID gets value to StringID
-func (o *PacketFilter) GetStringID() string {
+func (o *ServiceClass) GetStringID() string {
 	return accessor.GetStringID(o)
 }
 
 // SetStringID sets value to StringID
-func (o *PacketFilter) SetStringID(v string) {
+func (o *ServiceClass) SetStringID(v string) {
 	accessor.SetStringID(o, v)
 }
 
 // GetInt64ID gets value to Int64ID
-func (o *PacketFilter) GetInt64ID() int64 {
+func (o *ServiceClass) GetInt64ID() int64 {
 	return accessor.GetInt64ID(o)
 }
 
 // SetInt64ID sets value to Int64ID
-func (o *PacketFilter) SetInt64ID(v int64) {
+func (o *ServiceClass) SetInt64ID(v int64) {
 	accessor.SetInt64ID(o, v)
 }
 
-// GetName returns value of Name
-func (o *PacketFilter) GetName() string {
-	return o.Name
-}
-
-// SetName sets value to Name
-func (o *PacketFilter) SetName(v string) {
-	o.Name = v
-}
-
-// GetDescription returns value of Description
-func (o *PacketFilter) GetDescription() string {
-	return o.Description
+// GetServiceClassName returns value of ServiceClassName
+func (o *ServiceClass) GetServiceClassName() string {
+	return o.ServiceClassName
 }
 
-// SetDescription sets value to Description
-func (o *PacketFilter) SetDescription(v string) {
-	o.Description = v
+// SetServiceClassName sets value to ServiceClassName
+func (o *ServiceClass) SetServiceClassName(v string) {
+	o.ServiceClassName = v
 }
 
-// GetRequiredHostVersion returns value of RequiredHostVersion
-func (o *PacketFilter) GetRequiredHostVersion() types.StringNumber {
-	return o.RequiredHostVersion
+// GetServiceClassPath returns value of ServiceClassPath
+func (o *ServiceClass) GetServiceClassPath() string {
+	return o.ServiceClassPath
 }
 
-// SetRequiredHostVersion sets value to RequiredHostVersion
-func (o *PacketFilter) SetRequiredHostVersion(v types.StringNumber) {
-	o.RequiredHostVersion = v
+// SetServiceClassPath sets value to ServiceClassPath
+func (o *ServiceClass) SetServiceClassPath(v string) {
+	o.ServiceClassPath = v
 }
 
-// GetExpression returns value of Expression
-func (o *PacketFilter) GetExpression() []*PacketFilterExpression {
-	return o.Expression
+// GetDisplayName returns value of DisplayName
+func (o *ServiceClass) GetDisplayName() string {
+	return o.DisplayName
 }
 
-// SetExpression sets value to Expression
-func (o *PacketFilter) SetExpression(v []*PacketFilterExpression) {
-	o.Expression = v
+// SetDisplayName sets value to DisplayName
+func (o *ServiceClass) SetDisplayName(v string) {
+	o.DisplayName = v
 }
 
-// GetExpressionHash returns value of ExpressionHash
-func (o *PacketFilter) GetExpressionHash() string {
-	return o.ExpressionHash
+// GetIsPublic returns value of IsPublic
+func (o *ServiceClass) GetIsPublic() bool {
+	return o.IsPublic
 }
 
-// SetExpressionHash sets value to ExpressionHash
-func (o *PacketFilter) SetExpressionHash(v string) {
-	o.ExpressionHash = v
+// SetIsPublic sets value to IsPublic
+func (o *ServiceClass) SetIsPublic(v bool) {
+	o.IsPublic = v
 }
 
-// GetCreatedAt returns value of CreatedAt
-func (o *PacketFilter) GetCreatedAt() time.Time {
-	return o.CreatedAt
+// GetPrice returns value of Price
+func (o *ServiceClass) GetPrice() *Price {
+	return o.Price
 }
 
-// SetCreatedAt sets value to CreatedAt
-func (o *PacketFilter) SetCreatedAt(v time.Time) {
-	o.CreatedAt = v
+// SetPrice sets value to Price
+func (o *ServiceClass) SetPrice(v *Price) {
+	o.Price = v
 }
 
-// convertTo returns naked PacketFilter
-func (o *PacketFilter) convertTo() (*naked.PacketFilter, error) {
-	dest := &naked.PacketFilter{}
+// convertTo returns naked ServiceClass
+func (o *ServiceClass) convertTo() (*naked.ServiceClass, error) {
+	dest := &naked.ServiceClass{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked PacketFilter
-func (o *PacketFilter) convertFrom(naked *naked.PacketFilter) error {
+// convertFrom parse values from naked ServiceClass
+func (o *ServiceClass) convertFrom(naked *naked.ServiceClass) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* PacketFilterExpression
+* Price
 *************************************************/
 
-// PacketFilterExpression represents API parameter/response structure
-type PacketFilterExpression struct {
-	Protocol        types.Protocol
-	SourceNetwork   types.PacketFilterNetwork
-	SourcePort      types.PacketFilterPort
-	DestinationPort types.PacketFilterPort
-	Action          types.Action
+// Price represents API parameter/response structure
+type Price struct {
+	Base    int
+	Daily   int
+	Hourly  int
+	Monthly int
+	Zone    string
 }
 
 // Validate validates by field tags
-func (o *PacketFilterExpression) Validate() error {
+func (o *Price) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetProtocol returns value of Protocol
-func (o *PacketFilterExpression) GetProtocol() types.Protocol {
-	return o.Protocol
+// GetBase returns value of Base
+func (o *Price) GetBase() int {
+	return o.Base
 }
 
-// SetProtocol sets value to Protocol
-func (o *PacketFilterExpression) SetProtocol(v types.Protocol) {
-	o.Protocol = v
+// SetBase sets value to Base
+func (o *Price) SetBase(v int) {
+	o.Base = v
 }
 
-// GetSourceNetwork returns value of SourceNetwork
-func (o *PacketFilterExpression) GetSourceNetwork() types.PacketFilterNetwork {
-	return o.SourceNetwork
+// GetDaily returns value of Daily
+func (o *Price) GetDaily() int {
+	return o.Daily
 }
 
-// SetSourceNetwork sets value to SourceNetwork
-func (o *PacketFilterExpression) SetSourceNetwork(v types.PacketFilterNetwork) {
-	o.SourceNetwork = v
+// SetDaily sets value to Daily
+func (o *Price) SetDaily(v int) {
+	o.Daily = v
 }
 
-// GetSourcePort returns value of SourcePort
-func (o *PacketFilterExpression) GetSourcePort() types.PacketFilterPort {
-	return o.SourcePort
+// GetHourly returns value of Hourly
+func (o *Price) GetHourly() int {
+	return o.Hourly
 }
 
-// SetSourcePort sets value to SourcePort
-func (o *PacketFilterExpression) SetSourcePort(v types.PacketFilterPort) {
-	o.SourcePort = v
+// SetHourly sets value to Hourly
+func (o *Price) SetHourly(v int) {
+	o.Hourly = v
 }
 
-// GetDestinationPort returns value of DestinationPort
-func (o *PacketFilterExpression) GetDestinationPort() types.PacketFilterPort {
-	return o.DestinationPort
+// GetMonthly returns value of Monthly
+func (o *Price) GetMonthly() int {
+	return o.Monthly
 }
 
-// SetDestinationPort sets value to DestinationPort
-func (o *PacketFilterExpression) SetDestinationPort(v types.PacketFilterPort) {
-	o.DestinationPort = v
+// SetMonthly sets value to Monthly
+func (o *Price) SetMonthly(v int) {
+	o.Monthly = v
 }
 
-// GetAction returns value of Action
-func (o *PacketFilterExpression) GetAction() types.Action {
-	return o.Action
+// GetZone returns value of Zone
+func (o *Price) GetZone() string {
+	return o.Zone
 }
 
-// SetAction sets value to Action
-func (o *PacketFilterExpression) SetAction(v types.Action) {
-	o.Action = v
+// SetZone sets value to Zone
+func (o *Price) SetZone(v string) {
+	o.Zone = v
 }
 
-// convertTo returns naked PacketFilterExpression
-func (o *PacketFilterExpression) convertTo() (*naked.PacketFilterExpression, error) {
-	dest := &naked.PacketFilterExpression{}
+// convertTo returns naked Price
+func (o *Price) convertTo() (*naked.Price, error) {
+	dest := &naked.Price{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked PacketFilterExpression
-func (o *PacketFilterExpression) convertFrom(naked *naked.PacketFilterExpression) error {
+// convertFrom parse values from naked Price
+func (o *Price) convertFrom(naked *naked.Price) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* PacketFilterCreateRequest
+* SIM
 *************************************************/
 
-// PacketFilterCreateRequest represents API parameter/response structure
-type PacketFilterCreateRequest struct {
-	Name        string                    `validate:"required"`
-	Description string                    `validate:"min=0,max=512"`
-	Expression  []*PacketFilterExpression `mapconv:"[]Expression,recursive"`
+// SIM represents API parameter/response structure
+type SIM struct {
+	ID           types.ID
+	Name         string `validate:"required"`
+	Description  string `validate:"min=0,max=512"`
+	Tags         []string
+	Availability types.EAvailability
+	Class        string
+	ICCID        types.StringNumber `mapconv:"Status.ICCID" validate:"numeric"`
+	IconID       types.ID           `mapconv:"Icon.ID"`
+	CreatedAt    time.Time
+	ModifiedAt   time.Time
 }
 
 // Validate validates by field tags
-func (o *PacketFilterCreateRequest) Validate() error {
+func (o *SIM) Validate() error {
 	return validator.New().Struct(o)
 }
 
+// GetID returns value of ID
+func (o *SIM) GetID() types.ID {
+	return o.ID
+}
+
+// SetID sets value to ID
+func (o *SIM) SetID(v types.ID) {
+	o.ID = v
+}
+
+// GetStringID gets value to StringID
+func (o *SIM) GetStringID() string {
+	return accessor.GetStringID(o)
+}
+
+// SetStringID sets value to StringID
+func (o *SIM) SetStringID(v string) {
+	accessor.SetStringID(o, v)
+}
+
+// GetInt64ID gets value to Int64ID
+func (o *SIM) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
+
+// SetInt64ID sets value to Int64ID
+func (o *SIM) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
+}
+
 // GetName returns value of Name
-func (o *PacketFilterCreateRequest) GetName() string {
+func (o *SIM) GetName() string {
 	return o.Name
 }
 
 // SetName sets value to Name
-func (o *PacketFilterCreateRequest) SetName(v string) {
+func (o *SIM) SetName(v string) {
 	o.Name = v
 }
 
 // GetDescription returns value of Description
-func (o *PacketFilterCreateRequest) GetDescription() string {
+func (o *SIM) GetDescription() string {
 	return o.Description
 }
 
 // SetDescription sets value to Description
-func (o *PacketFilterCreateRequest) SetDescription(v string) {
+func (o *SIM) SetDescription(v string) {
 	o.Description = v
 }
 
-// GetExpression returns value of Expression
-func (o *PacketFilterCreateRequest) GetExpression() []*PacketFilterExpression {
-	return o.Expression
+// GetTags returns value of Tags
+func (o *SIM) GetTags() []string {
+	return o.Tags
 }
 
-// SetExpression sets value to Expression
-func (o *PacketFilterCreateRequest) SetExpression(v []*PacketFilterExpression) {
-	o.Expression = v
+// SetTags sets value to Tags
+func (o *SIM) SetTags(v []string) {
+	o.Tags = v
+}
+
+// GetAvailability returns value of Availability
+func (o *SIM) GetAvailability() types.EAvailability {
+	return o.Availability
+}
+
+// SetAvailability sets value to Availability
+func (o *SIM) SetAvailability(v types.EAvailability) {
+	o.Availability = v
+}
+
+// GetClass returns value of Class
+func (o *SIM) GetClass() string {
+	return o.Class
+}
+
+// SetClass sets value to Class
+func (o *SIM) SetClass(v string) {
+	o.Class = v
+}
+
+// GetICCID returns value of ICCID
+func (o *SIM) GetICCID() types.StringNumber {
+	return o.ICCID
+}
+
+// SetICCID sets value to ICCID
+func (o *SIM) SetICCID(v types.StringNumber) {
+	o.ICCID = v
+}
+
+// GetIconID returns value of IconID
+func (o *SIM) GetIconID() types.ID {
+	return o.IconID
+}
+
+// SetIconID sets value to IconID
+func (o *SIM) SetIconID(v types.ID) {
+	o.IconID = v
+}
+
+// GetCreatedAt returns value of CreatedAt
+func (o *SIM) GetCreatedAt() time.Time {
+	return o.CreatedAt
+}
+
+// SetCreatedAt sets value to CreatedAt
+func (o *SIM) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
+}
+
+// GetModifiedAt returns value of ModifiedAt
+func (o *SIM) GetModifiedAt() time.Time {
+	return o.ModifiedAt
+}
+
+// SetModifiedAt sets value to ModifiedAt
+func (o *SIM) SetModifiedAt(v time.Time) {
+	o.ModifiedAt = v
 }
 
-// convertTo returns naked PacketFilterCreateRequest
-func (o *PacketFilterCreateRequest) convertTo() (*naked.PacketFilter, error) {
-	dest := &naked.PacketFilter{}
+// convertTo returns naked SIM
+func (o *SIM) convertTo() (*naked.SIM, error) {
+	dest := &naked.SIM{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked PacketFilterCreateRequest
-func (o *PacketFilterCreateRequest) convertFrom(naked *naked.PacketFilter) error {
+// convertFrom parse values from naked SIM
+func (o *SIM) convertFrom(naked *naked.SIM) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* PacketFilterUpdateRequest
+* SIMCreateRequest
 *************************************************/
 
-// PacketFilterUpdateRequest represents API parameter/response structure
-type PacketFilterUpdateRequest struct {
-	Name        string                    `validate:"required"`
-	Description string                    `validate:"min=0,max=512"`
-	Expression  []*PacketFilterExpression `mapconv:"[]Expression,recursive"`
+// SIMCreateRequest represents API parameter/response structure
+type SIMCreateRequest struct {
+	Name        string `validate:"required"`
+	Description string `validate:"min=0,max=512"`
+	Tags        []string
+	IconID      types.ID           `mapconv:"Icon.ID"`
+	Class       string             `mapconv:"Provider.Class,default=sim"`
+	ICCID       types.StringNumber `mapconv:"Status.ICCID" validate:"numeric"`
+	PassCode    string             `mapconv:"Remark.PassCode"`
 }
 
 // Validate validates by field tags
-func (o *PacketFilterUpdateRequest) Validate() error {
+func (o *SIMCreateRequest) Validate() error {
 	return validator.New().Struct(o)
 }
 
 // GetName returns value of Name
-func (o *PacketFilterUpdateRequest) GetName() string {
+func (o *SIMCreateRequest) GetName() string {
 	return o.Name
 }
 
 // SetName sets value to Name
-func (o *PacketFilterUpdateRequest) SetName(v string) {
+func (o *SIMCreateRequest) SetName(v string) {
 	o.Name = v
 }
 
 // GetDescription returns value of Description
-func (o *PacketFilterUpdateRequest) GetDescription() string {
+func (o *SIMCreateRequest) GetDescription() string {
 	return o.Description
 }
 
 // SetDescription sets value to Description
-func (o *PacketFilterUpdateRequest) SetDescription(v string) {
+func (o *SIMCreateRequest) SetDescription(v string) {
 	o.Description = v
 }
 
-// GetExpression returns value of Expression
-func (o *PacketFilterUpdateRequest) GetExpression() []*PacketFilterExpression {
-	return o.Expression
+// GetTags returns value of Tags
+func (o *SIMCreateRequest) GetTags() []string {
+	return o.Tags
 }
 
-// SetExpression sets value to Expression
-func (o *PacketFilterUpdateRequest) SetExpression(v []*PacketFilterExpression) {
-	o.Expression = v
+// SetTags sets value to Tags
+func (o *SIMCreateRequest) SetTags(v []string) {
+	o.Tags = v
 }
 
-// convertTo returns naked PacketFilterUpdateRequest
-func (o *PacketFilterUpdateRequest) convertTo() (*naked.PacketFilter, error) {
-	dest := &naked.PacketFilter{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetIconID returns value of IconID
+func (o *SIMCreateRequest) GetIconID() types.ID {
+	return o.IconID
 }
 
-// convertFrom parse values from naked PacketFilterUpdateRequest
-func (o *PacketFilterUpdateRequest) convertFrom(naked *naked.PacketFilter) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetIconID sets value to IconID
+func (o *SIMCreateRequest) SetIconID(v types.ID) {
+	o.IconID = v
 }
 
-/*************************************************
-* Server
-*************************************************/
+// GetClass returns value of Class
+func (o *SIMCreateRequest) GetClass() string {
+	return o.Class
+}
 
-// Server represents API parameter/response structure
-type Server struct {
-	ID                      types.ID
-	Name                    string `validate:"required"`
-	Description             string `validate:"min=0,max=512"`
-	Tags                    []string
-	Availability            types.EAvailability
-	HostName                string
-	InterfaceDriver         types.EInterfaceDriver      `mapconv:",default=virtio"`
-	ServerPlanID            types.ID                    `mapconv:"ServerPlan.ID"`
-	ServerPlanName          string                      `mapconv:"ServerPlan.Name"`
-	CPU                     int                         `mapconv:"ServerPlan.CPU"`
-	MemoryMB                int                         `mapconv:"ServerPlan.MemoryMB"`
-	ServerPlanCommitment    types.ECommitment           `mapconv:"ServerPlan.Commitment,default=standard"`
-	ServerPlanGeneration    types.EPlanGeneration       `mapconv:"ServerPlan.Generation"`
-	Zone                    *ZoneInfo                   `json:",omitempty" mapconv:",omitempty,recursive"`
-	InstanceHostName        string                      `mapconv:"Instance.Host.Name"`
-	InstanceHostInfoURL     string                      `mapconv:"Instance.Host.InfoURL"`
-	InstanceStatus          types.EServerInstanceStatus `mapconv:"Instance.Status"`
-	InstanceBeforeStatus    types.EServerInstanceStatus `mapconv:"Instance.BeforeStatus"`
-	InstanceStatusChangedAt time.Time                   `mapconv:"Instance.StatusChangedAt"`
-	InstanceWarnings        string                      `mapconv:"Instance.Warnings"`
-	InstanceWarningsValue   int                         `mapconv:"Instance.WarningsValue"`
-	Disks                   []*Disk                     `json:",omitempty" mapconv:",recursive"`
-	Interfaces              []*Interface                `json:",omitempty" mapconv:"[]Interfaces,recursive,omitempty"`
-	CDROMID                 types.ID                    `mapconv:"CDROM.ID"`
-	PrivateHostID           types.ID                    `mapconv:"PrivateHost.ID"`
-	PrivateHostName         string                      `mapconv:"PrivateHost.Name"`
-	BundleInfo              *BundleInfo                 `json:",omitempty" mapconv:",omitempty,recursive"`
-	IconID                  types.ID                    `mapconv:"Icon.ID"`
-	CreatedAt               time.Time
-	ModifiedAt              time.Time
+// SetClass sets value to Class
+func (o *SIMCreateRequest) SetClass(v string) {
+	o.Class = v
 }
 
-// Validate validates by field tags
-func (o *Server) Validate() error {
-	return validator.New().Struct(o)
+// GetICCID returns value of ICCID
+func (o *SIMCreateRequest) GetICCID() types.StringNumber {
+	return o.ICCID
 }
 
-// GetID returns value of ID
-func (o *Server) GetID() types.ID {
-	return o.ID
+// SetICCID sets value to ICCID
+func (o *SIMCreateRequest) SetICCID(v types.StringNumber) {
+	o.ICCID = v
 }
 
-// SetID sets value to ID
-func (o *Server) SetID(v types.ID) {
-	o.ID = v
+// GetPassCode returns value of PassCode
+func (o *SIMCreateRequest) GetPassCode() string {
+	return o.PassCode
 }
 
-// GetStringID gets value to StringID
-func (o *Server) GetStringID() string {
-	return accessor.GetStringID(o)
+// SetPassCode sets value to PassCode
+func (o *SIMCreateRequest) SetPassCode(v string) {
+	o.PassCode = v
 }
 
-// SetStringID sets value to StringID
-func (o *Server) SetStringID(v string) {
-	accessor.SetStringID(o, v)
+// convertTo returns naked SIMCreateRequest
+func (o *SIMCreateRequest) convertTo() (*naked.SIM, error) {
+	dest := &naked.SIM{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// GetInt64ID gets value to Int64ID
-func (o *Server) GetInt64ID() int64 {
-	return accessor.GetInt64ID(o)
+// convertFrom parse values from naked SIMCreateRequest
+func (o *SIMCreateRequest) convertFrom(naked *naked.SIM) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// SetInt64ID sets value to Int64ID
-func (o *Server) SetInt64ID(v int64) {
-	accessor.SetInt64ID(o, v)
+/*************************************************
+* SIMUpdateRequest
+*************************************************/
+
+// SIMUpdateRequest represents API parameter/response structure
+type SIMUpdateRequest struct {
+	Name        string `validate:"required"`
+	Description string `validate:"min=0,max=512"`
+	Tags        []string
+	IconID      types.ID `mapconv:"Icon.ID"`
+}
+
+// Validate validates by field tags
+func (o *SIMUpdateRequest) Validate() error {
+	return validator.New().Struct(o)
 }
 
 // GetName returns value of Name
-func (o *Server) GetName() string {
+func (o *SIMUpdateRequest) GetName() string {
 	return o.Name
 }
 
 // SetName sets value to Name
-func (o *Server) SetName(v string) {
+func (o *SIMUpdateRequest) SetName(v string) {
 	o.Name = v
 }
 
 // GetDescription returns value of Description
-func (o *Server) GetDescription() string {
+func (o *SIMUpdateRequest) GetDescription() string {
 	return o.Description
 }
 
 // SetDescription sets value to Description
-func (o *Server) SetDescription(v string) {
+func (o *SIMUpdateRequest) SetDescription(v string) {
 	o.Description = v
 }
 
 // GetTags returns value of Tags
-func (o *Server) GetTags() []string {
+func (o *SIMUpdateRequest) GetTags() []string {
 	return o.Tags
 }
 
 // SetTags sets value to Tags
-func (o *Server) SetTags(v []string) {
+func (o *SIMUpdateRequest) SetTags(v []string) {
 	o.Tags = v
 }
 
-// GetAvailability returns value of Availability
-func (o *Server) GetAvailability() types.EAvailability {
-	return o.Availability
-}
-
-// SetAvailability sets value to Availability
-func (o *Server) SetAvailability(v types.EAvailability) {
-	o.Availability = v
-}
-
-// GetHostName returns value of HostName
-func (o *Server) GetHostName() string {
-	return o.HostName
-}
-
-// SetHostName sets value to HostName
-func (o *Server) SetHostName(v string) {
-	o.HostName = v
-}
-
-// GetInterfaceDriver returns value of InterfaceDriver
-func (o *Server) GetInterfaceDriver() types.EInterfaceDriver {
-	return o.InterfaceDriver
-}
-
-// SetInterfaceDriver sets value to InterfaceDriver
-func (o *Server) SetInterfaceDriver(v types.EInterfaceDriver) {
-	o.InterfaceDriver = v
-}
-
-// GetServerPlanID returns value of ServerPlanID
-func (o *Server) GetServerPlanID() types.ID {
-	return o.ServerPlanID
-}
-
-// SetServerPlanID sets value to ServerPlanID
-func (o *Server) SetServerPlanID(v types.ID) {
-	o.ServerPlanID = v
-}
-
-// GetServerPlanName returns value of ServerPlanName
-func (o *Server) GetServerPlanName() string {
-	return o.ServerPlanName
-}
-
-// SetServerPlanName sets value to ServerPlanName
-func (o *Server) SetServerPlanName(v string) {
-	o.ServerPlanName = v
-}
-
-// GetCPU returns value of CPU
-func (o *Server) GetCPU() int {
-	return o.CPU
-}
-
-// SetCPU sets value to CPU
-func (o *Server) SetCPU(v int) {
-	o.CPU = v
-}
-
-// GetMemoryMB returns value of MemoryMB
-func (o *Server) GetMemoryMB() int {
-	return o.MemoryMB
-}
-
-// SetMemoryMB sets value to MemoryMB
-func (o *Server) SetMemoryMB(v int) {
-	o.MemoryMB = v
+// GetIconID returns value of IconID
+func (o *SIMUpdateRequest) GetIconID() types.ID {
+	return o.IconID
 }
 
-// GetMemoryGB gets value to MemoryGB
-func (o *Server) GetMemoryGB() int {
-	return accessor.GetMemoryGB(o)
+// SetIconID sets value to IconID
+func (o *SIMUpdateRequest) SetIconID(v types.ID) {
+	o.IconID = v
 }
 
-// SetMemoryGB sets value to MemoryGB
-func (o *Server) SetMemoryGB(v int) {
-	accessor.SetMemoryGB(o, v)
+// convertTo returns naked SIMUpdateRequest
+func (o *SIMUpdateRequest) convertTo() (*naked.SIM, error) {
+	dest := &naked.SIM{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// GetServerPlanCommitment returns value of ServerPlanCommitment
-func (o *Server) GetServerPlanCommitment() types.ECommitment {
-	return o.ServerPlanCommitment
+// convertFrom parse values from naked SIMUpdateRequest
+func (o *SIMUpdateRequest) convertFrom(naked *naked.SIM) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// SetServerPlanCommitment sets value to ServerPlanCommitment
-func (o *Server) SetServerPlanCommitment(v types.ECommitment) {
-	o.ServerPlanCommitment = v
-}
+/*************************************************
+* SIMAssignIPRequest
+*************************************************/
 
-// GetServerPlanGeneration returns value of ServerPlanGeneration
-func (o *Server) GetServerPlanGeneration() types.EPlanGeneration {
-	return o.ServerPlanGeneration
+// SIMAssignIPRequest represents API parameter/response structure
+type SIMAssignIPRequest struct {
+	IP string
 }
 
-// SetServerPlanGeneration sets value to ServerPlanGeneration
-func (o *Server) SetServerPlanGeneration(v types.EPlanGeneration) {
-	o.ServerPlanGeneration = v
+// Validate validates by field tags
+func (o *SIMAssignIPRequest) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetZone returns value of Zone
-func (o *Server) GetZone() *ZoneInfo {
-	return o.Zone
+// GetIP returns value of IP
+func (o *SIMAssignIPRequest) GetIP() string {
+	return o.IP
 }
 
-// SetZone sets value to Zone
-func (o *Server) SetZone(v *ZoneInfo) {
-	o.Zone = v
+// SetIP sets value to IP
+func (o *SIMAssignIPRequest) SetIP(v string) {
+	o.IP = v
 }
 
-// GetInstanceHostName returns value of InstanceHostName
-func (o *Server) GetInstanceHostName() string {
-	return o.InstanceHostName
+// convertTo returns naked SIMAssignIPRequest
+func (o *SIMAssignIPRequest) convertTo() (*naked.SIMAssignIPRequest, error) {
+	dest := &naked.SIMAssignIPRequest{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetInstanceHostName sets value to InstanceHostName
-func (o *Server) SetInstanceHostName(v string) {
-	o.InstanceHostName = v
+// convertFrom parse values from naked SIMAssignIPRequest
+func (o *SIMAssignIPRequest) convertFrom(naked *naked.SIMAssignIPRequest) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// GetInstanceHostInfoURL returns value of InstanceHostInfoURL
-func (o *Server) GetInstanceHostInfoURL() string {
-	return o.InstanceHostInfoURL
-}
+/*************************************************
+* SIMIMEILockRequest
+*************************************************/
 
-// SetInstanceHostInfoURL sets value to InstanceHostInfoURL
-func (o *Server) SetInstanceHostInfoURL(v string) {
-	o.InstanceHostInfoURL = v
+// SIMIMEILockRequest represents API parameter/response structure
+type SIMIMEILockRequest struct {
+	IMEI string
 }
 
-// GetInstanceStatus returns value of InstanceStatus
-func (o *Server) GetInstanceStatus() types.EServerInstanceStatus {
-	return o.InstanceStatus
+// Validate validates by field tags
+func (o *SIMIMEILockRequest) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// SetInstanceStatus sets value to InstanceStatus
-func (o *Server) SetInstanceStatus(v types.EServerInstanceStatus) {
-	o.InstanceStatus = v
+// GetIMEI returns value of IMEI
+func (o *SIMIMEILockRequest) GetIMEI() string {
+	return o.IMEI
 }
 
-// GetInstanceBeforeStatus returns value of InstanceBeforeStatus
-func (o *Server) GetInstanceBeforeStatus() types.EServerInstanceStatus {
-	return o.InstanceBeforeStatus
+// SetIMEI sets value to IMEI
+func (o *SIMIMEILockRequest) SetIMEI(v string) {
+	o.IMEI = v
 }
 
-// SetInstanceBeforeStatus sets value to InstanceBeforeStatus
-func (o *Server) SetInstanceBeforeStatus(v types.EServerInstanceStatus) {
-	o.InstanceBeforeStatus = v
+// convertTo returns naked SIMIMEILockRequest
+func (o *SIMIMEILockRequest) convertTo() (*naked.SIMIMEILockRequest, error) {
+	dest := &naked.SIMIMEILockRequest{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// GetInstanceStatusChangedAt returns value of InstanceStatusChangedAt
-func (o *Server) GetInstanceStatusChangedAt() time.Time {
-	return o.InstanceStatusChangedAt
+// convertFrom parse values from naked SIMIMEILockRequest
+func (o *SIMIMEILockRequest) convertFrom(naked *naked.SIMIMEILockRequest) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// SetInstanceStatusChangedAt sets value to InstanceStatusChangedAt
-func (o *Server) SetInstanceStatusChangedAt(v time.Time) {
-	o.InstanceStatusChangedAt = v
-}
+/*************************************************
+* SIMLog
+*************************************************/
 
-// GetInstanceWarnings returns value of InstanceWarnings
-func (o *Server) GetInstanceWarnings() string {
-	return o.InstanceWarnings
+// SIMLog represents API parameter/response structure
+type SIMLog struct {
+	Date          time.Time
+	SessionStatus string
+	ResourceID    string
+	IMEI          string
+	IMSI          string
 }
 
-// SetInstanceWarnings sets value to InstanceWarnings
-func (o *Server) SetInstanceWarnings(v string) {
-	o.InstanceWarnings = v
+// Validate validates by field tags
+func (o *SIMLog) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetInstanceWarningsValue returns value of InstanceWarningsValue
-func (o *Server) GetInstanceWarningsValue() int {
-	return o.InstanceWarningsValue
+// GetDate returns value of Date
+func (o *SIMLog) GetDate() time.Time {
+	return o.Date
 }
 
-// SetInstanceWarningsValue sets value to InstanceWarningsValue
-func (o *Server) SetInstanceWarningsValue(v int) {
-	o.InstanceWarningsValue = v
+// SetDate sets value to Date
+func (o *SIMLog) SetDate(v time.Time) {
+	o.Date = v
 }
 
-// GetDisks returns value of Disks
-func (o *Server) GetDisks() []*Disk {
-	return o.Disks
+// GetSessionStatus returns value of SessionStatus
+func (o *SIMLog) GetSessionStatus() string {
+	return o.SessionStatus
 }
 
-// SetDisks sets value to Disks
-func (o *Server) SetDisks(v []*Disk) {
-	o.Disks = v
+// SetSessionStatus sets value to SessionStatus
+func (o *SIMLog) SetSessionStatus(v string) {
+	o.SessionStatus = v
 }
 
-// GetInterfaces returns value of Interfaces
-func (o *Server) GetInterfaces() []*Interface {
-	return o.Interfaces
+// GetResourceID returns value of ResourceID
+func (o *SIMLog) GetResourceID() string {
+	return o.ResourceID
 }
 
-// SetInterfaces sets value to Interfaces
-func (o *Server) SetInterfaces(v []*Interface) {
-	o.Interfaces = v
+// SetResourceID sets value to ResourceID
+func (o *SIMLog) SetResourceID(v string) {
+	o.ResourceID = v
 }
 
-// GetCDROMID returns value of CDROMID
-func (o *Server) GetCDROMID() types.ID {
-	return o.CDROMID
+// GetIMEI returns value of IMEI
+func (o *SIMLog) GetIMEI() string {
+	return o.IMEI
 }
 
-// SetCDROMID sets value to CDROMID
-func (o *Server) SetCDROMID(v types.ID) {
-	o.CDROMID = v
+// SetIMEI sets value to IMEI
+func (o *SIMLog) SetIMEI(v string) {
+	o.IMEI = v
 }
 
-// GetPrivateHostID returns value of PrivateHostID
-func (o *Server) GetPrivateHostID() types.ID {
-	return o.PrivateHostID
+// GetIMSI returns value of IMSI
+func (o *SIMLog) GetIMSI() string {
+	return o.IMSI
 }
 
-// SetPrivateHostID sets value to PrivateHostID
-func (o *Server) SetPrivateHostID(v types.ID) {
-	o.PrivateHostID = v
+// SetIMSI sets value to IMSI
+func (o *SIMLog) SetIMSI(v string) {
+	o.IMSI = v
 }
 
-// GetPrivateHostName returns value of PrivateHostName
-func (o *Server) GetPrivateHostName() string {
-	return o.PrivateHostName
+// convertTo returns naked SIMLog
+func (o *SIMLog) convertTo() (*naked.SIMLog, error) {
+	dest := &naked.SIMLog{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetPrivateHostName sets value to PrivateHostName
-func (o *Server) SetPrivateHostName(v string) {
-	o.PrivateHostName = v
+// convertFrom parse values from naked SIMLog
+func (o *SIMLog) convertFrom(naked *naked.SIMLog) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// GetBundleInfo returns value of BundleInfo
-func (o *Server) GetBundleInfo() *BundleInfo {
-	return o.BundleInfo
+/*************************************************
+* SIMNetworkOperatorConfig
+*************************************************/
+
+// SIMNetworkOperatorConfig represents API parameter/response structure
+type SIMNetworkOperatorConfig struct {
+	Allow       bool
+	CountryCode string
+	Name        string
 }
 
-// SetBundleInfo sets value to BundleInfo
-func (o *Server) SetBundleInfo(v *BundleInfo) {
-	o.BundleInfo = v
+// Validate validates by field tags
+func (o *SIMNetworkOperatorConfig) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetIconID returns value of IconID
-func (o *Server) GetIconID() types.ID {
-	return o.IconID
+// GetAllow returns value of Allow
+func (o *SIMNetworkOperatorConfig) GetAllow() bool {
+	return o.Allow
 }
 
-// SetIconID sets value to IconID
-func (o *Server) SetIconID(v types.ID) {
-	o.IconID = v
+// SetAllow sets value to Allow
+func (o *SIMNetworkOperatorConfig) SetAllow(v bool) {
+	o.Allow = v
 }
 
-// GetCreatedAt returns value of CreatedAt
-func (o *Server) GetCreatedAt() time.Time {
-	return o.CreatedAt
+// GetCountryCode returns value of CountryCode
+func (o *SIMNetworkOperatorConfig) GetCountryCode() string {
+	return o.CountryCode
 }
 
-// SetCreatedAt sets value to CreatedAt
-func (o *Server) SetCreatedAt(v time.Time) {
-	o.CreatedAt = v
+// SetCountryCode sets value to CountryCode
+func (o *SIMNetworkOperatorConfig) SetCountryCode(v string) {
+	o.CountryCode = v
 }
 
-// GetModifiedAt returns value of ModifiedAt
-func (o *Server) GetModifiedAt() time.Time {
-	return o.ModifiedAt
+// GetName returns value of Name
+func (o *SIMNetworkOperatorConfig) GetName() string {
+	return o.Name
 }
 
-// SetModifiedAt sets value to ModifiedAt
-func (o *Server) SetModifiedAt(v time.Time) {
-	o.ModifiedAt = v
+// SetName sets value to Name
+func (o *SIMNetworkOperatorConfig) SetName(v string) {
+	o.Name = v
 }
 
-// convertTo returns naked Server
-func (o *Server) convertTo() (*naked.Server, error) {
-	dest := &naked.Server{}
+// convertTo returns naked SIMNetworkOperatorConfig
+func (o *SIMNetworkOperatorConfig) convertTo() (*naked.SIMNetworkOperatorConfig, error) {
+	dest := &naked.SIMNetworkOperatorConfig{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked Server
-func (o *Server) convertFrom(naked *naked.Server) error {
+// convertFrom parse values from naked SIMNetworkOperatorConfig
+func (o *SIMNetworkOperatorConfig) convertFrom(naked *naked.SIMNetworkOperatorConfig) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* ZoneInfo
+* SIMNetworkOperatorConfigs
 *************************************************/
 
-// ZoneInfo represents API parameter/response structure
-type ZoneInfo struct {
-	ID          types.ID
-	Name        string         `validate:"required"`
-	DisplayName string         `json:",omitempty" mapconv:"Description,omitempty"`
-	IsDummy     bool           `json:",omitempty" mapconv:",omitempty"`
-	VNCProxy    *VNCProxy      `json:",omitempty" mapconv:",omitempty,recursive"`
-	FTPServer   *FTPServerInfo `json:",omitempty" mapconv:",omitempty,recursive"`
-	Region      *Region        `json:",omitempty" mapconv:",omitempty,recursive"`
+// SIMNetworkOperatorConfigs represents API parameter/response structure
+type SIMNetworkOperatorConfigs struct {
+	NetworkOperatorConfigs []*SIMNetworkOperatorConfig
 }
 
 // Validate validates by field tags
-func (o *ZoneInfo) Validate() error {
+func (o *SIMNetworkOperatorConfigs) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetID returns value of ID
-func (o *ZoneInfo) GetID() types.ID {
-	return o.ID
+// GetNetworkOperatorConfigs returns value of NetworkOperatorConfigs
+func (o *SIMNetworkOperatorConfigs) GetNetworkOperatorConfigs() []*SIMNetworkOperatorConfig {
+	return o.NetworkOperatorConfigs
 }
 
-// SetID sets value to ID
-func (o *ZoneInfo) SetID(v types.ID) {
-	o.ID = v
+// SetNetworkOperatorConfigs sets value to NetworkOperatorConfigs
+func (o *SIMNetworkOperatorConfigs) SetNetworkOperatorConfigs(v []*SIMNetworkOperatorConfig) {
+	o.NetworkOperatorConfigs = v
 }
 
-// GetStringID gets value to StringID
-func (o *ZoneInfo) GetStringID() string {
-	return accessor.GetStringID(o)
+// convertTo returns naked SIMNetworkOperatorConfigs
+func (o *SIMNetworkOperatorConfigs) convertTo() (*naked.SIMNetworkOperatorConfigs, error) {
+	dest := &naked.SIMNetworkOperatorConfigs{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetStringID sets value to StringID
-func (o *ZoneInfo) SetStringID(v string) {
-	accessor.SetStringID(o, v)
+// convertFrom parse values from naked SIMNetworkOperatorConfigs
+func (o *SIMNetworkOperatorConfigs) convertFrom(naked *naked.SIMNetworkOperatorConfigs) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// GetInt64ID gets value to Int64ID
-func (o *ZoneInfo) GetInt64ID() int64 {
-	return accessor.GetInt64ID(o)
+/*************************************************
+* LinkActivity
+*************************************************/
+
+// LinkActivity represents API parameter/response structure
+type LinkActivity struct {
+	Values []*MonitorLinkValue `mapconv:"[]Link"`
 }
 
-// SetInt64ID sets value to Int64ID
-func (o *ZoneInfo) SetInt64ID(v int64) {
-	accessor.SetInt64ID(o, v)
+// Validate validates by field tags
+func (o *LinkActivity) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetName returns value of Name
-func (o *ZoneInfo) GetName() string {
-	return o.Name
+// GetValues returns value of Values
+func (o *LinkActivity) GetValues() []*MonitorLinkValue {
+	return o.Values
 }
 
-// SetName sets value to Name
-func (o *ZoneInfo) SetName(v string) {
-	o.Name = v
+// SetValues sets value to Values
+func (o *LinkActivity) SetValues(v []*MonitorLinkValue) {
+	o.Values = v
 }
 
-// GetDisplayName returns value of DisplayName
-func (o *ZoneInfo) GetDisplayName() string {
-	return o.DisplayName
+// convertTo returns naked LinkActivity
+func (o *LinkActivity) convertTo() (*naked.MonitorValues, error) {
+	dest := &naked.MonitorValues{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetDisplayName sets value to DisplayName
-func (o *ZoneInfo) SetDisplayName(v string) {
-	o.DisplayName = v
+// convertFrom parse values from naked LinkActivity
+func (o *LinkActivity) convertFrom(naked *naked.MonitorValues) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// GetIsDummy returns value of IsDummy
-func (o *ZoneInfo) GetIsDummy() bool {
-	return o.IsDummy
+/*************************************************
+* MonitorLinkValue
+*************************************************/
+
+// MonitorLinkValue represents API parameter/response structure
+type MonitorLinkValue struct {
+	Time        time.Time `json:",omitempty" mapconv:",omitempty"`
+	UplinkBPS   float64   `json:",omitempty" mapconv:",omitempty"`
+	DownlinkBPS float64   `json:",omitempty" mapconv:",omitempty"`
 }
 
-// SetIsDummy sets value to IsDummy
-func (o *ZoneInfo) SetIsDummy(v bool) {
-	o.IsDummy = v
+// Validate validates by field tags
+func (o *MonitorLinkValue) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetVNCProxy returns value of VNCProxy
-func (o *ZoneInfo) GetVNCProxy() *VNCProxy {
-	return o.VNCProxy
+// GetTime returns value of Time
+func (o *MonitorLinkValue) GetTime() time.Time {
+	return o.Time
 }
 
-// SetVNCProxy sets value to VNCProxy
-func (o *ZoneInfo) SetVNCProxy(v *VNCProxy) {
-	o.VNCProxy = v
+// SetTime sets value to Time
+func (o *MonitorLinkValue) SetTime(v time.Time) {
+	o.Time = v
 }
 
-// GetFTPServer returns value of FTPServer
-func (o *ZoneInfo) GetFTPServer() *FTPServerInfo {
-	return o.FTPServer
+// GetUplinkBPS returns value of UplinkBPS
+func (o *MonitorLinkValue) GetUplinkBPS() float64 {
+	return o.UplinkBPS
 }
 
-// SetFTPServer sets value to FTPServer
-func (o *ZoneInfo) SetFTPServer(v *FTPServerInfo) {
-	o.FTPServer = v
+// SetUplinkBPS sets value to UplinkBPS
+func (o *MonitorLinkValue) SetUplinkBPS(v float64) {
+	o.UplinkBPS = v
 }
 
-// GetRegion returns value of Region
-func (o *ZoneInfo) GetRegion() *Region {
-	return o.Region
+// GetDownlinkBPS returns value of DownlinkBPS
+func (o *MonitorLinkValue) GetDownlinkBPS() float64 {
+	return o.DownlinkBPS
 }
 
-// SetRegion sets value to Region
-func (o *ZoneInfo) SetRegion(v *Region) {
-	o.Region = v
+// SetDownlinkBPS sets value to DownlinkBPS
+func (o *MonitorLinkValue) SetDownlinkBPS(v float64) {
+	o.DownlinkBPS = v
 }
 
-// convertTo returns naked ZoneInfo
-func (o *ZoneInfo) convertTo() (*naked.Zone, error) {
-	dest := &naked.Zone{}
+// convertTo returns naked MonitorLinkValue
+func (o *MonitorLinkValue) convertTo() (*naked.MonitorLinkValue, error) {
+	dest := &naked.MonitorLinkValue{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked ZoneInfo
-func (o *ZoneInfo) convertFrom(naked *naked.Zone) error {
+// convertFrom parse values from naked MonitorLinkValue
+func (o *MonitorLinkValue) convertFrom(naked *naked.MonitorLinkValue) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* VNCProxy
+* SimpleMonitor
 *************************************************/
 
-// VNCProxy represents API parameter/response structure
-type VNCProxy struct {
-	HostName  string `json:",omitempty" mapconv:",omitempty"`
-	IPAddress string `json:",omitempty" mapconv:",omitempty"`
+// SimpleMonitor represents API parameter/response structure
+type SimpleMonitor struct {
+	ID                 types.ID
+	Name               string `validate:"required"`
+	Description        string `validate:"min=0,max=512"`
+	Tags               []string
+	Availability       types.EAvailability
+	IconID             types.ID `mapconv:"Icon.ID"`
+	CreatedAt          time.Time
+	ModifiedAt         time.Time
+	Class              string                    `mapconv:"Provider.Class,default=simplemon"`
+	Target             string                    `mapconv:"Name/Status.Target" validate:"required"`
+	DelayLoop          int                       `mapconv:"Settings.SimpleMonitor.DelayLoop" validate:"omitempty,min=60,max=3600"`
+	Enabled            types.StringFlag          `mapconv:"Settings.SimpleMonitor.Enabled"`
+	HealthCheck        *SimpleMonitorHealthCheck `mapconv:"Settings.SimpleMonitor.HealthCheck,recursive" validate:"required"`
+	NotifyEmailEnabled types.StringFlag          `mapconv:"Settings.SimpleMonitor.NotifyEmail.Enabled"`
+	NotifyEmailHTML    types.StringFlag          `mapconv:"Settings.SimpleMonitor.NotifyEmail.HTML"`
+	NotifySlackEnabled types.StringFlag          `mapconv:"Settings.SimpleMonitor.NotifySlack.Enabled"`
+	SlackWebhooksURL   string                    `mapconv:"Settings.SimpleMonitor.NotifySlack.IncomingWebhooksURL" validate:"omitempty,url"`
+	NotifyInterval     int                       `mapconv:"Settings.SimpleMonitor.NotifyInterval" validate:"omitempty,min=3600,max=259200"`
+	SettingsHash       string
 }
 
 // Validate validates by field tags
-func (o *VNCProxy) Validate() error {
+func (o *SimpleMonitor) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetHostName returns value of HostName
-func (o *VNCProxy) GetHostName() string {
-	return o.HostName
+// GetID returns value of ID
+func (o *SimpleMonitor) GetID() types.ID {
+	return o.ID
 }
 
-// SetHostName sets value to HostName
-func (o *VNCProxy) SetHostName(v string) {
-	o.HostName = v
+// SetID sets value to ID
+func (o *SimpleMonitor) SetID(v types.ID) {
+	o.ID = v
 }
 
-// GetIPAddress returns value of IPAddress
-func (o *VNCProxy) GetIPAddress() string {
-	return o.IPAddress
+// GetStringID gets value to StringID
+func (o *SimpleMonitor) GetStringID() string {
+	return accessor.GetStringID(o)
 }
 
-// SetIPAddress sets value to IPAddress
-func (o *VNCProxy) SetIPAddress(v string) {
-	o.IPAddress = v
+// SetStringID sets value to StringID
+func (o *SimpleMonitor) SetStringID(v string) {
+	accessor.SetStringID(o, v)
 }
 
-// convertTo returns naked VNCProxy
-func (o *VNCProxy) convertTo() (*naked.VNCProxy, error) {
-	dest := &naked.VNCProxy{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetInt64ID gets value to Int64ID
+func (o *SimpleMonitor) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
 }
 
-// convertFrom parse values from naked VNCProxy
-func (o *VNCProxy) convertFrom(naked *naked.VNCProxy) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetInt64ID sets value to Int64ID
+func (o *SimpleMonitor) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
 }
 
-/*************************************************
-* FTPServerInfo
-*************************************************/
-
-// FTPServerInfo represents API parameter/response structure
-type FTPServerInfo struct {
-	HostName  string
-	IPAddress string
+// GetName returns value of Name
+func (o *SimpleMonitor) GetName() string {
+	return o.Name
 }
 
-// Validate validates by field tags
-func (o *FTPServerInfo) Validate() error {
-	return validator.New().Struct(o)
+// SetName sets value to Name
+func (o *SimpleMonitor) SetName(v string) {
+	o.Name = v
 }
 
-// GetHostName returns value of HostName
-func (o *FTPServerInfo) GetHostName() string {
-	return o.HostName
+// GetDescription returns value of Description
+func (o *SimpleMonitor) GetDescription() string {
+	return o.Description
 }
 
-// SetHostName sets value to HostName
-func (o *FTPServerInfo) SetHostName(v string) {
-	o.HostName = v
+// SetDescription sets value to Description
+func (o *SimpleMonitor) SetDescription(v string) {
+	o.Description = v
 }
 
-// GetIPAddress returns value of IPAddress
-func (o *FTPServerInfo) GetIPAddress() string {
-	return o.IPAddress
+// GetTags returns value of Tags
+func (o *SimpleMonitor) GetTags() []string {
+	return o.Tags
 }
 
-// SetIPAddress sets value to IPAddress
-func (o *FTPServerInfo) SetIPAddress(v string) {
-	o.IPAddress = v
+// SetTags sets value to Tags
+func (o *SimpleMonitor) SetTags(v []string) {
+	o.Tags = v
 }
 
-// convertTo returns naked FTPServerInfo
-func (o *FTPServerInfo) convertTo() (*naked.FTPServer, error) {
-	dest := &naked.FTPServer{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetAvailability returns value of Availability
+func (o *SimpleMonitor) GetAvailability() types.EAvailability {
+	return o.Availability
 }
 
-// convertFrom parse values from naked FTPServerInfo
-func (o *FTPServerInfo) convertFrom(naked *naked.FTPServer) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetAvailability sets value to Availability
+func (o *SimpleMonitor) SetAvailability(v types.EAvailability) {
+	o.Availability = v
 }
 
-/*************************************************
-* ServerCreateRequest
-*************************************************/
-
-// ServerCreateRequest represents API parameter/response structure
-type ServerCreateRequest struct {
-	CPU                  int                    `mapconv:"ServerPlan.CPU"`
-	MemoryMB             int                    `mapconv:"ServerPlan.MemoryMB"`
-	ServerPlanCommitment types.ECommitment      `mapconv:"ServerPlan.Commitment,default=standard"`
-	ServerPlanGeneration types.EPlanGeneration  `mapconv:"ServerPlan.Generation"`
-	ConnectedSwitches    []*ConnectedSwitch     `json:",omitempty" mapconv:"[]ConnectedSwitches,recursive"`
-	InterfaceDriver      types.EInterfaceDriver `mapconv:",default=virtio"`
-	HostName             string
-	Name                 string `validate:"required"`
-	Description          string `validate:"min=0,max=512"`
-	Tags                 []string
-	IconID               types.ID `mapconv:"Icon.ID"`
-	WaitDiskMigration    bool     `json:",omitempty" mapconv:",omitempty"`
+// GetIconID returns value of IconID
+func (o *SimpleMonitor) GetIconID() types.ID {
+	return o.IconID
 }
 
-// Validate validates by field tags
-func (o *ServerCreateRequest) Validate() error {
-	return validator.New().Struct(o)
+// SetIconID sets value to IconID
+func (o *SimpleMonitor) SetIconID(v types.ID) {
+	o.IconID = v
 }
 
-// GetCPU returns value of CPU
-func (o *ServerCreateRequest) GetCPU() int {
-	return o.CPU
+// GetCreatedAt returns value of CreatedAt
+func (o *SimpleMonitor) GetCreatedAt() time.Time {
+	return o.CreatedAt
 }
 
-// SetCPU sets value to CPU
-func (o *ServerCreateRequest) SetCPU(v int) {
-	o.CPU = v
+// SetCreatedAt sets value to CreatedAt
+func (o *SimpleMonitor) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
 }
 
-// GetMemoryMB returns value of MemoryMB
-func (o *ServerCreateRequest) GetMemoryMB() int {
-	return o.MemoryMB
+// GetModifiedAt returns value of ModifiedAt
+func (o *SimpleMonitor) GetModifiedAt() time.Time {
+	return o.ModifiedAt
 }
 
-// SetMemoryMB sets value to MemoryMB
-func (o *ServerCreateRequest) SetMemoryMB(v int) {
-	o.MemoryMB = v
+// SetModifiedAt sets value to ModifiedAt
+func (o *SimpleMonitor) SetModifiedAt(v time.Time) {
+	o.ModifiedAt = v
 }
 
-// GetMemoryGB gets value to MemoryGB
-func (o *ServerCreateRequest) GetMemoryGB() int {
-	return accessor.GetMemoryGB(o)
+// GetClass returns value of Class
+func (o *SimpleMonitor) GetClass() string {
+	return o.Class
 }
 
-// SetMemoryGB sets value to MemoryGB
-func (o *ServerCreateRequest) SetMemoryGB(v int) {
-	accessor.SetMemoryGB(o, v)
+// SetClass sets value to Class
+func (o *SimpleMonitor) SetClass(v string) {
+	o.Class = v
 }
 
-// GetServerPlanCommitment returns value of ServerPlanCommitment
-func (o *ServerCreateRequest) GetServerPlanCommitment() types.ECommitment {
-	return o.ServerPlanCommitment
+// GetTarget returns value of Target
+func (o *SimpleMonitor) GetTarget() string {
+	return o.Target
 }
 
-// SetServerPlanCommitment sets value to ServerPlanCommitment
-func (o *ServerCreateRequest) SetServerPlanCommitment(v types.ECommitment) {
-	o.ServerPlanCommitment = v
+// SetTarget sets value to Target
+func (o *SimpleMonitor) SetTarget(v string) {
+	o.Target = v
 }
 
-// GetServerPlanGeneration returns value of ServerPlanGeneration
-func (o *ServerCreateRequest) GetServerPlanGeneration() types.EPlanGeneration {
-	return o.ServerPlanGeneration
+// GetDelayLoop returns value of DelayLoop
+func (o *SimpleMonitor) GetDelayLoop() int {
+	return o.DelayLoop
 }
 
-// SetServerPlanGeneration sets value to ServerPlanGeneration
-func (o *ServerCreateRequest) SetServerPlanGeneration(v types.EPlanGeneration) {
-	o.ServerPlanGeneration = v
+// SetDelayLoop sets value to DelayLoop
+func (o *SimpleMonitor) SetDelayLoop(v int) {
+	o.DelayLoop = v
 }
 
-// GetConnectedSwitches returns value of ConnectedSwitches
-func (o *ServerCreateRequest) GetConnectedSwitches() []*ConnectedSwitch {
-	return o.ConnectedSwitches
+// GetEnabled returns value of Enabled
+func (o *SimpleMonitor) GetEnabled() types.StringFlag {
+	return o.Enabled
 }
 
-// SetConnectedSwitches sets value to ConnectedSwitches
-func (o *ServerCreateRequest) SetConnectedSwitches(v []*ConnectedSwitch) {
-	o.ConnectedSwitches = v
+// SetEnabled sets value to Enabled
+func (o *SimpleMonitor) SetEnabled(v types.StringFlag) {
+	o.Enabled = v
 }
 
-// GetInterfaceDriver returns value of InterfaceDriver
-func (o *ServerCreateRequest) GetInterfaceDriver() types.EInterfaceDriver {
-	return o.InterfaceDriver
+// GetHealthCheck returns value of HealthCheck
+func (o *SimpleMonitor) GetHealthCheck() *SimpleMonitorHealthCheck {
+	return o.HealthCheck
 }
 
-// SetInterfaceDriver sets value to InterfaceDriver
-func (o *ServerCreateRequest) SetInterfaceDriver(v types.EInterfaceDriver) {
-	o.InterfaceDriver = v
+// SetHealthCheck sets value to HealthCheck
+func (o *SimpleMonitor) SetHealthCheck(v *SimpleMonitorHealthCheck) {
+	o.HealthCheck = v
 }
 
-// GetHostName returns value of HostName
-func (o *ServerCreateRequest) GetHostName() string {
-	return o.HostName
+// GetNotifyEmailEnabled returns value of NotifyEmailEnabled
+func (o *SimpleMonitor) GetNotifyEmailEnabled() types.StringFlag {
+	return o.NotifyEmailEnabled
 }
 
-// SetHostName sets value to HostName
-func (o *ServerCreateRequest) SetHostName(v string) {
-	o.HostName = v
+// SetNotifyEmailEnabled sets value to NotifyEmailEnabled
+func (o *SimpleMonitor) SetNotifyEmailEnabled(v types.StringFlag) {
+	o.NotifyEmailEnabled = v
 }
 
-// GetName returns value of Name
-func (o *ServerCreateRequest) GetName() string {
-	return o.Name
+// GetNotifyEmailHTML returns value of NotifyEmailHTML
+func (o *SimpleMonitor) GetNotifyEmailHTML() types.StringFlag {
+	return o.NotifyEmailHTML
 }
 
-// SetName sets value to Name
-func (o *ServerCreateRequest) SetName(v string) {
-	o.Name = v
+// SetNotifyEmailHTML sets value to NotifyEmailHTML
+func (o *SimpleMonitor) SetNotifyEmailHTML(v types.StringFlag) {
+	o.NotifyEmailHTML = v
 }
 
-// GetDescription returns value of Description
-func (o *ServerCreateRequest) GetDescription() string {
-	return o.Description
+// GetNotifySlackEnabled returns value of NotifySlackEnabled
+func (o *SimpleMonitor) GetNotifySlackEnabled() types.StringFlag {
+	return o.NotifySlackEnabled
 }
 
-// SetDescription sets value to Description
-func (o *ServerCreateRequest) SetDescription(v string) {
-	o.Description = v
+// SetNotifySlackEnabled sets value to NotifySlackEnabled
+func (o *SimpleMonitor) SetNotifySlackEnabled(v types.StringFlag) {
+	o.NotifySlackEnabled = v
 }
 
-// GetTags returns value of Tags
-func (o *ServerCreateRequest) GetTags() []string {
-	return o.Tags
+// GetSlackWebhooksURL returns value of SlackWebhooksURL
+func (o *SimpleMonitor) GetSlackWebhooksURL() string {
+	return o.SlackWebhooksURL
 }
 
-// SetTags sets value to Tags
-func (o *ServerCreateRequest) SetTags(v []string) {
-	o.Tags = v
+// SetSlackWebhooksURL sets value to SlackWebhooksURL
+func (o *SimpleMonitor) SetSlackWebhooksURL(v string) {
+	o.SlackWebhooksURL = v
 }
 
-// GetIconID returns value of IconID
-func (o *ServerCreateRequest) GetIconID() types.ID {
-	return o.IconID
+// GetNotifyInterval returns value of NotifyInterval
+func (o *SimpleMonitor) GetNotifyInterval() int {
+	return o.NotifyInterval
 }
 
-// SetIconID sets value to IconID
-func (o *ServerCreateRequest) SetIconID(v types.ID) {
-	o.IconID = v
+// SetNotifyInterval sets value to NotifyInterval
+func (o *SimpleMonitor) SetNotifyInterval(v int) {
+	o.NotifyInterval = v
 }
 
-// GetWaitDiskMigration returns value of WaitDiskMigration
-func (o *ServerCreateRequest) GetWaitDiskMigration() bool {
-	return o.WaitDiskMigration
+// GetSettingsHash returns value of SettingsHash
+func (o *SimpleMonitor) GetSettingsHash() string {
+	return o.SettingsHash
 }
 
-// SetWaitDiskMigration sets value to WaitDiskMigration
-func (o *ServerCreateRequest) SetWaitDiskMigration(v bool) {
-	o.WaitDiskMigration = v
+// SetSettingsHash sets value to SettingsHash
+func (o *SimpleMonitor) SetSettingsHash(v string) {
+	o.SettingsHash = v
 }
 
-// convertTo returns naked ServerCreateRequest
-func (o *ServerCreateRequest) convertTo() (*naked.Server, error) {
-	dest := &naked.Server{}
+// convertTo returns naked SimpleMonitor
+func (o *SimpleMonitor) convertTo() (*naked.SimpleMonitor, error) {
+	dest := &naked.SimpleMonitor{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked ServerCreateRequest
-func (o *ServerCreateRequest) convertFrom(naked *naked.Server) error {
+// convertFrom parse values from naked SimpleMonitor
+func (o *SimpleMonitor) convertFrom(naked *naked.SimpleMonitor) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* ConnectedSwitch
+* SimpleMonitorHealthCheck
 *************************************************/
 
-// ConnectedSwitch represents API parameter/response structure
-type ConnectedSwitch struct {
-	ID    types.ID
-	Scope types.EScope
+// SimpleMonitorHealthCheck represents API parameter/response structure
+type SimpleMonitorHealthCheck struct {
+	Protocol          types.ESimpleMonitorProtocol `validate:"required,oneof=http https ping tcp dns ssh smtp pop3 snmp sslcertificate"`
+	Port              types.StringNumber
+	Path              string
+	Status            types.StringNumber
+	SNI               types.StringFlag
+	Host              string
+	BasicAuthUsername string
+	BasicAuthPassword string
+	QName             string
+	ExpectedData      string
+	Community         string
+	SNMPVersion       string
+	OID               string
+	RemainingDays     int
 }
 
 // Validate validates by field tags
-func (o *ConnectedSwitch) Validate() error {
+func (o *SimpleMonitorHealthCheck) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetID returns value of ID
-func (o *ConnectedSwitch) GetID() types.ID {
-	return o.ID
+// GetProtocol returns value of Protocol
+func (o *SimpleMonitorHealthCheck) GetProtocol() types.ESimpleMonitorProtocol {
+	return o.Protocol
 }
 
-// SetID sets value to ID
-func (o *ConnectedSwitch) SetID(v types.ID) {
-	o.ID = v
+// SetProtocol sets value to Protocol
+func (o *SimpleMonitorHealthCheck) SetProtocol(v types.ESimpleMonitorProtocol) {
+	o.Protocol = v
 }
 
-// GetStringID gets value to StringID
-func (o *ConnectedSwitch) GetStringID() string {
-	return accessor.GetStringID(o)
+// GetPort returns value of Port
+func (o *SimpleMonitorHealthCheck) GetPort() types.StringNumber {
+	return o.Port
 }
 
-// SetStringID sets value to StringID
-func (o *ConnectedSwitch) SetStringID(v string) {
-	accessor.SetStringID(o, v)
+// SetPort sets value to Port
+func (o *SimpleMonitorHealthCheck) SetPort(v types.StringNumber) {
+	o.Port = v
 }
 
-// GetInt64ID gets value to Int64ID
-func (o *ConnectedSwitch) GetInt64ID() int64 {
-	return accessor.GetInt64ID(o)
+// GetPath returns value of Path
+func (o *SimpleMonitorHealthCheck) GetPath() string {
+	return o.Path
 }
 
-// SetInt64ID sets value to Int64ID
-func (o *ConnectedSwitch) SetInt64ID(v int64) {
-	accessor.SetInt64ID(o, v)
+// SetPath sets value to Path
+func (o *SimpleMonitorHealthCheck) SetPath(v string) {
+	o.Path = v
 }
 
-// GetScope returns value of Scope
-func (o *ConnectedSwitch) GetScope() types.EScope {
-	return o.Scope
+// GetStatus returns value of Status
+func (o *SimpleMonitorHealthCheck) GetStatus() types.StringNumber {
+	return o.Status
 }
 
-// SetScope sets value to Scope
-func (o *ConnectedSwitch) SetScope(v types.EScope) {
-	o.Scope = v
+// SetStatus sets value to Status
+func (o *SimpleMonitorHealthCheck) SetStatus(v types.StringNumber) {
+	o.Status = v
 }
 
-// convertTo returns naked ConnectedSwitch
-func (o *ConnectedSwitch) convertTo() (*naked.ConnectedSwitch, error) {
-	dest := &naked.ConnectedSwitch{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetSNI returns value of SNI
+func (o *SimpleMonitorHealthCheck) GetSNI() types.StringFlag {
+	return o.SNI
 }
 
-// convertFrom parse values from naked ConnectedSwitch
-func (o *ConnectedSwitch) convertFrom(naked *naked.ConnectedSwitch) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetSNI sets value to SNI
+func (o *SimpleMonitorHealthCheck) SetSNI(v types.StringFlag) {
+	o.SNI = v
 }
 
-/*************************************************
-* ServerUpdateRequest
-*************************************************/
+// GetHost returns value of Host
+func (o *SimpleMonitorHealthCheck) GetHost() string {
+	return o.Host
+}
 
-// ServerUpdateRequest represents API parameter/response structure
-type ServerUpdateRequest struct {
-	Name        string `validate:"required"`
-	Description string `validate:"min=0,max=512"`
-	Tags        []string
-	IconID      types.ID `mapconv:"Icon.ID"`
+// SetHost sets value to Host
+func (o *SimpleMonitorHealthCheck) SetHost(v string) {
+	o.Host = v
 }
 
-// Validate validates by field tags
-func (o *ServerUpdateRequest) Validate() error {
-	return validator.New().Struct(o)
+// GetBasicAuthUsername returns value of BasicAuthUsername
+func (o *SimpleMonitorHealthCheck) GetBasicAuthUsername() string {
+	return o.BasicAuthUsername
 }
 
-// GetName returns value of Name
-func (o *ServerUpdateRequest) GetName() string {
-	return o.Name
+// SetBasicAuthUsername sets value to BasicAuthUsername
+func (o *SimpleMonitorHealthCheck) SetBasicAuthUsername(v string) {
+	o.BasicAuthUsername = v
 }
 
-// SetName sets value to Name
-func (o *ServerUpdateRequest) SetName(v string) {
-	o.Name = v
+// GetBasicAuthPassword returns value of BasicAuthPassword
+func (o *SimpleMonitorHealthCheck) GetBasicAuthPassword() string {
+	return o.BasicAuthPassword
 }
 
-// GetDescription returns value of Description
-func (o *ServerUpdateRequest) GetDescription() string {
-	return o.Description
+// SetBasicAuthPassword sets value to BasicAuthPassword
+func (o *SimpleMonitorHealthCheck) SetBasicAuthPassword(v string) {
+	o.BasicAuthPassword = v
 }
 
-// SetDescription sets value to Description
-func (o *ServerUpdateRequest) SetDescription(v string) {
-	o.Description = v
+// GetQName returns value of QName
+func (o *SimpleMonitorHealthCheck) GetQName() string {
+	return o.QName
 }
 
-// GetTags returns value of Tags
-func (o *ServerUpdateRequest) GetTags() []string {
-	return o.Tags
+// SetQName sets value to QName
+func (o *SimpleMonitorHealthCheck) SetQName(v string) {
+	o.QName = v
 }
 
-// SetTags sets value to Tags
-func (o *ServerUpdateRequest) SetTags(v []string) {
-	o.Tags = v
+// GetExpectedData returns value of ExpectedData
+func (o *SimpleMonitorHealthCheck) GetExpectedData() string {
+	return o.ExpectedData
 }
 
-// GetIconID returns value of IconID
-func (o *ServerUpdateRequest) GetIconID() types.ID {
-	return o.IconID
+// SetExpectedData sets value to ExpectedData
+func (o *SimpleMonitorHealthCheck) SetExpectedData(v string) {
+	o.ExpectedData = v
 }
 
-// SetIconID sets value to IconID
-func (o *ServerUpdateRequest) SetIconID(v types.ID) {
-	o.IconID = v
+// GetCommunity returns value of Community
+func (o *SimpleMonitorHealthCheck) GetCommunity() string {
+	return o.Community
 }
 
-// convertTo returns naked ServerUpdateRequest
-func (o *ServerUpdateRequest) convertTo() (*naked.Server, error) {
-	dest := &naked.Server{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// SetCommunity sets value to Community
+func (o *SimpleMonitorHealthCheck) SetCommunity(v string) {
+	o.Community = v
 }
 
-// convertFrom parse values from naked ServerUpdateRequest
-func (o *ServerUpdateRequest) convertFrom(naked *naked.Server) error {
-	return mapconv.ConvertFrom(naked, o)
+// GetSNMPVersion returns value of SNMPVersion
+func (o *SimpleMonitorHealthCheck) GetSNMPVersion() string {
+	return o.SNMPVersion
+}
+
+// SetSNMPVersion sets value to SNMPVersion
+func (o *SimpleMonitorHealthCheck) SetSNMPVersion(v string) {
+	o.SNMPVersion = v
+}
+
+// GetOID returns value of OID
+func (o *SimpleMonitorHealthCheck) GetOID() string {
+	return o.OID
+}
+
+// SetOID sets value to OID
+func (o *SimpleMonitorHealthCheck) SetOID(v string) {
+	o.OID = v
+}
+
+// GetRemainingDays returns value of RemainingDays
+func (o *SimpleMonitorHealthCheck) GetRemainingDays() int {
+	return o.RemainingDays
+}
+
+// SetRemainingDays sets value to RemainingDays
+func (o *SimpleMonitorHealthCheck) SetRemainingDays(v int) {
+	o.RemainingDays = v
 }
 
 /*************************************************
-* ServerChangePlanRequest
+* SimpleMonitorCreateRequest
 *************************************************/
 
-// ServerChangePlanRequest represents API parameter/response structure
-type ServerChangePlanRequest struct {
-	CPU                  int
-	MemoryMB             int
-	ServerPlanGeneration types.EPlanGeneration
-	ServerPlanCommitment types.ECommitment `mapconv:"ServerPlan.Commitment,default=standard"`
+// SimpleMonitorCreateRequest represents API parameter/response structure
+type SimpleMonitorCreateRequest struct {
+	Class              string                    `mapconv:"Provider.Class,default=simplemon"`
+	Target             string                    `mapconv:"Name/Status.Target" validate:"required"`
+	DelayLoop          int                       `mapconv:"Settings.SimpleMonitor.DelayLoop" validate:"omitempty,min=60,max=3600"`
+	Enabled            types.StringFlag          `mapconv:"Settings.SimpleMonitor.Enabled"`
+	HealthCheck        *SimpleMonitorHealthCheck `mapconv:"Settings.SimpleMonitor.HealthCheck,recursive" validate:"required"`
+	NotifyEmailEnabled types.StringFlag          `mapconv:"Settings.SimpleMonitor.NotifyEmail.Enabled"`
+	NotifyEmailHTML    types.StringFlag          `mapconv:"Settings.SimpleMonitor.NotifyEmail.HTML"`
+	NotifySlackEnabled types.StringFlag          `mapconv:"Settings.SimpleMonitor.NotifySlack.Enabled"`
+	SlackWebhooksURL   string                    `mapconv:"Settings.SimpleMonitor.NotifySlack.IncomingWebhooksURL" validate:"omitempty,url"`
+	NotifyInterval     int                       `mapconv:"Settings.SimpleMonitor.NotifyInterval" validate:"omitempty,min=3600,max=259200"`
+	Description        string                    `validate:"min=0,max=512"`
+	Tags               []string
+	IconID             types.ID `mapconv:"Icon.ID"`
 }
 
 // Validate validates by field tags
-func (o *ServerChangePlanRequest) Validate() error {
+func (o *SimpleMonitorCreateRequest) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetCPU returns value of CPU
-func (o *ServerChangePlanRequest) GetCPU() int {
-	return o.CPU
+// GetClass returns value of Class
+func (o *SimpleMonitorCreateRequest) GetClass() string {
+	return o.Class
 }
 
-// SetCPU sets value to CPU
-func (o *ServerChangePlanRequest) SetCPU(v int) {
-	o.CPU = v
+// SetClass sets value to Class
+func (o *SimpleMonitorCreateRequest) SetClass(v string) {
+	o.Class = v
 }
 
-// GetMemoryMB returns value of MemoryMB
-func (o *ServerChangePlanRequest) GetMemoryMB() int {
-	return o.MemoryMB
+// GetTarget returns value of Target
+func (o *SimpleMonitorCreateRequest) GetTarget() string {
+	return o.Target
+}
+
+// SetTarget sets value to Target
+func (o *SimpleMonitorCreateRequest) SetTarget(v string) {
+	o.Target = v
+}
+
+// GetDelayLoop returns value of DelayLoop
+func (o *SimpleMonitorCreateRequest) GetDelayLoop() int {
+	return o.DelayLoop
+}
+
+// SetDelayLoop sets value to DelayLoop
+func (o *SimpleMonitorCreateRequest) SetDelayLoop(v int) {
+	o.DelayLoop = v
+}
+
+// GetEnabled returns value of Enabled
+func (o *SimpleMonitorCreateRequest) GetEnabled() types.StringFlag {
+	return o.Enabled
+}
+
+// SetEnabled sets value to Enabled
+func (o *SimpleMonitorCreateRequest) SetEnabled(v types.StringFlag) {
+	o.Enabled = v
 }
 
-// SetMemoryMB sets value to MemoryMB
-func (o *ServerChangePlanRequest) SetMemoryMB(v int) {
-	o.MemoryMB = v
+// GetHealthCheck returns value of HealthCheck
+func (o *SimpleMonitorCreateRequest) GetHealthCheck() *SimpleMonitorHealthCheck {
+	return o.HealthCheck
 }
 
-// GetMemoryGB gets value to MemoryGB
-func (o *ServerChangePlanRequest) GetMemoryGB() int {
-	return accessor.GetMemoryGB(o)
+// SetHealthCheck sets value to HealthCheck
+func (o *SimpleMonitorCreateRequest) SetHealthCheck(v *SimpleMonitorHealthCheck) {
+	o.HealthCheck = v
 }
 
-// SetMemoryGB sets value to MemoryGB
-func (o *ServerChangePlanRequest) SetMemoryGB(v int) {
-	accessor.SetMemoryGB(o, v)
+// GetNotifyEmailEnabled returns value of NotifyEmailEnabled
+func (o *SimpleMonitorCreateRequest) GetNotifyEmailEnabled() types.StringFlag {
+	return o.NotifyEmailEnabled
 }
 
-// GetServerPlanGeneration returns value of ServerPlanGeneration
-func (o *ServerChangePlanRequest) GetServerPlanGeneration() types.EPlanGeneration {
-	return o.ServerPlanGeneration
+// SetNotifyEmailEnabled sets value to NotifyEmailEnabled
+func (o *SimpleMonitorCreateRequest) SetNotifyEmailEnabled(v types.StringFlag) {
+	o.NotifyEmailEnabled = v
 }
 
-// SetServerPlanGeneration sets value to ServerPlanGeneration
-func (o *ServerChangePlanRequest) SetServerPlanGeneration(v types.EPlanGeneration) {
-	o.ServerPlanGeneration = v
+// GetNotifyEmailHTML returns value of NotifyEmailHTML
+func (o *SimpleMonitorCreateRequest) GetNotifyEmailHTML() types.StringFlag {
+	return o.NotifyEmailHTML
 }
 
-// GetServerPlanCommitment returns value of ServerPlanCommitment
-func (o *ServerChangePlanRequest) GetServerPlanCommitment() types.ECommitment {
-	return o.ServerPlanCommitment
+// SetNotifyEmailHTML sets value to NotifyEmailHTML
+func (o *SimpleMonitorCreateRequest) SetNotifyEmailHTML(v types.StringFlag) {
+	o.NotifyEmailHTML = v
 }
 
-// SetServerPlanCommitment sets value to ServerPlanCommitment
-func (o *ServerChangePlanRequest) SetServerPlanCommitment(v types.ECommitment) {
-	o.ServerPlanCommitment = v
+// GetNotifySlackEnabled returns value of NotifySlackEnabled
+func (o *SimpleMonitorCreateRequest) GetNotifySlackEnabled() types.StringFlag {
+	return o.NotifySlackEnabled
 }
 
-// convertTo returns naked ServerChangePlanRequest
-func (o *ServerChangePlanRequest) convertTo() (*naked.ServerPlan, error) {
-	dest := &naked.ServerPlan{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// SetNotifySlackEnabled sets value to NotifySlackEnabled
+func (o *SimpleMonitorCreateRequest) SetNotifySlackEnabled(v types.StringFlag) {
+	o.NotifySlackEnabled = v
 }
 
-// convertFrom parse values from naked ServerChangePlanRequest
-func (o *ServerChangePlanRequest) convertFrom(naked *naked.ServerPlan) error {
-	return mapconv.ConvertFrom(naked, o)
+// GetSlackWebhooksURL returns value of SlackWebhooksURL
+func (o *SimpleMonitorCreateRequest) GetSlackWebhooksURL() string {
+	return o.SlackWebhooksURL
 }
 
-/*************************************************
-* InsertCDROMRequest
-*************************************************/
+// SetSlackWebhooksURL sets value to SlackWebhooksURL
+func (o *SimpleMonitorCreateRequest) SetSlackWebhooksURL(v string) {
+	o.SlackWebhooksURL = v
+}
 
-// InsertCDROMRequest represents API parameter/response structure
-type InsertCDROMRequest struct {
-	ID types.ID
+// GetNotifyInterval returns value of NotifyInterval
+func (o *SimpleMonitorCreateRequest) GetNotifyInterval() int {
+	return o.NotifyInterval
 }
 
-// Validate validates by field tags
-func (o *InsertCDROMRequest) Validate() error {
-	return validator.New().Struct(o)
+// SetNotifyInterval sets value to NotifyInterval
+func (o *SimpleMonitorCreateRequest) SetNotifyInterval(v int) {
+	o.NotifyInterval = v
 }
 
-// GetID returns value of ID
-func (o *InsertCDROMRequest) GetID() types.ID {
-	return o.ID
+// GetDescription returns value of Description
+func (o *SimpleMonitorCreateRequest) GetDescription() string {
+	return o.Description
 }
 
-// SetID sets value to ID
-func (o *InsertCDROMRequest) SetID(v types.ID) {
-	o.ID = v
+// SetDescription sets value to Description
+func (o *SimpleMonitorCreateRequest) SetDescription(v string) {
+	o.Description = v
 }
 
-// GetStringID gets value to StringID
-func (o *InsertCDROMRequest) GetStringID() string {
-	return accessor.GetStringID(o)
+// GetTags returns value of Tags
+func (o *SimpleMonitorCreateRequest) GetTags() []string {
+	return o.Tags
 }
 
-// SetStringID sets value to StringID
-func (o *InsertCDROMRequest) SetStringID(v string) {
-	accessor.SetStringID(o, v)
+// SetTags sets value to Tags
+func (o *SimpleMonitorCreateRequest) SetTags(v []string) {
+	o.Tags = v
 }
 
-// GetInt64ID gets value to Int64ID
-func (o *InsertCDROMRequest) GetInt64ID() int64 {
-	return accessor.GetInt64ID(o)
+// GetIconID returns value of IconID
+func (o *SimpleMonitorCreateRequest) GetIconID() types.ID {
+	return o.IconID
 }
 
-// SetInt64ID sets value to Int64ID
-func (o *InsertCDROMRequest) SetInt64ID(v int64) {
-	accessor.SetInt64ID(o, v)
+// SetIconID sets value to IconID
+func (o *SimpleMonitorCreateRequest) SetIconID(v types.ID) {
+	o.IconID = v
 }
 
-// convertTo returns naked InsertCDROMRequest
-func (o *InsertCDROMRequest) convertTo() (*naked.CDROM, error) {
-	dest := &naked.CDROM{}
+// convertTo returns naked SimpleMonitorCreateRequest
+func (o *SimpleMonitorCreateRequest) convertTo() (*naked.SimpleMonitor, error) {
+	dest := &naked.SimpleMonitor{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked InsertCDROMRequest
-func (o *InsertCDROMRequest) convertFrom(naked *naked.CDROM) error {
+// convertFrom parse values from naked SimpleMonitorCreateRequest
+func (o *SimpleMonitorCreateRequest) convertFrom(naked *naked.SimpleMonitor) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* EjectCDROMRequest
+* SimpleMonitorUpdateRequest
 *************************************************/
 
-// EjectCDROMRequest represents API parameter/response structure
-type EjectCDROMRequest struct {
-	ID types.ID
+// SimpleMonitorUpdateRequest represents API parameter/response structure
+type SimpleMonitorUpdateRequest struct {
+	DelayLoop          int                       `mapconv:"Settings.SimpleMonitor.DelayLoop" validate:"omitempty,min=60,max=3600"`
+	Enabled            types.StringFlag          `mapconv:"Settings.SimpleMonitor.Enabled"`
+	HealthCheck        *SimpleMonitorHealthCheck `mapconv:"Settings.SimpleMonitor.HealthCheck,recursive" validate:"required"`
+	NotifyEmailEnabled types.StringFlag          `mapconv:"Settings.SimpleMonitor.NotifyEmail.Enabled"`
+	NotifyEmailHTML    types.StringFlag          `mapconv:"Settings.SimpleMonitor.NotifyEmail.HTML"`
+	NotifySlackEnabled types.StringFlag          `mapconv:"Settings.SimpleMonitor.NotifySlack.Enabled"`
+	SlackWebhooksURL   string                    `mapconv:"Settings.SimpleMonitor.NotifySlack.IncomingWebhooksURL" validate:"omitempty,url"`
+	NotifyInterval     int                       `mapconv:"Settings.SimpleMonitor.NotifyInterval" validate:"omitempty,min=3600,max=259200"`
+	Description        string                    `validate:"min=0,max=512"`
+	Tags               []string
+	IconID             types.ID `mapconv:"Icon.ID"`
 }
 
 // Validate validates by field tags
-func (o *EjectCDROMRequest) Validate() error {
+func (o *SimpleMonitorUpdateRequest) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetID returns value of ID
-func (o *EjectCDROMRequest) GetID() types.ID {
-	return o.ID
+// GetDelayLoop returns value of DelayLoop
+func (o *SimpleMonitorUpdateRequest) GetDelayLoop() int {
+	return o.DelayLoop
 }
 
-// SetID sets value to ID
-func (o *EjectCDROMRequest) SetID(v types.ID) {
-	o.ID = v
+// SetDelayLoop sets value to DelayLoop
+func (o *SimpleMonitorUpdateRequest) SetDelayLoop(v int) {
+	o.DelayLoop = v
 }
 
-// GetStringID gets value to StringID
-func (o *EjectCDROMRequest) GetStringID() string {
-	return accessor.GetStringID(o)
+// GetEnabled returns value of Enabled
+func (o *SimpleMonitorUpdateRequest) GetEnabled() types.StringFlag {
+	return o.Enabled
 }
 
-// SetStringID sets value to StringID
-func (o *EjectCDROMRequest) SetStringID(v string) {
-	accessor.SetStringID(o, v)
+// SetEnabled sets value to Enabled
+func (o *SimpleMonitorUpdateRequest) SetEnabled(v types.StringFlag) {
+	o.Enabled = v
 }
 
-// GetInt64ID gets value to Int64ID
-func (o *EjectCDROMRequest) GetInt64ID() int64 {
-	return accessor.GetInt64ID(o)
+// GetHealthCheck returns value of HealthCheck
+func (o *SimpleMonitorUpdateRequest) GetHealthCheck() *SimpleMonitorHealthCheck {
+	return o.HealthCheck
 }
 
-// SetInt64ID sets value to Int64ID
-func (o *EjectCDROMRequest) SetInt64ID(v int64) {
-	accessor.SetInt64ID(o, v)
+// SetHealthCheck sets value to HealthCheck
+func (o *SimpleMonitorUpdateRequest) SetHealthCheck(v *SimpleMonitorHealthCheck) {
+	o.HealthCheck = v
 }
 
-// convertTo returns naked EjectCDROMRequest
-func (o *EjectCDROMRequest) convertTo() (*naked.CDROM, error) {
-	dest := &naked.CDROM{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetNotifyEmailEnabled returns value of NotifyEmailEnabled
+func (o *SimpleMonitorUpdateRequest) GetNotifyEmailEnabled() types.StringFlag {
+	return o.NotifyEmailEnabled
 }
 
-// convertFrom parse values from naked EjectCDROMRequest
-func (o *EjectCDROMRequest) convertFrom(naked *naked.CDROM) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetNotifyEmailEnabled sets value to NotifyEmailEnabled
+func (o *SimpleMonitorUpdateRequest) SetNotifyEmailEnabled(v types.StringFlag) {
+	o.NotifyEmailEnabled = v
 }
 
-/*************************************************
-* CPUTimeActivity
-*************************************************/
+// GetNotifyEmailHTML returns value of NotifyEmailHTML
+func (o *SimpleMonitorUpdateRequest) GetNotifyEmailHTML() types.StringFlag {
+	return o.NotifyEmailHTML
+}
 
-// CPUTimeActivity represents API parameter/response structure
-type CPUTimeActivity struct {
-	Values []*MonitorCPUTimeValue `mapconv:"[]CPU"`
+// SetNotifyEmailHTML sets value to NotifyEmailHTML
+func (o *SimpleMonitorUpdateRequest) SetNotifyEmailHTML(v types.StringFlag) {
+	o.NotifyEmailHTML = v
 }
 
-// Validate validates by field tags
-func (o *CPUTimeActivity) Validate() error {
-	return validator.New().Struct(o)
+// GetNotifySlackEnabled returns value of NotifySlackEnabled
+func (o *SimpleMonitorUpdateRequest) GetNotifySlackEnabled() types.StringFlag {
+	return o.NotifySlackEnabled
 }
 
-// GetValues returns value of Values
-func (o *CPUTimeActivity) GetValues() []*MonitorCPUTimeValue {
-	return o.Values
+// SetNotifySlackEnabled sets value to NotifySlackEnabled
+func (o *SimpleMonitorUpdateRequest) SetNotifySlackEnabled(v types.StringFlag) {
+	o.NotifySlackEnabled = v
 }
 
-// SetValues sets value to Values
-func (o *CPUTimeActivity) SetValues(v []*MonitorCPUTimeValue) {
-	o.Values = v
+// GetSlackWebhooksURL returns value of SlackWebhooksURL
+func (o *SimpleMonitorUpdateRequest) GetSlackWebhooksURL() string {
+	return o.SlackWebhooksURL
 }
 
-// convertTo returns naked CPUTimeActivity
-func (o *CPUTimeActivity) convertTo() (*naked.MonitorValues, error) {
-	dest := &naked.MonitorValues{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// SetSlackWebhooksURL sets value to SlackWebhooksURL
+func (o *SimpleMonitorUpdateRequest) SetSlackWebhooksURL(v string) {
+	o.SlackWebhooksURL = v
 }
 
-// convertFrom parse values from naked CPUTimeActivity
-func (o *CPUTimeActivity) convertFrom(naked *naked.MonitorValues) error {
-	return mapconv.ConvertFrom(naked, o)
+// GetNotifyInterval returns value of NotifyInterval
+func (o *SimpleMonitorUpdateRequest) GetNotifyInterval() int {
+	return o.NotifyInterval
 }
 
-/*************************************************
-* MonitorCPUTimeValue
-*************************************************/
+// SetNotifyInterval sets value to NotifyInterval
+func (o *SimpleMonitorUpdateRequest) SetNotifyInterval(v int) {
+	o.NotifyInterval = v
+}
 
-// MonitorCPUTimeValue represents API parameter/response structure
-type MonitorCPUTimeValue struct {
-	Time    time.Time `json:",omitempty" mapconv:",omitempty"`
-	CPUTime float64   `json:",omitempty" mapconv:",omitempty"`
+// GetDescription returns value of Description
+func (o *SimpleMonitorUpdateRequest) GetDescription() string {
+	return o.Description
 }
 
-// Validate validates by field tags
-func (o *MonitorCPUTimeValue) Validate() error {
-	return validator.New().Struct(o)
+// SetDescription sets value to Description
+func (o *SimpleMonitorUpdateRequest) SetDescription(v string) {
+	o.Description = v
 }
 
-// GetTime returns value of Time
-func (o *MonitorCPUTimeValue) GetTime() time.Time {
-	return o.Time
+// GetTags returns value of Tags
+func (o *SimpleMonitorUpdateRequest) GetTags() []string {
+	return o.Tags
 }
 
-// SetTime sets value to Time
-func (o *MonitorCPUTimeValue) SetTime(v time.Time) {
-	o.Time = v
+// SetTags sets value to Tags
+func (o *SimpleMonitorUpdateRequest) SetTags(v []string) {
+	o.Tags = v
 }
 
-// GetCPUTime returns value of CPUTime
-func (o *MonitorCPUTimeValue) GetCPUTime() float64 {
-	return o.CPUTime
+// GetIconID returns value of IconID
+func (o *SimpleMonitorUpdateRequest) GetIconID() types.ID {
+	return o.IconID
 }
 
-// SetCPUTime sets value to CPUTime
-func (o *MonitorCPUTimeValue) SetCPUTime(v float64) {
-	o.CPUTime = v
+// SetIconID sets value to IconID
+func (o *SimpleMonitorUpdateRequest) SetIconID(v types.ID) {
+	o.IconID = v
 }
 
-// convertTo returns naked MonitorCPUTimeValue
-func (o *MonitorCPUTimeValue) convertTo() (*naked.MonitorCPUTimeValue, error) {
-	dest := &naked.MonitorCPUTimeValue{}
+// convertTo returns naked SimpleMonitorUpdateRequest
+func (o *SimpleMonitorUpdateRequest) convertTo() (*naked.SimpleMonitor, error) {
+	dest := &naked.SimpleMonitor{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked MonitorCPUTimeValue
-func (o *MonitorCPUTimeValue) convertFrom(naked *naked.MonitorCPUTimeValue) error {
+// convertFrom parse values from naked SimpleMonitorUpdateRequest
+func (o *SimpleMonitorUpdateRequest) convertFrom(naked *naked.SimpleMonitor) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* SIM
+* ResponseTimeSecActivity
 *************************************************/
 
-// SIM represents API parameter/response structure
-type SIM struct {
-	ID           types.ID
-	Name         string `validate:"required"`
-	Description  string `validate:"min=0,max=512"`
-	Tags         []string
-	Availability types.EAvailability
-	Class        string
-	ICCID        types.StringNumber `mapconv:"Status.ICCID" validate:"numeric"`
-	IconID       types.ID           `mapconv:"Icon.ID"`
-	CreatedAt    time.Time
-	ModifiedAt   time.Time
+// ResponseTimeSecActivity represents API parameter/response structure
+type ResponseTimeSecActivity struct {
+	Values []*MonitorResponseTimeSecValue `mapconv:"[]ResponseTimeSec"`
 }
 
 // Validate validates by field tags
-func (o *SIM) Validate() error {
+func (o *ResponseTimeSecActivity) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetID returns value of ID
-func (o *SIM) GetID() types.ID {
-	return o.ID
-}
-
-// SetID sets value to ID
-func (o *SIM) SetID(v types.ID) {
-	o.ID = v
-}
-
-// GetStringID gets value to StringID
-func (o *SIM) GetStringID() string {
-	return accessor.GetStringID(o)
-}
-
-// SetStringID sets value to StringID
-func (o *SIM) SetStringID(v string) {
-	accessor.SetStringID(o, v)
+// GetValues returns value of Values
+func (o *ResponseTimeSecActivity) GetValues() []*MonitorResponseTimeSecValue {
+	return o.Values
 }
 
-// GetInt64ID gets value to Int64ID
-func (o *SIM) GetInt64ID() int64 {
-	return accessor.GetInt64ID(o)
+// SetValues sets value to Values
+func (o *ResponseTimeSecActivity) SetValues(v []*MonitorResponseTimeSecValue) {
+	o.Values = v
 }
 
-// SetInt64ID sets value to Int64ID
-func (o *SIM) SetInt64ID(v int64) {
-	accessor.SetInt64ID(o, v)
+// convertTo returns naked ResponseTimeSecActivity
+func (o *ResponseTimeSecActivity) convertTo() (*naked.MonitorValues, error) {
+	dest := &naked.MonitorValues{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// GetName returns value of Name
-func (o *SIM) GetName() string {
-	return o.Name
+// convertFrom parse values from naked ResponseTimeSecActivity
+func (o *ResponseTimeSecActivity) convertFrom(naked *naked.MonitorValues) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// SetName sets value to Name
-func (o *SIM) SetName(v string) {
-	o.Name = v
-}
+/*************************************************
+* MonitorResponseTimeSecValue
+*************************************************/
 
-// GetDescription returns value of Description
-func (o *SIM) GetDescription() string {
-	return o.Description
+// MonitorResponseTimeSecValue represents API parameter/response structure
+type MonitorResponseTimeSecValue struct {
+	Time            time.Time `json:",omitempty" mapconv:",omitempty"`
+	ResponseTimeSec float64   `json:",omitempty" mapconv:",omitempty"`
 }
 
-// SetDescription sets value to Description
-func (o *SIM) SetDescription(v string) {
-	o.Description = v
+// Validate validates by field tags
+func (o *MonitorResponseTimeSecValue) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetTags returns value of Tags
-func (o *SIM) GetTags() []string {
-	return o.Tags
+// GetTime returns value of Time
+func (o *MonitorResponseTimeSecValue) GetTime() time.Time {
+	return o.Time
 }
 
-// SetTags sets value to Tags
-func (o *SIM) SetTags(v []string) {
-	o.Tags = v
+// SetTime sets value to Time
+func (o *MonitorResponseTimeSecValue) SetTime(v time.Time) {
+	o.Time = v
 }
-
-// GetAvailability returns value of Availability
-func (o *SIM) GetAvailability() types.EAvailability {
-	return o.Availability
+
+// GetResponseTimeSec returns value of ResponseTimeSec
+func (o *MonitorResponseTimeSecValue) GetResponseTimeSec() float64 {
+	return o.ResponseTimeSec
 }
 
-// SetAvailability sets value to Availability
-func (o *SIM) SetAvailability(v types.EAvailability) {
-	o.Availability = v
+// SetResponseTimeSec sets value to ResponseTimeSec
+func (o *MonitorResponseTimeSecValue) SetResponseTimeSec(v float64) {
+	o.ResponseTimeSec = v
 }
 
-// GetClass returns value of Class
-func (o *SIM) GetClass() string {
-	return o.Class
+// convertTo returns naked MonitorResponseTimeSecValue
+func (o *MonitorResponseTimeSecValue) convertTo() (*naked.MonitorResponseTimeSecValue, error) {
+	dest := &naked.MonitorResponseTimeSecValue{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetClass sets value to Class
-func (o *SIM) SetClass(v string) {
-	o.Class = v
+// convertFrom parse values from naked MonitorResponseTimeSecValue
+func (o *MonitorResponseTimeSecValue) convertFrom(naked *naked.MonitorResponseTimeSecValue) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// GetICCID returns value of ICCID
-func (o *SIM) GetICCID() types.StringNumber {
-	return o.ICCID
+/*************************************************
+* SimpleMonitorHealthStatus
+*************************************************/
+
+// SimpleMonitorHealthStatus represents API parameter/response structure
+type SimpleMonitorHealthStatus struct {
+	LastCheckedAt       time.Time
+	LastHealthChangedAt time.Time
+	Health              types.ESimpleMonitorHealth
 }
 
-// SetICCID sets value to ICCID
-func (o *SIM) SetICCID(v types.StringNumber) {
-	o.ICCID = v
+// Validate validates by field tags
+func (o *SimpleMonitorHealthStatus) Validate() error {
+	return validator.New().Struct(o)
 }
 
-// GetIconID returns value of IconID
-func (o *SIM) GetIconID() types.ID {
-	return o.IconID
+// GetLastCheckedAt returns value of LastCheckedAt
+func (o *SimpleMonitorHealthStatus) GetLastCheckedAt() time.Time {
+	return o.LastCheckedAt
 }
 
-// SetIconID sets value to IconID
-func (o *SIM) SetIconID(v types.ID) {
-	o.IconID = v
+// SetLastCheckedAt sets value to LastCheckedAt
+func (o *SimpleMonitorHealthStatus) SetLastCheckedAt(v time.Time) {
+	o.LastCheckedAt = v
 }
 
-// GetCreatedAt returns value of CreatedAt
-func (o *SIM) GetCreatedAt() time.Time {
-	return o.CreatedAt
+// GetLastHealthChangedAt returns value of LastHealthChangedAt
+func (o *SimpleMonitorHealthStatus) GetLastHealthChangedAt() time.Time {
+	return o.LastHealthChangedAt
 }
 
-// SetCreatedAt sets value to CreatedAt
-func (o *SIM) SetCreatedAt(v time.Time) {
-	o.CreatedAt = v
+// SetLastHealthChangedAt sets value to LastHealthChangedAt
+func (o *SimpleMonitorHealthStatus) SetLastHealthChangedAt(v time.Time) {
+	o.LastHealthChangedAt = v
 }
 
-// GetModifiedAt returns value of ModifiedAt
-func (o *SIM) GetModifiedAt() time.Time {
-	return o.ModifiedAt
+// GetHealth returns value of Health
+func (o *SimpleMonitorHealthStatus) GetHealth() types.ESimpleMonitorHealth {
+	return o.Health
 }
 
-// SetModifiedAt sets value to ModifiedAt
-func (o *SIM) SetModifiedAt(v time.Time) {
-	o.ModifiedAt = v
+// SetHealth sets value to Health
+func (o *SimpleMonitorHealthStatus) SetHealth(v types.ESimpleMonitorHealth) {
+	o.Health = v
 }
 
-// convertTo returns naked SIM
-func (o *SIM) convertTo() (*naked.SIM, error) {
-	dest := &naked.SIM{}
+// convertTo returns naked SimpleMonitorHealthStatus
+func (o *SimpleMonitorHealthStatus) convertTo() (*naked.SimpleMonitorHealthStatus, error) {
+	dest := &naked.SimpleMonitorHealthStatus{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked SIM
-func (o *SIM) convertFrom(naked *naked.SIM) error {
+// convertFrom parse values from naked SimpleMonitorHealthStatus
+func (o *SimpleMonitorHealthStatus) convertFrom(naked *naked.SimpleMonitorHealthStatus) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* SIMCreateRequest
+* SSHKey
 *************************************************/
 
-// SIMCreateRequest represents API parameter/response structure
-type SIMCreateRequest struct {
+// SSHKey represents API parameter/response structure
+type SSHKey struct {
+	ID          types.ID
 	Name        string `validate:"required"`
 	Description string `validate:"min=0,max=512"`
-	Tags        []string
-	IconID      types.ID           `mapconv:"Icon.ID"`
-	Class       string             `mapconv:"Provider.Class,default=sim"`
-	ICCID       types.StringNumber `mapconv:"Status.ICCID" validate:"numeric"`
-	PassCode    string             `mapconv:"Remark.PassCode"`
+	CreatedAt   time.Time
+	PublicKey   string
+	Fingerprint string
 }
 
 // Validate validates by field tags
-func (o *SIMCreateRequest) Validate() error {
+func (o *SSHKey) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetName returns value of Name
-func (o *SIMCreateRequest) GetName() string {
-	return o.Name
+// GetID returns value of ID
+func (o *SSHKey) GetID() types.ID {
+	return o.ID
 }
 
-// SetName sets value to Name
-func (o *SIMCreateRequest) SetName(v string) {
-	o.Name = v
+// SetID sets value to ID
+func (o *SSHKey) SetID(v types.ID) {
+	o.ID = v
 }
 
-// GetDescription returns value of Description
-func (o *SIMCreateRequest) GetDescription() string {
-	return o.Description
+// GetStringID gets value to StringID
+func (o *SSHKey) GetStringID() string {
+	return accessor.GetStringID(o)
 }
 
-// SetDescription sets value to Description
-func (o *SIMCreateRequest) SetDescription(v string) {
-	o.Description = v
+// SetStringID sets value to StringID
+func (o *SSHKey) SetStringID(v string) {
+	accessor.SetStringID(o, v)
 }
 
-// GetTags returns value of Tags
-func (o *SIMCreateRequest) GetTags() []string {
-	return o.Tags
+// GetInt64ID gets value to Int64ID
+func (o *SSHKey) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
 }
 
-// SetTags sets value to Tags
-func (o *SIMCreateRequest) SetTags(v []string) {
-	o.Tags = v
+// SetInt64ID sets value to Int64ID
+func (o *SSHKey) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
 }
 
-// GetIconID returns value of IconID
-func (o *SIMCreateRequest) GetIconID() types.ID {
-	return o.IconID
+// GetName returns value of Name
+func (o *SSHKey) GetName() string {
+	return o.Name
 }
 
-// SetIconID sets value to IconID
-func (o *SIMCreateRequest) SetIconID(v types.ID) {
-	o.IconID = v
+// SetName sets value to Name
+func (o *SSHKey) SetName(v string) {
+	o.Name = v
 }
 
-// GetClass returns value of Class
-func (o *SIMCreateRequest) GetClass() string {
-	return o.Class
+// GetDescription returns value of Description
+func (o *SSHKey) GetDescription() string {
+	return o.Description
 }
 
-// SetClass sets value to Class
-func (o *SIMCreateRequest) SetClass(v string) {
-	o.Class = v
+// SetDescription sets value to Description
+func (o *SSHKey) SetDescription(v string) {
+	o.Description = v
 }
 
-// GetICCID returns value of ICCID
-func (o *SIMCreateRequest) GetICCID() types.StringNumber {
-	return o.ICCID
+// GetCreatedAt returns value of CreatedAt
+func (o *SSHKey) GetCreatedAt() time.Time {
+	return o.CreatedAt
 }
 
-// SetICCID sets value to ICCID
-func (o *SIMCreateRequest) SetICCID(v types.StringNumber) {
-	o.ICCID = v
+// SetCreatedAt sets value to CreatedAt
+func (o *SSHKey) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
 }
 
-// GetPassCode returns value of PassCode
-func (o *SIMCreateRequest) GetPassCode() string {
-	return o.PassCode
+// GetPublicKey returns value of PublicKey
+func (o *SSHKey) GetPublicKey() string {
+	return o.PublicKey
 }
 
-// SetPassCode sets value to PassCode
-func (o *SIMCreateRequest) SetPassCode(v string) {
-	o.PassCode = v
+// SetPublicKey sets value to PublicKey
+func (o *SSHKey) SetPublicKey(v string) {
+	o.PublicKey = v
 }
 
-// convertTo returns naked SIMCreateRequest
-func (o *SIMCreateRequest) convertTo() (*naked.SIM, error) {
-	dest := &naked.SIM{}
+// GetFingerprint returns value of Fingerprint
+func (o *SSHKey) GetFingerprint() string {
+	return o.Fingerprint
+}
+
+// SetFingerprint sets value to Fingerprint
+func (o *SSHKey) SetFingerprint(v string) {
+	o.Fingerprint = v
+}
+
+// convertTo returns naked SSHKey
+func (o *SSHKey) convertTo() (*naked.SSHKey, error) {
+	dest := &naked.SSHKey{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked SIMCreateRequest
-func (o *SIMCreateRequest) convertFrom(naked *naked.SIM) error {
+// convertFrom parse values from naked SSHKey
+func (o *SSHKey) convertFrom(naked *naked.SSHKey) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* SIMUpdateRequest
+* SSHKeyCreateRequest
 *************************************************/
 
-// SIMUpdateRequest represents API parameter/response structure
-type SIMUpdateRequest struct {
+// SSHKeyCreateRequest represents API parameter/response structure
+type SSHKeyCreateRequest struct {
 	Name        string `validate:"required"`
 	Description string `validate:"min=0,max=512"`
-	Tags        []string
-	IconID      types.ID `mapconv:"Icon.ID"`
+	PublicKey   string `validate:"required"`
 }
 
 // Validate validates by field tags
-func (o *SIMUpdateRequest) Validate() error {
+func (o *SSHKeyCreateRequest) Validate() error {
 	return validator.New().Struct(o)
 }
 
 // GetName returns value of Name
-func (o *SIMUpdateRequest) GetName() string {
+func (o *SSHKeyCreateRequest) GetName() string {
 	return o.Name
 }
 
 // SetName sets value to Name
-func (o *SIMUpdateRequest) SetName(v string) {
+func (o *SSHKeyCreateRequest) SetName(v string) {
 	o.Name = v
 }
 
 // GetDescription returns value of Description
-func (o *SIMUpdateRequest) GetDescription() string {
+func (o *SSHKeyCreateRequest) GetDescription() string {
 	return o.Description
 }
 
 // SetDescription sets value to Description
-func (o *SIMUpdateRequest) SetDescription(v string) {
+func (o *SSHKeyCreateRequest) SetDescription(v string) {
 	o.Description = v
 }
 
-// GetTags returns value of Tags
-func (o *SIMUpdateRequest) GetTags() []string {
-	return o.Tags
-}
-
-// SetTags sets value to Tags
-func (o *SIMUpdateRequest) SetTags(v []string) {
-	o.Tags = v
-}
-
-// GetIconID returns value of IconID
-func (o *SIMUpdateRequest) GetIconID() types.ID {
-	return o.IconID
+// GetPublicKey returns value of PublicKey
+func (o *SSHKeyCreateRequest) GetPublicKey() string {
+	return o.PublicKey
 }
 
-// SetIconID sets value to IconID
-func (o *SIMUpdateRequest) SetIconID(v types.ID) {
-	o.IconID = v
+// SetPublicKey sets value to PublicKey
+func (o *SSHKeyCreateRequest) SetPublicKey(v string) {
+	o.PublicKey = v
 }
 
-// convertTo returns naked SIMUpdateRequest
-func (o *SIMUpdateRequest) convertTo() (*naked.SIM, error) {
-	dest := &naked.SIM{}
+// convertTo returns naked SSHKeyCreateRequest
+func (o *SSHKeyCreateRequest) convertTo() (*naked.SSHKey, error) {
+	dest := &naked.SSHKey{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked SIMUpdateRequest
-func (o *SIMUpdateRequest) convertFrom(naked *naked.SIM) error {
+// convertFrom parse values from naked SSHKeyCreateRequest
+func (o *SSHKeyCreateRequest) convertFrom(naked *naked.SSHKey) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* SIMAssignIPRequest
+* SSHKeyGenerated
 *************************************************/
 
-// SIMAssignIPRequest represents API parameter/response structure
-type SIMAssignIPRequest struct {
-	IP string
+// SSHKeyGenerated represents API parameter/response structure
+type SSHKeyGenerated struct {
+	ID          types.ID
+	Name        string `validate:"required"`
+	Description string `validate:"min=0,max=512"`
+	CreatedAt   time.Time
+	PublicKey   string
+	PrivateKey  string
+	Fingerprint string
 }
 
 // Validate validates by field tags
-func (o *SIMAssignIPRequest) Validate() error {
+func (o *SSHKeyGenerated) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetIP returns value of IP
-func (o *SIMAssignIPRequest) GetIP() string {
-	return o.IP
+// GetID returns value of ID
+func (o *SSHKeyGenerated) GetID() types.ID {
+	return o.ID
 }
 
-// SetIP sets value to IP
-func (o *SIMAssignIPRequest) SetIP(v string) {
-	o.IP = v
+// SetID sets value to ID
+func (o *SSHKeyGenerated) SetID(v types.ID) {
+	o.ID = v
 }
 
-// convertTo returns naked SIMAssignIPRequest
-func (o *SIMAssignIPRequest) convertTo() (*naked.SIMAssignIPRequest, error) {
-	dest := &naked.SIMAssignIPRequest{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetStringID gets value to StringID
+func (o *SSHKeyGenerated) GetStringID() string {
+	return accessor.GetStringID(o)
 }
 
-// convertFrom parse values from naked SIMAssignIPRequest
-func (o *SIMAssignIPRequest) convertFrom(naked *naked.SIMAssignIPRequest) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetStringID sets value to StringID
+func (o *SSHKeyGenerated) SetStringID(v string) {
+	accessor.SetStringID(o, v)
 }
 
-/*************************************************
-* SIMIMEILockRequest
-*************************************************/
+// GetInt64ID gets value to Int64ID
+func (o *SSHKeyGenerated) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
+}
 
-// SIMIMEILockRequest represents API parameter/response structure
-type SIMIMEILockRequest struct {
-	IMEI string
+// SetInt64ID sets value to Int64ID
+func (o *SSHKeyGenerated) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
 }
 
-// Validate validates by field tags
-func (o *SIMIMEILockRequest) Validate() error {
-	return validator.New().Struct(o)
+// GetName returns value of Name
+func (o *SSHKeyGenerated) GetName() string {
+	return o.Name
 }
 
-// GetIMEI returns value of IMEI
-func (o *SIMIMEILockRequest) GetIMEI() string {
-	return o.IMEI
+// SetName sets value to Name
+func (o *SSHKeyGenerated) SetName(v string) {
+	o.Name = v
 }
 
-// SetIMEI sets value to IMEI
-func (o *SIMIMEILockRequest) SetIMEI(v string) {
-	o.IMEI = v
+// GetDescription returns value of Description
+func (o *SSHKeyGenerated) GetDescription() string {
+	return o.Description
 }
 
-// convertTo returns naked SIMIMEILockRequest
-func (o *SIMIMEILockRequest) convertTo() (*naked.SIMIMEILockRequest, error) {
-	dest := &naked.SIMIMEILockRequest{}
+// SetDescription sets value to Description
+func (o *SSHKeyGenerated) SetDescription(v string) {
+	o.Description = v
+}
+
+// GetCreatedAt returns value of CreatedAt
+func (o *SSHKeyGenerated) GetCreatedAt() time.Time {
+	return o.CreatedAt
+}
+
+// SetCreatedAt sets value to CreatedAt
+func (o *SSHKeyGenerated) SetCreatedAt(v time.Time) {
+	o.CreatedAt = v
+}
+
+// GetPublicKey returns value of PublicKey
+func (o *SSHKeyGenerated) GetPublicKey() string {
+	return o.PublicKey
+}
+
+// SetPublicKey sets value to PublicKey
+func (o *SSHKeyGenerated) SetPublicKey(v string) {
+	o.PublicKey = v
+}
+
+// GetPrivateKey returns value of PrivateKey
+func (o *SSHKeyGenerated) GetPrivateKey() string {
+	return o.PrivateKey
+}
+
+// SetPrivateKey sets value to PrivateKey
+func (o *SSHKeyGenerated) SetPrivateKey(v string) {
+	o.PrivateKey = v
+}
+
+// GetFingerprint returns value of Fingerprint
+func (o *SSHKeyGenerated) GetFingerprint() string {
+	return o.Fingerprint
+}
+
+// SetFingerprint sets value to Fingerprint
+func (o *SSHKeyGenerated) SetFingerprint(v string) {
+	o.Fingerprint = v
+}
+
+// convertTo returns naked SSHKeyGenerated
+func (o *SSHKeyGenerated) convertTo() (*naked.SSHKeyGenerated, error) {
+	dest := &naked.SSHKeyGenerated{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked SIMIMEILockRequest
-func (o *SIMIMEILockRequest) convertFrom(naked *naked.SIMIMEILockRequest) error {
+// convertFrom parse values from naked SSHKeyGenerated
+func (o *SSHKeyGenerated) convertFrom(naked *naked.SSHKeyGenerated) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* SIMLog
+* SSHKeyGenerateRequest
 *************************************************/
 
-// SIMLog represents API parameter/response structure
-type SIMLog struct {
-	Date          time.Time
-	SessionStatus string
-	ResourceID    string
-	IMEI          string
-	IMSI          string
+// SSHKeyGenerateRequest represents API parameter/response structure
+type SSHKeyGenerateRequest struct {
+	Name           string `validate:"required"`
+	Description    string `validate:"min=0,max=512"`
+	GenerateFormat string `mapconv:",default=openssh"`
+	PassPhrase     string `validate:"omitempty,min=8,max=64"`
 }
 
 // Validate validates by field tags
-func (o *SIMLog) Validate() error {
+func (o *SSHKeyGenerateRequest) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetDate returns value of Date
-func (o *SIMLog) GetDate() time.Time {
-	return o.Date
+// GetName returns value of Name
+func (o *SSHKeyGenerateRequest) GetName() string {
+	return o.Name
 }
 
-// SetDate sets value to Date
-func (o *SIMLog) SetDate(v time.Time) {
-	o.Date = v
+// SetName sets value to Name
+func (o *SSHKeyGenerateRequest) SetName(v string) {
+	o.Name = v
 }
 
-// GetSessionStatus returns value of SessionStatus
-func (o *SIMLog) GetSessionStatus() string {
-	return o.SessionStatus
+// GetDescription returns value of Description
+func (o *SSHKeyGenerateRequest) GetDescription() string {
+	return o.Description
 }
 
-// SetSessionStatus sets value to SessionStatus
-func (o *SIMLog) SetSessionStatus(v string) {
-	o.SessionStatus = v
+// SetDescription sets value to Description
+func (o *SSHKeyGenerateRequest) SetDescription(v string) {
+	o.Description = v
 }
 
-// GetResourceID returns value of ResourceID
-func (o *SIMLog) GetResourceID() string {
-	return o.ResourceID
+// GetGenerateFormat returns value of GenerateFormat
+func (o *SSHKeyGenerateRequest) GetGenerateFormat() string {
+	return o.GenerateFormat
 }
 
-// SetResourceID sets value to ResourceID
-func (o *SIMLog) SetResourceID(v string) {
-	o.ResourceID = v
+// SetGenerateFormat sets value to GenerateFormat
+func (o *SSHKeyGenerateRequest) SetGenerateFormat(v string) {
+	o.GenerateFormat = v
 }
 
-// GetIMEI returns value of IMEI
-func (o *SIMLog) GetIMEI() string {
-	return o.IMEI
+// GetPassPhrase returns value of PassPhrase
+func (o *SSHKeyGenerateRequest) GetPassPhrase() string {
+	return o.PassPhrase
 }
 
-// SetIMEI sets value to IMEI
-func (o *SIMLog) SetIMEI(v string) {
-	o.IMEI = v
+// SetPassPhrase sets value to PassPhrase
+func (o *SSHKeyGenerateRequest) SetPassPhrase(v string) {
+	o.PassPhrase = v
 }
 
-// GetIMSI returns value of IMSI
-func (o *SIMLog) GetIMSI() string {
-	return o.IMSI
+// convertTo returns naked SSHKeyGenerateRequest
+func (o *SSHKeyGenerateRequest) convertTo() (*naked.SSHKeyGenerateParam, error) {
+	dest := &naked.SSHKeyGenerateParam{}
+	err := mapconv.ConvertTo(o, dest)
+	return dest, err
 }
 
-// SetIMSI sets value to IMSI
-func (o *SIMLog) SetIMSI(v string) {
-	o.IMSI = v
+// convertFrom parse values from naked SSHKeyGenerateRequest
+func (o *SSHKeyGenerateRequest) convertFrom(naked *naked.SSHKeyGenerateParam) error {
+	return mapconv.ConvertFrom(naked, o)
 }
 
-// convertTo returns naked SIMLog
-func (o *SIMLog) convertTo() (*naked.SIMLog, error) {
-	dest := &naked.SIMLog{}
+/*************************************************
+* SSHKeyUpdateRequest
+*************************************************/
+
+// SSHKeyUpdateRequest represents API parameter/response structure
+type SSHKeyUpdateRequest struct {
+	Name        string `validate:"required"`
+	Description string `validate:"min=0,max=512"`
+}
+
+// Validate validates by field tags
+func (o *SSHKeyUpdateRequest) Validate() error {
+	return validator.New().Struct(o)
+}
+
+// GetName returns value of Name
+func (o *SSHKeyUpdateRequest) GetName() string {
+	return o.Name
+}
+
+// SetName sets value to Name
+func (o *SSHKeyUpdateRequest) SetName(v string) {
+	o.Name = v
+}
+
+// GetDescription returns value of Description
+func (o *SSHKeyUpdateRequest) GetDescription() string {
+	return o.Description
+}
+
+// SetDescription sets value to Description
+func (o *SSHKeyUpdateRequest) SetDescription(v string) {
+	o.Description = v
+}
+
+// convertTo returns naked SSHKeyUpdateRequest
+func (o *SSHKeyUpdateRequest) convertTo() (*naked.SSHKey, error) {
+	dest := &naked.SSHKey{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked SIMLog
-func (o *SIMLog) convertFrom(naked *naked.SIMLog) error {
+// convertFrom parse values from naked SSHKeyUpdateRequest
+func (o *SSHKeyUpdateRequest) convertFrom(naked *naked.SSHKey) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* SIMNetworkOperatorConfig
+* Subnet
 *************************************************/
 
-// SIMNetworkOperatorConfig represents API parameter/response structure
-type SIMNetworkOperatorConfig struct {
-	Allow       bool
-	CountryCode string
-	Name        string
+// Subnet represents API parameter/response structure
+type Subnet struct {
+	ID             types.ID
+	SwitchID       types.ID           `mapconv:"Switch.ID,omitempty"`
+	InternetID     types.ID           `mapconv:"Internet.ID,omitempty"`
+	DefaultRoute   string             `validate:"ipv4"`
+	NextHop        string             `validate:"ipv4"`
+	StaticRoute    string             `validate:"ipv4"`
+	NetworkAddress string             `validate:"ipv4"`
+	NetworkMaskLen int                `validate:"min=24,max=28"`
+	IPAddresses    []*SubnetIPAddress `mapconv:"[]IPAddresses,recursive"`
 }
 
 // Validate validates by field tags
-func (o *SIMNetworkOperatorConfig) Validate() error {
+func (o *Subnet) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetAllow returns value of Allow
-func (o *SIMNetworkOperatorConfig) GetAllow() bool {
-	return o.Allow
+// GetID returns value of ID
+func (o *Subnet) GetID() types.ID {
+	return o.ID
 }
 
-// SetAllow sets value to Allow
-func (o *SIMNetworkOperatorConfig) SetAllow(v bool) {
-	o.Allow = v
+// SetID sets value to ID
+func (o *Subnet) SetID(v types.ID) {
+	o.ID = v
 }
 
-// GetCountryCode returns value of CountryCode
-func (o *SIMNetworkOperatorConfig) GetCountryCode() string {
-	return o.CountryCode
+// GetStringID gets value to StringID
+func (o *Subnet) GetStringID() string {
+	return accessor.GetStringID(o)
 }
 
-// SetCountryCode sets value to CountryCode
-func (o *SIMNetworkOperatorConfig) SetCountryCode(v string) {
-	o.CountryCode = v
+// SetStringID sets value to StringID
+func (o *Subnet) SetStringID(v string) {
+	accessor.SetStringID(o, v)
 }
 
-// GetName returns value of Name
-func (o *SIMNetworkOperatorConfig) GetName() string {
-	return o.Name
+// GetInt64ID gets value to Int64ID
+func (o *Subnet) GetInt64ID() int64 {
+	return accessor.GetInt64ID(o)
 }
 
-// SetName sets value to Name
-func (o *SIMNetworkOperatorConfig) SetName(v string) {
-	o.Name = v
+// SetInt64ID sets value to Int64ID
+func (o *Subnet) SetInt64ID(v int64) {
+	accessor.SetInt64ID(o, v)
 }
 
-// convertTo returns naked SIMNetworkOperatorConfig
-func (o *SIMNetworkOperatorConfig) convertTo() (*naked.SIMNetworkOperatorConfig, error) {
-	dest := &naked.SIMNetworkOperatorConfig{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// GetSwitchID returns value of SwitchID
+func (o *Subnet) GetSwitchID() types.ID {
+	return o.SwitchID
 }
 
-// convertFrom parse values from naked SIMNetworkOperatorConfig
-func (o *SIMNetworkOperatorConfig) convertFrom(naked *naked.SIMNetworkOperatorConfig) error {
-	return mapconv.ConvertFrom(naked, o)
+// SetSwitchID sets value to SwitchID
+func (o *Subnet) SetSwitchID(v types.ID) {
+	o.SwitchID = v
 }
 
-/*************************************************
-* SIMNetworkOperatorConfigs
-*************************************************/
+// GetInternetID returns value of InternetID
+func (o *Subnet) GetInternetID() types.ID {
+	return o.InternetID
+}
 
-// SIMNetworkOperatorConfigs represents API parameter/response structure
-type SIMNetworkOperatorConfigs struct {
-	NetworkOperatorConfigs []*SIMNetworkOperatorConfig
+// SetInternetID sets value to InternetID
+func (o *Subnet) SetInternetID(v types.ID) {
+	o.InternetID = v
 }
 
-// Validate validates by field tags
-func (o *SIMNetworkOperatorConfigs) Validate() error {
-	return validator.New().Struct(o)
+// GetDefaultRoute returns value of DefaultRoute
+func (o *Subnet) GetDefaultRoute() string {
+	return o.DefaultRoute
 }
 
-// GetNetworkOperatorConfigs returns value of NetworkOperatorConfigs
-func (o *SIMNetworkOperatorConfigs) GetNetworkOperatorConfigs() []*SIMNetworkOperatorConfig {
-	return o.NetworkOperatorConfigs
+// SetDefaultRoute sets value to DefaultRoute
+func (o *Subnet) SetDefaultRoute(v string) {
+	o.DefaultRoute = v
 }
 
-// SetNetworkOperatorConfigs sets value to NetworkOperatorConfigs
-func (o *SIMNetworkOperatorConfigs) SetNetworkOperatorConfigs(v []*SIMNetworkOperatorConfig) {
-	o.NetworkOperatorConfigs = v
+// GetNextHop returns value of NextHop
+func (o *Subnet) GetNextHop() string {
+	return o.NextHop
 }
 
-// convertTo returns naked SIMNetworkOperatorConfigs
-func (o *SIMNetworkOperatorConfigs) convertTo() (*naked.SIMNetworkOperatorConfigs, error) {
-	dest := &naked.SIMNetworkOperatorConfigs{}
-	err := mapconv.ConvertTo(o, dest)
-	return dest, err
+// SetNextHop sets value to NextHop
+func (o *Subnet) SetNextHop(v string) {
+	o.NextHop = v
 }
 
-// convertFrom parse values from naked SIMNetworkOperatorConfigs
-func (o *SIMNetworkOperatorConfigs) convertFrom(naked *naked.SIMNetworkOperatorConfigs) error {
-	return mapconv.ConvertFrom(naked, o)
+// GetStaticRoute returns value of StaticRoute
+func (o *Subnet) GetStaticRoute() string {
+	return o.StaticRoute
 }
 
-/*************************************************
-* LinkActivity
-*************************************************/
+// SetStaticRoute sets value to StaticRoute
+func (o *Subnet) SetStaticRoute(v string) {
+	o.StaticRoute = v
+}
 
-// LinkActivity represents API parameter/response structure
-type LinkActivity struct {
-	Values []*MonitorLinkValue `mapconv:"[]Link"`
+// GetNetworkAddress returns value of NetworkAddress
+func (o *Subnet) GetNetworkAddress() string {
+	return o.NetworkAddress
 }
 
-// Validate validates by field tags
-func (o *LinkActivity) Validate() error {
-	return validator.New().Struct(o)
+// SetNetworkAddress sets value to NetworkAddress
+func (o *Subnet) SetNetworkAddress(v string) {
+	o.NetworkAddress = v
 }
 
-// GetValues returns value of Values
-func (o *LinkActivity) GetValues() []*MonitorLinkValue {
-	return o.Values
+// GetNetworkMaskLen returns value of NetworkMaskLen
+func (o *Subnet) GetNetworkMaskLen() int {
+	return o.NetworkMaskLen
 }
 
-// SetValues sets value to Values
-func (o *LinkActivity) SetValues(v []*MonitorLinkValue) {
-	o.Values = v
+// SetNetworkMaskLen sets value to NetworkMaskLen
+func (o *Subnet) SetNetworkMaskLen(v int) {
+	o.NetworkMaskLen = v
 }
 
-// convertTo returns naked LinkActivity
-func (o *LinkActivity) convertTo() (*naked.MonitorValues, error) {
-	dest := &naked.MonitorValues{}
+// GetIPAddresses returns value of IPAddresses
+func (o *Subnet) GetIPAddresses() []*SubnetIPAddress {
+	return o.IPAddresses
+}
+
+// SetIPAddresses sets value to IPAddresses
+func (o *Subnet) SetIPAddresses(v []*SubnetIPAddress) {
+	o.IPAddresses = v
+}
+
+// convertTo returns naked Subnet
+func (o *Subnet) convertTo() (*naked.Subnet, error) {
+	dest := &naked.Subnet{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked LinkActivity
-func (o *LinkActivity) convertFrom(naked *naked.MonitorValues) error {
+// convertFrom parse values from naked Subnet
+func (o *Subnet) convertFrom(naked *naked.Subnet) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 
 /*************************************************
-* MonitorLinkValue
+* SubnetIPAddress
 *************************************************/
 
-// MonitorLinkValue represents API parameter/response structure
-type MonitorLinkValue struct {
-	Time        time.Time `json:",omitempty" mapconv:",omitempty"`
-	UplinkBPS   float64   `json:",omitempty" mapconv:",omitempty"`
-	DownlinkBPS float64   `json:",omitempty" mapconv:",omitempty"`
+// SubnetIPAddress represents API parameter/response structure
+type SubnetIPAddress struct {
+	HostName  string
+	IPAddress string
 }
 
 // Validate validates by field tags
-func (o *MonitorLinkValue) Validate() error {
+func (o *SubnetIPAddress) Validate() error {
 	return validator.New().Struct(o)
 }
 
-// GetTime returns value of Time
-func (o *MonitorLinkValue) GetTime() time.Time {
-	return o.Time
-}
-
-// SetTime sets value to Time
-func (o *MonitorLinkValue) SetTime(v time.Time) {
-	o.Time = v
-}
-
-// GetUplinkBPS returns value of UplinkBPS
-func (o *MonitorLinkValue) GetUplinkBPS() float64 {
-	return o.UplinkBPS
+// GetHostName returns value of HostName
+func (o *SubnetIPAddress) GetHostName() string {
+	return o.HostName
 }
 
-// SetUplinkBPS sets value to UplinkBPS
-func (o *MonitorLinkValue) SetUplinkBPS(v float64) {
-	o.UplinkBPS = v
+// SetHostName sets value to HostName
+func (o *SubnetIPAddress) SetHostName(v string) {
+	o.HostName = v
 }
 
-// GetDownlinkBPS returns value of DownlinkBPS
-func (o *MonitorLinkValue) GetDownlinkBPS() float64 {
-	return o.DownlinkBPS
+// GetIPAddress returns value of IPAddress
+func (o *SubnetIPAddress) GetIPAddress() string {
+	return o.IPAddress
 }
 
-// SetDownlinkBPS sets value to DownlinkBPS
-func (o *MonitorLinkValue) SetDownlinkBPS(v float64) {
-	o.DownlinkBPS = v
+// SetIPAddress sets value to IPAddress
+func (o *SubnetIPAddress) SetIPAddress(v string) {
+	o.IPAddress = v
 }
 
-// convertTo returns naked MonitorLinkValue
-func (o *MonitorLinkValue) convertTo() (*naked.MonitorLinkValue, error) {
-	dest := &naked.MonitorLinkValue{}
+// convertTo returns naked SubnetIPAddress
+func (o *SubnetIPAddress) convertTo() (*naked.SubnetIPAddress, error) {
+	dest := &naked.SubnetIPAddress{}
 	err := mapconv.ConvertTo(o, dest)
 	return dest, err
 }
 
-// convertFrom parse values from naked MonitorLinkValue
-func (o *MonitorLinkValue) convertFrom(naked *naked.MonitorLinkValue) error {
+// convertFrom parse values from naked SubnetIPAddress
+func (o *SubnetIPAddress) convertFrom(naked *naked.SubnetIPAddress) error {
 	return mapconv.ConvertFrom(naked, o)
 }
 